@@ -28,6 +28,7 @@ import (
 
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
 	"github.com/matrixorigin/matrixone/pkg/util/metric"
+	"github.com/matrixorigin/matrixone/pkg/util/sysevent"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 
@@ -101,6 +102,7 @@ func createMOServer(callback *frontend.PDCallbackImpl) {
 		return frontend.NewIternalExecutor(pu, callback)
 	}
 	metric.InitMetric(ieFactory, pu, callback.Id, metric.ALL_IN_ONE_MODE)
+	sysevent.Init(ieFactory)
 	frontend.InitServerVersion(MoVersion)
 }
 
@@ -109,6 +111,7 @@ func runMOServer() error {
 }
 
 func serverShutdown(isgraceful bool) error {
+	sysevent.Stop()
 	return mo.Stop()
 }
 
@@ -366,6 +369,12 @@ func main() {
 			logutil.Infof("Initialize catalog failed. error:%v", err)
 			os.Exit(InitCatalogExit)
 		}
+		//drop the temporary tables left by the sessions before the restart
+		err = frontend.SweepTemporaryTables(tae.eng)
+		if err != nil {
+			logutil.Infof("Drop temporary tables failed. error:%v", err)
+			os.Exit(InitCatalogExit)
+		}
 		fmt.Println("Initialize the TAE engine Done")
 	} else {
 		logutil.Errorf("undefined engine %s", engineName)
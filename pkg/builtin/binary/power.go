@@ -94,7 +94,7 @@ func init() {
 				case lv.Ref == 1 || lv.Ref == 0:
 					lv.Ref = 0
 					power.Power(lvs, rvs, lvs)
-					lv.Nsp = lv.Nsp.Or(rv.Nsp)
+					nulls.Set(lv.Nsp, rv.Nsp)
 					if rv.Ref == 0 {
 						process.Put(proc, rv)
 					}
@@ -102,7 +102,7 @@ func init() {
 				case rv.Ref == 1 || rv.Ref == 0:
 					rv.Ref = 0
 					power.Power(lvs, rvs, rvs)
-					rv.Nsp = rv.Nsp.Or(lv.Nsp)
+					nulls.Set(rv.Nsp, lv.Nsp)
 					if lv.Ref == 0 {
 						process.Put(proc, lv)
 					}
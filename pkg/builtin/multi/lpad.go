@@ -15,7 +15,6 @@
 package multi
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/builtin"
@@ -59,89 +58,51 @@ func init() {
 			Max:        3,
 			Typ:        types.T_varchar,
 			ReturnType: types.T_varchar,
-			Fn: func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
-				vs := vecs[0].Col.(*types.Bytes) //Get the first arg
-
-				if !cs[1] && vecs[1].Typ.Oid != types.T_int64 {
-					return nil, errors.New("The second argument of the lpad function must be an int64 constant")
-				}
-				if !cs[2] && vecs[2].Typ.Oid != types.T_varchar {
-					return nil, errors.New("The third argument of the lpad function must be an string constant")
-				}
-				lens := vecs[1].Col.([]int64)
-				padds := vecs[2].Col.(*types.Bytes)
-				for _, num := range lens {
-					if num < 0 {
-						vec, err := process.Get(proc, 24*int64(len(vs.Lengths)), types.Type{Oid: types.T_varchar, Size: 24})
-						if err != nil {
-							return nil, err
-						}
-						nulls.Set(vec.Nsp, vecs[0].Nsp)
-						temp := ""
-						lengths_temp := []uint32{}
-						offsets_temp := []uint32{}
-						for k := 0; k < len(vs.Lengths); k++ {
-							temp += "NULL"
-							lengths_temp = append(lengths_temp, 4)
-							if len(offsets_temp) == 0 {
-								offsets_temp = append(offsets_temp, 0)
-							} else {
-								offsets_temp = append(offsets_temp, offsets_temp[len(offsets_temp)-1]+4)
-							}
-						}
-						res := &types.Bytes{
-							Data:    []byte(temp),
-							Lengths: lengths_temp,
-							Offsets: offsets_temp,
-						}
-						vector.SetCol(vec, res)
-						return vec, nil
-					}
+			Fn: func(origVecs []*vector.Vector, proc *process.Process, isConst []bool) (*vector.Vector, error) {
+				result, nsp, err := padBytes(origVecs, isConst, lpad.Lpad)
+				if err != nil {
+					return nil, err
 				}
-				//use vecs[0] as return
-				if vecs[0].Ref == 1 || vecs[0].Ref == 0 {
-					vecs[0].Ref = 0
-					temp := lpad.LpadVarchar(vs, lens, padds)
-					vs.Data = make([]byte, len(temp.Data))
-					vs.Lengths = make([]uint32, len(temp.Lengths))
-					vs.Offsets = make([]uint32, len(temp.Offsets))
-					copy(vs.Data, temp.Data)
-					copy(vs.Lengths, temp.Lengths)
-					copy(vs.Offsets, temp.Offsets)
-					return vecs[0], nil
+				if origVecs[0].Ref == 1 || origVecs[0].Ref == 0 {
+					// uses the original vector to store our result if it isn't needed anymore
+					origVecs[0].Ref = 0
+					origVecs[0].Nsp = nsp
+					vector.SetCol(origVecs[0], result)
+					return origVecs[0], nil
 				}
-
-				vec, err := process.Get(proc, 24*int64(len(vs.Lengths)), types.Type{Oid: types.T_varchar, Size: 24})
+				resultVec, err := process.Get(proc, 24*int64(len(result.Lengths)), types.Type{Oid: types.T_varchar, Size: 24})
 				if err != nil {
 					return nil, err
 				}
-				nulls.Set(vec.Nsp, vecs[0].Nsp)
-				vector.SetCol(vec, lpad.LpadVarchar(vs, lens, padds))
-				return vec, nil
-				// digits := int64(0)
-				// vs := vecs[0].Col.([]uint8)
-				// if len(vecs) > 1 {
-				// 	if !cs[1] && vecs[1].Typ.Oid != types.T_int64 {
-				// 		return nil, errors.New("The second argument of the round function must be an int64 constant")
-				// 	}
-				// 	digits = vecs[1].Col.([]int64)[0]
-				// }
-				// if vecs[0].Ref == 1 || vecs[0].Ref == 0 {
-				// 	vecs[0].Ref = 0
-				// 	floor.FloorUint8(vs, vs, digits)
-				// 	return vecs[0], nil
-				// }
-				// vec, err := process.Get(proc, int64(len(vs)), types.Type{Oid: types.T_uint8, Size: 1})
-				// if err != nil {
-				// 	return nil, err
-				// }
-				// rs := encoding.DecodeUint8Slice(vec.Data)
-				// rs = rs[:len(vs)]
-				// vec.Col = rs
-				// nulls.Set(vec.Nsp, vecs[0].Nsp)
-				// vector.SetCol(vec, floor.FloorUint8(vs, rs, digits))
-				// return vec, nil
+				resultVec.Nsp = nsp
+				vector.SetCol(resultVec, result)
+				return resultVec, nil
 			},
 		},
 	}
 }
+
+// padBytes pads the strings of lpad or rpad with fn, the rows with a null
+// argument or an invalid length are null
+func padBytes(vecs []*vector.Vector, isConst []bool, fn func(*types.Bytes, *types.Bytes, []int64, *types.Bytes, []bool, *nulls.Nulls) *types.Bytes) (*types.Bytes, *nulls.Nulls, error) {
+	isConst = append([]bool{}, isConst...)
+	pads, err := lpad.Pads(vecs[2].Col, isConst)
+	if err != nil {
+		return nil, nil, err
+	}
+	sizes, err := lpad.Sizes(vecs[1].Col)
+	if err != nil {
+		return nil, nil, err
+	}
+	nsp := new(nulls.Nulls)
+	if sizes == nil {
+		// a non numerical length makes empty strings
+		sizes, isConst[1] = []int64{0}, true
+		nulls.Set(nsp, vecs[0].Nsp)
+	} else {
+		for _, vec := range vecs {
+			nulls.Or(nsp, vec.Nsp, nsp)
+		}
+	}
+	return fn(&types.Bytes{}, vecs[0].Col.(*types.Bytes), sizes, pads, isConst, nsp), nsp, nil
+}
@@ -18,7 +18,6 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/builtin"
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
@@ -61,14 +60,11 @@ func init() {
 			Typ:        types.T_varchar,
 			ReturnType: types.T_varchar,
 			Fn: func(origVecs []*vector.Vector, proc *process.Process, isConst []bool) (*vector.Vector, error) {
-				// gets all args
-				strs, sizes, padstrs := origVecs[0].Col.(*types.Bytes), origVecs[1].Col, origVecs[2].Col
-				oriNsps := []*nulls.Nulls{origVecs[0].Nsp, origVecs[1].Nsp, origVecs[2].Nsp}
-
+				strs := origVecs[0].Col.(*types.Bytes)
 				if origVecs[0].Ref == 1 || origVecs[1].Ref == 0 {
 					// uses the original vector to store our result if it isn't needed anymore
 					origVecs[0].Ref = 0
-					result, nsp, err := rpad.Rpad(strs, sizes, padstrs, isConst, oriNsps)
+					result, nsp, err := padBytes(origVecs, isConst, rpad.Rpad)
 					if err != nil {
 						return nil, err
 					}
@@ -81,7 +77,7 @@ func init() {
 				if err != nil {
 					return nil, err
 				}
-				result, nsp, err := rpad.Rpad(strs, sizes, padstrs, isConst, oriNsps)
+				result, nsp, err := padBytes(origVecs, isConst, rpad.Rpad)
 				if err != nil {
 					return nil, err
 				}
@@ -97,13 +93,11 @@ func init() {
 			Typ:        types.T_char,
 			ReturnType: types.T_char,
 			Fn: func(origVecs []*vector.Vector, proc *process.Process, isConst []bool) (*vector.Vector, error) {
-				// gets all args
-				strs, sizes, padstrs := origVecs[0].Col.(*types.Bytes), origVecs[1].Col, origVecs[2].Col
-				oriNsps := []*nulls.Nulls{origVecs[0].Nsp, origVecs[1].Nsp, origVecs[2].Nsp}
+				strs := origVecs[0].Col.(*types.Bytes)
 				if origVecs[0].Ref == 1 || origVecs[1].Ref == 0 {
 					// uses the original vector to store our result if it isn't needed anymore
 					origVecs[0].Ref = 0
-					result, nsp, err := rpad.Rpad(strs, sizes, padstrs, isConst, oriNsps)
+					result, nsp, err := padBytes(origVecs, isConst, rpad.Rpad)
 					if err != nil {
 						return nil, err
 					}
@@ -116,7 +110,7 @@ func init() {
 				if err != nil {
 					return nil, err
 				}
-				result, nsp, err := rpad.Rpad(strs, sizes, padstrs, isConst, oriNsps)
+				result, nsp, err := padBytes(origVecs, isConst, rpad.Rpad)
 				if err != nil {
 					return nil, err
 				}
@@ -51,11 +51,8 @@ func init() {
 			Fn: func(origVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
 				origVecCol := origVec.Col.(*types.Bytes)
 
-				// totalCount - spaceCount is the total bytes need for the ltrim-ed string
-				spaceCount := ltrim.CountSpacesFromLeft(origVecCol)
-				totalCount := int32(len(origVecCol.Data))
-
-				resultVector, err := process.Get(proc, int64(totalCount-spaceCount), types.Type{Oid: types.T_varchar, Size: 24})
+				// the data is sized by the ltrim-ed strings of the rows not null
+				resultVector, err := process.Get(proc, ltrim.LtrimLength(origVecCol, origVec.Nsp), types.Type{Oid: types.T_varchar, Size: 24})
 				if err != nil {
 					return nil, err
 				}
@@ -66,7 +63,7 @@ func init() {
 				}
 				resultVector.Col = results
 				nulls.Set(resultVector.Nsp, origVec.Nsp)
-				vector.SetCol(resultVector, ltrim.LtrimVarChar(origVecCol, results))
+				vector.SetCol(resultVector, ltrim.LtrimVarChar(origVecCol, results, origVec.Nsp))
 				return resultVector, nil
 			},
 		},
@@ -76,11 +73,8 @@ func init() {
 			Fn: func(origVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
 				origVecCol := origVec.Col.(*types.Bytes)
 
-				// totalCount - spaceCount is the total bytes need for the ltrim-ed string
-				spaceCount := ltrim.CountSpacesFromLeft(origVecCol)
-				totalCount := int32(len(origVecCol.Data))
-
-				resultVector, err := process.Get(proc, int64(totalCount-spaceCount), types.Type{Oid: types.T_char, Size: 24})
+				// the data is sized by the ltrim-ed strings of the rows not null
+				resultVector, err := process.Get(proc, ltrim.LtrimLength(origVecCol, origVec.Nsp), types.Type{Oid: types.T_char, Size: 24})
 				if err != nil {
 					return nil, err
 				}
@@ -91,7 +85,7 @@ func init() {
 				}
 				resultVector.Col = results
 				nulls.Set(resultVector.Nsp, origVec.Nsp)
-				vector.SetCol(resultVector, ltrim.LtrimChar(origVecCol, results))
+				vector.SetCol(resultVector, ltrim.LtrimChar(origVecCol, results, origVec.Nsp))
 				return resultVector, nil
 			},
 		},
@@ -51,11 +51,8 @@ func init() {
 			Fn: func(origVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
 				origVecCol := origVec.Col.(*types.Bytes)
 
-				// totalCount - spaceCount is the total bytes need for the rtrim-ed string
-				spaceCount := rtrim.CountSpacesFromRight(origVecCol)
-				totalCount := int32(len(origVecCol.Data))
-
-				resultVector, err := process.Get(proc, int64(totalCount-spaceCount), types.Type{Oid: types.T_varchar, Size: 24})
+				// the data is sized by the rtrim-ed strings of the rows not null
+				resultVector, err := process.Get(proc, rtrim.RtrimLength(origVecCol, origVec.Nsp), types.Type{Oid: types.T_varchar, Size: 24})
 				if err != nil {
 					return nil, err
 				}
@@ -66,7 +63,7 @@ func init() {
 				}
 				resultVector.Col = results
 				nulls.Set(resultVector.Nsp, origVec.Nsp)
-				vector.SetCol(resultVector, rtrim.RtrimVarChar(origVecCol, results))
+				vector.SetCol(resultVector, rtrim.RtrimVarChar(origVecCol, results, origVec.Nsp))
 				return resultVector, nil
 			},
 		},
@@ -76,11 +73,8 @@ func init() {
 			Fn: func(origVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
 				origVecCol := origVec.Col.(*types.Bytes)
 
-				// totalCount - spaceCount is the total bytes need for the rtrim-ed string
-				spaceCount := rtrim.CountSpacesFromRight(origVecCol)
-				totalCount := int32(len(origVecCol.Data))
-
-				resultVector, err := process.Get(proc, int64(totalCount-spaceCount), types.Type{Oid: types.T_char, Size: 24})
+				// the data is sized by the rtrim-ed strings of the rows not null
+				resultVector, err := process.Get(proc, rtrim.RtrimLength(origVecCol, origVec.Nsp), types.Type{Oid: types.T_char, Size: 24})
 				if err != nil {
 					return nil, err
 				}
@@ -91,7 +85,7 @@ func init() {
 				}
 				resultVector.Col = results
 				nulls.Set(resultVector.Nsp, origVec.Nsp)
-				vector.SetCol(resultVector, rtrim.RtrimChar(origVecCol, results))
+				vector.SetCol(resultVector, rtrim.RtrimChar(origVecCol, results, origVec.Nsp))
 				return resultVector, nil
 			},
 		},
@@ -15,6 +15,7 @@
 package moerr
 
 import (
+	"errors"
 	"fmt"
 	"runtime/debug"
 )
@@ -34,11 +35,21 @@ const (
 	// Group 2: numeric
 	DIVIVISION_BY_ZERO = 2000 + iota
 	OUT_OF_RANGE
+	TRUNCATED_WRONG_VALUE
+
+	// Group 3: transaction and storage
+	DUPLICATE_KEY = 3000 + iota
+	TXN_WW_CONFLICT
+	DEADLOCK
+	LOCK_WAIT_TIMEOUT
+	LOCK_NOWAIT
 )
 
 type Error struct {
 	Code    int32
 	Message string
+	// the error wrapped by Wrap, nil if the error is raised by moerr
+	cause error
 }
 
 func (e *Error) Ok() bool {
@@ -49,6 +60,10 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
 //
 // Most of the times should not call this.  Just use nil
 // func NewSUCCESS() *Error {
@@ -57,11 +72,11 @@ func (e *Error) Error() string {
 //
 
 func NewInfo(msg string) *Error {
-	return &Error{INFO, msg}
+	return &Error{Code: INFO, Message: msg}
 }
 
 func NewWarn(msg string) *Error {
-	return &Error{WARN, msg}
+	return &Error{Code: WARN, Message: msg}
 }
 
 func NewInternalError(msg string, args ...interface{}) *Error {
@@ -70,14 +85,44 @@ func NewInternalError(msg string, args ...interface{}) *Error {
 	return &err
 }
 
-// Convert a runtime panic to internal error.
+// Convert a runtime panic to internal error.  A panic with an error
+// is kept as the cause, so its code is still reported to the user.
 func NewPanicError(v interface{}) *Error {
 	if e, ok := v.(*Error); ok {
 		return e
 	}
-	return NewInternalError("panic %v: %s", v, debug.Stack())
+	err := NewInternalError("panic %v: %s", v, debug.Stack())
+	if cause, ok := v.(error); ok {
+		err.cause = cause
+	}
+	return err
 }
 
 func NewError(code int32, msg string) *Error {
-	return &Error{code, msg}
+	return &Error{Code: code, Message: msg}
+}
+
+// Wrap gives err a code.  The message of err is kept, and err can
+// still be matched by errors.Is and errors.As.
+func Wrap(code int32, err error) *Error {
+	return &Error{Code: code, Message: err.Error(), cause: err}
+}
+
+// Code returns the code of the first specific Error in the chain of
+// err, errors without one are internal errors.  An internal error
+// wrapping a coded error, e.g. a recovered panic, reports the wrapped
+// code.
+func Code(err error) int32 {
+	code := int32(INTERNAL_ERROR)
+	for err != nil {
+		var e *Error
+		if !errors.As(err, &e) {
+			break
+		}
+		if e.Code != INTERNAL_ERROR {
+			return e.Code
+		}
+		err = e.cause
+	}
+	return code
 }
@@ -15,6 +15,8 @@
 package moerr
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -67,3 +69,39 @@ func TestPanicError(t *testing.T) {
 		}
 	}
 }
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("value out of range")
+	err := Wrap(OUT_OF_RANGE, cause)
+	if !errors.Is(err, cause) {
+		t.Errorf("Wrapped error lost its cause")
+	}
+	if err.Error() != cause.Error() {
+		t.Errorf("Wrapped error changed the message")
+	}
+	if Code(fmt.Errorf("cast: %w", err)) != OUT_OF_RANGE {
+		t.Errorf("Wrong code of wrapped error")
+	}
+}
+
+func TestMySQLError(t *testing.T) {
+	kases := []struct {
+		err      error
+		errno    uint16
+		sqlState string
+	}{
+		{NewError(DIVIVISION_BY_ZERO, "division by zero"), 1365, "22012"},
+		{NewError(DUPLICATE_KEY, "duplicate"), 1062, "23000"},
+		{NewPanicError(NewError(TXN_WW_CONFLICT, "w-w conflict")), 1213, "40001"},
+		{NewPanicError(fmt.Errorf("read: %w", NewError(DEADLOCK, "deadlock"))), 1213, "40001"},
+		{NewInternalError("foo"), 1105, "HY000"},
+		{errors.New("foo"), 1105, "HY000"},
+		{NewInfo("foo"), 1105, "HY000"},
+	}
+	for _, kase := range kases {
+		errno, sqlState := MySQLError(kase.err)
+		if errno != kase.errno || sqlState != kase.sqlState {
+			t.Errorf("%v: got %d %s, expect %d %s", kase.err, errno, sqlState, kase.errno, kase.sqlState)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moerr
+
+// mysqlError is the mysql error number and SQLSTATE a code is sent to
+// the client with.  Drivers decide whether to retry by them.
+type mysqlError struct {
+	errno    uint16
+	sqlState string
+}
+
+var unknownMySQLError = mysqlError{1105, "HY000"}
+
+var mysqlErrors = map[int32]mysqlError{
+	INTERNAL_ERROR:           unknownMySQLError,
+	NYI:                      {1235, "42000"},
+	ERROR_FUNCTION_PARAMETER: {1210, "HY000"},
+	DIVIVISION_BY_ZERO:       {1365, "22012"},
+	OUT_OF_RANGE:             {1264, "22003"},
+	TRUNCATED_WRONG_VALUE:    {1292, "22007"},
+	DUPLICATE_KEY:            {1062, "23000"},
+	TXN_WW_CONFLICT:          {1213, "40001"},
+	DEADLOCK:                 {1213, "40001"},
+	LOCK_WAIT_TIMEOUT:        {1205, "HY000"},
+	LOCK_NOWAIT:              {3572, "HY000"},
+}
+
+// MySQLError returns the mysql error number and SQLSTATE of err.
+func MySQLError(err error) (uint16, string) {
+	me, ok := mysqlErrors[Code(err)]
+	if !ok {
+		me = unknownMySQLError
+	}
+	return me.errno, me.sqlState
+}
@@ -69,3 +69,19 @@ func TestCompare_Compare(t *testing.T) {
 	result = c.Compare(0, 1, 0, 0)
 	require.Equal(t, 0, result)
 }
+
+func TestCompare_CompareScales(t *testing.T) {
+	c := New()
+	c.vs[0] = vector.New(types.Type{Oid: types.T_decimal128, Scale: 2})
+	c.xs[0] = make([]types.Decimal128, 1)
+	c.xs[0][0], _ = types.ParseStringToDecimal128("-1.50", 38, 2)
+	c.vs[1] = vector.New(types.Type{Oid: types.T_decimal128, Scale: 4})
+	c.xs[1] = make([]types.Decimal128, 1)
+
+	// each value is compared in the scale of its own vector
+	c.xs[1][0], _ = types.ParseStringToDecimal128("-1.5000", 38, 4)
+	require.Equal(t, 0, c.Compare(1, 0, 0, 0))
+	c.xs[1][0], _ = types.ParseStringToDecimal128("-1.5001", 38, 4)
+	require.Equal(t, -1, c.Compare(1, 0, 0, 0))
+	require.Equal(t, -(-1), c.Compare(0, 1, 0, 0))
+}
@@ -28,6 +28,13 @@ func New() *compare {
 	}
 }
 
+func NewGeneralCI() *compare {
+	return &compare{
+		ci: true,
+		vs: make([]*vector.Vector, 2),
+	}
+}
+
 func (c *compare) Vector() *vector.Vector {
 	return c.vs[0]
 }
@@ -47,5 +54,8 @@ func (c *compare) Copy(vecSrc, vecDst int, src, dst int64, proc *process.Process
 
 func (c *compare) Compare(veci, vecj int, vi, vj int64) int {
 	x, y := c.vs[veci].Col.(*types.Bytes), c.vs[vecj].Col.(*types.Bytes)
+	if c.ci {
+		return types.CompareGeneralCI(x.Get(vi), y.Get(vj))
+	}
 	return bytes.Compare(x.Get(vi), y.Get(vj))
 }
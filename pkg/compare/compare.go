@@ -25,6 +25,7 @@ import (
 	aint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/int32s"
 	aint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/int64s"
 	aint8s "github.com/matrixorigin/matrixone/pkg/compare/asc/int8s"
+	atimes "github.com/matrixorigin/matrixone/pkg/compare/asc/times"
 	auint16s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint16s"
 	auint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint32s"
 	auint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint64s"
@@ -40,6 +41,7 @@ import (
 	dint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/int32s"
 	dint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/int64s"
 	dint8s "github.com/matrixorigin/matrixone/pkg/compare/desc/int8s"
+	dtimes "github.com/matrixorigin/matrixone/pkg/compare/desc/times"
 	duint16s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint16s"
 	duint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint32s"
 	duint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint64s"
@@ -48,6 +50,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
+// NewWithCollation is like New, but strings are compared under collation c.
+func NewWithCollation(typ types.T, c int32, desc bool) Compare {
+	if c == types.CollationGeneralCI && (typ == types.T_char || typ == types.T_varchar) {
+		if desc {
+			return dvarchar.NewGeneralCI()
+		}
+		return avarchar.NewGeneralCI()
+	}
+	return New(typ, desc)
+}
+
 func New(typ types.T, desc bool) Compare {
 	switch typ {
 	case types.T_int8:
@@ -85,7 +98,7 @@ func New(typ types.T, desc bool) Compare {
 			return duint32s.New()
 		}
 		return auint32s.New()
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if desc {
 			return duint64s.New()
 		}
@@ -115,6 +128,11 @@ func New(typ types.T, desc bool) Compare {
 			return ddatetimes.New()
 		}
 		return adatetimes.New()
+	case types.T_time:
+		if desc {
+			return dtimes.New()
+		}
+		return atimes.New()
 	case types.T_decimal64:
 		if desc {
 			return ddecimal64s.New()
@@ -55,3 +55,19 @@ func TestCompare_Compare(t *testing.T) {
 	result = c.Compare(0, 1, 0, 0)
 	require.Equal(t, 0, result)
 }
+
+func TestCompare_CompareScales(t *testing.T) {
+	c := New()
+	c.vs[0] = vector.New(types.Type{Oid: types.T_decimal64, Scale: 2})
+	c.xs[0] = make([]types.Decimal64, 1)
+	c.xs[0][0], _ = types.ParseStringToDecimal64("-1.50", 18, 2)
+	c.vs[1] = vector.New(types.Type{Oid: types.T_decimal64, Scale: 4})
+	c.xs[1] = make([]types.Decimal64, 1)
+
+	// each value is compared in the scale of its own vector
+	c.xs[1][0], _ = types.ParseStringToDecimal64("-1.5000", 18, 4)
+	require.Equal(t, 0, c.Compare(1, 0, 0, 0))
+	c.xs[1][0], _ = types.ParseStringToDecimal64("-1.5001", 18, 4)
+	require.Equal(t, 1, c.Compare(1, 0, 0, 0))
+	require.Equal(t, -(1), c.Compare(0, 1, 0, 0))
+}
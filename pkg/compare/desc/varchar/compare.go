@@ -28,6 +28,13 @@ func New() *compare {
 	}
 }
 
+func NewGeneralCI() *compare {
+	return &compare{
+		ci: true,
+		vs: make([]*vector.Vector, 2),
+	}
+}
+
 func (c *compare) Vector() *vector.Vector {
 	return c.vs[0]
 }
@@ -47,7 +54,12 @@ func (c *compare) Copy(vecSrc, vecDst int, src, dst int64, proc *process.Process
 
 func (c *compare) Compare(veci, vecj int, vi, vj int64) int {
 	x, y := c.vs[veci].Col.(*types.Bytes), c.vs[vecj].Col.(*types.Bytes)
-	r := bytes.Compare(x.Get(vi), y.Get(vj))
+	var r int
+	if c.ci {
+		r = types.CompareGeneralCI(x.Get(vi), y.Get(vj))
+	} else {
+		r = bytes.Compare(x.Get(vi), y.Get(vj))
+	}
 	switch r {
 	case +1:
 		r = -1
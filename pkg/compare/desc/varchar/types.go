@@ -19,5 +19,6 @@ import (
 )
 
 type compare struct {
+	ci bool // compare under utf8mb4_general_ci
 	vs []*vector.Vector
 }
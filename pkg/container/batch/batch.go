@@ -187,6 +187,19 @@ func NewWithSize(n int) *Batch {
 	}
 }
 
+// Window returns the rows [start, end) of bat as a new batch without copying,
+// its vectors are views of the vectors of bat, so bat is not modified and is
+// cleaned as usual. The aggregation states of bat are not supported.
+func Window(bat *Batch, start, end int) *Batch {
+	b := NewWithSize(len(bat.Vecs))
+	b.Attrs = bat.Attrs
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.NewView(vec, start, end)
+	}
+	b.Zs = append(make([]int64, 0, end-start), bat.Zs[start:end]...)
+	return b
+}
+
 func (bat *Batch) Shrink(sels []int64) {
 	mp := make(map[*vector.Vector]uint8)
 	for _, vec := range bat.Vecs {
@@ -277,23 +290,27 @@ func (bat *Batch) Append(mh *mheap.Mheap, b *Batch) (*Batch, error) {
 	if bat == nil {
 		return b, nil
 	}
-	if len(bat.Vecs) != len(b.Vecs) {
-		return nil, errors.New(errno.InternalError, "unexpected error happens in batch append")
-	}
 	if len(bat.Vecs) == 0 {
 		return bat, nil
 	}
-	flags := make([]uint8, vector.Length(b.Vecs[0]))
-	for i := range flags {
-		flags[i]++
+	if err := Append(bat, b, mh); err != nil {
+		return nil, err
+	}
+	return bat, nil
+}
+
+// Append appends all the rows of src to dst
+func Append(dst, src *Batch, m *mheap.Mheap) error {
+	if len(dst.Vecs) != len(src.Vecs) {
+		return errors.New(errno.InternalError, "unexpected error happens in batch append")
 	}
-	for i := range bat.Vecs {
-		if err := vector.UnionBatch(bat.Vecs[i], b.Vecs[i], 0, vector.Length(b.Vecs[i]), flags[:vector.Length(b.Vecs[i])], mh); err != nil {
-			return nil, err
+	for i := range dst.Vecs {
+		if err := vector.AppendVector(dst.Vecs[i], src.Vecs[i], m); err != nil {
+			return err
 		}
 	}
-	bat.Zs = append(bat.Zs, b.Zs...)
-	return bat, nil
+	dst.Zs = append(dst.Zs, src.Zs...)
+	return nil
 }
 
 // InitZsOne init Batch.Zs and values are all 1
@@ -0,0 +1,494 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+/*
+ * The format of an encoded batch, all the integers are little endian:
+ *
+ *   magic (4 bytes) || version (uint16)
+ *   attribute count (uint32) || for each attribute: length (uint32) || name
+ *   row count (uint32) || Zs (row count * int64)
+ *   column count (uint32) || for each column:
+ *     oid (uint8) || size || width || scale || precision (int32 each)
+ *     flags (uint8, bit 0 is IsConst) || const length (uint64)
+ *     value count (uint32)
+ *     null bitmap length (uint32) || null bitmap (roaring bytes)
+ *     fixed width types: value count * type length bytes
+ *     char, varchar and json: value count * lengths (uint32) ||
+ *                             data length (uint32) || data
+ *
+ * The offsets of the bytes are the prefix sums of the lengths, the data of
+ * the values is written packed whatever the offsets of the source vector.
+ * A new version is only needed if the layout changes, the decoder keeps
+ * reading all the versions it knows about.
+ */
+
+const (
+	// CodecVersion is the version of the format written by Encode
+	CodecVersion uint16 = 1
+
+	flagConst uint8 = 1 << 0
+)
+
+var codecMagic = [4]byte{'M', 'O', 'B', 'T'}
+
+// Encode returns the batch encoded in the format shipped between nodes,
+// the selection list, the aggregation states and the hash table of the
+// batch are not part of the format.
+func Encode(bat *Batch) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.Grow(EncodedSize(bat))
+	if err := EncodeTo(&buf, bat); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode decodes a batch encoded by Encode, the vectors of the batch are
+// allocated from the go heap, use Clone to move them into a mheap.
+func Decode(data []byte) (*Batch, error) {
+	r := bytes.NewReader(data)
+	bat, err := DecodeFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("%v bytes left after the batch", r.Len()))
+	}
+	return bat, nil
+}
+
+// EncodedSize returns the number of bytes of the encoded batch
+func EncodedSize(bat *Batch) int {
+	size := 4 + 2 + 4 + 4 + 8*len(bat.Zs) + 4
+	for _, attr := range bat.Attrs {
+		size += 4 + len(attr)
+	}
+	for _, vec := range bat.Vecs {
+		size += 1 + 4*4 + 1 + 8 + 4 + 4
+		if vec.Nsp != nil && vec.Nsp.Np != nil {
+			size += int(vec.Nsp.Np.GetSerializedSizeInBytes())
+		}
+		switch vec.Typ.Oid {
+		case types.T_any:
+		case types.T_char, types.T_varchar, types.T_json:
+			vs := vec.Col.(*types.Bytes)
+			size += 4*len(vs.Lengths) + 4
+			for _, n := range vs.Lengths {
+				size += int(n)
+			}
+		default:
+			if data, err := fixedData(vec); err == nil {
+				size += len(data)
+			}
+		}
+	}
+	return size
+}
+
+// EncodeTo writes the batch to w column by column, so the batch is never
+// copied as a whole, see Encode for the format.
+func EncodeTo(w io.Writer, bat *Batch) error {
+	e := &encoder{w: w}
+	e.write(codecMagic[:])
+	e.uint16(CodecVersion)
+	e.uint32(uint32(len(bat.Attrs)))
+	for _, attr := range bat.Attrs {
+		e.uint32(uint32(len(attr)))
+		e.write([]byte(attr))
+	}
+	e.uint32(uint32(len(bat.Zs)))
+	e.write(encoding.EncodeInt64Slice(bat.Zs))
+	e.uint32(uint32(len(bat.Vecs)))
+	for _, vec := range bat.Vecs {
+		if err := e.vector(vec); err != nil {
+			return err
+		}
+	}
+	return e.err
+}
+
+// DecodeFrom reads a batch written by EncodeTo from r, column by column.
+func DecodeFrom(r io.Reader) (*Batch, error) {
+	d := &decoder{r: r}
+	var magic [4]byte
+	d.read(magic[:])
+	if d.err != nil {
+		return nil, d.err
+	}
+	if magic != codecMagic {
+		return nil, errors.New(errno.DataException, "not an encoded batch")
+	}
+	if version := d.uint16(); d.err == nil && version > CodecVersion {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("unsupported batch version %v", version))
+	}
+	bat := NewWithSize(0)
+	if n := d.uint32(); n > 0 && d.err == nil {
+		bat.Attrs = make([]string, n)
+		for i := range bat.Attrs {
+			bat.Attrs[i] = string(d.bytes(d.uint32()))
+		}
+	}
+	if n := d.uint32(); d.err == nil {
+		bat.Zs = make([]int64, n)
+		d.read(encoding.EncodeInt64Slice(bat.Zs))
+	}
+	if n := d.uint32(); d.err == nil {
+		bat.Vecs = make([]*vector.Vector, n)
+		for i := range bat.Vecs {
+			if bat.Vecs[i] = d.vector(); d.err != nil {
+				break
+			}
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return bat, nil
+}
+
+// Clone returns a deep copy of the batch, the data of the vectors is
+// allocated from m and the null bitmaps are copied, so the copy can be
+// cleaned independently of bat. The aggregation states are not cloned.
+func Clone(bat *Batch, m *mheap.Mheap) (*Batch, error) {
+	b := NewWithSize(len(bat.Vecs))
+	b.Attrs = append([]string{}, bat.Attrs...)
+	b.Zs = append([]int64{}, bat.Zs...)
+	for i, vec := range bat.Vecs {
+		if vec.Typ.Oid == types.T_any {
+			b.Vecs[i] = &vector.Vector{Typ: vec.Typ, Nsp: &nulls.Nulls{}}
+		} else {
+			v, err := vector.Dup(vec, m)
+			if err != nil {
+				b.Clean(m)
+				return nil, err
+			}
+			b.Vecs[i] = v
+		}
+		b.Vecs[i].Nsp = &nulls.Nulls{}
+		if vec.Nsp != nil && vec.Nsp.Np != nil {
+			b.Vecs[i].Nsp.Np = vec.Nsp.Np.Clone()
+		}
+		b.Vecs[i].IsConst = vec.IsConst
+		b.Vecs[i].Length = vec.Length
+	}
+	return b, nil
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+	buf [8]byte
+}
+
+func (e *encoder) write(data []byte) {
+	if e.err == nil && len(data) > 0 {
+		_, e.err = e.w.Write(data)
+	}
+}
+
+func (e *encoder) uint8(v uint8) {
+	e.buf[0] = v
+	e.write(e.buf[:1])
+}
+
+func (e *encoder) uint16(v uint16) {
+	binary.LittleEndian.PutUint16(e.buf[:], v)
+	e.write(e.buf[:2])
+}
+
+func (e *encoder) uint32(v uint32) {
+	binary.LittleEndian.PutUint32(e.buf[:], v)
+	e.write(e.buf[:4])
+}
+
+func (e *encoder) uint64(v uint64) {
+	binary.LittleEndian.PutUint64(e.buf[:], v)
+	e.write(e.buf[:8])
+}
+
+func (e *encoder) vector(vec *vector.Vector) error {
+	typ := vec.Typ
+	e.uint8(uint8(typ.Oid))
+	e.uint32(uint32(typ.Size))
+	e.uint32(uint32(typ.Width))
+	e.uint32(uint32(typ.Scale))
+	e.uint32(uint32(typ.Precision))
+	var flags uint8
+	if vec.IsConst {
+		flags |= flagConst
+	}
+	e.uint8(flags)
+	e.uint64(uint64(vec.Length))
+	var np []byte
+	if vec.Nsp != nil {
+		data, err := vec.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		np = data
+	}
+	// the number of values, a const vector has only one
+	switch typ.Oid {
+	case types.T_any:
+		e.uint32(0)
+	case types.T_char, types.T_varchar, types.T_json:
+		e.uint32(uint32(len(vec.Col.(*types.Bytes).Offsets)))
+	default:
+		data, err := fixedData(vec)
+		if err != nil {
+			return err
+		}
+		e.uint32(uint32(len(data) / int(typ.Oid.TypeLen())))
+	}
+	e.uint32(uint32(len(np)))
+	e.write(np)
+	switch typ.Oid {
+	case types.T_any:
+	case types.T_char, types.T_varchar, types.T_json:
+		vs := vec.Col.(*types.Bytes)
+		e.write(encoding.EncodeUint32Slice(vs.Lengths))
+		size := 0
+		for _, l := range vs.Lengths {
+			size += int(l)
+		}
+		e.uint32(uint32(size))
+		for i := range vs.Offsets {
+			e.write(vs.Get(int64(i)))
+		}
+	default:
+		data, _ := fixedData(vec)
+		e.write(data)
+	}
+	return nil
+}
+
+type decoder struct {
+	r   io.Reader
+	err error
+	buf [8]byte
+}
+
+func (d *decoder) read(data []byte) {
+	if d.err == nil && len(data) > 0 {
+		if _, d.err = io.ReadFull(d.r, data); d.err == io.EOF {
+			d.err = io.ErrUnexpectedEOF
+		}
+	}
+}
+
+func (d *decoder) bytes(n uint32) []byte {
+	if d.err != nil {
+		return nil
+	}
+	data := make([]byte, n)
+	d.read(data)
+	return data
+}
+
+func (d *decoder) uint8() uint8 {
+	d.read(d.buf[:1])
+	return d.buf[0]
+}
+
+func (d *decoder) uint16() uint16 {
+	d.read(d.buf[:2])
+	return binary.LittleEndian.Uint16(d.buf[:])
+}
+
+func (d *decoder) uint32() uint32 {
+	d.read(d.buf[:4])
+	return binary.LittleEndian.Uint32(d.buf[:])
+}
+
+func (d *decoder) uint64() uint64 {
+	d.read(d.buf[:8])
+	return binary.LittleEndian.Uint64(d.buf[:])
+}
+
+func (d *decoder) vector() *vector.Vector {
+	var typ types.Type
+
+	typ.Oid = types.T(d.uint8())
+	typ.Size = int32(d.uint32())
+	typ.Width = int32(d.uint32())
+	typ.Scale = int32(d.uint32())
+	typ.Precision = int32(d.uint32())
+	flags := d.uint8()
+	length := int(d.uint64())
+	n := d.uint32()
+	np := d.bytes(d.uint32())
+	if d.err != nil {
+		return nil
+	}
+	vec := &vector.Vector{
+		Typ:     typ,
+		Nsp:     &nulls.Nulls{},
+		IsConst: flags&flagConst != 0,
+		Length:  length,
+	}
+	if err := vec.Nsp.Read(np); err != nil {
+		d.err = err
+		return nil
+	}
+	switch typ.Oid {
+	case types.T_any:
+	case types.T_char, types.T_varchar, types.T_json:
+		vs := &types.Bytes{
+			Offsets: make([]uint32, n),
+			Lengths: make([]uint32, n),
+		}
+		d.read(encoding.EncodeUint32Slice(vs.Lengths))
+		vs.Data = d.bytes(d.uint32())
+		o := uint32(0)
+		for i, l := range vs.Lengths {
+			vs.Offsets[i] = o
+			o += l
+		}
+		if d.err == nil && int(o) != len(vs.Data) {
+			d.err = errors.New(errno.DataException, "lengths of the bytes mismatch the data")
+		}
+		vec.Col = vs
+	default:
+		sz, ok := fixedLength(typ.Oid)
+		if !ok {
+			d.err = errors.New(errno.DataException, fmt.Sprintf("unsupported type %s of the batch", typ))
+			return nil
+		}
+		data := d.bytes(n * uint32(sz))
+		if d.err != nil {
+			return nil
+		}
+		if d.err = setFixedData(vec, data); d.err != nil {
+			return nil
+		}
+	}
+	return vec
+}
+
+func fixedLength(oid types.T) (int, bool) {
+	switch oid {
+	case types.T_bool, types.T_int8, types.T_uint8:
+		return 1, true
+	case types.T_int16, types.T_uint16:
+		return 2, true
+	case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
+		return 4, true
+	case types.T_int64, types.T_uint64, types.T_bit, types.T_float64,
+		types.T_datetime, types.T_timestamp, types.T_time, types.T_decimal64:
+		return 8, true
+	case types.T_decimal128:
+		return 16, true
+	}
+	return 0, false
+}
+
+func fixedData(vec *vector.Vector) ([]byte, error) {
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		return encoding.EncodeFixedSlice(vec.Col.([]bool), 1), nil
+	case types.T_int8:
+		return encoding.EncodeFixedSlice(vec.Col.([]int8), 1), nil
+	case types.T_int16:
+		return encoding.EncodeFixedSlice(vec.Col.([]int16), 2), nil
+	case types.T_int32:
+		return encoding.EncodeFixedSlice(vec.Col.([]int32), 4), nil
+	case types.T_int64:
+		return encoding.EncodeFixedSlice(vec.Col.([]int64), 8), nil
+	case types.T_uint8:
+		return encoding.EncodeFixedSlice(vec.Col.([]uint8), 1), nil
+	case types.T_uint16:
+		return encoding.EncodeFixedSlice(vec.Col.([]uint16), 2), nil
+	case types.T_uint32:
+		return encoding.EncodeFixedSlice(vec.Col.([]uint32), 4), nil
+	case types.T_uint64, types.T_bit:
+		return encoding.EncodeFixedSlice(vec.Col.([]uint64), 8), nil
+	case types.T_float32:
+		return encoding.EncodeFixedSlice(vec.Col.([]float32), 4), nil
+	case types.T_float64:
+		return encoding.EncodeFixedSlice(vec.Col.([]float64), 8), nil
+	case types.T_date:
+		return encoding.EncodeFixedSlice(vec.Col.([]types.Date), 4), nil
+	case types.T_datetime:
+		return encoding.EncodeFixedSlice(vec.Col.([]types.Datetime), 8), nil
+	case types.T_timestamp:
+		return encoding.EncodeFixedSlice(vec.Col.([]types.Timestamp), 8), nil
+	case types.T_time:
+		return encoding.EncodeFixedSlice(vec.Col.([]types.Time), 8), nil
+	case types.T_decimal64:
+		return encoding.EncodeFixedSlice(vec.Col.([]types.Decimal64), 8), nil
+	case types.T_decimal128:
+		return encoding.EncodeFixedSlice(vec.Col.([]types.Decimal128), 16), nil
+	}
+	return nil, errors.New(errno.InternalError, fmt.Sprintf("unsupported type %s in batch encoding", vec.Typ))
+}
+
+func setFixedData(vec *vector.Vector, data []byte) error {
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		vec.Col = encoding.DecodeFixedSlice[bool](data, 1)
+	case types.T_int8:
+		vec.Col = encoding.DecodeFixedSlice[int8](data, 1)
+	case types.T_int16:
+		vec.Col = encoding.DecodeFixedSlice[int16](data, 2)
+	case types.T_int32:
+		vec.Col = encoding.DecodeFixedSlice[int32](data, 4)
+	case types.T_int64:
+		vec.Col = encoding.DecodeFixedSlice[int64](data, 8)
+	case types.T_uint8:
+		vec.Col = encoding.DecodeFixedSlice[uint8](data, 1)
+	case types.T_uint16:
+		vec.Col = encoding.DecodeFixedSlice[uint16](data, 2)
+	case types.T_uint32:
+		vec.Col = encoding.DecodeFixedSlice[uint32](data, 4)
+	case types.T_uint64, types.T_bit:
+		vec.Col = encoding.DecodeFixedSlice[uint64](data, 8)
+	case types.T_float32:
+		vec.Col = encoding.DecodeFixedSlice[float32](data, 4)
+	case types.T_float64:
+		vec.Col = encoding.DecodeFixedSlice[float64](data, 8)
+	case types.T_date:
+		vec.Col = encoding.DecodeFixedSlice[types.Date](data, 4)
+	case types.T_datetime:
+		vec.Col = encoding.DecodeFixedSlice[types.Datetime](data, 8)
+	case types.T_timestamp:
+		vec.Col = encoding.DecodeFixedSlice[types.Timestamp](data, 8)
+	case types.T_time:
+		vec.Col = encoding.DecodeFixedSlice[types.Time](data, 8)
+	case types.T_decimal64:
+		vec.Col = encoding.DecodeFixedSlice[types.Decimal64](data, 8)
+	case types.T_decimal128:
+		vec.Col = encoding.DecodeFixedSlice[types.Decimal128](data, 16)
+	default:
+		return errors.New(errno.DataException, fmt.Sprintf("unsupported type %s of the batch", vec.Typ))
+	}
+	return nil
+}
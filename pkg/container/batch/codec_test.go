@@ -0,0 +1,269 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var codecTypes = []types.Type{
+	{Oid: types.T_bool, Size: 1},
+	{Oid: types.T_int8, Size: 1},
+	{Oid: types.T_int16, Size: 2},
+	{Oid: types.T_int32, Size: 4},
+	{Oid: types.T_int64, Size: 8},
+	{Oid: types.T_uint8, Size: 1},
+	{Oid: types.T_uint16, Size: 2},
+	{Oid: types.T_uint32, Size: 4},
+	{Oid: types.T_uint64, Size: 8},
+	{Oid: types.T_float32, Size: 4, Width: 12, Precision: 3},
+	{Oid: types.T_float64, Size: 8, Width: 20, Precision: 5},
+	{Oid: types.T_date, Size: 4},
+	{Oid: types.T_datetime, Size: 8, Precision: 6},
+	{Oid: types.T_timestamp, Size: 8, Precision: 3},
+	{Oid: types.T_time, Size: 8},
+	{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2},
+	{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 9},
+	{Oid: types.T_char, Size: 24, Width: 10},
+	{Oid: types.T_varchar, Size: 24, Width: 100},
+	{Oid: types.T_json, Size: 24},
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		bat := newRandomBatch(r, r.Intn(200))
+
+		data, err := Encode(bat)
+		require.NoError(t, err)
+		require.Equal(t, len(data), EncodedSize(bat))
+		b, err := Decode(data)
+		require.NoError(t, err)
+		requireBatchEqual(t, bat, b)
+
+		var buf bytes.Buffer
+		require.NoError(t, EncodeTo(&buf, bat))
+		require.Equal(t, data, buf.Bytes())
+		b, err = DecodeFrom(&buf)
+		require.NoError(t, err)
+		requireBatchEqual(t, bat, b)
+		require.Equal(t, 0, buf.Len())
+	}
+}
+
+func TestCodecCorrupted(t *testing.T) {
+	data, err := Encode(newRandomBatch(rand.New(rand.NewSource(0)), 10))
+	require.NoError(t, err)
+	_, err = Decode(data[:len(data)-1])
+	require.Error(t, err)
+	_, err = Decode(append(data, 0))
+	require.Error(t, err)
+	_, err = Decode([]byte("not a batch"))
+	require.Error(t, err)
+	future := append([]byte{}, data...)
+	future[4] = byte(CodecVersion + 1)
+	_, err = Decode(future)
+	require.Error(t, err)
+}
+
+func TestClone(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	bat := newRandomBatch(rand.New(rand.NewSource(0)), 100)
+	b, err := Clone(bat, m)
+	require.NoError(t, err)
+	requireBatchEqual(t, bat, b)
+	require.NotEqual(t, int64(0), mheap.Size(m))
+
+	// the copy does not share the nulls nor the data of bat
+	for i, vec := range b.Vecs {
+		nulls.Add(vec.Nsp, 1000)
+		require.False(t, nulls.Contains(bat.Vecs[i].Nsp, 1000))
+	}
+	vs := b.Vecs[4].Col.([]int64)
+	vs[0]++
+	require.NotEqual(t, vs[0], bat.Vecs[4].Col.([]int64)[0])
+
+	b.Clean(m)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+// TestCodecGolden decodes the batches encoded by every version of the
+// format, run with -update to write the golden file of the current version.
+func TestCodecGolden(t *testing.T) {
+	name := filepath.Join("testdata", fmt.Sprintf("batch_v%d.golden", CodecVersion))
+	if *update {
+		data, err := Encode(newGoldenBatch())
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(name, data, 0644))
+	}
+	for version := uint16(1); version <= CodecVersion; version++ {
+		data, err := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("batch_v%d.golden", version)))
+		require.NoError(t, err)
+		bat, err := Decode(data)
+		require.NoError(t, err)
+		requireBatchEqual(t, newGoldenBatch(), bat)
+	}
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	encoded, err := Encode(newGoldenBatch())
+	require.NoError(t, err)
+	require.Equal(t, data, encoded)
+}
+
+// newGoldenBatch returns a batch with a column of every type, some nulls
+// and const columns, it must never change once its golden file is stored
+func newGoldenBatch() *Batch {
+	const rows = 4
+	bat := NewWithSize(0)
+	for i, typ := range codecTypes {
+		vec := vector.New(typ)
+		switch typ.Oid {
+		case types.T_char, types.T_varchar, types.T_json:
+			vs := vec.Col.(*types.Bytes)
+			for j := 0; j < rows; j++ {
+				vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+				vs.Data = append(vs.Data, fmt.Sprintf("%s-%d", typ, j)...)
+				vs.Lengths = append(vs.Lengths, uint32(len(vs.Data))-vs.Offsets[j])
+			}
+		default:
+			data := make([]byte, rows*typ.Oid.TypeLen())
+			for j := range data {
+				data[j] = byte(i + j)
+			}
+			if typ.Oid == types.T_bool {
+				for j := range data {
+					data[j] &= 1
+				}
+			}
+			if err := setFixedData(vec, data); err != nil {
+				panic(err)
+			}
+		}
+		nulls.Add(vec.Nsp, uint64(i%rows))
+		bat.Vecs = append(bat.Vecs, vec)
+		bat.Attrs = append(bat.Attrs, fmt.Sprintf("c%d", i))
+	}
+	cv := vector.NewConst(types.Type{Oid: types.T_int64, Size: 8})
+	cv.Col = []int64{42}
+	cv.Length = rows
+	nv := vector.NewConst(types.Type{Oid: types.T_any})
+	nv.Length = rows
+	nulls.Add(nv.Nsp, 0)
+	bat.Vecs = append(bat.Vecs, cv, nv)
+	bat.Attrs = append(bat.Attrs, "const", "null")
+	bat.Zs = []int64{1, 2, 1, 3}
+	return bat
+}
+
+func newRandomBatch(r *rand.Rand, rows int) *Batch {
+	bat := NewWithSize(0)
+	for i, typ := range codecTypes {
+		vec := vector.New(typ)
+		n := rows
+		if r.Intn(5) == 0 {
+			vec.IsConst = true
+			vec.Length = rows
+			n = 1
+		}
+		switch typ.Oid {
+		case types.T_char, types.T_varchar, types.T_json:
+			vs := vec.Col.(*types.Bytes)
+			for j := 0; j < n; j++ {
+				v := make([]byte, r.Intn(20))
+				r.Read(v)
+				vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+				vs.Lengths = append(vs.Lengths, uint32(len(v)))
+				vs.Data = append(vs.Data, v...)
+			}
+		default:
+			data := make([]byte, n*typ.Oid.TypeLen())
+			r.Read(data)
+			if typ.Oid == types.T_bool {
+				for j := range data {
+					data[j] &= 1
+				}
+			}
+			if err := setFixedData(vec, data); err != nil {
+				panic(err)
+			}
+		}
+		for j := 0; j < n; j++ {
+			if r.Intn(4) == 0 {
+				nulls.Add(vec.Nsp, uint64(j))
+			}
+		}
+		bat.Vecs = append(bat.Vecs, vec)
+		bat.Attrs = append(bat.Attrs, fmt.Sprintf("c%d", i))
+	}
+	bat.Zs = make([]int64, rows)
+	for i := range bat.Zs {
+		bat.Zs[i] = r.Int63n(3) + 1
+	}
+	return bat
+}
+
+func requireBatchEqual(t *testing.T, expected, actual *Batch) {
+	require.Equal(t, expected.Attrs, actual.Attrs)
+	require.Equal(t, len(expected.Zs), len(actual.Zs))
+	if len(expected.Zs) > 0 {
+		require.Equal(t, expected.Zs, actual.Zs)
+	}
+	require.Equal(t, len(expected.Vecs), len(actual.Vecs))
+	for i, vec := range expected.Vecs {
+		v := actual.Vecs[i]
+		require.Equal(t, vec.Typ, v.Typ, expected.Attrs[i])
+		require.Equal(t, vec.IsConst, v.IsConst, expected.Attrs[i])
+		require.Equal(t, vec.Length, v.Length, expected.Attrs[i])
+		require.Equal(t, nullRows(vec.Nsp), nullRows(v.Nsp), expected.Attrs[i])
+		switch vec.Typ.Oid {
+		case types.T_any:
+		case types.T_char, types.T_varchar, types.T_json:
+			vs, ws := vec.Col.(*types.Bytes), v.Col.(*types.Bytes)
+			require.Equal(t, len(vs.Offsets), len(ws.Offsets), expected.Attrs[i])
+			for j := range vs.Offsets {
+				require.True(t, bytes.Equal(vs.Get(int64(j)), ws.Get(int64(j))), expected.Attrs[i])
+			}
+		default:
+			vs, err := fixedData(vec)
+			require.NoError(t, err)
+			ws, err := fixedData(v)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(vs, ws), expected.Attrs[i])
+		}
+	}
+}
+
+func nullRows(n *nulls.Nulls) []uint64 {
+	if n == nil || n.Np == nil || n.Np.IsEmpty() {
+		return nil
+	}
+	return n.Np.ToArray()
+}
@@ -23,9 +23,9 @@ const (
 	kLoadFactorNumerator   = 1
 	kLoadFactorDenominator = 2
 
-	//kTwoLevelBucketCntBits = 8
-	//kTwoLevelBucketCnt     = 1 << kTwoLevelBucketCntBits
-	//kMaxTwoLevelBucketCnt  = kTwoLevelBucketCnt - 1
+	kTwoLevelBucketCntBits = 8
+	kTwoLevelBucketCnt     = 1 << kTwoLevelBucketCntBits
+	kMaxTwoLevelBucketCnt  = kTwoLevelBucketCnt - 1
 )
 
 type Aggregator interface {
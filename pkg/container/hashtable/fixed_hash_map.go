@@ -0,0 +1,236 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashtable
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+const (
+	// kInitialBucketCellCnt is the cell count of a bucket of a new
+	// FixedHashMap, the buckets add up to kInitialCellCnt cells.
+	kInitialBucketCellCnt = kInitialCellCnt / kTwoLevelBucketCnt
+
+	// kMigrateCellCnt is the number of old cells moved into the new cells
+	// of a bucket by each insert while the bucket is resizing.
+	kMigrateCellCnt = 16
+
+	// kBucketShift is the shift of the bits of the 32 bits hash picking the
+	// bucket, the cells of a bucket are picked by the low bits.
+	kBucketShift = 32 - kTwoLevelBucketCntBits
+)
+
+// FixedKey is the key of a FixedHashMap, the fixed width keys of the group
+// operator padded to 8 or 16 bytes.
+type FixedKey interface {
+	uint64 | [2]uint64
+}
+
+type FixedHashMapCell[K FixedKey] struct {
+	Key    K
+	Mapped uint64
+}
+
+// FixedHashMap is a two-level hash map of fixed width keys. The keys are
+// inlined in the cells, so no memory is allocated per key.
+//
+// The first level picks one of kTwoLevelBucketCnt buckets by the high bits
+// of the 32 bits hash, the second level is an open addressing table per bucket.
+// A bucket is resized on its own and incrementally, the cells of the old
+// table are moved into the new one a few at a time by the following
+// inserts into the bucket, so there is no pause to rehash the whole map.
+//
+// The mapped values are numbered from 1 in the order of insertion, like
+// the other maps of this package.
+type FixedHashMap[K FixedKey] struct {
+	elemCnt uint64
+	buckets []fixedBucket[K]
+	// the buffers of the cells being migrated
+	migrateKeys   [kMigrateCellCnt]K
+	migrateValues [kMigrateCellCnt]uint64
+	migrateHashes [kMigrateCellCnt]uint64
+}
+
+type fixedBucket[K FixedKey] struct {
+	elemCnt    uint64
+	maxElemCnt uint64
+	cells      []FixedHashMapCell[K]
+	// oldCells are the cells before the resize in progress, the cells
+	// before oldPos have been moved into cells already.
+	oldCells []FixedHashMapCell[K]
+	oldPos   int
+}
+
+func (ht *FixedHashMap[K]) Init() {
+	ht.elemCnt = 0
+	ht.buckets = make([]fixedBucket[K], kTwoLevelBucketCnt)
+	for i := range ht.buckets {
+		b := &ht.buckets[i]
+		b.maxElemCnt = kInitialBucketCellCnt * kLoadFactorNumerator / kLoadFactorDenominator
+		b.cells = make([]FixedHashMapCell[K], kInitialBucketCellCnt)
+	}
+}
+
+// InsertBatch inserts the keys and returns their mapped values in values,
+// hashes is the buffer of the hashes of the keys.
+func (ht *FixedHashMap[K]) InsertBatch(hashes []uint64, keys []K, values []uint64) {
+	hashFixedKeys(hashes, keys)
+	for i := range keys {
+		b := &ht.buckets[hashes[i]>>kBucketShift&kMaxTwoLevelBucketCnt]
+		if b.oldCells == nil {
+			if cell := findFixedCell(b.cells, hashes[i], keys[i]); cell.Mapped != 0 {
+				values[i] = cell.Mapped
+				continue
+			}
+		}
+		values[i] = ht.insert(hashes[i], keys[i])
+	}
+}
+
+// InsertBatchWithRing is InsertBatch which skips the keys whose z value is 0.
+func (ht *FixedHashMap[K]) InsertBatchWithRing(zValues []int64, hashes []uint64, keys []K, values []uint64) {
+	hashFixedKeys(hashes, keys)
+	for i := range keys {
+		if zValues[i] == 0 {
+			continue
+		}
+		values[i] = ht.insert(hashes[i], keys[i])
+	}
+}
+
+// FindBatch returns the mapped values of the keys in values, 0 for the
+// missing keys.
+func (ht *FixedHashMap[K]) FindBatch(hashes []uint64, keys []K, values []uint64) {
+	hashFixedKeys(hashes, keys)
+	for i := range keys {
+		values[i] = ht.find(hashes[i], keys[i])
+	}
+}
+
+func (ht *FixedHashMap[K]) insert(hash uint64, key K) uint64 {
+	b := &ht.buckets[hash>>kBucketShift&kMaxTwoLevelBucketCnt]
+	if b.elemCnt >= b.maxElemCnt {
+		if b.oldCells != nil {
+			ht.migrate(b, len(b.oldCells))
+		}
+		b.grow()
+	}
+	if b.oldCells != nil {
+		ht.migrate(b, kMigrateCellCnt)
+	}
+	cell := findFixedCell(b.cells, hash, key)
+	if cell.Mapped != 0 {
+		return cell.Mapped
+	}
+	if b.oldCells != nil {
+		if oldCell := findFixedCell(b.oldCells, hash, key); oldCell.Mapped != 0 {
+			return oldCell.Mapped
+		}
+	}
+	ht.elemCnt++
+	b.elemCnt++
+	cell.Key = key
+	cell.Mapped = ht.elemCnt
+	return cell.Mapped
+}
+
+func (ht *FixedHashMap[K]) find(hash uint64, key K) uint64 {
+	b := &ht.buckets[hash>>kBucketShift&kMaxTwoLevelBucketCnt]
+	if cell := findFixedCell(b.cells, hash, key); cell.Mapped != 0 {
+		return cell.Mapped
+	}
+	if b.oldCells != nil {
+		return findFixedCell(b.oldCells, hash, key).Mapped
+	}
+	return 0
+}
+
+func (ht *FixedHashMap[K]) Cardinality() uint64 {
+	return ht.elemCnt
+}
+
+// grow starts a resize of the bucket.
+func (b *fixedBucket[K]) grow() {
+	cellCnt := uint64(len(b.cells)) * 4
+	b.maxElemCnt = cellCnt * kLoadFactorNumerator / kLoadFactorDenominator
+	b.oldCells = b.cells
+	b.oldPos = 0
+	b.cells = make([]FixedHashMapCell[K], cellCnt)
+}
+
+// migrate moves at most n old cells of b into its new cells.
+// The old cells are left as they are, the keys not moved yet are still
+// found in them by their probe sequences.
+func (ht *FixedHashMap[K]) migrate(b *fixedBucket[K], n int) {
+	keys, mapped, hashes := &ht.migrateKeys, &ht.migrateValues, &ht.migrateHashes
+	end := b.oldPos + n
+	if end > len(b.oldCells) {
+		end = len(b.oldCells)
+	}
+	for b.oldPos < end {
+		cnt := 0
+		for ; b.oldPos < end && cnt < kMigrateCellCnt; b.oldPos++ {
+			if cell := &b.oldCells[b.oldPos]; cell.Mapped != 0 {
+				keys[cnt] = cell.Key
+				mapped[cnt] = cell.Mapped
+				cnt++
+			}
+		}
+		if cnt == 0 {
+			continue
+		}
+		hashFixedKeys(hashes[:cnt], keys[:cnt])
+		for i := 0; i < cnt; i++ {
+			cell := findFixedCell(b.cells, hashes[i], keys[i])
+			cell.Key = keys[i]
+			cell.Mapped = mapped[i]
+		}
+	}
+	if b.oldPos == len(b.oldCells) {
+		b.oldCells = nil
+	}
+}
+
+// findFixedCell returns the cell of key, or the empty cell where it is
+// inserted if key is not in cells.
+func findFixedCell[K FixedKey](cells []FixedHashMapCell[K], hash uint64, key K) *FixedHashMapCell[K] {
+	mask := uint64(len(cells) - 1)
+	for idx := hash & mask; true; idx = (idx + 1) & mask {
+		cell := &cells[idx]
+		if cell.Mapped == 0 || cell.Key == key {
+			return cell
+		}
+	}
+	return nil
+}
+
+// hashFixedKeys computes the crc32 hashes of keys like Int64HashMap does,
+// the 16 bytes keys are folded into 8 bytes first. The hashes of the dense
+// integer keys rarely collide since crc32 is linear.
+func hashFixedKeys[K FixedKey](hashes []uint64, keys []K) {
+	if len(keys) == 0 {
+		return
+	}
+	if unsafe.Sizeof(keys[0]) == 8 {
+		copy(hashes, unsafe.Slice((*uint64)(unsafe.Pointer(&keys[0])), len(keys)))
+	} else {
+		ks := unsafe.Slice((*[2]uint64)(unsafe.Pointer(&keys[0])), len(keys))
+		for i := range ks {
+			hashes[i] = ks[i][0] ^ bits.RotateLeft64(ks[i][1]*0x9e3779b97f4a7c15, 32)
+		}
+	}
+	Crc32Int64BatchHash(unsafe.Pointer(&hashes[0]), &hashes[0], len(keys))
+}
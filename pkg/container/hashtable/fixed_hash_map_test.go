@@ -0,0 +1,248 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashtable
+
+import (
+	"math/rand"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	unitLimit     = 256               // keys inserted per batch, like the group operator
+	benchmarkRows = 39062 * unitLimit // about 10M rows grouped per benchmark iteration
+)
+
+// genKeys returns n keys of the group operator for the columns of width
+// bytes, with groups distinct values. The keys are laid out like the group
+// operator does, a null flag byte followed by the value of each column.
+func genKeys(n, groups int, widths ...int) [][2]uint64 {
+	keys := make([][2]uint64, n)
+	for i := range keys {
+		v := uint64(rand.Intn(groups))
+		buf := (*[16]byte)(unsafe.Pointer(&keys[i]))
+		off := 0
+		for _, width := range widths {
+			off++ // the null flag
+			for j := 0; j < width; j++ {
+				buf[off+j] = byte(v >> (8 * j))
+			}
+			v = v*31 + 7
+			off += width
+		}
+	}
+	return keys
+}
+
+func TestFixedHashMap(t *testing.T) {
+	for name, gen := range map[string]func() [][2]uint64{
+		"int32":         func() [][2]uint64 { return genKeys(200000, 1<<30, 4) },
+		"int64":         func() [][2]uint64 { return genKeys(200000, 1<<30, 8) },
+		"int32, int32":  func() [][2]uint64 { return genKeys(200000, 1<<30, 4, 4) },
+		"duplicates":    func() [][2]uint64 { return genKeys(200000, 10, 8) },
+		"many groups":   func() [][2]uint64 { return genKeys(200000, 150000, 4, 4) },
+		"single group":  func() [][2]uint64 { return genKeys(10000, 1, 8) },
+		"int16, int32":  func() [][2]uint64 { return genKeys(200000, 50000, 2, 4) },
+		"int64, int32":  func() [][2]uint64 { return genKeys(200000, 50000, 8, 4) },
+		"int64, int16s": func() [][2]uint64 { return genKeys(200000, 1000, 8, 2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			keys := gen()
+			expected := insertString24(keys)
+
+			ht := &FixedHashMap[[2]uint64]{}
+			ht.Init()
+			values := insertFixed(ht, keys)
+			require.Equal(t, expected, values)
+			require.Equal(t, maxValue(expected), ht.Cardinality())
+
+			// all the keys are found after the resizes
+			found := make([]uint64, len(keys))
+			hashes := make([]uint64, len(keys))
+			ht.FindBatch(hashes, keys, found)
+			require.Equal(t, expected, found)
+			ht.FindBatch(hashes, [][2]uint64{{1 << 63, 1 << 63}}, found[:1])
+			require.Equal(t, uint64(0), found[0])
+		})
+	}
+}
+
+func TestFixedHashMap64(t *testing.T) {
+	for name, groups := range map[string]int{
+		"random":     1 << 30,
+		"duplicates": 100,
+	} {
+		t.Run(name, func(t *testing.T) {
+			keys := make([]uint64, 300000)
+			for i, key := range genKeys(len(keys), groups, 4) {
+				keys[i] = key[0]
+			}
+			expected := make([]uint64, len(keys))
+			hashes := make([]uint64, unitLimit)
+			old := &Int64HashMap{}
+			old.Init()
+			for i := 0; i < len(keys); i += unitLimit {
+				n := min(len(keys)-i, unitLimit)
+				hashes[0] = 0
+				old.InsertBatch(n, hashes, unsafe.Pointer(&keys[i]), expected[i:i+n])
+			}
+
+			ht := &FixedHashMap[uint64]{}
+			ht.Init()
+			values := make([]uint64, len(keys))
+			for i := 0; i < len(keys); i += unitLimit {
+				n := min(len(keys)-i, unitLimit)
+				ht.InsertBatch(hashes, keys[i:i+n], values[i:i+n])
+			}
+			require.Equal(t, expected, values)
+			require.Equal(t, old.Cardinality(), ht.Cardinality())
+		})
+	}
+}
+
+func TestFixedHashMapWithRing(t *testing.T) {
+	keys := genKeys(1000, 100, 8)
+	zs := make([]int64, len(keys))
+	for i := range zs {
+		zs[i] = int64(i % 2)
+	}
+	ht := &FixedHashMap[[2]uint64]{}
+	ht.Init()
+	values := make([]uint64, len(keys))
+	ht.InsertBatchWithRing(zs, make([]uint64, len(keys)), keys, values)
+	for i, v := range values {
+		if zs[i] == 0 {
+			require.Equal(t, uint64(0), v)
+		} else {
+			require.NotEqual(t, uint64(0), v)
+		}
+	}
+}
+
+func insertFixed(ht *FixedHashMap[[2]uint64], keys [][2]uint64) []uint64 {
+	values := make([]uint64, len(keys))
+	hashes := make([]uint64, unitLimit)
+	for i := 0; i < len(keys); i += unitLimit {
+		n := min(len(keys)-i, unitLimit)
+		ht.InsertBatch(hashes, keys[i:i+n], values[i:i+n])
+	}
+	return values
+}
+
+// insertString24 groups keys by the StringHashMap, the map used for the
+// keys wider than 8 bytes before FixedHashMap.
+func insertString24(keys [][2]uint64) []uint64 {
+	ht := &StringHashMap{}
+	ht.Init()
+	values := make([]uint64, len(keys))
+	states := make([][3]uint64, unitLimit)
+	keys24 := make([][3]uint64, unitLimit)
+	for i := 0; i < len(keys); i += unitLimit {
+		n := min(len(keys)-i, unitLimit)
+		for j := 0; j < n; j++ {
+			keys24[j] = [3]uint64{keys[i+j][0], keys[i+j][1]}
+		}
+		ht.InsertString24Batch(states, keys24[:n], values[i:i+n])
+	}
+	return values
+}
+
+func min(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+func maxValue(xs []uint64) uint64 {
+	var r uint64
+	for _, x := range xs {
+		if x > r {
+			r = x
+		}
+	}
+	return r
+}
+
+func BenchmarkGroupInt32(b *testing.B) {
+	keys := make([]uint64, benchmarkRows)
+	for i, key := range genKeys(len(keys), benchmarkRows/10, 4) {
+		keys[i] = key[0]
+	}
+	values := make([]uint64, unitLimit)
+	b.Run("Int64HashMap", func(b *testing.B) {
+		b.ReportAllocs()
+		hashes := make([]uint64, unitLimit)
+		for n := 0; n < b.N; n++ {
+			ht := &Int64HashMap{}
+			ht.Init()
+			for i := 0; i < len(keys); i += unitLimit {
+				hashes[0] = 0
+				ht.InsertBatch(unitLimit, hashes, unsafe.Pointer(&keys[i]), values)
+			}
+		}
+	})
+	b.Run("FixedHashMap", func(b *testing.B) {
+		b.ReportAllocs()
+		hashes := make([]uint64, unitLimit)
+		for n := 0; n < b.N; n++ {
+			ht := &FixedHashMap[uint64]{}
+			ht.Init()
+			for i := 0; i < len(keys); i += unitLimit {
+				ht.InsertBatch(hashes, keys[i:i+unitLimit], values)
+			}
+		}
+	})
+}
+
+func BenchmarkGroupInt64(b *testing.B) {
+	benchmarkGroup(b, genKeys(benchmarkRows, benchmarkRows/10, 8))
+}
+
+func BenchmarkGroupInt32Int32(b *testing.B) {
+	benchmarkGroup(b, genKeys(benchmarkRows, benchmarkRows/10, 4, 4))
+}
+
+func benchmarkGroup(b *testing.B, keys [][2]uint64) {
+	values := make([]uint64, unitLimit)
+	b.Run("StringHashMap", func(b *testing.B) {
+		b.ReportAllocs()
+		states := make([][3]uint64, unitLimit)
+		keys24 := make([][3]uint64, unitLimit)
+		for n := 0; n < b.N; n++ {
+			ht := &StringHashMap{}
+			ht.Init()
+			for i := 0; i < len(keys); i += unitLimit {
+				for j := range keys24 {
+					keys24[j] = [3]uint64{keys[i+j][0], keys[i+j][1]}
+				}
+				ht.InsertString24Batch(states, keys24, values)
+			}
+		}
+	})
+	b.Run("FixedHashMap", func(b *testing.B) {
+		b.ReportAllocs()
+		hashes := make([]uint64, unitLimit)
+		for n := 0; n < b.N; n++ {
+			ht := &FixedHashMap[[2]uint64]{}
+			ht.Init()
+			for i := 0; i < len(keys); i += unitLimit {
+				ht.InsertBatch(hashes, keys[i:i+unitLimit], values)
+			}
+		}
+	})
+}
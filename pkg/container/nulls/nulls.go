@@ -15,6 +15,10 @@
 // Package nulls wrap up functions for the manipulation of bitmap library roaring.
 // MatrixOne uses nulls to store all NULL values in a column.
 // You can think of Nulls as a bitmap.
+//
+// Each vector owns the bitmap of its Nulls: the functions here copy the rows
+// of one Nulls into another and never make two Nulls share a bitmap. In the
+// debug build a bitmap mutated through a Nulls other than its owner panics.
 package nulls
 
 import (
@@ -27,6 +31,7 @@ import (
 
 // Or performs union operation on Nulls n,m and store the result in r
 func Or(n, m, r *Nulls) {
+	checkOwner(r)
 	if (n == nil || (n != nil && n.Np == nil)) && m != nil && m.Np != nil {
 		if r.Np == nil {
 			r.Np = roaring.NewBitmap()
@@ -50,7 +55,23 @@ func Or(n, m, r *Nulls) {
 	}
 }
 
+// And performs intersection operation on Nulls n,m and store the result in r
+func And(n, m, r *Nulls) {
+	if n == nil || n.Np == nil || m == nil || m.Np == nil {
+		return
+	}
+	checkOwner(r)
+	if r.Np == nil {
+		r.Np = roaring.NewBitmap()
+	}
+	r.Np.Or(n.Np)
+	r.Np.And(m.Np)
+}
+
+// Reset removes all the rows of n, use a new Nulls instead if the bitmap of n
+// may be shared with another vector
 func Reset(n *Nulls) {
+	checkOwner(n)
 	if n.Np != nil {
 		n.Np.Clear()
 	}
@@ -96,6 +117,7 @@ func Contains(n *Nulls, row uint64) bool {
 }
 
 func Add(n *Nulls, rows ...uint64) {
+	checkOwner(n)
 	if n.Np == nil {
 		n.Np = roaring.BitmapOf(rows...)
 		return
@@ -107,13 +129,16 @@ func Del(n *Nulls, rows ...uint64) {
 	if n.Np == nil {
 		return
 	}
+	checkOwner(n)
 	for _, row := range rows {
 		n.Np.Remove(row)
 	}
 }
 
-// Set performs union operation on Nulls n,m and store the result in n
+// Set performs union operation on Nulls n,m and store the result in n, the
+// rows of m are copied so that n and m never share a bitmap
 func Set(n, m *Nulls) {
+	checkOwner(n)
 	if m != nil && m.Np != nil {
 		if n.Np == nil {
 			n.Np = roaring.NewBitmap()
@@ -122,6 +147,22 @@ func Set(n, m *Nulls) {
 	}
 }
 
+// Append adds the nulls of m to n, whose rows are shifted by offset
+func Append(n, m *Nulls, offset uint64) {
+	if m == nil || m.Np == nil || m.Np.IsEmpty() {
+		return
+	}
+	checkOwner(n)
+	if n.Np == nil {
+		n.Np = roaring.NewBitmap()
+	}
+	rows := m.Np.ToArray()
+	for i := range rows {
+		rows[i] += offset
+	}
+	n.Np.AddMany(rows)
+}
+
 // FilterCount returns the number count that appears in both n and sel
 func FilterCount(n *Nulls, sels []int64) int {
 	var cnt int
@@ -141,6 +182,7 @@ func FilterCount(n *Nulls, sels []int64) int {
 }
 
 func RemoveRange(n *Nulls, start, end uint64) {
+	checkOwner(n)
 	if n.Np != nil {
 		n.Np.RemoveRange(start, end)
 	}
@@ -149,6 +191,7 @@ func RemoveRange(n *Nulls, start, end uint64) {
 // Range adds the numbers in n starting at start and ending at end to m.
 // Return the result
 func Range(n *Nulls, start, end uint64, m *Nulls) *Nulls {
+	checkOwner(m)
 	switch {
 	case n.Np == nil && m.Np == nil:
 	case n.Np != nil && m.Np == nil:
@@ -169,6 +212,28 @@ func Range(n *Nulls, start, end uint64, m *Nulls) *Nulls {
 	return m
 }
 
+// Window returns the nulls of the rows [start, end) of n, with the row start
+// translated to row 0, only the NULL rows in the range are visited.
+func Window(n *Nulls, start, end uint64) *Nulls {
+	m := &Nulls{}
+	if n == nil || n.Np == nil {
+		return m
+	}
+	itr := n.Np.Iterator()
+	itr.AdvanceIfNeeded(start)
+	for itr.HasNext() {
+		row := itr.Next()
+		if row >= end {
+			break
+		}
+		if m.Np == nil {
+			m.Np = roaring.NewBitmap()
+		}
+		m.Np.Add(row - start)
+	}
+	return m
+}
+
 func Filter(n *Nulls, sels []int64) *Nulls {
 	if n.Np == nil {
 		return n
@@ -211,18 +276,16 @@ func (n *Nulls) Read(data []byte) error {
 	return nil
 }
 
+// Or adds the rows of m to n and returns n, it mutates n, so the result of
+// two vectors owned by others is built by the function Or instead
 func (n *Nulls) Or(m *Nulls) *Nulls {
-	switch {
-	case m == nil:
-		return n
-	case n.Np == nil && m.Np == nil:
-		return n
-	case n.Np != nil && m.Np == nil:
-		return n
-	case n.Np == nil && m.Np != nil:
-		return m
-	default:
-		n.Np.Or(m.Np)
-		return n
-	}
+	Set(n, m)
+	return n
+}
+
+// Dup returns a copy of n which does not share the bitmap of n
+func Dup(n *Nulls) *Nulls {
+	m := &Nulls{}
+	Set(m, n)
+	return m
 }
@@ -45,6 +45,31 @@ func TestOr(t *testing.T) {
 	})
 }
 
+func TestAnd(t *testing.T) {
+	t.Run("and test", func(t *testing.T) {
+		n := Nulls{Np: roaring.New()}
+		for k := 0; k < 4000; k++ {
+			n.Np.AddInt(k)
+		}
+		m := Nulls{Np: roaring.New()}
+		for k := 3000; k < 8000; k++ {
+			m.Np.AddInt(k)
+		}
+		result := Nulls{}
+		And(&n, &m, &result)
+		assert.EqualValues(t, 1000, result.Np.GetCardinality())
+		assert.True(t, Contains(&result, 3000))
+		assert.False(t, Contains(&result, 2999))
+		m1 := Nulls{}
+		result = Nulls{}
+		And(&n, &m1, &result)
+		assert.False(t, Any(&result))
+		result = Nulls{}
+		And(&m1, &n, &result)
+		assert.False(t, Any(&result))
+	})
+}
+
 func TestReset(t *testing.T) {
 	t.Run("reset test", func(t *testing.T) {
 		n := Nulls{Np: roaring.New()}
@@ -146,6 +171,19 @@ func TestSet(t *testing.T) {
 	})
 }
 
+func TestAppend(t *testing.T) {
+	t.Run("append test", func(t *testing.T) {
+		n := Nulls{}
+		Add(&n, 1)
+		m := Nulls{}
+		Add(&m, 0, 3)
+		Append(&n, &m, 5)
+		assert.Equal(t, []uint64{1, 5, 8}, n.Np.ToArray())
+		Append(&n, &Nulls{}, 10)
+		assert.Equal(t, uint64(3), n.Np.GetCardinality())
+	})
+}
+
 func TestFilterCount(t *testing.T) {
 	t.Run("FilterCount test", func(t *testing.T) {
 		n := Nulls{Np: roaring.New()}
@@ -199,3 +237,64 @@ func TestFilter(t *testing.T) {
 		assert.Equal(t, uint64(3), n.Np.GetCardinality())
 	})
 }
+
+func TestWindow(t *testing.T) {
+	n := Nulls{Np: roaring.New()}
+	n.Np.AddMany([]uint64{1, 5, 6, 12})
+	m := Window(&n, 5, 12)
+	assert.Equal(t, []uint64{0, 1}, m.Np.ToArray())
+	assert.False(t, Any(Window(&n, 7, 12)))
+	assert.False(t, Any(Window(&Nulls{}, 0, 12)))
+}
+
+func TestOrMethod(t *testing.T) {
+	m := &Nulls{}
+	Add(m, 1, 3)
+	// an empty n gets a copy of the rows of m, it never shares the bitmap
+	n := &Nulls{}
+	r := n.Or(m)
+	assert.Same(t, n, r)
+	assert.NotSame(t, m.Np, n.Np)
+	Add(n, 5)
+	assert.Equal(t, 2, Length(m))
+	assert.Equal(t, 3, Length(n))
+
+	// the rows of m are added to n, which is returned
+	assert.Equal(t, 3, Length(m.Or(n)))
+	assert.Equal(t, 3, Length(n.Or(nil)))
+}
+
+func TestDup(t *testing.T) {
+	n := &Nulls{}
+	Add(n, 2, 4)
+	m := Dup(n)
+	assert.NotSame(t, n.Np, m.Np)
+	Del(m, 2)
+	assert.True(t, Contains(n, 2))
+	assert.False(t, Contains(m, 2))
+	assert.False(t, Any(Dup(&Nulls{})))
+}
+
+func TestCheckOwnership(t *testing.T) {
+	defer func(check bool) { CheckOwnership = check }(CheckOwnership)
+	CheckOwnership = true
+
+	n := &Nulls{}
+	Add(n, 1)
+	Add(n, 2)
+	// a bitmap shared by two vectors is only read
+	shared := &Nulls{Np: n.Np}
+	assert.True(t, Contains(shared, 2))
+	assert.Panics(t, func() { Add(shared, 3) })
+	assert.Panics(t, func() { Set(shared, n) })
+	assert.Panics(t, func() { Reset(shared) })
+	assert.False(t, Contains(n, 3))
+
+	// the copies have bitmaps of their own
+	m := &Nulls{}
+	Set(m, n)
+	Add(m, 3)
+	Add(Dup(n), 4)
+	Or(n, m, n)
+	assert.Equal(t, 3, Length(n))
+}
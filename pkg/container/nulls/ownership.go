@@ -0,0 +1,42 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nulls
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	// CheckOwnership, if true, the first Nulls mutating a bitmap becomes its
+	// owner, and a mutation of the bitmap through another Nulls panics. It's
+	// set by the debug builds and by the tests.
+	CheckOwnership = debugBuild
+
+	// owners records the owner of every bitmap mutated, the bitmaps recorded
+	// are never freed so that the records are never stale.
+	owners sync.Map
+)
+
+// checkOwner panics if the bitmap of n, which is about to be mutated, is owned
+// by another Nulls, that's a bitmap shared by two vectors.
+func checkOwner(n *Nulls) {
+	if !CheckOwnership || n == nil || n.Np == nil {
+		return
+	}
+	if owner, ok := owners.LoadOrStore(n.Np, n); ok && owner.(*Nulls) != n {
+		panic(fmt.Sprintf("bitmap %p of nulls %p is mutated through nulls %p", n.Np, owner, n))
+	}
+}
@@ -181,7 +181,7 @@ func NewAnyValueRingWithTypeCheck(typ types.Type) (ring.Ring, error) {
 		return &AnyVRing1[uint16]{Typ: typ}, nil
 	case types.T_uint32:
 		return &AnyVRing1[uint32]{Typ: typ}, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return &AnyVRing1[uint64]{Typ: typ}, nil
 	case types.T_int8:
 		return &AnyVRing1[int8]{Typ: typ}, nil
@@ -136,25 +136,49 @@ func (r *ApproxCountDistinctRing) Grows(size int, m *mheap.Mheap) error {
 
 func (r *ApproxCountDistinctRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 	iter := newBytesIterSolo(vec)
-	if sel_data := iter.Nth(sel); sel_data != nil {
-		r.Sk[i].Insert(sel_data)
+	if data, ok := iter.Nth(sel); ok {
+		h := r.getHasher()
+		h.insert(r.Sk[i], data)
+		h.flush()
 	}
 }
 
 func (r *ApproxCountDistinctRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
 	iter := newBytesIterSolo(vec)
+	h := r.getHasher()
 	for i := range os {
 		dest := vps[i] - 1
 		sel := int64(i) + start
-		if sel_data := iter.Nth(sel); sel_data != nil {
-			r.Sk[dest].Insert(sel_data)
+		if data, ok := iter.Nth(sel); ok {
+			h.insert(r.Sk[dest], data)
 		}
 	}
+	h.flush()
 }
 
 func (r *ApproxCountDistinctRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 	iter := newBytesIterSolo(vec)
-	iter.Foreach(func(data []byte) { r.Sk[i].Insert(data) })
+	h := r.getHasher()
+	iter.Foreach(func(data []byte) { h.insert(r.Sk[i], data) })
+	h.flush()
+}
+
+func (r *ApproxCountDistinctRing) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	iter := newBytesIterSolo(vec)
+	h := r.getHasher()
+	for _, sel := range sels {
+		if data, ok := iter.Nth(sel); ok {
+			h.insert(r.Sk[i], data)
+		}
+	}
+	h.flush()
+}
+
+func (r *ApproxCountDistinctRing) getHasher() *hasher {
+	if r.hs == nil {
+		r.hs = newHasher()
+	}
+	return r.hs
 }
 
 func (r *ApproxCountDistinctRing) Add(a interface{}, x, y int64) {
@@ -0,0 +1,192 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxcd
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMheap() *mheap.Mheap {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return mheap.New(gm)
+}
+
+func newVector(oid types.T, col any, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: oid})
+	vec.Col = col
+	nulls.Add(vec.Nsp, nullRows...)
+	return vec
+}
+
+func newStrVector(vs []string, nullRows ...uint64) *vector.Vector {
+	col := &types.Bytes{}
+	for _, v := range vs {
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(v)))
+		col.Data = append(col.Data, v...)
+	}
+	return newVector(types.T_varchar, col, nullRows...)
+}
+
+func ones(n int) []int64 {
+	zs := make([]int64, n)
+	for i := range zs {
+		zs[i] = 1
+	}
+	return zs
+}
+
+func seq(start, n int) []int64 {
+	vs := make([]int64, n)
+	for i := range vs {
+		vs[i] = int64(start + i)
+	}
+	return vs
+}
+
+// fillSeq fills the group i of r with the int64 values [start, start+n)
+func fillSeq(r *ApproxCountDistinctRing, i int64, start, n int) {
+	for n > 0 {
+		size := 8192
+		if n < size {
+			size = n
+		}
+		r.BulkFill(i, ones(size), newVector(types.T_int64, seq(start, size)))
+		start += size
+		n -= size
+	}
+}
+
+func estimate(t *testing.T, r *ApproxCountDistinctRing) []uint64 {
+	vec := r.Eval(nil)
+	require.Equal(t, types.T_uint64, vec.Typ.Oid)
+	return vec.Col.([]uint64)
+}
+
+func TestExactForTinyCardinalities(t *testing.T) {
+	m := newTestMheap()
+	ns := []int{0, 1, 2, 10, 100, 1000}
+	r := NewApproxCountDistinct(types.Type{Oid: types.T_int64})
+	require.NoError(t, r.Grows(len(ns), m))
+	for i, n := range ns {
+		// each value twice and a null
+		fillSeq(r, int64(i), 0, n)
+		fillSeq(r, int64(i), 0, n)
+		r.BulkFill(int64(i), ones(1), newVector(types.T_int64, []int64{0}, 0))
+	}
+	for i, v := range estimate(t, r) {
+		require.Equal(t, uint64(ns[i]), v)
+	}
+}
+
+func TestErrorBound(t *testing.T) {
+	m := newTestMheap()
+	const n = 1000000
+	r := NewApproxCountDistinct(types.Type{Oid: types.T_int64})
+	require.NoError(t, r.Grows(1, m))
+	fillSeq(r, 0, 0, n)
+
+	s := NewApproxCountDistinct(types.Type{Oid: types.T_varchar})
+	require.NoError(t, s.Grows(1, m))
+	strs := make([]string, 0, 8192)
+	for i := 0; i < n; i++ {
+		strs = append(strs, fmt.Sprintf("value-%d", i))
+		if len(strs) == cap(strs) || i == n-1 {
+			s.BulkFill(0, ones(len(strs)), newStrVector(strs))
+			strs = strs[:0]
+		}
+	}
+
+	for _, v := range append(estimate(t, r), estimate(t, s)...) {
+		require.InDelta(t, n, float64(v), n*0.02)
+	}
+}
+
+func TestFillTypes(t *testing.T) {
+	m := newTestMheap()
+	negZero := math.Copysign(0, -1)
+	vecs := []*vector.Vector{
+		newVector(types.T_bool, []bool{true, false, true, false}, 3),
+		newVector(types.T_int8, []int8{1, -1, 1, 2}),
+		newVector(types.T_uint16, []uint16{1, 1, 1, 1}, 0),
+		newVector(types.T_float32, []float32{0, float32(negZero), 1.5, 1.5}),
+		newVector(types.T_float64, []float64{negZero, 0, 2.5, -2.5}, 3),
+		newVector(types.T_date, []types.Date{1, 2, 2, 3}),
+		newVector(types.T_time, []types.Time{1, 2, 2, 3}, 0),
+		newVector(types.T_datetime, []types.Datetime{5, 5, 5, 5}),
+		newVector(types.T_timestamp, []types.Timestamp{5, 6, 7, 8}, 0, 1),
+		newVector(types.T_decimal64, []types.Decimal64{10, 20, 10, 20}),
+		newVector(types.T_decimal128, []types.Decimal128{{Lo: 1}, {Hi: 1}, {Lo: 1}, {}}),
+		newStrVector([]string{"", "a", "", "ab"}),
+		newStrVector([]string{"", "", "a", "a"}, 0, 1),
+	}
+	expected := []uint64{2, 3, 1, 2, 2, 3, 2, 1, 2, 2, 3, 3, 1}
+	for i, vec := range vecs {
+		// fill the group by all the ways
+		r := NewApproxCountDistinct(vec.Typ)
+		require.NoError(t, r.Grows(4, m))
+		r.BulkFill(0, ones(4), vec)
+		for j := int64(0); j < 4; j++ {
+			r.Fill(1, j, 1, vec)
+		}
+		r.BatchFill(0, make([]uint8, 4), []uint64{3, 3, 3, 3}, ones(4), vec)
+		r.BulkFillSels(3, []int64{0, 1, 2, 3}, ones(4), vec)
+		require.Equal(t, []uint64{expected[i], expected[i], expected[i], expected[i]}, estimate(t, r), vec.Typ.Oid.String())
+	}
+}
+
+func TestMergeAssociativity(t *testing.T) {
+	m := newTestMheap()
+	// the sketches of the overlapping ranges and all of them
+	ranges := [][2]int{{0, 300000}, {200000, 300000}, {450000, 5000}, {0, 500000}}
+	newRing := func() *ApproxCountDistinctRing {
+		r := NewApproxCountDistinct(types.Type{Oid: types.T_int64})
+		require.NoError(t, r.Grows(len(ranges), m))
+		for i, rg := range ranges {
+			fillSeq(r, int64(i), rg[0], rg[1])
+		}
+		return r
+	}
+
+	// (a + b) + c
+	r1, r2 := newRing(), newRing()
+	r1.Add(r2, 0, 1)
+	r1.Add(r2, 0, 2)
+	// a + (b + c)
+	r3, r4 := newRing(), newRing()
+	r3.Add(r4, 1, 2)
+	r4.Add(r3, 0, 1)
+	// c + b + a by batch
+	r5, r6 := newRing(), newRing()
+	r5.BatchAdd(r6, 0, make([]uint8, 2), []uint64{3, 3})
+	r5.Add(r6, 2, 0)
+
+	all := estimate(t, newRing())[3]
+	require.InDelta(t, 500000, float64(all), 500000*0.02)
+	require.Equal(t, all, estimate(t, r1)[0])
+	require.Equal(t, all, estimate(t, r4)[0])
+	require.Equal(t, all, estimate(t, r5)[2])
+}
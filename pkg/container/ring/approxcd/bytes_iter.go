@@ -15,7 +15,9 @@
 package approxcd
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -28,14 +30,16 @@ type bytesIter interface {
 	NextBytes() ([]byte, bool)
 	// For each []byte, call the passed function
 	Foreach(func([]byte))
-	// zero-based index, return nil if null
-	Nth(int64) []byte
+	// zero-based index, return false if null
+	Nth(int64) ([]byte, bool)
 }
 
 type fixedBytes struct {
 	data               []byte
 	nsp                *nulls.Nulls
 	dataLen, i, stride int
+	// the encoding of -0 of floats, which is replaced by the encoding of 0
+	negZero []byte
 }
 
 func newFixedBytes(data []byte, nsp *nulls.Nulls, stride int) *fixedBytes {
@@ -52,10 +56,10 @@ func (b *fixedBytes) NextBytes() (data []byte, hasNext bool) {
 	if b.i >= b.dataLen {
 		return nil, false
 	}
-	if nulls.Contains(b.nsp, uint64(b.i)) {
+	if nulls.Contains(b.nsp, uint64(b.i/b.stride)) {
 		data, hasNext = nil, true
 	} else {
-		data, hasNext = b.data[b.i:b.i+b.stride], true
+		data, hasNext = b.canonical(b.data[b.i:b.i+b.stride]), true
 	}
 	b.i += b.stride
 	return
@@ -64,23 +68,30 @@ func (b *fixedBytes) NextBytes() (data []byte, hasNext bool) {
 func (b *fixedBytes) Foreach(f func([]byte)) {
 	if nulls.Any(b.nsp) {
 		for ; b.i < b.dataLen; b.i += b.stride {
-			if !nulls.Contains(b.nsp, uint64(b.i)) {
-				f(b.data[b.i : b.i+b.stride])
+			if !nulls.Contains(b.nsp, uint64(b.i/b.stride)) {
+				f(b.canonical(b.data[b.i : b.i+b.stride]))
 			}
 		}
 	} else {
 		for ; b.i < b.dataLen; b.i += b.stride {
-			f(b.data[b.i : b.i+b.stride])
+			f(b.canonical(b.data[b.i : b.i+b.stride]))
 		}
 	}
 }
 
-func (b *fixedBytes) Nth(i int64) []byte {
+func (b *fixedBytes) Nth(i int64) ([]byte, bool) {
 	if nulls.Contains(b.nsp, uint64(i)) {
-		return nil
+		return nil, false
 	}
 	s, e := i*int64(b.stride), (i+1)*int64(b.stride)
-	return b.data[s:e]
+	return b.canonical(b.data[s:e]), true
+}
+
+func (b *fixedBytes) canonical(data []byte) []byte {
+	if b.negZero != nil && bytes.Equal(data, b.negZero) {
+		return make([]byte, b.stride)
+	}
+	return data
 }
 
 type varBytes struct {
@@ -126,19 +137,23 @@ func (b *varBytes) Foreach(f func([]byte)) {
 	}
 }
 
-func (b *varBytes) Nth(i int64) []byte {
+func (b *varBytes) Nth(i int64) ([]byte, bool) {
 	if nulls.Contains(b.nsp, uint64(i)) {
-		return nil
+		return nil, false
 	}
-	return b.data.Get(i)
+	return b.data.Get(i), true
 }
 
+// newBytesIterSolo returns the iterator of the canonical encodings of the
+// values of vec, the equal values have the same encoding
 func newBytesIterSolo(vec *vector.Vector) bytesIter {
 	var data []byte
 	var stride int
 	switch vec.Typ.Oid {
 	case types.T_char, types.T_varchar, types.T_json:
 		return newVarBytes(vec)
+	case types.T_bool:
+		data, stride = encoding.EncodeBoolSlice(vec.Col.([]bool)), 1
 	case types.T_int8:
 		data, stride = encoding.EncodeInt8Slice(vec.Col.([]int8)), 1
 	case types.T_uint8:
@@ -155,14 +170,18 @@ func newBytesIterSolo(vec *vector.Vector) bytesIter {
 		data, stride = encoding.EncodeFloat32Slice(vec.Col.([]float32)), 4
 	case types.T_int64:
 		data, stride = encoding.EncodeInt64Slice(vec.Col.([]int64)), 8
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data, stride = encoding.EncodeUint64Slice(vec.Col.([]uint64)), 8
 	case types.T_float64:
 		data, stride = encoding.EncodeFloat64Slice(vec.Col.([]float64)), 8
 	case types.T_date:
 		data, stride = encoding.EncodeDateSlice(vec.Col.([]types.Date)), encoding.DateSize
+	case types.T_time:
+		data, stride = encoding.EncodeTimeSlice(vec.Col.([]types.Time)), encoding.TimeSize
 	case types.T_datetime:
 		data, stride = encoding.EncodeDatetimeSlice(vec.Col.([]types.Datetime)), encoding.DatetimeSize
+	case types.T_timestamp:
+		data, stride = encoding.EncodeTimestampSlice(vec.Col.([]types.Timestamp)), encoding.TimestampSize
 	case types.T_decimal64:
 		data, stride = encoding.EncodeDecimal64Slice(vec.Col.([]types.Decimal64)), encoding.Decimal64Size
 	case types.T_decimal128:
@@ -171,7 +190,14 @@ func newBytesIterSolo(vec *vector.Vector) bytesIter {
 	if data == nil {
 		panic(fmt.Sprintf("not support for type %s", vec.Typ.Oid))
 	}
-	return newFixedBytes(data, vec.Nsp, stride)
+	b := newFixedBytes(data, vec.Nsp, stride)
+	switch vec.Typ.Oid {
+	case types.T_float32:
+		b.negZero = encoding.EncodeFloat32(float32(math.Copysign(0, -1)))
+	case types.T_float64:
+		b.negZero = encoding.EncodeFloat64(math.Copysign(0, -1))
+	}
+	return b
 }
 
 // A combinator of multiple bytes_iters.
@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxcd
+
+import (
+	hll "github.com/axiomhq/hyperloglog"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// hashBatchSize is the number of values hashed at a time
+const hashBatchSize = 256
+
+// minKeySize is the min size of a key of the hash function, which reads
+// 16 bytes at least
+const minKeySize = 16
+
+// hasher hashes the values with the vectorized hash function shared with the
+// hash tables, and inserts the hashes into their sketches in batches
+type hasher struct {
+	keys   [][]byte
+	sks    []*hll.Sketch
+	states [][3]uint64
+	// the short keys padded to minKeySize
+	padded [][minKeySize]byte
+}
+
+func newHasher() *hasher {
+	return &hasher{
+		keys:   make([][]byte, 0, hashBatchSize),
+		sks:    make([]*hll.Sketch, 0, hashBatchSize),
+		states: make([][3]uint64, hashBatchSize),
+		padded: make([][minKeySize]byte, hashBatchSize),
+	}
+}
+
+func (h *hasher) insert(sk *hll.Sketch, key []byte) {
+	if n := len(key); n < minKeySize {
+		// zero padded with the length in the last byte, so that the
+		// padded keys of different keys are different
+		buf := &h.padded[len(h.keys)]
+		copy(buf[:], key)
+		for i := n; i < minKeySize-1; i++ {
+			buf[i] = 0
+		}
+		buf[minKeySize-1] = byte(n)
+		key = buf[:]
+	}
+	h.keys = append(h.keys, key)
+	h.sks = append(h.sks, sk)
+	if len(h.keys) == hashBatchSize {
+		h.flush()
+	}
+}
+
+func (h *hasher) flush() {
+	n := len(h.keys)
+	if n == 0 {
+		return
+	}
+	hashtable.AesBytesBatchGenHashStates(&h.keys[0], &h.states[0], n)
+	for i := 0; i < n; i++ {
+		h.sks[i].InsertHash(h.states[i][0])
+	}
+	h.keys = h.keys[:0]
+	h.sks = h.sks[:0]
+}
+
+// FillSketch inserts the non-null values of vec into sk. The values are
+// hashed the same way as approx_count_distinct, so that the sketches built
+// by the storage can be merged with the ones of the aggregation.
+func FillSketch(sk *hll.Sketch, vec *vector.Vector) {
+	h := newHasher()
+	newBytesIterSolo(vec).Foreach(func(data []byte) { h.insert(sk, data) })
+	h.flush()
+}
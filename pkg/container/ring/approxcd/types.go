@@ -24,11 +24,19 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+// ApproxCountDistinctRing estimates the count of the distinct values of a
+// group with a HyperLogLog++ sketch of 2^14 registers, which is sparse for
+// small cardinalities and 16KB once dense. The standard error of the estimate
+// is 1.04/sqrt(2^14), about 0.81%, and the count of up to a few thousand
+// distinct values is exact in practice while the sketch is sparse. The
+// sketches are merged by taking the max of the registers, so the partial
+// aggregations can be combined in any order.
 type ApproxCountDistinctRing struct {
 	Typ types.Type
 	Sk  []*hll.Sketch
 	Vs  []uint64
 	Da  []byte
+	hs  *hasher
 }
 
 // impl Serialize & Deserialize for sql/protocol
@@ -0,0 +1,149 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ring
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// StrArena holds a string value of each group of a ring. The values are
+// copied into an arena owned by the ring, so the ring never refers to the
+// vectors it is filled with, which may be recycled as soon as the fill
+// returns. The value of group i takes Ls[i] bytes of a slot of Cs[i] bytes
+// at Os[i] of Da.
+type StrArena struct {
+	Da []byte
+	Os []uint32
+	Ls []uint32
+	Cs []uint32
+	// garbage is the number of bytes of Da in no slot
+	garbage int
+}
+
+// NewStrArena returns an arena holding the values
+func NewStrArena(vs ...[]byte) StrArena {
+	var a StrArena
+	a.Grows(len(vs))
+	for i, v := range vs {
+		a.Set(int64(i), v)
+	}
+	return a
+}
+
+// Count returns the number of the values
+func (a *StrArena) Count() int {
+	return len(a.Os)
+}
+
+// Size returns the memory size of the arena
+func (a *StrArena) Size() int {
+	return cap(a.Da)
+}
+
+// Get returns the value of group i, which is valid until the next Set or
+// Grows of the arena
+func (a *StrArena) Get(i int64) []byte {
+	return a.Da[a.Os[i] : a.Os[i]+a.Ls[i] : a.Os[i]+a.Ls[i]]
+}
+
+// Set copies v into the arena as the value of group i. The slot of group i
+// is reused if v fits in it, the slot left by a longer value is not
+// reclaimed until the arena grows.
+func (a *StrArena) Set(i int64, v []byte) {
+	if uint32(len(v)) <= a.Cs[i] {
+		copy(a.Da[a.Os[i]:], v)
+		a.Ls[i] = uint32(len(v))
+		return
+	}
+	a.garbage += int(a.Cs[i])
+	a.Os[i], a.Ls[i], a.Cs[i] = 0, 0, 0
+	a.reserve(len(v))
+	a.Os[i], a.Ls[i], a.Cs[i] = uint32(len(a.Da)), uint32(len(v)), uint32(len(v))
+	a.Da = append(a.Da, v...)
+}
+
+// reserve makes room for n more bytes at the end of the arena. A full
+// arena is copied into a new one of twice the size of its values, which
+// drops the garbage, so the arena is never much larger than its values.
+func (a *StrArena) reserve(n int) {
+	if len(a.Da)+n <= cap(a.Da) {
+		return
+	}
+	da := make([]byte, 0, 2*(len(a.Da)-a.garbage+n))
+	for i, o := range a.Os {
+		a.Os[i] = uint32(len(da))
+		da = append(da, a.Da[o:o+a.Ls[i]]...)
+		a.Cs[i] = a.Ls[i]
+	}
+	a.Da, a.garbage = da, 0
+}
+
+// Grows appends n groups of empty values
+func (a *StrArena) Grows(n int) {
+	for i := 0; i < n; i++ {
+		a.Os = append(a.Os, 0)
+		a.Ls = append(a.Ls, 0)
+		a.Cs = append(a.Cs, 0)
+	}
+}
+
+// SetLength keeps the first n groups
+func (a *StrArena) SetLength(n int) {
+	for _, c := range a.Cs[n:] {
+		a.garbage += int(c)
+	}
+	a.Os, a.Ls, a.Cs = a.Os[:n], a.Ls[:n], a.Cs[:n]
+}
+
+// Shrink keeps the groups sels
+func (a *StrArena) Shrink(sels []int64) {
+	kept := 0
+	for i, sel := range sels {
+		a.Os[i], a.Ls[i], a.Cs[i] = a.Os[sel], a.Ls[sel], a.Cs[sel]
+		kept += int(a.Cs[i])
+	}
+	a.Os, a.Ls, a.Cs = a.Os[:len(sels)], a.Ls[:len(sels)], a.Cs[:len(sels)]
+	a.garbage = len(a.Da) - kept
+}
+
+// Free releases the arena
+func (a *StrArena) Free() {
+	*a = StrArena{}
+}
+
+// Bytes returns the values as a fresh Bytes, whose data is allocated from
+// m and returned too
+func (a *StrArena) Bytes(m *mheap.Mheap) (*types.Bytes, []byte, error) {
+	size := int64(0)
+	for _, l := range a.Ls {
+		size += int64(l)
+	}
+	data, err := mheap.Alloc(m, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	bs := &types.Bytes{
+		Data:    data[:0],
+		Offsets: make([]uint32, len(a.Os)),
+		Lengths: make([]uint32, len(a.Os)),
+	}
+	for i := range a.Os {
+		bs.Offsets[i] = uint32(len(bs.Data))
+		bs.Lengths[i] = a.Ls[i]
+		bs.Data = append(bs.Data, a.Get(int64(i))...)
+	}
+	return bs, data, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ring
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrArena(t *testing.T) {
+	a := NewStrArena([]byte("abc"), nil, []byte("de"))
+	require.Equal(t, 3, a.Count())
+	require.Equal(t, "abc", string(a.Get(0)))
+	require.Equal(t, "", string(a.Get(1)))
+
+	// a value fitting in the slot is set in place
+	da := a.Da
+	a.Set(0, []byte("x"))
+	a.Set(0, []byte("yz"))
+	require.Equal(t, "yz", string(a.Get(0)))
+	require.Equal(t, "de", string(a.Get(2)))
+	require.Equal(t, &da[0], &a.Da[0])
+
+	// the value set is copied
+	v := []byte("value")
+	a.Set(1, v)
+	copy(v, "XXXXX")
+	require.Equal(t, "value", string(a.Get(1)))
+	require.Equal(t, "yz", string(a.Get(0)))
+
+	// the values got are not appended into the slots of the others
+	require.Equal(t, 2, cap(a.Get(0)))
+
+	a.Shrink([]int64{1, 2})
+	require.Equal(t, 2, a.Count())
+	require.Equal(t, "value", string(a.Get(0)))
+	require.Equal(t, "de", string(a.Get(1)))
+	a.SetLength(1)
+	require.Equal(t, 1, a.Count())
+	a.Grows(1)
+	require.Equal(t, "", string(a.Get(1)))
+	a.Free()
+	require.Equal(t, 0, a.Count())
+	require.Equal(t, 0, a.Size())
+}
+
+func TestStrArenaBounded(t *testing.T) {
+	// the values replaced by longer ones are dropped when the arena grows,
+	// so the arena is never much larger than its values
+	var a StrArena
+	a.Grows(4)
+	for n := 1; n <= 4096; n++ {
+		v := bytes.Repeat([]byte{byte('a' + n%26)}, n)
+		a.Set(int64(n%4), v)
+		live := 0
+		for i := int64(0); i < 4; i++ {
+			live += len(a.Get(i))
+		}
+		require.LessOrEqual(t, a.Size(), 2*live+2*n)
+		require.Equal(t, string(v), string(a.Get(int64(n%4))))
+	}
+	// the arena is not shrunk, the room left is used by the next values
+	size := a.Size()
+	a.SetLength(1)
+	a.Set(0, bytes.Repeat([]byte{'z'}, 8192))
+	require.Equal(t, size, a.Size())
+	require.Equal(t, 8192, len(a.Get(0)))
+}
+
+func TestStrArenaBytes(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	a := NewStrArena([]byte("ab"), nil, []byte("cde"))
+	bs, data, err := a.Bytes(m)
+	require.NoError(t, err)
+	require.Equal(t, int64(cap(data)), mheap.Size(m))
+	require.Equal(t, []uint32{0, 2, 2}, bs.Offsets)
+	require.Equal(t, []uint32{2, 0, 3}, bs.Lengths)
+	require.Equal(t, "abcde", string(bs.Data))
+
+	// the result is fresh
+	a.Set(0, []byte("xy"))
+	require.Equal(t, "ab", string(bs.Get(0)))
+	mheap.Free(m, data)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
@@ -169,7 +169,7 @@ func (r *NumericRing) Fill(idxOfGroup, idxOfRow, cntOfRow int64, vec *vector.Vec
 		rowData = uint64(vec.Col.([]uint16)[idxOfRow])
 	case types.T_uint32:
 		rowData = uint64(vec.Col.([]uint32)[idxOfRow])
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		rowData = uint64(vec.Col.([]uint64)[idxOfRow])
 	}
 	r.BitAndResult[idxOfGroup] &= rowData // update BitAndResult of this group
@@ -290,7 +290,7 @@ func (r *NumericRing) BulkFill(idxOfGroup int64, cntOfRows []int64, vec *vector.
 				}
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vecCol := vec.Col.([]uint64)
 		for _, val := range vecCol {
 			r.BitAndResult[idxOfGroup] &= uint64(val)
@@ -352,7 +352,7 @@ func (r *NumericRing) BatchFill(offset int64, os []uint8, vps []uint64, cntOfRow
 		for i := range os {
 			r.BitAndResult[vps[i]-1] &= uint64(vecCol[offset+int64(i)])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vecCol := vec.Col.([]uint64)
 		for i := range os {
 			r.BitAndResult[vps[i]-1] &= uint64(vecCol[offset+int64(i)])
@@ -143,8 +143,12 @@ func (r *BitOrRing) Grows(size int, m *mheap.Mheap) error {
 	return nil
 }
 
-// Fill update Uint64Ring by a row
+// Fill update BitOrRing by a row, a null row only counts as null
 func (r *BitOrRing) Fill(idxOfGroup, idxOfRow, cntOfRow int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(idxOfRow)) {
+		r.NullCounts[idxOfGroup] += cntOfRow
+		return
+	}
 	var rowData uint64
 	switch vec.Typ.Oid {
 	case types.T_float32:
@@ -165,197 +169,195 @@ func (r *BitOrRing) Fill(idxOfGroup, idxOfRow, cntOfRow int64, vec *vector.Vecto
 		rowData = uint64(vec.Col.([]uint16)[idxOfRow])
 	case types.T_uint32:
 		rowData = uint64(vec.Col.([]uint32)[idxOfRow])
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		rowData = uint64(vec.Col.([]uint64)[idxOfRow])
 	}
 	r.Values[idxOfGroup] |= rowData // update Values of this group
-
-	if nulls.Contains(vec.Nsp, uint64(idxOfRow)) {
-		r.NullCounts[idxOfGroup] += cntOfRow
-	}
 }
 
 // BulkFill update ring by a whole vector
 func (r *BitOrRing) BulkFill(idxOfGroup int64, cntOfRows []int64, vec *vector.Vector) {
+	hasNull := nulls.Any(vec.Nsp)
 	switch vec.Typ.Oid {
 	case types.T_float32:
 		vecCol := vec.Col.([]float32)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_float64:
 		vecCol := vec.Col.([]float64)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_int8:
 		vecCol := vec.Col.([]int8)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_int16:
 		vecCol := vec.Col.([]int16)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_int32:
 		vecCol := vec.Col.([]int32)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_int64:
 		vecCol := vec.Col.([]int64)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_uint8:
 		vecCol := vec.Col.([]uint8)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_uint16:
 		vecCol := vec.Col.([]uint16)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	case types.T_uint32:
 		vecCol := vec.Col.([]uint32)
-		for _, val := range vecCol {
-			r.Values[idxOfGroup] |= uint64(val)
-		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
-		}
-	case types.T_uint64:
-		vecCol := vec.Col.([]uint64)
-		for _, val := range vecCol {
 			r.Values[idxOfGroup] |= uint64(val)
 		}
-		if nulls.Any(vec.Nsp) {
-			for i := range vecCol {
-				if nulls.Contains(vec.Nsp, uint64(i)) {
-					r.NullCounts[idxOfGroup] += cntOfRows[i]
-				}
+	case types.T_uint64, types.T_bit:
+		vecCol := vec.Col.([]uint64)
+		for i, val := range vecCol {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(i)) {
+				r.NullCounts[idxOfGroup] += cntOfRows[i]
+				continue
 			}
+			r.Values[idxOfGroup] |= uint64(val)
 		}
 	}
 }
 
 func (r *BitOrRing) BatchFill(offset int64, os []uint8, vps []uint64, cntOfRows []int64, vec *vector.Vector) {
+	hasNull := nulls.Any(vec.Nsp)
 	switch vec.Typ.Oid {
 	case types.T_float32:
 		vecCol := vec.Col.([]float32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_float64:
 		vecCol := vec.Col.([]float64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_int8:
 		vecCol := vec.Col.([]int8)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_int16:
 		vecCol := vec.Col.([]int16)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_int32:
 		vecCol := vec.Col.([]int32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_int64:
 		vecCol := vec.Col.([]int64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_uint8:
 		vecCol := vec.Col.([]uint8)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_uint16:
 		vecCol := vec.Col.([]uint16)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	case types.T_uint32:
 		vecCol := vec.Col.([]uint32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vecCol := vec.Col.([]uint64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			r.Values[vps[i]-1] |= uint64(vecCol[offset+int64(i)])
 		}
 	}
 
-	if nulls.Any(vec.Nsp) {
+	if hasNull {
 		for i := range os {
 			if nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
 				r.NullCounts[vps[i]-1] += cntOfRows[int64(i)+offset]
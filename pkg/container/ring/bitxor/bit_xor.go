@@ -135,6 +135,10 @@ func (r *BitXorRing) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r BitXorRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.NullCounts[i] += z
+		return
+	}
 	isOdd := 0
 	if z%2 == 1 {
 		isOdd = 1
@@ -154,23 +158,24 @@ func (r BitXorRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 		r.Values[i] ^= uint64(vec.Col.([]uint16)[sel]) * uint64(isOdd)
 	case types.T_uint32:
 		r.Values[i] ^= uint64(vec.Col.([]uint32)[sel]) * uint64(isOdd)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		r.Values[i] ^= uint64(vec.Col.([]uint64)[sel]) * uint64(isOdd)
 	case types.T_float32:
 		r.Values[i] ^= uint64(vec.Col.([]float32)[sel]) * uint64(isOdd)
 	case types.T_float64:
 		r.Values[i] ^= uint64(vec.Col.([]float64)[sel]) * uint64(isOdd)
 	}
-	if nulls.Contains(vec.Nsp, uint64(sel)) {
-		r.NullCounts[i] += z
-	}
 }
 
 func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	hasNull := nulls.Any(vec.Nsp)
 	switch vec.Typ.Oid {
 	case types.T_int8:
 		vs := vec.Col.([]int8)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -180,6 +185,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_int16:
 		vs := vec.Col.([]int16)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -189,6 +197,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_int32:
 		vs := vec.Col.([]int32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -198,6 +209,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_int64:
 		vs := vec.Col.([]int64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -207,6 +221,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_uint8:
 		vs := vec.Col.([]uint8)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -216,6 +233,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_uint16:
 		vs := vec.Col.([]uint16)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -225,15 +245,21 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_uint32:
 		vs := vec.Col.([]uint32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[vps[i]-1] ^= uint64(vs[int64(i)+offset]) * uint64(isOdd)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := vec.Col.([]uint64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -243,6 +269,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_float32:
 		vs := vec.Col.([]float32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -252,6 +281,9 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 	case types.T_float64:
 		vs := vec.Col.([]float64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
+				continue
+			}
 			isOdd := 0
 			if zs[int64(i)+offset]%2 == 1 {
 				isOdd = 1
@@ -260,7 +292,7 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 		}
 	}
 
-	if nulls.Any(vec.Nsp) {
+	if hasNull {
 		for i := range os {
 			if nulls.Contains(vec.Nsp, uint64(offset)+uint64(i)) {
 				r.NullCounts[vps[i]-1] += zs[int64(i)+offset]
@@ -270,167 +302,138 @@ func (r *BitXorRing) BatchFill(offset int64, os []uint8, vps []uint64, zs []int6
 }
 
 func (r *BitXorRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	hasNull := nulls.Any(vec.Nsp)
 	switch vec.Typ.Oid {
 	case types.T_int8:
 		vs := vec.Col.([]int8)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_int16:
 		vs := vec.Col.([]int16)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_int32:
 		vs := vec.Col.([]int32)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_int64:
 		vs := vec.Col.([]int64)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_uint8:
 		vs := vec.Col.([]uint8)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_uint16:
 		vs := vec.Col.([]uint16)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_uint32:
 		vs := vec.Col.([]uint32)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := vec.Col.([]uint64)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_float32:
 		vs := vec.Col.([]float32)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 	case types.T_float64:
 		vs := vec.Col.([]float64)
 		for j, v := range vs {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(j)) {
+				r.NullCounts[i] += zs[j]
+				continue
+			}
 			isOdd := 0
 			if zs[j]%2 == 1 {
 				isOdd = 1
 			}
 			r.Values[i] ^= uint64(v) * uint64(isOdd)
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.NullCounts[i] += zs[j]
-				}
-			}
-		}
 
 	}
 }
@@ -0,0 +1,110 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitxor
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMheap() *mheap.Mheap {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return mheap.New(gm)
+}
+
+func newInt32Vector(vs []int32, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	vec.Col = vs
+	nulls.Add(vec.Nsp, nullRows...)
+	return vec
+}
+
+func ones(n int) []int64 {
+	zs := make([]int64, n)
+	for i := range zs {
+		zs[i] = 1
+	}
+	return zs
+}
+
+func TestBulkFill(t *testing.T) {
+	m := newTestMheap()
+	r := NewBitXor(types.Type{Oid: types.T_int32})
+	require.NoError(t, r.Grows(3, m))
+
+	// no nulls, a value twice cancels out
+	r.BulkFill(0, ones(4), newInt32Vector([]int32{1, 4, 8, 4}))
+	// all nulls, the values of the null rows are skipped
+	r.BulkFill(1, ones(2), newInt32Vector([]int32{7, 7}, 0, 1))
+	// some nulls
+	r.BulkFill(2, ones(3), newInt32Vector([]int32{2, 16, 1}, 1))
+	require.Equal(t, []uint64{9, 0, 3}, r.Values)
+
+	vec := r.Eval([]int64{4, 2, 3})
+	require.Equal(t, types.T_uint64, vec.Typ.Oid)
+	require.False(t, nulls.Contains(vec.Nsp, 0))
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	require.False(t, nulls.Contains(vec.Nsp, 2))
+}
+
+func TestFill(t *testing.T) {
+	m := newTestMheap()
+	r := NewBitXor(types.Type{Oid: types.T_uint8})
+	require.NoError(t, r.Grows(2, m))
+	vec := vector.New(types.Type{Oid: types.T_uint8, Size: 1})
+	vec.Col = []uint8{1, 255, 2}
+	nulls.Add(vec.Nsp, 1)
+	r.Fill(0, 0, 1, vec)
+	r.Fill(0, 1, 2, vec)
+	r.Fill(0, 2, 1, vec)
+	// a row counted twice cancels out
+	r.Fill(0, 0, 2, vec)
+	r.Fill(1, 1, 1, vec)
+	require.Equal(t, []uint64{3, 0}, r.Values)
+	require.Equal(t, []int64{2, 1}, r.NullCounts)
+
+	vec = r.Eval([]int64{6, 1})
+	require.False(t, nulls.Contains(vec.Nsp, 0))
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+}
+
+func TestBatchFillAndMerge(t *testing.T) {
+	m := newTestMheap()
+	vec := newInt32Vector([]int32{1, 64, 2, 4, 32}, 1, 4)
+	// two partial rings of a parallel group by, row i goes to the group vps[i]-1
+	r1 := NewBitXor(types.Type{Oid: types.T_int32})
+	require.NoError(t, r1.Grows(2, m))
+	r1.BatchFill(0, make([]uint8, 3), []uint64{1, 2, 1}, ones(5), vec)
+	r2 := r1.Dup().(*BitXorRing)
+	require.NoError(t, r2.Grows(2, m))
+	r2.BatchFill(3, make([]uint8, 2), []uint64{1, 2}, ones(5), vec)
+	require.Equal(t, []uint64{3, 0}, r1.Values)
+	require.Equal(t, []uint64{4, 0}, r2.Values)
+
+	r1.BatchAdd(r2, 0, make([]uint8, 2), []uint64{1, 2})
+	require.Equal(t, []int64{0, 2}, r1.NullCounts)
+	vec = r1.Eval([]int64{3, 2})
+	require.Equal(t, []uint64{7, 0}, vec.Col)
+	require.False(t, nulls.Contains(vec.Nsp, 0))
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+}
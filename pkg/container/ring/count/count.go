@@ -156,7 +156,16 @@ func (r *CountRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64,
 }
 
 func (r *CountRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
-	if nulls.Any(vec.Nsp) {
+	switch nulls.Length(vec.Nsp) {
+	case 0:
+		for _, z := range zs {
+			r.Vs[i] += z
+		}
+	case len(zs):
+		for _, z := range zs {
+			r.Ns[i] += z
+		}
+	default:
 		for j, z := range zs {
 			if nulls.Contains(vec.Nsp, uint64(j)) {
 				r.Ns[i] += z
@@ -164,9 +173,26 @@ func (r *CountRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 				r.Vs[i] += z
 			}
 		}
-	} else {
-		for _, z := range zs {
-			r.Vs[i] += z
+	}
+}
+
+func (r *CountRing) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	switch nulls.FilterCount(vec.Nsp, sels) {
+	case 0:
+		for _, sel := range sels {
+			r.Vs[i] += zs[sel]
+		}
+	case len(sels):
+		for _, sel := range sels {
+			r.Ns[i] += zs[sel]
+		}
+	default:
+		for _, sel := range sels {
+			if nulls.Contains(vec.Nsp, uint64(sel)) {
+				r.Ns[i] += zs[sel]
+			} else {
+				r.Vs[i] += zs[sel]
+			}
 		}
 	}
 }
@@ -436,7 +462,7 @@ func getValue(vec *vector.Vector, sel int64) any {
 		return vec.Col.([]uint16)[sel]
 	case types.T_uint32:
 		return vec.Col.([]uint32)[sel]
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return vec.Col.([]uint64)[sel]
 	case types.T_float32:
 		return vec.Col.([]float32)[sel]
@@ -448,6 +474,8 @@ func getValue(vec *vector.Vector, sel int64) any {
 		return vec.Col.([]types.Datetime)[sel]
 	case types.T_timestamp:
 		return vec.Col.([]types.Timestamp)[sel]
+	case types.T_time:
+		return vec.Col.([]types.Time)[sel]
 	case types.T_decimal64:
 		return vec.Col.([]types.Decimal64)[sel]
 	case types.T_decimal128:
@@ -0,0 +1,138 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package max
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"golang.org/x/exp/constraints"
+)
+
+type number interface {
+	constraints.Integer | constraints.Float
+}
+
+// bulkFill folds the rows of col into the max of the group i, and returns
+// the count number of the null rows. es marks the groups which have no
+// value yet, it is nil for the rings whose groups start from the smallest
+// value of the type.
+func bulkFill[T number](vs []T, es []bool, i int64, col []T, zs []int64, nsp *nulls.Nulls) int64 {
+	switch nulls.Length(nsp) {
+	case 0:
+		if len(col) > 0 {
+			vs[i] = maxOf(vs[i], es, i, col)
+		}
+		return 0
+	case len(col):
+		return sumOf(zs[:len(col)])
+	}
+	var n int64
+	for j, v := range col {
+		if nulls.Contains(nsp, uint64(j)) {
+			n += zs[j]
+		} else if (es != nil && es[i]) || v > vs[i] {
+			vs[i] = v
+			if es != nil {
+				es[i] = false
+			}
+		}
+	}
+	return n
+}
+
+// bulkFillSels is bulkFill of the rows sels of col.
+func bulkFillSels[T number](vs []T, es []bool, i int64, sels []int64, col []T, zs []int64, nsp *nulls.Nulls) int64 {
+	var n int64
+	switch nulls.FilterCount(nsp, sels) {
+	case 0:
+		if len(sels) > 0 {
+			x := vs[i]
+			if es != nil && es[i] {
+				x = col[sels[0]]
+				es[i] = false
+			}
+			for _, sel := range sels {
+				if v := col[sel]; v > x {
+					x = v
+				}
+			}
+			vs[i] = x
+		}
+	case len(sels):
+		for _, sel := range sels {
+			n += zs[sel]
+		}
+	default:
+		for _, sel := range sels {
+			if nulls.Contains(nsp, uint64(sel)) {
+				n += zs[sel]
+			} else if v := col[sel]; (es != nil && es[i]) || v > vs[i] {
+				vs[i] = v
+				if es != nil {
+					es[i] = false
+				}
+			}
+		}
+	}
+	return n
+}
+
+// batchFill folds the row start+k of col into the max of the group vps[k]-1.
+func batchFill[T number](vs []T, es []bool, ns []int64, start int64, os []uint8, vps []uint64, zs []int64, col []T, nsp *nulls.Nulls) {
+	if !nulls.Any(nsp) {
+		for k := range os {
+			j := vps[k] - 1
+			if v := col[int64(k)+start]; (es != nil && es[j]) || v > vs[j] {
+				vs[j] = v
+				if es != nil {
+					es[j] = false
+				}
+			}
+		}
+		return
+	}
+	for k := range os {
+		j := vps[k] - 1
+		if nulls.Contains(nsp, uint64(start)+uint64(k)) {
+			ns[j] += zs[int64(k)+start]
+		} else if v := col[int64(k)+start]; (es != nil && es[j]) || v > vs[j] {
+			vs[j] = v
+			if es != nil {
+				es[j] = false
+			}
+		}
+	}
+}
+
+// maxOf returns the max of x and col without branches in the loop.
+func maxOf[T number](x T, es []bool, i int64, col []T) T {
+	if es != nil && es[i] {
+		x = col[0]
+		es[i] = false
+	}
+	for _, v := range col {
+		if v > x {
+			x = v
+		}
+	}
+	return x
+}
+
+func sumOf(zs []int64) int64 {
+	var n int64
+	for _, z := range zs {
+		n += z
+	}
+	return n
+}
@@ -141,48 +141,26 @@ func (r *Float64Ring) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r *Float64Ring) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
 	if v := vec.Col.([]float64)[sel]; r.Es[i] || v > r.Vs[i] {
 		r.Vs[i] = v
 		r.Es[i] = false
 	}
-	if nulls.Contains(vec.Nsp, uint64(sel)) {
-		r.Ns[i] += z
-	}
 }
 
 func (r *Float64Ring) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
-	vs := vec.Col.([]float64)
-	for i := range os {
-		j := vps[i] - 1
-		if r.Es[j] || vs[int64(i)+start] > r.Vs[j] {
-			r.Vs[j] = vs[int64(i)+start]
-			r.Es[j] = false
-		}
-	}
-	if nulls.Any(vec.Nsp) {
-		for i := range os {
-			if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
-				r.Ns[vps[i]-1] += zs[int64(i)+start]
-			}
-		}
-	}
+	batchFill(r.Vs, r.Es, r.Ns, start, os, vps, zs, vec.Col.([]float64), vec.Nsp)
 }
 
 func (r *Float64Ring) BulkFill(i int64, zs []int64, vec *vector.Vector) {
-	vs := vec.Col.([]float64)
-	for _, v := range vs {
-		if r.Es[i] || v > r.Vs[i] {
-			r.Vs[i] = v
-			r.Es[i] = false
-		}
-	}
-	if nulls.Any(vec.Nsp) {
-		for j := range vs {
-			if nulls.Contains(vec.Nsp, uint64(j)) {
-				r.Ns[i] += zs[j]
-			}
-		}
-	}
+	r.Ns[i] += bulkFill(r.Vs, r.Es, i, vec.Col.([]float64), zs, vec.Nsp)
+}
+
+func (r *Float64Ring) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	r.Ns[i] += bulkFillSels(r.Vs, r.Es, i, sels, vec.Col.([]float64), zs, vec.Nsp)
 }
 
 func (r *Float64Ring) Add(a interface{}, x, y int64) {
@@ -134,45 +134,25 @@ func (r *Int16Ring) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r *Int16Ring) Fill(i int64, sel, z int64, vec *vector.Vector) {
-	if v := vec.Col.([]int16)[sel]; v > r.Vs[i] {
-		r.Vs[i] = v
-	}
 	if nulls.Contains(vec.Nsp, uint64(sel)) {
 		r.Ns[i] += z
+		return
+	}
+	if v := vec.Col.([]int16)[sel]; v > r.Vs[i] {
+		r.Vs[i] = v
 	}
 }
 
 func (r *Int16Ring) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
-	vs := vec.Col.([]int16)
-	for i := range os {
-		j := vps[i] - 1
-		if vs[int64(i)+start] > r.Vs[j] {
-			r.Vs[j] = vs[int64(i)+start]
-		}
-	}
-	if nulls.Any(vec.Nsp) {
-		for i := range os {
-			if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
-				r.Ns[vps[i]-1] += zs[int64(i)+start]
-			}
-		}
-	}
+	batchFill(r.Vs, nil, r.Ns, start, os, vps, zs, vec.Col.([]int16), vec.Nsp)
 }
 
 func (r *Int16Ring) BulkFill(i int64, zs []int64, vec *vector.Vector) {
-	vs := vec.Col.([]int16)
-	for _, v := range vs {
-		if v > r.Vs[i] {
-			r.Vs[i] = v
-		}
-	}
-	if nulls.Any(vec.Nsp) {
-		for j := range vs {
-			if nulls.Contains(vec.Nsp, uint64(j)) {
-				r.Ns[i] += zs[j]
-			}
-		}
-	}
+	r.Ns[i] += bulkFill(r.Vs, nil, i, vec.Col.([]int16), zs, vec.Nsp)
+}
+
+func (r *Int16Ring) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	r.Ns[i] += bulkFillSels(r.Vs, nil, i, sels, vec.Col.([]int16), zs, vec.Nsp)
 }
 
 func (r *Int16Ring) Add(a interface{}, x, y int64) {
@@ -12,6 +12,20 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 package max
 
 import (
@@ -32,20 +46,24 @@ func NewStr(typ types.Type) *StrRing {
 }
 
 func (r *StrRing) String() string {
-	return fmt.Sprintf("%v-%v", r.Vs, r.Ns)
+	vs := make([]string, r.Vs.Count())
+	for i := range vs {
+		vs[i] = string(r.Vs.Get(int64(i)))
+	}
+	return fmt.Sprintf("%v-%v", vs, r.Ns)
 }
 
 func (r *StrRing) Free(m *mheap.Mheap) {
-	r.Vs = nil
+	r.Vs.Free()
 	r.Ns = nil
 }
 
 func (r *StrRing) Count() int {
-	return len(r.Vs)
+	return r.Vs.Count()
 }
 
 func (r *StrRing) Size() int {
-	return 0
+	return r.Vs.Size()
 }
 
 func (r *StrRing) Dup() ring.Ring {
@@ -59,16 +77,15 @@ func (r *StrRing) Type() types.Type {
 }
 
 func (r *StrRing) SetLength(n int) {
-	r.Vs = r.Vs[:n]
+	r.Vs.SetLength(n)
 	r.Ns = r.Ns[:n]
 }
 
 func (r *StrRing) Shrink(sels []int64) {
 	for i, sel := range sels {
-		r.Vs[i] = r.Vs[sel]
 		r.Ns[i] = r.Ns[sel]
 	}
-	r.Vs = r.Vs[:len(sels)]
+	r.Vs.Shrink(sels)
 	r.Ns = r.Ns[:len(sels)]
 }
 
@@ -77,121 +94,97 @@ func (r *StrRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
 }
 
 func (r *StrRing) Grow(m *mheap.Mheap) error {
-	if r.Mp == nil {
-		r.Mp = m
-	}
-	if len(r.Vs) == 0 {
-		r.Ns = make([]int64, 0, 8)
-		r.Vs = make([][]byte, 0, 8)
-	}
-	r.Ns = append(r.Ns, 0)
-	r.Vs = append(r.Vs, make([]byte, 0, 4))
-	return nil
+	return r.Grows(1, m)
 }
 
 func (r *StrRing) Grows(size int, m *mheap.Mheap) error {
 	if r.Mp == nil {
 		r.Mp = m
 	}
-	if len(r.Vs) == 0 {
+	if len(r.Ns) == 0 {
 		r.Ns = make([]int64, 0, size)
-		r.Vs = make([][]byte, 0, size)
 	}
 	for i := 0; i < size; i++ {
 		r.Ns = append(r.Ns, 0)
-		r.Vs = append(r.Vs, make([]byte, 0, 4))
 	}
+	r.Vs.Grows(size)
 	return nil
 }
 
-func (r *StrRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
-	if v := vec.Col.(*types.Bytes).Get(sel); bytes.Compare(v, r.Vs[i]) > 0 {
-		r.Vs[i] = append(r.Vs[i][:0], v...)
+// fill copies v into the ring as the max of group i if it is larger, so
+// the ring doesn't refer to the vectors filled. The empty string is the
+// smallest, so a group starts from it.
+func (r *StrRing) fill(i int64, v []byte) {
+	if bytes.Compare(v, r.Vs.Get(i)) > 0 {
+		r.Vs.Set(i, v)
 	}
+}
+
+func (r *StrRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 	if nulls.Contains(vec.Nsp, uint64(sel)) {
 		r.Ns[i] += z
+		return
 	}
+	r.fill(i, vec.Col.(*types.Bytes).Get(sel))
 }
 
 func (r *StrRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
 	vs := vec.Col.(*types.Bytes)
 	for i := range os {
-		j := vps[i] - 1
-		if v := vs.Get(int64(i) + start); bytes.Compare(v, r.Vs[j]) > 0 {
-			r.Vs[j] = append(r.Vs[j][:0], v...)
-		}
-	}
-	if nulls.Any(vec.Nsp) {
-		for i := range os {
-			if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
-				r.Ns[vps[i]-1] += zs[int64(i)+start]
-			}
+		j := int64(vps[i] - 1)
+		if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+			r.Ns[j] += zs[int64(i)+start]
+		} else {
+			r.fill(j, vs.Get(int64(i)+start))
 		}
 	}
 }
 
 func (r *StrRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 	vs := vec.Col.(*types.Bytes)
+	// the max of the rows is copied only once
+	var x []byte
+	found := false
 	for j := range zs {
-		if v := vs.Get(int64(j)); bytes.Compare(v, r.Vs[i]) > 0 {
-			r.Vs[i] = append(r.Vs[i][:0], v...)
+		if nulls.Contains(vec.Nsp, uint64(j)) {
+			r.Ns[i] += zs[j]
+		} else if v := vs.Get(int64(j)); !found || bytes.Compare(v, x) > 0 {
+			x, found = v, true
 		}
 	}
-	if nulls.Any(vec.Nsp) {
-		for j := range zs {
-			if nulls.Contains(vec.Nsp, uint64(j)) {
-				r.Ns[i] += zs[j]
-			}
-		}
+	if found {
+		r.fill(i, x)
 	}
 }
 
 func (r *StrRing) Add(a interface{}, x, y int64) {
 	ar := a.(*StrRing)
-	if bytes.Compare(ar.Vs[y], r.Vs[x]) > 0 {
-		r.Vs[x] = ar.Vs[y]
-	}
+	r.fill(x, ar.Vs.Get(y))
 	r.Ns[x] += ar.Ns[y]
 }
 
 func (r *StrRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
 	ar := a.(*StrRing)
 	for i := range os {
-		j := vps[i] - 1
-		if bytes.Compare(ar.Vs[int64(i)+start], r.Vs[j]) > 0 {
-			r.Vs[j] = ar.Vs[int64(i)+start]
-		}
+		j := int64(vps[i] - 1)
+		r.fill(j, ar.Vs.Get(int64(i)+start))
 		r.Ns[j] += ar.Ns[int64(i)+start]
 	}
 }
 
 func (r *StrRing) Mul(a interface{}, x, y, z int64) {
 	ar := a.(*StrRing)
-	if bytes.Compare(ar.Vs[y], r.Vs[x]) > 0 {
-		r.Vs[x] = ar.Vs[y]
-	}
+	r.fill(x, ar.Vs.Get(y))
 	r.Ns[x] += ar.Ns[y] * z
 }
 
+// Eval returns the maxes as a fresh vector, whose data is allocated from the
+// heap of the ring
 func (r *StrRing) Eval(zs []int64) *vector.Vector {
-	var data []byte
-	var os, ns []uint32
-
-	defer func() {
-		r.Vs = nil
-		r.Ns = nil
-	}()
-	{
-		o := uint32(0)
-		for _, v := range r.Vs {
-			os = append(os, o)
-			data = append(data, v...)
-			o += uint32(len(v))
-			ns = append(ns, uint32(len(v)))
-		}
-		if err := r.Mp.Gm.Alloc(int64(cap(data))); err != nil {
-			return nil
-		}
+	defer r.Free(r.Mp)
+	col, data, err := r.Vs.Bytes(r.Mp)
+	if err != nil {
+		return nil
 	}
 	nsp := new(nulls.Nulls)
 	for i, z := range zs {
@@ -200,13 +193,10 @@ func (r *StrRing) Eval(zs []int64) *vector.Vector {
 		}
 	}
 	return &vector.Vector{
-		Nsp: nsp,
-		Or:  false,
-		Typ: r.Typ,
-		Col: &types.Bytes{
-			Offsets: os,
-			Lengths: ns,
-			Data:    data,
-		},
+		Nsp:  nsp,
+		Data: data,
+		Or:   false,
+		Typ:  r.Typ,
+		Col:  col,
 	}
 }
@@ -0,0 +1,94 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package max
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+var strType = types.Type{Oid: types.T_varchar, Size: 24}
+
+func newStrVector(vs []string, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(strType)
+	col := &types.Bytes{}
+	for _, v := range vs {
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(v)))
+		col.Data = append(col.Data, v...)
+	}
+	vec.Col = col
+	nulls.Add(vec.Nsp, nullRows...)
+	return vec
+}
+
+// recycle overwrites the data of a vector as if it was recycled to the pool
+// and filled again
+func recycle(vec *vector.Vector) {
+	data := vec.Col.(*types.Bytes).Data
+	for i := range data {
+		data[i] = '~'
+	}
+}
+
+func TestStrRecycled(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	r := NewStr(strType)
+	require.NoError(t, r.Grows(3, m))
+
+	// the values of the null rows are skipped
+	vec := newStrVector([]string{"fig", "zzz", "pear"}, 1)
+	r.BulkFill(0, []int64{1, 1, 1}, vec)
+	recycle(vec)
+	vec = newStrVector([]string{"apple", "kiwi", "zzz"}, 2)
+	r.BatchFill(0, make([]uint8, 3), []uint64{2, 2, 1}, []int64{1, 1, 1}, vec)
+	recycle(vec)
+
+	// the values merged are copied, so refilling the ring merged doesn't
+	// change them
+	ar := NewStr(strType)
+	require.NoError(t, ar.Grows(1, m))
+	vec = newStrVector([]string{"mango"})
+	ar.Fill(0, 0, 1, vec)
+	recycle(vec)
+	r.Add(ar, 1, 0)
+	r.Mul(ar, 2, 0, 3)
+	vec = newStrVector([]string{"plum"})
+	ar.Fill(0, 0, 1, vec)
+	recycle(vec)
+	ar.Free(m)
+	// a larger value set in place doesn't change the others merged
+	vec = newStrVector([]string{"melon"})
+	r.Fill(2, 0, 1, vec)
+	recycle(vec)
+
+	require.Equal(t, []int64{2, 0, 0}, r.Ns)
+	res := r.Eval([]int64{4, 3, 4})
+	col := res.Col.(*types.Bytes)
+	for i, want := range []string{"pear", "mango", "melon"} {
+		require.Equal(t, want, string(col.Get(int64(i))))
+	}
+	require.False(t, nulls.Any(res.Nsp))
+	require.Equal(t, int64(cap(res.Data)), mheap.Size(m))
+	mheap.Free(m, res.Data)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
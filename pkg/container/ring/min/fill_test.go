@@ -0,0 +1,135 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package min
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+const BenchmarkRows = 8192
+
+func newTestMheap() *mheap.Mheap {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return mheap.New(gm)
+}
+
+func newInt64Vector(vs []int64, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = vs
+	nulls.Add(vec.Nsp, nullRows...)
+	return vec
+}
+
+func ones(n int) []int64 {
+	zs := make([]int64, n)
+	for i := range zs {
+		zs[i] = 1
+	}
+	return zs
+}
+
+func TestBulkFill(t *testing.T) {
+	m := newTestMheap()
+	r := NewInt64(types.Type{Oid: types.T_int64})
+	require.NoError(t, r.Grows(3, m))
+
+	// no nulls
+	r.BulkFill(0, ones(4), newInt64Vector([]int64{5, 3, 9, 4}))
+	// all nulls
+	r.BulkFill(1, ones(3), newInt64Vector([]int64{0, 0, 0}, 0, 1, 2))
+	// some nulls, the values of the null rows are skipped
+	r.BulkFill(2, ones(4), newInt64Vector([]int64{7, 0, 8, -1}, 1, 3))
+	require.Equal(t, []int64{3, 3, 2}, []int64{r.Vs[0], r.Ns[1], r.Ns[2]})
+	require.Equal(t, int64(7), r.Vs[2])
+
+	vec := r.Eval([]int64{4, 3, 4})
+	require.Equal(t, int64(3), vec.Col.([]int64)[0])
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	require.False(t, nulls.Contains(vec.Nsp, 2))
+}
+
+func TestBulkFillSels(t *testing.T) {
+	m := newTestMheap()
+	r := NewInt64(types.Type{Oid: types.T_int64})
+	require.NoError(t, r.Grows(3, m))
+	vec := newInt64Vector([]int64{0, 6, 0, 2, 5}, 0, 2)
+	zs := []int64{1, 1, 2, 1, 3}
+
+	// the group is not null only because the null rows are not selected
+	ring.BulkFillSels(r, 0, []int64{1, 4}, zs, vec)
+	// only the null rows are selected
+	ring.BulkFillSels(r, 1, []int64{0, 2}, zs, vec)
+	// null rows and values, the value of the null rows are skipped
+	ring.BulkFillSels(r, 2, []int64{0, 3, 4}, zs, vec)
+
+	require.Equal(t, []int64{5, 2}, []int64{r.Vs[0], r.Vs[2]})
+	require.Equal(t, []int64{0, 3, 1}, r.Ns)
+	res := r.Eval([]int64{4, 3, 5})
+	require.False(t, nulls.Contains(res.Nsp, 0))
+	require.True(t, nulls.Contains(res.Nsp, 1))
+	require.False(t, nulls.Contains(res.Nsp, 2))
+}
+
+func TestBatchFill(t *testing.T) {
+	m := newTestMheap()
+	r := NewFloat64(types.Type{Oid: types.T_float64})
+	require.NoError(t, r.Grows(2, m))
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	vec.Col = []float64{1.5, -3, 2, 0}
+	r.BatchFill(0, make([]uint8, 4), []uint64{1, 2, 1, 2}, ones(4), vec)
+	require.Equal(t, []float64{1.5, -3}, r.Vs)
+
+	nulls.Add(vec.Nsp, 1)
+	vec.Col = []float64{1, -9, 2, -4}
+	r.BatchFill(0, make([]uint8, 4), []uint64{1, 2, 1, 2}, ones(4), vec)
+	require.Equal(t, []float64{1, -4}, r.Vs)
+	require.Equal(t, []int64{0, 1}, r.Ns)
+}
+
+func BenchmarkBulkFill(b *testing.B) {
+	vs := make([]int64, BenchmarkRows)
+	for i := range vs {
+		vs[i] = int64(BenchmarkRows - i)
+	}
+	zs := ones(BenchmarkRows)
+	allNulls := newInt64Vector(vs)
+	allNulls.Nsp.Np.AddRange(0, BenchmarkRows)
+	m := newTestMheap()
+	for name, vec := range map[string]*vector.Vector{
+		"no nulls":  newInt64Vector(vs),
+		"all nulls": allNulls,
+		"one null":  newInt64Vector(vs, BenchmarkRows/2),
+	} {
+		b.Run(name, func(b *testing.B) {
+			r := NewInt64(types.Type{Oid: types.T_int64})
+			if err := r.Grow(m); err != nil {
+				b.Fatal(err)
+			}
+			for i := 0; i < b.N; i++ {
+				r.BulkFill(0, zs, vec)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package min
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/stretchr/testify/require"
+)
+
+var strType = types.Type{Oid: types.T_varchar, Size: 24}
+
+func newStrVector(vs []string, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(strType)
+	col := &types.Bytes{}
+	for _, v := range vs {
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(v)))
+		col.Data = append(col.Data, v...)
+	}
+	vec.Col = col
+	nulls.Add(vec.Nsp, nullRows...)
+	return vec
+}
+
+// recycle overwrites the data of a vector as if it was recycled to the pool
+// and filled again
+func recycle(vec *vector.Vector) {
+	data := vec.Col.(*types.Bytes).Data
+	for i := range data {
+		data[i] = '~'
+	}
+}
+
+func TestStrRecycled(t *testing.T) {
+	m := newTestMheap()
+	r := NewStr(strType)
+	require.NoError(t, r.Grows(4, m))
+
+	vec := newStrVector([]string{"pear", "apple", "fig", "", "kiwi"}, 3)
+	r.BulkFill(0, ones(5), vec)
+	recycle(vec)
+	vec = newStrVector([]string{"cherry", "banana", ""}, 2)
+	for i := int64(0); i < 3; i++ {
+		r.Fill(1, i, 1, vec)
+	}
+	recycle(vec)
+	vec = newStrVector([]string{"plum", "grape", "", "lime"}, 2)
+	r.BatchFill(0, make([]uint8, 4), []uint64{3, 3, 2, 1}, ones(4), vec)
+	recycle(vec)
+
+	// the values merged are copied, so refilling the ring merged doesn't
+	// change them
+	ar := NewStr(strType)
+	require.NoError(t, ar.Grows(2, m))
+	vec = newStrVector([]string{"melon"})
+	ar.BulkFill(0, ones(1), vec)
+	recycle(vec)
+	r.Add(ar, 2, 0)
+	r.Mul(ar, 3, 0, 2)
+	r.BatchAdd(ar, 1, []uint8{0}, []uint64{1})
+	vec = newStrVector([]string{"date"})
+	ar.Fill(0, 0, 1, vec)
+	recycle(vec)
+	ar.Free(m)
+	// a smaller value set in place doesn't change the others merged
+	vec = newStrVector([]string{"lemon"})
+	r.Fill(3, 0, 1, vec)
+	recycle(vec)
+
+	require.Equal(t, []int64{1, 2, 0, 0}, r.Ns)
+	res := r.Eval([]int64{6, 4, 2, 2})
+	require.Equal(t, int64(mheap.Size(m)), int64(cap(res.Data)))
+	col := res.Col.(*types.Bytes)
+	for i, want := range []string{"apple", "banana", "grape", "lemon"} {
+		require.Equal(t, want, string(col.Get(int64(i))))
+	}
+	require.False(t, nulls.Any(res.Nsp))
+	mheap.Free(m, res.Data)
+	require.Equal(t, int64(0), mheap.Size(m))
+
+	// a group of nulls or without rows is null, an empty group merged
+	// doesn't make an empty min
+	r = NewStr(strType)
+	require.NoError(t, r.Grows(2, m))
+	vec = newStrVector([]string{"x", "y"}, 0, 1)
+	r.BulkFill(0, ones(2), vec)
+	ar = NewStr(strType)
+	require.NoError(t, ar.Grows(1, m))
+	vec = newStrVector([]string{"b"})
+	r.Fill(1, 0, 1, vec)
+	r.Add(ar, 1, 0)
+	res = r.Eval([]int64{2, 1})
+	require.True(t, nulls.Contains(res.Nsp, 0))
+	require.Equal(t, "b", string(res.Col.(*types.Bytes).Get(1)))
+}
+
+func TestStrNotPinned(t *testing.T) {
+	const batchSize = 1 << 20
+	m := newTestMheap()
+	r := NewStr(strType)
+	require.NoError(t, r.Grows(1, m))
+	var collected int32
+	for i := 0; i < 16; i++ {
+		// the min of each batch is a large value smaller than the min
+		// before, so it replaces the min of the ring
+		data := make([]byte, batchSize)
+		data[0] = byte('z' - i)
+		runtime.SetFinalizer(&data[0], func(*byte) {
+			atomic.AddInt32(&collected, 1)
+		})
+		vec := vector.New(strType)
+		vec.Col = &types.Bytes{Data: data, Offsets: []uint32{0}, Lengths: []uint32{batchSize}}
+		r.BulkFill(0, ones(1), vec)
+	}
+	// the ring holds a copy of the min only, and none of the batches
+	require.LessOrEqual(t, r.Size(), 2*batchSize)
+	require.Eventually(t, func() bool {
+		runtime.GC()
+		return atomic.LoadInt32(&collected) == 16
+	}, 5*time.Second, 10*time.Millisecond)
+	res := r.Eval([]int64{16})
+	require.Equal(t, byte('z'-15), res.Col.(*types.Bytes).Get(0)[0])
+	mheap.Free(m, res.Data)
+}
@@ -15,6 +15,7 @@
 package min
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
@@ -99,7 +100,7 @@ type Float64Ring struct {
 type StrRing struct {
 	Es  []bool // isEmpty
 	Ns  []int64
-	Vs  [][]byte
+	Vs  ring.StrArena
 	Typ types.Type
 	Mp  *mheap.Mheap
 }
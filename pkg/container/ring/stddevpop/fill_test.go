@@ -0,0 +1,76 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stddevpop
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMheap() *mheap.Mheap {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return mheap.New(gm)
+}
+
+func ones(n int) []int64 {
+	zs := make([]int64, n)
+	for i := range zs {
+		zs[i] = 1
+	}
+	return zs
+}
+
+func TestFillNulls(t *testing.T) {
+	m := newTestMheap()
+	r := NewStdDevPopRing(types.Type{Oid: types.T_float64})
+	require.NoError(t, r.Grows(2, m))
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	vec.Col = []float64{2, 4, -50, 4, 4, 5, 5, 7, 9, 1e300}
+	nulls.Add(vec.Nsp, 2, 9)
+
+	// group 1 has only the null row 9
+	r.BatchFill(0, make([]uint8, 10), []uint64{1, 1, 1, 1, 1, 1, 1, 1, 1, 2}, ones(10), vec)
+	res := r.Eval([]int64{9, 1})
+	require.Equal(t, 2.0, res.Col.([]float64)[0])
+	require.False(t, nulls.Contains(res.Nsp, 0))
+	require.True(t, nulls.Contains(res.Nsp, 1))
+}
+
+func TestMergeSameValues(t *testing.T) {
+	m := newTestMheap()
+	// the variance of the same values is zero and must not turn negative by
+	// the rounding of the sums, whose square root is NaN
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	vec.Col = []float64{0.1 + 1e8, 0.1 + 1e8, 0.1 + 1e8}
+	r1 := NewStdDevPopRing(types.Type{Oid: types.T_float64})
+	require.NoError(t, r1.Grow(m))
+	r1.BulkFill(0, ones(3), vec)
+	r2 := r1.Dup().(*StdDevPopRing)
+	require.NoError(t, r2.Grow(m))
+	r2.Fill(0, 1, 5, vec)
+	r1.Add(r2, 0, 0)
+	got := r1.Eval([]int64{8}).Col.([]float64)[0]
+	require.False(t, math.IsNaN(got))
+	require.InDelta(t, 0, got, 1e-3)
+}
@@ -47,6 +47,8 @@ func NewStdDevPopRingWithTypeCheck(typ types.Type) (*StdDevPopRing, error) {
 		return NewStdDevPopRing(typ), nil
 	case types.T_float32, types.T_float64:
 		return NewStdDevPopRing(typ), nil
+	case types.T_decimal64, types.T_decimal128:
+		return NewStdDevPopRing(typ), nil
 	}
 	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support Var", typ))
 }
@@ -107,7 +109,7 @@ func (v *StdDevPopRing) Grow(m *mheap.Mheap) error {
 		}
 		mheap.Free(m, v.Data)
 		v.Data = data
-		v.SumX2 = encoding.DecodeFloat64Slice(data)
+		v.SumX = encoding.DecodeFloat64Slice(data)
 	}
 
 	v.SumX = v.SumX[:n+1]
@@ -184,10 +186,12 @@ func (v *StdDevPopRing) Eval(zs []int64) *vector.Vector {
 		if n := z - v.NullCounts[i]; n == 0 {
 			nulls.Add(nsp, uint64(i))
 		} else {
-			v.SumX[i] /= float64(n)  // compute E(x)
-			v.SumX2[i] /= float64(n) // compute E(x^2)
-
-			variance := v.SumX2[i] - math.Pow(v.SumX[i], 2)
+			// (sum(x^2) - sum(x)^2 / n) / n, the rounding of the sums may
+			// make a zero variance a little negative
+			variance := (v.SumX2[i] - v.SumX[i]*v.SumX[i]/float64(n)) / float64(n)
+			if variance < 0 {
+				variance = 0
+			}
 
 			v.SumX[i] = math.Sqrt(variance) // using v.SumX to record the result and return.
 		}
@@ -204,6 +208,10 @@ func (v *StdDevPopRing) Eval(zs []int64) *vector.Vector {
 }
 
 func (v *StdDevPopRing) Fill(i, j int64, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(j)) {
+		v.NullCounts[i] += z
+		return
+	}
 	var value float64 = 0
 	switch vec.Typ.Oid {
 	case types.T_int8:
@@ -226,14 +234,14 @@ func (v *StdDevPopRing) Fill(i, j int64, z int64, vec *vector.Vector) {
 		value = float64(vec.Col.([]float32)[j])
 	case types.T_float64:
 		value = vec.Col.([]float64)[j]
+	case types.T_decimal64:
+		value = types.Decimal64ToFloat64(vec.Col.([]types.Decimal64)[j], vec.Typ.Scale)
+	case types.T_decimal128:
+		value = types.Decimal128ToFloat64(vec.Col.([]types.Decimal128)[j], vec.Typ.Scale)
 	}
 
 	v.SumX[i] += value * float64(z)
 	v.SumX2[i] += math.Pow(value, 2) * float64(z)
-
-	if nulls.Contains(vec.Nsp, uint64(z)) {
-		v.NullCounts[i] += z
-	}
 }
 
 func (v *StdDevPopRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
@@ -408,73 +416,146 @@ func (v *StdDevPopRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 				v.SumX2[i] += math.Pow(value, 2) * float64(zs[j])
 			}
 		}
+	case types.T_decimal64:
+		values := vec.Col.([]types.Decimal64)
+		for j, d := range values {
+			if nulls.Contains(vec.Nsp, uint64(j)) {
+				v.NullCounts[i] += zs[j]
+				continue
+			}
+			value := types.Decimal64ToFloat64(d, vec.Typ.Scale)
+			v.SumX[i] += value * float64(zs[j])
+			v.SumX2[i] += math.Pow(value, 2) * float64(zs[j])
+		}
+	case types.T_decimal128:
+		values := vec.Col.([]types.Decimal128)
+		for j, d := range values {
+			if nulls.Contains(vec.Nsp, uint64(j)) {
+				v.NullCounts[i] += zs[j]
+				continue
+			}
+			value := types.Decimal128ToFloat64(d, vec.Typ.Scale)
+			v.SumX[i] += value * float64(zs[j])
+			v.SumX2[i] += math.Pow(value, 2) * float64(zs[j])
+		}
 	}
 }
 
 func (v *StdDevPopRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	hasNull := nulls.Any(vec.Nsp)
 	switch vec.Typ.Oid {
 	case types.T_int8:
 		vs := vec.Col.([]int8)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_int16:
 		vs := vec.Col.([]int16)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_int32:
 		vs := vec.Col.([]int32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_int64:
 		vs := vec.Col.([]int64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_uint8:
 		vs := vec.Col.([]uint8)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_uint16:
 		vs := vec.Col.([]uint16)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_uint32:
 		vs := vec.Col.([]uint32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_uint64:
 		vs := vec.Col.([]uint64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_float32:
 		vs := vec.Col.([]float32)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(float64(vs[int64(i)+start]), 2) * float64(zs[int64(i)+start])
 		}
 	case types.T_float64:
 		vs := vec.Col.([]float64)
 		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
 			v.SumX[vps[i]-1] += vs[int64(i)+start] * float64(zs[int64(i)+start])
 			v.SumX2[vps[i]-1] += math.Pow(vs[int64(i)+start], 2) * float64(zs[int64(i)+start])
 		}
+	case types.T_decimal64:
+		vs := vec.Col.([]types.Decimal64)
+		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
+			value := types.Decimal64ToFloat64(vs[int64(i)+start], vec.Typ.Scale)
+			v.SumX[vps[i]-1] += value * float64(zs[int64(i)+start])
+			v.SumX2[vps[i]-1] += math.Pow(value, 2) * float64(zs[int64(i)+start])
+		}
+	case types.T_decimal128:
+		vs := vec.Col.([]types.Decimal128)
+		for i := range os {
+			if hasNull && nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				continue
+			}
+			value := types.Decimal128ToFloat64(vs[int64(i)+start], vec.Typ.Scale)
+			v.SumX[vps[i]-1] += value * float64(zs[int64(i)+start])
+			v.SumX2[vps[i]-1] += math.Pow(value, 2) * float64(zs[int64(i)+start])
+		}
 	}
-	if nulls.Any(vec.Nsp) {
+	if hasNull {
 		for i := range os {
 			if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
 				v.NullCounts[vps[i]-1] += zs[int64(i)+start]
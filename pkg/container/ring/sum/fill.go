@@ -0,0 +1,77 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sum
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"golang.org/x/exp/constraints"
+)
+
+type number interface {
+	constraints.Integer | constraints.Float
+}
+
+// bulkFill returns the sum of the rows of col times their count numbers
+// as R, and the count number of the null rows.
+func bulkFill[T, R number](col []T, zs []int64, nsp *nulls.Nulls) (R, int64) {
+	var sum R
+	var n int64
+
+	switch nulls.Length(nsp) {
+	case 0:
+		for j, v := range col {
+			sum += R(v) * R(zs[j])
+		}
+	case len(col):
+		for _, z := range zs[:len(col)] {
+			n += z
+		}
+	default:
+		for j, v := range col {
+			if nulls.Contains(nsp, uint64(j)) {
+				n += zs[j]
+			} else {
+				sum += R(v) * R(zs[j])
+			}
+		}
+	}
+	return sum, n
+}
+
+// bulkFillSels is bulkFill of the rows sels of col.
+func bulkFillSels[T, R number](sels []int64, col []T, zs []int64, nsp *nulls.Nulls) (R, int64) {
+	var sum R
+	var n int64
+
+	switch nulls.FilterCount(nsp, sels) {
+	case 0:
+		for _, sel := range sels {
+			sum += R(col[sel]) * R(zs[sel])
+		}
+	case len(sels):
+		for _, sel := range sels {
+			n += zs[sel]
+		}
+	default:
+		for _, sel := range sels {
+			if nulls.Contains(nsp, uint64(sel)) {
+				n += zs[sel]
+			} else {
+				sum += R(col[sel]) * R(zs[sel])
+			}
+		}
+	}
+	return sum, n
+}
@@ -132,15 +132,16 @@ func (r *FloatRing) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r *FloatRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
 	switch vec.Typ.Oid {
 	case types.T_float32:
 		r.Vs[i] += float64(vec.Col.([]float32)[sel]) * float64(z)
 	case types.T_float64:
 		r.Vs[i] += float64(vec.Col.([]float64)[sel]) * float64(z)
 	}
-	if nulls.Contains(vec.Nsp, uint64(sel)) {
-		r.Ns[i] += z
-	}
 }
 
 func (r *FloatRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
@@ -166,32 +167,31 @@ func (r *FloatRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64,
 }
 
 func (r *FloatRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	var sum float64
+	var n int64
+
 	switch vec.Typ.Oid {
 	case types.T_float32:
-		vs := vec.Col.([]float32)
-		for j, v := range vs {
-			r.Vs[i] += float64(v) * float64(zs[j])
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[float32, float64](vec.Col.([]float32), zs, vec.Nsp)
 	case types.T_float64:
-		vs := vec.Col.([]float64)
-		for j, v := range vs {
-			r.Vs[i] += v * float64(zs[j])
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[float64, float64](vec.Col.([]float64), zs, vec.Nsp)
+	}
+	r.Vs[i] += sum
+	r.Ns[i] += n
+}
+
+func (r *FloatRing) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	var sum float64
+	var n int64
+
+	switch vec.Typ.Oid {
+	case types.T_float32:
+		sum, n = bulkFillSels[float32, float64](sels, vec.Col.([]float32), zs, vec.Nsp)
+	case types.T_float64:
+		sum, n = bulkFillSels[float64, float64](sels, vec.Col.([]float64), zs, vec.Nsp)
 	}
+	r.Vs[i] += sum
+	r.Ns[i] += n
 }
 
 func (r *FloatRing) Add(a interface{}, x, y int64) {
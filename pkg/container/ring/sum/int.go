@@ -132,6 +132,10 @@ func (r *IntRing) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r *IntRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
 	switch vec.Typ.Oid {
 	case types.T_int8:
 		r.Vs[i] += int64(vec.Col.([]int8)[sel]) * z
@@ -142,9 +146,6 @@ func (r *IntRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 	case types.T_int64:
 		r.Vs[i] += int64(vec.Col.([]int64)[sel]) * z
 	}
-	if nulls.Contains(vec.Nsp, uint64(sel)) {
-		r.Ns[i] += z
-	}
 }
 
 func (r *IntRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
@@ -180,56 +181,39 @@ func (r *IntRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, v
 }
 
 func (r *IntRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	var sum int64
+	var n int64
+
 	switch vec.Typ.Oid {
 	case types.T_int8:
-		vs := vec.Col.([]int8)
-		for j, v := range vs {
-			r.Vs[i] += int64(v) * zs[j]
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[int8, int64](vec.Col.([]int8), zs, vec.Nsp)
 	case types.T_int16:
-		vs := vec.Col.([]int16)
-		for j, v := range vs {
-			r.Vs[i] += int64(v) * zs[j]
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[int16, int64](vec.Col.([]int16), zs, vec.Nsp)
 	case types.T_int32:
-		vs := vec.Col.([]int32)
-		for j, v := range vs {
-			r.Vs[i] += int64(v) * zs[j]
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[int32, int64](vec.Col.([]int32), zs, vec.Nsp)
 	case types.T_int64:
-		vs := vec.Col.([]int64)
-		for j, v := range vs {
-			r.Vs[i] += int64(v) * zs[j]
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[int64, int64](vec.Col.([]int64), zs, vec.Nsp)
+	}
+	r.Vs[i] += sum
+	r.Ns[i] += n
+}
+
+func (r *IntRing) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	var sum int64
+	var n int64
+
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		sum, n = bulkFillSels[int8, int64](sels, vec.Col.([]int8), zs, vec.Nsp)
+	case types.T_int16:
+		sum, n = bulkFillSels[int16, int64](sels, vec.Col.([]int16), zs, vec.Nsp)
+	case types.T_int32:
+		sum, n = bulkFillSels[int32, int64](sels, vec.Col.([]int32), zs, vec.Nsp)
+	case types.T_int64:
+		sum, n = bulkFillSels[int64, int64](sels, vec.Col.([]int64), zs, vec.Nsp)
 	}
+	r.Vs[i] += sum
+	r.Ns[i] += n
 }
 
 // r[x] += a[y]
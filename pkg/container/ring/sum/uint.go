@@ -132,6 +132,10 @@ func (r *UIntRing) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r *UIntRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
 	switch vec.Typ.Oid {
 	case types.T_uint8:
 		r.Vs[i] += uint64(vec.Col.([]uint8)[sel]) * uint64(z)
@@ -142,9 +146,6 @@ func (r *UIntRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 	case types.T_uint64:
 		r.Vs[i] += uint64(vec.Col.([]uint64)[sel]) * uint64(z)
 	}
-	if nulls.Contains(vec.Nsp, uint64(sel)) {
-		r.Ns[i] += z
-	}
 }
 
 func (r *UIntRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
@@ -180,57 +181,39 @@ func (r *UIntRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64,
 }
 
 func (r *UIntRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	var sum uint64
+	var n int64
+
 	switch vec.Typ.Oid {
 	case types.T_uint8:
-		vs := vec.Col.([]uint8)
-		for j, v := range vs {
-			r.Vs[i] += uint64(v) * uint64(zs[j])
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[uint8, uint64](vec.Col.([]uint8), zs, vec.Nsp)
 	case types.T_uint16:
-		vs := vec.Col.([]uint16)
-		for j, v := range vs {
-			r.Vs[i] += uint64(v) * uint64(zs[j])
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[uint16, uint64](vec.Col.([]uint16), zs, vec.Nsp)
 	case types.T_uint32:
-		vs := vec.Col.([]uint32)
-		for j, v := range vs {
-			r.Vs[i] += uint64(v) * uint64(zs[j])
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[uint32, uint64](vec.Col.([]uint32), zs, vec.Nsp)
 	case types.T_uint64:
-		vs := vec.Col.([]uint64)
-		for j, v := range vs {
-			r.Vs[i] += uint64(v) * uint64(zs[j])
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
-		}
+		sum, n = bulkFill[uint64, uint64](vec.Col.([]uint64), zs, vec.Nsp)
+	}
+	r.Vs[i] += sum
+	r.Ns[i] += n
+}
+
+func (r *UIntRing) BulkFillSels(i int64, sels []int64, zs []int64, vec *vector.Vector) {
+	var sum uint64
+	var n int64
 
+	switch vec.Typ.Oid {
+	case types.T_uint8:
+		sum, n = bulkFillSels[uint8, uint64](sels, vec.Col.([]uint8), zs, vec.Nsp)
+	case types.T_uint16:
+		sum, n = bulkFillSels[uint16, uint64](sels, vec.Col.([]uint16), zs, vec.Nsp)
+	case types.T_uint32:
+		sum, n = bulkFillSels[uint32, uint64](sels, vec.Col.([]uint32), zs, vec.Nsp)
+	case types.T_uint64:
+		sum, n = bulkFillSels[uint64, uint64](sels, vec.Col.([]uint64), zs, vec.Nsp)
 	}
+	r.Vs[i] += sum
+	r.Ns[i] += n
 }
 
 func (r *UIntRing) Add(a interface{}, x, y int64) {
@@ -109,3 +109,25 @@ type Ring interface {
 	// Mul is the function to merge 2 rings when join
 	Mul(interface{}, int64, int64, int64)
 }
+
+// SelsRing is a Ring which can fill a group with the rows of a vector picked
+// by a selection vector, so the filtered rows needn't be compacted first.
+type SelsRing interface {
+	Ring
+
+	// BulkFillSels is BulkFill with the rows sels of the vector only,
+	// zs[sel] is the count number of the row sel.
+	BulkFillSels(i int64, sels []int64, zs []int64, v *vector.Vector)
+}
+
+// BulkFillSels fills the group i of r with the rows sels of v, the rows are
+// filled one by one if r is not a SelsRing.
+func BulkFillSels(r Ring, i int64, sels []int64, zs []int64, v *vector.Vector) {
+	if sr, ok := r.(SelsRing); ok {
+		sr.BulkFillSels(i, sels, zs, v)
+		return
+	}
+	for _, sel := range sels {
+		r.Fill(i, sel, zs[sel], v)
+	}
+}
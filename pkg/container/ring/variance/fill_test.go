@@ -0,0 +1,158 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMheap() *mheap.Mheap {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return mheap.New(gm)
+}
+
+func newInt64Vector(vs []int64, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = vs
+	nulls.Add(vec.Nsp, nullRows...)
+	return vec
+}
+
+func ones(n int) []int64 {
+	zs := make([]int64, n)
+	for i := range zs {
+		zs[i] = 1
+	}
+	return zs
+}
+
+func TestBulkFill(t *testing.T) {
+	m := newTestMheap()
+	r := NewVarianceRing(types.Type{Oid: types.T_int64})
+	require.NoError(t, r.Grows(3, m))
+
+	// no nulls
+	r.BulkFill(0, ones(4), newInt64Vector([]int64{1, 2, 3, 4}))
+	// all nulls
+	r.BulkFill(1, ones(2), newInt64Vector([]int64{5, 9}, 0, 1))
+	// some nulls, the values of the null rows are skipped
+	r.BulkFill(2, ones(4), newInt64Vector([]int64{2, 100, 4, -7}, 1, 3))
+
+	vec := r.Eval([]int64{4, 2, 4})
+	require.Equal(t, types.T_float64, vec.Typ.Oid)
+	require.Equal(t, 1.25, vec.Col.([]float64)[0])
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	require.False(t, nulls.Contains(vec.Nsp, 2))
+	require.Equal(t, 1.0, vec.Col.([]float64)[2])
+}
+
+func TestFill(t *testing.T) {
+	m := newTestMheap()
+	r := NewVarianceRing(types.Type{Oid: types.T_int64})
+	// more groups than the first allocation holds
+	for i := 0; i < 20; i++ {
+		require.NoError(t, r.Grow(m))
+	}
+	vec := newInt64Vector([]int64{1, 1000, 3}, 1)
+	r.Fill(0, 0, 1, vec)
+	r.Fill(0, 1, 2, vec)
+	r.Fill(0, 2, 1, vec)
+	r.Fill(19, 1, 1, vec)
+	require.Equal(t, int64(2), r.NullCounts[0])
+
+	zs := make([]int64, 20)
+	zs[0], zs[19] = 4, 1
+	res := r.Eval(zs)
+	require.Equal(t, 1.0, res.Col.([]float64)[0])
+	require.False(t, nulls.Contains(res.Nsp, 0))
+	require.True(t, nulls.Contains(res.Nsp, 19))
+}
+
+func TestDecimal(t *testing.T) {
+	m := newTestMheap()
+	typ := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2}
+	r, err := NewVarianceRingWithTypeCheck(typ)
+	require.NoError(t, err)
+	require.NoError(t, r.Grow(m))
+	vec := vector.New(typ)
+	// 1.50, 2.50, null
+	vec.Col = []types.Decimal64{150, 250, 7}
+	nulls.Add(vec.Nsp, 2)
+	r.BulkFill(0, ones(3), vec)
+	require.Equal(t, 0.25, r.Eval([]int64{3}).Col.([]float64)[0])
+}
+
+// TestLargeInput compares the variance of partial rings merged as in a
+// parallel group by with the exact variance
+func TestLargeInput(t *testing.T) {
+	const rows, parts = 100000, 4
+	rd := rand.New(rand.NewSource(7))
+	vs := make([]int64, rows)
+	var nullRows []uint64
+	sum, sum2 := new(big.Int), new(big.Int)
+	n := int64(0)
+	for i := range vs {
+		vs[i] = 1e9 + rd.Int63n(2e6) - 1e6
+		if i%10 == 0 {
+			nullRows = append(nullRows, uint64(i))
+			continue
+		}
+		x := big.NewInt(vs[i])
+		sum.Add(sum, x)
+		sum2.Add(sum2, x.Mul(x, x))
+		n++
+	}
+	// (n * sum(x^2) - sum(x)^2) / n^2
+	exact := new(big.Rat).SetFrac(
+		new(big.Int).Sub(new(big.Int).Mul(big.NewInt(n), sum2), new(big.Int).Mul(sum, sum)),
+		new(big.Int).Mul(big.NewInt(n), big.NewInt(n)))
+	want, _ := exact.Float64()
+
+	m := newTestMheap()
+	vec := newInt64Vector(vs, nullRows...)
+	zs := ones(rows)
+	r := NewVarianceRing(types.Type{Oid: types.T_int64})
+	require.NoError(t, r.Grow(m))
+	for p := 0; p < parts; p++ {
+		part := r.Dup().(*VarRing)
+		require.NoError(t, part.Grow(m))
+		for start := p * rows / parts; start < (p+1)*rows/parts; start += 250 {
+			part.BatchFill(int64(start), make([]uint8, 250), firstGroup(250), zs, vec)
+		}
+		r.Add(part, 0, 0)
+	}
+	got := r.Eval([]int64{rows}).Col.([]float64)[0]
+	require.InEpsilon(t, want, got, 1e-6)
+}
+
+// firstGroup returns the vps of n rows of the first group
+func firstGroup(n int) []uint64 {
+	vps := make([]uint64, n)
+	for i := range vps {
+		vps[i] = 1
+	}
+	return vps
+}
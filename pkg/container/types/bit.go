@@ -0,0 +1,60 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// bit data type:
+// BIT(n) values are strings of n bits, 1 <= n <= 64, as in mysql.
+//
+// Internal representation:
+// bit values are represented using a uint64 whose low n bits are the bits of
+// the value, the width n is the Width of the type. So bit values compare and
+// order as unsigned integers.
+
+package types
+
+// MaxBitWidth is the largest width of a BIT(n) column
+const MaxBitWidth = 64
+
+// BitFits reports whether v fits in a BIT(width)
+func BitFits(v uint64, width int32) bool {
+	return width >= MaxBitWidth || v>>uint(width) == 0
+}
+
+// BitBytes returns v of a BIT(width) as a binary string of (width+7)/8 bytes,
+// the most significant byte first
+func BitBytes(v uint64, width int32) []byte {
+	if width <= 0 {
+		width = 1
+	}
+	bs := make([]byte, (width+7)/8)
+	for i := len(bs) - 1; i >= 0; i-- {
+		bs[i] = byte(v)
+		v >>= 8
+	}
+	return bs
+}
+
+// ParseBitBytes returns the number of a binary string, the most significant
+// byte first, ok is false if the number has more than 64 bits
+func ParseBitBytes(bs []byte) (v uint64, ok bool) {
+	for len(bs) > 0 && bs[0] == 0 {
+		bs = bs[1:]
+	}
+	if len(bs) > 8 {
+		return 0, false
+	}
+	for _, b := range bs {
+		v = v<<8 | uint64(b)
+	}
+	return v, true
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Collations supported by char and varchar. The collation of a string type is
+// carried in Type.Precision, which is otherwise unused by string types, so it
+// is persisted together with the rest of the column type.
+const (
+	// CollationBin compares strings byte by byte, it is the default.
+	CollationBin int32 = iota
+	// CollationGeneralCI compares strings case-insensitively and ignores
+	// trailing spaces.
+	CollationGeneralCI
+)
+
+var collationNames = map[string]int32{
+	"binary":             CollationBin,
+	"utf8_bin":           CollationBin,
+	"utf8mb4_bin":        CollationBin,
+	"utf8_general_ci":    CollationGeneralCI,
+	"utf8mb4_general_ci": CollationGeneralCI,
+}
+
+// ParseCollation returns the collation id of name.
+func ParseCollation(name string) (int32, bool) {
+	c, ok := collationNames[strings.ToLower(name)]
+	return c, ok
+}
+
+// CollationName returns the canonical name of the collation c.
+func CollationName(c int32) string {
+	if c == CollationGeneralCI {
+		return "utf8mb4_general_ci"
+	}
+	return "utf8mb4_bin"
+}
+
+// HasCollation reports whether values of type t carry a collation.
+func (t Type) HasCollation() bool {
+	return t.Oid == T_char || t.Oid == T_varchar
+}
+
+// Collation returns the collation of t, types other than char and varchar
+// always compare as binary.
+func (t Type) Collation() int32 {
+	if t.HasCollation() {
+		return t.Precision
+	}
+	return CollationBin
+}
+
+// CompareGeneralCI compares a and b under utf8mb4_general_ci. Runes are folded
+// while scanning, so no folded copy of either string is built.
+func CompareGeneralCI(a, b []byte) int {
+	a, b = trimTrailingSpace(a), trimTrailingSpace(b)
+	for len(a) > 0 && len(b) > 0 {
+		ra, na := FoldGeneralCIRune(a)
+		rb, nb := FoldGeneralCIRune(b)
+		if ra != rb {
+			if ra < rb {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[na:], b[nb:]
+	}
+	switch {
+	case len(a) > 0:
+		return 1
+	case len(b) > 0:
+		return -1
+	}
+	return 0
+}
+
+// FoldGeneralCI appends the utf8mb4_general_ci weight string of src to dst.
+// Two strings are equal under the collation iff their weight strings are byte
+// equal, and weight strings sort in collation order, which makes them usable
+// as hash and sort keys.
+func FoldGeneralCI(dst, src []byte) []byte {
+	src = trimTrailingSpace(src)
+	for len(src) > 0 {
+		r, n := FoldGeneralCIRune(src)
+		if r > unicode.MaxRune {
+			// 0xff never occurs in utf8, so invalid bytes sort last
+			dst = append(dst, 0xff, src[0])
+		} else {
+			dst = utf8.AppendRune(dst, r)
+		}
+		src = src[n:]
+	}
+	return dst
+}
+
+// FoldGeneralCIRune decodes the first rune of s and maps it to its upper case.
+// A byte which is not valid utf8 is mapped past unicode.MaxRune, so it sorts
+// after every valid rune.
+func FoldGeneralCIRune(s []byte) (rune, int) {
+	if c := s[0]; c < utf8.RuneSelf {
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		return rune(c), 1
+	}
+	r, n := utf8.DecodeRune(s)
+	if r == utf8.RuneError && n == 1 {
+		return unicode.MaxRune + 1 + rune(s[0]), 1
+	}
+	return unicode.ToUpper(r), n
+}
+
+func trimTrailingSpace(s []byte) []byte {
+	for len(s) > 0 && s[len(s)-1] == ' ' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCollation(t *testing.T) {
+	c, ok := ParseCollation("UTF8MB4_General_CI")
+	require.True(t, ok)
+	require.Equal(t, CollationGeneralCI, c)
+	require.Equal(t, "utf8mb4_general_ci", CollationName(c))
+	c, ok = ParseCollation("utf8mb4_bin")
+	require.True(t, ok)
+	require.Equal(t, CollationBin, c)
+	_, ok = ParseCollation("latin1_swedish_ci")
+	require.False(t, ok)
+
+	require.Equal(t, CollationGeneralCI, Type{Oid: T_varchar, Precision: CollationGeneralCI}.Collation())
+	require.Equal(t, CollationBin, Type{Oid: T_timestamp, Precision: 6}.Collation())
+}
+
+func TestCompareGeneralCI(t *testing.T) {
+	kases := []struct {
+		a, b string
+		r    int
+	}{
+		{"abc", "ABC", 0},
+		{"abc ", "ABC", 0},
+		{"Straße", "STRASSE", 1},
+		{"émile", "ÉMILE", 0},
+		{"apple", "Banana", -1},
+		{"b", "A", 1},
+		{"ab", "abc", -1},
+		{"", "  ", 0},
+		{"a\xff", "A\xff", 0},
+		{"a\xff", "a\xfe", 1},
+		{"a\xff", "aé", 1},
+	}
+	for _, k := range kases {
+		require.Equal(t, k.r, CompareGeneralCI([]byte(k.a), []byte(k.b)), "%q vs %q", k.a, k.b)
+		require.Equal(t, -k.r, CompareGeneralCI([]byte(k.b), []byte(k.a)), "%q vs %q", k.b, k.a)
+
+		// weight strings must agree with the comparison
+		wa, wb := FoldGeneralCI(nil, []byte(k.a)), FoldGeneralCI(nil, []byte(k.b))
+		require.Equal(t, k.r, bytes.Compare(wa, wb), "%q vs %q", k.a, k.b)
+	}
+	require.Equal(t, []byte("ID:ABC"), FoldGeneralCI([]byte("ID:"), []byte("aBc  ")))
+}
+
+func TestCompareGeneralCINoAlloc(t *testing.T) {
+	a, b := []byte("Hello, Wörld"), []byte("hello, wÖRLD   ")
+	allocs := testing.AllocsPerRun(100, func() {
+		CompareGeneralCI(a, b)
+	})
+	require.Equal(t, float64(0), allocs)
+}
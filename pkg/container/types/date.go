@@ -370,7 +370,7 @@ func (d Date) ToTime() Datetime {
 }
 
 func (d Date) Month() uint8 {
-	_, month, _, _ := d.Calendar(true)
+	_, month, _ := d.civil()
 	return month
 }
 
@@ -382,6 +382,38 @@ func LastDay(year uint16, month uint8) int {
 }
 
 func (d Date) Day() uint8 {
-	_, _, day, _ := d.Calendar(true)
+	_, _, day := d.civil()
 	return day
 }
+
+// daysBeforeEpochFromMarch is the number of days from 0000-03-01 to 0001-01-01.
+const daysBeforeEpochFromMarch = 306
+
+// civil decomposes the date into its year, month and day only by the integer
+// arithmetic of the days, with the multiplications of Neri and Schneider's
+// "Euclidean affine functions" instead of the divisions. The years are counted
+// from March so that the leap day is the last day of a year, and the days are
+// shifted by a 400 years era to keep them positive for the first months of
+// year 0.
+func (d Date) civil() (year int32, month, day uint8) {
+	n := uint32(int32(d) + daysBeforeEpochFromMarch + daysPer400Years)
+	// the century and the day of the century
+	n1 := 4*n + 3
+	c := n1 / daysPer400Years
+	nc := n1 % daysPer400Years / 4
+	// the year of the century and the day of the year
+	p2 := uint64(4*nc+3) * 2939745
+	z := uint32(p2 >> 32)
+	ny := uint32(p2) / 2939745 / 4
+	// the month from March and the day of the month
+	n3 := 2141*ny + 197913
+	m := n3 >> 16
+	day = uint8((n3&0xffff)/2141 + 1)
+	year = int32(100*c+z) - 400
+	if ny >= 306 {
+		year++
+		m -= 12
+	}
+	month = uint8(m)
+	return
+}
@@ -232,34 +232,77 @@ func (dt Datetime) AddDateTime(date gotime.Time, addMsec, addSec, addMin, addHou
 	return FromClock(int32(date.Year()), uint8(date.Month()), uint8(date.Day()), uint8(date.Hour()), uint8(date.Minute()), uint8(date.Second()), uint32(date.Nanosecond()/1000))
 }
 
-func (dt Datetime) AddInterval(nums int64, its IntervalType) Datetime {
-	goTime := dt.ConvertToGoTime()
-	var addMsec, addSec, addMin, addHour, addDay, addMonth, addYear int64
+// AddInterval returns dt added by nums units of its, adding months to the
+// last day of a month gives the last day of the result month if it is
+// shorter. ok is false if the result is out of the range of DATETIME, which
+// is from the year 1 to the year 9999.
+func (dt Datetime) AddInterval(nums int64, its IntervalType) (Datetime, bool) {
+	var unit int64
 	switch its {
 	case MicroSecond:
-		addMsec += nums
+		unit = 1
 	case Second:
-		addSec += nums
+		unit = microSecsPerSec
 	case Minute:
-		addMin += nums
+		unit = secsPerMinute * microSecsPerSec
 	case Hour:
-		addHour += nums
+		unit = secsPerHour * microSecsPerSec
 	case Day:
-		addDay += nums
+		unit = secsPerDay * microSecsPerSec
 	case Week:
-		addDay += 7 * nums
+		unit = 7 * secsPerDay * microSecsPerSec
 	case Month:
-		addMonth += nums
+		return dt.addMonths(nums)
 	case Quarter:
-		addMonth += 3 * nums
+		if nums > maxIntervalMonths/3 || nums < -maxIntervalMonths/3 {
+			return 0, false
+		}
+		return dt.addMonths(3 * nums)
 	case Year:
-		addYear += nums
+		if nums > maxIntervalMonths/12 || nums < -maxIntervalMonths/12 {
+			return 0, false
+		}
+		return dt.addMonths(12 * nums)
+	default:
+		return 0, false
+	}
+	if nums > maxDatetimeMicroSecs/unit || nums < -maxDatetimeMicroSecs/unit {
+		return 0, false
 	}
-	return dt.AddDateTime(goTime, addMsec, addSec, addMin, addHour, addDay, addMonth, addYear)
+	r := dt.sec()*microSecsPerSec + dt.microSec() + nums*unit
+	if r < 0 || r > maxDatetimeMicroSecs {
+		return 0, false
+	}
+	return Datetime(r/microSecsPerSec<<20 + r%microSecsPerSec), true
+}
+
+const (
+	// maxDatetimeMicroSecs is the microseconds from 0001-01-01 00:00:00 to
+	// 9999-12-31 23:59:59.999999, there are 3652059 days before 10000-01-01
+	maxDatetimeMicroSecs = 3652059*secsPerDay*microSecsPerSec - 1
+	// maxIntervalMonths is the months of the range of DATETIME
+	maxIntervalMonths = MaxDateYear * 12
+)
+
+func (dt Datetime) addMonths(nums int64) (Datetime, bool) {
+	if nums > maxIntervalMonths || nums < -maxIntervalMonths {
+		return 0, false
+	}
+	y, m, d, _ := dt.ToDate().Calendar(true)
+	months := int64(y)*12 + int64(m) - 1 + nums
+	if months < 12 || months >= (MaxDateYear+1)*12 {
+		return 0, false
+	}
+	year, month := int32(months/12), uint8(months%12+1)
+	if last := uint8(LastDay(uint16(year), month)); d > last {
+		d = last
+	}
+	secs := int64(FromCalendar(year, month, d))*secsPerDay + dt.sec()%secsPerDay
+	return Datetime(secs<<20 + dt.microSec()), true
 }
 
 func (dt Datetime) microSec() int64 {
-	return int64(dt) << 44 >> 44
+	return int64(dt) & microSecondBitMask
 }
 
 func (dt Datetime) sec() int64 {
@@ -278,6 +321,22 @@ func (dt Datetime) Day() uint8 {
 	return dt.ToDate().Day()
 }
 
+func (dt Datetime) Hour() uint8 {
+	return uint8(dt.sec() % secsPerDay / secsPerHour)
+}
+
+func (dt Datetime) Minute() uint8 {
+	return uint8(dt.sec() % secsPerHour / secsPerMinute)
+}
+
+func (dt Datetime) Second() uint8 {
+	return uint8(dt.sec() % secsPerMinute)
+}
+
+func (dt Datetime) MicroSec() uint32 {
+	return uint32(dt.microSec())
+}
+
 func (dt Datetime) WeekOfYear() (int32, uint8) {
 	return dt.ToDate().WeekOfYear()
 }
@@ -112,7 +112,8 @@ func TestAddDatetime(t *testing.T) {
 		ret, rettype, _ := NormalizeInterval(test.InputIntervalNum, test.InputIntervalTypes)
 		d, err := ParseDatetime(test.Input)
 		require.Equal(t, err, nil)
-		d = d.AddInterval(ret, rettype)
+		d, ok := d.AddInterval(ret, rettype)
+		require.True(t, ok)
 		require.Equal(t, d.String(), test.expect)
 	}
 }
@@ -155,11 +156,43 @@ func TestSubDateTime(t *testing.T) {
 		ret, rettype, _ := NormalizeInterval(test.InputIntervalNum, test.InputIntervalTypes)
 		d, err := ParseDatetime(test.Input)
 		require.Equal(t, err, nil)
-		d = d.AddInterval(-ret, rettype)
+		d, ok := d.AddInterval(-ret, rettype)
+		require.True(t, ok)
 		require.Equal(t, d.String(), test.expect)
 	}
 }
 
+func TestAddIntervalOutOfRange(t *testing.T) {
+	tests := []struct {
+		input  string
+		num    int64
+		unit   IntervalType
+		expect string
+	}{
+		{"9999-12-31 23:59:59.999999", 0, MicroSecond, "9999-12-31 23:59:59.999999"},
+		{"9999-12-31 23:59:59.999999", 1, MicroSecond, ""},
+		{"9999-12-31 10:00:00", 1, Day, ""},
+		{"9999-12-31 10:00:00", 1, Month, ""},
+		{"0001-01-01 00:00:00", -1, Second, ""},
+		{"0001-01-31 00:00:00", -1, Month, ""},
+		{"0001-03-31 10:00:00", -1, Month, "0001-02-28 10:00:00"},
+		{"2022-01-01 00:00:00", 1 << 62, Week, ""},
+		{"2022-01-01 00:00:00", -1 << 62, Year, ""},
+		{"2022-01-01 00:00:00", 7000 * 365, Day, "9017-05-10 00:00:00"},
+		{"2022-01-01 00:00:00", 8000, Year, ""},
+		{"2022-01-01 00:00:00", 1, IntervalTypeInvalid, ""},
+	}
+	for _, test := range tests {
+		d, err := ParseDatetime(test.input)
+		require.NoError(t, err)
+		r, ok := d.AddInterval(test.num, test.unit)
+		require.Equal(t, test.expect != "", ok, test)
+		if ok {
+			require.Equal(t, test.expect, r.String())
+		}
+	}
+}
+
 func TestParseDatetime(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -269,3 +302,58 @@ func TestUnix(t *testing.T) {
 		}
 	}
 }
+
+func TestCivil(t *testing.T) {
+	// every day of the years 1 to 9999, the century boundaries included
+	for d := Date(0); d <= FromCalendar(9999, 12, 31); d++ {
+		y, m, day, _ := d.Calendar(true)
+		y1, m1, day1 := d.civil()
+		if y != y1 || m != m1 || day != day1 {
+			t.Fatalf("civil of %d want %d-%d-%d but got %d-%d-%d", d, y, m, day, y1, m1, day1)
+		}
+	}
+	for _, c := range []struct {
+		date       string
+		year       uint16
+		month, day uint8
+	}{
+		{"1899-12-31", 1899, 12, 31},
+		{"1900-01-01", 1900, 1, 1},
+		{"1900-02-28", 1900, 2, 28},
+		{"1900-03-01", 1900, 3, 1},
+		{"1999-12-31", 1999, 12, 31},
+		{"2000-02-29", 2000, 2, 29},
+		{"2000-03-01", 2000, 3, 1},
+		{"2100-03-01", 2100, 3, 1},
+	} {
+		d, err := ParseDate(c.date)
+		require.NoError(t, err)
+		require.Equal(t, c.year, d.Year(), c.date)
+		require.Equal(t, c.month, d.Month(), c.date)
+		require.Equal(t, c.day, d.Day(), c.date)
+	}
+	// the year 0 is a leap year before the date 0
+	for d, want := range map[Date][3]int{-1: {0, 12, 31}, -306: {0, 3, 1}, -307: {0, 2, 29}, -366: {0, 1, 1}} {
+		y, m, day := d.civil()
+		require.Equal(t, want, [3]int{int(y), int(m), int(day)}, d)
+	}
+}
+
+func TestClockParts(t *testing.T) {
+	dt, err := ParseDatetime("1999-12-31 23:59:59.999999")
+	require.NoError(t, err)
+	require.Equal(t, uint8(23), dt.Hour())
+	require.Equal(t, uint8(59), dt.Minute())
+	require.Equal(t, uint8(59), dt.Second())
+	require.Equal(t, uint32(999999), dt.MicroSec())
+
+	dt, err = ParseDatetime("2000-01-01 00:00:00.000001")
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), dt.Hour())
+	require.Equal(t, uint8(0), dt.Minute())
+	require.Equal(t, uint8(0), dt.Second())
+	require.Equal(t, uint32(1), dt.MicroSec())
+	require.Equal(t, uint16(2000), dt.Year())
+	require.Equal(t, uint8(1), dt.Month())
+	require.Equal(t, uint8(1), dt.Day())
+}
@@ -29,6 +29,8 @@
 // have result of type decimal64, the result's scale is the maximum of its two operands, overflow may happen in these two operations and no precaution is implemented,
 // nor does any indication. for multiplication and division on decimal64, the result is of type Decimal128 and therefore these two operations are safe on Decimal64, that is,
 // overflow is guaranteed IMPOSSIBLE.
+// the scale of a quotient is the scale of the dividend plus DecimalDivScaleIncrement as mysql does, so 1.00 / 3 is 0.333333,
+// and its last digit is rounded.
 //
 // For Decimal128, operations on this data type may overflow and no precautions nor indications are implemented, but these will only happen in extreme use cases, that is, when the result can not fit into a 128 bit representation
 //
@@ -42,11 +44,7 @@
 package types
 
 import (
-	"errors"
-	"fmt"
 	"math"
-	"strconv"
-	"strings"
 	"unsafe"
 )
 
@@ -327,317 +325,27 @@ func NegDecimal128(a Decimal128) (result Decimal128) {
 	return result
 }
 
-// this implementation is bad, tangling, but it works,
-// wrote 50+ test cases, hoping to mitigate the pain of  my conscience, it didn't
-// I failed to find an elegant approach to support scientific notation, what a pathetic loser...
-// decimalStringPreprocess convert input decimal string to its internal representation form,
-// for example:  			Decimal(10, 5)    --------> 	converted result
-// 			input:			"12345.6789" 						"1234567890"
-// 							"12345.67"							"1234567000"
-// 							"-12345.67"							"1234567000" neg == true
-// 							"12345.123451"  					"1234512345"
-//							"12345.123456"						"1234512345" carry == true, the result is rounded(round away from zero)
-// 							"0.12345e5"							"1234500000"
-//							"0.12345e6"							err == "out of range"
-// 							"0.12345e-3"						"12"
-// 							"0.12345E-3"						"12"
-//							"0.126"								"12" carry == true
-func decimalStringPreprocess(s string, precision, scale int32) (result []byte, carry bool, neg bool, err error) {
-	if s == "" {
-		return []byte("0"), false, false, errors.New("invalid decimal string")
-	}
-	if len(s) >= 50 {
-		s = s[:50] // to prevent attack,
-	}
-	exponent := 0
-	if strings.Contains(s, "e") {
-		s2 := strings.Split(s, "e")
-		if len(s2) > 2 {
-			return []byte(""), false, false, errors.New("invalid decimal string")
-		}
-		exponent, err = strconv.Atoi(s2[1])
-		if err != nil {
-			return []byte(""), false, false, errors.New("invalid decimal string")
-		}
-		s = s2[0]
-	}
-	if strings.Contains(s, "E") {
-		s2 := strings.Split(s, "E")
-		if len(s2) > 2 {
-			return []byte(""), false, false, errors.New("invalid decimal string")
-		}
-		exponent, err = strconv.Atoi(s2[1])
-		if err != nil {
-			return []byte(""), false, false, errors.New("invalid decimal string")
-		}
-		s = s2[0]
-	}
-	parts := strings.Split(s, ".")
-	partsNumber := len(parts)
-	if partsNumber == 2 { // this means the input string is of the form "123.456"
-		part0Bytes := []byte(parts[0])
-		part1Bytes := []byte(parts[1])
-		if len(parts[0]) > 0 {
-			if part0Bytes[0] == '+' {
-				part0Bytes = part0Bytes[1:]
-			}
-			if part0Bytes[0] == '-' {
-				neg = true
-				part0Bytes = part0Bytes[1:]
-			}
-		}
-		part0Bytes = []byte(strings.TrimLeft(string(part0Bytes), "0"))
-		if exponent > 0 {
-			lengthOfPart1Bytes := len(part1Bytes)
-			if lengthOfPart1Bytes > exponent {
-				part0Bytes = append(part0Bytes, part1Bytes[:exponent]...)
-				part1Bytes = part1Bytes[exponent:]
-			} else if lengthOfPart1Bytes == exponent {
-				part0Bytes = append(part0Bytes, part1Bytes...)
-				part1Bytes = []byte("")
-			} else {
-				paddingZeros := exponent - lengthOfPart1Bytes
-				part0Bytes = append(part0Bytes, part1Bytes...)
-				for i := 0; i < paddingZeros; i++ {
-					part0Bytes = append(part0Bytes, '0')
-				}
-				part1Bytes = []byte("")
-			}
-		}
-		if exponent < 0 {
-			exponentAbs := -exponent
-			lengthOfPart0Bytes := len(part0Bytes)
-			if lengthOfPart0Bytes > exponentAbs {
-				part1Bytes = append(part0Bytes[lengthOfPart0Bytes-exponentAbs:], part1Bytes...)
-				part0Bytes = part0Bytes[:lengthOfPart0Bytes-exponentAbs]
-			} else if lengthOfPart0Bytes == exponentAbs {
-				part1Bytes = append(part0Bytes, part1Bytes...)
-				part0Bytes = []byte("")
-			} else {
-				paddingZeros := exponentAbs - lengthOfPart0Bytes
-				part1Bytes = append(part0Bytes, part1Bytes...)
-				for i := 0; i < paddingZeros; i++ {
-					part1Bytes = append([]byte("0"), part1Bytes...)
-				}
-				part0Bytes = []byte("")
-			}
-		}
-		if len(part0Bytes) > int(precision-scale) { // for example, input "123.45" is invalid for Decimal(5, 3)
-			return []byte(""), false, false, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
-		}
-		if len(part1Bytes) > int(scale) {
-			for i := int(scale); i < len(part1Bytes); i++ {
-				if part1Bytes[i] < '0' || part1Bytes[i] > '9' {
-					return result, carry, neg, errors.New("invalid decimal string")
-				}
-			}
-			if part1Bytes[scale] >= '5' {
-				carry = true
-			}
-			part1Bytes = part1Bytes[:scale]
-		} else {
-			scaleDiff := int(scale) - len(part1Bytes)
-			for i := 0; i < scaleDiff; i++ {
-				part1Bytes = append(part1Bytes, '0')
-			}
-		}
-		result = append(part0Bytes, part1Bytes...)
-		return result, carry, neg, nil
-	} else if partsNumber == 1 { // this means the input string is of the form "123", or "123e3", "123e-3"
-		part0Bytes := []byte(parts[0])
-		if part0Bytes[0] == '+' {
-			part0Bytes = part0Bytes[1:]
-		}
-		if part0Bytes[0] == '-' {
-			neg = true
-			part0Bytes = part0Bytes[1:]
-		}
-		part0Bytes = []byte(strings.TrimLeft(string(part0Bytes), "0"))
-		if exponent > 0 {
-			for i := 0; i < int(scale)+exponent; i++ {
-				part0Bytes = append(part0Bytes, '0')
-			}
-			if len(part0Bytes) > int(precision) { // for example, input "52345e3" is invalid for Decimal(10, 3)
-				return []byte("0"), false, false, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
-			}
-			return part0Bytes, carry, neg, nil
-		} else if exponent < 0 {
-			exponentAbs := -exponent
-			lengthOfPart0Bytes := len(part0Bytes)
-			if lengthOfPart0Bytes < exponentAbs {
-				lengthOfPart0BytesPlusScale := lengthOfPart0Bytes + int(scale)
-				if lengthOfPart0BytesPlusScale < exponentAbs {
-					return []byte("0"), false, false, nil
-				} else {
-					for i := 0; i < int(scale); i++ {
-						part0Bytes = append(part0Bytes, '0')
-					}
-					remaining := part0Bytes[:lengthOfPart0BytesPlusScale-exponentAbs]
-					if len(part0Bytes) > lengthOfPart0BytesPlusScale-exponentAbs {
-						if part0Bytes[lengthOfPart0BytesPlusScale-exponentAbs] >= '5' && part0Bytes[lengthOfPart0BytesPlusScale-exponentAbs] <= '9' {
-							carry = true
-						}
-					}
-					return remaining, carry, neg, nil
-				}
-			} else {
-				paddingZeros := int(scale) - lengthOfPart0Bytes
-				if paddingZeros >= 0 {
-					for i := 0; i < paddingZeros; i++ {
-						part0Bytes = append(part0Bytes, '0')
-					}
-				} else {
-					if part0Bytes[scale] >= '5' && part0Bytes[scale] <= '9' {
-						carry = true
-					}
-					part0Bytes = part0Bytes[:scale]
-				}
-				return part0Bytes, carry, neg, nil
-			}
-		} else {
-			for i := 0; i < int(scale); i++ {
-				part0Bytes = append(part0Bytes, '0')
-			}
-			if len(part0Bytes) > int(precision) { // for example, input "12345678" is invalid for Decimal(10, 3)
-				return []byte("0"), false, false, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
-			}
-			return part0Bytes, carry, neg, nil
-		}
-	} else {
-		return []byte(""), false, false, errors.New("invalid decimal string")
-	}
-}
-
-//todo: use strconv to simplify this code
+// ParseStringToDecimal64 is ParseDecimal64
 func ParseStringToDecimal64(s string, precision, scale int32) (result Decimal64, err error) {
-	sInBytes, carry, neg, err := decimalStringPreprocess(s, precision, scale)
-	if err != nil {
-		return result, err
-	}
-	resultInInt64 := int64(result)
-	for _, ch := range sInBytes {
-		ch -= '0'
-		if ch > 9 {
-			return result, errors.New("invalid decimal string")
-		}
-		digit := int64(ch)
-		resultInInt64 = resultInInt64 * 10
-		resultInInt64 = resultInInt64 + digit
-	}
-	if carry {
-		resultInInt64 += 1
-	}
-	if neg {
-		resultInInt64 = -resultInInt64
-	}
-	result = Decimal64(resultInInt64)
-	return result, nil
+	return ParseDecimal64(s, precision, scale)
 }
 
+// ParseStringToDecimal128WithoutTable is ParseDecimal128Literal
 func ParseStringToDecimal128WithoutTable(s string) (result Decimal128, scale int32, err error) {
-	precision := int32(38)
-	parts := strings.Split(s, ".")
-	scale = int32(0)
-	if len(parts) == 1 || len(parts[1]) == 0 { // this means the input string is of the form "123", "123."
-		scale = 0
-	} else {
-		scale = int32(len(parts[1]))
-	}
-	result, err = ParseStringToDecimal128(s, precision, scale)
-	return result, scale, err
+	return ParseDecimal128Literal(s)
 }
 
+// ParseStringToDecimal128 is ParseDecimal128
 func ParseStringToDecimal128(s string, precision, scale int32) (result Decimal128, err error) {
-	sInBytes, carry, neg, err := decimalStringPreprocess(s, precision, scale)
-	if err != nil {
-		return result, err
-	}
-
-	for _, ch := range sInBytes {
-		ch -= '0'
-		if ch > 9 {
-			return result, errors.New("invalid decimal string")
-		}
-		digit := int64(ch)
-		result = ScaleDecimal128By10(result)
-		result = AddDecimal128ByInt64(result, digit)
-	}
-	if carry {
-		result = AddDecimal128ByInt64(result, int64(1))
-	}
-	if neg {
-		result = NegDecimal128(result)
-	}
-	return result, nil
+	return ParseDecimal128(s, precision, scale)
 }
 
 func (a Decimal64) Decimal64ToString(scale int32) []byte {
-	aInInt64 := int64(a)
-	if scale == 0 {
-		result := strconv.FormatInt(aInInt64, 10)
-		return []byte(result)
-	}
-	if aInInt64 == 0 {
-		return []byte("0")
-	}
-	result := strconv.FormatInt(aInInt64, 10)
-	neg := false
-	if aInInt64 < 0 {
-		neg = true
-		result = result[1:]
-	}
-
-	length := len(result)
-	if length < int(scale) {
-		for i := 0; i < int(scale)-length; i++ {
-			result = "0" + result
-		}
-		result = "0." + result
-	} else if length == int(scale) {
-		result = "0." + result
-	} else {
-		result = result[:length-int(scale)] + "." + result[length-int(scale):]
-	}
-	if neg {
-		result = "-" + result
-	}
-
-	return []byte(result)
+	return AppendDecimal64(nil, a, scale)
 }
 
 func (a Decimal128) Decimal128ToString(scale int32) []byte {
-	result := ""
-	neg := Decimal128IsNegative(a)
-	notZero := Decimal128IsNotZero(a)
-	if !notZero {
-		return []byte("0")
-	}
-	tmp := a
-	digits := "0123456789"
-	digit := int64(0)
-	for notZero {
-		digit = ModDecimal128By10Abs(tmp)
-		result = string(digits[digit]) + result
-		tmp = DivideDecimal128By10(tmp)
-		notZero = Decimal128IsNotZero(tmp)
-	}
-	length := len(result)
-	if length < int(scale) {
-		for i := 0; i < int(scale)-length; i++ {
-			result = "0" + result
-		}
-		result = "0." + result
-	} else if length == int(scale) {
-		result = "0." + result
-	} else {
-		if scale != 0 {
-			result = result[:length-int(scale)] + "." + result[length-int(scale):]
-		}
-	}
-	if neg {
-		result = "-" + result
-	}
-	return []byte(result)
+	return AppendDecimal128(nil, a, scale)
 }
 
 func Decimal64Add(a, b Decimal64, aScale, bScale int32) (result Decimal64) {
@@ -736,13 +444,32 @@ func Decimal128SubAligned(a, b Decimal128) (result Decimal128) {
 	return result
 }
 
+const (
+	// DecimalDivScaleIncrement is the number of the digits which the
+	// quotient of decimals has more than the dividend, the default
+	// div_precision_increment of mysql
+	DecimalDivScaleIncrement = 4
+	// MaxDecimalScale is the max scale of a decimal
+	MaxDecimalScale = 38
+)
+
+// DecimalDivScale returns the scale of the quotient of a dividend of scale
+// aScale
+func DecimalDivScale(aScale int32) int32 {
+	if aScale+DecimalDivScaleIncrement > MaxDecimalScale {
+		return MaxDecimalScale
+	}
+	return aScale + DecimalDivScaleIncrement
+}
+
+// Decimal64Decimal64Div returns a / b of the scale DecimalDivScale(aScale)
 func Decimal64Decimal64Div(a, b Decimal64, aScale, bScale int32) (result Decimal128) {
 	aScaled := InitDecimal128(int64(a))
-	for i := 0; i < int(bScale); i++ {
+	for i := int32(0); i <= bScale+DecimalDivScale(aScale)-aScale; i++ {
 		aScaled = ScaleDecimal128By10(aScaled)
 	}
 	C.div_int128_int64(unsafe.Pointer(&aScaled), unsafe.Pointer(&b), unsafe.Pointer(&result))
-	return result
+	return roundDecimal128LastDigit(result)
 }
 
 func Decimal128Int64Div(a Decimal128, b int64) (result Decimal128) {
@@ -750,13 +477,26 @@ func Decimal128Int64Div(a Decimal128, b int64) (result Decimal128) {
 	C.div_int128_int64(unsafe.Pointer(&aScaled), unsafe.Pointer(&b), unsafe.Pointer(&result))
 	return result
 }
+
+// Decimal128Decimal128Div returns a / b of the scale DecimalDivScale(aScale)
 func Decimal128Decimal128Div(a, b Decimal128, aScale, bScale int32) (result Decimal128) {
 	aScaled := a
-	for i := 0; i < int(bScale); i++ {
+	for i := int32(0); i <= bScale+DecimalDivScale(aScale)-aScale; i++ {
 		aScaled = ScaleDecimal128By10(aScaled)
 	}
 	C.div_int128_int128(unsafe.Pointer(&aScaled), unsafe.Pointer(&b), unsafe.Pointer(&result))
-	return result
+	return roundDecimal128LastDigit(result)
+}
+
+// roundDecimal128LastDigit removes the last digit of a, rounding half away
+// from zero
+func roundDecimal128LastDigit(a Decimal128) Decimal128 {
+	if a.Hi < 0 {
+		a = Decimal128AddAligned(a, InitDecimal128(-5))
+	} else {
+		a = Decimal128AddAligned(a, InitDecimal128(5))
+	}
+	return DivideDecimal128By10(a)
 }
 
 func Decimal64ToDecimal128(a Decimal64) (result Decimal128) {
@@ -284,15 +284,15 @@ func TestDecimal64Decimal64Div(t *testing.T) {
 	aScale := int32(1)
 	bScale := int32(2)
 	result0 := Decimal64Decimal64Div(a0, b0, aScale, bScale)
-	require.Equal(t, Decimal128{100, 0}, result0)
+	require.Equal(t, Decimal128{1000000, 0}, result0)
 	a1 := Decimal64(123)
 	b1 := Decimal64(-123)
 	result1 := Decimal64Decimal64Div(a1, b1, aScale, bScale)
-	require.Equal(t, Decimal128{-100, -1}, result1)
+	require.Equal(t, Decimal128{-1000000, -1}, result1)
 	a2 := Decimal64(-1230)
 	b2 := Decimal64(123)
 	result2 := Decimal64Decimal64Div(a2, b2, aScale, bScale)
-	require.Equal(t, Decimal128{-1000, -1}, result2)
+	require.Equal(t, Decimal128{-10000000, -1}, result2)
 }
 
 func TestDecimal128Mul(t *testing.T) {
@@ -318,17 +318,31 @@ func TestDecimal128Div(t *testing.T) {
 	aScale := int32(1)
 	bScale := int32(2)
 	result0 := Decimal128Decimal128Div(a0, b0, aScale, bScale)
-	require.Equal(t, Decimal128{100, 0}, result0)
+	require.Equal(t, Decimal128{1000000, 0}, result0)
 
 	a1 := Decimal128{1230, 0}
 	b1 := Decimal128{123, 0}
 	result1 := Decimal128Decimal128Div(a1, b1, aScale, bScale)
-	require.Equal(t, Decimal128{1000, 0}, result1)
+	require.Equal(t, Decimal128{10000000, 0}, result1)
 
 	a2 := Decimal128{-1230, -1}
 	b2 := Decimal128{123, 0}
 	result2 := Decimal128Decimal128Div(a2, b2, aScale, bScale)
-	require.Equal(t, Decimal128{-1000, -1}, result2)
+	require.Equal(t, Decimal128{-10000000, -1}, result2)
+}
+
+func TestDecimalDivScale(t *testing.T) {
+	require.Equal(t, int32(4), DecimalDivScale(0))
+	require.Equal(t, int32(6), DecimalDivScale(2))
+	require.Equal(t, int32(MaxDecimalScale), DecimalDivScale(36))
+
+	// 1.00 / 3.0 is 0.333333, 2.00 / 3.0 is 0.666667 and -2.00 / 3.0 is
+	// -0.666667
+	require.Equal(t, InitDecimal128(333333), Decimal64Decimal64Div(100, 30, 2, 1))
+	require.Equal(t, InitDecimal128(666667), Decimal64Decimal64Div(200, 30, 2, 1))
+	require.Equal(t, InitDecimal128(-666667), Decimal64Decimal64Div(-200, 30, 2, 1))
+	require.Equal(t, InitDecimal128(-666667), Decimal128Decimal128Div(InitDecimal128(200), InitDecimal128(-30), 2, 1))
+	require.Equal(t, InitDecimal128(1428571), Decimal128Decimal128Div(InitDecimal128(1000), InitDecimal128(7), 3, 0))
 }
 
 func TestDecimal64ToDecimal128(t *testing.T) {
@@ -0,0 +1,373 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// The text form of decimals shared by the casts, the literals of the planner
+// and the result writer.
+//
+// A decimal string is
+//
+//	[spaces] [+|-] digits [. [digits]] [(e|E) [+|-] digits] [spaces]
+//
+// where the digits before or after the point may be omitted but not both.
+// Like mysql, the value is rounded half away from zero to the scale, for
+// example "0.125" is 0.13 and "-0.125" is -0.13 of Decimal(10, 2).
+//
+// A decimal of scale s is formatted with exactly s digits after the point,
+// the trailing zeros are kept, for example 1.50 and 0.00 of Decimal(10, 2).
+
+// maxDecimalExponent bounds the exponent of a decimal string, which shifts
+// the digits far beyond the widest decimal already
+const maxDecimalExponent = 1 << 20
+
+// uint128 is the absolute value of a decimal while it is parsed or formatted
+type uint128 struct {
+	hi, lo uint64
+}
+
+// pow10Uint128 is 10^i for i in [0, 38]
+var pow10Uint128 [39]uint128
+
+func init() {
+	pow10Uint128[0] = uint128{lo: 1}
+	for i := 1; i < len(pow10Uint128); i++ {
+		pow10Uint128[i] = pow10Uint128[i-1].mulAdd(0)
+	}
+}
+
+// mulAdd returns x * 10 + d
+func (x uint128) mulAdd(d uint64) uint128 {
+	carry, lo := bits.Mul64(x.lo, 10)
+	lo, c := bits.Add64(lo, d, 0)
+	return uint128{hi: x.hi*10 + carry + c, lo: lo}
+}
+
+func (x uint128) less(y uint128) bool {
+	return x.hi < y.hi || (x.hi == y.hi && x.lo < y.lo)
+}
+
+// add1 returns x + 1
+func (x uint128) add1() uint128 {
+	lo, c := bits.Add64(x.lo, 1, 0)
+	return uint128{hi: x.hi + c, lo: lo}
+}
+
+// divmod returns x / y and x % y
+func (x uint128) divmod(y uint64) (uint128, uint64) {
+	hi, r := x.hi/y, x.hi%y
+	lo, r := bits.Div64(r, x.lo, y)
+	return uint128{hi: hi, lo: lo}, r
+}
+
+func (x uint128) neg() uint128 {
+	lo, borrow := bits.Sub64(0, x.lo, 0)
+	hi, _ := bits.Sub64(0, x.hi, borrow)
+	return uint128{hi: hi, lo: lo}
+}
+
+// ParseDecimal128 parses s into a decimal of the width and scale, see above
+// for the accepted strings. An error is returned if s is malformed, or if the
+// rounded value has more than width digits.
+func ParseDecimal128(s string, width, scale int32) (Decimal128, error) {
+	var result Decimal128
+
+	// trim the spaces, and take the sign
+	i, j := 0, len(s)
+	for i < j && isDecimalSpace(s[i]) {
+		i++
+	}
+	for j > i && isDecimalSpace(s[j-1]) {
+		j--
+	}
+	neg := false
+	if i < j && (s[i] == '+' || s[i] == '-') {
+		neg = s[i] == '-'
+		i++
+	}
+
+	// the digits are s[intStart:intEnd] and s[fracStart:fracEnd]
+	intStart := i
+	for i < j && isDecimalDigit(s[i]) {
+		i++
+	}
+	intEnd := i
+	fracStart, fracEnd := i, i
+	if i < j && s[i] == '.' {
+		i++
+		fracStart = i
+		for i < j && isDecimalDigit(s[i]) {
+			i++
+		}
+		fracEnd = i
+	}
+	if intEnd == intStart && fracEnd == fracStart {
+		return result, invalidDecimalError(s)
+	}
+	exponent := 0
+	if i < j && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		expNeg := false
+		if i < j && (s[i] == '+' || s[i] == '-') {
+			expNeg = s[i] == '-'
+			i++
+		}
+		if i == j {
+			return result, invalidDecimalError(s)
+		}
+		for ; i < j && isDecimalDigit(s[i]); i++ {
+			if exponent < maxDecimalExponent {
+				exponent = exponent*10 + int(s[i]-'0')
+			}
+		}
+		if expNeg {
+			exponent = -exponent
+		}
+	}
+	if i != j {
+		return result, invalidDecimalError(s)
+	}
+
+	// the leading zeros are not significant
+	for intStart < intEnd && s[intStart] == '0' {
+		intStart++
+	}
+	intDigits := intEnd - intStart
+	digits := intDigits + fracEnd - fracStart
+	digitAt := func(k int) uint64 {
+		if k < intDigits {
+			return uint64(s[intStart+k] - '0')
+		}
+		return uint64(s[fracStart+k-intDigits] - '0')
+	}
+
+	// the value is the first keep digits, followed by zeros if there are
+	// fewer digits, and rounded by the next digit
+	keep := intDigits + exponent + int(scale)
+	var x uint128
+	significant := 0
+	for k := 0; k < keep && k < digits; k++ {
+		x = x.mulAdd(digitAt(k))
+		if significant > 0 || x.lo != 0 {
+			if significant++; significant > int(width) {
+				return result, decimalOutOfRangeError(s, width, scale)
+			}
+		}
+	}
+	if significant > 0 {
+		for k := digits; k < keep; k++ {
+			x = x.mulAdd(0)
+			if significant++; significant > int(width) {
+				return result, decimalOutOfRangeError(s, width, scale)
+			}
+		}
+	}
+	if keep >= 0 && keep < digits && digitAt(keep) >= 5 {
+		if x = x.add1(); width < int32(len(pow10Uint128)) && !x.less(pow10Uint128[width]) {
+			return result, decimalOutOfRangeError(s, width, scale)
+		}
+	}
+	if neg {
+		x = x.neg()
+	}
+	result.Lo, result.Hi = int64(x.lo), int64(x.hi)
+	return result, nil
+}
+
+// ParseDecimal64 is ParseDecimal128 of a Decimal64, whose width is at most 18
+func ParseDecimal64(s string, width, scale int32) (Decimal64, error) {
+	d, err := ParseDecimal128(s, width, scale)
+	return Decimal64(d.Lo), err
+}
+
+// ParseDecimal128Literal parses a literal of the planner into a Decimal128 of
+// the width 38 and the scale which keeps all its digits after the point
+func ParseDecimal128Literal(s string) (Decimal128, int32, error) {
+	scale := decimalLiteralScale(s)
+	d, err := ParseDecimal128(s, 38, scale)
+	return d, scale, err
+}
+
+// decimalLiteralScale returns the number of digits after the point of the
+// value of s, it is 0 for a malformed s, which is reported when parsed.
+func decimalLiteralScale(s string) int32 {
+	i := 0
+	for i < len(s) && s[i] != '.' && s[i] != 'e' && s[i] != 'E' {
+		i++
+	}
+	scale := 0
+	if i < len(s) && s[i] == '.' {
+		for i++; i < len(s) && isDecimalDigit(s[i]); i++ {
+			scale++
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		if exponent, err := strconv.Atoi(s[i+1:]); err == nil {
+			scale -= exponent
+		}
+	}
+	if scale < 0 {
+		return 0
+	}
+	if scale > 38 {
+		return 38
+	}
+	return int32(scale)
+}
+
+// AppendDecimal128 appends the text form of d of the scale to dst
+func AppendDecimal128(dst []byte, d Decimal128, scale int32) []byte {
+	x := uint128{hi: uint64(d.Hi), lo: uint64(d.Lo)}
+	if d.Hi < 0 {
+		dst = append(dst, '-')
+		x = x.neg()
+	}
+	// the digits are written backwards, 19 digits a time while x needs
+	// more than 64 bits
+	var buf [40]byte
+	i := len(buf)
+	for x.hi != 0 {
+		var r uint64
+		x, r = x.divmod(1e19)
+		for k := 0; k < 19; k++ {
+			i--
+			buf[i] = byte('0' + r%10)
+			r /= 10
+		}
+	}
+	for r := x.lo; ; {
+		i--
+		buf[i] = byte('0' + r%10)
+		if r /= 10; r == 0 {
+			break
+		}
+	}
+	return appendDecimalDigits(dst, buf[i:], scale)
+}
+
+// AppendDecimal64 appends the text form of d of the scale to dst
+func AppendDecimal64(dst []byte, d Decimal64, scale int32) []byte {
+	x := uint64(d)
+	if d < 0 {
+		dst = append(dst, '-')
+		x = -x
+	}
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], x, 10)
+	return appendDecimalDigits(dst, digits, scale)
+}
+
+// FormatDecimal128 returns the text form of d of the scale
+func FormatDecimal128(d Decimal128, scale int32) string {
+	var buf [48]byte
+	return string(AppendDecimal128(buf[:0], d, scale))
+}
+
+// FormatDecimal64 returns the text form of d of the scale
+func FormatDecimal64(d Decimal64, scale int32) string {
+	var buf [32]byte
+	return string(AppendDecimal64(buf[:0], d, scale))
+}
+
+// Decimal128ToFloat64 returns the float64 nearest to d of the scale
+func Decimal128ToFloat64(d Decimal128, scale int32) float64 {
+	var buf [48]byte
+	f, _ := strconv.ParseFloat(string(AppendDecimal128(buf[:0], d, scale)), 64)
+	return f
+}
+
+// Decimal64ToFloat64 returns the float64 nearest to d of the scale
+func Decimal64ToFloat64(d Decimal64, scale int32) float64 {
+	var buf [32]byte
+	f, _ := strconv.ParseFloat(string(AppendDecimal64(buf[:0], d, scale)), 64)
+	return f
+}
+
+// Decimal128ToUint64 rounds d of the scale half away from zero to an integer,
+// and returns its absolute value and sign. ok is false if the absolute value
+// does not fit uint64.
+func Decimal128ToUint64(d Decimal128, scale int32) (x uint64, neg bool, ok bool) {
+	abs := uint128{hi: uint64(d.Hi), lo: uint64(d.Lo)}
+	if d.Hi < 0 {
+		abs, neg = abs.neg(), true
+	}
+	// only the first digit dropped decides the rounding
+	var r uint64
+	for ; scale > 0; scale-- {
+		abs, r = abs.divmod(10)
+	}
+	if r >= 5 {
+		abs = abs.add1()
+	}
+	return abs.lo, neg, abs.hi == 0
+}
+
+// Decimal64ToUint64 is Decimal128ToUint64 of a Decimal64, which always fits
+func Decimal64ToUint64(d Decimal64, scale int32) (x uint64, neg bool) {
+	x, neg = uint64(d), d < 0
+	if neg {
+		x = -x
+	}
+	var r uint64
+	for ; scale > 0; scale-- {
+		x, r = x/10, x%10
+	}
+	if r >= 5 {
+		x++
+	}
+	return x, neg
+}
+
+// appendDecimalDigits appends the digits of a decimal of the scale to dst,
+// with the point before the last scale digits
+func appendDecimalDigits(dst, digits []byte, scale int32) []byte {
+	n := len(digits) - int(scale)
+	if n <= 0 {
+		dst = append(dst, '0', '.')
+		for ; n < 0; n++ {
+			dst = append(dst, '0')
+		}
+		return append(dst, digits...)
+	}
+	dst = append(dst, digits[:n]...)
+	if scale > 0 {
+		dst = append(dst, '.')
+		dst = append(dst, digits[n:]...)
+	}
+	return dst
+}
+
+func isDecimalSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func isDecimalDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func invalidDecimalError(s string) error {
+	return fmt.Errorf("invalid decimal string '%s'", s)
+}
+
+func decimalOutOfRangeError(s string, width, scale int32) error {
+	return moerr.NewError(moerr.OUT_OF_RANGE, fmt.Sprintf("decimal value '%s' out of range for Decimal(%d, %d)", s, width, scale))
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// decimalTextCases are the decimal strings parsed of the width and scale,
+// want is the formatted value, or empty if the string is malformed or out
+// of range
+var decimalTextCases = []struct {
+	s            string
+	width, scale int32
+	want         string
+}{
+	{"0", 10, 2, "0.00"},
+	{"-0", 10, 2, "0.00"},
+	{"+0.000", 10, 0, "0"},
+	{"1.5", 10, 2, "1.50"},
+	{"001.50", 10, 2, "1.50"},
+	{"-1.5", 10, 2, "-1.50"},
+	{".5", 10, 2, "0.50"},
+	{"5.", 10, 2, "5.00"},
+	{"-.5", 10, 2, "-0.50"},
+	{"  12.34  ", 10, 2, "12.34"},
+	{"\t\n-12.34\r\n", 10, 2, "-12.34"},
+
+	// rounded half away from zero
+	{"0.125", 10, 2, "0.13"},
+	{"-0.125", 10, 2, "-0.13"},
+	{"0.124999999", 10, 2, "0.12"},
+	{"0.115", 10, 2, "0.12"},
+	{"2.5", 10, 0, "3"},
+	{"-2.5", 10, 0, "-3"},
+	{"0.5", 10, 0, "1"},
+	{"0.49999", 10, 0, "0"},
+	{"0.0049", 10, 2, "0.00"},
+	{"0.005", 10, 2, "0.01"},
+	{"0." + strings.Repeat("9", 200), 10, 2, "1.00"},
+	{"1." + strings.Repeat("0", 100) + "1", 10, 2, "1.00"},
+	{"-" + strings.Repeat("0", 100) + "1." + strings.Repeat("4", 100), 10, 3, "-1.444"},
+
+	// exponents
+	{"1e2", 10, 2, "100.00"},
+	{"1E+2", 10, 2, "100.00"},
+	{"1.5e-1", 10, 2, "0.15"},
+	{"1.55e-1", 10, 2, "0.16"},
+	{"12345e-8", 10, 3, "0.000"},
+	{"52345e-8", 10, 3, "0.001"},
+	{"-52345e-8", 10, 3, "-0.001"},
+	{".123456e3", 10, 5, "123.45600"},
+	{"0.12345E-3", 10, 5, "0.00012"},
+	{"123e-1000000", 10, 2, "0.00"},
+	{"0e1000000", 10, 2, "0.00"},
+	{"1e-0", 10, 0, "1"},
+
+	// the width
+	{"99999999.99", 10, 2, "99999999.99"},
+	{"-99999999.99", 10, 2, "-99999999.99"},
+	{"99999999.995", 10, 2, ""},
+	{"100000000", 10, 2, ""},
+	{"1e8", 10, 2, ""},
+	{"1e1000000", 10, 2, ""},
+	{"0.6", 10, 10, "0.6000000000"},
+	{"1", 10, 10, ""},
+	{"0.99999999995", 10, 10, ""},
+	{strings.Repeat("9", 38), 38, 0, strings.Repeat("9", 38)},
+	{"-" + strings.Repeat("9", 38), 38, 0, "-" + strings.Repeat("9", 38)},
+	{strings.Repeat("9", 39), 38, 0, ""},
+	{strings.Repeat("9", 38) + ".5", 38, 0, ""},
+	{"0." + strings.Repeat("1", 38), 38, 38, "0." + strings.Repeat("1", 38)},
+	{"1.5e37", 38, 0, "15" + strings.Repeat("0", 36)},
+	{"12345678901234567890.123456789", 38, 9, "12345678901234567890.123456789"},
+	{"-18446744073709551616", 38, 0, "-18446744073709551616"},
+
+	// malformed
+	{"", 10, 2, ""},
+	{"   ", 10, 2, ""},
+	{".", 10, 2, ""},
+	{"-", 10, 2, ""},
+	{"--1", 10, 2, ""},
+	{"+-1", 10, 2, ""},
+	{"1.2.3", 10, 2, ""},
+	{"1 2", 10, 2, ""},
+	{"1e", 10, 2, ""},
+	{"1e+", 10, 2, ""},
+	{"e5", 10, 2, ""},
+	{"1.5x", 10, 2, ""},
+	{"0x10", 10, 2, ""},
+	{"guten tag", 10, 2, ""},
+	{"1,5", 10, 2, ""},
+}
+
+func TestParseDecimal128(t *testing.T) {
+	for _, c := range decimalTextCases {
+		d, err := ParseDecimal128(c.s, c.width, c.scale)
+		if c.want == "" {
+			require.Error(t, err, c.s)
+			continue
+		}
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.want, FormatDecimal128(d, c.scale), c.s)
+
+		// the formatted value is parsed into the same decimal
+		again, err := ParseDecimal128(c.want, c.width, c.scale)
+		require.NoError(t, err, c.want)
+		require.Equal(t, d, again, c.want)
+	}
+}
+
+func TestParseDecimal64(t *testing.T) {
+	for _, c := range decimalTextCases {
+		if c.width > 18 {
+			continue
+		}
+		d, err := ParseDecimal64(c.s, c.width, c.scale)
+		if c.want == "" {
+			require.Error(t, err, c.s)
+			continue
+		}
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.want, FormatDecimal64(d, c.scale), c.s)
+
+		d128, err := ParseDecimal128(c.s, c.width, c.scale)
+		require.NoError(t, err, c.s)
+		require.Equal(t, Decimal64ToDecimal128(d), d128, c.s)
+	}
+}
+
+func TestParseDecimal128Literal(t *testing.T) {
+	cases := []struct {
+		s     string
+		scale int32
+		want  string
+	}{
+		{"1.23", 2, "1.23"},
+		{"-1.230", 3, "-1.230"},
+		{"123", 0, "123"},
+		{"123.", 0, "123"},
+		{"1.5e-2", 3, "0.015"},
+		{"1.5e2", 0, "150"},
+		{"1.25e1", 1, "12.5"},
+		{"0." + strings.Repeat("1", 40), 38, "0." + strings.Repeat("1", 38)},
+	}
+	for _, c := range cases {
+		d, scale, err := ParseDecimal128Literal(c.s)
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.scale, scale, c.s)
+		require.Equal(t, c.want, FormatDecimal128(d, scale), c.s)
+	}
+	_, _, err := ParseDecimal128Literal("1.2.3")
+	require.Error(t, err)
+}
+
+func TestAppendDecimal(t *testing.T) {
+	buf := []byte("x=")
+	buf = AppendDecimal128(buf, Decimal128{Lo: -1230, Hi: -1}, 2)
+	buf = append(buf, ',')
+	buf = AppendDecimal64(buf, Decimal64(5), 3)
+	buf = append(buf, ',')
+	buf = AppendDecimal64(buf, Decimal64(-9223372036854775808), 18)
+	require.Equal(t, "x=-12.30,0.005,-9.223372036854775808", string(buf))
+
+	// the largest and smallest int128
+	require.Equal(t, "170141183460469231731687303715884105727", FormatDecimal128(Decimal128{Lo: -1, Hi: 1<<63 - 1}, 0))
+	require.Equal(t, "-1.70141183460469231731687303715884105728", FormatDecimal128(Decimal128{Lo: 0, Hi: -1 << 63}, 38))
+}
+
+func TestDecimalToNumber(t *testing.T) {
+	d, _ := ParseDecimal128("-12.345", 10, 3)
+	require.Equal(t, -12.345, Decimal128ToFloat64(d, 3))
+	require.Equal(t, 0.1, Decimal64ToFloat64(Decimal64(1), 1))
+
+	// rounded half away from zero
+	for _, c := range []struct {
+		s     string
+		scale int32
+		x     uint64
+		neg   bool
+	}{
+		{"2.5", 1, 3, false},
+		{"-2.5", 1, 3, true},
+		{"2.49", 2, 2, false},
+		{"-0.4", 1, 0, true},
+		{"0.5", 1, 1, false},
+		{"18446744073709551615.4", 1, 18446744073709551615, false},
+	} {
+		d, err := ParseDecimal128(c.s, 38, c.scale)
+		require.NoError(t, err)
+		x, neg, ok := Decimal128ToUint64(d, c.scale)
+		require.True(t, ok, c.s)
+		require.Equal(t, c.x, x, c.s)
+		require.Equal(t, c.neg, neg, c.s)
+		if c.x < 1e17 {
+			d64, err := ParseDecimal64(c.s, 18, c.scale)
+			require.NoError(t, err)
+			x, neg = Decimal64ToUint64(d64, c.scale)
+			require.Equal(t, c.x, x, c.s)
+			require.Equal(t, c.neg, neg, c.s)
+		}
+	}
+	d, _ = ParseDecimal128("18446744073709551615.5", 38, 1)
+	_, _, ok := Decimal128ToUint64(d, 1)
+	require.False(t, ok)
+	x, neg := Decimal64ToUint64(Decimal64(-9223372036854775808), 0)
+	require.Equal(t, uint64(1<<63), x)
+	require.True(t, neg)
+}
+
+func BenchmarkParseDecimal128(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseDecimal128("-12345678901234567890.123456789", 38, 9)
+	}
+}
+
+func BenchmarkAppendDecimal128(b *testing.B) {
+	d, _ := ParseDecimal128("-12345678901234567890.123456789", 38, 9)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendDecimal128(buf[:0], d, 9)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// time data type:
+// TIME values are not only a time of day, but also an elapsed time or a time
+// interval between two events, so they may be negative or far longer than 24
+// hours. The range is '-838:59:59.000000' to '838:59:59.000000', as in mysql.
+//
+// Internal representation:
+// time values are represented using a 64bit integer counting the microseconds
+// of the duration, negative values are negative durations. So the order of
+// the integers is the order of the time values.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	microSecsPerSec = 1000000
+	maxHourInTime   = 838
+)
+
+const (
+	TimeMaxValue = Time((maxHourInTime*secsPerHour + maxMinuteInHour*secsPerMinute + maxSecondInMinute) * microSecsPerSec)
+	TimeMinValue = -TimeMaxValue
+)
+
+var (
+	errIncorrectTimeValue = errors.New(errno.DataException, "Incorrect time value")
+	errTimeOutOfRange     = errors.New(errno.DataException, "time out of range")
+)
+
+// TimeFromClock gets the time value of a duration given by its fields.
+func TimeFromClock(isNeg bool, hour uint64, minute, sec uint8, msec uint32) Time {
+	t := (int64(hour)*secsPerHour+int64(minute)*secsPerMinute+int64(sec))*microSecsPerSec + int64(msec)
+	if isNeg {
+		return Time(-t)
+	}
+	return Time(t)
+}
+
+// ClockFormat splits t into its sign and fields.
+func (t Time) ClockFormat() (isNeg bool, hour uint64, minute, sec uint8, msec uint32) {
+	v := int64(t)
+	if v < 0 {
+		isNeg = true
+		v = -v
+	}
+	msec = uint32(v % microSecsPerSec)
+	secs := v / microSecsPerSec
+	hour = uint64(secs / secsPerHour)
+	minute = uint8(secs % secsPerHour / secsPerMinute)
+	sec = uint8(secs % secsPerMinute)
+	return
+}
+
+func (t Time) String() string {
+	// like datetime, the microseconds are printed only when there are any
+	isNeg, hour, minute, sec, msec := t.ClockFormat()
+	sign := ""
+	if isNeg {
+		sign = "-"
+	}
+	if msec > 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, hour, minute, sec, msec)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hour, minute, sec)
+}
+
+// String2 stringify time, including its fractional seconds precision part(fsp)
+func (t Time) String2(precision int32) string {
+	isNeg, hour, minute, sec, msec := t.ClockFormat()
+	sign := ""
+	if isNeg {
+		sign = "-"
+	}
+	if precision > 0 {
+		msecInstr := fmt.Sprintf("%06d", msec)[:precision]
+		return fmt.Sprintf("%s%02d:%02d:%02d.%s", sign, hour, minute, sec, msecInstr)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hour, minute, sec)
+}
+
+// ParseTime will parse a string to be a Time, the fractional seconds are
+// rounded to precision digits
+// Support Format:
+// 1. [-][d ]hh:mm:ss(.msec), [-][d ]hh:mm and [-]d hh
+// 2. [-]hhmmss(.msec), [-]mmss(.msec) and [-]ss(.msec)
+func ParseTime(s string, precision int32) (Time, error) {
+	s = strings.TrimSpace(s)
+	isNeg := false
+	if len(s) > 0 && s[0] == '-' {
+		isNeg = true
+		s = s[1:]
+	}
+	msecStr := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, msecStr = s[:i], s[i+1:]
+		if !isDigits(msecStr) {
+			return -1, errIncorrectTimeValue
+		}
+	}
+	var day, hour, minute, sec uint64
+	var err error
+	hasDay := false
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		if day, err = parseTimeField(s[:i]); err != nil {
+			return -1, err
+		}
+		s, hasDay = strings.TrimSpace(s[i+1:]), true
+	}
+	if hasDay || strings.IndexByte(s, ':') >= 0 {
+		fields := strings.Split(s, ":")
+		if len(fields) > 3 || (len(fields) < 3 && len(msecStr) > 0) {
+			return -1, errIncorrectTimeValue
+		}
+		vals := [3]uint64{}
+		for i, field := range fields {
+			if vals[i], err = parseTimeField(field); err != nil {
+				return -1, err
+			}
+		}
+		hour, minute, sec = vals[0], vals[1], vals[2]
+	} else {
+		n, err := parseTimeField(s)
+		if err != nil {
+			return -1, err
+		}
+		hour, minute, sec = n/10000, n/100%100, n%100
+	}
+	if minute > maxMinuteInHour || sec > maxSecondInMinute {
+		return -1, errIncorrectTimeValue
+	}
+	hour += day * 24
+	if hour > maxHourInTime {
+		return -1, errTimeOutOfRange
+	}
+	msec, carry, err := getMsec(msecStr, precision)
+	if err != nil {
+		return -1, errIncorrectTimeValue
+	}
+	t := TimeFromClock(false, hour, uint8(minute), uint8(sec), msec) + Time(carry)*microSecsPerSec
+	if t > TimeMaxValue {
+		return -1, errTimeOutOfRange
+	}
+	if isNeg {
+		return -t, nil
+	}
+	return t, nil
+}
+
+// ParseTimeFromInt64 parses an integer in the form of [-]hhmmss to be a Time.
+func ParseTimeFromInt64(v int64) (Time, error) {
+	isNeg := v < 0
+	n := uint64(v)
+	if isNeg {
+		n = uint64(-v)
+	}
+	hour, minute, sec := n/10000, n/100%100, n%100
+	if minute > maxMinuteInHour || sec > maxSecondInMinute {
+		return -1, errIncorrectTimeValue
+	}
+	if hour > maxHourInTime {
+		return -1, errTimeOutOfRange
+	}
+	return TimeFromClock(isNeg, hour, uint8(minute), uint8(sec), 0), nil
+}
+
+func parseTimeField(s string) (uint64, error) {
+	if !isDigits(s) {
+		return 0, errIncorrectTimeValue
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errTimeOutOfRange
+	}
+	return n, nil
+}
+
+func isDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ToTime returns the time of day part of dt.
+func (dt Datetime) ToTime() Time {
+	return Time((dt.sec()%secsPerDay)*microSecsPerSec + dt.microSec())
+}
+
+// ToDatetime returns the datetime that is t after the start of day d, so
+// negative values and values longer than a day fall into the adjacent days.
+func (t Time) ToDatetime(d Date) Datetime {
+	us := int64(d)*secsPerDay*microSecsPerSec + int64(t)
+	secs, msec := us/microSecsPerSec, us%microSecsPerSec
+	if msec < 0 {
+		secs--
+		msec += microSecsPerSec
+	}
+	return Datetime(secs<<20 + msec)
+}
+
+// DatetimeSub returns the duration from y to x, it is negative if x is
+// before y. An error is returned if it is out of the range of TIME.
+func DatetimeSub(x, y Datetime) (Time, error) {
+	r := (x.sec()-y.sec())*microSecsPerSec + x.microSec() - y.microSec()
+	if r > int64(TimeMaxValue) || r < int64(TimeMinValue) {
+		return 0, errTimeOutOfRange
+	}
+	return Time(r), nil
+}
+
+// AddInterval returns t added by nums units of its, only units not longer
+// than a week make sense for a duration. ok is false for the other units or
+// if the result is out of the range of TIME.
+func (t Time) AddInterval(nums int64, its IntervalType) (Time, bool) {
+	var unit int64
+	switch its {
+	case MicroSecond:
+		unit = 1
+	case Second:
+		unit = microSecsPerSec
+	case Minute:
+		unit = secsPerMinute * microSecsPerSec
+	case Hour:
+		unit = secsPerHour * microSecsPerSec
+	case Day:
+		unit = secsPerDay * microSecsPerSec
+	case Week:
+		unit = 7 * secsPerDay * microSecsPerSec
+	default:
+		return 0, false
+	}
+	if nums > int64(TimeMaxValue-TimeMinValue)/unit || nums < -int64(TimeMaxValue-TimeMinValue)/unit {
+		return 0, false
+	}
+	r := t + Time(nums*unit)
+	if r > TimeMaxValue || r < TimeMinValue {
+		return 0, false
+	}
+	return r, true
+}
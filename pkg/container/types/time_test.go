@@ -0,0 +1,150 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	cases := []struct {
+		s    string
+		want string
+	}{
+		{"12:34:56", "12:34:56"},
+		{"-12:34:56", "-12:34:56"},
+		{"12:34", "12:34:00"},
+		{"1 02:03:04", "26:03:04"},
+		{"-2 1", "-49:00:00"},
+		{"123456", "12:34:56"},
+		{"-3456", "-00:34:56"},
+		{"56", "00:00:56"},
+		{"12:34:56.123", "12:34:56.123000"},
+		{"-00:00:00.5", "-00:00:00.500000"},
+		{"838:59:59", "838:59:59"},
+		{"-838:59:59", "-838:59:59"},
+		{" 01:02:03 ", "01:02:03"},
+	}
+	for _, c := range cases {
+		v, err := ParseTime(c.s, 6)
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.want, v.String(), c.s)
+	}
+
+	for _, s := range []string{"", "-", "12:60:00", "12:00:60", "1:2:3:4", "ab:cd", "12:00.5", "12:00:00.1x"} {
+		_, err := ParseTime(s, 6)
+		require.Error(t, err, s)
+	}
+	for _, s := range []string{"839:00:00", "-838:59:59.6", "35 00:00:00", "8390000"} {
+		_, err := ParseTime(s, 0)
+		require.Error(t, err, s)
+	}
+}
+
+func TestTimePrecision(t *testing.T) {
+	v, err := ParseTime("10:00:00.123456", 3)
+	require.NoError(t, err)
+	require.Equal(t, "10:00:00.123", v.String2(3))
+	require.Equal(t, "10:00:00", v.String2(0))
+	v, err = ParseTime("-10:00:59.5", 0)
+	require.NoError(t, err)
+	require.Equal(t, "-10:01:00", v.String2(0))
+	v, err = ParseTime("838:59:58.7", 0)
+	require.NoError(t, err)
+	require.Equal(t, TimeMaxValue, v)
+}
+
+func TestParseTimeFromInt64(t *testing.T) {
+	v, err := ParseTimeFromInt64(-8385959)
+	require.NoError(t, err)
+	require.Equal(t, TimeMinValue, v)
+	v, err = ParseTimeFromInt64(10203)
+	require.NoError(t, err)
+	require.Equal(t, "01:02:03", v.String())
+	_, err = ParseTimeFromInt64(8390000)
+	require.Error(t, err)
+	_, err = ParseTimeFromInt64(1060)
+	require.Error(t, err)
+}
+
+func TestTimeOrder(t *testing.T) {
+	var vs []Time
+	for _, s := range []string{"01:00:00", "-00:00:01", "-12:00:00", "00:00:00", "00:00:00.000001", "-00:00:00.000001", "838:59:59"} {
+		v, err := ParseTime(s, 6)
+		require.NoError(t, err)
+		vs = append(vs, v)
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+	var rs []string
+	for _, v := range vs {
+		rs = append(rs, v.String())
+	}
+	require.Equal(t, []string{"-12:00:00", "-00:00:01", "-00:00:00.000001", "00:00:00", "00:00:00.000001", "01:00:00", "838:59:59"}, rs)
+}
+
+func TestTimeDatetime(t *testing.T) {
+	dt, err := ParseDatetime("2022-03-04 05:06:07.000008")
+	require.NoError(t, err)
+	require.Equal(t, "05:06:07.000008", dt.ToTime().String())
+
+	d, err := ParseDate("2022-03-04")
+	require.NoError(t, err)
+	v, err := ParseTime("-01:00:00", 6)
+	require.NoError(t, err)
+	require.Equal(t, "2022-03-03 23:00:00", v.ToDatetime(d).String())
+	v, err = ParseTime("25:00:00.5", 6)
+	require.NoError(t, err)
+	require.Equal(t, "2022-03-05 01:00:00.500000", v.ToDatetime(d).String())
+	require.Equal(t, v, v.ToDatetime(d).ToTime()+24*3600*microSecsPerSec)
+}
+
+func TestTimeAddInterval(t *testing.T) {
+	v, err := ParseTime("-00:30:00", 6)
+	require.NoError(t, err)
+	r, ok := v.AddInterval(1, Hour)
+	require.True(t, ok)
+	require.Equal(t, "00:30:00", r.String())
+	r, ok = v.AddInterval(-90, Second)
+	require.True(t, ok)
+	require.Equal(t, "-00:31:30", r.String())
+	_, ok = v.AddInterval(1, Month)
+	require.False(t, ok)
+	_, ok = TimeMaxValue.AddInterval(1, MicroSecond)
+	require.False(t, ok)
+	_, ok = v.AddInterval(1<<62, Week)
+	require.False(t, ok)
+}
+
+func TestDatetimeSub(t *testing.T) {
+	x, err := ParseDatetime("2022-03-04 05:06:07.000008")
+	require.NoError(t, err)
+	y, err := ParseDatetime("2022-03-03 06:00:00.5")
+	require.NoError(t, err)
+	r, err := DatetimeSub(x, y)
+	require.NoError(t, err)
+	require.Equal(t, "23:06:06.500008", r.String())
+	r, err = DatetimeSub(y, x)
+	require.NoError(t, err)
+	require.Equal(t, "-23:06:06.500008", r.String())
+	z, err := ParseDatetime("2022-05-04 05:06:07")
+	require.NoError(t, err)
+	_, err = DatetimeSub(z, x)
+	require.Error(t, err)
+	_, err = DatetimeSub(x, z)
+	require.Error(t, err)
+}
@@ -223,6 +223,23 @@ func TimestampToDatetime(xs []Timestamp, rs []Datetime) ([]Datetime, error) {
 	return rs, nil
 }
 
+// DatetimeToTimestamp converts the datetimes of the local time zone to
+// timestamps
+func DatetimeToTimestamp(xs []Datetime, rs []Timestamp) ([]Timestamp, error) {
+	localTZAligned := localTZ << 20
+	xsInInt64 := *(*[]int64)(unsafe.Pointer(&xs))
+	rsInInt64 := *(*[]int64)(unsafe.Pointer(&rs))
+	for i, x := range xsInInt64 {
+		rsInInt64[i] = x - localTZAligned
+	}
+	return rs, nil
+}
+
+// ToDate returns the date of the timestamp in the local time zone
+func (ts Timestamp) ToDate() Date {
+	return Datetime(int64(ts) + localTZ<<20).ToDate()
+}
+
 // FromClockUTC gets the utc time value in Timestamp
 func FromClockUTC(year int32, month, day, hour, min, sec uint8, msec uint32) Timestamp {
 	days := FromCalendar(year, month, day)
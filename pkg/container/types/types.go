@@ -41,12 +41,16 @@ const (
 	T_uint32 T = T(plan.Type_UINT32)
 	T_uint64 T = T(plan.Type_UINT64)
 
+	// bit family, a BIT(n) value holds n <= 64 bits in a uint64, n is the width
+	T_bit T = T(plan.Type_BIT)
+
 	// numeric/float family - unsigned attribute is deprecated
 	T_float32 T = T(plan.Type_FLOAT32)
 	T_float64 T = T(plan.Type_FLOAT64)
 
 	// date family
 	T_date      T = T(plan.Type_DATE)
+	T_time      T = T(plan.Type_TIME)
 	T_datetime  T = T(plan.Type_DATETIME)
 	T_timestamp T = T(plan.Type_TIMESTAMP)
 	T_interval  T = T(plan.Type_INTERVAL)
@@ -87,6 +91,7 @@ type Bytes struct {
 
 type Date int32
 
+type Time int64
 type Datetime int64
 type Timestamp int64
 
@@ -111,6 +116,8 @@ var Types map[string]T = map[string]T{
 	"integer unsigned":  T_uint32,
 	"bigint unsigned":   T_uint64,
 
+	"bit": T_bit,
+
 	"decimal64":  T_decimal64,
 	"decimal128": T_decimal128,
 
@@ -118,6 +125,7 @@ var Types map[string]T = map[string]T{
 	"double": T_float64,
 
 	"date":      T_date,
+	"time":      T_time,
 	"datetime":  T_datetime,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
@@ -132,6 +140,50 @@ func (t Type) String() string {
 	return t.Oid.String()
 }
 
+// SQLString returns t the way MySQL shows it in SHOW COLUMNS, such as
+// int(11), varchar(100), decimal(20,5) or datetime(6)
+func (t Type) SQLString() string {
+	switch t.Oid {
+	case T_bool:
+		return "tinyint(1)"
+	case T_int8:
+		return "tinyint(4)"
+	case T_int16:
+		return "smallint(6)"
+	case T_int32:
+		return "int(11)"
+	case T_int64:
+		return "bigint(20)"
+	case T_uint8:
+		return "tinyint(3) unsigned"
+	case T_uint16:
+		return "smallint(5) unsigned"
+	case T_uint32:
+		return "int(10) unsigned"
+	case T_uint64:
+		return "bigint(20) unsigned"
+	case T_bit:
+		return fmt.Sprintf("bit(%d)", t.Width)
+	case T_float32:
+		return "float"
+	case T_float64:
+		return "double"
+	case T_decimal64, T_decimal128:
+		return fmt.Sprintf("decimal(%d,%d)", t.Width, t.Scale)
+	case T_char:
+		return fmt.Sprintf("char(%d)", t.Width)
+	case T_varchar:
+		return fmt.Sprintf("varchar(%d)", t.Width)
+	case T_datetime, T_timestamp, T_time:
+		name := strings.ToLower(t.Oid.String())
+		if t.Precision > 0 {
+			return fmt.Sprintf("%s(%d)", name, t.Precision)
+		}
+		return name
+	}
+	return strings.ToLower(t.Oid.String())
+}
+
 func (a Type) Eq(b Type) bool {
 	return a.Oid == b.Oid && a.Size == b.Size && a.Width == b.Width && a.Scale == b.Scale
 }
@@ -149,7 +201,7 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_datetime, T_timestamp, T_time:
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
@@ -157,7 +209,7 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_bit:
 		typ.Size = 8
 	case T_float32:
 		typ.Size = 4
@@ -197,6 +249,8 @@ func (t T) String() string {
 		return "INT UNSIGNED"
 	case T_uint64:
 		return "BIGINT UNSIGNED"
+	case T_bit:
+		return "BIT"
 	case T_float32:
 		return "FLOAT"
 	case T_float64:
@@ -207,6 +261,8 @@ func (t T) String() string {
 		return "DATETIME"
 	case T_timestamp:
 		return "TIMESTAMP"
+	case T_time:
+		return "TIME"
 	case T_char:
 		return "CHAR"
 	case T_varchar:
@@ -252,6 +308,8 @@ func (t T) OidString() string {
 		return "T_uint32"
 	case T_uint64:
 		return "T_uint64"
+	case T_bit:
+		return "T_bit"
 	case T_sel:
 		return "T_sel"
 	case T_char:
@@ -264,6 +322,8 @@ func (t T) OidString() string {
 		return "T_datetime"
 	case T_timestamp:
 		return "T_timestamp"
+	case T_time:
+		return "T_time"
 	case T_decimal64:
 		return "T_decimal64"
 	case T_decimal128:
@@ -295,7 +355,7 @@ func (t T) GoType() string {
 		return "uint16"
 	case T_uint32:
 		return "uint32"
-	case T_uint64:
+	case T_uint64, T_bit:
 		return "uint64"
 	case T_sel:
 		return "int64"
@@ -309,6 +369,8 @@ func (t T) GoType() string {
 		return "datetime"
 	case T_timestamp:
 		return "timestamp"
+	case T_time:
+		return "time"
 	case T_decimal64:
 		return "decimal64"
 	case T_decimal128:
@@ -335,7 +397,7 @@ func (t T) TypeLen() int {
 		return 2
 	case T_int32, T_date:
 		return 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_datetime, T_timestamp, T_time:
 		return 8
 	case T_uint8:
 		return 1
@@ -343,7 +405,7 @@ func (t T) TypeLen() int {
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_bit:
 		return 8
 	case T_float32:
 		return 4
@@ -371,7 +433,7 @@ func (t T) FixedLength() int {
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_bit, T_datetime, T_float64, T_timestamp, T_time:
 		return 8
 	case T_decimal64:
 		return -8
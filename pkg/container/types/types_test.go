@@ -28,6 +28,16 @@ func TestType_String(t *testing.T) {
 	require.Equal(t, "BIGINT", myType.String())
 }
 
+func TestType_SQLString(t *testing.T) {
+	require.Equal(t, "int(11)", Type{Oid: T_int32, Size: 4, Width: 32}.SQLString())
+	require.Equal(t, "bigint(20) unsigned", Type{Oid: T_uint64, Size: 8}.SQLString())
+	require.Equal(t, "varchar(100)", Type{Oid: T_varchar, Size: 24, Width: 100}.SQLString())
+	require.Equal(t, "decimal(20,5)", Type{Oid: T_decimal128, Size: 16, Width: 20, Scale: 5}.SQLString())
+	require.Equal(t, "datetime", Type{Oid: T_datetime, Size: 8}.SQLString())
+	require.Equal(t, "datetime(6)", Type{Oid: T_datetime, Size: 8, Precision: 6}.SQLString())
+	require.Equal(t, "date", Type{Oid: T_date, Size: 4}.SQLString())
+}
+
 func TestType_Eq(t *testing.T) {
 	myType := Type{Oid: T_int64, Size: 8}
 	myType1 := Type{Oid: T_int64, Size: 8}
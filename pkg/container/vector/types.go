@@ -63,6 +63,18 @@ type Vector struct {
 	// some attributes for const vector (a vector with a lot of rows of a same const value)
 	IsConst bool
 	Length  int
+
+	// ref is set if the data is shared by views, see NewView
+	ref *viewRef
+}
+
+// viewRef counts the vectors sharing a data, the last one to be cleaned
+// frees the data, or unpins it if the data is borrowed, see Borrow
+type viewRef struct {
+	cnt   int32
+	or    bool
+	data  []byte
+	unpin func()
 }
 
 // emptyInterface is the header for an interface{} value.
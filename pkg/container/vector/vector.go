@@ -89,7 +89,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint32{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return &Vector{
 			Typ: typ,
 			Col: []uint64{},
@@ -125,6 +125,12 @@ func New(typ types.Type) *Vector {
 			Col: []types.Timestamp{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_time:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Time{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_sel:
 		return &Vector{
 			Typ: typ,
@@ -186,6 +192,11 @@ func (v *Vector) ConstVectorIsNull() bool {
 }
 
 func Reset(v *Vector) {
+	if v.ref != nil && !v.IsScalar() { // leave the shared data alone
+		v.Col = New(v.Typ).Col
+		v.Data = nil
+		return
+	}
 	switch v.Typ.Oid {
 	case types.T_char, types.T_varchar, types.T_json:
 		v.Col.(*types.Bytes).Reset()
@@ -196,6 +207,12 @@ func Reset(v *Vector) {
 
 func Free(v *Vector, m *mheap.Mheap) {
 	v.Ref--
+	if v.ref != nil {
+		if v.Ref == 0 && v.Link == 0 {
+			release(v, m)
+		}
+		return
+	}
 	if !v.Or && v.Data != nil {
 		if v.Ref == 0 && v.Link == 0 {
 			mheap.Free(m, v.Data)
@@ -205,6 +222,10 @@ func Free(v *Vector, m *mheap.Mheap) {
 }
 
 func Clean(v *Vector, m *mheap.Mheap) {
+	if v.ref != nil {
+		release(v, m)
+		return
+	}
 	if !v.Or && v.Data != nil {
 		mheap.Free(m, v.Data)
 		v.Data = nil
@@ -216,6 +237,9 @@ func SetCol(v *Vector, col interface{}) {
 }
 
 func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
+	if v.ref != nil { // the data is replaced
+		release(v, m)
+	}
 	v.Ref = w.Ref
 	switch v.Typ.Oid {
 	case types.T_int8:
@@ -267,7 +291,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeUint32Slice(v.Data)[:0]
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data, err := mheap.Alloc(m, int64(rows*8))
 		if err != nil {
 			return
@@ -309,6 +333,13 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeTimestampSlice(v.Data)[:0]
+	case types.T_time:
+		data, err := mheap.Alloc(m, int64(rows*8))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = encoding.DecodeTimeSlice(v.Data)[:0]
 	case types.T_char, types.T_varchar:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
@@ -366,7 +397,7 @@ func SetLength(v *Vector, n int) {
 	case types.T_uint32:
 		v.Data = v.Data[:n*4]
 		setLengthFixed[uint32](v, n)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[uint64](v, n)
 	case types.T_float32:
@@ -384,6 +415,9 @@ func SetLength(v *Vector, n int) {
 	case types.T_timestamp:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[types.Timestamp](v, n)
+	case types.T_time:
+		v.Data = v.Data[:n*8]
+		setLengthFixed[types.Time](v, n)
 	case types.T_decimal64:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[types.Decimal64](v, n)
@@ -413,6 +447,7 @@ func SetLength(v *Vector, n int) {
 	}
 }
 
+// Dup returns a copy of v, neither the data nor the nulls are shared with v
 func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 	switch v.Typ.Oid {
 	case types.T_bool:
@@ -427,7 +462,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -443,7 +478,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -459,7 +494,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -475,7 +510,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -491,7 +526,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -507,7 +542,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -523,7 +558,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -539,11 +574,11 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -555,7 +590,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -571,7 +606,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -587,7 +622,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -615,7 +650,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -631,7 +666,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -647,7 +682,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -663,7 +698,23 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -679,7 +730,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -695,7 +746,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Col:  ws,
 			Data: data,
 			Typ:  v.Typ,
-			Nsp:  v.Nsp,
+			Nsp:  nulls.Dup(v.Nsp),
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
@@ -730,7 +781,7 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_uint32:
 		w.Col = v.Col.([]uint32)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		w.Col = v.Col.([]uint64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_float32:
@@ -757,6 +808,9 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_timestamp:
 		w.Col = v.Col.([]types.Timestamp)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_time:
+		w.Col = v.Col.([]types.Time)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_decimal64:
 		w.Col = v.Col.([]types.Decimal64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
@@ -795,7 +849,7 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_uint32:
 		v.Col = append(v.Col.([]uint32), arg.([]uint32)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]uint32), 4)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		v.Col = append(v.Col.([]uint64), arg.([]uint64)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]uint64), 8)
 	case types.T_float32:
@@ -813,6 +867,9 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_timestamp:
 		v.Col = append(v.Col.([]types.Timestamp), arg.([]types.Timestamp)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]types.Timestamp), 8)
+	case types.T_time:
+		v.Col = append(v.Col.([]types.Time), arg.([]types.Time)...)
+		v.Data = encoding.EncodeFixedSlice(v.Col.([]types.Time), 8)
 	case types.T_sel:
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_tuple:
@@ -832,6 +889,7 @@ func Append(v *Vector, arg interface{}) error {
 }
 
 func Shrink(v *Vector, sels []int64) {
+	cowInGoHeap(v)
 	switch v.Typ.Oid {
 	case types.T_bool:
 		vs := v.Col.([]bool)
@@ -896,7 +954,7 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*4]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := v.Col.([]uint64)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -968,6 +1026,14 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*8]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Data = v.Data[:len(sels)*8]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		for i, sel := range sels {
@@ -988,6 +1054,9 @@ func Shrink(v *Vector, sels []int64) {
 }
 
 func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
 	switch v.Typ.Oid {
 	case types.T_bool:
 		vs := v.Col.([]bool)
@@ -1076,7 +1145,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*4]
 		mheap.Free(m, data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -1175,6 +1244,17 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*8]
 		mheap.Free(m, data)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		v.Col = shuffle.TimeShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		v.Data = v.Data[:len(sels)*8]
+		mheap.Free(m, data)
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -1205,6 +1285,9 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 
 // v[vi] = w[wi]
 func Copy(v, w *Vector, vi, wi int64, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
 	vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 	data := ws.Data[ws.Offsets[wi] : ws.Offsets[wi]+ws.Lengths[wi]]
 	if vs.Lengths[vi] >= ws.Lengths[wi] {
@@ -1232,6 +1315,9 @@ func Copy(v, w *Vector, vi, wi int64, m *mheap.Mheap) error {
 }
 
 func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
 	if v.Or {
 		return errors.New("UnionOne operation cannot be performed for origin vector")
 	}
@@ -1459,7 +1545,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*4]
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -1664,6 +1750,34 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*8]
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			vs[0] = w.Col.([]types.Time)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Time)[sel])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*8]
+		}
 	case types.T_decimal64:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -1728,6 +1842,9 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 }
 
 func UnionNull(v, w *Vector, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
 	if v.Or {
 		return errors.New("UnionNull operation cannot be performed for origin vector")
 	}
@@ -1947,7 +2064,7 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*4]
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -2114,6 +2231,33 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*8]
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*8]
+		}
 	case types.T_decimal64:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -2174,6 +2318,9 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 }
 
 func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
 	if v.Or {
 		return errors.New("Union operation cannot be performed for origin vector")
 	}
@@ -2347,7 +2494,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		cnt := len(sels)
 		ws := w.Col.([]uint64)
 		vs := v.Col.([]uint64)
@@ -2495,6 +2642,27 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
+	case types.T_time:
+		cnt := len(sels)
+		ws := w.Col.([]types.Time)
+		vs := v.Col.([]types.Time)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeTimeSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		j := n
+		for i, sel := range sels {
+			vs[i] = ws[sel]
+			j++
+		}
+		v.Col = vs
 	}
 	if nulls.Any(w.Nsp) {
 		j := uint64(oldLen)
@@ -2509,6 +2677,9 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 }
 
 func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
 	if v.Or {
 		return errors.New("UnionOne operation cannot be performed for origin vector")
 	}
@@ -2860,7 +3031,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		col := w.Col.([]uint64)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -3169,6 +3340,49 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
+	case types.T_time:
+		col := w.Col.([]types.Time)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
 	case types.T_decimal64:
 		col := w.Col.([]types.Decimal64)
 		if len(v.Data) == 0 {
@@ -3267,6 +3481,125 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 	return nil
 }
 
+// AppendVector appends all the rows of w to v, the data of v is allocated by
+// m and grows geometrically.
+func AppendVector(v, w *Vector, m *mheap.Mheap) error {
+	if err := cow(v, m); err != nil {
+		return err
+	}
+	if v.Or {
+		return errors.New("append operation cannot be performed for origin vector")
+	}
+	if w.IsScalar() {
+		for i := 0; i < w.Length; i++ {
+			if err := UnionOne(v, w, 0, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	n := Length(v)
+	var err error
+	switch v.Typ.Oid {
+	case types.T_bool:
+		err = appendFixed[bool](v, w, 1, m)
+	case types.T_int8:
+		err = appendFixed[int8](v, w, 1, m)
+	case types.T_int16:
+		err = appendFixed[int16](v, w, 2, m)
+	case types.T_int32:
+		err = appendFixed[int32](v, w, 4, m)
+	case types.T_int64:
+		err = appendFixed[int64](v, w, 8, m)
+	case types.T_uint8:
+		err = appendFixed[uint8](v, w, 1, m)
+	case types.T_uint16:
+		err = appendFixed[uint16](v, w, 2, m)
+	case types.T_uint32:
+		err = appendFixed[uint32](v, w, 4, m)
+	case types.T_uint64, types.T_bit:
+		err = appendFixed[uint64](v, w, 8, m)
+	case types.T_float32:
+		err = appendFixed[float32](v, w, 4, m)
+	case types.T_float64:
+		err = appendFixed[float64](v, w, 8, m)
+	case types.T_date:
+		err = appendFixed[types.Date](v, w, 4, m)
+	case types.T_datetime:
+		err = appendFixed[types.Datetime](v, w, 8, m)
+	case types.T_timestamp:
+		err = appendFixed[types.Timestamp](v, w, 8, m)
+	case types.T_time:
+		err = appendFixed[types.Time](v, w, 8, m)
+	case types.T_decimal64:
+		err = appendFixed[types.Decimal64](v, w, 8, m)
+	case types.T_decimal128:
+		err = appendFixed[types.Decimal128](v, w, 16, m)
+	case types.T_char, types.T_varchar, types.T_json:
+		err = appendBytes(v, w, m)
+	default:
+		return fmt.Errorf("unexpect type %s for function vector.AppendVector", v.Typ)
+	}
+	if err != nil {
+		return err
+	}
+	nulls.Append(v.Nsp, w.Nsp, uint64(n))
+	return nil
+}
+
+func appendFixed[T any](v, w *Vector, sz int, m *mheap.Mheap) error {
+	vs, ws := v.Col.([]T), w.Col.([]T)
+	if len(ws) == 0 {
+		return nil
+	}
+	n := len(vs)
+	// the data of a vector built by Append is not allocated by m
+	if v.Data == nil || n+len(ws) > cap(vs) {
+		data, err := mheap.Grow(m, encoding.EncodeFixedSlice(vs, sz), int64((n+len(ws))*sz))
+		if err != nil {
+			return err
+		}
+		if v.Data != nil {
+			mheap.Free(m, v.Data)
+		}
+		v.Data = data
+		vs = encoding.DecodeFixedSlice[T](data, sz)
+	}
+	vs = vs[:n+len(ws)]
+	copy(vs[n:], ws)
+	v.Col = vs
+	return nil
+}
+
+// appendBytes appends the strings of w, whose offsets are rebased to the
+// data of v.
+func appendBytes(v, w *Vector, m *mheap.Mheap) error {
+	vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
+	size := 0
+	for _, length := range ws.Lengths {
+		size += int(length)
+	}
+	if n := len(vs.Data); (v.Data == nil && size > 0) || n+size > cap(vs.Data) {
+		data, err := mheap.Grow(m, vs.Data, int64(n+size))
+		if err != nil {
+			return err
+		}
+		if v.Data != nil {
+			mheap.Free(m, v.Data)
+		}
+		v.Data = data
+		vs.Data = data[:n]
+	}
+	for i := range ws.Offsets {
+		from := ws.Get(int64(i))
+		vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+		vs.Lengths = append(vs.Lengths, uint32(len(from)))
+		vs.Data = append(vs.Data, from...)
+	}
+	v.Col = vs
+	return nil
+}
+
 func (v *Vector) Show() ([]byte, error) {
 	var buf bytes.Buffer
 
@@ -3367,7 +3700,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint32Slice(v.Col.([]uint32)))
 		return buf.Bytes(), nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -3439,6 +3772,18 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeTimestampSlice(v.Col.([]types.Timestamp)))
 		return buf.Bytes(), nil
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeTimeSlice(v.Col.([]types.Time)))
+		return buf.Bytes(), nil
 	case types.T_sel:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
@@ -3626,7 +3971,7 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = encoding.DecodeUint32Slice(data[size:])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
 			v.Data = data[4:]
@@ -3704,6 +4049,19 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = encoding.DecodeTimestampSlice(data[size:])
 		}
+	case types.T_time:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Data = data[4:]
+			v.Col = encoding.DecodeTimeSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Data = data[size:]
+			v.Col = encoding.DecodeTimeSlice(data[size:])
+		}
 	case types.T_char, types.T_varchar, types.T_json:
 		Col := v.Col.(*types.Bytes)
 		Col.Reset()
@@ -3852,7 +4210,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		col := v.Col.([]uint64)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -3906,6 +4264,15 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_time:
+		col := v.Col.([]types.Time)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	case types.T_sel:
 		col := v.Col.([]int64)
 		if len(col) == 1 {
@@ -4180,7 +4547,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := v.Col.([]uint64)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -4370,6 +4737,33 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		for i := 0; i < rows; i++ {
@@ -15,6 +15,8 @@
 package vector
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -1159,6 +1161,125 @@ func TestVector(t *testing.T) {
 }
 */
 
+func TestAppendVector(t *testing.T) {
+	typs := []types.Type{
+		{Oid: types.T_int8, Size: 1},
+		{Oid: types.T_int64, Size: 8},
+		{Oid: types.T_float32, Size: 4},
+		{Oid: types.T_date, Size: 4},
+		{Oid: types.T_datetime, Size: 8},
+		{Oid: types.T_decimal64, Size: 8},
+		{Oid: types.T_decimal128, Size: 16},
+		{Oid: types.T_varchar, Size: 24},
+	}
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	mp := mheap.New(gm)
+	for _, typ := range typs {
+		t.Run(typ.String(), func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				var rows []interface{}
+				var nsp []bool
+				v := New(typ)
+				for j := rand.Intn(5); j >= 0; j-- {
+					w, ws, ns := randomVector(typ, rand.Intn(300))
+					require.NoError(t, AppendVector(v, w, mp))
+					rows = append(rows, ws...)
+					nsp = append(nsp, ns...)
+				}
+				require.Equal(t, len(rows), Length(v))
+				for j := range rows {
+					require.Equal(t, nsp[j], nulls.Contains(v.Nsp, uint64(j)), "row %d", j)
+					require.Equal(t, rows[j], vectorValue(v, j), "row %d", j)
+				}
+				Clean(v, mp)
+				require.Equal(t, int64(0), mheap.Size(mp))
+			}
+		})
+	}
+}
+
+func TestAppendScalar(t *testing.T) {
+	hm := host.New(1 << 20)
+	gm := guest.New(1<<20, hm)
+	mp := mheap.New(gm)
+	typ := types.Type{Oid: types.T_int32, Size: 4}
+	v := New(typ)
+	w := NewConst(typ)
+	w.Col = []int32{7}
+	w.Length = 3
+	require.NoError(t, AppendVector(v, w, mp))
+	require.Equal(t, []int32{7, 7, 7}, v.Col)
+	Clean(v, mp)
+	require.Equal(t, int64(0), mheap.Size(mp))
+}
+
+// randomVector returns a vector of n random rows, its values and nulls
+func randomVector(typ types.Type, n int) (*Vector, []interface{}, []bool) {
+	w := New(typ)
+	vs := make([]interface{}, n)
+	ns := make([]bool, n)
+	for i := 0; i < n; i++ {
+		var arg interface{}
+		x := rand.Int63()
+		switch typ.Oid {
+		case types.T_int8:
+			vs[i] = int8(x)
+			arg = []int8{int8(x)}
+		case types.T_int64:
+			vs[i] = x
+			arg = []int64{x}
+		case types.T_float32:
+			vs[i] = float32(x)
+			arg = []float32{float32(x)}
+		case types.T_date:
+			vs[i] = types.Date(x)
+			arg = []types.Date{types.Date(x)}
+		case types.T_datetime:
+			vs[i] = types.Datetime(x)
+			arg = []types.Datetime{types.Datetime(x)}
+		case types.T_decimal64:
+			vs[i] = types.Decimal64(x)
+			arg = []types.Decimal64{types.Decimal64(x)}
+		case types.T_decimal128:
+			vs[i] = types.Decimal128{Lo: x, Hi: -x}
+			arg = []types.Decimal128{{Lo: x, Hi: -x}}
+		case types.T_varchar:
+			vs[i] = []byte(fmt.Sprintf("%x", x)[:rand.Intn(8)])
+			arg = [][]byte{vs[i].([]byte)}
+		}
+		if err := Append(w, arg); err != nil {
+			panic(err)
+		}
+		if ns[i] = rand.Intn(4) == 0; ns[i] {
+			nulls.Add(w.Nsp, uint64(i))
+		}
+	}
+	return w, vs, ns
+}
+
+func vectorValue(v *Vector, i int) interface{} {
+	switch col := v.Col.(type) {
+	case []int8:
+		return col[i]
+	case []int64:
+		return col[i]
+	case []float32:
+		return col[i]
+	case []types.Date:
+		return col[i]
+	case []types.Datetime:
+		return col[i]
+	case []types.Decimal64:
+		return col[i]
+	case []types.Decimal128:
+		return col[i]
+	case *types.Bytes:
+		return col.Get(int64(i))
+	}
+	panic(fmt.Sprintf("unexpected column %T", v.Col))
+}
+
 func FillVectorData(v *Vector) {
 	switch v.Typ.Oid {
 	case types.T_bool:
@@ -1195,3 +1316,144 @@ func FillVectorData(v *Vector) {
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
 	}
 }
+
+func newViewTestVector(t *testing.T, mp *mheap.Mheap, rows int) *Vector {
+	v := New(types.Type{Oid: types.T_int64, Size: 8})
+	for i := 0; i < rows; i++ {
+		w := New(types.Type{Oid: types.T_int64, Size: 8})
+		w.Col = []int64{int64(i)}
+		require.NoError(t, UnionOne(v, w, 0, mp))
+	}
+	nulls.Add(v.Nsp, 2, 7)
+	return v
+}
+
+func TestNewView(t *testing.T) {
+	hm := host.New(1 << 20)
+	gm := guest.New(1<<20, hm)
+	mp := mheap.New(gm)
+	v := newViewTestVector(t, mp, 10)
+	w := NewView(v, 2, 9)
+	require.Equal(t, []int64{2, 3, 4, 5, 6, 7, 8}, w.Col)
+	require.Equal(t, []int64{2, 3, 4, 5, 6, 7, 8}, DecodeFixedCol[int64](w, 8))
+	require.True(t, nulls.Contains(w.Nsp, 0))
+	require.True(t, nulls.Contains(w.Nsp, 5))
+	require.False(t, nulls.Contains(w.Nsp, 2))
+	// a view of a view
+	x := NewView(w, 1, 3)
+	require.Equal(t, []int64{3, 4}, x.Col)
+	require.False(t, nulls.Any(x.Nsp))
+	require.True(t, IsShared(v) && IsShared(w) && IsShared(x))
+
+	size := mheap.Size(mp)
+	Clean(v, mp)
+	Clean(w, mp)
+	require.Equal(t, size, mheap.Size(mp))
+	require.Equal(t, []int64{3, 4}, x.Col)
+	Clean(x, mp)
+	require.Equal(t, int64(0), mheap.Size(mp))
+
+	s := New(types.Type{Oid: types.T_varchar, Size: 24})
+	s.Col = &types.Bytes{}
+	require.NoError(t, s.Col.(*types.Bytes).Append([][]byte{[]byte("a"), []byte("bc"), []byte("def")}))
+	sw := NewView(s, 1, 3)
+	require.Equal(t, []byte("bc"), sw.Col.(*types.Bytes).Get(0))
+	require.Equal(t, []byte("def"), sw.Col.(*types.Bytes).Get(1))
+	require.NoError(t, Append(sw, [][]byte{[]byte("g")}))
+	require.Equal(t, 3, Length(s))
+	require.Equal(t, []byte("a"), s.Col.(*types.Bytes).Get(0))
+}
+
+func TestViewCopyOnWrite(t *testing.T) {
+	hm := host.New(1 << 20)
+	gm := guest.New(1<<20, hm)
+	mp := mheap.New(gm)
+	v := newViewTestVector(t, mp, 10)
+	w := NewView(v, 0, 5)
+	x := NewView(v, 5, 10)
+
+	// appending to a view doesn't overwrite the rows after it
+	require.NoError(t, UnionOne(w, v, 9, mp))
+	require.Equal(t, []int64{0, 1, 2, 3, 4, 9}, w.Col)
+	require.False(t, IsShared(w))
+	require.Equal(t, []int64{5, 6, 7, 8, 9}, x.Col)
+
+	// shrinking a view leaves the shared data alone
+	Shrink(x, []int64{2, 3})
+	require.Equal(t, []int64{7, 8}, x.Col)
+	require.True(t, nulls.Contains(x.Nsp, 0))
+	require.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, v.Col)
+
+	// so does modifying the vector having views
+	y := NewView(v, 0, 3)
+	require.NoError(t, Shuffle(v, []int64{9, 8}, mp))
+	require.Equal(t, []int64{9, 8}, v.Col)
+	require.Equal(t, []int64{0, 1, 2}, y.Col)
+
+	Reset(y)
+	require.Equal(t, 0, Length(y))
+	for _, vec := range []*Vector{v, w, x, y} {
+		Clean(vec, mp)
+	}
+	require.Equal(t, int64(0), mheap.Size(mp))
+}
+
+func TestViewLifetime(t *testing.T) {
+	hm := host.New(1 << 20)
+	gm := guest.New(1<<20, hm)
+	mp := mheap.New(gm)
+	for i := 0; i < 10; i++ {
+		v := newViewTestVector(t, mp, 64)
+		views := make([]*Vector, 8)
+		for j := range views {
+			views[j] = NewView(v, j*8, j*8+8)
+		}
+		done := make(chan struct{})
+		for j := range views {
+			go func(w *Vector, j int) {
+				defer func() { done <- struct{}{} }()
+				if j%2 == 0 {
+					_ = UnionOne(w, w, 0, mp)
+				}
+				Clean(w, mp)
+			}(views[j], j)
+		}
+		Clean(v, mp)
+		for range views {
+			<-done
+		}
+		require.Equal(t, int64(0), mheap.Size(mp))
+	}
+}
+
+func TestBorrow(t *testing.T) {
+	hm := host.New(1 << 20)
+	gm := guest.New(1<<20, hm)
+	mp := mheap.New(gm)
+	data := make([]byte, 80)
+	col := encoding.DecodeInt64Slice(data)
+	for i := range col {
+		col[i] = int64(i)
+	}
+	unpinned := 0
+	v := New(types.Type{Oid: types.T_int64, Size: 8})
+	v.Data, v.Col = data, col
+	Borrow(v, func() { unpinned++ })
+	require.True(t, IsShared(v))
+	w := NewView(v, 5, 10)
+
+	// modifying the vector copies its rows into the mheap
+	require.NoError(t, Shuffle(v, []int64{1, 3}, mp))
+	require.Equal(t, []int64{1, 3}, v.Col)
+	require.Equal(t, int64(3), col[3])
+	require.Equal(t, 0, unpinned)
+	require.NotEqual(t, int64(0), mheap.Size(mp))
+
+	// the last vector sharing the data unpins it
+	w.Ref = 1
+	Free(w, mp)
+	require.Equal(t, 1, unpinned)
+	Clean(v, mp)
+	require.Equal(t, 1, unpinned)
+	require.Equal(t, int64(0), mheap.Size(mp))
+}
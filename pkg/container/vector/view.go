@@ -0,0 +1,141 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import (
+	"reflect"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewView returns a vector of the rows [start, end) of v without copying
+// the data. v and its views share the data until one of them is modified,
+// which copies its rows first, and the data is freed when v and all its
+// views are cleaned.
+func NewView(v *Vector, start, end int) *Vector {
+	if v.ref == nil {
+		v.ref = &viewRef{cnt: 1, or: v.Or, data: v.Data}
+	}
+	atomic.AddInt32(&v.ref.cnt, 1)
+	w := &Vector{
+		Or:      v.Or,
+		Typ:     v.Typ,
+		IsConst: v.IsConst,
+		ref:     v.ref,
+	}
+	if v.IsScalar() {
+		w.Col = v.Col
+		w.Data = v.Data
+		w.Nsp = v.Nsp
+		w.Length = end - start
+		return w
+	}
+	w.Nsp = nulls.Window(v.Nsp, uint64(start), uint64(end))
+	switch v.Typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		vs := v.Col.(*types.Bytes)
+		// the capacities are cut, so that an append reallocates them
+		w.Col = &types.Bytes{
+			Data:    vs.Data[:len(vs.Data):len(vs.Data)],
+			Offsets: vs.Offsets[start:end:end],
+			Lengths: vs.Lengths[start:end:end],
+		}
+		w.Data = v.Data
+	default:
+		col := reflect.ValueOf(v.Col).Slice3(start, end, end)
+		w.Col = col.Interface()
+		if sz := int(col.Type().Elem().Size()); len(v.Data) >= end*sz {
+			w.Data = v.Data[start*sz : end*sz : end*sz]
+		}
+	}
+	return w
+}
+
+// Borrow makes v a vector sharing the data of its owner, such as the buffer
+// of a storage file. v and its views never modify the data, and the last of
+// them to be cleaned calls unpin instead of freeing the data.
+func Borrow(v *Vector, unpin func()) {
+	v.Or = false
+	v.ref = &viewRef{cnt: 1, data: v.Data, unpin: unpin}
+}
+
+// IsShared returns true if the data of v is shared with its views, or v is a
+// view. Such a vector must be cleaned rather than reused.
+func IsShared(v *Vector) bool {
+	return v.ref != nil
+}
+
+// release gives up the shared data of v
+func release(v *Vector, m *mheap.Mheap) {
+	if r := v.ref; atomic.AddInt32(&r.cnt, -1) == 0 {
+		switch {
+		case r.unpin != nil:
+			r.unpin()
+		case !r.or && r.data != nil:
+			mheap.Free(m, r.data)
+		}
+	}
+	v.ref = nil
+	v.Data = nil
+}
+
+// cow copies the rows of a shared v into its own data allocated by m,
+// before v is modified
+func cow(v *Vector, m *mheap.Mheap) error {
+	if v.ref == nil || v.IsScalar() {
+		return nil
+	}
+	w := New(v.Typ)
+	if err := AppendVector(w, v, m); err != nil {
+		return err
+	}
+	release(v, m)
+	v.Or = false
+	v.Col = w.Col
+	v.Data = w.Data
+	v.Nsp = w.Nsp
+	return nil
+}
+
+// cowInGoHeap is cow for the modifications without a mheap, the copy lives
+// in the go heap and v still refers to the shared data until it is cleaned
+func cowInGoHeap(v *Vector) {
+	if v.ref == nil || v.IsScalar() {
+		return
+	}
+	switch v.Typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		vs := v.Col.(*types.Bytes)
+		v.Col = &types.Bytes{
+			Data:    vs.Data,
+			Offsets: append([]uint32{}, vs.Offsets...),
+			Lengths: append([]uint32{}, vs.Lengths...),
+		}
+	default:
+		vs := reflect.ValueOf(v.Col)
+		ws := reflect.MakeSlice(vs.Type(), vs.Len(), vs.Len())
+		reflect.Copy(ws, vs)
+		v.Col = ws.Interface()
+		if ws.Len() > 0 {
+			v.Data = unsafe.Slice((*byte)(ws.UnsafePointer()), ws.Len()*int(vs.Type().Elem().Size()))
+		} else {
+			v.Data = nil
+		}
+	}
+}
@@ -29,6 +29,7 @@ var TypeSize int
 var DateSize int
 var DatetimeSize int
 var TimestampSize int
+var TimeSize int
 var Decimal64Size int
 var Decimal128Size int
 
@@ -37,6 +38,7 @@ func init() {
 	DateSize = int(unsafe.Sizeof(types.Date(0)))
 	DatetimeSize = int(unsafe.Sizeof(types.Datetime(0)))
 	TimestampSize = int(unsafe.Sizeof(types.Timestamp(0)))
+	TimeSize = int(unsafe.Sizeof(types.Time(0)))
 	Decimal64Size = int(unsafe.Sizeof(types.Decimal64(0)))
 	Decimal128Size = int(unsafe.Sizeof(types.Decimal128{}))
 }
@@ -182,6 +184,14 @@ func DecodeTimestamp(v []byte) types.Timestamp {
 	return *(*types.Timestamp)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeTime(v types.Time) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
+}
+
+func DecodeTime(v []byte) types.Time {
+	return *(*types.Time)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeDecimal64(v types.Decimal64) []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), Decimal64Size)
 }
@@ -338,6 +348,14 @@ func DecodeTimestampSlice(v []byte) (ret []types.Timestamp) {
 	return DecodeFixedSlice[types.Timestamp](v, TimestampSize)
 }
 
+func EncodeTimeSlice(v []types.Time) []byte {
+	return EncodeFixedSlice(v, TimeSize)
+}
+
+func DecodeTimeSlice(v []byte) (ret []types.Time) {
+	return DecodeFixedSlice[types.Time](v, TimeSize)
+}
+
 func EncodeDecimal64Slice(v []types.Decimal64) []byte {
 	return EncodeFixedSlice(v, Decimal64Size)
 }
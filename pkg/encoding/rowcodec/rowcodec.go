@@ -0,0 +1,397 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rowcodec is the canonical encoding of the rows of values, used by
+// the keys of compound indexes and anywhere else a row is a key. The encoding
+// is order-preserving: the bytes.Compare of the encodings of two rows of the
+// same types is the comparison of the rows column by column, NULL first.
+//
+// Each value starts with a NULL ordering byte, a NULL is that byte only.
+//   - ints, date, datetime, timestamp and time are big-endian with the sign
+//     bit flipped, uints are big-endian
+//   - floats are big-endian with the sign bit flipped if positive and all
+//     the bits flipped if negative, -0 is encoded as 0
+//   - decimals are normalized to their significant digits and exponent, so
+//     a value has the same encoding whatever the scale of its column is
+//   - strings are escaped with 0x00 -> 0x00 0xff and terminated by 0x00 0x01
+package rowcodec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	nullTag    byte = 0x00
+	notNullTag byte = 0x01
+)
+
+// the sign byte of a decimal
+const (
+	decimalNeg  byte = 0x01
+	decimalZero byte = 0x02
+	decimalPos  byte = 0x03
+)
+
+const (
+	escape     byte = 0x00
+	escaped00  byte = 0xff
+	terminator byte = 0x01
+)
+
+var ErrCorrupted = errors.New("rowcodec: corrupted encoding")
+
+// EncodeValue appends the encoding of v to dst, v is of the go type of the
+// type, or nil for NULL
+func EncodeValue(dst []byte, typ types.Type, v any) []byte {
+	if v == nil {
+		return append(dst, nullTag)
+	}
+	dst = append(dst, notNullTag)
+	switch typ.Oid {
+	case types.T_bool:
+		if v.(bool) {
+			return append(dst, 1)
+		}
+		return append(dst, 0)
+	case types.T_int8:
+		return append(dst, uint8(v.(int8))^0x80)
+	case types.T_int16:
+		return appendUint16(dst, uint16(v.(int16))^(1<<15))
+	case types.T_int32:
+		return appendUint32(dst, uint32(v.(int32))^(1<<31))
+	case types.T_int64:
+		return encodeInt64(dst, v.(int64))
+	case types.T_uint8:
+		return append(dst, v.(uint8))
+	case types.T_uint16:
+		return appendUint16(dst, v.(uint16))
+	case types.T_uint32:
+		return appendUint32(dst, v.(uint32))
+	case types.T_uint64, types.T_bit:
+		return appendUint64(dst, v.(uint64))
+	case types.T_float32:
+		f := v.(float32)
+		if f == 0 {
+			f = 0
+		}
+		bits := math.Float32bits(f)
+		if bits&(1<<31) != 0 {
+			bits = ^bits
+		} else {
+			bits |= 1 << 31
+		}
+		return appendUint32(dst, bits)
+	case types.T_float64:
+		f := v.(float64)
+		if f == 0 {
+			f = 0
+		}
+		bits := math.Float64bits(f)
+		if bits&(1<<63) != 0 {
+			bits = ^bits
+		} else {
+			bits |= 1 << 63
+		}
+		return appendUint64(dst, bits)
+	case types.T_date:
+		return appendUint32(dst, uint32(v.(types.Date))^(1<<31))
+	case types.T_datetime:
+		return encodeInt64(dst, int64(v.(types.Datetime)))
+	case types.T_timestamp:
+		return encodeInt64(dst, int64(v.(types.Timestamp)))
+	case types.T_time:
+		return encodeInt64(dst, int64(v.(types.Time)))
+	case types.T_decimal64:
+		return encodeDecimal(dst, v.(types.Decimal64).Decimal64ToString(typ.Scale))
+	case types.T_decimal128:
+		return encodeDecimal(dst, v.(types.Decimal128).Decimal128ToString(typ.Scale))
+	case types.T_char, types.T_varchar, types.T_json:
+		return encodeBytes(dst, v.([]byte))
+	}
+	panic(fmt.Sprintf("rowcodec: unsupported type %s", typ))
+}
+
+// EncodeRow appends the encoding of the row to dst, the value at i is of the
+// type at i
+func EncodeRow(dst []byte, typs []types.Type, vals []any) []byte {
+	for i, v := range vals {
+		dst = EncodeValue(dst, typs[i], v)
+	}
+	return dst
+}
+
+// DecodeValue decodes a value of the type from the head of src, it returns
+// the value, nil for NULL, and the rest of src
+func DecodeValue(src []byte, typ types.Type) (v any, rest []byte, err error) {
+	if len(src) == 0 {
+		return nil, nil, ErrCorrupted
+	}
+	switch src[0] {
+	case nullTag:
+		return nil, src[1:], nil
+	case notNullTag:
+		src = src[1:]
+	default:
+		return nil, nil, ErrCorrupted
+	}
+	if size := fixedSize(typ.Oid); size > 0 {
+		if len(src) < size {
+			return nil, nil, ErrCorrupted
+		}
+		return decodeFixed(src[:size], typ.Oid), src[size:], nil
+	}
+	switch typ.Oid {
+	case types.T_decimal64, types.T_decimal128:
+		return decodeDecimal(src, typ)
+	case types.T_char, types.T_varchar, types.T_json:
+		return decodeBytes(src)
+	}
+	return nil, nil, fmt.Errorf("rowcodec: unsupported type %s", typ)
+}
+
+// DecodeRow decodes a row of the types
+func DecodeRow(src []byte, typs []types.Type) ([]any, error) {
+	vals := make([]any, len(typs))
+	var err error
+	for i, typ := range typs {
+		if vals[i], src, err = DecodeValue(src, typ); err != nil {
+			return nil, err
+		}
+	}
+	if len(src) != 0 {
+		return nil, ErrCorrupted
+	}
+	return vals, nil
+}
+
+func appendUint16(dst []byte, v uint16) []byte {
+	return append(dst, byte(v>>8), byte(v))
+}
+
+func appendUint32(dst []byte, v uint32) []byte {
+	return append(dst, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint64(dst []byte, v uint64) []byte {
+	return appendUint32(appendUint32(dst, uint32(v>>32)), uint32(v))
+}
+
+func encodeInt64(dst []byte, v int64) []byte {
+	return appendUint64(dst, uint64(v)^(1<<63))
+}
+
+// fixedSize returns the size of the encoding of a value of the type without
+// the NULL ordering byte, 0 if the size is not fixed
+func fixedSize(oid types.T) int {
+	switch oid {
+	case types.T_bool, types.T_int8, types.T_uint8:
+		return 1
+	case types.T_int16, types.T_uint16:
+		return 2
+	case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
+		return 4
+	case types.T_int64, types.T_uint64, types.T_bit, types.T_float64, types.T_datetime, types.T_timestamp, types.T_time:
+		return 8
+	}
+	return 0
+}
+
+func decodeFixed(src []byte, oid types.T) any {
+	switch oid {
+	case types.T_bool:
+		return src[0] != 0
+	case types.T_int8:
+		return int8(src[0] ^ 0x80)
+	case types.T_int16:
+		return int16(binary.BigEndian.Uint16(src) ^ (1 << 15))
+	case types.T_int32:
+		return int32(binary.BigEndian.Uint32(src) ^ (1 << 31))
+	case types.T_int64:
+		return decodeInt64(src)
+	case types.T_uint8:
+		return src[0]
+	case types.T_uint16:
+		return binary.BigEndian.Uint16(src)
+	case types.T_uint32:
+		return binary.BigEndian.Uint32(src)
+	case types.T_uint64, types.T_bit:
+		return binary.BigEndian.Uint64(src)
+	case types.T_float32:
+		bits := binary.BigEndian.Uint32(src)
+		if bits&(1<<31) != 0 {
+			bits &^= 1 << 31
+		} else {
+			bits = ^bits
+		}
+		return math.Float32frombits(bits)
+	case types.T_float64:
+		bits := binary.BigEndian.Uint64(src)
+		if bits&(1<<63) != 0 {
+			bits &^= 1 << 63
+		} else {
+			bits = ^bits
+		}
+		return math.Float64frombits(bits)
+	case types.T_date:
+		return types.Date(binary.BigEndian.Uint32(src) ^ (1 << 31))
+	case types.T_datetime:
+		return types.Datetime(decodeInt64(src))
+	case types.T_timestamp:
+		return types.Timestamp(decodeInt64(src))
+	default:
+		return types.Time(decodeInt64(src))
+	}
+}
+
+func decodeInt64(src []byte) int64 {
+	return int64(binary.BigEndian.Uint64(src) ^ (1 << 63))
+}
+
+func encodeBytes(dst []byte, v []byte) []byte {
+	for {
+		i := bytes.IndexByte(v, escape)
+		if i < 0 {
+			break
+		}
+		dst = append(dst, v[:i]...)
+		dst = append(dst, escape, escaped00)
+		v = v[i+1:]
+	}
+	dst = append(dst, v...)
+	return append(dst, escape, terminator)
+}
+
+func decodeBytes(src []byte) (any, []byte, error) {
+	v := make([]byte, 0, len(src))
+	for {
+		i := bytes.IndexByte(src, escape)
+		if i < 0 || i+1 >= len(src) {
+			return nil, nil, ErrCorrupted
+		}
+		v = append(v, src[:i]...)
+		switch src[i+1] {
+		case terminator:
+			return v, src[i+2:], nil
+		case escaped00:
+			v = append(v, 0)
+			src = src[i+2:]
+		default:
+			return nil, nil, ErrCorrupted
+		}
+	}
+}
+
+// encodeDecimal appends the encoding of a decimal by its string. The value
+// is 0.d1d2...dn * 10^e where d1 and dn are not zero, it is encoded as the
+// sign byte, e and the digits terminated by 0x00, the bytes after the sign
+// byte are flipped for a negative value.
+func encodeDecimal(dst []byte, s []byte) []byte {
+	neg := len(s) > 0 && s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	point := bytes.IndexByte(s, '.')
+	if point < 0 {
+		point = len(s)
+	}
+	digits := make([]byte, 0, len(s))
+	digits = append(digits, s[:point]...)
+	if point < len(s) {
+		digits = append(digits, s[point+1:]...)
+	}
+	exp := point
+	for len(digits) > 0 && digits[0] == '0' {
+		digits = digits[1:]
+		exp--
+	}
+	digits = bytes.TrimRight(digits, "0")
+	if len(digits) == 0 {
+		return append(dst, decimalZero)
+	}
+	if neg {
+		dst = append(dst, decimalNeg)
+	} else {
+		dst = append(dst, decimalPos)
+	}
+	start := len(dst)
+	dst = appendUint16(dst, uint16(int16(exp))^(1<<15))
+	dst = append(dst, digits...)
+	dst = append(dst, 0)
+	if neg {
+		for i := start; i < len(dst); i++ {
+			dst[i] = ^dst[i]
+		}
+	}
+	return dst
+}
+
+func decodeDecimal(src []byte, typ types.Type) (any, []byte, error) {
+	if len(src) == 0 {
+		return nil, nil, ErrCorrupted
+	}
+	s := "0"
+	rest := src[1:]
+	switch src[0] {
+	case decimalZero:
+	case decimalNeg, decimalPos:
+		neg := src[0] == decimalNeg
+		var end byte
+		if neg {
+			end = 0xff
+		}
+		if len(rest) < 2 {
+			return nil, nil, ErrCorrupted
+		}
+		// the bytes of e may be the terminator
+		i := bytes.IndexByte(rest[2:], end)
+		if i < 0 {
+			return nil, nil, ErrCorrupted
+		}
+		i += 2
+		body := make([]byte, i)
+		copy(body, rest[:i])
+		rest = rest[i+1:]
+		if neg {
+			for j := range body {
+				body[j] = ^body[j]
+			}
+		}
+		exp := int16(binary.BigEndian.Uint16(body) ^ (1 << 15))
+		s = "0." + string(body[2:]) + "e" + strconv.Itoa(int(exp))
+		if neg {
+			s = "-" + s
+		}
+	default:
+		return nil, nil, ErrCorrupted
+	}
+	if typ.Oid == types.T_decimal64 {
+		v, err := types.ParseStringToDecimal64(s, 18, typ.Scale)
+		if err != nil {
+			return nil, nil, err
+		}
+		return v, rest, nil
+	}
+	v, err := types.ParseStringToDecimal128(s, 38, typ.Scale)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, rest, nil
+}
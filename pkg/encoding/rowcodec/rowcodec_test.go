@@ -0,0 +1,236 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowcodec
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testTypes = []types.Type{
+	{Oid: types.T_bool},
+	{Oid: types.T_int8},
+	{Oid: types.T_int16},
+	{Oid: types.T_int32},
+	{Oid: types.T_int64},
+	{Oid: types.T_uint8},
+	{Oid: types.T_uint16},
+	{Oid: types.T_uint32},
+	{Oid: types.T_uint64},
+	{Oid: types.T_float32},
+	{Oid: types.T_float64},
+	{Oid: types.T_date},
+	{Oid: types.T_datetime},
+	{Oid: types.T_timestamp},
+	{Oid: types.T_time},
+	{Oid: types.T_decimal64, Width: 18, Scale: 2},
+	{Oid: types.T_decimal128, Width: 38, Scale: 5},
+	{Oid: types.T_varchar, Width: 10},
+	{Oid: types.T_char, Width: 10},
+}
+
+// randValue returns a random value of the type, the values are drawn from
+// small ranges to have many equal values
+func randValue(r *rand.Rand, typ types.Type) any {
+	if r.Intn(10) == 0 {
+		return nil
+	}
+	n := r.Int63n(7) - 3
+	switch typ.Oid {
+	case types.T_bool:
+		return n > 0
+	case types.T_int8:
+		return []int8{math.MinInt8, -1, 0, 1, math.MaxInt8}[r.Intn(5)]
+	case types.T_int16:
+		return int16(n * 1000)
+	case types.T_int32:
+		return []int32{math.MinInt32, -256, -1, 0, 1, 256, math.MaxInt32}[r.Intn(7)]
+	case types.T_int64:
+		return []int64{math.MinInt64, -1 << 40, -1, 0, 1, 1 << 40, math.MaxInt64}[r.Intn(7)]
+	case types.T_uint8:
+		return uint8(n + 3)
+	case types.T_uint16:
+		return uint16(n+3) << 8
+	case types.T_uint32:
+		return []uint32{0, 1, 255, 256, math.MaxUint32}[r.Intn(5)]
+	case types.T_uint64:
+		return []uint64{0, 1, 1 << 40, math.MaxUint64}[r.Intn(4)]
+	case types.T_float32:
+		return []float32{float32(math.Inf(-1)), -1.5, -1e-30, 0, float32(math.Copysign(0, -1)), 1e-30, 2.25, math.MaxFloat32}[r.Intn(8)]
+	case types.T_float64:
+		return []float64{math.Inf(-1), -1e300, -0.5, math.Copysign(0, -1), 0, 0.5, 3, math.Inf(1)}[r.Intn(8)]
+	case types.T_date:
+		return types.Date(n * 100)
+	case types.T_datetime:
+		return types.Datetime(n << 40)
+	case types.T_timestamp:
+		return types.Timestamp(n * 7)
+	case types.T_time:
+		return types.Time(n)
+	case types.T_decimal64:
+		return types.Decimal64([]int64{-100000, -150, -15, -1, 0, 1, 10, 150, 1500, 99999999}[r.Intn(10)])
+	case types.T_decimal128:
+		return types.InitDecimal128([]int64{math.MinInt64, -100000, -1, 0, 1, 50, 500, 123456789, math.MaxInt64}[r.Intn(9)])
+	default:
+		return [][]byte{{}, {0}, {0, 0}, {0, 1}, {1}, []byte("a"), []byte("a\x00b"), []byte("ab"), {0xff}, {0xff, 0}}[r.Intn(10)]
+	}
+}
+
+func compareValue(typ types.Type, a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	cmp := func(less, greater bool) int {
+		if less {
+			return -1
+		}
+		if greater {
+			return 1
+		}
+		return 0
+	}
+	switch x := a.(type) {
+	case bool:
+		y := b.(bool)
+		return cmp(!x && y, x && !y)
+	case int8:
+		return cmp(x < b.(int8), x > b.(int8))
+	case int16:
+		return cmp(x < b.(int16), x > b.(int16))
+	case int32:
+		return cmp(x < b.(int32), x > b.(int32))
+	case int64:
+		return cmp(x < b.(int64), x > b.(int64))
+	case uint8:
+		return cmp(x < b.(uint8), x > b.(uint8))
+	case uint16:
+		return cmp(x < b.(uint16), x > b.(uint16))
+	case uint32:
+		return cmp(x < b.(uint32), x > b.(uint32))
+	case uint64:
+		return cmp(x < b.(uint64), x > b.(uint64))
+	case float32:
+		return cmp(x < b.(float32), x > b.(float32))
+	case float64:
+		return cmp(x < b.(float64), x > b.(float64))
+	case types.Date:
+		return cmp(x < b.(types.Date), x > b.(types.Date))
+	case types.Datetime:
+		return cmp(x < b.(types.Datetime), x > b.(types.Datetime))
+	case types.Timestamp:
+		return cmp(x < b.(types.Timestamp), x > b.(types.Timestamp))
+	case types.Time:
+		return cmp(x < b.(types.Time), x > b.(types.Time))
+	case types.Decimal64:
+		c := types.CompareDecimal64Decimal64(x, b.(types.Decimal64), typ.Scale, typ.Scale)
+		return cmp(c < 0, c > 0)
+	case types.Decimal128:
+		c := types.CompareDecimal128Decimal128(x, b.(types.Decimal128), typ.Scale, typ.Scale)
+		return cmp(c < 0, c > 0)
+	default:
+		return bytes.Compare(a.([]byte), b.([]byte))
+	}
+}
+
+func compareRow(typs []types.Type, a, b []any) int {
+	for i, typ := range typs {
+		if c := compareValue(typ, a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func TestOrderPreserving(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for round := 0; round < 200; round++ {
+		typs := make([]types.Type, 1+r.Intn(4))
+		for i := range typs {
+			typs[i] = testTypes[r.Intn(len(testTypes))]
+		}
+		rows := make([][]any, 50)
+		keys := make([][]byte, len(rows))
+		for i := range rows {
+			rows[i] = make([]any, len(typs))
+			for j, typ := range typs {
+				rows[i][j] = randValue(r, typ)
+			}
+			keys[i] = EncodeRow(nil, typs, rows[i])
+		}
+		for i := range rows {
+			for j := range rows {
+				require.Equal(t, compareRow(typs, rows[i], rows[j]), bytes.Compare(keys[i], keys[j]), "%v: %v vs %v", typs, rows[i], rows[j])
+			}
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for round := 0; round < 1000; round++ {
+		typs := make([]types.Type, 1+r.Intn(5))
+		row := make([]any, len(typs))
+		for i := range typs {
+			typs[i] = testTypes[r.Intn(len(testTypes))]
+			row[i] = randValue(r, typs[i])
+		}
+		key := EncodeRow(nil, typs, row)
+		decoded, err := DecodeRow(key, typs)
+		require.NoError(t, err)
+		for i, typ := range typs {
+			if f, ok := row[i].(float64); ok && f == 0 {
+				// -0 is decoded as 0
+				row[i] = float64(0)
+			}
+			if f, ok := row[i].(float32); ok && f == 0 {
+				row[i] = float32(0)
+			}
+			require.Equal(t, row[i], decoded[i], "%s", typ)
+		}
+	}
+
+	_, err := DecodeRow([]byte{notNullTag, 0, 0, 0}, []types.Type{{Oid: types.T_int32}})
+	require.Error(t, err)
+	_, err = DecodeRow([]byte{notNullTag, 'a'}, []types.Type{{Oid: types.T_varchar}})
+	require.Error(t, err)
+	_, err = DecodeRow(EncodeValue(nil, types.Type{Oid: types.T_int8}, int8(1)), []types.Type{})
+	require.Error(t, err)
+}
+
+func TestDecimalScaleNormalized(t *testing.T) {
+	d1 := types.Decimal64(150)
+	d2 := types.Decimal64(15)
+	require.Equal(t,
+		EncodeValue(nil, types.Type{Oid: types.T_decimal64, Scale: 2}, d1),
+		EncodeValue(nil, types.Type{Oid: types.T_decimal64, Scale: 1}, d2))
+	require.Equal(t,
+		EncodeValue(nil, types.Type{Oid: types.T_decimal64, Scale: 1}, d2),
+		EncodeValue(nil, types.Type{Oid: types.T_decimal128, Scale: 4}, types.InitDecimal128(15000)))
+
+	// decoded to the scale of the column
+	v, _, err := DecodeValue(EncodeValue(nil, types.Type{Oid: types.T_decimal64, Scale: 1}, d2), types.Type{Oid: types.T_decimal64, Scale: 3})
+	require.NoError(t, err)
+	require.Equal(t, types.Decimal64(1500), v)
+}
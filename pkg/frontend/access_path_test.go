@@ -0,0 +1,279 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessPath(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	conn := openAccountDB(t, port, "root", "")
+	_, err = conn.Exec("create database path_db")
+	require.NoError(t, err)
+
+	// a table of 4 blocks sorted by the primary key mock_0
+	schema := catalog.MockSchema(3, 0)
+	schema.Name = "t"
+	schema.BlockMaxRows = 10
+	bat := catalog.MockData(schema, 40)
+	c0 := make([]int32, 40)
+	c1 := make([]int32, 40)
+	for i := range c0 {
+		c0[i] = int32(i)
+		c1[i] = int32(i % 10)
+	}
+	vector.SetCol(bat.Vecs[0], c0)
+	vector.SetCol(bat.Vecs[1], c1)
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase("path_db")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(bat))
+	require.NoError(t, txn.Commit())
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("path_db")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	table := rel.GetMeta().(*catalog.TableEntry)
+	var metas []*catalog.BlockEntry
+	for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+		metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+	}
+	require.NoError(t, txn.Commit())
+	for _, meta := range metas {
+		txn, err = tae.StartTxn(nil)
+		require.NoError(t, err)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec())
+		require.NoError(t, txn.Commit())
+	}
+
+	_, err = conn.Exec("use path_db")
+	require.NoError(t, err)
+	explain := func(query string) string {
+		for _, line := range queryStrings(t, conn, "explain "+query) {
+			if strings.Contains(line, "Access Path:") {
+				return strings.TrimSpace(line)
+			}
+		}
+		return ""
+	}
+	require.Contains(t, explain("select mock_1 from t where mock_0 = 25"), "Access Path: zonemap scan on mock_0")
+	pruned := table.GetPrunedBlocks()
+	require.Equal(t, []string{"5"}, queryStrings(t, conn, "select mock_1 from t where mock_0 = 25"))
+	require.Equal(t, uint64(3), table.GetPrunedBlocks()-pruned)
+	require.Equal(t, []string{"39"}, queryStrings(t, conn, "select mock_0 from t where mock_0 = 39 and mock_1 = 9"))
+	require.Empty(t, queryStrings(t, conn, "select mock_1 from t where mock_0 = 100"))
+	// the aggregates of the rows of no block read
+	require.Equal(t, []string{"0"}, queryStrings(t, conn, "select count(*) from t where mock_0 = 100"))
+
+	// the zonemaps of the unsorted column prune nothing
+	require.Contains(t, explain("select mock_0 from t where mock_1 = 5"), "Access Path: full scan on mock_1")
+	require.Equal(t, []string{"4"}, queryStrings(t, conn, "select count(*) from t where mock_1 = 5"))
+	require.Equal(t, "", explain("select mock_0 from t where mock_0 > 25"))
+}
+
+func TestPrefixAccessPath(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	conn := openAccountDB(t, port, "root", "")
+	_, err = conn.Exec("create database prefix_db")
+	require.NoError(t, err)
+
+	// a table of 4 blocks sorted by the primary key k
+	schema := catalog.NewEmptySchema("t")
+	require.NoError(t, schema.AppendPKCol("k", types.T_varchar.ToType(), 0))
+	require.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	require.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	bat := catalog.MockData(schema, 40)
+	keys := &types.Bytes{}
+	vs := make([]int32, 40)
+	for i := range vs {
+		key := fmt.Sprintf("k%02d", i)
+		keys.Offsets = append(keys.Offsets, uint32(len(keys.Data)))
+		keys.Lengths = append(keys.Lengths, uint32(len(key)))
+		keys.Data = append(keys.Data, key...)
+		vs[i] = int32(i)
+	}
+	vector.SetCol(bat.Vecs[0], keys)
+	vector.SetCol(bat.Vecs[1], vs)
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase("prefix_db")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(bat))
+	require.NoError(t, txn.Commit())
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("prefix_db")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	table := rel.GetMeta().(*catalog.TableEntry)
+	var metas []*catalog.BlockEntry
+	for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+		metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+	}
+	require.NoError(t, txn.Commit())
+	for _, meta := range metas {
+		txn, err = tae.StartTxn(nil)
+		require.NoError(t, err)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec())
+		require.NoError(t, txn.Commit())
+	}
+
+	_, err = conn.Exec("use prefix_db")
+	require.NoError(t, err)
+	explain := func(query string) string {
+		for _, line := range queryStrings(t, conn, "explain "+query) {
+			if strings.Contains(line, "Access Path:") {
+				return strings.TrimSpace(line)
+			}
+		}
+		return ""
+	}
+	require.Contains(t, explain("select v from t where k like 'k2%'"), "Access Path: zonemap scan on k prefix 'k2'")
+	pruned := table.GetPrunedBlocks()
+	require.Equal(t, []string{"10"}, queryStrings(t, conn, "select count(*) from t where k like 'k2%'"))
+	require.Equal(t, uint64(3), table.GetPrunedBlocks()-pruned)
+	require.Equal(t, []string{"25"}, queryStrings(t, conn, "select v from t where k like 'k25%'"))
+	require.Empty(t, queryStrings(t, conn, "select v from t where k like 'k5%'"))
+
+	// the other patterns scan all the blocks
+	require.Equal(t, "", explain("select v from t where k like '%5'"))
+	require.Equal(t, []string{"4"}, queryStrings(t, conn, "select count(*) from t where k like '%5'"))
+	require.Equal(t, []string{"4"}, queryStrings(t, conn, "select count(*) from t where k like 'k_5'"))
+}
+
+func TestRangeAccessPath(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	conn := openAccountDB(t, port, "root", "")
+	_, err = conn.Exec("create database range_db")
+	require.NoError(t, err)
+
+	// a time series of 4 blocks without a primary key, the day of row i is
+	// 2022-01-01 + i
+	schema := catalog.NewEmptySchema("t")
+	require.NoError(t, schema.AppendCol("d", types.T_date.ToType()))
+	require.NoError(t, schema.AppendCol("dt", types.T_datetime.ToType()))
+	require.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	require.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	bat := catalog.MockData(schema, 40)
+	first, err := types.ParseDate("2022-01-01")
+	require.NoError(t, err)
+	ds := make([]types.Date, 40)
+	dts := make([]types.Datetime, 40)
+	vs := make([]int32, 40)
+	for i := range ds {
+		ds[i] = first + types.Date(i)
+		dts[i] = ds[i].ToTime()
+		vs[i] = int32(i)
+	}
+	vector.SetCol(bat.Vecs[0], ds)
+	vector.SetCol(bat.Vecs[1], dts)
+	vector.SetCol(bat.Vecs[2], vs)
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase("range_db")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(bat))
+	require.NoError(t, txn.Commit())
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("range_db")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	table := rel.GetMeta().(*catalog.TableEntry)
+	var metas []*catalog.BlockEntry
+	for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+		metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+	}
+	require.NoError(t, txn.Commit())
+	for _, meta := range metas {
+		txn, err = tae.StartTxn(nil)
+		require.NoError(t, err)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec())
+		require.NoError(t, txn.Commit())
+	}
+
+	_, err = conn.Exec("use range_db")
+	require.NoError(t, err)
+	explain := func(query string) string {
+		for _, line := range queryStrings(t, conn, "explain "+query) {
+			if strings.Contains(line, "Access Path:") {
+				return strings.TrimSpace(line)
+			}
+		}
+		return ""
+	}
+	skipped := func(query string, want []string, blocks uint64) {
+		pruned := table.GetPrunedBlocks()
+		require.Equal(t, want, queryStrings(t, conn, query), query)
+		require.Equal(t, blocks, table.GetPrunedBlocks()-pruned, query)
+	}
+
+	// a closed interval
+	query := "select count(*) from t where d between '2022-01-11' and '2022-01-20'"
+	require.Contains(t, explain(query), "Access Path: zonemap scan on d range [2022-01-11, 2022-01-20]")
+	skipped(query, []string{"10"}, 3)
+	skipped("select count(*) from t where d >= '2022-01-15' and d < '2022-01-25'", []string{"10"}, 2)
+	skipped("select count(*) from t where dt > '2022-01-05 12:00:00' and '2022-01-08' >= dt", []string{"3"}, 3)
+
+	// an open interval
+	query = "select count(*) from t where dt >= '2022-02-01'"
+	require.Contains(t, explain(query), "Access Path: zonemap scan on dt range [2022-02-01 00:00:00, +inf)")
+	skipped(query, []string{"9"}, 3)
+	skipped("select count(*) from t where d < '2022-01-05'", []string{"4"}, 3)
+
+	// out of the range of the table
+	skipped("select count(*) from t where d > '2022-03-01'", []string{"0"}, 4)
+	skipped("select count(*) from t where dt between '2021-01-01' and '2021-12-31'", []string{"0"}, 4)
+
+	// the zonemaps prune nothing of a range covering the table
+	require.Contains(t, explain("select v from t where d >= '2021-12-01'"), "Access Path: full scan on d range [2021-12-01, +inf)")
+	skipped("select count(*) from t where d >= '2021-12-01'", []string{"40"}, 0)
+	require.Equal(t, "", explain("select v from t where d >= '2022-01-15' or v = 3"))
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitType(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database bit_db",
+		"use bit_db",
+		"create table t (a int, b bit(4), c bit, d bit(64))",
+		"insert into t values (1, b'1010', b'1', b'1111111111111111111111111111111111111111111111111111111111111111')",
+		"insert into t values (2, 3, 0, 0)",
+		"insert into t values (3, 0b1111, 1, 256)",
+		"insert into t values (4, x'0c', b'0', 0x0102)",
+		"insert into t values (5, null, null, null)",
+	)
+
+	t.Run("width", func(t *testing.T) {
+		for _, stmt := range []string{
+			"insert into t values (6, b'10000', 0, 0)",
+			"insert into t values (6, 16, 0, 0)",
+			"insert into t values (6, 0, 2, 0)",
+			"insert into t values (6, -1, 0, 0)",
+			"insert into t values (6, 0, 0, x'010000000000000000')",
+		} {
+			_, err := db.Exec(stmt)
+			require.Error(t, err, stmt)
+		}
+		_, err := db.Exec("create table u (b bit(65))")
+		require.Error(t, err)
+		require.Equal(t, []string{"5"}, queryStrings(t, db, "select count(*) from t"))
+	})
+
+	t.Run("select", func(t *testing.T) {
+		// a bit is sent as the binary string of its bytes
+		require.Equal(t, []string{"\x0a", "\x03", "\x0f", "\x0c"}, queryStrings(t, db, "select b from t where a < 5 order by a"))
+		require.Equal(t, []string{"\xff\xff\xff\xff\xff\xff\xff\xff", "\x00\x00\x00\x00\x00\x00\x00\x00"}, queryStrings(t, db, "select d from t where a < 3 order by a"))
+		require.Equal(t, []string{"10|1|18446744073709551615", "3|0|0", "15|1|256", "12|0|258", "NULL|NULL|NULL"},
+			queryRows(t, db, "select b + 0, c + 0, d + 0 from t order by a"))
+	})
+
+	t.Run("order by", func(t *testing.T) {
+		require.Equal(t, []string{"2", "1", "4", "3"}, queryStrings(t, db, "select a from t where a < 5 order by b"))
+		require.Equal(t, []string{"1", "4", "3", "2"}, queryStrings(t, db, "select a from t where a < 5 order by d desc"))
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		require.Equal(t, []string{"1"}, queryStrings(t, db, "select a from t where b = b'1010'"))
+		require.Equal(t, []string{"3", "4"}, queryStrings(t, db, "select a from t where b > 10 order by a"))
+		require.Equal(t, []string{"1"}, queryStrings(t, db, "select a from t where d > 0x8000000000000000"))
+		require.Equal(t, []string{"1", "3"}, queryStrings(t, db, "select a from t where c = b'1' order by a"))
+		require.Equal(t, []string{"1", "3", "4"}, queryStrings(t, db, "select a from t where b < d order by a"))
+	})
+
+	t.Run("cast", func(t *testing.T) {
+		require.Equal(t, []string{"10|10"}, queryRows(t, db, "select cast(b as unsigned), cast(b as signed) from t where a = 1"))
+		require.Equal(t, []string{"\x0a"}, queryStrings(t, db, "select cast(b as char) from t where a = 1"))
+		execAll(t, db,
+			"create table v (a int, b bit(12))",
+			"insert into v values (1, 400), (2, 4095), (3, '1'), (4, 300)",
+		)
+		require.Equal(t, []string{"49", "300", "400", "4095"}, queryStrings(t, db, "select b + 0 from v order by b"))
+		_, err := db.Exec("insert into v values (5, 4096)")
+		require.Error(t, err)
+	})
+
+	t.Run("bit aggregates", func(t *testing.T) {
+		require.Equal(t, []string{"0|15|10"}, queryRows(t, db, "select bit_and(b), bit_or(b), bit_xor(b) from t"))
+	})
+
+	t.Run("show columns", func(t *testing.T) {
+		rows := queryRows(t, db, "show columns from t")
+		require.Contains(t, rows[1], "b|bit(4)")
+		require.Contains(t, rows[2], "c|bit(1)")
+		require.Contains(t, rows[3], "d|bit(64)")
+	})
+}
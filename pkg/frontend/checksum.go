@@ -0,0 +1,225 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+/*
+handle checksum table
+*/
+func (mce *MysqlCmdExecutor) handleChecksumTable(st *tree.ChecksumTable) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	tableCol := new(MysqlColumn)
+	tableCol.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	tableCol.SetName("Table")
+	ses.Mrs.AddColumn(tableCol)
+	checksumCol := new(MysqlColumn)
+	checksumCol.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	checksumCol.SetSigned(false)
+	checksumCol.SetName("Checksum")
+	ses.Mrs.AddColumn(checksumCol)
+
+	storage := ses.GetStorage()
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	for _, tn := range st.Tables {
+		dbName := string(tn.SchemaName)
+		if len(dbName) == 0 {
+			dbName = ses.GetDatabaseName()
+		}
+		if len(dbName) == 0 {
+			return errorDatabaseIsNull
+		}
+		row := []interface{}{fmt.Sprintf("%s.%s", dbName, tn.ObjectName), nil}
+		// like mysql, the checksum of a table which does not exist is NULL,
+		// and so is the live checksum, which is not maintained
+		if db, err := storage.Database(dbName, snapshot); err == nil && !st.Quick {
+			if rel, err := db.Relation(string(tn.ObjectName), snapshot); err == nil {
+				sum, err := checksumRelation(rel, snapshot)
+				rel.Close(snapshot)
+				if err != nil {
+					return err
+				}
+				row[1] = sum
+			}
+		}
+		ses.Mrs.AddRow(row)
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// checksumRelation returns the checksum of the rows of the relation visible
+// to the snapshot. The readers run in parallel, and since the checksum of
+// a table is the sum of the hashes of its rows, they merge their partial
+// sums by addition. The checksum does not depend on the order of the rows,
+// so it is stable across the flushes and the compactions of the blocks.
+func checksumRelation(rel engine.Relation, snapshot engine.Snapshot) (uint64, error) {
+	var attrs []string
+	for _, def := range rel.TableDefs(snapshot) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, attr.Attr.Name)
+		}
+	}
+	if len(attrs) == 0 {
+		return 0, nil
+	}
+
+	readers := rel.NewReader(runtime.NumCPU(), nil, nil, snapshot)
+	sums := make([]uint64, len(readers))
+	errs := make([]error, len(readers))
+	var wg sync.WaitGroup
+	for i, reader := range readers {
+		wg.Add(1)
+		go func(i int, reader engine.Reader) {
+			defer wg.Done()
+			refCounts := make([]uint64, len(attrs))
+			for j := range refCounts {
+				refCounts[j] = 1
+			}
+			var buf []byte
+			for {
+				bat, err := reader.Read(refCounts, attrs)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				if bat == nil {
+					return
+				}
+				var sum uint64
+				sum, buf = checksumBatch(bat, buf)
+				sums[i] += sum
+			}
+		}(i, reader)
+	}
+	wg.Wait()
+
+	var sum uint64
+	for i := range readers {
+		if errs[i] != nil {
+			return 0, errs[i]
+		}
+		sum += sums[i]
+	}
+	return sum, nil
+}
+
+// checksumBatch returns the sum of the hashes of the rows of the batch,
+// each row counted as many times as its multiplicity. A row is hashed by
+// its canonical encoding, for each column a NULL byte 0, or a byte 1
+// followed by the bytes of the value, and the bytes of a string value are
+// prefixed by their length. buf is the buffer of the encoding, which the
+// caller reuses.
+func checksumBatch(bat *batch.Batch, buf []byte) (uint64, []byte) {
+	if len(bat.Vecs) == 0 {
+		return 0, buf
+	}
+	n := vector.Length(bat.Vecs[0])
+	fixed := make([][]byte, len(bat.Vecs))
+	sizes := make([]int, len(bat.Vecs))
+	for j, vec := range bat.Vecs {
+		fixed[j], sizes[j] = fixedBytes(vec)
+	}
+
+	var sum uint64
+	for i := 0; i < n; i++ {
+		buf = buf[:0]
+		for j, vec := range bat.Vecs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				buf = append(buf, 0)
+				continue
+			}
+			buf = append(buf, 1)
+			if fixed[j] != nil {
+				buf = append(buf, fixed[j][i*sizes[j]:(i+1)*sizes[j]]...)
+				continue
+			}
+			v := vec.Col.(*types.Bytes).Get(int64(i))
+			var length [binary.MaxVarintLen64]byte
+			buf = append(buf, length[:binary.PutUvarint(length[:], uint64(len(v)))]...)
+			buf = append(buf, v...)
+		}
+		h := xxhash.Sum64(buf)
+		if bat.Zs != nil {
+			h *= uint64(bat.Zs[i])
+		}
+		sum += h
+	}
+	return sum, buf
+}
+
+// fixedBytes returns the bytes of the column of a fixed length type and
+// the size of a value, or nil for the string types
+func fixedBytes(vec *vector.Vector) ([]byte, int) {
+	switch col := vec.Col.(type) {
+	case []bool:
+		return encoding.EncodeBoolSlice(col), 1
+	case []int8:
+		return encoding.EncodeInt8Slice(col), 1
+	case []int16:
+		return encoding.EncodeInt16Slice(col), 2
+	case []int32:
+		return encoding.EncodeInt32Slice(col), 4
+	case []int64:
+		return encoding.EncodeInt64Slice(col), 8
+	case []uint8:
+		return encoding.EncodeUint8Slice(col), 1
+	case []uint16:
+		return encoding.EncodeUint16Slice(col), 2
+	case []uint32:
+		return encoding.EncodeUint32Slice(col), 4
+	case []uint64:
+		return encoding.EncodeUint64Slice(col), 8
+	case []float32:
+		return encoding.EncodeFloat32Slice(col), 4
+	case []float64:
+		return encoding.EncodeFloat64Slice(col), 8
+	case []types.Date:
+		return encoding.EncodeDateSlice(col), 4
+	case []types.Time:
+		return encoding.EncodeTimeSlice(col), 8
+	case []types.Datetime:
+		return encoding.EncodeDatetimeSlice(col), 8
+	case []types.Timestamp:
+		return encoding.EncodeTimestampSlice(col), 8
+	case []types.Decimal64:
+		return encoding.EncodeDecimal64Slice(col), 8
+	case []types.Decimal128:
+		return encoding.EncodeDecimal128Slice(col), 16
+	}
+	return nil, 0
+}
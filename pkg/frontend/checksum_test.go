@@ -0,0 +1,179 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/stretchr/testify/require"
+)
+
+func newChecksumBatch(as []int64, bs []string, nullBs ...uint64) *batch.Batch {
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	_ = vector.Append(bat.Vecs[0], as)
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	col := make([][]byte, len(bs))
+	for i, b := range bs {
+		col[i] = []byte(b)
+	}
+	_ = vector.Append(bat.Vecs[1], col)
+	nulls.Add(bat.Vecs[1].Nsp, nullBs...)
+	bat.Zs = make([]int64, len(as))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func TestChecksumBatch(t *testing.T) {
+	sum, _ := checksumBatch(newChecksumBatch([]int64{1, 2, 3}, []string{"x", "y", ""}, 2), nil)
+
+	// the rows in other batches and in another order
+	sum1, _ := checksumBatch(newChecksumBatch([]int64{3, 1}, []string{"", "x"}, 0), nil)
+	sum2, _ := checksumBatch(newChecksumBatch([]int64{2}, []string{"y"}), nil)
+	require.Equal(t, sum, sum1+sum2)
+
+	// an empty string is not NULL
+	other, _ := checksumBatch(newChecksumBatch([]int64{1, 2, 3}, []string{"x", "y", ""}), nil)
+	require.NotEqual(t, sum, other)
+	// the values are not concatenated
+	other, _ = checksumBatch(newChecksumBatch([]int64{1, 2, 3}, []string{"xy", "", ""}, 2), nil)
+	require.NotEqual(t, sum, other)
+	other, _ = checksumBatch(newChecksumBatch([]int64{1, 2, 4}, []string{"x", "y", ""}, 2), nil)
+	require.NotEqual(t, sum, other)
+
+	// the multiplicity of the rows
+	bat := newChecksumBatch([]int64{1, 3}, []string{"x", ""}, 1)
+	bat.Zs[0] = 2
+	sum1, _ = checksumBatch(bat, nil)
+	sum2, _ = checksumBatch(newChecksumBatch([]int64{1, 1, 3}, []string{"x", "x", ""}, 2), nil)
+	require.Equal(t, sum1, sum2)
+}
+
+// compactTableBlocks compacts the full blocks of the table
+func compactTableBlocks(t *testing.T, tae *db.DB, dbName, tableName string) int {
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase(dbName)
+	require.NoError(t, err)
+	rel, err := database.GetRelationByName(tableName)
+	require.NoError(t, err)
+	schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	var metas []*catalog.BlockEntry
+	for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+		blk := it.GetBlock()
+		if blk.Rows() == int(schema.BlockMaxRows) {
+			metas = append(metas, blk.GetMeta().(*catalog.BlockEntry))
+		}
+	}
+	require.NoError(t, txn.Commit())
+
+	for _, meta := range metas {
+		txn, err := tae.StartTxn(nil)
+		require.NoError(t, err)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		require.NoError(t, err)
+		require.NoError(t, task.OnExec())
+		require.NoError(t, txn.Commit())
+	}
+	return len(metas)
+}
+
+func checksumTable(t *testing.T, db *sql.DB, table string) sql.NullString {
+	rows, err := db.Query("checksum table " + table)
+	require.NoError(t, err)
+	defer rows.Close()
+	var name string
+	var sum sql.NullString
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&name, &sum))
+	require.False(t, rows.Next())
+	require.NoError(t, rows.Err())
+	return sum
+}
+
+func TestChecksumTable(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	conn := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database checksum_db",
+		"use checksum_db",
+		"create table t (a int, b varchar(20), c double)",
+	} {
+		_, err := conn.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the rows are in 3 blocks of 40000 rows
+	const rows, batchRows = 90000, 30000
+	for i := 0; i < rows; i += batchRows {
+		values := make([]string, batchRows)
+		for j := range values {
+			if (i+j)%7 == 0 {
+				values[j] = fmt.Sprintf("(%d, null, %d.5)", i+j, i+j)
+			} else {
+				values[j] = fmt.Sprintf("(%d, 'b%d', %d.5)", i+j, (i+j)%100, i+j)
+			}
+		}
+		_, err := conn.Exec("insert into t values " + strings.Join(values, ", "))
+		require.NoError(t, err)
+	}
+
+	sum := checksumTable(t, conn, "t")
+	require.True(t, sum.Valid)
+	require.Equal(t, sum, checksumTable(t, conn, "checksum_db.t"))
+
+	// the compaction does not change the checksum
+	require.Equal(t, 2, compactTableBlocks(t, tae, "checksum_db", "t"))
+	require.Equal(t, sum, checksumTable(t, conn, "t"))
+
+	// a single value updated
+	_, err = conn.Exec("update t set a = -1 where a = 40001")
+	require.NoError(t, err)
+	require.NotEqual(t, sum, checksumTable(t, conn, "t"))
+	_, err = conn.Exec("update t set a = 40001 where a = -1")
+	require.NoError(t, err)
+	require.Equal(t, sum, checksumTable(t, conn, "t"))
+
+	// like mysql, no live checksum and no table
+	require.False(t, checksumTable(t, conn, "t quick").Valid)
+	require.False(t, checksumTable(t, conn, "not_exists").Valid)
+
+	t.Run("assert", func(t *testing.T) {
+		require.Equal(t, []string{"true"}, queryStrings(t, conn, "select assert(a >= 0, 'negative a') from t where a = 5"))
+		rows, err := conn.Query("select assert(a < 89999, 'a is too large') from t")
+		if err == nil {
+			for rows.Next() {
+			}
+			err = rows.Err()
+			require.NoError(t, rows.Close())
+		}
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "a is too large")
+	})
+}
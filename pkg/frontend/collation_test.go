@@ -0,0 +1,153 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func queryStrings(t *testing.T, db *sql.DB, query string) []string {
+	rows, err := db.Query(query)
+	require.NoError(t, err, query)
+	defer rows.Close()
+	var rs []string
+	for rows.Next() {
+		var s string
+		require.NoError(t, rows.Scan(&s))
+		rs = append(rs, s)
+	}
+	require.NoError(t, rows.Err())
+	return rs
+}
+
+func TestCollation(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database collation_db",
+		"use collation_db",
+		"create table t (b varchar(20), c varchar(20) collate utf8mb4_general_ci)",
+		"insert into t values ('apple', 'apple'), ('Apple', 'Apple'), ('banana', 'banana'), ('APPLE ', 'APPLE '), ('Banana', 'Banana'), ('cherry', 'cherry')",
+		"create table u (c varchar(20)) collate = utf8mb4_general_ci",
+		"insert into u values ('Apple')",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		require.Equal(t, []string{"apple"}, queryStrings(t, db, "select b from t where b = 'apple'"))
+		require.ElementsMatch(t, []string{"apple", "Apple", "APPLE "}, queryStrings(t, db, "select c from t where c = 'apple'"))
+		require.ElementsMatch(t, []string{"apple", "Apple", "APPLE "}, queryStrings(t, db, "select b from t where b collate utf8mb4_general_ci = 'APPLE'"))
+		require.ElementsMatch(t, []string{"banana", "Banana", "cherry"}, queryStrings(t, db, "select c from t where c > 'apple'"))
+		require.ElementsMatch(t, []string{"banana", "Banana", "cherry"}, queryStrings(t, db, "select c from t where c <> 'apple'"))
+		// the table default applies to its columns
+		require.Equal(t, []string{"Apple"}, queryStrings(t, db, "select c from u where c = 'APPLE'"))
+	})
+
+	t.Run("like", func(t *testing.T) {
+		require.ElementsMatch(t, []string{"Banana"}, queryStrings(t, db, "select b from t where b like 'B%'"))
+		require.ElementsMatch(t, []string{"banana", "Banana"}, queryStrings(t, db, "select c from t where c like 'B%'"))
+		require.ElementsMatch(t, []string{"cherry"}, queryStrings(t, db, "select c from t where c not like '%A%'"))
+	})
+
+	t.Run("order by", func(t *testing.T) {
+		require.Equal(t, []string{"APPLE ", "Apple", "Banana", "apple", "banana", "cherry"}, queryStrings(t, db, "select b from t order by b"))
+		rs := queryStrings(t, db, "select c from t order by c desc")
+		require.Equal(t, "cherry", rs[0])
+		require.ElementsMatch(t, []string{"banana", "Banana"}, rs[1:3])
+		require.ElementsMatch(t, []string{"apple", "Apple", "APPLE "}, rs[3:])
+		rs = queryStrings(t, db, "select b from t order by b collate utf8mb4_general_ci limit 3")
+		require.ElementsMatch(t, []string{"apple", "Apple", "APPLE "}, rs)
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		require.Len(t, queryStrings(t, db, "select b from t group by b"), 6)
+		require.ElementsMatch(t, []string{"3", "2", "1"}, queryStrings(t, db, "select count(*) from t group by c"))
+		require.ElementsMatch(t, []string{"3", "2", "1"}, queryStrings(t, db, "select count(*) from t group by b collate utf8mb4_general_ci"))
+		require.Len(t, queryStrings(t, db, "select distinct c from t"), 3)
+	})
+
+	t.Run("mixed collations", func(t *testing.T) {
+		_, err := db.Query("select b from t where b = c")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Illegal mix of collations")
+		// an explicit collation wins over the implicit ones
+		require.Len(t, queryStrings(t, db, "select b from t where b = c collate utf8mb4_general_ci"), 6)
+		require.Equal(t, []string{"apple"}, queryStrings(t, db, "select c from t where c collate utf8mb4_bin = 'apple'"))
+		// a literal adopts the collation of the column
+		require.Len(t, queryStrings(t, db, "select c from t where 'APPLE' = c"), 3)
+		_, err = db.Query("select b from t where b collate utf8mb4_bin = c collate utf8mb4_general_ci")
+		require.Error(t, err)
+		_, err = db.Query("select b from t where b collate latin1_swedish_ci = 'a'")
+		require.Error(t, err)
+	})
+}
+
+func TestDatabaseDefaults(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	showCreate := func(query string) string {
+		var name, stmt string
+		require.NoError(t, db.QueryRow(query).Scan(&name, &stmt), query)
+		return stmt
+	}
+	for _, stmt := range []string{
+		"create database ci_db default character set utf8mb4 collate utf8mb4_general_ci",
+		"use ci_db",
+		"create table t (a varchar(20), b varchar(20) collate utf8mb4_bin, c int)",
+		"insert into t values ('Apple', 'Apple', 1)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the columns inherit the collation of the database unless they have one
+	require.Equal(t, []string{"Apple"}, queryStrings(t, db, "select a from t where a = 'APPLE'"))
+	require.Empty(t, queryStrings(t, db, "select b from t where b = 'APPLE'"))
+
+	require.Equal(t, "CREATE DATABASE `ci_db` DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci",
+		showCreate("show create database ci_db"))
+	table := showCreate("show create table t")
+	require.Contains(t, table, "`a` VARCHAR(20) COLLATE utf8mb4_general_ci")
+	require.Contains(t, table, "`b` VARCHAR(20) COLLATE utf8mb4_bin")
+
+	// the statements shown create the same database and table
+	dbStmt, tableStmt := showCreate("show create database ci_db"), table
+	for _, stmt := range []string{"drop database ci_db", dbStmt, "use ci_db", tableStmt} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, dbStmt, showCreate("show create database ci_db"))
+	require.Equal(t, tableStmt, showCreate("show create table t"))
+
+	for _, stmt := range []string{
+		"create database plain_db",
+		"use plain_db",
+		"create table t (a varchar(20))",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, "CREATE DATABASE `plain_db`", showCreate("show create database plain_db"))
+	require.Contains(t, showCreate("show create table t"), "`a` VARCHAR(20) COLLATE utf8mb4_bin")
+
+	_, err := db.Exec("create database latin1_db character set latin1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "only utf8mb4 is supported")
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/stretchr/testify/require"
+)
+
+func showCreateTableOf(t *testing.T, conn *sql.DB, table string) string {
+	rows := queryRows(t, conn, "show create table "+table)
+	require.Len(t, rows, 1)
+	return strings.TrimPrefix(rows[0], table+"|")
+}
+
+// tableDefsOf returns the definitions of the table in the storage
+func tableDefsOf(t *testing.T, eng moengine.TxnEngine, dbName, table string) []engine.TableDef {
+	txn, err := eng.StartTxn(nil)
+	require.NoError(t, err)
+	defer func() { _ = txn.Rollback() }()
+	database, err := eng.Database(dbName, txn.GetCtx())
+	require.NoError(t, err)
+	rel, err := database.Relation(table, txn.GetCtx())
+	require.NoError(t, err)
+	return rel.TableDefs(txn.GetCtx())
+}
+
+func TestComments(t *testing.T) {
+	dir := t.TempDir()
+	tae, err := db.Open(dir, nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	conn := openAccountDB(t, port, "root", "")
+	execAll(t, conn,
+		"create database comment_db",
+		"use comment_db",
+		`create table t1 (a int comment 'it''s the "a"', b varchar(10) comment 'back\\slash 中文', c int) comment = 'table ''t1'' 表'`,
+	)
+	// the catalog of t1 is checkpointed, t2 is replayed from the log
+	require.NoError(t, tae.Catalog.Checkpoint(tae.Scheduler.GetSafeTS()))
+	execAll(t, conn, `create table t2 (x int primary key comment "line1\nline2") comment 'plain'`)
+
+	createT1 := "CREATE TABLE `t1` (\n" +
+		"`a` INT NULL COMMENT 'it''s the \"a\"',\n" +
+		"`b` VARCHAR(10) COLLATE utf8mb4_bin NULL COMMENT 'back\\\\slash 中文',\n" +
+		"`c` INT NULL\n" +
+		") COMMENT='table ''t1'' 表'"
+	createT2 := "CREATE TABLE `t2` (\n" +
+		"`x` INT NOT NULL COMMENT 'line1\\nline2',\n" +
+		"PRIMARY KEY (`x`)\n" +
+		") COMMENT='plain'"
+	require.Equal(t, createT1, showCreateTableOf(t, conn, "t1"))
+	require.Equal(t, createT2, showCreateTableOf(t, conn, "t2"))
+	var columnComments []string
+	for _, row := range queryRows(t, conn, "show full columns from t1") {
+		fields := strings.Split(row, "|")
+		columnComments = append(columnComments, fields[0]+"|"+fields[len(fields)-1])
+	}
+	require.Equal(t, []string{"a|it's the \"a\"", "b|back\\slash 中文", "c|"}, columnComments)
+	require.ElementsMatch(t, []string{"t1|table 't1' 表", "t2|plain"},
+		queryRows(t, conn, "select relname, rel_comment from mo_catalog.mo_tables where reldatabase = 'comment_db'"))
+	require.ElementsMatch(t, []string{"a|it's the \"a\"", "b|back\\slash 中文", "c|"},
+		queryRows(t, conn, "select attname, att_comment from mo_catalog.mo_columns where att_database = 'comment_db' and att_relname = 't1' and att_is_hidden = 0"))
+
+	// the statement shown creates the same table again
+	execAll(t, conn, strings.Replace(createT1, "`t1`", "`t3`", 1))
+	require.Equal(t, strings.Replace(createT1, "`t1`", "`t3`", 1), showCreateTableOf(t, conn, "t3"))
+
+	// the comments are limited in characters as mysql
+	execAll(t, conn, "create table t4 (a int comment '"+strings.Repeat("中", 1024)+"') comment '"+strings.Repeat("表", 2048)+"'")
+	_, err = conn.Exec("create table t5 (a int comment '" + strings.Repeat("x", 1025) + "')")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Comment for field 'a' is too long")
+	_, err = conn.Exec("create table t5 (a int) comment '" + strings.Repeat("x", 2049) + "'")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Comment for table 't5' is too long")
+
+	// the comments survive the restart
+	require.NoError(t, tae.Close())
+	tae, err = db.Open(dir, nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	eng := moengine.NewEngine(tae)
+	require.Equal(t, createT1, showCreateTable("t1", tableDefsOf(t, eng, "comment_db", "t1")))
+	require.Equal(t, createT2, showCreateTable("t2", tableDefsOf(t, eng, "comment_db", "t2")))
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase("comment_db")
+	require.NoError(t, err)
+	rel, err := database.GetRelationByName("t4")
+	require.NoError(t, err)
+	schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	require.Equal(t, strings.Repeat("表", 2048), schema.Comment)
+	require.Equal(t, strings.Repeat("中", 1024), schema.ColDefs[0].Comment)
+	require.NoError(t, txn.Rollback())
+}
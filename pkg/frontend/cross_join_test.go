@@ -0,0 +1,78 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossJoin(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database cross_db",
+		"use cross_db",
+		"create table t1 (a int, b int)",
+		"create table t2 (c int, d int)",
+		"create table big (k int)",
+		"insert into t1 values (1, 10), (2, 20), (3, 30)",
+		"insert into t2 values (1, 5), (2, 25), (4, 35)",
+	)
+	// the product spans several batches
+	const bigRows = 10000
+	values := make([]string, bigRows)
+	for i := range values {
+		values[i] = fmt.Sprintf("(%d)", i)
+	}
+	execAll(t, db, "insert into big values "+strings.Join(values, ", "))
+
+	product := []string{
+		"1|10|1|5", "1|10|2|25", "1|10|4|35",
+		"2|20|1|5", "2|20|2|25", "2|20|4|35",
+		"3|30|1|5", "3|30|2|25", "3|30|4|35",
+	}
+	for _, c := range []struct {
+		query string
+		want  []string
+	}{
+		{"select * from t1, t2", product},
+		{"select * from t1 cross join t2", product},
+		{"select * from t1 join t2", product},
+		// the conditions which are not equalities filter the product
+		{"select * from t1 join t2 on t1.a < t2.c", []string{"1|10|2|25", "1|10|4|35", "2|20|4|35", "3|30|4|35"}},
+		{"select * from t1 join t2 on t1.a < t2.c and t1.b + 10 > t2.d", []string{"3|30|4|35"}},
+		// and the residual of an equi-join filters its matches
+		{"select * from t1 join t2 on t1.a = t2.c and t1.b < t2.d", []string{"2|20|2|25"}},
+		{"select count(*), sum(k) from t1, big", []string{fmt.Sprintf("%d|%d", 3*bigRows, 3*bigRows*(bigRows-1)/2)}},
+		{"select count(*) from t1 join big on t1.a * 1000 > big.k", []string{"6000"}},
+	} {
+		require.ElementsMatch(t, c.want, queryRows(t, db, c.query), c.query)
+	}
+
+	// a cross join estimated larger than max_cross_join_rows is refused
+	execAll(t, db, "set max_cross_join_rows = 8")
+	_, err := db.Query("select * from t1, t2")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max_cross_join_rows")
+	require.Len(t, queryRows(t, db, "select * from t1 join t2 on t1.a = t2.c"), 2)
+	execAll(t, db, "set max_cross_join_rows = 9")
+	require.Len(t, queryRows(t, db, "select * from t1, t2"), 9)
+	execAll(t, db, "set max_cross_join_rows = 0")
+	require.Len(t, queryRows(t, db, "select * from t1, t2, t1 x"), 27)
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateInterval(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+
+	// the compound units, whose sign is of the whole interval
+	for query, want := range map[string]string{
+		"select date_sub('2022-01-01 10:00:00', interval '1:30' minute_second)":             "2022-01-01 09:58:30",
+		"select date_sub('2022-01-01 10:00:00', interval '-1:30' MINUTE_SECOND)":            "2022-01-01 10:01:30",
+		"select date_sub(cast('2022-01-01 10:00:00' as datetime), interval '1 2' day_hour)": "2021-12-31 08:00:00",
+		"select date_add('2022-01-01 10:00:00', interval '-1 2' day_hour)":                  "2021-12-31 08:00:00",
+		"select date '2022-01-01' - interval '1 2' day_hour":                                "2021-12-30 22:00:00",
+		"select date_add(date '2022-01-31', interval '1-1' year_month)":                     "2023-02-28",
+		"select date_add(cast('9999-12-31 10:00:00' as datetime), interval 1 day)":          "NULL",
+		"select date_sub(date '0001-01-01', interval 1 day)":                                "NULL",
+		"select date_add('9999-12-31', interval '0 24' day_hour)":                           "NULL",
+	} {
+		require.Equal(t, []string{want}, queryRows(t, db, query), query)
+	}
+
+	// every row has its own interval
+	execAll(t, db,
+		"create database interval_db",
+		"use interval_db",
+		"create table t (d datetime, n int, s varchar(30), dd date)",
+		"insert into t values ('2022-01-01 10:00:00', 1, '2022-01-01', '2022-01-31'), ('2022-01-01 10:00:00', -2, '2022-01-01 10:00:00', '2022-03-31'), (null, 3, null, null), ('9999-12-31 00:00:00', null, 'x', '9999-12-31'), ('9999-12-31 00:00:00', 1, 'x', '9999-12-31')",
+	)
+	require.Equal(t, []string{
+		"2021-12-31 10:00:00|2022-01-01 11:00:00|2021-12-31|2022-02-28|2022-01-31 00:01:00",
+		"2022-01-03 10:00:00|2022-01-01 08:00:00|2022-01-03 10:00:00|2022-01-31|2022-03-30 23:58:00",
+		"NULL|NULL|NULL|NULL|NULL",
+		"NULL|NULL|NULL|NULL|NULL",
+		"9999-12-30 00:00:00|9999-12-31 01:00:00|NULL|NULL|9999-12-31 00:01:00",
+	}, queryRows(t, db, "select d - interval n day, date_add(d, interval n hour), date_sub(s, interval n day), dd + interval n month, date_add(dd, interval n minute) from t"))
+	require.Equal(t, []string{"2022-01-02", "2021-12-30", "2022-01-04", "NULL", "2022-01-02"},
+		queryRows(t, db, "select date_add(date '2022-01-01', interval n day) from t"))
+
+	// the number of a compound unit of every row would be a string to parse
+	_, err := db.Exec("select date_add(d, interval n day_hour) from t")
+	require.Error(t, err)
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecimalText checks the decimals cast from the inserted values and sent
+// by the result writer are those of the shared text routines
+func TestDecimalText(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database decimal_db",
+		"use decimal_db",
+		"create table t (a decimal(10, 2), b decimal(38, 9))",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	inputs := []string{
+		"0", "-0", "1.5", "  12.34 ", "-.5", "5.", "0.125", "-0.125", "0.0049", "0.005",
+		"1.5e-1", "1E+2", "-52345e-8", "99999999.99", "0." + strings.Repeat("9", 60),
+		"99999999.995", "1e8", "", "--1", "1.5x", "1e",
+	}
+	for _, s := range inputs {
+		a, errA := types.ParseDecimal64(s, 10, 2)
+		b, errB := types.ParseDecimal128(s, 38, 9)
+		_, err := db.Exec(fmt.Sprintf("insert into t values ('%s', '%s')", s, s))
+		if errA != nil || errB != nil {
+			require.Error(t, err, s)
+			continue
+		}
+		require.NoError(t, err, s)
+		require.Equal(t, []string{types.FormatDecimal64(a, 2)}, queryStrings(t, db, "select a from t"), s)
+		require.Equal(t, []string{types.FormatDecimal128(b, 9)}, queryStrings(t, db, "select b from t"), s)
+		_, err = db.Exec("delete from t")
+		require.NoError(t, err)
+	}
+
+	// the numeric literals are cast by the same routines
+	for _, s := range []string{"1.255", "-0.125", "12e-1", "3"} {
+		_, err := db.Exec(fmt.Sprintf("insert into t values (%s, %s)", s, s))
+		require.NoError(t, err, s)
+		a, err := types.ParseDecimal64(s, 10, 2)
+		require.NoError(t, err, s)
+		require.Equal(t, []string{types.FormatDecimal64(a, 2)}, queryStrings(t, db, "select a from t"), s)
+		_, err = db.Exec("delete from t")
+		require.NoError(t, err)
+	}
+}
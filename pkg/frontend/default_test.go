@@ -0,0 +1,83 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultExpressions(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database default_db",
+		"use default_db",
+		"create table events (id int, uid varchar(36) default (uuid()), created datetime default now(), modified datetime default current_timestamp on update current_timestamp, v int)",
+		"insert into events (id, v) values (1, 0), (2, 0), (3, 0)",
+		"insert into events values (4, default, default, default, 0), (5, 'x', '2020-01-02 03:04:05', '2020-01-02 03:04:05', 0)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// a fresh uuid for every row
+	uids := queryStrings(t, db, "select uid from events where id < 5 order by id")
+	require.Equal(t, 4, len(uids))
+	seen := make(map[string]bool)
+	for _, uid := range uids {
+		require.Equal(t, 36, len(uid), uid)
+		require.False(t, seen[uid], uid)
+		seen[uid] = true
+	}
+	require.Equal(t, []string{"x"}, queryStrings(t, db, "select uid from events where id = 5"))
+
+	// the same time for the rows of a statement
+	created := queryStrings(t, db, "select created from events where id < 4 order by id")
+	require.Equal(t, 3, len(created))
+	require.Equal(t, created[0], created[1])
+	require.Equal(t, created[0], created[2])
+	require.Equal(t, created, queryStrings(t, db, "select modified from events where id < 4 order by id"))
+	require.Equal(t, []string{"2020-01-02 03:04:05"}, queryStrings(t, db, "select created from events where id = 5"))
+
+	// the modified time of the updated rows is refreshed
+	time.Sleep(time.Second)
+	_, err := db.Exec("update events set v = 1 where id = 1 or id = 5")
+	require.NoError(t, err)
+	modified := queryStrings(t, db, "select modified from events where id = 1 or id = 2 or id = 5 order by id")
+	require.Greater(t, modified[0], created[0])
+	require.Equal(t, created[1], modified[1])
+	require.Equal(t, modified[0], modified[2])
+	// the assigned value wins over ON UPDATE
+	_, err = db.Exec("update events set modified = '2021-01-01 00:00:00' where id = 2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"2021-01-01 00:00:00"}, queryStrings(t, db, "select modified from events where id = 2"))
+	require.Equal(t, []string{"2020-01-02 03:04:05"}, queryStrings(t, db, "select created from events where id = 5"))
+
+	var name, sql string
+	require.NoError(t, db.QueryRow("show create table events").Scan(&name, &sql))
+	require.True(t, strings.Contains(sql, "DEFAULT (uuid())"), sql)
+	require.True(t, strings.Contains(sql, "DEFAULT now()"), sql)
+	require.True(t, strings.Contains(sql, "DEFAULT current_timestamp() ON UPDATE current_timestamp()"), sql)
+	_, err = mysql.ParseOne(sql)
+	require.NoError(t, err, sql)
+
+	_, err = db.Exec("create table bad (a int, b int default (a + 1))")
+	require.Error(t, err)
+}
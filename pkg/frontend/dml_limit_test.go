@@ -0,0 +1,120 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateDeleteLimit(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database limit_db",
+		"use limit_db",
+		"create table t (a int, ts datetime)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the rows are in 3 blocks of 40000 rows, and the oldest rows are
+	// scattered across them.
+	const rows, batchRows = 90000, 30000
+	base := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < rows; i += batchRows {
+		values := make([]string, batchRows)
+		for j := range values {
+			seconds := (i + j) * 7919 % rows
+			values[j] = fmt.Sprintf("(%d, '%s')", i+j, base.Add(time.Duration(seconds)*time.Second).Format("2006-01-02 15:04:05"))
+		}
+		_, err := db.Exec("insert into t values " + strings.Join(values, ", "))
+		require.NoError(t, err)
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		res, err := db.Exec("delete from t order by ts limit 10")
+		require.NoError(t, err)
+		n, err := res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, int64(10), n)
+		require.Equal(t, []string{"89990"}, queryStrings(t, db, "select count(*) from t"))
+		require.Equal(t, []string{"0"}, queryStrings(t, db, "select count(*) from t where ts < cast('2022-01-01 00:00:10' as datetime)"))
+		require.Equal(t, []string{"1"}, queryStrings(t, db, "select count(*) from t where ts = cast('2022-01-01 00:00:10' as datetime)"))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		_, err := db.Exec("begin")
+		require.NoError(t, err)
+		res, err := db.Exec("update t set a = -1 order by ts desc limit 5")
+		require.NoError(t, err)
+		n, err := res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), n)
+		require.Equal(t, []string{"5"}, queryStrings(t, db, "select count(*) from t where a = -1"))
+		require.Equal(t, []string{"0"}, queryStrings(t, db, "select count(*) from t where a = -1 and ts < cast('2022-01-01 23:59:55' as datetime)"))
+		require.Equal(t, []string{"89990"}, queryStrings(t, db, "select count(*) from t"))
+		_, err = db.Exec("rollback")
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"0"}, queryStrings(t, db, "select count(*) from t where a = -1"))
+		require.Equal(t, []string{"89990"}, queryStrings(t, db, "select count(*) from t"))
+	})
+
+	t.Run("update without order", func(t *testing.T) {
+		res, err := db.Exec("update t set a = a + 1000000 where a < 100 limit 3")
+		require.NoError(t, err)
+		n, err := res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), n)
+		require.Equal(t, []string{"3"}, queryStrings(t, db, "select count(*) from t where a >= 1000000"))
+		require.Equal(t, []string{"89990"}, queryStrings(t, db, "select count(*) from t"))
+	})
+
+	t.Run("update the columns from other columns", func(t *testing.T) {
+		for _, stmt := range []string{
+			"create table t3 (a int, b int, c int)",
+			"insert into t3 values (1, 10, 100), (2, 20, 200), (3, 30, 300)",
+			"update t3 set b = 0 where a = 2",
+			"update t3 set b = c, c = c where a = 3",
+		} {
+			_, err := db.Exec(stmt)
+			require.NoError(t, err, stmt)
+		}
+		require.Equal(t, []string{"10", "0", "300"}, queryStrings(t, db, "select b from t3 order by a"))
+		require.Equal(t, []string{"100", "200", "300"}, queryStrings(t, db, "select c from t3 order by a"))
+	})
+}
+
+func TestUnorderedLimitWarnings(t *testing.T) {
+	for sql, warnings := range map[string]uint16{
+		"delete from t limit 1":                      1,
+		"delete from t order by a limit 1":           0,
+		"delete from t where a > 1":                  0,
+		"update t set a = 1 limit 1":                 1,
+		"update t set a = 1 order by a desc limit 1": 0,
+		"select a from t limit 1":                    0,
+	} {
+		stmt, err := mysql.ParseOne(sql)
+		require.NoError(t, err)
+		require.Equal(t, warnings, unorderedLimitWarnings(stmt), sql)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// slowQuery is a cross join of 8 million rows, it takes seconds to run.
+const slowQuery = "select count(t1.a) from t t1, t t2, t t3"
+
+// queryError runs the query and returns the error of it, which may come
+// with the rows.
+func queryError(db *sql.DB, query string) error {
+	rows, err := db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+	}
+	return rows.Err()
+}
+
+func TestMaxExecutionTime(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	values := make([]string, 200)
+	for i := range values {
+		values[i] = fmt.Sprintf("(%d)", i)
+	}
+	for _, stmt := range []string{
+		"create database timeout_db",
+		"use timeout_db",
+		"create table t (a int)",
+		"insert into t values " + strings.Join(values, ", "),
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	t.Run("variable", func(t *testing.T) {
+		_, err := db.Exec("set max_execution_time = 50")
+		require.NoError(t, err)
+		defer func() {
+			_, err := db.Exec("set max_execution_time = default")
+			require.NoError(t, err)
+		}()
+		require.Equal(t, []string{"50"}, queryStrings(t, db, "select @@max_execution_time"))
+
+		start := time.Now()
+		requireMysqlErrorCode(t, ER_QUERY_TIMEOUT, queryError(db, slowQuery))
+		require.Less(t, time.Since(start), time.Second)
+
+		// the fast queries are not affected
+		require.Equal(t, []string{"200"}, queryStrings(t, db, "select count(*) from t"))
+		// the ddl is not limited
+		_, err = db.Exec("create table u (a int)")
+		require.NoError(t, err)
+	})
+
+	t.Run("hint", func(t *testing.T) {
+		start := time.Now()
+		requireMysqlErrorCode(t, ER_QUERY_TIMEOUT, queryError(db, "select /*+ MAX_EXECUTION_TIME(50) */ count(t1.a) from t t1, t t2, t t3"))
+		require.Less(t, time.Since(start), time.Second)
+
+		// the hint overrides the variable
+		_, err := db.Exec("set max_execution_time = 1")
+		require.NoError(t, err)
+		defer func() {
+			_, err := db.Exec("set max_execution_time = 0")
+			require.NoError(t, err)
+		}()
+		require.Equal(t, []string{"40000"}, queryStrings(t, db, "select /*+ MAX_EXECUTION_TIME(100000) */ count(t1.a) from t t1, t t2"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		for _, stmt := range []string{
+			"set max_execution_time = -1",
+			"set max_execution_time = 'abc'",
+		} {
+			_, err := db.Exec(stmt)
+			require.Error(t, err, stmt)
+		}
+	})
+}
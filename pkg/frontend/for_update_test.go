@@ -0,0 +1,147 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/require"
+)
+
+func execAll(t *testing.T, db *sql.DB, stmts ...string) {
+	for _, stmt := range stmts {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+}
+
+// execAsync runs the statement in background and sends its error to the
+// returned channel
+func execAsync(db *sql.DB, stmt string) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := db.Exec(stmt)
+		done <- err
+	}()
+	return done
+}
+
+func requireBlocked(t *testing.T, done <-chan error) {
+	select {
+	case err := <-done:
+		t.Fatalf("statement is not blocked by the row lock: %v", err)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestSelectForUpdate(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db1 := openAccountDB(t, port, "root", "")
+	db2 := openAccountDB(t, port, "root", "")
+	db3 := openAccountDB(t, port, "root", "")
+	execAll(t, db1,
+		"create database for_update_db",
+		"use for_update_db",
+		"create table t (a int, b int)",
+		"insert into t values (1, 10), (2, 20)")
+	execAll(t, db2, "use for_update_db")
+	execAll(t, db3, "use for_update_db")
+
+	t.Run("commit without changes", func(t *testing.T) {
+		execAll(t, db1, "begin")
+		require.Equal(t, []string{"10"}, queryStrings(t, db1, "select b from t where a = 1 for update"))
+		done := execAsync(db2, "update t set b = b + 1 where a = 1")
+		requireBlocked(t, done)
+		// the plain reads are not blocked
+		require.Equal(t, []string{"10"}, queryStrings(t, db3, "select b from t where a = 1"))
+		execAll(t, db1, "commit")
+		require.NoError(t, <-done)
+		require.Equal(t, []string{"11"}, queryStrings(t, db1, "select b from t where a = 1"))
+	})
+
+	t.Run("commit with changes", func(t *testing.T) {
+		execAll(t, db1, "begin")
+		require.Equal(t, []string{"11"}, queryStrings(t, db1, "select b from t where a = 1 for update"))
+		done := execAsync(db2, "update t set b = b + 1 where a = 1")
+		requireBlocked(t, done)
+		execAll(t, db1, "update t set b = b + 1 where a = 1", "commit")
+		// the waiter read the row before it was changed, it must retry
+		// instead of losing the update
+		err := <-done
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "locked row was changed")
+		execAll(t, db2, "update t set b = b + 1 where a = 1")
+		require.Equal(t, []string{"13"}, queryStrings(t, db1, "select b from t where a = 1"))
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		execAll(t, db1, "begin")
+		require.Equal(t, []string{"13"}, queryStrings(t, db1, "select b from t where a = 1 for update"))
+		execAll(t, db1, "update t set b = 100 where a = 1")
+		done := execAsync(db2, "update t set b = b + 1 where a = 1")
+		requireBlocked(t, done)
+		execAll(t, db1, "rollback")
+		require.NoError(t, <-done)
+		require.Equal(t, []string{"14"}, queryStrings(t, db1, "select b from t where a = 1"))
+	})
+
+	t.Run("for update", func(t *testing.T) {
+		execAll(t, db1, "begin")
+		require.Equal(t, []string{"14"}, queryStrings(t, db1, "select b from t where a = 1 for update"))
+		execAll(t, db2, "begin")
+		done := make(chan []string, 1)
+		go func() {
+			done <- queryStrings(t, db2, "select b from t where a = 1 for update")
+		}()
+		select {
+		case <-done:
+			t.Fatal("select for update is not blocked by the row lock")
+		case <-time.After(300 * time.Millisecond):
+		}
+		execAll(t, db1, "commit")
+		require.Equal(t, []string{"14"}, <-done)
+		execAll(t, db2, "commit")
+	})
+}
+
+func TestSelectForUpdateTimeout(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), &options.Options{
+		LockCfg: &options.LockCfg{WaitTimeout: 200},
+	})
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	db1 := openAccountDB(t, port, "root", "")
+	db2 := openAccountDB(t, port, "root", "")
+	execAll(t, db1,
+		"create database for_update_db",
+		"use for_update_db",
+		"create table t (a int, b int)",
+		"insert into t values (1, 10)")
+	execAll(t, db2, "use for_update_db")
+
+	execAll(t, db1, "begin")
+	require.Equal(t, []string{"10"}, queryStrings(t, db1, "select b from t for update"))
+	_, err = db2.Exec("delete from t where a = 1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "lock wait timeout exceeded")
+	execAll(t, db1, "commit")
+
+	execAll(t, db2, "delete from t where a = 1")
+	require.Equal(t, []string{"0"}, queryStrings(t, db1, "select count(*) from t"))
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSeries(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database series_db",
+		"use series_db",
+		"create table sales (d datetime, amount int)",
+		"insert into sales values ('2022-01-01 00:00:00', 10), ('2022-01-03 00:00:00', 30), ('2022-01-04 00:00:00', 40)",
+		"create table ids (id int)",
+		"insert into ids values (2), (4), (7)",
+	)
+
+	require.Equal(t, []string{"1", "2", "3", "4", "5"}, queryStrings(t, db, "select * from generate_series(1, 5)"))
+	require.Equal(t, []string{"1", "4", "7", "10"}, queryStrings(t, db, "select generate_series from generate_series(1, 10, 3)"))
+	require.Equal(t, []string{"5", "3", "1"}, queryStrings(t, db, "select g from generate_series(5, 0, -2) as g(g)"))
+	require.Equal(t, []string{"3", "2", "1"}, queryStrings(t, db, "select * from generate_series(3, 1)"))
+	require.Equal(t, []string{"7"}, queryStrings(t, db, "select * from generate_series(7, 7, -1)"))
+	require.Empty(t, queryStrings(t, db, "select * from generate_series(1, null)"))
+
+	// a large series is read in many batches
+	require.Equal(t, []string{"1000000|500000500000"}, queryRows(t, db, "select count(*), sum(s.generate_series) from generate_series(1, 1000000) s"))
+	require.Equal(t, []string{"9223372036854775805", "9223372036854775807"}, queryStrings(t, db, "select * from generate_series(9223372036854775805, 9223372036854775807, 2)"))
+
+	require.Equal(t, []string{
+		"2022-01-31 00:00:00",
+		"2022-02-28 00:00:00",
+		"2022-03-31 00:00:00",
+	}, queryStrings(t, db, "select * from generate_series('2022-01-31', '2022-04-01', interval 1 month)"))
+	require.Equal(t, []string{
+		"2022-01-01 12:00:00",
+		"2022-01-01 06:00:00",
+		"2022-01-01 00:00:00",
+	}, queryStrings(t, db, "select * from generate_series('2022-01-01 12:00:00', '2022-01-01 00:00:00', interval -6 hour)"))
+
+	// the gaps of the days without sales are filled
+	require.Equal(t, []string{
+		"2022-01-01 00:00:00|10",
+		"2022-01-02 00:00:00|NULL",
+		"2022-01-03 00:00:00|30",
+		"2022-01-04 00:00:00|40",
+		"2022-01-05 00:00:00|NULL",
+	}, queryRows(t, db, "select g.d, s.amount from generate_series('2022-01-01', '2022-01-05', interval 1 day) as g(d) left join sales s on g.d = s.d order by g.d"))
+	require.Equal(t, []string{"1", "3", "5", "6"}, queryStrings(t, db, "select * from generate_series(1, 6) g where g.generate_series not in (select id from ids) order by g.generate_series"))
+
+	for _, query := range []string{
+		"select * from generate_series(1, 10, 0)",
+		"select * from generate_series(1, 10, -1)",
+		"select * from generate_series(10, 1, 1)",
+		"select * from generate_series('2022-01-02', '2022-01-01', interval 1 day)",
+	} {
+		_, err := db.Query(query)
+		require.Error(t, err, query)
+		require.Contains(t, err.Error(), "the step of generate_series", query)
+	}
+	_, err := db.Query("select * from generate_series(1, 10, ids.id)")
+	require.Error(t, err)
+	_, err = db.Query("select * from no_such_series(1, 10)")
+	require.Error(t, err)
+}
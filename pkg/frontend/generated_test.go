@@ -0,0 +1,64 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedColumns(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database generated_db",
+		"use generated_db",
+		"create table users (id int, email varchar(100), email_lc varchar(100) as (lower(email)) stored)",
+		"insert into users (id, email) values (1, 'Ann@Example.COM'), (2, 'BOB@example.com')",
+		"insert into users values (3, 'Carl@X.org', default)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, []string{"ann@example.com", "bob@example.com", "carl@x.org"}, queryStrings(t, db, "select email_lc from users order by id"))
+
+	_, err := db.Exec("insert into users values (4, 'Dan@X.org', 'dan@x.org')")
+	require.Error(t, err)
+	_, err = db.Exec("update users set email_lc = 'x' where id = 1")
+	require.Error(t, err)
+
+	_, err = db.Exec("update users set email = 'ANN@NEW.COM' where id = 1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ann@new.com"}, queryStrings(t, db, "select email_lc from users where id = 1"))
+
+	var name, sql string
+	require.NoError(t, db.QueryRow("show create table users").Scan(&name, &sql))
+	stmt, err := mysql.ParseOne(sql)
+	require.NoError(t, err, sql)
+	def := stmt.(*tree.CreateTable).Defs[2].(*tree.ColumnTableDef)
+	var generated *tree.AttributeGeneratedAlways
+	for _, attr := range def.Attributes {
+		if g, ok := attr.(*tree.AttributeGeneratedAlways); ok {
+			generated = g
+		}
+	}
+	require.NotNil(t, generated, sql)
+	require.True(t, generated.Stored)
+	require.Equal(t, "lower(email)", tree.String(generated.Expr, dialect.MYSQL))
+}
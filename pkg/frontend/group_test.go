@@ -0,0 +1,84 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamGroup(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database group_db",
+		"use group_db",
+		"create table t (a int, b int, c varchar(10) collate utf8mb4_general_ci, k int, d int)",
+		"create table u (x int)",
+		"insert into u values (1), (2), (3), (4), (5), (6)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the groups of a span several batches
+	const rows, batchRows = 30000, 10000
+	cs := []string{"x", "X", "y", "Y ", "z"}
+	for i := 0; i < rows; i += batchRows {
+		values := make([]string, batchRows)
+		for j := range values {
+			n := i + j
+			values[j] = fmt.Sprintf("(%d, %d, '%s', %d, 1)", n%7, n%3, cs[n%len(cs)], n)
+		}
+		_, err := db.Exec("insert into t values " + strings.Join(values, ", "))
+		require.NoError(t, err)
+	}
+
+	for _, c := range []struct {
+		keys, order string
+	}{
+		{"a", "a"},
+		{"a", "a desc"},
+		{"a, b", "b, a"},
+		{"a, b", "a desc, b"},
+		{"c", "c"},
+		// one group of all the rows
+		{"d", "d, k"},
+		// a group for every row
+		{"k", "k"},
+	} {
+		// the rows of a = 0 are a run of NULL keys
+		// the value of a key in its group is not compared, it is any of the
+		// values equal under the collation of the key
+		query := "select count(*), sum(a), max(k) from (select x as a, b, c, k, d from t left join u on a = x%s) s group by " + c.keys
+		hash := queryRows(t, db, fmt.Sprintf(query, ""))
+		stream := queryRows(t, db, fmt.Sprintf(query, " order by "+c.order))
+		require.ElementsMatch(t, hash, stream, c.order)
+	}
+
+	// the groups are built in the order of the input
+	res := queryRows(t, db, "select a, count(*) from (select a from t order by a desc) s group by a")
+	require.True(t, sort.SliceIsSorted(res, func(i, j int) bool { return res[i] > res[j] }), res)
+	require.Len(t, res, 7)
+
+	// the collation of the order differs from the collation of the keys
+	hash := queryRows(t, db, "select count(*), max(k) from t group by c")
+	stream := queryRows(t, db, "select count(*), max(k) from (select c, k from t order by c collate utf8mb4_bin) s group by c")
+	require.ElementsMatch(t, hash, stream)
+}
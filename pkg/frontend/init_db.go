@@ -229,7 +229,7 @@ func PrepareInitialDataForMoTables() [][]string {
 		mo_database,mo_tables,mo_columns
 
 		tables created in the initdb step:
-		mo_global_variables,mo_user
+		mo_global_variables,mo_user,mo_db_privilege,user_privileges
 	*/
 	data := [][]string{
 		{"mo_database", "mo_catalog", "p", "r", "tae hardcode", "databases"},
@@ -585,9 +585,13 @@ func DefineSchemaForMoUser() *CatalogSchema {
 }
 
 func PrepareInitialDataForMoUser() [][]string {
+	/*
+		the authentication_string is in the mysql_native_password format.
+		root has the empty password.
+	*/
 	data := [][]string{
-		{"localhost", "root", "''"},
-		{"localhost", "dump", "111"},
+		{"%", "root", ""},
+		{"%", "dump", encodeNativePassword("111")},
 	}
 	return data
 }
@@ -595,7 +599,103 @@ func PrepareInitialDataForMoUser() [][]string {
 func FillInitialDataForMoUser() *batch.Batch {
 	schema := DefineSchemaForMoUser()
 	data := PrepareInitialDataForMoUser()
-	return PrepareInitialDataForSchema(schema, data)
+	return prepareCatalogBatch(schema, data)
+}
+
+// DefineSchemaForMoDBPrivilege decides the schema of the mo_db_privilege
+func DefineSchemaForMoDBPrivilege() *CatalogSchema {
+	/*
+		mo_db_privilege schema
+		| Attribute | Type         | Primary Key | Note                          |
+		| --------- | ------------ | ---- | ------------------------------------ |
+		| user_name | varchar(256) |      | user name                            |
+		| db_name   | varchar(256) |      | database name, * for all databases   |
+		| privilege | varchar(16)  |      | read or write                        |
+	*/
+	userNameAttr := &CatalogSchemaAttribute{
+		AttributeName: "user_name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "user name",
+	}
+	userNameAttr.AttributeType.Width = 256
+
+	dbNameAttr := &CatalogSchemaAttribute{
+		AttributeName: "db_name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "database name, * for all databases",
+	}
+	dbNameAttr.AttributeType.Width = 256
+
+	privilegeAttr := &CatalogSchemaAttribute{
+		AttributeName: "privilege",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "read or write",
+	}
+	privilegeAttr.AttributeType.Width = 16
+
+	attrs := []*CatalogSchemaAttribute{
+		userNameAttr,
+		dbNameAttr,
+		privilegeAttr,
+	}
+	return &CatalogSchema{Name: "mo_db_privilege", Attributes: attrs}
+}
+
+// DefineSchemaForMoUserPrivileges decides the schema of the user_privileges.
+// It is the privileges in mo_db_privilege in the form of the
+// information_schema, and it is rewritten with mo_db_privilege.
+func DefineSchemaForMoUserPrivileges() *CatalogSchema {
+	/*
+		user_privileges schema
+		| Attribute      | Type         | Primary Key | Note                          |
+		| -------------- | ------------ | ---- | ------------------------------------ |
+		| grantee        | varchar(520) |      | 'user name'@'user host'              |
+		| table_schema   | varchar(256) |      | database name, * for all databases   |
+		| privilege_type | varchar(16)  |      | SELECT, INSERT, UPDATE or DELETE     |
+		| is_grantable   | varchar(3)   |      | always NO                            |
+	*/
+	granteeAttr := &CatalogSchemaAttribute{
+		AttributeName: "grantee",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "'user name'@'user host'",
+	}
+	granteeAttr.AttributeType.Width = 520
+
+	schemaAttr := &CatalogSchemaAttribute{
+		AttributeName: "table_schema",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "database name, * for all databases",
+	}
+	schemaAttr.AttributeType.Width = 256
+
+	privilegeTypeAttr := &CatalogSchemaAttribute{
+		AttributeName: "privilege_type",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "SELECT, INSERT, UPDATE or DELETE",
+	}
+	privilegeTypeAttr.AttributeType.Width = 16
+
+	grantableAttr := &CatalogSchemaAttribute{
+		AttributeName: "is_grantable",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "always NO",
+	}
+	grantableAttr.AttributeType.Width = 3
+
+	attrs := []*CatalogSchemaAttribute{
+		granteeAttr,
+		schemaAttr,
+		privilegeTypeAttr,
+		grantableAttr,
+	}
+	return &CatalogSchema{Name: "user_privileges", Attributes: attrs}
 }
 
 // InitDB setups the initial catalog tables in tae
@@ -714,6 +814,34 @@ func InitDB(tae engine.Engine) error {
 		return err
 	}
 
+	//4. create table mo_db_privilege
+	privSch := DefineSchemaForMoDBPrivilege()
+	privDefs := convertCatalogSchemaToTableDef(privSch)
+	err = catalogDB.Create(0, privSch.GetName(), privDefs, txnCtx.GetCtx())
+	if err != nil {
+		logutil.Infof("create table %v failed.error:%v", privSch.GetName(), err)
+		err2 := txnCtx.Rollback()
+		if err2 != nil {
+			logutil.Infof("txnCtx rollback failed. error:%v", err2)
+			return err2
+		}
+		return err
+	}
+
+	//5. create table user_privileges
+	userPrivSch := DefineSchemaForMoUserPrivileges()
+	userPrivDefs := convertCatalogSchemaToTableDef(userPrivSch)
+	err = catalogDB.Create(0, userPrivSch.GetName(), userPrivDefs, txnCtx.GetCtx())
+	if err != nil {
+		logutil.Infof("create table %v failed.error:%v", userPrivSch.GetName(), err)
+		err2 := txnCtx.Rollback()
+		if err2 != nil {
+			logutil.Infof("txnCtx rollback failed. error:%v", err2)
+			return err2
+		}
+		return err
+	}
+
 	/*
 		stage 2: create information_schema database.
 		Views in the information_schema need to created by 'create view'
@@ -759,14 +887,16 @@ func sanityCheck(tae engine.Engine) error {
 		return errorMissingCatalogDatabases
 	}
 
-	// database mo_catalog has tables:mo_database,mo_tables,mo_columns,mo_global_variables, mo_user
-	wantTablesOfMoCatalog := []string{"mo_database", "mo_tables", "mo_columns", "mo_global_variables", "mo_user"}
+	// database mo_catalog has tables:mo_database,mo_tables,mo_columns,mo_global_variables, mo_user, mo_db_privilege, user_privileges
+	wantTablesOfMoCatalog := []string{"mo_database", "mo_tables", "mo_columns", "mo_global_variables", "mo_user", "mo_db_privilege", "user_privileges"}
 	wantSchemasOfCatalog := []*CatalogSchema{
 		DefineSchemaForMoDatabase(),
 		DefineSchemaForMoTables(),
 		DefineSchemaForMoColumns(),
 		DefineSchemaForMoGlobalVariables(),
 		DefineSchemaForMoUser(),
+		DefineSchemaForMoDBPrivilege(),
+		DefineSchemaForMoUserPrivileges(),
 	}
 	catalogDbName := "mo_catalog"
 	err = isWantedDatabase(taeEngine, txnCtx, catalogDbName, wantTablesOfMoCatalog, wantSchemasOfCatalog)
@@ -894,11 +1024,15 @@ func isWantedTable(db engine.Database, txnCtx moengine.Txn,
 	*/
 	//read data from table
 	readers := table.NewReader(1, nil, nil, txnCtx.GetCtx())
-	x := make([]uint64, schema.Length())
-	fieldNames := make([]string, schema.Length())
+	var x []uint64
+	var fieldNames []string
 	for i := 0; i < schema.Length(); i++ {
-		x[i] = 1
-		fieldNames[i] = schema.GetAttribute(i).GetName()
+		//the password hashes are not printed
+		if schema.GetAttribute(i).GetName() == "authentication_string" {
+			continue
+		}
+		x = append(x, 1)
+		fieldNames = append(fieldNames, schema.GetAttribute(i).GetName())
 	}
 	fmt.Printf("\nTable:%s \n\nAttributes:\n%v \n\n", tableName, fieldNames)
 	fmt.Printf("Datas:\n\n")
@@ -906,6 +1040,10 @@ func isWantedTable(db engine.Database, txnCtx moengine.Txn,
 	if err != nil {
 		return err
 	}
+	//the table is empty
+	if result == nil {
+		return nil
+	}
 	for i := 0; i < vector.Length(result.Vecs[0]); i++ {
 		line := FormatLineInBatch(result, i)
 		fmt.Println(line)
@@ -16,18 +16,26 @@ package frontend
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"go/constant"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type InsertValues struct {
@@ -36,9 +44,57 @@ type InsertValues struct {
 	currentDb string
 	dataBatch *batch.Batch
 	relation  engine.Relation
+	// generated, the expressions of the stored generated columns by their
+	// positions in dataBatch
+	generated map[int]*plan2.Expr
+	// defaults, the columns whose defaults are computed from expressions by
+	// their positions in dataBatch
+	defaults map[int]*defaultColumn
+	// ignore, INSERT IGNORE skips the rows violating the primary key, NOT
+	// NULL or the widths of the columns with warnings
+	ignore bool
+	// skipped, the warnings of the rows skipped by their positions in the
+	// rows inserted
+	skipped map[int]process.Warning
 }
 
-func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) error {
+// skip skips the row with the warning, a row is warned once
+func (plan *InsertValues) skip(row int, code uint16, message string) {
+	if plan.skipped == nil {
+		plan.skipped = make(map[int]process.Warning)
+	}
+	if _, ok := plan.skipped[row]; !ok {
+		plan.skipped[row] = process.Warning{Level: "Warning", Code: code, Message: message}
+	}
+}
+
+// dropSkipped removes the rows skipped from dataBatch, rows are the positions
+// of its rows in the rows inserted, the ones of the rows left are returned
+func (plan *InsertValues) dropSkipped(rows []int64) []int64 {
+	sels := make([]int64, 0, len(rows))
+	kept := rows[:0]
+	for i, row := range rows {
+		if _, ok := plan.skipped[int(row)]; !ok {
+			sels = append(sels, int64(i))
+			kept = append(kept, row)
+		}
+	}
+	if len(sels) < len(rows) {
+		for _, vec := range plan.dataBatch.Vecs {
+			vector.Shrink(vec, sels)
+		}
+	}
+	return kept
+}
+
+// defaultColumn is a column whose default is computed from an expression
+type defaultColumn struct {
+	expr *plan2.Expr
+	// sels, the rows taking the default, in ascending order
+	sels []int64
+}
+
+func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64, stmtProc *process.Process) error {
 	snapshot := mce.GetSession().GetTxnHandler().GetTxn().GetCtx()
 
 	plan := &InsertValues{currentDb: mce.GetSession().GetDatabaseName()}
@@ -48,17 +104,235 @@ func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) er
 	}
 
 	defer plan.relation.Close(snapshot)
-	if err := plan.relation.Write(ts, plan.dataBatch, snapshot); err != nil {
+	if err := mce.GetSession().checkPrivilege(plan.dbName, plan.tblName, privilegeWrite); err != nil {
 		return err
 	}
+	proc := process.New(mheap.New(mce.GetSession().GuestMmu))
+	proc.UnixTime = time.Now().UnixNano()
+	proc.ShareWarnings(stmtProc)
+	defer func() {
+		for i := range plan.defaults {
+			vector.Clean(plan.dataBatch.Vecs[i], proc.Mp)
+		}
+	}()
+	if err := evalDefaultColumns(plan, proc); err != nil {
+		return err
+	}
+	if err := evalGeneratedColumns(plan, proc); err != nil {
+		return err
+	}
+	defer func() {
+		for i := range plan.generated {
+			vector.Clean(plan.dataBatch.Vecs[i], proc.Mp)
+		}
+	}()
+	if plan.ignore {
+		if err := mce.skipInsertValues(plan, snapshot, proc); err != nil {
+			return err
+		}
+	}
+	rows := vector.Length(plan.dataBatch.Vecs[0])
+	if rows > 0 || !plan.ignore {
+		if err := mce.writeInsertValues(plan, ts, snapshot); err != nil {
+			return err
+		}
+	}
 
-	resp := NewOkResponse(uint64(vector.Length(plan.dataBatch.Vecs[0])), 0, 0, 0, int(COM_QUERY), "")
+	resp := NewOkResponse(uint64(rows), 0, proc.Warnings(), 0, int(COM_QUERY), "")
 	if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
 	return nil
 }
 
+// skipInsertValues removes the rows skipped by INSERT IGNORE from dataBatch
+// and records their warnings in proc. The rows out of the widths of their
+// columns are found by buildInsertValues, then the rows with nulls in the NOT
+// NULL columns, then the rows whose primary keys are duplicates in the table
+// or of the earlier rows.
+func (mce *MysqlCmdExecutor) skipInsertValues(plan *InsertValues, snapshot engine.Snapshot, proc *process.Process) error {
+	bat := plan.dataBatch
+	n := vector.Length(bat.Vecs[0])
+	for _, def := range plan.relation.TableDefs(snapshot) {
+		attr, ok := def.(*engine.AttributeDef)
+		if !ok || !(attr.Attr.NotNull || attr.Attr.Primary) {
+			continue
+		}
+		vec := batch.GetVector(bat, attr.Attr.Name)
+		if vec == nil || !nulls.Any(vec.Nsp) {
+			continue
+		}
+		for row := 0; row < n; row++ {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				plan.skip(row, ER_BAD_NULL_ERROR, fmt.Sprintf("Column '%s' cannot be null", attr.Attr.Name))
+			}
+		}
+	}
+	rows := make([]int64, n)
+	for i := range rows {
+		rows[i] = int64(i)
+	}
+	if rows = plan.dropSkipped(rows); len(rows) > 0 {
+		dups, err := mce.insertDuplicates(plan, snapshot)
+		if err != nil {
+			return err
+		}
+		pks := plan.relation.GetPrimaryKeys(snapshot)
+		for _, i := range dups {
+			keys := make([]string, len(pks))
+			for j, pk := range pks {
+				v, err := userVariableValue(batch.GetVector(bat, pk.Name), i)
+				if err != nil {
+					return err
+				}
+				if d, ok := v.(plan2.DecimalVar); ok {
+					v = d.Text
+				}
+				keys[j] = fmt.Sprint(v)
+			}
+			plan.skip(int(rows[i]), ER_DUP_ENTRY, fmt.Sprintf("Duplicate entry '%s' for key 'PRIMARY'", strings.Join(keys, "-")))
+		}
+		plan.dropSkipped(rows)
+	}
+
+	skipped := make([]int, 0, len(plan.skipped))
+	for row := range plan.skipped {
+		skipped = append(skipped, row)
+	}
+	sort.Ints(skipped)
+	for _, row := range skipped {
+		w := plan.skipped[row]
+		proc.AddWarning(w.Level, w.Code, w.Message)
+	}
+	return nil
+}
+
+// insertDuplicates returns the rows of dataBatch whose primary keys are
+// duplicates, the rows of a partitioned table are deduplicated in the
+// relations of their partitions
+func (mce *MysqlCmdExecutor) insertDuplicates(plan *InsertValues, snapshot engine.Snapshot) ([]int64, error) {
+	db, err := mce.GetSession().GetStorage().Database(plan.dbName, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	partition, err := getPartitionDef(db, plan.tblName, plan.relation.TableDefs(snapshot), snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if partition == nil {
+		return duplicates(plan.relation, plan.dataBatch)
+	}
+
+	vec := batch.GetVector(plan.dataBatch, partition.Column)
+	if vec == nil {
+		return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("missing value of partition column '%s'", partition.Column))
+	}
+	parts, err := partition.Route(vec)
+	if err != nil {
+		return nil, err
+	}
+	m := mheap.New(mce.GetSession().GuestMmu)
+	bats, err := partition.SplitBatch(plan.dataBatch, parts, m)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, bat := range bats {
+			if bat != nil && bat != plan.dataBatch {
+				bat.Clean(m)
+			}
+		}
+	}()
+	// the rows of a partition are in the order of dataBatch
+	sels := make([][]int64, len(bats))
+	for i, p := range parts {
+		sels[p] = append(sels[p], int64(i))
+	}
+	var dups []int64
+	for i, bat := range bats {
+		if bat == nil {
+			continue
+		}
+		relation, err := db.Relation(plan2.PartitionTableName(plan.tblName, partition.Names[i]), snapshot)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := duplicates(relation, bat)
+		relation.Close(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			dups = append(dups, sels[i][row])
+		}
+	}
+	sort.Slice(dups, func(i, j int) bool { return dups[i] < dups[j] })
+	return dups, nil
+}
+
+// duplicates returns the rows of the batch whose primary keys are duplicates
+// in the relation or of the earlier rows
+func duplicates(relation engine.Relation, bat *batch.Batch) ([]int64, error) {
+	rel, ok := relation.(engine.DedupRelation)
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, "INSERT IGNORE is not supported by the engine of the table")
+	}
+	return rel.Duplicates(bat)
+}
+
+// writeInsertValues writes the rows into the relation, the rows of a
+// partitioned table are routed into the relations of their partitions
+func (mce *MysqlCmdExecutor) writeInsertValues(plan *InsertValues, ts uint64, snapshot engine.Snapshot) error {
+	db, err := mce.GetSession().GetStorage().Database(plan.dbName, snapshot)
+	if err != nil {
+		return err
+	}
+	defs := plan.relation.TableDefs(snapshot)
+	partition, err := getPartitionDef(db, plan.tblName, defs, snapshot)
+	if err != nil {
+		return err
+	}
+	if partition == nil {
+		return plan.relation.Write(ts, plan.dataBatch, snapshot)
+	}
+
+	vec := batch.GetVector(plan.dataBatch, partition.Column)
+	if vec == nil {
+		return errors.New(errno.InvalidColumnReference, fmt.Sprintf("missing value of partition column '%s'", partition.Column))
+	}
+	parts, err := partition.Route(vec)
+	if err != nil {
+		return err
+	}
+	m := mheap.New(mce.GetSession().GuestMmu)
+	bats, err := partition.SplitBatch(plan.dataBatch, parts, m)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, bat := range bats {
+			if bat != nil && bat != plan.dataBatch {
+				bat.Clean(m)
+			}
+		}
+	}()
+	for i, bat := range bats {
+		if bat == nil {
+			continue
+		}
+		relation, err := db.Relation(plan2.PartitionTableName(plan.tblName, partition.Names[i]), snapshot)
+		if err != nil {
+			return err
+		}
+		err = relation.Write(ts, bat, snapshot)
+		relation.Close(snapshot)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getTableRef(tbl *tree.TableName, currentDB string, eg engine.Engine, snapshot engine.Snapshot) (string, string, engine.Relation, error) {
 	if len(tbl.SchemaName) == 0 {
 		tbl.SchemaName = tree.Identifier(currentDB)
@@ -94,10 +368,33 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 	plan.tblName = id
 	plan.relation = relation
 	plan.dbName = db
+	plan.ignore = stmt.Ignore
+
+	// check checks the value by rangeCheck, INSERT IGNORE skips the rows
+	// whose values are out of the ranges or the widths of their columns
+	check := func(value interface{}, typ types.Type, columnName string, rowNumber int) (interface{}, error) {
+		v, err := rangeCheck(value, typ, columnName, rowNumber)
+		if err == nil || !plan.ignore {
+			return v, err
+		}
+		if e, ok := err.(*errors.SqlError); !ok || e.Code() != errno.DataException {
+			return v, err
+		}
+		code, msg := ER_WARN_DATA_OUT_OF_RANGE, fmt.Sprintf("Out of range value for column '%s' at row %d", columnName, rowNumber)
+		if typ.Oid == types.T_char || typ.Oid == types.T_varchar || typ.Oid == types.T_bit {
+			code, msg = ER_DATA_TOO_LONG, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber)
+		}
+		plan.skip(rowNumber-1, code, msg)
+		return zeroValue(typ), nil
+	}
 
 	attrType := make(map[string]types.Type)   // Map from relation's attribute name to its type
 	attrDefault := make(map[string]tree.Expr) // Map from relation's attribute name to its default value
 	orderAttr := make([]string, 0, 32)        // order relation's attribute names
+	var colDefs []*plan2.ColDef               // the columns of the relation, by which the generated columns are bound
+	generated := make(map[string]bool)        // the stored generated columns
+	// the placeholders of the defaults computed from expressions
+	placeholders := make(map[string]tree.Expr)
 	{
 		count := 0
 		for _, def := range relation.TableDefs(snapshot) {
@@ -108,6 +405,17 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					value, null := v.Attr.GetDefaultExpr()
 					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, value, null)
 				}
+				if v.Attr.DefaultExpression != "" {
+					// the default is computed from its expression later
+					placeholders[v.Attr.Name] = makeExprFromVal(v.Attr.Type, nil, true)
+					attrDefault[v.Attr.Name] = placeholders[v.Attr.Name]
+				}
+				if v.Attr.Generated != "" {
+					// the generated column is filled by its expression later
+					generated[v.Attr.Name] = true
+					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, nil, true)
+				}
+				colDefs = append(colDefs, makeColDef(&v.Attr))
 				count++
 			}
 		}
@@ -122,6 +430,17 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 	} else {
 		attrs = orderAttr // todo: need to use copy ?
 	}
+	// only the default of a generated column can be inserted
+	for i, attr := range attrs {
+		if !generated[attr] {
+			continue
+		}
+		for _, row := range rows.Rows {
+			if i < len(row) && !isDefaultExpr(row[i]) {
+				return errors.New(errno.GeneratedAlways, fmt.Sprintf("the value specified for generated column '%s' in table '%s' is not allowed", attr, id))
+			}
+		}
+	}
 	// deal with default Expr
 	rows.Rows, attrs, err = rewriteInsertRows(stmt.Columns == nil, attrs, orderAttr, rows.Rows, attrDefault)
 	if err != nil {
@@ -133,6 +452,19 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			return errors.New(errno.InvalidColumnReference, fmt.Sprintf("Column count doesn't match value count at row '%v'", i))
 		}
 	}
+	// the rows taking the defaults computed from expressions
+	defaultRows := make(map[string][]int64)
+	for i, attr := range attrs {
+		placeholder, ok := placeholders[attr]
+		if !ok {
+			continue
+		}
+		for j, row := range rows.Rows {
+			if row[i] == placeholder {
+				defaultRows[attr] = append(defaultRows[attr], int64(j))
+			}
+		}
+	}
 
 	bat = batch.New(true, attrs)
 	for i, attr := range attrs {
@@ -163,7 +495,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(bool), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(bool), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(bool)
@@ -185,7 +517,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(int8)
@@ -207,7 +539,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(int16)
@@ -229,7 +561,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(int32)
@@ -251,7 +583,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(int64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(int64)
@@ -273,7 +605,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(uint8)
@@ -295,7 +627,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(uint16)
@@ -317,7 +649,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(uint32)
@@ -328,7 +660,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit:
 			vs := make([]uint64, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
@@ -339,7 +671,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(uint64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(uint64)
@@ -361,7 +693,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(float32), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(float32), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(float32)
@@ -383,7 +715,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(float64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(float64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(float64)
@@ -405,7 +737,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(string), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(string), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = []byte(vv.(string))
@@ -427,7 +759,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(types.Date), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(types.Date), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(types.Date)
@@ -449,7 +781,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(types.Datetime), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(types.Datetime), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(types.Datetime)
@@ -471,7 +803,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(types.Timestamp), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(types.Timestamp), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(types.Timestamp)
@@ -482,6 +814,28 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := check(v.(types.Time), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Time)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_decimal64:
 			vs := make([]types.Decimal64, len(rows.Rows))
 			{
@@ -493,7 +847,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(types.Decimal64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(types.Decimal64), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(types.Decimal64)
@@ -515,7 +869,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 					if v == nil {
 						nulls.Add(vec.Nsp, uint64(j))
 					} else {
-						if vv, err := rangeCheck(v.(types.Decimal128), vec.Typ, bat.Attrs[i], j+1); err != nil {
+						if vv, err := check(v.(types.Decimal128), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
 							vs[j] = vv.(types.Decimal128)
@@ -552,7 +906,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vec.Col = make([]uint16, len(rows.Rows))
 		case types.T_uint32:
 			vec.Col = make([]uint32, len(rows.Rows))
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit:
 			vec.Col = make([]uint64, len(rows.Rows))
 		case types.T_float32:
 			vec.Col = make([]float32, len(rows.Rows))
@@ -579,9 +933,139 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 	}
 	batch.Reorder(bat, orderAttr)
 	plan.dataBatch = bat
+	for i, col := range colDefs {
+		sels, ok := defaultRows[col.Name]
+		if !ok {
+			continue
+		}
+		expr, err := plan2.BuildDefaultExpr(col)
+		if err != nil {
+			return err
+		}
+		if plan.defaults == nil {
+			plan.defaults = make(map[int]*defaultColumn)
+		}
+		plan.defaults[i] = &defaultColumn{expr: expr, sels: sels}
+	}
+	for i, col := range colDefs {
+		if col.Generated == "" {
+			continue
+		}
+		expr, err := plan2.BuildGeneratedExpr(col, colDefs)
+		if err != nil {
+			return err
+		}
+		if plan.generated == nil {
+			plan.generated = make(map[int]*plan2.Expr)
+		}
+		plan.generated[i] = expr
+	}
+	return nil
+}
+
+func makeColDef(attr *engine.Attribute) *plan2.ColDef {
+	return &plan2.ColDef{
+		Name: attr.Name,
+		Typ: &plan2.Type{
+			Id:        plan.Type_TypeId(attr.Type.Oid),
+			Width:     attr.Type.Width,
+			Precision: attr.Type.Precision,
+			Scale:     attr.Type.Scale,
+		},
+		Generated:         attr.Generated,
+		DefaultExpression: attr.DefaultExpression,
+	}
+}
+
+// evalDefaultColumns computes the defaults of the rows to insert from the
+// expressions of the columns. The volatile expressions are evaluated for
+// every row, the others once for the statement.
+func evalDefaultColumns(plan *InsertValues, proc *process.Process) error {
+	if len(plan.defaults) == 0 {
+		return nil
+	}
+	bat := plan.dataBatch
+	n := vector.Length(bat.Vecs[0])
+	// the expressions refer to no column, they are evaluated for one row
+	ebat := &batch.Batch{Zs: make([]int64, 1)}
+	for i, def := range plan.defaults {
+		volatile := plan2.IsVolatileExpr(def.expr)
+		vec := vector.New(bat.Vecs[i].Typ)
+		sels := def.sels
+		var val *vector.Vector
+		var err error
+		for j := 0; j < n; j++ {
+			if len(sels) == 0 || sels[0] != int64(j) {
+				if err = vector.UnionOne(vec, bat.Vecs[i], int64(j), proc.Mp); err != nil {
+					vector.Clean(vec, proc.Mp)
+					return err
+				}
+				continue
+			}
+			sels = sels[1:]
+			if val == nil || volatile {
+				if val, err = colexec.EvalExpr(ebat, proc, def.expr); err != nil {
+					vector.Clean(vec, proc.Mp)
+					return err
+				}
+			}
+			if err = vector.UnionOne(vec, val, 0, proc.Mp); err != nil {
+				vector.Clean(vec, proc.Mp)
+				return err
+			}
+		}
+		bat.Vecs[i] = vec
+	}
 	return nil
 }
 
+// evalGeneratedColumns computes the stored generated columns of the rows to
+// insert from the other columns of the rows
+func evalGeneratedColumns(plan *InsertValues, proc *process.Process) error {
+	if len(plan.generated) == 0 {
+		return nil
+	}
+	bat := plan.dataBatch
+	n := vector.Length(bat.Vecs[0])
+	ebat := &batch.Batch{Attrs: bat.Attrs, Vecs: bat.Vecs, Zs: make([]int64, n)}
+	vecs := make(map[int]*vector.Vector, len(plan.generated))
+	for i, expr := range plan.generated {
+		vec, err := colexec.EvalExpr(ebat, proc, expr)
+		if err != nil {
+			return err
+		}
+		switch {
+		case vec.IsScalar():
+			rvec := vector.New(vec.Typ)
+			for j := 0; j < n; j++ {
+				if err = vector.UnionOne(rvec, vec, 0, proc.Mp); err != nil {
+					return err
+				}
+			}
+			vec = rvec
+		case isBatchVector(bat, vec):
+			// the expression is a column of the row
+			if vec, err = vector.Dup(vec, proc.Mp); err != nil {
+				return err
+			}
+		}
+		vecs[i] = vec
+	}
+	for i, vec := range vecs {
+		bat.Vecs[i] = vec
+	}
+	return nil
+}
+
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
+
 // makeExprFromVal make an expr from value
 func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 	if isNull {
@@ -628,7 +1112,7 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := uint64(value.(uint32))
 		str := strconv.FormatUint(res, 10)
 		return tree.NewNumVal(constant.MakeUint64(res), str, false)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		res := value.(uint64)
 		str := strconv.FormatUint(res, 10)
 		return tree.NewNumVal(constant.MakeUint64(res), str, false)
@@ -649,6 +1133,9 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 	case types.T_datetime:
 		res := value.(types.Datetime).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_time:
+		res := value.(types.Time).String2(typ.Precision)
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	}
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
@@ -753,12 +1240,12 @@ func isDefaultExpr(expr tree.Expr) bool {
 
 var (
 	// errors may happen while building constant
-	ErrDivByZero          = errors.New(errno.SyntaxErrororAccessRuleViolation, "division by zero")
-	ErrZeroModulus        = errors.New(errno.SyntaxErrororAccessRuleViolation, "zero modulus")
-	errConstantOutRange   = errors.New(errno.DataException, "constant value out of range")
+	ErrDivByZero          = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "division by zero")
+	ErrZeroModulus        = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "zero modulus")
+	errConstantOutRange   = moerr.NewError(moerr.OUT_OF_RANGE, "constant value out of range")
 	errConstantNotAllowed = errors.New(errno.DataException, "constant value not allowed")
-	errBinaryOutRange     = errors.New(errno.DataException, "binary result out of range")
-	errUnaryOutRange      = errors.New(errno.DataException, "unary result out of range")
+	errBinaryOutRange     = moerr.NewError(moerr.OUT_OF_RANGE, "binary result out of range")
+	errUnaryOutRange      = moerr.NewError(moerr.OUT_OF_RANGE, "unary result out of range")
 )
 
 func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
@@ -774,6 +1261,10 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 		if e.Op == tree.UNARY_MINUS {
 			switch n := e.Expr.(type) {
 			case *tree.NumVal:
+				n, err := numericBinaryLiteral(typ, n)
+				if err != nil {
+					return nil, err
+				}
 				return buildConstantValue(typ, tree.NewNumVal(n.Value, "-"+n.String(), true))
 			}
 
@@ -884,7 +1375,7 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 				return int64(floatResult - 0.5), nil
 			}
 			return int64(floatResult), nil
-		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit:
 			if floatResult < 0 || floatResult+0.5 > math.MaxInt64 {
 				return nil, errBinaryOutRange
 			}
@@ -921,7 +1412,7 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 				return int64(floatResult - 0.5), nil
 			}
 			return int64(floatResult), nil
-		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit:
 			if floatResult < 0 || floatResult+0.5 > math.MaxInt64 {
 				return nil, errBinaryOutRange
 			}
@@ -943,7 +1434,30 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 }
 
+// numericBinaryLiteral returns the number of a binary literal for a numeric
+// column, and num itself otherwise.
+func numericBinaryLiteral(typ types.Type, num *tree.NumVal) (*tree.NumVal, error) {
+	if !num.IsBinaryLiteral() {
+		return num, nil
+	}
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		v, ok := num.BinaryLiteralUint64()
+		if !ok {
+			return nil, errConstantOutRange
+		}
+		return tree.NewNumValWithType(constant.MakeUint64(v), strconv.FormatUint(v, 10), false, tree.P_int64), nil
+	}
+	return num, nil
+}
+
 func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
+	num, err := numericBinaryLiteral(typ, num)
+	if err != nil {
+		return nil, err
+	}
 	val := num.Value
 	str := num.String()
 
@@ -971,10 +1485,10 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return int64(v), nil
 			}
 		case types.T_decimal64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
-		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
+		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit:
 			v, _ := constant.Uint64Val(val)
 			if num.Negative() {
 				if v != 0 {
@@ -1002,6 +1516,12 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case types.T_time:
+			v, _ := constant.Int64Val(val)
+			if num.Negative() {
+				v = -v
+			}
+			return types.ParseTimeFromInt64(v)
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -1028,7 +1548,7 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				}
 			}
 			return v, nil
-		case types.T_uint64, types.T_uint32, types.T_uint16, types.T_uint8:
+		case types.T_uint64, types.T_bit, types.T_uint32, types.T_uint16, types.T_uint8:
 			parts := strings.Split(str, ".")
 			v, err := strconv.ParseUint(parts[0], 10, 64)
 			if err != nil || len(parts) == 1 {
@@ -1061,17 +1581,28 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			return float64(v), nil
 		case types.T_datetime:
 			return types.ParseDatetime(str)
+		case types.T_time:
+			return types.ParseTime(str, typ.Precision)
 		case types.T_decimal64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
 		}
 	case constant.String:
 		switch typ.Oid {
 		case types.T_decimal64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
+		case types.T_time:
+			return types.ParseTime(constant.StringVal(val), typ.Precision)
+		case types.T_bit:
+			// a string is stored in a BIT column as the bits of its bytes
+			v, ok := types.ParseBitBytes([]byte(constant.StringVal(val)))
+			if !ok {
+				return nil, errConstantOutRange
+			}
+			return v, nil
 		}
 		if !num.Negative() {
 			switch typ.Oid {
@@ -1090,6 +1621,50 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 }
 
 // rangeCheck do range check for value, and do type conversion.
+// zeroValue returns the zero value of the type as built by rangeCheck, it
+// takes the place of a value skipped by INSERT IGNORE
+func zeroValue(typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_bool:
+		return false
+	case types.T_int8:
+		return int8(0)
+	case types.T_int16:
+		return int16(0)
+	case types.T_int32:
+		return int32(0)
+	case types.T_int64:
+		return int64(0)
+	case types.T_uint8:
+		return uint8(0)
+	case types.T_uint16:
+		return uint16(0)
+	case types.T_uint32:
+		return uint32(0)
+	case types.T_uint64, types.T_bit:
+		return uint64(0)
+	case types.T_float32:
+		return float32(0)
+	case types.T_float64:
+		return float64(0)
+	case types.T_char, types.T_varchar:
+		return ""
+	case types.T_date:
+		return types.Date(0)
+	case types.T_datetime:
+		return types.Datetime(0)
+	case types.T_timestamp:
+		return types.Timestamp(0)
+	case types.T_time:
+		return types.Time(0)
+	case types.T_decimal64:
+		return types.Decimal64(0)
+	case types.T_decimal128:
+		return types.Decimal128{}
+	}
+	return nil
+}
+
 func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber int) (interface{}, error) {
 	errString := "Out of range value for column '%s' at row %d"
 
@@ -1130,6 +1705,11 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			}
 		case types.T_uint64:
 			return v, nil
+		case types.T_bit:
+			if types.BitFits(v, typ.Width) {
+				return v, nil
+			}
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
@@ -1164,7 +1744,7 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime, types.Timestamp, types.Decimal64, types.Decimal128, bool:
+	case types.Date, types.Datetime, types.Timestamp, types.Time, types.Decimal64, types.Decimal128, bool:
 		return v, nil
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
@@ -0,0 +1,103 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireAffected(t *testing.T, db *sql.DB, stmt string, want int64) {
+	res, err := db.Exec(stmt)
+	require.NoError(t, err, stmt)
+	n, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, want, n, stmt)
+}
+
+func TestInsertIgnore(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database ignore_db",
+		"use ignore_db",
+		"create table t (a int primary key, b varchar(3) not null, c tinyint)",
+		"insert into t values (1, 'a', 1), (2, 'b', 2)",
+	)
+
+	t.Run("skip", func(t *testing.T) {
+		requireAffected(t, db, "insert ignore into t values (1, 'x', 0), (3, 'c', 3), (3, 'd', 4), (4, null, 4), (5, 'eeee', 5), (6, 'f', 1000), (7, 'g', 7)", 2)
+		warnings := []string{
+			"Warning|1062|Duplicate entry '1' for key 'PRIMARY'",
+			"Warning|1062|Duplicate entry '3' for key 'PRIMARY'",
+			"Warning|1048|Column 'b' cannot be null",
+			"Warning|1406|Data too long for column 'b' at row 5",
+			"Warning|1264|Out of range value for column 'c' at row 6",
+		}
+		require.Equal(t, warnings, queryRows(t, db, "show warnings"))
+		// SHOW WARNINGS lists the warnings of the statement before it
+		require.Equal(t, warnings, queryRows(t, db, "show warnings"))
+		require.Equal(t, []string{"1|a|1", "2|b|2", "3|c|3", "7|g|7"}, queryRows(t, db, "select * from t order by a"))
+		require.Empty(t, queryRows(t, db, "show warnings"))
+
+		requireAffected(t, db, "insert ignore into t values (1, 'a', 1), (2, 'b', 2)", 0)
+		require.Len(t, queryRows(t, db, "show warnings"), 2)
+		requireAffected(t, db, "insert ignore into t (a, b) values (8, 'h')", 1)
+		require.Empty(t, queryRows(t, db, "show warnings"))
+		require.Equal(t, []string{"8|h|NULL"}, queryRows(t, db, "select * from t where a = 8"))
+
+		// without IGNORE a duplicate fails the statement
+		_, err := db.Exec("insert into t values (9, 'i', 9), (1, 'x', 1)")
+		require.Error(t, err)
+		require.Equal(t, []string{"5"}, queryStrings(t, db, "select count(*) from t"))
+	})
+
+	t.Run("txn", func(t *testing.T) {
+		execAll(t, db, "begin")
+		// the rows appended by the txn are duplicates too
+		requireAffected(t, db, "insert ignore into t values (10, 'j', 10), (1, 'x', 1)", 1)
+		requireAffected(t, db, "insert ignore into t values (10, 'k', 10), (11, 'k', 11)", 1)
+		require.Equal(t, []string{"Warning|1062|Duplicate entry '10' for key 'PRIMARY'"}, queryRows(t, db, "show warnings"))
+		// an error other than the skipped violations still fails the
+		// statement, the rows of the statement are not inserted
+		_, err := db.Exec("insert ignore into t values (12, 'l', 12), (13)")
+		require.Error(t, err)
+		require.Empty(t, queryRows(t, db, "show warnings"))
+		execAll(t, db, "commit")
+		require.Equal(t, []string{"10|j|10", "11|k|11"}, queryRows(t, db, "select * from t where a >= 10 order by a"))
+
+		_, err = db.Exec("insert ignore into t values (14, 'n', 14), (15, 'o', 'abc')")
+		require.Error(t, err)
+		require.Equal(t, []string{"0"}, queryStrings(t, db, "select count(*) from t where a >= 12"))
+	})
+
+	t.Run("compound", func(t *testing.T) {
+		execAll(t, db,
+			"create table t2 (a int, b varchar(10), c int, primary key (a, b))",
+			"insert into t2 values (1, 'x', 1)",
+		)
+		requireAffected(t, db, "insert ignore into t2 values (1, 'x', 2), (1, 'y', 3), (2, 'x', 4), (1, 'y', 5)", 2)
+		require.Equal(t, []string{
+			"Warning|1062|Duplicate entry '1-x' for key 'PRIMARY'",
+			"Warning|1062|Duplicate entry '1-y' for key 'PRIMARY'",
+		}, queryRows(t, db, "show warnings"))
+		require.Equal(t, []string{"1|x|1", "1|y|3", "2|x|4"}, queryRows(t, db, "select * from t2 order by a, b"))
+
+		_, err := db.Exec("insert ignore into t2 select * from t2")
+		require.Error(t, err)
+	})
+}
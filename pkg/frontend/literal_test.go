@@ -0,0 +1,66 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryLiteral(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database literal_db",
+		"use literal_db",
+		"create table t (a int default 0x10, b varchar(10) default x'4142', c bigint unsigned default b'11')",
+		"insert into t values (0x11, 0x4344, 0xffffffffffffffff), (b'1', b'0100010101000110', 0b10)",
+		"insert into t (a) values (-0x1)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, []string{"-1", "1", "17"}, queryStrings(t, db, "select a from t order by a"))
+	require.Equal(t, []string{"AB", "EF", "CD"}, queryStrings(t, db, "select b from t order by a"))
+	require.Equal(t, []string{"3", "2", "18446744073709551615"}, queryStrings(t, db, "select c from t order by a"))
+
+	// a string in a string context, a number in a numeric context
+	require.Equal(t, []string{"A"}, queryStrings(t, db, "select 0x41"))
+	require.Equal(t, []string{"A"}, queryStrings(t, db, "select b'1000001'"))
+	require.Equal(t, []string{"66"}, queryStrings(t, db, "select 0x41 + 1"))
+	require.Equal(t, []string{"65"}, queryStrings(t, db, "select cast(x'41' as unsigned)"))
+	require.Equal(t, []string{"18446744073709551615"}, queryStrings(t, db, "select cast(0xffffffffffffffff as unsigned)"))
+	require.Equal(t, []string{"true"}, queryStrings(t, db, "select 0x41 = 'A'"))
+	require.Equal(t, []string{"17"}, queryStrings(t, db, "select a from t where a = 0x11"))
+	require.Equal(t, []string{"CD"}, queryStrings(t, db, "select b from t where b = 0x4344"))
+	require.Equal(t, []string{"1"}, queryStrings(t, db, "select a from t where c < 0xffffffffffffffff and b > x'4345'"))
+
+	_, err := db.Exec("update t set a = 0x20, b = 0x5859 where a = b'1'")
+	require.NoError(t, err)
+	require.Equal(t, []string{"XY"}, queryStrings(t, db, "select b from t where a = 32"))
+
+	// more than 64 bits in a numeric context
+	for _, stmt := range []string{
+		"select 0x010000000000000000 + 1",
+		"select a from t where a = x'010000000000000000'",
+		"insert into t (a) values (0x010000000000000000)",
+		"update t set c = 0x010000000000000000",
+	} {
+		_, err := db.Exec(stmt)
+		require.Error(t, err, stmt)
+	}
+	require.Equal(t, []string{"\x01\x00\x00\x00\x00\x00\x00\x00\x00"}, queryStrings(t, db, "select 0x010000000000000000"))
+}
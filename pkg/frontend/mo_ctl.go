@@ -0,0 +1,99 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+// The admin commands of mo_ctl(cmd, arg), which only root may run
+const (
+	// moCtlCompact runs the compactions of the table [db.]table, or of its
+	// block [db.]table.block, and waits for them
+	moCtlCompact = "compact"
+	// moCtlClearPoison clears the poisoned compactions of the table
+	// [db.]table, or of all the tables if the arg is empty
+	moCtlClearPoison = "clear_poison"
+)
+
+// moCtl runs an admin command of mo_ctl on the storage, the tables without a
+// database are of the current database
+func moCtl(eng engine.Engine, database, cmd, arg string) (string, error) {
+	txnEngine, ok := txnEngineOf(eng)
+	if !ok {
+		return "", errorIsNotTaeEngine
+	}
+	admin, ok := txnEngine.(moengine.AdminEngine)
+	if !ok {
+		return "", errorIsNotTaeEngine
+	}
+	switch strings.ToLower(cmd) {
+	case moCtlCompact:
+		dbName, tableName, blockID, err := parseMoCtlTarget(database, arg, true)
+		if err != nil {
+			return "", err
+		}
+		n, err := admin.Compact(dbName, tableName, blockID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d compactions", n), nil
+	case moCtlClearPoison:
+		var dbName, tableName string
+		if arg != "" {
+			var err error
+			if dbName, tableName, _, err = parseMoCtlTarget(database, arg, false); err != nil {
+				return "", err
+			}
+		}
+		n, err := admin.ClearPoison(dbName, tableName)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d poisoned compactions cleared", n), nil
+	}
+	return "", fmt.Errorf("mo_ctl: unknown command '%s'", cmd)
+}
+
+// parseMoCtlTarget parses the target [db.]table[.block] of mo_ctl
+func parseMoCtlTarget(database, arg string, withBlock bool) (dbName, tableName string, blockID uint64, err error) {
+	parts := strings.Split(arg, ".")
+	if withBlock && len(parts) == 3 {
+		if blockID, err = strconv.ParseUint(parts[2], 10, 64); err != nil || blockID == 0 {
+			return "", "", 0, fmt.Errorf("mo_ctl: bad block id '%s'", parts[2])
+		}
+		parts = parts[:2]
+	}
+	switch len(parts) {
+	case 1:
+		dbName, tableName = database, parts[0]
+	case 2:
+		dbName, tableName = parts[0], parts[1]
+	default:
+		return "", "", 0, fmt.Errorf("mo_ctl: bad table '%s'", arg)
+	}
+	if dbName == "" {
+		return "", "", 0, NewMysqlError(ER_NO_DB_ERROR)
+	}
+	if tableName == "" {
+		return "", "", 0, fmt.Errorf("mo_ctl: bad table '%s'", arg)
+	}
+	return
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoCtl(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database ctl_db",
+		"use ctl_db",
+		"create table t (a int primary key, b varchar(10))",
+		"insert into t values (1, 'a'), (2, 'b'), (3, 'c')",
+	)
+
+	t.Run("compact", func(t *testing.T) {
+		require.Equal(t, []string{"1 compactions"}, queryStrings(t, db, "select mo_ctl('compact', 'ctl_db.t')"))
+		// nothing changed since the compaction
+		require.Equal(t, []string{"0 compactions"}, queryStrings(t, db, "select mo_ctl('COMPACT', 't')"))
+		require.Equal(t, []string{"1|a", "2|b", "3|c"}, queryRows(t, db, "select a, b from t order by a"))
+		execAll(t, db, "delete from t where a = 2")
+		require.Equal(t, []string{"1 compactions"}, queryStrings(t, db, "select mo_ctl('compact', 't')"))
+		require.Equal(t, []string{"1|a", "3|c"}, queryRows(t, db, "select a, b from t order by a"))
+	})
+
+	t.Run("clear poison", func(t *testing.T) {
+		require.Equal(t, []string{"0 poisoned compactions cleared"}, queryStrings(t, db, "select mo_ctl('clear_poison', 'ctl_db.t')"))
+		require.Equal(t, []string{"0 poisoned compactions cleared"}, queryStrings(t, db, "select mo_ctl('clear_poison', '')"))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, stmt := range []string{
+			"select mo_ctl('vacuum', 't')",
+			"select mo_ctl('compact', 'missing')",
+			"select mo_ctl('compact', 'a.b.c.d')",
+			"select mo_ctl('compact', 't.x')",
+			"select mo_ctl('compact', 't.999999')",
+			"select mo_ctl(b, 't') from t",
+		} {
+			_, err := db.Exec(stmt)
+			require.Error(t, err, stmt)
+		}
+
+		execAll(t, db, "create user u1 identified by 'pwd'")
+		_, err := openAccountDB(t, port, "u1", "pwd").Exec("select mo_ctl('compact', 'ctl_db.t')")
+		require.Error(t, err)
+	})
+}
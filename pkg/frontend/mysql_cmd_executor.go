@@ -15,9 +15,12 @@
 package frontend
 
 import (
+	"context"
 	goErrors "errors"
 	"fmt"
+	"go/constant"
 	"os"
+	"runtime"
 	"runtime/pprof"
 	"sort"
 	"strconv"
@@ -480,30 +483,57 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs[rowIndex].String2(precision)
 					}
 				}
+			case types.T_bit:
+				// a bit is sent as the binary string of its bytes
+				width := vec.Typ.Width
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]uint64)
+					row[i] = types.BitBytes(vs[rowIndex], width)
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]uint64)
+						row[i] = types.BitBytes(vs[rowIndex], width)
+					}
+				}
+			case types.T_time:
+				precision := vec.Typ.Precision
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.Time)
+					row[i] = vs[rowIndex].String2(precision)
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.Time)
+						row[i] = vs[rowIndex].String2(precision)
+					}
+				}
 			case types.T_decimal64:
 				scale := vec.Typ.Scale
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Decimal64)
-					row[i] = vs[rowIndex].Decimal64ToString(scale)
+					row[i] = types.AppendDecimal64(nil, vs[rowIndex], scale)
 				} else {
 					if nulls.Contains(vec.Nsp, uint64(rowIndex)) {
 						row[i] = nil
 					} else {
 						vs := vec.Col.([]types.Decimal64)
-						row[i] = vs[rowIndex].Decimal64ToString(scale)
+						row[i] = types.AppendDecimal64(nil, vs[rowIndex], scale)
 					}
 				}
 			case types.T_decimal128:
 				scale := vec.Typ.Scale
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Decimal128)
-					row[i] = vs[rowIndex].Decimal128ToString(scale)
+					row[i] = types.AppendDecimal128(nil, vs[rowIndex], scale)
 				} else {
 					if nulls.Contains(vec.Nsp, uint64(rowIndex)) {
 						row[i] = nil
 					} else {
 						vs := vec.Col.([]types.Decimal128)
-						row[i] = vs[rowIndex].Decimal128ToString(scale)
+						row[i] = types.AppendDecimal128(nil, vs[rowIndex], scale)
 					}
 				}
 			default:
@@ -565,7 +595,7 @@ func (mce *MysqlCmdExecutor) handleChangeDB(db string) error {
 	txnHandler := ses.GetTxnHandler()
 	txnCtx := txnHandler.GetTxn().GetCtx()
 	//TODO: check meta data
-	if _, err := ses.Pu.StorageEngine.Database(db, txnCtx); err != nil {
+	if _, err := ses.GetStorage().Database(db, txnCtx); err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, db)
 	}
@@ -577,6 +607,29 @@ func (mce *MysqlCmdExecutor) handleChangeDB(db string) error {
 	return nil
 }
 
+// isSelectWithoutTable returns true if the statement is a single SELECT
+// without any table in the FROM clause, like SELECT database()
+func isSelectWithoutTable(stmt *tree.Select) bool {
+	sc, ok := stmt.Select.(*tree.SelectClause)
+	if !ok {
+		return false
+	}
+	if sc.From == nil {
+		return true
+	}
+	for _, table := range sc.From.Tables {
+		ate, ok := table.(*tree.AliasedTableExpr)
+		if !ok {
+			return false
+		}
+		tn, ok := ate.Expr.(*tree.TableName)
+		if !ok || strings.ToLower(string(tn.ObjectName)) != "dual" {
+			return false
+		}
+	}
+	return true
+}
+
 //handle SELECT DATABASE()
 func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 	var err error = nil
@@ -636,7 +689,8 @@ func (mce *MysqlCmdExecutor) handleSelectVariables(ve *tree.VarExpr) error {
 		if err != nil {
 			return err
 		}
-		row[0] = val
+		col.SetName("@" + ve.Name)
+		row[0] = setUserVariableColumn(col, val)
 	}
 
 	ses.Mrs.AddRow(row)
@@ -699,12 +753,15 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		//then, it uses the database name in the session
 		loadDb = ses.protocol.GetDatabaseName()
 	}
+	if err = ses.checkPrivilege(loadDb, loadTable, privilegeWrite); err != nil {
+		return err
+	}
 
 	txnHandler := ses.GetTxnHandler()
 	if txnHandler.isTxnState(TxnBegan) {
 		return fmt.Errorf("Do not support the Load in a transaction started by BEGIN/START TRANSACTION statement")
 	}
-	dbHandler, err := ses.Pu.StorageEngine.Database(loadDb, txnHandler.GetTxn().GetCtx())
+	dbHandler, err := ses.GetStorage().Database(loadDb, txnHandler.GetTxn().GetCtx())
 	if err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, loadDb)
@@ -822,17 +879,117 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar, proc *process.Process, usePlan2 bool) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
 
-	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	autocommit := ses.isAutocommit()
+	if sv != nil {
+		for _, assign := range sv.Assignments {
+			if assign.System {
+				err = setSystemVariable(ses, assign)
+			} else if usePlan2 {
+				err = mce.setUserVariable(assign, proc)
+			} else {
+				var value interface{}
+				if value, err = getConstantValue(assign.Value); err == nil {
+					err = ses.SetUserDefinedVar(assign.Name, value)
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	// enabling autocommit commits the active txn
+	if !autocommit && ses.isAutocommit() && ses.GetTxnHandler().isTxnState(TxnBegan) {
+		if err = ses.GetTxnHandler().CommitAfterBegin(); err != nil {
+			return err
+		}
+		// the rest of the statement runs in an autocommit txn
+		if err = ses.GetTxnHandler().StartByAutocommit(); err != nil {
+			return err
+		}
+	}
+
+	resp := NewOkResponse(0, 0, proc.Warnings(), 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
 	return nil
 }
 
+// setSystemVariable assigns the system variable in the assignment.
+// The assignments of the unknown variables are ignored.
+func setSystemVariable(ses *Session, assign *tree.VarAssignmentExpr) error {
+	name := strings.ToLower(assign.Name)
+	def, ok := gSysVarsDefs[name]
+	if !ok {
+		return nil
+	}
+	var value interface{}
+	if _, ok := assign.Value.(*tree.DefaultVal); ok {
+		value = def.Default
+		if !assign.Global {
+			// the session value is reset to the global one
+			if gVal, err := ses.GetGlobalVar(name); err == nil {
+				value = gVal
+			}
+		}
+	} else {
+		var err error
+		if value, err = getConstantValue(assign.Value); err != nil {
+			return err
+		}
+	}
+	if assign.Global {
+		return ses.SetGlobalVar(name, value)
+	}
+	return ses.SetSessionVar(name, value)
+}
+
+// getConstantValue returns the value of a constant expression in SET.
+func getConstantValue(expr tree.Expr) (interface{}, error) {
+	switch e := expr.(type) {
+	case *tree.NumVal:
+		switch e.Value.Kind() {
+		case constant.Int:
+			v, _ := constant.Int64Val(e.Value)
+			if e.Negative() {
+				v = -v
+			}
+			return v, nil
+		case constant.Float:
+			v, _ := constant.Float64Val(e.Value)
+			if e.Negative() {
+				v = -v
+			}
+			return v, nil
+		case constant.String:
+			return constant.StringVal(e.Value), nil
+		case constant.Bool:
+			return constant.BoolVal(e.Value), nil
+		}
+	case *tree.UnaryExpr:
+		if e.Op == tree.UNARY_MINUS {
+			v, err := getConstantValue(e.Expr)
+			if err != nil {
+				return nil, err
+			}
+			switch v := v.(type) {
+			case int64:
+				return -v, nil
+			case float64:
+				return -v, nil
+			}
+		}
+	case *tree.ParenExpr:
+		return getConstantValue(e.Expr)
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported value '%s' in set statement", tree.String(expr, dialect.MYSQL)))
+}
+
 /*
 handle show variables
 */
@@ -902,6 +1059,38 @@ func (mce *MysqlCmdExecutor) handleShowVariables(sv *tree.ShowVariables) error {
 	return err
 }
 
+// handleShowWarnings lists the warnings of the last statement
+func (mce *MysqlCmdExecutor) handleShowWarnings() error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("Level")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_LONG)
+	col2.SetName("Code")
+
+	col3 := new(MysqlColumn)
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col3.SetName("Message")
+
+	ses.Mrs.AddColumn(col1)
+	ses.Mrs.AddColumn(col2)
+	ses.Mrs.AddColumn(col3)
+	for _, w := range ses.warnings {
+		ses.Mrs.AddRow([]interface{}{w.Level, int64(w.Code), w.Message})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
 	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
 	// IMO, this approach is simple and future-proof
@@ -1176,7 +1365,7 @@ func (mce *MysqlCmdExecutor) handleShowTables(_ *tree.ShowTables) error {
 }
 
 /*
-handle show columns from table
+handle show [full] columns from table and describe table
 */
 func (mce *MysqlCmdExecutor) handleShowColumns(sc *tree.ShowColumns) error {
 	var err error = nil
@@ -1200,16 +1389,17 @@ func (mce *MysqlCmdExecutor) handleShowColumns(sc *tree.ShowColumns) error {
 		return errorDatabaseIsNull
 	}
 
-	const (
-		FIELD_POSITION = iota
-		TYPE_POSITION
-		NULL_POSITION
-		KEY_POSITION
-		DEFAULT_POSITIION
-		COMMENT_POSITIION
-	)
+	if sc.Where != nil {
+		return errors.New(errno.FeatureNotSupported, "not support where clause in show columns statement now")
+	}
+
 	outputColumnNames := []string{
-		"Field", "Type", "Null", "Key", "Default", "Comment",
+		"Field", "Type", "Null", "Key", "Default", "Extra",
+	}
+	if sc.Full {
+		outputColumnNames = []string{
+			"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment",
+		}
 	}
 
 	for _, name := range outputColumnNames {
@@ -1237,47 +1427,77 @@ func (mce *MysqlCmdExecutor) handleShowColumns(sc *tree.ShowColumns) error {
 	//get attributes
 	defs := table.TableDefs(txnHandler.GetTxn().GetCtx())
 
-	var pkDefs []*engine.PrimaryIndexDef
+	var hideKey string
+	if attr := table.GetHideKey(txnHandler.GetTxn().GetCtx()); attr != nil {
+		hideKey = attr.Name
+	}
 
-	nameToRowID := make(map[string]int)
-	nameRowID := 0
+	// the columns of the primary key, compound or not
+	pkNames := make(map[string]bool)
+	var attrs []*engine.Attribute
 	for _, def := range defs {
-		data := make([]interface{}, len(outputColumnNames))
-
-		if attr, ok := def.(*engine.AttributeDef); ok {
-			nameToRowID[attr.Attr.Name] = nameRowID
-			nameRowID++
-			data[FIELD_POSITION] = attr.Attr.Name
-			typeStr := attr.Attr.Type.String()
-			if attr.Attr.Type.Oid == types.T_varchar {
-				typeStr += fmt.Sprintf("(%d)", attr.Attr.Type.Width)
+		switch d := def.(type) {
+		case *engine.AttributeDef:
+			if len(hideKey) != 0 && d.Attr.Name == hideKey {
+				continue
 			}
-			data[TYPE_POSITION] = typeStr
-			if attr.Attr.Primary {
-				data[NULL_POSITION] = "NO"
-				data[KEY_POSITION] = "PRI"
-			} else {
-				data[NULL_POSITION] = "YES"
-				data[KEY_POSITION] = ""
+			attrs = append(attrs, &d.Attr)
+			if d.Attr.Primary {
+				pkNames[d.Attr.Name] = true
+			}
+		case *engine.PrimaryIndexDef:
+			for _, name := range d.Names {
+				pkNames[name] = true
 			}
-			data[DEFAULT_POSITIION] = ""
-			data[COMMENT_POSITIION] = ""
-
-		} else if attr2, ok2 := def.(*engine.PrimaryIndexDef); ok2 {
-			pkDefs = append(pkDefs, attr2)
 		}
+	}
 
-		ses.Mrs.AddRow(data)
+	var likePattern string
+	if sc.Like != nil {
+		likePattern = strings.ToLower(sc.Like.Right.String())
 	}
+	for _, attr := range attrs {
+		if sc.ColName != nil && !strings.EqualFold(sc.ColName.Parts[0], attr.Name) {
+			continue
+		}
+		if sc.Like != nil && !WildcardMatch(likePattern, strings.ToLower(attr.Name)) {
+			continue
+		}
 
-	if len(pkDefs) != 0 && ses.Mrs.GetRowCount() != 0 {
-		for _, def := range pkDefs {
-			for _, name := range def.Names {
-				rowId := nameToRowID[name]
-				ses.Mrs.Data[rowId][NULL_POSITION] = "NO"
-				ses.Mrs.Data[rowId][KEY_POSITION] = "PRI"
+		null, key, extra := "YES", "", ""
+		if pkNames[attr.Name] {
+			null, key = "NO", "PRI"
+		} else if attr.NotNull {
+			null = "NO"
+		}
+		if attr.AutoIncrement {
+			extra = "auto_increment"
+		}
+		var dflt interface{}
+		if attr.Default.Exist && !attr.Default.IsNull {
+			switch v := attr.Default.Value.(type) {
+			case types.Decimal64:
+				dflt = types.FormatDecimal64(v, attr.Type.Scale)
+			case types.Decimal128:
+				dflt = types.FormatDecimal128(v, attr.Type.Scale)
+			default:
+				dflt = fmt.Sprintf("%v", v)
 			}
 		}
+
+		if sc.Full {
+			var collation interface{}
+			if attr.Type.HasCollation() {
+				collation = types.CollationName(attr.Type.Collation())
+			}
+			ses.Mrs.AddRow([]interface{}{
+				attr.Name, attr.Type.SQLString(), collation, null, key, dflt, extra, "", attr.Comment,
+			})
+		} else {
+			ses.Mrs.AddRow([]interface{}{
+				attr.Name, attr.Type.SQLString(), null, key, dflt, extra,
+			})
+		}
 	}
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
@@ -1314,14 +1534,14 @@ func (mce *MysqlCmdExecutor) handleShowCreateDatabase(scd *tree.ShowCreateDataba
 	//get database
 	storage := ses.GetStorage()
 	txnHandler := ses.GetTxnHandler()
-	_, err = storage.Database(dbName, txnHandler.GetTxn().GetCtx())
+	db, err := storage.Database(dbName, txnHandler.GetTxn().GetCtx())
 	if err != nil {
 		return err
 	}
 
 	row := make([]interface{}, len(outputColumnNames))
 	row[0] = dbName
-	row[1] = fmt.Sprintf("CREATE DATABASE `%s`", dbName)
+	row[1] = showCreateDatabase(dbName, db)
 	ses.Mrs.AddRow(row)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
@@ -1392,13 +1612,42 @@ func (mce *MysqlCmdExecutor) handleShowCreateTable(sct *tree.ShowCreateTable) er
 	//get attributes
 	defs := table.TableDefs(txnHandler.GetTxn().GetCtx())
 
+	row := make([]interface{}, len(outputColumnNames))
+	row[0] = tableName
+	row[1] = showCreateTable(tableName, defs)
+
+	ses.Mrs.AddRow(row)
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return err
+}
+
+// showCreateDatabase returns the CREATE DATABASE statement of the database
+func showCreateDatabase(dbName string, db engine.Database) string {
+	createStr := fmt.Sprintf("CREATE DATABASE `%s`", dbName)
+	if ddb, ok := db.(engine.DefaultsDatabase); ok {
+		if defaults := ddb.Defaults(); defaults.Charset != "" {
+			createStr += fmt.Sprintf(" DEFAULT CHARACTER SET %s COLLATE %s", defaults.Charset, defaults.Collation)
+		}
+	}
+	return createStr
+}
+
+// showCreateTable returns the CREATE TABLE statement of the table
+func showCreateTable(tableName string, defs []engine.TableDef) string {
 	var pkDefs []*engine.PrimaryIndexDef
+	var comment string
 	createStr := fmt.Sprintf("CREATE TABLE `%s` (", tableName)
 	rowCount := 0
 	for _, def := range defs {
 		if attr, ok := def.(*engine.AttributeDef); ok {
 			nullOrNot := ""
-			if attr.Attr.Primary {
+			if attr.Attr.Primary || attr.Attr.NotNull {
 				nullOrNot = "NOT NULL"
 			} else {
 				nullOrNot = "NULL"
@@ -1412,17 +1661,39 @@ func (mce *MysqlCmdExecutor) handleShowCreateTable(sct *tree.ShowCreateTable) er
 			if attr.Attr.Type.Oid == types.T_varchar {
 				typeStr += fmt.Sprintf("(%d)", attr.Attr.Type.Width)
 			}
+			// the collation of a string column is resolved when the table is
+			// created, so it is shown even if it is the default
+			if attr.Attr.Type.HasCollation() {
+				typeStr += " COLLATE " + types.CollationName(attr.Attr.Type.Collation())
+			}
+			if attr.Attr.Generated != "" {
+				typeStr += fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", attr.Attr.Generated)
+			}
 			createStr += fmt.Sprintf("`%s` %s %s", attr.Attr.Name, typeStr, nullOrNot)
+			if attr.Attr.DefaultExpression != "" {
+				createStr += " DEFAULT " + attr.Attr.DefaultExpression
+			}
+			if attr.Attr.OnUpdate != "" {
+				createStr += " ON UPDATE " + attr.Attr.OnUpdate
+			}
+			if attr.Attr.Comment != "" {
+				createStr += " COMMENT " + quoteString(attr.Attr.Comment)
+			}
 			rowCount++
 		} else if attr2, ok2 := def.(*engine.PrimaryIndexDef); ok2 {
 			pkDefs = append(pkDefs, attr2)
+		} else if commentDef, ok := def.(*engine.CommentDef); ok {
+			comment = commentDef.Comment
 		}
 	}
 
 	if len(pkDefs) != 0 {
 		for _, def := range pkDefs {
 			pkStr := "PRIMARY KEY ("
-			for _, name := range def.Names {
+			for i, name := range def.Names {
+				if i > 0 {
+					pkStr += ", "
+				}
 				pkStr += fmt.Sprintf("`%s`", name)
 			}
 			pkStr += ")"
@@ -1437,20 +1708,38 @@ func (mce *MysqlCmdExecutor) handleShowCreateTable(sct *tree.ShowCreateTable) er
 		createStr += "\n"
 	}
 	createStr += ")"
+	if comment != "" {
+		createStr += " COMMENT=" + quoteString(comment)
+	}
 
-	row := make([]interface{}, len(outputColumnNames))
-	row[0] = tableName
-	row[1] = createStr
-
-	ses.Mrs.AddRow(row)
-
-	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	return createStr
+}
 
-	if err := proto.SendResponse(resp); err != nil {
-		return fmt.Errorf("routine send response failed. error:%v ", err)
+// quoteString returns s as a string literal in single quotes, escaped as
+// mysql does in SHOW CREATE TABLE, so it is parsed back into s
+func quoteString(s string) string {
+	var buf strings.Builder
+	buf.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case 0:
+			buf.WriteString("\\0")
+		case '\n':
+			buf.WriteString("\\n")
+		case '\r':
+			buf.WriteString("\\r")
+		case '\032':
+			buf.WriteString("\\Z")
+		case '\\':
+			buf.WriteString("\\\\")
+		case '\'':
+			buf.WriteString("''")
+		default:
+			buf.WriteByte(c)
+		}
 	}
-	return err
+	buf.WriteByte('\'')
+	return buf.String()
 }
 
 //----------------------------------------------------------------------------------------------------
@@ -1549,12 +1838,19 @@ func (cwft *TxnComputationWrapper) GetAffectedRows() uint64 {
 
 func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error) {
 	var err error
-	cwft.plan, err = plan2.BuildPlan(cwft.ses.GetTxnCompilerContext(), cwft.stmt)
+	cwft.plan, err = plan2.BuildPlanCached(cwft.ses.GetTxnCompilerContext(), cwft.stmt, cwft.ses.GetPlanCache())
 	if err != nil {
 		return nil, err
 	}
+	if changesSchema(cwft.plan) {
+		cwft.ses.GetTxnHandler().setSchemaChanged()
+	}
+	if err = cwft.ses.checkPrivileges(planPrivileges(cwft.plan, cwft.ses.GetDatabaseName())); err != nil {
+		return nil, err
+	}
 
 	cwft.proc.UnixTime = time.Now().UnixNano()
+	cwft.proc.SessionInfo = cwft.ses.GetSessionInfo()
 	txnHandler := cwft.ses.GetTxnHandler()
 	cwft.proc.Snapshot = txnHandler.GetTxn().GetCtx()
 	cwft.compile = compile2.New(cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), cwft.ses.GetStorage(), cwft.proc)
@@ -1669,6 +1965,16 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Lim.Parallelism = runtime.NumCPU()
+	// the spill files are removed however the query ends
+	defer func() {
+		if err := proc.Spill().Clean(); err != nil {
+			logutil.Errorf("clean the spill files of query %s failed: %v", proc.Id, err)
+		}
+	}()
+	defer func() {
+		ses.info.endStatement(proto.GetDatabaseName())
+	}()
 
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
@@ -1691,6 +1997,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	var selfHandle = false
 	var fromLoadData = false
 	var txnErr error
+	var cancel context.CancelFunc = func() {}
 
 	stmt := cws[0].GetAst()
 	mce.beforeRun(stmt)
@@ -1700,6 +2007,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+		proc.ResetWarnings()
+		proc.ResetProgress()
+		ses.info.startStatement(proto.GetUserName(), proto.GetDatabaseName(), sql, proc.Progress())
 		//temp try 0 epoch
 		pdHook.IncQueryCountAtEpoch(epoch, 1)
 		statementCount++
@@ -1707,25 +2017,51 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		//check transaction states
 		switch stmt.(type) {
 		case *tree.BeginTransaction:
+			// BEGIN in a txn commits it implicitly
+			if txnHandler.isTxnState(TxnBegan) {
+				if err = txnHandler.CommitAfterBegin(); err != nil {
+					goto handleFailed
+				}
+			}
 			err = txnHandler.StartByBegin()
 			if err != nil {
 				goto handleFailed
 			}
 		case *tree.CommitTransaction:
-			err = txnHandler.CommitAfterBegin()
-			if err != nil {
-				goto handleFailed
+			// COMMIT out of a txn does nothing
+			if txnHandler.isTxnState(TxnBegan) {
+				err = txnHandler.CommitAfterBegin()
+				if err != nil {
+					goto handleFailed
+				}
 			}
 		case *tree.RollbackTransaction:
-			err = txnHandler.Rollback()
-			if err != nil {
-				goto handleFailed
+			if txnHandler.isTxnState(TxnBegan) {
+				err = txnHandler.Rollback()
+				if err != nil {
+					goto handleFailed
+				}
 			}
 		default:
-			_, err = txnHandler.StartByAutocommitIfNeeded()
+			if causesImplicitCommit(stmt) {
+				// the DDL commits the active txn and runs in a txn of its own
+				if txnHandler.isTxnState(TxnBegan) {
+					if err = txnHandler.CommitAfterBegin(); err != nil {
+						goto handleFailed
+					}
+				}
+				_, err = txnHandler.StartByAutocommitIfNeeded()
+			} else if ses.isAutocommit() || txnHandler.IsInTaeTxn() {
+				_, err = txnHandler.StartByAutocommitIfNeeded()
+			} else {
+				// without autocommit, the statement starts a txn that is
+				// ended by COMMIT or ROLLBACK
+				err = txnHandler.StartByBegin()
+			}
 			if err != nil {
 				goto handleFailed
 			}
+			txnHandler.StartStatement()
 			logutil.Infof("start autocommit txn in default")
 		}
 
@@ -1737,10 +2073,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				ses.closeRef = mce.exportDataClose
 			}
 			if sc, ok := st.Select.(*tree.SelectClause); ok {
-				if len(sc.Exprs) == 1 {
+				if len(sc.Exprs) == 1 && len(sc.Into) == 0 {
 					if fe, ok := sc.Exprs[0].Expr.(*tree.FuncExpr); ok {
 						if un, ok := fe.Func.FunctionReference.(*tree.UnresolvedName); ok {
-							if strings.ToUpper(un.Parts[0]) == "DATABASE" {
+							// plan2 evaluates database() as a builtin function
+							if !usePlan2 && strings.ToUpper(un.Parts[0]) == "DATABASE" {
 								err = mce.handleSelectDatabase(st)
 								if err != nil {
 									goto handleFailed
@@ -1775,9 +2112,15 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			//if none database has been selected, database operations must be failed.
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
-				*tree.ShowStatus, *tree.ShowVariables, *tree.DropDatabase, *tree.Load,
+				*tree.ShowStatus, *tree.ShowVariables, *tree.ShowProcessList, *tree.ShowProfile, *tree.DropDatabase, *tree.Load,
 				*tree.Use, *tree.SetVar,
+				*tree.CreateUser, *tree.DropUser, *tree.SetPassword, *tree.Grant, *tree.Revoke, *tree.ShowGrants,
 				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			case *tree.Select:
+				if !usePlan2 || !isSelectWithoutTable(t) {
+					err = NewMysqlError(ER_NO_DB_ERROR)
+					goto handleFailed
+				}
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					err = NewMysqlError(ER_NO_DB_ERROR)
@@ -1788,6 +2131,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 					err = NewMysqlError(ER_NO_DB_ERROR)
 					goto handleFailed
 				}
+			case *tree.ChecksumTable:
+				for _, tn := range t.Tables {
+					if tn.SchemaName == "" {
+						err = NewMysqlError(ER_NO_DB_ERROR)
+						goto handleFailed
+					}
+				}
 			default:
 				err = NewMysqlError(ER_NO_DB_ERROR)
 				goto handleFailed
@@ -1807,11 +2157,21 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.Select:
+			if sc, ok := st.Select.(*tree.SelectClause); ok && len(sc.Into) > 0 && usePlan2 {
+				selfHandle = true
+				if err = mce.handleSelectInto(st, sc.Into, proc); err != nil {
+					goto handleFailed
+				}
+				if err = proto.sendOKPacket(0, 0, 0, proc.Warnings(), ""); err != nil {
+					goto handleFailed
+				}
+			}
 		case *tree.Insert:
 			_, ok := st.Rows.Select.(*tree.ValuesClause)
 			if ok && usePlan2 {
 				selfHandle = true
-				err = mce.handleInsertValues(st, epoch)
+				err = mce.handleInsertValues(st, epoch, proc)
 				if err != nil {
 					goto handleFailed
 				}
@@ -1819,7 +2179,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		case *tree.DropDatabase:
 			// if the droped database is the same as the one in use, database must be reseted to empty.
 			if string(st.Name) == proto.GetDatabaseName() {
-				proto.SetUserName("")
+				proto.SetDatabaseName("")
 			}
 		case *tree.Load:
 			selfHandle = true
@@ -1828,9 +2188,18 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				goto handleFailed
 			}
 			fromLoadData = true
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			// the txn has been switched above, plan2 has nothing to run and
+			// there is no autocommit txn to commit.
+			if usePlan2 {
+				if err = proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+					goto handleFailed
+				}
+				goto handleNext
+			}
 		case *tree.SetVar:
 			selfHandle = true
-			err = mce.handleSetVar(st)
+			err = mce.handleSetVar(st, proc, usePlan2)
 			if err != nil {
 				goto handleFailed
 			}
@@ -1840,13 +2209,85 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.ShowWarnings:
+			selfHandle = true
+			if err = mce.handleShowWarnings(); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowGrants:
+			selfHandle = true
+			if err = mce.handleShowGrants(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowProcessList:
+			selfHandle = true
+			if err = mce.handleShowProcessList(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowProfile:
+			selfHandle = true
+			if err = mce.handleShowProfile(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateUser:
+			selfHandle = true
+			if err = mce.handleCreateUser(st); err != nil {
+				goto handleFailed
+			}
+			if err = proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropUser:
+			selfHandle = true
+			if err = mce.handleDropUser(st); err != nil {
+				goto handleFailed
+			}
+			if err = proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+				goto handleFailed
+			}
+		case *tree.SetPassword:
+			selfHandle = true
+			if err = mce.handleSetPassword(st); err != nil {
+				goto handleFailed
+			}
+			if err = proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+				goto handleFailed
+			}
+		case *tree.Grant:
+			selfHandle = true
+			if err = mce.handleGrant(st); err != nil {
+				goto handleFailed
+			}
+			if err = proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+				goto handleFailed
+			}
+		case *tree.Revoke:
+			selfHandle = true
+			if err = mce.handleRevoke(st); err != nil {
+				goto handleFailed
+			}
+			if err = proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+				goto handleFailed
+			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
 			if err = mce.handleAnalyzeStmt(st); err != nil {
 				goto handleFailed
 			}
+		case *tree.ChecksumTable:
+			selfHandle = true
+			if err = mce.handleChecksumTable(st); err != nil {
+				goto handleFailed
+			}
 		case *tree.ExplainStmt:
 			selfHandle = true
+			// DESCRIBE t is parsed as an explain of SHOW COLUMNS
+			if sc, ok := st.Statement.(*tree.ShowColumns); ok {
+				if err = mce.handleShowColumns(sc); err != nil {
+					goto handleFailed
+				}
+				break
+			}
 			if err = mce.handleExplainStmt(st); err != nil {
 				goto handleFailed
 			}
@@ -1869,21 +2310,19 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				}
 			}
 		case *tree.ShowColumns:
-			if usePlan2 && isAoe {
-				selfHandle = true
-				if err = mce.handleShowColumns(st); err != nil {
-					goto handleFailed
-				}
+			selfHandle = true
+			if err = mce.handleShowColumns(st); err != nil {
+				goto handleFailed
 			}
 		case *tree.ShowCreateDatabase:
-			if usePlan2 && isAoe {
+			if usePlan2 {
 				selfHandle = true
 				if err = mce.handleShowCreateDatabase(st); err != nil {
 					goto handleFailed
 				}
 			}
 		case *tree.ShowCreateTable:
-			if usePlan2 && isAoe {
+			if usePlan2 {
 				selfHandle = true
 				if err = mce.handleShowCreateTable(st); err != nil {
 					goto handleFailed
@@ -1906,6 +2345,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 
 		cmpBegin = time.Now()
 
+		// the deadline is set before the compilation, since the processes
+		// of the scopes inherit the context of proc.
+		proc.Ctx = nil
+		if timeout := getExecutionTimeout(ses, stmt); timeout > 0 {
+			proc.Ctx, cancel = context.WithTimeout(context.Background(), timeout)
+		}
+
 		if ret, err = cw.Compile(ses, getDataFromPipeline); err != nil {
 			goto handleFailed
 		}
@@ -1996,13 +2442,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
+			err = proto.sendEOFOrOkPacket(proc.Warnings(), 0)
 			if err != nil {
 				goto handleFailed
 			}
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -2039,7 +2485,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			resp := NewOkResponse(
 				cw.GetAffectedRows(),
 				0,
-				0,
+				unorderedLimitWarnings(stmt)+proc.Warnings(),
 				0,
 				int(COM_QUERY),
 				nil,
@@ -2053,6 +2499,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 		}
 	handleSucceeded:
+		cancel()
+		// SHOW WARNINGS keeps the warnings of the statement before it
+		if _, ok := stmt.(*tree.ShowWarnings); !ok {
+			ses.warnings = proc.WarningMessages()
+		}
 		if !fromLoadData {
 			txnErr = txnHandler.CommitAfterAutocommitOnly()
 			if txnErr != nil {
@@ -2061,6 +2512,17 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		}
 		goto handleNext
 	handleFailed:
+		cancel()
+		ses.warnings = nil
+		switch err {
+		case process.ErrQueryTimeout:
+			err = NewMysqlError(ER_QUERY_TIMEOUT)
+		case process.ErrQueryInterrupted:
+			err = NewMysqlError(ER_QUERY_INTERRUPTED)
+		}
+		if txnErr = txnHandler.RollbackStatement(); txnErr != nil {
+			return txnErr
+		}
 		txnErr = txnHandler.RollbackAfterAutocommitOnly()
 		if txnErr != nil {
 			return txnErr
@@ -2072,6 +2534,62 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	return nil
 }
 
+// causesImplicitCommit returns true if the statement commits the active txn
+// before it runs, like the DDL in MySQL
+func causesImplicitCommit(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
+		*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable, *tree.CreateView,
+		*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
+		*tree.CreateRole, *tree.DropRole,
+		*tree.Grant, *tree.Revoke, *tree.SetPassword:
+		return true
+	}
+	return false
+}
+
+// unorderedLimitWarnings returns the number of the warnings of an UPDATE or
+// a DELETE with LIMIT but without ORDER BY, which modifies the rows in no
+// particular order.
+func unorderedLimitWarnings(stmt tree.Statement) uint16 {
+	var orderBy tree.OrderBy
+	var limit *tree.Limit
+	switch st := stmt.(type) {
+	case *tree.Update:
+		orderBy, limit = st.OrderBy, st.Limit
+	case *tree.Delete:
+		orderBy, limit = st.OrderBy, st.Limit
+	default:
+		return 0
+	}
+	if limit == nil || len(orderBy) > 0 {
+		return 0
+	}
+	logutil.Warnf("the statement with LIMIT but without ORDER BY is nondeterministic: %s", tree.String(stmt, dialect.MYSQL))
+	return 1
+}
+
+// getExecutionTimeout returns the max execution time of the statement, 0 if
+// there is no limit. Like mysql, it only applies to the SELECT statements,
+// and the MAX_EXECUTION_TIME hint overrides the max_execution_time variable.
+func getExecutionTimeout(ses *Session, stmt tree.Statement) time.Duration {
+	st, ok := stmt.(*tree.Select)
+	if !ok {
+		return 0
+	}
+	if sc, ok := st.Select.(*tree.SelectClause); ok && sc.Hints != nil && sc.Hints.MaxExecutionTime > 0 {
+		return time.Duration(sc.Hints.MaxExecutionTime) * time.Millisecond
+	}
+	v, err := ses.GetSessionVar("max_execution_time")
+	if err != nil {
+		return 0
+	}
+	if ms, ok := v.(int64); ok && ms > 0 {
+		return time.Duration(ms) * time.Millisecond
+	}
+	return 0
+}
+
 func (mce *MysqlCmdExecutor) handleDDl(ses *Session, stmt tree.Statement, epoch uint64) error {
 	txnHandler := ses.GetTxnHandler()
 	switch ddl := stmt.(type) {
@@ -2151,7 +2669,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (resp *Response, err erro
 	case COM_QUERY:
 		var query = string(req.GetData().([]byte))
 		mce.addSqlCount(1)
-		logutil.Infof("query:%s", SubStringFromBegin(query, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		logutil.Infof("query:%s", SubStringFromBegin(hideCredentials(query), int(ses.Pu.SV.GetLengthOfQueryPrinted())))
 		seps := strings.Split(query, " ")
 		if len(seps) <= 0 {
 			resp = NewGeneralErrorResponse(COM_QUERY, fmt.Errorf("invalid query"))
@@ -2264,6 +2782,9 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 	case types.T_uint64:
 		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 		col.SetSigned(false)
+	case types.T_bit:
+		col.SetColumnType(defines.MYSQL_TYPE_BIT)
+		col.SetSigned(false)
 	case types.T_float32:
 		col.SetColumnType(defines.MYSQL_TYPE_FLOAT)
 	case types.T_float64:
@@ -2278,6 +2799,8 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
 	case types.T_timestamp:
 		col.SetColumnType(defines.MYSQL_TYPE_TIMESTAMP)
+	case types.T_time:
+		col.SetColumnType(defines.MYSQL_TYPE_TIME)
 	case types.T_decimal64:
 		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
 	case types.T_decimal128:
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/prashantv/gostub"
@@ -647,7 +648,7 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleCmdFieldList("A")
 		convey.So(err, convey.ShouldBeNil)
 
-		err = mce.handleSetVar(nil)
+		err = mce.handleSetVar(nil, process.New(mheap.New(guestMmu)), false)
 		convey.So(err, convey.ShouldBeNil)
 
 		req := &Request{
@@ -1001,3 +1002,81 @@ func Test_GetComputationWrapper(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func Test_sessionInfoFunctions(t *testing.T) {
+	convey.Convey("database()/user()/version()/connection_id()", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		txnImpl := mock_frontend.NewMockTxn(ctrl)
+		txnImpl.EXPECT().GetCtx().Return(nil).AnyTimes()
+		tae := mock_frontend.NewMockTxnEngine(ctrl)
+		tae.EXPECT().StartTxn(gomock.Any()).Return(txnImpl, nil).AnyTimes()
+		tae.EXPECT().Database(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", tae)
+		convey.So(err, convey.ShouldBeNil)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+
+		newSession := func(id uint32, user string) *Session {
+			proto := NewMysqlClientProtocol(id, ioses, 1024, pu.SV)
+			proto.SetUserName(user)
+			ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu, gSysVariables)
+			_, err := ses.GetTxnHandler().StartByAutocommitIfNeeded()
+			convey.So(err, convey.ShouldBeNil)
+			return ses
+		}
+
+		// run returns the first row of the result
+		run := func(ses *Session, sql string) []interface{} {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(isSelectWithoutTable(stmt.(*tree.Select)), convey.ShouldBeTrue)
+			proc := process.New(mheap.New(ses.GuestMmu))
+			cw := InitTxnComputationWrapper(ses, stmt, proc)
+			var row []interface{}
+			_, err = cw.Compile(ses, func(_ interface{}, bat *batch.Batch) error {
+				if bat == nil || len(bat.Zs) == 0 {
+					return nil
+				}
+				for _, vec := range bat.Vecs {
+					if nulls.Contains(vec.Nsp, 0) {
+						row = append(row, nil)
+						continue
+					}
+					switch col := vec.Col.(type) {
+					case *types.Bytes:
+						row = append(row, string(col.Get(0)))
+					case []uint64:
+						row = append(row, col[0])
+					case []float64:
+						row = append(row, col[0])
+					}
+				}
+				return nil
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cw.compile.Run(0), convey.ShouldBeNil)
+			return row
+		}
+
+		ses1 := newSession(1, "root")
+		ses2 := newSession(2, "dump")
+
+		row := run(ses1, "select database(), user(), version(), connection_id()")
+		convey.So(row, convey.ShouldResemble, []interface{}{nil, "root", serverVersion, uint64(1)})
+		row = run(ses2, "select database(), user(), connection_id()")
+		convey.So(row, convey.ShouldResemble, []interface{}{nil, "dump", uint64(2)})
+
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses1)
+		convey.So(mce.handleChangeDB("T"), convey.ShouldBeNil)
+		row = run(ses1, "select database(), concat_ws('.', database(), user()), connection_id() + 10")
+		convey.So(row, convey.ShouldResemble, []interface{}{"T", "T.root", float64(11)})
+		row = run(ses2, "select database()")
+		convey.So(row, convey.ShouldResemble, []interface{}{nil})
+	})
+}
@@ -16,7 +16,7 @@ package frontend
 
 import (
 	"bytes"
-	"crypto/sha1"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
 	"math/rand"
@@ -25,6 +25,7 @@ import (
 	"unicode"
 
 	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -279,6 +280,15 @@ type MysqlProtocolImpl struct {
 	rowHandler
 
 	SV *config.SystemVariables
+
+	//the tls config offered to the client, nil when tls is disabled
+	tlsConfig *tls.Config
+
+	//the connection has been upgraded to tls
+	isTLS bool
+
+	//the accounts for authentication, nil when the engine has no mo_user
+	accounts *accountStore
 }
 
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
@@ -557,78 +567,93 @@ func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
 	return pos + count
 }
 
-//the server calculates the hash value of the password with the algorithm
-//and judges it with the authentication data from the client.
-//Algorithm: SHA1( password ) XOR SHA1( slat + SHA1( SHA1( password ) ) )
-func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
-	if len(password) == 0 {
-		return false
+//authenticateUser checks the user, the host and the password of the client
+//with the accounts in mo_user
+func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
+	var hash2 []byte
+	var account *userAccount
+	var err error
+	if mp.accounts != nil {
+		if account, err = mp.accounts.getAccount(mp.username); err != nil {
+			return err
+		}
 	}
-	//hash1 = SHA1(password)
-	sha := sha1.New()
-	_, err := sha.Write(password)
-	if err != nil {
-		logutil.Errorf("SHA1(password) failed.")
-		return false
+
+	if account != nil {
+		host, _ := mp.Peer()
+		if !matchHostPattern(account.host, host) {
+			return fmt.Errorf("the user %s is not allowed to connect from %s", mp.username, host)
+		}
+		if hash2, err = decodeNativePassword(account.authString); err != nil {
+			return err
+		}
+	} else if len(mp.username) != 0 && mp.username == mp.SV.GetDumpuser() { //the user dump for test
+		hash2, _ = decodeNativePassword(encodeNativePassword(mp.SV.GetDumppassword()))
+	} else {
+		return fmt.Errorf("the user %s does not exist", mp.username)
 	}
-	hash1 := sha.Sum(nil)
 
-	//hash2 = SHA1(SHA1(password))
-	sha.Reset()
-	_, err = sha.Write(hash1)
-	if err != nil {
-		logutil.Errorf("SHA1(SHA1(password)) failed.")
-		return false
+	if !checkNativePassword(hash2, mp.salt, authResponse) {
+		return fmt.Errorf("check password failed")
 	}
-	hash2 := sha.Sum(nil)
+	return nil
+}
 
-	//hash3 = SHA1(salt + SHA1(SHA1(password)))
-	sha.Reset()
-	_, err = sha.Write(salt)
-	if err != nil {
-		logutil.Errorf("write salt failed.")
-		return false
+func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
+	mp.sequenceId = value
+}
+
+//serverCapability returns the capabilities the server announces
+func (mp *MysqlProtocolImpl) serverCapability() uint32 {
+	if mp.tlsConfig != nil {
+		return DefaultCapability | CLIENT_SSL
 	}
-	_, err = sha.Write(hash2)
-	if err != nil {
-		logutil.Errorf("write SHA1(SHA1(password)) failed.")
+	return DefaultCapability
+}
+
+//isSSLRequest checks whether the payload is a SSLRequest packet,
+//a truncated handshake response with CLIENT_SSL set
+func (mp *MysqlProtocolImpl) isSSLRequest(payload []byte) bool {
+	capabilities, _, ok := mp.io.ReadUint16(payload, 0)
+	if !ok || uint32(capabilities)&CLIENT_SSL == 0 {
 		return false
 	}
-	hash3 := sha.Sum(nil)
-
-	//SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
-	for i := range hash1 {
-		hash1[i] ^= hash3[i]
+	if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
+		//int<4> capabilities, int<4> max-packet size, int<1> character set, string[23] reserved
+		return len(payload) == 32
 	}
-
-	logutil.Infof("server calculated %v\n", hash1)
-	logutil.Infof("client calculated %v\n", auth)
-
-	return bytes.Equal(hash1, auth)
+	//int<2> capabilities, int<3> max-packet size
+	return len(payload) == 5
 }
 
-//the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
-	var psw []byte
-	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
-		psw = []byte(mp.SV.GetDumppassword())
+//upgradeToTLS switches the connection to tls after a SSLRequest.
+//The client sends the handshake response through tls later.
+func (mp *MysqlProtocolImpl) upgradeToTLS() error {
+	if mp.tlsConfig == nil {
+		return fmt.Errorf("the client asks for tls, but tls is not enabled on the server")
 	}
-
-	//TO Check password
-	if mp.checkPassword(psw, mp.salt, authResponse) {
-		logutil.Infof("check password succeeded\n")
-	} else {
-		return fmt.Errorf("check password failed\n")
+	raw, err := mp.tcpConn.RawConn()
+	if err != nil {
+		return err
 	}
+	conn, ok := raw.(*upgradableConn)
+	if !ok {
+		return fmt.Errorf("the connection can not be upgraded to tls")
+	}
+	//the client may send the tls handshake right behind the SSLRequest
+	var buffered []byte
+	if in := mp.tcpConn.InBuf(); in.Readable() > 0 {
+		if _, buffered, err = in.ReadAll(); err != nil {
+			return err
+		}
+	}
+	if err = conn.upgrade(mp.tlsConfig, buffered); err != nil {
+		return fmt.Errorf("tls handshake failed. error:%v", err)
+	}
+	mp.isTLS = true
 	return nil
 }
 
-func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
-	mp.sequenceId = value
-}
-
 func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	if len(payload) < 2 {
 		return fmt.Errorf("received a broken response packet")
@@ -646,7 +671,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp41.authResponse
-		mp.capability = DefaultCapability & resp41.capabilities
+		mp.capability = mp.serverCapability() & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
 			return fmt.Errorf("get collationName and charset failed")
@@ -668,7 +693,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp320.authResponse
-		mp.capability = DefaultCapability & resp320.capabilities
+		mp.capability = mp.serverCapability() & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
 		mp.charset = "utf8mb4"
@@ -678,9 +703,20 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		mp.database = resp320.database
 	}
 
+	if mp.SV != nil && mp.SV.GetRequireSecureTransport() && !mp.isTLS {
+		fail := errorMsgRefer[ER_SECURE_TRANSPORT_REQUIRED]
+		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], fail.errorMsgOrFormat)
+		return fmt.Errorf("the connection without tls is rejected")
+	}
+
 	if err := mp.authenticateUser(authResponse); err != nil {
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
-		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
+		host, _ := mp.Peer()
+		usingPassword := "NO"
+		if len(authResponse) != 0 {
+			usingPassword = "YES"
+		}
+		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], fmt.Sprintf(fail.errorMsgOrFormat, mp.username, host, usingPassword))
 		return err
 	}
 
@@ -712,7 +748,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint8(data, pos, 0)
 
 	//int<2>              capabilities flags (lower 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16(DefaultCapability&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16(mp.serverCapability()&0xFFFF))
 
 	//int<1>              character set
 	pos = mp.io.WriteUint8(data, pos, utf8mb4BinCollationID)
@@ -721,7 +757,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((mp.serverCapability()>>16)&0xFFFF))
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
@@ -1088,6 +1124,16 @@ func (mp *MysqlProtocolImpl) sendErrPacket(errorCode uint16, sqlState, errorMess
 	return mp.writePackets(errPkt)
 }
 
+//send the Err packet of err. The error code and the SQLSTATE come from the MysqlError,
+//or the moerr code of the error, e.g. 1062 for the duplicate keys and 1213 for the w-w conflicts.
+func (mp *MysqlProtocolImpl) sendErrPacketOf(err error) error {
+	if myerr, ok := err.(*MysqlError); ok {
+		return mp.sendErrPacket(myerr.ErrorCode, myerr.SqlState, myerr.Error())
+	}
+	errorCode, sqlState := moerr.MySQLError(err)
+	return mp.sendErrPacket(errorCode, sqlState, err.Error())
+}
+
 func (mp *MysqlProtocolImpl) makeEOFPayload(warnings, status uint16) []byte {
 	data := make([]byte, HeaderOffset+10)
 	pos := HeaderOffset
@@ -1308,7 +1354,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 					data = mp.appendStringLenEncOfInt64(data, value)
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_BIT:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -1333,7 +1379,11 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 				data = mp.appendStringLenEnc(data, value)
 			}
 		case defines.MYSQL_TYPE_TIME:
-			return nil, fmt.Errorf("unsupported MYSQL_TYPE_TIME")
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
 		default:
 			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
@@ -1381,7 +1431,7 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 
 		if err != nil {
 			//ERR_Packet in case of error
-			err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+			err1 := mp.sendErrPacketOf(err)
 			if err1 != nil {
 				return err1
 			}
@@ -1590,7 +1640,7 @@ func (mp *MysqlProtocolImpl) sendResultSetTextRow(mrs *MysqlResultSet, r uint64)
 	}
 	if _, err = mp.makeResultSetTextRow(nil, mrs, r); err != nil {
 		//ERR_Packet in case of error
-		err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+		err1 := mp.sendErrPacketOf(err)
 		if err1 != nil {
 			return err1
 		}
@@ -29,8 +29,15 @@ import (
 	"github.com/fagongzi/goetty/buf"
 	"github.com/golang/mock/gomock"
 	fuzz "github.com/google/gofuzz"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/operator"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/stretchr/testify/require"
 
 	"database/sql"
@@ -2001,6 +2008,7 @@ func Test_handleHandshake(t *testing.T) {
 		defer ctrl.Finish()
 		ioses := mock_frontend.NewMockIOSession(ctrl)
 		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddr().Return("127.0.0.1:6001").AnyTimes()
 
 		var IO IOPackageImpl
 		var SV *config.SystemVariables = &config.SystemVariables{}
@@ -2030,6 +2038,7 @@ func Test_handleHandshake_Recover(t *testing.T) {
 	defer ctrl.Finish()
 	ioses := mock_frontend.NewMockIOSession(ctrl)
 	ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddr().Return("127.0.0.1:6001").AnyTimes()
 
 	convey.Convey("handleHandshake succ", t, func() {
 		var IO IOPackageImpl
@@ -2052,3 +2061,52 @@ func Test_handleHandshake_Recover(t *testing.T) {
 		}
 	})
 }
+
+func Test_send_err_packet_codes(t *testing.T) {
+	convey.Convey("send err packet with the mysql error codes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		var packet []byte
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+			packet = append([]byte{}, msg.([]byte)...)
+			return nil
+		}).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		_, castErr := typecast.BytesToInt(&types.Bytes{Data: []byte("1000"), Offsets: []uint32{0}, Lengths: []uint32{4}}, make([]int8, 1), &nulls.Nulls{})
+		kases := []struct {
+			err      error
+			errno    uint16
+			sqlState string
+		}{
+			{operator.ErrDivByZero, 1365, "22012"},
+			{castErr, 1264, "22003"},
+			{data.ErrDuplicate, 1062, "23000"},
+			{txnif.TxnWWConflictErr, 1213, "40001"},
+			{txnbase.ErrDeadlock, 1213, "40001"},
+			{txnbase.ErrLockWaitTimeout, 1205, "HY000"},
+			{moerr.NewPanicError(data.ErrDuplicate), 1062, "23000"},
+			{moerr.NewPanicError("foo"), ER_UNKNOWN_ERROR, DefaultMySQLState},
+			{errors.New("foo"), ER_UNKNOWN_ERROR, DefaultMySQLState},
+			{NewMysqlError(ER_QUERY_TIMEOUT), ER_QUERY_TIMEOUT, errorMsgRefer[ER_QUERY_TIMEOUT].sqlStates[0]},
+		}
+		for _, kase := range kases {
+			err = proto.SendResponse(NewGeneralErrorResponse(COM_QUERY, kase.err))
+			convey.So(err, convey.ShouldBeNil)
+
+			payload := packet[PacketHeaderLength:]
+			convey.So(payload[0], convey.ShouldEqual, defines.ErrHeader)
+			convey.So(binary.LittleEndian.Uint16(payload[1:]), convey.ShouldEqual, kase.errno)
+			convey.So(string(payload[4:9]), convey.ShouldEqual, kase.sqlState)
+			convey.So(string(payload[9:]), convey.ShouldEqual, kase.err.Error())
+		}
+	})
+}
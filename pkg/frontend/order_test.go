@@ -0,0 +1,82 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeOrder(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database order_db",
+		"use order_db",
+		"create table t (a int, b int)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the rows are in 3 blocks of 40000 rows, which are sorted in parallel
+	const rows, batchRows = 90000, 30000
+	for i := 0; i < rows; i += batchRows {
+		values := make([]string, batchRows)
+		for j := range values {
+			values[j] = fmt.Sprintf("(%d, %d)", i+j, (i+j)*7919%100)
+		}
+		_, err := db.Exec("insert into t values " + strings.Join(values, ", "))
+		require.NoError(t, err)
+	}
+
+	expected := func(bDesc, aDesc bool) []string {
+		as := make([]int, rows)
+		for i := range as {
+			as[i] = i
+		}
+		sort.Slice(as, func(i, j int) bool {
+			bi, bj := as[i]*7919%100, as[j]*7919%100
+			if bi != bj {
+				return bi < bj != bDesc
+			}
+			return as[i] < as[j] != aDesc
+		})
+		res := make([]string, rows)
+		for i, a := range as {
+			res[i] = strconv.Itoa(a)
+		}
+		return res
+	}
+	for _, c := range []struct {
+		order        string
+		bDesc, aDesc bool
+	}{
+		{"b, a", false, false},
+		{"b desc, a", true, false},
+		{"b, a desc", false, true},
+		{"b desc, a desc", true, true},
+	} {
+		res := expected(c.bDesc, c.aDesc)
+		require.Equal(t, res, queryStrings(t, db, "select a from t order by "+c.order), c.order)
+		// the sorted rows of the subquery are not sorted again
+		require.Equal(t, res, queryStrings(t, db, "select a from (select a, b from t where a >= 0 order by "+c.order+") s order by "+c.order), c.order)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlanCache checks the cached plans are not used after the tables they
+// read are changed, and not shared by the tables of the same name in the
+// different databases
+func TestPlanCache(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	other := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database plan_cache_a",
+		"create database plan_cache_b",
+		"use plan_cache_a",
+		"create table t (a int)",
+		"insert into t values (1)",
+		"use plan_cache_b",
+		"create table t (a varchar(10), b int)",
+		"insert into t values ('x', 2)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the same statement of the two databases
+	for i := 0; i < 3; i++ {
+		_, err := db.Exec("use plan_cache_a")
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, queryStrings(t, db, "select a from t"))
+		_, err = db.Exec("use plan_cache_b")
+		require.NoError(t, err)
+		require.Equal(t, []string{"x"}, queryStrings(t, db, "select a from t"))
+	}
+
+	// the table is changed by this session
+	for _, stmt := range []string{
+		"drop table t",
+		"create table t (a int)",
+		"insert into t values (3)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, []string{"3"}, queryStrings(t, db, "select a from t"))
+
+	// and by another session
+	_, err := other.Exec("use plan_cache_a")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, queryStrings(t, other, "select a from t"))
+	for _, stmt := range []string{
+		"use plan_cache_a",
+		"drop table t",
+		"create table t (a varchar(10))",
+		"insert into t values ('y')",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, []string{"y"}, queryStrings(t, other, "select a from t"))
+
+	// a dropped database
+	_, err = db.Exec("drop database plan_cache_a")
+	require.NoError(t, err)
+	_, err = other.Query("select a from t")
+	require.Error(t, err)
+}
@@ -0,0 +1,965 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+const (
+	catalogDatabaseName     = "mo_catalog"
+	informationSchemaName   = "information_schema"
+	nativePasswordPlugin    = "mysql_native_password"
+	globalPrivilegeDatabase = "*"
+	rootUserName            = "root"
+)
+
+// privilegeType is a set of the privileges on a database
+type privilegeType uint8
+
+const (
+	privilegeRead privilegeType = 1 << iota
+	privilegeWrite
+
+	privilegeAll = privilegeRead | privilegeWrite
+)
+
+// privilegeNames are the values of the privilege column in mo_db_privilege
+var privilegeNames = []struct {
+	priv privilegeType
+	name string
+}{
+	{privilegeRead, "read"},
+	{privilegeWrite, "write"},
+}
+
+func parsePrivilegeName(name string) privilegeType {
+	for _, p := range privilegeNames {
+		if p.name == name {
+			return p.priv
+		}
+	}
+	return 0
+}
+
+// encodeNativePassword returns the authentication string of the password in
+// the mysql_native_password format, "*" followed by the upper case hex of
+// SHA1(SHA1(password)). The empty password has the empty authentication string.
+func encodeNativePassword(password string) string {
+	if len(password) == 0 {
+		return ""
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(hash2[:]))
+}
+
+// decodeNativePassword returns SHA1(SHA1(password)) kept in the authentication string
+func decodeNativePassword(authString string) ([]byte, error) {
+	if len(authString) == 0 {
+		return nil, nil
+	}
+	if len(authString) != 2*sha1.Size+1 || authString[0] != '*' {
+		return nil, NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	hash2, err := hex.DecodeString(authString[1:])
+	if err != nil {
+		return nil, NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	return hash2, nil
+}
+
+// checkNativePassword checks the auth response of the client. The client sends
+// SHA1(password) XOR SHA1(salt + SHA1(SHA1(password))), so the server gets
+// SHA1(password) back with the stored hash2 and compares its SHA1 with hash2.
+func checkNativePassword(hash2, salt, auth []byte) bool {
+	if len(hash2) == 0 {
+		return len(auth) == 0
+	}
+	if len(auth) != sha1.Size {
+		return false
+	}
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash2)
+	hash3 := sha.Sum(nil)
+	hash1 := make([]byte, sha1.Size)
+	for i := range hash1 {
+		hash1[i] = auth[i] ^ hash3[i]
+	}
+	check := sha1.Sum(hash1)
+	return subtle.ConstantTimeCompare(check[:], hash2) == 1
+}
+
+// matchHostPattern checks the host of the client matches the host part of
+// an account. '%' matches any string and '_' matches one character. The
+// pattern localhost matches the loopback addresses.
+func matchHostPattern(pattern, host string) bool {
+	if strings.EqualFold(pattern, "localhost") {
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			return true
+		}
+		return strings.EqualFold(host, "localhost")
+	}
+	return matchLikePattern(strings.ToLower(pattern), strings.ToLower(host))
+}
+
+func matchLikePattern(pattern, s string) bool {
+	if len(pattern) == 0 {
+		return len(s) == 0
+	}
+	switch pattern[0] {
+	case '%':
+		for i := 0; i <= len(s); i++ {
+			if matchLikePattern(pattern[1:], s[i:]) {
+				return true
+			}
+		}
+		return false
+	case '_':
+		return len(s) > 0 && matchLikePattern(pattern[1:], s[1:])
+	default:
+		return len(s) > 0 && pattern[0] == s[0] && matchLikePattern(pattern[1:], s[1:])
+	}
+}
+
+// credentialRegexp finds the passwords and the password hashes in the
+// account statements
+var credentialRegexp = regexp.MustCompile(`(?i)(identified\s+(?:with\s+\S+\s+)?(?:by|as)\s*|password(?:\s+for\s+\S+)?\s*=\s*(?:password\s*\(\s*)?)('(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*")`)
+
+// hideCredentials masks the passwords in the query before it is logged
+func hideCredentials(query string) string {
+	return credentialRegexp.ReplaceAllString(query, "$1'***'")
+}
+
+// userAccount is a row of mo_user
+type userAccount struct {
+	host       string
+	name       string
+	authString string
+}
+
+// accountStore keeps the accounts in mo_user and their privileges in
+// mo_db_privilege. Every operation runs in its own txn so that the changes
+// are visible to the other connections at once.
+type accountStore struct {
+	eng moengine.TxnEngine
+}
+
+// newAccountStore returns nil if the engine does not keep the catalog tables
+func newAccountStore(eng engine.Engine) *accountStore {
+	txnEngine, ok := txnEngineOf(eng)
+	if !ok {
+		return nil
+	}
+	return &accountStore{eng: txnEngine}
+}
+
+// run calls fn with the catalog database in a new txn. The txn is committed
+// if fn succeeds.
+func (as *accountStore) run(fn func(db engine.Database, snapshot engine.Snapshot) error) error {
+	txnCtx, err := as.eng.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	db, err := as.eng.Database(catalogDatabaseName, txnCtx.GetCtx())
+	if err == nil {
+		err = fn(db, txnCtx.GetCtx())
+	}
+	if err != nil {
+		if err2 := txnCtx.Rollback(); err2 != nil {
+			logutil.Errorf("txnCtx rollback failed. error:%v", err2)
+		}
+		return err
+	}
+	return txnCtx.Commit()
+}
+
+// catalogRows are the rows read from a catalog table with the hidden keys
+// to delete them
+type catalogRows struct {
+	rows [][]string
+	keys *vector.Vector
+}
+
+func (cr *catalogRows) delete(rel engine.Relation, sels []int64, snapshot engine.Snapshot) error {
+	if len(sels) == 0 {
+		return nil
+	}
+	keys := vector.New(cr.keys.Typ)
+	col := cr.keys.Col.([]types.Decimal128)
+	selected := make([]types.Decimal128, len(sels))
+	for i, sel := range sels {
+		selected[i] = col[sel]
+	}
+	if err := vector.Append(keys, selected); err != nil {
+		return err
+	}
+	return rel.Delete(0, keys, rel.GetHideKey(snapshot).Name, snapshot)
+}
+
+// readCatalogRows reads all the rows of a catalog table with varchar columns
+func readCatalogRows(rel engine.Relation, schema *CatalogSchema, snapshot engine.Snapshot) (*catalogRows, error) {
+	hideKey := rel.GetHideKey(snapshot)
+	attrs := make([]string, 0, schema.Length()+1)
+	for _, attr := range schema.GetAttributes() {
+		attrs = append(attrs, attr.GetName())
+	}
+	attrs = append(attrs, hideKey.Name)
+	refCounts := make([]uint64, len(attrs))
+	for i := range refCounts {
+		refCounts[i] = 1
+	}
+
+	cr := &catalogRows{keys: vector.New(hideKey.Type)}
+	reader := rel.NewReader(1, nil, nil, snapshot)[0]
+	for {
+		bat, err := reader.Read(refCounts, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if bat == nil {
+			break
+		}
+		n := vector.Length(bat.Vecs[0])
+		for i := 0; i < n; i++ {
+			row := make([]string, schema.Length())
+			for j := range row {
+				vec := bat.Vecs[j]
+				if !nulls.Contains(vec.Nsp, uint64(i)) {
+					row[j] = string(vec.Col.(*types.Bytes).Get(int64(i)))
+				}
+			}
+			cr.rows = append(cr.rows, row)
+		}
+		if err = vector.Append(cr.keys, bat.Vecs[schema.Length()].Col.([]types.Decimal128)[:n]); err != nil {
+			return nil, err
+		}
+	}
+	return cr, nil
+}
+
+// prepareCatalogBatch makes the batch of the rows for a catalog table with
+// varchar columns. Unlike FillBatchWithData, an empty string is kept as it is.
+func prepareCatalogBatch(schema *CatalogSchema, data [][]string) *batch.Batch {
+	attrs := make([]string, schema.Length())
+	for i, attr := range schema.GetAttributes() {
+		attrs[i] = attr.GetName()
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range schema.GetAttributes() {
+		col := make([][]byte, len(data))
+		for j, row := range data {
+			col[j] = []byte(row[i])
+		}
+		bat.Vecs[i] = vector.New(attr.GetType())
+		_ = vector.Append(bat.Vecs[i], col)
+	}
+	bat.Zs = make([]int64, len(data))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func (as *accountStore) getAccount(name string) (*userAccount, error) {
+	var account *userAccount
+	err := as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		var err error
+		account, _, _, err = findAccount(db, name, snapshot)
+		return err
+	})
+	return account, err
+}
+
+// findAccount returns the account, the rows of mo_user and the index of
+// the account in them
+func findAccount(db engine.Database, name string, snapshot engine.Snapshot) (*userAccount, *catalogRows, int64, error) {
+	rel, err := db.Relation(DefineSchemaForMoUser().GetName(), snapshot)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	cr, err := readCatalogRows(rel, DefineSchemaForMoUser(), snapshot)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	for i, row := range cr.rows {
+		if row[1] == name {
+			return &userAccount{host: row[0], name: row[1], authString: row[2]}, cr, int64(i), nil
+		}
+	}
+	return nil, cr, 0, nil
+}
+
+func (as *accountStore) createAccount(account *userAccount) error {
+	return as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		schema := DefineSchemaForMoUser()
+		rel, err := db.Relation(schema.GetName(), snapshot)
+		if err != nil {
+			return err
+		}
+		bat := prepareCatalogBatch(schema, [][]string{{account.host, account.name, account.authString}})
+		return rel.Write(0, bat, snapshot)
+	})
+}
+
+// dropAccount deletes the account and its privileges
+func (as *accountStore) dropAccount(name string) error {
+	return as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		account, cr, i, err := findAccount(db, name, snapshot)
+		if err != nil || account == nil {
+			return err
+		}
+		rel, err := db.Relation(DefineSchemaForMoUser().GetName(), snapshot)
+		if err != nil {
+			return err
+		}
+		if err = cr.delete(rel, []int64{i}, snapshot); err != nil {
+			return err
+		}
+		_, err = deletePrivileges(db, name, "", privilegeAll, snapshot)
+		return err
+	})
+}
+
+func (as *accountStore) setPassword(name, authString string) error {
+	return as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		account, cr, i, err := findAccount(db, name, snapshot)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return NewMysqlError(ER_PASSWORD_NO_MATCH)
+		}
+		schema := DefineSchemaForMoUser()
+		rel, err := db.Relation(schema.GetName(), snapshot)
+		if err != nil {
+			return err
+		}
+		if err = cr.delete(rel, []int64{i}, snapshot); err != nil {
+			return err
+		}
+		bat := prepareCatalogBatch(schema, [][]string{{account.host, account.name, authString}})
+		return rel.Write(0, bat, snapshot)
+	})
+}
+
+// getPrivileges returns the privileges of the user keyed by the database
+func (as *accountStore) getPrivileges(name string) (map[string]privilegeType, error) {
+	privs := make(map[string]privilegeType)
+	err := as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		schema := DefineSchemaForMoDBPrivilege()
+		rel, err := db.Relation(schema.GetName(), snapshot)
+		if err != nil {
+			return err
+		}
+		cr, err := readCatalogRows(rel, schema, snapshot)
+		if err != nil {
+			return err
+		}
+		for _, row := range cr.rows {
+			if row[0] == name {
+				privs[row[1]] |= parsePrivilegeName(row[2])
+			}
+		}
+		return nil
+	})
+	return privs, err
+}
+
+// grant adds the privileges on the database to the user
+func (as *accountStore) grant(name, dbName string, priv privilegeType) error {
+	return as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		schema := DefineSchemaForMoDBPrivilege()
+		rel, err := db.Relation(schema.GetName(), snapshot)
+		if err != nil {
+			return err
+		}
+		cr, err := readCatalogRows(rel, schema, snapshot)
+		if err != nil {
+			return err
+		}
+		for _, row := range cr.rows {
+			if row[0] == name && row[1] == dbName {
+				priv &^= parsePrivilegeName(row[2])
+			}
+		}
+		var data [][]string
+		for _, p := range privilegeNames {
+			if priv&p.priv != 0 {
+				data = append(data, []string{name, dbName, p.name})
+			}
+		}
+		if len(data) == 0 {
+			return nil
+		}
+		if err = rel.Write(0, prepareCatalogBatch(schema, data), snapshot); err != nil {
+			return err
+		}
+		return writeUserPrivileges(db, append(cr.rows, data...), snapshot)
+	})
+}
+
+// revoke removes the privileges on the database from the user. It returns
+// false if the user has none of them.
+func (as *accountStore) revoke(name, dbName string, priv privilegeType) (bool, error) {
+	var found bool
+	err := as.run(func(db engine.Database, snapshot engine.Snapshot) error {
+		var err error
+		found, err = deletePrivileges(db, name, dbName, priv, snapshot)
+		return err
+	})
+	return found, err
+}
+
+// deletePrivileges deletes the privileges of the user on the database, or
+// on all the databases if dbName is empty
+func deletePrivileges(db engine.Database, name, dbName string, priv privilegeType, snapshot engine.Snapshot) (bool, error) {
+	schema := DefineSchemaForMoDBPrivilege()
+	rel, err := db.Relation(schema.GetName(), snapshot)
+	if err != nil {
+		return false, err
+	}
+	cr, err := readCatalogRows(rel, schema, snapshot)
+	if err != nil {
+		return false, err
+	}
+	var sels []int64
+	var rows [][]string
+	for i, row := range cr.rows {
+		if row[0] == name && (len(dbName) == 0 || row[1] == dbName) && priv&parsePrivilegeName(row[2]) != 0 {
+			sels = append(sels, int64(i))
+		} else {
+			rows = append(rows, row)
+		}
+	}
+	if len(sels) == 0 {
+		return false, nil
+	}
+	if err = cr.delete(rel, sels, snapshot); err != nil {
+		return false, err
+	}
+	return true, writeUserPrivileges(db, rows, snapshot)
+}
+
+// privilegeTypeNames are the names of the privileges in the GRANT statements
+// and in user_privileges for a privilege in mo_db_privilege
+var privilegeTypeNames = map[privilegeType][]string{
+	privilegeRead:  {"SELECT"},
+	privilegeWrite: {"INSERT", "UPDATE", "DELETE"},
+}
+
+// writeUserPrivileges replaces the rows of user_privileges with the rows of
+// mo_db_privilege in the txn, so that user_privileges never shows a revoked
+// privilege
+func writeUserPrivileges(db engine.Database, privRows [][]string, snapshot engine.Snapshot) error {
+	userRel, err := db.Relation(DefineSchemaForMoUser().GetName(), snapshot)
+	if err != nil {
+		return err
+	}
+	users, err := readCatalogRows(userRel, DefineSchemaForMoUser(), snapshot)
+	if err != nil {
+		return err
+	}
+	hosts := make(map[string]string, len(users.rows))
+	for _, row := range users.rows {
+		hosts[row[1]] = row[0]
+	}
+
+	schema := DefineSchemaForMoUserPrivileges()
+	rel, err := db.Relation(schema.GetName(), snapshot)
+	if err != nil {
+		return err
+	}
+	cr, err := readCatalogRows(rel, schema, snapshot)
+	if err != nil {
+		return err
+	}
+	sels := make([]int64, len(cr.rows))
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	if err = cr.delete(rel, sels, snapshot); err != nil {
+		return err
+	}
+
+	var data [][]string
+	for _, row := range privRows {
+		host, ok := hosts[row[0]]
+		if !ok {
+			continue
+		}
+		grantee := formatAccount(&tree.User{Username: row[0], Hostname: host})
+		for _, name := range privilegeTypeNames[parsePrivilegeName(row[2])] {
+			data = append(data, []string{grantee, row[1], name, "NO"})
+		}
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return rel.Write(0, prepareCatalogBatch(schema, data), snapshot)
+}
+
+// privilegeRequest is a privilege needed by a statement
+type privilegeRequest struct {
+	db    string
+	table string
+	priv  privilegeType
+}
+
+// planPrivileges returns the privileges needed to execute the plan
+func planPrivileges(p *plan.Plan, currentDb string) []privilegeRequest {
+	orDefault := func(db string) string {
+		if len(db) == 0 {
+			return currentDb
+		}
+		return db
+	}
+	var reqs []privilegeRequest
+	queryPrivileges := func(qry *plan.Query) {
+		for _, node := range qry.GetNodes() {
+			var priv privilegeType
+			switch node.NodeType {
+			case plan.Node_TABLE_SCAN:
+				priv = privilegeRead
+			case plan.Node_INSERT, plan.Node_UPDATE, plan.Node_DELETE:
+				priv = privilegeWrite
+			default:
+				continue
+			}
+			if ref := node.GetObjRef(); ref != nil {
+				reqs = append(reqs, privilegeRequest{db: orDefault(ref.GetSchemaName()), table: ref.GetObjName(), priv: priv})
+			}
+		}
+	}
+
+	if qry := p.GetQuery(); qry != nil {
+		queryPrivileges(qry)
+	}
+	if ddl := p.GetDdl(); ddl != nil {
+		if qry := ddl.GetQuery(); qry != nil {
+			queryPrivileges(qry)
+		}
+		switch {
+		case ddl.GetCreateDatabase() != nil:
+			reqs = append(reqs, privilegeRequest{db: ddl.GetCreateDatabase().GetDatabase(), priv: privilegeWrite})
+		case ddl.GetDropDatabase() != nil:
+			reqs = append(reqs, privilegeRequest{db: ddl.GetDropDatabase().GetDatabase(), priv: privilegeWrite})
+		case ddl.GetCreateTable() != nil:
+			reqs = append(reqs, privilegeRequest{db: orDefault(ddl.GetCreateTable().GetDatabase()), table: ddl.GetCreateTable().GetTableDef().GetName(), priv: privilegeWrite})
+		case ddl.GetDropTable() != nil:
+			reqs = append(reqs, privilegeRequest{db: orDefault(ddl.GetDropTable().GetDatabase()), table: ddl.GetDropTable().GetTable(), priv: privilegeWrite})
+		case ddl.GetAlterTable() != nil:
+			reqs = append(reqs, privilegeRequest{db: currentDb, table: ddl.GetAlterTable().GetTable(), priv: privilegeWrite})
+		case ddl.GetTruncateTable() != nil:
+			reqs = append(reqs, privilegeRequest{db: currentDb, table: ddl.GetTruncateTable().GetTable(), priv: privilegeWrite})
+		case ddl.GetCreateIndex() != nil, ddl.GetDropIndex() != nil:
+			reqs = append(reqs, privilegeRequest{db: currentDb, priv: privilegeWrite})
+		}
+	}
+	sort.SliceStable(reqs, func(i, j int) bool { return reqs[i].db < reqs[j].db })
+	return reqs
+}
+
+// isSuperUser checks the user can manage the accounts and has all the
+// privileges. They are root and the dump user.
+func (ses *Session) isSuperUser() bool {
+	return ses.isSuperUserName(ses.GetUserName())
+}
+
+func (ses *Session) isSuperUserName(user string) bool {
+	return user == rootUserName || (ses.Pu != nil && user == ses.Pu.SV.GetDumpuser())
+}
+
+// needPrivilegeCheck is false for the internal sessions, the super users
+// and the engines without the account tables
+func (ses *Session) needPrivilegeCheck() bool {
+	return !ses.IsInternal && !ses.isSuperUser() && newAccountStore(ses.GetStorage()) != nil
+}
+
+// checkPrivilege checks the user has the privilege on the table of the database
+func (ses *Session) checkPrivilege(db, table string, priv privilegeType) error {
+	return ses.checkPrivileges([]privilegeRequest{{db: db, table: table, priv: priv}})
+}
+
+// checkPrivileges checks the privileges with the grants of the user read
+// from the catalog, so that a grant takes effect in the next statement
+func (ses *Session) checkPrivileges(reqs []privilegeRequest) error {
+	if len(reqs) == 0 || !ses.needPrivilegeCheck() {
+		return nil
+	}
+	var privs map[string]privilegeType
+	for _, req := range reqs {
+		db := strings.ToLower(req.db)
+		if db == catalogDatabaseName || db == informationSchemaName {
+			// the catalog can be read by everyone except the account tables
+			if req.priv == privilegeRead && !isAccountTable(db, req.table) {
+				continue
+			}
+			return ses.dbAccessDenied(req.db)
+		}
+		if privs == nil {
+			var err error
+			if privs, err = newAccountStore(ses.GetStorage()).getPrivileges(ses.GetUserName()); err != nil {
+				return err
+			}
+		}
+		if (privs[req.db]|privs[globalPrivilegeDatabase])&req.priv != req.priv {
+			return ses.dbAccessDenied(req.db)
+		}
+	}
+	return nil
+}
+
+func isAccountTable(db, table string) bool {
+	return db == catalogDatabaseName &&
+		(table == DefineSchemaForMoUser().GetName() || table == DefineSchemaForMoDBPrivilege().GetName() ||
+			table == DefineSchemaForMoUserPrivileges().GetName())
+}
+
+func (ses *Session) dbAccessDenied(db string) error {
+	host, _ := ses.protocol.Peer()
+	return NewMysqlError(ER_DBACCESS_DENIED_ERROR, ses.GetUserName(), host, db)
+}
+
+// accountStoreForStatement returns the account store for the account
+// statement, only the super users can run it
+func (mce *MysqlCmdExecutor) accountStoreForStatement(stmt string, superOnly bool) (*accountStore, error) {
+	ses := mce.GetSession()
+	accounts := newAccountStore(ses.GetStorage())
+	if accounts == nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("%s needs the tae engine", stmt))
+	}
+	if superOnly && !ses.IsInternal && !ses.isSuperUser() {
+		return nil, NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, stmt)
+	}
+	return accounts, nil
+}
+
+func formatAccount(user *tree.User) string {
+	return fmt.Sprintf("'%s'@'%s'", user.Username, user.Hostname)
+}
+
+// accountAuthString returns the authentication string of the user in the
+// CREATE USER or the SET PASSWORD statement
+func accountAuthString(user *tree.User) (string, error) {
+	if len(user.AuthPlugin) != 0 && !strings.EqualFold(user.AuthPlugin, nativePasswordPlugin) {
+		return "", NewMysqlError(ER_PLUGIN_IS_NOT_LOADED, user.AuthPlugin)
+	}
+	if len(user.HashString) != 0 {
+		if _, err := decodeNativePassword(user.HashString); err != nil {
+			return "", err
+		}
+		return strings.ToUpper(user.HashString), nil
+	}
+	return encodeNativePassword(user.AuthString), nil
+}
+
+// findUser returns the account matches both the name and the host of the user
+func findUser(accounts *accountStore, user *tree.User) (*userAccount, error) {
+	account, err := accounts.getAccount(user.Username)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	if account.host != user.Hostname {
+		return nil, nil
+	}
+	return account, nil
+}
+
+func (mce *MysqlCmdExecutor) handleCreateUser(cu *tree.CreateUser) error {
+	accounts, err := mce.accountStoreForStatement("CREATE USER", true)
+	if err != nil {
+		return err
+	}
+	for _, user := range cu.Users {
+		authString, err := accountAuthString(user)
+		if err != nil {
+			return err
+		}
+		account, err := accounts.getAccount(user.Username)
+		if err != nil {
+			return err
+		}
+		if account != nil {
+			if cu.IfNotExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", formatAccount(user))
+		}
+		if err = accounts.createAccount(&userAccount{host: user.Hostname, name: user.Username, authString: authString}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mce *MysqlCmdExecutor) handleDropUser(du *tree.DropUser) error {
+	accounts, err := mce.accountStoreForStatement("DROP USER", true)
+	if err != nil {
+		return err
+	}
+	for _, user := range du.Users {
+		account, err := findUser(accounts, user)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			if du.IfExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "DROP USER", formatAccount(user))
+		}
+		if err = accounts.dropAccount(user.Username); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mce *MysqlCmdExecutor) handleSetPassword(sp *tree.SetPassword) error {
+	ses := mce.GetSession()
+	user := sp.User
+	// everyone can change the own password
+	superOnly := user != nil && user.Username != ses.GetUserName()
+	accounts, err := mce.accountStoreForStatement("CREATE USER", superOnly)
+	if err != nil {
+		return err
+	}
+	var account *userAccount
+	if user == nil {
+		account, err = accounts.getAccount(ses.GetUserName())
+	} else {
+		account, err = findUser(accounts, user)
+	}
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return NewMysqlError(ER_PASSWORD_NO_MATCH)
+	}
+	return accounts.setPassword(account.name, encodeNativePassword(sp.Password))
+}
+
+// grantPrivileges converts the privileges in GRANT or REVOKE to the
+// database privileges
+func grantPrivileges(privileges []*tree.Privilege) (privilegeType, error) {
+	var priv privilegeType
+	for _, p := range privileges {
+		if len(p.ColumnList) != 0 {
+			return 0, errors.New(errno.FeatureNotSupported, "the column privileges are not supported")
+		}
+		switch p.Type {
+		case tree.PRIVILEGE_TYPE_STATIC_ALL:
+			priv |= privilegeAll
+		case tree.PRIVILEGE_TYPE_STATIC_SELECT:
+			priv |= privilegeRead
+		case tree.PRIVILEGE_TYPE_STATIC_INSERT, tree.PRIVILEGE_TYPE_STATIC_UPDATE, tree.PRIVILEGE_TYPE_STATIC_DELETE,
+			tree.PRIVILEGE_TYPE_STATIC_CREATE, tree.PRIVILEGE_TYPE_STATIC_DROP, tree.PRIVILEGE_TYPE_STATIC_ALTER,
+			tree.PRIVILEGE_TYPE_STATIC_INDEX:
+			priv |= privilegeWrite
+		case tree.PRIVILEGE_TYPE_STATIC_USAGE:
+		default:
+			return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the privilege %s is not supported", p.Type.ToString()))
+		}
+	}
+	return priv, nil
+}
+
+// grantDatabase returns the database of the privilege level in GRANT or REVOKE
+func (mce *MysqlCmdExecutor) grantDatabase(level *tree.PrivilegeLevel) (string, error) {
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+		return globalPrivilegeDatabase, nil
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE:
+		if len(level.DbName) != 0 {
+			return level.DbName, nil
+		}
+		if db := mce.GetSession().GetDatabaseName(); len(db) != 0 {
+			return db, nil
+		}
+		return "", NewMysqlError(ER_NO_DB_ERROR)
+	default:
+		return "", errors.New(errno.FeatureNotSupported, "only the privileges on the databases are supported")
+	}
+}
+
+func (mce *MysqlCmdExecutor) handleGrant(g *tree.Grant) error {
+	if g.IsGrantRole || g.IsProxy {
+		return errors.New(errno.FeatureNotSupported, "only the privileges on the databases are supported")
+	}
+	accounts, err := mce.accountStoreForStatement("GRANT", true)
+	if err != nil {
+		return err
+	}
+	priv, err := grantPrivileges(g.Privileges)
+	if err != nil {
+		return err
+	}
+	db, err := mce.grantDatabase(g.Level)
+	if err != nil {
+		return err
+	}
+	for _, user := range g.Users {
+		account, err := findUser(accounts, user)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return NewMysqlError(ER_CANNOT_USER, "GRANT", formatAccount(user))
+		}
+		if err = accounts.grant(account.name, db, priv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mce *MysqlCmdExecutor) handleRevoke(r *tree.Revoke) error {
+	if r.IsRevokeRole {
+		return errors.New(errno.FeatureNotSupported, "only the privileges on the databases are supported")
+	}
+	accounts, err := mce.accountStoreForStatement("REVOKE", true)
+	if err != nil {
+		return err
+	}
+	priv, err := grantPrivileges(r.Privileges)
+	if err != nil {
+		return err
+	}
+	db, err := mce.grantDatabase(r.Level)
+	if err != nil {
+		return err
+	}
+	for _, user := range r.Users {
+		account, err := findUser(accounts, user)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return NewMysqlError(ER_NONEXISTING_GRANT, user.Username, user.Hostname)
+		}
+		found, err := accounts.revoke(account.name, db, priv)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return NewMysqlError(ER_NONEXISTING_GRANT, user.Username, user.Hostname)
+		}
+	}
+	return nil
+}
+
+// formatGrants returns the GRANT statements of the privileges of the account.
+// The privileges on all the databases take the place of USAGE in the first
+// statement, and the databases follow in order.
+func formatGrants(account *userAccount, privs map[string]privilegeType, superUser bool) []string {
+	to := " TO " + formatAccount(&tree.User{Username: account.name, Hostname: account.host})
+	if superUser {
+		return []string{"GRANT ALL PRIVILEGES ON *.*" + to + " WITH GRANT OPTION"}
+	}
+	onGrant := func(on string, priv privilegeType) string {
+		var names []string
+		for _, p := range privilegeNames {
+			if priv&p.priv != 0 {
+				names = append(names, privilegeTypeNames[p.priv]...)
+			}
+		}
+		return "GRANT " + strings.Join(names, ", ") + " ON " + on + to
+	}
+
+	grants := []string{"GRANT USAGE ON *.*" + to}
+	if priv := privs[globalPrivilegeDatabase]; priv != 0 {
+		grants[0] = onGrant("*.*", priv)
+	}
+	dbs := make([]string, 0, len(privs))
+	for db, priv := range privs {
+		if db != globalPrivilegeDatabase && priv != 0 {
+			dbs = append(dbs, db)
+		}
+	}
+	sort.Strings(dbs)
+	for _, db := range dbs {
+		grants = append(grants, onGrant("`"+strings.ReplaceAll(db, "`", "``")+"`.*", privs[db]))
+	}
+	return grants
+}
+
+// handleShowGrants lists the privileges of the user, or of the current user
+// if there is no user in the statement. Only the super users can list the
+// privileges of the other users.
+func (mce *MysqlCmdExecutor) handleShowGrants(sg *tree.ShowGrants) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+	name := sg.Username
+	if len(name) == 0 {
+		name = ses.GetUserName()
+	}
+	accounts, err := mce.accountStoreForStatement("SHOW GRANTS", name != ses.GetUserName())
+	if err != nil {
+		return err
+	}
+	var account *userAccount
+	if len(sg.Username) == 0 {
+		account, err = accounts.getAccount(name)
+	} else {
+		account, err = findUser(accounts, &tree.User{Username: sg.Username, Hostname: sg.Hostname})
+	}
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return NewMysqlError(ER_NONEXISTING_GRANT, sg.Username, sg.Hostname)
+	}
+	privs, err := accounts.getPrivileges(account.name)
+	if err != nil {
+		return err
+	}
+
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col.SetName(fmt.Sprintf("Grants for %s@%s", account.name, account.host))
+	ses.Mrs.AddColumn(col)
+	for _, grant := range formatGrants(account, privs, ses.isSuperUserName(account.name)) {
+		ses.Mrs.AddRow([]interface{}{grant})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
@@ -0,0 +1,369 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/stretchr/testify/require"
+)
+
+// startAccountTestServer starts a server on tae with the catalog tables
+func startAccountTestServer(t *testing.T) (*MOServer, int) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	return startTestServerOnTae(t, tae)
+}
+
+// startTestServerOnTae is startAccountTestServer on the given tae
+func startTestServerOnTae(t *testing.T, tae *db.DB) (*MOServer, int) {
+	t.Cleanup(func() {
+		// the test may have closed it to open the storage again
+		if tae.Closed.Load() == nil {
+			_ = tae.Close()
+		}
+	})
+	eng := moengine.NewEngine(tae)
+	require.NoError(t, InitDB(eng))
+
+	// the sessions use the global storage engine
+	oldEngine := config.StorageEngine
+	config.StorageEngine = eng
+	t.Cleanup(func() { config.StorageEngine = oldEngine })
+
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+	require.NoError(t, err)
+	sv := NewMOServer(fmt.Sprintf("127.0.0.1:%d", port), pu, getPCI())
+	require.NoError(t, sv.Start())
+	t.Cleanup(func() { _ = sv.Stop() })
+	return sv, port
+}
+
+func openAccountDB(t *testing.T, port int, user, password string) *sql.DB {
+	dsn := fmt.Sprintf("%s:%s@tcp(127.0.0.1:%d)/?timeout=10s&readTimeout=10s&writeTimeout=10s", user, password, port)
+	db, err := sql.Open("mysql", dsn)
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func requireMysqlErrorCode(t *testing.T, code uint16, err error) {
+	require.Error(t, err)
+	merr, ok := err.(*mysql.MySQLError)
+	require.True(t, ok, "%v", err)
+	require.Equal(t, code, merr.Number, merr.Message)
+}
+
+func TestNativePassword(t *testing.T) {
+	salt := []byte("01234567890123456789")
+	scramble := func(password string) []byte {
+		hash1 := sha1.Sum([]byte(password))
+		hash2 := sha1.Sum(hash1[:])
+		hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+		for i := range hash1 {
+			hash1[i] ^= hash3[i]
+		}
+		return hash1[:]
+	}
+
+	authString := encodeNativePassword("secret")
+	require.Equal(t, "*14E65567ABDB5135D0CFD9A70B3032C179A49EE7", authString)
+	hash2, err := decodeNativePassword(authString)
+	require.NoError(t, err)
+	require.True(t, checkNativePassword(hash2, salt, scramble("secret")))
+	require.False(t, checkNativePassword(hash2, salt, scramble("Secret")))
+	require.False(t, checkNativePassword(hash2, salt, nil))
+
+	require.Equal(t, "", encodeNativePassword(""))
+	hash2, err = decodeNativePassword("")
+	require.NoError(t, err)
+	require.True(t, checkNativePassword(hash2, salt, nil))
+	require.False(t, checkNativePassword(hash2, salt, scramble("secret")))
+
+	for _, bad := range []string{"secret", "*14E6", "*14E65567ABDB5135D0CFD9A70B3032C179A49EZZ"} {
+		_, err = decodeNativePassword(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestMatchHostPattern(t *testing.T) {
+	cases := []struct {
+		pattern, host string
+		expect        bool
+	}{
+		{"%", "10.1.2.3", true},
+		{"127.0.0.1", "127.0.0.1", true},
+		{"127.0.0.%", "127.0.0.1", true},
+		{"127.0.0.%", "127.0.1.1", false},
+		{"10.0.0._", "10.0.0.7", true},
+		{"10.0.0._", "10.0.0.17", false},
+		{"localhost", "127.0.0.1", true},
+		{"localhost", "::1", true},
+		{"LocalHost", "localhost", true},
+		{"localhost", "10.0.0.1", false},
+		{"%.example.com", "db.example.com", true},
+		{"%.example.com", "example.com", false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expect, matchHostPattern(c.pattern, c.host), "%s %s", c.pattern, c.host)
+	}
+}
+
+func TestHideCredentials(t *testing.T) {
+	cases := map[string]string{
+		"create user u1 identified by 'pwd'": "create user u1 identified by '***'",
+		"CREATE USER 'u1'@'%' IDENTIFIED WITH mysql_native_password AS '*ABC', 'u2' identified by \"x\"": "CREATE USER 'u1'@'%' IDENTIFIED WITH mysql_native_password AS '***', 'u2' identified by '***'",
+		"set password = 'a\\'b'":                      "set password = '***'",
+		"set password for 'u1'@'%' = password('pwd')": "set password for 'u1'@'%' = password('***')",
+		"select 'password = 1'":                       "select 'password = 1'",
+	}
+	for query, expect := range cases {
+		require.Equal(t, expect, hideCredentials(query))
+	}
+}
+
+func TestPasswordAuthentication(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	root := openAccountDB(t, port, "root", "")
+	require.NoError(t, root.Ping())
+
+	_, err := root.Exec("create user 'u1'@'%' identified by 'pwd1', 'u2'@'127.0.0.%' identified by 'pwd2', 'u3'@'10.%' identified by 'pwd3'")
+	require.NoError(t, err)
+	_, err = root.Exec("create user 'u1'@'%' identified by 'other'")
+	requireMysqlErrorCode(t, ER_CANNOT_USER, err)
+	_, err = root.Exec("create user if not exists 'u1'@'%' identified by 'other'")
+	require.NoError(t, err)
+
+	require.NoError(t, openAccountDB(t, port, "u1", "pwd1").Ping())
+	requireMysqlErrorCode(t, ER_ACCESS_DENIED_ERROR, openAccountDB(t, port, "u1", "other").Ping())
+	requireMysqlErrorCode(t, ER_ACCESS_DENIED_ERROR, openAccountDB(t, port, "u1", "").Ping())
+	requireMysqlErrorCode(t, ER_ACCESS_DENIED_ERROR, openAccountDB(t, port, "nobody", "pwd1").Ping())
+
+	// host patterns
+	require.NoError(t, openAccountDB(t, port, "u2", "pwd2").Ping())
+	requireMysqlErrorCode(t, ER_ACCESS_DENIED_ERROR, openAccountDB(t, port, "u3", "pwd3").Ping())
+
+	// the password hashes are kept instead of the passwords
+	var authString string
+	_, err = root.Exec("use mo_catalog")
+	require.NoError(t, err)
+	require.NoError(t, root.QueryRow("select authentication_string from mo_catalog.mo_user where user_name = 'u1'").Scan(&authString))
+	require.Equal(t, encodeNativePassword("pwd1"), authString)
+
+	// set the own password and the password of another user
+	u1 := openAccountDB(t, port, "u1", "pwd1")
+	_, err = u1.Exec("set password = 'pwd1b'")
+	require.NoError(t, err)
+	require.NoError(t, openAccountDB(t, port, "u1", "pwd1b").Ping())
+	requireMysqlErrorCode(t, ER_ACCESS_DENIED_ERROR, openAccountDB(t, port, "u1", "pwd1").Ping())
+	_, err = u1.Exec("set password for 'u2'@'127.0.0.%' = 'x'")
+	requireMysqlErrorCode(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, err)
+	_, err = u1.Exec("create user 'u4'@'%' identified by 'x'")
+	requireMysqlErrorCode(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, err)
+	_, err = root.Exec("set password for 'u2'@'127.0.0.%' = 'pwd2b'")
+	require.NoError(t, err)
+	require.NoError(t, openAccountDB(t, port, "u2", "pwd2b").Ping())
+
+	_, err = root.Exec("drop user 'u2'@'127.0.0.%'")
+	require.NoError(t, err)
+	requireMysqlErrorCode(t, ER_ACCESS_DENIED_ERROR, openAccountDB(t, port, "u2", "pwd2b").Ping())
+	_, err = root.Exec("drop user 'u2'@'127.0.0.%'")
+	requireMysqlErrorCode(t, ER_CANNOT_USER, err)
+	_, err = root.Exec("drop user if exists 'u2'@'127.0.0.%'")
+	require.NoError(t, err)
+}
+
+func TestDatabasePrivileges(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	root := openAccountDB(t, port, "root", "")
+	for _, sql := range []string{
+		"create database db1",
+		"use db1",
+		"create table t1 (a int)",
+		"insert into t1 values (1), (2)",
+		"create user 'u1'@'%' identified by 'pwd'",
+	} {
+		_, err := root.Exec(sql)
+		require.NoError(t, err, sql)
+	}
+
+	// the grants are checked in the statements of the same connection
+	conn, err := openAccountDB(t, port, "u1", "pwd").Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := context.Background()
+	_, err = conn.ExecContext(ctx, "use db1")
+	require.NoError(t, err)
+	count := func() (int, error) {
+		var n int
+		err := conn.QueryRowContext(ctx, "select count(*) from db1.t1").Scan(&n)
+		return n, err
+	}
+
+	_, err = count()
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+	_, err = conn.ExecContext(ctx, "insert into t1 values (3)")
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+	_, err = conn.ExecContext(ctx, "create table db1.t2 (a int)")
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+	_, err = conn.ExecContext(ctx, "select * from mo_catalog.mo_user")
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+	_, err = conn.ExecContext(ctx, "grant select on db1.* to 'u1'@'%'")
+	requireMysqlErrorCode(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, err)
+
+	_, err = root.Exec("grant select on db1.* to 'u1'@'%'")
+	require.NoError(t, err)
+	n, err := count()
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	_, err = conn.ExecContext(ctx, "insert into t1 values (3)")
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+
+	_, err = root.Exec("grant insert on db1.* to 'u1'@'%'")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "insert into t1 values (3)")
+	require.NoError(t, err)
+	n, err = count()
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	_, err = root.Exec("revoke select on db1.* from 'u1'@'%'")
+	require.NoError(t, err)
+	_, err = count()
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+	_, err = root.Exec("revoke select on db1.* from 'u1'@'%'")
+	requireMysqlErrorCode(t, ER_NONEXISTING_GRANT, err)
+
+	// the global privileges cover all the databases
+	_, err = root.Exec("grant all on *.* to 'u1'@'%'")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "create database db2")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "create table db2.t1 (a int)")
+	require.NoError(t, err)
+	n, err = count()
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// the privileges are dropped with the user
+	_, err = root.Exec("drop user 'u1'@'%'")
+	require.NoError(t, err)
+	_, err = root.Exec("create user 'u1'@'%' identified by 'pwd'")
+	require.NoError(t, err)
+	_, err = count()
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+}
+
+func TestShowGrants(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	root := openAccountDB(t, port, "root", "")
+	users := []string{
+		"create user 'u1'@'%' identified by 'pwd'",
+		"create user 'u2'@'10.0.0.%' identified by 'pwd'",
+	}
+	execAll(t, root, users...)
+	execAll(t, root,
+		"use mo_catalog",
+		"create database a_db",
+		"create database b_db",
+		"create database c_db",
+		"grant select on a_db.* to 'u1'@'%'",
+		"grant all on b_db.* to 'u1'@'%'",
+		"grant insert, delete on c_db.* to 'u1'@'%'",
+		"grant select on *.* to 'u2'@'10.0.0.%'",
+		"grant update on c_db.* to 'u2'@'10.0.0.%'",
+		"revoke select on b_db.* from 'u1'@'%'",
+	)
+
+	u1Grants := []string{
+		"GRANT USAGE ON *.* TO 'u1'@'%'",
+		"GRANT SELECT ON `a_db`.* TO 'u1'@'%'",
+		"GRANT INSERT, UPDATE, DELETE ON `b_db`.* TO 'u1'@'%'",
+		"GRANT INSERT, UPDATE, DELETE ON `c_db`.* TO 'u1'@'%'",
+	}
+	u2Grants := []string{
+		"GRANT SELECT ON *.* TO 'u2'@'10.0.0.%'",
+		"GRANT INSERT, UPDATE, DELETE ON `c_db`.* TO 'u2'@'10.0.0.%'",
+	}
+	require.Equal(t, u1Grants, queryStrings(t, root, "show grants for 'u1'@'%'"))
+	require.Equal(t, u2Grants, queryStrings(t, root, "show grants for 'u2'@'10.0.0.%'"))
+	require.Equal(t, []string{"GRANT ALL PRIVILEGES ON *.* TO 'root'@'%' WITH GRANT OPTION"}, queryStrings(t, root, "show grants"))
+	_, err := root.Query("show grants for 'u1'@'localhost'")
+	requireMysqlErrorCode(t, ER_NONEXISTING_GRANT, err)
+
+	privileges := []string{
+		"'u1'@'%'|a_db|SELECT|NO",
+		"'u1'@'%'|b_db|DELETE|NO",
+		"'u1'@'%'|b_db|INSERT|NO",
+		"'u1'@'%'|b_db|UPDATE|NO",
+		"'u1'@'%'|c_db|DELETE|NO",
+		"'u1'@'%'|c_db|INSERT|NO",
+		"'u1'@'%'|c_db|UPDATE|NO",
+		"'u2'@'10.0.0.%'|*|SELECT|NO",
+		"'u2'@'10.0.0.%'|c_db|DELETE|NO",
+		"'u2'@'10.0.0.%'|c_db|INSERT|NO",
+		"'u2'@'10.0.0.%'|c_db|UPDATE|NO",
+	}
+	query := "select grantee, table_schema, privilege_type, is_grantable from mo_catalog.user_privileges order by grantee, table_schema, privilege_type"
+	require.Equal(t, privileges, queryRows(t, root, query))
+	require.Equal(t, []string{"a_db|1", "b_db|3", "c_db|6"}, queryRows(t, root,
+		"select d.datname, count(*) from mo_catalog.user_privileges p join mo_catalog.mo_database d on p.table_schema = d.datname group by d.datname order by d.datname"))
+
+	// a user lists the own grants only
+	u1 := openAccountDB(t, port, "u1", "pwd")
+	require.Equal(t, u1Grants, queryStrings(t, u1, "show grants"))
+	require.Equal(t, u1Grants, queryStrings(t, u1, "show grants for current_user()"))
+	require.Equal(t, u1Grants, queryStrings(t, u1, "show grants for 'u1'@'%'"))
+	_, err = u1.Query("show grants for 'u2'@'10.0.0.%'")
+	requireMysqlErrorCode(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, err)
+	execAll(t, u1, "use a_db")
+	_, err = u1.Query("select * from mo_catalog.user_privileges")
+	requireMysqlErrorCode(t, ER_DBACCESS_DENIED_ERROR, err)
+
+	// the rendered grants give the same privileges on a fresh instance
+	_, port2 := startAccountTestServer(t)
+	root2 := openAccountDB(t, port2, "root", "")
+	execAll(t, root2, "use mo_catalog")
+	execAll(t, root2, users...)
+	execAll(t, root2, u1Grants...)
+	execAll(t, root2, u2Grants...)
+	require.Equal(t, u1Grants, queryStrings(t, root2, "show grants for 'u1'@'%'"))
+	require.Equal(t, u2Grants, queryStrings(t, root2, "show grants for 'u2'@'10.0.0.%'"))
+	require.Equal(t, privileges, queryRows(t, root2, query))
+
+	// the revoked privileges and the dropped users are gone
+	execAll(t, root,
+		"revoke all on c_db.* from 'u1'@'%'",
+		"drop user 'u2'@'10.0.0.%'",
+	)
+	require.Equal(t, u1Grants[:3], queryStrings(t, root, "show grants for 'u1'@'%'"))
+	require.Equal(t, privileges[:4], queryRows(t, root, query))
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	commandSleep = "Sleep"
+	commandQuery = "Query"
+
+	// processInfoLength is the max length of the statement listed by SHOW
+	// PROCESSLIST, SHOW FULL PROCESSLIST lists all of it
+	processInfoLength = 100
+)
+
+// processInfo is the state of a connection listed by SHOW PROCESSLIST, it is
+// updated by the connection and read by the other ones.
+type processInfo struct {
+	id uint64
+
+	mu      sync.Mutex
+	host    string
+	user    string
+	db      string
+	command string
+	// start, the time the command started
+	start time.Time
+	// info, the running statement
+	info string
+	// progress, the progress of the running or the last statement
+	progress *process.Progress
+}
+
+func newProcessInfo(id uint64) *processInfo {
+	return &processInfo{
+		id:       id,
+		command:  commandSleep,
+		start:    time.Now(),
+		progress: process.NewProgress(),
+	}
+}
+
+func (pi *processInfo) setHost(host string) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	pi.host = host
+}
+
+// startStatement records the statement the connection starts to run, and
+// the progress it counts its work in.
+func (pi *processInfo) startStatement(user, db, sql string, progress *process.Progress) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	pi.user, pi.db = user, db
+	pi.command = commandQuery
+	pi.start = time.Now()
+	pi.info = sql
+	pi.progress = progress
+}
+
+// endStatement records the connection is idle, the progress of the last
+// statement is kept for SHOW PROFILE.
+func (pi *processInfo) endStatement(db string) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	pi.db = db
+	pi.command = commandSleep
+	pi.start = time.Now()
+	pi.info = ""
+}
+
+func (pi *processInfo) lastProgress() *process.Progress {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	return pi.progress
+}
+
+// row returns the row of the connection in SHOW PROCESSLIST, the progress
+// of an idle connection is NULL.
+func (pi *processInfo) row(full bool) []interface{} {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	row := []interface{}{pi.id, pi.user, pi.host, nil, pi.command, int64(time.Since(pi.start).Seconds()), "", nil, nil, int64(0)}
+	if pi.db != "" {
+		row[3] = pi.db
+	}
+	if pi.command == commandQuery {
+		row[6] = "executing"
+		info := pi.info
+		if !full && len(info) > processInfoLength {
+			info = info[:processInfoLength]
+		}
+		row[7] = info
+		if pct, ok := pi.progress.Percent(); ok {
+			row[8] = pct
+		}
+		row[9] = pi.progress.RowsExamined()
+	}
+	return row
+}
+
+// processList returns the processes of the connections, ordered by id.
+func (rm *RoutineManager) processList() []*processInfo {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	list := make([]*processInfo, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		list = append(list, rt.info)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].id < list[j].id })
+	return list
+}
+
+// processList returns the processes listed by SHOW PROCESSLIST, only the one
+// of the session if it does not belong to a routine manager.
+func (mce *MysqlCmdExecutor) processList() []*processInfo {
+	if rm := mce.GetRoutineManager(); rm != nil {
+		return rm.processList()
+	}
+	return []*processInfo{mce.GetSession().info}
+}
+
+func (mce *MysqlCmdExecutor) handleShowProcessList(st *tree.ShowProcessList) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	cols := []struct {
+		name string
+		typ  uint8
+	}{
+		{"Id", defines.MYSQL_TYPE_LONGLONG},
+		{"User", defines.MYSQL_TYPE_VARCHAR},
+		{"Host", defines.MYSQL_TYPE_VARCHAR},
+		{"db", defines.MYSQL_TYPE_VARCHAR},
+		{"Command", defines.MYSQL_TYPE_VARCHAR},
+		{"Time", defines.MYSQL_TYPE_LONG},
+		{"State", defines.MYSQL_TYPE_VARCHAR},
+		{"Info", defines.MYSQL_TYPE_VARCHAR},
+		{"Progress", defines.MYSQL_TYPE_DOUBLE},
+		{"Rows_examined", defines.MYSQL_TYPE_LONGLONG},
+	}
+	for _, c := range cols {
+		col := new(MysqlColumn)
+		col.SetColumnType(c.typ)
+		col.SetName(c.name)
+		ses.Mrs.AddColumn(col)
+	}
+	for _, pi := range mce.processList() {
+		ses.Mrs.AddRow(pi.row(st.Full))
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// handleShowProfile lists the counters of the operators of the running or
+// the last statement of a connection
+func (mce *MysqlCmdExecutor) handleShowProfile(st *tree.ShowProfile) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	var progress *process.Progress
+	for _, pi := range mce.processList() {
+		if pi.id == st.ProcessID {
+			progress = pi.lastProgress()
+			break
+		}
+	}
+	if progress == nil {
+		return NewMysqlError(ER_NO_SUCH_THREAD, st.ProcessID)
+	}
+
+	cols := []struct {
+		name string
+		typ  uint8
+	}{
+		{"Operator", defines.MYSQL_TYPE_VARCHAR},
+		{"Relation", defines.MYSQL_TYPE_VARCHAR},
+		{"Rows_examined", defines.MYSQL_TYPE_LONGLONG},
+		{"Blocks", defines.MYSQL_TYPE_LONGLONG},
+		{"Estimated_rows", defines.MYSQL_TYPE_LONGLONG},
+		{"Progress", defines.MYSQL_TYPE_DOUBLE},
+	}
+	for _, c := range cols {
+		col := new(MysqlColumn)
+		col.SetColumnType(c.typ)
+		col.SetName(c.name)
+		ses.Mrs.AddColumn(col)
+	}
+	for _, sp := range progress.Scans() {
+		ses.Mrs.AddRow([]interface{}{"Table Scan", sp.Relation, sp.Rows(), sp.Blocks(), sp.EstimatedRows, sp.Percent()})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
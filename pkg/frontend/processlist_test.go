@@ -0,0 +1,147 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/stretchr/testify/require"
+)
+
+type processListRow struct {
+	id       uint64
+	command  string
+	info     sql.NullString
+	progress sql.NullFloat64
+	rows     int64
+}
+
+func showProcess(t *testing.T, conn *sql.DB, id uint64) processListRow {
+	rows, err := conn.Query("show full processlist")
+	require.NoError(t, err)
+	defer rows.Close()
+	for rows.Next() {
+		var r processListRow
+		var user, host, db, state sql.NullString
+		var tm int64
+		require.NoError(t, rows.Scan(&r.id, &user, &host, &db, &r.command, &tm, &state, &r.info, &r.progress, &r.rows))
+		if r.id == id {
+			return r
+		}
+	}
+	require.NoError(t, rows.Err())
+	require.Failf(t, "no process", "%d", id)
+	return processListRow{}
+}
+
+func TestProcessListProgress(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	conn := openAccountDB(t, port, "root", "")
+	other := openAccountDB(t, port, "root", "")
+	execAll(t, conn,
+		"create database progress_db",
+		"use progress_db",
+		"create table small (a int)",
+		"insert into small values (1), (2), (3)")
+
+	// a table of 100 blocks of 1000 rows, too large to be sent to the client
+	// before it reads the rows
+	const rows, blockRows = 100000, 1000
+	schema := catalog.NewEmptySchema("big")
+	require.NoError(t, schema.AppendCol("s", types.T_varchar.ToType()))
+	require.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = blockRows
+	bat := catalog.MockData(schema, rows)
+	vs := &types.Bytes{}
+	value := strings.Repeat("x", 300)
+	for i := 0; i < rows; i++ {
+		vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+		vs.Lengths = append(vs.Lengths, uint32(len(value)))
+		vs.Data = append(vs.Data, value...)
+	}
+	vector.SetCol(bat.Vecs[0], vs)
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase("progress_db")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(bat))
+	require.NoError(t, txn.Commit())
+
+	ids := queryStrings(t, conn, "select connection_id()")
+	require.Len(t, ids, 1)
+	id, err := strconv.ParseUint(ids[0], 10, 64)
+	require.NoError(t, err)
+	r := showProcess(t, other, id)
+	require.Equal(t, commandSleep, r.command)
+	require.False(t, r.info.Valid)
+	require.False(t, r.progress.Valid)
+
+	// the progress of the scan increases as the client reads the rows
+	res, err := conn.Query("select s from big")
+	require.NoError(t, err)
+	read := 0
+	last := -1.0
+	var running []float64
+	for res.Next() {
+		read++
+		if read%10000 != 1 {
+			continue
+		}
+		r = showProcess(t, other, id)
+		if r.command != commandQuery {
+			break
+		}
+		require.Equal(t, "select s from big", r.info.String)
+		require.True(t, r.progress.Valid)
+		require.GreaterOrEqual(t, r.progress.Float64, last)
+		require.LessOrEqual(t, r.progress.Float64, 100.0)
+		require.GreaterOrEqual(t, r.rows, int64(read))
+		last = r.progress.Float64
+		running = append(running, last)
+	}
+	for res.Next() {
+		read++
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, rows, read)
+	require.NotEmpty(t, running)
+	require.Less(t, running[0], 100.0)
+	require.Greater(t, running[len(running)-1], running[0])
+
+	// the counters of the last statement are kept
+	r = showProcess(t, other, id)
+	require.Equal(t, commandSleep, r.command)
+	require.False(t, r.progress.Valid)
+	require.Equal(t, []string{"Table Scan|big|100000|100|100000|100.0000"}, queryRows(t, other, "show profile for process "+ids[0]))
+
+	// and reset by the next statement
+	require.Equal(t, []string{"3"}, queryStrings(t, conn, "select count(*) from small"))
+	require.Equal(t, []string{"Table Scan|small|3|1|3|100.0000"}, queryRows(t, other, "show profile for process "+ids[0]))
+
+	_, err = other.Query("show profile for process 1000000")
+	requireMysqlErrorCode(t, ER_NO_SUCH_THREAD, err)
+}
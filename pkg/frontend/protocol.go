@@ -246,11 +246,7 @@ func (mp *MysqlProtocolImpl) SendResponse(resp *Response) error {
 		if err == nil {
 			return mp.sendOKPacket(0, 0, uint16(resp.status), 0, "")
 		}
-		switch myerr := err.(type) {
-		case *MysqlError:
-			return mp.sendErrPacket(myerr.ErrorCode, myerr.SqlState, myerr.Error())
-		}
-		return mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, fmt.Sprintf("%v", err))
+		return mp.sendErrPacketOf(err)
 	case ResultResponse:
 		mer := resp.data.(*MysqlExecutionResult)
 		if mer == nil {
@@ -46,6 +46,9 @@ type Routine struct {
 	onceCloseNotifyChan sync.Once
 
 	routineMgr *RoutineManager
+
+	//the state of the connection listed by SHOW PROCESSLIST
+	info *processInfo
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -99,6 +102,7 @@ func (routine *Routine) Loop() {
 
 		if ses == nil {
 			ses = NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit(), gSysVariables)
+			ses.info = routine.info
 		}
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
@@ -117,6 +121,15 @@ func (routine *Routine) Loop() {
 			logutil.Infof("connection id %d , the time of handling the request %s", routine.getConnID(), time.Since(reqBegin).String())
 		}
 	}
+
+	if ses != nil {
+		if err = ses.rollbackOpenTxn(); err != nil {
+			logutil.Errorf("rollback the txn of connection %d failed. error:%v", routine.getConnID(), err)
+		}
+		if err = ses.dropTemporaryTables(); err != nil {
+			logutil.Errorf("drop the temporary tables of connection %d failed. error:%v", routine.getConnID(), err)
+		}
+	}
 }
 
 /*
@@ -152,6 +165,7 @@ func NewRoutine(protocol MysqlProtocol, executor CmdExecutor, pu *config.Paramet
 		notifyChan:  make(chan interface{}),
 		guestMmu:    guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu),
 		mempool:     pu.Mempool,
+		info:        newProcessInfo(uint64(protocol.ConnectionID())),
 	}
 
 	//async process request
@@ -31,6 +31,9 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//tls config of the server, nil when tls is not configured
+	tls *tlsManager
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -48,6 +51,11 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 
 	routine := NewRoutine(pro, exe, rm.pu)
 	routine.SetRoutineMgr(rm)
+	routine.info.setHost(rs.RemoteAddr())
+	if rm.tls != nil {
+		pro.tlsConfig = rm.tls.config()
+	}
+	pro.accounts = newAccountStore(rm.pu.StorageEngine)
 
 	hsV10pkt := pro.makeHandshakeV10Payload()
 	err := pro.writePackets(hsV10pkt)
@@ -151,6 +159,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 			logutil.Infof("RP[%v] Payload80[%v]",rs.RemoteAddr(),di)
 		*/
 
+		//the client asks for tls before sending the handshake response
+		if protocol.isSSLRequest(payload) {
+			return protocol.upgradeToTLS()
+		}
+
 		err := protocol.handleHandshake(payload)
 		if err != nil {
 			return err
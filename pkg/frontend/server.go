@@ -16,11 +16,16 @@ package frontend
 
 import (
 	"fmt"
+	"net"
+	"os"
+	"os/signal"
 	"sync/atomic"
+	"syscall"
 
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 //RelationName counter for the new connection
@@ -30,6 +35,11 @@ var initConnectionID uint32 = 1000
 type MOServer struct {
 	addr string
 	app  goetty.NetApplication
+	rm   *RoutineManager
+
+	// reloads the tls certificates on SIGHUP
+	sighup chan os.Signal
+	stop   chan struct{}
 }
 
 func (mo *MOServer) Start() error {
@@ -46,13 +56,46 @@ func (mo *MOServer) Start() error {
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
-	return mo.app.Start()
+	// the spill files left by the queries of a crashed server
+	if err := process.SweepSpillDirs(); err != nil {
+		logutil.Warnf("sweep the spill directories failed: %v", err)
+	}
+	if err := mo.app.Start(); err != nil {
+		return err
+	}
+	signal.Notify(mo.sighup, syscall.SIGHUP)
+	go mo.reloadOnSignal()
+	return nil
 }
 
 func (mo *MOServer) Stop() error {
+	signal.Stop(mo.sighup)
+	close(mo.stop)
 	return mo.app.Stop()
 }
 
+// ReloadTLSConfig loads the tls certificates named in the system variables
+// again. The new certificates are used by the connections established after
+// the reload, the existing connections are not affected.
+func (mo *MOServer) ReloadTLSConfig() error {
+	return mo.rm.tls.reload()
+}
+
+func (mo *MOServer) reloadOnSignal() {
+	for {
+		select {
+		case <-mo.sighup:
+			if err := mo.ReloadTLSConfig(); err != nil {
+				logutil.Errorf("reload tls config failed. error:%v", err)
+			} else {
+				logutil.Infof("tls config reloaded")
+			}
+		case <-mo.stop:
+			return
+		}
+	}
+}
+
 func nextConnectionID() uint32 {
 	return atomic.AddUint32(&initConnectionID, 1)
 }
@@ -60,8 +103,17 @@ func nextConnectionID() uint32 {
 func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl) *MOServer {
 	encoder, decoder := NewSqlCodec()
 	rm := NewRoutineManager(pu, pdHook)
+	tm, err := newTLSManager(pu.SV)
+	if err != nil {
+		logutil.Panicf("load tls config failed with %+v", err)
+	}
+	rm.tls = tm
+	listener, err := net.Listen("tcp4", addr)
+	if err != nil {
+		logutil.Panicf("start server failed with %+v", err)
+	}
 	// TODO asyncFlushBatch
-	app, err := goetty.NewTCPApplication(addr, rm.Handler,
+	app, err := goetty.NewApplication(&tlsListener{Listener: listener}, rm.Handler,
 		goetty.WithAppSessionOptions(
 			goetty.WithCodec(encoder, decoder),
 			goetty.WithLogger(logutil.GetGlobalLogger()),
@@ -72,7 +124,10 @@ func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl)
 	}
 
 	return &MOServer{
-		addr: addr,
-		app:  app,
+		addr:   addr,
+		app:    app,
+		rm:     rm,
+		sighup: make(chan os.Signal, 1),
+		stop:   make(chan struct{}),
 	}
 }
@@ -24,9 +24,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"strings"
 )
 
@@ -41,6 +43,9 @@ var (
 	errorTaeTxnInIllegalState         = goErrors.New("the txn is in the illegal state and needed to be cleaned before using further")
 )
 
+// planCacheCapacity is the number of the plans cached by a session
+const planCacheCapacity = 256
+
 const (
 	TxnInit       = iota // when the TxnState instance has just been created
 	TxnBegan             // when the txn has been started by the BEGIN statement
@@ -134,6 +139,11 @@ type TxnHandler struct {
 	storage  engine.Engine
 	taeTxn   moengine.Txn
 	txnState *TxnState
+	// schemaChanged is true if the txn executed any DDL
+	schemaChanged bool
+	// savepoint is the state of the txn started by BEGIN before the
+	// running statement
+	savepoint txnif.Savepoint
 }
 
 func InitTxnHandler(storage engine.Engine) *TxnHandler {
@@ -167,10 +177,17 @@ type Session struct {
 	ep *tree.ExportParam
 
 	closeRef      *CloseExportData
+	// into collects the result of SELECT ... INTO @var
+	into          *selectIntoResult
 	txnHandler    *TxnHandler
 	txnCompileCtx *TxnCompilerContext
 	storage       engine.Engine
+	planCache     *plan2.PlanCache
 	sql           string
+	// warnings, the warnings of the last statement, listed by SHOW WARNINGS
+	warnings []process.Warning
+	// info, the state of the connection listed by SHOW PROCESSLIST
+	info *processInfo
 
 	sysVars         map[string]interface{}
 	userDefinedVars map[string]interface{}
@@ -178,7 +195,13 @@ type Session struct {
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit, gSysVars *GlobalSystemVariables) *Session {
-	txnHandler := InitTxnHandler(config.StorageEngine)
+	//the engine of the parameter unit is used if the global one is not set
+	storage := config.StorageEngine
+	if storage == nil && PU != nil {
+		storage = PU.StorageEngine
+	}
+	storage = newSessionStorage(storage)
+	txnHandler := InitTxnHandler(storage)
 	ses := &Session{
 		protocol: proto,
 		pdHook:   pdHook,
@@ -193,10 +216,12 @@ func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempo
 		txnHandler: txnHandler,
 		//TODO:fix database name after the catalog is ready
 		txnCompileCtx:   InitTxnCompilerContext(txnHandler, proto.GetDatabaseName()),
-		storage:         config.StorageEngine,
+		storage:         storage,
+		planCache:       plan2.NewPlanCache(planCacheCapacity),
 		sysVars:         gSysVars.CopySysVarsToSession(),
 		userDefinedVars: make(map[string]interface{}),
 		gSysVars:        gSysVars,
+		info:            newProcessInfo(uint64(proto.ConnectionID())),
 	}
 	ses.txnCompileCtx.SetSession(ses)
 	return ses
@@ -303,7 +328,7 @@ func (ses *Session) GetSql() string {
 }
 
 func (ses *Session) IsTaeEngine() bool {
-	_, ok := ses.storage.(moengine.TxnEngine)
+	_, ok := txnEngineOf(ses.storage)
 	return ok
 }
 
@@ -311,6 +336,10 @@ func (ses *Session) GetStorage() engine.Engine {
 	return ses.storage
 }
 
+func (ses *Session) GetPlanCache() *plan2.PlanCache {
+	return ses.planCache
+}
+
 func (ses *Session) GetDatabaseName() string {
 	return ses.protocol.GetDatabaseName()
 }
@@ -324,6 +353,88 @@ func (ses *Session) GetUserName() string {
 	return ses.protocol.GetUserName()
 }
 
+// GetSessionInfo returns the session state visible to the functions
+// database(), user(), version(), connection_id() and mo_ctl()
+func (ses *Session) GetSessionInfo() process.SessionInfo {
+	info := process.SessionInfo{
+		User:                   ses.GetUserName(),
+		Database:               ses.GetDatabaseName(),
+		Version:                serverVersion + ses.Pu.SV.GetServerVersionSuffix(),
+		ConnectionID:           uint64(ses.protocol.ConnectionID()),
+		StrictMode:             ses.isStrictMode(),
+		ErrorForDivisionByZero: ses.isErrorForDivisionByZero(),
+		MaxCrossJoinRows:       ses.getMaxCrossJoinRows(),
+	}
+	if info.User == rootUserName {
+		info.Ctl = func(cmd, arg string) (string, error) {
+			return moCtl(ses.GetStorage(), ses.GetDatabaseName(), cmd, arg)
+		}
+	}
+	return info
+}
+
+// isAutocommit returns whether the statements out of the txn started by
+// BEGIN are committed automatically
+func (ses *Session) isAutocommit() bool {
+	val, err := ses.GetSessionVar("autocommit")
+	if err != nil {
+		return true
+	}
+	v, _ := val.(int8)
+	return v == 1
+}
+
+// getMaxCrossJoinRows returns the max estimated rows of a cross join, 0
+// is no limit
+func (ses *Session) getMaxCrossJoinRows() int64 {
+	val, err := ses.GetSessionVar("max_cross_join_rows")
+	if err != nil {
+		return 0
+	}
+	v, _ := val.(int64)
+	return v
+}
+
+// rollbackOpenTxn rolls back the txn left open when the client disconnects
+func (ses *Session) rollbackOpenTxn() error {
+	th := ses.GetTxnHandler()
+	if !th.isTxnState(TxnBegan) {
+		return nil
+	}
+	err := th.Rollback()
+	_ = th.CleanTxn()
+	return err
+}
+
+// isStrictMode returns whether the sql_mode of the session has
+// STRICT_TRANS_TABLES or STRICT_ALL_TABLES
+func (ses *Session) isStrictMode() bool {
+	return ses.hasSQLMode("STRICT_TRANS_TABLES", "STRICT_ALL_TABLES")
+}
+
+// isErrorForDivisionByZero returns whether the sql_mode of the session is
+// strict and has ERROR_FOR_DIVISION_BY_ZERO
+func (ses *Session) isErrorForDivisionByZero() bool {
+	return ses.isStrictMode() && ses.hasSQLMode("ERROR_FOR_DIVISION_BY_ZERO")
+}
+
+// hasSQLMode returns whether the sql_mode of the session has any of modes
+func (ses *Session) hasSQLMode(modes ...string) bool {
+	val, err := ses.GetSessionVar("sql_mode")
+	if err != nil {
+		return false
+	}
+	mode, _ := val.(string)
+	for _, m := range strings.Split(mode, ",") {
+		for _, want := range modes {
+			if strings.EqualFold(m, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (th *TxnHandler) GetStorage() engine.Engine {
 	return th.storage
 }
@@ -363,14 +474,14 @@ func (th *TxnHandler) IsInTaeTxn() bool {
 }
 
 func (th *TxnHandler) IsTaeEngine() bool {
-	_, ok := th.storage.(moengine.TxnEngine)
+	_, ok := txnEngineOf(th.storage)
 	return ok
 }
 
 func (th *TxnHandler) createTxn(beganErr, autocommitErr error) (moengine.Txn, error) {
 	var err error
 	var txn moengine.Txn
-	if taeEng, ok := th.storage.(moengine.TxnEngine); ok {
+	if taeEng, ok := txnEngineOf(th.storage); ok {
 		switch th.txnState.getState() {
 		case TxnInit, TxnEnd:
 			//begin a transaction
@@ -473,10 +584,40 @@ func (th *TxnHandler) commit(option int) error {
 		} else {
 			th.txnState.switchToState(TxnErr, err)
 		}
+		th.endSchemaChange()
 	}
 	return err
 }
 
+// setSchemaChanged records the txn executed a DDL
+func (th *TxnHandler) setSchemaChanged() {
+	th.schemaChanged = true
+}
+
+// changesSchema returns true if the plan is a DDL changing the schemas
+func changesSchema(p *plan2.Plan) bool {
+	ddl := p.GetDdl()
+	if ddl == nil {
+		return false
+	}
+	switch ddl.GetDdlType() {
+	case plan.DataDefinition_CREATE_DATABASE, plan.DataDefinition_DROP_DATABASE,
+		plan.DataDefinition_CREATE_TABLE, plan.DataDefinition_DROP_TABLE, plan.DataDefinition_ALTER_TABLE,
+		plan.DataDefinition_CREATE_INDEX, plan.DataDefinition_DROP_INDEX:
+		return true
+	}
+	return false
+}
+
+// endSchemaChange changes the schema versions when the txn of a DDL ends, so
+// the plans built from the schema seen by the other txns are not used anymore
+func (th *TxnHandler) endSchemaChange() {
+	if th.schemaChanged {
+		th.schemaChanged = false
+		engine.BumpSchemaEpoch()
+	}
+}
+
 // CommitAfterBegin commits the tae txn started by the BEGIN statement
 func (th *TxnHandler) CommitAfterBegin() error {
 	logutil.Infof("commit began")
@@ -537,6 +678,7 @@ func (th *TxnHandler) rollback(option int) error {
 		} else {
 			th.txnState.switchToState(TxnErr, err)
 		}
+		th.endSchemaChange()
 	}
 
 	return err
@@ -556,6 +698,34 @@ func (th *TxnHandler) RollbackAfterAutocommitOnly() error {
 	return err
 }
 
+// StartStatement records the savepoint of the txn started by BEGIN before a
+// statement runs
+func (th *TxnHandler) StartStatement() {
+	th.savepoint = nil
+	if th.isTxnState(TxnBegan) {
+		if txn, ok := th.taeTxn.(moengine.SavepointTxn); ok {
+			th.savepoint = txn.Savepoint()
+		}
+	}
+}
+
+// RollbackStatement undoes the writes of the failed statement, the txn
+// started by BEGIN stays active. The txn is rolled back if its writes can
+// not be undone.
+func (th *TxnHandler) RollbackStatement() error {
+	sp := th.savepoint
+	th.savepoint = nil
+	if sp == nil || !th.isTxnState(TxnBegan) {
+		return nil
+	}
+	err := th.taeTxn.(moengine.SavepointTxn).RollbackToSavepoint(sp)
+	if err != nil {
+		logutil.Errorf("rollback the failed statement. error:%v", err)
+		_ = th.Rollback()
+	}
+	return err
+}
+
 //CleanTxn just cleans the txn when the errors happen during the txn operations.
 // It does not commit any txn.
 func (th *TxnHandler) CleanTxn() error {
@@ -624,6 +794,20 @@ func (tcc *TxnCompilerContext) DatabaseExists(name string) bool {
 	return true
 }
 
+func (tcc *TxnCompilerContext) DatabaseDefaults(name string) engine.DatabaseDefaults {
+	if len(name) == 0 {
+		name = tcc.DefaultDatabase()
+	}
+	db, err := tcc.txnHandler.GetStorage().Database(name, tcc.txnHandler.GetTxn().GetCtx())
+	if err != nil {
+		return engine.DatabaseDefaults{}
+	}
+	if ddb, ok := db.(engine.DefaultsDatabase); ok {
+		return ddb.Defaults()
+	}
+	return engine.DatabaseDefaults{}
+}
+
 func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.ObjectRef, *plan2.TableDef) {
 	if len(dbName) == 0 {
 		dbName = tcc.DefaultDatabase()
@@ -658,7 +842,10 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 					Width:     attr.Attr.Type.Width,
 					Precision: attr.Attr.Type.Precision,
 				},
-				Primary: attr.Attr.Primary,
+				Primary:           attr.Attr.Primary,
+				Generated:         attr.Attr.Generated,
+				DefaultExpression: attr.Attr.DefaultExpression,
+				OnUpdate:          attr.Attr.OnUpdate,
 			})
 		}
 	}
@@ -685,9 +872,82 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 		Name: tableName,
 		Cols: defs,
 	}
+	partition, err := getPartitionDef(db, tableName, engineDefs, tcc.txnHandler.GetTxn().GetCtx())
+	if err != nil {
+		logutil.Errorf("get table %v partition error %v", tableName, err)
+		return nil, nil
+	}
+	var properties []*plan.Property
+	if partition != nil {
+		properties = append(properties, &plan.Property{
+			Key:   plan2.PartitionPropertyKey,
+			Value: partition.Marshal(),
+		})
+	}
+	if hasCommitTS(table, defs) {
+		properties = append(properties, &plan.Property{
+			Key:   plan2.CommitTSPropertyKey,
+			Value: "true",
+		})
+	}
+	if len(properties) > 0 {
+		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: properties,
+				},
+			},
+		})
+	}
 	return obj, tableDef
 }
 
+// hasCommitTS returns true if the rows of the relation can be read with the
+// commit ts pseudo column, which is shadowed by a column of the same name
+func hasCommitTS(table engine.Relation, defs []*plan2.ColDef) bool {
+	if _, ok := table.(engine.CommitTSRelation); !ok {
+		return false
+	}
+	for _, def := range defs {
+		if def.Name == engine.CommitTSColumnName {
+			return false
+		}
+	}
+	return true
+}
+
+// getPartitionDef returns the partition definition of a partitioned table.
+// The partitions whose relations were dropped are removed from it, so that
+// their ranges fall into the next partition
+func getPartitionDef(db engine.Database, tableName string, defs []engine.TableDef, snapshot engine.Snapshot) (*plan2.PartitionDef, error) {
+	for _, def := range defs {
+		properties, ok := def.(*engine.PropertiesDef)
+		if !ok {
+			continue
+		}
+		for _, property := range properties.Properties {
+			if property.Key != plan2.PartitionPropertyKey {
+				continue
+			}
+			partition, err := plan2.UnmarshalPartitionDef(property.Value)
+			if err != nil {
+				return nil, err
+			}
+			var dropped []string
+			for _, name := range partition.Names {
+				relation, err := db.Relation(plan2.PartitionTableName(tableName, name), snapshot)
+				if err != nil {
+					dropped = append(dropped, name)
+					continue
+				}
+				relation.Close(snapshot)
+			}
+			return partition.Drop(dropped), nil
+		}
+	}
+	return nil, nil
+}
+
 func (tcc *TxnCompilerContext) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
 	if isSystemVar {
 		if isGlobalVar {
@@ -786,6 +1046,53 @@ func (tcc *TxnCompilerContext) GetHideKeyDef(dbName string, tableName string) *p
 	return hideDef
 }
 
+// openRelation opens the table of the object, nil if it can not be opened
+func (tcc *TxnCompilerContext) openRelation(obj *plan2.ObjectRef) engine.Relation {
+	dbName := obj.SchemaName
+	if len(dbName) == 0 {
+		dbName = tcc.DefaultDatabase()
+	}
+	db, err := tcc.txnHandler.GetStorage().Database(dbName, tcc.txnHandler.GetTxn().GetCtx())
+	if err != nil {
+		logutil.Errorf("get database %v error %v", dbName, err)
+		return nil
+	}
+	relation, err := db.Relation(obj.ObjName, tcc.txnHandler.GetTxn().GetCtx())
+	if err != nil {
+		logutil.Errorf("get table %v error %v", obj.ObjName, err)
+		return nil
+	}
+	return relation
+}
+
 func (tcc *TxnCompilerContext) Cost(obj *plan2.ObjectRef, e *plan2.Expr) *plan2.Cost {
-	return &plan2.Cost{}
+	c := &plan2.Cost{}
+	if relation := tcc.openRelation(obj); relation != nil {
+		c.Card = float64(relation.Rows())
+	}
+	return c
+}
+
+func (tcc *TxnCompilerContext) ColumnNDV(obj *plan2.ObjectRef, colName string) float64 {
+	relation, ok := tcc.openRelation(obj).(engine.StatsRelation)
+	if !ok {
+		return 0
+	}
+	return float64(relation.CardinalNumber(colName))
+}
+
+func (tcc *TxnCompilerContext) AccessPaths(obj *plan2.ObjectRef, colName string, value any) []engine.PathEstimate {
+	relation, ok := tcc.openRelation(obj).(engine.PathRelation)
+	if !ok {
+		return nil
+	}
+	return relation.AccessPaths(colName, value)
+}
+
+func (tcc *TxnCompilerContext) RangeAccessPaths(obj *plan2.ObjectRef, colName string, min, max any) []engine.PathEstimate {
+	relation, ok := tcc.openRelation(obj).(engine.RangePathRelation)
+	if !ok {
+		return nil
+	}
+	return relation.RangeAccessPaths(colName, min, max)
 }
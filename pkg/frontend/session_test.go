@@ -607,4 +607,36 @@ func TestVariables(t *testing.T) {
 		newSes2 := genSession(ctrl, gSysVars)
 		checkWant(ses, existSes, newSes2, v1, v1_default, v1_default, v1_want, v1_want, v1_want, v1_want)
 	})
+
+	convey.Convey("sql_mode", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+
+		ses := genSession(ctrl, gSysVars)
+		convey.So(ses.isStrictMode(), convey.ShouldBeTrue)
+		convey.So(ses.isErrorForDivisionByZero(), convey.ShouldBeTrue)
+
+		err := ses.SetSessionVar("sql_mode", "ansi_quotes,no_zero_date")
+		convey.So(err, convey.ShouldBeNil)
+		mode, err := ses.GetSessionVar("sql_mode")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mode, convey.ShouldEqual, "ANSI_QUOTES,NO_ZERO_DATE")
+		convey.So(ses.isStrictMode(), convey.ShouldBeFalse)
+
+		err = ses.SetSessionVar("sql_mode", "strict_all_tables")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.isStrictMode(), convey.ShouldBeTrue)
+		convey.So(ses.isErrorForDivisionByZero(), convey.ShouldBeFalse)
+
+		// ERROR_FOR_DIVISION_BY_ZERO takes effect in the strict mode only
+		err = ses.SetSessionVar("sql_mode", "error_for_division_by_zero")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.isErrorForDivisionByZero(), convey.ShouldBeFalse)
+
+		err = ses.SetSessionVar("sql_mode", "no_such_mode")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
@@ -0,0 +1,114 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// queryRows returns the rows of query with the columns joined by '|',
+// NULL is shown as NULL
+func queryRows(t *testing.T, db *sql.DB, query string) []string {
+	rows, err := db.Query(query)
+	require.NoError(t, err, query)
+	defer rows.Close()
+	cols, err := rows.Columns()
+	require.NoError(t, err)
+	var rs []string
+	for rows.Next() {
+		vals := make([]sql.NullString, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		require.NoError(t, rows.Scan(ptrs...))
+		strs := make([]string, len(cols))
+		for i, v := range vals {
+			strs[i] = "NULL"
+			if v.Valid {
+				strs[i] = v.String
+			}
+		}
+		rs = append(rs, strings.Join(strs, "|"))
+	}
+	require.NoError(t, rows.Err())
+	return rs
+}
+
+func TestShowColumns(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database show_columns_db",
+		"use show_columns_db",
+		`create table t (
+			a int auto_increment,
+			b varchar(100) comment 'the b',
+			c decimal(20,5) default 1.5,
+			d datetime(6),
+			e bigint unsigned not null,
+			f bool,
+			g date default '2022-01-02',
+			h float,
+			i double,
+			j tinyint,
+			k smallint unsigned,
+			l decimal(10,2),
+			m time,
+			n datetime,
+			o varchar(10) collate utf8mb4_general_ci,
+			primary key (a, b))`,
+		"create table u (a int)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	columns := []string{
+		"a|int(11)|NO|PRI|NULL|auto_increment",
+		"b|varchar(100)|NO|PRI|NULL|",
+		"c|decimal(20,5)|YES||1.50000|",
+		"d|datetime(6)|YES||NULL|",
+		"e|bigint(20) unsigned|NO||NULL|",
+		"f|tinyint(1)|YES||NULL|",
+		"g|date|YES||2022-01-02|",
+		"h|float|YES||NULL|",
+		"i|double|YES||NULL|",
+		"j|tinyint(4)|YES||NULL|",
+		"k|smallint(5) unsigned|YES||NULL|",
+		"l|decimal(10,2)|YES||NULL|",
+		"m|time|YES||NULL|",
+		"n|datetime|YES||NULL|",
+		"o|varchar(10)|YES||NULL|",
+	}
+	require.Equal(t, columns, queryRows(t, db, "show columns from t"))
+	require.Equal(t, columns, queryRows(t, db, "describe t"))
+	require.Equal(t, columns, queryRows(t, db, "show columns from t from show_columns_db"))
+	require.Equal(t, columns[1:2], queryRows(t, db, "describe t b"))
+	require.Equal(t, columns[3:4], queryRows(t, db, "show columns from t like 'd%'"))
+
+	// the hidden key of a table without a primary key is not shown
+	require.Equal(t, []string{"a|int(11)|YES||NULL|"}, queryRows(t, db, "describe u"))
+
+	full := queryRows(t, db, "show full columns from t")
+	require.Len(t, full, len(columns))
+	require.Equal(t, "a|int(11)|NULL|NO|PRI|NULL|auto_increment||", full[0])
+	require.Equal(t, "b|varchar(100)|utf8mb4_bin|NO|PRI|NULL|||the b", full[1])
+	require.Equal(t, "o|varchar(10)|utf8mb4_general_ci|YES||NULL|||", full[14])
+}
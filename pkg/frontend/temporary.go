@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+// newSessionStorage returns the storage seen by a session, in which the
+// temporary tables of the session shadow the tables of the same names
+func newSessionStorage(eng engine.Engine) engine.Engine {
+	if eng == nil {
+		return nil
+	}
+	return engine.NewTemporaryEngine(eng)
+}
+
+// txnEngineOf returns the txn engine under the storage of a session
+func txnEngineOf(eng engine.Engine) (moengine.TxnEngine, bool) {
+	if tmp, ok := eng.(*engine.TemporaryEngine); ok {
+		eng = tmp.Engine
+	}
+	txnEngine, ok := eng.(moengine.TxnEngine)
+	return txnEngine, ok
+}
+
+// dropTemporaryTables drops the temporary tables of the session when the
+// connection is closed. The txn left open by the session is rolled back first.
+func (ses *Session) dropTemporaryTables() error {
+	tmp, ok := ses.storage.(*engine.TemporaryEngine)
+	if !ok || !tmp.HasTemporaryTables() {
+		return nil
+	}
+	if ses.txnHandler.IsInTaeTxn() {
+		if err := ses.txnHandler.Rollback(); err != nil {
+			logutil.Errorf("rollback the txn of the closed session failed. error:%v", err)
+		}
+	}
+	txnEngine, ok := txnEngineOf(tmp)
+	if !ok {
+		return tmp.DropTemporaryTables(0, nil)
+	}
+	txn, err := txnEngine.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	if err = tmp.DropTemporaryTables(0, txn.GetCtx()); err != nil {
+		if err2 := txn.Rollback(); err2 != nil {
+			logutil.Errorf("txn rollback failed. error:%v", err2)
+		}
+		return err
+	}
+	return txn.Commit()
+}
+
+// SweepTemporaryTables drops the temporary tables left by the sessions before
+// a restart, whose connections were not closed normally
+func SweepTemporaryTables(eng engine.Engine) error {
+	txnEngine, ok := eng.(moengine.TxnEngine)
+	if !ok {
+		return errorIsNotTaeEngine
+	}
+	txn, err := txnEngine.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	if err = engine.DropTemporaryTables(eng, 0, txn.GetCtx()); err != nil {
+		if err2 := txn.Rollback(); err2 != nil {
+			logutil.Errorf("txn rollback failed. error:%v", err2)
+		}
+		return err
+	}
+	return txn.Commit()
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/stretchr/testify/require"
+)
+
+// relationsOf returns the relations of the database in the storage
+func relationsOf(t *testing.T, eng moengine.TxnEngine, dbName string) []string {
+	txn, err := eng.StartTxn(nil)
+	require.NoError(t, err)
+	defer func() { _ = txn.Rollback() }()
+	database, err := eng.Database(dbName, txn.GetCtx())
+	require.NoError(t, err)
+	return database.Relations(txn.GetCtx())
+}
+
+func hasTemporaryRelation(names []string) bool {
+	for _, name := range names {
+		if engine.IsTemporaryTableName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTemporaryTable(t *testing.T) {
+	tae, err := db.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, port := startTestServerOnTae(t, tae)
+	eng := moengine.NewEngine(tae)
+
+	root := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database tmp_db",
+		"use tmp_db",
+		"create table t (a int)",
+		"insert into t values (1), (2), (3)",
+	} {
+		_, err := root.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the two sessions create the temporary tables of the same name, which
+	// shadow the table t
+	s1 := openAccountDB(t, port, "root", "")
+	s2 := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"use tmp_db",
+		"create temporary table t (a int, b varchar(10))",
+	} {
+		_, err := s1.Exec(stmt)
+		require.NoError(t, err, stmt)
+		_, err = s2.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	_, err = s1.Exec("create temporary table t (a int)")
+	require.Error(t, err)
+	_, err = s1.Exec("create temporary table if not exists t (a int)")
+	require.NoError(t, err)
+
+	for _, stmt := range []string{
+		"insert into t values (10, 'x')",
+		"insert into t values (11, 'y'), (12, 'z')",
+	} {
+		_, err := s1.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	for _, stmt := range []string{
+		"insert into t values (20, 'x'), (21, 'y')",
+		"update t set b = 'w' where a = 21",
+		"delete from t where a = 20",
+	} {
+		_, err := s2.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.Equal(t, []string{"10", "11", "12"}, queryStrings(t, s1, "select a from t order by a"))
+	require.Equal(t, []string{"w"}, queryStrings(t, s2, "select b from t"))
+	require.Equal(t, []string{"1", "2", "3"}, queryStrings(t, root, "select a from t order by a"))
+	require.Equal(t, []string{"12"}, queryStrings(t, s1, "select max(a) from t where b <> 'x'"))
+
+	// the relations of the temporary tables are not listed
+	require.Equal(t, []string{"t"}, queryStrings(t, root, "show tables"))
+	require.Equal(t, []string{"t"}, queryStrings(t, s1, "show tables"))
+
+	// the table t is seen again after the temporary table is dropped
+	_, err = s1.Exec("drop table t")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3"}, queryStrings(t, s1, "select a from t order by a"))
+	require.Equal(t, []string{"21"}, queryStrings(t, s2, "select a from t"))
+
+	// the temporary tables are dropped when the connection is closed
+	require.True(t, hasTemporaryRelation(relationsOf(t, eng, "tmp_db")))
+	require.NoError(t, s2.Close())
+	require.Eventually(t, func() bool {
+		return !hasTemporaryRelation(relationsOf(t, eng, "tmp_db"))
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, []string{"t"}, relationsOf(t, eng, "tmp_db"))
+}
+
+func TestSweepTemporaryTables(t *testing.T) {
+	dir := t.TempDir()
+	tae, err := db.Open(dir, nil)
+	require.NoError(t, err)
+	eng := moengine.NewEngine(tae)
+	require.NoError(t, InitDB(eng))
+
+	// the temporary table is left as if the server crashed
+	txn, err := eng.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, eng.Create(0, "tmp_db", 0, txn.GetCtx()))
+	database, err := engine.NewTemporaryEngine(eng).Database("tmp_db", txn.GetCtx())
+	require.NoError(t, err)
+	defs, err := moengine.SchemaToDefs(catalog.MockSchema(2, 0))
+	require.NoError(t, err)
+	require.NoError(t, database.(engine.TemporaryDatabase).CreateTemporary(0, "t", defs, txn.GetCtx()))
+	require.NoError(t, database.Create(0, "u", defs, txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+	require.NoError(t, tae.Close())
+
+	tae, err = db.Open(dir, nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	eng = moengine.NewEngine(tae)
+	require.True(t, hasTemporaryRelation(relationsOf(t, eng, "tmp_db")))
+	require.NoError(t, SweepTemporaryTables(eng))
+	require.Equal(t, []string{"u"}, relationsOf(t, eng, "tmp_db"))
+}
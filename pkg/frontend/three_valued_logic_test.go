@@ -0,0 +1,59 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreeValuedLogic(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database logic_db",
+		"use logic_db",
+		"create table t (id int, a int, b int)",
+		"insert into t values (1, 1, 1), (2, 9, 1), (3, null, 1), (4, null, null), (5, 1, null), (6, 9, null)",
+	)
+
+	// a row passes a filter only if its condition is true, not null
+	for query, want := range map[string][]string{
+		"select id from t where not (a > 5 or b is null)":    {"1"},
+		"select id from t where a > 5 or b is null":          {"2", "4", "5", "6"},
+		"select id from t where a > 5 and b is not null":     {"2"},
+		"select id from t where not (a > 5 and b > 0)":       {"1", "5"},
+		"select id from t where a > 5 xor b > 0":             {"1"},
+		"select id from t where a > 5 or null":               {"2", "6"},
+		"select id from t where not (a < 5 and null)":        {"2", "6"},
+		"select id from t where null":                        nil,
+		"select id from t where not null":                    nil,
+		"select id from t where a is null and b is null":     {"4"},
+		"select id from t where not (a is null or b > 5)":    {"1", "2"},
+		"select id from t where (a > 5 or b > 0) and id > 1": {"2", "3", "6"},
+	} {
+		require.Equal(t, want, queryRows(t, db, query), query)
+	}
+
+	require.Equal(t, []string{
+		"1|false|true|false",
+		"2|true|false|true",
+		"3|NULL|NULL|NULL",
+		"4|true|NULL|NULL",
+		"5|true|true|false",
+		"6|true|false|NULL",
+	}, queryRows(t, db, "select id, a > 5 or b is null, not (a > 5), a > 5 and b > 0 from t"))
+}
@@ -0,0 +1,160 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeType(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database time_db",
+		"use time_db",
+		"create table t (a int, b time, c time(3))",
+		"insert into t values (1, '12:34:56', '12:34:56.1234')",
+		"insert into t values (2, '-01:00:00', '-00:00:00.5')",
+		"insert into t values (3, '838:59:59', '-838:59:59')",
+		"insert into t values (4, 102030, -102030)",
+		"insert into t values (5, '1 02:00:00', '00:00:00')",
+		"insert into t values (6, null, null)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	t.Run("insert", func(t *testing.T) {
+		for _, stmt := range []string{
+			"insert into t values (7, '839:00:00', null)",
+			"insert into t values (7, '-838:59:59.5', null)",
+			"insert into t values (7, '12:60:00', null)",
+			"insert into t values (7, 1060, null)",
+			"create table u (a time(7))",
+		} {
+			_, err := db.Exec(stmt)
+			require.Error(t, err, stmt)
+		}
+	})
+
+	t.Run("select", func(t *testing.T) {
+		require.Equal(t, []string{"12:34:56", "-01:00:00", "838:59:59", "10:20:30", "26:00:00"},
+			queryStrings(t, db, "select b from t where a < 6 order by a"))
+		require.Equal(t, []string{"12:34:56.123", "-00:00:00.500", "-838:59:59.000", "-10:20:30.000", "00:00:00.000"},
+			queryStrings(t, db, "select c from t where a < 6 order by a"))
+	})
+
+	t.Run("order by", func(t *testing.T) {
+		require.Equal(t, []string{"-01:00:00", "10:20:30", "12:34:56", "26:00:00", "838:59:59"},
+			queryStrings(t, db, "select b from t where a < 6 order by b"))
+		require.Equal(t, []string{"12:34:56.123", "00:00:00.000", "-00:00:00.500", "-10:20:30.000", "-838:59:59.000"},
+			queryStrings(t, db, "select c from t where a < 6 order by c desc"))
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		require.Equal(t, []string{"2"}, queryStrings(t, db, "select a from t where b < '00:00:00'"))
+		require.Equal(t, []string{"-00:00:00.500"}, queryStrings(t, db, "select c from t where c = '-00:00:00.5'"))
+		require.ElementsMatch(t, []string{"1", "3", "5"}, queryStrings(t, db, "select a from t where b > '12:00:00'"))
+		require.ElementsMatch(t, []string{"1", "2"}, queryStrings(t, db, "select a from t where c > b"))
+	})
+
+	t.Run("cast", func(t *testing.T) {
+		require.Equal(t, []string{"-00:00:00.500"}, queryStrings(t, db, "select cast(cast(c as char(20)) as time(3)) from t where a = 2"))
+		require.Equal(t, []string{"-838:59:59"}, queryStrings(t, db, "select cast('-838:59:59' as time)"))
+		require.Equal(t, []string{"13:14:15.000006"}, queryStrings(t, db, "select cast(cast('2022-01-02 13:14:15.000006' as datetime) as time(6))"))
+		rows, err := db.Query("select cast('900:00:00' as time)")
+		if err == nil {
+			// the error of the cast comes with the rows
+			for rows.Next() {
+			}
+			err = rows.Err()
+			rows.Close()
+		}
+		require.Error(t, err)
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		require.Equal(t, []string{"00:00:00"}, queryStrings(t, db, "select b + interval 1 hour from t where a = 2"))
+		require.Equal(t, []string{"-01:00:30"}, queryStrings(t, db, "select b - interval 30 second from t where a = 2"))
+		require.Equal(t, []string{"12:34:56.623"}, queryStrings(t, db, "select c + interval 500000 microsecond from t where a = 1"))
+		// the results out of the range of time are null
+		rows, err := db.Query("select b + interval 1 second from t where a = 3")
+		require.NoError(t, err)
+		defer rows.Close()
+		require.True(t, rows.Next())
+		var b *string
+		require.NoError(t, rows.Scan(&b))
+		require.Nil(t, b)
+	})
+}
+
+func TestDatetimeSub(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database datetime_sub_db",
+		"use datetime_sub_db",
+		"create table t (a int, x datetime(3), y datetime, d date, ts timestamp)",
+		"insert into t values (1, '2022-01-02 10:00:00.5', '2022-01-02 08:30:00', '2022-01-01', '2022-01-02 08:30:00')",
+		"insert into t values (2, '2022-01-02 00:00:00', '2022-01-03 00:00:00', '2022-01-03', '2022-01-01 00:00:00')",
+		"create table u (b int, z datetime)",
+		"insert into u values (1, '2022-01-02 09:00:00')",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the operands are swapped for the negative durations
+	require.Equal(t, []string{"01:30:00.500", "-24:00:00.000"}, queryStrings(t, db, "select x - y from t order by a"))
+	require.Equal(t, []string{"-01:30:00.500", "24:00:00.000"}, queryStrings(t, db, "select y - x from t order by a"))
+	require.Equal(t, []string{"-00:30:00"}, queryStrings(t, db, "select y - cast('2022-01-02 09:00:00' as datetime) from t where a = 1"))
+
+	// the dates and the timestamps are taken as datetimes
+	require.Equal(t, []string{"34:00:00.500", "-24:00:00.000"}, queryStrings(t, db, "select x - d from t order by a"))
+	require.Equal(t, []string{"-32:30:00", "00:00:00"}, queryStrings(t, db, "select d - y from t order by a"))
+	require.Equal(t, []string{"-24:00:00"}, queryStrings(t, db, "select d - date '2022-01-02' from t where a = 1"))
+	require.Equal(t, []string{"00:00:00.000000", "48:00:00.000000"}, queryStrings(t, db, "select y - ts from t order by a"))
+	require.Equal(t, []string{"32:30:00.000000", "-48:00:00.000000"}, queryStrings(t, db, "select ts - d from t order by a"))
+
+	// the durations of the rows without a match are null
+	require.Equal(t, []string{"1|-00:30:00", "2|NULL"}, queryRows(t, db, "select a, y - z from t left join u on a = b order by a"))
+
+	// the durations compare with intervals
+	require.Equal(t, []string{"1"}, queryStrings(t, db, "select a from t where x - y > interval 1 hour"))
+	require.Equal(t, []string{"2"}, queryStrings(t, db, "select a from t where x - y <= interval -1 day"))
+	require.Equal(t, []string{"2"}, queryStrings(t, db, "select a from t where interval 1 day = y - x"))
+
+	for _, query := range []string{
+		// out of the range of time
+		"select x - date '2021-11-01' from t",
+		// a number is subtracted by date_sub
+		"select d - 1 from t",
+		"select x - 1 from t",
+		// an interval longer than a week is not a time
+		"select a from t where x - y > interval 1 month",
+	} {
+		rows, err := db.Query(query)
+		if err == nil {
+			// the error of the subtraction comes with the rows
+			for rows.Next() {
+			}
+			err = rows.Err()
+			rows.Close()
+		}
+		require.Error(t, err, query)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+// tlsHandshakeTimeout bounds the tls handshake after a SSLRequest
+const tlsHandshakeTimeout = 10 * time.Second
+
+// tlsManager holds the tls config of the server. The certificates are loaded
+// from the files named in the system variables and can be reloaded at any
+// time. A reload only affects the handshakes that start after it, the
+// established connections keep their tls sessions.
+type tlsManager struct {
+	sv *config.SystemVariables
+	// *tls.Config, nil when tls is disabled
+	cfg atomic.Value
+}
+
+func newTLSManager(sv *config.SystemVariables) (*tlsManager, error) {
+	tm := &tlsManager{sv: sv}
+	if err := tm.reload(); err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// config returns the current tls config, nil means tls is disabled
+func (tm *tlsManager) config() *tls.Config {
+	cfg, _ := tm.cfg.Load().(*tls.Config)
+	return cfg
+}
+
+// reload loads the certificates again. The current config is kept if the
+// new one can not be loaded.
+func (tm *tlsManager) reload() error {
+	cfg, err := loadTLSConfig(tm.sv)
+	if err != nil {
+		return err
+	}
+	tm.cfg.Store(cfg)
+	return nil
+}
+
+func loadTLSConfig(sv *config.SystemVariables) (*tls.Config, error) {
+	certFile, keyFile := sv.GetTlsCertFile(), sv.GetTlsKeyFile()
+	if len(certFile) == 0 && len(keyFile) == 0 {
+		return nil, nil
+	}
+	if len(certFile) == 0 || len(keyFile) == 0 {
+		return nil, fmt.Errorf("both tlsCertFile and tlsKeyFile are needed to enable tls")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load the server certificate failed. error:%v", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if caFile := sv.GetTlsCaFile(); len(caFile) != 0 {
+		data, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read the ca file failed. error:%v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate is found in the ca file %s", caFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if sv.GetTlsVerifyClientCert() {
+		if cfg.ClientCAs == nil {
+			return nil, fmt.Errorf("tlsCaFile is needed to verify the client certificates")
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// tlsListener wraps the accepted connections so that they can be
+// upgraded to tls in the middle of the handshake
+type tlsListener struct {
+	net.Listener
+}
+
+func (l *tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &upgradableConn{conn: conn}, nil
+}
+
+// upgradableConn is a connection that starts as plain tcp and is
+// switched to tls after the client sends a SSLRequest
+type upgradableConn struct {
+	sync.RWMutex
+	conn net.Conn
+}
+
+func (c *upgradableConn) current() net.Conn {
+	c.RLock()
+	defer c.RUnlock()
+	return c.conn
+}
+
+// upgrade runs the server side tls handshake. The buffered bytes were read
+// from the connection after the SSLRequest and belong to the handshake.
+func (c *upgradableConn) upgrade(cfg *tls.Config, buffered []byte) error {
+	raw := c.current()
+	if _, ok := raw.(*tls.Conn); ok {
+		return fmt.Errorf("the connection is already using tls")
+	}
+	conn := tls.Server(&prefixConn{Conn: raw, prefix: buffered}, cfg)
+	if err := raw.SetDeadline(time.Now().Add(tlsHandshakeTimeout)); err != nil {
+		return err
+	}
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+	if err := raw.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.conn = conn
+	return nil
+}
+
+func (c *upgradableConn) Read(b []byte) (int, error) {
+	return c.current().Read(b)
+}
+
+func (c *upgradableConn) Write(b []byte) (int, error) {
+	return c.current().Write(b)
+}
+
+func (c *upgradableConn) Close() error {
+	return c.current().Close()
+}
+
+func (c *upgradableConn) LocalAddr() net.Addr {
+	return c.current().LocalAddr()
+}
+
+func (c *upgradableConn) RemoteAddr() net.Addr {
+	return c.current().RemoteAddr()
+}
+
+func (c *upgradableConn) SetDeadline(t time.Time) error {
+	return c.current().SetDeadline(t)
+}
+
+func (c *upgradableConn) SetReadDeadline(t time.Time) error {
+	return c.current().SetReadDeadline(t)
+}
+
+func (c *upgradableConn) SetWriteDeadline(t time.Time) error {
+	return c.current().SetWriteDeadline(t)
+}
+
+// prefixConn returns the prefix before reading from the connection
+type prefixConn struct {
+	net.Conn
+	prefix []byte
+}
+
+func (c *prefixConn) Read(b []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(b, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
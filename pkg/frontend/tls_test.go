@@ -0,0 +1,316 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+// newTestCert writes a certificate signed by parent, or a self-signed ca
+// when parent is nil, into dir
+func newTestCert(t *testing.T, dir, name string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	tc := &testCert{
+		cert:     cert,
+		key:      key,
+		certFile: filepath.Join(dir, name+".pem"),
+		keyFile:  filepath.Join(dir, name+"-key.pem"),
+	}
+	require.NoError(t, os.WriteFile(tc.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(tc.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return tc
+}
+
+func (tc *testCert) keyPair(t *testing.T) tls.Certificate {
+	pair, err := tls.LoadX509KeyPair(tc.certFile, tc.keyFile)
+	require.NoError(t, err)
+	return pair
+}
+
+// rewrite copies the files of other over the files of tc
+func (tc *testCert) rewrite(t *testing.T, other *testCert) {
+	for src, dst := range map[string]string{other.certFile: tc.certFile, other.keyFile: tc.keyFile} {
+		data, err := os.ReadFile(src)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(dst, data, 0600))
+	}
+}
+
+func startTLSTestServer(t *testing.T, setup func(sv *config.SystemVariables)) (*MOServer, int) {
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+	setup(pu.SV)
+	sv := NewMOServer(fmt.Sprintf("127.0.0.1:%d", port), pu, getPCI())
+	require.NoError(t, sv.Start())
+	return sv, port
+}
+
+// openTLSDB opens a db using the tls config registered as tlsName,
+// an empty tlsName means a plain connection
+func openTLSDB(t *testing.T, port int, tlsName string) *sql.DB {
+	dsn := fmt.Sprintf("dump:111@tcp(127.0.0.1:%d)/?timeout=10s&readTimeout=10s&writeTimeout=10s", port)
+	if len(tlsName) != 0 {
+		dsn += "&tls=" + tlsName
+	}
+	db, err := sql.Open("mysql", dsn)
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	return db
+}
+
+func registerTestTLSConfig(t *testing.T, name string, ca *testCert, clientCert *testCert) {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	cfg := &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"}
+	if clientCert != nil {
+		cfg.Certificates = []tls.Certificate{clientCert.keyPair(t)}
+	}
+	require.NoError(t, mysql.RegisterTLSConfig(name, cfg))
+	t.Cleanup(func() { mysql.DeregisterTLSConfig(name) })
+}
+
+// connectionsUseTLS reports whether all the established connections of
+// the server use tls
+func connectionsUseTLS(sv *MOServer) bool {
+	sv.rm.rwlock.RLock()
+	defer sv.rm.rwlock.RUnlock()
+	for _, rt := range sv.rm.clients {
+		if !rt.protocol.(*MysqlProtocolImpl).isTLS {
+			return false
+		}
+	}
+	return true
+}
+
+func connectionIDs(sv *MOServer) []uint32 {
+	sv.rm.rwlock.RLock()
+	defer sv.rm.rwlock.RUnlock()
+	var ids []uint32
+	for _, rt := range sv.rm.clients {
+		ids = append(ids, rt.getConnID())
+	}
+	return ids
+}
+
+func TestTLSHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil)
+	server := newTestCert(t, dir, "server", ca)
+	sv, port := startTLSTestServer(t, func(sv *config.SystemVariables) {
+		require.NoError(t, sv.SetTlsCertFile(server.certFile))
+		require.NoError(t, sv.SetTlsKeyFile(server.keyFile))
+	})
+	defer sv.Stop()
+	registerTestTLSConfig(t, "handshake", ca, nil)
+
+	db := openTLSDB(t, port, "handshake")
+	require.NoError(t, db.Ping())
+	require.True(t, connectionsUseTLS(sv))
+	require.NoError(t, db.Close())
+
+	// plain connections are still accepted
+	db = openTLSDB(t, port, "")
+	require.NoError(t, db.Ping())
+	require.False(t, connectionsUseTLS(sv))
+	require.NoError(t, db.Close())
+
+	// the server is not trusted by the client
+	other := newTestCert(t, dir, "other", nil)
+	registerTestTLSConfig(t, "untrusted", other, nil)
+	db = openTLSDB(t, port, "untrusted")
+	require.Error(t, db.Ping())
+	require.NoError(t, db.Close())
+}
+
+func TestTLSNotEnabled(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil)
+	sv, port := startTLSTestServer(t, func(*config.SystemVariables) {})
+	defer sv.Stop()
+	registerTestTLSConfig(t, "disabled", ca, nil)
+
+	// the driver refuses tls when the server does not announce CLIENT_SSL
+	db := openTLSDB(t, port, "disabled")
+	require.Error(t, db.Ping())
+	require.NoError(t, db.Close())
+
+	db = openTLSDB(t, port, "")
+	require.NoError(t, db.Ping())
+	require.NoError(t, db.Close())
+}
+
+func TestRequireSecureTransport(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil)
+	server := newTestCert(t, dir, "server", ca)
+	sv, port := startTLSTestServer(t, func(sv *config.SystemVariables) {
+		require.NoError(t, sv.SetTlsCertFile(server.certFile))
+		require.NoError(t, sv.SetTlsKeyFile(server.keyFile))
+		require.NoError(t, sv.SetRequireSecureTransport(true))
+	})
+	defer sv.Stop()
+	registerTestTLSConfig(t, "required", ca, nil)
+
+	db := openTLSDB(t, port, "")
+	err := db.Ping()
+	require.Error(t, err)
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	require.True(t, ok, "%v", err)
+	require.Equal(t, ER_SECURE_TRANSPORT_REQUIRED, mysqlErr.Number)
+	require.NoError(t, db.Close())
+
+	db = openTLSDB(t, port, "required")
+	require.NoError(t, db.Ping())
+	require.NoError(t, db.Close())
+}
+
+func TestTLSVerifyClientCert(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil)
+	server := newTestCert(t, dir, "server", ca)
+	client := newTestCert(t, dir, "client", ca)
+	sv, port := startTLSTestServer(t, func(sv *config.SystemVariables) {
+		require.NoError(t, sv.SetTlsCertFile(server.certFile))
+		require.NoError(t, sv.SetTlsKeyFile(server.keyFile))
+		require.NoError(t, sv.SetTlsCaFile(ca.certFile))
+		require.NoError(t, sv.SetTlsVerifyClientCert(true))
+	})
+	defer sv.Stop()
+	registerTestTLSConfig(t, "nocert", ca, nil)
+	registerTestTLSConfig(t, "withcert", ca, client)
+
+	db := openTLSDB(t, port, "nocert")
+	require.Error(t, db.Ping())
+	require.NoError(t, db.Close())
+
+	db = openTLSDB(t, port, "withcert")
+	require.NoError(t, db.Ping())
+	require.True(t, connectionsUseTLS(sv))
+	require.NoError(t, db.Close())
+
+	// a client certificate signed by an unknown ca
+	otherCA := newTestCert(t, dir, "otherca", nil)
+	stranger := newTestCert(t, dir, "stranger", otherCA)
+	registerTestTLSConfig(t, "stranger", ca, stranger)
+	db = openTLSDB(t, port, "stranger")
+	require.Error(t, db.Ping())
+	require.NoError(t, db.Close())
+}
+
+func TestTLSCertReload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil)
+	server := newTestCert(t, dir, "server", ca)
+	sv, port := startTLSTestServer(t, func(sv *config.SystemVariables) {
+		require.NoError(t, sv.SetTlsCertFile(server.certFile))
+		require.NoError(t, sv.SetTlsKeyFile(server.keyFile))
+	})
+	defer sv.Stop()
+	registerTestTLSConfig(t, "before", ca, nil)
+
+	// the connection opened before the rotation
+	kept := openTLSDB(t, port, "before")
+	defer kept.Close()
+	require.NoError(t, kept.Ping())
+	ids := connectionIDs(sv)
+	require.Equal(t, 1, len(ids))
+	keptID := ids[0]
+
+	// rotate the server certificate to another ca and notify the server
+	newCA := newTestCert(t, dir, "newca", nil)
+	server.rewrite(t, newTestCert(t, dir, "newserver", newCA))
+	registerTestTLSConfig(t, "after", newCA, nil)
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+	require.Eventually(t, func() bool {
+		db := openTLSDB(t, port, "after")
+		defer db.Close()
+		return db.Ping() == nil
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// new connections trusting the old ca fail
+	db := openTLSDB(t, port, "before")
+	require.Error(t, db.Ping())
+	require.NoError(t, db.Close())
+
+	// the existing connection is kept
+	require.NoError(t, kept.Ping())
+	require.Contains(t, connectionIDs(sv), keptID)
+
+	// a broken config keeps the current certificates
+	require.NoError(t, sv.rm.pu.SV.SetTlsKeyFile(filepath.Join(dir, "missing.pem")))
+	require.Error(t, sv.ReloadTLSConfig())
+	db = openTLSDB(t, port, "after")
+	require.NoError(t, db.Ping())
+	require.NoError(t, db.Close())
+}
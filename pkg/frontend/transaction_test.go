@@ -0,0 +1,93 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionStatements(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db1 := openAccountDB(t, port, "root", "")
+	db2 := openAccountDB(t, port, "root", "")
+	execAll(t, db1,
+		"create database txn_db",
+		"use txn_db",
+		"create table t (a int primary key, b int)",
+		"create table p (a int primary key) partition by range (a) (partition p0 values less than (10), partition p1 values less than maxvalue)")
+	execAll(t, db2, "use txn_db")
+
+	t.Run("rollback", func(t *testing.T) {
+		execAll(t, db1, "begin", "insert into t values (1, 1)", "insert into t values (2, 2)")
+		require.Equal(t, []string{"1", "2"}, queryStrings(t, db1, "select a from t order by a"))
+		require.Equal(t, []string{"0"}, queryStrings(t, db2, "select count(*) from t"))
+		execAll(t, db1, "rollback")
+		require.Equal(t, []string{"0"}, queryStrings(t, db1, "select count(*) from t"))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		execAll(t, db1, "start transaction")
+		require.Equal(t, []string{"0"}, queryStrings(t, db1, "select count(*) from t"))
+		execAll(t, db2, "insert into t values (10, 10)")
+		// the statements of the txn read from its start snapshot
+		require.Equal(t, []string{"0"}, queryStrings(t, db1, "select count(*) from t"))
+		execAll(t, db1, "commit")
+		require.Equal(t, []string{"1"}, queryStrings(t, db1, "select count(*) from t"))
+	})
+
+	t.Run("failed statement", func(t *testing.T) {
+		execAll(t, db1, "insert into p values (20)", "begin", "insert into p values (1)")
+		// the row of p0 is written before p1 fails on the duplicate key
+		_, err := db1.Exec("insert into p values (2), (20)")
+		require.Error(t, err)
+		require.Equal(t, []string{"1", "20"}, queryStrings(t, db1, "select a from p order by a"))
+		execAll(t, db1, "insert into p values (2)", "commit")
+		require.Equal(t, []string{"1", "2", "20"}, queryStrings(t, db2, "select a from p order by a"))
+	})
+
+	t.Run("autocommit off", func(t *testing.T) {
+		require.Equal(t, []string{"1"}, queryStrings(t, db1, "select @@autocommit"))
+		execAll(t, db1, "set autocommit = 0", "insert into t values (3, 3)")
+		require.Equal(t, []string{"0"}, queryStrings(t, db1, "select @@autocommit"))
+		require.Equal(t, []string{"0"}, queryStrings(t, db2, "select count(*) from t where a = 3"))
+		execAll(t, db1, "rollback", "insert into t values (4, 4)", "commit", "insert into t values (5, 5)")
+		require.Equal(t, []string{"4", "10"}, queryStrings(t, db2, "select a from t order by a"))
+		// enabling autocommit commits the active txn
+		execAll(t, db1, "set autocommit = 1")
+		require.Equal(t, []string{"4", "5", "10"}, queryStrings(t, db2, "select a from t order by a"))
+	})
+
+	t.Run("implicit commit", func(t *testing.T) {
+		execAll(t, db1, "begin", "insert into t values (6, 6)", "create table t2 (a int)")
+		require.Equal(t, []string{"4", "5", "6", "10"}, queryStrings(t, db2, "select a from t order by a"))
+		// the rollback has no txn to end
+		execAll(t, db1, "rollback")
+		require.Equal(t, []string{"4", "5", "6", "10"}, queryStrings(t, db1, "select a from t order by a"))
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		db3 := openAccountDB(t, port, "root", "")
+		execAll(t, db3, "use txn_db", "begin", "insert into t values (7, 7)")
+		require.Equal(t, []string{"4"}, queryStrings(t, db3, "select b from t where a = 4 for update"))
+		done := execAsync(db2, "update t set b = 40 where a = 4")
+		requireBlocked(t, done)
+		// the open txn is rolled back and its row lock is released
+		require.NoError(t, db3.Close())
+		require.NoError(t, <-done)
+		require.Equal(t, []string{"4", "5", "6", "10"}, queryStrings(t, db2, "select a from t order by a"))
+	})
+}
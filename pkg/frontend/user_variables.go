@@ -0,0 +1,217 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Like mysql, the value of a user defined variable is an int64 or uint64,
+// a float64, a plan2.DecimalVar, a string or nil. The values of the other
+// types are kept as their strings.
+
+// selectIntoResult collects the result of SELECT ... INTO @var. Only the
+// first row is kept, since the statement fails on more rows.
+type selectIntoResult struct {
+	sync.Mutex
+	rows int64
+	row  []interface{}
+}
+
+// getDataIntoVariables is the fill function of SELECT ... INTO @var, it
+// collects the rows into the selectIntoResult of the session.
+func getDataIntoVariables(obj interface{}, bat *batch.Batch) error {
+	ses := obj.(*Session)
+	if bat == nil || len(bat.Vecs) == 0 {
+		return nil
+	}
+
+	into := ses.into
+	into.Lock()
+	defer into.Unlock()
+	n := vector.Length(bat.Vecs[0])
+	for j := 0; j < n; j++ {
+		if bat.Zs[j] <= 0 {
+			continue
+		}
+		if into.rows == 0 {
+			rowIndex := int64(j)
+			if len(bat.Sels) != 0 {
+				rowIndex = bat.Sels[j]
+			}
+			into.row = make([]interface{}, len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				val, err := userVariableValue(vec, rowIndex)
+				if err != nil {
+					return err
+				}
+				into.row[i] = val
+			}
+		}
+		into.rows += bat.Zs[j]
+	}
+	return nil
+}
+
+// userVariableValue returns the value of the row of the vector as the value
+// of a user defined variable.
+func userVariableValue(vec *vector.Vector, row int64) (interface{}, error) {
+	if vec.IsScalarNull() {
+		return nil, nil
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil, nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		if vec.Col.([]bool)[row] {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case types.T_int8:
+		return int64(vec.Col.([]int8)[row]), nil
+	case types.T_int16:
+		return int64(vec.Col.([]int16)[row]), nil
+	case types.T_int32:
+		return int64(vec.Col.([]int32)[row]), nil
+	case types.T_int64:
+		return vec.Col.([]int64)[row], nil
+	case types.T_uint8:
+		return uint64(vec.Col.([]uint8)[row]), nil
+	case types.T_uint16:
+		return uint64(vec.Col.([]uint16)[row]), nil
+	case types.T_uint32:
+		return uint64(vec.Col.([]uint32)[row]), nil
+	case types.T_uint64:
+		return vec.Col.([]uint64)[row], nil
+	case types.T_float32:
+		return float64(vec.Col.([]float32)[row]), nil
+	case types.T_float64:
+		return vec.Col.([]float64)[row], nil
+	case types.T_char, types.T_varchar:
+		return string(vec.Col.(*types.Bytes).Get(row)), nil
+	case types.T_date:
+		return vec.Col.([]types.Date)[row].String(), nil
+	case types.T_datetime:
+		return vec.Col.([]types.Datetime)[row].String(), nil
+	case types.T_timestamp:
+		return vec.Col.([]types.Timestamp)[row].String2(vec.Typ.Precision), nil
+	case types.T_time:
+		return vec.Col.([]types.Time)[row].String2(vec.Typ.Precision), nil
+	case types.T_decimal64:
+		return plan2.DecimalVar{
+			Text:  string(types.AppendDecimal64(nil, vec.Col.([]types.Decimal64)[row], vec.Typ.Scale)),
+			Width: vec.Typ.Width,
+			Scale: vec.Typ.Scale,
+		}, nil
+	case types.T_decimal128:
+		return plan2.DecimalVar{
+			Text:  string(types.AppendDecimal128(nil, vec.Col.([]types.Decimal128)[row], vec.Typ.Scale)),
+			Width: vec.Typ.Width,
+			Scale: vec.Typ.Scale,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s of user variable", vec.Typ)
+}
+
+// setUserVariableColumn sets the type of the column of SELECT @var by the
+// value of the variable, and returns the value in the row.
+func setUserVariableColumn(col *MysqlColumn, val interface{}) interface{} {
+	switch v := val.(type) {
+	case int64:
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	case uint64:
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		col.SetSigned(false)
+	case float64:
+		col.SetColumnType(defines.MYSQL_TYPE_DOUBLE)
+	case plan2.DecimalVar:
+		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
+		col.SetDecimal(uint8(v.Scale))
+		return v.Text
+	default:
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	}
+	return val
+}
+
+// handleSelectInto runs SELECT ... INTO @var, the variables are assigned
+// with the values of the single row. Like mysql, the variables are kept on
+// no rows with a warning, and it fails on more rows.
+func (mce *MysqlCmdExecutor) handleSelectInto(st *tree.Select, vars []*tree.VarExpr, proc *process.Process) error {
+	ses := mce.GetSession()
+	into := &selectIntoResult{}
+	ses.into = into
+	defer func() {
+		ses.into = nil
+	}()
+
+	cw := InitTxnComputationWrapper(ses, st, proc)
+	ret, err := cw.Compile(ses, getDataIntoVariables)
+	if err != nil {
+		return err
+	}
+	if len(plan2.GetResultColumnsFromPlan(cw.plan)) != len(vars) {
+		return NewMysqlError(ER_WRONG_NUMBER_OF_COLUMNS_IN_SELECT)
+	}
+	if err = ret.(ComputationRunner).Run(0); err != nil {
+		return err
+	}
+
+	switch {
+	case into.rows == 0:
+		proc.AddWarning("Warning", ER_SP_FETCH_NO_DATA, "No data - zero rows fetched, selected, or processed")
+	case into.rows > 1:
+		return NewMysqlError(ER_TOO_MANY_ROWS)
+	default:
+		for i, v := range vars {
+			if err = ses.SetUserDefinedVar(v.Name, into.row[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setUserVariable assigns the user defined variable in SET @var = expr, the
+// expression is evaluated as SELECT expr INTO @var.
+func (mce *MysqlCmdExecutor) setUserVariable(assign *tree.VarAssignmentExpr, proc *process.Process) error {
+	v := tree.NewVarExpr(assign.Name, false, false, nil)
+	st := &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: tree.SelectExprs{{Expr: assign.Value}},
+			Into:  []*tree.VarExpr{v},
+			From: &tree.From{
+				Tables: tree.TableExprs{&tree.AliasedTableExpr{
+					Expr: tree.NewTableName(tree.Identifier("dual"), tree.ObjectNamePrefix{}),
+				}},
+			},
+		},
+	}
+	return mce.handleSelectInto(st, []*tree.VarExpr{v}, proc)
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserVariables(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	execAll(t, db,
+		"create database var_db",
+		"use var_db",
+		"create table t (a int, b varchar(20), c decimal(10,2))",
+		"insert into t values (1, 'one', 1.10), (2, 'two', 2.20), (3, 'three', 3.30)",
+	)
+
+	// the column type of SELECT @var follows the type of the value
+	columnType := func(query string) string {
+		rows, err := db.Query(query)
+		require.NoError(t, err, query)
+		defer rows.Close()
+		types, err := rows.ColumnTypes()
+		require.NoError(t, err)
+		require.Len(t, types, 1)
+		return types[0].DatabaseTypeName()
+	}
+
+	t.Run("set", func(t *testing.T) {
+		execAll(t, db, "set @i = 5, @S = lower('AB'), @f = 0.5e0")
+		require.Equal(t, []string{"5"}, queryStrings(t, db, "select @i"))
+		require.Equal(t, "BIGINT", columnType("select @I"))
+		require.Equal(t, []string{"ab"}, queryStrings(t, db, "select @s"))
+		require.Equal(t, "VARCHAR", columnType("select @s"))
+		require.Equal(t, "DOUBLE", columnType("select @f"))
+
+		// the expression is evaluated when the variable is set
+		execAll(t, db, "set @j = @i + 1", "set @i = 10")
+		require.Equal(t, []string{"6"}, queryStrings(t, db, "select @j"))
+		require.Equal(t, []string{"11"}, queryStrings(t, db, "select @i + 1"))
+		require.Equal(t, []string{"AB"}, queryStrings(t, db, "select upper(@s)"))
+	})
+
+	t.Run("into", func(t *testing.T) {
+		execAll(t, db, "select count(*) into @c from t")
+		require.Equal(t, []string{"3"}, queryStrings(t, db, "select @c"))
+		execAll(t, db, "select b, c into @b, @dec from t where a = 2")
+		require.Equal(t, []string{"two"}, queryStrings(t, db, "select @b"))
+		require.Equal(t, []string{"2.20"}, queryStrings(t, db, "select @dec"))
+		require.Equal(t, "DECIMAL", columnType("select @dec"))
+		execAll(t, db, "set @d = @dec")
+		require.Equal(t, []string{"2.20"}, queryStrings(t, db, "select @d"))
+		require.Equal(t, []string{"4.40"}, queryStrings(t, db, "select @d + @dec"))
+
+		// no rows keeps the variables
+		execAll(t, db, "select b into @b from t where a > 10")
+		require.Equal(t, []string{"two"}, queryStrings(t, db, "select @b"))
+
+		_, err := db.Exec("select b into @b from t where a > 1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Result consisted of more than one row")
+		require.Equal(t, []string{"two"}, queryStrings(t, db, "select @b"))
+
+		_, err = db.Exec("select a, b into @b from t where a = 1")
+		require.Error(t, err)
+	})
+
+	t.Run("where", func(t *testing.T) {
+		execAll(t, db, "set @lo = 2", "set @name = 'three'")
+		require.Equal(t, []string{"2", "3"}, queryStrings(t, db, "select a from t where a >= @lo order by a"))
+		require.Equal(t, []string{"3"}, queryStrings(t, db, "select a from t where b = @name"))
+		require.Equal(t, []string{"3"}, queryStrings(t, db, "select a from t where c > @dec"))
+		execAll(t, db, "select max(a) into @max from t")
+		require.Equal(t, []string{"three"}, queryStrings(t, db, "select b from t where a = @max"))
+
+		// the plan is not cached with the value of the variable
+		execAll(t, db, "set @lo = 3")
+		require.Equal(t, []string{"3"}, queryStrings(t, db, "select a from t where a >= @lo order by a"))
+
+		// an unset variable is NULL
+		var unset sql.NullString
+		require.NoError(t, db.QueryRow("select @unset").Scan(&unset))
+		require.False(t, unset.Valid)
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValuesTable(t *testing.T) {
+	_, port := startAccountTestServer(t)
+	db := openAccountDB(t, port, "root", "")
+	for _, stmt := range []string{
+		"create database values_db",
+		"use values_db",
+		"create table users (id int, name varchar(20))",
+		"insert into users values (1, 'ann'), (2, 'bob'), (3, 'carl')",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	// the types are unified across the rows
+	require.Equal(t, []string{"1.0000", "2.5000", "-4.0000"}, queryStrings(t, db, "select a from (values (1, 'x'), (2.5, 3), (-4, null)) as t(a, b)"))
+	rows, err := db.Query("select b from (values (1, 'x'), (2.5, 3), (-4, null)) as t(a, b)")
+	require.NoError(t, err)
+	var bs []sql.NullString
+	for rows.Next() {
+		var b sql.NullString
+		require.NoError(t, rows.Scan(&b))
+		bs = append(bs, b)
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, []sql.NullString{{String: "x", Valid: true}, {String: "3", Valid: true}, {}}, bs)
+	require.Equal(t, []string{"2"}, queryStrings(t, db, "select count(b) from (values (1, 'x'), (2.5, 3), (-4, null)) as t(a, b)"))
+
+	// the build side of a join against a table
+	rows, err = db.Query("select u.name, t.score from users u join (values (1, 10), (3, 30), (4, 40)) as t(id, score) on u.id = t.id order by u.id")
+	require.NoError(t, err)
+	var joined []string
+	for rows.Next() {
+		var name string
+		var score int64
+		require.NoError(t, rows.Scan(&name, &score))
+		joined = append(joined, fmt.Sprintf("%s:%d", name, score))
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"ann:10", "carl:30"}, joined)
+
+	// a large VALUES list is read in many batches
+	var sb strings.Builder
+	sb.WriteString("select count(*), sum(a) from (values ")
+	n := 100000
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "(%d)", i)
+	}
+	sb.WriteString(") as t(a)")
+	var count, sum int64
+	require.NoError(t, db.QueryRow(sb.String()).Scan(&count, &sum))
+	require.Equal(t, int64(n), count)
+	require.Equal(t, int64(n)*int64(n+1)/2, sum)
+}
@@ -558,7 +558,7 @@ func (svst SystemVariableSetType) bits2string(bits uint64) (string, error) {
 			if !ok {
 				return "", errorValueIsInvalid
 			}
-			if i != 0 {
+			if bld.Len() > 0 {
 				bld.WriteByte(',')
 			}
 			bld.WriteString(v)
@@ -872,6 +872,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("max_allowed_packet", 1024, 1073741824, false),
 		Default:           int64(16777216),
 	},
+	"max_execution_time": {
+		Name:              "max_execution_time",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("max_execution_time", 0, 4294967295, false),
+		Default:           int64(0),
+	},
+	"max_cross_join_rows": {
+		Name:              "max_cross_join_rows",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("max_cross_join_rows", 0, math.MaxInt64, false),
+		Default:           int64(100000000),
+	},
 	"version_comment": {
 		Name:              "version_comment",
 		Scope:             ScopeGlobal,
@@ -888,6 +904,28 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemSystemEnumType("tx_isolation", "READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"),
 		Default:           "REPEATABLE-READ",
 	},
+	"autocommit": {
+		Name:              "autocommit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("autocommit"),
+		Default:           int8(1),
+	},
+	"sql_mode": {
+		Name:              "sql_mode",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type: InitSystemVariableSetType("sql_mode",
+			"REAL_AS_FLOAT", "PIPES_AS_CONCAT", "ANSI_QUOTES", "IGNORE_SPACE",
+			"ONLY_FULL_GROUP_BY", "NO_UNSIGNED_SUBTRACTION", "NO_DIR_IN_CREATE", "ANSI",
+			"NO_AUTO_VALUE_ON_ZERO", "NO_BACKSLASH_ESCAPES", "STRICT_TRANS_TABLES", "STRICT_ALL_TABLES",
+			"NO_ZERO_IN_DATE", "NO_ZERO_DATE", "ALLOW_INVALID_DATES", "ERROR_FOR_DIVISION_BY_ZERO",
+			"TRADITIONAL", "HIGH_NOT_PRECEDENCE", "NO_ENGINE_SUBSTITUTION", "PAD_CHAR_TO_FULL_LENGTH",
+			"TIME_TRUNCATE_FRACTIONAL"),
+		Default: "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION",
+	},
 	"testglobalvar_dyn": {
 		Name:              "testglobalvar_dyn",
 		Scope:             ScopeGlobal,
@@ -135,4 +135,16 @@ func TestPartition(t *testing.T) {
 	v11.Nsp.Np.Add(1)
 	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v11)
 	require.Equal(t, []int64{0, 1}, partitions)
+
+	v12 := vector.New(types.Type{Oid: types.T(types.T_decimal64), Scale: 2})
+	v12.Data = encoding.EncodeDecimal64Slice([]types.Decimal64{-150, -150, 150, 150, 0, 0})
+	v12.Col = encoding.DecodeDecimal64Slice(v12.Data)
+	v12.Nsp = &nulls.Nulls{Np: roaring.New()}
+	require.Equal(t, []int64{0, 2, 3}, Partition([]int64{0, 1, 2, 4}, []bool{false, false, false, false}, nil, v12))
+
+	v13 := vector.New(types.Type{Oid: types.T(types.T_decimal128), Scale: 2})
+	v13.Data = encoding.EncodeDecimal128Slice([]types.Decimal128{{Lo: -150, Hi: -1}, {Lo: -150, Hi: -1}, {Lo: 150}, {Lo: 150}, {}, {}})
+	v13.Col = encoding.DecodeDecimal128Slice(v13.Data)
+	v13.Nsp = &nulls.Nulls{Np: roaring.New()}
+	require.Equal(t, []int64{0, 2, 3}, Partition([]int64{0, 1, 2, 4}, []bool{false, false, false, false}, nil, v13))
 }
@@ -100,6 +100,7 @@ const (
 	Type_DECIMAL64  Type_TypeId = 32
 	Type_DECIMAL128 Type_TypeId = 33
 	Type_DECIMAL    Type_TypeId = 34
+	Type_BIT        Type_TypeId = 35
 	Type_ANYINT     Type_TypeId = 37
 	Type_ANYFLOAT   Type_TypeId = 38
 	Type_ANYNUMBER  Type_TypeId = 39
@@ -154,6 +155,7 @@ var Type_TypeId_name = map[int32]string{
 	32:  "DECIMAL64",
 	33:  "DECIMAL128",
 	34:  "DECIMAL",
+	35:  "BIT",
 	37:  "ANYINT",
 	38:  "ANYFLOAT",
 	39:  "ANYNUMBER",
@@ -202,6 +204,7 @@ var Type_TypeId_value = map[string]int32{
 	"DECIMAL64":  32,
 	"DECIMAL128": 33,
 	"DECIMAL":    34,
+	"BIT":        35,
 	"ANYINT":     37,
 	"ANYFLOAT":   38,
 	"ANYNUMBER":  39,
@@ -1202,7 +1205,9 @@ func (m *ExprList) GetList() []*Expr {
 
 // TargetType used in cast function as target type
 type TargetType struct {
-	Typ                  *Type    `protobuf:"bytes,1,opt,name=typ,proto3" json:"typ,omitempty"`
+	Typ *Type `protobuf:"bytes,1,opt,name=typ,proto3" json:"typ,omitempty"`
+	// the cast is inserted by the planner
+	Implicit             bool     `protobuf:"varint,2,opt,name=implicit,proto3" json:"implicit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1248,6 +1253,13 @@ func (m *TargetType) GetTyp() *Type {
 	return nil
 }
 
+func (m *TargetType) GetImplicit() bool {
+	if m != nil {
+		return m.Implicit
+	}
+	return false
+}
+
 // Reference a subquery
 type SubQuery struct {
 	NodeId               int32    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -2005,6 +2017,11 @@ type ColDef struct {
 	Default              *DefaultExpr `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
 	Primary              bool         `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
 	Pkidx                int32        `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	AutoIncr             bool         `protobuf:"varint,8,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
+	Comment              string       `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	Generated            string       `protobuf:"bytes,10,opt,name=generated,proto3" json:"generated,omitempty"`
+	DefaultExpression    string       `protobuf:"bytes,11,opt,name=default_expression,json=defaultExpression,proto3" json:"default_expression,omitempty"`
+	OnUpdate             string       `protobuf:"bytes,12,opt,name=on_update,json=onUpdate,proto3" json:"on_update,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2092,6 +2109,41 @@ func (m *ColDef) GetPkidx() int32 {
 	return 0
 }
 
+func (m *ColDef) GetAutoIncr() bool {
+	if m != nil {
+		return m.AutoIncr
+	}
+	return false
+}
+
+func (m *ColDef) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *ColDef) GetGenerated() string {
+	if m != nil {
+		return m.Generated
+	}
+	return ""
+}
+
+func (m *ColDef) GetDefaultExpression() string {
+	if m != nil {
+		return m.DefaultExpression
+	}
+	return ""
+}
+
+func (m *ColDef) GetOnUpdate() string {
+	if m != nil {
+		return m.OnUpdate
+	}
+	return ""
+}
+
 type IndexDef struct {
 	Typ                  IndexDef_IndexType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.IndexDef_IndexType" json:"typ,omitempty"`
 	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -2924,6 +2976,9 @@ type Node struct {
 	RowsetData           *RowsetData    `protobuf:"bytes,19,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
 	ExtraOptions         string         `protobuf:"bytes,20,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
 	UseDeleteKey         string         `protobuf:"bytes,21,opt,name=useDeleteKey,proto3" json:"useDeleteKey,omitempty"`
+	LockRows             bool           `protobuf:"varint,22,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
+	NullAware            bool           `protobuf:"varint,23,opt,name=null_aware,json=nullAware,proto3" json:"null_aware,omitempty"`
+	TblFuncExprList      []*Expr        `protobuf:"bytes,24,rep,name=tbl_func_expr_list,json=tblFuncExprList,proto3" json:"tbl_func_expr_list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -3109,6 +3164,27 @@ func (m *Node) GetUseDeleteKey() string {
 	return ""
 }
 
+func (m *Node) GetLockRows() bool {
+	if m != nil {
+		return m.LockRows
+	}
+	return false
+}
+
+func (m *Node) GetNullAware() bool {
+	if m != nil {
+		return m.NullAware
+	}
+	return false
+}
+
+func (m *Node) GetTblFuncExprList() []*Expr {
+	if m != nil {
+		return m.TblFuncExprList
+	}
+	return nil
+}
+
 type Query struct {
 	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
 	// Each step is simply a root node.  Root node refers to other
@@ -3774,6 +3850,8 @@ func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 type CreateDatabase struct {
 	IfNotExists          bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
 	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Charset              string   `protobuf:"bytes,3,opt,name=charset,proto3" json:"charset,omitempty"`
+	Collation            string   `protobuf:"bytes,4,opt,name=collation,proto3" json:"collation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3826,6 +3904,20 @@ func (m *CreateDatabase) GetDatabase() string {
 	return ""
 }
 
+func (m *CreateDatabase) GetCharset() string {
+	if m != nil {
+		return m.Charset
+	}
+	return ""
+}
+
+func (m *CreateDatabase) GetCollation() string {
+	if m != nil {
+		return m.Collation
+	}
+	return ""
+}
+
 type AlterDatabase struct {
 	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
 	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
@@ -5084,6 +5176,16 @@ func (m *TargetType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Implicit {
+		i--
+		if m.Implicit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Typ != nil {
 		{
 			size, err := m.Typ.MarshalToSizedBuffer(dAtA[:i])
@@ -5831,6 +5933,44 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OnUpdate) > 0 {
+		i -= len(m.OnUpdate)
+		copy(dAtA[i:], m.OnUpdate)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OnUpdate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DefaultExpression) > 0 {
+		i -= len(m.DefaultExpression)
+		copy(dAtA[i:], m.DefaultExpression)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.DefaultExpression)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Generated) > 0 {
+		i -= len(m.Generated)
+		copy(dAtA[i:], m.Generated)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Generated)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AutoIncr {
+		i--
+		if m.AutoIncr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Pkidx != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Pkidx))
 		i--
@@ -6648,6 +6788,46 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TblFuncExprList) > 0 {
+		for iNdEx := len(m.TblFuncExprList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TblFuncExprList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.NullAware {
+		i--
+		if m.NullAware {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.LockRows {
+		i--
+		if m.LockRows {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if len(m.UseDeleteKey) > 0 {
 		i -= len(m.UseDeleteKey)
 		copy(dAtA[i:], m.UseDeleteKey)
@@ -7600,6 +7780,20 @@ func (m *CreateDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Collation) > 0 {
+		i -= len(m.Collation)
+		copy(dAtA[i:], m.Collation)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Collation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Charset) > 0 {
+		i -= len(m.Charset)
+		copy(dAtA[i:], m.Charset)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Charset)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Database) > 0 {
 		i -= len(m.Database)
 		copy(dAtA[i:], m.Database)
@@ -8275,6 +8469,9 @@ func (m *TargetType) ProtoSize() (n int) {
 		l = m.Typ.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.Implicit {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8688,6 +8885,25 @@ func (m *ColDef) ProtoSize() (n int) {
 	if m.Pkidx != 0 {
 		n += 1 + sovPlan(uint64(m.Pkidx))
 	}
+	if m.AutoIncr {
+		n += 2
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Generated)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.DefaultExpression)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OnUpdate)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9135,6 +9351,18 @@ func (m *Node) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.LockRows {
+		n += 3
+	}
+	if m.NullAware {
+		n += 3
+	}
+	if len(m.TblFuncExprList) > 0 {
+		for _, e := range m.TblFuncExprList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9498,6 +9726,14 @@ func (m *CreateDatabase) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Charset)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Collation)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10548,6 +10784,26 @@ func (m *TargetType) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Implicit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Implicit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -12241,6 +12497,154 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoIncr = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnUpdate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -14863,6 +15267,80 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			}
 			m.UseDeleteKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRows", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockRows = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullAware", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NullAware = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TblFuncExprList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TblFuncExprList = append(m.TblFuncExprList, &Expr{})
+			if err := m.TblFuncExprList[len(m.TblFuncExprList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -16244,6 +16722,70 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 			}
 			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
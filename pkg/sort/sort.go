@@ -102,6 +102,13 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
 		}
+	case types.T_time:
+		vs := vec.Col.([]types.Time)
+		if desc {
+			dint64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		} else {
+			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		}
 	case types.T_uint8:
 		if desc {
 			duint8s.Sort(vec.Col.([]uint8), os)
@@ -120,7 +127,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			uint32s.Sort(vec.Col.([]uint32), os)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if desc {
 			duint64s.Sort(vec.Col.([]uint64), os)
 		} else {
@@ -41,8 +41,10 @@ func (_ *ValueExtend) ExtendAttributes() []*Attribute {
 	return nil
 }
 
+// Eval returns a pinned view of the value, which is shared by the evaluations
+// of all the batches and must not be reused by the operators.
 func (a *ValueExtend) Eval(_ *batch.Batch, _ *process.Process) (*vector.Vector, types.T, error) {
-	return a.V, a.V.Typ.Oid, nil
+	return process.Pin(a.V), a.V.Typ.Oid, nil
 }
 
 func (a *ValueExtend) Eq(e Extend) bool {
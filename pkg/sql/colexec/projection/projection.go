@@ -68,21 +68,30 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				proc.Reg.InputBatch = &batch.Batch{}
 				return false, err
 			}
-			reuse := false
+			// the result is a vector of the input batch, its data is linked
+			// like the one of an attribute so that it isn't freed by Reduce
 			for k := 0; k < len(bat.Vecs); k++ {
-				if rbat.Vecs[i] == bat.Vecs[k] {
-					reuse = true
+				if vec := bat.Vecs[k]; rbat.Vecs[i] == vec {
+					rbat.Vecs[i] = &vector.Vector{
+						Or:   vec.Or,
+						Data: vec.Data,
+						Typ:  vec.Typ,
+						Col:  vec.Col,
+						Nsp:  vec.Nsp,
+					}
+					vec.Link++
 					break
 				}
 			}
-			if reuse {
-				rbat.Vecs[i] = &vector.Vector{
-					Ref:  rbat.Vecs[i].Ref,
-					Or:   rbat.Vecs[i].Or,
-					Data: rbat.Vecs[i].Data,
-					Typ:  rbat.Vecs[i].Typ,
-					Col:  rbat.Vecs[i].Col,
-					Nsp:  rbat.Vecs[i].Nsp,
+			if rbat.Vecs[i].Ref == process.PinnedRef {
+				// the result is a constant of the plan shared by all the
+				// batches, the batch gets its own copy
+				if rbat.Vecs[i], err = vector.Dup(rbat.Vecs[i], proc.Mp); err != nil {
+					rbat.Vecs = rbat.Vecs[:i]
+					batch.Clean(bat, proc.Mp)
+					batch.Clean(rbat, proc.Mp)
+					proc.Reg.InputBatch = &batch.Batch{}
+					return false, err
 				}
 			}
 		}
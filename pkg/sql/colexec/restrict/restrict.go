@@ -120,13 +120,18 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 	sels := vec.Col.([]int64)
 	if len(sels) == 0 {
+		if process.Owned(vec) {
+			process.Put(proc, vec)
+		}
 		bat.Zs = bat.Zs[:0]
 		proc.Reg.InputBatch = bat
 		return false, nil
 	}
 	batch.Reduce(bat, n.E.Attributes(), proc.Mp)
 	batch.Shrink(bat, sels)
-	process.Put(proc, vec)
+	if process.Owned(vec) {
+		process.Put(proc, vec)
+	}
 	proc.Reg.InputBatch = bat
 	return false, nil
 }
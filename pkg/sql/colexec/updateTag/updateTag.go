@@ -56,12 +56,16 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			proc.Reg.InputBatch = &batch.Batch{}
 			return false, err
 		}
-		vec, err = vector.Dup(vec, proc.Mp)
+		rvec, err := vector.Dup(vec, proc.Mp)
 		if err != nil {
 			batch.Clean(updateBatch, proc.Mp)
 			proc.Reg.InputBatch = &batch.Batch{}
 			return false, err
 		}
+		if process.Owned(vec) {
+			process.Put(proc, vec)
+		}
+		vec = rvec
 		err = constantPadding(vec, affectedRows)
 		if err != nil {
 			batch.Clean(updateBatch, proc.Mp)
@@ -116,7 +116,7 @@ func New(op int, dist bool, typ types.Type) (ring.Ring, error) {
 
 func NewBitAnd(typ types.Type) (ring.Ring, error) {
 	switch typ.Oid {
-	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
 		return bitand.NewNumeric(typ), nil
 	}
 	return nil, fmt.Errorf("'%v' not support BitAnd", typ)
@@ -124,7 +124,7 @@ func NewBitAnd(typ types.Type) (ring.Ring, error) {
 
 func NewBitOr(typ types.Type) (ring.Ring, error) {
 	switch typ.Oid {
-	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
 		return bitor.NewBitOr(typ), nil
 	}
 	return nil, fmt.Errorf("'%v' not support BitOr", typ)
@@ -132,7 +132,7 @@ func NewBitOr(typ types.Type) (ring.Ring, error) {
 
 func NewBitXor(typ types.Type) (ring.Ring, error) {
 	switch typ.Oid {
-	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_float32, types.T_float64:
 		return bitxor.NewBitXor(typ), nil
 	}
 	return nil, fmt.Errorf("'%v' not support BitXor", typ)
@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// ExprCollation returns the collation the results of expr are compared with.
+func ExprCollation(expr *plan.Expr) int32 {
+	return types.Type{Oid: types.T(expr.Typ.GetId()), Precision: expr.Typ.GetPrecision()}.Collation()
+}
+
+// WeightVector returns a vector of the weight strings of vec under the
+// collation c, sorting by it gives the same order as sorting vec under c.
+// vec is returned as is if its values are already compared byte by byte.
+func WeightVector(vec *vector.Vector, c int32) *vector.Vector {
+	if c == types.CollationBin || !vec.Typ.HasCollation() || vec.IsScalar() {
+		return vec
+	}
+	vs := vec.Col.(*types.Bytes)
+	ws := &types.Bytes{
+		Data:    make([]byte, 0, len(vs.Data)),
+		Offsets: make([]uint32, len(vs.Offsets)),
+		Lengths: make([]uint32, len(vs.Lengths)),
+	}
+	for i := range vs.Offsets {
+		o := len(ws.Data)
+		ws.Data = types.FoldGeneralCI(ws.Data, vs.Get(int64(i)))
+		ws.Offsets[i] = uint32(o)
+		ws.Lengths[i] = uint32(len(ws.Data) - o)
+	}
+	w := vector.New(types.Type{Oid: vec.Typ.Oid, Size: vec.Typ.Size, Width: vec.Typ.Width})
+	w.Col = ws
+	w.Nsp = vec.Nsp
+	return w
+}
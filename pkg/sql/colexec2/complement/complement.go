@@ -70,6 +70,9 @@ func Prepare(proc *process.Process, arg interface{}) error {
 	}
 	ap.ctr.decimal64Slice = make([]types.Decimal64, UnitLimit)
 	ap.ctr.decimal128Slice = make([]types.Decimal128, UnitLimit)
+	if ap.NullAware && len(ap.Conditions[0]) > 1 {
+		ap.ctr.probeRows = newKeyRows(UnitLimit, len(ap.Conditions[0]))
+	}
 	return nil
 }
 
@@ -96,7 +99,15 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			if len(bat.Zs) == 0 {
 				continue
 			}
-			if ctr.bat == nil {
+			if ctr.bat == nil { // every row qualifies if the build side is empty
+				if err := ctr.emptyProbe(bat, ap, proc); err != nil {
+					ctr.state = End
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+				return false, nil
+			}
+			if ap.NullAware && ctr.hasNull {
 				bat.Clean(proc.Mp)
 				continue
 			}
@@ -143,6 +154,9 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		}
 		bat.Clean(proc.Mp)
 	}
+	if ctr.bat == nil {
+		return nil
+	}
 	for i, cond := range ap.Conditions[1] {
 		vec, err := colexec.EvalExpr(ctr.bat, proc, cond.Expr)
 		if err != nil {
@@ -175,46 +189,13 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		if n > UnitLimit {
 			n = UnitLimit
 		}
+		if ap.NullAware && len(ap.Conditions[1]) > 1 {
+			ctr.fillBuildRows(ap, n, i)
+		}
 		copy(ctr.zValues[:n], OneInt64s[:n])
 		for j, cond := range ap.Conditions[1] {
 			vec := ctr.vecs[j].vec
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				}
-			case -16:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-				}
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
-						} else {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-			}
+			fillGroup(ctr, vec, n, i, cond)
 		}
 		for k := 0; k < n; k++ {
 			if l := len(ctr.keys[k]); l < 16 {
@@ -224,6 +205,9 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
 		for k, v := range ctr.values[:n] {
 			if ctr.zValues[k] == 0 {
+				if ap.NullAware && len(ap.Conditions[1]) == 1 {
+					ctr.hasNull = true
+				}
 				continue
 			}
 			if v > ctr.rows {
@@ -277,46 +261,13 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 		if n > UnitLimit {
 			n = UnitLimit
 		}
+		if ap.NullAware && len(ap.Conditions[0]) > 1 {
+			ctr.fillKeyRows(ctr.probeRows, ap.Conditions[0], n, i)
+		}
 		copy(ctr.zValues[:n], OneInt64s[:n])
 		for j, cond := range ap.Conditions[0] {
 			vec := ctr.vecs[j].vec
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				}
-			case -16:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-				}
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
-						} else {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-			}
+			fillGroup(ctr, vec, n, i, cond)
 		}
 		for k := 0; k < n; k++ {
 			if l := len(ctr.keys[k]); l < 16 {
@@ -329,9 +280,14 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 		}
 		for k := 0; k < n; k++ {
 			if ctr.zValues[k] == 0 {
+				// a row having NULL keys matches no build row, but compares
+				// NULL with the build rows not unequal to it for NOT IN
+				if ap.NullAware && (len(ap.Conditions[0]) == 1 || mayMatchAny(ctr.buildRows, &ctr.probeRows[k])) {
+					continue
+				}
+			} else if ctr.values[k] != 0 {
 				continue
-			}
-			if ctr.values[k] != 0 {
+			} else if ap.NullAware && len(ctr.nullRows) > 0 && mayMatchAny(ctr.nullRows, &ctr.probeRows[k]) {
 				continue
 			}
 			for j, pos := range ap.Result {
@@ -347,59 +303,202 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 	return nil
 }
 
-func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
+func (ctr *Container) emptyProbe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, pos := range ap.Result {
+		vec, err := vector.Dup(bat.Vecs[pos], proc.Mp)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return err
+		}
+		rbat.Vecs[i] = vec
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// fillBuildRows keeps the keys of the build rows [start, start+n) column by
+// column, and records whether they have NULL keys
+func (ctr *Container) fillBuildRows(ap *Argument, n int, start int) {
+	rows := newKeyRows(n, len(ap.Conditions[1]))
+	ctr.fillKeyRows(rows, ap.Conditions[1], n, start)
+	for k := range rows {
+		cnt := 0
+		for _, null := range rows[k].nulls {
+			if null {
+				cnt++
+			}
+		}
+		switch {
+		case cnt == len(rows[k].nulls):
+			ctr.hasNull = true
+		case cnt > 0:
+			ctr.nullRows = append(ctr.nullRows, rows[k])
+		}
+	}
+	ctr.buildRows = append(ctr.buildRows, rows...)
+}
+
+// fillKeyRows fills the keys of the rows [start, start+n) into rows column
+// by column
+func (ctr *Container) fillKeyRows(rows []keyRow, conds []Condition, n int, start int) {
+	for j, cond := range conds {
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		fillGroup(ctr, ctr.vecs[j].vec, n, start, cond)
+		for k := 0; k < n; k++ {
+			rows[k].keys[j] = append(rows[k].keys[j][:0], ctr.keys[k]...)
+			rows[k].nulls[j] = ctr.zValues[k] == 0
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+	}
+}
+
+func newKeyRows(n int, cols int) []keyRow {
+	rows := make([]keyRow, n)
+	for i := range rows {
+		rows[i].keys = make([][]byte, cols)
+		rows[i].nulls = make([]bool, cols)
+	}
+	return rows
+}
+
+// mayMatchAny returns true if a row of rows has no key unequal to the
+// key of row, so that they compare either true or NULL
+func mayMatchAny(rows []keyRow, row *keyRow) bool {
+	for i := range rows {
+		if mayMatch(&rows[i], row) {
+			return true
+		}
+	}
+	return false
+}
+
+func mayMatch(r, s *keyRow) bool {
+	for j := range r.keys {
+		if r.nulls[j] || s.nulls[j] {
+			continue
+		}
+		if !bytes.Equal(r.keys[j], s.keys[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+func fillGroup(ctr *Container, vec *vector.Vector, n int, start int, cond Condition) {
+	switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+	case 1:
+		fillGroupStr[uint8](ctr, vec, n, 1, start, cond.NullSafe)
+	case 2:
+		fillGroupStr[uint16](ctr, vec, n, 2, start, cond.NullSafe)
+	case 4:
+		fillGroupStr[uint32](ctr, vec, n, 4, start, cond.NullSafe)
+	case 8:
+		fillGroupStr[uint64](ctr, vec, n, 8, start, cond.NullSafe)
+	case -8:
+		if cond.Scale > 0 {
+			fillGroupStrWithDecimal64(ctr, vec, n, start, cond.Scale, cond.NullSafe)
+		} else {
+			fillGroupStr[uint64](ctr, vec, n, 8, start, cond.NullSafe)
+		}
+	case -16:
+		if cond.Scale > 0 {
+			fillGroupStrWithDecimal128(ctr, vec, n, start, cond.Scale, cond.NullSafe)
+		} else {
+			fillGroupStr[types.Decimal128](ctr, vec, n, 16, start, cond.NullSafe)
+		}
+	default:
+		vs := vec.Col.(*types.Bytes)
+		if !nulls.Any(vec.Nsp) {
+			for i := 0; i < n; i++ {
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], vs.Get(int64(i+start)), cond.NullSafe)
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				if vec.Nsp.Np.Contains(uint64(i + start)) {
+					fillNullGroup(ctr, i, cond.NullSafe)
+				} else {
+					ctr.keys[i] = appendGroupKey(ctr.keys[i], vs.Get(int64(i+start)), cond.NullSafe)
+				}
+			}
+		}
+	}
+}
+
+func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int, nullSafe bool) {
 	vs := vector.DecodeFixedCol[T](vec, sz)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
 	if !nulls.Any(vec.Nsp) {
 		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+			ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz], nullSafe)
 		}
 	} else {
 		for i := 0; i < n; i++ {
 			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
+				fillNullGroup(ctr, i, nullSafe)
 			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz], nullSafe)
 			}
 		}
 	}
 }
 
-func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32, nullSafe bool) {
 	src := vector.DecodeFixedCol[types.Decimal64](vec, 8)
 	vs := types.AlignDecimal64UsingScaleDiffBatch(src[start:start+n], ctr.decimal64Slice[:n], scale)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
 	if !nulls.Any(vec.Nsp) {
 		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+			ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*8:(i+1)*8], nullSafe)
 		}
 	} else {
 		for i := 0; i < n; i++ {
 			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
+				fillNullGroup(ctr, i, nullSafe)
 			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*8:(i+1)*8], nullSafe)
 			}
 		}
 	}
 }
 
-func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32, nullSafe bool) {
 	src := vector.DecodeFixedCol[types.Decimal128](vec, 16)
 	vs := ctr.decimal128Slice[:n]
 	types.AlignDecimal128UsingScaleDiffBatch(src[start:start+n], vs, scale)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
 	if !nulls.Any(vec.Nsp) {
 		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+			ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*16:(i+1)*16], nullSafe)
 		}
 	} else {
 		for i := 0; i < n; i++ {
 			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
+				fillNullGroup(ctr, i, nullSafe)
 			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*16:(i+1)*16], nullSafe)
 			}
 		}
 	}
 }
+
+// appendGroupKey appends the key of a column to key, the key of a
+// null-safe condition is prefixed by a byte telling if it is NULL
+func appendGroupKey(key []byte, data []byte, nullSafe bool) []byte {
+	if nullSafe {
+		key = append(key, 0)
+	}
+	return append(key, data...)
+}
+
+// fillNullGroup handles a NULL key of row i, which only matches the NULL
+// keys of the other side if the condition is null-safe
+func fillNullGroup(ctr *Container, i int, nullSafe bool) {
+	if nullSafe {
+		ctr.keys[i] = append(ctr.keys[i], 1)
+	} else {
+		ctr.zValues[i] = 0
+	}
+}
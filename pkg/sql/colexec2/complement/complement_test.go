@@ -58,153 +58,153 @@ func init() {
 		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int8}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_decimal64}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_decimal64}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_decimal128}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_decimal128}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal64}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal64}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal128}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal128}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal64}, {Oid: types.T_char}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal64}, {Oid: types.T_char}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal128}, {Oid: types.T_char}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal128}, {Oid: types.T_char}}, []int32{0},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 	}
@@ -253,19 +253,19 @@ func BenchmarkComplement(b *testing.B) {
 			newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []int32{0},
 				[][]Condition{
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 				}),
 			newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int8}}, []int32{0},
 				[][]Condition{
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 				}),
 		}
@@ -441,3 +441,128 @@ func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process,
 	}
 	return bat
 }
+
+func TestNullAwareComplement(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	single := []int64{1, 2, 3}
+	singleProbe := [][]*int64{{&single[0]}, {&single[1]}, {nil}, {&single[2]}}
+	singleBuilds := [][][]*int64{
+		{},
+		{{&single[0]}},
+		{{&single[0]}, {nil}},
+		{{nil}},
+		{{&single[2]}, {&single[1]}},
+	}
+	multi := []int64{1, 2, 3}
+	multiProbe := [][]*int64{
+		{&multi[0], &multi[0]}, {&multi[0], nil}, {nil, nil}, {&multi[1], &multi[1]}, {nil, &multi[2]},
+	}
+	multiBuilds := [][][]*int64{
+		{},
+		{{&multi[0], &multi[1]}},
+		{{&multi[0], nil}},
+		{{nil, &multi[1]}},
+		{{nil, nil}},
+		{{&multi[1], &multi[1]}, {&multi[2], nil}},
+		{{&multi[0], &multi[0]}},
+	}
+	for _, nullAware := range []bool{true, false} {
+		for _, build := range singleBuilds {
+			testNullAwareComplement(t, mheap.New(gm), nullAware, singleProbe, build)
+		}
+		for _, build := range multiBuilds {
+			testNullAwareComplement(t, mheap.New(gm), nullAware, multiProbe, build)
+		}
+	}
+}
+
+func testNullAwareComplement(t *testing.T, m *mheap.Mheap, nullAware bool, probe, build [][]*int64) {
+	cols := len(probe[0])
+	conds := make([][]Condition, 2)
+	result := make([]int32, cols)
+	for i := 0; i < cols; i++ {
+		conds[0] = append(conds[0], Condition{Expr: newExpr(int32(i), types.Type{Oid: types.T_int64})})
+		conds[1] = append(conds[1], Condition{Expr: newExpr(int32(i), types.Type{Oid: types.T_int64})})
+		result[i] = int32(i)
+	}
+	tc := newTestCase(m, nil, nil, result, conds)
+	tc.arg.NullAware = nullAware
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newInt64Batch(t, tc.proc, probe)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	if len(build) > 0 {
+		tc.proc.Reg.MergeReceivers[1].Ch <- newInt64Batch(t, tc.proc, build)
+	}
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	var rows [][]*int64
+	for {
+		if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+			require.NoError(t, err)
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		for i := range bat.Zs {
+			row := make([]*int64, cols)
+			for j, vec := range bat.Vecs {
+				if !nulls.Contains(vec.Nsp, uint64(i)) {
+					v := vec.Col.([]int64)[i]
+					row[j] = &v
+				}
+			}
+			rows = append(rows, row)
+		}
+		bat.Clean(tc.proc.Mp)
+	}
+	require.Equal(t, referenceComplement(nullAware, probe, build), rows, "null aware: %v, build: %v", nullAware, build)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
+// referenceComplement is the nested loop anti join of NOT IN if nullAware,
+// otherwise of NOT EXISTS
+func referenceComplement(nullAware bool, probe, build [][]*int64) [][]*int64 {
+	var rows [][]*int64
+	for _, p := range probe {
+		qualified := true
+		for _, b := range build {
+			isFalse, isNull := false, false
+			for j := range p {
+				switch {
+				case p[j] == nil || b[j] == nil:
+					isNull = true
+				case *p[j] != *b[j]:
+					isFalse = true
+				}
+			}
+			if !isFalse && (!isNull || nullAware) {
+				qualified = false
+			}
+		}
+		if qualified {
+			rows = append(rows, p)
+		}
+	}
+	return rows
+}
+
+func newInt64Batch(t *testing.T, proc *process.Process, rows [][]*int64) *batch.Batch {
+	bat := batch.NewWithSize(len(rows[0]))
+	bat.InitZsOne(len(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, int64(len(rows))*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:len(rows)]
+		for j, row := range rows {
+			if row[i] == nil {
+				nulls.Add(vec.Nsp, uint64(j))
+			} else {
+				vs[j] = *row[i]
+			}
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
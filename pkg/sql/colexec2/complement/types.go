@@ -39,6 +39,13 @@ type evalVector struct {
 	vec      *vector.Vector
 }
 
+// keyRow is the keys of a row column by column, which verifies a row having
+// NULL keys of NOT IN row by row
+type keyRow struct {
+	keys  [][]byte
+	nulls []bool
+}
+
 type Container struct {
 	state         int
 	rows          uint64
@@ -59,16 +66,29 @@ type Container struct {
 
 	decimal64Slice  []types.Decimal64
 	decimal128Slice []types.Decimal128
+
+	// hasNull is set if the build side has a row whose keys are all NULL,
+	// then no row qualifies NOT IN
+	hasNull bool
+	// nullRows are the build rows with only some of their keys NULL
+	nullRows []keyRow
+	// buildRows are all the build rows of multi-column keys, which verify
+	// the probe rows with NULL keys
+	buildRows []keyRow
+	probeRows []keyRow
 }
 
 type Condition struct {
 	Scale int32
 	Expr  *plan.Expr
+	// NullSafe is set for the condition <=>, which matches NULL with NULL
+	NullSafe bool
 }
 
 type Argument struct {
 	ctr        *Container
 	IsPreBuild bool // hashtable is pre-build
+	NullAware  bool // NOT IN semantics, a row comparing NULL with the build side never qualifies
 	Result     []int32
 	Conditions [][]Condition
 }
@@ -0,0 +1,95 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// ScaleDecimalVector returns a vector of the decimals of vec in the given
+// scale, equal values of different scales have the same representation in it.
+// vec is returned as is if it is not of decimals or already in the scale. It
+// fails if a value has more fractional digits than the scale keeps.
+func ScaleDecimalVector(vec *vector.Vector, scale int32, m *mheap.Mheap) (*vector.Vector, error) {
+	var n int
+	switch vs := vec.Col.(type) {
+	case []types.Decimal64:
+		n = len(vs)
+	case []types.Decimal128:
+		n = len(vs)
+	}
+	if vec.Typ.Scale == scale || (vec.Typ.Oid != types.T_decimal64 && vec.Typ.Oid != types.T_decimal128) {
+		return vec, nil
+	}
+	data, err := mheap.Alloc(m, int64(n*vec.Typ.Oid.TypeLen()))
+	if err != nil {
+		return nil, err
+	}
+	w := vector.New(vec.Typ)
+	w.Typ.Scale = scale
+	w.Data = data
+	w.Nsp = vec.Nsp
+	w.IsConst = vec.IsConst
+	w.Length = vec.Length
+	switch vec.Typ.Oid {
+	case types.T_decimal64:
+		vs := vec.Col.([]types.Decimal64)
+		ws := encoding.DecodeDecimal64Slice(data)[:n]
+		if scale > vec.Typ.Scale {
+			types.AlignDecimal64UsingScaleDiffBatch(vs, ws, scale-vec.Typ.Scale)
+		} else {
+			div := int64(math.Pow10(int(vec.Typ.Scale - scale)))
+			for i, v := range vs {
+				if int64(v)%div != 0 && !nulls.Contains(vec.Nsp, uint64(i)) {
+					vector.Clean(w, m)
+					return nil, errors.New(errno.DataException, fmt.Sprintf("decimal %s has more fractional digits than the scale %d", v.Decimal64ToString(vec.Typ.Scale), scale))
+				}
+				ws[i] = types.Decimal64(int64(v) / div)
+			}
+		}
+		w.Col = ws
+	case types.T_decimal128:
+		vs := vec.Col.([]types.Decimal128)
+		ws := encoding.DecodeDecimal128Slice(data)[:n]
+		if scale > vec.Typ.Scale {
+			if n > 0 {
+				types.AlignDecimal128UsingScaleDiffBatch(vs, ws, scale-vec.Typ.Scale)
+			}
+		} else {
+			for i, v := range vs {
+				isNull := nulls.Contains(vec.Nsp, uint64(i))
+				for j := scale; j < vec.Typ.Scale; j++ {
+					if types.ModDecimal128By10Abs(v) != 0 && !isNull {
+						vector.Clean(w, m)
+						return nil, errors.New(errno.DataException, fmt.Sprintf("decimal %s has more fractional digits than the scale %d", vs[i].Decimal128ToString(vec.Typ.Scale), scale))
+					}
+					v = types.DivideDecimal128By10(v)
+				}
+				ws[i] = v
+			}
+		}
+		w.Col = ws
+	}
+	return w, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exchange
+
+import (
+	"bytes"
+	"fmt"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("exchange(%v)", len(ap.Regs)))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = &Container{
+		keys:   make([][]byte, UnitLimit),
+		states: make([][3]uint64, UnitLimit),
+		sels:   make([][]int64, len(ap.Regs)),
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		for _, reg := range ap.Regs {
+			select {
+			case <-reg.Ctx.Done():
+			case reg.Ch <- nil:
+			}
+		}
+		return false, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	vecs := ctr.vecs[:0]
+	for i := range bat.Vecs {
+		if bat.Vecs[i].Or {
+			vec, err := vector.Dup(bat.Vecs[i], proc.Mp)
+			if err != nil {
+				return false, err
+			}
+			vecs = append(vecs, vec)
+		}
+	}
+	for i := range bat.Vecs {
+		if bat.Vecs[i].Or {
+			bat.Vecs[i] = vecs[0]
+			vecs = vecs[1:]
+		}
+	}
+	ctr.partition(bat)
+	// all the rows belong to one consumer, the batch is sent as it is
+	for i, sels := range ctr.sels {
+		if len(sels) == len(bat.Zs) {
+			send(ap.Regs[i], bat, proc)
+			return exited(ap.Regs), nil
+		}
+	}
+	defer bat.Clean(proc.Mp)
+	for i, sels := range ctr.sels {
+		if len(sels) == 0 {
+			continue
+		}
+		b, err := split(bat, sels, proc)
+		if err != nil {
+			return false, err
+		}
+		send(ap.Regs[i], b, proc)
+	}
+	return exited(ap.Regs), nil
+}
+
+// send frees the batch if the consumer has exited
+func send(reg *process.WaitRegister, bat *batch.Batch, proc *process.Process) {
+	if reg.Ctx.Err() != nil {
+		bat.Clean(proc.Mp)
+		return
+	}
+	select {
+	case <-reg.Ctx.Done():
+		bat.Clean(proc.Mp)
+	case reg.Ch <- bat:
+	}
+}
+
+// exited returns true if all the consumers have exited
+func exited(regs []*process.WaitRegister) bool {
+	for _, reg := range regs {
+		if reg.Ctx.Err() == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// partition computes the consumer of each row by the hash of its key
+func (ctr *Container) partition(bat *batch.Batch) {
+	for i := range ctr.sels {
+		ctr.sels[i] = ctr.sels[i][:0]
+	}
+	cnt := uint64(len(ctr.sels))
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		for _, vec := range bat.Vecs {
+			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+			case 1:
+				fillKeys[uint8](ctr, vec, n, 1, i)
+			case 2:
+				fillKeys[uint16](ctr, vec, n, 2, i)
+			case 4:
+				fillKeys[uint32](ctr, vec, n, 4, i)
+			case 8, -8:
+				fillKeys[uint64](ctr, vec, n, 8, i)
+			case -16:
+				fillKeys[types.Decimal128](ctr, vec, n, 16, i)
+			default:
+				fillStrKeys(ctr, vec, n, i)
+			}
+		}
+		for k := 0; k < n; k++ {
+			if l := len(ctr.keys[k]); l < 16 {
+				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+			}
+		}
+		hashtable.AesBytesBatchGenHashStates(&ctr.keys[0], &ctr.states[0], n)
+		for k := 0; k < n; k++ {
+			// the low bits are used by the hash tables of the consumers to
+			// locate the keys, so the high bits are used here
+			p := (ctr.states[k][0] >> 32) % cnt
+			ctr.sels[p] = append(ctr.sels[p], int64(i+k))
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+	}
+}
+
+// split returns the rows of sels as a new batch, the aggregation states of
+// the rows are copied too
+func split(bat *batch.Batch, sels []int64, proc *process.Process) (*batch.Batch, error) {
+	b := batch.NewWithSize(len(bat.Vecs))
+	b.Attrs = bat.Attrs
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.Union(b.Vecs[i], vec, sels, proc.Mp); err != nil {
+			b.Clean(proc.Mp)
+			return nil, err
+		}
+	}
+	if len(bat.Rs) > 0 {
+		b.Rs = make([]ring.Ring, len(bat.Rs))
+		for i, r := range bat.Rs {
+			b.Rs[i] = r.Dup()
+			if err := b.Rs[i].Grows(len(sels), proc.Mp); err != nil {
+				b.Clean(proc.Mp)
+				return nil, err
+			}
+			for k, sel := range sels {
+				b.Rs[i].Add(r, int64(k), sel)
+			}
+		}
+	}
+	b.Zs = make([]int64, len(sels))
+	for k, sel := range sels {
+		b.Zs[k] = bat.Zs[sel]
+	}
+	return b, nil
+}
+
+func fillKeys[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
+	vs := vector.DecodeFixedCol[T](vec, sz)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], byte(0))
+			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.keys[i] = append(ctr.keys[i], byte(1))
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], byte(0))
+				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+			}
+		}
+	}
+}
+
+func fillStrKeys(ctr *Container, vec *vector.Vector, n int, start int) {
+	vs := vec.Col.(*types.Bytes)
+	// keys equal under the collation must go to the same consumer
+	ci := vec.Typ.Collation() == types.CollationGeneralCI
+	for i := 0; i < n; i++ {
+		if nulls.Contains(vec.Nsp, uint64(i+start)) {
+			ctr.keys[i] = append(ctr.keys[i], byte(1))
+		} else if ci {
+			ctr.keys[i] = append(ctr.keys[i], byte(0))
+			ctr.keys[i] = types.FoldGeneralCI(ctr.keys[i], vs.Get(int64(i+start)))
+		} else {
+			ctr.keys[i] = append(ctr.keys[i], byte(0))
+			ctr.keys[i] = append(ctr.keys[i], vs.Get(int64(i+start))...)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exchange
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Producers = 3
+	Consumers = 4
+	Rows      = 1000 // default rows of each producer
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	require.Equal(t, "exchange(0)", buf.String())
+}
+
+func TestPrepare(t *testing.T) {
+	require.NoError(t, Prepare(nil, &Argument{}))
+}
+
+// TestExchange groups keys spread evenly, each consumer receives a part of
+// the groups and the unioned result is the result of the serial plan
+func TestExchange(t *testing.T) {
+	m := newMheap()
+	keys := func(producer, i int) int64 { return int64(i) }
+	serial := runSerial(t, m, keys)
+	results := runExchange(t, m, keys)
+	require.Equal(t, Rows, len(serial))
+	union := make(map[int64]int64)
+	for _, rs := range results {
+		require.NotEmpty(t, rs)
+		for k, v := range rs {
+			_, ok := union[k]
+			require.False(t, ok, "group %v is owned by several consumers", k)
+			union[k] = v
+		}
+	}
+	require.Equal(t, serial, union)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+// TestExchangeSkew groups a single key, the only consumer owning the key
+// receives all the rows and the others are idle
+func TestExchangeSkew(t *testing.T) {
+	m := newMheap()
+	keys := func(producer, i int) int64 { return 7 }
+	serial := runSerial(t, m, keys)
+	results := runExchange(t, m, keys)
+	busy := 0
+	for _, rs := range results {
+		if len(rs) > 0 {
+			busy++
+			require.Equal(t, serial, rs)
+		}
+	}
+	require.Equal(t, 1, busy)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+// TestExchangeExit checks the batches of the exited consumers are freed
+func TestExchangeExit(t *testing.T) {
+	m := newMheap()
+	proc := process.New(m)
+	consumer := process.NewFromProc(m, proc, 1)
+	consumer.Cancel()
+	arg := &Argument{Regs: consumer.Reg.MergeReceivers}
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch(t, proc, 0, func(_, i int) int64 { return int64(i) })
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+func newMheap() *mheap.Mheap {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return mheap.New(gm)
+}
+
+// runSerial merges the partial groups of all producers in one scope
+func runSerial(t *testing.T, m *mheap.Mheap, keys func(int, int) int64) map[int64]int64 {
+	proc := process.NewFromProc(m, process.New(m), Producers)
+	for i := 0; i < Producers; i++ {
+		proc.Reg.MergeReceivers[i].Ch = make(chan *batch.Batch, 2)
+		proc.Reg.MergeReceivers[i].Ch <- runGroup(t, m, i, keys)
+		proc.Reg.MergeReceivers[i].Ch <- nil
+	}
+	return runMergeGroup(t, proc)
+}
+
+// runExchange repartitions the partial groups of the producers across the
+// consumers, it returns the result of each consumer
+func runExchange(t *testing.T, m *mheap.Mheap, keys func(int, int) int64) []map[int64]int64 {
+	var wg sync.WaitGroup
+
+	consumers := make([]*process.Process, Consumers)
+	for i := range consumers {
+		consumers[i] = process.NewFromProc(m, process.New(m), Producers)
+	}
+	for i := 0; i < Producers; i++ {
+		regs := make([]*process.WaitRegister, Consumers)
+		for j := range consumers {
+			regs[j] = consumers[j].Reg.MergeReceivers[i]
+		}
+		bat := runGroup(t, m, i, keys)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			proc := process.New(m)
+			arg := &Argument{Regs: regs}
+			require.NoError(t, Prepare(proc, arg))
+			proc.Reg.InputBatch = bat
+			_, err := Call(proc, arg)
+			require.NoError(t, err)
+			proc.Reg.InputBatch = nil
+			_, err = Call(proc, arg)
+			require.NoError(t, err)
+		}()
+	}
+	results := make([]map[int64]int64, Consumers)
+	for i := range consumers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = runMergeGroup(t, consumers[i])
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// runGroup returns the partial groups of sum(v) group by k of a producer
+func runGroup(t *testing.T, m *mheap.Mheap, producer int, keys func(int, int) int64) *batch.Batch {
+	proc := process.New(m)
+	arg := &group.Argument{
+		Exprs: []*plan.Expr{newExpression(0)},
+		Aggs:  []aggregate.Aggregate{{Op: aggregate.Sum, E: newExpression(1)}},
+	}
+	require.NoError(t, group.Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch(t, proc, producer, keys)
+	_, err := group.Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = group.Call(proc, arg)
+	require.NoError(t, err)
+	return proc.Reg.InputBatch
+}
+
+func runMergeGroup(t *testing.T, proc *process.Process) map[int64]int64 {
+	arg := &mergegroup.Argument{NeedEval: true}
+	require.NoError(t, mergegroup.Prepare(proc, arg))
+	_, err := mergegroup.Call(proc, arg)
+	require.NoError(t, err)
+	rs := make(map[int64]int64)
+	if bat := proc.Reg.InputBatch; bat != nil {
+		ks := bat.Vecs[0].Col.([]int64)
+		vs := bat.Vecs[1].Col.([]int64)
+		for i := range ks {
+			rs[ks[i]] = vs[i]
+		}
+		bat.Clean(proc.Mp)
+	}
+	proc.Cancel()
+	return rs
+}
+
+func newExpression(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+// newBatch returns a batch of (k, v), v is the row number of the producer
+func newBatch(t *testing.T, proc *process.Process, producer int, keys func(int, int) int64) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.InitZsOne(Rows)
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, Rows*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:Rows]
+		for j := range vs {
+			if i == 0 {
+				vs[j] = keys(producer, j)
+			} else {
+				vs[j] = int64(producer*Rows + j)
+			}
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exchange
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	UnitLimit = 256
+)
+
+type Container struct {
+	keys   [][]byte
+	states [][3]uint64
+	// sels[i] is the rows sent to the i-th consumer
+	sels [][]int64
+	vecs []*vector.Vector
+}
+
+// Argument of the exchange operator, it repartitions the batches across the
+// consumers by the hash of all the vectors of the batch, so the rows of the
+// same key are always sent to the same consumer.
+type Argument struct {
+	ctr  *Container
+	Regs []*process.WaitRegister
+}
@@ -55,12 +55,30 @@ func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector
 				vec = vector.NewConst(constDType)
 				vec.Col = []float64{t.C.GetDval()}
 			case *plan.Const_Sval:
-				vec = vector.NewConst(constSType)
 				sval := t.C.GetSval()
-				vec.Col = &types.Bytes{
-					Data:    []byte(sval),
-					Offsets: []uint32{0},
-					Lengths: []uint32{uint32(len(sval))},
+				// the decimal constants are kept as their text
+				switch typ := expr.Typ; typ.GetId() {
+				case plan.Type_DECIMAL64:
+					d, err := types.ParseDecimal64(sval, typ.GetWidth(), typ.GetScale())
+					if err != nil {
+						return nil, err
+					}
+					vec = vector.NewConst(types.Type{Oid: types.T_decimal64, Size: 8, Width: typ.GetWidth(), Scale: typ.GetScale()})
+					vec.Col = []types.Decimal64{d}
+				case plan.Type_DECIMAL128:
+					d, err := types.ParseDecimal128(sval, typ.GetWidth(), typ.GetScale())
+					if err != nil {
+						return nil, err
+					}
+					vec = vector.NewConst(types.Type{Oid: types.T_decimal128, Size: 16, Width: typ.GetWidth(), Scale: typ.GetScale()})
+					vec.Col = []types.Decimal128{d}
+				default:
+					vec = vector.NewConst(constSType)
+					vec.Col = &types.Bytes{
+						Data:    []byte(sval),
+						Offsets: []uint32{0},
+						Lengths: []uint32{uint32(len(sval))},
+					}
 				}
 			default:
 				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unimplemented const expression %v", t.C.GetValue()))
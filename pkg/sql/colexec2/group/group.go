@@ -144,6 +144,8 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			switch ctr.typ {
 			case H8:
 				ctr.bat.Ht = ctr.intHashMap
+			case H16:
+				ctr.bat.Ht = ctr.int128HashMap
 			case H24:
 				ctr.bat.Ht = ctr.strHashMap
 			case H32:
@@ -226,7 +228,13 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		ctr.bat = batch.NewWithSize(len(ap.Exprs))
 		for i := range ctr.groupVecs {
 			vec := ctr.groupVecs[i].vec
-			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			typ := vec.Typ
+			if typ.HasCollation() {
+				// the keys are grouped by the collation of the group by expression,
+				// the merge stages read it back from the type of the key vector.
+				typ.Precision = colexec.ExprCollation(ap.Exprs[i])
+			}
+			ctr.bat.Vecs[i] = vector.New(typ)
 			switch vec.Typ.Oid {
 			case types.T_int8, types.T_uint8, types.T_bool:
 				size += 1 + 1
@@ -234,12 +242,15 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 				size += 2 + 1
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			case types.T_int64, types.T_uint64, types.T_bit, types.T_float64, types.T_datetime, types.T_time, types.T_decimal64:
 				size += 8 + 1
 			case types.T_decimal128:
 				size += 16 + 1
 			case types.T_char, types.T_varchar:
-				if width := vec.Typ.Width; width > 0 {
+				if typ.Collation() != types.CollationBin {
+					// weight strings have no fixed length
+					size = 128
+				} else if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
 					size = 128
@@ -259,7 +270,8 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		ctr.hashes = make([]uint64, UnitLimit)
 		ctr.strHashStates = make([][3]uint64, UnitLimit)
 		ctr.values = make([]uint64, UnitLimit)
-		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap = &hashtable.FixedHashMap[uint64]{}
+		ctr.int128HashMap = &hashtable.FixedHashMap[[2]uint64]{}
 		ctr.strHashMap = &hashtable.StringHashMap{}
 		switch {
 		case size <= 8:
@@ -267,6 +279,11 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			ctr.h8.keys = make([]uint64, UnitLimit)
 			ctr.h8.zKeys = make([]uint64, UnitLimit)
 			ctr.intHashMap.Init()
+		case size <= 16:
+			ctr.typ = H16
+			ctr.h16.keys = make([][2]uint64, UnitLimit)
+			ctr.h16.zKeys = make([][2]uint64, UnitLimit)
+			ctr.int128HashMap.Init()
 		case size <= 24:
 			ctr.typ = H24
 			ctr.h24.keys = make([][3]uint64, UnitLimit)
@@ -288,9 +305,25 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			ctr.strHashMap.Init()
 		}
 	}
+	// equal decimals of different scales must have the same key,
+	// so the keys are hashed in the scale of the first batch.
+	for i := range ctr.groupVecs {
+		vec, err := colexec.ScaleDecimalVector(ctr.groupVecs[i].vec, ctr.bat.Vecs[i].Typ.Scale, proc.Mp)
+		if err != nil {
+			return false, err
+		}
+		if vec != ctr.groupVecs[i].vec {
+			if ctr.groupVecs[i].needFree {
+				vector.Clean(ctr.groupVecs[i].vec, proc.Mp)
+			}
+			ctr.groupVecs[i] = evalVector{vec: vec, needFree: true}
+		}
+	}
 	switch ctr.typ {
 	case H8:
 		err = ctr.processH8(bat, ap, proc)
+	case H16:
+		err = ctr.processH16(bat, ap, proc)
 	case H24:
 		err = ctr.processH24(bat, ap, proc)
 	case H32:
@@ -309,6 +342,16 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 }
 
 func (ctr *Container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if len(bat.Sels) > 0 {
+		// only the rows of sels are aggregated, they are not compacted first
+		for _, sel := range bat.Sels {
+			ctr.bat.Zs[0] += bat.Zs[sel]
+		}
+		for i, r := range ctr.bat.Rs {
+			ring.BulkFillSels(r, 0, bat.Sels, bat.Zs, ctr.aggVecs[i].vec)
+		}
+		return nil
+	}
 	for _, z := range bat.Zs {
 		ctr.bat.Zs[0] += z
 	}
@@ -346,8 +389,43 @@ func (ctr *Container) processH8(bat *batch.Batch, ap *Argument, proc *process.Pr
 				fillStringGroup(ctr, vec, ctr.h8.keys, n, 8, i)
 			}
 		}
-		ctr.hashes[0] = 0
-		ctr.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+		ctr.intHashMap.InsertBatch(ctr.hashes, ctr.h8.keys[:n], ctr.values)
+		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) processH16(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.keyOffs, ctr.zKeyOffs)
+		copy(ctr.h16.keys, ctr.h16.zKeys)
+		for _, evec := range ctr.groupVecs {
+			vec := evec.vec
+			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+			case 1:
+				fillGroup[uint8](ctr, vec, ctr.h16.keys, n, 1, i)
+			case 2:
+				fillGroup[uint16](ctr, vec, ctr.h16.keys, n, 2, i)
+			case 4:
+				fillGroup[uint32](ctr, vec, ctr.h16.keys, n, 4, i)
+			case 8:
+				fillGroup[uint64](ctr, vec, ctr.h16.keys, n, 8, i)
+			case -8:
+				fillGroup[types.Decimal64](ctr, vec, ctr.h16.keys, n, 8, i)
+			case -16:
+				fillGroup[types.Decimal128](ctr, vec, ctr.h16.keys, n, 16, i)
+			default:
+				fillStringGroup(ctr, vec, ctr.h16.keys, n, 16, i)
+			}
+		}
+		ctr.int128HashMap.InsertBatch(ctr.hashes, ctr.h16.keys[:n], ctr.values)
 		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
 			return err
 		}
@@ -470,7 +548,7 @@ func (ctr *Container) processHStr(bat *batch.Batch, ap *Argument, proc *process.
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		for _, evec := range ctr.groupVecs {
+		for j, evec := range ctr.groupVecs {
 			vec := evec.vec
 			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
 			case 1:
@@ -486,22 +564,7 @@ func (ctr *Container) processHStr(bat *batch.Batch, ap *Argument, proc *process.
 			case -16:
 				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
-						} else {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
+				fillStringGroupStr(ctr, vec, ctr.bat.Vecs[j].Typ.Collation(), n, i)
 
 			}
 		}
@@ -619,3 +682,19 @@ func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, star
 	}
 
 }
+
+func fillStringGroupStr(ctr *Container, vec *vector.Vector, collation int32, n int, start int) {
+	vs := vec.Col.(*types.Bytes)
+	for k := 0; k < n; k++ {
+		if nulls.Contains(vec.Nsp, uint64(start+k)) {
+			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+			continue
+		}
+		ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+		if collation == types.CollationGeneralCI {
+			ctr.hstr.keys[k] = types.FoldGeneralCI(ctr.hstr.keys[k], vs.Get(int64(start+k)))
+		} else {
+			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(start+k))...)
+		}
+	}
+}
@@ -66,6 +66,15 @@ func init() {
 			{Oid: types.T_int32},
 			{Oid: types.T_int64},
 		}, []*plan.Expr{newExpression(0), newExpression(3)}, []aggregate.Aggregate{{Op: 0, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false, true}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_int64},
+		}, []*plan.Expr{newExpression(1)}, []aggregate.Aggregate{{Op: 0, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false, true, false}, []types.Type{
+			{Oid: types.T_int32},
+			{Oid: types.T_int32},
+			{Oid: types.T_int64},
+		}, []*plan.Expr{newExpression(0), newExpression(1)}, []aggregate.Aggregate{{Op: 0, E: newExpression(2)}}),
 		newTestCase(mheap.New(gm), []bool{false, true, false, true}, []types.Type{
 			{Oid: types.T_int64},
 			{Oid: types.T_int64},
@@ -132,6 +141,30 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupDecimal(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, oid := range []types.T{types.T_decimal64, types.T_decimal128} {
+		tc := newTestCase(mheap.New(gm), []bool{false}, nil, []*plan.Expr{newExpression(0)}, []aggregate.Aggregate{{Op: aggregate.Count, E: newExpression(0)}})
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		// equal values of different scales are in the same group
+		tc.proc.Reg.InputBatch = newDecimalBatch(t, tc.proc, oid, 2, []string{"1.50", "-2.00", "0.10"})
+		_, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = newDecimalBatch(t, tc.proc, oid, 4, []string{"-2.0000", "1.5000", "3.0000"})
+		_, err = Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = nil
+		_, err = Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		bat := tc.proc.Reg.InputBatch
+		require.Equal(t, []string{"1.50", "-2.00", "0.10", "3.00"}, decimalStrings(bat.Vecs[0]))
+		require.Equal(t, []int64{2, 2, 1, 1}, bat.Zs)
+		bat.Clean(tc.proc.Mp)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -295,3 +328,45 @@ func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process,
 	}
 	return bat
 }
+
+// newDecimalBatch returns a batch of a decimal column of the given scale
+func newDecimalBatch(t *testing.T, proc *process.Process, oid types.T, scale int32, vs []string) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.InitZsOne(len(vs))
+	vec := vector.New(types.Type{Oid: oid, Width: 18, Scale: scale})
+	data, err := mheap.Alloc(proc.Mp, int64(len(vs)*vec.Typ.Oid.TypeLen()))
+	require.NoError(t, err)
+	vec.Data = data
+	if oid == types.T_decimal64 {
+		col := encoding.DecodeDecimal64Slice(data)[:len(vs)]
+		for i, v := range vs {
+			col[i], err = types.ParseStringToDecimal64(v, 18, scale)
+			require.NoError(t, err)
+		}
+		vec.Col = col
+	} else {
+		col := encoding.DecodeDecimal128Slice(data)[:len(vs)]
+		for i, v := range vs {
+			col[i], err = types.ParseStringToDecimal128(v, 18, scale)
+			require.NoError(t, err)
+		}
+		vec.Col = col
+	}
+	bat.Vecs[0] = vec
+	return bat
+}
+
+func decimalStrings(vec *vector.Vector) []string {
+	var rs []string
+	switch vs := vec.Col.(type) {
+	case []types.Decimal64:
+		for _, v := range vs {
+			rs = append(rs, string(v.Decimal64ToString(vec.Typ.Scale)))
+		}
+	case []types.Decimal128:
+		for _, v := range vs {
+			rs = append(rs, string(v.Decimal128ToString(vec.Typ.Scale)))
+		}
+	}
+	return rs
+}
@@ -28,6 +28,7 @@ const (
 
 const (
 	H8 = iota
+	H16
 	H24
 	H32
 	H40
@@ -49,7 +50,8 @@ type Container struct {
 	hashes        []uint64
 	strHashStates [][3]uint64
 	values        []uint64
-	intHashMap    *hashtable.Int64HashMap
+	intHashMap    *hashtable.FixedHashMap[uint64]
+	int128HashMap *hashtable.FixedHashMap[[2]uint64]
 	strHashMap    *hashtable.StringHashMap
 
 	aggVecs   []evalVector
@@ -59,6 +61,10 @@ type Container struct {
 		keys  []uint64
 		zKeys []uint64
 	}
+	h16 struct {
+		keys  [][2]uint64
+		zKeys [][2]uint64
+	}
 	h24 struct {
 		keys  [][3]uint64
 		zKeys [][3]uint64
@@ -58,153 +58,153 @@ func init() {
 		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int8}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_int8})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_decimal64}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_decimal64}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_decimal128}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_decimal128}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_int64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_int64})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal64}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal64}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal64, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal128}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_int8}, {Oid: types.T_decimal128}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128})},
 				},
 				{
-					{0, newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_decimal128, Scale: 1})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal64}, {Oid: types.T_char}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal64}, {Oid: types.T_char}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal64})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal64})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal128}, {Oid: types.T_char}}, []ResultPos{{0, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 		newTestCase(mheap.New(gm), []bool{true, true}, []types.Type{{Oid: types.T_decimal128}, {Oid: types.T_char}}, []ResultPos{{0, 0}, {1, 0}},
 			[][]Condition{
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128, Scale: 1})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 				{
-					{0, newExpr(0, types.Type{Oid: types.T_decimal128})},
-					{0, newExpr(1, types.Type{Oid: types.T_char})},
+					{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_decimal128})},
+					{Scale: 0, Expr: newExpr(1, types.Type{Oid: types.T_char})},
 				},
 			}),
 	}
@@ -245,6 +245,56 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestNullSafeJoin(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, typ := range []types.Type{{Oid: types.T_int64}, {Oid: types.T_varchar}} {
+		for _, nullSafe := range []bool{false, true} {
+			tc := newTestCase(mheap.New(gm), []bool{true}, []types.Type{typ}, []ResultPos{{0, 0}, {1, 0}},
+				[][]Condition{
+					{
+						{Expr: newExpr(0, typ), NullSafe: nullSafe},
+					},
+					{
+						{Expr: newExpr(0, typ), NullSafe: nullSafe},
+					},
+				})
+			Prepare(tc.proc, tc.arg)
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- nil
+			tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[1].Ch <- nil
+			rows, nullRows := 0, 0
+			for {
+				ok, err := Call(tc.proc, tc.arg)
+				require.NoError(t, err)
+				if ok {
+					break
+				}
+				if bat := tc.proc.Reg.InputBatch; bat != nil {
+					rows += len(bat.Zs)
+					for i := range bat.Zs {
+						if nulls.Contains(bat.Vecs[0].Nsp, uint64(i)) {
+							require.True(t, nulls.Contains(bat.Vecs[1].Nsp, uint64(i)))
+							nullRows++
+						}
+					}
+					bat.Clean(tc.proc.Mp)
+				}
+			}
+			// the first row of both sides is NULL, which only matches with <=>
+			if nullSafe {
+				require.Equal(t, Rows, rows)
+				require.Equal(t, 1, nullRows)
+			} else {
+				require.Equal(t, Rows-1, rows)
+				require.Equal(t, 0, nullRows)
+			}
+			require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		}
+	}
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -253,19 +303,19 @@ func BenchmarkJoin(b *testing.B) {
 			newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []ResultPos{{0, 0}, {1, 0}},
 				[][]Condition{
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 				}),
 			newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int8}}, []ResultPos{{0, 0}, {1, 0}},
 				[][]Condition{
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 					{
-						{0, newExpr(0, types.Type{Oid: types.T_int8})},
+						{Scale: 0, Expr: newExpr(0, types.Type{Oid: types.T_int8})},
 					},
 				}),
 		}
@@ -189,43 +189,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for j, cond := range ap.Conditions[1] {
 				vec := ctr.vecs[j].vec
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[i] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
+				fillGroup(ctr, vec, n, i, cond)
 			}
 			for k := 0; k < n; k++ {
 				if l := len(ctr.keys[k]); l < 16 {
@@ -291,43 +255,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for j, cond := range ap.Conditions[1] {
 				vec := ctr.vecs[j].vec
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[i] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
+				fillGroup(ctr, vec, n, i, cond)
 			}
 			for k := 0; k < n; k++ {
 				if l := len(ctr.keys[k]); l < 16 {
@@ -423,43 +351,7 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 		copy(ctr.zValues[:n], OneInt64s[:n])
 		for j, cond := range ap.Conditions[0] {
 			vec := ctr.vecs[j].vec
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				}
-			case -16:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-				}
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
-						} else {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-			}
+			fillGroup(ctr, vec, n, i, cond)
 		}
 		for k := 0; k < n; k++ {
 			if l := len(ctr.keys[k]); l < 16 {
@@ -529,59 +421,118 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 	return nil
 }
 
-func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
+func fillGroup(ctr *Container, vec *vector.Vector, n int, start int, cond Condition) {
+	switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+	case 1:
+		fillGroupStr[uint8](ctr, vec, n, 1, start, cond.NullSafe)
+	case 2:
+		fillGroupStr[uint16](ctr, vec, n, 2, start, cond.NullSafe)
+	case 4:
+		fillGroupStr[uint32](ctr, vec, n, 4, start, cond.NullSafe)
+	case 8:
+		fillGroupStr[uint64](ctr, vec, n, 8, start, cond.NullSafe)
+	case -8:
+		if cond.Scale > 0 {
+			fillGroupStrWithDecimal64(ctr, vec, n, start, cond.Scale, cond.NullSafe)
+		} else {
+			fillGroupStr[uint64](ctr, vec, n, 8, start, cond.NullSafe)
+		}
+	case -16:
+		if cond.Scale > 0 {
+			fillGroupStrWithDecimal128(ctr, vec, n, start, cond.Scale, cond.NullSafe)
+		} else {
+			fillGroupStr[types.Decimal128](ctr, vec, n, 16, start, cond.NullSafe)
+		}
+	default:
+		vs := vec.Col.(*types.Bytes)
+		if !nulls.Any(vec.Nsp) {
+			for i := 0; i < n; i++ {
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], vs.Get(int64(i+start)), cond.NullSafe)
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				if vec.Nsp.Np.Contains(uint64(i + start)) {
+					fillNullGroup(ctr, i, cond.NullSafe)
+				} else {
+					ctr.keys[i] = appendGroupKey(ctr.keys[i], vs.Get(int64(i+start)), cond.NullSafe)
+				}
+			}
+		}
+	}
+}
+
+func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int, nullSafe bool) {
 	vs := vector.DecodeFixedCol[T](vec, sz)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
 	if !nulls.Any(vec.Nsp) {
 		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+			ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz], nullSafe)
 		}
 	} else {
 		for i := 0; i < n; i++ {
 			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
+				fillNullGroup(ctr, i, nullSafe)
 			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz], nullSafe)
 			}
 		}
 	}
 }
 
-func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32, nullSafe bool) {
 	src := vector.DecodeFixedCol[types.Decimal64](vec, 8)
 	vs := types.AlignDecimal64UsingScaleDiffBatch(src[start:start+n], ctr.decimal64Slice[:n], scale)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
 	if !nulls.Any(vec.Nsp) {
 		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+			ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*8:(i+1)*8], nullSafe)
 		}
 	} else {
 		for i := 0; i < n; i++ {
 			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
+				fillNullGroup(ctr, i, nullSafe)
 			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*8:(i+1)*8], nullSafe)
 			}
 		}
 	}
 }
 
-func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32, nullSafe bool) {
 	src := vector.DecodeFixedCol[types.Decimal128](vec, 16)
 	vs := ctr.decimal128Slice[:n]
 	types.AlignDecimal128UsingScaleDiffBatch(src[start:start+n], vs, scale)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
 	if !nulls.Any(vec.Nsp) {
 		for i := 0; i < n; i++ {
-			ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+			ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*16:(i+1)*16], nullSafe)
 		}
 	} else {
 		for i := 0; i < n; i++ {
 			if vec.Nsp.Np.Contains(uint64(i + start)) {
-				ctr.zValues[i] = 0
+				fillNullGroup(ctr, i, nullSafe)
 			} else {
-				ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+				ctr.keys[i] = appendGroupKey(ctr.keys[i], data[(i)*16:(i+1)*16], nullSafe)
 			}
 		}
 	}
 }
+
+// appendGroupKey appends the key of a column to key, the key of a
+// null-safe condition is prefixed by a byte telling if it is NULL
+func appendGroupKey(key []byte, data []byte, nullSafe bool) []byte {
+	if nullSafe {
+		key = append(key, 0)
+	}
+	return append(key, data...)
+}
+
+// fillNullGroup handles a NULL key of row i, which only matches the NULL
+// keys of the other side if the condition is null-safe
+func fillNullGroup(ctr *Container, i int, nullSafe bool) {
+	if nullSafe {
+		ctr.keys[i] = append(ctr.keys[i], 1)
+	} else {
+		ctr.zValues[i] = 0
+	}
+}
@@ -72,6 +72,8 @@ type ResultPos struct {
 type Condition struct {
 	Scale int32
 	Expr  *plan.Expr
+	// NullSafe is set for the condition <=>, which matches NULL with NULL
+	NullSafe bool
 }
 
 type Argument struct {
@@ -49,7 +49,14 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	length := len(bat.Zs)
 	newSeen := n.Seen + uint64(length)
 	if newSeen >= n.Limit { // limit - seen
-		batch.SetLength(bat, int(n.Limit-n.Seen))
+		// the batch may be shared with other consumers, so it is cut by a
+		// window rather than in place
+		if len(bat.Rs) == 0 {
+			proc.Reg.InputBatch = batch.Window(bat, 0, int(n.Limit-n.Seen))
+			bat.Clean(proc.Mp)
+		} else {
+			batch.SetLength(bat, int(n.Limit-n.Seen))
+		}
 		n.Seen = newSeen
 		return true, nil
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -71,32 +72,38 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
+// build merges the batches of all the receivers, each receiver is read until
+// its end in order. The only batch received is returned as it is.
 func (ctr *Container) build(proc *process.Process) error {
-	if len(proc.Reg.MergeReceivers) == 1 {
+	var first *batch.Batch
+
+	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat := <-proc.Reg.MergeReceivers[i].Ch
 			if bat == nil {
-				return nil
+				break
 			}
 			if len(bat.Zs) == 0 {
 				continue
 			}
-			ctr.bat = bat
-			return nil
+			if ctr.bat == nil && first == nil {
+				first = bat
+				continue
+			}
+			if first != nil {
+				if err := ctr.process(first, proc); err != nil {
+					bat.Clean(proc.Mp)
+					return err
+				}
+				first = nil
+			}
+			if err := ctr.process(bat, proc); err != nil {
+				return err
+			}
 		}
 	}
-	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
-		if bat == nil {
-			continue
-		}
-		if len(bat.Zs) == 0 {
-			i--
-			continue
-		}
-		if err := ctr.process(bat, proc); err != nil {
-			return err
-		}
+	if first != nil {
+		ctr.bat = first
 	}
 	return nil
 }
@@ -114,12 +121,15 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 				size += 2 + 1
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			case types.T_int64, types.T_uint64, types.T_bit, types.T_float64, types.T_datetime, types.T_time, types.T_decimal64:
 				size += 8 + 1
 			case types.T_decimal128:
 				size += 16 + 1
 			case types.T_char, types.T_varchar:
-				if width := vec.Typ.Width; width > 0 {
+				if vec.Typ.Collation() != types.CollationBin {
+					// weight strings have no fixed length
+					size = 128
+				} else if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
 					size = 128
@@ -163,6 +173,22 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 			ctr.hstr.keys = make([][]byte, UnitLimit)
 			ctr.strHashMap.Init()
 		}
+	} else {
+		// equal decimals of different scales must have the same key,
+		// so the keys are merged in the scale of the first batch.
+		for i, vec := range bat.Vecs {
+			w, err := colexec.ScaleDecimalVector(vec, ctr.bat.Vecs[i].Typ.Scale, proc.Mp)
+			if err != nil {
+				bat.Clean(proc.Mp)
+				ctr.bat.Clean(proc.Mp)
+				ctr.bat = nil
+				return err
+			}
+			if w != vec {
+				vector.Clean(vec, proc.Mp)
+				bat.Vecs[i] = w
+			}
+		}
 	}
 	switch ctr.typ {
 	case H0:
@@ -408,22 +434,7 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 			case -16:
 				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
-						} else {
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
-							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
+				fillStringGroupStr(ctr, vec, n, i)
 
 			}
 		}
@@ -546,3 +557,20 @@ func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, star
 		}
 	}
 }
+
+func fillStringGroupStr(ctr *Container, vec *vector.Vector, n int, start int) {
+	vs := vec.Col.(*types.Bytes)
+	collation := vec.Typ.Collation()
+	for k := 0; k < n; k++ {
+		if nulls.Contains(vec.Nsp, uint64(start+k)) {
+			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+			continue
+		}
+		ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+		if collation == types.CollationGeneralCI {
+			ctr.hstr.keys[k] = types.FoldGeneralCI(ctr.hstr.keys[k], vs.Get(int64(start+k)))
+		} else {
+			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(start+k))...)
+		}
+	}
+}
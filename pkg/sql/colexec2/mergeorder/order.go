@@ -16,9 +16,11 @@ package mergeorder
 
 import (
 	"bytes"
+	"container/heap"
 
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
@@ -40,10 +42,11 @@ func String(arg interface{}, buf *bytes.Buffer) {
 func Prepare(_ *process.Process, arg interface{}) error {
 	ap := arg.(*Argument)
 	ap.ctr = new(Container)
-	ap.ctr.poses = make([]int32, 0, len(ap.Fs))
 	return nil
 }
 
+// Call merges the sorted batches of the receivers by a k-way merge, a result
+// batch is returned as soon as its rows are known to be the smallest ones.
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	ap := arg.(*Argument)
 	ctr := ap.ctr
@@ -52,170 +55,181 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		case Build:
 			if err := ctr.build(ap, proc); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				return true, err
 			}
 			ctr.state = Eval
 		case Eval:
-			if ctr.bat != nil {
-				for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
-					vector.Clean(ctr.bat.Vecs[i], proc.Mp)
-				}
-				ctr.bat.Vecs = ctr.bat.Vecs[:ctr.n]
+			if err := ctr.eval(ap, proc); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return true, err
 			}
-			proc.Reg.InputBatch = ctr.bat
-			ctr.bat = nil
-			ctr.state = End
-			return true, nil
+			if proc.Reg.InputBatch == nil {
+				ctr.state = End
+				return true, nil
+			}
+			return false, nil
 		default:
 			proc.Reg.InputBatch = nil
 			return true, nil
 		}
 	}
-
 }
 
 func (ctr *Container) build(ap *Argument, proc *process.Process) error {
-	for {
-		if len(proc.Reg.MergeReceivers) == 0 {
-			break
+	regs := append([]*process.WaitRegister{}, proc.Reg.MergeReceivers...)
+	for i, reg := range regs {
+		c := &cursor{idx: i, reg: reg}
+		ok, err := ctr.receive(ap, c, proc)
+		if err != nil {
+			return err
 		}
-		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-			reg := proc.Reg.MergeReceivers[i]
-			bat := <-reg.Ch
-			if bat == nil {
-				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
-				i--
-				continue
-			}
-			if len(bat.Zs) == 0 {
-				i--
-				continue
-			}
-			ctr.n = len(bat.Vecs)
-			ctr.poses = ctr.poses[:0]
-			for _, f := range ap.Fs {
-				vec, err := colexec.EvalExpr(bat, proc, f.E)
-				if err != nil {
-					return err
-				}
-				flg := true
-				for i := range bat.Vecs {
-					if bat.Vecs[i] == vec {
-						flg = false
-						ctr.poses = append(ctr.poses, int32(i))
-						break
-					}
-				}
-				if flg {
-					ctr.poses = append(ctr.poses, int32(len(bat.Vecs)))
-					bat.Vecs = append(bat.Vecs, vec)
-				}
-			}
-			if ctr.bat == nil {
-				mp := make(map[int]int)
-				for i, pos := range ctr.poses {
-					mp[int(pos)] = i
-				}
-				ctr.bat = bat
-				ctr.cmps = make([]compare.Compare, len(bat.Vecs))
-				for i := range ctr.cmps {
-					if pos, ok := mp[i]; ok {
-						ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, ap.Fs[pos].Type == order.Descending)
-					} else {
-						ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, true)
-					}
-				}
-			} else {
-				if err := ctr.processBatch(bat, proc); err != nil {
-					bat.Clean(proc.Mp)
-					ctr.bat.Clean(proc.Mp)
-					return err
-				}
-				bat.Clean(proc.Mp)
-			}
+		if ok {
+			ctr.cursors = append(ctr.cursors, c)
 		}
 	}
+	heap.Init(ctr)
 	return nil
 }
 
-func (ctr *Container) processBatch(bat2 *batch.Batch, proc *process.Process) error {
-	bat1 := ctr.bat
-	rbat := batch.NewWithSize(len(bat1.Vecs))
-	for i, vec := range bat1.Vecs {
-		rbat.Vecs[i] = vector.New(vec.Typ)
+func (ctr *Container) eval(ap *Argument, proc *process.Process) error {
+	if c := ctr.pending; c != nil {
+		ctr.pending = nil
+		ok, err := ctr.receive(ap, c, proc)
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Push(ctr, c)
+		}
 	}
-	for i, cmp := range ctr.cmps {
-		cmp.Set(0, bat1.GetVector(int32(i)))
-		cmp.Set(1, bat2.GetVector(int32(i)))
+	if len(ctr.cursors) == 0 {
+		proc.Reg.InputBatch = nil
+		return nil
+	}
+	rbat := batch.NewWithSize(ctr.n)
+	for i := range rbat.Vecs {
+		rbat.Vecs[i] = vector.New(ctr.typs[i])
 	}
-	// init index-number for merge-sort
-	i, j := int64(0), int64(0)
-	l1, l2 := int64(vector.Length(bat1.Vecs[0])), int64(vector.Length(bat2.Vecs[0]))
-
-	// do merge-sort work
-	for i < l1 && j < l2 {
-		compareResult := 0
-		for _, pos := range ctr.poses {
-			compareResult = ctr.cmps[pos].Compare(0, 1, i, j)
-			if compareResult != 0 {
-				break
+	for len(ctr.cursors) > 0 && len(rbat.Zs) < BatchRows {
+		c := ctr.cursors[0]
+		for i := range rbat.Vecs {
+			if err := vector.UnionOne(rbat.Vecs[i], c.bat.Vecs[i], c.row, proc.Mp); err != nil {
+				rbat.Clean(proc.Mp)
+				return err
 			}
 		}
-		if compareResult <= 0 { // Weight of item1 is less than or equal to item2
-			for k := 0; k < len(rbat.Vecs); k++ {
-				err := vector.UnionOne(rbat.Vecs[k], bat1.Vecs[k], i, proc.Mp)
-				if err != nil {
-					rbat.Clean(proc.Mp)
-					return err
+		rbat.Zs = append(rbat.Zs, c.bat.Zs[c.row])
+		if c.row++; c.row < int64(len(c.bat.Zs)) {
+			heap.Fix(ctr, 0)
+			continue
+		}
+		// the next batch of the receiver is waited for by the next call,
+		// so that the rows known to be the smallest are not held back.
+		heap.Pop(ctr)
+		c.bat.Clean(proc.Mp)
+		c.bat = nil
+		ctr.pending = c
+		break
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// receive gets the next non-empty batch of the receiver, it returns false if
+// the receiver is exhausted.
+func (ctr *Container) receive(ap *Argument, c *cursor, proc *process.Process) (bool, error) {
+	for {
+		bat := <-c.reg.Ch
+		if bat == nil {
+			for i, reg := range proc.Reg.MergeReceivers {
+				if reg == c.reg {
+					proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+					break
 				}
 			}
-			rbat.Zs = append(rbat.Zs, bat1.Zs[i])
-			i++
-		} else {
-			for k := 0; k < len(rbat.Vecs); k++ {
-				err := vector.UnionOne(rbat.Vecs[k], bat2.Vecs[k], j, proc.Mp)
-				if err != nil {
-					rbat.Clean(proc.Mp)
-					return err
-				}
+			return false, nil
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.typs == nil {
+			ctr.n = len(bat.Vecs)
+			ctr.typs = make([]types.Type, ctr.n)
+			for i, vec := range bat.Vecs {
+				ctr.typs[i] = vec.Typ
 			}
-			rbat.Zs = append(rbat.Zs, bat2.Zs[j])
-			j++
 		}
-	}
-	if i < l1 {
-		count := int(l1 - i)
-		// union all bat1 from i to l1
-		for k := 0; k < len(rbat.Vecs); k++ {
-			err := vector.UnionBatch(rbat.Vecs[k], bat1.Vecs[k], i, count, makeFlagsOne(count), proc.Mp)
+		c.bat, c.row = bat, 0
+		c.keys = c.keys[:0]
+		for _, f := range ap.Fs {
+			vec, err := colexec.EvalExpr(bat, proc, f.E)
 			if err != nil {
-				rbat.Clean(proc.Mp)
-				return err
+				bat.Clean(proc.Mp)
+				c.bat = nil
+				return false, err
 			}
+			flg := true
+			for i := range bat.Vecs {
+				if bat.Vecs[i] == vec {
+					flg = false
+					break
+				}
+			}
+			if flg { // the key is freed with the batch
+				bat.Vecs = append(bat.Vecs, vec)
+			}
+			c.keys = append(c.keys, vec)
 		}
-		rbat.Zs = append(rbat.Zs, bat1.Zs[i:]...)
-	}
-	if j < l2 {
-		count := int(l2 - j)
-		// union all bat2 from j to l2
-		for k := 0; k < len(rbat.Vecs); k++ {
-			err := vector.UnionBatch(rbat.Vecs[k], bat2.Vecs[k], j, count, makeFlagsOne(count), proc.Mp)
-			if err != nil {
-				rbat.Clean(proc.Mp)
-				return err
+		if ctr.cmps == nil {
+			ctr.cmps = make([]compare.Compare, len(ap.Fs))
+			for i, f := range ap.Fs {
+				ctr.cmps[i] = compare.NewWithCollation(c.keys[i].Typ.Oid, colexec.ExprCollation(f.E), f.Type == order.Descending)
 			}
 		}
-		rbat.Zs = append(rbat.Zs, bat2.Zs[j:]...)
+		return true, nil
 	}
-	ctr.bat.Clean(proc.Mp)
-	ctr.bat = rbat
-	return nil
 }
 
-func makeFlagsOne(n int) []uint8 {
-	t := make([]uint8, n)
-	for i := range t {
-		t[i]++
+func (ctr *Container) clean(proc *process.Process) {
+	for _, c := range ctr.cursors {
+		c.bat.Clean(proc.Mp)
 	}
-	return t
+	ctr.cursors = nil
+	ctr.pending = nil
+}
+
+func (ctr *Container) compare(c0, c1 *cursor) int {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, c0.keys[i])
+		cmp.Set(1, c1.keys[i])
+		if r := cmp.Compare(0, 1, c0.row, c1.row); r != 0 {
+			return r
+		}
+	}
+	return c0.idx - c1.idx
+}
+
+func (ctr *Container) Len() int {
+	return len(ctr.cursors)
+}
+
+func (ctr *Container) Less(i, j int) bool {
+	return ctr.compare(ctr.cursors[i], ctr.cursors[j]) < 0
+}
+
+func (ctr *Container) Swap(i, j int) {
+	ctr.cursors[i], ctr.cursors[j] = ctr.cursors[j], ctr.cursors[i]
+}
+
+func (ctr *Container) Push(x interface{}) {
+	ctr.cursors = append(ctr.cursors, x.(*cursor))
+}
+
+func (ctr *Container) Pop() interface{} {
+	n := len(ctr.cursors) - 1
+	x := ctr.cursors[n]
+	ctr.cursors = ctr.cursors[:n]
+	return x
 }
@@ -17,6 +17,7 @@ package mergeorder
 import (
 	"bytes"
 	"context"
+	"math/rand"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -85,10 +86,11 @@ func TestOrder(t *testing.T) {
 		tc.proc.Reg.MergeReceivers[1].Ch <- &batch.Batch{}
 		tc.proc.Reg.MergeReceivers[1].Ch <- nil
 		for {
-			if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
-				if tc.proc.Reg.InputBatch != nil {
-					tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
-				}
+			ok, err := Call(tc.proc, tc.arg)
+			if tc.proc.Reg.InputBatch != nil {
+				tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+			}
+			if ok || err != nil {
 				break
 			}
 		}
@@ -104,6 +106,146 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+// TestMergeSorted compares the merge of the sorted partitions with the sort
+// of all the rows.
+func TestMergeSorted(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, ds := range [][]order.Direction{
+		{order.Ascending, order.Ascending},
+		{order.Descending, order.Ascending},
+		{order.Ascending, order.Descending},
+		{order.Descending, order.Descending},
+	} {
+		fs := []order.Field{{E: newExpression(0), Type: ds[0]}, {E: newExpression(1), Type: ds[1]}}
+		proc := process.New(mheap.New(gm))
+		r := rand.New(rand.NewSource(int64(ds[0])*10 + int64(ds[1])))
+		var all [][]int64
+		// 3 partitions of 2 sorted batches
+		parts := make([][][]int64, 3)
+		for i := range parts {
+			for j := 0; j < 2; j++ {
+				var rows [][]int64
+				for k := 0; k < 50+r.Intn(50); k++ {
+					rows = append(rows, []int64{int64(r.Intn(10)), int64(r.Intn(10)), int64(len(all))})
+					all = append(all, rows[len(rows)-1])
+				}
+				parts[i] = append(parts[i], rows...)
+			}
+		}
+		expected := sortRows(t, proc, fs, all)
+
+		proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(parts))
+		for i, rows := range parts {
+			sorted := sortRows(t, proc, fs, rows)
+			proc.Reg.MergeReceivers[i] = &process.WaitRegister{Ctx: context.Background(), Ch: make(chan *batch.Batch, 4)}
+			proc.Reg.MergeReceivers[i].Ch <- newInt64Batch(t, proc, sorted[:len(sorted)/2])
+			proc.Reg.MergeReceivers[i].Ch <- &batch.Batch{}
+			proc.Reg.MergeReceivers[i].Ch <- newInt64Batch(t, proc, sorted[len(sorted)/2:])
+			proc.Reg.MergeReceivers[i].Ch <- nil
+		}
+		arg := &Argument{Fs: fs}
+		require.NoError(t, Prepare(proc, arg))
+		var result [][]int64
+		for {
+			ok, err := Call(proc, arg)
+			require.NoError(t, err)
+			if bat := proc.Reg.InputBatch; bat != nil {
+				result = append(result, batchRows(bat)...)
+				bat.Clean(proc.Mp)
+			}
+			if ok {
+				break
+			}
+		}
+		require.Equal(t, len(expected), len(result))
+		for i := range expected {
+			require.Equal(t, expected[i][:2], result[i][:2], "row %d", i)
+		}
+		require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	}
+}
+
+// TestMergeStreaming checks that the rows are returned before the receivers
+// are exhausted.
+func TestMergeStreaming(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	regs := make([]*process.WaitRegister, 2)
+	for i := range regs {
+		regs[i] = &process.WaitRegister{Ctx: context.Background(), Ch: make(chan *batch.Batch, 1)}
+	}
+	proc.Reg.MergeReceivers = regs
+	arg := &Argument{Fs: []order.Field{{E: newExpression(0), Type: order.Ascending}}}
+	require.NoError(t, Prepare(proc, arg))
+	call := func() [][]int64 {
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, ok)
+		rows := batchRows(proc.Reg.InputBatch)
+		proc.Reg.InputBatch.Clean(proc.Mp)
+		return rows
+	}
+
+	regs[0].Ch <- newInt64Batch(t, proc, [][]int64{{1}, {4}})
+	regs[1].Ch <- newInt64Batch(t, proc, [][]int64{{2}, {3}, {6}})
+	require.Equal(t, [][]int64{{1}, {2}, {3}, {4}}, call())
+	regs[0].Ch <- newInt64Batch(t, proc, [][]int64{{5}, {7}})
+	require.Equal(t, [][]int64{{5}, {6}}, call())
+	regs[1].Ch <- nil
+	require.Equal(t, [][]int64{{7}}, call())
+	regs[0].Ch <- nil
+	ok, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Nil(t, proc.Reg.InputBatch)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+// sortRows sorts the rows by the order operator
+func sortRows(t *testing.T, proc *process.Process, fs []order.Field, rows [][]int64) [][]int64 {
+	arg := &order.Argument{Fs: fs}
+	require.NoError(t, order.Prepare(proc, arg))
+	proc.Reg.InputBatch = newInt64Batch(t, proc, rows)
+	_, err := order.Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = order.Call(proc, arg)
+	require.NoError(t, err)
+	rows = batchRows(proc.Reg.InputBatch)
+	proc.Reg.InputBatch.Clean(proc.Mp)
+	proc.Reg.InputBatch = nil
+	return rows
+}
+
+func newInt64Batch(t *testing.T, proc *process.Process, rows [][]int64) *batch.Batch {
+	bat := batch.NewWithSize(len(rows[0]))
+	bat.InitZsOne(len(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, int64(len(rows))*8)
+		require.NoError(t, err)
+		vs := encoding.DecodeInt64Slice(data)[:len(rows)]
+		for j := range rows {
+			vs[j] = rows[j][i]
+		}
+		vec.Data, vec.Col = data, vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
+
+func batchRows(bat *batch.Batch) [][]int64 {
+	rows := make([][]int64, len(bat.Zs))
+	for i := range rows {
+		for _, vec := range bat.Vecs {
+			rows[i] = append(rows[i], vec.Col.([]int64)[i])
+		}
+	}
+	return rows
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -122,10 +264,11 @@ func BenchmarkOrder(b *testing.B) {
 			tc.proc.Reg.MergeReceivers[1].Ch <- &batch.Batch{}
 			tc.proc.Reg.MergeReceivers[1].Ch <- nil
 			for {
-				if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
-					if tc.proc.Reg.InputBatch != nil {
-						tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
-					}
+				ok, err := Call(tc.proc, tc.arg)
+				if tc.proc.Reg.InputBatch != nil {
+					tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+				}
+				if ok || err != nil {
 					break
 				}
 			}
@@ -17,7 +17,10 @@ package mergeorder
 import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 const (
@@ -26,13 +29,26 @@ const (
 	End
 )
 
+// BatchRows is the max number of rows of a result batch
+const BatchRows = 8192
+
+// cursor is the current position of a receiver, whose batches are sorted
+type cursor struct {
+	idx  int // index of the receiver, the earlier receiver wins the ties
+	row  int64
+	reg  *process.WaitRegister
+	bat  *batch.Batch
+	keys []*vector.Vector // the sort keys of bat
+}
+
 type Container struct {
 	n     int // result vector number
 	state int
-	poses []int32           // sorted list of attributes
-	cmps  []compare.Compare // compare structures used to do sort work for attrs
+	typs  []types.Type
+	cmps  []compare.Compare // compare structures used to do sort work for the sort keys
 
-	bat *batch.Batch // bat store the result of merge-order
+	cursors []*cursor // heap of the receivers which are not exhausted
+	pending *cursor   // the receiver whose batch is exhausted by the last result
 }
 
 type Argument struct {
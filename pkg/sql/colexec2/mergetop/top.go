@@ -121,7 +121,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				ctr.cmps = make([]compare.Compare, len(bat.Vecs))
 				for i := range ctr.cmps {
 					if pos, ok := mp[i]; ok {
-						ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, ap.Fs[pos].Type == top.Descending)
+						ctr.cmps[i] = compare.NewWithCollation(bat.Vecs[i].Typ.Oid, colexec.ExprCollation(ap.Fs[pos].E), ap.Fs[pos].Type == top.Descending)
 					} else {
 						ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, true)
 					}
@@ -45,9 +45,14 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 	length := len(bat.Zs)
 	if n.Seen+uint64(length) > n.Offset {
-		sels := newSels(int64(n.Offset-n.Seen), int64(length)-int64(n.Offset-n.Seen))
+		start := int(n.Offset - n.Seen)
 		n.Seen += uint64(length)
-		bat.Shrink(sels)
+		if len(bat.Rs) == 0 {
+			proc.Reg.InputBatch = batch.Window(bat, start, length)
+			bat.Clean(proc.Mp)
+			return false, nil
+		}
+		bat.Shrink(newSels(int64(start), int64(length-start)))
 		proc.Reg.InputBatch = bat
 		return false, nil
 	}
@@ -50,16 +50,54 @@ func Prepare(_ *process.Process, arg interface{}) error {
 	return nil
 }
 
+// Call sorts all the rows of the input at the end, so that the output is a
+// sorted stream which can be merged with the others by a k-way merge.
 func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
 	bat := proc.Reg.InputBatch
 	if bat == nil {
+		if ctr.bat == nil {
+			return true, nil
+		}
+		proc.Reg.InputBatch, ctr.bat = ctr.bat, nil
+		if _, err := ctr.process(ap, proc.Reg.InputBatch, proc); err != nil {
+			proc.Reg.InputBatch.Clean(proc.Mp)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
 		return true, nil
 	}
 	if len(bat.Zs) == 0 {
 		return false, nil
 	}
-	ap := arg.(*Argument)
-	return ap.ctr.process(ap, bat, proc)
+	defer bat.Clean(proc.Mp)
+	proc.Reg.InputBatch = &batch.Batch{}
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	// decimals are appended in the scale of the first batch
+	for i, vec := range bat.Vecs {
+		w, err := colexec.ScaleDecimalVector(vec, ctr.bat.Vecs[i].Typ.Scale, proc.Mp)
+		if err != nil {
+			ctr.bat.Clean(proc.Mp)
+			ctr.bat = nil
+			return false, err
+		}
+		if w != vec {
+			vector.Clean(vec, proc.Mp)
+			bat.Vecs[i] = w
+		}
+	}
+	if err := batch.Append(ctr.bat, bat, proc.Mp); err != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+		return false, err
+	}
+	return false, nil
 }
 
 func (ctr *Container) process(ap *Argument, bat *batch.Batch, proc *process.Process) (bool, error) {
@@ -81,6 +119,14 @@ func (ctr *Container) process(ap *Argument, bat *batch.Batch, proc *process.Proc
 				break
 			}
 		}
+		// sort strings of a case-insensitive collation by their weights
+		if w := colexec.WeightVector(vec, colexec.ExprCollation(f.E)); w != vec {
+			if ctr.vecs[i].needFree {
+				vector.Clean(vec, proc.Mp)
+			}
+			ctr.vecs[i].vec = w
+			ctr.vecs[i].needFree = false
+		}
 	}
 	defer func() {
 		for i := range ctr.vecs {
@@ -16,6 +16,8 @@ package order
 
 import (
 	"bytes"
+	"math/big"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -88,10 +90,57 @@ func TestOrder(t *testing.T) {
 		Call(tc.proc, tc.arg)
 		tc.proc.Reg.InputBatch = nil
 		Call(tc.proc, tc.arg)
+		// all the rows are sorted at the end
+		require.Equal(t, 2*Rows, len(tc.proc.Reg.InputBatch.Zs))
+		tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
 		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
 	}
 }
 
+func TestOrderDecimal(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, oid := range []types.T{types.T_decimal64, types.T_decimal128} {
+		for _, dir := range []Direction{DefaultDirection, Descending} {
+			tc := newTestCase(mheap.New(gm), nil, []Field{{E: newExpression(0), Type: dir}, {E: newExpression(1), Type: 0}})
+			Prepare(tc.proc, tc.arg)
+			tc.proc.Reg.InputBatch = newDecimalBatch(t, tc.proc, oid, 2, []string{"1.50", "-2.00", "-0.10", "10.00", "1.50", "0.00"})
+			_, err := Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			tc.proc.Reg.InputBatch = newDecimalBatch(t, tc.proc, oid, 4, []string{"-2.0000", "1.5000", "-10.0000", "0.1000"})
+			_, err = Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			tc.proc.Reg.InputBatch = nil
+			_, err = Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			bat := tc.proc.Reg.InputBatch
+
+			// the order of the values parsed from their strings
+			vs := decimalStrings(bat.Vecs[0])
+			expect := make([]string, len(vs))
+			copy(expect, vs)
+			sort.SliceStable(expect, func(i, j int) bool {
+				x, _ := new(big.Rat).SetString(expect[i])
+				y, _ := new(big.Rat).SetString(expect[j])
+				if dir == Descending {
+					return x.Cmp(y) > 0
+				}
+				return x.Cmp(y) < 0
+			})
+			require.Equal(t, expect, vs)
+			// equal values are ordered by the second key
+			ns := bat.Vecs[1].Col.([]int64)
+			for i := 1; i < len(vs); i++ {
+				if vs[i] == vs[i-1] {
+					require.Less(t, ns[i-1], ns[i])
+				}
+			}
+			bat.Clean(tc.proc.Mp)
+			require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		}
+	}
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -210,3 +259,50 @@ func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64)
 	}
 	return bat
 }
+
+// newDecimalBatch returns a batch of a decimal column of the given scale and
+// an int64 column of the reversed row numbers
+func newDecimalBatch(t *testing.T, proc *process.Process, oid types.T, scale int32, vs []string) *batch.Batch {
+	bat := newBatch(t, []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}}, proc, int64(len(vs)))
+	vector.Clean(bat.Vecs[0], proc.Mp)
+	ns := bat.Vecs[1].Col.([]int64)
+	for i := range ns {
+		ns[i] = int64(len(ns) - i)
+	}
+	vec := vector.New(types.Type{Oid: oid, Width: 18, Scale: scale})
+	data, err := mheap.Alloc(proc.Mp, int64(len(vs)*vec.Typ.Oid.TypeLen()))
+	require.NoError(t, err)
+	vec.Data = data
+	if oid == types.T_decimal64 {
+		col := encoding.DecodeDecimal64Slice(data)[:len(vs)]
+		for i, v := range vs {
+			col[i], err = types.ParseStringToDecimal64(v, 18, scale)
+			require.NoError(t, err)
+		}
+		vec.Col = col
+	} else {
+		col := encoding.DecodeDecimal128Slice(data)[:len(vs)]
+		for i, v := range vs {
+			col[i], err = types.ParseStringToDecimal128(v, 18, scale)
+			require.NoError(t, err)
+		}
+		vec.Col = col
+	}
+	bat.Vecs[0] = vec
+	return bat
+}
+
+func decimalStrings(vec *vector.Vector) []string {
+	var rs []string
+	switch vs := vec.Col.(type) {
+	case []types.Decimal64:
+		for _, v := range vs {
+			rs = append(rs, string(v.Decimal64ToString(vec.Typ.Scale)))
+		}
+	case []types.Decimal128:
+		for _, v := range vs {
+			rs = append(rs, string(v.Decimal128ToString(vec.Typ.Scale)))
+		}
+	}
+	return rs
+}
@@ -17,6 +17,7 @@ package order
 import (
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 )
@@ -39,6 +40,7 @@ type evalVector struct {
 type Container struct {
 	ds   []bool       // ds[i] == true: the attrs[i] are in descending order
 	vecs []evalVector // sorted list of attributes
+	bat  *batch.Batch // all the rows of the input, which are sorted at the end
 }
 
 type Field struct {
@@ -29,6 +29,10 @@ func String(_ interface{}, buf *bytes.Buffer) {
 func Prepare(proc *process.Process, arg interface{}) error {
 	ap := arg.(*Argument)
 	ap.ctr = new(Container)
+	ap.ctr.flags = make([]uint8, BatchRows)
+	for i := range ap.ctr.flags {
+		ap.ctr.flags[i] = 1
+	}
 	return nil
 }
 
@@ -44,23 +48,27 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
-			if bat == nil {
-				ctr.state = End
-				if ctr.bat != nil {
-					ctr.bat.Clean(proc.Mp)
+			if ctr.probeBat == nil {
+				bat := <-proc.Reg.MergeReceivers[0].Ch
+				if bat == nil {
+					ctr.state = End
+					if ctr.bat != nil {
+						ctr.bat.Clean(proc.Mp)
+					}
+					continue
 				}
-				continue
-			}
-			if len(bat.Zs) == 0 {
-				continue
-			}
-			if ctr.bat == nil {
-				bat.Clean(proc.Mp)
-				continue
+				if len(bat.Zs) == 0 {
+					continue
+				}
+				if ctr.bat == nil {
+					bat.Clean(proc.Mp)
+					continue
+				}
+				ctr.probeBat, ctr.probeRow, ctr.innerRow = bat, 0, 0
 			}
-			if err := ctr.probe(bat, ap, proc); err != nil {
+			if err := ctr.probe(ap, proc); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				proc.Reg.InputBatch = nil
 				return true, err
 			}
@@ -99,8 +107,11 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 	return nil
 }
 
-func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
-	defer bat.Clean(proc.Mp)
+// probe joins the rows of the outer batch from probeRow with the inner
+// rows, and stops once the result has BatchRows rows, so the product of
+// an outer batch may take several calls.
+func (ctr *Container) probe(ap *Argument, proc *process.Process) error {
+	bat := ctr.probeBat
 	rbat := batch.NewWithSize(len(ap.Result))
 	for i, rp := range ap.Result {
 		if rp.Rel == 0 {
@@ -109,25 +120,57 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
 		}
 	}
-	count := len(bat.Zs)
-	for i := 0; i < count; i++ {
-		for j := 0; j < len(ctr.bat.Zs); j++ {
-			for k, rp := range ap.Result {
-				if rp.Rel == 0 {
+	count, innerCount := len(bat.Zs), len(ctr.bat.Zs)
+	for ctr.probeRow < count && len(rbat.Zs) < BatchRows {
+		// the product can be large, so the deadline is also checked
+		// inside a batch of it.
+		if err := proc.Interrupted(); err != nil {
+			rbat.Clean(proc.Mp)
+			return err
+		}
+		i, j := ctr.probeRow, ctr.innerRow
+		n := innerCount - j
+		if n > BatchRows-len(rbat.Zs) {
+			n = BatchRows - len(rbat.Zs)
+		}
+		for k, rp := range ap.Result {
+			if rp.Rel == 0 {
+				for m := 0; m < n; m++ {
 					if err := vector.UnionOne(rbat.Vecs[k], bat.Vecs[rp.Pos], int64(i), proc.Mp); err != nil {
 						rbat.Clean(proc.Mp)
 						return err
 					}
-				} else {
-					if err := vector.UnionOne(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], int64(j), proc.Mp); err != nil {
-						rbat.Clean(proc.Mp)
-						return err
-					}
+				}
+			} else {
+				if err := vector.UnionBatch(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], int64(j), n, ctr.flags[:n], proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
 				}
 			}
-			rbat.Zs = append(rbat.Zs, ctr.bat.Zs[j])
+		}
+		for m := 0; m < n; m++ {
+			rbat.Zs = append(rbat.Zs, bat.Zs[i]*ctr.bat.Zs[j+m])
+		}
+		if ctr.innerRow += n; ctr.innerRow == innerCount {
+			ctr.probeRow++
+			ctr.innerRow = 0
 		}
 	}
+	if ctr.probeRow == count {
+		bat.Clean(proc.Mp)
+		ctr.probeBat = nil
+	}
 	proc.Reg.InputBatch = rbat
 	return nil
 }
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.probeBat != nil {
+		ctr.probeBat.Clean(proc.Mp)
+		ctr.probeBat = nil
+	}
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+	}
+}
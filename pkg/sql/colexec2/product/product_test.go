@@ -95,6 +95,86 @@ func TestProduct(t *testing.T) {
 	}
 }
 
+func TestProductBatchRows(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	cases := []struct {
+		name        string
+		outer       []int64
+		inner       []int64
+		wantBatches int
+	}{
+		{name: "small x small", outer: []int64{Rows}, inner: []int64{Rows}, wantBatches: 1},
+		{name: "small x large", outer: []int64{Rows, Rows}, inner: []int64{3000}, wantBatches: 8},
+		{name: "large x small", outer: []int64{BatchRows}, inner: []int64{2, 1}, wantBatches: 3},
+	}
+	for _, c := range cases {
+		tc := newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int64}}, []ResultPos{{0, 0}, {1, 0}})
+		Prepare(tc.proc, tc.arg)
+		outerRows, innerRows := 0, 0
+		for _, rows := range c.outer {
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, rows)
+			outerRows += int(rows)
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		var inner []int64
+		for _, rows := range c.inner {
+			tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, rows)
+			for i := int64(0); i < rows; i++ {
+				inner = append(inner, i)
+			}
+			innerRows += int(rows)
+		}
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		batches, rows := 0, 0
+		for {
+			ok, err := Call(tc.proc, tc.arg)
+			require.NoError(t, err, c.name)
+			if ok {
+				break
+			}
+			bat := tc.proc.Reg.InputBatch
+			require.LessOrEqual(t, len(bat.Zs), BatchRows, c.name)
+			outerVs, innerVs := bat.Vecs[0].Col.([]int64), bat.Vecs[1].Col.([]int64)
+			for i := range bat.Zs {
+				// the rows are the inner rows joined with each outer row in order
+				j := (rows + i) % innerRows
+				require.Equal(t, inner[j], innerVs[i], c.name)
+				require.Equal(t, inner[j] == 0, nulls.Contains(bat.Vecs[1].Nsp, uint64(i)), c.name)
+				require.Equal(t, nulls.Contains(bat.Vecs[0].Nsp, uint64(i)), outerVs[i] == 0, c.name)
+			}
+			rows += len(bat.Zs)
+			batches++
+			bat.Clean(tc.proc.Mp)
+		}
+		require.Equal(t, outerRows*innerRows, rows, c.name)
+		require.Equal(t, c.wantBatches, batches, c.name)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp), c.name)
+	}
+}
+
+func TestProductInterrupted(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int64}}, []ResultPos{{0, 0}, {1, 0}})
+	ctx, cancel := context.WithCancel(context.Background())
+	tc.proc.Ctx = ctx
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, BatchRows)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	ok, err := Call(tc.proc, tc.arg)
+	require.NoError(t, err)
+	require.False(t, ok)
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	cancel()
+	_, err = Call(tc.proc, tc.arg)
+	require.Error(t, err)
+	// the outer batch in progress and the inner side are released
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkProduct(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
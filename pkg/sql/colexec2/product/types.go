@@ -24,9 +24,20 @@ const (
 	End
 )
 
+// BatchRows is the max number of rows of a result batch
+const BatchRows = 8192
+
 type Container struct {
 	state int
-	bat   *batch.Batch
+	// bat is the materialized inner side
+	bat *batch.Batch
+	// probeBat is the batch of the outer side being joined, whose row
+	// probeRow is joined with the inner rows from innerRow by the next call
+	probeBat *batch.Batch
+	probeRow int
+	innerRow int
+	// flags selects every row of a batch for the unions of inner rows
+	flags []uint8
 }
 
 type ResultPos struct {
@@ -16,9 +16,13 @@ package projection
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -34,28 +38,49 @@ func String(arg interface{}, buf *bytes.Buffer) {
 	buf.WriteString(")")
 }
 
-func Prepare(_ *process.Process, _ interface{}) error {
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	n := len(ap.Es) / exprsPerWorker
+	if proc.Lim.Parallelism < n {
+		n = proc.Lim.Parallelism
+	}
+	if len(ap.Es) < parallelExprs || n < 2 {
+		return nil
+	}
+	for _, e := range ap.Es {
+		if IsVolatile(e) {
+			return nil
+		}
+	}
+	ap.workers = make([]*process.Process, n)
+	for i := range ap.workers {
+		ap.workers[i] = proc.NewWorker()
+	}
 	return nil
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
 	bat := proc.Reg.InputBatch
 	if bat == nil {
+		ap.freeWorkers()
 		return true, nil
 	}
 	if len(bat.Zs) == 0 {
 		return false, nil
 	}
-	ap := arg.(*Argument)
 	rbat := batch.NewWithSize(len(ap.Es))
-	for i, e := range ap.Es {
-		vec, err := colexec.EvalExpr(bat, proc, e)
-		if err != nil {
-			bat.Clean(proc.Mp)
-			rbat.Clean(proc.Mp)
-			return false, err
-		}
-		rbat.Vecs[i] = vec
+	var err error
+	if len(ap.workers) > 0 && len(bat.Zs) >= parallelRows {
+		err = ap.evalParallel(bat, rbat)
+	} else {
+		err = evalExprs(bat, proc, ap.Es, rbat.Vecs)
+	}
+	if err != nil {
+		ap.freeWorkers()
+		bat.Clean(proc.Mp)
+		rbat.Clean(proc.Mp)
+		return false, err
 	}
 	for _, vec := range rbat.Vecs {
 		for k := 0; k < len(bat.Vecs); k++ {
@@ -70,3 +95,64 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	proc.Reg.InputBatch = rbat
 	return false, nil
 }
+
+// evalExprs evaluates the expressions into vecs, it stops at the first error
+func evalExprs(bat *batch.Batch, proc *process.Process, es []*plan.Expr, vecs []*vector.Vector) error {
+	for i, e := range es {
+		vec, err := colexec.EvalExpr(bat, proc, e)
+		if err != nil {
+			return err
+		}
+		vecs[i] = vec
+	}
+	return nil
+}
+
+// evalParallel divides the expressions into contiguous parts evaluated by the
+// workers against the same batch. The error of the first failed expression is
+// returned, which is the error of the serial evaluation.
+func (ap *Argument) evalParallel(bat, rbat *batch.Batch) error {
+	n := len(ap.workers)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i, w := range ap.workers {
+		lo, hi := i*len(ap.Es)/n, (i+1)*len(ap.Es)/n
+		wg.Add(1)
+		go func(i int, w *process.Process) {
+			defer wg.Done()
+			errs[i] = evalExprs(bat, w, ap.Es[lo:hi], rbat.Vecs[lo:hi])
+		}(i, w)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ap *Argument) freeWorkers() {
+	for _, w := range ap.workers {
+		process.FreeRegisters(w)
+	}
+}
+
+// IsVolatile returns true if the expression calls a function whose result
+// depends on the session or on the time of the call
+func IsVolatile(e *plan.Expr) bool {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return false
+	}
+	fn, err := function.GetFunctionByID(f.F.Func.GetObj())
+	if err != nil || fn.IsVolatile() {
+		return true
+	}
+	for _, arg := range f.F.Args {
+		if IsVolatile(arg) {
+			return true
+		}
+	}
+	return false
+}
@@ -16,6 +16,7 @@ package projection
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -24,6 +25,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/operator"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -98,8 +101,144 @@ func TestProjection(t *testing.T) {
 	}
 }
 
+func TestParallelProjection(t *testing.T) {
+	ts := []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_int64, Size: 8}}
+	es := wideExprs(t, 64)
+	serial := runProjection(t, 0, es, ts, 2048)
+	for _, parallelism := range []int{2, 3, 8} {
+		ap := &Argument{Es: es}
+		proc := newProcess(parallelism)
+		require.NoError(t, Prepare(proc, ap))
+		require.Len(t, ap.workers, parallelism)
+		require.Equal(t, serial, runProjection(t, parallelism, es, ts, 2048))
+	}
+	// a small batch is evaluated serially
+	require.Equal(t, runProjection(t, 0, es, ts, 100), runProjection(t, 8, es, ts, 100))
+
+	// a volatile expression is not evaluated in parallel
+	ap := &Argument{Es: append(wideExprs(t, 63), funcExpr(t, "connection_id"))}
+	require.NoError(t, Prepare(newProcess(8), ap))
+	require.Empty(t, ap.workers)
+	// nor a narrow projection
+	ap = &Argument{Es: wideExprs(t, 16)}
+	require.NoError(t, Prepare(newProcess(8), ap))
+	require.Empty(t, ap.workers)
+}
+
+// The error of the first failed expression is returned whichever worker
+// fails first.
+func TestParallelProjectionError(t *testing.T) {
+	ts := []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_int64, Size: 8}}
+	es := wideExprs(t, 64)
+	col0 := colExpr(0)
+	es[10] = funcExpr(t, "%", col0, col0)
+	es[50] = funcExpr(t, "div", col0, col0)
+	for _, parallelism := range []int{0, 4, 8} {
+		for i := 0; i < 10; i++ {
+			proc := newProcess(parallelism)
+			proc.SessionInfo.ErrorForDivisionByZero = true
+			ap := &Argument{Es: es}
+			require.NoError(t, Prepare(proc, ap))
+			proc.Reg.InputBatch = newBatch(t, ts, proc, 2048)
+			_, err := Call(proc, ap)
+			require.Equal(t, operator.ErrModByZero, err)
+		}
+	}
+}
+
+func BenchmarkProjection(b *testing.B) {
+	ts := []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_int64, Size: 8}}
+	es := wideExprs(b, 64)
+	for _, parallelism := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("parallelism-%d", parallelism), func(b *testing.B) {
+			proc := newProcess(parallelism)
+			ap := &Argument{Es: es}
+			require.NoError(b, Prepare(proc, ap))
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				proc.Reg.InputBatch = newBatch(b, ts, proc, 8192)
+				b.StartTimer()
+				if _, err := Call(proc, ap); err != nil {
+					b.Fatal(err)
+				}
+				b.StopTimer()
+				proc.Reg.InputBatch.Clean(proc.Mp)
+				b.StartTimer()
+			}
+		})
+	}
+}
+
+func newProcess(parallelism int) *process.Process {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	proc.Lim.Parallelism = parallelism
+	return proc
+}
+
+// runProjection returns the columns of the projection of a batch of int64
+func runProjection(t *testing.T, parallelism int, es []*plan.Expr, ts []types.Type, rows int64) [][]int64 {
+	proc := newProcess(parallelism)
+	ap := &Argument{Es: es}
+	require.NoError(t, Prepare(proc, ap))
+	proc.Reg.InputBatch = newBatch(t, ts, proc, rows)
+	_, err := Call(proc, ap)
+	require.NoError(t, err)
+	rbat := proc.Reg.InputBatch
+	cols := make([][]int64, len(rbat.Vecs))
+	for i, vec := range rbat.Vecs {
+		cols[i] = append([]int64{}, vec.Col.([]int64)...)
+	}
+	rbat.Clean(proc.Mp)
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, ap)
+	require.NoError(t, err)
+	return cols
+}
+
+// wideExprs returns the n expressions col0 * i + col1
+func wideExprs(t require.TestingT, n int) []*plan.Expr {
+	es := make([]*plan.Expr, n)
+	for i := range es {
+		es[i] = funcExpr(t, "+", funcExpr(t, "*", colExpr(0), int64Expr(int64(i))), colExpr(1))
+	}
+	return es
+}
+
+func colExpr(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_INT64, Size: 8},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}},
+	}
+}
+
+func int64Expr(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_INT64, Size: 8},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+	}
+}
+
+func funcExpr(t require.TestingT, name string, args ...*plan.Expr) *plan.Expr {
+	argTypes := make([]types.T, len(args))
+	for i, arg := range args {
+		argTypes[i] = types.T(arg.Typ.Id)
+	}
+	f, id, _, err := function.GetFunctionByName(name, argTypes)
+	require.NoError(t, err)
+	rt, _ := f.ReturnType()
+	return &plan.Expr{
+		Typ: &plan.Type{Id: plan.Type_TypeId(rt), Size: int32(rt.ToType().Size)},
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{Obj: id, ObjName: name},
+			Args: args,
+		}},
+	}
+}
+
 // create a new block based on the type information
-func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
+func newBatch(t require.TestingT, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	bat := batch.NewWithSize(len(ts))
 	bat.InitZsOne(int(rows))
 	for i := range bat.Vecs {
@@ -14,8 +14,23 @@
 
 package projection
 
-import "github.com/matrixorigin/matrixone/pkg/pb/plan"
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	// parallelExprs and parallelRows are the least numbers of the expressions
+	// and of the rows of a batch to evaluate the expressions in parallel.
+	parallelExprs = 32
+	parallelRows  = 1024
+	// exprsPerWorker is the least number of the expressions of a worker.
+	exprsPerWorker = 8
+)
 
 type Argument struct {
 	Es []*plan.Expr
+	// workers are the processes evaluating the parts of the expressions,
+	// they are made in Prepare if the projection is evaluated in parallel.
+	workers []*process.Process
 }
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -50,6 +51,12 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		return false, err
 	}
 	defer vector.Clean(vec, proc.Mp)
+	// a null condition is unknown, its rows are dropped like the false ones
+	if vec.IsScalarNull() {
+		bat.Shrink(nil)
+		proc.Reg.InputBatch = bat
+		return false, nil
+	}
 	bs, ok := vec.Col.([]bool)
 	if !ok {
 		return false, errors.New(errno.SyntaxError, "only support logic expression to be filter condition")
@@ -61,7 +68,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	} else {
 		sels := make([]int64, 0, 8)
 		for i, b := range bs {
-			if b {
+			if b && !nulls.Contains(vec.Nsp, uint64(i)) {
 				sels = append(sels, int64(i))
 			}
 		}
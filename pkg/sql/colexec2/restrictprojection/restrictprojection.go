@@ -0,0 +1,149 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrictprojection
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	restrict.String(&restrict.Argument{E: n.E}, buf)
+	projection.String(&projection.Argument{Es: n.Es}, buf)
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = &container{
+		proj: &projection.Argument{Es: ap.Es},
+	}
+	for _, e := range ap.Es {
+		ap.ctr.cols = columnsOf(e, ap.ctr.cols)
+	}
+	return projection.Prepare(proc, ap.ctr.proj)
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		return projection.Call(proc, ctr.proj)
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	vec, err := colexec.EvalExpr(bat, proc, ap.E)
+	if err != nil {
+		bat.Clean(proc.Mp)
+		return false, err
+	}
+	bs, ok := vec.Col.([]bool)
+	switch {
+	case vec.IsScalarNull():
+		// a null condition is unknown, its rows are dropped like the false ones
+		bat.Shrink(nil)
+	case !ok:
+		cleanCondition(bat, vec, proc)
+		return false, errors.New(errno.SyntaxError, "only support logic expression to be filter condition")
+	case vec.IsScalar():
+		if !bs[0] {
+			bat.Shrink(nil)
+		}
+	default:
+		sels := ctr.sels[:0]
+		for i, b := range bs {
+			if b && !nulls.Contains(vec.Nsp, uint64(i)) {
+				sels = append(sels, int64(i))
+			}
+		}
+		ctr.sels = sels
+		switch {
+		case len(sels) == 0:
+			bat.Shrink(nil)
+		case len(sels) < len(bat.Zs):
+			ctr.shrink(bat, sels)
+		}
+	}
+	cleanCondition(bat, vec, proc)
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	return projection.Call(proc, ctr.proj)
+}
+
+// shrink keeps the rows sels of the columns read by the projection, the other
+// columns are dropped by the projection unchanged
+func (ctr *container) shrink(bat *batch.Batch, sels []int64) {
+	ctr.vecs = ctr.vecs[:0]
+	for _, pos := range ctr.cols {
+		vec := bat.Vecs[pos]
+		if !contains(ctr.vecs, vec) {
+			vector.Shrink(vec, sels)
+			ctr.vecs = append(ctr.vecs, vec)
+		}
+	}
+	for i, sel := range sels {
+		bat.Zs[i] = bat.Zs[sel]
+	}
+	bat.Zs = bat.Zs[:len(sels)]
+	ctr.vecs = ctr.vecs[:0]
+}
+
+// cleanCondition frees the result of the filter condition unless it is a
+// column of the batch
+func cleanCondition(bat *batch.Batch, vec *vector.Vector, proc *process.Process) {
+	if !contains(bat.Vecs, vec) {
+		vector.Clean(vec, proc.Mp)
+	}
+}
+
+func contains(vecs []*vector.Vector, vec *vector.Vector) bool {
+	for _, v := range vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
+
+// columnsOf appends the columns read by the expression which are not in cols
+func columnsOf(e *plan.Expr, cols []int32) []int32 {
+	switch t := e.Expr.(type) {
+	case *plan.Expr_Col:
+		for _, pos := range cols {
+			if pos == t.Col.ColPos {
+				return cols
+			}
+		}
+		return append(cols, t.Col.ColPos)
+	case *plan.Expr_F:
+		for _, arg := range t.F.Args {
+			cols = columnsOf(arg, cols)
+		}
+	}
+	return cols
+}
@@ -0,0 +1,368 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrictprojection
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 1000 // default rows
+	Cols = 8    // default columns
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{E: lessExpr(t, 10), Es: []*plan.Expr{colExpr(1)}}, buf)
+	require.Contains(t, buf.String(), "σ(")
+	require.Contains(t, buf.String(), "π(")
+}
+
+// TestRestrictProjection compares the fused operator with a restrict followed
+// by a projection, for filters passing from no row to all rows.
+func TestRestrictProjection(t *testing.T) {
+	es := []*plan.Expr{
+		funcExpr(t, "+", colExpr(0), colExpr(3)),
+		funcExpr(t, "*", colExpr(6), int64Expr(2)),
+		colExpr(5),
+		colExpr(0),
+		int64Expr(7),
+	}
+	conds := []*plan.Expr{
+		lessExpr(t, 0),
+		lessExpr(t, 1),
+		lessExpr(t, Rows/100),
+		lessExpr(t, Rows/2),
+		lessExpr(t, Rows-1),
+		lessExpr(t, Rows),
+		boolExpr(true),
+		boolExpr(false),
+		funcExpr(t, "<", colExpr(0), colExpr(2)),
+		funcExpr(t, ">", colExpr(7), int64Expr(7*Rows+Rows/3)),
+	}
+	for _, cond := range conds {
+		want := runUnfused(t, cond, es)
+		require.Equal(t, want, runFused(t, cond, es), cond.String())
+	}
+}
+
+// The projection may read the column of the filter condition.
+func TestBoolColumn(t *testing.T) {
+	proc := newProcess()
+	ap := &Argument{E: colExpr(1), Es: []*plan.Expr{colExpr(0), colExpr(1)}}
+	require.NoError(t, Prepare(proc, ap))
+	bat := newBatch(t, proc, 2, Rows)
+	bs := make([]bool, Rows)
+	for i := range bs {
+		bs[i] = i%4 == 0
+	}
+	vector.Clean(bat.Vecs[1], proc.Mp)
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_bool})
+	bat.Vecs[1].Col = bs
+	proc.Reg.InputBatch = bat
+	_, err := Call(proc, ap)
+	require.NoError(t, err)
+	rbat := proc.Reg.InputBatch
+	require.Equal(t, Rows/4, len(rbat.Zs))
+	for i, v := range rbat.Vecs[0].Col.([]int64) {
+		require.Equal(t, int64(i*4), v)
+	}
+	require.Equal(t, []bool{true}, rbat.Vecs[1].Col.([]bool)[:1])
+	rbat.Clean(proc.Mp)
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, ap)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func TestNotLogicCondition(t *testing.T) {
+	proc := newProcess()
+	ap := &Argument{E: colExpr(0), Es: []*plan.Expr{colExpr(0)}}
+	require.NoError(t, Prepare(proc, ap))
+	proc.Reg.InputBatch = newBatch(t, proc, Cols, Rows)
+	_, err := Call(proc, ap)
+	require.Error(t, err)
+	proc.Reg.InputBatch.Clean(proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+// TestNullCondition checks that both the fused operator and the restrict drop
+// the rows whose condition is null, the value of a comparison under a null row
+// is garbage.
+func TestNullCondition(t *testing.T) {
+	nullExpr := &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_BOOL, Size: 1},
+		Expr: &plan.Expr_C{C: &plan.Const{Isnull: true}},
+	}
+	// the first column is null at the rows 0 to 4
+	passing := []int64{5, 6, 7, 8, 9}
+	notNull := make([]int64, 0, Rows)
+	for i := int64(5); i < Rows; i++ {
+		notNull = append(notNull, i)
+	}
+	conds := []struct {
+		cond *plan.Expr
+		rows []int64
+	}{
+		{lessExpr(t, 10), passing},
+		{funcExpr(t, "not", funcExpr(t, ">=", colExpr(0), int64Expr(10))), passing},
+		{funcExpr(t, "or", lessExpr(t, 10), nullExpr), passing},
+		{funcExpr(t, "and", nullExpr, lessExpr(t, 10)), nil},
+		{funcExpr(t, "not", funcExpr(t, "isnull", colExpr(0))), notNull},
+		{nullExpr, nil},
+		{funcExpr(t, "not", nullExpr), nil},
+	}
+	for _, c := range conds {
+		for _, fused := range []bool{true, false} {
+			proc := newProcess()
+			bat := newBatch(t, proc, 2, Rows)
+			for i := 0; i < 5; i++ {
+				nulls.Add(bat.Vecs[0].Nsp, uint64(i))
+			}
+			proc.Reg.InputBatch = bat
+			if fused {
+				ap := &Argument{E: c.cond, Es: []*plan.Expr{colExpr(1)}}
+				require.NoError(t, Prepare(proc, ap))
+				_, err := Call(proc, ap)
+				require.NoError(t, err)
+			} else {
+				ap := &restrict.Argument{E: c.cond}
+				require.NoError(t, restrict.Prepare(proc, ap))
+				_, err := restrict.Call(proc, ap)
+				require.NoError(t, err)
+			}
+			rbat := proc.Reg.InputBatch
+			var rows []int64
+			if len(rbat.Zs) > 0 {
+				// the second column of the row j is Rows + j
+				for _, v := range rbat.Vecs[len(rbat.Vecs)-1].Col.([]int64) {
+					rows = append(rows, v-Rows)
+				}
+			}
+			require.Equal(t, c.rows, rows, "fused %v: %s", fused, c.cond)
+			rbat.Clean(proc.Mp)
+		}
+	}
+}
+
+// BenchmarkRestrictProjection projects a column of a wide batch filtered by a
+// bool column, at several selectivities.
+func BenchmarkRestrictProjection(b *testing.B) {
+	cond := &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_BOOL, Size: 1},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: Cols}},
+	}
+	es := []*plan.Expr{funcExpr(b, "+", colExpr(1), int64Expr(1))}
+	for _, percent := range []int{1, 10, 50, 100} {
+		b.Run(fmt.Sprintf("selectivity-%d%%/fused", percent), func(b *testing.B) {
+			proc := newProcess()
+			ap := &Argument{E: cond, Es: es}
+			require.NoError(b, Prepare(proc, ap))
+			benchmark(b, proc, percent, func() error {
+				_, err := Call(proc, ap)
+				return err
+			})
+		})
+		b.Run(fmt.Sprintf("selectivity-%d%%/unfused", percent), func(b *testing.B) {
+			proc := newProcess()
+			rap, pap := &restrict.Argument{E: cond}, &projection.Argument{Es: es}
+			require.NoError(b, restrict.Prepare(proc, rap))
+			require.NoError(b, projection.Prepare(proc, pap))
+			benchmark(b, proc, percent, func() error {
+				if _, err := restrict.Call(proc, rap); err != nil {
+					return err
+				}
+				_, err := projection.Call(proc, pap)
+				return err
+			})
+		})
+	}
+}
+
+// benchmark calls the operators on batches whose last column is a filter
+// passing percent of the rows
+func benchmark(b *testing.B, proc *process.Process, percent int, call func() error) {
+	const rows = 8192
+	bs := make([]bool, rows)
+	for i := range bs {
+		bs[i] = i%100 < percent
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		bat := newBatch(b, proc, Cols, rows)
+		vec := vector.New(types.Type{Oid: types.T_bool})
+		vec.Col = append([]bool{}, bs...)
+		bat.Vecs = append(bat.Vecs, vec)
+		proc.Reg.InputBatch = bat
+		b.StartTimer()
+		if err := call(); err != nil {
+			b.Fatal(err)
+		}
+		b.StopTimer()
+		proc.Reg.InputBatch.Clean(proc.Mp)
+		b.StartTimer()
+	}
+}
+
+// result is the output of the operators, nil columns for an empty batch
+type result struct {
+	zs   []int64
+	cols [][]int64
+}
+
+func runFused(t *testing.T, cond *plan.Expr, es []*plan.Expr) []result {
+	proc := newProcess()
+	ap := &Argument{E: cond, Es: es}
+	require.NoError(t, Prepare(proc, ap))
+	rs := run(t, proc, func() error {
+		_, err := Call(proc, ap)
+		return err
+	})
+	_, err := Call(proc, ap)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp), cond.String())
+	return rs
+}
+
+func runUnfused(t *testing.T, cond *plan.Expr, es []*plan.Expr) []result {
+	proc := newProcess()
+	rap, pap := &restrict.Argument{E: cond}, &projection.Argument{Es: es}
+	require.NoError(t, restrict.Prepare(proc, rap))
+	require.NoError(t, projection.Prepare(proc, pap))
+	rs := run(t, proc, func() error {
+		if _, err := restrict.Call(proc, rap); err != nil {
+			return err
+		}
+		_, err := projection.Call(proc, pap)
+		return err
+	})
+	_, err := projection.Call(proc, pap)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp), cond.String())
+	return rs
+}
+
+// run calls the operators on two batches and an empty one
+func run(t *testing.T, proc *process.Process, call func() error) []result {
+	var rs []result
+	for _, bat := range []*batch.Batch{newBatch(t, proc, Cols, Rows), newBatch(t, proc, Cols, Rows), {}} {
+		proc.Reg.InputBatch = bat
+		require.NoError(t, call())
+		rbat := proc.Reg.InputBatch
+		var r result
+		if len(rbat.Zs) > 0 {
+			r.zs = append(r.zs, rbat.Zs...)
+			for _, vec := range rbat.Vecs {
+				vs := vec.Col.([]int64)
+				if vec.IsScalar() {
+					vs = []int64{vs[0]}
+				}
+				r.cols = append(r.cols, append([]int64{}, vs...))
+			}
+		}
+		rs = append(rs, r)
+		rbat.Clean(proc.Mp)
+	}
+	proc.Reg.InputBatch = nil
+	return rs
+}
+
+func newProcess() *process.Process {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return process.New(mheap.New(gm))
+}
+
+// lessExpr returns the condition col0 < n
+func lessExpr(t require.TestingT, n int64) *plan.Expr {
+	return funcExpr(t, "<", colExpr(0), int64Expr(n))
+}
+
+func colExpr(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_INT64, Size: 8},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}},
+	}
+}
+
+func int64Expr(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_INT64, Size: 8},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+	}
+}
+
+func boolExpr(v bool) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_BOOL, Size: 1},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Bval{Bval: v}}},
+	}
+}
+
+func funcExpr(t require.TestingT, name string, args ...*plan.Expr) *plan.Expr {
+	argTypes := make([]types.T, len(args))
+	for i, arg := range args {
+		argTypes[i] = types.T(arg.Typ.Id)
+	}
+	f, id, _, err := function.GetFunctionByName(name, argTypes)
+	require.NoError(t, err)
+	rt, _ := f.ReturnType()
+	return &plan.Expr{
+		Typ: &plan.Type{Id: plan.Type_TypeId(rt), Size: int32(rt.ToType().Size)},
+		Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{Obj: id, ObjName: name},
+			Args: args,
+		}},
+	}
+}
+
+// newBatch returns a batch of int64 columns, the column i of the row j is
+// i * rows + j
+func newBatch(t require.TestingT, proc *process.Process, cols int, rows int64) *batch.Batch {
+	bat := batch.NewWithSize(cols)
+	bat.InitZsOne(int(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+		for j := range vs {
+			vs[j] = int64(i)*rows + int64(j)
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrictprojection
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+)
+
+type container struct {
+	// cols are the distinct columns read by the projection, only they are
+	// filtered.
+	cols []int32
+	// sels are the rows passing the filter, the slice is reused by batches.
+	sels []int64
+	// vecs are the vectors filtered of the current batch.
+	vecs []*vector.Vector
+	proj *projection.Argument
+}
+
+// Argument is a restrict followed by a projection, the projection is evaluated
+// only on the rows passing the filter.
+type Argument struct {
+	ctr *container
+	// E is the filter condition.
+	E *plan.Expr
+	// Es are the projected expressions.
+	Es []*plan.Expr
+}
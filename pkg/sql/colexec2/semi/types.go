@@ -66,6 +66,8 @@ type Container struct {
 type Condition struct {
 	Scale int32
 	Expr  *plan.Expr
+	// NullSafe is set for the condition <=>, which matches NULL with NULL
+	NullSafe bool
 }
 
 type Argument struct {
@@ -0,0 +1,544 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setop
+
+import (
+	"bufio"
+	"bytes"
+	"hash/crc32"
+	"io"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// groupOverhead is the estimated bytes of a group besides its key, which
+// are its cell of the hash table and its counters
+const groupOverhead = 80
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.Op == Intersect {
+		buf.WriteString(" ∩ ")
+	} else {
+		buf.WriteString(" - ")
+	}
+	if ap.All {
+		buf.WriteString("all ")
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.keys = make([][]byte, UnitLimit)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.resetCounters()
+	return nil
+}
+
+// Call reads all the right rows into the counters of their groups, then
+// returns the qualified left rows batch by batch, a row returned several
+// times is returned once with the multiplicity in Zs. The rows are spilled
+// if the counters exceed the memory limit, then the partitions of the left
+// rows are returned one after another.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				ctr.cleanSpill()
+				return true, err
+			}
+			ctr.state = Probe
+			if ctr.spill != nil {
+				ctr.state = Partition
+			}
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				bat.Clean(proc.Mp)
+				continue
+			}
+			err := ctr.probe(bat, ap, proc)
+			bat.Clean(proc.Mp)
+			if err != nil {
+				ctr.state = End
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		case Partition:
+			if err := ctr.partition(proc); err != nil {
+				ctr.state = End
+				ctr.cleanSpill()
+				return true, err
+			}
+			ctr.state = Merge
+			ctr.part = -1
+		case Merge:
+			bat, err := ctr.nextSpilled()
+			if err == nil && bat != nil {
+				err = ctr.probe(bat, ap, proc)
+			}
+			if err != nil {
+				ctr.state = End
+				ctr.cleanSpill()
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			return false, nil
+		default:
+			ctr.cleanSpill()
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			bat.Clean(proc.Mp)
+			continue
+		}
+		err := ctr.count(bat, ap, proc)
+		bat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+	if ctr.spill != nil {
+		// the partitions are merged with all their counters on disk
+		return ctr.spillCounters(proc)
+	}
+	return nil
+}
+
+// count adds the right rows of bat to the counters of their groups
+func (ctr *Container) count(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat, i, n)
+		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+		for k := 0; k < n; k++ {
+			g := ctr.group(k, ap.MemoryLimit > 0)
+			ctr.counts[g] += bat.Zs[i+k]
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+		if ap.MemoryLimit > 0 && ctr.size > ap.MemoryLimit {
+			if err := ctr.spillCounters(proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// group returns the group of the k-th key inserted, which is added if new
+func (ctr *Container) group(k int, keepKey bool) int {
+	g := int(ctr.values[k]) - 1
+	if g == len(ctr.counts) {
+		ctr.counts = append(ctr.counts, 0)
+		ctr.seen = append(ctr.seen, 0)
+		if keepKey {
+			ctr.groupKeys = append(ctr.groupKeys, append([]byte{}, ctr.keys[k]...))
+		}
+		ctr.size += int64(len(ctr.keys[k])) + groupOverhead
+	}
+	return g
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat, i, n)
+		if ap.All {
+			ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+		} else {
+			// the left rows are distinct by their groups
+			ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+		}
+		for k := 0; k < n; k++ {
+			var cnt int64
+			z := bat.Zs[i+k]
+			switch {
+			case ctr.values[k] == 0:
+				// not a row of the right input
+				if ap.Op == Except {
+					cnt = z
+				}
+			case ap.All:
+				g := ctr.values[k] - 1
+				cnt = ctr.bagCount(ap.Op, g, z)
+			default:
+				g := ctr.group(k, false)
+				if ctr.seen[g] == 0 && (ctr.counts[g] > 0) == (ap.Op == Intersect) {
+					cnt = 1
+				}
+				ctr.seen[g] += z
+			}
+			ctr.keys[k] = ctr.keys[k][:0]
+			if cnt <= 0 {
+				continue
+			}
+			for j, vec := range bat.Vecs {
+				if err := vector.UnionOne(rbat.Vecs[j], vec, int64(i+k), proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, cnt)
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// bagCount returns how many of the next z left rows of group g qualify
+func (ctr *Container) bagCount(op Op, g uint64, z int64) int64 {
+	seen := ctr.seen[g]
+	ctr.seen[g] += z
+	if op == Intersect {
+		// the first counts[g] left rows
+		return min(seen+z, ctr.counts[g]) - min(seen, ctr.counts[g])
+	}
+	// the left rows after the first counts[g]
+	return max(seen+z, ctr.counts[g]) - max(seen, ctr.counts[g])
+}
+
+// fillKeys fills the keys of the rows [start, start+n) of bat, the key of a
+// column is prefixed by a byte telling if it is NULL, so that NULL equals
+// NULL, and a string is prefixed by its length
+func (ctr *Container) fillKeys(bat *batch.Batch, start, n int) {
+	for _, vec := range bat.Vecs {
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillKeys[uint8](ctr, vec, 1, start, n)
+		case 2:
+			fillKeys[uint16](ctr, vec, 2, start, n)
+		case 4:
+			fillKeys[uint32](ctr, vec, 4, start, n)
+		case 8, -8:
+			fillKeys[uint64](ctr, vec, 8, start, n)
+		case -16:
+			fillKeys[types.Decimal128](ctr, vec, 16, start, n)
+		default:
+			vs := vec.Col.(*types.Bytes)
+			for i := 0; i < n; i++ {
+				if nulls.Contains(vec.Nsp, uint64(i+start)) {
+					ctr.keys[i] = append(ctr.keys[i], 1)
+					continue
+				}
+				v := vs.Get(int64(i + start))
+				ctr.keys[i] = append(ctr.keys[i], 0)
+				ctr.keys[i] = append(ctr.keys[i], encoding.EncodeUint32(uint32(len(v)))...)
+				ctr.keys[i] = append(ctr.keys[i], v...)
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if l := len(ctr.keys[i]); l < 16 {
+			ctr.keys[i] = append(ctr.keys[i], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+}
+
+func fillKeys[T any](ctr *Container, vec *vector.Vector, sz int, start, n int) {
+	vs := vector.DecodeFixedCol[T](vec, sz)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
+	for i := 0; i < n; i++ {
+		if nulls.Contains(vec.Nsp, uint64(i+start)) {
+			ctr.keys[i] = append(ctr.keys[i], 1)
+			continue
+		}
+		ctr.keys[i] = append(ctr.keys[i], 0)
+		ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+	}
+}
+
+func (ctr *Container) resetCounters() {
+	ctr.strHashMap = &hashtable.StringHashMap{}
+	ctr.strHashMap.Init()
+	ctr.counts = ctr.counts[:0]
+	ctr.seen = ctr.seen[:0]
+	ctr.groupKeys = ctr.groupKeys[:0]
+	ctr.size = 0
+}
+
+func partitionOf(key []byte) int {
+	return int(crc32.ChecksumIEEE(key) % SpillPartitions)
+}
+
+func (ctr *Container) newSpill(proc *process.Process) (err error) {
+	s := &spill{
+		rights: make([]*process.SpillFile, SpillPartitions),
+		lefts:  make([]*process.SpillFile, SpillPartitions),
+		ws:     make([]*bufio.Writer, SpillPartitions),
+	}
+	ctr.spill = s
+	m := proc.Spill()
+	for i := 0; i < SpillPartitions; i++ {
+		if s.rights[i], err = m.CreateTempFile(process.SpillSetOp); err != nil {
+			return err
+		}
+		if s.lefts[i], err = m.CreateTempFile(process.SpillSetOp); err != nil {
+			return err
+		}
+		s.ws[i] = bufio.NewWriter(s.rights[i])
+	}
+	return nil
+}
+
+// spillCounters writes the counters of the groups to the files of their
+// partitions and clears them
+func (ctr *Container) spillCounters(proc *process.Process) error {
+	if ctr.spill == nil {
+		if err := ctr.newSpill(proc); err != nil {
+			return err
+		}
+	}
+	ws := ctr.spill.ws
+	for g, key := range ctr.groupKeys {
+		w := ws[partitionOf(key)]
+		if _, err := w.Write(encoding.EncodeUint32(uint32(len(key)))); err != nil {
+			return err
+		}
+		if _, err := w.Write(key); err != nil {
+			return err
+		}
+		if _, err := w.Write(encoding.EncodeInt64(ctr.counts[g])); err != nil {
+			return err
+		}
+	}
+	ctr.resetCounters()
+	return nil
+}
+
+// partition writes the left rows to the files of their partitions
+func (ctr *Container) partition(proc *process.Process) error {
+	s := ctr.spill
+	for i, w := range s.ws {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		s.ws[i] = bufio.NewWriter(s.lefts[i])
+	}
+	sels := make([][]int64, SpillPartitions)
+	var buf bytes.Buffer
+	for {
+		bat := <-proc.Reg.MergeReceivers[0].Ch
+		if bat == nil {
+			break
+		}
+		for i := range sels {
+			sels[i] = sels[i][:0]
+		}
+		count := len(bat.Zs)
+		for i := 0; i < count; i += UnitLimit {
+			n := count - i
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			ctr.fillKeys(bat, i, n)
+			for k := 0; k < n; k++ {
+				p := partitionOf(ctr.keys[k])
+				sels[p] = append(sels[p], int64(i+k))
+				ctr.keys[k] = ctr.keys[k][:0]
+			}
+		}
+		for p, ps := range sels {
+			if len(ps) == 0 {
+				continue
+			}
+			buf.Reset()
+			if err := writeRows(&buf, bat, ps, proc); err != nil {
+				bat.Clean(proc.Mp)
+				return err
+			}
+			if _, err := s.ws[p].Write(encoding.EncodeUint32(uint32(buf.Len()))); err != nil {
+				bat.Clean(proc.Mp)
+				return err
+			}
+			if _, err := s.ws[p].Write(buf.Bytes()); err != nil {
+				bat.Clean(proc.Mp)
+				return err
+			}
+		}
+		bat.Clean(proc.Mp)
+	}
+	for _, w := range s.ws {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRows encodes the rows sels of bat into buf
+func writeRows(buf *bytes.Buffer, bat *batch.Batch, sels []int64, proc *process.Process) error {
+	pbat := batch.NewWithSize(len(bat.Vecs))
+	defer pbat.Clean(proc.Mp)
+	for i, vec := range bat.Vecs {
+		pbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	for _, sel := range sels {
+		for i, vec := range bat.Vecs {
+			if err := vector.UnionOne(pbat.Vecs[i], vec, sel, proc.Mp); err != nil {
+				return err
+			}
+		}
+		pbat.Zs = append(pbat.Zs, bat.Zs[sel])
+	}
+	return protocol.EncodeBatch(pbat, buf)
+}
+
+// nextSpilled returns the next batch of the left rows spilled, the counters
+// of a partition are loaded before its left rows are returned. It returns
+// nil after the last partition.
+func (ctr *Container) nextSpilled() (*batch.Batch, error) {
+	s := ctr.spill
+	for {
+		if s.r != nil {
+			bat, err := readBatch(s.r)
+			if err != nil || bat != nil {
+				return bat, err
+			}
+		}
+		if ctr.part++; ctr.part == SpillPartitions {
+			return nil, nil
+		}
+		if err := ctr.loadCounters(ctr.part); err != nil {
+			return nil, err
+		}
+		if _, err := s.lefts[ctr.part].Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		s.r = bufio.NewReader(s.lefts[ctr.part])
+	}
+}
+
+func readBatch(r *bufio.Reader) (*batch.Batch, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data := make([]byte, encoding.DecodeUint32(head[:]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	bat, _, err := protocol.DecodeBatch(data)
+	return bat, err
+}
+
+// loadCounters reads the counters of partition p, the counters of a group
+// spilled several times are added up
+func (ctr *Container) loadCounters(p int) error {
+	ctr.resetCounters()
+	f := ctr.spill.rights[p]
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	r := bufio.NewReader(f)
+	var head [8]byte
+	for {
+		if _, err := io.ReadFull(r, head[:4]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		key := make([]byte, encoding.DecodeUint32(head[:4]))
+		if _, err := io.ReadFull(r, key); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(r, head[:]); err != nil {
+			return err
+		}
+		ctr.keys[0] = key
+		ctr.strHashMap.InsertStringBatch(ctr.strHashStates[:1], ctr.keys[:1], ctr.values[:1])
+		g := ctr.group(0, false)
+		ctr.counts[g] += encoding.DecodeInt64(head[:])
+		ctr.keys[0] = nil
+	}
+}
+
+func (ctr *Container) cleanSpill() {
+	s := ctr.spill
+	if s == nil {
+		return
+	}
+	for i := range s.rights {
+		if s.rights[i] != nil {
+			_ = s.rights[i].Remove()
+		}
+		if s.lefts[i] != nil {
+			_ = s.lefts[i].Remove()
+		}
+	}
+	ctr.spill = nil
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,305 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setop
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// row is a row of int64 columns, a nil column is NULL
+type row []*int64
+
+func (r row) String() string {
+	var buf bytes.Buffer
+	for i, v := range r {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if v == nil {
+			buf.WriteString("NULL")
+		} else {
+			fmt.Fprintf(&buf, "%d", *v)
+		}
+	}
+	return buf.String()
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Op: Intersect, All: true}, buf)
+	String(&Argument{Op: Except}, buf)
+	require.Equal(t, " ∩ all  - ", buf.String())
+}
+
+func TestSetOp(t *testing.T) {
+	r := rand.New(rand.NewSource(20220805))
+	random := func(n, cols, distinct int) []row {
+		rows := make([]row, n)
+		for i := range rows {
+			rows[i] = make(row, cols)
+			for j := range rows[i] {
+				if v := int64(r.Intn(distinct + 1)); v < int64(distinct) {
+					rows[i][j] = &v
+				}
+			}
+		}
+		return rows
+	}
+	sequence := func(from, n int) []row {
+		rows := make([]row, n)
+		for i := range rows {
+			v := int64(from + i)
+			rows[i] = row{&v}
+		}
+		return rows
+	}
+	inputs := []struct {
+		name        string
+		left, right []row
+	}{
+		{"duplicates", random(600, 1, 4), random(500, 1, 4)},
+		{"duplicates of 2 columns", random(700, 2, 3), random(400, 2, 3)},
+		{"many groups", random(1000, 2, 40), random(900, 2, 40)},
+		{"disjoint", sequence(0, 300), sequence(1000, 300)},
+		{"same", sequence(0, 300), sequence(0, 300)},
+		{"empty left", nil, random(10, 1, 4)},
+		{"empty right", random(10, 1, 4), nil},
+	}
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, in := range inputs {
+		for _, op := range []Op{Intersect, Except} {
+			for _, all := range []bool{true, false} {
+				expect := reference(op, all, in.left, in.right)
+				// the counters are spilled every few groups by a tiny limit
+				for _, limit := range []int64{0, 1, 2000} {
+					arg := &Argument{Op: op, All: all, MemoryLimit: limit}
+					name := fmt.Sprintf("%s %s%s limit %d", in.name, opName(op), allName(all), limit)
+					got := runSetOp(t, mheap.New(gm), arg, in.left, in.right)
+					require.Equal(t, expect, sortRows(got), name)
+					// the order is deterministic
+					require.Equal(t, got, runSetOp(t, mheap.New(gm), arg, in.left, in.right), name)
+				}
+			}
+		}
+	}
+}
+
+func runSetOp(t *testing.T, m *mheap.Mheap, arg *Argument, left, right []row) []row {
+	proc := process.New(m)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proc.Reg.MergeReceivers = []*process.WaitRegister{
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 16)},
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 16)},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	for i, rows := range [][]row{left, right} {
+		// in batches of 3 sizes
+		for start, size := 0, 1; start < len(rows); start, size = start+size, size*7%600+1 {
+			end := start + size
+			if end > len(rows) {
+				end = len(rows)
+			}
+			proc.Reg.MergeReceivers[i].Ch <- newInt64Batch(t, proc, rows[start:end])
+		}
+		proc.Reg.MergeReceivers[i].Ch <- &batch.Batch{}
+		proc.Reg.MergeReceivers[i].Ch <- nil
+	}
+	var rows []row
+	for {
+		ok, err := Call(proc, arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		bat := proc.Reg.InputBatch
+		for i, z := range bat.Zs {
+			r := make(row, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				if !nulls.Contains(vec.Nsp, uint64(i)) {
+					v := vec.Col.([]int64)[i]
+					r[j] = &v
+				}
+			}
+			for ; z > 0; z-- {
+				rows = append(rows, r)
+			}
+		}
+		bat.Clean(proc.Mp)
+	}
+	require.Nil(t, arg.ctr.spill)
+	// the spill files are removed, the directory is left to the end of the query
+	require.Equal(t, int64(0), proc.Spill().Size())
+	require.NoError(t, proc.Spill().Clean())
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	return rows
+}
+
+// reference computes the set operation on the sorted rows, a row is
+// returned by its multiplicities on both sides
+func reference(op Op, all bool, left, right []row) []row {
+	left, right = sortRows(left), sortRows(right)
+	var rows []row
+	for i, j := 0, 0; i < len(left); {
+		k := i
+		for k < len(left) && left[k].String() == left[i].String() {
+			k++
+		}
+		for j < len(right) && right[j].String() < left[i].String() {
+			j++
+		}
+		l := j
+		for l < len(right) && right[l].String() == left[i].String() {
+			l++
+		}
+		m, n := k-i, l-j
+		var cnt int
+		switch {
+		case op == Intersect && all:
+			cnt = int(min(int64(m), int64(n)))
+		case op == Except && all:
+			cnt = int(max(0, int64(m-n)))
+		case op == Intersect && n > 0, op == Except && n == 0:
+			cnt = 1
+		}
+		for ; cnt > 0; cnt-- {
+			rows = append(rows, left[i])
+		}
+		i, j = k, l
+	}
+	return rows
+}
+
+func sortRows(rows []row) []row {
+	rows = append([]row(nil), rows...)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].String() < rows[j].String()
+	})
+	return rows
+}
+
+func opName(op Op) string {
+	if op == Intersect {
+		return "intersect"
+	}
+	return "except"
+}
+
+func allName(all bool) string {
+	if all {
+		return " all"
+	}
+	return ""
+}
+
+func newInt64Batch(t *testing.T, proc *process.Process, rows []row) *batch.Batch {
+	bat := batch.NewWithSize(len(rows[0]))
+	bat.InitZsOne(len(rows))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, int64(len(rows))*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:len(rows)]
+		for j, row := range rows {
+			if row[i] == nil {
+				nulls.Add(vec.Nsp, uint64(j))
+			} else {
+				vs[j] = *row[i]
+			}
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
+
+func TestSetOpStrings(t *testing.T) {
+	newBatch := func(rows [][2]string) *batch.Batch {
+		bat := batch.NewWithSize(2)
+		bat.InitZsOne(len(rows))
+		for i := range bat.Vecs {
+			bat.Vecs[i] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+			for j, r := range rows {
+				if r[i] == "NULL" {
+					nulls.Add(bat.Vecs[i].Nsp, uint64(j))
+				}
+				require.NoError(t, vector.Append(bat.Vecs[i], [][]byte{[]byte(r[i])}))
+			}
+		}
+		return bat
+	}
+	left := [][2]string{{"ab", "c"}, {"a", "bc"}, {"NULL", "c"}, {"ab", "c"}}
+	right := [][2]string{{"ab", "c"}, {"NULL", "c"}}
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, limit := range []int64{0, 1} {
+		for op, expect := range map[Op][]string{
+			Intersect: {"NULL|c", "ab|c"},
+			Except:    {"a|bc"},
+		} {
+			proc := process.New(mheap.New(gm))
+			proc.Reg.MergeReceivers = []*process.WaitRegister{
+				{Ch: make(chan *batch.Batch, 2)},
+				{Ch: make(chan *batch.Batch, 2)},
+			}
+			proc.Reg.MergeReceivers[0].Ch <- newBatch(left)
+			proc.Reg.MergeReceivers[0].Ch <- nil
+			proc.Reg.MergeReceivers[1].Ch <- newBatch(right)
+			proc.Reg.MergeReceivers[1].Ch <- nil
+			arg := &Argument{Op: op, MemoryLimit: limit}
+			require.NoError(t, Prepare(proc, arg))
+			var got []string
+			for {
+				ok, err := Call(proc, arg)
+				require.NoError(t, err)
+				if ok {
+					break
+				}
+				bat := proc.Reg.InputBatch
+				for i := range bat.Zs {
+					var strs [2]string
+					for j, vec := range bat.Vecs {
+						strs[j] = string(vec.Col.(*types.Bytes).Get(int64(i)))
+						if nulls.Contains(vec.Nsp, uint64(i)) {
+							strs[j] = "NULL"
+						}
+					}
+					got = append(got, strs[0]+"|"+strs[1])
+				}
+				bat.Clean(proc.Mp)
+			}
+			sort.Strings(got)
+			require.Equal(t, expect, got, "%s limit %d", opName(op), limit)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setop
+
+import (
+	"bufio"
+
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Probe
+	Partition
+	Merge
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+// SpillPartitions is the number of partitions the rows are spilled to once
+// the counters exceed the memory limit
+const SpillPartitions = 16
+
+// Op is the set operation
+type Op int
+
+const (
+	Intersect Op = iota
+	Except
+)
+
+// spill is the rows spilled to disk, each partition has a file of the
+// counters of the right rows and a file of the left rows
+type spill struct {
+	rights []*process.SpillFile
+	lefts  []*process.SpillFile
+	ws     []*bufio.Writer
+	// r reads the left rows of the partition being merged
+	r *bufio.Reader
+}
+
+type Container struct {
+	state         int
+	keys          [][]byte
+	values        []uint64
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	// counts are the multiplicities of the right rows by group, seen are
+	// the left rows of the groups read so far
+	counts []int64
+	seen   []int64
+	// groupKeys are the keys of the groups, which are kept only if the
+	// counters may be spilled
+	groupKeys [][]byte
+	// size is the estimated bytes of the counters
+	size int64
+
+	spill *spill
+	// part is the partition being merged
+	part int
+}
+
+// Argument of the set operations INTERSECT and EXCEPT, which return the
+// rows of the left input also or not in the right input. All the columns
+// of the inputs are compared, and NULL equals NULL.
+type Argument struct {
+	ctr *Container
+	Op  Op
+	// All keeps the duplicates by the bag semantics, a row appearing m times
+	// on the left and n times on the right is returned min(m, n) times by
+	// INTERSECT ALL and max(0, m-n) times by EXCEPT ALL. Otherwise the rows
+	// returned are distinct.
+	All bool
+	// MemoryLimit is the bytes the counters of the right rows may take
+	// before both inputs are spilled to disk by partitions, 0 for no limit
+	MemoryLimit int64
+}
@@ -0,0 +1,333 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamgroup
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("stream γ([")
+	for i, expr := range ap.Exprs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v", expr))
+	}
+	buf.WriteString("], [")
+	for i, agg := range ap.Aggs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v(%v)", aggregate.Names[agg.Op], agg.E))
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	return nil
+}
+
+// Call emits the groups finished by each input batch, the aggregations are
+// evaluated like the final merge group does.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	var err error
+
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ctr.bat != nil {
+			ctr.eval()
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
+		}
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	defer bat.Clean(proc.Mp)
+	proc.Reg.InputBatch = &batch.Batch{}
+	if err = ctr.evalVectors(bat, ap, proc); err != nil {
+		return false, err
+	}
+	defer ctr.cleanVectors(proc)
+	if ctr.bat == nil {
+		if err = ctr.newBatch(ap, proc); err != nil {
+			return false, err
+		}
+	}
+	if err = ctr.fill(bat, proc); err != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+		return false, err
+	}
+	// all the groups but the last one are finished
+	if n := len(ctr.bat.Zs) - 1; n > 0 {
+		last, err := ctr.split(n, proc)
+		if err != nil {
+			ctr.bat.Clean(proc.Mp)
+			ctr.bat = nil
+			return false, err
+		}
+		ctr.eval()
+		proc.Reg.InputBatch = ctr.bat
+		ctr.bat = last
+	}
+	return false, nil
+}
+
+func (ctr *Container) evalVectors(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if len(ctr.aggVecs) == 0 {
+		ctr.aggVecs = make([]evalVector, len(ap.Aggs))
+		ctr.groupVecs = make([]evalVector, len(ap.Exprs))
+	}
+	for i, agg := range ap.Aggs {
+		vec, err := colexec.EvalExpr(bat, proc, agg.E)
+		if err != nil {
+			ctr.cleanVectors(proc)
+			return err
+		}
+		ctr.aggVecs[i] = evalVector{vec: vec, needFree: !inBatch(bat, vec)}
+	}
+	for i, expr := range ap.Exprs {
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			ctr.cleanVectors(proc)
+			return err
+		}
+		ctr.groupVecs[i] = evalVector{vec: vec, needFree: !inBatch(bat, vec)}
+	}
+	return nil
+}
+
+func (ctr *Container) cleanVectors(proc *process.Process) {
+	for _, evecs := range [][]evalVector{ctr.aggVecs, ctr.groupVecs} {
+		for i := range evecs {
+			if evecs[i].needFree {
+				vector.Clean(evecs[i].vec, proc.Mp)
+			}
+			evecs[i] = evalVector{}
+		}
+	}
+}
+
+func inBatch(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctr *Container) newBatch(ap *Argument, proc *process.Process) error {
+	var err error
+
+	ctr.bat = batch.NewWithSize(len(ap.Exprs))
+	for i := range ctr.groupVecs {
+		typ := ctr.groupVecs[i].vec.Typ
+		if typ.HasCollation() {
+			typ.Precision = colexec.ExprCollation(ap.Exprs[i])
+		}
+		ctr.bat.Vecs[i] = vector.New(typ)
+	}
+	ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
+	for i, agg := range ap.Aggs {
+		if ctr.bat.Rs[i], err = aggregate.New(agg.Op, agg.Dist, ctr.aggVecs[i].vec.Typ); err != nil {
+			ctr.bat.Rs = ctr.bat.Rs[:i]
+			ctr.bat.Clean(proc.Mp)
+			ctr.bat = nil
+			return err
+		}
+	}
+	ctr.inserted = make([]uint8, UnitLimit)
+	ctr.values = make([]uint64, UnitLimit)
+	return nil
+}
+
+// fill adds the rows of bat to the groups, a row starts a new group if its
+// keys differ from the keys of the row before it, the first row of bat is
+// compared with the open group.
+func (ctr *Container) fill(bat *batch.Batch, proc *process.Process) error {
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		cnt := 0
+		for k := 0; k < n; k++ {
+			ctr.inserted[k] = 0
+			if !ctr.sameGroup(int64(i + k)) {
+				ctr.inserted[k] = 1
+				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+				cnt++
+			}
+			g := len(ctr.bat.Zs)
+			ctr.values[k] = uint64(g)
+			ctr.bat.Zs[g-1] += bat.Zs[i+k]
+		}
+		if cnt > 0 {
+			for j, vec := range ctr.bat.Vecs {
+				if err := vector.UnionBatch(vec, ctr.groupVecs[j].vec, int64(i), cnt, ctr.inserted[:n], proc.Mp); err != nil {
+					return err
+				}
+			}
+			for _, r := range ctr.bat.Rs {
+				if err := r.Grows(cnt, proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+		for j, r := range ctr.bat.Rs {
+			r.BatchFill(int64(i), ctr.inserted[:n], ctr.values, bat.Zs, ctr.aggVecs[j].vec)
+		}
+	}
+	return nil
+}
+
+// sameGroup returns whether the row of the input belongs to the last group
+func (ctr *Container) sameGroup(row int64) bool {
+	if len(ctr.bat.Zs) == 0 {
+		return false
+	}
+	for j, evec := range ctr.groupVecs {
+		collation := ctr.bat.Vecs[j].Typ.Collation()
+		if row == 0 {
+			// the keys of the open group are not in the input
+			last := int64(len(ctr.bat.Zs) - 1)
+			if !equal(evec.vec, row, ctr.bat.Vecs[j], last, collation) {
+				return false
+			}
+		} else if !equal(evec.vec, row, evec.vec, row-1, collation) {
+			return false
+		}
+	}
+	return true
+}
+
+// split removes the groups from the n-th one from ctr.bat, and returns them
+// as a new batch. n is the last group.
+func (ctr *Container) split(n int, proc *process.Process) (*batch.Batch, error) {
+	last := batch.NewWithSize(len(ctr.bat.Vecs))
+	for j, vec := range ctr.bat.Vecs {
+		last.Vecs[j] = vector.New(vec.Typ)
+		if err := vector.UnionOne(last.Vecs[j], vec, int64(n), proc.Mp); err != nil {
+			last.Clean(proc.Mp)
+			return nil, err
+		}
+	}
+	last.Rs = make([]ring.Ring, 0, len(ctr.bat.Rs))
+	for _, r := range ctr.bat.Rs {
+		lr := r.Dup()
+		if err := lr.Grow(proc.Mp); err != nil {
+			last.Clean(proc.Mp)
+			return nil, err
+		}
+		lr.Add(r, 0, int64(n))
+		last.Rs = append(last.Rs, lr)
+	}
+	last.Zs = []int64{ctr.bat.Zs[n]}
+	for _, vec := range ctr.bat.Vecs {
+		vector.SetLength(vec, n)
+	}
+	for _, r := range ctr.bat.Rs {
+		r.SetLength(n)
+	}
+	ctr.bat.Zs = ctr.bat.Zs[:n]
+	return last, nil
+}
+
+// eval replaces the aggregations of ctr.bat with their results
+func (ctr *Container) eval() {
+	for _, r := range ctr.bat.Rs {
+		ctr.bat.Vecs = append(ctr.bat.Vecs, r.Eval(ctr.bat.Zs))
+	}
+	ctr.bat.Rs = nil
+	for i := range ctr.bat.Zs {
+		ctr.bat.Zs[i] = 1
+	}
+}
+
+// equal returns whether the i-th value of v equals the j-th value of w, NULLs
+// are equal to each other as they are in the same group.
+func equal(v *vector.Vector, i int64, w *vector.Vector, j int64, collation int32) bool {
+	vn, wn := nulls.Contains(v.Nsp, uint64(i)), nulls.Contains(w.Nsp, uint64(j))
+	if vn || wn {
+		return vn && wn
+	}
+	switch v.Typ.Oid {
+	case types.T_bool:
+		return equalFixed[bool](v, i, w, j)
+	case types.T_int8:
+		return equalFixed[int8](v, i, w, j)
+	case types.T_int16:
+		return equalFixed[int16](v, i, w, j)
+	case types.T_int32:
+		return equalFixed[int32](v, i, w, j)
+	case types.T_int64:
+		return equalFixed[int64](v, i, w, j)
+	case types.T_uint8:
+		return equalFixed[uint8](v, i, w, j)
+	case types.T_uint16:
+		return equalFixed[uint16](v, i, w, j)
+	case types.T_uint32:
+		return equalFixed[uint32](v, i, w, j)
+	case types.T_uint64, types.T_bit:
+		return equalFixed[uint64](v, i, w, j)
+	case types.T_float32:
+		return equalFixed[float32](v, i, w, j)
+	case types.T_float64:
+		return equalFixed[float64](v, i, w, j)
+	case types.T_date:
+		return equalFixed[types.Date](v, i, w, j)
+	case types.T_time:
+		return equalFixed[types.Time](v, i, w, j)
+	case types.T_datetime:
+		return equalFixed[types.Datetime](v, i, w, j)
+	case types.T_timestamp:
+		return equalFixed[types.Timestamp](v, i, w, j)
+	case types.T_decimal64:
+		return equalFixed[types.Decimal64](v, i, w, j)
+	case types.T_decimal128:
+		return equalFixed[types.Decimal128](v, i, w, j)
+	case types.T_char, types.T_varchar, types.T_json:
+		a, b := v.Col.(*types.Bytes).Get(i), w.Col.(*types.Bytes).Get(j)
+		if collation == types.CollationGeneralCI {
+			return types.CompareGeneralCI(a, b) == 0
+		}
+		return bytes.Equal(a, b)
+	}
+	panic(fmt.Sprintf("unexpected group key type %s", v.Typ))
+}
+
+func equalFixed[T comparable](v *vector.Vector, i int64, w *vector.Vector, j int64) bool {
+	return v.Col.([]T)[i] == w.Col.([]T)[j]
+}
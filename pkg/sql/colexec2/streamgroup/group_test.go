@@ -0,0 +1,225 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamgroup
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// null is the NULL key of the test batches
+const null = -1
+
+var aggs = []aggregate.Aggregate{
+	{Op: aggregate.Sum, E: newExpression(1)},
+	{Op: aggregate.Count, E: newExpression(1)},
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Exprs: []*plan.Expr{newExpression(0)}, Aggs: aggs}, buf)
+	require.Equal(t, "stream γ([", buf.String()[:len("stream γ([")])
+}
+
+func TestStreamGroup(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		keys [][]int64
+	}{
+		{"single row groups", [][]int64{{0, 1, 2}, {3, 4}, {5}}},
+		{"one group", [][]int64{{7, 7, 7}, {7}, {7, 7}}},
+		{"null keys", [][]int64{{null, null}, {null, 1, 1}, {1, 2}, {2}, {3, 3}}},
+		{"batch edges", [][]int64{{1, 1}, {2, 2}, {2, 3}, {4}}},
+		{"descending", [][]int64{{9, 9, 8}, {8, 5}, {5, null}}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			proc := newProcess()
+			stream := runStream(t, proc, c.keys)
+			require.Equal(t, int64(0), mheap.Size(proc.Mp))
+			hash := runHash(t, proc, c.keys)
+			require.Equal(t, int64(0), mheap.Size(proc.Mp))
+			require.ElementsMatch(t, hash, stream)
+			// the groups are emitted in the order of the input
+			var keys []string
+			for _, ks := range c.keys {
+				for _, k := range ks {
+					if key := keyString(k); len(keys) == 0 || keys[len(keys)-1] != key {
+						keys = append(keys, key)
+					}
+				}
+			}
+			require.Len(t, stream, len(keys))
+			for i, row := range stream {
+				require.Equal(t, keys[i], row[:len(keys[i])])
+			}
+		})
+	}
+}
+
+// TestStreamGroupMemory checks that only the open group is kept, whatever
+// the number of groups is.
+func TestStreamGroupMemory(t *testing.T) {
+	proc := newProcess()
+	arg := &Argument{Exprs: []*plan.Expr{newExpression(0)}, Aggs: aggs}
+	require.NoError(t, Prepare(proc, arg))
+	size := int64(-1)
+	for i := int64(0); i < 1000; i++ {
+		keys := make([]int64, 10)
+		for j := range keys {
+			keys[j] = i*10 + int64(j)
+		}
+		proc.Reg.InputBatch = newBatch(t, proc, keys)
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+		// the open group of the batch before is finished by the batch
+		finished := 10
+		if i == 0 {
+			finished = 9
+		}
+		require.Equal(t, finished, len(proc.Reg.InputBatch.Zs))
+		proc.Reg.InputBatch.Clean(proc.Mp)
+		require.Equal(t, 1, len(arg.ctr.bat.Zs))
+		if size < 0 {
+			size = mheap.Size(proc.Mp)
+		}
+		require.Equal(t, size, mheap.Size(proc.Mp), "batch %d", i)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+	require.Equal(t, 1, len(proc.Reg.InputBatch.Zs))
+	proc.Reg.InputBatch.Clean(proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func runStream(t *testing.T, proc *process.Process, keys [][]int64) []string {
+	var rows []string
+
+	arg := &Argument{Exprs: []*plan.Expr{newExpression(0)}, Aggs: aggs}
+	require.NoError(t, Prepare(proc, arg))
+	for i := 0; i <= len(keys); i++ {
+		proc.Reg.InputBatch = nil
+		if i < len(keys) {
+			proc.Reg.InputBatch = newBatch(t, proc, keys[i])
+		}
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		if bat := proc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+			rows = append(rows, batchRows(bat)...)
+			bat.Clean(proc.Mp)
+		}
+		require.Equal(t, i == len(keys), end)
+	}
+	return rows
+}
+
+func runHash(t *testing.T, proc *process.Process, keys [][]int64) []string {
+	arg := &group.Argument{Exprs: []*plan.Expr{newExpression(0)}, Aggs: aggs}
+	require.NoError(t, group.Prepare(proc, arg))
+	for _, ks := range keys {
+		proc.Reg.InputBatch = newBatch(t, proc, ks)
+		_, err := group.Call(proc, arg)
+		require.NoError(t, err)
+	}
+	proc.Reg.InputBatch = nil
+	_, err := group.Call(proc, arg)
+	require.NoError(t, err)
+	bat := proc.Reg.InputBatch
+	for _, r := range bat.Rs {
+		bat.Vecs = append(bat.Vecs, r.Eval(bat.Zs))
+	}
+	bat.Rs = nil
+	rows := batchRows(bat)
+	bat.Clean(proc.Mp)
+	return rows
+}
+
+func batchRows(bat *batch.Batch) []string {
+	rows := make([]string, len(bat.Zs))
+	for i := range rows {
+		key := int64(null)
+		if !nulls.Contains(bat.Vecs[0].Nsp, uint64(i)) {
+			key = bat.Vecs[0].Col.([]int64)[i]
+		}
+		rows[i] = keyString(key)
+		for _, vec := range bat.Vecs[1:] {
+			rows[i] += fmt.Sprintf("|%d", vec.Col.([]int64)[i])
+		}
+	}
+	return rows
+}
+
+func keyString(key int64) string {
+	if key == null {
+		return "NULL"
+	}
+	return fmt.Sprintf("%d", key)
+}
+
+func newProcess() *process.Process {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return process.New(mheap.New(gm))
+}
+
+func newExpression(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+// newBatch returns a batch of the keys and the values, the value of a row is
+// its key plus 10
+func newBatch(t *testing.T, proc *process.Process, keys []int64) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.InitZsOne(len(keys))
+	for i := range bat.Vecs {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, int64(len(keys))*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:len(keys)]
+		for j, key := range keys {
+			vs[j] = key + int64(i)*10
+			if key == null {
+				nulls.Add(vec.Nsp, uint64(j))
+			}
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
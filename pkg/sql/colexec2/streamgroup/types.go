@@ -0,0 +1,52 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamgroup
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+)
+
+const (
+	UnitLimit = 256
+)
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type Container struct {
+	inserted []uint8
+	values   []uint64
+
+	aggVecs   []evalVector
+	groupVecs []evalVector
+
+	// bat holds the groups of the current input batch, its last group is
+	// still open and carried to the next batch
+	bat *batch.Batch
+}
+
+// Argument of the streaming group by. The input must be ordered on the group
+// keys, so the rows of a group are adjacent and a group is finished as soon
+// as the keys change. Only the open group is kept across batches.
+type Argument struct {
+	ctr   *Container
+	Exprs []*plan.Expr          // group Expressions
+	Aggs  []aggregate.Aggregate // aggregations
+}
@@ -107,7 +107,7 @@ func (ctr *Container) build(ap *Argument, bat *batch.Batch, proc *process.Proces
 		ctr.cmps = make([]compare.Compare, len(bat.Vecs))
 		for i := range ctr.cmps {
 			if pos, ok := mp[i]; ok {
-				ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, ap.Fs[pos].Type == Descending)
+				ctr.cmps[i] = compare.NewWithCollation(bat.Vecs[i].Typ.Oid, colexec.ExprCollation(ap.Fs[pos].E), ap.Fs[pos].Type == Descending)
 			} else {
 				ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, true)
 			}
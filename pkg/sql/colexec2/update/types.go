@@ -0,0 +1,35 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+type Argument struct {
+	Ts           uint64
+	TableSource  engine.Relation
+	M            sync.Mutex
+	UseDeleteKey string
+	// Attrs, the columns of the table written by the update.
+	Attrs []string
+	// Values, the new values of Attrs, evaluated on the batch whose first
+	// vector is the key of the rows to update.
+	Values       []*plan.Expr
+	AffectedRows uint64
+}
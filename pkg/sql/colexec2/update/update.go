@@ -0,0 +1,113 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	buf.WriteString("update rows")
+}
+
+func Prepare(_ *process.Process, _ interface{}) error {
+	return nil
+}
+
+// Call updates the rows of the batch by deleting them with the key and
+// appending their new values.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	p := arg.(*Argument)
+	bat := proc.Reg.InputBatch
+	if bat == nil || len(bat.Zs) == 0 {
+		return false, nil
+	}
+	defer batch.Clean(bat, proc.Mp)
+
+	count := len(bat.Zs)
+	ubat := batch.New(true, p.Attrs)
+	defer batch.Clean(ubat, proc.Mp)
+	for i, e := range p.Values {
+		vec, err := colexec.EvalExpr(bat, proc, e)
+		if err != nil {
+			return false, err
+		}
+		ubat.Vecs[i] = vec
+	}
+	// the vectors shared with the input are taken over by ubat after all the
+	// values are evaluated, since the values refer to the input by position
+	for i, vec := range ubat.Vecs {
+		if vec.IsScalar() {
+			var err error
+			if ubat.Vecs[i], err = expandConst(vec, count, proc); err != nil {
+				return false, err
+			}
+			continue
+		}
+		shared := false
+		for k := 1; k < len(bat.Vecs); k++ {
+			if vec == bat.Vecs[k] {
+				bat.Vecs[k] = nil
+				shared = true
+				break
+			}
+		}
+		if shared {
+			continue
+		}
+		// a column assigned to more than one column is taken over once
+		for j := 0; j < i; j++ {
+			if vec == ubat.Vecs[j] {
+				dup, err := vector.Dup(vec, proc.Mp)
+				if err != nil {
+					return false, err
+				}
+				ubat.Vecs[i] = dup
+				break
+			}
+		}
+	}
+
+	if err := p.TableSource.Delete(p.Ts, bat.GetVector(0), p.UseDeleteKey, proc.Snapshot); err != nil {
+		return false, err
+	}
+	if err := p.TableSource.Write(p.Ts, ubat, proc.Snapshot); err != nil {
+		return false, err
+	}
+	proc.Reg.InputBatch = &batch.Batch{}
+
+	p.M.Lock()
+	p.AffectedRows += uint64(count)
+	p.M.Unlock()
+	return false, nil
+}
+
+// expandConst returns a vector of n copies of the value of the constant vector.
+func expandConst(vec *vector.Vector, n int, proc *process.Process) (*vector.Vector, error) {
+	defer vector.Clean(vec, proc.Mp)
+	rvec := vector.New(vec.Typ)
+	for i := 0; i < n; i++ {
+		if err := vector.UnionOne(rvec, vec, 0, proc.Mp); err != nil {
+			vector.Clean(rvec, proc.Mp)
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
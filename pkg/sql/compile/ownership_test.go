@@ -0,0 +1,94 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// TestColumnFeedsManyOperators runs the plans where a column or a constant is
+// read by more than one operator, the vectors given back are poisoned so that
+// a vector used after being given back is caught.
+func TestColumnFeedsManyOperators(t *testing.T) {
+	InitAddress("127.0.0.1")
+	defer func(poison bool) { process.PoisonReleased = poison }(process.PoisonReleased)
+	process.PoisonReleased = true
+
+	e := memEngine.NewTestEngine()
+	for _, sql := range []string{
+		"create table one (a int)",
+		"insert into one values (5)",
+		"insert into one values (7)",
+	} {
+		runTestQuery(t, e, sql)
+	}
+
+	for sql, rows := range map[string][]string{
+		// spid and score of t1 are (1, 1), (2, 2), (2, 4), (3, 3), (1, 5), (4, 10), (5, 99)
+		"select score + 1, score * 2, score from t1":                      {"2 2 1", "3 4 2", "5 8 4", "4 6 3", "6 10 5", "11 20 10", "100 198 99"},
+		"select score, score + 1, score - 1 from t1":                      {"1 2 0", "2 3 1", "4 5 3", "3 4 2", "5 6 4", "10 11 9", "99 100 98"},
+		"select spid + spid, spid from t1":                                {"2 1", "4 2", "4 2", "6 3", "2 1", "8 4", "10 5"},
+		"select spid, score from t1 where score > 2 and score < spid * 2": {"3 3"},
+		"select score from t1 where score + 1 > 4 and score - 1 < 9":      {"4", "5"},
+		// the constant is reused by every batch, and the batches of one
+		// row make it the last consumer of the constant
+		"select 10 - a, a + 1, a from one": {"5 6 5", "3 8 7"},
+	} {
+		for i := 0; i < 2; i++ {
+			require.ElementsMatch(t, rows, runTestQuery(t, e, sql), sql)
+		}
+	}
+}
+
+// runTestQuery runs sql and returns its rows, the values of a row are joined
+// by spaces.
+func runTestQuery(t *testing.T, e engine.Engine, sql string) []string {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	c := New("test", sql, "", e, proc)
+	es, err := c.Build()
+	require.NoError(t, err, sql)
+	var rows []string
+	for _, ex := range es {
+		require.NoError(t, ex.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat == nil {
+				return nil
+			}
+			for i := range bat.Zs {
+				row := make([]string, len(bat.Vecs))
+				for j, vec := range bat.Vecs {
+					row[j] = fmt.Sprint(reflect.ValueOf(vec.Col).Index(i).Interface())
+				}
+				rows = append(rows, strings.Join(row, " "))
+			}
+			return nil
+		}), sql)
+		require.NoError(t, ex.Run(0), sql)
+	}
+	return rows
+}
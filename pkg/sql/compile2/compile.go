@@ -16,6 +16,7 @@ package compile2
 
 import (
 	"fmt"
+	"runtime"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -25,9 +26,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/exchange"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -68,10 +73,37 @@ func (c *Compile) Compile(pn *plan.Plan, u interface{}, fill func(interface{}, *
 	if err != nil {
 		return err
 	}
+	fuseScope(s)
 	c.scope = s
 	return nil
 }
 
+// fuseScope replaces each restrict followed by a projection in the scope and
+// its children by a single instruction, so the rows filtered out are neither
+// copied nor projected.
+func fuseScope(s *Scope) {
+	for _, ps := range s.PreScopes {
+		fuseScope(ps)
+	}
+	ins := s.Instructions[:0]
+	for i := 0; i < len(s.Instructions); i++ {
+		in := s.Instructions[i]
+		if in.Op == overload.Restrict && i+1 < len(s.Instructions) && s.Instructions[i+1].Op == overload.Projection {
+			arg, ok := constructRestrictProjection(in.Arg.(*restrict.Argument), s.Instructions[i+1].Arg.(*projection.Argument))
+			if ok {
+				ins = append(ins, vm.Instruction{
+					Op:  overload.RestrictProjection,
+					Arg: arg,
+				})
+				i++
+				continue
+			}
+		}
+		ins = append(ins, in)
+	}
+	s.Instructions = ins
+}
+
 func (c *Compile) setAffectedRows(n uint64) {
 	c.affectRows = n
 }
@@ -94,6 +126,7 @@ func (c *Compile) Run(ts uint64) (err error) {
 
 	//	PrintScope(nil, []*Scope{c.scope})
 
+	c.bumpSchemaVersion()
 	switch c.scope.Magic {
 	case Normal:
 		return c.scope.Run(c.e)
@@ -109,6 +142,8 @@ func (c *Compile) Run(ts uint64) (err error) {
 		return c.scope.CreateTable(ts, c.proc.Snapshot, c.e, c.db)
 	case DropTable:
 		return c.scope.DropTable(ts, c.proc.Snapshot, c.e)
+	case AlterTable:
+		return c.scope.AlterTable(ts, c.proc.Snapshot, c.e, c.db)
 	case CreateIndex:
 		return c.scope.CreateIndex(ts, c.proc.Snapshot, c.e)
 	case DropIndex:
@@ -120,10 +155,47 @@ func (c *Compile) Run(ts uint64) (err error) {
 		}
 		c.setAffectedRows(affectedRows)
 		return nil
+	case Update:
+		affectedRows, err := c.scope.Update(ts, c.proc.Snapshot, c.e)
+		if err != nil {
+			return err
+		}
+		c.setAffectedRows(affectedRows)
+		return nil
 	}
 	return nil
 }
 
+// bumpSchemaVersion changes the schema versions of the tables changed by a DDL
+func (c *Compile) bumpSchemaVersion() {
+	ddl := c.scope.Plan.GetDdl()
+	switch c.scope.Magic {
+	case DropDatabase:
+		engine.BumpDatabaseSchemaVersion(ddl.GetDropDatabase().GetDatabase())
+	case CreateTable:
+		qry := ddl.GetCreateTable()
+		dbName := qry.GetDatabase()
+		if dbName == "" {
+			dbName = c.db
+		}
+		engine.BumpSchemaVersion(dbName, qry.GetTableDef().GetName())
+	case DropTable:
+		qry := ddl.GetDropTable()
+		engine.BumpSchemaVersion(qry.GetDatabase(), qry.GetTable())
+	case AlterTable:
+		qry := ddl.GetAlterTable()
+		dbName := c.db
+		for _, def := range qry.GetTableDef().GetDefs() {
+			for _, p := range def.GetProperties().GetProperties() {
+				if p.GetKey() == plan2.DatabasePropertyKey {
+					dbName = p.GetValue()
+				}
+			}
+		}
+		engine.BumpSchemaVersion(dbName, qry.GetTable())
+	}
+}
+
 func (c *Compile) compileScope(pn *plan.Plan) (*Scope, error) {
 	switch qry := pn.Plan.(type) {
 	case *plan.Plan_Query:
@@ -150,6 +222,11 @@ func (c *Compile) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: DropTable,
 				Plan:  pn,
 			}, nil
+		case plan.DataDefinition_ALTER_TABLE:
+			return &Scope{
+				Magic: AlterTable,
+				Plan:  pn,
+			}, nil
 		case plan.DataDefinition_CREATE_INDEX:
 			return &Scope{
 				Magic: CreateIndex,
@@ -187,6 +264,11 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 			PreScopes: ss,
 			Magic:     Deletion,
 		}
+	case plan.Query_UPDATE:
+		rs = &Scope{
+			PreScopes: ss,
+			Magic:     Update,
+		}
 	default:
 		rs = &Scope{
 			PreScopes: ss,
@@ -209,6 +291,15 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 			Op:  overload.Deletion,
 			Arg: scp,
 		})
+	case plan.Query_UPDATE:
+		scp, err := constructUpdate(qry.Nodes[qry.Steps[0]], c.e, c.proc.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  overload.Update,
+			Arg: scp,
+		})
 	default:
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op: overload.Output,
@@ -236,6 +327,18 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 	case plan.Node_VALUE_SCAN:
 		ds := &Scope{Magic: Normal}
 		ds.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+		if n.RowsetData != nil && n.TableDef != nil {
+			// a VALUES table, its rows are read in batches
+			src := &Source{
+				Attributes: make([]string, len(n.TableDef.Cols)),
+				R:          newValuesReader(n),
+			}
+			for i, col := range n.TableDef.Cols {
+				src.Attributes[i] = col.Name
+			}
+			ds.DataSource = src
+			return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, []*Scope{ds}))), nil
+		}
 		bat := batch.NewWithSize(1)
 		{
 			bat.Vecs[0] = vector.NewConst(types.Type{Oid: types.T_int64})
@@ -243,16 +346,28 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			bat.InitZsOne(1)
 		}
 		ds.DataSource = &Source{Bat: bat}
-		//if n.RowsetData != nil {
-		//	init bat from n.RowsetData
-		//}
 		return c.compileSort(n, c.compileProjection(n, []*Scope{ds})), nil
+	case plan.Node_FUNCTION_SCAN:
+		r, err := newTableFunctionReader(n, c.proc)
+		if err != nil {
+			return nil, err
+		}
+		ds := &Scope{Magic: Normal}
+		ds.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+		ds.DataSource = &Source{
+			Attributes: []string{n.TableDef.Cols[0].Name},
+			R:          r,
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, []*Scope{ds}))), nil
 	case plan.Node_TABLE_SCAN:
 		snap := engine.Snapshot(c.proc.Snapshot)
 		db, err := c.e.Database(n.ObjRef.SchemaName, snap)
 		if err != nil {
 			return nil, err
 		}
+		if partitions, ok := plan2.GetPartitionScan(n.TableDef); ok {
+			return c.compilePartitionScan(n, db, partitions)
+		}
 		rel, err := db.Relation(n.TableDef.Name, snap)
 		if err != nil {
 			return nil, err
@@ -272,24 +387,7 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			ds.DataSource = &Source{Bat: bat}
 			return c.compileSort(n, c.compileProjection(n, []*Scope{ds})), nil
 		}
-		src := &Source{
-			RelationName: n.TableDef.Name,
-			SchemaName:   n.ObjRef.SchemaName,
-			Attributes:   make([]string, len(n.TableDef.Cols)),
-		}
-		for i, col := range n.TableDef.Cols {
-			src.Attributes[i] = col.Name
-		}
-		nodes := rel.Nodes(snap)
-		ss := make([]*Scope, len(nodes))
-		for i := range nodes {
-			ss[i] = &Scope{
-				DataSource: src,
-				Magic:      Remote,
-				NodeInfo:   nodes[i],
-			}
-			ss[i].Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
-		}
+		ss := c.compileTableScan(n, n.TableDef.Name, rel, nil)
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_PROJECT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
@@ -308,6 +406,11 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_JOIN:
 		needSwap, joinTyp := joinType(n, ns)
+		if joinTyp == plan.Node_INNER && len(n.OnList) == 0 {
+			if err := c.checkCrossJoin(n); err != nil {
+				return nil, err
+			}
+		}
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
 			return nil, err
@@ -319,7 +422,11 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		if needSwap {
 			return c.compileSort(n, c.compileJoin(n, children, ss, joinTyp)), nil
 		}
-		return c.compileSort(n, c.compileJoin(n, ss, children, joinTyp)), nil
+		rs := c.compileJoin(n, ss, children, joinTyp)
+		if joinTyp == plan.Node_INNER {
+			rs = c.compileJoinRestrict(n, rs)
+		}
+		return c.compileSort(n, rs), nil
 	case plan.Node_SORT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
@@ -327,7 +434,7 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		}
 		ss = c.compileSort(n, ss)
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
-	case plan.Node_DELETE:
+	case plan.Node_DELETE, plan.Node_UPDATE:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
 			return nil, err
@@ -338,6 +445,76 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 	}
 }
 
+// compileTableScan appends the scopes reading the relation to ss
+func (c *Compile) compileTableScan(n *plan.Node, relName string, rel engine.Relation, ss []*Scope) []*Scope {
+	src := &Source{
+		RelationName: relName,
+		SchemaName:   n.ObjRef.SchemaName,
+		Attributes:   make([]string, len(n.TableDef.Cols)),
+		LockRows:     n.LockRows,
+		Progress:     c.proc.Progress().AddScan(relName, rel.Rows()),
+	}
+	path, hasPath := plan2.GetAccessPath(n.TableDef)
+	for i, col := range n.TableDef.Cols {
+		src.Attributes[i] = col.Name
+		if hasPath && path.Column == col.Name {
+			src.Path = path.Path
+			src.PathAttr = path.Column
+			if path.IsRange() {
+				src.PathRange = true
+				src.PathValue, src.PathMax = path.Range(col.Typ)
+			} else {
+				src.PathValue = path.Value(col.Typ)
+			}
+		}
+	}
+	nodes := rel.Nodes(engine.Snapshot(c.proc.Snapshot))
+	for i := range nodes {
+		s := &Scope{
+			DataSource: src,
+			Magic:      Remote,
+			NodeInfo:   nodes[i],
+		}
+		s.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+		ss = append(ss, s)
+	}
+	return ss
+}
+
+// compilePartitionScan builds the scopes reading the partitions left after
+// pruning, the partitions are read in parallel
+func (c *Compile) compilePartitionScan(n *plan.Node, db engine.Database, partitions []string) ([]*Scope, error) {
+	snap := engine.Snapshot(c.proc.Snapshot)
+	var ss []*Scope
+	for _, partition := range partitions {
+		relName := plan2.PartitionTableName(n.TableDef.Name, partition)
+		rel, err := db.Relation(relName, snap)
+		if err != nil {
+			return nil, err
+		}
+		if rel.Rows() > 0 {
+			ss = c.compileTableScan(n, relName, rel, ss)
+		}
+		rel.Close(snap)
+	}
+	if len(ss) == 0 {
+		bat := batch.NewWithSize(len(n.TableDef.Cols))
+		for i, col := range n.TableDef.Cols {
+			bat.Vecs[i] = vector.New(types.Type{
+				Oid:   types.T(col.Typ.Id),
+				Width: col.Typ.Width,
+				Size:  col.Typ.Size,
+				Scale: col.Typ.Scale,
+			})
+		}
+		ds := &Scope{Magic: Normal}
+		ds.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+		ds.DataSource = &Source{Bat: bat}
+		return c.compileSort(n, c.compileProjection(n, []*Scope{ds})), nil
+	}
+	return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+}
+
 func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	if len(n.WhereList) == 0 {
 		return ss
@@ -351,6 +528,33 @@ func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
+// compileJoinRestrict appends to ss the filter of the conditions of an
+// inner join which are not equalities, they are applied to the result of
+// the join
+func (c *Compile) compileJoinRestrict(n *plan.Node, ss []*Scope) []*Scope {
+	if len(n.WhereList) == 0 {
+		return ss
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  overload.Restrict,
+			Arg: constructJoinRestrict(n),
+		})
+	}
+	return ss
+}
+
+// checkCrossJoin refuses a cross join estimated to make more rows than the
+// max_cross_join_rows of the session, which is mostly a join condition left
+// out by mistake
+func (c *Compile) checkCrossJoin(n *plan.Node) error {
+	limit := c.proc.SessionInfo.MaxCrossJoinRows
+	if limit <= 0 || n.Cost == nil || n.Cost.Card <= float64(limit) {
+		return nil
+	}
+	return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("cross join is estimated to make %.0f rows, more than max_cross_join_rows %d, add a join condition or set max_cross_join_rows to 0", n.Cost.Card, limit))
+}
+
 func (c *Compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -501,10 +705,13 @@ func (c *Compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 
 func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
-		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op:  overload.Order,
-			Arg: constructOrder(n, c.proc),
-		})
+		// the sorted outputs are merged without sorting them again
+		if arg := constructOrder(n, c.proc); !sortedBy(ss[i], arg.Fs) {
+			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+				Op:  overload.Order,
+				Arg: arg,
+			})
+		}
 	}
 	rs := &Scope{
 		PreScopes: ss,
@@ -581,6 +788,18 @@ func (c *Compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 }
 
 func (c *Compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
+	if len(n.GroupBy) > 0 {
+		// the groups of the rows ordered on the group keys are built one
+		// after another, without a hash table
+		if len(ss) == 1 && groupedBy(ss[0], n.GroupBy) {
+			ss[0].Instructions = append(ss[0].Instructions, vm.Instruction{
+				Op:  overload.StreamGroup,
+				Arg: constructStreamGroup(n),
+			})
+			return ss
+		}
+		return c.compileExchangeGroup(n, ss, c.NumCPU())
+	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Group,
@@ -609,6 +828,62 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+// compileExchangeGroup builds the group by of keys in parallel. The partial
+// results of the scopes are repartitioned across cnt merge scopes by the hash
+// of the group keys, so each merge scope owns a disjoint part of the groups
+// and their results are simply unioned.
+func (c *Compile) compileExchangeGroup(n *plan.Node, ss []*Scope, cnt int) []*Scope {
+	rs := &Scope{
+		Magic: Merge,
+	}
+	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, cnt)
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  overload.Merge,
+		Arg: &merge.Argument{},
+	})
+	gs := make([]*Scope, cnt)
+	for i := range gs {
+		gs[i] = &Scope{
+			Magic: Merge,
+		}
+		gs[i].Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
+		gs[i].Instructions = append(gs[i].Instructions, vm.Instruction{
+			Op:  overload.MergeGroup,
+			Arg: constructMergeGroup(n, true),
+		})
+		gs[i].Instructions = append(gs[i].Instructions, vm.Instruction{
+			Op: overload.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	for i := range ss {
+		regs := make([]*process.WaitRegister, cnt)
+		for j := range gs {
+			regs[j] = gs[j].Proc.Reg.MergeReceivers[i]
+		}
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  overload.Group,
+			Arg: constructGroup(n),
+		})
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: overload.Exchange,
+			Arg: &exchange.Argument{
+				Regs: regs,
+			},
+		})
+	}
+	rs.PreScopes = append(ss, gs...)
+	return []*Scope{rs}
+}
+
+// NumCPU returns the number of the scopes running an operator in parallel
+func (c *Compile) NumCPU() int {
+	return runtime.NumCPU()
+}
+
 func rewriteExprListForAggNode(es []*plan.Expr, groupSize int32) {
 	for i := range es {
 		rewriteExprForAggNode(es[i], groupSize)
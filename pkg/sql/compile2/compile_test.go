@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrictprojection"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/overload"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuseScope(t *testing.T) {
+	cond := &plan.Expr{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Bval{Bval: true}}}}
+	col := &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}}}
+	_, id, _, err := function.GetFunctionByName("connection_id", nil)
+	require.NoError(t, err)
+	volatile := &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{Obj: id}}}}
+
+	s := &Scope{
+		Instructions: vm.Instructions{
+			{Op: overload.Restrict, Arg: &restrict.Argument{E: cond}},
+			{Op: overload.Projection, Arg: &projection.Argument{Es: []*plan.Expr{col}}},
+			{Op: overload.Output, Arg: &output.Argument{}},
+		},
+		PreScopes: []*Scope{
+			{
+				Instructions: vm.Instructions{
+					{Op: overload.Restrict, Arg: &restrict.Argument{E: cond}},
+					{Op: overload.Projection, Arg: &projection.Argument{Es: []*plan.Expr{col, volatile}}},
+				},
+			},
+			{
+				Instructions: vm.Instructions{
+					{Op: overload.Projection, Arg: &projection.Argument{Es: []*plan.Expr{col}}},
+					{Op: overload.Restrict, Arg: &restrict.Argument{E: cond}},
+				},
+			},
+		},
+	}
+	fuseScope(s)
+	require.Equal(t, []int{overload.RestrictProjection, overload.Output}, opsOf(s))
+	arg := s.Instructions[0].Arg.(*restrictprojection.Argument)
+	require.Equal(t, cond, arg.E)
+	require.Equal(t, []*plan.Expr{col}, arg.Es)
+	// a volatile projection is evaluated after the restrict
+	require.Equal(t, []int{overload.Restrict, overload.Projection}, opsOf(s.PreScopes[0]))
+	require.Equal(t, []int{overload.Projection, overload.Restrict}, opsOf(s.PreScopes[1]))
+
+	in := dupInstruction(s.Instructions[0])
+	require.Equal(t, arg.Es, in.Arg.(*restrictprojection.Argument).Es)
+	require.NotSame(t, arg, in.Arg)
+}
+
+func opsOf(s *Scope) []int {
+	ops := make([]int, len(s.Instructions))
+	for i, in := range s.Instructions {
+		ops[i] = in.Op
+	}
+	return ops
+}
@@ -43,11 +43,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrictprojection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/setop"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/streamgroup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/update"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -90,9 +96,16 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 	case *complement.Argument:
 		rin.Arg = &complement.Argument{
 			IsPreBuild: arg.IsPreBuild,
+			NullAware:  arg.NullAware,
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
 		}
+	case *setop.Argument:
+		rin.Arg = &setop.Argument{
+			Op:          arg.Op,
+			All:         arg.All,
+			MemoryLimit: arg.MemoryLimit,
+		}
 	case *offset.Argument:
 		rin.Arg = &offset.Argument{
 			Offset: arg.Offset,
@@ -109,6 +122,11 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 		rin.Arg = &restrict.Argument{
 			E: arg.E,
 		}
+	case *restrictprojection.Argument:
+		rin.Arg = &restrictprojection.Argument{
+			E:  arg.E,
+			Es: arg.Es,
+		}
 	case *output.Argument:
 		rin.Arg = &output.Argument{
 			Data: arg.Data,
@@ -143,12 +161,48 @@ func constructDeletion(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot)
 	}, nil
 }
 
+func constructUpdate(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot) (*update.Argument, error) {
+	dbSource, err := eg.Database(n.ObjRef.SchemaName, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	relation, err := dbSource.Relation(n.TableDef.Name, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make([]string, len(n.UpdateList.Columns))
+	for i, col := range n.UpdateList.Columns {
+		attrs[i] = col.ColName
+	}
+	return &update.Argument{
+		TableSource:  relation,
+		UseDeleteKey: n.UseDeleteKey,
+		Attrs:        attrs,
+		Values:       n.UpdateList.Values,
+	}, nil
+}
+
 func constructProjection(n *plan.Node) *projection.Argument {
 	return &projection.Argument{
 		Es: n.ProjectList,
 	}
 }
 
+// constructRestrictProjection fuses a restrict and the projection following
+// it, ok is false if the projection has a volatile expression, which must be
+// evaluated once for each row of the projection.
+func constructRestrictProjection(r *restrict.Argument, p *projection.Argument) (*restrictprojection.Argument, bool) {
+	for _, e := range p.Es {
+		if projection.IsVolatile(e) {
+			return nil, false
+		}
+	}
+	return &restrictprojection.Argument{
+		E:  r.E,
+		Es: p.Es,
+	}, true
+}
+
 func constructTop(n *plan.Node, proc *process.Process) *top.Argument {
 	vec, err := colexec.EvalExpr(constBat, proc, n.Limit)
 	if err != nil {
@@ -178,7 +232,8 @@ func constructJoin(n *plan.Node, proc *process.Process) *join.Argument {
 		conds[1] = make([]join.Condition, len(n.OnList))
 	}
 	for i, expr := range n.OnList {
-		conds[0][i].Expr, conds[1][i].Expr = constructJoinCondition(expr)
+		conds[0][i].Expr, conds[1][i].Expr, conds[0][i].NullSafe = constructJoinCondition(expr)
+		conds[1][i].NullSafe = conds[0][i].NullSafe
 	}
 	return &join.Argument{
 		IsPreBuild: false,
@@ -202,7 +257,8 @@ func constructSemi(n *plan.Node, proc *process.Process) *semi.Argument {
 		conds[1] = make([]semi.Condition, len(n.OnList))
 	}
 	for i, expr := range n.OnList {
-		conds[0][i].Expr, conds[1][i].Expr = constructJoinCondition(expr)
+		conds[0][i].Expr, conds[1][i].Expr, conds[0][i].NullSafe = constructJoinCondition(expr)
+		conds[1][i].NullSafe = conds[0][i].NullSafe
 	}
 	return &semi.Argument{
 		IsPreBuild: false,
@@ -222,7 +278,8 @@ func constructLeft(n *plan.Node, proc *process.Process) *left.Argument {
 		conds[1] = make([]left.Condition, len(n.OnList))
 	}
 	for i, expr := range n.OnList {
-		conds[0][i].Expr, conds[1][i].Expr = constructJoinCondition(expr)
+		conds[0][i].Expr, conds[1][i].Expr, conds[0][i].NullSafe = constructJoinCondition(expr)
+		conds[1][i].NullSafe = conds[0][i].NullSafe
 	}
 	return &left.Argument{
 		IsPreBuild: false,
@@ -239,6 +296,42 @@ func constructProduct(n *plan.Node, proc *process.Process) *product.Argument {
 	return &product.Argument{Result: result}
 }
 
+// constructJoinRestrict returns the filter of the residual conditions of a
+// join, whose columns refer to the sides of the join, so they are mapped to
+// the columns of the result of the join
+func constructJoinRestrict(n *plan.Node) *restrict.Argument {
+	es := make([]*plan.Expr, len(n.WhereList))
+	for i, e := range n.WhereList {
+		es[i] = plan2.DeepCopyExpr(e)
+		resetJoinResultPosition(es[i], n.ProjectList)
+	}
+	return &restrict.Argument{
+		E: colexec.RewriteFilterExprList(es),
+	}
+}
+
+func resetJoinResultPosition(expr *plan.Expr, result []*plan.Expr) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		for i, r := range result {
+			rel, pos := constructJoinResult(r)
+			if rel == e.Col.RelPos && pos == e.Col.ColPos {
+				e.Col.RelPos, e.Col.ColPos = 0, int32(i)
+				return
+			}
+		}
+		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join condition '%s' not support now", expr)))
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			resetJoinResultPosition(arg, result)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			resetJoinResultPosition(arg, result)
+		}
+	}
+}
+
 func constructComplement(n *plan.Node, proc *process.Process) *complement.Argument {
 	result := make([]int32, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -254,10 +347,12 @@ func constructComplement(n *plan.Node, proc *process.Process) *complement.Argume
 		conds[1] = make([]complement.Condition, len(n.OnList))
 	}
 	for i, expr := range n.OnList {
-		conds[0][i].Expr, conds[1][i].Expr = constructJoinCondition(expr)
+		conds[0][i].Expr, conds[1][i].Expr, conds[0][i].NullSafe = constructJoinCondition(expr)
+		conds[1][i].NullSafe = conds[0][i].NullSafe
 	}
 	return &complement.Argument{
 		IsPreBuild: false,
+		NullAware:  n.NullAware,
 		Conditions: conds,
 		Result:     result,
 	}
@@ -321,6 +416,14 @@ func constructGroup(n *plan.Node) *group.Argument {
 	}
 }
 
+func constructStreamGroup(n *plan.Node) *streamgroup.Argument {
+	arg := constructGroup(n)
+	return &streamgroup.Argument{
+		Aggs:  arg.Aggs,
+		Exprs: arg.Exprs,
+	}
+}
+
 func constructMergeGroup(_ *plan.Node, needEval bool) *mergegroup.Argument {
 	return &mergegroup.Argument{
 		NeedEval: needEval,
@@ -378,6 +481,113 @@ func constructMergeOrder(n *plan.Node, proc *process.Process) *mergeorder.Argume
 	}
 }
 
+// sortedBy returns whether the output of the scope is already sorted by fs,
+// the sort of a scope is kept by the filters and the projections of columns.
+func sortedBy(s *Scope, fs []order.Field) bool {
+	cols := make([]int32, len(fs))
+	for i, f := range fs {
+		col, ok := f.E.Expr.(*plan.Expr_Col)
+		if !ok {
+			return false
+		}
+		cols[i] = col.Col.ColPos
+	}
+	sorted, ok := orderOf(s, cols)
+	return ok && sortedByFields(sorted, fs, cols)
+}
+
+// groupedBy returns whether the output of s is ordered on the group keys, the
+// leading fields of its order are the keys in any order and direction, so the
+// rows of a group are adjacent.
+func groupedBy(s *Scope, keys []*plan.Expr) bool {
+	cols := make([]int32, len(keys))
+	for i, key := range keys {
+		col, ok := key.Expr.(*plan.Expr_Col)
+		if !ok {
+			return false
+		}
+		cols[i] = col.Col.ColPos
+	}
+	sorted, ok := orderOf(s, cols)
+	if !ok || len(sorted) < len(keys) {
+		return false
+	}
+	used := make([]bool, len(keys))
+	for _, f := range sorted[:len(keys)] {
+		col, ok := f.E.Expr.(*plan.Expr_Col)
+		if !ok {
+			return false
+		}
+		found := false
+		for i, pos := range cols {
+			// the keys are compared under the collation they are sorted by
+			if !used[i] && pos == col.Col.ColPos && colexec.ExprCollation(f.E) == colexec.ExprCollation(keys[i]) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// orderOf returns the order of the output of s, the columns cols of the
+// output are mapped to the columns of the sorted rows.
+func orderOf(s *Scope, cols []int32) ([]order.Field, bool) {
+	for i := len(s.Instructions) - 1; i >= 0; i-- {
+		switch in := s.Instructions[i]; in.Op {
+		case overload.Restrict:
+		case overload.Projection, overload.RestrictProjection:
+			var es []*plan.Expr
+			if arg, ok := in.Arg.(*projection.Argument); ok {
+				es = arg.Es
+			} else {
+				es = in.Arg.(*restrictprojection.Argument).Es
+			}
+			for j, pos := range cols {
+				if int(pos) >= len(es) {
+					return nil, false
+				}
+				col, ok := es[pos].Expr.(*plan.Expr_Col)
+				if !ok {
+					return nil, false
+				}
+				cols[j] = col.Col.ColPos
+			}
+		case overload.Order:
+			return in.Arg.(*order.Argument).Fs, true
+		case overload.MergeOrder:
+			return in.Arg.(*mergeorder.Argument).Fs, true
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// sortedByFields returns whether the order of sorted is the order of fs, whose
+// columns are cols of the sorted rows.
+func sortedByFields(sorted []order.Field, fs []order.Field, cols []int32) bool {
+	if len(sorted) < len(fs) {
+		return false
+	}
+	for i, f := range fs {
+		col, ok := sorted[i].E.Expr.(*plan.Expr_Col)
+		if !ok || col.Col.ColPos != cols[i] {
+			return false
+		}
+		if (sorted[i].Type == order.Descending) != (f.Type == order.Descending) {
+			return false
+		}
+		if colexec.ExprCollation(sorted[i].E) != colexec.ExprCollation(f.E) {
+			return false
+		}
+	}
+	return true
+}
+
 func constructJoinResult(expr *plan.Expr) (int32, int32) {
 	e, ok := expr.Expr.(*plan.Expr_Col)
 	if !ok {
@@ -386,20 +596,24 @@ func constructJoinResult(expr *plan.Expr) (int32, int32) {
 	return e.Col.RelPos, e.Col.ColPos
 }
 
-func constructJoinCondition(expr *plan.Expr) (*plan.Expr, *plan.Expr) {
+// constructJoinCondition returns the probe and build side of an equi-join
+// condition, and whether NULL keys of both sides match each other
+func constructJoinCondition(expr *plan.Expr) (*plan.Expr, *plan.Expr, bool) {
 	e, ok := expr.Expr.(*plan.Expr_F)
 	if !ok || !supportedJoinCondition(e.F.Func.GetObj()) {
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join condition '%s' not support now", expr)))
 	}
+	fid, _ := function.DecodeOverloadID(e.F.Func.GetObj())
+	nullSafe := fid == function.NULL_SAFE_EQUAL
 	if exprRelPos(e.F.Args[0]) == 1 {
-		return e.F.Args[1], e.F.Args[0]
+		return e.F.Args[1], e.F.Args[0], nullSafe
 	}
-	return e.F.Args[0], e.F.Args[1]
+	return e.F.Args[0], e.F.Args[1], nullSafe
 }
 
 func supportedJoinCondition(id int64) bool {
 	fid, _ := function.DecodeOverloadID(id)
-	return fid == function.EQUAL
+	return fid == function.EQUAL || fid == function.NULL_SAFE_EQUAL
 }
 
 func exprRelPos(expr *plan.Expr) int32 {
@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// progressReader counts the rows and the blocks read by a reader of a table
+// scan in the progress of the scan, each batch read is a block
+type progressReader struct {
+	engine.Reader
+	progress *process.ScanProgress
+}
+
+func newProgressReader(r engine.Reader, progress *process.ScanProgress) engine.Reader {
+	if progress == nil {
+		return r
+	}
+	return &progressReader{Reader: r, progress: progress}
+}
+
+func (r *progressReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	bat, err := r.Reader.Read(refCnts, attrs)
+	if err == nil && bat != nil {
+		r.progress.Add(int64(batch.Length(bat)))
+	}
+	return bat, err
+}
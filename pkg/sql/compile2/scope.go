@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/deletion"
 	"runtime"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 
@@ -39,7 +40,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/update"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -48,15 +51,26 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-func (s *Scope) CreateDatabase(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
-	dbName := s.Plan.GetDdl().GetCreateDatabase().GetDatabase()
-	if _, err := engine.Database(dbName, snapshot); err == nil {
-		if s.Plan.GetDdl().GetCreateDatabase().GetIfNotExists() {
+func (s *Scope) CreateDatabase(ts uint64, snapshot engine.Snapshot, eg engine.Engine) error {
+	qry := s.Plan.GetDdl().GetCreateDatabase()
+	dbName := qry.GetDatabase()
+	if _, err := eg.Database(dbName, snapshot); err == nil {
+		if qry.GetIfNotExists() {
 			return nil
 		}
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("database %s already exists", dbName))
 	}
-	return engine.Create(ts, dbName, 0, snapshot)
+	if qry.GetCharset() == "" && qry.GetCollation() == "" {
+		return eg.Create(ts, dbName, 0, snapshot)
+	}
+	de, ok := eg.(engine.DefaultsEngine)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, "the engine does not support the default charset and collation of databases")
+	}
+	return de.CreateWithDefaults(ts, dbName, 0, engine.DatabaseDefaults{
+		Charset:   qry.GetCharset(),
+		Collation: qry.GetCollation(),
+	}, snapshot)
 }
 
 func (s *Scope) DropDatabase(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
@@ -70,7 +84,7 @@ func (s *Scope) DropDatabase(ts uint64, snapshot engine.Snapshot, engine engine.
 	return engine.Delete(ts, dbName, snapshot)
 }
 
-func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.Engine, dbName string) error {
+func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, eg engine.Engine, dbName string) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
 	planCols := qry.GetTableDef().GetCols()
@@ -83,11 +97,25 @@ func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.E
 	if qry.GetDatabase() != "" {
 		dbName = qry.GetDatabase()
 	}
-	dbSource, err := engine.Database(dbName, snapshot)
+	dbSource, err := eg.Database(dbName, snapshot)
 	if err != nil {
 		return err
 	}
 	tblName := qry.GetTableDef().GetName()
+	if qry.GetTemporary() {
+		// a temporary table shadows the table of the same name
+		tmpSource, ok := dbSource.(engine.TemporaryDatabase)
+		if !ok {
+			return errors.New(errno.FeatureNotSupported, "temporary tables are not supported by the storage engine")
+		}
+		if tmpSource.IsTemporary(tblName) {
+			if qry.GetIfNotExists() {
+				return nil
+			}
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("table '%s' already exists", tblName))
+		}
+		return tmpSource.CreateTemporary(ts, tblName, append(exeCols, exeDefs...), snapshot)
+	}
 	if relation, err := dbSource.Relation(tblName, snapshot); err == nil {
 		relation.Close(snapshot)
 		if qry.GetIfNotExists() {
@@ -95,7 +123,27 @@ func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.E
 		}
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("table '%s' already exists", tblName))
 	}
-	return dbSource.Create(ts, tblName, append(exeCols, exeDefs...), snapshot)
+	if err := dbSource.Create(ts, tblName, append(exeCols, exeDefs...), snapshot); err != nil {
+		return err
+	}
+	partition, err := plan2.GetPartitionDef(qry.GetTableDef())
+	if err != nil || partition == nil {
+		return err
+	}
+	// each partition is stored in a relation of the same columns
+	var partDefs []*plan.TableDef_DefType
+	for _, def := range planDefs {
+		if def.GetProperties() == nil {
+			partDefs = append(partDefs, def)
+		}
+	}
+	for _, name := range partition.Names {
+		defs := append(planColsToExeCols(planCols), planDefsToExeDefs(partDefs)...)
+		if err := dbSource.Create(ts, plan2.PartitionTableName(tblName, name), defs, snapshot); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
@@ -110,15 +158,75 @@ func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Eng
 		return err
 	}
 	tblName := qry.GetTable()
-	if relation, err := dbSource.Relation(tblName, snapshot); err != nil {
+	relation, err := dbSource.Relation(tblName, snapshot)
+	if err != nil {
 		if qry.GetIfExists() {
 			return nil
 		}
 		return err
-	} else {
+	}
+	partition, err := getPartitionDef(relation, snapshot)
+	relation.Close(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := dbSource.Delete(ts, tblName, snapshot); err != nil {
+		return err
+	}
+	if partition != nil {
+		return dropPartitions(ts, snapshot, dbSource, tblName, partition.Names)
+	}
+	return nil
+}
+
+func (s *Scope) AlterTable(ts uint64, snapshot engine.Snapshot, engine engine.Engine, dbName string) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+	var drops []string
+	for _, def := range qry.GetTableDef().GetDefs() {
+		for _, p := range def.GetProperties().GetProperties() {
+			switch p.GetKey() {
+			case plan2.DatabasePropertyKey:
+				dbName = p.GetValue()
+			case plan2.DropPartitionPropertyKey:
+				drops = append(drops, strings.Split(p.GetValue(), ",")...)
+			}
+		}
+	}
+	dbSource, err := engine.Database(dbName, snapshot)
+	if err != nil {
+		return err
+	}
+	return dropPartitions(ts, snapshot, dbSource, qry.GetTable(), drops)
+}
+
+// dropPartitions removes the relations storing the partitions, the relations
+// of partitions dropped before are skipped
+func dropPartitions(ts uint64, snapshot engine.Snapshot, dbSource engine.Database, tblName string, partitions []string) error {
+	for _, name := range partitions {
+		relName := plan2.PartitionTableName(tblName, name)
+		relation, err := dbSource.Relation(relName, snapshot)
+		if err != nil {
+			continue
+		}
 		relation.Close(snapshot)
+		if err := dbSource.Delete(ts, relName, snapshot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getPartitionDef(relation engine.Relation, snapshot engine.Snapshot) (*plan2.PartitionDef, error) {
+	for _, def := range relation.TableDefs(snapshot) {
+		if properties, ok := def.(*engine.PropertiesDef); ok {
+			for _, p := range properties.Properties {
+				if p.Key == plan2.PartitionPropertyKey {
+					return plan2.UnmarshalPartitionDef(p.Value)
+				}
+			}
+		}
 	}
-	return dbSource.Delete(ts, tblName, snapshot)
+	return nil, nil
 }
 
 func (s *Scope) CreateIndex(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
@@ -196,7 +304,14 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 					Value:  planValToExeVal(col.GetDefault().GetValue(), colTyp.GetId()),
 					IsNull: col.GetDefault().GetIsNull(),
 				},
-				Primary: col.GetPrimary(),
+				Primary:       col.GetPrimary(),
+				NotNull:       !colTyp.GetNullable(),
+				AutoIncrement: col.GetAutoIncr(),
+				Comment:       col.GetComment(),
+				Generated:     col.GetGenerated(),
+
+				DefaultExpression: col.GetDefaultExpression(),
+				OnUpdate:          col.GetOnUpdate(),
 			},
 		}
 	}
@@ -215,6 +330,8 @@ func planValToExeVal(value *plan.ConstantValue, typ plan.Type_TypeId) interface{
 			return int32(v.Int64V)
 		case plan.Type_INT64:
 			return v.Int64V
+		case plan.Type_TIME:
+			return types.Time(v.Int64V)
 		}
 	case *plan.ConstantValue_Uint64V:
 		switch typ {
@@ -267,10 +384,27 @@ func PrintScope(prefix []byte, ss []*Scope) {
 }
 
 // Get the number of cpu's available for the current scope
+func (s *Scope) Update(ts uint64, snapshot engine.Snapshot, engine engine.Engine) (uint64, error) {
+	s.Magic = Merge
+	arg := s.Instructions[len(s.Instructions)-1].Arg.(*update.Argument)
+	arg.Ts = ts
+	defer arg.TableSource.Close(snapshot)
+	if err := s.MergeRun(engine); err != nil {
+		return 0, err
+	}
+	return arg.AffectedRows, nil
+}
+
 func (s *Scope) NumCPU() int {
 	return runtime.NumCPU()
 }
 
+// shareParallelism returns the parallelism of each of the n scopes running in
+// parallel for the scope of proc
+func shareParallelism(proc *process.Process, n int) int {
+	return proc.Lim.Parallelism / n
+}
+
 // Run read data from storage engine and run the instructions of scope.
 func (s *Scope) Run(e engine.Engine) (err error) {
 	p := pipeline2.New(s.DataSource.Attributes, s.Instructions, s.Reg)
@@ -352,6 +486,7 @@ func (s *Scope) DispatchRun(e engine.Engine) error {
 			Magic: Merge,
 		}
 		ss[i].Proc = process.NewFromProc(mheap.New(s.Proc.Mp.Gm), s.Proc, len(s.PreScopes))
+		ss[i].Proc.Lim.Parallelism = shareParallelism(s.Proc, mcpu)
 		for j := 0; j < len(s.PreScopes); j++ {
 			regs[j][i] = ss[i].Proc.Reg.MergeReceivers[j]
 		}
@@ -414,14 +549,30 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		if s.DataSource.LockRows {
+			lrel, ok := rel.(engine.LockingRelation)
+			if !ok {
+				return errors.New(errno.FeatureNotSupported, "SELECT ... FOR UPDATE is not supported by the storage engine")
+			}
+			rds = lrel.NewLockingReader(mcpu, nil, s.NodeInfo.Data, snap)
+		} else if prel, ok := rel.(engine.RangePathRelation); ok && s.DataSource.PathRange && s.DataSource.Path != engine.FullScan {
+			if rds, err = prel.NewRangePathReader(mcpu, s.DataSource.Path, s.DataSource.PathAttr, s.DataSource.PathValue, s.DataSource.PathMax); err != nil {
+				return err
+			}
+		} else if prel, ok := rel.(engine.PathRelation); ok && !s.DataSource.PathRange && s.DataSource.Path != engine.FullScan {
+			if rds, err = prel.NewPathReader(mcpu, s.DataSource.Path, s.DataSource.PathAttr, s.DataSource.PathValue); err != nil {
+				return err
+			}
+		} else {
+			rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		}
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
 		ss[i] = &Scope{
 			Magic: Normal,
 			DataSource: &Source{
-				R:            rds[i],
+				R:            newProgressReader(rds[i], s.DataSource.Progress),
 				SchemaName:   s.DataSource.SchemaName,
 				RelationName: s.DataSource.RelationName,
 				Attributes:   s.DataSource.Attributes,
@@ -430,8 +581,14 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(s.Proc.Mp.Gm))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Lim.Parallelism = shareParallelism(s.Proc, mcpu)
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
+		ss[i].Proc.SessionInfo = s.Proc.SessionInfo
+		ss[i].Proc.Ctx = s.Proc.Ctx
+		ss[i].Proc.ShareWarnings(s.Proc)
+		ss[i].Proc.ShareSpill(s.Proc)
+		ss[i].Proc.ShareProgress(s.Proc)
 	}
 	{
 		var flg bool
@@ -500,6 +657,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 			case overload.Group:
 				flg = true
 				arg := in.Arg.(*group.Argument)
+				exchanged := i+1 < len(s.Instructions) && s.Instructions[i+1].Op == overload.Exchange
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
 					Op: overload.MergeGroup,
@@ -507,6 +665,13 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 						NeedEval: false,
 					},
 				}
+				if exchanged {
+					// the partial results are merged after the exchange
+					s.Instructions[0] = vm.Instruction{
+						Op:  overload.Merge,
+						Arg: &merge.Argument{},
+					}
+				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 						Op: overload.Group,
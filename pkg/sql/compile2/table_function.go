@@ -0,0 +1,216 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// newTableFunctionReader returns the reader of the rows of the table function
+// of a FUNCTION_SCAN node, the arguments of the function are evaluated here
+func newTableFunctionReader(n *plan.Node, proc *process.Process) (engine.Reader, error) {
+	args, err := evalTableFunctionArgs(n.TblFuncExprList, proc)
+	if err != nil {
+		return nil, err
+	}
+	switch name := n.ObjRef.GetObjName(); name {
+	case plan2.GenerateSeriesName:
+		if n.TableDef.Cols[0].Typ.Id == plan.Type_DATETIME {
+			return newDatetimeSeriesReader(args)
+		}
+		return newIntSeriesReader(args)
+	default:
+		return nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("table function '%s' is not implemented", name))
+	}
+}
+
+// evalTableFunctionArgs returns the values of the constant arguments, a NULL
+// argument is nil
+func evalTableFunctionArgs(exprs []*plan.Expr, proc *process.Process) ([]interface{}, error) {
+	bat := batch.NewWithSize(0)
+	bat.InitZsOne(1)
+	args := make([]interface{}, len(exprs))
+	for i, expr := range exprs {
+		vec, err := colexec2.EvalExpr(bat, proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		if !nulls.Contains(vec.Nsp, 0) {
+			switch col := vec.Col.(type) {
+			case []int64:
+				args[i] = col[0]
+			case []types.Datetime:
+				args[i] = col[0]
+			default:
+				vector.Clean(vec, proc.Mp)
+				return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("unsupported table function argument type %s", vec.Typ))
+			}
+		}
+		vector.Clean(vec, proc.Mp)
+	}
+	return args, nil
+}
+
+// intSeriesReader reads the integers start, start+step ... up to stop. The
+// i-th row is start+i*step, and the index of the last row is |stop-start| /
+// |step|, both computed in uint64 so that a series over the whole int64 range
+// does not overflow.
+type intSeriesReader struct {
+	start int64
+	step  int64
+	next  uint64
+	last  uint64
+	done  bool
+}
+
+func newIntSeriesReader(args []interface{}) (*intSeriesReader, error) {
+	for _, arg := range args {
+		if arg == nil {
+			return &intSeriesReader{done: true}, nil
+		}
+	}
+	start, stop := args[0].(int64), args[1].(int64)
+	step := int64(1)
+	if len(args) == 3 {
+		step = args[2].(int64)
+	} else if stop < start {
+		step = -1
+	}
+	if err := checkSeriesStep(step == 0, step > 0, start <= stop, start >= stop); err != nil {
+		return nil, err
+	}
+	r := &intSeriesReader{start: start, step: step}
+	if step > 0 {
+		r.last = (uint64(stop) - uint64(start)) / uint64(step)
+	} else {
+		r.last = (uint64(start) - uint64(stop)) / (-uint64(step))
+	}
+	return r, nil
+}
+
+func (r *intSeriesReader) Read(_ []uint64, attrs []string) (*batch.Batch, error) {
+	if r.done {
+		return nil, nil
+	}
+	n := uint64(valuesBatchRows)
+	if r.last-r.next < n {
+		n = r.last - r.next + 1
+	}
+	vs := make([]int64, n)
+	for i := range vs {
+		vs[i] = r.start + int64((r.next+uint64(i))*uint64(r.step))
+	}
+	if r.next+n-1 == r.last {
+		r.done = true
+	} else {
+		r.next += n
+	}
+	return seriesBatch(attrs, types.Type{Oid: types.T_int64, Size: 8}, vs, len(vs))
+}
+
+// datetimeSeriesReader reads the datetimes start, start+step ... up to stop.
+// The i-th row is start plus i times the step, so that a month step from the
+// end of a month keeps to the ends of the months.
+type datetimeSeriesReader struct {
+	start types.Datetime
+	stop  types.Datetime
+	num   int64
+	unit  types.IntervalType
+	next  int64
+	done  bool
+}
+
+func newDatetimeSeriesReader(args []interface{}) (*datetimeSeriesReader, error) {
+	for _, arg := range args {
+		if arg == nil {
+			return &datetimeSeriesReader{done: true}, nil
+		}
+	}
+	r := &datetimeSeriesReader{
+		start: args[0].(types.Datetime),
+		stop:  args[1].(types.Datetime),
+		num:   args[2].(int64),
+		unit:  types.IntervalType(args[3].(int64)),
+	}
+	if err := checkSeriesStep(r.num == 0, r.num > 0, r.start <= r.stop, r.start >= r.stop); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *datetimeSeriesReader) Read(_ []uint64, attrs []string) (*batch.Batch, error) {
+	if r.done {
+		return nil, nil
+	}
+	vs := make([]types.Datetime, 0, valuesBatchRows)
+	for len(vs) < valuesBatchRows {
+		v, ok := r.start.AddInterval(r.next*r.num, r.unit)
+		if !ok || (r.num > 0 && v > r.stop) || (r.num < 0 && v < r.stop) {
+			r.done = true
+			break
+		}
+		vs = append(vs, v)
+		r.next++
+	}
+	if len(vs) == 0 {
+		return nil, nil
+	}
+	return seriesBatch(attrs, types.Type{Oid: types.T_datetime, Size: 8}, vs, len(vs))
+}
+
+// checkSeriesStep returns an error if the step is zero or goes away from stop
+func checkSeriesStep(isZero, isAscending, startBeforeStop, startAfterStop bool) error {
+	if isZero {
+		return errors.New(errno.DataException, "the step of generate_series must not be zero")
+	}
+	if (isAscending && !startBeforeStop) || (!isAscending && !startAfterStop) {
+		return errors.New(errno.DataException, "the step of generate_series must go from start towards stop")
+	}
+	return nil
+}
+
+// seriesBatch returns the batch of the values of a series, the data of the
+// vector is not allocated by the mheap
+func seriesBatch(attrs []string, typ types.Type, vs interface{}, n int) (*batch.Batch, error) {
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		if attr != plan2.GenerateSeriesName {
+			return nil, fmt.Errorf("column '%s' is not in generate_series", attr)
+		}
+		vec := vector.New(typ)
+		vec.Or = true
+		if err := vector.Append(vec, vs); err != nil {
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.Zs = make([]int64, n)
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat, nil
+}
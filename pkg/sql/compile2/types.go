@@ -36,6 +36,8 @@ const (
 	DropTable
 	DropIndex
 	Deletion
+	AlterTable
+	Update
 )
 
 // Address is the ip:port of local node
@@ -48,6 +50,19 @@ type Source struct {
 	Attributes   []string
 	R            engine.Reader
 	Bat          *batch.Batch
+	// LockRows locks the rows read for SELECT ... FOR UPDATE
+	LockRows bool
+	// Path reads the rows whose PathAttr equals PathValue if it is not a
+	// full scan, or the rows whose PathAttr is in [PathValue, PathMax] if
+	// PathRange is set
+	Path      engine.AccessPath
+	PathAttr  string
+	PathValue any
+	PathRange bool
+	PathMax   any
+	// Progress counts the rows read by the scan, it is nil if the source
+	// is not a table scan
+	Progress *process.ScanProgress
 }
 
 // Col is the information of attribute
@@ -0,0 +1,124 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// valuesBatchRows is the max number of the rows of a batch read from the rows
+// of a VALUES table, a large VALUES list is read in many batches
+const valuesBatchRows = 8192
+
+// valuesReader reads the rows of a VALUES table from the rowset data of its
+// VALUE_SCAN node
+type valuesReader struct {
+	cols []*plan.ColDef
+	data []*plan.ColData
+	rows int
+	next int
+}
+
+func newValuesReader(n *plan.Node) *valuesReader {
+	r := &valuesReader{
+		cols: n.TableDef.Cols,
+		data: n.RowsetData.Cols,
+	}
+	if len(r.data) > 0 {
+		r.rows = int(r.data[0].RowCount)
+	}
+	return r
+}
+
+func (r *valuesReader) Read(_ []uint64, attrs []string) (*batch.Batch, error) {
+	if r.next >= r.rows {
+		return nil, nil
+	}
+	start, end := r.next, r.next+valuesBatchRows
+	if end > r.rows {
+		end = r.rows
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		j := 0
+		for j < len(r.cols) && r.cols[j].Name != attr {
+			j++
+		}
+		if j == len(r.cols) {
+			return nil, fmt.Errorf("column '%s' is not in VALUES", attr)
+		}
+		vec, err := valuesVector(r.cols[j].Typ, r.data[j], start, end)
+		if err != nil {
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.Zs = make([]int64, end-start)
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	r.next = end
+	return bat, nil
+}
+
+// valuesVector returns the vector of the rows [start, end) of a column, the
+// data of the vector is not allocated by the mheap
+func valuesVector(typ *plan.Type, col *plan.ColData, start, end int) (*vector.Vector, error) {
+	vec := vector.New(types.Type{
+		Oid:   types.T(typ.Id),
+		Width: typ.Width,
+		Size:  typ.Size,
+		Scale: typ.Scale,
+	})
+	vec.Or = true
+	var err error
+	switch typ.Id {
+	case plan.Type_BOOL:
+		vs := make([]bool, end-start)
+		for i := range vs {
+			vs[i] = col.I32[start+i] != 0
+		}
+		err = vector.Append(vec, vs)
+	case plan.Type_INT64:
+		err = vector.Append(vec, col.I64[start:end])
+	case plan.Type_FLOAT64:
+		err = vector.Append(vec, col.F64[start:end])
+	case plan.Type_CHAR, plan.Type_VARCHAR:
+		vs := make([][]byte, end-start)
+		for i := range vs {
+			vs[i] = []byte(col.S[start+i])
+		}
+		err = vector.Append(vec, vs)
+	default:
+		err = fmt.Errorf("unsupported type %s of VALUES", typ.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if col.NullCount > 0 {
+		for i := start; i < end; i++ {
+			if col.Nulls[i] {
+				nulls.Add(vec.Nsp, uint64(i-start))
+			}
+		}
+	}
+	return vec, nil
+}
@@ -61,7 +61,7 @@ func writeBack(u interface{}, bat *batch.Batch) error {
 	if bat == nil || len(bat.Zs) == 0 {
 		return nil
 	}
-	if err := protocol.EncodeBatch(bat, &buf); err != nil {
+	if err := protocol.EncodeRemoteBatch(bat, &buf); err != nil {
 		return err
 	}
 	return conn.WriteAndFlush(&message.Message{Data: buf.Bytes()})
@@ -15,9 +15,11 @@
 package mysql
 
 import (
+	"encoding/hex"
 	"errors"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
@@ -46,6 +48,12 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+	lastTyp int
+
+	// the token scanned ahead of the current one, if any.
+	peeked  bool
+	peekTyp int
+	peekStr string
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -55,7 +63,23 @@ func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
 }
 
 func (l *Lexer) Lex(lval *yySymType) int {
-	typ, str := l.scanner.Scan()
+	typ, str := l.scan()
+	// the optimizer hints only make sense right after SELECT,
+	// they are ordinary comments anywhere else.
+	for typ == OPTIMIZER_HINT && l.lastTyp != SELECT {
+		typ, str = l.scan()
+	}
+	// SELECT ... INTO @var may come before FROM, where one token of
+	// lookahead can't tell it from SELECT ... INTO OUTFILE, so the
+	// INTO of a variable list gets a token of its own.
+	if typ == INTO {
+		l.peekTyp, l.peekStr = l.scanner.Scan()
+		l.peeked = true
+		if l.peekTyp == AT_ID {
+			typ = INTO_VARIABLE
+		}
+	}
+	l.lastTyp = typ
 	l.scanner.LastToken = str
 
 	switch typ {
@@ -75,6 +99,14 @@ func (l *Lexer) Lex(lval *yySymType) int {
 	return typ
 }
 
+func (l *Lexer) scan() (int, string) {
+	if l.peeked {
+		l.peeked = false
+		return l.peekTyp, l.peekStr
+	}
+	return l.scanner.Scan()
+}
+
 func (l *Lexer) Error(err string) {
 	l.scanner.LastError = scanner.PositionedErr{Err: err, Pos: l.scanner.Pos + 1, Near: l.scanner.LastToken}
 }
@@ -112,27 +144,44 @@ func (l *Lexer) toFloat(lval *yySymType, str string) int {
 }
 
 func (l *Lexer) toHex(lval *yySymType, str string) int {
+	// the scanner returns the digits of x'...', which are of even length
+	bytes, err := hex.DecodeString(str)
+	if err != nil {
+		l.scanner.LastError = err
+		return LEX_ERROR
+	}
+	l.scanner.LastToken = "x'" + str + "'"
+	lval.item = bytes
 	return HEX
 }
 
 func (l *Lexer) toHexNum(lval *yySymType, str string) int {
-	ival, err := strconv.ParseUint(str[2:], 16, 64)
-	if err != nil {
-		// TODO: toDecimal()
-		//l.scanner.LastError = err
-		lval.str = str
-		return HEXNUM
+	digits := str[2:]
+	if len(digits)%2 != 0 {
+		digits = "0" + digits
 	}
-	switch {
-	case ival <= math.MaxInt64:
-		lval.item = int64(ival)
-	default:
-		lval.item = ival
+	bytes, err := hex.DecodeString(digits)
+	if err != nil {
+		l.scanner.LastError = err
+		return LEX_ERROR
 	}
-	lval.str = str
+	lval.item = bytes
 	return HEXNUM
 }
 
 func (l *Lexer) toBit(lval *yySymType, str string) int {
+	// b'...' is scanned as its digits and 0b... as it is
+	if strings.HasPrefix(str, "0b") {
+		str = str[2:]
+	} else {
+		l.scanner.LastToken = "b'" + str + "'"
+	}
+	bytes := make([]byte, (len(str)+7)/8)
+	for i := 0; i < len(str); i++ {
+		if str[len(str)-1-i] == '1' {
+			bytes[len(bytes)-1-i/8] |= 1 << (i % 8)
+		}
+	}
+	lval.item = bytes
 	return BIT_LITERAL
 }
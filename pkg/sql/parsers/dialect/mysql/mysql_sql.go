@@ -67,390 +67,404 @@ const CROSS = 57389
 const NATURAL = 57390
 const USE = 57391
 const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const DECIMAL_VALUE = 57469
-const TIME = 57470
-const TIMESTAMP = 57471
-const DATETIME = 57472
-const YEAR = 57473
-const CHAR = 57474
-const VARCHAR = 57475
-const BOOL = 57476
-const CHARACTER = 57477
-const VARBINARY = 57478
-const NCHAR = 57479
-const TEXT = 57480
-const TINYTEXT = 57481
-const MEDIUMTEXT = 57482
-const LONGTEXT = 57483
-const BLOB = 57484
-const TINYBLOB = 57485
-const MEDIUMBLOB = 57486
-const LONGBLOB = 57487
-const JSON = 57488
-const ENUM = 57489
-const GEOMETRY = 57490
-const POINT = 57491
-const LINESTRING = 57492
-const POLYGON = 57493
-const GEOMETRYCOLLECTION = 57494
-const MULTIPOINT = 57495
-const MULTILINESTRING = 57496
-const MULTIPOLYGON = 57497
-const INT1 = 57498
-const INT2 = 57499
-const INT3 = 57500
-const INT4 = 57501
-const INT8 = 57502
-const SQL_SMALL_RESULT = 57503
-const SQL_BIG_RESULT = 57504
-const SQL_BUFFER_RESULT = 57505
-const CREATE = 57506
-const ALTER = 57507
-const DROP = 57508
-const RENAME = 57509
-const ANALYZE = 57510
-const ADD = 57511
-const SCHEMA = 57512
-const TABLE = 57513
-const INDEX = 57514
-const VIEW = 57515
-const TO = 57516
-const IGNORE = 57517
-const IF = 57518
-const PRIMARY = 57519
-const COLUMN = 57520
-const CONSTRAINT = 57521
-const SPATIAL = 57522
-const FULLTEXT = 57523
-const FOREIGN = 57524
-const KEY_BLOCK_SIZE = 57525
-const SHOW = 57526
-const DESCRIBE = 57527
-const EXPLAIN = 57528
-const DATE = 57529
-const ESCAPE = 57530
-const REPAIR = 57531
-const OPTIMIZE = 57532
-const TRUNCATE = 57533
-const MAXVALUE = 57534
-const PARTITION = 57535
-const REORGANIZE = 57536
-const LESS = 57537
-const THAN = 57538
-const PROCEDURE = 57539
-const TRIGGER = 57540
-const STATUS = 57541
-const VARIABLES = 57542
-const ROLE = 57543
-const PROXY = 57544
-const AVG_ROW_LENGTH = 57545
-const STORAGE = 57546
-const DISK = 57547
-const MEMORY = 57548
-const CHECKSUM = 57549
-const COMPRESSION = 57550
-const DATA = 57551
-const DIRECTORY = 57552
-const DELAY_KEY_WRITE = 57553
-const ENCRYPTION = 57554
-const ENGINE = 57555
-const MAX_ROWS = 57556
-const MIN_ROWS = 57557
-const PACK_KEYS = 57558
-const ROW_FORMAT = 57559
-const STATS_AUTO_RECALC = 57560
-const STATS_PERSISTENT = 57561
-const STATS_SAMPLE_PAGES = 57562
-const DYNAMIC = 57563
-const COMPRESSED = 57564
-const REDUNDANT = 57565
-const COMPACT = 57566
-const FIXED = 57567
-const COLUMN_FORMAT = 57568
-const AUTO_RANDOM = 57569
-const RESTRICT = 57570
-const CASCADE = 57571
-const ACTION = 57572
-const PARTIAL = 57573
-const SIMPLE = 57574
-const CHECK = 57575
-const ENFORCED = 57576
-const RANGE = 57577
-const LIST = 57578
-const ALGORITHM = 57579
-const LINEAR = 57580
-const PARTITIONS = 57581
-const SUBPARTITION = 57582
-const SUBPARTITIONS = 57583
-const TYPE = 57584
-const ANY = 57585
-const SOME = 57586
-const PROPERTIES = 57587
-const PARSER = 57588
-const VISIBLE = 57589
-const INVISIBLE = 57590
-const BTREE = 57591
-const HASH = 57592
-const RTREE = 57593
-const BSI = 57594
-const ZONEMAP = 57595
-const LEADING = 57596
-const BOTH = 57597
-const TRAILING = 57598
-const UNKNOWN = 57599
-const EXPIRE = 57600
-const ACCOUNT = 57601
-const UNLOCK = 57602
-const DAY = 57603
-const NEVER = 57604
-const SECOND = 57605
-const ASCII = 57606
-const COALESCE = 57607
-const COLLATION = 57608
-const HOUR = 57609
-const MICROSECOND = 57610
-const MINUTE = 57611
-const MONTH = 57612
-const QUARTER = 57613
-const REPEAT = 57614
-const REVERSE = 57615
-const ROW_COUNT = 57616
-const WEEK = 57617
-const REVOKE = 57618
-const FUNCTION = 57619
-const PRIVILEGES = 57620
-const TABLESPACE = 57621
-const EXECUTE = 57622
-const SUPER = 57623
-const GRANT = 57624
-const OPTION = 57625
-const REFERENCES = 57626
-const REPLICATION = 57627
-const SLAVE = 57628
-const CLIENT = 57629
-const USAGE = 57630
-const RELOAD = 57631
-const FILE = 57632
-const TEMPORARY = 57633
-const ROUTINE = 57634
-const EVENT = 57635
-const SHUTDOWN = 57636
-const NULLX = 57637
-const AUTO_INCREMENT = 57638
-const APPROXNUM = 57639
-const SIGNED = 57640
-const UNSIGNED = 57641
-const ZEROFILL = 57642
-const USER = 57643
-const IDENTIFIED = 57644
-const CIPHER = 57645
-const ISSUER = 57646
-const X509 = 57647
-const SUBJECT = 57648
-const SAN = 57649
-const REQUIRE = 57650
-const SSL = 57651
-const NONE = 57652
-const PASSWORD = 57653
-const MAX_QUERIES_PER_HOUR = 57654
-const MAX_UPDATES_PER_HOUR = 57655
-const MAX_CONNECTIONS_PER_HOUR = 57656
-const MAX_USER_CONNECTIONS = 57657
-const FORMAT = 57658
-const VERBOSE = 57659
-const CONNECTION = 57660
-const LOAD = 57661
-const INFILE = 57662
-const TERMINATED = 57663
-const OPTIONALLY = 57664
-const ENCLOSED = 57665
-const ESCAPED = 57666
-const STARTING = 57667
-const LINES = 57668
-const DATABASES = 57669
-const TABLES = 57670
-const EXTENDED = 57671
-const FULL = 57672
-const PROCESSLIST = 57673
-const FIELDS = 57674
-const COLUMNS = 57675
-const OPEN = 57676
-const ERRORS = 57677
-const WARNINGS = 57678
-const INDEXES = 57679
-const NAMES = 57680
-const GLOBAL = 57681
-const SESSION = 57682
-const ISOLATION = 57683
-const LEVEL = 57684
-const READ = 57685
-const WRITE = 57686
-const ONLY = 57687
-const REPEATABLE = 57688
-const COMMITTED = 57689
-const UNCOMMITTED = 57690
-const SERIALIZABLE = 57691
-const LOCAL = 57692
-const EXCEPT = 57693
-const CURRENT_TIMESTAMP = 57694
-const DATABASE = 57695
-const CURRENT_TIME = 57696
-const LOCALTIME = 57697
-const LOCALTIMESTAMP = 57698
-const UTC_DATE = 57699
-const UTC_TIME = 57700
-const UTC_TIMESTAMP = 57701
-const REPLACE = 57702
-const CONVERT = 57703
-const SEPARATOR = 57704
-const CURRENT_DATE = 57705
-const CURRENT_USER = 57706
-const CURRENT_ROLE = 57707
-const SECOND_MICROSECOND = 57708
-const MINUTE_MICROSECOND = 57709
-const MINUTE_SECOND = 57710
-const HOUR_MICROSECOND = 57711
-const HOUR_SECOND = 57712
-const HOUR_MINUTE = 57713
-const DAY_MICROSECOND = 57714
-const DAY_SECOND = 57715
-const DAY_MINUTE = 57716
-const DAY_HOUR = 57717
-const YEAR_MONTH = 57718
-const SQL_TSI_HOUR = 57719
-const SQL_TSI_DAY = 57720
-const SQL_TSI_WEEK = 57721
-const SQL_TSI_MONTH = 57722
-const SQL_TSI_QUARTER = 57723
-const SQL_TSI_YEAR = 57724
-const SQL_TSI_SECOND = 57725
-const SQL_TSI_MINUTE = 57726
-const RECURSIVE = 57727
-const MATCH = 57728
-const AGAINST = 57729
-const BOOLEAN = 57730
-const LANGUAGE = 57731
-const WITH = 57732
-const QUERY = 57733
-const EXPANSION = 57734
-const ADDDATE = 57735
-const BIT_AND = 57736
-const BIT_OR = 57737
-const BIT_XOR = 57738
-const CAST = 57739
-const COUNT = 57740
-const APPROX_COUNT_DISTINCT = 57741
-const APPROX_PERCENTILE = 57742
-const CURDATE = 57743
-const CURTIME = 57744
-const DATE_ADD = 57745
-const DATE_SUB = 57746
-const EXTRACT = 57747
-const GROUP_CONCAT = 57748
-const MAX = 57749
-const MID = 57750
-const MIN = 57751
-const NOW = 57752
-const POSITION = 57753
-const SESSION_USER = 57754
-const STD = 57755
-const STDDEV = 57756
-const STDDEV_POP = 57757
-const STDDEV_SAMP = 57758
-const SUBDATE = 57759
-const SUBSTR = 57760
-const SUBSTRING = 57761
-const SUM = 57762
-const SYSDATE = 57763
-const SYSTEM_USER = 57764
-const TRANSLATE = 57765
-const TRIM = 57766
-const VARIANCE = 57767
-const VAR_POP = 57768
-const VAR_SAMP = 57769
-const AVG = 57770
-const ROW = 57771
-const OUTFILE = 57772
-const HEADER = 57773
-const MAX_FILE_SIZE = 57774
-const FORCE_QUOTE = 57775
-const UNUSED = 57776
+const LOWER_THAN_ON = 57393
+const ON = 57394
+const USING = 57395
+const SUBQUERY_AS_EXPR = 57396
+const ID = 57397
+const AT_ID = 57398
+const AT_AT_ID = 57399
+const STRING = 57400
+const VALUE_ARG = 57401
+const LIST_ARG = 57402
+const COMMENT = 57403
+const COMMENT_KEYWORD = 57404
+const OPTIMIZER_HINT = 57405
+const INTO_VARIABLE = 57406
+const INTEGRAL = 57407
+const HEX = 57408
+const HEXNUM = 57409
+const BIT_LITERAL = 57410
+const FLOAT = 57411
+const NULL = 57412
+const TRUE = 57413
+const FALSE = 57414
+const EMPTY_FROM_CLAUSE = 57415
+const LOWER_THAN_CHARSET = 57416
+const CHARSET = 57417
+const UNIQUE = 57418
+const KEY = 57419
+const OR = 57420
+const XOR = 57421
+const AND = 57422
+const NOT = 57423
+const BETWEEN = 57424
+const CASE = 57425
+const WHEN = 57426
+const THEN = 57427
+const ELSE = 57428
+const END = 57429
+const LE = 57430
+const GE = 57431
+const NE = 57432
+const NULL_SAFE_EQUAL = 57433
+const IS = 57434
+const LIKE = 57435
+const REGEXP = 57436
+const IN = 57437
+const ASSIGNMENT = 57438
+const SHIFT_LEFT = 57439
+const SHIFT_RIGHT = 57440
+const DIV = 57441
+const MOD = 57442
+const UNARY = 57443
+const LOWER_THAN_COLLATE = 57444
+const COLLATE = 57445
+const BINARY = 57446
+const UNDERSCORE_BINARY = 57447
+const LOWER_THAN_TIME_UNIT = 57448
+const YEAR = 57449
+const MONTH = 57450
+const QUARTER = 57451
+const INTERVAL = 57452
+const BEGIN = 57453
+const START = 57454
+const TRANSACTION = 57455
+const COMMIT = 57456
+const ROLLBACK = 57457
+const WORK = 57458
+const CONSISTENT = 57459
+const SNAPSHOT = 57460
+const CHAIN = 57461
+const NO = 57462
+const RELEASE = 57463
+const BIT = 57464
+const TINYINT = 57465
+const SMALLINT = 57466
+const MEDIUMINT = 57467
+const INT = 57468
+const INTEGER = 57469
+const BIGINT = 57470
+const INTNUM = 57471
+const REAL = 57472
+const DOUBLE = 57473
+const FLOAT_TYPE = 57474
+const DECIMAL = 57475
+const NUMERIC = 57476
+const DECIMAL_VALUE = 57477
+const TIME = 57478
+const TIMESTAMP = 57479
+const DATETIME = 57480
+const CHAR = 57481
+const VARCHAR = 57482
+const BOOL = 57483
+const CHARACTER = 57484
+const VARBINARY = 57485
+const NCHAR = 57486
+const TEXT = 57487
+const TINYTEXT = 57488
+const MEDIUMTEXT = 57489
+const LONGTEXT = 57490
+const BLOB = 57491
+const TINYBLOB = 57492
+const MEDIUMBLOB = 57493
+const LONGBLOB = 57494
+const JSON = 57495
+const ENUM = 57496
+const GEOMETRY = 57497
+const POINT = 57498
+const LINESTRING = 57499
+const POLYGON = 57500
+const GEOMETRYCOLLECTION = 57501
+const MULTIPOINT = 57502
+const MULTILINESTRING = 57503
+const MULTIPOLYGON = 57504
+const INT1 = 57505
+const INT2 = 57506
+const INT3 = 57507
+const INT4 = 57508
+const INT8 = 57509
+const SQL_SMALL_RESULT = 57510
+const SQL_BIG_RESULT = 57511
+const SQL_BUFFER_RESULT = 57512
+const CREATE = 57513
+const ALTER = 57514
+const DROP = 57515
+const RENAME = 57516
+const ANALYZE = 57517
+const ADD = 57518
+const SCHEMA = 57519
+const TABLE = 57520
+const INDEX = 57521
+const VIEW = 57522
+const TO = 57523
+const IGNORE = 57524
+const IF = 57525
+const PRIMARY = 57526
+const COLUMN = 57527
+const CONSTRAINT = 57528
+const SPATIAL = 57529
+const FULLTEXT = 57530
+const FOREIGN = 57531
+const KEY_BLOCK_SIZE = 57532
+const SHOW = 57533
+const DESCRIBE = 57534
+const EXPLAIN = 57535
+const DATE = 57536
+const ESCAPE = 57537
+const REPAIR = 57538
+const OPTIMIZE = 57539
+const TRUNCATE = 57540
+const MAXVALUE = 57541
+const PARTITION = 57542
+const REORGANIZE = 57543
+const LESS = 57544
+const THAN = 57545
+const PROCEDURE = 57546
+const TRIGGER = 57547
+const STATUS = 57548
+const VARIABLES = 57549
+const ROLE = 57550
+const PROXY = 57551
+const AVG_ROW_LENGTH = 57552
+const STORAGE = 57553
+const DISK = 57554
+const MEMORY = 57555
+const CHECKSUM = 57556
+const COMPRESSION = 57557
+const DATA = 57558
+const DIRECTORY = 57559
+const DELAY_KEY_WRITE = 57560
+const ENCRYPTION = 57561
+const ENGINE = 57562
+const MAX_ROWS = 57563
+const MIN_ROWS = 57564
+const PACK_KEYS = 57565
+const ROW_FORMAT = 57566
+const STATS_AUTO_RECALC = 57567
+const STATS_PERSISTENT = 57568
+const STATS_SAMPLE_PAGES = 57569
+const TTL = 57570
+const DYNAMIC = 57571
+const COMPRESSED = 57572
+const REDUNDANT = 57573
+const COMPACT = 57574
+const FIXED = 57575
+const COLUMN_FORMAT = 57576
+const AUTO_RANDOM = 57577
+const RESTRICT = 57578
+const CASCADE = 57579
+const ACTION = 57580
+const PARTIAL = 57581
+const SIMPLE = 57582
+const CHECK = 57583
+const ENFORCED = 57584
+const GENERATED = 57585
+const ALWAYS = 57586
+const STORED = 57587
+const VIRTUAL = 57588
+const RANGE = 57589
+const LIST = 57590
+const ALGORITHM = 57591
+const LINEAR = 57592
+const PARTITIONS = 57593
+const SUBPARTITION = 57594
+const SUBPARTITIONS = 57595
+const TYPE = 57596
+const ANY = 57597
+const SOME = 57598
+const PROPERTIES = 57599
+const PARSER = 57600
+const VISIBLE = 57601
+const INVISIBLE = 57602
+const BTREE = 57603
+const HASH = 57604
+const RTREE = 57605
+const BSI = 57606
+const ZONEMAP = 57607
+const LEADING = 57608
+const BOTH = 57609
+const TRAILING = 57610
+const UNKNOWN = 57611
+const EXPIRE = 57612
+const ACCOUNT = 57613
+const UNLOCK = 57614
+const DAY = 57615
+const NEVER = 57616
+const SECOND = 57617
+const ASCII = 57618
+const COALESCE = 57619
+const COLLATION = 57620
+const HOUR = 57621
+const MICROSECOND = 57622
+const MINUTE = 57623
+const REPEAT = 57624
+const REVERSE = 57625
+const ROW_COUNT = 57626
+const WEEK = 57627
+const REVOKE = 57628
+const FUNCTION = 57629
+const PRIVILEGES = 57630
+const TABLESPACE = 57631
+const EXECUTE = 57632
+const SUPER = 57633
+const GRANT = 57634
+const OPTION = 57635
+const REFERENCES = 57636
+const REPLICATION = 57637
+const SLAVE = 57638
+const CLIENT = 57639
+const USAGE = 57640
+const RELOAD = 57641
+const FILE = 57642
+const TEMPORARY = 57643
+const ROUTINE = 57644
+const EVENT = 57645
+const SHUTDOWN = 57646
+const NULLX = 57647
+const AUTO_INCREMENT = 57648
+const APPROXNUM = 57649
+const SIGNED = 57650
+const UNSIGNED = 57651
+const ZEROFILL = 57652
+const USER = 57653
+const IDENTIFIED = 57654
+const CIPHER = 57655
+const ISSUER = 57656
+const X509 = 57657
+const SUBJECT = 57658
+const SAN = 57659
+const REQUIRE = 57660
+const SSL = 57661
+const NONE = 57662
+const PASSWORD = 57663
+const MAX_QUERIES_PER_HOUR = 57664
+const MAX_UPDATES_PER_HOUR = 57665
+const MAX_CONNECTIONS_PER_HOUR = 57666
+const MAX_USER_CONNECTIONS = 57667
+const FORMAT = 57668
+const VERBOSE = 57669
+const CONNECTION = 57670
+const LOAD = 57671
+const INFILE = 57672
+const TERMINATED = 57673
+const OPTIONALLY = 57674
+const ENCLOSED = 57675
+const ESCAPED = 57676
+const STARTING = 57677
+const LINES = 57678
+const DATABASES = 57679
+const TABLES = 57680
+const EXTENDED = 57681
+const FULL = 57682
+const PROCESSLIST = 57683
+const FIELDS = 57684
+const COLUMNS = 57685
+const OPEN = 57686
+const ERRORS = 57687
+const WARNINGS = 57688
+const INDEXES = 57689
+const PROFILE = 57690
+const PROCESS = 57691
+const GRANTS = 57692
+const QUICK = 57693
+const NAMES = 57694
+const GLOBAL = 57695
+const SESSION = 57696
+const ISOLATION = 57697
+const LEVEL = 57698
+const READ = 57699
+const WRITE = 57700
+const ONLY = 57701
+const REPEATABLE = 57702
+const COMMITTED = 57703
+const UNCOMMITTED = 57704
+const SERIALIZABLE = 57705
+const LOCAL = 57706
+const EXCEPT = 57707
+const CURRENT_TIMESTAMP = 57708
+const DATABASE = 57709
+const CURRENT_TIME = 57710
+const LOCALTIME = 57711
+const LOCALTIMESTAMP = 57712
+const UTC_DATE = 57713
+const UTC_TIME = 57714
+const UTC_TIMESTAMP = 57715
+const REPLACE = 57716
+const CONVERT = 57717
+const SEPARATOR = 57718
+const CURRENT_DATE = 57719
+const CURRENT_USER = 57720
+const CURRENT_ROLE = 57721
+const SECOND_MICROSECOND = 57722
+const MINUTE_MICROSECOND = 57723
+const MINUTE_SECOND = 57724
+const HOUR_MICROSECOND = 57725
+const HOUR_SECOND = 57726
+const HOUR_MINUTE = 57727
+const DAY_MICROSECOND = 57728
+const DAY_SECOND = 57729
+const DAY_MINUTE = 57730
+const DAY_HOUR = 57731
+const YEAR_MONTH = 57732
+const SQL_TSI_HOUR = 57733
+const SQL_TSI_DAY = 57734
+const SQL_TSI_WEEK = 57735
+const SQL_TSI_MONTH = 57736
+const SQL_TSI_QUARTER = 57737
+const SQL_TSI_YEAR = 57738
+const SQL_TSI_SECOND = 57739
+const SQL_TSI_MINUTE = 57740
+const RECURSIVE = 57741
+const MATCH = 57742
+const AGAINST = 57743
+const BOOLEAN = 57744
+const LANGUAGE = 57745
+const WITH = 57746
+const QUERY = 57747
+const EXPANSION = 57748
+const ADDDATE = 57749
+const BIT_AND = 57750
+const BIT_OR = 57751
+const BIT_XOR = 57752
+const CAST = 57753
+const COUNT = 57754
+const APPROX_COUNT_DISTINCT = 57755
+const APPROX_PERCENTILE = 57756
+const CURDATE = 57757
+const CURTIME = 57758
+const DATE_ADD = 57759
+const DATE_SUB = 57760
+const EXTRACT = 57761
+const GROUP_CONCAT = 57762
+const MAX = 57763
+const MID = 57764
+const MIN = 57765
+const NOW = 57766
+const POSITION = 57767
+const SESSION_USER = 57768
+const STD = 57769
+const STDDEV = 57770
+const STDDEV_POP = 57771
+const STDDEV_SAMP = 57772
+const SUBDATE = 57773
+const SUBSTR = 57774
+const SUBSTRING = 57775
+const SUM = 57776
+const SYSDATE = 57777
+const SYSTEM_USER = 57778
+const TRANSLATE = 57779
+const TRIM = 57780
+const VARIANCE = 57781
+const VAR_POP = 57782
+const VAR_SAMP = 57783
+const AVG = 57784
+const ROW = 57785
+const OUTFILE = 57786
+const HEADER = 57787
+const MAX_FILE_SIZE = 57788
+const FORCE_QUOTE = 57789
+const UNUSED = 57790
 
 var yyToknames = [...]string{
 	"$end",
@@ -503,6 +517,7 @@ var yyToknames = [...]string{
 	"NATURAL",
 	"USE",
 	"FORCE",
+	"LOWER_THAN_ON",
 	"ON",
 	"USING",
 	"SUBQUERY_AS_EXPR",
@@ -517,6 +532,8 @@ var yyToknames = [...]string{
 	"LIST_ARG",
 	"COMMENT",
 	"COMMENT_KEYWORD",
+	"OPTIMIZER_HINT",
+	"INTO_VARIABLE",
 	"INTEGRAL",
 	"HEX",
 	"HEXNUM",
@@ -567,9 +584,14 @@ var yyToknames = [...]string{
 	"'^'",
 	"'~'",
 	"UNARY",
+	"LOWER_THAN_COLLATE",
 	"COLLATE",
 	"BINARY",
 	"UNDERSCORE_BINARY",
+	"LOWER_THAN_TIME_UNIT",
+	"YEAR",
+	"MONTH",
+	"QUARTER",
 	"INTERVAL",
 	"'.'",
 	"BEGIN",
@@ -600,7 +622,6 @@ var yyToknames = [...]string{
 	"TIME",
 	"TIMESTAMP",
 	"DATETIME",
-	"YEAR",
 	"CHAR",
 	"VARCHAR",
 	"BOOL",
@@ -690,6 +711,7 @@ var yyToknames = [...]string{
 	"STATS_AUTO_RECALC",
 	"STATS_PERSISTENT",
 	"STATS_SAMPLE_PAGES",
+	"TTL",
 	"DYNAMIC",
 	"COMPRESSED",
 	"REDUNDANT",
@@ -704,6 +726,10 @@ var yyToknames = [...]string{
 	"SIMPLE",
 	"CHECK",
 	"ENFORCED",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
 	"RANGE",
 	"LIST",
 	"ALGORITHM",
@@ -739,8 +765,6 @@ var yyToknames = [...]string{
 	"HOUR",
 	"MICROSECOND",
 	"MINUTE",
-	"MONTH",
-	"QUARTER",
 	"REPEAT",
 	"REVERSE",
 	"ROW_COUNT",
@@ -807,6 +831,10 @@ var yyToknames = [...]string{
 	"ERRORS",
 	"WARNINGS",
 	"INDEXES",
+	"PROFILE",
+	"PROCESS",
+	"GRANTS",
+	"QUICK",
 	"NAMES",
 	"GLOBAL",
 	"SESSION",
@@ -914,2187 +942,2355 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6495
+//line mysql_sql.y:6734
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 354,
-	-2, 335,
 	-1, 57,
-	189, 502,
-	-2, 538,
-	-1, 66,
-	216, 244,
-	217, 244,
-	-2, 264,
-	-1, 316,
-	58, 1322,
-	453, 1322,
-	-2, 92,
-	-1, 335,
-	58, 665,
-	453, 665,
-	-2, 500,
-	-1, 336,
-	58, 493,
-	453, 493,
-	-2, 501,
-	-1, 342,
-	17, 355,
-	-2, 318,
-	-1, 572,
-	17, 355,
-	-2, 318,
-	-1, 594,
-	54, 1349,
-	-2, 1356,
-	-1, 602,
-	54, 1350,
-	-2, 1364,
-	-1, 604,
-	54, 1346,
-	-2, 1366,
-	-1, 605,
-	54, 1347,
-	-2, 1367,
-	-1, 610,
-	54, 1348,
-	-2, 1373,
-	-1, 611,
-	54, 1351,
-	-2, 1374,
-	-1, 612,
-	54, 1352,
-	-2, 1375,
-	-1, 613,
-	54, 791,
+	17, 370,
+	-2, 351,
+	-1, 62,
+	196, 527,
+	-2, 563,
+	-1, 71,
+	223, 252,
+	224, 252,
+	-2, 276,
+	-1, 328,
+	59, 1371,
+	467, 1371,
+	-2, 93,
+	-1, 347,
+	59, 693,
+	467, 693,
+	-2, 525,
+	-1, 348,
+	59, 518,
+	467, 518,
+	-2, 526,
+	-1, 354,
+	17, 371,
+	-2, 334,
+	-1, 590,
+	17, 371,
+	-2, 334,
+	-1, 734,
+	55, 846,
+	-2, 1431,
+	-1, 735,
+	55, 847,
+	-2, 1430,
+	-1, 736,
+	55, 1395,
+	-2, 1415,
+	-1, 737,
+	55, 1396,
+	-2, 1416,
+	-1, 738,
+	55, 1397,
+	-2, 1422,
+	-1, 739,
+	55, 1398,
+	-2, 1405,
+	-1, 740,
+	55, 1399,
+	-2, 1413,
+	-1, 741,
+	55, 1400,
+	-2, 1423,
+	-1, 742,
+	55, 1401,
+	-2, 1424,
+	-1, 743,
+	55, 1402,
+	-2, 1429,
+	-1, 744,
+	55, 1403,
+	-2, 1434,
+	-1, 745,
+	55, 1404,
+	-2, 1435,
+	-1, 758,
+	55, 921,
+	-2, 1312,
+	-1, 759,
+	55, 922,
+	-2, 1391,
+	-1, 767,
+	55, 932,
 	-2, 1376,
-	-1, 614,
-	54, 792,
-	-2, 1377,
-	-1, 615,
-	54, 793,
-	-2, 1378,
-	-1, 617,
-	54, 1353,
-	-2, 1380,
-	-1, 618,
-	54, 810,
-	-2, 1381,
-	-1, 619,
-	54, 809,
-	-2, 1382,
-	-1, 622,
-	54, 1354,
-	-2, 1385,
-	-1, 623,
-	54, 1355,
+	-1, 769,
+	55, 934,
 	-2, 1386,
-	-1, 629,
-	54, 884,
-	-2, 1267,
-	-1, 630,
-	54, 895,
-	-2, 1327,
-	-1, 631,
-	54, 897,
-	-2, 1337,
-	-1, 632,
-	54, 885,
-	-2, 1342,
-	-1, 787,
-	1, 528,
-	56, 528,
-	452, 528,
-	-2, 535,
-	-1, 910,
-	17, 354,
-	-2, 723,
-	-1, 960,
-	119, 1037,
-	-2, 1035,
-	-1, 962,
-	119, 442,
-	-2, 1032,
-	-1, 963,
-	119, 443,
-	-2, 1033,
-	-1, 1161,
-	1, 529,
-	56, 529,
-	452, 529,
-	-2, 535,
-	-1, 1219,
-	54, 940,
-	-2, 1344,
-	-1, 1220,
-	54, 941,
-	-2, 1345,
-	-1, 1619,
-	75, 535,
-	115, 535,
-	149, 535,
-	152, 535,
-	-2, 575,
-	-1, 1621,
-	250, 690,
-	-2, 671,
-	-1, 1742,
-	75, 535,
-	115, 535,
-	149, 535,
-	152, 535,
-	-2, 576,
-	-1, 1770,
-	250, 690,
-	-2, 672,
-	-1, 2162,
-	55, 550,
-	56, 550,
-	-2, 535,
-	-1, 2166,
-	55, 550,
-	56, 550,
-	-2, 535,
-	-1, 2178,
-	55, 554,
-	56, 554,
-	-2, 535,
-	-1, 2181,
-	55, 555,
-	56, 555,
-	-2, 535,
+	-1, 780,
+	55, 828,
+	-2, 1425,
+	-1, 781,
+	55, 829,
+	-2, 1426,
+	-1, 782,
+	55, 830,
+	-2, 1427,
+	-1, 818,
+	1, 553,
+	57, 553,
+	466, 553,
+	-2, 560,
+	-1, 901,
+	127, 1077,
+	-2, 1075,
+	-1, 903,
+	127, 467,
+	-2, 1072,
+	-1, 904,
+	127, 468,
+	-2, 1073,
+	-1, 1111,
+	17, 370,
+	-2, 758,
+	-1, 1195,
+	1, 554,
+	57, 554,
+	466, 554,
+	-2, 560,
+	-1, 1284,
+	55, 978,
+	-2, 1393,
+	-1, 1285,
+	55, 979,
+	-2, 1394,
+	-1, 1690,
+	78, 560,
+	119, 560,
+	156, 560,
+	159, 560,
+	-2, 602,
+	-1, 1692,
+	258, 725,
+	-2, 699,
+	-1, 1803,
+	78, 560,
+	119, 560,
+	156, 560,
+	159, 560,
+	-2, 603,
+	-1, 1832,
+	258, 725,
+	-2, 700,
+	-1, 2256,
+	56, 575,
+	57, 575,
+	-2, 560,
+	-1, 2260,
+	56, 575,
+	57, 575,
+	-2, 560,
+	-1, 2272,
+	56, 579,
+	57, 579,
+	-2, 560,
+	-1, 2276,
+	56, 580,
+	57, 580,
+	-2, 560,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 20072
+const yyLast = 21749
 
 var yyAct = [...]int{
-	777, 2166, 1222, 2168, 2165, 2173, 2139, 635, 2113, 1815,
-	766, 2003, 633, 653, 2084, 2128, 1782, 2065, 1979, 2066,
-	559, 1982, 1738, 1956, 84, 524, 1613, 292, 1148, 840,
-	1911, 1813, 557, 1814, 1805, 303, 87, 1967, 1507, 462,
-	84, 305, 1884, 1771, 393, 1680, 296, 19, 1397, 337,
-	337, 1697, 1804, 1503, 763, 512, 1700, 583, 1709, 83,
-	823, 593, 1540, 1491, 1705, 1373, 663, 52, 1519, 1512,
-	1666, 1531, 1508, 394, 1154, 1558, 1547, 1557, 1442, 415,
-	718, 942, 634, 84, 528, 298, 847, 567, 957, 960,
-	1223, 952, 951, 52, 1404, 760, 943, 1293, 1210, 1307,
-	3, 51, 1237, 312, 312, 295, 12, 293, 6, 294,
-	5, 816, 1367, 1746, 644, 1162, 779, 1224, 428, 1221,
-	761, 343, 586, 342, 500, 735, 820, 19, 285, 307,
-	288, 842, 792, 791, 793, 1121, 439, 464, 849, 879,
-	385, 404, 406, 752, 414, 568, 309, 52, 450, 308,
-	1137, 80, 1829, 1734, 1612, 1130, 549, 479, 774, 945,
-	79, 412, 533, 585, 1349, 535, 79, 1492, 1368, 2020,
-	299, 510, 1133, 2031, 1356, 79, 810, 405, 531, 339,
-	344, 425, 79, 499, 715, 77, 12, 712, 6, 79,
-	5, 23, 39, 24, 362, 400, 355, 402, 410, 409,
-	2053, 79, 1468, 23, 39, 24, 805, 806, 714, 1359,
-	2051, 372, 536, 523, 75, 490, 522, 525, 526, 525,
-	526, 2069, 2070, 75, 795, 769, 494, 2088, 408, 1909,
-	75, 1912, 1913, 1914, 1915, 1495, 1991, 75, 1496, 1994,
-	1497, 1832, 1614, 773, 386, 1336, 442, 433, 1541, 75,
-	1520, 1521, 1522, 1523, 401, 1376, 1374, 1371, 1375, 1377,
-	485, 1370, 1369, 1544, 373, 1376, 1374, 817, 1375, 1377,
-	1135, 1883, 1133, 1791, 1790, 481, 1787, 492, 493, 1731,
-	491, 480, 1900, 1609, 1692, 84, 432, 1691, 486, 2079,
-	753, 2055, 1688, 1890, 2158, 431, 2174, 2093, 84, 2050,
-	1543, 1968, 1969, 1970, 1972, 1971, 2005, 357, 2100, 2030,
-	1213, 1214, 1215, 1981, 2149, 2028, 755, 354, 353, 1878,
-	2068, 1211, 1847, 1846, 466, 2011, 341, 2175, 407, 1379,
-	1380, 1381, 1382, 446, 2001, 2002, 545, 2005, 349, 488,
-	467, 2057, 2058, 2169, 79, 2140, 23, 39, 24, 1835,
-	1410, 1214, 1215, 521, 520, 1524, 52, 52, 406, 1357,
-	532, 442, 427, 1443, 65, 513, 483, 534, 72, 489,
-	430, 472, 1989, 2033, 2034, 1353, 1689, 1184, 484, 487,
-	411, 1141, 337, 781, 1610, 511, 476, 40, 482, 394,
-	394, 394, 75, 405, 444, 443, 754, 397, 515, 297,
-	1516, 1869, 1707, 1706, 1395, 514, 471, 516, 1182, 1181,
-	808, 505, 1180, 539, 415, 537, 538, 589, 589, 809,
-	435, 436, 1179, 807, 374, 397, 562, 375, 352, 2153,
-	717, 2117, 1498, 1407, 1347, 1346, 312, 1385, 348, 1941,
-	1335, 831, 468, 469, 470, 560, 732, 1329, 432, 84,
-	84, 84, 84, 1174, 369, 1146, 1115, 736, 68, 69,
-	749, 70, 71, 860, 720, 1841, 564, 1873, 570, 895,
-	445, 399, 429, 713, 1387, 1484, 337, 337, 432, 337,
-	529, 2056, 2135, 1980, 466, 2126, 1532, 767, 2015, 502,
-	356, 517, 1331, 52, 548, 525, 526, 337, 337, 399,
-	467, 561, 1387, 750, 52, 525, 526, 588, 588, 444,
-	443, 544, 1517, 337, 1212, 337, 2032, 787, 84, 57,
-	67, 76, 496, 38, 1492, 571, 573, 402, 572, 437,
-	504, 312, 800, 768, 337, 818, 786, 1690, 1687, 66,
-	64, 63, 1156, 1486, 552, 1136, 337, 394, 556, 337,
-	518, 1386, 478, 788, 1409, 1350, 798, 1186, 527, 1119,
-	530, 78, 727, 728, 547, 832, 782, 78, 723, 312,
-	576, 577, 578, 579, 580, 582, 78, 337, 337, 839,
-	84, 710, 415, 78, 401, 848, 1132, 569, 801, 857,
-	78, 434, 771, 1485, 776, 1376, 1374, 780, 1375, 1377,
-	312, 843, 78, 366, 1591, 789, 790, 748, 783, 796,
-	1871, 367, 797, 1308, 1870, 841, 377, 844, 772, 737,
-	738, 739, 740, 765, 756, 1513, 1516, 775, 2131, 802,
-	1724, 48, 312, 553, 554, 555, 1131, 49, 519, 770,
-	824, 912, 550, 824, 785, 1365, 731, 824, 1942, 1944,
-	1945, 1946, 1943, 551, 730, 1226, 1225, 794, 784, 834,
-	1874, 1875, 1300, 923, 854, 379, 378, 1723, 1308, 819,
-	1448, 1880, 837, 1879, 50, 814, 1298, 1299, 1297, 826,
-	1670, 861, 1580, 830, 855, 856, 854, 815, 1864, 855,
-	856, 854, 833, 468, 469, 470, 1682, 835, 898, 899,
-	900, 901, 902, 895, 1665, 910, 2164, 838, 1417, 1234,
-	827, 828, 829, 856, 854, 949, 949, 954, 1236, 836,
-	2145, 73, 913, 914, 915, 916, 845, 2132, 376, 911,
-	418, 423, 424, 2110, 848, 2094, 1952, 919, 1517, 2040,
-	405, 962, 1231, 1510, 1987, 78, 1986, 1511, 1514, 855,
-	856, 854, 1683, 1958, 855, 856, 854, 963, 938, 563,
-	917, 887, 1593, 855, 856, 854, 364, 1936, 365, 372,
-	2062, 2148, 1951, 363, 361, 360, 368, 1451, 370, 371,
-	1450, 406, 84, 84, 1149, 1150, 1935, 468, 469, 470,
-	560, 52, 855, 856, 854, 292, 403, 1950, 1948, 1515,
-	380, 1934, 1176, 855, 856, 854, 948, 1129, 931, 1938,
-	956, 337, 2147, 843, 1931, 1117, 405, 1151, 1153, 1925,
-	1116, 1922, 322, 1921, 321, 325, 317, 1887, 1830, 844,
-	1823, 1822, 337, 1949, 1947, 1454, 313, 855, 856, 854,
-	955, 1821, 402, 2129, 2130, 1937, 561, 332, 1820, 941,
-	1985, 589, 1817, 84, 1676, 1113, 1675, 1674, 961, 1206,
-	1673, 1208, 1114, 1480, 1312, 312, 721, 1739, 2089, 1165,
-	1166, 1167, 855, 856, 854, 1126, 1168, 1177, 2078, 2061,
-	1232, 1233, 1957, 1430, 2037, 2022, 1191, 2009, 420, 421,
-	422, 903, 904, 896, 897, 898, 899, 900, 901, 902,
-	895, 1163, 855, 856, 854, 938, 1281, 1282, 1283, 1284,
-	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 824, 824,
-	824, 1302, 1303, 1171, 1169, 1216, 1140, 1170, 1429, 1172,
-	1199, 794, 2178, 1173, 2008, 1318, 1939, 1932, 1202, 1559,
-	1183, 588, 468, 469, 470, 1203, 1204, 1205, 1928, 1320,
-	855, 856, 854, 1907, 1187, 1188, 1189, 2036, 1642, 1192,
-	1927, 1193, 1570, 1567, 1568, 1569, 1229, 1926, 1564, 1885,
-	1563, 1562, 1560, 1200, 1866, 855, 856, 854, 1831, 1272,
-	1398, 1737, 1735, 1684, 558, 1529, 1528, 1527, 2156, 1526,
-	1301, 1227, 1228, 1143, 1230, 1142, 939, 315, 314, 318,
-	1267, 1268, 1269, 1270, 1271, 320, 1895, 1277, 1278, 1279,
-	1280, 1309, 468, 469, 470, 560, 1314, 324, 934, 933,
-	1295, 722, 1323, 1413, 2183, 1310, 1311, 1561, 855, 856,
-	854, 757, 893, 903, 904, 896, 897, 898, 899, 900,
-	901, 902, 895, 1334, 1630, 1313, 1315, 1316, 1458, 2177,
-	2176, 1413, 1457, 1139, 2159, 1319, 346, 1321, 1322, 1649,
-	1653, 1655, 1657, 1659, 1660, 1662, 345, 1570, 1567, 1568,
-	1569, 561, 2016, 1644, 1645, 1646, 1647, 1628, 1629, 1650,
-	1965, 1631, 1902, 1632, 1633, 1634, 1635, 1636, 1637, 1638,
-	1639, 1640, 1641, 1648, 1145, 2155, 2154, 1139, 2143, 1139,
-	2142, 1652, 1654, 1656, 1658, 1661, 1901, 575, 2116, 2115,
-	1897, 2076, 1725, 319, 323, 758, 1337, 327, 759, 432,
-	1722, 329, 330, 331, 1897, 2071, 333, 334, 736, 1824,
-	1721, 1144, 1643, 1696, 337, 1195, 2059, 337, 1565, 1566,
-	432, 906, 337, 909, 2048, 2047, 1619, 1362, 1601, 1352,
-	1546, 855, 856, 854, 855, 856, 854, 907, 908, 905,
-	1545, 894, 893, 903, 904, 896, 897, 898, 899, 900,
-	901, 902, 895, 2146, 1461, 1392, 896, 897, 898, 899,
-	900, 901, 902, 895, 1459, 337, 1897, 2026, 1897, 2025,
-	1456, 1351, 1897, 2024, 1455, 84, 84, 1897, 2023, 1403,
-	864, 865, 866, 867, 868, 869, 870, 862, 1384, 1364,
-	1341, 2014, 2013, 1342, 1963, 1964, 1344, 1453, 894, 893,
-	903, 904, 896, 897, 898, 899, 900, 901, 902, 895,
-	1400, 1401, 1422, 1418, 1419, 1360, 1361, 1354, 780, 1339,
-	1412, 402, 1394, 19, 1340, 1717, 1963, 1962, 1716, 1388,
-	1906, 1905, 1904, 1903, 1348, 1389, 1317, 1390, 751, 1715,
-	1897, 1896, 1599, 52, 1363, 1198, 1604, 855, 856, 854,
-	855, 856, 854, 1413, 1585, 1163, 574, 1383, 1413, 1571,
-	1396, 855, 856, 854, 855, 856, 854, 2134, 1774, 1413,
-	1393, 1413, 1421, 1413, 1420, 1198, 1338, 1402, 1399, 1437,
-	1333, 1332, 12, 1590, 6, 719, 5, 1391, 1327, 1326,
-	1408, 852, 1440, 1441, 2179, 1414, 1198, 1197, 1415, 1416,
-	1139, 1138, 475, 1777, 1324, 855, 856, 854, 949, 1772,
-	1472, 949, 1411, 1651, 1475, 1785, 1786, 725, 724, 495,
-	1773, 473, 1620, 474, 848, 474, 337, 1133, 1118, 1602,
-	337, 337, 1406, 476, 337, 850, 1478, 1330, 1424, 1425,
-	1426, 1427, 1428, 910, 1432, 1584, 476, 432, 1433, 1434,
-	1435, 1436, 1479, 1469, 1778, 1305, 1506, 2125, 1583, 84,
-	1195, 1439, 1147, 581, 546, 2119, 1467, 855, 856, 854,
-	2101, 79, 1474, 52, 2098, 1438, 1445, 2096, 405, 1449,
-	855, 856, 854, 2039, 1487, 1489, 1447, 84, 1551, 1582,
-	1471, 1977, 1295, 1462, 1530, 1452, 824, 1961, 1463, 1470,
-	1581, 1464, 824, 1473, 1476, 1477, 1889, 1481, 1577, 1959,
-	1482, 855, 856, 854, 1954, 1159, 1916, 1576, 1525, 75,
-	1575, 1483, 855, 856, 854, 1574, 1699, 1893, 1892, 1490,
-	855, 856, 854, 1784, 1891, 1509, 1888, 1533, 1534, 855,
-	856, 854, 855, 856, 854, 1877, 1862, 855, 856, 854,
-	1535, 1536, 1573, 1598, 1801, 1798, 1537, 1797, 1701, 584,
-	1780, 1710, 1713, 1128, 1595, 1556, 1678, 1671, 1296, 337,
-	75, 1597, 1550, 1555, 855, 856, 854, 1366, 1343, 1551,
-	1325, 84, 1779, 1781, 1553, 1196, 1589, 855, 856, 854,
-	1664, 1554, 1185, 1178, 1572, 855, 856, 854, 1586, 940,
-	937, 1578, 1579, 2106, 936, 1304, 1588, 935, 1594, 932,
-	880, 929, 927, 855, 856, 854, 1618, 926, 925, 1592,
-	1600, 920, 892, 1605, 891, 1596, 1617, 855, 856, 854,
-	1603, 890, 1695, 889, 1681, 888, 886, 885, 884, 883,
-	1787, 882, 719, 881, 1679, 1608, 52, 878, 1668, 877,
-	876, 875, 1775, 874, 873, 872, 871, 733, 716, 477,
-	1663, 1667, 1627, 1667, 1669, 1122, 1123, 1672, 2104, 2067,
-	1677, 452, 455, 456, 457, 453, 1378, 454, 458, 1194,
-	1125, 306, 1686, 497, 745, 743, 1127, 337, 337, 746,
-	744, 84, 742, 741, 1702, 1703, 1704, 747, 1685, 456,
-	457, 432, 2163, 1743, 1328, 2081, 565, 1711, 566, 1714,
-	1506, 1708, 1164, 1149, 1150, 1606, 1493, 501, 1500, 1694,
-	1157, 447, 1607, 503, 804, 1833, 1732, 1499, 846, 1719,
-	460, 338, 452, 455, 456, 457, 453, 2123, 454, 458,
-	1788, 1728, 1729, 1727, 1226, 1225, 1806, 1808, 1112, 1806,
-	1806, 1718, 1730, 2120, 1768, 2044, 1740, 2042, 1792, 432,
-	507, 508, 1795, 1796, 1720, 1793, 1794, 1996, 452, 455,
-	456, 457, 453, 824, 454, 458, 1799, 1995, 1802, 1803,
-	1993, 1807, 894, 893, 903, 904, 896, 897, 898, 899,
-	900, 901, 902, 895, 1919, 1917, 1736, 1693, 2121, 1616,
-	1615, 1809, 1810, 1549, 346, 506, 1811, 345, 1548, 1405,
-	1726, 2107, 719, 1423, 345, 2108, 2107, 459, 1345, 284,
-	2108, 358, 1819, 1, 1273, 509, 1837, 729, 417, 441,
-	726, 440, 438, 74, 1306, 1238, 664, 944, 1827, 950,
-	1955, 2080, 1812, 894, 893, 903, 904, 896, 897, 898,
-	899, 900, 901, 902, 895, 894, 893, 903, 904, 896,
-	897, 898, 899, 900, 901, 902, 895, 1865, 84, 2112,
-	2038, 1840, 2083, 652, 636, 1988, 1494, 1908, 1990, 1681,
-	1910, 1460, 1358, 1826, 1355, 498, 1465, 1466, 677, 1788,
-	667, 928, 1808, 668, 1863, 1825, 1838, 1839, 711, 1842,
-	1843, 1844, 1845, 1881, 1867, 1848, 1849, 1850, 1851, 1852,
-	1853, 1854, 1855, 1856, 1857, 1858, 1859, 1860, 1861, 419,
-	1920, 1886, 666, 1818, 1542, 347, 1894, 894, 893, 903,
-	904, 896, 897, 898, 899, 900, 901, 902, 895, 416,
-	359, 1953, 1882, 1611, 1789, 1712, 1800, 1898, 1698, 1235,
-	2172, 466, 2162, 2138, 2118, 2004, 2157, 2049, 2099, 2092,
-	1918, 2000, 1834, 310, 811, 540, 383, 467, 1978, 432,
-	1933, 391, 432, 432, 432, 734, 1518, 1372, 432, 1155,
-	52, 1134, 762, 311, 2029, 1960, 350, 1899, 1158, 351,
-	1161, 1160, 1923, 1924, 1217, 863, 1294, 1998, 1929, 1930,
-	1966, 1587, 930, 1974, 1975, 1976, 918, 1984, 1973, 591,
-	1446, 1983, 643, 637, 1539, 1538, 1783, 799, 26, 461,
-	1999, 1992, 894, 893, 903, 904, 896, 897, 898, 899,
-	900, 901, 902, 895, 853, 84, 2006, 2007, 958, 665,
-	86, 1175, 432, 959, 1997, 1828, 2085, 651, 650, 649,
-	648, 451, 449, 1444, 448, 302, 301, 851, 432, 2064,
-	2063, 2018, 2019, 1733, 2012, 1876, 1940, 1872, 1868, 2010,
-	841, 1742, 1741, 2021, 894, 893, 903, 904, 896, 897,
-	898, 899, 900, 901, 902, 895, 1769, 1770, 1776, 2027,
-	1626, 1622, 1624, 1625, 2035, 1623, 2043, 2041, 2045, 2046,
-	1621, 1504, 1505, 1502, 1501, 1124, 1120, 2052, 2054, 946,
-	953, 426, 778, 81, 300, 1201, 11, 18, 2060, 17,
-	2087, 16, 47, 46, 45, 2072, 2073, 2074, 2075, 2091,
-	2017, 44, 15, 2086, 8, 43, 42, 41, 14, 13,
-	37, 36, 35, 34, 2095, 33, 2097, 2090, 894, 893,
-	903, 904, 896, 897, 898, 899, 900, 901, 902, 895,
-	32, 2102, 31, 30, 2105, 2103, 29, 28, 27, 2114,
-	9, 56, 55, 2109, 54, 53, 20, 432, 21, 432,
-	2111, 22, 2077, 62, 61, 60, 767, 2122, 767, 2124,
-	59, 58, 25, 10, 2127, 7, 4, 2087, 2137, 2,
-	0, 0, 0, 0, 2133, 0, 432, 0, 0, 0,
-	2086, 2136, 2141, 0, 0, 767, 2144, 0, 0, 0,
-	0, 0, 2114, 2150, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2160, 0, 0, 0, 0, 0,
-	0, 0, 2161, 0, 0, 0, 0, 0, 0, 0,
-	2171, 2170, 0, 0, 0, 0, 0, 0, 0, 0,
-	2181, 0, 2182, 2180, 0, 2171, 0, 0, 0, 0,
-	0, 1075, 1062, 0, 1024, 1077, 996, 1012, 1085, 1014,
-	1015, 1049, 974, 1033, 211, 1010, 966, 999, 1000, 968,
-	1007, 969, 997, 1026, 155, 995, 1065, 1036, 180, 1083,
-	182, 0, 0, 240, 195, 0, 2152, 1029, 1067, 1031,
-	1054, 1023, 1050, 982, 1043, 1078, 1011, 1047, 1079, 0,
-	0, 0, 0, 468, 469, 470, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 1046, 1072, 1009, 0,
-	0, 983, 1076, 1030, 1048, 0, 967, 1044, 0, 972,
-	975, 1084, 1070, 1004, 1005, 0, 0, 0, 0, 0,
-	0, 0, 1027, 1032, 1051, 1020, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1001, 0, 1040, 0, 0,
-	0, 977, 973, 0, 1025, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 1074, 1111, 149, 275, 976, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 1095,
-	1096, 1097, 1098, 1099, 1107, 1108, 0, 981, 0, 1002,
-	1052, 0, 965, 1061, 1068, 1022, 269, 1071, 1019, 1018,
-	1102, 0, 1101, 244, 1103, 1104, 179, 1066, 998, 1008,
-	1003, 1006, 230, 213, 1073, 1039, 218, 228, 183, 255,
-	222, 260, 246, 268, 1055, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 1100, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1109, 0, 1110,
-	281, 162, 964, 264, 0, 209, 1063, 970, 980, 978,
-	1016, 1041, 1042, 205, 280, 1057, 1060, 1058, 1086, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 971,
-	0, 241, 262, 274, 265, 1017, 989, 1028, 273, 992,
-	990, 1056, 991, 1045, 1088, 199, 200, 201, 202, 1013,
-	0, 142, 1037, 1021, 1089, 1090, 1091, 1092, 1093, 1094,
-	994, 1069, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 988, 993, 987, 1034, 1035,
-	1080, 1081, 1082, 1053, 979, 1064, 984, 986, 985, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1059, 1038,
-	124, 0, 181, 1087, 224, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 672, 0,
-	0, 0, 1105, 1106, 277, 278, 279, 263, 211, 0,
-	0, 0, 0, 0, 645, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 689, 695, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 592, 679, 678,
-	654, 0, 0, 0, 138, 655, 0, 660, 0, 656,
-	659, 657, 658, 0, 0, 681, 0, 0, 0, 0,
-	0, 590, 642, 0, 646, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 639, 640, 0, 0, 0,
-	0, 673, 0, 641, 0, 0, 675, 0, 662, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 661, 671, 676, 149, 631, 669,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 687, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 670, 0, 230, 213, 698, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1275, 1274, 1276, 281, 162, 0, 264, 685, 209,
-	697, 680, 682, 683, 686, 690, 691, 629, 632, 692,
-	694, 696, 699, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 630, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 674, 199,
-	200, 201, 202, 688, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 705,
-	684, 704, 706, 707, 703, 708, 709, 693, 647, 0,
-	701, 700, 702, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 103, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 0, 0, 277, 278,
-	279, 263, 79, 0, 672, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	645, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 689,
-	695, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	638, 0, 0, 592, 679, 678, 654, 0, 0, 0,
-	138, 655, 0, 660, 0, 656, 659, 657, 658, 0,
-	0, 681, 0, 0, 0, 0, 0, 590, 642, 0,
-	646, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 639, 640, 0, 0, 0, 0, 673, 0, 641,
-	0, 0, 675, 0, 662, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	661, 671, 676, 149, 631, 669, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 687,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	670, 0, 230, 213, 698, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 162, 0, 264, 685, 209, 697, 680, 682, 683,
-	686, 690, 691, 629, 632, 692, 694, 696, 699, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 630, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 674, 199, 200, 201, 202, 688,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 705, 684, 704, 706, 707,
-	703, 708, 709, 693, 647, 0, 701, 700, 702, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 78, 224, 160, 594, 595, 596, 597,
-	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
-	608, 103, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
-	627, 628, 672, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 645, 0,
-	0, 0, 155, 825, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 689, 695, 0,
-	0, 0, 0, 0, 0, 821, 0, 0, 638, 0,
-	0, 592, 679, 678, 654, 0, 0, 0, 138, 655,
-	0, 660, 0, 656, 659, 657, 658, 0, 0, 681,
-	0, 0, 0, 0, 0, 590, 642, 0, 646, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 639,
-	640, 0, 0, 0, 0, 673, 0, 641, 0, 0,
-	822, 0, 662, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 661, 671,
-	676, 149, 631, 669, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 687, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 670, 0,
-	230, 213, 698, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 281, 162,
-	0, 264, 685, 209, 697, 680, 682, 683, 686, 690,
-	691, 629, 632, 692, 694, 696, 699, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 630, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 674, 199, 200, 201, 202, 688, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 705, 684, 704, 706, 707, 703, 708,
-	709, 693, 647, 0, 701, 700, 702, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 594, 595, 596, 597, 598, 599,
-	600, 601, 602, 603, 604, 605, 606, 607, 608, 103,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 618,
-	619, 620, 621, 622, 623, 624, 625, 626, 627, 628,
-	672, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 645, 0, 0, 0,
-	155, 2151, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 689, 695, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 638, 0, 0, 592,
-	679, 678, 654, 0, 0, 0, 138, 655, 0, 660,
-	0, 656, 659, 657, 658, 0, 0, 681, 0, 0,
-	0, 0, 0, 590, 642, 0, 646, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 639, 640, 0,
-	0, 0, 0, 673, 0, 641, 0, 0, 675, 0,
-	662, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 661, 671, 676, 149,
-	631, 669, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 687, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 670, 0, 230, 213,
-	698, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 0, 264,
-	685, 209, 697, 680, 682, 683, 686, 690, 691, 629,
-	632, 692, 694, 696, 699, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	630, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	674, 199, 200, 201, 202, 688, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 705, 684, 704, 706, 707, 703, 708, 709, 693,
-	647, 0, 701, 700, 702, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 594, 595, 596, 597, 598, 599, 600, 601,
-	602, 603, 604, 605, 606, 607, 608, 103, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 618, 619, 620,
-	621, 622, 623, 624, 625, 626, 627, 628, 672, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 645, 0, 0, 0, 155, 825,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 689, 695, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 592, 679, 678,
-	654, 0, 0, 0, 138, 655, 0, 660, 0, 656,
-	659, 657, 658, 0, 0, 681, 0, 0, 0, 0,
-	0, 590, 642, 0, 646, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 639, 640, 0, 0, 0,
-	0, 673, 0, 641, 0, 0, 675, 0, 662, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 661, 671, 676, 149, 631, 669,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 687, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 670, 0, 230, 213, 698, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 685, 209,
-	697, 680, 682, 683, 686, 690, 691, 629, 632, 692,
-	694, 696, 699, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 630, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 674, 199,
-	200, 201, 202, 688, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 705,
-	684, 704, 706, 707, 703, 708, 709, 693, 647, 0,
-	701, 700, 702, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 103, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 0, 0, 277, 278,
-	279, 263, 672, 0, 0, 1431, 0, 0, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 645, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 689, 695, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 638, 0,
-	0, 592, 679, 678, 654, 0, 0, 0, 138, 655,
-	0, 660, 0, 656, 659, 657, 658, 0, 0, 681,
-	0, 0, 0, 0, 0, 590, 642, 0, 646, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 639,
-	640, 0, 0, 0, 0, 673, 0, 641, 0, 0,
-	675, 0, 662, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 661, 671,
-	676, 149, 631, 669, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 687, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 670, 0,
-	230, 213, 698, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 281, 162,
-	0, 264, 685, 209, 697, 680, 682, 683, 686, 690,
-	691, 629, 632, 692, 694, 696, 699, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 630, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 674, 199, 200, 201, 202, 688, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 705, 684, 704, 706, 707, 703, 708,
-	709, 693, 647, 0, 701, 700, 702, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 594, 595, 596, 597, 598, 599,
-	600, 601, 602, 603, 604, 605, 606, 607, 608, 103,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 618,
-	619, 620, 621, 622, 623, 624, 625, 626, 627, 628,
-	672, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 645, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 689, 695, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 638, 0, 0, 592,
-	679, 678, 654, 0, 0, 0, 138, 655, 0, 660,
-	0, 656, 659, 657, 658, 0, 0, 681, 0, 0,
-	0, 0, 0, 590, 642, 0, 646, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 639, 640, 587,
-	0, 0, 0, 673, 0, 641, 0, 0, 675, 0,
-	662, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 661, 671, 676, 149,
-	631, 669, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 687, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 670, 0, 230, 213,
-	698, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 0, 264,
-	685, 209, 697, 680, 682, 683, 686, 690, 691, 629,
-	632, 692, 694, 696, 699, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	630, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	674, 199, 200, 201, 202, 688, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 705, 684, 704, 706, 707, 703, 708, 709, 693,
-	647, 0, 701, 700, 702, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 594, 595, 596, 597, 598, 599, 600, 601,
-	602, 603, 604, 605, 606, 607, 608, 103, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 618, 619, 620,
-	621, 622, 623, 624, 625, 626, 627, 628, 672, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 645, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 689, 695, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 592, 679, 678,
-	654, 0, 0, 0, 138, 655, 0, 660, 0, 656,
-	659, 657, 658, 0, 0, 681, 0, 0, 0, 0,
-	0, 590, 642, 0, 646, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 639, 640, 0, 0, 0,
-	0, 673, 0, 641, 0, 0, 675, 0, 662, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 661, 671, 676, 149, 631, 669,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 687, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 670, 0, 230, 213, 698, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 685, 209,
-	697, 680, 682, 683, 686, 690, 691, 629, 632, 692,
-	694, 696, 699, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 630, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 674, 199,
-	200, 201, 202, 688, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 705,
-	684, 704, 706, 707, 703, 708, 709, 693, 647, 0,
-	701, 700, 702, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 103, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 672, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 1218, 0,
-	0, 0, 645, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 689, 695, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 638, 0, 0, 592, 679, 678, 654, 0,
-	0, 0, 138, 655, 0, 660, 0, 656, 659, 657,
-	658, 0, 0, 681, 0, 0, 0, 0, 0, 0,
-	642, 0, 646, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 639, 640, 0, 0, 0, 0, 673,
-	0, 641, 0, 0, 675, 0, 662, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 661, 671, 676, 149, 631, 669, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 687, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 670, 0, 230, 213, 698, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 1219, 1220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 281, 162, 0, 264, 685, 209, 697, 680,
-	682, 683, 686, 690, 691, 629, 632, 692, 694, 696,
-	699, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 630, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 674, 199, 200, 201,
-	202, 688, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 705, 684, 704,
-	706, 707, 703, 708, 709, 693, 647, 0, 701, 700,
-	702, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 594, 595,
-	596, 597, 598, 599, 600, 601, 602, 603, 604, 605,
-	606, 607, 608, 103, 609, 610, 611, 612, 613, 614,
-	615, 616, 617, 618, 619, 620, 621, 622, 623, 624,
-	625, 626, 627, 628, 672, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	645, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 689,
-	695, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	638, 0, 0, 592, 679, 678, 654, 0, 0, 0,
-	138, 655, 0, 660, 0, 656, 659, 657, 658, 0,
-	0, 681, 0, 0, 0, 0, 0, 0, 642, 0,
-	646, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 639, 640, 0, 0, 0, 0, 673, 0, 641,
-	0, 0, 675, 0, 662, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	661, 671, 676, 149, 631, 669, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 687,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	670, 0, 230, 213, 698, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 162, 0, 264, 685, 209, 697, 680, 682, 683,
-	686, 690, 691, 629, 632, 692, 694, 696, 699, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 630, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 674, 199, 200, 201, 202, 688,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 705, 684, 704, 706, 707,
-	703, 708, 709, 693, 647, 0, 701, 700, 702, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 594, 595, 596, 597,
-	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
-	608, 103, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
-	627, 628, 0, 0, 277, 278, 279, 263, 322, 0,
-	321, 325, 317, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 332, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 335,
-	0, 0, 336, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 1258, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 314, 318, 0, 0, 0, 0,
-	0, 320, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 324, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 316, 246, 268,
-	0, 340, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 1254, 264,
-	1251, 209, 0, 0, 1253, 1250, 1252, 1256, 1257, 205,
-	280, 0, 1255, 0, 0, 233, 0, 0, 0, 319,
-	323, 326, 215, 327, 328, 0, 0, 329, 330, 331,
-	0, 0, 333, 334, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
-	1246, 1247, 1248, 1249, 1261, 1262, 1263, 1264, 1265, 1266,
-	1259, 1260, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	684, 659, 2262, 2260, 2259, 2267, 2232, 666, 2204, 1878,
+	798, 2082, 2173, 686, 2221, 1322, 2149, 1844, 2154, 2058,
+	2155, 2061, 1799, 2030, 577, 1182, 538, 1139, 96, 1684,
+	1138, 455, 1985, 575, 1876, 1877, 708, 1761, 1868, 2046,
+	472, 103, 1956, 1833, 1558, 316, 317, 1309, 100, 20,
+	314, 660, 409, 1883, 349, 349, 1444, 1867, 308, 526,
+	698, 57, 1554, 1764, 1758, 1543, 1773, 663, 654, 601,
+	1591, 1769, 1748, 1419, 1582, 1570, 1563, 1723, 410, 1559,
+	1623, 1188, 1625, 1489, 430, 1624, 665, 437, 57, 316,
+	440, 406, 1252, 1253, 1321, 1275, 1316, 56, 858, 439,
+	1298, 615, 544, 664, 675, 882, 585, 898, 1452, 792,
+	885, 3, 901, 99, 13, 97, 6, 98, 5, 1229,
+	851, 795, 1413, 822, 1254, 1196, 1807, 810, 658, 793,
+	1237, 636, 824, 655, 20, 855, 514, 436, 823, 877,
+	419, 421, 1136, 89, 1165, 1066, 57, 438, 92, 447,
+	474, 884, 429, 566, 57, 57, 421, 586, 794, 400,
+	355, 321, 784, 1172, 85, 319, 354, 493, 320, 1897,
+	460, 1795, 1683, 806, 657, 324, 324, 775, 420, 774,
+	776, 777, 427, 778, 779, 1168, 1626, 84, 2115, 1629,
+	552, 84, 84, 420, 24, 44, 25, 433, 1544, 13,
+	1414, 6, 84, 5, 309, 1638, 1642, 1644, 1646, 1648,
+	1649, 1651, 2104, 1637, 1634, 1635, 1636, 1396, 1604, 1631,
+	351, 1630, 1627, 524, 1639, 547, 1403, 845, 513, 84,
+	84, 24, 44, 25, 84, 2038, 80, 553, 826, 415,
+	80, 80, 443, 444, 1406, 417, 1641, 1643, 1645, 1647,
+	1650, 80, 82, 632, 894, 356, 386, 891, 840, 841,
+	829, 483, 425, 424, 537, 539, 540, 536, 539, 540,
+	2158, 2159, 801, 508, 401, 504, 2177, 1628, 80, 893,
+	1983, 1547, 2070, 80, 1548, 2137, 1549, 2135, 1986, 1987,
+	1988, 1989, 423, 2073, 1900, 1685, 805, 450, 1571, 1572,
+	1573, 1574, 1258, 441, 1592, 1595, 1170, 437, 437, 1955,
+	852, 495, 374, 387, 1168, 550, 1852, 1422, 1420, 1417,
+	1421, 1423, 1359, 1416, 1415, 1422, 1420, 1792, 1421, 1423,
+	2114, 1760, 1759, 505, 416, 506, 507, 494, 476, 476,
+	1680, 785, 1972, 471, 499, 1482, 1279, 1280, 1745, 1746,
+	1425, 1426, 1427, 1428, 1594, 477, 477, 454, 456, 2168,
+	2139, 1742, 1962, 1278, 1279, 1280, 2252, 787, 2189, 2268,
+	2182, 2157, 500, 450, 1276, 2134, 484, 2047, 2048, 2049,
+	2051, 2050, 2084, 2080, 2081, 1948, 2084, 2112, 437, 2151,
+	2150, 1632, 1633, 1947, 2243, 422, 1915, 349, 1914, 2117,
+	2118, 353, 2141, 2142, 410, 410, 410, 1938, 2090, 562,
+	535, 534, 2269, 482, 548, 502, 412, 519, 1404, 2263,
+	2273, 528, 2233, 530, 2060, 1903, 1213, 613, 1110, 430,
+	1567, 527, 503, 551, 1942, 2068, 437, 87, 549, 1400,
+	1221, 1176, 367, 580, 525, 1743, 388, 812, 452, 451,
+	786, 426, 529, 497, 629, 1681, 307, 1431, 440, 316,
+	316, 316, 316, 306, 836, 498, 501, 637, 1575, 1442,
+	650, 490, 1771, 1770, 1217, 496, 843, 588, 844, 392,
+	1219, 1218, 556, 554, 555, 1640, 614, 783, 1216, 842,
+	349, 349, 440, 349, 414, 389, 390, 1433, 476, 2247,
+	2208, 799, 1602, 1500, 1466, 2146, 57, 1394, 2224, 531,
+	1393, 349, 349, 516, 652, 477, 1257, 1244, 324, 1210,
+	1123, 1490, 1059, 1110, 452, 451, 617, 349, 486, 349,
+	445, 818, 437, 394, 393, 582, 453, 867, 1095, 1536,
+	545, 651, 2228, 349, 2116, 2217, 1568, 383, 834, 1538,
+	1544, 349, 1583, 634, 817, 485, 569, 369, 1481, 2140,
+	573, 574, 561, 349, 410, 633, 349, 366, 365, 808,
+	2015, 1171, 811, 832, 1432, 492, 1277, 406, 1167, 589,
+	591, 2274, 819, 868, 813, 417, 590, 853, 361, 539,
+	540, 539, 540, 1190, 620, 349, 349, 875, 437, 2059,
+	430, 1537, 83, 883, 888, 888, 83, 83, 835, 518,
+	1744, 600, 324, 510, 800, 2225, 2094, 83, 859, 1741,
+	803, 859, 1397, 878, 1940, 859, 859, 486, 1939, 876,
+	1166, 883, 831, 437, 1943, 1944, 814, 820, 821, 649,
+	879, 804, 903, 567, 83, 83, 830, 1468, 456, 83,
+	324, 1223, 797, 807, 568, 887, 887, 788, 481, 904,
+	837, 892, 532, 638, 639, 640, 641, 1064, 897, 587,
+	442, 1113, 802, 57, 416, 1361, 1360, 825, 570, 571,
+	572, 364, 57, 1061, 324, 1620, 1317, 1342, 1340, 1341,
+	543, 360, 546, 565, 816, 854, 870, 1317, 440, 1495,
+	1411, 828, 815, 380, 873, 861, 1080, 1126, 1952, 865,
+	866, 381, 1951, 1083, 412, 1422, 1420, 324, 1421, 1423,
+	1074, 1112, 869, 850, 862, 863, 864, 871, 849, 1120,
+	1111, 2222, 2223, 605, 610, 611, 1060, 1062, 594, 595,
+	596, 597, 598, 624, 625, 1149, 1150, 368, 1933, 872,
+	1082, 1080, 880, 533, 2258, 1909, 889, 1114, 1115, 1116,
+	1117, 874, 1342, 1340, 1341, 1384, 564, 420, 902, 1727,
+	1057, 1722, 1058, 78, 2242, 1499, 896, 1847, 1498, 581,
+	391, 1118, 417, 1836, 2238, 2201, 1071, 2016, 2018, 2019,
+	2020, 2017, 414, 576, 2191, 1433, 478, 479, 480, 578,
+	2183, 1370, 1147, 1081, 1082, 1080, 1848, 2124, 478, 479,
+	480, 578, 1372, 437, 437, 411, 1564, 1567, 2241, 1839,
+	2026, 2066, 478, 479, 480, 578, 96, 1834, 1081, 1082,
+	1080, 628, 432, 1850, 1851, 1305, 1212, 2065, 1835, 627,
+	1098, 1099, 1100, 1101, 1102, 1095, 349, 2033, 878, 1303,
+	1304, 1302, 2010, 418, 1338, 2239, 1335, 395, 579, 2025,
+	1337, 1334, 1336, 1185, 1187, 879, 1339, 2009, 349, 378,
+	579, 379, 386, 1840, 2008, 2005, 377, 373, 372, 382,
+	375, 1999, 376, 1996, 579, 384, 385, 1995, 1241, 478,
+	479, 480, 1311, 2024, 1250, 1250, 1255, 1959, 607, 608,
+	609, 2022, 1094, 1093, 1103, 1104, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1095, 859, 859, 859, 2012, 1898, 1214,
+	1180, 1199, 1200, 1201, 1891, 1202, 1081, 1082, 1080, 1338,
+	1782, 1335, 2023, 1568, 1622, 1337, 1334, 1336, 1561, 1890,
+	2021, 1339, 1562, 1565, 1147, 1197, 1889, 1175, 1204, 1888,
+	1880, 1312, 1733, 1208, 421, 1849, 2011, 1560, 1179, 1732,
+	1246, 1183, 1184, 1203, 1731, 1209, 1730, 324, 1781, 1205,
+	1207, 825, 1345, 1346, 1347, 1348, 1349, 1350, 1343, 1344,
+	1475, 1353, 1842, 1081, 1082, 1080, 1661, 1249, 1206, 1228,
+	618, 420, 1081, 1082, 1080, 1566, 1800, 2272, 1224, 1225,
+	1226, 1616, 1238, 1256, 2178, 1841, 1843, 1231, 1504, 1232,
+	2167, 1220, 1497, 1239, 1846, 2145, 2031, 1081, 1082, 1080,
+	1245, 2106, 1094, 1093, 1103, 1104, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1095, 2088, 2087, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1345, 1346, 1347,
+	1348, 1349, 1350, 1343, 1344, 2214, 1081, 1082, 1080, 2250,
+	1259, 478, 479, 480, 440, 1852, 1106, 2013, 1109, 1510,
+	1081, 1082, 1080, 637, 2193, 2006, 2002, 1837, 1081, 1082,
+	1080, 2001, 1107, 1108, 1105, 2000, 1094, 1093, 1103, 1104,
+	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1095, 1081, 1082,
+	1080, 2147, 1094, 1093, 1103, 1104, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1095, 1961, 1509, 1263, 1957, 1935, 1264,
+	1899, 1445, 1266, 1798, 1320, 1081, 1082, 1080, 1796, 859,
+	1738, 1271, 1272, 1273, 1274, 1310, 1491, 1580, 1579, 1081,
+	1082, 1080, 1578, 1373, 1096, 1097, 1098, 1099, 1100, 1101,
+	1102, 1095, 1577, 1281, 1378, 1379, 1178, 1094, 1093, 1103,
+	1104, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1095, 349,
+	1177, 1148, 349, 1318, 1319, 440, 1270, 349, 1143, 1142,
+	619, 1356, 2280, 358, 1399, 1516, 1363, 2240, 1079, 1515,
+	2121, 2099, 2120, 357, 1306, 1262, 2096, 1286, 1287, 1288,
+	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1267,
+	1300, 1438, 1307, 1308, 1255, 1081, 1082, 1080, 1079, 2279,
+	1351, 1352, 349, 1261, 2044, 1357, 2271, 2270, 1976, 417,
+	1975, 1407, 1408, 811, 437, 593, 1787, 1451, 1780, 888,
+	1174, 2253, 1779, 1458, 2064, 1460, 2249, 2248, 1757, 1375,
+	1430, 1690, 1981, 1457, 1597, 1410, 1596, 1354, 1355, 1527,
+	1358, 1174, 2236, 1967, 1368, 1519, 883, 1517, 1081, 1082,
+	1080, 1174, 2235, 1374, 1514, 1376, 1081, 1082, 1080, 2207,
+	2206, 1513, 1473, 20, 1449, 1506, 1401, 1081, 1082, 1080,
+	887, 1969, 2165, 1398, 1503, 57, 1969, 2160, 1395, 1474,
+	632, 2143, 1502, 1484, 1434, 2132, 2131, 1435, 1478, 1409,
+	1893, 1436, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1084,
+	1441, 1429, 1197, 1969, 2110, 1369, 2212, 1969, 2109, 1969,
+	2108, 1443, 1785, 1437, 1081, 1082, 1080, 1440, 1439, 1969,
+	2107, 2093, 2092, 1078, 1446, 653, 1450, 592, 13, 1455,
+	6, 1448, 5, 2227, 1462, 2095, 1081, 1082, 1080, 2042,
+	2043, 1079, 1111, 2042, 2041, 1471, 1492, 1075, 1476, 1496,
+	1472, 1477, 1480, 1094, 1093, 1103, 1104, 1096, 1097, 1098,
+	1099, 1100, 1101, 1102, 1095, 1526, 1750, 1483, 1980, 1979,
+	1486, 1978, 1977, 349, 1784, 1969, 1968, 349, 349, 420,
+	616, 349, 1783, 1485, 1079, 1668, 509, 1300, 1487, 1488,
+	488, 1507, 1494, 440, 1508, 1691, 1512, 1168, 1081, 1082,
+	1080, 1675, 1557, 1079, 1614, 437, 1081, 1082, 1080, 1520,
+	1236, 1612, 1523, 1524, 1525, 1674, 1601, 1528, 1529, 1530,
+	1531, 1532, 1533, 1534, 1063, 1081, 1082, 1080, 1501, 1079,
+	1522, 1079, 1521, 1470, 1469, 316, 1362, 1464, 1463, 1081,
+	1082, 1080, 1236, 1260, 1673, 1250, 489, 1608, 1250, 1672,
+	1470, 1611, 1671, 1479, 1377, 1581, 1467, 1380, 1381, 1382,
+	1383, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1081, 1082,
+	1080, 1670, 1576, 1081, 1082, 1080, 1081, 1082, 1080, 1236,
+	1235, 1654, 1535, 1669, 1174, 1173, 1584, 1585, 1075, 1076,
+	1542, 490, 1605, 1600, 490, 1081, 1082, 1080, 1539, 1541,
+	859, 622, 621, 1314, 57, 632, 487, 1081, 1082, 1080,
+	488, 859, 1181, 1653, 1607, 1788, 599, 1586, 1587, 563,
+	1588, 349, 2216, 2210, 2190, 2187, 1193, 1621, 1667, 84,
+	1662, 2185, 437, 2123, 1598, 1140, 1655, 1656, 1606, 1618,
+	1609, 1721, 1659, 1660, 1603, 2098, 2056, 1610, 2040, 2197,
+	1615, 1666, 1081, 1082, 1080, 2034, 2028, 1617, 1613, 1652,
+	1665, 1990, 1094, 1093, 1103, 1104, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1095, 1664, 1081, 1082, 1080, 80, 1688,
+	2195, 1658, 1689, 1654, 1081, 1082, 1080, 1763, 2156, 1310,
+	1657, 57, 1965, 1964, 1963, 1751, 1736, 1960, 1081, 1082,
+	1080, 1949, 1663, 1725, 1679, 1081, 1082, 1080, 1946, 1931,
+	1930, 1864, 1737, 1861, 1081, 1082, 1080, 1860, 1720, 1724,
+	1697, 1724, 1726, 1698, 1073, 1729, 1619, 1765, 602, 1734,
+	1093, 1103, 1104, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
+	1095, 1740, 1676, 1313, 1774, 1777, 1735, 1766, 1767, 1768,
+	1081, 1082, 1080, 1754, 1756, 1728, 1753, 1301, 349, 349,
+	80, 1412, 437, 1265, 1755, 1234, 1222, 1081, 1082, 1080,
+	1739, 1215, 440, 1804, 1137, 1164, 1163, 1162, 1161, 1160,
+	1159, 1557, 1775, 1158, 1778, 1772, 1157, 1156, 1155, 1154,
+	1153, 1152, 1151, 1786, 1146, 1145, 1545, 1518, 1144, 1141,
+	1135, 1134, 1133, 1869, 1871, 1132, 1869, 1869, 1131, 1130,
+	1129, 1128, 1793, 1122, 1121, 1855, 440, 1853, 1077, 1858,
+	1859, 895, 630, 491, 1424, 1791, 318, 1067, 1068, 1233,
+	1070, 316, 511, 1862, 1830, 1865, 1866, 1072, 1854, 1801,
+	643, 642, 1870, 1856, 1857, 1094, 1093, 1103, 1104, 1096,
+	1097, 1098, 1099, 1100, 1101, 1102, 1095, 646, 2257, 1465,
+	1875, 616, 647, 1872, 1873, 2170, 583, 1874, 1103, 1104,
+	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1095, 644, 1789,
+	1790, 350, 584, 645, 1882, 1198, 1905, 1887, 515, 1886,
+	462, 465, 466, 467, 463, 1551, 464, 468, 1183, 1184,
+	1895, 1094, 1093, 1103, 1104, 1096, 1097, 1098, 1099, 1100,
+	1101, 1102, 1095, 457, 1892, 1191, 1677, 839, 334, 2035,
+	333, 337, 329, 1678, 462, 465, 466, 467, 463, 1901,
+	464, 468, 325, 437, 1550, 462, 465, 466, 467, 463,
+	1908, 464, 468, 344, 648, 1310, 466, 467, 881, 470,
+	1361, 1360, 521, 522, 1230, 1871, 1932, 1853, 1056, 542,
+	1906, 1907, 541, 1910, 1911, 1912, 1913, 1974, 1936, 1916,
+	1917, 1918, 1919, 1920, 1921, 1922, 1923, 1924, 1925, 1926,
+	1927, 1928, 1929, 1953, 1958, 517, 2211, 1994, 2128, 2126,
+	2075, 2074, 2072, 1966, 1993, 1991, 1970, 1885, 1797, 1747,
+	1687, 1686, 520, 358, 357, 1884, 1749, 616, 1505, 2027,
+	1454, 1971, 2032, 357, 2199, 2198, 1973, 1392, 88, 2198,
+	1934, 2199, 1950, 476, 1447, 469, 370, 1, 1364, 523,
+	1992, 626, 431, 604, 449, 623, 448, 446, 79, 2007,
+	477, 440, 57, 1315, 440, 440, 440, 700, 656, 1251,
+	440, 2029, 2169, 2203, 2122, 2172, 1456, 685, 667, 2067,
+	1546, 1982, 2069, 1997, 1998, 1984, 1405, 2037, 1894, 2003,
+	2004, 2077, 1402, 86, 631, 2045, 2063, 512, 2053, 2054,
+	2055, 2036, 2052, 1268, 1269, 2062, 729, 707, 890, 606,
+	706, 1881, 1593, 359, 2078, 603, 371, 2071, 1954, 1682,
+	327, 326, 330, 1776, 1863, 1762, 1371, 2266, 332, 437,
+	2256, 2085, 2086, 2231, 2209, 2083, 2251, 2133, 2188, 2181,
+	336, 440, 2079, 1902, 322, 846, 557, 398, 2057, 635,
+	1569, 1418, 1189, 1169, 789, 323, 2113, 440, 2039, 362,
+	2091, 1192, 363, 1195, 1194, 1282, 2101, 1085, 2100, 1299,
+	1119, 662, 1493, 674, 668, 2105, 1590, 1589, 1845, 456,
+	833, 27, 1240, 899, 702, 102, 2097, 1211, 900, 2076,
+	2119, 2111, 1896, 2174, 683, 2127, 682, 2129, 2130, 2125,
+	681, 680, 461, 459, 458, 313, 312, 2136, 2138, 311,
+	1453, 2153, 2152, 2102, 2103, 1794, 1945, 2144, 2014, 1941,
+	1937, 2089, 1803, 1802, 2176, 1831, 1832, 1838, 1693, 2161,
+	2162, 2163, 2164, 2180, 1695, 1696, 1694, 2175, 1692, 331,
+	335, 790, 1555, 339, 791, 1556, 1553, 341, 342, 343,
+	2148, 2179, 345, 346, 1552, 1069, 1065, 1247, 612, 809,
+	434, 310, 1599, 12, 11, 19, 18, 2192, 17, 52,
+	51, 50, 49, 16, 2196, 2194, 8, 48, 2205, 47,
+	46, 15, 14, 2200, 41, 40, 440, 34, 440, 2166,
+	33, 39, 38, 2202, 37, 799, 2213, 799, 2215, 36,
+	35, 32, 31, 30, 29, 28, 9, 2219, 2176, 2230,
+	2220, 61, 60, 59, 2226, 58, 21, 440, 22, 23,
+	2229, 2175, 67, 2234, 66, 65, 799, 2237, 64, 63,
+	26, 10, 7, 4, 2, 2205, 2244, 0, 0, 2184,
+	0, 2186, 0, 0, 0, 0, 0, 0, 2254, 0,
+	0, 0, 0, 0, 0, 0, 2255, 0, 0, 0,
+	0, 0, 0, 2265, 0, 2264, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2277, 2276, 2275, 0, 2265,
+	2246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1016, 1003, 2218, 965, 1018, 937, 953, 1026,
+	955, 956, 990, 915, 974, 229, 951, 907, 940, 941,
+	909, 948, 910, 938, 967, 172, 936, 1006, 977, 198,
+	1024, 200, 0, 0, 261, 213, 0, 0, 970, 1008,
+	972, 995, 964, 991, 923, 984, 1019, 952, 0, 988,
+	1020, 0, 0, 0, 0, 478, 479, 480, 0, 0,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
+	987, 1013, 950, 0, 0, 924, 1017, 971, 989, 0,
+	908, 985, 0, 913, 916, 1025, 1011, 945, 946, 0,
+	0, 0, 0, 0, 0, 0, 968, 973, 992, 961,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 942,
+	0, 981, 0, 0, 0, 0, 918, 914, 0, 0,
+	297, 223, 302, 966, 0, 146, 266, 281, 156, 257,
+	294, 160, 264, 152, 228, 253, 148, 279, 263, 210,
+	192, 193, 147, 0, 248, 170, 183, 167, 226, 0,
+	1015, 1052, 166, 917, 289, 150, 151, 288, 225, 276,
+	280, 211, 205, 149, 278, 209, 204, 196, 174, 187,
+	238, 203, 239, 188, 215, 214, 216, 1036, 1037, 1038,
+	1039, 1040, 1048, 1049, 0, 922, 0, 943, 993, 0,
+	906, 1002, 1009, 963, 291, 1012, 960, 959, 1043, 0,
+	1042, 265, 1044, 1045, 197, 1007, 939, 949, 944, 947,
+	251, 231, 1014, 980, 236, 249, 201, 277, 240, 282,
+	267, 290, 996, 243, 142, 268, 169, 212, 153, 154,
+	165, 171, 173, 175, 176, 221, 222, 234, 256, 269,
+	270, 271, 272, 168, 161, 250, 162, 185, 163, 143,
+	258, 164, 144, 235, 275, 1041, 182, 1053, 141, 1054,
+	1055, 246, 208, 145, 207, 237, 274, 273, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1050, 0, 1051, 303, 179, 905, 286, 0, 227, 1004,
+	911, 921, 919, 957, 982, 983, 998, 1001, 999, 1027,
+	254, 0, 0, 0, 0, 0, 190, 233, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	912, 0, 262, 284, 296, 287, 958, 930, 969, 295,
+	933, 931, 997, 932, 986, 1029, 217, 218, 219, 220,
+	954, 0, 159, 978, 962, 1030, 1031, 1032, 1033, 1034,
+	1035, 935, 1010, 178, 184, 0, 186, 158, 232, 181,
+	293, 194, 242, 241, 191, 245, 224, 189, 259, 195,
+	202, 247, 292, 230, 252, 157, 283, 260, 206, 180,
+	929, 934, 928, 975, 976, 1021, 1022, 1023, 994, 920,
+	1005, 925, 927, 926, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1000, 979, 140, 712, 199, 1028, 244,
+	177, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 676, 0, 0, 0, 172, 0, 0, 0,
+	198, 0, 200, 0, 0, 261, 213, 0, 0, 0,
+	0, 755, 763, 0, 0, 0, 0, 1046, 1047, 299,
+	300, 301, 285, 669, 0, 0, 699, 411, 733, 687,
+	0, 0, 0, 155, 0, 0, 688, 694, 693, 695,
+	689, 692, 690, 691, 0, 0, 747, 0, 0, 0,
+	0, 0, 661, 673, 0, 677, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 670, 671, 0, 0,
+	0, 0, 713, 0, 672, 0, 0, 0, 715, 0,
+	0, 769, 758, 759, 697, 0, 146, 266, 281, 156,
+	257, 294, 160, 264, 152, 228, 253, 148, 279, 263,
+	210, 192, 193, 147, 0, 248, 170, 183, 167, 226,
+	696, 711, 716, 166, 709, 289, 150, 151, 288, 225,
+	276, 280, 211, 205, 149, 278, 209, 204, 196, 174,
+	187, 238, 203, 239, 188, 215, 214, 216, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 753, 0,
+	0, 0, 265, 0, 0, 197, 0, 0, 0, 710,
+	0, 251, 231, 766, 0, 236, 249, 201, 277, 240,
+	282, 267, 290, 0, 243, 142, 268, 169, 212, 153,
+	154, 165, 171, 173, 175, 176, 221, 222, 234, 256,
+	269, 270, 271, 272, 168, 161, 250, 162, 185, 163,
+	143, 258, 164, 144, 235, 275, 0, 182, 0, 141,
+	0, 0, 246, 208, 145, 207, 237, 274, 273, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1366, 1365, 1367, 303, 179, 0, 286, 751, 227,
+	765, 746, 748, 749, 752, 756, 757, 760, 762, 764,
+	768, 254, 0, 0, 0, 0, 0, 190, 233, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 284, 296, 767, 0, 0, 0,
+	295, 0, 0, 0, 0, 0, 714, 217, 218, 219,
+	220, 754, 0, 159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 184, 0, 186, 158, 232,
+	181, 293, 194, 242, 241, 191, 245, 224, 189, 259,
+	195, 202, 247, 292, 230, 252, 157, 283, 260, 206,
+	180, 775, 750, 774, 776, 777, 773, 778, 779, 761,
+	679, 0, 771, 770, 772, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 140, 0, 199, 0,
+	244, 177, 739, 722, 723, 724, 678, 725, 720, 721,
+	740, 717, 736, 737, 701, 704, 726, 119, 727, 738,
+	741, 742, 780, 781, 782, 730, 743, 735, 734, 728,
+	718, 744, 745, 705, 703, 731, 732, 719, 0, 0,
+	299, 300, 301, 285, 84, 0, 712, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 676, 0, 0, 0, 172, 0, 0, 0,
+	198, 0, 200, 0, 0, 261, 213, 0, 0, 0,
+	0, 755, 763, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 669, 0, 0, 699, 411, 733, 687,
+	0, 0, 0, 155, 0, 0, 688, 694, 693, 695,
+	689, 692, 690, 691, 0, 0, 747, 0, 0, 0,
+	0, 0, 661, 673, 0, 677, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 670, 671, 0, 0,
+	0, 0, 713, 0, 672, 0, 0, 0, 715, 0,
+	0, 769, 758, 759, 697, 0, 146, 266, 281, 156,
+	257, 294, 160, 264, 152, 228, 253, 148, 279, 263,
+	210, 192, 193, 147, 0, 248, 170, 183, 167, 226,
+	696, 711, 716, 166, 709, 289, 150, 151, 288, 225,
+	276, 280, 211, 205, 149, 278, 209, 204, 196, 174,
+	187, 238, 203, 239, 188, 215, 214, 216, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 753, 0,
+	0, 0, 265, 0, 0, 197, 0, 0, 0, 710,
+	0, 251, 231, 766, 0, 236, 249, 201, 277, 240,
+	282, 267, 290, 0, 243, 142, 268, 169, 212, 153,
+	154, 165, 171, 173, 175, 176, 221, 222, 234, 256,
+	269, 270, 271, 272, 168, 161, 250, 162, 185, 163,
+	143, 258, 164, 144, 235, 275, 0, 182, 0, 141,
+	0, 0, 246, 208, 145, 207, 237, 274, 273, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 303, 179, 0, 286, 751, 227,
+	765, 746, 748, 749, 752, 756, 757, 760, 762, 764,
+	768, 254, 0, 0, 0, 0, 0, 190, 233, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 284, 296, 767, 0, 0, 0,
+	295, 0, 0, 0, 0, 0, 714, 217, 218, 219,
+	220, 754, 0, 159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 184, 0, 186, 158, 232,
+	181, 293, 194, 242, 241, 191, 245, 224, 189, 259,
+	195, 202, 247, 292, 230, 252, 157, 283, 260, 206,
+	180, 775, 750, 774, 776, 777, 773, 778, 779, 761,
+	679, 0, 771, 770, 772, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 140, 0, 199, 83,
+	244, 177, 739, 722, 723, 724, 678, 725, 720, 721,
+	740, 717, 736, 737, 701, 704, 726, 119, 727, 738,
+	741, 742, 780, 781, 782, 730, 743, 735, 734, 728,
+	718, 744, 745, 705, 703, 731, 732, 719, 712, 0,
+	299, 300, 301, 285, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 676, 0, 0, 0, 172, 860,
+	0, 0, 198, 0, 200, 0, 0, 261, 213, 0,
+	0, 0, 0, 755, 763, 0, 0, 0, 0, 0,
+	0, 0, 856, 0, 0, 669, 0, 0, 699, 411,
+	733, 687, 0, 0, 0, 155, 0, 0, 688, 694,
+	693, 695, 689, 692, 690, 691, 0, 0, 747, 0,
+	0, 0, 0, 0, 661, 673, 0, 677, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 670, 671,
+	0, 0, 0, 0, 713, 0, 672, 0, 0, 0,
+	857, 0, 0, 769, 758, 759, 697, 0, 146, 266,
+	281, 156, 257, 294, 160, 264, 152, 228, 253, 148,
+	279, 263, 210, 192, 193, 147, 0, 248, 170, 183,
+	167, 226, 696, 711, 716, 166, 709, 289, 150, 151,
+	288, 225, 276, 280, 211, 205, 149, 278, 209, 204,
+	196, 174, 187, 238, 203, 239, 188, 215, 214, 216,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	753, 0, 0, 0, 265, 0, 0, 197, 0, 0,
+	0, 710, 0, 251, 231, 766, 0, 236, 249, 201,
+	277, 240, 282, 267, 290, 0, 243, 142, 268, 169,
+	212, 153, 154, 165, 171, 173, 175, 176, 221, 222,
+	234, 256, 269, 270, 271, 272, 168, 161, 250, 162,
+	185, 163, 143, 258, 164, 144, 235, 275, 0, 182,
+	0, 141, 0, 0, 246, 208, 145, 207, 237, 274,
+	273, 298, 304, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 303, 179, 0, 286,
+	751, 227, 765, 746, 748, 749, 752, 756, 757, 760,
+	762, 764, 768, 254, 0, 0, 0, 0, 0, 190,
+	233, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 284, 296, 767, 0,
+	0, 0, 295, 0, 0, 0, 0, 0, 714, 217,
+	218, 219, 220, 754, 0, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 184, 0, 186,
+	158, 232, 181, 293, 194, 242, 241, 191, 245, 224,
+	189, 259, 195, 202, 247, 292, 230, 252, 157, 283,
+	260, 206, 180, 775, 750, 774, 776, 777, 773, 778,
+	779, 761, 679, 0, 771, 770, 772, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 140, 0,
+	199, 0, 244, 177, 739, 722, 723, 724, 678, 725,
+	720, 721, 740, 717, 736, 737, 701, 704, 726, 119,
+	727, 738, 741, 742, 780, 781, 782, 730, 743, 735,
+	734, 728, 718, 744, 745, 705, 703, 731, 732, 719,
+	712, 0, 299, 300, 301, 285, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 676, 0, 0, 0,
+	172, 0, 0, 0, 198, 0, 200, 0, 0, 261,
+	213, 0, 0, 0, 0, 755, 763, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
+	699, 411, 733, 687, 0, 0, 0, 155, 0, 0,
+	688, 694, 693, 695, 689, 692, 690, 691, 0, 0,
+	747, 0, 0, 0, 0, 0, 661, 673, 0, 677,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	670, 671, 0, 0, 0, 0, 713, 0, 672, 0,
+	0, 0, 715, 0, 0, 769, 758, 759, 697, 0,
+	146, 266, 281, 156, 257, 294, 160, 264, 152, 228,
+	253, 148, 279, 263, 210, 192, 193, 147, 0, 248,
+	170, 183, 167, 226, 696, 711, 716, 166, 709, 289,
+	150, 151, 288, 225, 276, 280, 211, 205, 149, 278,
+	209, 204, 196, 174, 187, 238, 203, 239, 188, 215,
+	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 753, 0, 0, 0, 265, 0, 0, 197,
+	0, 0, 0, 710, 0, 251, 231, 766, 2278, 236,
+	249, 201, 277, 240, 282, 267, 290, 0, 243, 142,
+	268, 169, 212, 153, 154, 165, 171, 173, 175, 176,
+	221, 222, 234, 256, 269, 270, 271, 272, 168, 161,
+	250, 162, 185, 163, 143, 258, 164, 144, 235, 275,
+	0, 182, 0, 141, 0, 0, 246, 208, 145, 207,
+	237, 274, 273, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 179,
+	0, 286, 751, 227, 765, 746, 748, 749, 752, 756,
+	757, 760, 762, 764, 768, 254, 0, 0, 0, 0,
+	0, 190, 233, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 284, 296,
+	767, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	714, 217, 218, 219, 220, 754, 0, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 184,
+	0, 186, 158, 232, 181, 293, 194, 242, 241, 191,
+	245, 224, 189, 259, 195, 202, 247, 292, 230, 252,
+	157, 283, 260, 206, 180, 775, 750, 774, 776, 777,
+	773, 778, 779, 761, 679, 0, 771, 770, 772, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 199, 0, 244, 177, 739, 722, 723, 724,
+	678, 725, 720, 721, 740, 717, 736, 737, 701, 704,
+	726, 119, 727, 738, 741, 742, 780, 781, 782, 730,
+	743, 735, 734, 728, 718, 744, 745, 705, 703, 731,
+	732, 719, 712, 0, 299, 300, 301, 285, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 676, 0,
+	0, 0, 172, 2245, 0, 0, 198, 0, 200, 0,
+	0, 261, 213, 0, 0, 0, 0, 755, 763, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 669,
+	0, 0, 699, 411, 733, 687, 0, 0, 0, 155,
+	0, 0, 688, 694, 693, 695, 689, 692, 690, 691,
+	0, 0, 747, 0, 0, 0, 0, 0, 661, 673,
+	0, 677, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 670, 671, 0, 0, 0, 0, 713, 0,
+	672, 0, 0, 0, 715, 0, 0, 769, 758, 759,
+	697, 0, 146, 266, 281, 156, 257, 294, 160, 264,
+	152, 228, 253, 148, 279, 263, 210, 192, 193, 147,
+	0, 248, 170, 183, 167, 226, 696, 711, 716, 166,
+	709, 289, 150, 151, 288, 225, 276, 280, 211, 205,
+	149, 278, 209, 204, 196, 174, 187, 238, 203, 239,
+	188, 215, 214, 216, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 753, 0, 0, 0, 265, 0,
+	0, 197, 0, 0, 0, 710, 0, 251, 231, 766,
+	0, 236, 249, 201, 277, 240, 282, 267, 290, 0,
+	243, 142, 268, 169, 212, 153, 154, 165, 171, 173,
+	175, 176, 221, 222, 234, 256, 269, 270, 271, 272,
+	168, 161, 250, 162, 185, 163, 143, 258, 164, 144,
+	235, 275, 0, 182, 0, 141, 0, 0, 246, 208,
+	145, 207, 237, 274, 273, 298, 304, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 179, 0, 286, 751, 227, 765, 746, 748, 749,
+	752, 756, 757, 760, 762, 764, 768, 254, 0, 0,
+	0, 0, 0, 190, 233, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	284, 296, 767, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 714, 217, 218, 219, 220, 754, 0, 159,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 184, 0, 186, 158, 232, 181, 293, 194, 242,
+	241, 191, 245, 224, 189, 259, 195, 202, 247, 292,
+	230, 252, 157, 283, 260, 206, 180, 775, 750, 774,
+	776, 777, 773, 778, 779, 761, 679, 0, 771, 770,
+	772, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 140, 0, 199, 0, 244, 177, 739, 722,
+	723, 724, 678, 725, 720, 721, 740, 717, 736, 737,
+	701, 704, 726, 119, 727, 738, 741, 742, 780, 781,
+	782, 730, 743, 735, 734, 728, 718, 744, 745, 705,
+	703, 731, 732, 719, 712, 0, 299, 300, 301, 285,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	676, 0, 0, 0, 172, 860, 0, 0, 198, 0,
+	200, 0, 0, 261, 213, 0, 0, 0, 0, 755,
+	763, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 669, 0, 0, 699, 411, 733, 687, 0, 0,
+	0, 155, 0, 0, 688, 694, 693, 695, 689, 692,
+	690, 691, 0, 0, 747, 0, 0, 0, 0, 0,
+	661, 673, 0, 677, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 670, 671, 0, 0, 0, 0,
+	713, 0, 672, 0, 0, 0, 715, 0, 0, 769,
+	758, 759, 697, 0, 146, 266, 281, 156, 257, 294,
+	160, 264, 152, 228, 253, 148, 279, 263, 210, 192,
+	193, 147, 0, 248, 170, 183, 167, 226, 696, 711,
+	716, 166, 709, 289, 150, 151, 288, 225, 276, 280,
+	211, 205, 149, 278, 209, 204, 196, 174, 187, 238,
+	203, 239, 188, 215, 214, 216, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 753, 0, 0, 0,
+	265, 0, 0, 197, 0, 0, 0, 710, 0, 251,
+	231, 766, 0, 236, 249, 201, 277, 240, 282, 267,
+	290, 0, 243, 142, 268, 169, 212, 153, 154, 165,
+	171, 173, 175, 176, 221, 222, 234, 256, 269, 270,
+	271, 272, 168, 161, 250, 162, 185, 163, 143, 258,
+	164, 144, 235, 275, 0, 182, 0, 141, 0, 0,
+	246, 208, 145, 207, 237, 274, 273, 298, 304, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 179, 0, 286, 751, 227, 765, 746,
+	748, 749, 752, 756, 757, 760, 762, 764, 768, 254,
+	0, 0, 0, 0, 0, 190, 233, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 284, 296, 767, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 714, 217, 218, 219, 220, 754,
+	0, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 184, 0, 186, 158, 232, 181, 293,
+	194, 242, 241, 191, 245, 224, 189, 259, 195, 202,
+	247, 292, 230, 252, 157, 283, 260, 206, 180, 775,
+	750, 774, 776, 777, 773, 778, 779, 761, 679, 0,
+	771, 770, 772, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 199, 0, 244, 177,
+	739, 722, 723, 724, 678, 725, 720, 721, 740, 717,
+	736, 737, 701, 704, 726, 119, 727, 738, 741, 742,
+	780, 781, 782, 730, 743, 735, 734, 728, 718, 744,
+	745, 705, 703, 731, 732, 719, 0, 0, 299, 300,
+	301, 285, 712, 0, 0, 1511, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 676, 0,
+	0, 0, 172, 0, 0, 0, 198, 0, 200, 0,
+	0, 261, 213, 0, 0, 0, 0, 755, 763, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 669,
+	0, 0, 699, 411, 733, 687, 0, 0, 0, 155,
+	0, 0, 688, 694, 693, 695, 689, 692, 690, 691,
+	0, 0, 747, 0, 0, 0, 0, 0, 661, 673,
+	0, 677, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 670, 671, 0, 0, 0, 0, 713, 0,
+	672, 0, 0, 0, 715, 0, 0, 769, 758, 759,
+	697, 0, 146, 266, 281, 156, 257, 294, 160, 264,
+	152, 228, 253, 148, 279, 263, 210, 192, 193, 147,
+	0, 248, 170, 183, 167, 226, 696, 711, 716, 166,
+	709, 289, 150, 151, 288, 225, 276, 280, 211, 205,
+	149, 278, 209, 204, 196, 174, 187, 238, 203, 239,
+	188, 215, 214, 216, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 753, 0, 0, 0, 265, 0,
+	0, 197, 0, 0, 0, 710, 0, 251, 231, 766,
+	0, 236, 249, 201, 277, 240, 282, 267, 290, 0,
+	243, 142, 268, 169, 212, 153, 154, 165, 171, 173,
+	175, 176, 221, 222, 234, 256, 269, 270, 271, 272,
+	168, 161, 250, 162, 185, 163, 143, 258, 164, 144,
+	235, 275, 0, 182, 0, 141, 0, 0, 246, 208,
+	145, 207, 237, 274, 273, 298, 304, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 179, 0, 286, 751, 227, 765, 746, 748, 749,
+	752, 756, 757, 760, 762, 764, 768, 254, 0, 0,
+	0, 0, 0, 190, 233, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	284, 296, 767, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 714, 217, 218, 219, 220, 754, 0, 159,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 184, 0, 186, 158, 232, 181, 293, 194, 242,
+	241, 191, 245, 224, 189, 259, 195, 202, 247, 292,
+	230, 252, 157, 283, 260, 206, 180, 775, 750, 774,
+	776, 777, 773, 778, 779, 761, 679, 0, 771, 770,
+	772, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 140, 0, 199, 0, 244, 177, 739, 722,
+	723, 724, 678, 725, 720, 721, 740, 717, 736, 737,
+	701, 704, 726, 119, 727, 738, 741, 742, 780, 781,
+	782, 730, 743, 735, 734, 728, 718, 744, 745, 705,
+	703, 731, 732, 719, 712, 0, 299, 300, 301, 285,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	676, 0, 0, 0, 172, 0, 0, 0, 198, 0,
+	200, 0, 0, 261, 213, 0, 0, 0, 0, 755,
+	763, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 669, 0, 0, 699, 411, 733, 687, 0, 0,
+	0, 155, 0, 0, 688, 694, 693, 695, 689, 692,
+	690, 691, 0, 0, 747, 0, 0, 0, 0, 0,
+	661, 673, 0, 677, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 670, 671, 886, 0, 0, 0,
+	713, 0, 672, 0, 0, 0, 715, 0, 0, 769,
+	758, 759, 697, 0, 146, 266, 281, 156, 257, 294,
+	160, 264, 152, 228, 253, 148, 279, 263, 210, 192,
+	193, 147, 0, 248, 170, 183, 167, 226, 696, 711,
+	716, 166, 709, 289, 150, 151, 288, 225, 276, 280,
+	211, 205, 149, 278, 209, 204, 196, 174, 187, 238,
+	203, 239, 188, 215, 214, 216, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 753, 0, 0, 0,
+	265, 0, 0, 197, 0, 0, 0, 710, 0, 251,
+	231, 766, 0, 236, 249, 201, 277, 240, 282, 267,
+	290, 0, 243, 142, 268, 169, 212, 153, 154, 165,
+	171, 173, 175, 176, 221, 222, 234, 256, 269, 270,
+	271, 272, 168, 161, 250, 162, 185, 163, 143, 258,
+	164, 144, 235, 275, 0, 182, 0, 141, 0, 0,
+	246, 208, 145, 207, 237, 274, 273, 298, 304, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 179, 0, 286, 751, 227, 765, 746,
+	748, 749, 752, 756, 757, 760, 762, 764, 768, 254,
+	0, 0, 0, 0, 0, 190, 233, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 284, 296, 767, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 714, 217, 218, 219, 220, 754,
+	0, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 184, 0, 186, 158, 232, 181, 293,
+	194, 242, 241, 191, 245, 224, 189, 259, 195, 202,
+	247, 292, 230, 252, 157, 283, 260, 206, 180, 775,
+	750, 774, 776, 777, 773, 778, 779, 761, 679, 0,
+	771, 770, 772, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 199, 0, 244, 177,
+	739, 722, 723, 724, 678, 725, 720, 721, 740, 717,
+	736, 737, 701, 704, 726, 119, 727, 738, 741, 742,
+	780, 781, 782, 730, 743, 735, 734, 728, 718, 744,
+	745, 705, 703, 731, 732, 719, 712, 0, 299, 300,
+	301, 285, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 676, 0, 0, 0, 172, 0, 0, 0,
+	198, 0, 200, 0, 0, 261, 213, 0, 0, 0,
+	0, 755, 763, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 669, 0, 0, 699, 411, 733, 687,
+	0, 0, 0, 155, 0, 0, 688, 694, 693, 695,
+	689, 692, 690, 691, 0, 0, 747, 0, 0, 0,
+	0, 0, 661, 673, 0, 677, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 670, 671, 0, 0,
+	0, 0, 713, 0, 672, 0, 0, 0, 715, 0,
+	0, 769, 758, 759, 697, 0, 146, 266, 281, 156,
+	257, 294, 160, 264, 152, 228, 253, 148, 279, 263,
+	210, 192, 193, 147, 0, 248, 170, 183, 167, 226,
+	696, 711, 716, 166, 709, 289, 150, 151, 288, 225,
+	276, 280, 211, 205, 149, 278, 209, 204, 196, 174,
+	187, 238, 203, 239, 188, 215, 214, 216, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 753, 0,
+	0, 0, 265, 0, 0, 197, 0, 0, 0, 710,
+	0, 251, 231, 766, 0, 236, 249, 201, 277, 240,
+	282, 267, 290, 0, 243, 142, 268, 169, 212, 153,
+	154, 165, 171, 173, 175, 176, 221, 222, 234, 256,
+	269, 270, 271, 272, 168, 161, 250, 162, 185, 163,
+	143, 258, 164, 144, 235, 275, 0, 182, 0, 141,
+	0, 0, 246, 208, 145, 207, 237, 274, 273, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 303, 179, 0, 286, 751, 227,
+	765, 746, 748, 749, 752, 756, 757, 760, 762, 764,
+	768, 254, 0, 0, 0, 0, 0, 190, 233, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 284, 296, 767, 0, 0, 0,
+	295, 0, 0, 0, 0, 0, 714, 217, 218, 219,
+	220, 754, 0, 159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 184, 0, 186, 158, 232,
+	181, 293, 194, 242, 241, 191, 245, 224, 189, 259,
+	195, 202, 247, 292, 230, 252, 157, 283, 260, 206,
+	180, 775, 750, 774, 776, 777, 773, 778, 779, 761,
+	679, 0, 771, 770, 772, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 140, 0, 199, 0,
+	244, 177, 739, 722, 723, 724, 678, 725, 720, 721,
+	740, 717, 736, 737, 701, 704, 726, 119, 727, 738,
+	741, 742, 780, 781, 782, 730, 743, 735, 734, 728,
+	718, 744, 745, 705, 703, 731, 732, 719, 712, 0,
+	299, 300, 301, 285, 0, 0, 0, 0, 229, 0,
+	1283, 0, 0, 0, 676, 0, 0, 0, 172, 0,
+	0, 0, 198, 0, 200, 0, 0, 261, 213, 0,
+	0, 0, 0, 755, 763, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 669, 0, 0, 699, 411,
+	733, 687, 0, 0, 0, 155, 0, 0, 688, 694,
+	693, 695, 689, 692, 690, 691, 0, 0, 747, 0,
+	0, 0, 0, 0, 0, 673, 0, 677, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 670, 671,
+	0, 0, 0, 0, 713, 0, 672, 0, 0, 0,
+	715, 0, 0, 769, 758, 759, 697, 0, 146, 266,
+	281, 156, 257, 294, 160, 264, 152, 228, 253, 148,
+	279, 263, 210, 192, 193, 147, 0, 248, 170, 183,
+	167, 226, 696, 711, 716, 166, 709, 289, 150, 151,
+	288, 225, 276, 280, 211, 205, 149, 278, 209, 204,
+	196, 174, 187, 238, 203, 239, 188, 215, 214, 216,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	753, 0, 0, 0, 265, 0, 0, 197, 0, 0,
+	0, 710, 0, 251, 231, 766, 0, 236, 249, 201,
+	277, 240, 282, 267, 290, 0, 243, 142, 268, 169,
+	212, 153, 154, 165, 171, 173, 175, 176, 221, 222,
+	234, 256, 269, 270, 271, 272, 168, 161, 250, 162,
+	185, 163, 143, 258, 164, 144, 235, 275, 0, 182,
+	0, 141, 0, 0, 246, 208, 145, 207, 237, 274,
+	273, 298, 1284, 1285, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 303, 179, 0, 286,
+	751, 227, 765, 746, 748, 749, 752, 756, 757, 760,
+	762, 764, 768, 254, 0, 0, 0, 0, 0, 190,
+	233, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 284, 296, 767, 0,
+	0, 0, 295, 0, 0, 0, 0, 0, 714, 217,
+	218, 219, 220, 754, 0, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 184, 0, 186,
+	158, 232, 181, 293, 194, 242, 241, 191, 245, 224,
+	189, 259, 195, 202, 247, 292, 230, 252, 157, 283,
+	260, 206, 180, 775, 750, 774, 776, 777, 773, 778,
+	779, 761, 679, 0, 771, 770, 772, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 140, 0,
+	199, 0, 244, 177, 739, 722, 723, 724, 678, 725,
+	720, 721, 740, 717, 736, 737, 701, 704, 726, 119,
+	727, 738, 741, 742, 780, 781, 782, 730, 743, 735,
+	734, 728, 718, 744, 745, 705, 703, 731, 732, 719,
+	712, 0, 299, 300, 301, 285, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 676, 0, 0, 0,
+	172, 0, 0, 0, 198, 0, 200, 0, 0, 261,
+	213, 0, 0, 0, 0, 755, 763, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
+	699, 411, 733, 687, 0, 0, 0, 155, 0, 0,
+	688, 694, 693, 695, 689, 692, 690, 691, 0, 0,
+	747, 0, 0, 0, 0, 0, 0, 673, 0, 677,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	670, 671, 0, 0, 0, 0, 713, 0, 672, 0,
+	0, 0, 715, 0, 0, 769, 758, 759, 697, 0,
+	146, 266, 281, 156, 257, 294, 160, 264, 152, 228,
+	253, 148, 279, 263, 210, 192, 193, 147, 0, 248,
+	170, 183, 167, 226, 696, 711, 716, 166, 709, 289,
+	150, 151, 288, 225, 276, 280, 211, 205, 149, 278,
+	209, 204, 196, 174, 187, 238, 203, 239, 188, 215,
+	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 753, 0, 0, 0, 265, 0, 0, 197,
+	0, 0, 0, 710, 0, 251, 231, 766, 0, 236,
+	249, 201, 277, 240, 282, 267, 290, 0, 243, 142,
+	268, 169, 212, 153, 154, 165, 171, 173, 175, 176,
+	221, 222, 234, 256, 269, 270, 271, 272, 168, 161,
+	250, 162, 185, 163, 143, 258, 164, 144, 235, 275,
+	0, 182, 0, 141, 0, 0, 246, 208, 145, 207,
+	237, 274, 273, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 179,
+	0, 286, 751, 227, 765, 746, 748, 749, 752, 756,
+	757, 760, 762, 764, 768, 254, 0, 0, 0, 0,
+	0, 190, 233, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 284, 296,
+	767, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	714, 217, 218, 219, 220, 754, 0, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 184,
+	0, 186, 158, 232, 181, 293, 194, 242, 241, 191,
+	245, 224, 189, 259, 195, 202, 247, 292, 230, 252,
+	157, 283, 260, 206, 180, 775, 750, 774, 776, 777,
+	773, 778, 779, 761, 679, 0, 771, 770, 772, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 199, 0, 244, 177, 739, 722, 723, 724,
+	678, 725, 720, 721, 740, 717, 736, 737, 701, 704,
+	726, 119, 727, 738, 741, 742, 780, 781, 782, 730,
+	743, 735, 734, 728, 718, 744, 745, 705, 703, 731,
+	732, 719, 0, 0, 299, 300, 301, 285, 334, 0,
+	333, 337, 329, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 344, 198, 0, 200, 0, 0, 261,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 0, 0, 348, 0, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 223, 302, 0, 0,
+	146, 266, 281, 156, 257, 294, 160, 264, 152, 228,
+	253, 148, 279, 263, 210, 192, 193, 147, 0, 248,
+	170, 183, 167, 226, 0, 0, 0, 166, 0, 289,
+	150, 151, 288, 225, 276, 280, 211, 205, 149, 278,
+	209, 204, 196, 174, 187, 238, 203, 239, 188, 215,
+	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	327, 326, 330, 0, 0, 0, 0, 0, 332, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 197,
+	336, 0, 0, 0, 0, 251, 231, 0, 0, 236,
+	249, 201, 277, 240, 328, 267, 290, 0, 352, 142,
+	268, 169, 212, 153, 154, 165, 171, 173, 175, 176,
+	221, 222, 234, 256, 269, 270, 271, 272, 168, 161,
+	250, 162, 185, 163, 143, 258, 164, 144, 235, 275,
+	0, 182, 0, 141, 0, 0, 246, 208, 145, 207,
+	237, 274, 273, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 179,
+	0, 286, 0, 227, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 254, 0, 0, 0, 331,
+	335, 338, 233, 339, 340, 0, 0, 341, 342, 343,
+	0, 0, 345, 346, 0, 0, 0, 262, 284, 296,
+	287, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	0, 217, 218, 219, 220, 0, 0, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 184,
+	0, 186, 158, 232, 181, 293, 194, 242, 241, 191,
+	245, 224, 189, 259, 195, 202, 247, 292, 230, 252,
+	157, 283, 260, 206, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 199, 0, 244, 177, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
+	138, 139, 0, 0, 299, 300, 301, 285, 334, 0,
+	333, 337, 329, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 325, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 344, 198, 0, 200, 0, 0, 261,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 0, 0, 348, 0, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 223, 302, 0, 0,
+	146, 266, 281, 156, 257, 294, 160, 264, 152, 228,
+	253, 148, 279, 263, 210, 192, 193, 147, 0, 248,
+	170, 183, 167, 226, 0, 0, 0, 166, 0, 289,
+	150, 151, 288, 225, 276, 280, 211, 205, 149, 278,
+	209, 204, 196, 174, 187, 238, 203, 239, 188, 215,
+	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	327, 326, 330, 0, 0, 0, 0, 0, 332, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 197,
+	336, 0, 0, 0, 0, 251, 231, 0, 0, 236,
+	249, 201, 277, 240, 328, 267, 290, 0, 243, 142,
+	268, 169, 212, 153, 154, 165, 171, 173, 175, 176,
+	221, 222, 234, 256, 269, 270, 271, 272, 168, 161,
+	250, 162, 185, 163, 143, 258, 164, 144, 235, 275,
+	0, 182, 0, 141, 0, 0, 246, 208, 145, 207,
+	237, 274, 273, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 179,
+	0, 286, 0, 227, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 254, 0, 0, 0, 331,
+	335, 338, 233, 339, 340, 0, 0, 341, 342, 343,
+	0, 0, 345, 346, 0, 0, 0, 262, 284, 296,
+	287, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	0, 217, 218, 219, 220, 0, 0, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 184,
+	0, 186, 158, 232, 181, 293, 194, 242, 241, 191,
+	245, 224, 189, 259, 195, 202, 247, 292, 230, 252,
+	157, 283, 260, 206, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 199, 0, 244, 177, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
+	138, 139, 0, 0, 299, 300, 301, 285, 84, 0,
+	24, 44, 25, 0, 0, 0, 0, 0, 0, 0,
+	229, 90, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 0, 198, 0, 200, 0, 0, 261,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 95, 0, 0,
+	101, 0, 0, 0, 0, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 223, 302, 0, 0,
+	146, 266, 281, 156, 257, 294, 160, 264, 152, 228,
+	253, 148, 279, 263, 210, 192, 193, 147, 0, 248,
+	170, 183, 167, 226, 0, 0, 0, 166, 0, 289,
+	150, 151, 288, 225, 276, 280, 211, 205, 149, 278,
+	209, 204, 196, 174, 187, 238, 203, 239, 188, 215,
+	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 94, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 197,
+	0, 0, 0, 0, 0, 251, 231, 0, 0, 236,
+	249, 201, 277, 240, 282, 267, 290, 0, 243, 142,
+	268, 169, 212, 153, 154, 165, 171, 173, 175, 176,
+	221, 222, 234, 256, 269, 270, 271, 272, 168, 161,
+	250, 162, 185, 163, 143, 258, 164, 144, 235, 275,
+	0, 182, 0, 141, 0, 0, 246, 208, 145, 207,
+	237, 274, 273, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 179,
+	0, 286, 0, 227, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
+	0, 190, 233, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 284, 296,
+	287, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	0, 217, 218, 219, 220, 91, 93, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 184,
+	0, 186, 158, 232, 181, 293, 194, 242, 241, 191,
+	245, 224, 189, 259, 195, 202, 247, 292, 230, 252,
+	157, 283, 260, 206, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 199, 83, 244, 177, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
+	138, 139, 229, 0, 299, 300, 301, 285, 0, 0,
+	0, 0, 172, 0, 0, 0, 198, 0, 200, 0,
+	0, 261, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 0, 0, 155,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1564, 1567, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 297, 223, 302,
+	0, 0, 146, 266, 281, 156, 257, 294, 160, 264,
+	152, 228, 253, 148, 279, 263, 210, 192, 193, 147,
+	0, 248, 170, 183, 167, 226, 0, 0, 0, 166,
+	0, 289, 150, 151, 288, 225, 276, 280, 211, 205,
+	149, 278, 209, 204, 196, 174, 187, 238, 203, 239,
+	188, 215, 214, 216, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1568, 291, 0, 0, 0, 1561, 0, 1560, 265, 1562,
+	1565, 197, 0, 0, 0, 0, 0, 251, 231, 0,
+	0, 236, 249, 201, 277, 240, 282, 267, 290, 0,
+	243, 142, 268, 169, 212, 153, 154, 165, 171, 173,
+	175, 176, 221, 222, 234, 256, 269, 270, 271, 272,
+	168, 161, 250, 162, 185, 163, 143, 258, 164, 144,
+	235, 275, 1566, 182, 0, 141, 0, 0, 246, 208,
+	145, 207, 237, 274, 273, 298, 304, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 179, 0, 286, 0, 227, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	0, 0, 0, 190, 233, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	284, 296, 287, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 0, 217, 218, 219, 220, 0, 0, 159,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 184, 0, 186, 158, 232, 181, 293, 194, 242,
+	241, 191, 245, 224, 189, 259, 195, 202, 247, 292,
+	230, 252, 157, 283, 260, 206, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 140, 0, 199, 0, 244, 177, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 0, 0,
-	277, 278, 279, 263, 322, 0, 321, 325, 317, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 313, 0,
-	0, 0, 0, 0, 0, 0, 155, 0, 0, 332,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 335, 0, 0, 336, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 0, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	314, 318, 0, 0, 0, 0, 0, 320, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 324,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 316, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 282, 283,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 281, 162, 0, 264, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
-	0, 233, 0, 0, 0, 319, 323, 326, 215, 327,
-	328, 0, 0, 329, 330, 331, 0, 0, 333, 334,
-	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
-	202, 0, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 0, 0, 277, 278, 279, 263,
-	79, 0, 23, 39, 24, 0, 0, 0, 0, 0,
-	0, 0, 211, 286, 0, 0, 0, 0, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
-	0, 85, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 290,
-	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
-	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 281, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 287, 289, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 78, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 137, 138, 139, 229, 0, 299, 300, 301, 285,
+	0, 0, 0, 0, 172, 397, 0, 0, 198, 0,
+	200, 0, 0, 261, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 411, 407, 0, 0, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 412, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 297,
+	223, 302, 0, 0, 146, 266, 281, 156, 257, 294,
+	160, 264, 152, 228, 253, 148, 279, 263, 210, 192,
+	193, 147, 0, 248, 170, 183, 167, 226, 0, 0,
+	402, 166, 414, 289, 150, 413, 288, 225, 276, 280,
+	211, 205, 149, 278, 209, 204, 196, 174, 187, 238,
+	203, 239, 188, 215, 214, 216, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 197, 0, 0, 0, 0, 0, 251,
+	231, 0, 0, 236, 249, 201, 277, 240, 282, 267,
+	290, 396, 243, 142, 268, 169, 212, 153, 154, 165,
+	171, 173, 175, 176, 221, 222, 234, 256, 269, 270,
+	271, 272, 168, 161, 250, 162, 185, 163, 143, 258,
+	164, 144, 235, 275, 0, 182, 0, 141, 0, 0,
+	246, 208, 145, 207, 237, 274, 273, 298, 304, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 179, 0, 286, 0, 227, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
+	0, 0, 0, 0, 0, 190, 233, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 284, 296, 287, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 399, 217, 218, 219, 220, 0,
+	0, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 184, 0, 186, 158, 232, 181, 293,
+	194, 242, 241, 191, 245, 408, 403, 404, 195, 202,
+	247, 292, 230, 252, 157, 283, 260, 405, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 199, 0, 244, 177,
 	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
 	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	211, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1513, 1516,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1517, 269, 0, 0, 0, 1510, 0, 1509, 244,
-	1511, 1514, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 1515, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 211, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 155, 382,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 85, 395, 396,
-	0, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 0, 0, 387, 149, 275, 399,
-	267, 133, 398, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 381, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 384, 199,
-	200, 201, 202, 0, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 392, 388, 389, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 390, 163, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 0, 211, 277, 278,
-	279, 263, 858, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 859,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 855, 856, 854,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 137, 138, 139, 0, 229, 299, 300,
+	301, 285, 1242, 0, 0, 0, 0, 172, 0, 0,
+	0, 198, 0, 200, 0, 0, 261, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	1243, 0, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1081, 1082, 1080, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 223, 302, 0, 0, 146, 266, 281,
+	156, 257, 294, 160, 264, 152, 228, 253, 148, 279,
+	263, 210, 192, 193, 147, 0, 248, 170, 183, 167,
+	226, 0, 0, 0, 166, 0, 289, 150, 151, 288,
+	225, 276, 280, 211, 205, 149, 278, 209, 204, 196,
+	174, 187, 238, 203, 239, 188, 215, 214, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 0,
+	0, 0, 0, 265, 0, 0, 197, 0, 0, 0,
+	0, 0, 251, 231, 0, 0, 236, 249, 201, 277,
+	240, 282, 267, 290, 0, 243, 142, 268, 169, 212,
+	153, 154, 165, 171, 173, 175, 176, 221, 222, 234,
+	256, 269, 270, 271, 272, 168, 161, 250, 162, 185,
+	163, 143, 258, 164, 144, 235, 275, 0, 182, 0,
+	141, 0, 0, 246, 208, 145, 207, 237, 274, 273,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 179, 0, 286, 0,
+	227, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 0, 0, 0, 190, 233,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 284, 296, 287, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 217, 218,
+	219, 220, 0, 0, 159, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 184, 0, 186, 158,
+	232, 181, 293, 194, 242, 241, 191, 245, 224, 189,
+	259, 195, 202, 247, 292, 230, 252, 157, 283, 260,
+	206, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 199,
+	0, 244, 177, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 229,
+	0, 299, 300, 301, 285, 0, 0, 0, 0, 172,
+	0, 0, 0, 198, 0, 200, 0, 0, 261, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	411, 407, 0, 0, 0, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 223, 302, 0, 0, 146,
+	266, 281, 156, 257, 294, 160, 264, 152, 228, 253,
+	148, 279, 263, 210, 192, 193, 147, 0, 248, 170,
+	183, 167, 226, 0, 0, 402, 166, 414, 289, 150,
+	413, 288, 225, 276, 280, 211, 205, 149, 278, 209,
+	204, 196, 174, 187, 238, 203, 239, 188, 215, 214,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 0, 0, 0, 0, 265, 0, 0, 197, 0,
+	0, 0, 0, 0, 251, 231, 0, 0, 236, 249,
+	201, 277, 240, 282, 267, 290, 0, 243, 142, 268,
+	169, 212, 153, 154, 165, 171, 173, 175, 176, 221,
+	222, 234, 256, 269, 270, 271, 272, 168, 161, 250,
+	162, 185, 163, 143, 258, 164, 144, 235, 275, 0,
+	182, 0, 141, 0, 0, 246, 208, 145, 207, 237,
+	274, 273, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 179, 0,
+	286, 0, 227, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 254, 0, 0, 0, 0, 0,
+	190, 233, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 284, 296, 287,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	217, 218, 219, 220, 0, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 184, 0,
+	186, 158, 232, 181, 293, 194, 242, 241, 191, 245,
+	408, 403, 404, 195, 202, 247, 292, 230, 252, 157,
+	283, 260, 405, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 199, 0, 244, 177, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 84, 0, 299, 300, 301, 285, 0, 0, 0,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 198, 0, 200,
+	0, 0, 261, 213, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 395, 396, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 0,
+	80, 0, 1248, 101, 0, 0, 0, 0, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 387, 149, 275, 399, 267, 133, 398,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 223,
+	302, 0, 0, 146, 266, 281, 156, 257, 294, 160,
+	264, 152, 228, 253, 148, 279, 263, 210, 192, 193,
+	147, 0, 248, 170, 183, 167, 226, 0, 0, 0,
+	166, 0, 289, 150, 151, 288, 225, 276, 280, 211,
+	205, 149, 278, 209, 204, 196, 174, 187, 238, 203,
+	239, 188, 215, 214, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 197, 0, 0, 0, 0, 0, 251, 231,
+	0, 0, 236, 249, 201, 277, 240, 282, 267, 290,
+	0, 243, 142, 268, 169, 212, 153, 154, 165, 171,
+	173, 175, 176, 221, 222, 234, 256, 269, 270, 271,
+	272, 168, 161, 250, 162, 185, 163, 143, 258, 164,
+	144, 235, 275, 0, 182, 0, 141, 0, 0, 246,
+	208, 145, 207, 237, 274, 273, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 392, 388, 389, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 390, 163, 0, 0, 0, 0,
+	0, 303, 179, 0, 286, 0, 227, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 0, 0, 0, 190, 233, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 284, 296, 287, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 217, 218, 219, 220, 0, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 184, 0, 186, 158, 232, 181, 293, 194,
+	242, 241, 191, 245, 224, 189, 259, 195, 202, 247,
+	292, 230, 252, 157, 283, 260, 206, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 79, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 0, 0, 211, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 75, 0, 947, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 199, 83, 244, 177, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 299, 300, 301,
+	285, 229, 0, 558, 0, 0, 0, 0, 0, 0,
+	0, 172, 559, 0, 0, 198, 0, 200, 0, 0,
+	261, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 0, 0, 348, 0, 0, 0, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 223, 302, 0,
+	0, 146, 266, 281, 156, 257, 294, 160, 264, 152,
+	228, 253, 148, 279, 263, 210, 192, 193, 147, 0,
+	248, 170, 183, 167, 226, 0, 0, 0, 166, 0,
+	289, 150, 151, 288, 225, 276, 280, 211, 205, 149,
+	278, 209, 204, 196, 174, 187, 238, 203, 239, 188,
+	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 0, 0, 0, 0, 0, 265, 0, 0,
+	197, 0, 0, 0, 0, 0, 251, 231, 0, 0,
+	236, 249, 201, 277, 240, 282, 267, 290, 0, 243,
+	142, 268, 169, 212, 153, 154, 165, 171, 173, 175,
+	176, 221, 222, 234, 256, 269, 270, 271, 272, 168,
+	161, 250, 162, 185, 163, 143, 258, 164, 144, 235,
+	275, 0, 182, 0, 141, 0, 0, 246, 208, 145,
+	207, 237, 274, 273, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	179, 0, 286, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
+	0, 0, 190, 233, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 284,
+	296, 287, 0, 0, 0, 295, 0, 0, 0, 0,
+	560, 0, 217, 218, 219, 220, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	184, 0, 186, 158, 232, 181, 293, 194, 242, 241,
+	191, 245, 224, 189, 259, 195, 202, 247, 292, 230,
+	252, 157, 283, 260, 206, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 199, 0, 244, 177, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 229, 0, 299, 300, 301, 285, 0,
+	0, 0, 0, 172, 0, 0, 0, 198, 0, 200,
+	0, 0, 261, 213, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 1124, 0, 0, 0,
+	155, 0, 0, 1125, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
+	0, 0, 0, 0, 1127, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 223,
+	302, 0, 0, 146, 266, 281, 156, 257, 294, 160,
+	264, 152, 228, 253, 148, 279, 263, 210, 192, 193,
+	147, 0, 248, 170, 183, 167, 226, 0, 0, 0,
+	166, 0, 289, 150, 151, 288, 225, 276, 280, 211,
+	205, 149, 278, 209, 204, 196, 174, 187, 238, 203,
+	239, 188, 215, 214, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 197, 0, 0, 0, 0, 0, 251, 231,
+	0, 0, 236, 249, 201, 277, 240, 282, 267, 290,
+	0, 243, 142, 268, 169, 212, 153, 154, 165, 171,
+	173, 175, 176, 221, 222, 234, 256, 269, 270, 271,
+	272, 168, 161, 250, 162, 185, 163, 143, 258, 164,
+	144, 235, 275, 0, 182, 0, 141, 0, 0, 246,
+	208, 145, 207, 237, 274, 273, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 303, 179, 0, 286, 0, 227, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 0, 0, 0, 190, 233, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	262, 284, 296, 287, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 217, 218, 219, 220, 0, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 184, 0, 186, 158, 232, 181, 293, 194,
+	242, 241, 191, 245, 224, 189, 259, 195, 202, 247,
+	292, 230, 252, 157, 283, 260, 206, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 78, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 0, 0, 277, 278, 279, 263, 211,
-	0, 541, 0, 0, 0, 0, 0, 0, 0, 155,
-	542, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	0, 0, 0, 140, 0, 199, 0, 244, 177, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 299, 300, 301,
+	285, 229, 0, 848, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 0, 0, 198, 0, 200, 0, 0,
+	261, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 0, 0, 348, 0, 0, 0, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 223, 302, 0,
+	0, 146, 266, 281, 156, 257, 294, 160, 264, 152,
+	228, 253, 148, 279, 263, 210, 192, 193, 147, 0,
+	248, 170, 183, 167, 226, 0, 0, 0, 166, 0,
+	289, 150, 151, 288, 225, 276, 280, 211, 205, 149,
+	278, 209, 204, 196, 174, 187, 238, 203, 239, 188,
+	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 0, 0, 0, 0, 0, 265, 0, 0,
+	197, 0, 0, 0, 0, 0, 251, 231, 0, 0,
+	236, 249, 201, 277, 240, 282, 267, 290, 0, 243,
+	142, 268, 169, 212, 153, 154, 165, 171, 173, 175,
+	176, 221, 222, 234, 256, 269, 270, 271, 272, 168,
+	161, 250, 162, 185, 163, 143, 258, 164, 144, 235,
+	275, 0, 182, 0, 141, 0, 0, 246, 208, 145,
+	207, 237, 274, 273, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	179, 0, 286, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
+	0, 0, 190, 233, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 284,
+	296, 287, 0, 0, 0, 295, 0, 0, 0, 0,
+	847, 0, 217, 218, 219, 220, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	184, 0, 186, 158, 232, 181, 293, 194, 242, 241,
+	191, 245, 224, 189, 259, 195, 202, 247, 292, 230,
+	252, 157, 283, 260, 206, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 199, 0, 244, 177, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 229, 0, 299, 300, 301, 285, 0,
+	0, 0, 0, 172, 0, 0, 0, 198, 0, 200,
+	0, 0, 261, 213, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 335, 0,
-	0, 336, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 2171, 101, 411, 0, 0, 0, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 223,
+	302, 0, 0, 146, 266, 281, 156, 257, 294, 160,
+	264, 152, 228, 253, 148, 279, 263, 210, 192, 193,
+	147, 0, 248, 170, 183, 167, 226, 0, 0, 0,
+	166, 0, 289, 150, 151, 288, 225, 276, 280, 211,
+	205, 149, 278, 209, 204, 196, 174, 187, 238, 203,
+	239, 188, 215, 214, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 197, 0, 0, 0, 0, 0, 251, 231,
+	0, 0, 236, 249, 201, 277, 240, 282, 267, 290,
+	0, 243, 142, 268, 169, 212, 153, 154, 165, 171,
+	173, 175, 176, 221, 222, 234, 256, 269, 270, 271,
+	272, 168, 161, 250, 162, 185, 163, 143, 258, 164,
+	144, 235, 275, 0, 182, 0, 141, 0, 0, 246,
+	208, 145, 207, 237, 274, 273, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 543, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 303, 179, 0, 286, 0, 227, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 0, 0, 0, 190, 233, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 284, 296, 287, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 217, 218, 219, 220, 0, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 184, 0, 186, 158, 232, 181, 293, 194,
+	242, 241, 191, 245, 224, 189, 259, 195, 202, 247,
+	292, 230, 252, 157, 283, 260, 206, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 921,
-	0, 0, 0, 138, 922, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 924, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 0, 0, 277, 278, 279,
-	263, 211, 0, 813, 0, 0, 0, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	335, 0, 0, 336, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	812, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 199, 0, 244, 177, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 229, 0, 299, 300, 301,
+	285, 0, 0, 0, 0, 172, 0, 0, 0, 198,
+	0, 200, 0, 0, 261, 213, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2082, 85, 679,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 796, 0,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	297, 223, 302, 0, 0, 146, 266, 281, 156, 257,
+	294, 160, 264, 152, 228, 253, 148, 279, 263, 210,
+	192, 193, 147, 0, 248, 170, 183, 167, 226, 0,
+	0, 0, 166, 0, 289, 150, 151, 288, 225, 276,
+	280, 211, 205, 149, 278, 209, 204, 196, 174, 187,
+	238, 203, 239, 188, 215, 214, 216, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 0, 0, 0, 291, 0, 0, 0, 0, 0,
+	0, 265, 0, 0, 197, 0, 0, 0, 0, 0,
+	251, 231, 0, 0, 236, 249, 201, 277, 240, 282,
+	267, 290, 0, 243, 142, 268, 169, 212, 153, 154,
+	165, 171, 173, 175, 176, 221, 222, 234, 256, 269,
+	270, 271, 272, 168, 161, 250, 162, 185, 163, 143,
+	258, 164, 144, 235, 275, 0, 182, 0, 141, 0,
+	0, 246, 208, 145, 207, 237, 274, 273, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 179, 0, 286, 0, 227, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 0, 0, 0, 190, 233, 0, 255,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 284, 296, 287, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 1540, 217, 218, 219, 220,
+	0, 0, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 184, 0, 186, 158, 232, 181,
+	293, 194, 242, 241, 191, 245, 224, 189, 259, 195,
+	202, 247, 292, 230, 252, 157, 283, 260, 206, 180,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 764,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 1488, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 199, 0, 244,
+	177, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 229, 0, 299,
+	300, 301, 285, 0, 0, 0, 0, 172, 1227, 0,
+	0, 198, 0, 200, 0, 0, 261, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	796, 0, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 223, 302, 0, 0, 146, 266, 281,
+	156, 257, 294, 160, 264, 152, 228, 253, 148, 279,
+	263, 210, 192, 193, 147, 0, 248, 170, 183, 167,
+	226, 0, 0, 0, 166, 0, 289, 150, 151, 288,
+	225, 276, 280, 211, 205, 149, 278, 209, 204, 196,
+	174, 187, 238, 203, 239, 188, 215, 214, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 0,
+	0, 0, 0, 265, 0, 0, 197, 0, 0, 0,
+	0, 0, 251, 231, 0, 0, 236, 249, 201, 277,
+	240, 282, 267, 290, 0, 243, 142, 268, 169, 212,
+	153, 154, 165, 171, 173, 175, 176, 221, 222, 234,
+	256, 269, 270, 271, 272, 168, 161, 250, 162, 185,
+	163, 143, 258, 164, 144, 235, 275, 0, 182, 0,
+	141, 0, 0, 246, 208, 145, 207, 237, 274, 273,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 179, 0, 286, 0,
+	227, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 0, 0, 0, 190, 233,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 284, 296, 287, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 217, 218,
+	219, 220, 0, 0, 159, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 184, 0, 186, 158,
+	232, 181, 293, 194, 242, 241, 191, 245, 224, 189,
+	259, 195, 202, 247, 292, 230, 252, 157, 283, 260,
+	206, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 199,
+	0, 244, 177, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 229,
+	0, 299, 300, 301, 285, 0, 0, 0, 0, 172,
+	0, 0, 0, 198, 0, 200, 0, 0, 261, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 796, 0, 0, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 223, 302, 0, 0, 146,
+	266, 281, 156, 257, 294, 160, 264, 152, 228, 253,
+	148, 279, 263, 210, 192, 193, 147, 0, 248, 170,
+	183, 167, 226, 0, 0, 0, 166, 0, 289, 150,
+	151, 288, 225, 276, 280, 211, 205, 149, 278, 209,
+	204, 196, 174, 187, 238, 203, 239, 188, 215, 214,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 0, 0, 0, 0, 265, 0, 0, 197, 0,
+	0, 0, 0, 0, 251, 231, 0, 0, 236, 249,
+	201, 277, 240, 282, 267, 290, 0, 243, 142, 268,
+	169, 212, 153, 154, 165, 171, 173, 175, 176, 221,
+	222, 234, 256, 269, 270, 271, 272, 168, 161, 250,
+	162, 185, 163, 143, 258, 164, 144, 235, 275, 0,
+	182, 0, 141, 0, 0, 246, 208, 145, 207, 237,
+	274, 273, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 179, 0,
+	286, 0, 227, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 254, 0, 0, 0, 0, 0,
+	190, 233, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 284, 296, 287,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	217, 218, 219, 220, 0, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 184, 0,
+	186, 158, 232, 181, 293, 194, 242, 241, 191, 245,
+	224, 189, 259, 195, 202, 247, 292, 230, 252, 157,
+	283, 260, 206, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 827, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 199, 0, 244, 177, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 1190, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 229, 0, 299, 300, 301, 285, 0, 0, 0,
+	0, 172, 0, 0, 0, 198, 0, 200, 0, 0,
+	261, 213, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 764, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 411, 0, 0, 0, 0, 0, 155, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 0, 0, 0, 0, 0, 297, 223, 302, 0,
+	0, 146, 266, 281, 156, 257, 294, 160, 264, 152,
+	228, 253, 148, 279, 263, 210, 192, 193, 147, 0,
+	248, 170, 183, 167, 226, 0, 0, 0, 166, 0,
+	289, 150, 151, 288, 225, 276, 280, 211, 205, 149,
+	278, 209, 204, 196, 174, 187, 238, 203, 239, 188,
+	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	291, 0, 0, 0, 0, 0, 0, 265, 0, 0,
+	197, 0, 0, 0, 0, 0, 251, 231, 0, 0,
+	236, 249, 201, 277, 240, 282, 267, 290, 0, 243,
+	142, 268, 169, 212, 153, 154, 165, 171, 173, 175,
+	176, 221, 222, 234, 256, 269, 270, 271, 272, 168,
+	161, 250, 162, 185, 163, 143, 258, 164, 144, 235,
+	275, 0, 182, 0, 141, 0, 0, 246, 208, 145,
+	207, 237, 274, 273, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	179, 0, 286, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
+	0, 0, 190, 233, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 284,
+	296, 287, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 217, 218, 219, 220, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	184, 0, 186, 158, 232, 181, 293, 194, 242, 241,
+	191, 245, 224, 189, 259, 195, 202, 247, 292, 230,
+	252, 157, 283, 260, 206, 180, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 199, 0, 244, 177, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 229, 0, 299, 300, 301, 285, 0,
+	0, 0, 0, 172, 0, 0, 0, 198, 0, 200,
+	0, 0, 261, 213, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	1879, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 679, 0, 0, 0, 0, 0, 138,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 223,
+	302, 0, 0, 146, 266, 281, 156, 257, 294, 160,
+	264, 152, 228, 253, 148, 279, 263, 210, 192, 193,
+	147, 0, 248, 170, 183, 167, 226, 0, 0, 0,
+	166, 0, 289, 150, 151, 288, 225, 276, 280, 211,
+	205, 149, 278, 209, 204, 196, 174, 187, 238, 203,
+	239, 188, 215, 214, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 197, 0, 0, 0, 0, 0, 251, 231,
+	0, 0, 236, 249, 201, 277, 240, 282, 267, 290,
+	0, 243, 142, 268, 169, 212, 153, 154, 165, 171,
+	173, 175, 176, 221, 222, 234, 256, 269, 270, 271,
+	272, 168, 161, 250, 162, 185, 163, 143, 258, 164,
+	144, 235, 275, 0, 182, 0, 141, 0, 0, 246,
+	208, 145, 207, 237, 274, 273, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
+	0, 303, 179, 0, 286, 0, 227, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 0, 0, 0, 190, 233, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	262, 284, 296, 287, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 217, 218, 219, 220, 0, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 184, 0, 186, 158, 232, 181, 293, 194,
+	242, 241, 191, 245, 224, 189, 259, 195, 202, 247,
+	292, 230, 252, 157, 283, 260, 206, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1816, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	0, 0, 0, 140, 0, 199, 0, 244, 177, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 229, 0, 299, 300, 301,
+	285, 0, 0, 0, 0, 172, 0, 0, 0, 198,
+	0, 200, 0, 0, 261, 213, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 764, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 315, 0, 0, 101, 0, 0, 0, 0,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	297, 223, 302, 0, 0, 146, 266, 281, 156, 257,
+	294, 160, 264, 152, 228, 253, 148, 279, 263, 210,
+	192, 193, 147, 0, 248, 170, 183, 167, 226, 0,
+	0, 0, 166, 0, 289, 150, 151, 288, 225, 276,
+	280, 211, 205, 149, 278, 209, 204, 196, 174, 187,
+	238, 203, 239, 188, 215, 214, 216, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 0, 0, 0, 291, 0, 0, 0, 0, 0,
+	0, 265, 0, 0, 197, 0, 0, 0, 0, 0,
+	251, 231, 0, 0, 236, 249, 201, 277, 240, 282,
+	267, 290, 0, 243, 142, 268, 169, 212, 153, 154,
+	165, 171, 173, 175, 176, 221, 222, 234, 256, 269,
+	270, 271, 272, 168, 161, 250, 162, 185, 163, 143,
+	258, 164, 144, 235, 275, 0, 182, 0, 141, 0,
+	0, 246, 208, 145, 207, 237, 274, 273, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 179, 0, 286, 0, 227, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 0, 0, 0, 190, 233, 0, 255,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 284, 296, 287, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 217, 218, 219, 220,
+	0, 0, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 184, 0, 186, 158, 232, 181,
+	293, 194, 242, 241, 191, 245, 224, 189, 259, 195,
+	202, 247, 292, 230, 252, 157, 283, 260, 206, 180,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1552, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 199, 0, 244,
+	177, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 229, 0, 299,
+	300, 301, 285, 0, 0, 0, 0, 172, 0, 0,
+	0, 198, 0, 200, 0, 0, 261, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	796, 0, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 223, 302, 0, 0, 146, 266, 281,
+	156, 257, 294, 160, 264, 152, 228, 253, 148, 279,
+	263, 210, 192, 193, 147, 0, 248, 170, 183, 167,
+	226, 0, 0, 0, 166, 0, 289, 150, 151, 288,
+	225, 276, 280, 211, 205, 149, 278, 209, 204, 196,
+	174, 187, 238, 203, 239, 188, 215, 214, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 0,
+	0, 0, 0, 265, 0, 0, 197, 0, 0, 0,
+	0, 0, 251, 231, 0, 0, 236, 249, 201, 277,
+	240, 282, 267, 290, 0, 243, 142, 268, 169, 212,
+	153, 154, 165, 171, 173, 175, 176, 221, 222, 234,
+	256, 269, 270, 271, 272, 168, 161, 250, 162, 185,
+	163, 143, 258, 164, 144, 235, 275, 0, 182, 0,
+	141, 0, 0, 246, 208, 145, 207, 237, 274, 273,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 179, 0, 286, 0,
+	227, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 0, 0, 0, 190, 233,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 284, 296, 287, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 217, 218,
+	219, 220, 0, 0, 159, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 184, 0, 186, 158,
+	232, 181, 293, 194, 242, 241, 191, 245, 224, 189,
+	259, 195, 202, 247, 292, 230, 252, 157, 283, 260,
+	206, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 199,
+	0, 244, 177, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 229,
+	0, 299, 300, 301, 285, 0, 0, 0, 0, 172,
+	0, 0, 0, 198, 0, 200, 0, 0, 261, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 0, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1752, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 223, 302, 0, 0, 146,
+	266, 281, 156, 257, 294, 160, 264, 152, 228, 253,
+	148, 279, 263, 210, 192, 193, 147, 0, 248, 170,
+	183, 167, 226, 0, 0, 0, 166, 0, 289, 150,
+	151, 288, 225, 276, 280, 211, 205, 149, 278, 209,
+	204, 196, 174, 187, 238, 203, 239, 188, 215, 214,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 0, 0, 0, 0, 265, 0, 0, 197, 0,
+	0, 0, 0, 0, 251, 231, 0, 0, 236, 249,
+	201, 277, 240, 282, 267, 290, 0, 243, 142, 268,
+	169, 212, 153, 154, 165, 171, 173, 175, 176, 221,
+	222, 234, 256, 269, 270, 271, 272, 168, 161, 250,
+	162, 185, 163, 143, 258, 164, 144, 235, 275, 0,
+	182, 0, 141, 0, 0, 246, 208, 145, 207, 237,
+	274, 273, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 179, 0,
+	286, 0, 227, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 254, 0, 0, 0, 0, 0,
+	190, 233, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 284, 296, 287,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	217, 218, 219, 220, 0, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 184, 0,
+	186, 158, 232, 181, 293, 194, 242, 241, 191, 245,
+	224, 189, 259, 195, 202, 247, 292, 230, 252, 157,
+	283, 260, 206, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 199, 0, 244, 177, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 229, 0, 299, 300, 301, 285, 0, 0, 0,
+	0, 172, 0, 0, 0, 198, 0, 200, 0, 0,
+	261, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 0, 0, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1461, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 223, 302, 0,
+	0, 146, 266, 281, 156, 257, 294, 160, 264, 152,
+	228, 253, 148, 279, 263, 210, 192, 193, 147, 0,
+	248, 170, 183, 167, 226, 0, 0, 0, 166, 0,
+	289, 150, 151, 288, 225, 276, 280, 211, 205, 149,
+	278, 209, 204, 196, 174, 187, 238, 203, 239, 188,
+	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 0, 0, 0, 0, 0, 265, 0, 0,
+	197, 0, 0, 0, 0, 0, 251, 231, 0, 0,
+	236, 249, 201, 277, 240, 282, 267, 290, 0, 243,
+	142, 268, 169, 212, 153, 154, 165, 171, 173, 175,
+	176, 221, 222, 234, 256, 269, 270, 271, 272, 168,
+	161, 250, 162, 185, 163, 143, 258, 164, 144, 235,
+	275, 0, 182, 0, 141, 0, 0, 246, 208, 145,
+	207, 237, 274, 273, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	179, 0, 286, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
+	0, 0, 190, 233, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 284,
+	296, 287, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 217, 218, 219, 220, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	184, 0, 186, 158, 232, 181, 293, 194, 242, 241,
+	191, 245, 224, 189, 259, 195, 202, 247, 292, 230,
+	252, 157, 283, 260, 206, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 199, 0, 244, 177, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 229, 0, 299, 300, 301, 285, 0,
+	0, 0, 0, 172, 0, 0, 0, 198, 0, 200,
+	0, 0, 261, 213, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 1459, 0, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 223,
+	302, 0, 0, 146, 266, 281, 156, 257, 294, 160,
+	264, 152, 228, 253, 148, 279, 263, 210, 192, 193,
+	147, 0, 248, 170, 183, 167, 226, 0, 0, 0,
+	166, 0, 289, 150, 151, 288, 225, 276, 280, 211,
+	205, 149, 278, 209, 204, 196, 174, 187, 238, 203,
+	239, 188, 215, 214, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 197, 0, 0, 0, 0, 0, 251, 231,
+	0, 0, 236, 249, 201, 277, 240, 282, 267, 290,
+	0, 243, 142, 268, 169, 212, 153, 154, 165, 171,
+	173, 175, 176, 221, 222, 234, 256, 269, 270, 271,
+	272, 168, 161, 250, 162, 185, 163, 143, 258, 164,
+	144, 235, 275, 0, 182, 0, 141, 0, 0, 246,
+	208, 145, 207, 237, 274, 273, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 303, 179, 0, 286, 0, 227, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 0, 0, 0, 190, 233, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	262, 284, 296, 287, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 217, 218, 219, 220, 0, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 184, 0, 186, 158, 232, 181, 293, 194,
+	242, 241, 191, 245, 224, 189, 259, 195, 202, 247,
+	292, 230, 252, 157, 283, 260, 206, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 1207, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	0, 0, 0, 140, 0, 199, 0, 244, 177, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 229, 0, 299, 300, 301,
+	285, 0, 0, 0, 0, 172, 0, 0, 0, 198,
+	0, 200, 0, 0, 261, 213, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 335, 0,
-	0, 336, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 0, 0, 348, 0,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	297, 223, 302, 0, 0, 146, 266, 281, 156, 257,
+	294, 160, 264, 152, 228, 253, 148, 279, 263, 210,
+	192, 193, 147, 0, 248, 170, 183, 167, 226, 0,
+	0, 0, 166, 0, 289, 150, 151, 288, 225, 276,
+	280, 211, 205, 149, 278, 209, 204, 196, 174, 187,
+	238, 203, 239, 188, 215, 214, 216, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 0, 0, 0, 291, 0, 0, 0, 0, 0,
+	0, 265, 0, 0, 197, 0, 0, 0, 0, 0,
+	251, 231, 0, 0, 236, 249, 201, 277, 240, 282,
+	267, 290, 0, 243, 142, 268, 169, 212, 153, 154,
+	165, 171, 173, 175, 176, 221, 222, 234, 256, 269,
+	270, 271, 272, 168, 161, 250, 162, 185, 163, 143,
+	258, 164, 144, 235, 275, 0, 182, 0, 141, 0,
+	0, 246, 208, 145, 207, 237, 274, 273, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 179, 0, 286, 0, 227, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 0, 0, 0, 190, 233, 0, 255,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 284, 296, 287, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 217, 218, 219, 220,
+	0, 0, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 184, 0, 186, 158, 232, 181,
+	293, 194, 242, 241, 191, 245, 224, 189, 259, 195,
+	202, 247, 292, 230, 252, 157, 283, 260, 206, 180,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 1152, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 199, 0, 244,
+	177, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 229, 0, 299,
+	300, 301, 285, 0, 0, 0, 0, 172, 0, 0,
+	0, 198, 0, 200, 0, 0, 261, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 223, 302, 0, 0, 146, 266, 281,
+	156, 257, 294, 160, 264, 152, 228, 253, 148, 279,
+	263, 210, 192, 193, 147, 0, 248, 170, 183, 167,
+	226, 0, 0, 0, 166, 0, 289, 150, 151, 288,
+	225, 276, 280, 211, 205, 149, 278, 209, 204, 196,
+	174, 187, 238, 203, 239, 188, 215, 214, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 1186,
+	0, 0, 0, 265, 0, 0, 197, 0, 0, 0,
+	0, 0, 251, 231, 0, 0, 236, 249, 201, 277,
+	240, 282, 267, 290, 0, 243, 142, 268, 169, 212,
+	153, 154, 165, 171, 173, 175, 176, 221, 222, 234,
+	256, 269, 270, 271, 272, 168, 161, 250, 162, 185,
+	163, 143, 258, 164, 144, 235, 275, 0, 182, 0,
+	141, 0, 0, 246, 208, 145, 207, 237, 274, 273,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 179, 0, 286, 0,
+	227, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 0, 0, 0, 190, 233,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 284, 296, 287, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 217, 218,
+	219, 220, 0, 0, 159, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 184, 0, 186, 158,
+	232, 181, 293, 194, 242, 241, 191, 245, 224, 189,
+	259, 195, 202, 247, 292, 230, 252, 157, 283, 260,
+	206, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 199,
+	0, 244, 177, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 229,
+	0, 299, 300, 301, 285, 0, 0, 0, 0, 172,
+	0, 0, 0, 198, 0, 200, 0, 0, 261, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 796, 0, 0, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 223, 302, 0, 0, 146,
+	266, 281, 156, 257, 294, 160, 264, 152, 228, 253,
+	148, 279, 263, 210, 192, 193, 147, 0, 248, 170,
+	183, 167, 226, 0, 0, 0, 166, 0, 289, 150,
+	151, 288, 225, 276, 280, 211, 205, 149, 278, 209,
+	204, 196, 174, 187, 238, 203, 239, 188, 215, 214,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 0, 0, 0, 0, 265, 0, 0, 197, 0,
+	0, 0, 0, 0, 251, 231, 0, 0, 236, 249,
+	201, 277, 240, 282, 267, 290, 0, 243, 142, 268,
+	169, 212, 153, 154, 165, 171, 173, 175, 176, 221,
+	222, 234, 256, 269, 270, 271, 272, 168, 161, 250,
+	162, 185, 163, 143, 258, 164, 144, 235, 275, 0,
+	182, 0, 141, 0, 0, 246, 208, 145, 207, 237,
+	274, 273, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 179, 0,
+	286, 0, 227, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 254, 0, 0, 0, 0, 0,
+	190, 233, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 284, 296, 838,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	217, 218, 219, 220, 0, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 184, 0,
+	186, 158, 232, 181, 293, 194, 242, 241, 191, 245,
+	224, 189, 259, 195, 202, 247, 292, 230, 252, 157,
+	283, 260, 206, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 199, 0, 244, 177, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 229, 0, 299, 300, 301, 285, 0, 0, 0,
+	435, 172, 0, 0, 0, 198, 0, 200, 0, 0,
+	261, 213, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 764, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 0, 0, 155, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 0, 0, 0, 0, 0, 297, 223, 302, 0,
+	0, 146, 266, 281, 156, 257, 294, 160, 264, 152,
+	228, 253, 148, 279, 263, 210, 192, 193, 147, 0,
+	248, 170, 183, 167, 226, 0, 0, 0, 166, 0,
+	289, 150, 151, 288, 225, 276, 280, 211, 205, 149,
+	278, 209, 204, 196, 174, 187, 238, 203, 239, 188,
+	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	291, 0, 0, 0, 0, 0, 0, 265, 0, 0,
+	197, 0, 0, 0, 0, 0, 251, 231, 0, 0,
+	236, 249, 201, 277, 240, 282, 267, 290, 0, 243,
+	142, 268, 169, 212, 153, 154, 165, 171, 173, 175,
+	176, 221, 222, 234, 256, 269, 270, 271, 272, 168,
+	161, 250, 162, 185, 163, 143, 258, 164, 144, 235,
+	275, 0, 182, 0, 141, 0, 0, 246, 208, 145,
+	207, 237, 274, 273, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	179, 0, 286, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
+	0, 0, 190, 233, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 284,
+	296, 287, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 217, 218, 219, 220, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	184, 0, 186, 158, 232, 181, 293, 194, 242, 241,
+	191, 245, 224, 189, 259, 195, 202, 247, 292, 230,
+	252, 157, 283, 260, 206, 180, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 803, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 199, 0, 244, 177, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 229, 0, 299, 300, 301, 285, 0,
+	0, 0, 0, 172, 0, 0, 0, 198, 0, 200,
+	0, 0, 261, 213, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 223,
+	302, 0, 0, 146, 266, 281, 156, 257, 294, 160,
+	264, 152, 228, 253, 148, 279, 263, 210, 192, 193,
+	147, 0, 248, 170, 183, 167, 226, 0, 0, 0,
+	166, 0, 289, 150, 151, 288, 225, 276, 280, 211,
+	205, 149, 278, 209, 204, 196, 174, 187, 238, 203,
+	239, 188, 215, 214, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 197, 0, 0, 0, 0, 0, 251, 231,
+	0, 0, 236, 249, 201, 277, 240, 282, 267, 290,
+	0, 243, 142, 268, 169, 212, 153, 154, 165, 171,
+	173, 175, 176, 221, 222, 234, 256, 269, 270, 271,
+	272, 168, 161, 250, 162, 185, 163, 143, 258, 164,
+	144, 235, 275, 0, 182, 0, 141, 0, 0, 246,
+	208, 145, 207, 237, 274, 273, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
+	0, 303, 179, 0, 286, 0, 227, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 0, 0, 0, 190, 233, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	262, 284, 296, 287, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 217, 218, 219, 220, 0, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 184, 0, 186, 158, 232, 181, 293, 194,
+	242, 241, 191, 245, 224, 189, 259, 195, 202, 247,
+	292, 230, 252, 157, 283, 260, 206, 180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 413, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	82, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	0, 428, 0, 140, 0, 199, 0, 244, 177, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 229, 0, 299, 300, 301,
+	285, 0, 0, 0, 0, 172, 0, 0, 0, 198,
+	0, 200, 0, 0, 261, 213, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	297, 223, 302, 0, 0, 146, 266, 281, 156, 257,
+	294, 160, 264, 152, 228, 253, 148, 279, 263, 210,
+	192, 193, 147, 0, 248, 170, 183, 167, 226, 0,
+	0, 0, 166, 0, 289, 150, 151, 288, 225, 276,
+	280, 211, 205, 149, 278, 209, 204, 196, 174, 187,
+	238, 203, 239, 188, 215, 214, 216, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 0, 0, 0, 291, 0, 0, 0, 0, 0,
+	0, 265, 0, 0, 197, 0, 0, 0, 0, 0,
+	251, 231, 0, 0, 236, 249, 201, 277, 240, 282,
+	267, 290, 0, 243, 142, 268, 169, 212, 153, 154,
+	165, 171, 173, 175, 176, 221, 222, 234, 256, 269,
+	270, 271, 272, 168, 161, 250, 162, 185, 163, 143,
+	258, 164, 144, 235, 275, 0, 182, 0, 141, 0,
+	0, 246, 208, 145, 207, 237, 274, 273, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 179, 0, 286, 0, 227, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 0, 0, 0, 190, 233, 0, 255,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 284, 296, 287, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 217, 218, 219, 220,
+	0, 0, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 184, 0, 186, 158, 232, 181,
+	293, 194, 242, 241, 191, 245, 224, 189, 259, 195,
+	202, 247, 292, 230, 252, 157, 283, 260, 206, 180,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 0, 211, 277,
-	278, 279, 263, 463, 0, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 468, 469, 470,
-	465, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 0, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
-	200, 201, 202, 0, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 0,
-	0, 211, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	468, 469, 470, 465, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 211, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 468, 469, 470, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 1766, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 162, 0, 264, 0, 209, 0, 0, 0, 1766,
-	0, 0, 1164, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 1164, 0, 0, 0, 2167, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 1748, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 1836,
-	0, 142, 0, 0, 0, 0, 0, 0, 1748, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1766, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1164, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1752, 0, 277, 278, 279, 263, 0, 0,
-	1748, 0, 0, 1756, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1752, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1745, 1756, 0, 0, 1747, 1749, 1751,
-	0, 1753, 1754, 1755, 1757, 1758, 1759, 1761, 1762, 1763,
-	1764, 0, 0, 0, 1745, 0, 0, 0, 1747, 1749,
-	1751, 0, 1753, 1754, 1755, 1757, 1758, 1759, 1761, 1762,
-	1763, 1764, 0, 0, 0, 1767, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1767, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1765,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1744, 0, 0, 0,
-	1765, 0, 0, 0, 0, 1752, 0, 0, 0, 0,
-	0, 1760, 0, 0, 0, 0, 1756, 1744, 1750, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1760, 0, 0, 0, 1745, 0, 0, 1750,
-	1747, 1749, 1751, 0, 1753, 1754, 1755, 1757, 1758, 1759,
-	1761, 1762, 1763, 1764, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1767, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1765, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1744,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1760, 0, 0, 0, 0, 0,
-	0, 1750,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 199, 0, 244,
+	177, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 0, 229, 299,
+	300, 301, 285, 473, 0, 0, 0, 0, 172, 0,
+	0, 0, 198, 0, 200, 0, 0, 261, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 479,
+	480, 475, 0, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 297, 223, 302, 0, 0, 146, 266,
+	281, 156, 257, 294, 160, 264, 152, 228, 253, 148,
+	279, 263, 210, 192, 193, 147, 0, 248, 170, 183,
+	167, 226, 0, 0, 0, 166, 0, 289, 150, 151,
+	288, 225, 276, 280, 211, 205, 149, 278, 209, 204,
+	196, 174, 187, 238, 203, 239, 188, 215, 214, 216,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 197, 0, 0,
+	0, 0, 0, 251, 231, 0, 0, 236, 249, 201,
+	277, 240, 282, 267, 290, 0, 243, 142, 268, 169,
+	212, 153, 154, 165, 171, 173, 175, 176, 221, 222,
+	234, 256, 269, 270, 271, 272, 168, 161, 250, 162,
+	185, 163, 143, 258, 164, 144, 235, 275, 0, 182,
+	0, 141, 0, 0, 246, 208, 145, 207, 237, 274,
+	273, 298, 304, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 303, 179, 0, 286,
+	0, 227, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 254, 0, 0, 0, 0, 0, 190,
+	233, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 284, 296, 287, 0,
+	0, 0, 295, 0, 0, 0, 0, 0, 0, 217,
+	218, 219, 220, 0, 0, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 184, 0, 186,
+	158, 232, 181, 293, 194, 242, 241, 191, 245, 224,
+	189, 259, 195, 202, 247, 292, 230, 252, 157, 283,
+	260, 206, 180, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 0, 0, 198, 0,
+	200, 0, 0, 261, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 140, 0,
+	199, 0, 244, 177, 478, 479, 480, 475, 0, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 299, 300, 301, 285, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 297,
+	223, 302, 0, 0, 146, 266, 281, 156, 257, 294,
+	160, 264, 152, 228, 253, 148, 279, 263, 210, 192,
+	193, 147, 0, 248, 170, 183, 167, 226, 0, 0,
+	0, 166, 0, 289, 150, 151, 288, 225, 276, 280,
+	211, 205, 149, 278, 209, 204, 196, 174, 187, 238,
+	203, 239, 188, 215, 214, 216, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 197, 0, 0, 0, 0, 0, 251,
+	231, 0, 0, 236, 249, 201, 277, 240, 282, 267,
+	290, 0, 243, 142, 268, 169, 212, 153, 154, 165,
+	171, 173, 175, 176, 221, 222, 234, 256, 269, 270,
+	271, 272, 168, 161, 250, 162, 185, 163, 143, 258,
+	164, 144, 235, 275, 0, 182, 0, 141, 0, 0,
+	246, 208, 145, 207, 237, 274, 273, 298, 304, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 179, 0, 286, 0, 227, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
+	0, 0, 0, 0, 0, 190, 233, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 284, 296, 287, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 0, 217, 218, 219, 220, 0,
+	0, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 184, 0, 186, 158, 232, 181, 293,
+	194, 242, 241, 191, 245, 224, 189, 259, 195, 202,
+	247, 292, 230, 252, 157, 283, 260, 206, 180, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 0, 0, 198, 0, 200, 0, 0, 261,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 199, 0, 244, 177,
+	478, 479, 480, 0, 0, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 299, 300,
+	301, 285, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 223, 302, 0, 0,
+	146, 266, 281, 156, 257, 294, 160, 264, 152, 228,
+	253, 148, 279, 263, 210, 192, 193, 147, 0, 248,
+	170, 183, 167, 226, 0, 0, 0, 166, 0, 289,
+	150, 151, 288, 225, 276, 280, 211, 205, 149, 278,
+	209, 204, 196, 174, 187, 238, 203, 239, 188, 215,
+	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 197,
+	0, 0, 0, 0, 0, 251, 231, 0, 0, 236,
+	249, 201, 277, 240, 282, 267, 290, 0, 243, 142,
+	268, 169, 212, 153, 154, 165, 171, 173, 175, 176,
+	221, 222, 234, 256, 269, 270, 271, 272, 168, 161,
+	250, 162, 185, 163, 143, 258, 164, 144, 235, 275,
+	0, 182, 0, 141, 0, 0, 246, 208, 145, 207,
+	237, 274, 273, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 179,
+	0, 286, 0, 227, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
+	0, 190, 233, 0, 255, 0, 0, 0, 1713, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 284, 296,
+	287, 0, 1827, 0, 295, 0, 0, 0, 0, 0,
+	0, 217, 218, 219, 220, 0, 0, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 1198, 0, 178, 184,
+	0, 186, 158, 232, 181, 293, 194, 242, 241, 191,
+	245, 224, 189, 259, 195, 202, 247, 292, 230, 252,
+	157, 283, 260, 206, 180, 0, 0, 0, 0, 0,
+	0, 0, 1809, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1701, 0,
+	0, 1718, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 199, 0, 244, 177, 0, 1638, 1642, 1644,
+	1646, 1648, 1649, 1651, 0, 1637, 1634, 1635, 1636, 0,
+	0, 1715, 1716, 1717, 1699, 1700, 1639, 0, 1702, 0,
+	1703, 1704, 1705, 1706, 1707, 1708, 1709, 1710, 1711, 1712,
+	1719, 0, 0, 0, 299, 300, 301, 285, 1641, 1643,
+	1645, 1647, 1650, 84, 0, 24, 44, 25, 1827, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 70, 0, 0, 0, 77, 0, 1714,
+	0, 0, 1198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 45, 0, 0, 0,
+	0, 0, 80, 1813, 0, 0, 0, 0, 2261, 1827,
+	0, 0, 0, 0, 1817, 0, 0, 0, 1809, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1198, 1806, 0, 0, 0, 1808, 1810,
+	1812, 0, 1814, 1815, 1816, 1818, 1819, 1820, 1822, 1823,
+	1824, 1825, 1829, 0, 0, 0, 0, 0, 0, 0,
+	1904, 0, 0, 0, 0, 0, 0, 0, 0, 1809,
+	0, 0, 0, 0, 0, 73, 74, 0, 75, 76,
+	0, 1828, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1826, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1805, 0, 0, 0, 0, 62, 72, 81, 0, 42,
+	0, 0, 0, 0, 0, 1821, 0, 0, 0, 1813,
+	0, 0, 1811, 0, 0, 71, 69, 68, 0, 0,
+	1817, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 43, 0,
+	1806, 0, 0, 0, 1808, 1810, 1812, 1640, 1814, 1815,
+	1816, 1818, 1819, 1820, 1822, 1823, 1824, 1825, 1829, 0,
+	1813, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1817, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1828, 0, 0,
+	0, 1806, 0, 0, 0, 1808, 1810, 1812, 0, 1814,
+	1815, 1816, 1818, 1819, 1820, 1822, 1823, 1824, 1825, 1829,
+	53, 0, 0, 0, 0, 0, 54, 0, 0, 1826,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1805, 0, 1828, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1821, 0, 55, 0, 0, 0, 0, 1811, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1826, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1805, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1821, 0, 0, 0, 0, 0, 0, 1811,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83,
 }
 
 var yyPact = [...]int{
-	338, -1000, -301, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	21327, -1000, -302, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 238, 1927, -1000, 8612, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 17783, 1728, -1000, 7914, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 211, 14787,
-	18211, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7468, 7022,
-	100, -1000, 1719, -1000, -1000, -1000, -1000, 120, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 422, -54, 299, 305,
-	536, 536, 8770, 1719, 1385, 160, 10, -1000, 17355, 710,
-	338, 152, 18211, -1000, 353, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 268, 261, 15267, 19687, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 8152, 7692, 168, -1000, 1918, -1000, -1000,
+	-1000, -1000, 363, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 515, 118, 362, 366, 396, 396, 9496, 1918, 1543,
+	196, 67, -1000, 19245, 766, 21327, 18803, -1000, 15267, 19687,
+	-42, 578, -1000, 223, 186, 181, 409, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3114,75 +3310,67 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 14787, 18211, -88, 502, -1000, 183,
-	195, 176, 351, -1000, -1000, -1000, -1000, 18211, 1611, -1000,
-	-1000, -1000, 1627, 18640, 160, -1000, 1290, 1311, -1000, -1000,
-	1525, -1000, 99, -14, -34, 70, -1000, -1000, 121, -1000,
-	-1000, -1000, -1000, -1000, 25, -1000, -20, -1000, -26, -1000,
-	-1000, -1000, -119, -1000, -1000, -1000, -1000, -1000, 1288, 331,
-	1552, -184, 1610, 1626, 1385, 1709, 1660, -17, 172, 172,
-	209, 172, -1000, -1000, -1000, -1000, -1000, -1000, 539, 137,
-	-1000, -1000, -132, -136, 383, -136, -10, -1000, -1000, -1000,
-	-1000, -1000, -1000, 174, -1000, -195, -1000, 287, -1000, 283,
-	-1000, 10501, 118, 1329, 475, -1000, 553, 553, 18211, 18211,
-	18211, 553, 955, 730, 347, -1000, -1000, -1000, 1596, 1598,
-	1626, 1385, -1000, 1719, 1719, 1220, 1051, 174, 174, 174,
-	174, 174, 1328, 18211, -1000, 1425, 5262, 5262, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 154, 1524, -1000, 18211,
-	1550, -1000, 345, 801, 961, -1000, -1000, 183, 1282, -1000,
-	491, -1000, -1000, -1000, -1000, 18211, 1523, 18211, 14787, 14787,
-	14787, 14787, -1000, 1572, 1571, -1000, 1564, 1563, 1576, 18211,
-	-1000, -1000, -1000, 18993, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1202, 1719, 102, 816, 13931, 16071, 18211, 13931, -1000,
-	-1000, -1000, -1000, -1000, -120, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 102, 13931, 13931, -94, -1000,
-	-1000, -289, 1610, 5700, -1000, -1000, 5700, -1000, -1000, 193,
-	172, -1000, 13931, 577, 16071, 885, 18211, 18211, -1000, -1000,
-	383, 383, -1000, 539, 539, -1000, -1000, -121, 1720, 6576,
-	-130, 18211, 172, 16927, 1620, -155, 297, 281, 291, -1000,
-	-1000, -192, -1000, -1000, 1298, 11363, 9627, 207, 13931, 3504,
-	-1000, -1000, 3504, 553, 553, 553, 3504, 326, -1000, -1000,
-	-1000, -1000, -1000, -1000, 18211, -1000, -1000, 1610, -1000, -1000,
-	-1000, 1626, 1610, 1626, -1000, -1000, 13931, 16071, 18211, 18211,
-	19346, 18211, 1328, 1625, 18211, 1300, -1000, -1000, 9199, 344,
-	5700, 1111, 1522, -1000, -1000, 1521, 1520, 1519, 1517, 1516,
-	1515, 1513, -1000, 1476, -1000, -1000, 1509, 1507, 1505, 1504,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1503, -1000, -1000, -1000,
-	1502, 1476, -1000, -1000, 1501, 1499, 1497, 1490, 1488, -1000,
-	-1000, -1000, -1000, 1060, -1000, -1000, -1000, -1000, 3066, 6576,
-	6576, 6576, 6576, -1000, -1000, 1436, 5700, 1487, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 10929, -1000, 1484, 1483, 1478, 1477, 1476, 1475,
-	959, 958, 1473, 1470, 1466, 6576, 936, 1465, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 19687, 19687, 1803, -1000,
+	-1000, -1000, 1846, 20130, 20130, 228, 500, -1000, 1474, 1455,
+	-1000, -1000, 1688, -1000, 108, 32, -8, 147, -1000, -1000,
+	190, -1000, -1000, -1000, -1000, -1000, 78, -1000, 23, -1000,
+	22, -1000, -1000, -1000, -82, -1000, -1000, -1000, -1000, -1000,
+	1354, 415, 1700, -153, 1781, 1888, 1543, 1906, 1852, 28,
+	231, 231, 256, 231, -1000, -1000, -1000, -1000, -1000, -1000,
+	651, 187, -1000, -1000, -91, 1863, 1860, -101, 440, -101,
+	30, -1000, -1000, -1000, -1000, -1000, -1000, 19687, 233, -1000,
+	-184, -1000, 347, -1000, 344, -1000, 11283, 184, 1483, 674,
+	-1000, 551, 551, 19687, 19687, 19687, 551, 551, 764, 750,
+	408, -1000, -1000, 1756, 1772, 1888, 1543, -1000, 1918, 1918,
+	1290, 1178, 233, 233, 233, 233, 233, 1480, 19687, -1000,
+	1593, 713, -1000, -1000, 210, 19687, -1000, 401, 1769, -1000,
+	399, 922, 1119, -1000, -1000, 223, 1465, -1000, 669, -1000,
+	-1000, -1000, -1000, 19687, 1687, 197, -1000, 19687, 15267, 15267,
+	15267, 15267, -1000, 1720, 1719, -1000, 1757, 1736, 1823, 19687,
+	-1000, 1593, -1000, 20496, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1288, -286, 1918, 6328, 19687, 146, 1832, 15709,
+	17477, 19687, 15709, -1000, -1000, -1000, -1000, -1000, -83, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 146,
+	15709, 15709, -51, -1000, -1000, -288, 1781, 6328, -1000, -1000,
+	6328, -1000, -1000, 250, 231, -1000, 15709, 618, 17477, 1003,
+	19687, 19687, -1000, -1000, 440, 440, -1000, 651, 651, -1000,
+	-1000, -128, 13941, -95, 1915, 7232, -94, 19687, 231, 274,
+	18361, 1813, -117, 355, 339, 342, -1000, -1000, -155, -1000,
+	-1000, 1458, 12173, 10381, 249, 15709, 3610, -1000, -1000, 3610,
+	551, 551, 551, 3610, 3610, 418, -1000, -1000, -1000, -1000,
+	-1000, -1000, 19687, -1000, -1000, 1781, -1000, -1000, -1000, 1888,
+	1781, 1888, -1000, -1000, 15709, 17477, 19687, 19687, 20862, 19687,
+	1480, 1845, 19687, 5876, 5876, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 224, 1686, -1000, 1909, 6328, 2287, -1000, 1859,
+	-1000, 223, 105, -1000, -1000, -1000, -1000, -1000, -1000, 395,
+	19687, -1000, 19687, -1000, -1000, 1388, -1000, 575, 1695, 1698,
+	1695, -1000, -1000, -1000, -1000, 1716, -1000, 1603, -1000, -1000,
+	1593, -1000, -1000, -1000, 1452, -1000, 1683, -1000, 1286, 1305,
+	747, 6328, 1220, -1000, 982, 404, -1000, -1000, -1000, 3158,
+	7232, 7232, 7232, 7232, -1000, -1000, 1625, 6328, 1679, 1678,
+	-1000, -1000, -1000, -1000, 393, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11725, -1000, 1676,
+	1675, 1674, 1673, 1670, 1667, 1666, 1665, 1639, 1500, 1664,
+	1118, 1117, 1663, 1660, 1659, 7232, 1110, 1500, 1500, 1657,
+	1656, 1655, 1654, 1653, 1652, 1651, 1648, 1645, 1644, 1643,
+	1642, 1641, 1640, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1300, -1000, -287, -1000, 10067, 18211, 18211, -1000, 1712, 5700,
-	2186, -1000, 1649, -1000, 183, 61, -1000, -1000, -1000, -1000,
-	-1000, -1000, 337, 18211, 1293, -1000, 470, 1534, 1549, 1534,
-	-1000, -1000, -1000, -1000, 1565, -1000, 1442, -1000, -1000, 1425,
-	-1000, -1000, 529, -1000, -1000, -1000, -1000, -1000, -20, -26,
-	1292, -1000, -49, 92, -1000, -1000, 1265, -1000, -1000, -1000,
-	529, 1292, 190, 935, 933, -1000, 1076, 336, 1327, -1000,
-	759, 16499, 18211, 217, 1616, 1298, 1383, 1603, 1720, 1720,
-	1720, 383, 19346, 539, 18211, 539, -1000, -1000, 539, -1000,
-	334, 18211, 217, 1459, -1000, -1000, -1000, 295, 282, 279,
-	16071, 186, -1000, -1000, 1298, -1000, -1000, -1000, 1458, 468,
-	-1000, -1000, 6576, -1000, 606, -1000, -1000, 3504, 3504, 3504,
-	-1000, 12647, -1000, -1000, 1610, -1000, 1610, 1292, 1298, 1548,
-	1325, -1000, -1000, -1000, -1000, -1000, 1451, 1261, -1000, 1720,
-	5262, -1000, 14787, -1000, 5700, 5700, 5700, -1000, 15643, -1000,
-	15215, -1000, 240, 6138, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5700, 1644, 1644, 1644, 5700, 635, 5700, 5700, -1000,
-	653, 7020, 1644, 1644, 1644, 1644, 1644, -1000, 2620, 1644,
-	1644, 1644, 1644, 6576, 6576, 6576, 6576, 6576, 6576, 6576,
-	6576, 6576, 6576, 6576, 6576, 1434, 579, 6576, 6576, 6576,
-	1051, 1469, 1320, -1000, -1000, -1000, -1000, -1000, 528, 606,
-	5700, -1000, 7020, 7020, 799, 5700, 5700, 5700, -1000, 1200,
-	-1000, -1000, 5700, -1000, -1000, 5700, 6576, 5700, -1000, -1000,
-	1644, 1720, 1269, -1000, 1446, -1000, 1253, 1591, -1000, 328,
-	1302, -1000, 403, 1245, -1000, 1626, 606, -1000, 321, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 520, -1000, -1000, -1000, -1000, -1000,
+	23, 22, 1361, -1000, -23, 104, -1000, -1000, 1448, -1000,
+	-1000, -1000, 520, 1361, 243, 1109, 1095, -1000, 902, 1476,
+	-1000, 936, 17919, 19687, 258, 1811, 1458, 1493, 1776, 1915,
+	1915, 1915, 440, 20862, 651, 19687, 920, 1639, -1000, 651,
+	-1000, 404, 651, -1000, 392, 19687, 209, 258, 1636, -1000,
+	-1000, -1000, 353, 336, 343, 17477, 242, -1000, -1000, 1458,
+	-1000, -1000, -1000, 1631, 559, -1000, -1000, 7232, -1000, 747,
+	-1000, -1000, 3610, 3610, 3610, -1000, -1000, 13499, -1000, 1855,
+	1781, -1000, 1781, 1361, 1458, 1697, 1469, -1000, -1000, -1000,
+	-1000, 1630, 1443, -1000, 946, -1000, -1000, 9939, 390, 946,
+	-1000, -286, -1000, 10835, 19687, 19687, 1888, 747, -1000, 389,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3197,154 +3385,172 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -90, -1000, -1000, 18211, 1240, 1712, 18211, 5700,
-	-1000, -1000, 5700, 1444, -1000, 5700, -1000, -1000, -1000, -1000,
-	1727, 316, 315, 13931, -1000, 148, 13931, -1000, -1000, 18211,
-	184, 13931, -18, -140, 5700, 5700, 18211, 5700, -1000, -1000,
-	-1000, 1425, 564, 1443, -239, -1000, -65, -1000, 1545, 63,
-	-1000, 1603, -1000, 322, -1000, -1000, -1000, -1000, 1720, -1000,
-	383, -1000, 383, 539, 18211, -1000, -1000, -239, 1186, -1000,
-	-1000, -1000, 274, 1298, 13931, 920, 207, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 18211, 18211, 338, -1000, 18211, 1716,
-	-1000, 1297, 1647, -1000, 634, 584, -1000, -1000, 314, -1000,
-	-1000, 280, -1000, -1000, -1000, -1000, -1000, 1436, -1000, -1000,
-	-1000, 1184, 1234, 606, 5700, -1000, -1000, 5700, 5700, 685,
-	5700, 1178, 1238, 1236, -1000, 1176, -1000, 1722, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -43, -1000, -1000, 19687,
+	1406, -1000, 1909, 19687, 6328, -1000, -1000, 6328, 1628, -1000,
+	6328, -1000, -1000, -1000, -1000, -286, -1000, 4966, -1000, 6328,
+	6328, 6328, 6328, -1000, 290, 6780, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 7232, 7232, 7232, 7232, 7232, 7232, 7232,
+	7232, 7232, 7232, 7232, 7232, 1622, 749, 7232, 7232, 7232,
+	831, 1178, 1606, 1467, 404, 404, 404, 404, -1000, 598,
+	747, 6328, 6328, 19687, 639, 639, 639, 913, 6328, 6328,
+	639, 6328, 1850, 1850, 2698, 6328, -1000, 1268, -1000, -1000,
+	744, 6328, -1000, -1000, 6328, 7232, 6328, 404, -1000, -1000,
+	-1000, 1850, 6328, 6328, 1850, 1850, 1850, 655, 1850, 1850,
+	1850, 1850, 1850, 1850, 1850, 1926, 383, 380, 15709, -1000,
+	201, 15709, -1000, -1000, 19687, 241, 15709, 27, -115, 6328,
+	6328, 6328, -1000, -1000, -1000, 1593, 616, 1626, -221, -1000,
+	-13, -1000, 1692, 72, -1000, 1776, -1000, 338, -1000, -1000,
+	-1000, -1000, 1915, -1000, 440, -1000, -1000, -1000, 440, 651,
+	19687, -1000, -1000, 19687, -221, 1263, -1000, -1000, -1000, 331,
+	1458, 15709, 1060, 249, -1000, -1000, -1000, -1000, -1000, -1000,
+	1935, 1855, -1000, 19687, 21327, -1000, 19687, 1919, 5876, 756,
+	-1000, -1000, 17035, -1000, 16593, 1919, 1311, 1401, 1746, -1000,
+	377, 1420, -1000, 555, 1397, -1000, -1000, 2287, 912, -1000,
+	-1000, 1888, -1000, 747, 747, 19687, 747, -1000, 1251, 1417,
+	-1000, 747, -1000, 668, 623, -1000, 272, -1000, -1000, -1000,
+	-1000, -1000, 1625, -1000, -1000, -1000, 1682, 1545, -1000, 730,
+	730, 423, 423, 423, 423, 423, 1036, 1036, -1000, -1000,
+	-1000, 3158, 1622, 7232, 7232, 7232, 309, 1717, 1053, -1000,
+	-1000, -1000, -1000, -1000, 6328, 609, -1000, 6328, 989, 722,
+	376, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5700, 5700, 5700,
-	5700, 5700, 872, 4824, -1000, -1000, -1000, 5700, 5700, 5700,
-	5700, 788, 930, -1000, 591, 591, 357, 357, 357, 357,
-	357, 1071, 1071, -1000, -1000, -1000, 3066, 1434, 6576, 6576,
-	6576, 158, 1967, 1893, -1000, 5700, 583, -1000, 5700, 725,
-	-1000, -1000, 7020, 1161, 824, 1138, 1134, -1000, 996, 1128,
-	1746, 1118, 5700, 1716, -287, 4380, 169, 18211, -287, 18211,
-	18211, 4380, -1000, 18211, -1000, 2186, 798, -1000, -1000, 1626,
-	-1000, 606, 606, 18211, 606, 13931, 368, 486, -1000, 12219,
-	13931, -1000, -1000, 13931, 117, 1609, -1000, -1000, -108, -100,
-	606, 606, 313, -1000, 1624, 1614, 8342, -1000, -79, -1000,
-	-1000, -1000, 275, -1000, 929, 927, 926, 925, 18211, -1000,
-	-1000, -1000, -1000, -1000, 397, 397, 397, 1596, -1000, 1720,
-	1720, 383, -1000, -28, -56, -1000, 1292, 1104, -1000, -1000,
-	-1000, -1000, 1094, -1000, 1714, 1707, 14787, 14359, -1000, -1000,
-	-1000, -1000, -1000, 5700, 1455, 1437, 1429, 823, 1223, -1000,
-	-1000, -1000, -1000, 5700, 1416, 1389, 1384, 1381, 1372, -1000,
-	5700, 5700, 671, 1364, 1353, 1322, 1309, 1218, -1000, 158,
-	1967, 1841, -1000, 6576, 6576, 1247, 516, -1000, 5700, 676,
-	823, 385, -1000, -1000, 5700, -1000, -1000, -1000, 385, -1000,
-	6576, -1000, 1206, 1714, -1000, 1092, 1294, -1000, -287, -1000,
-	-1000, 1269, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1210, 1292, -1000, -1000, -1000, -1000, 13931, 1619,
-	217, -1000, -16, 196, -294, -96, 1704, 1703, 18211, 160,
-	18211, 1090, 1287, -1000, -1000, -1000, 928, 549, -1000, 18211,
-	627, 323, 172, 323, 603, 1433, -1000, -1000, -79, -1000,
-	795, 792, 791, 789, -55, -1000, -1000, -1000, -1000, -1000,
-	1432, 385, -1000, 636, 923, -1000, -1000, 1720, -1000, -28,
-	-1000, 261, 258, 9, 1701, -1000, -1000, -1000, 5700, 5700,
-	1647, -1000, -1000, 606, -1000, -1000, -1000, 1077, -1000, 1392,
-	1424, -1000, 1392, 1392, 1392, 267, 267, 1427, 1427, 1428,
-	1427, -1000, 1203, -1000, -1000, -1000, -1000, -1000, 1192, 1189,
-	5700, -1000, -1000, -1000, -1000, -1000, -1000, 6576, -1000, -1000,
-	-1000, -1000, 606, 5700, 1074, 1064, 611, 1056, 1674, -1000,
-	-1000, -1000, 4380, 1269, -1000, -1000, 13931, 13931, -240, -21,
-	18211, -296, 922, -1000, 1700, 921, 807, -1000, 1425, 19736,
-	8342, 1259, -42, -1000, -1000, -1000, 1392, -1000, 1424, 1424,
-	1392, 1392, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1423, 1421, -1000, 1392, 1420, 1392, 1392, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 18211, 18211, -1000, 18211, 18211,
-	172, 5700, -1000, -1000, -1000, -1000, -1000, -1000, 13503, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 787,
-	-1000, -1000, -1000, 920, 606, 1234, -1000, -1000, -1000, 783,
-	-1000, 776, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	766, -1000, -1000, 765, -1000, -1000, -1000, -1000, 1073, -1000,
-	606, -1000, -1000, -1000, 5700, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -130, -298, 763, -1000, 918, -99, -1000, -1000,
-	1622, 139, 19624, -1000, 397, 397, 350, 397, 397, 397,
-	397, 96, 95, 397, 397, 397, 397, 397, 397, 397,
-	397, 397, 397, 397, 397, 397, 397, 1412, -1000, -1000,
-	1259, -1000, -1000, 618, 6576, -1000, -1000, 914, 636, 372,
-	438, 1411, -1000, 69, 596, 594, -1000, 18211, -1000, -46,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 909, 909, -1000,
-	-1000, 762, -1000, -1000, 1402, 1374, 34, 1400, -1000, 1394,
-	1393, 18211, 950, 1205, -1000, 1392, 5700, 4, -1000, -1000,
-	1050, 1026, 1197, 1195, -1000, 897, -114, -107, -1000, 1382,
-	-1000, -1000, 1699, 160, -1000, 1698, 19736, -1000, 758, 756,
-	397, 397, 754, 907, 900, 888, 397, 397, 749, 877,
-	18993, 736, 721, 702, 780, 876, 410, 769, 768, 707,
-	18211, 1380, 822, -1000, -1000, 1967, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 688, 1375, -1000,
-	-1000, 1363, -1000, -1000, 1191, -1000, 1159, 1024, 13503, 35,
-	35, 13503, 13503, 13503, 1357, 232, -1000, 13503, 1608, 794,
-	-1000, -1000, -1000, -1000, 681, -1000, 679, -1000, 180, -106,
-	-107, -1000, 1684, -101, 1681, 1671, 18211, 807, -1000, 82,
-	-1000, -1000, -1000, 385, 385, -1000, -1000, -1000, -1000, 874,
-	827, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 639, 1245, 1237, 997, 1917, 1228, 6328,
+	-1000, -1000, 6328, 1058, 5424, -1000, -1000, -1000, 1224, -1000,
+	-1000, 1217, -1000, 1132, 1210, 1661, 1208, 6328, 1395, 1393,
+	6328, 6328, 6328, 6328, 1202, 6328, 6328, 6328, 6328, 6328,
+	6328, 6328, 15709, 429, 491, -1000, 13057, 15709, -1000, -1000,
+	15709, 129, 1689, -1000, -1000, -72, -64, 747, 747, -1000,
+	1831, 1791, 9054, -1000, -41, -1000, -1000, -1000, 385, -1000,
+	1091, 1081, 1077, 1076, 19687, -1000, -1000, -1000, -1000, -1000,
+	460, 460, 460, 1756, -1000, 1915, 1915, 440, -1000, 1414,
+	16, -24, -1000, 1361, 1199, -1000, -1000, -1000, -1000, -1000,
+	1197, -1000, 1915, -1000, 15267, -1000, 1380, -1000, -1000, -1000,
+	375, -1000, 1915, 185, 19687, -286, 19687, 19687, 4966, -1000,
+	19687, -1000, -1000, -1000, -1000, -1000, -1000, 1374, -1000, 4966,
+	-1000, -1000, -1000, -1000, 1367, -1000, 309, 1717, 918, -1000,
+	7232, 7232, 1589, 594, -1000, 6328, 845, 66, 66, 738,
+	19687, -1000, -1000, -1000, 6328, 6328, -1000, 1553, 1544, -1000,
+	6328, 6328, 975, -1000, -1000, -1000, 738, -1000, 7232, -1000,
+	1537, -1000, -1000, 1523, 1514, 1491, 1348, -1000, 1446, 1434,
+	1415, 1412, 1407, 1378, 1364, 1361, -1000, -1000, -1000, -1000,
+	15709, 1820, 258, -1000, 31, 260, -290, -53, 1905, 1904,
+	196, 19687, 1194, 1359, -1000, -1000, -1000, 21148, 737, -1000,
+	19687, 691, 350, 231, 350, 689, 1620, -1000, -1000, -41,
+	-1000, 898, 896, 891, 884, -5, -1000, -1000, -1000, -1000,
+	-1000, 1611, 738, -1000, 831, 1069, -1000, -1000, 1915, -1000,
+	16, -1000, 330, 319, 62, 1903, -1000, -1000, 1913, 1330,
+	1814, 756, 16151, 1913, -286, -1000, -1000, 1311, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 7232, 404, -1000, -1000,
+	-1000, 747, 6328, 1191, -1000, 6, 1552, 1592, -1000, 1552,
+	1552, 1552, 329, 329, 1609, 1609, 1610, 1609, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 104, 18211, 1156, -1000, 399, 1016, 5700,
-	-234, 13503, -1000, 825, -1000, -1000, 1142, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1137, 1133, 1131, 13503, -1000, -1000,
-	-1000, 64, 109, -1000, -1000, 1608, 901, 828, 1349, 674,
-	-96, 1661, -1000, 807, 1659, 807, 807, 1089, -1000, -1000,
-	43, 156, 146, -1000, 214, -1000, -1000, -1000, -1000, -1000,
-	-1000, 119, 1080, -1000, 822, 819, -1000, 714, 1538, -1000,
-	-27, 1069, -1000, -1000, -1000, -1000, -1000, 1055, -1000, -1000,
-	397, 818, 26, -1000, -1000, -1000, -1000, -1000, 1595, 11791,
-	-116, -1000, 808, -1000, 807, -1000, -1000, -1000, 18211, 40,
-	670, 6576, 1343, 6576, 1340, 54, 1336, -1000, -1000, -1000,
-	-1000, -1000, 232, -1000, -1000, 1537, 1472, 1726, -1000, -1000,
-	-1000, -1000, 109, 109, 109, 109, -25, 668, -1000, 885,
-	-1000, 18211, -1000, 1053, -1000, -1000, -1000, 312, -1000, -1000,
-	-1000, -1000, 1331, 1657, -1000, 1662, 18211, 1601, 18211, 1323,
-	396, 6576, -1000, -1000, 1731, -1000, 1721, 598, 598, -1000,
-	-1000, -1000, 1232, -1000, 393, -1000, 13075, 18211, -1000, 135,
-	51, -1000, 1044, -1000, 1042, 18211, 655, 1117, -1000, -1000,
-	-1000, 742, 67, -1000, 18211, 3942, -1000, 310, 1040, -1000,
-	931, 36, -1000, -1000, 998, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 606, 18211, -1000, 135, 1589, -1000, 641, -1000,
-	-1000, -1000, 19603, 131, -1000, -1000, 19603, 39, -1000, 114,
-	-1000, -1000, 994, -1000, 875, 1260, -1000, 39, 19736, 5700,
-	-1000, 19736, 968, -1000,
+	-1000, -1000, 1185, 1181, -1000, 911, 1345, -1000, -1000, 1337,
+	1275, 6328, 1179, 1478, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15709, 15709, -223,
+	17, 19687, -292, 1067, -1000, 1902, 1062, 935, -1000, 1593,
+	21187, 9054, 754, 6, -1000, -1000, -1000, 1552, -1000, 1592,
+	1592, 1552, 1552, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1582, 1578, -1000, 1552, 1576, 1552, 1552, -1000,
+	-1000, -1000, 19687, 19687, -1000, 19687, 19687, 231, 6328, -1000,
+	-1000, -1000, -1000, -1000, -1000, 14825, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 882, -1000, -1000, -1000, 1060, 1911, 1901,
+	15267, -1000, -1000, 1911, 1311, -1000, 747, -1000, -1000, -1000,
+	-1000, -1000, -1000, 881, -1000, 878, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 871, -1000, -1000, 856, -1000, -1000,
+	-1000, -1000, 6328, -1000, -1000, -1000, 1253, -1000, -1000, -1000,
+	-1000, -1000, -1000, -94, -295, 850, -1000, 1059, -56, -1000,
+	-1000, 1826, 208, 21384, -1000, 460, 460, 636, 460, 460,
+	460, 460, 164, 162, 460, 460, 460, 460, 460, 460,
+	460, 460, 460, 460, 460, 460, 460, 460, 1575, 1574,
+	-1000, -1000, 754, -1000, -1000, 675, 7232, -1000, -1000, 1057,
+	831, 378, 405, 1573, -1000, 135, 124, 1566, 1933, 632,
+	628, -1000, 19687, -1000, -18, -1000, -1000, -1000, -1000, -1000,
+	1056, 1056, -1000, -1000, 829, -1000, -1000, 1562, 1061, 91,
+	1559, -1000, 1558, 1557, 19687, 1206, 1339, -1000, 1552, 6328,
+	52, -1000, -1000, -1000, 6328, 6328, 1814, -1000, 1173, 1171,
+	1335, 1332, 1195, -1000, -73, -60, -1000, 1526, -1000, -1000,
+	1899, 196, -1000, 1898, 21187, -1000, 819, 815, 460, 460,
+	813, 1024, 1020, 1015, 460, 460, 807, 1014, 20496, 806,
+	799, 784, 888, 1006, 541, 872, 864, 791, 19687, 1521,
+	955, 19687, -1000, -1000, 1717, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 779, 1520, 1816, 6328,
+	-206, -1000, -1000, 1513, -1000, -1000, 1307, -1000, 1303, 1167,
+	14825, 99, 99, 14825, 14825, 14825, 1511, 340, -1000, 14825,
+	1793, 1187, -1000, 747, 1305, -1000, -1000, -1000, 769, -1000,
+	753, -1000, 236, -70, -60, -1000, 1896, -57, 1895, 1894,
+	19687, 935, -1000, 119, -1000, -1000, -1000, 738, 738, -1000,
+	-1000, -1000, -1000, 974, 973, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 180, 19687, 1285,
+	-1000, 524, 1299, 1139, 6328, 1510, 1134, 1500, 1500, -205,
+	14825, -1000, 960, -1000, -1000, 1283, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1273, 1271, 1267, 14825, -1000, -1000, -1000,
+	128, 123, -1000, -1000, 1793, 1135, 1133, 1498, 739, -53,
+	1893, -1000, 935, 1892, 935, 935, 1249, -1000, -1000, 107,
+	232, 230, -1000, 280, -1000, -1000, -1000, -1000, -1000, -1000,
+	173, 1244, -1000, 955, 954, 379, -1000, 1044, 6328, 127,
+	-1000, -1000, 1556, -1000, 14, 1240, -1000, -1000, -1000, -1000,
+	-1000, 1235, -1000, -1000, 460, 949, 84, -1000, -1000, -1000,
+	-1000, -1000, 1755, 12615, -77, -1000, 943, -1000, 935, -1000,
+	-1000, -1000, 19687, 101, 732, 7232, 1496, 7232, 1490, 102,
+	1489, -1000, -1000, -1000, -1000, -1000, 726, 340, 1017, -1000,
+	-1000, -1000, -1000, -1000, 1548, 1517, 1925, -1000, -1000, -1000,
+	-1000, 123, 123, 123, 123, 5, 717, -1000, 1003, -1000,
+	19687, -1000, 1223, -1000, -1000, -1000, 373, -1000, -1000, -1000,
+	-1000, 1488, 1890, -1000, 1269, 19687, 998, 19687, 1487, 453,
+	7232, 564, -1000, 127, -1000, 1932, -1000, 1929, 478, 478,
+	-1000, -1000, -1000, 1297, -1000, 450, -1000, 14383, 19687, -1000,
+	205, 115, -1000, 1215, -1000, 1205, 19687, 716, 798, 1130,
+	-1000, -1000, -1000, -1000, 745, 139, -1000, 19687, 4514, -1000,
+	372, 1190, -1000, 1001, 96, -1000, -1000, 1184, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 747, 19687, -1000, 205,
+	1745, -1000, 686, -1000, -1000, -1000, 21333, 200, -1000, -1000,
+	21333, 100, -1000, 192, -1000, -1000, 1170, -1000, 939, 365,
+	-1000, 100, 21187, 4062, -1000, -1000, 21187, 1162, 1125, -1000,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 100, 2119, 2116, 109, 107, 2115, 2113, 2112, 2111,
-	2110, 2105, 2104, 2103, 2101, 2098, 2096, 2095, 2094, 2092,
-	2091, 2090, 2088, 2087, 2086, 2083, 2082, 2080, 2065, 2063,
-	2062, 2061, 2060, 105, 2059, 2058, 2057, 2056, 2055, 2054,
-	130, 2052, 2051, 2044, 2043, 2042, 2041, 2039, 2037, 2036,
-	124, 46, 101, 721, 66, 185, 163, 122, 2035, 85,
-	170, 2034, 2033, 28, 116, 2032, 123, 121, 87, 145,
-	91, 86, 57, 2031, 2030, 2029, 135, 2026, 2025, 2024,
-	2023, 53, 2022, 72, 35, 29, 2021, 77, 2020, 2015,
-	2013, 2012, 2011, 75, 2010, 64, 43, 2008, 2007, 2006,
-	1992, 1991, 32, 1989, 45, 1988, 1987, 1986, 1985, 1983,
-	1982, 1981, 15, 17, 19, 1980, 1979, 16, 3, 1977,
-	138, 80, 76, 94, 1976, 180, 1975, 1974, 1972, 148,
-	1971, 117, 1970, 1969, 1968, 1967, 9, 1966, 42, 1965,
-	1964, 1963, 44, 1961, 1960, 1959, 89, 36, 71, 88,
-	1958, 1954, 1939, 137, 20, 54, 0, 131, 39, 1938,
-	128, 134, 1937, 84, 194, 133, 48, 1936, 38, 62,
-	1935, 1934, 1933, 98, 61, 12, 1932, 82, 1930, 90,
-	78, 1929, 97, 1926, 119, 2, 96, 1922, 139, 1916,
-	1915, 1914, 115, 1911, 1910, 55, 113, 1909, 1908, 1906,
-	31, 1905, 33, 21, 1904, 129, 146, 1903, 1902, 1901,
-	120, 95, 74, 1899, 1897, 65, 1896, 112, 68, 125,
-	1895, 728, 1891, 111, 63, 18, 1888, 140, 1886, 244,
-	156, 126, 1885, 1884, 149, 1601, 143, 1883, 155, 10,
-	1882, 1881, 11, 1879, 25, 1878, 1877, 1876, 1875, 6,
-	1874, 1873, 1872, 1, 5, 1870, 4, 114, 1869, 51,
-	56, 1868, 1866, 58, 1865, 1864, 1863, 1862, 1860, 162,
-	1859, 1845, 1844, 1843, 1842, 1839, 1818, 81, 1813, 1811,
-	1810, 1808, 60, 1807, 1806, 1805, 1804, 1803, 30, 1802,
-	1800, 22, 1798, 26, 1797, 1796, 1795, 13, 1794, 1793,
-	14, 1792, 1790, 7, 8, 1789, 1761, 52, 34, 37,
-	70, 69, 1760, 23, 1759, 92, 1757, 1756, 102, 1755,
-	99, 1754, 1753, 144, 161, 1752, 136, 1751, 1750, 1749,
-	1748, 1747, 1745, 1744, 1743, 1741, 132, 1737,
-}
-
-//line mysql_sql.y:6495
+	0, 111, 2234, 2233, 117, 115, 2232, 2231, 2230, 2229,
+	2228, 2225, 2224, 2222, 2219, 2218, 2216, 2215, 2213, 2212,
+	2211, 2206, 2205, 2204, 2203, 2202, 2201, 2200, 2199, 2194,
+	2192, 2191, 2190, 2187, 2185, 2184, 113, 2182, 2181, 2180,
+	2179, 2177, 2176, 148, 2173, 2172, 2171, 2170, 2169, 2168,
+	2166, 2165, 2164, 2163, 136, 48, 97, 773, 60, 252,
+	151, 110, 2162, 58, 204, 2161, 2160, 25, 127, 2159,
+	166, 160, 106, 157, 93, 105, 69, 2158, 124, 2157,
+	145, 2156, 2155, 2154, 2146, 62, 2145, 79, 50, 31,
+	2142, 80, 2138, 2136, 2135, 2134, 2128, 85, 82, 71,
+	43, 2127, 2126, 2125, 2123, 2122, 33, 2121, 47, 2120,
+	2119, 2118, 2116, 2115, 2114, 2113, 14, 18, 20, 2112,
+	2111, 17, 2, 2110, 108, 101, 53, 72, 2109, 255,
+	2106, 2105, 2104, 2103, 170, 2102, 322, 2101, 2100, 2096,
+	2094, 9, 2093, 42, 2092, 2089, 2088, 52, 2087, 2085,
+	2084, 112, 41, 74, 107, 2083, 2082, 343, 150, 24,
+	121, 0, 139, 40, 2081, 143, 132, 2080, 102, 312,
+	123, 56, 2078, 44, 70, 2077, 2076, 2074, 95, 67,
+	103, 2073, 86, 2072, 51, 83, 2071, 100, 2070, 128,
+	1, 133, 30, 27, 2069, 2067, 2065, 125, 2064, 2063,
+	59, 126, 2062, 2061, 2059, 34, 2058, 35, 21, 2056,
+	165, 161, 2055, 158, 2053, 129, 109, 81, 2052, 2051,
+	73, 2050, 122, 75, 131, 2049, 780, 120, 65, 19,
+	2048, 16, 159, 2047, 274, 153, 135, 2046, 2045, 168,
+	1746, 162, 2044, 144, 10, 2043, 2042, 11, 2039, 26,
+	2038, 2037, 2036, 2035, 6, 2034, 2033, 2030, 3, 5,
+	2027, 4, 104, 2026, 37, 63, 2025, 2024, 66, 2023,
+	64, 2019, 2018, 2016, 315, 2015, 2013, 2012, 2011, 2010,
+	2009, 2008, 68, 36, 142, 2007, 2006, 98, 2004, 2003,
+	1997, 1994, 119, 1993, 1992, 1988, 32, 1986, 1985, 22,
+	1982, 29, 1981, 1980, 1979, 13, 1978, 1977, 1976, 130,
+	12, 1975, 1974, 7, 8, 1973, 1972, 57, 38, 39,
+	77, 76, 1971, 23, 1969, 92, 1968, 1967, 94, 15,
+	96, 1963, 1958, 152, 182, 1957, 149, 1956, 1955, 1954,
+	1953, 1952, 1951, 1949, 1948, 1947, 1946, 138, 1945,
+}
+
+//line mysql_sql.y:6734
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3613,6 +3819,11 @@ func (st *yySymType) objectTypeUnion() tree.ObjectType {
 	return v
 }
 
+func (st *yySymType) optimizerHintsUnion() *tree.OptimizerHints {
+	v, _ := st.union.(*tree.OptimizerHints)
+	return v
+}
+
 func (st *yySymType) orderUnion() *tree.Order {
 	v, _ := st.union.(*tree.Order)
 	return v
@@ -3798,6 +4009,11 @@ func (st *yySymType) tableExprsUnion() tree.TableExprs {
 	return v
 }
 
+func (st *yySymType) tableFunctionUnion() *tree.TableFunction {
+	v, _ := st.union.(*tree.TableFunction)
+	return v
+}
+
 func (st *yySymType) tableNameUnion() *tree.TableName {
 	v, _ := st.union.(*tree.TableName)
 	return v
@@ -3913,6 +4129,11 @@ func (st *yySymType) varExprUnion() *tree.VarExpr {
 	return v
 }
 
+func (st *yySymType) varExprsUnion() []*tree.VarExpr {
+	v, _ := st.union.([]*tree.VarExpr)
+	return v
+}
+
 func (st *yySymType) whenClauseUnion() *tree.When {
 	v, _ := st.union.(*tree.When)
 	return v
@@ -3939,245 +4160,254 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 334, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 345, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 48, 306, 306, 305, 305, 304, 304, 303, 303,
-	303, 302, 302, 302, 301, 301, 300, 300, 298, 298,
-	299, 297, 296, 296, 294, 294, 292, 292, 293, 293,
-	287, 287, 290, 290, 288, 288, 288, 288, 291, 286,
-	286, 286, 285, 285, 47, 47, 47, 224, 224, 46,
-	46, 238, 238, 238, 238, 238, 236, 236, 236, 236,
-	235, 235, 234, 234, 239, 239, 237, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 41,
-	41, 41, 41, 44, 45, 232, 232, 232, 232, 232,
-	233, 233, 233, 42, 43, 43, 223, 223, 228, 228,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 222, 222, 231, 231, 231, 230, 230, 229,
-	229, 35, 35, 35, 38, 37, 221, 221, 221, 221,
-	221, 221, 221, 221, 36, 36, 36, 36, 36, 36,
-	34, 34, 33, 220, 220, 219, 40, 40, 40, 40,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 159,
-	159, 159, 325, 325, 326, 327, 328, 328, 328, 49,
-	7, 32, 32, 269, 269, 170, 170, 171, 171, 169,
-	169, 169, 169, 169, 169, 272, 273, 166, 21, 21,
-	21, 21, 21, 21, 21, 21, 21, 21, 31, 335,
-	335, 335, 29, 30, 268, 268, 268, 28, 27, 26,
-	25, 25, 24, 23, 23, 163, 163, 165, 165, 161,
-	336, 336, 244, 244, 164, 164, 22, 22, 162, 162,
-	143, 160, 160, 160, 6, 8, 8, 8, 8, 8,
-	13, 12, 11, 10, 9, 5, 4, 276, 276, 276,
-	276, 276, 276, 314, 314, 314, 315, 75, 75, 70,
-	70, 277, 277, 186, 316, 316, 284, 284, 283, 283,
-	282, 282, 73, 73, 74, 74, 62, 62, 50, 50,
-	289, 289, 289, 289, 295, 295, 266, 266, 109, 109,
-	139, 139, 140, 140, 51, 51, 52, 52, 52, 52,
-	52, 52, 322, 322, 324, 324, 323, 72, 72, 68,
-	68, 69, 69, 69, 67, 67, 66, 65, 65, 64,
-	63, 63, 63, 54, 54, 53, 53, 53, 53, 53,
-	125, 125, 125, 55, 55, 330, 330, 330, 270, 270,
-	270, 275, 275, 122, 122, 123, 123, 121, 121, 56,
-	56, 57, 57, 57, 57, 120, 120, 119, 58, 58,
-	59, 59, 61, 61, 61, 61, 130, 130, 129, 129,
-	129, 129, 78, 78, 128, 127, 127, 127, 77, 77,
-	76, 76, 71, 71, 60, 60, 126, 337, 337, 124,
-	152, 152, 152, 158, 158, 151, 151, 151, 151, 151,
-	157, 157, 153, 153, 154, 154, 154, 3, 3, 3,
-	16, 16, 16, 16, 20, 20, 332, 332, 14, 217,
-	217, 216, 216, 218, 218, 218, 218, 212, 212, 213,
-	213, 213, 213, 214, 214, 214, 215, 215, 215, 215,
-	211, 211, 210, 208, 208, 208, 209, 209, 209, 209,
-	209, 209, 155, 155, 15, 205, 205, 206, 206, 206,
-	207, 207, 199, 199, 199, 199, 19, 203, 203, 204,
-	204, 204, 204, 204, 200, 200, 202, 202, 198, 198,
-	198, 198, 198, 18, 197, 197, 195, 195, 193, 193,
-	194, 194, 192, 192, 192, 196, 196, 17, 271, 271,
-	240, 240, 243, 243, 250, 250, 251, 251, 249, 249,
-	256, 256, 255, 255, 254, 254, 253, 253, 252, 252,
-	247, 247, 246, 246, 241, 241, 241, 241, 241, 242,
-	242, 245, 245, 248, 248, 100, 100, 101, 101, 101,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 312,
-	312, 313, 103, 103, 103, 107, 107, 107, 107, 107,
-	107, 102, 102, 102, 104, 104, 104, 85, 85, 84,
-	84, 79, 79, 80, 80, 81, 81, 82, 82, 83,
-	83, 83, 83, 83, 83, 226, 226, 310, 310, 311,
-	311, 307, 307, 307, 309, 309, 309, 309, 309, 308,
-	308, 86, 137, 137, 137, 156, 156, 156, 136, 136,
-	136, 99, 99, 98, 98, 96, 96, 96, 96, 96,
-	96, 96, 96, 96, 96, 96, 96, 96, 225, 225,
-	167, 167, 168, 168, 117, 115, 115, 116, 116, 116,
-	116, 113, 114, 112, 112, 112, 112, 112, 111, 111,
-	110, 110, 110, 201, 201, 108, 108, 106, 106, 106,
-	105, 105, 105, 257, 175, 175, 175, 175, 175, 175,
-	175, 175, 175, 175, 175, 175, 175, 177, 177, 177,
-	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
-	177, 177, 177, 177, 177, 177, 177, 178, 178, 183,
-	183, 321, 321, 320, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 95, 95, 95, 135, 135, 135, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 281, 281, 281, 132, 132, 132, 132, 132, 132,
-	132, 132, 132, 132, 132, 132, 333, 333, 333, 317,
-	317, 318, 318, 318, 318, 318, 318, 318, 318, 318,
-	318, 318, 318, 319, 319, 319, 319, 319, 319, 319,
-	319, 319, 319, 319, 319, 319, 319, 319, 319, 319,
-	134, 134, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 187, 187, 188, 188, 278, 278,
-	278, 278, 278, 278, 279, 279, 280, 280, 280, 280,
-	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
-	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
-	274, 274, 274, 274, 274, 274, 274, 274, 176, 176,
-	176, 176, 131, 131, 131, 189, 184, 184, 185, 185,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 181,
-	181, 181, 181, 181, 173, 173, 174, 174, 174, 174,
-	174, 174, 174, 174, 174, 180, 180, 182, 182, 191,
-	191, 191, 190, 190, 190, 190, 190, 190, 190, 97,
-	97, 97, 97, 258, 172, 172, 172, 172, 172, 172,
-	172, 172, 88, 88, 88, 88, 92, 92, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 94, 93, 93, 93, 93, 91, 91, 91, 91,
-	91, 89, 89, 89, 89, 89, 89, 89, 89, 89,
-	89, 89, 89, 89, 89, 89, 90, 138, 138, 259,
-	259, 262, 262, 260, 260, 261, 263, 263, 263, 264,
-	264, 264, 265, 265, 265, 267, 267, 142, 142, 142,
-	148, 148, 141, 141, 149, 149, 150, 150, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 329, 329, 329, 331, 331,
+	1, 1, 51, 316, 316, 315, 315, 314, 314, 313,
+	313, 313, 312, 312, 312, 311, 311, 310, 310, 306,
+	306, 307, 305, 304, 304, 302, 302, 300, 300, 301,
+	301, 295, 295, 298, 298, 296, 296, 296, 296, 299,
+	294, 294, 294, 290, 290, 50, 50, 50, 228, 228,
+	49, 49, 243, 243, 243, 243, 243, 241, 241, 241,
+	241, 240, 240, 239, 239, 244, 244, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	44, 44, 44, 44, 47, 48, 237, 237, 237, 237,
+	237, 238, 238, 238, 45, 46, 46, 227, 227, 233,
+	233, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 236, 236, 236, 235, 235, 234,
+	234, 38, 38, 38, 41, 40, 226, 226, 226, 226,
+	226, 226, 226, 226, 39, 39, 39, 39, 39, 39,
+	37, 37, 36, 225, 225, 224, 43, 43, 43, 43,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 164,
+	164, 164, 335, 335, 336, 337, 338, 338, 338, 52,
+	53, 291, 291, 291, 7, 7, 35, 34, 34, 274,
+	274, 175, 175, 176, 176, 174, 174, 174, 174, 174,
+	174, 277, 278, 171, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 31, 346, 346, 346,
+	29, 30, 273, 273, 273, 28, 27, 26, 32, 33,
+	33, 33, 25, 25, 24, 23, 23, 168, 168, 170,
+	170, 166, 347, 347, 249, 249, 169, 169, 22, 22,
+	167, 167, 148, 165, 165, 165, 6, 8, 8, 8,
+	8, 8, 13, 12, 11, 10, 9, 5, 4, 293,
+	293, 281, 281, 281, 281, 281, 281, 324, 324, 324,
+	325, 79, 79, 74, 74, 282, 282, 191, 326, 326,
+	289, 289, 288, 288, 287, 287, 77, 77, 78, 78,
+	66, 66, 292, 292, 54, 54, 297, 297, 297, 297,
+	303, 303, 271, 271, 113, 113, 144, 144, 145, 145,
+	55, 55, 56, 56, 56, 56, 56, 56, 332, 332,
+	334, 334, 333, 76, 76, 72, 72, 73, 73, 73,
+	71, 71, 70, 69, 69, 68, 67, 67, 67, 58,
+	58, 57, 57, 57, 57, 57, 129, 129, 129, 59,
+	59, 309, 309, 308, 308, 341, 341, 340, 340, 340,
+	275, 275, 275, 280, 280, 126, 126, 127, 127, 125,
+	125, 60, 60, 61, 61, 61, 61, 124, 124, 123,
+	62, 62, 63, 63, 65, 65, 65, 65, 135, 135,
+	134, 134, 134, 134, 82, 82, 133, 132, 132, 132,
+	81, 81, 80, 80, 75, 75, 64, 64, 64, 131,
+	130, 130, 348, 348, 128, 157, 157, 157, 163, 163,
+	156, 156, 156, 156, 156, 162, 162, 158, 158, 159,
+	159, 159, 3, 3, 3, 16, 16, 16, 16, 20,
+	20, 343, 343, 14, 222, 222, 221, 221, 223, 223,
+	223, 223, 217, 217, 218, 218, 218, 218, 219, 219,
+	219, 220, 220, 220, 220, 216, 216, 215, 213, 213,
+	213, 214, 214, 214, 214, 214, 214, 160, 160, 15,
+	210, 210, 211, 211, 211, 212, 212, 204, 204, 204,
+	204, 19, 208, 208, 209, 209, 209, 209, 209, 205,
+	205, 207, 207, 203, 203, 203, 203, 203, 18, 202,
+	202, 200, 200, 198, 198, 199, 199, 197, 197, 197,
+	201, 201, 17, 276, 276, 245, 245, 248, 248, 255,
+	255, 256, 256, 254, 254, 261, 261, 260, 260, 259,
+	259, 258, 258, 257, 257, 257, 257, 252, 252, 251,
+	251, 246, 246, 246, 246, 246, 247, 247, 250, 250,
+	253, 253, 104, 104, 105, 105, 105, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 322, 322, 323,
+	107, 107, 107, 111, 111, 111, 111, 111, 111, 106,
+	106, 106, 108, 108, 108, 89, 89, 88, 88, 83,
+	83, 84, 84, 85, 85, 86, 86, 87, 87, 87,
+	87, 87, 87, 230, 230, 320, 320, 321, 321, 317,
+	317, 317, 319, 319, 319, 319, 319, 318, 318, 90,
+	142, 142, 142, 161, 161, 161, 141, 141, 141, 103,
+	103, 102, 102, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	231, 231, 231, 229, 229, 172, 172, 173, 173, 121,
+	119, 119, 120, 120, 120, 120, 117, 118, 116, 116,
+	116, 116, 116, 115, 115, 114, 114, 114, 206, 206,
+	112, 112, 110, 110, 110, 109, 109, 109, 262, 180,
+	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 183, 183, 188, 188, 331, 331, 330,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	99, 99, 99, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 286, 286,
+	286, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 344, 344, 344, 327, 327, 328, 328,
+	328, 328, 328, 328, 328, 328, 328, 328, 328, 328,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 139, 139, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 192, 192, 193, 193, 283, 283, 283, 283, 283,
+	283, 284, 284, 285, 285, 285, 285, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 181, 181, 181, 181, 181,
+	136, 136, 136, 194, 189, 189, 190, 190, 184, 184,
+	184, 184, 184, 184, 184, 184, 184, 186, 186, 186,
+	186, 186, 178, 178, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 185, 185, 187, 187, 196, 196, 196,
+	195, 195, 195, 195, 195, 195, 195, 101, 101, 101,
+	101, 263, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 92, 92, 92, 92, 96, 96, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 97, 97, 97, 97, 95, 95, 95, 95,
+	95, 93, 93, 93, 93, 93, 93, 93, 93, 93,
+	93, 93, 93, 93, 93, 93, 94, 143, 143, 264,
+	264, 267, 267, 265, 265, 266, 268, 268, 268, 269,
+	269, 269, 270, 270, 270, 272, 272, 147, 147, 147,
+	153, 153, 146, 146, 154, 154, 155, 155, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 339, 339, 339, 342, 342,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 3, 4, 4, 4, 3, 2, 4, 4, 2,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 14, 0, 2, 1, 3, 3, 3, 1,
+	3, 5, 0, 2, 3, 1, 3, 1, 1, 1,
+	1, 1, 1, 0, 3, 0, 3, 0, 3, 0,
+	3, 0, 2, 1, 2, 3, 4, 3, 3, 1,
+	0, 1, 1, 0, 1, 9, 4, 7, 0, 3,
+	7, 4, 1, 3, 3, 3, 1, 0, 1, 1,
+	1, 1, 3, 1, 4, 1, 3, 1, 2, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 1, 2, 1, 2, 2, 1, 1, 1,
+	3, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 3, 6, 3, 1, 1, 1,
+	1, 1, 1, 1, 2, 4, 6, 1, 4, 1,
+	3, 3, 3, 4, 4, 4, 3, 3, 2, 4,
+	4, 2, 2, 2, 1, 1, 1, 1, 1, 1,
 	3, 1, 1, 1, 2, 2, 0, 4, 2, 4,
 	1, 5, 3, 2, 1, 2, 2, 4, 4, 5,
 	2, 1, 7, 1, 3, 3, 1, 1, 1, 1,
 	2, 3, 4, 7, 2, 3, 3, 4, 5, 1,
 	1, 1, 1, 3, 2, 1, 1, 1, 1, 6,
-	1, 7, 9, 0, 2, 0, 1, 1, 2, 2,
-	2, 1, 4, 2, 2, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
-	1, 1, 5, 5, 0, 1, 1, 2, 2, 3,
-	6, 7, 4, 7, 8, 0, 2, 0, 2, 2,
-	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
-	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	4, 4, 6, 4, 4, 6, 4, 2, 1, 5,
-	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
-	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
-	1, 1, 0, 4, 1, 3, 2, 1, 0, 8,
-	0, 4, 7, 4, 0, 2, 0, 2, 0, 2,
-	0, 4, 1, 3, 1, 1, 4, 3, 4, 5,
-	4, 5, 2, 3, 1, 3, 6, 0, 3, 0,
-	1, 2, 4, 4, 0, 1, 3, 1, 3, 2,
-	0, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 2, 7, 7, 1, 1, 1, 0, 1,
-	1, 1, 1, 0, 2, 0, 3, 0, 2, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 3,
-	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
-	2, 3, 0, 2, 1, 1, 2, 2, 0, 1,
-	2, 4, 1, 3, 1, 4, 3, 0, 1, 2,
-	0, 1, 2, 1, 1, 0, 1, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 8, 11, 0, 1, 6, 0,
-	2, 1, 2, 2, 2, 2, 2, 0, 1, 2,
-	2, 2, 2, 1, 3, 2, 2, 2, 2, 2,
-	1, 3, 2, 1, 3, 2, 0, 3, 3, 5,
-	5, 4, 1, 1, 4, 1, 3, 1, 3, 2,
-	1, 1, 0, 1, 1, 1, 11, 0, 2, 3,
-	2, 3, 1, 1, 1, 3, 3, 4, 0, 2,
-	2, 2, 2, 5, 1, 1, 0, 3, 0, 1,
-	1, 2, 4, 4, 4, 0, 1, 10, 0, 1,
-	0, 6, 0, 4, 0, 3, 1, 3, 4, 5,
-	0, 3, 1, 3, 2, 3, 1, 2, 0, 6,
-	0, 2, 0, 2, 4, 5, 4, 5, 1, 6,
-	5, 0, 3, 0, 1, 0, 1, 1, 3, 2,
-	3, 3, 4, 4, 3, 3, 3, 3, 4, 4,
+	4, 0, 1, 1, 1, 1, 6, 7, 9, 0,
+	2, 0, 1, 1, 2, 2, 2, 1, 4, 2,
+	2, 3, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 5, 1, 1, 1,
+	5, 5, 0, 1, 1, 2, 2, 3, 5, 2,
+	5, 4, 6, 7, 4, 7, 8, 0, 2, 0,
+	2, 2, 1, 1, 1, 1, 0, 1, 4, 5,
+	1, 3, 1, 1, 3, 5, 1, 1, 1, 1,
+	1, 1, 4, 4, 6, 4, 4, 6, 5, 0,
+	1, 2, 1, 5, 4, 4, 2, 0, 1, 3,
+	3, 1, 3, 1, 3, 1, 3, 4, 0, 1,
+	0, 1, 1, 3, 1, 1, 0, 4, 1, 3,
+	2, 1, 0, 2, 0, 8, 0, 4, 7, 4,
+	0, 2, 0, 2, 0, 2, 0, 4, 1, 3,
+	1, 1, 5, 3, 4, 6, 4, 5, 2, 3,
+	1, 3, 6, 0, 3, 0, 1, 2, 4, 4,
+	0, 1, 3, 1, 3, 2, 0, 1, 1, 3,
+	3, 1, 3, 3, 3, 3, 1, 2, 2, 9,
+	9, 0, 2, 1, 3, 0, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 0, 2, 0, 3, 0,
+	2, 1, 3, 1, 2, 3, 5, 0, 1, 2,
+	1, 3, 1, 1, 4, 4, 4, 3, 2, 2,
+	2, 3, 2, 3, 0, 2, 1, 1, 2, 2,
+	0, 1, 2, 4, 1, 3, 1, 4, 3, 4,
+	3, 4, 0, 1, 2, 0, 1, 2, 1, 1,
+	0, 1, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 8,
+	11, 0, 1, 6, 0, 2, 1, 2, 2, 2,
+	2, 2, 0, 1, 2, 2, 2, 2, 1, 3,
+	2, 2, 2, 2, 2, 1, 3, 2, 1, 3,
+	2, 0, 3, 3, 5, 5, 4, 1, 1, 4,
+	1, 3, 1, 3, 2, 1, 1, 0, 1, 1,
+	1, 11, 0, 2, 3, 2, 3, 1, 1, 1,
+	3, 3, 4, 0, 2, 2, 2, 2, 5, 1,
+	1, 0, 3, 0, 1, 1, 2, 4, 4, 4,
+	0, 1, 10, 0, 1, 0, 6, 0, 4, 0,
+	3, 1, 3, 4, 5, 0, 3, 1, 3, 2,
+	3, 1, 2, 0, 6, 4, 6, 0, 2, 0,
+	2, 4, 5, 4, 5, 1, 6, 5, 0, 3,
+	0, 1, 0, 1, 1, 3, 2, 3, 3, 4,
+	4, 3, 3, 3, 3, 4, 4, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 4, 5, 4, 8, 1, 3, 3,
+	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 3, 0,
+	1, 1, 3, 1, 1, 2, 1, 7, 7, 7,
+	7, 8, 5, 0, 1, 0, 1, 1, 1, 1,
+	3, 3, 1, 1, 1, 1, 1, 0, 1, 3,
+	1, 3, 5, 1, 1, 1, 1, 3, 5, 0,
+	1, 1, 2, 1, 2, 2, 1, 1, 2, 2,
+	2, 2, 2, 1, 5, 6, 7, 5, 4, 4,
+	0, 1, 1, 1, 2, 0, 1, 1, 2, 5,
+	0, 1, 1, 1, 2, 2, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
+	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 4, 5, 4, 1,
-	3, 3, 0, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
-	3, 0, 1, 1, 3, 1, 1, 2, 1, 7,
-	7, 7, 7, 8, 5, 0, 1, 0, 1, 1,
-	1, 1, 3, 3, 1, 1, 1, 1, 1, 0,
-	1, 3, 1, 3, 5, 1, 1, 1, 1, 3,
-	5, 0, 1, 1, 2, 1, 2, 2, 1, 1,
-	2, 2, 2, 2, 2, 1, 5, 6, 1, 2,
-	0, 1, 1, 2, 5, 0, 1, 1, 1, 2,
-	2, 3, 3, 1, 1, 2, 2, 2, 0, 1,
-	2, 2, 2, 0, 3, 0, 3, 1, 1, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 1, 1, 1, 1,
-	3, 5, 2, 2, 2, 2, 1, 1, 2, 5,
-	6, 6, 6, 1, 1, 1, 1, 0, 2, 0,
-	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
-	2, 2, 2, 0, 1, 1, 5, 4, 4, 5,
-	5, 5, 5, 4, 5, 5, 5, 5, 5, 5,
-	5, 1, 1, 1, 4, 4, 6, 8, 6, 4,
-	5, 5, 4, 6, 6, 7, 1, 1, 1, 1,
+	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
+	3, 2, 1, 1, 2, 5, 6, 6, 6, 1,
+	1, 1, 1, 0, 2, 0, 1, 1, 2, 4,
+	1, 2, 2, 2, 1, 2, 2, 2, 2, 2,
+	0, 1, 1, 5, 4, 4, 5, 5, 5, 5,
+	4, 5, 5, 5, 5, 5, 5, 5, 1, 1,
+	1, 4, 4, 6, 8, 6, 4, 5, 5, 4,
+	6, 6, 7, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 4,
+	2, 2, 4, 6, 2, 2, 4, 6, 4, 2,
+	2, 0, 1, 2, 3, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 4, 2, 2, 4, 6, 2, 2, 4,
-	6, 4, 2, 2, 0, 1, 2, 3, 1, 1,
-	1, 1, 1, 1, 0, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 3, 3, 3, 4,
+	0, 1, 1, 3, 0, 1, 1, 3, 3, 3,
+	3, 2, 3, 4, 3, 4, 1, 3, 4, 3,
+	4, 1, 1, 1, 3, 4, 4, 5, 3, 4,
+	5, 6, 1, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 3,
-	3, 4, 0, 1, 1, 3, 0, 1, 1, 3,
-	3, 3, 3, 2, 3, 4, 3, 4, 1, 3,
-	4, 3, 4, 1, 1, 1, 3, 4, 4, 5,
-	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 3, 1, 1, 1, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 2, 2, 2, 2, 1, 2, 2, 2,
@@ -4221,467 +4451,488 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -334, -2, -1, -3, -4, -5, -6, -39, -21,
-	-7, -49, -33, -34, -35, -41, -46, -47, -48, -51,
-	-16, -15, -14, 8, 10, -8, -159, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, -32, 185, 9,
-	49, -36, -37, -38, -42, -43, -44, -45, 293, 299,
-	336, -52, -54, -17, -18, -19, -20, 181, -9, -10,
-	-11, -12, -13, 203, 202, 26, 201, 182, 120, 121,
-	123, 124, 30, -53, -322, 54, 183, -55, 407, 6,
-	452, -62, 27, -84, -156, 57, -144, -147, 410, 411,
-	412, 413, 414, 415, 416, 417, 418, 419, 420, 421,
-	422, 423, 424, 425, 426, 427, 428, 429, 430, 431,
-	432, 433, 434, 435, 436, 437, 438, 439, 440, 441,
-	442, 443, 444, 445, 404, 220, 244, 247, 254, 120,
-	137, 131, 159, 151, 152, 128, 224, 225, 64, 123,
-	364, 350, 335, 126, 239, 241, 243, 246, 226, 147,
-	142, 238, 222, 140, 227, 28, 228, 164, 229, 230,
-	409, 346, 275, 368, 352, 251, 141, 347, 242, 349,
-	165, 169, 356, 299, 135, 136, 354, 358, 163, 200,
-	32, 406, 34, 212, 359, 167, 162, 158, 367, 255,
-	253, 161, 134, 157, 223, 38, 171, 170, 172, 329,
-	330, 331, 332, 231, 232, 287, 355, 154, 143, 279,
-	129, 18, 362, 207, 351, 300, 233, 248, 210, 256,
-	166, 168, 214, 219, 408, 252, 360, 139, 211, 240,
-	206, 363, 130, 293, 302, 234, 124, 245, 357, 366,
-	37, 315, 133, 127, 197, 121, 216, 221, 235, 236,
-	237, 258, 257, 249, 155, 213, 160, 132, 156, 122,
-	215, 365, 316, 451, 277, 318, 153, 150, 217, 190,
-	361, 353, 125, 322, 317, 148, 259, 448, 449, 450,
-	288, 274, 260, 261, 11, -160, 19, 333, -40, 334,
-	185, 54, -156, -5, -4, -33, -51, 188, -59, -60,
-	-61, -124, -126, -84, 54, -156, -235, -205, -234, -206,
-	-237, -207, -155, 20, 182, 181, 215, 10, 183, 297,
-	189, 8, 6, 298, 201, 9, 299, 301, 302, 305,
-	306, 307, 31, 310, 311, 57, 60, -156, -235, -205,
-	219, 226, -66, -67, -125, 15, 5, -271, 318, 218,
-	-199, -197, 308, 198, 197, 76, 370, 187, -335, -268,
-	353, 352, -164, 351, 344, 346, 181, 189, 354, 32,
-	356, 357, 347, 318, 125, 122, -221, 80, 130, 129,
-	-221, 218, 29, -228, 328, -227, -229, 146, 356, 357,
-	367, -222, 355, -142, -156, 58, 59, 75, 152, 149,
-	-67, -125, -66, -53, -54, -52, -54, 318, 218, 189,
-	188, 370, -324, 402, -323, -156, -270, -330, 20, -275,
-	178, 179, 180, 21, 22, -1, -73, 210, -84, 119,
-	-59, -136, -156, 335, 89, -40, -40, 334, -325, -326,
-	-327, -329, 185, 334, 333, 119, -84, 30, -127, -128,
-	-129, -130, 41, 45, 47, 42, 43, 44, 48, -337,
-	23, -152, -158, 23, -153, 60, -154, -147, 57, 58,
-	59, -52, -54, 51, 55, 11, 55, 54, 453, 58,
-	295, 309, 318, 296, 308, 190, 218, 309, 218, 344,
-	190, 300, 303, 304, 345, 51, 191, 51, -285, 367,
-	-50, 27, -69, 17, -55, -54, 16, 20, 21, -332,
-	188, 402, -195, 193, -195, 189, -195, -336, 11, 99,
-	217, 216, 348, 345, -244, 349, 350, -164, -163, 97,
-	-164, 188, 370, -269, 193, 360, 407, 128, 129, 130,
-	-232, 20, 29, 327, -205, 218, 55, 89, 19, -230,
-	89, 100, -230, -229, -229, -229, -230, -102, 29, -154,
-	60, 116, -102, 29, 119, 30, 30, -68, -69, -55,
-	-54, -67, -66, -67, 56, 56, -269, -269, -269, -269,
-	-269, 55, -324, -72, 54, -56, -57, 107, -179, -156,
-	81, -181, 57, -174, 410, 411, 412, 413, 414, 415,
-	416, 417, 418, 419, 420, 421, 422, 423, 424, 426,
-	427, 428, 429, 430, 431, 432, 433, 434, 435, 436,
-	437, 438, 439, 440, 441, 442, 443, 444, 445, 287,
-	318, 148, 288, -175, -177, -303, -298, -172, 54, 105,
-	106, 113, 82, -176, -257, 24, 84, 378, -132, -133,
-	-134, -135, -299, -297, 60, 65, 69, 71, 72, 70,
-	67, 144, 118, -54, -317, -145, -274, -280, -278, 149,
-	204, 145, 8, 111, 328, 116, 146, -281, 59, 58,
-	281, 75, 282, 283, 370, 278, 284, 193, 333, 43,
-	285, 286, 289, 377, 290, 44, 291, 280, 208, 292,
-	381, 380, 382, 374, 371, 369, 372, 373, 375, 376,
-	-56, -276, 33, -51, 54, 30, 54, -156, -121, 12,
-	119, 65, 60, -40, 56, 55, -328, 71, 72, -331,
-	163, 155, -156, 54, -220, -219, -136, -60, -60, -60,
-	-60, 41, 41, 41, 46, 41, 46, 41, -129, -156,
-	-158, 56, -236, 188, 294, 214, -234, 215, 299, 302,
-	-211, -210, -208, -155, 60, -206, -239, -136, -155, 345,
-	-236, -211, -210, 337, 447, -50, -179, -156, -65, -64,
-	-179, 190, -195, -211, 81, -205, -154, -156, -84, -163,
-	-163, -165, -336, -161, -336, 345, -121, -177, -244, -162,
-	-156, -195, -211, 318, 24, 361, 362, 126, 129, 128,
-	368, -233, 327, 20, -205, -227, -223, 60, 328, -210,
-	-231, 51, 116, -282, -179, 29, -231, -230, -230, -230,
-	-231, 115, -156, -50, -68, -50, -69, -211, -205, -156,
-	-85, -84, -157, -154, -147, -323, 23, -71, -156, -120,
-	55, -119, 11, -151, 80, 78, 79, -156, 23, 60,
-	119, -179, 96, -190, 89, 90, 91, 92, 93, 94,
-	95, 54, 54, 54, 54, 54, 54, 54, 54, -188,
-	54, 54, 54, 54, 54, 54, 54, -188, 54, 54,
-	54, 54, 54, 102, 101, 112, 105, 106, 107, 108,
-	109, 110, 111, 103, 104, 99, 81, 97, 98, 83,
-	-54, -179, -185, -177, -177, -177, -177, -257, -183, -179,
-	54, 60, 65, -156, 106, 54, 54, 54, -279, 54,
-	-187, -188, 54, 60, 60, 54, 54, 54, -177, 60,
-	54, -120, -277, -186, -316, 446, -75, 56, -70, -156,
-	-314, -315, -70, -74, -156, -67, -179, -149, -150, -141,
-	-146, -153, -154, -147, 276, 186, 20, 80, 23, 25,
-	281, 313, 83, 116, 16, 84, 149, 115, 283, 378,
-	282, 181, 47, 75, 380, 382, 381, 371, 369, 320,
-	324, 326, 323, 370, 344, 29, 10, 26, 202, 21,
-	22, 109, 183, 204, 87, 88, 205, 24, 203, 72,
-	19, 50, 11, 333, 13, 14, 284, 319, 193, 192,
-	99, 337, 189, 45, 8, 118, 27, 96, 321, 41,
-	77, 43, 97, 17, 372, 373, 31, 336, 403, 209,
-	111, 285, 286, 48, 81, 327, 70, 51, 78, 15,
-	46, 98, 184, 377, 44, 218, 325, 289, 291, 402,
-	290, 187, 6, 280, 379, 30, 201, 42, 188, 345,
-	86, 191, 71, 208, 145, 5, 76, 9, 49, 52,
-	374, 375, 376, 33, 85, 12, 292, 407, 328, 338,
-	339, 340, 341, 342, 343, 173, 174, 175, 176, 177,
-	250, 196, 194, 198, 199, 446, 447, 178, 179, 271,
-	273, 146, 19, -40, -326, 119, -71, -121, 55, 89,
-	-77, -76, 51, 52, -78, 51, -76, 41, 41, -72,
-	-238, 107, 57, 55, -209, 319, 453, 58, 56, 55,
-	-238, 191, 60, 60, 55, 18, 119, 55, -63, 25,
-	26, -84, 193, -84, -212, -213, 325, 24, -198, 52,
-	-193, -194, -192, -196, 29, -121, -121, -121, -163, -157,
-	-165, -160, -165, -161, 119, -143, -156, -212, 54, 127,
-	130, 130, 129, -205, 191, 54, 89, -231, -231, -231,
-	29, -155, -50, -50, 51, 55, 54, 56, 55, -121,
-	-57, -58, -59, -179, -179, -179, -156, 60, -156, 107,
-	-173, 81, 274, 70, 71, 72, -174, -191, 20, 260,
-	261, -184, -185, -179, -131, 21, 20, -131, -131, -179,
-	-131, 107, -185, -185, 56, -258, 65, -318, -319, 383,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	285, 280, 286, 284, 278, 292, 287, 288, 148, 400,
-	401, 394, 395, 396, 397, 398, 399, -131, -131, -131,
-	-131, -131, -179, -333, 272, 271, 273, -131, -131, -131,
-	-131, -175, -175, -175, -175, -175, -175, -175, -175, -175,
-	-175, -175, -175, -182, -189, -257, 54, 99, 97, 98,
-	83, -177, -175, -175, 56, 55, -321, -320, 85, -179,
-	-318, -318, 65, -184, -179, -184, -184, 56, -185, -184,
-	-175, -184, -131, -121, 55, 54, 56, 55, 33, 119,
-	55, 89, 56, 55, -68, 119, 335, -156, 56, -67,
-	-219, -179, -179, 54, -179, 11, 119, 119, -210, 16,
-	407, -155, -136, 191, -211, -286, 192, 377, -289, 349,
-	-179, -179, -156, -64, -72, 81, 54, -217, 407, 327,
-	326, 322, -214, -215, 321, 323, 320, 324, 51, 266,
-	267, 268, 269, -192, -142, 115, 229, 152, -121, -163,
-	-163, -165, -156, -217, 56, 130, -211, -166, 60, -223,
-	-84, -84, -1, -156, -123, 13, 55, 119, -173, 274,
-	70, -257, 56, 55, -179, -179, -179, 23, -185, 56,
-	56, 56, 56, 11, -179, -179, -179, -179, -179, 56,
-	11, 11, -179, -179, -179, -179, -179, -185, -182, -177,
-	-175, -175, -180, 205, 80, -179, -178, -320, 87, -179,
-	55, 52, -318, 56, 11, 56, 56, 56, 52, 56,
-	55, 56, -179, -123, -186, -284, -283, -282, 33, -51,
-	-70, -277, -156, -315, -282, -156, -149, -146, -154, -147,
-	65, -68, -71, -211, 107, 107, 57, -155, 328, -155,
-	-211, -224, 407, 27, -295, 343, 338, 340, 119, 23,
-	24, -79, -80, -81, -86, -82, -136, -168, -83, 196,
-	194, 198, -311, 76, 199, 250, 77, 189, -216, -218,
-	329, 330, 331, 332, 80, -215, 60, 60, 60, 60,
-	-84, -148, 89, -148, -148, -121, -121, -163, -170, -171,
-	-169, 276, -272, 328, 319, 56, 56, -122, 14, 16,
-	-59, -156, 107, -179, 56, 56, 56, -87, -93, 116,
-	149, 204, 148, 147, 145, 315, 316, 140, 141, 142,
-	139, 56, -179, 56, 56, 56, 56, 56, -179, -179,
-	11, 56, 56, 56, 56, 56, -180, 80, -177, -174,
-	56, 88, -179, 86, -87, -102, -179, -102, -175, 56,
-	-122, 56, 55, -277, 56, -155, 16, 23, -212, 299,
-	188, -266, 448, -293, 338, 16, 16, -51, -84, 56,
-	55, -88, -92, -89, -91, -90, -94, -93, 149, 150,
-	116, 153, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 30, 204, 145, 146, 147, 148, 165, 131,
-	151, 405, 173, 132, 174, 133, 175, 134, 176, 135,
-	136, 177, 137, -83, -156, 77, -310, -311, -195, -310,
-	77, 54, -218, 65, 65, 65, 65, -215, 54, -102,
-	-104, -154, 60, 116, 60, -121, -169, 277, 31, 118,
-	279, 29, 275, 16, -179, -185, 56, -259, -261, 54,
-	-260, 54, -259, -259, -259, -95, 136, 135, -95, -263,
-	54, -263, -264, 54, -263, 56, 56, 56, -179, -174,
-	-179, 56, 56, 56, 19, 56, 56, -282, -155, -155,
-	-224, 300, -84, -109, 449, 60, 16, 60, -291, 60,
-	-72, -100, -101, -118, 313, 220, -196, 224, 64, 225,
-	335, 226, 189, 228, 229, 230, 200, 231, 232, 233,
-	328, 234, 235, 236, 237, 296, 5, 262, -81, -99,
-	-98, -96, 70, 81, 29, 313, -97, 64, 115, 243,
-	221, 244, -117, -167, 194, 76, 77, 301, -168, -265,
-	316, 315, -259, -260, -260, -259, -259, 54, 54, -259,
-	-262, 54, -259, -259, -307, -308, -156, -308, -156, -307,
-	-307, -195, -179, -200, -202, -136, 54, 65, -273, -166,
-	65, 65, 65, 65, 56, -179, -287, -244, -139, 450,
-	65, 60, 340, 23, -240, 210, 55, -118, -148, -148,
-	-142, 115, -148, -148, -148, -148, 227, 227, -148, -148,
-	-148, -148, -148, -148, -148, -148, -148, -148, -148, -148,
-	-148, -148, 54, -96, 70, -175, 60, -104, -105, 29,
-	242, 238, -106, 29, 222, 223, -108, 54, 250, 77,
-	77, -84, -267, 317, -138, 60, -138, 65, 54, 52,
-	259, 54, 54, 54, -308, 56, 56, 55, -259, -179,
-	278, 56, 56, 56, 55, 56, 55, 56, -294, 343,
-	-290, -288, 338, 339, 340, 341, 54, 16, -51, 16,
-	-118, 65, 65, -148, -148, 65, 60, 60, 60, -148,
-	-148, 65, 60, -158, 65, 65, 65, 65, 29, 60,
-	-107, 29, 238, 242, 239, 240, 241, 65, 29, 65,
-	29, 65, 29, -156, 54, -312, -313, 60, 65, 54,
-	-201, 54, 56, 55, 56, 56, -200, -309, 266, 267,
-	268, 270, 269, -309, -200, -200, -200, 54, -226, -225,
-	251, 81, -203, -202, -63, 56, 65, 65, -296, 192,
-	-292, 342, -288, 16, 340, 16, 16, -140, -156, -291,
-	-241, 252, 253, -242, -248, 255, -102, -102, 60, 60,
-	-103, 221, -85, 56, 55, 89, 56, -179, -111, -110,
-	403, -200, 60, 56, 56, 56, 56, -200, 251, -204,
-	200, 64, 407, 264, 265, -63, 56, 56, -302, 54,
-	65, -293, 16, -291, 16, -291, -291, 56, 55, -246,
-	256, 54, -244, 54, -244, 77, 267, 222, 223, 56,
-	-313, 60, 56, -115, -116, -113, -114, 51, 347, 248,
-	249, 56, -203, -203, -203, -203, 56, -148, 60, 263,
-	-306, 30, 56, -301, -300, -137, -297, -156, 343, 60,
-	-291, -156, -243, 257, 65, -175, 54, -175, 54, -245,
-	254, 54, -225, -114, 51, -113, 51, 10, 9, -117,
-	65, -154, -305, -304, -303, 56, 55, 119, -250, 54,
-	16, 56, -239, 56, -239, 54, 89, -175, -112, 245,
-	246, 30, 129, -112, 55, 89, -300, -156, -251, -249,
-	210, -242, 56, 56, -239, 65, 56, 70, 29, 247,
-	-304, 29, -179, 119, 56, 55, 57, -247, 258, 56,
-	-156, -249, -252, 33, 65, -256, -253, 54, -118, 212,
-	-256, -118, -255, -254, 257, 213, 56, 55, 57, 54,
-	-254, -253, -185, 56,
+	-1000, -345, -2, -1, -3, -4, -5, -6, -42, -21,
+	-7, -52, -53, -36, -37, -38, -44, -49, -50, -51,
+	-55, -16, -15, -14, 8, 10, -8, -164, -22, -23,
+	-24, -25, -26, -32, -33, -27, -28, -29, -30, -31,
+	-34, -35, 192, 231, 9, 49, -39, -40, -41, -45,
+	-46, -47, -48, 303, 309, 346, -56, -58, -17, -18,
+	-19, -20, 188, -9, -10, -11, -12, -13, 210, 209,
+	26, 208, 189, 128, 129, 131, 132, 30, -57, -332,
+	55, 190, -59, 421, 6, 466, -293, 199, 11, -165,
+	19, 343, -43, 344, 192, 55, -161, -5, -4, -36,
+	-55, 58, -149, -152, 424, 425, 426, 427, 428, 429,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 439,
+	440, 441, 442, 443, 444, 445, 446, 447, 448, 449,
+	450, 451, 452, 453, 454, 455, 456, 457, 458, 459,
+	418, 261, 227, 252, 255, 266, 128, 145, 139, 166,
+	158, 159, 136, 231, 232, 65, 131, 378, 360, 345,
+	134, 247, 249, 251, 254, 233, 155, 150, 246, 229,
+	148, 234, 28, 235, 171, 236, 237, 423, 356, 287,
+	382, 362, 259, 149, 357, 250, 359, 172, 176, 370,
+	309, 367, 143, 144, 364, 372, 170, 207, 32, 420,
+	34, 219, 373, 174, 169, 165, 381, 267, 265, 168,
+	142, 164, 230, 38, 178, 177, 179, 339, 340, 341,
+	342, 238, 239, 124, 369, 161, 151, 291, 137, 18,
+	376, 214, 361, 310, 240, 256, 217, 268, 173, 175,
+	221, 366, 365, 226, 422, 368, 264, 374, 147, 218,
+	248, 213, 377, 138, 303, 312, 241, 132, 253, 371,
+	380, 37, 325, 141, 135, 204, 129, 223, 228, 242,
+	243, 244, 245, 270, 269, 257, 162, 220, 167, 140,
+	163, 130, 222, 379, 326, 465, 289, 328, 160, 157,
+	224, 197, 375, 363, 133, 332, 327, 123, 271, 462,
+	463, 464, 125, 286, 272, 273, 195, 195, -63, -64,
+	-65, -128, -130, -131, -88, 55, -161, -161, -240, -210,
+	-239, -211, -242, -212, -160, 20, 189, 188, 222, 10,
+	190, 307, 196, 8, 6, 308, 208, 9, 309, 311,
+	312, 315, 316, 317, 31, 320, 321, 58, 61, -161,
+	-240, -210, 226, 233, -70, -71, -129, 15, 5, -276,
+	328, 225, -204, -202, 318, 205, 204, 79, 384, 194,
+	-346, -273, 363, 362, -169, 365, 367, 361, 354, 356,
+	188, 196, 364, 32, 370, 371, 357, 195, 328, 133,
+	130, -226, 83, 138, 137, -226, 225, 29, -233, 338,
+	-232, -234, 154, 370, 371, 381, -305, 60, 369, -147,
+	-161, 59, 78, 159, 156, -71, -129, -70, -57, -58,
+	-56, -58, 328, 225, 196, 195, 384, -334, 416, -333,
+	-161, -341, 66, -1, -66, 27, -88, -161, -63, -141,
+	-161, 345, 92, -43, -43, 344, -335, -336, -337, -339,
+	192, 344, 343, 127, -88, -89, -88, 30, -132, -133,
+	-134, -135, 41, 45, 47, 42, 43, 44, 48, -348,
+	23, -157, -163, 23, -158, 61, -159, -152, 58, 59,
+	60, -157, -56, 33, -58, 55, 127, 52, 56, 11,
+	56, 55, 467, 59, 305, 319, 328, 306, 318, 197,
+	225, 319, 225, 354, 197, 310, 313, 314, 355, 52,
+	198, 52, -290, 381, -54, 27, -73, 17, -59, -58,
+	16, 20, 21, -343, 195, 416, -200, 200, -200, 196,
+	-200, -347, 11, 102, 224, 223, 358, 355, -249, 359,
+	360, 19, 19, -169, -168, 100, -169, 195, 384, -88,
+	-274, 200, 374, 421, 136, 137, 138, -237, 20, 29,
+	337, -210, 225, 56, 92, 19, -235, 92, 103, -235,
+	-234, -234, -234, -235, -235, -106, 29, -159, 61, 120,
+	-106, 29, 127, 30, 30, -72, -73, -59, -58, -71,
+	-70, -71, 57, 57, -274, -274, -274, -274, -274, 56,
+	-334, -76, 55, -275, -340, 20, -280, 185, 186, 187,
+	21, 22, -77, 217, -88, -125, 12, 127, 68, 61,
+	-43, 57, 56, -338, 74, 75, -342, 170, 162, -161,
+	55, -291, 56, 368, 356, -225, -224, -141, -64, -64,
+	-64, -64, 41, 41, 41, 46, 41, 46, 41, -134,
+	-161, -76, -163, 57, -282, -191, -326, 460, -189, -190,
+	-184, 84, -186, -179, -180, -182, -313, -306, -177, 55,
+	108, 109, 116, 85, -181, -262, 24, 87, 428, 392,
+	-137, -138, -139, -140, -161, -307, -305, 61, 68, 72,
+	74, 75, 73, 70, 69, 71, 152, 126, -58, 58,
+	-327, 436, -150, 456, 437, 455, -279, -285, -283, 156,
+	211, 153, 8, 114, 338, 120, 154, 433, 452, 459,
+	430, 431, 425, 426, 427, 429, 438, 440, 451, -286,
+	447, 457, 458, 60, 450, 449, 434, 435, 441, 424,
+	432, 442, 443, 448, 453, 454, 293, 78, 294, 295,
+	384, 290, 296, 200, 343, 43, 297, 298, 124, 125,
+	299, 391, 300, 44, 301, 292, 215, 328, 302, 123,
+	395, 394, 396, 388, 385, 383, 386, 387, 389, 390,
+	444, 445, 446, -161, -241, 195, 304, 221, -239, 222,
+	309, 312, -216, -215, -213, -160, 61, -211, -244, -141,
+	-160, 355, -241, -216, -215, 347, 461, -54, -184, -69,
+	-68, -184, 197, -200, -216, 84, -210, -159, -161, -88,
+	-168, -168, -170, -347, -166, -347, 366, 395, -213, 355,
+	-125, -182, -249, -167, -161, -200, 190, -216, 328, 24,
+	375, 376, 134, 137, 136, 382, -238, 337, 20, -210,
+	-232, -227, 61, 338, -215, -236, 52, 120, -287, -184,
+	29, -236, -235, -235, -235, -236, -236, 119, -161, -54,
+	-72, -54, -73, -216, -210, -161, -89, -162, -159, -152,
+	-333, 23, -75, -161, -60, -61, 110, -184, -161, -60,
+	-281, 33, -55, 55, 30, 55, -71, -184, -154, -155,
+	-146, -151, -158, -159, -152, 288, 193, 20, 83, 23,
+	25, 293, 323, 86, 120, 16, 87, 156, 119, 295,
+	392, 294, 188, 47, 78, 394, 396, 395, 385, 383,
+	330, 334, 336, 333, 384, 354, 29, 10, 26, 209,
+	21, 22, 112, 190, 211, 90, 91, 212, 24, 210,
+	75, 19, 50, 11, 343, 13, 14, 296, 329, 200,
+	199, 102, 347, 196, 45, 8, 126, 27, 99, 331,
+	41, 80, 43, 100, 17, 386, 387, 31, 346, 417,
+	216, 114, 297, 298, 48, 84, 337, 73, 52, 81,
+	15, 46, 101, 191, 391, 44, 225, 335, 299, 301,
+	416, 300, 194, 6, 292, 393, 30, 208, 42, 195,
+	355, 89, 198, 74, 215, 153, 5, 79, 9, 49,
+	53, 388, 389, 390, 33, 88, 12, 302, 421, 338,
+	348, 349, 350, 351, 352, 353, 180, 181, 182, 183,
+	184, 258, 203, 201, 205, 206, 460, 461, 185, 186,
+	283, 285, 154, 260, 262, 263, 19, -43, -336, 127,
+	-75, -88, -125, 56, 92, -81, -80, 52, 53, -82,
+	52, -80, 41, 41, -76, 56, 57, 55, 57, 56,
+	83, 81, 82, -184, 99, -195, 92, 93, 94, 95,
+	96, 97, 98, 105, 104, 115, 108, 109, 110, 111,
+	112, 113, 114, 106, 107, 102, 84, 100, 101, 86,
+	119, -58, -184, -190, -182, -182, -182, -182, -262, -188,
+	-184, 55, 55, 127, 61, 68, -141, 109, 55, 55,
+	55, 55, 55, 55, 55, 55, -284, 55, -192, -193,
+	55, 55, 61, 61, 55, 55, 55, -182, 61, -193,
+	-193, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, -243, 110, 58, 56, -214,
+	329, 467, 59, 57, 56, -243, 198, 61, 61, 56,
+	18, 56, -67, 25, 26, -88, 200, -88, -217, -218,
+	335, 24, -203, 53, -198, -199, -197, -201, 29, -125,
+	-125, -125, -168, -162, -170, -165, 68, -284, -170, -166,
+	127, -148, -161, 217, -217, 55, 135, 138, 138, 137,
+	-210, 198, 55, 92, -236, -236, -236, 29, -160, -292,
+	19, -54, -54, 52, 55, 57, 56, -309, 56, 67,
+	-156, -161, 23, 61, 127, -309, -282, -79, 57, -74,
+	-161, -324, -325, -74, -78, -161, -72, 127, 345, -161,
+	57, -71, -224, -184, -184, 55, -184, -191, -289, -288,
+	-287, -184, -184, -184, -184, -178, 84, 286, 73, 74,
+	75, -179, -196, 20, 272, 273, -180, -180, -180, -180,
+	-180, -180, -180, -180, -180, -180, -180, -180, -187, -194,
+	-262, 55, 102, 100, 101, 86, -182, -180, -180, -108,
+	-159, 61, 120, 57, 56, -331, -330, 88, -184, -184,
+	-161, -328, -329, 397, 398, 399, 400, 401, 402, 403,
+	404, 405, 406, 407, 297, 292, 298, 296, 290, 302,
+	124, 125, 123, 414, 415, 408, 409, 410, 411, 412,
+	413, -328, -328, 68, -189, -189, -184, -328, -189, -136,
+	21, 20, -136, -184, -344, 284, 283, 285, -189, 57,
+	57, -263, 68, -190, -189, -180, -189, -136, -190, -190,
+	-136, -136, -136, -136, 110, -136, -136, -136, -136, -136,
+	-136, -136, 11, 127, 127, -215, 16, 421, -160, -141,
+	198, -216, -294, 199, 391, -297, 359, -184, -184, -68,
+	-76, 84, 55, -222, 421, 337, 336, 332, -219, -220,
+	331, 333, 330, 334, 52, 278, 279, 280, 281, -197,
+	-147, 119, 236, 159, -125, -168, -168, -170, -161, -78,
+	-222, 57, 138, -216, -171, 61, -227, 9, -292, -88,
+	-1, -161, -124, -123, 11, -61, -308, -305, -161, 61,
+	-161, 110, -124, 57, 56, 33, 127, 56, 92, 57,
+	56, -154, -151, -159, -152, 68, -72, -75, 57, 56,
+	-178, 286, 73, -262, -190, -187, -182, -180, -180, -185,
+	212, 83, -184, -183, -330, 90, -184, 23, 56, 53,
+	127, -328, 57, 57, 11, 11, 57, -184, -184, 57,
+	11, 11, -184, 57, 57, 57, 53, 57, 56, 57,
+	-184, 57, 57, -184, -184, -184, -190, 57, -184, -184,
+	-184, -184, -184, -184, -184, -216, 110, 110, 58, -160,
+	338, -160, -216, -228, 421, 27, -303, 353, 348, 350,
+	23, 24, -83, -84, -85, -90, -86, -141, -173, -87,
+	203, 201, 205, -321, 79, 206, 258, 80, 196, -221,
+	-223, 339, 340, 341, 342, 83, -220, 61, 61, 61,
+	61, -88, -153, 92, -153, -153, -125, -125, -168, -175,
+	-176, -174, 288, -277, 338, 329, 57, 57, -125, -62,
+	-63, 56, 127, -125, 33, -55, -74, -282, -161, -325,
+	-287, -161, 57, -287, 57, -185, 83, -182, -179, 57,
+	91, -184, 89, -91, -97, -98, 120, 156, 211, 123,
+	155, 153, 325, 326, 148, 149, 150, 147, 139, 158,
+	419, 180, 140, 181, 141, 182, 142, 183, 143, 144,
+	184, 145, -91, -106, -161, -184, -184, 57, 57, -184,
+	-184, 11, -106, -180, 57, 57, 57, 57, 57, 57,
+	57, 57, 57, 57, 57, 57, -160, 16, 23, -217,
+	309, 195, -271, 462, -301, 348, 16, 16, -55, -88,
+	57, 56, -92, -96, -93, -95, -94, -98, -97, 156,
+	157, 120, 160, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 30, 211, 153, 154, 155, 123, 172,
+	-87, -161, 80, -320, -321, -200, -320, 80, 55, -223,
+	68, 68, 68, 68, -220, 55, -106, -108, 61, -125,
+	-174, 289, 31, 126, 291, 29, 287, 16, -127, 13,
+	56, -305, 110, -127, -282, -179, -184, 57, -270, 326,
+	325, -264, -266, 55, -265, 55, -264, -264, -264, -99,
+	144, 143, -99, -268, 55, -268, -269, 55, -268, 57,
+	57, 57, 19, 57, 57, 57, -184, 57, 57, -160,
+	-160, -228, 310, -88, -113, 463, 61, 16, 61, -299,
+	61, -76, -104, -105, -122, 323, 227, -201, 231, 65,
+	232, 345, 233, 196, 235, 236, 237, 207, 238, 239,
+	240, 338, 241, 242, 243, 244, 306, 5, 274, 245,
+	-85, -103, -102, -100, 73, 84, 29, 323, -101, 65,
+	119, 251, 228, 252, -121, -172, 260, 23, 52, 201,
+	79, 80, 311, -173, -270, -264, -265, -265, -264, -264,
+	55, 55, -264, -267, 55, -264, -264, -317, -318, -161,
+	-318, -161, -317, -317, -200, -184, -205, -207, -141, 55,
+	68, -278, -171, -126, 14, 16, -63, -126, 68, 68,
+	68, 68, -184, 57, -295, -249, -144, 464, 68, 61,
+	350, 23, -245, 217, 56, -122, -153, -153, -147, 119,
+	-153, -153, -153, -153, 234, 234, -153, -153, -153, -153,
+	-153, -153, -153, -153, -153, -153, -153, -153, -153, -153,
+	55, 55, -100, 73, -180, 61, -108, -109, 29, 250,
+	246, -110, 29, 229, 230, -112, 55, 258, 261, 55,
+	9, 80, 80, -88, -272, 327, -143, 61, -143, 68,
+	55, 53, 271, 55, 55, 55, -318, 57, 57, 56,
+	-264, -184, 290, -184, -190, 57, 57, 57, 56, 57,
+	56, 57, -302, 353, -298, -296, 348, 349, 350, 351,
+	55, 16, -55, 16, -122, 68, 68, -153, -153, 68,
+	61, 61, 61, -153, -153, 68, 61, -163, 68, 68,
+	68, 68, 29, 61, -111, 29, 246, 250, 247, 248,
+	249, 68, 29, 68, 29, 68, 29, -161, 55, -322,
+	-323, 61, -161, 68, 55, 23, -184, -283, 441, -206,
+	55, 57, 56, 57, 57, -205, -319, 278, 279, 280,
+	282, 281, -319, -205, -205, -205, 55, -230, -229, 259,
+	84, -208, -207, -67, 57, 68, 68, -304, 199, -300,
+	352, -296, 16, 350, 16, 16, -145, -161, -299, -246,
+	264, 265, -247, -253, 267, -106, -106, 61, 61, -107,
+	228, -89, 57, 56, 92, 56, 57, -184, 55, 57,
+	-192, -193, -115, -114, 417, -205, 61, 57, 57, 57,
+	57, -205, 259, -209, 207, 65, 421, 276, 277, -67,
+	57, 57, -312, 55, 68, -301, 16, -299, 16, -299,
+	-299, 57, 56, -251, 268, 55, -249, 55, -249, 80,
+	279, 229, 230, 57, -323, 61, 126, 57, -184, -231,
+	263, 262, -119, -120, -117, -118, 52, 357, 256, 257,
+	57, -208, -208, -208, -208, 57, -153, 61, 275, -316,
+	30, 57, -311, -310, -142, -305, -161, 353, 61, -299,
+	-161, -248, 269, 68, -180, 55, -180, 55, -250, 266,
+	55, 68, -229, 57, -118, 52, -117, 52, 10, 9,
+	-121, 68, -159, -315, -314, -313, 57, 56, 127, -255,
+	55, 16, 57, -244, 57, -244, 55, 92, -180, -329,
+	-231, -116, 253, 254, 30, 137, -116, 56, 92, -310,
+	-161, -256, -254, 217, -247, 57, 57, -244, 68, 57,
+	57, 73, 29, 255, -314, 29, -184, 127, 57, 56,
+	58, -252, 270, 57, -161, -254, -257, 33, 68, -261,
+	-258, 55, -122, 219, -261, -122, -260, -259, 269, 220,
+	57, 56, 58, 55, 216, -259, -258, -190, 216, 57,
+	57,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	21, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	447, 448, 449, 0, 0, 274, 0, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 237, 210, 0, 0,
-	181, 161, 162, 163, 119, 120, 121, 122, 0, 0,
-	0, 334, -2, 450, 451, 452, 453, -2, 275, 276,
-	277, 278, 279, 199, 200, 201, -2, 0, 174, 0,
-	166, 166, 0, 354, 0, 0, 0, 365, 0, 378,
-	20, 312, 0, 317, 629, 665, 666, 667, 1356, 1357,
-	1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367,
-	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377,
-	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387,
-	1388, 1389, 1390, 1391, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
-	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
-	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
-	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
-	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
-	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
-	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
-	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341,
-	1342, 1343, 1344, 1345, 0, 190, 0, 0, 194, 0,
-	0, 0, 271, 186, 187, 188, 189, 0, 0, 400,
-	401, 424, 427, 430, 0, 180, 0, 0, 80, 495,
-	82, 497, 0, 86, 88, 89, -2, 93, 94, 95,
-	96, 97, 98, 99, 0, 101, 1235, 103, 1296, 106,
-	107, 108, 0, 117, 118, -2, -2, 492, 0, 0,
-	1285, 62, -2, 0, 0, 0, 370, 456, 526, 526,
-	0, 526, 539, 503, 504, 505, 524, 525, 0, 0,
-	247, 248, 0, 264, 255, 264, 0, 239, 240, 241,
-	245, 246, 265, 213, 175, 176, 165, 0, 170, 0,
-	164, 0, 0, 133, 0, 138, 0, 0, 1234, 1300,
-	1250, 0, 1268, 0, 159, 152, 153, 1027, 1196, 0,
-	349, 0, 355, 354, 354, 0, 354, 213, 213, 213,
-	213, 213, 342, 0, 344, 347, 0, 0, 379, 380,
-	375, 376, 377, 381, 382, 3, 0, 0, 316, 0,
-	387, 191, 668, 0, 0, 195, 196, 0, 0, 202,
-	0, 205, 1392, 1393, 1394, 0, 0, 0, 0, 0,
-	0, 0, 415, 0, 0, 414, 0, 0, 0, 0,
-	428, 429, 431, 0, 433, 434, 442, 443, 444, 445,
-	446, 0, 354, 76, 0, 0, 0, 0, 0, 499,
-	87, 116, 90, 91, 0, 111, 113, 115, 114, 100,
-	112, 102, 104, 105, 110, 76, 0, 0, 0, 63,
-	337, 0, 318, 0, 367, 369, 0, 371, 372, 0,
-	526, 457, 0, 0, 0, 0, 0, 0, 260, 261,
-	255, 255, 249, 257, 0, 262, 263, 0, 387, 0,
-	0, 0, 526, 0, 0, 0, 0, 168, 0, 173,
-	123, 128, 126, 127, 129, 0, 0, 0, 0, 0,
-	157, 158, 0, 0, 0, 0, 0, 146, 149, 621,
-	622, 623, 150, 151, 0, 1028, 1029, 318, 350, 366,
-	368, 349, -2, 0, 363, 364, 0, 0, 0, 0,
-	0, 0, 343, 0, 0, 395, 389, 391, 435, 28,
-	0, 918, 665, 923, -2, 1357, 1358, 1359, 1360, 1361,
-	1362, 1363, -2, 1365, -2, -2, 1368, 1369, 1370, 1372,
-	-2, -2, -2, -2, -2, -2, 1379, -2, -2, -2,
-	1383, 1384, -2, -2, 1387, 1388, 1389, 1390, 1391, -2,
-	-2, -2, -2, 934, 736, 737, 738, 739, 0, 0,
-	0, 0, 0, 746, 747, 0, 759, 0, 753, 754,
-	755, 756, 38, 39, 954, 955, 956, 957, 958, 959,
-	960, 961, 879, 723, 0, 0, 0, 864, 854, 0,
-	874, 892, 0, 0, 0, 0, 893, 0, 40, 41,
-	870, 871, 872, 873, 875, 876, 877, 878, 880, 881,
-	882, 883, 886, 887, 888, 889, 890, 891, 894, 896,
-	866, 867, 868, 869, 858, 859, 860, 861, 862, 863,
-	395, 286, 304, 288, 0, 293, 0, 630, 354, 0,
-	0, 192, 0, 197, 0, 0, 204, 206, 207, 208,
-	1395, 1396, 272, 0, 387, 183, 0, 418, 412, 0,
-	405, 416, 417, 408, 0, 410, 0, 406, 407, 347,
-	432, 426, 0, 77, 78, 79, 81, 92, 0, 0,
-	70, 480, 486, 483, 493, 496, 0, 84, 498, 109,
-	0, 65, 0, 0, 0, 338, 351, 28, 356, 357,
-	360, 0, 0, 467, 0, 494, 518, -2, 387, 387,
-	387, 255, 0, 257, 0, 257, 252, 256, 0, 266,
-	268, 0, 467, 1327, 214, 177, 178, 0, 0, 172,
-	0, 0, 130, 131, 132, 139, 134, 136, 0, 0,
-	140, 154, 155, 156, 310, 311, 141, 0, 0, 0,
-	145, 0, 160, 336, 318, 340, 318, 280, 281, 0,
-	283, 627, 284, 440, 441, 345, 0, 0, 422, 387,
-	0, 396, 0, 392, 0, 0, 0, 436, 0, 438,
-	0, 913, 0, 0, 942, 943, 944, 945, 946, 947,
-	948, 906, 902, 902, 902, 0, 902, 0, 0, 840,
-	0, 0, 902, 902, 902, 902, 902, 841, 0, 902,
-	902, 902, 902, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	-2, 908, 0, 742, 743, 744, 745, 748, 0, 760,
-	0, 898, 0, 0, 0, 906, 906, 906, 843, 0,
-	844, 855, 0, 847, 848, 906, 0, 906, 852, 853,
-	902, 387, 287, 301, 0, 305, 0, 0, 297, 299,
-	292, 294, 0, 0, 314, 349, 388, 669, 0, 1034,
-	-2, 1036, -2, -2, 1038, 1039, 1040, 1041, 1042, 1043,
-	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
-	1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
-	1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
-	1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
-	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
-	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
-	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
-	1184, 1185, 0, 198, 203, 0, 0, 354, 0, 0,
-	402, 419, 0, 0, 403, 0, 404, 409, 411, 425,
-	0, 71, 75, 0, 482, 0, 0, 485, 83, 0,
-	0, 0, 59, 320, 0, 0, 0, 0, 359, 361,
-	362, 347, 0, 0, 459, 468, 0, 527, 0, 0,
-	523, -2, 530, 0, 536, 238, 242, 243, 387, 258,
-	255, 259, 255, 257, 0, 267, 270, 459, 0, 179,
-	167, 169, 0, 125, 0, 0, 0, 142, 143, 144,
-	147, 148, 339, 341, 0, 0, 20, 348, 0, 385,
-	390, 397, 398, 910, 911, 912, 437, 439, 29, 393,
-	914, 0, 916, 919, 924, 925, 921, 0, 939, -2,
-	-2, 0, 907, 908, 0, 903, 904, 0, 0, 0,
-	0, 0, 0, 0, 856, 0, 953, 0, 811, 812,
-	813, 814, 815, 816, 817, 818, 819, 820, 821, 822,
-	823, 824, 825, 826, 827, 828, 829, 830, 831, 832,
-	833, 834, 835, 836, 837, 838, 839, 0, 0, 0,
-	0, 0, 0, 0, 806, 807, 808, 0, 0, 0,
-	0, 724, 725, 726, 727, 728, 729, 730, 731, 732,
-	733, 734, 735, 926, 937, 938, 0, 0, 0, 0,
-	0, 935, 930, 0, 740, 0, 757, 761, 0, 0,
-	899, 900, 0, 0, 908, 0, 0, 865, 0, 0,
-	0, 0, 0, 385, 304, 306, 0, 0, 304, 0,
-	0, 0, 313, 0, 285, 0, 0, 273, 209, 349,
-	184, 185, 420, 0, 413, 0, 0, 0, 481, 0,
-	0, 484, 85, 0, 67, 0, 60, 61, 324, 0,
-	352, 353, 29, 358, 0, 0, 631, 458, 0, 469,
-	470, 471, 472, 473, 0, 0, 0, 0, 0, 519,
-	520, 521, 522, 531, 1030, 1030, 1030, 0, 250, 387,
-	387, 255, 269, 215, 0, 171, 124, 0, 227, 135,
-	282, 628, 0, 423, 383, 0, 0, 0, 915, 917,
-	920, 922, 794, 0, 0, 0, 0, 0, 0, 783,
-	777, 778, 857, 0, 0, 0, 0, 0, 0, 802,
-	0, 0, 0, 0, 0, 0, 0, 0, 927, 935,
-	931, 0, 928, 0, 0, 909, 0, 762, 0, 0,
-	0, 0, 901, 795, 0, 799, 842, 845, 0, 849,
-	0, 851, 0, 383, 302, 0, 307, 308, 304, 291,
-	298, 290, 300, 295, 296, 315, 670, 1035, 1032, 1033,
-	193, 182, 0, 69, 72, 73, 74, 487, 0, 488,
-	467, 66, 0, 0, 326, 48, 0, 0, 0, 0,
-	0, 0, 632, 633, 635, 636, 0, 0, 638, 692,
-	0, 647, 526, 647, 0, 0, 649, 650, 460, 461,
-	0, 0, 0, 0, 0, 475, 476, 477, 478, 479,
-	0, 0, 1031, 0, 0, 253, 251, 387, 211, 216,
-	217, 0, 221, 0, 0, 137, 346, 373, 0, 0,
-	399, 30, 394, 909, 779, 780, 781, 0, 764, 1009,
-	1013, 767, 1009, 1009, 1009, 773, 773, 1016, 1016, 1019,
-	1016, 782, 0, 801, 784, 785, 788, 786, 0, 0,
-	0, 800, 789, 790, 776, 905, 929, 0, 936, 932,
-	741, 749, 758, 0, 0, 0, 0, 0, 0, 787,
-	374, 303, 0, 289, 421, 491, 0, 0, 67, 0,
-	0, 328, 0, 325, 0, 0, 0, 454, 347, -2,
-	0, -2, 1022, 963, 964, 965, 1009, 967, 1013, 1013,
-	1009, 1009, 995, 996, 997, 998, 999, 1000, 1001, 1002,
-	1003, 0, 0, 986, 1009, 1011, 1009, 1009, 1006, 968,
-	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
-	979, 980, 981, 637, 693, 659, 659, 648, 659, 659,
-	526, 0, 462, 463, 464, 465, 466, 474, 0, 532,
-	533, 624, 625, 626, 534, 254, 218, 219, 220, 0,
-	223, 224, 226, 0, 384, 386, 750, 765, 1010, 0,
-	766, 0, 768, 769, 770, 771, 774, 775, 772, 982,
-	0, 983, 984, 0, 985, 798, 803, 804, 0, 933,
-	763, 751, 752, 796, 0, 846, 850, 309, 489, 490,
-	64, 68, 50, 330, 0, 327, 0, 321, 323, 58,
-	0, 540, -2, 577, 1030, 1030, 0, 1030, 1030, 1030,
-	1030, 0, 0, 1030, 1030, 1030, 1030, 1030, 1030, 1030,
-	1030, 1030, 1030, 1030, 1030, 1030, 1030, 0, 634, 661,
-	-2, 673, 675, 0, 0, 678, 679, 0, 0, 0,
-	0, 715, 685, 0, 0, 951, 952, 0, 691, 1025,
-	1023, 1024, 966, 991, 992, 993, 994, 0, 0, 987,
-	988, 0, 989, 990, 0, 651, 660, 0, 660, 0,
-	0, 659, 0, 0, 514, 1009, 0, 0, 225, 212,
-	0, 0, 0, 0, 805, 0, 44, 0, 319, 0,
-	329, 49, 0, 0, 537, 0, 535, 579, 0, 0,
-	1030, 1030, 0, 0, 0, 0, 1030, 1030, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 674, 676, 677, 680, 681, 682, 720,
-	721, 722, 683, 717, 718, 719, 684, 0, 0, 949,
-	950, 713, 962, 1026, 0, 1007, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 645, 507, 0, 360, 0,
-	222, 1015, 1014, 1017, 0, 1020, 0, 797, 42, 46,
-	51, 52, 0, 0, 0, 0, 0, 0, 455, 573,
-	578, 580, 581, 0, 0, 584, 585, 586, 587, 0,
-	0, 590, 591, 592, 593, 594, 595, 596, 597, 598,
-	599, 615, 616, 617, 618, 619, 620, 600, 601, 602,
-	603, 604, 605, 612, 0, 0, 609, 0, 0, 0,
-	708, 0, 1004, 0, 1005, 1012, 0, 652, 654, 655,
-	656, 657, 658, 653, 0, 0, 0, 0, 644, 646,
-	688, 0, 506, 515, 516, 360, 0, 0, 31, 0,
-	48, 0, 53, 0, 0, 0, 0, 0, 332, 322,
-	562, 0, 0, 568, 0, 574, 582, 583, 588, 589,
-	606, 0, 0, 608, 0, 0, 716, 0, 695, 709,
-	0, 0, 1008, 507, 507, 507, 507, 0, 689, 508,
-	1030, 0, 0, 512, 513, 517, 1018, 1021, 22, 0,
-	0, 45, 0, 54, 0, 56, 57, 331, 0, 542,
-	0, 0, 0, 0, 0, 571, 0, 613, 614, 607,
-	610, 611, 686, 694, 696, 697, 698, 0, 710, 711,
-	712, 714, 639, 640, 641, 642, 0, 0, 510, 0,
-	21, 0, 32, 0, 34, 36, 37, 662, 43, 47,
-	55, 333, 544, 0, 563, 0, 0, 0, 0, 0,
-	0, 0, 687, 699, 0, 700, 0, 0, 0, 643,
-	509, 511, 23, 24, 0, 33, 0, 0, 541, 0,
-	573, 564, 0, 566, 0, 0, 0, 0, 701, 703,
-	704, 0, 0, 702, 0, 0, 35, 663, 0, 546,
-	0, 560, 565, 567, 0, 572, 570, 705, 707, 706,
-	25, 26, 27, 0, 545, 0, 558, 543, 0, 569,
-	664, 547, -2, 0, 561, 548, -2, 0, 556, 0,
-	549, 557, 0, 552, 0, 0, 551, 0, -2, 0,
-	553, -2, 0, 559,
+	20, 472, 473, 474, 299, 0, 286, 0, 234, 235,
+	236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	214, 215, 0, 0, 0, 181, 161, 162, 163, 120,
+	121, 122, 123, 0, 0, 0, 350, -2, 475, 476,
+	477, 478, -2, 287, 288, 289, 290, 291, 199, 200,
+	201, -2, 0, 174, 0, 166, 166, 0, 370, 0,
+	0, 0, 381, 0, 395, 21, 0, 300, 0, 190,
+	0, 0, 194, 0, 0, 0, 283, 186, 187, 188,
+	189, 693, 694, 695, 1405, 1406, 1407, 1408, 1409, 1410,
+	1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420,
+	1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430,
+	1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440,
+	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
+	1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248,
+	1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258,
+	1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
+	1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
+	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
+	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298,
+	1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
+	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318,
+	1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328,
+	1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338,
+	1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348,
+	1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358,
+	1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368,
+	1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378,
+	1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
+	1389, 1390, 1391, 1392, 1393, 1394, 0, 0, 0, 422,
+	423, 446, 452, 455, 455, 0, 657, 180, 0, 0,
+	81, 520, 83, 522, 0, 87, 89, 90, -2, 94,
+	95, 96, 97, 98, 99, 100, 0, 102, 1279, 104,
+	1344, 107, 108, 109, 0, 118, 119, -2, -2, 517,
+	0, 0, 1332, 63, -2, 0, 0, 0, 386, 481,
+	551, 551, 0, 551, 564, 528, 529, 530, 549, 550,
+	0, 0, 255, 256, 0, 0, 259, 276, 267, 276,
+	0, 247, 248, 249, 253, 254, 277, 0, 219, 175,
+	176, 165, 0, 170, 0, 164, 0, 0, 134, 0,
+	139, 0, 0, 1278, 1348, 1295, 0, 0, 1313, 0,
+	159, 42, 1067, 1240, 0, 365, 0, 371, 370, 370,
+	0, 370, 219, 219, 219, 219, 219, 358, 0, 360,
+	363, 400, 396, 3, 326, 0, 331, 657, 409, 191,
+	696, 0, 0, 195, 196, 0, 0, 202, 0, 205,
+	1441, 1442, 1443, 0, 0, 211, 655, 0, 0, 0,
+	0, 0, 437, 0, 0, 436, 0, 0, 0, 0,
+	453, 363, 456, 0, 458, 459, 467, 468, 469, 470,
+	471, 454, 0, 318, 370, 944, 0, 77, 0, 0,
+	0, 0, 0, 524, 88, 117, 91, 92, 0, 112,
+	114, 116, 115, 101, 113, 103, 105, 106, 111, 77,
+	0, 0, 0, 64, 353, 0, 334, 0, 383, 385,
+	0, 387, 388, 0, 551, 482, 0, 0, 0, 0,
+	0, 0, 272, 273, 267, 267, 257, 269, 0, 274,
+	275, 0, 0, 0, 409, 0, 0, 0, 551, 0,
+	0, 0, 0, 0, 168, 0, 173, 124, 129, 127,
+	128, 130, 0, 0, 0, 0, 0, 157, 158, 0,
+	0, 0, 0, 0, 0, 148, 151, 649, 650, 651,
+	152, 153, 0, 1068, 1069, 334, 366, 382, 384, 365,
+	-2, 0, 379, 380, 0, 0, 0, 0, 0, 0,
+	359, 0, 0, 0, 0, 401, 402, 397, 398, 399,
+	403, 404, 0, 0, 330, 370, 0, 0, 192, 0,
+	197, 0, 0, 204, 206, 207, 208, 1444, 1445, 284,
+	0, 210, 0, 212, 213, 409, 183, 0, 440, 434,
+	0, 427, 438, 439, 430, 0, 432, 0, 428, 429,
+	363, 448, 457, 450, 0, 315, 0, 319, 0, 945,
+	946, 0, 956, 961, 972, 771, 772, 773, 774, 0,
+	0, 0, 0, 0, 782, 783, 0, 795, 1409, 0,
+	789, 790, 791, 792, 29, 39, 40, 992, 993, 994,
+	995, 996, 997, 998, 999, 1000, 1001, 916, 758, 693,
+	0, 1417, 0, 1437, 1418, 1436, 0, 901, 891, 0,
+	911, 929, 0, 0, 0, 0, 930, 1414, 1433, 1440,
+	1411, 1412, 1406, 1407, 1408, 1410, 1419, 1421, 1432, 0,
+	1428, 1438, 1439, 41, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, 907, 908, 909, 910,
+	912, 913, 914, 915, 917, 918, 919, 920, -2, -2,
+	923, 924, 925, 926, 927, 928, 931, -2, 933, -2,
+	903, 904, 905, 906, 895, 896, 897, 898, 899, 900,
+	-2, -2, -2, 658, 0, 78, 79, 80, 82, 93,
+	0, 0, 71, 505, 511, 508, 518, 521, 0, 85,
+	523, 110, 0, 66, 0, 0, 0, 354, 367, 372,
+	373, 376, 0, 0, 492, 0, 519, 543, -2, 409,
+	409, 409, 267, 0, 269, 0, 0, 901, 261, 269,
+	264, 268, 0, 278, 280, 0, 0, 492, 1376, 220,
+	177, 178, 0, 0, 172, 0, 0, 131, 132, 133,
+	140, 135, 137, 0, 0, 141, 154, 155, 156, 324,
+	325, 142, 0, 0, 0, 146, 147, 0, 160, 332,
+	334, 356, 334, 292, 293, 0, 295, 296, 465, 466,
+	361, 0, 0, 444, 391, 411, 413, 460, 29, 391,
+	298, 318, 302, 0, 307, 0, 365, 410, 697, 0,
+	1074, -2, 1076, -2, -2, 1078, 1079, 1080, 1081, 1082,
+	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
+	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
+	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
+	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
+	1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132,
+	1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142,
+	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
+	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
+	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
+	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 0, 198, 203, 0,
+	0, 656, 370, 0, 0, 424, 441, 0, 0, 425,
+	0, 426, 431, 433, 447, 318, 451, 320, 449, 0,
+	0, 0, 0, 951, 0, 0, 980, 981, 982, 983,
+	984, 985, 986, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, -2, 946, 0, 777, 778, 779, 781, 784, 0,
+	796, 0, 0, 0, 935, 0, 0, 0, 944, 944,
+	0, 944, 940, 940, 0, 944, 880, 0, 881, 892,
+	0, 0, 884, 885, 944, 0, 944, 889, 890, 877,
+	878, 940, 0, 0, 940, 940, 940, 940, 940, 940,
+	940, 940, 940, 940, 940, 0, 72, 76, 0, 507,
+	0, 0, 510, 84, 0, 0, 0, 60, 336, 0,
+	0, 0, 375, 377, 378, 363, 0, 0, 484, 493,
+	0, 552, 0, 0, 548, -2, 555, 0, 561, 246,
+	250, 251, 409, 270, 267, 271, 258, 260, 267, 269,
+	0, 279, 282, 0, 484, 0, 179, 167, 169, 0,
+	126, 0, 0, 0, 143, 144, 145, 149, 150, 352,
+	0, 332, 357, 0, 21, 364, 0, 417, 0, 0,
+	414, 461, 0, 463, 0, 417, 301, 0, 0, 311,
+	313, 306, 308, 0, 0, 328, 297, 0, 0, 285,
+	209, 365, 184, 185, 442, 0, 435, 316, 0, 321,
+	322, 947, 948, 949, 950, 952, 0, 954, 957, 962,
+	963, 959, 0, 977, -2, -2, 759, 760, 761, 762,
+	763, 764, 765, 766, 767, 768, 769, 770, 964, 975,
+	976, 0, 0, 0, 0, 0, 973, 968, 0, 780,
+	652, 653, 654, 775, 0, 793, 797, 0, 0, 0,
+	30, 937, 848, 849, 850, 851, 852, 853, 854, 855,
+	856, 857, 858, 859, 860, 861, 862, 863, 864, 865,
+	866, 867, 868, 869, 870, 871, 872, 873, 874, 875,
+	876, 936, 938, 0, 0, 0, 946, 0, 0, 0,
+	941, 942, 0, 0, 0, 843, 844, 845, 0, 902,
+	893, 0, 991, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 506, 0, 0, 509, 86,
+	0, 68, 0, 61, 62, 340, 0, 368, 369, 374,
+	0, 0, 659, 483, 0, 494, 495, 496, 497, 498,
+	0, 0, 0, 0, 0, 544, 545, 546, 547, 556,
+	1070, 1070, 1070, 0, 262, 409, 409, 267, 281, 216,
+	221, 0, 171, 125, 0, 233, 136, 333, 355, 294,
+	0, 445, 409, 418, 0, 412, 392, 393, 462, 464,
+	30, 415, 409, 0, 0, 318, 0, 0, 0, 327,
+	0, 698, 1075, 1072, 1073, 193, 182, 0, 317, 0,
+	953, 955, 958, 960, 0, 965, 973, 969, 0, 966,
+	0, 0, 947, 0, 798, 0, 0, 0, 0, 0,
+	0, 939, 831, 832, 0, 0, 836, 0, 0, 839,
+	0, 0, 0, 879, 894, 882, 0, 886, 0, 888,
+	0, 814, 815, 0, 0, 0, 0, 820, 0, 0,
+	0, 0, 0, 0, 0, 70, 73, 74, 75, 512,
+	0, 513, 492, 67, 0, 0, 342, 49, 0, 0,
+	0, 0, 0, 660, 661, 663, 664, 0, 0, 666,
+	727, 0, 675, 551, 675, 0, 0, 677, 678, 485,
+	486, 0, 0, 0, 0, 0, 500, 501, 502, 503,
+	504, 0, 0, 1071, 0, 0, 265, 263, 409, 217,
+	222, 223, 0, 227, 0, 0, 138, 362, 407, 419,
+	420, 0, 0, 407, 318, 305, 312, 304, 314, 309,
+	310, 329, 443, 323, 943, 967, 0, 974, 970, 776,
+	785, 794, 0, 0, 800, 1062, 1049, 1053, 804, 1049,
+	1049, 1049, 810, 810, 1056, 1056, 1059, 1056, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
+	1020, 1021, 0, 0, 31, 0, 0, 837, 838, 0,
+	0, 0, 0, 0, 813, 816, 817, 818, 819, 821,
+	822, 823, 824, 825, 826, 827, 516, 0, 0, 68,
+	0, 0, 344, 0, 341, 0, 0, 0, 479, 363,
+	-2, 0, -2, 1062, 1003, 1004, 1005, 1049, 1007, 1053,
+	1053, 1049, 1049, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
+	1042, 1043, 0, 0, 1026, 1049, 1051, 1049, 1049, 1046,
+	665, 728, 687, 687, 676, 687, 687, 551, 0, 487,
+	488, 489, 490, 491, 499, 0, 557, 558, 559, 266,
+	224, 225, 226, 0, 229, 230, 232, 0, 405, 0,
+	0, 394, 416, 405, 303, 971, 799, 786, 801, 1063,
+	1064, 802, 1050, 0, 803, 0, 805, 806, 807, 808,
+	811, 812, 809, 1022, 0, 1023, 1024, 0, 1025, 787,
+	788, 833, 0, 835, 840, 841, 0, 883, 887, 514,
+	515, 65, 69, 51, 346, 0, 343, 0, 337, 339,
+	59, 0, 565, -2, 604, 1070, 1070, 0, 1070, 1070,
+	1070, 1070, 0, 0, 1070, 1070, 1070, 1070, 1070, 1070,
+	1070, 1070, 1070, 1070, 1070, 1070, 1070, 1070, 0, 0,
+	662, 689, -2, 701, 703, 0, 0, 706, 707, 0,
+	0, 0, 0, 750, 713, 0, 0, 0, 0, 0,
+	989, 990, 0, 726, 1065, 1006, 1031, 1032, 1033, 1034,
+	0, 0, 1027, 1028, 0, 1029, 1030, 0, 679, 688,
+	0, 688, 0, 0, 687, 0, 0, 539, 1049, 0,
+	0, 231, 218, 389, 0, 0, 421, 390, 0, 0,
+	0, 0, 0, 842, 45, 0, 335, 0, 345, 50,
+	0, 0, 562, 0, 560, 606, 0, 0, 1070, 1070,
+	0, 0, 0, 0, 1070, 1070, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 702, 704, 705, 708, 709, 710, 755, 756,
+	757, 711, 752, 753, 754, 712, 0, 0, 0, 0,
+	0, 987, 988, 748, 1002, 1066, 0, 1047, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 673, 532, 0,
+	376, 0, 228, 406, 408, 1055, 1054, 1057, 0, 1060,
+	0, 834, 43, 47, 52, 53, 0, 0, 0, 0,
+	0, 0, 480, 600, 605, 607, 608, 0, 0, 611,
+	612, 613, 614, 0, 0, 617, 618, 619, 620, 621,
+	622, 623, 624, 625, 626, 643, 644, 645, 646, 647,
+	648, 627, 628, 629, 630, 631, 632, 640, 0, 0,
+	637, 0, 0, 0, 0, 0, 0, 891, 0, 743,
+	0, 1044, 0, 1045, 1052, 0, 680, 682, 683, 684,
+	685, 686, 681, 0, 0, 0, 0, 672, 674, 723,
+	0, 531, 540, 541, 376, 0, 0, 32, 0, 49,
+	0, 54, 0, 0, 0, 0, 0, 348, 338, 589,
+	0, 0, 595, 0, 601, 609, 610, 615, 616, 633,
+	0, 0, 635, 0, 0, 0, 751, 0, 0, 720,
+	718, 719, 730, 744, 0, 0, 1048, 532, 532, 532,
+	532, 0, 724, 533, 1070, 0, 0, 537, 538, 542,
+	1058, 1061, 23, 0, 0, 46, 0, 55, 0, 57,
+	58, 347, 0, 567, 0, 0, 0, 0, 0, 598,
+	0, 641, 642, 634, 638, 639, 0, 714, 0, 717,
+	721, 722, 729, 731, 732, 733, 0, 745, 746, 747,
+	749, 667, 668, 669, 670, 0, 0, 535, 0, 22,
+	0, 33, 0, 35, 37, 38, 690, 44, 48, 56,
+	349, 569, 0, 590, 0, 0, 0, 0, 0, 0,
+	0, 0, 715, 720, 734, 0, 735, 0, 0, 0,
+	671, 534, 536, 24, 25, 0, 34, 0, 0, 566,
+	0, 600, 591, 0, 593, 0, 0, 0, 0, 0,
+	716, 736, 738, 739, 0, 0, 737, 0, 0, 36,
+	691, 0, 571, 0, 587, 592, 594, 0, 599, 597,
+	636, 740, 742, 741, 26, 27, 28, 0, 570, 0,
+	583, 568, 0, 596, 692, 572, -2, 0, 588, 573,
+	-2, 0, 581, 0, 574, 582, 0, 577, 0, 0,
+	576, 0, -2, 0, 585, 578, -2, 0, 0, 584,
+	586,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 452,
-	90, 89, 91, 3, 453, 3, 3, 3, 3, 3,
+	3, 3, 3, 85, 3, 3, 3, 113, 105, 3,
+	55, 57, 110, 108, 56, 109, 127, 111, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 466,
+	93, 92, 94, 3, 467, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 115, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 104, 3, 116,
 }
 
 var yyTok2 = [...]int{
@@ -4690,12 +4941,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	86, 87, 88, 89, 90, 91, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 106, 107, 112, 114, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4749,7 +5000,10 @@ var yyTok3 = [...]int{
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
 	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
 	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
-	57775, 450, 57776, 451, 0,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
+	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
+	57790, 465, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5099,7 +5353,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:505
+//line mysql_sql.y:523
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -5107,32 +5361,32 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:511
+//line mysql_sql.y:529
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:534
+//line mysql_sql.y:553
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 21:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:538
+//line mysql_sql.y:557
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 22:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:544
+//line mysql_sql.y:563
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -5147,42 +5401,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:559
+//line mysql_sql.y:578
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 24:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:563
+//line mysql_sql.y:582
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:569
+//line mysql_sql.y:588
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 26:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:573
+//line mysql_sql.y:592
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 27:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:579
+//line mysql_sql.y:598
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5190,10 +5444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:586
+//line mysql_sql.y:605
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5201,58 +5455,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:595
+//line mysql_sql.y:614
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:599
+//line mysql_sql.y:618
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:603
+//line mysql_sql.y:622
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:608
+//line mysql_sql.y:627
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:612
+//line mysql_sql.y:631
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:616
+//line mysql_sql.y:635
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:622
+//line mysql_sql.y:641
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5262,10 +5516,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:631
+//line mysql_sql.y:650
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5275,42 +5529,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:642
+//line mysql_sql.y:661
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:646
+//line mysql_sql.y:665
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:652
+//line mysql_sql.y:671
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:656
+//line mysql_sql.y:675
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:662
+//line mysql_sql.y:681
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5333,10 +5587,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:686
+//line mysql_sql.y:705
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -5355,34 +5609,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:705
+//line mysql_sql.y:724
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:709
+//line mysql_sql.y:728
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:714
+//line mysql_sql.y:733
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:718
+//line mysql_sql.y:737
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5390,42 +5644,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:726
+//line mysql_sql.y:745
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:730
+//line mysql_sql.y:749
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:735
+//line mysql_sql.y:754
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:739
+//line mysql_sql.y:758
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:744
+//line mysql_sql.y:763
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:748
+//line mysql_sql.y:767
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5448,36 +5702,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:772
+//line mysql_sql.y:791
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:776
+//line mysql_sql.y:795
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:782
+//line mysql_sql.y:801
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:788
+//line mysql_sql.y:807
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5496,10 +5750,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:806
+//line mysql_sql.y:825
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5517,10 +5771,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:823
+//line mysql_sql.y:842
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5538,50 +5792,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:846
+//line mysql_sql.y:865
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:850
+//line mysql_sql.y:869
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:854
+//line mysql_sql.y:873
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:859
+//line mysql_sql.y:878
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:863
+//line mysql_sql.y:882
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:869
+//line mysql_sql.y:888
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5592,10 +5846,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:879
+//line mysql_sql.y:898
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5604,10 +5858,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:887
+//line mysql_sql.y:906
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5617,26 +5871,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:897
+//line mysql_sql.y:916
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:901
+//line mysql_sql.y:920
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:911
+//line mysql_sql.y:930
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5647,10 +5901,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:921
+//line mysql_sql.y:940
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5659,30 +5913,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:931
+//line mysql_sql.y:950
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:937
+//line mysql_sql.y:956
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:943
+//line mysql_sql.y:962
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5690,10 +5944,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:950
+//line mysql_sql.y:969
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5702,10 +5956,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:958
+//line mysql_sql.y:977
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5713,58 +5967,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:966
+//line mysql_sql.y:985
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:970
+//line mysql_sql.y:989
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:974
+//line mysql_sql.y:993
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:978
+//line mysql_sql.y:997
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:984
+//line mysql_sql.y:1003
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:988
+//line mysql_sql.y:1007
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:994
+//line mysql_sql.y:1013
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5772,10 +6026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1001
+//line mysql_sql.y:1020
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5783,418 +6037,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1010
+//line mysql_sql.y:1029
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1014
+//line mysql_sql.y:1033
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1020
+//line mysql_sql.y:1039
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1024
+//line mysql_sql.y:1043
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1028
+//line mysql_sql.y:1047
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1032
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1036
+//line mysql_sql.y:1055
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1040
+//line mysql_sql.y:1059
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1044
+//line mysql_sql.y:1063
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1048
+//line mysql_sql.y:1067
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1052
+//line mysql_sql.y:1071
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1056
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1060
+//line mysql_sql.y:1079
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1064
+//line mysql_sql.y:1083
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1068
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1072
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1076
+//line mysql_sql.y:1095
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1080
+//line mysql_sql.y:1099
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1084
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1088
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1092
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1096
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1100
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1104
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1108
+//line mysql_sql.y:1127
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 110:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1112
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1116
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1120
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1124
+//line mysql_sql.y:1143
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1128
+//line mysql_sql.y:1147
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1132
+//line mysql_sql.y:1151
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1136
+//line mysql_sql.y:1155
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1140
+//line mysql_sql.y:1159
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1144
+//line mysql_sql.y:1163
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1148
+//line mysql_sql.y:1167
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1160
+//line mysql_sql.y:1179
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 125:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1166
+//line mysql_sql.y:1185
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1174
+//line mysql_sql.y:1193
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1178
+//line mysql_sql.y:1197
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1182
+//line mysql_sql.y:1201
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1186
+//line mysql_sql.y:1205
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1190
+//line mysql_sql.y:1209
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1196
+//line mysql_sql.y:1215
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1200
+//line mysql_sql.y:1219
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1204
+//line mysql_sql.y:1223
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1210
+//line mysql_sql.y:1229
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 135:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1216
+//line mysql_sql.y:1235
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 136:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1220
+//line mysql_sql.y:1239
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 138:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1227
+//line mysql_sql.y:1246
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1233
+//line mysql_sql.y:1252
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1237
+//line mysql_sql.y:1256
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1243
+//line mysql_sql.y:1262
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6203,10 +6457,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1251
+//line mysql_sql.y:1270
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6215,10 +6469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1259
+//line mysql_sql.y:1278
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6228,10 +6482,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1268
+//line mysql_sql.y:1287
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6240,10 +6494,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1276
+//line mysql_sql.y:1295
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6252,10 +6506,21 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 146:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:1303
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				Name:  yyDollar[1].varExprUnion().Name,
+				Value: yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1284
+//line mysql_sql.y:1310
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6279,77 +6544,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1307
+//line mysql_sql.y:1333
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1314
+//line mysql_sql.y:1341
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1321
+//line mysql_sql.y:1349
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
+				System:   true,
 				Name:     yyDollar[1].str,
 				Value:    tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
 				Reserved: tree.NewNumValWithType(constant.MakeString(yyDollar[4].str), yyDollar[4].str, false, tree.P_char),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1329
+//line mysql_sql.y:1358
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: &tree.DefaultVal{},
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  &tree.DefaultVal{},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1336
+//line mysql_sql.y:1366
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1343
+//line mysql_sql.y:1374
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: &tree.DefaultVal{},
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  &tree.DefaultVal{},
 			}
 		}
 		yyVAL.union = yyLOCAL
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1356
+//line mysql_sql.y:1384
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
@@ -6357,7 +6628,7 @@ yydefault:
 	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1360
+//line mysql_sql.y:1388
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
@@ -6365,33 +6636,33 @@ yydefault:
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1364
+//line mysql_sql.y:1392
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1398
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1374
+//line mysql_sql.y:1402
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1381
+//line mysql_sql.y:1409
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1392
+//line mysql_sql.y:1420
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
@@ -6399,7 +6670,7 @@ yydefault:
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1398
+//line mysql_sql.y:1426
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
@@ -6407,7 +6678,7 @@ yydefault:
 	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1403
+//line mysql_sql.y:1431
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6415,7 +6686,7 @@ yydefault:
 	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1407
+//line mysql_sql.y:1435
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
@@ -6423,7 +6694,7 @@ yydefault:
 	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1411
+//line mysql_sql.y:1439
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
@@ -6431,7 +6702,7 @@ yydefault:
 	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1415
+//line mysql_sql.y:1443
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
@@ -6439,7 +6710,7 @@ yydefault:
 	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1419
+//line mysql_sql.y:1447
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
@@ -6447,7 +6718,7 @@ yydefault:
 	case 171:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1423
+//line mysql_sql.y:1451
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6455,7 +6726,7 @@ yydefault:
 	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1427
+//line mysql_sql.y:1455
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6463,7 +6734,7 @@ yydefault:
 	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1431
+//line mysql_sql.y:1459
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -6471,7 +6742,7 @@ yydefault:
 	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1437
+//line mysql_sql.y:1465
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6479,7 +6750,7 @@ yydefault:
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1441
+//line mysql_sql.y:1469
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6487,7 +6758,7 @@ yydefault:
 	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1445
+//line mysql_sql.y:1473
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6495,7 +6766,7 @@ yydefault:
 	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1449
+//line mysql_sql.y:1477
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
@@ -6504,7 +6775,7 @@ yydefault:
 	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1482
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
@@ -6513,7 +6784,7 @@ yydefault:
 	case 179:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1459
+//line mysql_sql.y:1487
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -6521,7 +6792,7 @@ yydefault:
 	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1465
+//line mysql_sql.y:1493
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
@@ -6529,7 +6800,7 @@ yydefault:
 	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1469
+//line mysql_sql.y:1497
 		{
 			yyLOCAL = &tree.Use{}
 		}
@@ -6537,7 +6808,7 @@ yydefault:
 	case 182:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1475
+//line mysql_sql.y:1503
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6551,7 +6822,7 @@ yydefault:
 	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1488
+//line mysql_sql.y:1516
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -6559,7 +6830,7 @@ yydefault:
 	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1492
+//line mysql_sql.y:1520
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -6567,7 +6838,7 @@ yydefault:
 	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1498
+//line mysql_sql.y:1526
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
@@ -6575,7 +6846,7 @@ yydefault:
 	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1535
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -6583,7 +6854,7 @@ yydefault:
 	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1513
+//line mysql_sql.y:1541
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
@@ -6592,7 +6863,7 @@ yydefault:
 	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1518
+//line mysql_sql.y:1546
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
@@ -6601,7 +6872,7 @@ yydefault:
 	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1523
+//line mysql_sql.y:1551
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
@@ -6609,7 +6880,7 @@ yydefault:
 	case 193:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1527
+//line mysql_sql.y:1555
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
@@ -6617,7 +6888,7 @@ yydefault:
 	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1531
+//line mysql_sql.y:1559
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
@@ -6625,7 +6896,7 @@ yydefault:
 	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1535
+//line mysql_sql.y:1563
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6638,7 +6909,7 @@ yydefault:
 	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1544
+//line mysql_sql.y:1572
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6650,7 +6921,7 @@ yydefault:
 	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1552
+//line mysql_sql.y:1580
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6664,7 +6935,7 @@ yydefault:
 	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1562
+//line mysql_sql.y:1590
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
@@ -6674,7 +6945,7 @@ yydefault:
 	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1575
+//line mysql_sql.y:1603
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
@@ -6682,7 +6953,7 @@ yydefault:
 	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1579
+//line mysql_sql.y:1607
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
@@ -6690,47 +6961,87 @@ yydefault:
 	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1585
+//line mysql_sql.y:1613
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1591
+//line mysql_sql.y:1619
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1596
+//line mysql_sql.y:1624
 		{
 			yyVAL.str = "true"
 		}
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1597
+//line mysql_sql.y:1625
 		{
 			yyVAL.str = "false"
 		}
 	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1598
+//line mysql_sql.y:1626
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 209:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1603
+//line mysql_sql.y:1631
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
+	case 210:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1637
+		{
+			yyLOCAL = &tree.ChecksumTable{Tables: yyDollar[3].tableNamesUnion(), Quick: yyDollar[4].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
 	case 211:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:1642
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:1646
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:1650
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 216:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1661
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), []tree.AlterTableOption{tree.NewAlterOptionDropPartition(yyDollar[6].identifierListUnion())})
+		}
+		yyVAL.union = yyLOCAL
+	case 217:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1667
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6742,10 +7053,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 218:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1624
+//line mysql_sql.y:1678
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6758,118 +7069,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1637
+//line mysql_sql.y:1691
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1641
+//line mysql_sql.y:1695
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1646
+//line mysql_sql.y:1700
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1650
+//line mysql_sql.y:1704
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1656
+//line mysql_sql.y:1710
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1660
+//line mysql_sql.y:1714
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1666
+//line mysql_sql.y:1720
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1670
+//line mysql_sql.y:1724
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1674
+//line mysql_sql.y:1728
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 228:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1678
+//line mysql_sql.y:1732
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1682
+//line mysql_sql.y:1736
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1686
+//line mysql_sql.y:1740
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1692
+//line mysql_sql.y:1746
 		{
 			yyVAL.item = nil
 		}
-	case 226:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1697
+//line mysql_sql.y:1751
 		{
 			yyVAL.item = nil
 		}
-	case 238:
+	case 246:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1718
+//line mysql_sql.y:1774
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6877,10 +7188,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 250:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1732
+//line mysql_sql.y:1788
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6889,10 +7200,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 251:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1742
+//line mysql_sql.y:1798
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6901,94 +7212,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 252:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1751
+//line mysql_sql.y:1807
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1755
+//line mysql_sql.y:1811
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1759
+//line mysql_sql.y:1815
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1765
+//line mysql_sql.y:1821
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1771
+//line mysql_sql.y:1827
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1777
+//line mysql_sql.y:1833
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 258:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1783
+//line mysql_sql.y:1839
 		{
-			yyLOCAL = &tree.ShowTables{
-				Open:   false,
-				Full:   yyDollar[2].fullOptUnion(),
-				DBName: yyDollar[4].str,
-				Like:   yyDollar[5].comparisionExprUnion(),
-				Where:  yyDollar[6].whereUnion(),
-			}
+			yyLOCAL = &tree.ShowProfile{ProcessID: uint64(yyDollar[5].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 259:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1793
+//line mysql_sql.y:1845
 		{
-			yyLOCAL = &tree.ShowTables{
-				Open:   true,
-				Full:   yyDollar[3].fullOptUnion(),
-				DBName: yyDollar[5].str,
-				Like:   yyDollar[6].comparisionExprUnion(),
+			yyLOCAL = &tree.ShowGrants{}
+		}
+		yyVAL.union = yyLOCAL
+	case 260:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1849
+		{
+			yyLOCAL = &tree.ShowGrants{}
+		}
+		yyVAL.union = yyLOCAL
+	case 261:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1853
+		{
+			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname}
+		}
+		yyVAL.union = yyLOCAL
+	case 262:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1859
+		{
+			yyLOCAL = &tree.ShowTables{
+				Open:   false,
+				Full:   yyDollar[2].fullOptUnion(),
+				DBName: yyDollar[4].str,
+				Like:   yyDollar[5].comparisionExprUnion(),
+				Where:  yyDollar[6].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 263:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1869
+		{
+			yyLOCAL = &tree.ShowTables{
+				Open:   true,
+				Full:   yyDollar[3].fullOptUnion(),
+				DBName: yyDollar[5].str,
+				Like:   yyDollar[6].comparisionExprUnion(),
 				Where:  yyDollar[7].whereUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 264:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1805
+//line mysql_sql.y:1881
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 265:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1811
+//line mysql_sql.y:1887
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -7001,10 +7344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 266:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1823
+//line mysql_sql.y:1899
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -7017,118 +7360,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 267:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1836
+//line mysql_sql.y:1912
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1840
+//line mysql_sql.y:1916
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 269:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1845
+//line mysql_sql.y:1921
 		{
 			yyVAL.str = ""
 		}
-	case 258:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1849
+//line mysql_sql.y:1925
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 259:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1855
+//line mysql_sql.y:1931
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1868
+//line mysql_sql.y:1944
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1872
+//line mysql_sql.y:1948
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1878
+//line mysql_sql.y:1954
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 279:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:1958
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1888
+//line mysql_sql.y:1964
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1892
+//line mysql_sql.y:1968
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1901
+//line mysql_sql.y:1977
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1905
+//line mysql_sql.y:1981
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 285:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1909
+//line mysql_sql.y:1985
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1925
+//line mysql_sql.y:2001
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7136,10 +7479,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1934
+//line mysql_sql.y:2010
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7147,10 +7490,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 294:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1943
+//line mysql_sql.y:2019
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -7159,26 +7502,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1953
+//line mysql_sql.y:2029
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1959
+//line mysql_sql.y:2035
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 297:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1965
+//line mysql_sql.y:2041
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -7188,21 +7531,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 298:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1980
+//line mysql_sql.y:2056
 		{
-			ins := yyDollar[4].insertUnion()
-			ins.Table = yyDollar[2].tableExprUnion()
-			ins.PartitionNames = yyDollar[3].identifierListUnion()
+			ins := yyDollar[5].insertUnion()
+			ins.Ignore = yyDollar[2].boolValUnion()
+			ins.Table = yyDollar[3].tableExprUnion()
+			ins.PartitionNames = yyDollar[4].identifierListUnion()
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 299:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2065
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2069
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 301:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1989
+//line mysql_sql.y:2075
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7210,20 +7570,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1996
+//line mysql_sql.y:2082
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 303:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2002
+//line mysql_sql.y:2088
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7232,10 +7592,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2010
+//line mysql_sql.y:2096
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7243,10 +7603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2017
+//line mysql_sql.y:2103
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -7254,10 +7614,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2024
+//line mysql_sql.y:2110
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -7276,34 +7636,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2043
+//line mysql_sql.y:2129
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2047
+//line mysql_sql.y:2133
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2051
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2057
+//line mysql_sql.y:2143
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -7311,155 +7671,171 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2066
+//line mysql_sql.y:2152
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2070
+//line mysql_sql.y:2156
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2076
+//line mysql_sql.y:2162
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 300:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2080
+//line mysql_sql.y:2166
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 301:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2086
+//line mysql_sql.y:2172
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2090
+//line mysql_sql.y:2176
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2096
+//line mysql_sql.y:2182
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2101
+//line mysql_sql.y:2187
 		{
 		}
-	case 306:
+	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2191
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2112
+//line mysql_sql.y:2198
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2116
+//line mysql_sql.y:2202
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2123
+//line mysql_sql.y:2209
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2128
+//line mysql_sql.y:2214
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2132
+//line mysql_sql.y:2218
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2138
+//line mysql_sql.y:2224
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2142
+//line mysql_sql.y:2228
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2148
+//line mysql_sql.y:2234
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2152
+//line mysql_sql.y:2238
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 332:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2243
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:2247
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2157
+//line mysql_sql.y:2252
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 335:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2161
+//line mysql_sql.y:2256
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7472,10 +7848,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2174
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7483,10 +7859,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 337:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2181
+//line mysql_sql.y:2276
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7494,10 +7870,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 338:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2188
+//line mysql_sql.y:2283
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7516,10 +7892,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 339:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2206
+//line mysql_sql.y:2301
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7538,38 +7914,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2225
+//line mysql_sql.y:2320
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2231
+//line mysql_sql.y:2326
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2238
+//line mysql_sql.y:2333
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2242
+//line mysql_sql.y:2337
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7582,115 +7958,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2255
+//line mysql_sql.y:2350
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2259
+//line mysql_sql.y:2354
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2359
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2268
+//line mysql_sql.y:2363
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2275
+//line mysql_sql.y:2370
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2280
+//line mysql_sql.y:2375
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2287
+//line mysql_sql.y:2382
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 352:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2293
+//line mysql_sql.y:2388
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion(), ForUpdate: yyDollar[5].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2297
+//line mysql_sql.y:2392
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2301
+//line mysql_sql.y:2396
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 355:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2305
+//line mysql_sql.y:2400
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion(), ForUpdate: yyDollar[6].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2309
+//line mysql_sql.y:2404
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 357:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2313
+//line mysql_sql.y:2408
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2319
+//line mysql_sql.y:2414
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7698,10 +8074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2326
+//line mysql_sql.y:2421
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7709,26 +8085,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2335
+//line mysql_sql.y:2430
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2339
+//line mysql_sql.y:2434
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 362:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2345
+//line mysql_sql.y:2440
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7736,162 +8112,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 363:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2353
+//line mysql_sql.y:2448
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2357
+//line mysql_sql.y:2452
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2362
+//line mysql_sql.y:2457
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2366
+//line mysql_sql.y:2461
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2372
+//line mysql_sql.y:2467
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2376
+//line mysql_sql.y:2471
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 369:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2380
+//line mysql_sql.y:2475
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 370:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2385
+//line mysql_sql.y:2480
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2389
+//line mysql_sql.y:2484
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2395
+//line mysql_sql.y:2490
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2401
+//line mysql_sql.y:2496
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2405
+//line mysql_sql.y:2500
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2411
+//line mysql_sql.y:2506
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2416
+//line mysql_sql.y:2511
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2420
+//line mysql_sql.y:2515
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2424
+//line mysql_sql.y:2519
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2431
+//line mysql_sql.y:2526
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2435
+//line mysql_sql.y:2530
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2441
+//line mysql_sql.y:2536
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2445
+//line mysql_sql.y:2540
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7902,10 +8278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2455
+//line mysql_sql.y:2550
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7916,10 +8292,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2465
+//line mysql_sql.y:2560
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7930,10 +8306,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2475
+//line mysql_sql.y:2570
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7944,10 +8320,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2487
+//line mysql_sql.y:2582
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7956,10 +8332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2495
+//line mysql_sql.y:2590
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7968,10 +8344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2503
+//line mysql_sql.y:2598
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7980,161 +8356,213 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 389:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2513
+//line mysql_sql.y:2608
 		{
 			yyLOCAL = &tree.SelectClause{
-				Distinct: yyDollar[2].boolValUnion(),
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
+				Hints:    yyDollar[2].optimizerHintsUnion(),
+				Distinct: yyDollar[3].boolValUnion(),
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				Into:     yyDollar[5].varExprsUnion(),
+				From:     yyDollar[6].fromUnion(),
+				Where:    yyDollar[7].whereUnion(),
+				GroupBy:  yyDollar[8].groupByUnion(),
+				Having:   yyDollar[9].whereUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 390:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2524
+//line mysql_sql.y:2621
 		{
 			yyLOCAL = &tree.SelectClause{
+				Hints:    yyDollar[2].optimizerHintsUnion(),
 				Distinct: false,
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
-				Option:   yyDollar[2].str,
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				Into:     yyDollar[5].varExprsUnion(),
+				From:     yyDollar[6].fromUnion(),
+				Where:    yyDollar[7].whereUnion(),
+				GroupBy:  yyDollar[8].groupByUnion(),
+				Having:   yyDollar[9].whereUnion(),
+				Option:   yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 391:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:2636
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:2640
+		{
+			yyLOCAL = yyDollar[2].varExprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:2646
+		{
+			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 394:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:2650
+		{
+			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 395:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.OptimizerHints
+//line mysql_sql.y:2655
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 396:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.OptimizerHints
+//line mysql_sql.y:2659
+		{
+			yyLOCAL = tree.NewOptimizerHints(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 400:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2542
+//line mysql_sql.y:2669
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2546
+//line mysql_sql.y:2673
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2550
+//line mysql_sql.y:2677
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 405:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2559
+//line mysql_sql.y:2686
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2563
+//line mysql_sql.y:2690
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 407:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2568
+//line mysql_sql.y:2695
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2572
+//line mysql_sql.y:2699
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 409:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2577
+//line mysql_sql.y:2704
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2581
+//line mysql_sql.y:2708
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2587
+//line mysql_sql.y:2714
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2591
+//line mysql_sql.y:2718
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2597
+//line mysql_sql.y:2724
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2601
+//line mysql_sql.y:2728
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2605
+//line mysql_sql.y:2732
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 416:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2609
+//line mysql_sql.y:2736
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 417:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2615
+//line mysql_sql.y:2742
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -8143,44 +8571,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2623
+//line mysql_sql.y:2750
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2629
+//line mysql_sql.y:2756
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2637
+//line mysql_sql.y:2764
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2641
+//line mysql_sql.y:2768
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2778
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8190,10 +8618,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 425:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2660
+//line mysql_sql.y:2787
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8203,10 +8631,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 426:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2670
+//line mysql_sql.y:2797
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8216,10 +8644,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2679
+//line mysql_sql.y:2806
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8228,15 +8656,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2689
+//line mysql_sql.y:2816
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 407:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2693
+//line mysql_sql.y:2820
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -8244,130 +8672,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 408:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2830
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 409:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2707
+//line mysql_sql.y:2834
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 410:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2711
+//line mysql_sql.y:2838
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 411:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2715
+//line mysql_sql.y:2842
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 412:
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2721
+//line mysql_sql.y:2848
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2725
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2731
+//line mysql_sql.y:2858
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 415:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2737
+//line mysql_sql.y:2864
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 416:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2741
+//line mysql_sql.y:2868
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 417:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2745
+//line mysql_sql.y:2872
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 418:
+	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2751
+//line mysql_sql.y:2878
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2755
+//line mysql_sql.y:2882
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2761
+//line mysql_sql.y:2888
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2765
+//line mysql_sql.y:2892
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2771
+//line mysql_sql.y:2898
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2775
+//line mysql_sql.y:2902
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2781
+//line mysql_sql.y:2908
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2785
+//line mysql_sql.y:2912
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -8378,28 +8806,62 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 448:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2922
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableFunctionUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+					Cols:  yyDollar[3].identifierListUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 449:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.TableFunction
+//line mysql_sql.y:2935
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = tree.NewTableFunction(&tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2798
+//line mysql_sql.y:2945
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 451:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.ParenTableExpr
+//line mysql_sql.y:2949
+		{
+			yyLOCAL = &tree.ParenTableExpr{Expr: tree.NewSelect(tree.NewValuesClause(yyDollar[3].rowsExprsUnion()), nil, nil)}
+		}
+		yyVAL.union = yyLOCAL
+	case 452:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2803
+//line mysql_sql.y:2954
 		{
 		}
-	case 428:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2804
+//line mysql_sql.y:2955
 		{
 		}
-	case 429:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2808
+//line mysql_sql.y:2959
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -8409,58 +8871,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 455:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2820
+//line mysql_sql.y:2971
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2824
+//line mysql_sql.y:2975
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2828
+//line mysql_sql.y:2979
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 435:
+	case 460:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2837
+//line mysql_sql.y:2988
 		{
 			yyVAL.str = ""
 		}
-	case 436:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2841
+//line mysql_sql.y:2992
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 437:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2845
+//line mysql_sql.y:2996
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 438:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2849
+//line mysql_sql.y:3000
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 439:
+	case 464:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2853
+//line mysql_sql.y:3004
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 454:
+	case 479:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2883
+//line mysql_sql.y:3034
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -8471,10 +8933,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 480:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2893
+//line mysql_sql.y:3044
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -8485,15 +8947,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2904
+//line mysql_sql.y:3055
 		{
 		}
-	case 458:
+	case 483:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2909
+//line mysql_sql.y:3060
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8503,189 +8965,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 484:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2919
+//line mysql_sql.y:3070
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2923
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 486:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2929
+//line mysql_sql.y:3080
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2933
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2939
+//line mysql_sql.y:3090
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2943
+//line mysql_sql.y:3094
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2947
+//line mysql_sql.y:3098
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2951
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2957
+//line mysql_sql.y:3108
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2964
+//line mysql_sql.y:3115
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2969
+//line mysql_sql.y:3120
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2974
+//line mysql_sql.y:3125
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2979
+//line mysql_sql.y:3130
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2985
+//line mysql_sql.y:3136
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2989
+//line mysql_sql.y:3140
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2993
+//line mysql_sql.y:3144
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2999
+//line mysql_sql.y:3150
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3003
+//line mysql_sql.y:3154
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3007
+//line mysql_sql.y:3158
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3011
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3017
+//line mysql_sql.y:3168
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3021
+//line mysql_sql.y:3172
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3027
+//line mysql_sql.y:3178
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8697,42 +9159,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3040
+//line mysql_sql.y:3191
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3044
+//line mysql_sql.y:3195
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3048
+//line mysql_sql.y:3199
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3053
+//line mysql_sql.y:3204
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3057
+//line mysql_sql.y:3208
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8740,20 +9202,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3064
+//line mysql_sql.y:3215
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 514:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3070
+//line mysql_sql.y:3221
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8762,10 +9224,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 515:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3078
+//line mysql_sql.y:3229
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8773,20 +9235,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 516:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3085
+//line mysql_sql.y:3236
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 519:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3097
+//line mysql_sql.y:3248
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8794,82 +9256,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3106
+//line mysql_sql.y:3257
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3110
+//line mysql_sql.y:3261
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3116
+//line mysql_sql.y:3267
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3120
+//line mysql_sql.y:3271
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3124
+//line mysql_sql.y:3275
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3133
+//line mysql_sql.y:3284
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3137
+//line mysql_sql.y:3288
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3141
+//line mysql_sql.y:3292
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3145
+//line mysql_sql.y:3296
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 531:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3151
+//line mysql_sql.y:3302
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8888,18 +9350,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3170
+//line mysql_sql.y:3321
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3174
+//line mysql_sql.y:3325
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8920,123 +9382,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3196
+//line mysql_sql.y:3347
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3200
+//line mysql_sql.y:3351
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3204
+//line mysql_sql.y:3355
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3208
+//line mysql_sql.y:3359
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3212
+//line mysql_sql.y:3363
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3218
+//line mysql_sql.y:3369
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3222
+//line mysql_sql.y:3373
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3228
+//line mysql_sql.y:3379
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3233
+//line mysql_sql.y:3384
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 543:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3238
+//line mysql_sql.y:3389
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3242
+//line mysql_sql.y:3393
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3246
+//line mysql_sql.y:3397
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3250
+//line mysql_sql.y:3401
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3254
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 548:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3260
+//line mysql_sql.y:3411
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -9045,98 +9507,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3274
+//line mysql_sql.y:3425
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3278
+//line mysql_sql.y:3429
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3283
+//line mysql_sql.y:3434
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3287
+//line mysql_sql.y:3438
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3293
+//line mysql_sql.y:3444
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3297
+//line mysql_sql.y:3448
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3303
+//line mysql_sql.y:3454
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3307
+//line mysql_sql.y:3458
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3311
+//line mysql_sql.y:3462
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3316
+//line mysql_sql.y:3467
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3320
+//line mysql_sql.y:3471
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 562:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3326
+//line mysql_sql.y:3477
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -9148,34 +9610,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 563:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3338
+//line mysql_sql.y:3489
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3342
+//line mysql_sql.y:3493
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 565:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3347
+//line mysql_sql.y:3498
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 566:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3351
+//line mysql_sql.y:3502
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -9185,18 +9647,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3361
+//line mysql_sql.y:3512
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3365
+//line mysql_sql.y:3516
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -9205,42 +9667,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3374
+//line mysql_sql.y:3525
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3378
+//line mysql_sql.y:3529
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3384
+//line mysql_sql.y:3535
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3388
+//line mysql_sql.y:3539
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3394
+//line mysql_sql.y:3545
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9250,10 +9712,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 574:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3403
+//line mysql_sql.y:3554
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9263,42 +9725,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3413
+//line mysql_sql.y:3564
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3417
+//line mysql_sql.y:3568
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3423
+//line mysql_sql.y:3574
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3427
+//line mysql_sql.y:3578
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3433
+//line mysql_sql.y:3584
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9306,10 +9768,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3440
+//line mysql_sql.y:3591
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9317,50 +9779,66 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3449
+//line mysql_sql.y:3600
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3453
+//line mysql_sql.y:3604
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3458
+//line mysql_sql.y:3609
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 584:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3462
+//line mysql_sql.y:3613
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 585:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3617
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 586:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3621
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3467
+//line mysql_sql.y:3626
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3471
+//line mysql_sql.y:3630
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -9370,18 +9848,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3481
+//line mysql_sql.y:3640
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3485
+//line mysql_sql.y:3644
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -9391,10 +9869,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3496
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9403,10 +9881,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 592:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3504
+//line mysql_sql.y:3663
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9415,10 +9893,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3512
+//line mysql_sql.y:3671
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9427,10 +9905,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 594:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3520
+//line mysql_sql.y:3679
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9439,10 +9917,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 596:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3531
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -9453,10 +9931,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 597:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3541
+//line mysql_sql.y:3700
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -9466,478 +9944,486 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 598:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3551
+//line mysql_sql.y:3710
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3555
+//line mysql_sql.y:3714
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3560
+//line mysql_sql.y:3719
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3564
+//line mysql_sql.y:3723
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3569
+//line mysql_sql.y:3728
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3573
+//line mysql_sql.y:3732
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3579
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3583
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 606:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3587
+//line mysql_sql.y:3746
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3593
+//line mysql_sql.y:3752
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3597
+//line mysql_sql.y:3756
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 609:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3601
+//line mysql_sql.y:3760
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 610:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3605
+//line mysql_sql.y:3764
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3609
+//line mysql_sql.y:3768
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3613
+//line mysql_sql.y:3772
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3617
+//line mysql_sql.y:3776
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3621
+//line mysql_sql.y:3780
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 615:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3625
+//line mysql_sql.y:3784
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3629
+//line mysql_sql.y:3788
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3633
+//line mysql_sql.y:3792
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3637
+//line mysql_sql.y:3796
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3641
+//line mysql_sql.y:3800
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3645
+//line mysql_sql.y:3804
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3649
+//line mysql_sql.y:3808
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3653
+//line mysql_sql.y:3812
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3657
+//line mysql_sql.y:3816
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3661
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3665
+//line mysql_sql.y:3824
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3669
+//line mysql_sql.y:3828
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3673
+//line mysql_sql.y:3832
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3677
+//line mysql_sql.y:3836
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3681
+//line mysql_sql.y:3840
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3685
+//line mysql_sql.y:3844
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3689
+//line mysql_sql.y:3848
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3693
+//line mysql_sql.y:3852
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 633:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3697
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 634:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3701
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 635:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3705
+//line mysql_sql.y:3864
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 636:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line mysql_sql.y:3868
+		{
+			yyLOCAL = tree.NewTableOptionTTL(yyDollar[3].str, yyDollar[6].item.(int64), yyDollar[7].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3712
+//line mysql_sql.y:3875
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3716
+//line mysql_sql.y:3879
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3722
+//line mysql_sql.y:3885
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3727
+//line mysql_sql.y:3890
 		{
 			yyVAL.str = ""
 		}
-	case 613:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3731
+//line mysql_sql.y:3894
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 614:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3735
+//line mysql_sql.y:3898
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 615:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3741
+//line mysql_sql.y:3904
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3745
+//line mysql_sql.y:3908
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3749
+//line mysql_sql.y:3912
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3753
+//line mysql_sql.y:3916
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3757
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3761
+//line mysql_sql.y:3924
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3777
+//line mysql_sql.y:3940
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3781
+//line mysql_sql.y:3944
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3790
+//line mysql_sql.y:3953
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3795
+//line mysql_sql.y:3958
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3801
+//line mysql_sql.y:3964
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3808
+//line mysql_sql.y:3971
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3812
+//line mysql_sql.y:3975
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3818
+//line mysql_sql.y:3981
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3822
+//line mysql_sql.y:3985
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3828
+//line mysql_sql.y:3991
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9948,18 +10434,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3838
+//line mysql_sql.y:4001
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 667:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3844
+//line mysql_sql.y:4007
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9969,10 +10455,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 668:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3853
+//line mysql_sql.y:4016
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9982,10 +10468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 669:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3862
+//line mysql_sql.y:4025
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -10009,10 +10495,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 670:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3885
+//line mysql_sql.y:4048
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10022,10 +10508,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 671:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3894
+//line mysql_sql.y:4057
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -10036,10 +10522,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 672:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3904
+//line mysql_sql.y:4067
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -10047,298 +10533,370 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3912
+//line mysql_sql.y:4075
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 675:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3918
+//line mysql_sql.y:4081
 		{
 			yyVAL.str = ""
 		}
-	case 648:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3922
+//line mysql_sql.y:4085
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 651:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3932
+//line mysql_sql.y:4095
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3938
+//line mysql_sql.y:4101
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3944
+//line mysql_sql.y:4107
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 687:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3958
+//line mysql_sql.y:4121
 		{
 			yyVAL.str = ""
 		}
-	case 661:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3965
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3971
+//line mysql_sql.y:4134
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3975
+//line mysql_sql.y:4138
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 692:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3979
+//line mysql_sql.y:4142
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3990
+//line mysql_sql.y:4153
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3994
+//line mysql_sql.y:4157
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 698:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3998
+//line mysql_sql.y:4161
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 699:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4003
+//line mysql_sql.y:4166
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4007
+//line mysql_sql.y:4170
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4013
+//line mysql_sql.y:4176
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4017
+//line mysql_sql.y:4180
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4023
+//line mysql_sql.y:4186
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4027
+//line mysql_sql.y:4190
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4031
+//line mysql_sql.y:4194
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4035
+//line mysql_sql.y:4198
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4039
+//line mysql_sql.y:4202
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4043
+//line mysql_sql.y:4206
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4047
+//line mysql_sql.y:4210
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4051
+//line mysql_sql.y:4214
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4055
+//line mysql_sql.y:4218
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4059
+//line mysql_sql.y:4222
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4063
+//line mysql_sql.y:4226
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 714:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4067
+//line mysql_sql.y:4230
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 715:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4071
+//line mysql_sql.y:4234
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 716:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4238
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[5].exprUnion(), yyDollar[7].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 717:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4242
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[3].exprUnion(), yyDollar[5].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 718:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4246
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			var es tree.Exprs = nil
+			if yyDollar[4].exprUnion() != nil {
+				es = append(es, yyDollar[4].exprUnion())
+			}
+			yyLOCAL = tree.NewAttributeOnUpdate(&tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 719:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4258
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			var es tree.Exprs = nil
+			if yyDollar[4].exprUnion() != nil {
+				es = append(es, yyDollar[4].exprUnion())
+			}
+			yyLOCAL = tree.NewAttributeOnUpdate(&tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 720:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:4271
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4081
+//line mysql_sql.y:4275
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 722:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 723:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:4285
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4085
+//line mysql_sql.y:4289
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 725:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4090
+//line mysql_sql.y:4294
 		{
 			yyVAL.str = ""
 		}
-	case 691:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4094
+//line mysql_sql.y:4298
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 692:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4100
+//line mysql_sql.y:4304
 		{
 			yyVAL.str = ""
 		}
-	case 693:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4104
+//line mysql_sql.y:4308
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 694:
+	case 729:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4110
+//line mysql_sql.y:4314
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -10349,10 +10907,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 730:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4121
+//line mysql_sql.y:4326
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -10360,10 +10918,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4131
+//line mysql_sql.y:4336
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -10371,10 +10929,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4138
+//line mysql_sql.y:4343
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -10382,10 +10940,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4145
+//line mysql_sql.y:4350
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -10393,10 +10951,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 735:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4152
+//line mysql_sql.y:4357
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -10404,339 +10962,347 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4161
+//line mysql_sql.y:4366
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4167
+//line mysql_sql.y:4372
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4173
+//line mysql_sql.y:4378
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4177
+//line mysql_sql.y:4382
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4181
+//line mysql_sql.y:4386
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4185
+//line mysql_sql.y:4390
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4189
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 743:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4194
+//line mysql_sql.y:4399
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4201
+//line mysql_sql.y:4406
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4205
+//line mysql_sql.y:4410
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4209
+//line mysql_sql.y:4414
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 748:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4214
+//line mysql_sql.y:4419
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4218
+//line mysql_sql.y:4423
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 750:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4223
+//line mysql_sql.y:4428
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4227
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4243
+//line mysql_sql.y:4448
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4454
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4265
+//line mysql_sql.y:4470
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4269
+//line mysql_sql.y:4474
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4273
+//line mysql_sql.y:4478
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4277
+//line mysql_sql.y:4482
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4281
+//line mysql_sql.y:4486
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4285
+//line mysql_sql.y:4490
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4289
+//line mysql_sql.y:4494
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4293
+//line mysql_sql.y:4498
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4297
+//line mysql_sql.y:4502
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4508
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4512
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4311
+//line mysql_sql.y:4516
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4315
+//line mysql_sql.y:4520
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 776:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4319
+//line mysql_sql.y:4524
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4323
+//line mysql_sql.y:4528
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4327
+//line mysql_sql.y:4532
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4331
+//line mysql_sql.y:4536
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 780:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4540
+		{
+			yyLOCAL = tree.NewCollateExpr(yyDollar[1].exprUnion(), yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4335
+//line mysql_sql.y:4544
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4339
+//line mysql_sql.y:4548
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4343
+//line mysql_sql.y:4552
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4347
+//line mysql_sql.y:4556
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 785:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4352
+//line mysql_sql.y:4561
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10745,26 +11311,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 786:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4360
+//line mysql_sql.y:4569
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 787:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4364
+//line mysql_sql.y:4573
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 788:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4368
+//line mysql_sql.y:4577
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -10774,90 +11340,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4377
+//line mysql_sql.y:4586
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4381
+//line mysql_sql.y:4590
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4385
+//line mysql_sql.y:4594
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4389
+//line mysql_sql.y:4598
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 793:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4394
+//line mysql_sql.y:4603
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4398
+//line mysql_sql.y:4607
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4403
+//line mysql_sql.y:4612
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 796:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4407
+//line mysql_sql.y:4616
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4413
+//line mysql_sql.y:4622
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4417
+//line mysql_sql.y:4626
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 799:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4423
+//line mysql_sql.y:4632
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10865,10 +11431,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 801:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:4642
+		{
+			yyLOCAL = yyDollar[1].columnTypeUnion()
+			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4433
+//line mysql_sql.y:4647
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10882,10 +11457,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4446
+//line mysql_sql.y:4660
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10899,10 +11474,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4459
+//line mysql_sql.y:4673
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10915,10 +11490,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4471
+//line mysql_sql.y:4685
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10933,10 +11508,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 806:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4485
+//line mysql_sql.y:4699
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10952,10 +11527,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4500
+//line mysql_sql.y:4714
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10971,10 +11546,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4515
+//line mysql_sql.y:4729
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10988,10 +11563,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4528
+//line mysql_sql.y:4742
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11006,15 +11581,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 810:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4543
+//line mysql_sql.y:4757
 		{
 		}
-	case 776:
+	case 813:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4549
+//line mysql_sql.y:4763
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11024,10 +11599,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 814:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4558
+//line mysql_sql.y:4772
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11036,10 +11611,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 815:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4566
+//line mysql_sql.y:4780
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11048,10 +11623,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 816:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4788
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11061,10 +11636,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 817:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4797
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11074,10 +11649,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 818:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4806
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11087,10 +11662,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 819:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4815
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11100,10 +11675,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 820:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4824
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -11113,10 +11688,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 821:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4619
+//line mysql_sql.y:4833
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11126,10 +11701,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 822:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4628
+//line mysql_sql.y:4842
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11139,10 +11714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 823:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4851
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11152,10 +11727,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 824:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4646
+//line mysql_sql.y:4860
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11165,10 +11740,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 825:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4655
+//line mysql_sql.y:4869
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11178,10 +11753,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 826:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4664
+//line mysql_sql.y:4878
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11191,10 +11766,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 827:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4673
+//line mysql_sql.y:4887
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11204,10 +11779,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 831:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4689
+//line mysql_sql.y:4903
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11216,10 +11791,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 832:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4697
+//line mysql_sql.y:4911
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11228,10 +11803,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 833:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4705
+//line mysql_sql.y:4919
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11240,10 +11815,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 834:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4713
+//line mysql_sql.y:4927
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11252,10 +11827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 835:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4721
+//line mysql_sql.y:4935
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -11265,10 +11840,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 836:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4730
+//line mysql_sql.y:4944
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11277,10 +11852,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 837:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4738
+//line mysql_sql.y:4952
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11290,10 +11865,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 838:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4747
+//line mysql_sql.y:4961
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11303,10 +11878,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 839:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4756
+//line mysql_sql.y:4970
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11315,35 +11890,37 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 840:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4764
+//line mysql_sql.y:4978
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			arg1 := tree.SetUnresolvedName("both")
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+				Exprs: tree.Exprs{arg1, yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 841:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4772
+//line mysql_sql.y:4987
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			arg2 := tree.NewNumValWithType(constant.MakeString(" "), " ", false, tree.P_char)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{arg1, yyDollar[5].exprUnion()},
+				Exprs: tree.Exprs{arg1, arg2, yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 842:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4781
+//line mysql_sql.y:4997
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -11353,16 +11930,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4801
+//line mysql_sql.y:5017
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 840:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4837
+//line mysql_sql.y:5053
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -11375,10 +11952,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4849
+//line mysql_sql.y:5065
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -11391,10 +11968,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 879:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4863
+//line mysql_sql.y:5079
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11403,10 +11980,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4871
+//line mysql_sql.y:5087
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11414,10 +11991,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4878
+//line mysql_sql.y:5094
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -11430,10 +12007,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 882:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4890
+//line mysql_sql.y:5106
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -11442,10 +12019,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 883:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4898
+//line mysql_sql.y:5114
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -11457,10 +12034,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 884:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4909
+//line mysql_sql.y:5125
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -11470,10 +12047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4918
+//line mysql_sql.y:5134
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -11483,10 +12060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 886:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4927
+//line mysql_sql.y:5143
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -11495,10 +12072,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 887:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4935
+//line mysql_sql.y:5151
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -11509,10 +12086,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 888:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4945
+//line mysql_sql.y:5161
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -11521,10 +12098,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4953
+//line mysql_sql.y:5169
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -11533,10 +12110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 890:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4961
+//line mysql_sql.y:5177
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -11546,52 +12123,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 891:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4971
+//line mysql_sql.y:5187
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4975
+//line mysql_sql.y:5191
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 893:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4981
+//line mysql_sql.y:5197
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 894:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4985
+//line mysql_sql.y:5201
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4998
+//line mysql_sql.y:5214
 		{
 		}
-	case 865:
+	case 902:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5000
+//line mysql_sql.y:5216
 		{
 		}
-	case 898:
+	case 935:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5040
+//line mysql_sql.y:5256
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
@@ -11601,10 +12178,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5049
+//line mysql_sql.y:5265
 		{
 			str := strconv.FormatInt(yyDollar[2].item.(int64), 10)
 			str += " " + yyDollar[3].str
@@ -11616,10 +12193,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 937:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5060
+//line mysql_sql.y:5276
 		{
 			str := yyDollar[2].str
 			str += " " + yyDollar[3].str
@@ -11631,10 +12208,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 938:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5287
+		{
+			name := tree.SetUnresolvedName("interval")
+			unit := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[2].unresolvedNameUnion(), unit},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 939:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5071
+//line mysql_sql.y:5296
 		{
 			str := strconv.FormatInt(-yyDollar[3].item.(int64), 10)
 			str += " " + yyDollar[4].str
@@ -11646,404 +12236,404 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 940:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5083
+//line mysql_sql.y:5308
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5087
+//line mysql_sql.y:5312
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5091
+//line mysql_sql.y:5316
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 943:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:5097
+//line mysql_sql.y:5322
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 944:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5102
+//line mysql_sql.y:5327
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5106
+//line mysql_sql.y:5331
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5112
+//line mysql_sql.y:5337
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5116
+//line mysql_sql.y:5341
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5123
+//line mysql_sql.y:5348
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 949:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5127
+//line mysql_sql.y:5352
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 950:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5131
+//line mysql_sql.y:5356
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5135
+//line mysql_sql.y:5360
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 952:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5139
+//line mysql_sql.y:5364
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 953:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5143
+//line mysql_sql.y:5368
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 954:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5147
+//line mysql_sql.y:5372
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 955:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5152
+//line mysql_sql.y:5377
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5157
+//line mysql_sql.y:5382
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 957:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5163
+//line mysql_sql.y:5388
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 958:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5167
+//line mysql_sql.y:5392
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5171
+//line mysql_sql.y:5396
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5175
+//line mysql_sql.y:5400
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5182
+//line mysql_sql.y:5407
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5186
+//line mysql_sql.y:5411
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5192
+//line mysql_sql.y:5417
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 965:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5196
+//line mysql_sql.y:5421
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 966:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5200
+//line mysql_sql.y:5425
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 967:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5204
+//line mysql_sql.y:5429
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5208
+//line mysql_sql.y:5433
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 969:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5212
+//line mysql_sql.y:5437
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 970:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5216
+//line mysql_sql.y:5441
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 971:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5220
+//line mysql_sql.y:5445
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 973:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5226
+//line mysql_sql.y:5451
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 974:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5230
+//line mysql_sql.y:5455
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5236
+//line mysql_sql.y:5461
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5240
+//line mysql_sql.y:5465
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5247
+//line mysql_sql.y:5472
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5251
+//line mysql_sql.y:5476
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5255
+//line mysql_sql.y:5480
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5261
+//line mysql_sql.y:5486
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5265
+//line mysql_sql.y:5490
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5269
+//line mysql_sql.y:5494
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5273
+//line mysql_sql.y:5498
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5277
+//line mysql_sql.y:5502
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5281
+//line mysql_sql.y:5506
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5285
+//line mysql_sql.y:5510
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5291
+//line mysql_sql.y:5516
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5295
+//line mysql_sql.y:5520
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5299
+//line mysql_sql.y:5524
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5303
+//line mysql_sql.y:5528
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5309
+//line mysql_sql.y:5534
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -12059,104 +12649,119 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5326
+//line mysql_sql.y:5551
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5330
+//line mysql_sql.y:5555
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5335
+//line mysql_sql.y:5560
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5340
+//line mysql_sql.y:5565
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5344
+//line mysql_sql.y:5569
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5348
+//line mysql_sql.y:5573
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5352
+//line mysql_sql.y:5577
 		{
-			ival := util.GetUint64(yyDollar[1].item)
-			yyLOCAL = tree.NewNumValWithType(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(string(yyDollar[1].item.([]byte))), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
+		}
+		yyVAL.union = yyLOCAL
+	case 999:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5581
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(string(yyDollar[1].item.([]byte))), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
+		}
+		yyVAL.union = yyLOCAL
+	case 1000:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5585
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(string(yyDollar[1].item.([]byte))), yylex.(*Lexer).scanner.LastToken, false, tree.P_bit)
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5357
+//line mysql_sql.y:5589
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal128)
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5366
+//line mysql_sql.y:5596
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1006:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5377
+//line mysql_sql.y:5607
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5382
+//line mysql_sql.y:5612
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5388
+//line mysql_sql.y:5618
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12169,10 +12774,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5400
+//line mysql_sql.y:5630
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12185,10 +12790,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5412
+//line mysql_sql.y:5642
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12201,10 +12806,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5424
+//line mysql_sql.y:5654
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12218,10 +12823,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5437
+//line mysql_sql.y:5667
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12235,10 +12840,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5450
+//line mysql_sql.y:5680
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12252,10 +12857,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5463
+//line mysql_sql.y:5693
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12269,10 +12874,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1015:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5476
+//line mysql_sql.y:5706
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12286,10 +12891,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5489
+//line mysql_sql.y:5719
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12303,10 +12908,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5502
+//line mysql_sql.y:5732
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12320,10 +12925,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5515
+//line mysql_sql.y:5745
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12337,10 +12942,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5528
+//line mysql_sql.y:5758
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12354,10 +12959,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5541
+//line mysql_sql.y:5771
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12371,10 +12976,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5554
+//line mysql_sql.y:5784
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12388,10 +12993,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1022:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5569
+//line mysql_sql.y:5799
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -12415,10 +13020,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1023:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5592
+//line mysql_sql.y:5822
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -12455,10 +13060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1024:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5629
+//line mysql_sql.y:5859
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -12495,10 +13100,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1025:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5677
+//line mysql_sql.y:5907
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12514,10 +13119,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5694
+//line mysql_sql.y:5924
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12530,10 +13135,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1027:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5706
+//line mysql_sql.y:5936
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12549,10 +13154,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1028:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5721
+//line mysql_sql.y:5951
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -12573,10 +13178,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1029:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5741
+//line mysql_sql.y:5971
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12592,10 +13197,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1030:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5756
+//line mysql_sql.y:5986
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12610,10 +13215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1031:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5772
+//line mysql_sql.y:6002
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12627,10 +13232,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1032:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5785
+//line mysql_sql.y:6015
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12644,10 +13249,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1033:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5798
+//line mysql_sql.y:6028
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12661,10 +13266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1034:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5811
+//line mysql_sql.y:6041
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12678,10 +13283,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5824
+//line mysql_sql.y:6054
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12694,10 +13299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5836
+//line mysql_sql.y:6066
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12710,10 +13315,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5848
+//line mysql_sql.y:6078
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12726,10 +13331,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1038:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5860
+//line mysql_sql.y:6090
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12742,10 +13347,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5872
+//line mysql_sql.y:6102
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12758,10 +13363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5884
+//line mysql_sql.y:6114
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12774,10 +13379,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5896
+//line mysql_sql.y:6126
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12790,10 +13395,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1042:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5908
+//line mysql_sql.y:6138
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12806,10 +13411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1043:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5920
+//line mysql_sql.y:6150
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12822,10 +13427,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1044:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5932
+//line mysql_sql.y:6162
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12839,10 +13444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1045:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5945
+//line mysql_sql.y:6175
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12856,10 +13461,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5960
+//line mysql_sql.y:6190
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12872,75 +13477,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5983
+//line mysql_sql.y:6213
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1048:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5988
+//line mysql_sql.y:6218
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1049:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5994
+//line mysql_sql.y:6224
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1051:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6001
+//line mysql_sql.y:6231
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1052:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6005
+//line mysql_sql.y:6235
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1053:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6010
+//line mysql_sql.y:6240
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1054:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6014
+//line mysql_sql.y:6244
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1055:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6020
+//line mysql_sql.y:6250
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1056:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6026
+//line mysql_sql.y:6256
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12948,10 +13553,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1057:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6033
+//line mysql_sql.y:6263
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12959,10 +13564,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1058:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6040
+//line mysql_sql.y:6270
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12970,10 +13575,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1059:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6049
+//line mysql_sql.y:6279
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12981,10 +13586,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1060:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6056
+//line mysql_sql.y:6286
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12992,10 +13597,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1061:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6063
+//line mysql_sql.y:6293
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -13003,52 +13608,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1062:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6072
+//line mysql_sql.y:6302
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1063:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6076
+//line mysql_sql.y:6306
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1064:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6080
+//line mysql_sql.y:6310
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1065:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6086
+//line mysql_sql.y:6316
 		{
 		}
-	case 1026:
+	case 1066:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6088
+//line mysql_sql.y:6318
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1070:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6098
+//line mysql_sql.y:6328
 		{
 			yyVAL.str = ""
 		}
-	case 1031:
+	case 1071:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6102
+//line mysql_sql.y:6332
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
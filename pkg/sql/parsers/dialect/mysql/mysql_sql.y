@@ -59,6 +59,7 @@ import (
     limit *tree.Limit
     unionTypeRecord *tree.UnionTypeRecord
     parenTableExpr *tree.ParenTableExpr
+    tableFunction *tree.TableFunction
     identifierList tree.IdentifierList
     joinCond tree.JoinCond
 
@@ -147,6 +148,7 @@ import (
     fieldsList []*tree.Fields
     lines *tree.Lines
     varExpr *tree.VarExpr
+    varExprs []*tree.VarExpr
     loadColumn tree.LoadColumn
     loadColumns []tree.LoadColumn
     assignments []*tree.Assignment
@@ -162,6 +164,7 @@ import (
     withClause *tree.With
     cte *tree.CTE
     cteList []*tree.CTE
+    optimizerHints *tree.OptimizerHints
 }
 
 %token LEX_ERROR
@@ -172,10 +175,14 @@ import (
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%nonassoc LOWER_THAN_ON
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
 %token <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD
+%token <str> OPTIMIZER_HINT
+// INTO right before a user variable, see Lexer.Lex
+%token <str> INTO_VARIABLE
 %token <item> INTEGRAL HEX HEXNUM BIT_LITERAL FLOAT
 %token <str> NULL TRUE FALSE
 %left EMPTY_FROM_CLAUSE
@@ -195,8 +202,11 @@ import (
 %left <str> '*' '/' DIV '%' MOD
 %left <str> '^'
 %right <str> '~' UNARY
+%nonassoc LOWER_THAN_COLLATE
 %left <str> COLLATE
 %right <str> BINARY UNDERSCORE_BINARY
+%nonassoc LOWER_THAN_TIME_UNIT
+%nonassoc <str> YEAR MONTH QUARTER
 %right <str> INTERVAL
 %nonassoc <str> '.'
 
@@ -207,7 +217,7 @@ import (
 // Type
 %token <str> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
 %token <str> REAL DOUBLE FLOAT_TYPE DECIMAL NUMERIC DECIMAL_VALUE
-%token <str> TIME TIMESTAMP DATETIME YEAR
+%token <str> TIME TIMESTAMP DATETIME
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
 %token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM
@@ -224,9 +234,10 @@ import (
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
-%token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
+%token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES TTL
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
+%token <str> GENERATED ALWAYS STORED VIRTUAL
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS
 %token <str> TYPE ANY SOME
 
@@ -241,7 +252,7 @@ import (
 %token <str> EXPIRE ACCOUNT UNLOCK DAY NEVER
 
 // Time
-%token <str> SECOND ASCII COALESCE COLLATION HOUR MICROSECOND MINUTE MONTH QUARTER REPEAT
+%token <str> SECOND ASCII COALESCE COLLATION HOUR MICROSECOND MINUTE REPEAT
 %token <str> REVERSE ROW_COUNT WEEK
 
 // Revoke
@@ -263,6 +274,10 @@ import (
 
 // Supported SHOW tokens
 %token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
+%token <str> PROFILE PROCESS GRANTS
+
+// CHECKSUM TABLE tokens
+%token <str> QUICK
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -309,14 +324,14 @@ import (
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
-%type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt
+%type <statement> show_variables_stmt show_status_stmt show_index_stmt show_profile_stmt show_grants_stmt
+%type <statement> alter_user_stmt alter_table_stmt update_stmt use_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
-%type <statement> analyze_stmt
+%type <statement> analyze_stmt checksum_stmt
 %type <exportParm> export_data_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -359,6 +374,7 @@ import (
 %type <aliasedTableExpr> aliased_table_name
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> derived_table
+%type <tableFunction> table_function
 %type <str> inner_join straight_join outer_join natural_join
 %type <funcType> func_type_opt
 %type <funcExpr> function_call_generic
@@ -416,8 +432,8 @@ import (
 %type <updateExpr> update_expression
 %type <updateExprs> update_list
 %type <completionType> completion_type
-%type <str> id_prefix_at password_opt
-%type <boolVal> grant_option_opt enforce enforce_opt
+%type <str> password_opt
+%type <boolVal> grant_option_opt enforce enforce_opt stored_opt
 
 %type <varAssignmentExpr> var_assignment
 %type <varAssignmentExprs> var_assignment_list
@@ -459,7 +475,7 @@ import (
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
-%type <boolVal> local_opt
+%type <boolVal> local_opt checksum_opt select_lock_opt ignore_opt
 %type <duplicateKey> duplicate_opt
 %type <fields> load_fields field_item export_fields
 %type <fieldsList> field_item_list
@@ -467,6 +483,7 @@ import (
 %type <lines> load_lines export_lines_opt
 %type <int64Val> ignore_lines
 %type <varExpr> user_variable variable system_variable
+%type <varExprs> user_variable_list select_into_opt
 %type <loadColumn> columns_or_variable
 %type <loadColumns> columns_or_variable_list columns_or_variable_list_opt
 %type <unresolvedName> normal_ident
@@ -491,6 +508,7 @@ import (
 %type <epxlainOption> utility_option_elem
 %type <str> utility_option_name utility_option_arg
 %type <str> explain_option_key select_option_opt
+%type <optimizerHints> optimizer_hint_opt
 %type <str> explain_foramt_value view_recursive_opt trim_direction
 
 %start start_command
@@ -523,6 +541,7 @@ stmt:
 |   show_stmt
 |   alter_stmt
 |   analyze_stmt
+|   checksum_stmt
 |   update_stmt
 |   use_stmt
 |   transaction_stmt
@@ -1280,7 +1299,14 @@ var_assignment:
             Value: $4,
         }
     }
-|   id_prefix_at equal_or_assignment set_expr
+|   user_variable equal_or_assignment set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            Name: $1.Name,
+            Value: $3,
+        }
+    }
+|   AT_AT_ID equal_or_assignment set_expr
     {
     	vs := strings.Split($1, ".")
         var isGlobal bool
@@ -1306,6 +1332,7 @@ var_assignment:
 |   NAMES charset_name
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char),
         }
@@ -1313,6 +1340,7 @@ var_assignment:
 |   NAMES charset_name COLLATE DEFAULT
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char),
         }
@@ -1320,6 +1348,7 @@ var_assignment:
 |   NAMES charset_name COLLATE name_string
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char),
             Reserved: tree.NewNumValWithType(constant.MakeString($4), $4, false, tree.P_char),
@@ -1328,6 +1357,7 @@ var_assignment:
 |   NAMES DEFAULT
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: &tree.DefaultVal{},
         }
@@ -1335,6 +1365,7 @@ var_assignment:
 |   charset_keyword charset_name
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char),
         }
@@ -1342,15 +1373,12 @@ var_assignment:
 |   charset_keyword DEFAULT
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: &tree.DefaultVal{},
         }
     }
 
-id_prefix_at:
-    AT_ID
-|   AT_AT_ID
-
 set_expr:
     ON
     {
@@ -1604,10 +1632,36 @@ analyze_stmt:
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
 
+checksum_stmt:
+    CHECKSUM TABLE table_name_list checksum_opt
+    {
+        $$ = &tree.ChecksumTable{Tables: $3, Quick: $4}
+    }
+
+checksum_opt:
+    {
+        $$ = false
+    }
+|   QUICK
+    {
+        $$ = true
+    }
+|   EXTENDED
+    {
+        $$ = false
+    }
+
 alter_stmt:
     alter_user_stmt
+|   alter_table_stmt
 // |    alter_ddl_stmt
 
+alter_table_stmt:
+    ALTER TABLE table_name DROP PARTITION partition_id_list
+    {
+        $$ = tree.NewAlterTable($3, []tree.AlterTableOption{tree.NewAlterOptionDropPartition($6)})
+    }
+
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
     {
@@ -1707,6 +1761,8 @@ show_stmt:
 |   show_databases_stmt
 |   show_tables_stmt
 |   show_process_stmt
+|   show_profile_stmt
+|   show_grants_stmt
 |   show_errors_stmt
 |   show_warnings_stmt
 |   show_variables_stmt
@@ -1778,6 +1834,26 @@ show_process_stmt:
         $$ = &tree.ShowProcessList{Full: $2}
     }
 
+show_profile_stmt:
+    SHOW PROFILE FOR PROCESS INTEGRAL
+    {
+        $$ = &tree.ShowProfile{ProcessID: uint64($5.(int64))}
+    }
+
+show_grants_stmt:
+    SHOW GRANTS
+    {
+        $$ = &tree.ShowGrants{}
+    }
+|   SHOW GRANTS FOR CURRENT_USER braces_opt
+    {
+        $$ = &tree.ShowGrants{}
+    }
+|   SHOW GRANTS FOR user_name
+    {
+        $$ = &tree.ShowGrants{Username: $4.Username, Hostname: $4.Hostname}
+    }
+
 show_tables_stmt:
     SHOW full_opt TABLES database_name_opt like_opt where_expression_opt
     {
@@ -1976,14 +2052,24 @@ delete_stmt:
 // |   DELETE comment_opt ignore_opt delete_table_list from_or_using table_references where_expression_opt
 
 insert_stmt:
-    INSERT into_table_name partition_clause_opt insert_data
+    INSERT ignore_opt into_table_name partition_clause_opt insert_data
     {
-        ins := $4
-        ins.Table = $2
-        ins.PartitionNames = $3
+        ins := $5
+        ins.Ignore = $2
+        ins.Table = $3
+        ins.PartitionNames = $4
         $$ = ins
     }
 
+ignore_opt:
+    {
+        $$ = false
+    }
+|   IGNORE
+    {
+        $$ = true
+    }
+
 insert_data:
     VALUES values_list
     {
@@ -2153,6 +2239,15 @@ into_table_name:
         $$ = $1
     }
 
+select_lock_opt:
+    {
+        $$ = false
+    }
+|   FOR UPDATE
+    {
+        $$ = true
+    }
+
 export_data_param_opt:
     {
         $$ = nil
@@ -2289,9 +2384,9 @@ select_stmt:
     }
 
 select_no_parens:
-    simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+    simple_select order_by_opt limit_opt export_data_param_opt select_lock_opt
     {
-        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
+        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4, ForUpdate: $5}
     }
 |   select_with_parens order_by_clause export_data_param_opt
     {
@@ -2301,9 +2396,9 @@ select_no_parens:
     {
         $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
     }
-|	with_clause simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+|	with_clause simple_select order_by_opt limit_opt export_data_param_opt select_lock_opt
     {
-        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1}
+        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1, ForUpdate: $6}
     }
 |   with_clause select_with_parens order_by_clause export_data_param_opt
     {
@@ -2509,30 +2604,62 @@ union_op:
     }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT optimizer_hint_opt distinct_opt select_expression_list select_into_opt from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Hints: $2,
+            Distinct: $3,
+            Exprs: $4,
+            Into: $5,
+            From: $6,
+            Where: $7,
+            GroupBy: $8,
+            Having: $9,
         }
     }
-|	SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+|	SELECT optimizer_hint_opt select_option_opt select_expression_list select_into_opt from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
+            Hints: $2,
             Distinct: false,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
-            Option: $2,
+            Exprs: $4,
+            Into: $5,
+            From: $6,
+            Where: $7,
+            GroupBy: $8,
+            Having: $9,
+            Option: $3,
         }
     }
 
+select_into_opt:
+    {
+        $$ = nil
+    }
+|   INTO_VARIABLE user_variable_list
+    {
+        $$ = $2
+    }
+
+user_variable_list:
+    user_variable
+    {
+        $$ = []*tree.VarExpr{$1}
+    }
+|   user_variable_list ',' user_variable
+    {
+        $$ = append($1, $3)
+    }
+
+optimizer_hint_opt:
+    {
+        $$ = nil
+    }
+|   OPTIMIZER_HINT
+    {
+        $$ = tree.NewOptimizerHints($1)
+    }
+
 select_option_opt:
 	SQL_SMALL_RESULT
 |	SQL_BIG_RESULT
@@ -2791,13 +2918,37 @@ table_factor:
             },
         }
     }
+|   table_function as_opt_id column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($2),
+                Cols: $3,
+            },
+        }
+    }
 // |   '(' table_references ')'
 
+table_function:
+    ident '(' expression_list_opt ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = tree.NewTableFunction(&tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+        })
+    }
+
 derived_table:
     '(' select_no_parens ')'
     {
         $$ = &tree.ParenTableExpr{Expr: $2}
     }
+|   '(' VALUES values_list ')'
+    {
+        $$ = &tree.ParenTableExpr{Expr: tree.NewSelect(tree.NewValuesClause($3), nil, nil)}
+    }
 
 as_opt:
     {}
@@ -3462,6 +3613,14 @@ values_opt:
     {
         $$ = &tree.ValuesLessThan{ValueList: $5}
     }
+|   VALUES LESS THAN MAXVALUE
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES LESS THAN '(' MAXVALUE ')'
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
 
 sub_partition_num_opt:
     {
@@ -3705,6 +3864,10 @@ table_option:
 	{
 		$$ = &tree.TableOptionProperties{Preperties: $3}
 	}
+|   TTL '(' ident ',' INTERVAL INTEGRAL time_stamp_unit ')'
+    {
+        $$ = tree.NewTableOptionTTL($3, $6.(int64), $7)
+    }
 // |   INSERT_METHOD equal_opt insert_method_options
 
 properties_list:
@@ -4071,10 +4234,51 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeCheck($4, $6, $1)
     }
-// |   ON UPDATE function_call_nonkeyword
-//     {
-//         $$ = tree.NewAttributeOnUpdate($3)
-//     }
+|   GENERATED ALWAYS AS '(' expression ')' stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($5, $7)
+    }
+|   AS '(' expression ')' stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($3, $5)
+    }
+|   ON UPDATE name_datatime_precision datatime_precision_opt
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($3))
+        var es tree.Exprs = nil
+        if $4 != nil {
+            es = append(es, $4)
+        }
+        $$ = tree.NewAttributeOnUpdate(&tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: es,
+        })
+    }
+|   ON UPDATE NOW datatime_precision
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($3))
+        var es tree.Exprs = nil
+        if $4 != nil {
+            es = append(es, $4)
+        }
+        $$ = tree.NewAttributeOnUpdate(&tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: es,
+        })
+    }
+
+stored_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
 
 enforce:
     ENFORCED
@@ -4118,6 +4322,7 @@ references_def:
     }
 
 on_delete_update_opt:
+    %prec LOWER_THAN_ON
     {
         $$ = &tree.ReferenceOnRecord{
             OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -4127,14 +4332,14 @@ on_delete_update_opt:
 |   on_delete_update
 
 on_delete_update:
-    on_delete
+    on_delete %prec LOWER_THAN_ON
     {
         $$ = &tree.ReferenceOnRecord{
             OnDelete: $1,
             OnUpdate: tree.REFERENCE_OPTION_INVALID,
         }
     }
-|   on_update
+|   on_update %prec LOWER_THAN_ON
     {
         $$ = &tree.ReferenceOnRecord{
             OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -4293,7 +4498,7 @@ bit_expr:
     {
         $$ = tree.NewBinaryExpr(tree.RIGHT_SHIFT, $1, $3)
     }
-|   simple_expr
+|   simple_expr %prec LOWER_THAN_COLLATE
     {
         $$ = $1
     }
@@ -4331,6 +4536,10 @@ simple_expr:
     {
         $$ = tree.NewUnaryExpr(tree.UNARY_TILDE, $2)
     }
+|   simple_expr COLLATE collate_name
+    {
+        $$ = tree.NewCollateExpr($1, $3)
+    }
 |   '!' simple_expr %prec UNARY
     {
         $$ = tree.NewUnaryExpr(tree.UNARY_MARK, $2)
@@ -4429,6 +4638,11 @@ when_clause:
 
 cast_type:
     decimal_type
+|   int_type unsigned_opt
+    {
+        $$ = $1
+        $$.InternalType.Unsigned = $2
+    }
 |   BINARY length_opt
     {
         locale := ""
@@ -4763,18 +4977,20 @@ function_call_generic:
 |	TRIM '(' expression FROM expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
+		arg1 := tree.SetUnresolvedName("both")
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
-             Exprs: tree.Exprs{$3},
+             Exprs: tree.Exprs{arg1, $3, $5},
         }
 	}
 |	TRIM '(' trim_direction FROM expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
 		arg1 := tree.SetUnresolvedName(strings.ToLower($3))
+		arg2 := tree.NewNumValWithType(constant.MakeString(" "), " ", false, tree.P_char)
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
-             Exprs: tree.Exprs{arg1, $5},
+             Exprs: tree.Exprs{arg1, arg2, $5},
         }
 	}
 |	TRIM '(' trim_direction expression FROM expression ')'
@@ -5036,7 +5252,7 @@ name_confict:
 |   YEAR
 
 interval_expr:
-    INTERVAL STRING
+    INTERVAL STRING %prec LOWER_THAN_TIME_UNIT
 	{
 		name := tree.SetUnresolvedName("interval")
 		es := tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char)
@@ -5056,7 +5272,7 @@ interval_expr:
             Exprs: tree.Exprs{es},
         }
     }
-|   INTERVAL ident time_unit
+|   INTERVAL STRING time_unit
     {
 		str := $2
 		str += " " + $3
@@ -5067,6 +5283,15 @@ interval_expr:
             Exprs: tree.Exprs{es},
         }
     }
+|   INTERVAL column_name time_unit
+    {
+ 		name := tree.SetUnresolvedName("interval")
+		unit := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$2, unit},
+        }
+    }
 |   INTERVAL '-' INTEGRAL time_unit
     {
 		str := strconv.FormatInt(-$3.(int64), 10)
@@ -5349,16 +5574,21 @@ literal:
         $$ = tree.NewNumValWithType(constant.MakeUnknown(), "", false, tree.P_null)
     }
 |   HEXNUM
-	{
-		ival := util.GetUint64($1)
-		$$ = tree.NewNumValWithType(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
-	}
+    {
+        $$ = tree.NewNumValWithType(constant.MakeString(string($1.([]byte))), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
+    }
+|   HEX
+    {
+        $$ = tree.NewNumValWithType(constant.MakeString(string($1.([]byte))), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
+    }
+|   BIT_LITERAL
+    {
+        $$ = tree.NewNumValWithType(constant.MakeString(string($1.([]byte))), yylex.(*Lexer).scanner.LastToken, false, tree.P_bit)
+    }
 |   DECIMAL_VALUE
     {
         $$ = tree.NewNumValWithType(constant.MakeString($1), $1, false, tree.P_decimal128)
     }
-// |   HEX
-// |   BIT_LITERAL
 // |   VALUE_ARG
 
 column_type:
@@ -6264,9 +6494,13 @@ reserved_keyword:
 |	LEADING
 |	TRAILING
 |   TIMESTAMP
+|   GENERATED
+|   STORED
+|   VIRTUAL
 
 non_reserved_keyword:
     AGAINST
+|   ALWAYS
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ACTION
@@ -6316,6 +6550,7 @@ non_reserved_keyword:
 |   GEOMETRYCOLLECTION
 |   GLOBAL
 |   GRANT
+|   GRANTS
 |   INT
 |   INTEGER
 |   INDEXES
@@ -6365,8 +6600,11 @@ non_reserved_keyword:
 |   POINT
 |   POLYGON
 |   PROCEDURE
+|   PROCESS
+|   PROFILE
 |   PROXY
 |   QUERY
+|   QUICK
 |   RANGE
 |   READ
 |   REAL
@@ -6393,6 +6631,7 @@ non_reserved_keyword:
 |   STATS_AUTO_RECALC
 |   STATS_PERSISTENT
 |   STATS_SAMPLE_PAGES
+|   TTL
 |   SUBPARTITIONS
 |   SUBPARTITION
 |   SIMPLE
@@ -15,6 +15,7 @@
 package mysql
 
 import (
+	"go/constant"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -52,6 +53,18 @@ var (
 		input  string
 		output string
 	}{{
+		input:  "select /*+ MAX_EXECUTION_TIME(1000) */ a from t",
+		output: "select /*+ max_execution_time(1000) */ a from t",
+	}, {
+		input:  "select /*+ NO_INDEX_MERGE(t) max_execution_time( 20 ) */ distinct a from t",
+		output: "select /*+ max_execution_time(20) */ distinct a from t",
+	}, {
+		input:  "select a from t where /*+ MAX_EXECUTION_TIME(1000) */ a > 1",
+		output: "select a from t where a > 1",
+	}, {
+		input:  "insert /*+ MAX_EXECUTION_TIME(1000) */ into t values (1)",
+		output: "insert into t values (1)",
+	}, {
 		input:  "SET timestamp=DEFAULT;",
 		output: "set timestamp = default",
 	}, {
@@ -60,6 +73,9 @@ var (
 	}, {
 		input:  "select ltrim(\"a\"),rtrim(\"a\"),trim(BOTH \"\" from \"a\"),trim(BOTH \" \" from \"a\");",
 		output: "select ltrim(a), rtrim(a), trim(both, , a), trim(both,  , a) from dual",
+	}, {
+		input:  "select a from t1 where a collate utf8mb4_general_ci = 'A' order by b collate 'utf8mb4_bin'",
+		output: "select a from t1 where a collate utf8mb4_general_ci = A order by b collate utf8mb4_bin",
 	}, {
 		input:  "select rpad('hello', -18446744073709551616, '1');",
 		output: "select rpad(hello, -18446744073709551616, 1) from dual",
@@ -102,6 +118,9 @@ var (
 	}, {
 		input:  "select ltrim(\"a\"),rtrim(\"a\"),trim(BOTH \"\" from \"a\"),trim(BOTH \" \" from \"a\");",
 		output: "select ltrim(a), rtrim(a), trim(both, , a), trim(both,  , a) from dual",
+	}, {
+		input:  "select trim(\"a\"),trim(\"x\" from \"xax\"),trim(LEADING from \"a\"),trim(trailing \"ab\" from \"cab\");",
+		output: "select trim(a), trim(both, x, xax), trim(leading,  , a), trim(trailing, ab, cab) from dual",
 	}, {
 		input:  "SELECT (rpad(1.0, 2048,1)) IS NOT FALSE;",
 		output: "select (rpad(1.0, 2048, 1)) != false from dual",
@@ -119,7 +138,13 @@ var (
 		output: "select adddate(date(2021-01-01), interval(1 day)) from dual",
 	}, {
 		input:  "select '2007-01-01' + interval a day from t1;",
-		output: "select 2007-01-01 + interval(a day) from t1",
+		output: "select 2007-01-01 + interval(a, day) from t1",
+	}, {
+		input:  "select date_sub('2022-01-01 10:00:00', interval '-1:30' minute_second), t.d + interval t.n day_hour from t",
+		output: "select date_sub(2022-01-01 10:00:00, interval(-1:30 minute_second)), t.d + interval(t.n, day_hour) from t",
+	}, {
+		input:  "select interval '1 day' year",
+		output: "select interval(1 day year) from dual",
 	}, {
 		input:  "SELECT CAST(COALESCE(t0.c0, -1) AS UNSIGNED) IS TRUE FROM t0;",
 		output: "select cast(coalesce(t0.c0, -1) as unsigned unsigned) = true from t0",
@@ -188,12 +213,17 @@ var (
 	}, {
 		input:  "SELECT (CAST(0x7FFFFFFFFFFFFFFF AS char));",
 		output: "select (cast(0x7fffffffffffffff as char)) from dual",
+	}, {
+		input:  "select 0xABC, X'0aBC', x'', b'1010', 0b1010 from t where a = 0x1 and b = B'1'",
+		output: "select 0xabc, x'0aBC', x'', b'1010', 0b1010 from t where a = 0x1 and b = b'1'",
 	}, {
 		input:  "select cast(-19999999999999999999 as signed);",
 		output: "select cast(-19999999999999999999 as signed) from dual",
 	}, {
 		input:  "select cast(19999999999999999999 as signed);",
 		output: "select cast(19999999999999999999 as signed) from dual",
+	}, {
+		input: "select cast(a as bigint), cast(b as int unsigned), cast(c as smallint) from t",
 	}, {
 		input:  "select date_sub(now(), interval 1 day) from t1;",
 		output: "select date_sub(now(), interval(1 day)) from t1",
@@ -232,6 +262,20 @@ var (
 		output: "create table t (a double(13))",
 	}, {
 		input: "select a as promo_revenue from (select * from r) as c_orders(c_custkey, c_count)",
+	}, {
+		input:  "select * from (values (1, 'a'), (2, null)) as t(a, b)",
+		output: "select * from (values (1, a), (2, null)) as t(a, b)",
+	}, {
+		input:  "select t.a from (values row(1), row(2)) t(a) join u on t.a = u.a",
+		output: "select t.a from (values (1), (2)) as t(a) inner join u on t.a = u.a",
+	}, {
+		input: "select * from generate_series(1, 10)",
+	}, {
+		input:  "select g from generate_series(5, 0, -2) g(g)",
+		output: "select g from generate_series(5, 0, -2) as g(g)",
+	}, {
+		input:  "select g.d, t.v from generate_series('2022-01-01', '2022-01-31', interval 1 day) g left join t on g.d = t.d",
+		output: "select g.d, t.v from generate_series(2022-01-01, 2022-01-31, interval(1 day)) as g left join t on g.d = t.d",
 	}, {
 		input:  "select extract(year from l_shipdate) as l_year from t",
 		output: "select extract(year, l_shipdate) as l_year from t",
@@ -303,6 +347,11 @@ var (
 	}, {
 		input:  "create table t (a int) properties('a' = 'b')",
 		output: "create table t (a int) properties(a = b)",
+	}, {
+		input:  "create table t (a int, ts datetime) TTL(ts, INTERVAL 30 DAY)",
+		output: "create table t (a int, ts datetime) ttl(ts, interval 30 day)",
+	}, {
+		input: "create table ttl (ttl datetime) ttl(ttl, interval 1 hour)",
 	}, {
 		input:  "load data infile '/root/lineorder_flat_10.tbl' into table lineorder_flat FIELDS TERMINATED BY '' OPTIONALLY ENCLOSED BY '' LINES TERMINATED BY '';",
 		output: "load data infile /root/lineorder_flat_10.tbl into table lineorder_flat fields terminated by \t optionally enclosed by \u0000 lines",
@@ -326,6 +375,12 @@ var (
 		output: "set names = utf8mb4 utf8mb4_general_ci",
 	}, {
 		input: "insert into cms values (null, default)",
+	}, {
+		input:  "INSERT IGNORE INTO t1 (a, b) VALUES (1, 2), (3, 4)",
+		output: "insert ignore into t1 (a, b) values (1, 2), (3, 4)",
+	}, {
+		input:  "insert ignore t1 select * from t2",
+		output: "insert ignore into t1 select * from t2",
 	}, {
 		input:  "create database `show`",
 		output: "create database show",
@@ -535,10 +590,31 @@ var (
 		input: "create table a (a int) partition by range columns (a, b, db.t.c)",
 	}, {
 		input: "create table a (a int) partition by range(1 + 21)",
+	}, {
+		input:  "create table a (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than maxvalue)",
+		output: "create table a (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+	}, {
+		input:  "create table a (a date) partition by range columns (a) (partition p0 values less than ('2020-01-01'), partition p1 values less than (maxvalue))",
+		output: "create table a (a date) partition by range columns (a) (partition p0 values less than (2020-01-01), partition p1 values less than (maxvalue))",
+	}, {
+		input: "alter table db.t drop partition p0, p1",
 	}, {
 		input: "create table a (a int storage disk constraint cx check (b + c) enforced)",
 	}, {
 		input: "create table a (a int storage disk, b int references b(a asc, b desc) match full on delete cascade on update restrict)",
+	}, {
+		input:  "create table a (email varchar(100), email_lc varchar(100) as (lower(email)) stored)",
+		output: "create table a (email varchar(100), email_lc varchar(100) generated always as (lower(email)) stored)",
+	}, {
+		input: "create table a (a int, b int generated always as (a + 1) virtual)",
+	}, {
+		input:  "create table a (a int, b datetime default now() on update current_timestamp, c varchar(36) default (uuid()))",
+		output: "create table a (a int, b datetime default now() on update current_timestamp(), c varchar(36) default (uuid()))",
+	}, {
+		input: "create table a (a int storage disk, b int references b(a) on update cascade, c datetime on update now(3))",
+	}, {
+		input:  "create table a (a int, b int generated always as (a * 2))",
+		output: "create table a (a int, b int generated always as (a * 2) virtual)",
 	}, {
 		input: "create table a (a int storage disk, b int)",
 	}, {
@@ -674,6 +750,20 @@ var (
 		input: "show full processlist",
 	}, {
 		input: "show processlist",
+	}, {
+		input:  "SHOW PROFILE FOR PROCESS 12",
+		output: "show profile for process 12",
+	}, {
+		input: "show grants",
+	}, {
+		input:  "show grants for current_user()",
+		output: "show grants",
+	}, {
+		input:  "show grants for 'u1'@'localhost'",
+		output: "show grants for u1@localhost",
+	}, {
+		input:  "show grants for u1",
+		output: "show grants for u1",
 	}, {
 		input:  "show full tables from db1 like 'a%' where a != 0",
 		output: "show full tables from db1 like a% where a != 0",
@@ -821,6 +911,11 @@ var (
 	}, {
 		input:  "select a from t order by a desc limit 1, 2",
 		output: "select a from t order by a desc limit 2 offset 1",
+	}, {
+		input: "select a from t where b = 1 for update",
+	}, {
+		input:  "with t1 as (select a from t) select a from t1 order by a limit 1 FOR UPDATE",
+		output: "with t1 as (select a from t) select a from t1 order by a limit 1 for update",
 	}, {
 		input: "select * from t union select c from t1",
 	}, {
@@ -856,6 +951,27 @@ var (
 	}, {
 		input:  "analyze table part (a,b )",
 		output: "analyze table part(a, b)",
+	}, {
+		input:  "checksum table t1, db.t2",
+		output: "checksum table t1, db.t2",
+	}, {
+		input:  "checksum table t1 quick",
+		output: "checksum table t1 quick",
+	}, {
+		input:  "checksum table t1 extended",
+		output: "checksum table t1",
+	}, {
+		input:  "select assert(a > 0, 'a must be positive') from t",
+		output: "select assert(a > 0, a must be positive) from t",
+	}, {
+		input:  "select count(*) into @c from t where a > 1",
+		output: "select count(*) into @c from t where a > 1",
+	}, {
+		input:  "select a, b INTO @x, @Y from t limit 1",
+		output: "select a, b into @x, @y from t limit 1",
+	}, {
+		input:  "select 1 into outfile '/Users/tmp/test'",
+		output: "select 1 from dual into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test'",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -911,6 +1027,50 @@ var (
 	}}
 )
 
+func TestBinaryLiteral(t *testing.T) {
+	for _, tcase := range []struct {
+		input string
+		typ   tree.P_TYPE
+		value string
+	}{
+		{"select 0x4142", tree.P_hexnum, "AB"},
+		{"select 0xABC", tree.P_hexnum, "\x0a\xbc"},
+		{"select x'0aBC'", tree.P_hexnum, "\x0a\xbc"},
+		{"select x''", tree.P_hexnum, ""},
+		{"select b'1000001'", tree.P_bit, "A"},
+		{"select 0b1000001", tree.P_bit, "A"},
+		{"select b'100000101000010'", tree.P_bit, "AB"},
+		{"select b''", tree.P_bit, ""},
+	} {
+		ast, err := ParseOne(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		num := ast.(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr.(*tree.NumVal)
+		if num.ValType != tcase.typ || constant.StringVal(num.Value) != tcase.value {
+			t.Errorf("Parse(%q): %d %q, want %d %q", tcase.input, num.ValType, constant.StringVal(num.Value), tcase.typ, tcase.value)
+		}
+
+		// the formatted statement has the same literal, as a dump of it
+		out := tree.String(ast, dialect.MYSQL)
+		ast, err = ParseOne(out)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", out, err)
+			continue
+		}
+		num = ast.(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr.(*tree.NumVal)
+		if num.ValType != tcase.typ || constant.StringVal(num.Value) != tcase.value {
+			t.Errorf("Parse(%q): %d %q, want %d %q", out, num.ValType, constant.StringVal(num.Value), tcase.typ, tcase.value)
+		}
+	}
+
+	// x'...' is of an even number of digits
+	if _, err := ParseOne("select x'ABC'"); err == nil {
+		t.Errorf("Parse(x'ABC') should fail")
+	}
+}
+
 func TestMulti(t *testing.T) {
 	for _, tcase := range multiSQL {
 		if tcase.output == "" {
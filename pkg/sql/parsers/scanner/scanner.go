@@ -39,7 +39,8 @@ type Scanner struct {
 func NewScanner(dialectType dialect.DialectType, sql string) *Scanner {
 	initTokens(dialectType)
 	return &Scanner{
-		buf: sql,
+		buf:         sql,
+		dialectType: dialectType,
 	}
 }
 
@@ -123,6 +124,8 @@ func (s *Scanner) Scan() (int, string) {
 			case s.cur() == '!' && s.dialectType == dialect.MYSQL:
 				// TODO: ExtractMysqlComment
 				return s.scanMySQLSpecificComment()
+			case s.cur() == '+' && s.dialectType == dialect.MYSQL:
+				return s.scanOptimizerHint()
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
@@ -427,6 +430,16 @@ func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 	return s.Scan()
 }
 
+// scanOptimizerHint scans an optimizer hint comment such as
+// /*+ MAX_EXECUTION_TIME(1000) */ and returns the hints inside it.
+func (s *Scanner) scanOptimizerHint() (int, string) {
+	id, str := s.scanCommentTypeBlock()
+	if id == LEX_ERROR {
+		return id, str
+	}
+	return OPTIMIZER_HINT, str[3 : len(str)-2]
+}
+
 // ExtractMysqlComment extracts the version and SQL from a comment-only query
 // such as /*!50708 sql here */
 func ExtractMysqlComment(sql string) (string, string) {
@@ -500,7 +513,7 @@ func (s *Scanner) scanNumber() (int, string) {
 		goto exponent
 	}
 
-	// 0x construct.
+	// 0x and 0b construct.
 	if s.cur() == '0' {
 		s.skip(1)
 		if s.cur() == 'x' || s.cur() == 'X' {
@@ -509,6 +522,12 @@ func (s *Scanner) scanNumber() (int, string) {
 			s.scanMantissa(16)
 			goto exit
 		}
+		if s.cur() == 'b' && digitVal(s.peek(1)) < 2 {
+			token = BIT_LITERAL
+			s.skip(1)
+			s.scanMantissa(2)
+			goto exit
+		}
 	}
 
 	s.scanMantissa(10)
@@ -534,7 +553,7 @@ exponent:
 	}
 
 exit:
-	if isLetter(s.cur()) {
+	if isLetter(s.cur()) || (token == BIT_LITERAL && isDigit(s.cur())) {
 		// TODO: optimize
 		token = ID
 		s.scanIdentifier(false)
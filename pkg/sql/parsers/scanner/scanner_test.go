@@ -66,6 +66,63 @@ func TestLiteralID(t *testing.T) {
 	}
 }
 
+func TestBinaryLiteral(t *testing.T) {
+	initTokens(dialect.MYSQL)
+	testcases := []struct {
+		in  string
+		id  int
+		out string
+	}{{
+		in:  "0xABC",
+		id:  HEXNUM,
+		out: "0xabc",
+	}, {
+		in:  "x'0aBC'",
+		id:  HEX,
+		out: "0aBC",
+	}, {
+		in:  "X''",
+		id:  HEX,
+		out: "",
+	}, {
+		in:  "x'ABC'",
+		id:  LEX_ERROR,
+		out: "ABC",
+	}, {
+		in:  "x'AG'",
+		id:  LEX_ERROR,
+		out: "A",
+	}, {
+		in:  "b'1010'",
+		id:  BIT_LITERAL,
+		out: "1010",
+	}, {
+		in:  "0b1010",
+		id:  BIT_LITERAL,
+		out: "0b1010",
+	}, {
+		in:  "b'102'",
+		id:  LEX_ERROR,
+		out: "10",
+	}, {
+		in:  "0b102",
+		id:  ID,
+		out: "0b102",
+	}, {
+		in:  "0bc",
+		id:  ID,
+		out: "0bc",
+	}}
+
+	for _, tcase := range testcases {
+		s := NewScanner(dialect.MYSQL, tcase.in)
+		id, out := s.Scan()
+		if tcase.id != id || string(out) != tcase.out {
+			t.Errorf("Scan(%s): %d, %s, want %d, %s", tcase.in, id, out, tcase.id, tcase.out)
+		}
+	}
+}
+
 func tokenName(id int) string {
 	if id == STRING {
 		return "STRING"
@@ -130,6 +130,10 @@ func initTokens(dialectType dialect.DialectType) {
 		RANGE = MYSQL_RANGE
 		CHECK = MYSQL_CHECK
 		ENFORCED = MYSQL_ENFORCED
+		GENERATED = MYSQL_GENERATED
+		ALWAYS = MYSQL_ALWAYS
+		STORED = MYSQL_STORED
+		VIRTUAL = MYSQL_VIRTUAL
 		RESTRICT = MYSQL_RESTRICT
 		CASCADE = MYSQL_CASCADE
 		ACTION = MYSQL_ACTION
@@ -152,6 +156,7 @@ func initTokens(dialectType dialect.DialectType) {
 		STATS_AUTO_RECALC = MYSQL_STATS_AUTO_RECALC
 		STATS_PERSISTENT = MYSQL_STATS_PERSISTENT
 		STATS_SAMPLE_PAGES = MYSQL_STATS_SAMPLE_PAGES
+		TTL = MYSQL_TTL
 		DYNAMIC = MYSQL_DYNAMIC
 		FIXED = MYSQL_FIXED
 		REDUNDANT = MYSQL_REDUNDANT
@@ -219,6 +224,7 @@ func initTokens(dialectType dialect.DialectType) {
 		USER = MYSQL_USER
 		AT_ID = MYSQL_AT_ID
 		AT_AT_ID = MYSQL_AT_AT_ID
+		OPTIMIZER_HINT = MYSQL_OPTIMIZER_HINT
 		ROLE = MYSQL_ROLE
 		PARSER = MYSQL_PARSER
 		VISIBLE = MYSQL_VISIBLE
@@ -410,6 +416,9 @@ func initTokens(dialectType dialect.DialectType) {
 		EXTENDED = MYSQL_EXTENDED
 		FULL = MYSQL_FULL
 		PROCESSLIST = MYSQL_PROCESSLIST
+		PROFILE = MYSQL_PROFILE
+		PROCESS = MYSQL_PROCESS
+		GRANTS = MYSQL_GRANTS
 		NAMES = MYSQL_NAMES
 		CHARSET = MYSQL_CHARSET
 		GLOBAL = MYSQL_GLOBAL
@@ -445,6 +454,7 @@ func initTokens(dialectType dialect.DialectType) {
 		LANGUAGE = MYSQL_LANGUAGE
 		WITH = MYSQL_WITH
 		QUERY = MYSQL_QUERY
+		QUICK = MYSQL_QUICK
 		EXPANSION = MYSQL_EXPANSION
 		UNUSED = MYSQL_UNUSED
 
@@ -464,6 +474,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"action":                   ACTION,
 		"against":                  AGAINST,
 		"all":                      ALL,
+		"always":                   ALWAYS,
 		"alter":                    ALTER,
 		"algorithm":                ALGORITHM,
 		"analyze":                  ANALYZE,
@@ -602,12 +613,13 @@ func initTokens(dialectType dialect.DialectType) {
 		"fields":                   FIELDS,
 		"file":                     FILE,
 		"fixed":                    FIXED,
-		"generated":                UNUSED,
+		"generated":                GENERATED,
 		"geometry":                 GEOMETRY,
 		"geometrycollection":       GEOMETRYCOLLECTION,
 		"get":                      UNUSED,
 		"global":                   GLOBAL,
 		"grant":                    GRANT,
+		"grants":                   GRANTS,
 		"group":                    GROUP,
 		"group_concat":             GROUP_CONCAT,
 		"having":                   HAVING,
@@ -730,11 +742,14 @@ func initTokens(dialectType dialect.DialectType) {
 		"precision":                UNUSED,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
+		"process":                  PROCESS,
+		"profile":                  PROFILE,
 		"procedure":                PROCEDURE,
 		"proxy":                    PROXY,
 		"properties":               PROPERTIES,
 		"privileges":               PRIVILEGES,
 		"query":                    QUERY,
+		"quick":                    QUICK,
 		"quarter":                  QUARTER,
 		"range":                    RANGE,
 		"read":                     READ,
@@ -804,7 +819,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"stats_auto_recalc":        STATS_AUTO_RECALC,
 		"stats_persistent":         STATS_PERSISTENT,
 		"stats_sample_pages":       STATS_SAMPLE_PAGES,
-		"stored":                   UNUSED,
+		"ttl":                      TTL,
+		"stored":                   STORED,
 		"storage":                  STORAGE,
 		"straight_join":            STRAIGHT_JOIN,
 		"stream":                   STREAM,
@@ -849,7 +865,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"varchar":                  VARCHAR,
 		"varcharacter":             UNUSED,
 		"varying":                  UNUSED,
-		"virtual":                  UNUSED,
+		"virtual":                  VIRTUAL,
 		"view":                     VIEW,
 		"visible":                  VISIBLE,
 		"week":                     WEEK,
@@ -1005,6 +1021,10 @@ var (
 	RANGE                    int
 	CHECK                    int
 	ENFORCED                 int
+	GENERATED                int
+	ALWAYS                   int
+	STORED                   int
+	VIRTUAL                  int
 	RESTRICT                 int
 	CASCADE                  int
 	ACTION                   int
@@ -1026,6 +1046,7 @@ var (
 	STATS_AUTO_RECALC        int
 	STATS_PERSISTENT         int
 	STATS_SAMPLE_PAGES       int
+	TTL                      int
 	DYNAMIC                  int
 	FIXED                    int
 	COMPRESSED               int
@@ -1101,6 +1122,7 @@ var (
 	RTREE                    int
 	AT_ID                    int
 	AT_AT_ID                 int
+	OPTIMIZER_HINT           int
 	UNION                    int
 	SELECT                   int
 	STREAM                   int
@@ -1285,6 +1307,9 @@ var (
 	DATABASES                int
 	TABLES                   int
 	PROCESSLIST              int
+	PROFILE                  int
+	PROCESS                  int
+	GRANTS                   int
 	NAMES                    int
 	CHARSET                  int
 	GLOBAL                   int
@@ -1320,6 +1345,7 @@ var (
 	LANGUAGE                 int
 	WITH                     int
 	QUERY                    int
+	QUICK                    int
 	EXPANSION                int
 	CURRENT_USER             int
 	CURRENT_ROLE             int
@@ -82,3 +82,52 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+// ALTER TABLE statement
+type AlterTable struct {
+	statementImpl
+	Table   *TableName
+	Options []AlterTableOption
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t *TableName, opts []AlterTableOption) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: opts,
+	}
+}
+
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+type AlterOptionDropPartition struct {
+	alterTableOptionImpl
+	Partitions IdentifierList
+}
+
+func (node *AlterOptionDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	node.Partitions.Format(ctx)
+}
+
+func NewAlterOptionDropPartition(p IdentifierList) *AlterOptionDropPartition {
+	return &AlterOptionDropPartition{
+		Partitions: p,
+	}
+}
@@ -35,6 +35,7 @@ const (
 	P_float64
 	P_char
 	P_decimal128
+	P_bit
 )
 
 //the AST for the constant numeric value.
@@ -75,6 +76,26 @@ func (n *NumVal) Negative() bool {
 	return n.negative
 }
 
+// IsBinaryLiteral reports whether n is a hexadecimal literal (0x41, x'41')
+// or a bit-value literal (b'1000001', 0b1000001), its value is the string
+// of the bytes it denotes.
+func (n *NumVal) IsBinaryLiteral() bool {
+	return n.ValType == P_hexnum || n.ValType == P_bit
+}
+
+// BinaryLiteralUint64 returns the value of a binary literal in a numeric
+// context, ok is false if the value has more than 64 bits.
+func (n *NumVal) BinaryLiteralUint64() (v uint64, ok bool) {
+	s := strings.TrimLeft(constant.StringVal(n.Value), "\x00")
+	if len(s) > 8 {
+		return 0, false
+	}
+	for i := 0; i < len(s); i++ {
+		v = v<<8 | uint64(s[i])
+	}
+	return v, true
+}
+
 func NewNumVal(value constant.Value, origString string, negative bool) *NumVal {
 	return &NumVal{
 		Value:      value,
@@ -16,6 +16,7 @@ package tree
 
 import (
 	"strconv"
+	"strings"
 )
 
 type CreateOption interface {
@@ -414,7 +415,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func NewAttributeGeneratedAlways(e Expr, s bool) *AttributeGeneratedAlways {
@@ -589,6 +597,11 @@ type AttributeOnUpdate struct {
 	Expr Expr
 }
 
+func (node *AttributeOnUpdate) Format(ctx *FmtCtx) {
+	ctx.WriteString("on update ")
+	node.Expr.Format(ctx)
+}
+
 func NewAttributeOnUpdate(e Expr) *AttributeOnUpdate {
 	return &AttributeOnUpdate{
 		Expr: e,
@@ -1424,6 +1437,29 @@ func NewTableOptionEncryption(e string) *TableOptionEncryption {
 	return &TableOptionEncryption{Encryption: e}
 }
 
+// TableOptionTTL is TTL(column, INTERVAL value unit), the rows expire when
+// the value of the column is older than the interval
+type TableOptionTTL struct {
+	tableOptionImpl
+	Column string
+	Value  int64
+	Unit   string
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl(")
+	ctx.WriteString(node.Column)
+	ctx.WriteString(", interval ")
+	ctx.WriteString(strconv.FormatInt(node.Value, 10))
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+	ctx.WriteByte(')')
+}
+
+func NewTableOptionTTL(column string, value int64, unit string) *TableOptionTTL {
+	return &TableOptionTTL{Column: column, Value: value, Unit: unit}
+}
+
 type PartitionType interface {
 	NodeFormatter
 }
@@ -598,6 +598,26 @@ func NewCastExpr(e Expr, t ResolvableTypeReference) *CastExpr {
 	}
 }
 
+//the COLLATE expression
+type CollateExpr struct {
+	exprImpl
+	Expr    Expr
+	Collate string
+}
+
+func (node *CollateExpr) Format(ctx *FmtCtx) {
+	node.Expr.Format(ctx)
+	ctx.WriteString(" collate ")
+	ctx.WriteString(node.Collate)
+}
+
+func NewCollateExpr(e Expr, c string) *CollateExpr {
+	return &CollateExpr{
+		Expr:    e,
+		Collate: c,
+	}
+}
+
 //the parenthesized list of expressions.
 type Tuple struct {
 	exprImpl
@@ -804,6 +824,10 @@ type MaxValue struct {
 	exprImpl
 }
 
+func (node *MaxValue) Format(ctx *FmtCtx) {
+	ctx.WriteString("maxvalue")
+}
+
 func NewMaxValue() *MaxValue {
 	return &MaxValue{}
 }
@@ -21,10 +21,17 @@ type Insert struct {
 	PartitionNames IdentifierList
 	Columns        IdentifierList
 	Rows           *Select
+	// Ignore, the rows violating the primary key, NOT NULL or the widths of
+	// the columns are skipped with warnings instead of failing the statement
+	Ignore bool
 }
 
 func (node *Insert) Format(ctx *FmtCtx) {
-	ctx.WriteString("insert into ")
+	ctx.WriteString("insert ")
+	if node.Ignore {
+		ctx.WriteString("ignore ")
+	}
+	ctx.WriteString("into ")
 	node.Table.Format(ctx)
 
 	if node.PartitionNames != nil {
@@ -16,6 +16,7 @@ package tree
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -31,6 +32,8 @@ type Select struct {
 	Limit   *Limit
 	With    *With
 	Ep      *ExportParam
+	// ForUpdate locks the rows read until the txn ends
+	ForUpdate bool
 }
 
 func (node *Select) Format(ctx *FmtCtx) {
@@ -51,6 +54,9 @@ func (node *Select) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Ep.Format(ctx)
 	}
+	if node.ForUpdate {
+		ctx.WriteString(" for update")
+	}
 }
 
 func NewSelect(s SelectStatement, o OrderBy, l *Limit) *Select {
@@ -73,7 +79,7 @@ func (node *OrderBy) Format(ctx *FmtCtx) {
 	}
 }
 
-//the ordering expression.
+// the ordering expression.
 type Order struct {
 	Expr      Expr
 	Direction Direction
@@ -120,7 +126,7 @@ func (d Direction) String() string {
 	return directionName[d]
 }
 
-//the LIMIT clause.
+// the LIMIT clause.
 type Limit struct {
 	Offset, Count Expr
 }
@@ -163,17 +169,24 @@ func (node *ParenSelect) Format(ctx *FmtCtx) {
 // SelectClause represents a SELECT statement.
 type SelectClause struct {
 	SelectStatement
+	Hints    *OptimizerHints
 	Distinct bool
 	Exprs    SelectExprs
-	From     *From
-	Where    *Where
-	GroupBy  GroupBy
-	Having   *Where
-	Option   string
+	// Into, the user variables assigned by SELECT ... INTO @var.
+	Into    []*VarExpr
+	From    *From
+	Where   *Where
+	GroupBy GroupBy
+	Having  *Where
+	Option  string
 }
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if node.Hints != nil {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
@@ -182,6 +195,15 @@ func (node *SelectClause) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	node.Exprs.Format(ctx)
+	if len(node.Into) > 0 {
+		ctx.WriteString(" into ")
+		for i, v := range node.Into {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			v.Format(ctx)
+		}
+	}
 	if len(node.From.Tables) > 0 {
 		ctx.WriteByte(' ')
 		node.From.Format(ctx)
@@ -200,7 +222,49 @@ func (node *SelectClause) Format(ctx *FmtCtx) {
 	}
 }
 
-//WHERE or HAVING clause.
+// OptimizerHints represents the optimizer hints in the comment right after
+// SELECT, e.g. SELECT /*+ MAX_EXECUTION_TIME(1000) */ ...
+// Like mysql, the unknown or malformed hints are ignored.
+type OptimizerHints struct {
+	// MaxExecutionTime, the timeout of the statement in milliseconds,
+	// 0 if it is not given.
+	MaxExecutionTime uint64
+}
+
+// NewOptimizerHints parses the content of a hint comment.
+func NewOptimizerHints(s string) *OptimizerHints {
+	hints := &OptimizerHints{}
+	for {
+		s = strings.TrimSpace(s)
+		i := strings.IndexByte(s, '(')
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(s[i:], ')')
+		if j < 0 {
+			break
+		}
+		name, arg := strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:i+j])
+		s = s[i+j+1:]
+		switch strings.ToUpper(name) {
+		case "MAX_EXECUTION_TIME":
+			if v, err := strconv.ParseUint(arg, 10, 32); err == nil {
+				hints.MaxExecutionTime = v
+			}
+		}
+	}
+	return hints
+}
+
+func (node *OptimizerHints) Format(ctx *FmtCtx) {
+	ctx.WriteString("/*+ ")
+	if node.MaxExecutionTime > 0 {
+		ctx.WriteString(fmt.Sprintf("max_execution_time(%d) ", node.MaxExecutionTime))
+	}
+	ctx.WriteString("*/")
+}
+
+// WHERE or HAVING clause.
 type Where struct {
 	Type string
 	Expr Expr
@@ -221,7 +285,7 @@ func NewWhere(e Expr) *Where {
 	return &Where{Expr: e}
 }
 
-//SELECT expressions.
+// SELECT expressions.
 type SelectExprs []SelectExpr
 
 func (node *SelectExprs) Format(ctx *FmtCtx) {
@@ -233,7 +297,7 @@ func (node *SelectExprs) Format(ctx *FmtCtx) {
 	}
 }
 
-//a SELECT expression.
+// a SELECT expression.
 type SelectExpr struct {
 	exprImpl
 	Expr Expr
@@ -248,7 +312,7 @@ func (node *SelectExpr) Format(ctx *FmtCtx) {
 	}
 }
 
-//a GROUP BY clause.
+// a GROUP BY clause.
 type GroupBy []Expr
 
 func (node *GroupBy) Format(ctx *FmtCtx) {
@@ -272,7 +336,7 @@ const (
 	JOIN_TYPE_NATURAL_RIGHT = "NATURAL RIGHT"
 )
 
-//the table expression
+// the table expression
 type TableExpr interface {
 	NodeFormatter
 }
@@ -318,7 +382,7 @@ func NewJoinTableExpr(jt string, l, r TableExpr, jc JoinCond) *JoinTableExpr {
 	}
 }
 
-//the join condition.
+// the join condition.
 type JoinCond interface {
 	NodeFormatter
 }
@@ -336,7 +400,7 @@ func NewNaturalJoinCond() *NaturalJoinCond {
 	return &NaturalJoinCond{}
 }
 
-//the ON condition for join
+// the ON condition for join
 type OnJoinCond struct {
 	JoinCond
 	Expr Expr
@@ -351,7 +415,7 @@ func NewOnJoinCond(e Expr) *OnJoinCond {
 	return &OnJoinCond{Expr: e}
 }
 
-//the USING condition
+// the USING condition
 type UsingJoinCond struct {
 	JoinCond
 	Cols IdentifierList
@@ -367,7 +431,7 @@ func NewUsingJoinCond(c IdentifierList) *UsingJoinCond {
 	return &UsingJoinCond{Cols: c}
 }
 
-//the parenthesized TableExpr.
+// the parenthesized TableExpr.
 type ParenTableExpr struct {
 	TableExpr
 	Expr TableExpr
@@ -383,7 +447,21 @@ func NewParenTableExpr(e TableExpr) *ParenTableExpr {
 	return &ParenTableExpr{Expr: e}
 }
 
-//The alias, optionally with a column list:
+// a table-valued function in the FROM clause, e.g. generate_series(1, 10)
+type TableFunction struct {
+	TableExpr
+	Func *FuncExpr
+}
+
+func (node *TableFunction) Format(ctx *FmtCtx) {
+	node.Func.Format(ctx)
+}
+
+func NewTableFunction(f *FuncExpr) *TableFunction {
+	return &TableFunction{Func: f}
+}
+
+// The alias, optionally with a column list:
 // "AS name" or "AS name(col1, col2)".
 type AliasClause struct {
 	NodeFormatter
@@ -402,7 +480,7 @@ func (node *AliasClause) Format(ctx *FmtCtx) {
 	}
 }
 
-//the table expression coupled with an optional alias.
+// the table expression coupled with an optional alias.
 type AliasedTableExpr struct {
 	TableExpr
 	Expr TableExpr
@@ -424,7 +502,7 @@ func NewAliasedTableExpr(e TableExpr, a AliasClause) *AliasedTableExpr {
 	}
 }
 
-//the statements as a data source includes the select statement.
+// the statements as a data source includes the select statement.
 type StatementSource struct {
 	TableExpr
 	Statement Statement
@@ -436,7 +514,7 @@ func NewStatementSource(s Statement) *StatementSource {
 	}
 }
 
-//the list of table expressions.
+// the list of table expressions.
 type TableExprs []TableExpr
 
 func (node *TableExprs) Format(ctx *FmtCtx) {
@@ -448,7 +526,7 @@ func (node *TableExprs) Format(ctx *FmtCtx) {
 	}
 }
 
-//the FROM clause.
+// the FROM clause.
 type From struct {
 	Tables TableExprs
 }
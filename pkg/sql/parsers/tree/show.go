@@ -14,6 +14,8 @@
 
 package tree
 
+import "strconv"
+
 type Show interface {
 	Explain
 }
@@ -196,6 +198,47 @@ func NewShowProcessList(f bool) *ShowProcessList {
 	return &ShowProcessList{Full: f}
 }
 
+//SHOW PROFILE FOR PROCESS statement
+//the counters of the operators of the running or the last statement of a
+//connection
+type ShowProfile struct {
+	showImpl
+	ProcessID uint64
+}
+
+func (node *ShowProfile) Format(ctx *FmtCtx) {
+	ctx.WriteString("show profile for process ")
+	ctx.WriteString(strconv.FormatUint(node.ProcessID, 10))
+}
+
+func NewShowProfile(id uint64) *ShowProfile {
+	return &ShowProfile{ProcessID: id}
+}
+
+//SHOW GRANTS statement
+//the privileges of the user, or of the current user if Username is empty
+type ShowGrants struct {
+	showImpl
+	Username string
+	Hostname string
+}
+
+func (node *ShowGrants) Format(ctx *FmtCtx) {
+	ctx.WriteString("show grants")
+	if node.Username != "" {
+		ctx.WriteString(" for ")
+		ctx.WriteString(node.Username)
+		if node.Hostname != "%" {
+			ctx.WriteByte('@')
+			ctx.WriteString(node.Hostname)
+		}
+	}
+}
+
+func NewShowGrants(username, hostname string) *ShowGrants {
+	return &ShowGrants{Username: username, Hostname: hostname}
+}
+
 type ShowErrors struct {
 	showImpl
 }
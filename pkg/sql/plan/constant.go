@@ -574,7 +574,7 @@ func toFloat64(e *extend.ValueExtend) error {
 func toDecimal(e *extend.ValueExtend) error {
 	vec := vector.New(types.Type{Oid: types.T_decimal128, Size: 16})
 	vec.Ref = 1
-	value, scale, err := types.ParseStringToDecimal128WithoutTable(e.OrigStr)
+	value, scale, err := types.ParseDecimal128Literal(e.OrigStr)
 	if err != nil {
 		return err
 	}
@@ -511,6 +511,10 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 		if e.Op == tree.UNARY_MINUS {
 			switch n := e.Expr.(type) {
 			case *tree.NumVal:
+				n, err := numericBinaryLiteral(typ, n)
+				if err != nil {
+					return nil, err
+				}
 				return buildConstantValue(typ, tree.NewNumVal(n.Value, "-"+n.String(), true))
 			}
 
@@ -680,7 +684,30 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 }
 
+// numericBinaryLiteral returns the number of a binary literal for a numeric
+// column, and num itself otherwise.
+func numericBinaryLiteral(typ types.Type, num *tree.NumVal) (*tree.NumVal, error) {
+	if !num.IsBinaryLiteral() {
+		return num, nil
+	}
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		v, ok := num.BinaryLiteralUint64()
+		if !ok {
+			return nil, errConstantOutRange
+		}
+		return tree.NewNumValWithType(constant.MakeUint64(v), strconv.FormatUint(v, 10), false, tree.P_int64), nil
+	}
+	return num, nil
+}
+
 func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
+	num, err := numericBinaryLiteral(typ, num)
+	if err != nil {
+		return nil, err
+	}
 	val := num.Value
 	str := num.String()
 
@@ -708,9 +735,9 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return int64(v), nil
 			}
 		case types.T_decimal64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
 		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 			v, _ := constant.Uint64Val(val)
 			if num.Negative() {
@@ -793,16 +820,16 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		case types.T_datetime:
 			return types.ParseDatetime(str)
 		case types.T_decimal64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
 		}
 	case constant.String:
 		switch typ.Oid {
 		case types.T_decimal64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
 		}
 		if !num.Negative() {
 			switch typ.Oid {
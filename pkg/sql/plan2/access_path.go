@@ -0,0 +1,381 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// AccessPathPropertyKey is set on the table def of a table scan node, it
+// holds the access path chosen to read the rows of an equality filter
+const AccessPathPropertyKey = "access_path"
+
+// the costs of the work of an access path, relative to reading a row
+const (
+	blockReadCost    = 16
+	zonemapProbeCost = 1
+	indexProbeCost   = 8
+)
+
+// AccessPath is the access path chosen by a table scan to read the rows
+// whose column equals a constant. The value is Str for the char and varchar
+// columns, and the integer representation in Int for the others. The path
+// reads the rows whose column starts with Str instead if Prefix is set, or
+// the rows of a temporal column or the commit ts pseudo column in [Lo, Hi]
+// if Bounded is set, a nil bound is unbounded.
+type AccessPath struct {
+	Path    engine.AccessPath `json:"path"`
+	Column  string            `json:"column"`
+	Int     int64             `json:"int,omitempty"`
+	Str     string            `json:"str,omitempty"`
+	Prefix  bool              `json:"prefix,omitempty"`
+	Bounded bool              `json:"bounded,omitempty"`
+	Lo      *int64            `json:"lo,omitempty"`
+	Hi      *int64            `json:"hi,omitempty"`
+	Cost    float64           `json:"cost"`
+}
+
+// GetAccessPath returns the access path chosen by the table scan, ok is false
+// if no path is chosen
+func GetAccessPath(tableDef *TableDef) (*AccessPath, bool) {
+	value, ok := getTableProperty(tableDef, AccessPathPropertyKey)
+	if !ok {
+		return nil, false
+	}
+	path := new(AccessPath)
+	if err := json.Unmarshal([]byte(value), path); err != nil {
+		return nil, false
+	}
+	return path, true
+}
+
+// Value returns the value compared with the column, of the go type of the
+// column
+func (p *AccessPath) Value(typ *plan.Type) any {
+	if isStringType(typ) {
+		return []byte(p.Str)
+	}
+	v, _ := columnValue(typ, p.Int)
+	return v
+}
+
+// IsRange returns true if the path reads a range of values
+func (p *AccessPath) IsRange() bool {
+	return p.Prefix || p.Bounded
+}
+
+// Range returns the bounds of the values read by a range path, which are in
+// [min, max], of the go type of the column. A nil bound is unbounded.
+func (p *AccessPath) Range(typ *plan.Type) (min, max any) {
+	if p.Prefix {
+		lo, hi := like.PrefixRange([]byte(p.Str))
+		if hi == nil {
+			return lo, nil
+		}
+		return lo, hi
+	}
+	// a bound the column can not hold is left unbounded, the filter
+	// still applies to the rows read
+	if p.Lo != nil {
+		if v, ok := columnValue(typ, *p.Lo); ok {
+			min = v
+		}
+	}
+	if p.Hi != nil {
+		if v, ok := columnValue(typ, *p.Hi); ok {
+			max = v
+		}
+	}
+	return
+}
+
+// Interval returns the bounds of a bounded path on the column of the table
+// as an interval, like [2022-01-01, +inf)
+func (p *AccessPath) Interval(tableDef *TableDef) string {
+	lo, hi := "(-inf", "+inf)"
+	for _, col := range tableDef.Cols {
+		if col.Name != p.Column {
+			continue
+		}
+		min, max := p.Range(col.Typ)
+		if min != nil {
+			lo = fmt.Sprintf("[%v", min)
+		}
+		if max != nil {
+			hi = fmt.Sprintf("%v]", max)
+		}
+	}
+	return lo + ", " + hi
+}
+
+// tighten narrows the bounds of a range path by the comparison of the
+// column with v
+func (p *AccessPath) tighten(op string, v int64) {
+	switch op {
+	case "<", "<=":
+		if op == "<" {
+			v--
+		}
+		if p.Hi == nil || v < *p.Hi {
+			p.Hi = &v
+		}
+	case ">", ">=":
+		if op == ">" {
+			v++
+		}
+		if p.Lo == nil || v > *p.Lo {
+			p.Lo = &v
+		}
+	}
+}
+
+// pathCost returns the estimated cost of the work of an access path
+func pathCost(est engine.PathEstimate) float64 {
+	probeCost := float64(zonemapProbeCost)
+	if est.Path == engine.PrimaryKeyLookup || est.Path == engine.SecondaryIndexLookup {
+		probeCost = indexProbeCost
+	}
+	return float64(est.Rows) + float64(est.Blocks)*blockReadCost + float64(est.Probes)*probeCost
+}
+
+// chooseAccessPaths records the cheapest access path of each table scan with
+// an equality filter between a column and a constant, or a range of a
+// temporal column. The paths available and their work are estimated by the
+// storage for each equality and range.
+func (builder *QueryBuilder) chooseAccessPaths() {
+	filters := builder.scanFilters()
+	for id, node := range builder.qry.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN || node.LockRows || node.ObjRef == nil {
+			continue
+		}
+		if _, ok := getTableProperty(node.TableDef, PartitionPropertyKey); ok {
+			continue
+		}
+		var best *AccessPath
+		for _, filter := range filters[int32(id)] {
+			for _, path := range builder.equalityPaths(node, filter) {
+				if best == nil || path.Cost < best.Cost {
+					best = path
+				}
+			}
+		}
+		for _, path := range builder.rangePaths(node, filters[int32(id)]) {
+			if best == nil || path.Cost < best.Cost {
+				best = path
+			}
+		}
+		if best != nil {
+			data, _ := json.Marshal(best)
+			node.TableDef = withTableProperty(node.TableDef, AccessPathPropertyKey, string(data))
+		}
+	}
+}
+
+// equalityPaths returns the access paths of the equality filters and the
+// prefix LIKE filters in the conjunction with their costs
+func (builder *QueryBuilder) equalityPaths(node *Node, expr *Expr) []*AccessPath {
+	fn, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := fn.F.Args
+	switch fn.F.Func.GetObjName() {
+	case "and":
+		return append(builder.equalityPaths(node, args[0]), builder.equalityPaths(node, args[1])...)
+	case "=":
+		for i := range args {
+			colPos, ok := scanColumn(node.TableDef, args[i])
+			if !ok {
+				continue
+			}
+			col := node.TableDef.Cols[colPos]
+			path := &AccessPath{Column: col.Name}
+			if !scanConstant(col.Typ, args[1-i], path) {
+				continue
+			}
+			var paths []*AccessPath
+			for _, est := range builder.compCtx.AccessPaths(node.ObjRef, col.Name, path.Value(col.Typ)) {
+				p := *path
+				p.Path = est.Path
+				p.Cost = pathCost(est)
+				paths = append(paths, &p)
+			}
+			return paths
+		}
+	case "like":
+		colPos, ok := scanColumn(node.TableDef, args[0])
+		if !ok {
+			return nil
+		}
+		col := node.TableDef.Cols[colPos]
+		path := &AccessPath{Column: col.Name}
+		if !isStringType(col.Typ) || !scanConstant(col.Typ, args[1], path) {
+			return nil
+		}
+		// the values starting with the prefix are a range of bytes
+		pat := like.Analyze([]byte(path.Str))
+		if pat.Kind != like.Prefix || len(pat.Literal) == 0 {
+			return nil
+		}
+		path.Str = string(pat.Literal)
+		path.Prefix = true
+		min, max := path.Range(col.Typ)
+		var paths []*AccessPath
+		for _, est := range builder.compCtx.RangeAccessPaths(node.ObjRef, col.Name, min, max) {
+			p := *path
+			p.Path = est.Path
+			p.Cost = pathCost(est)
+			paths = append(paths, &p)
+		}
+		return paths
+	}
+	return nil
+}
+
+// rangePaths returns the access paths of the ranges of the temporal columns
+// and the commit ts pseudo column compared with constants in the filters,
+// the comparisons of a column in the conjunctions are intersected into a
+// single range
+func (builder *QueryBuilder) rangePaths(node *Node, filters []*Expr) []*AccessPath {
+	ranges := make(map[int32]*AccessPath)
+	var cols []int32
+	var collect func(expr *Expr)
+	collect = func(expr *Expr) {
+		fn, ok := expr.Expr.(*plan.Expr_F)
+		if !ok {
+			return
+		}
+		args := fn.F.Args
+		switch name := fn.F.Func.GetObjName(); name {
+		case "and":
+			collect(args[0])
+			collect(args[1])
+		case "<", "<=", ">", ">=":
+			for i := range args {
+				colPos, ok := scanColumn(node.TableDef, args[i])
+				if !ok || !isTemporalType(node.TableDef.Cols[colPos].Typ) && !isCommitTSColumn(node.TableDef, colPos) {
+					continue
+				}
+				v, ok := partitionConstant(node.TableDef.Cols[colPos].Typ, args[1-i])
+				if !ok {
+					continue
+				}
+				path, ok := ranges[colPos]
+				if !ok {
+					path = &AccessPath{Column: node.TableDef.Cols[colPos].Name, Bounded: true}
+					ranges[colPos] = path
+					cols = append(cols, colPos)
+				}
+				if i == 0 {
+					path.tighten(name, v)
+				} else {
+					path.tighten(reverseComparison[name], v)
+				}
+				return
+			}
+		}
+	}
+	for _, filter := range filters {
+		collect(filter)
+	}
+	var paths []*AccessPath
+	for _, colPos := range cols {
+		path := ranges[colPos]
+		min, max := path.Range(node.TableDef.Cols[colPos].Typ)
+		for _, est := range builder.compCtx.RangeAccessPaths(node.ObjRef, path.Column, min, max) {
+			p := *path
+			p.Path = est.Path
+			p.Cost = pathCost(est)
+			paths = append(paths, &p)
+		}
+	}
+	return paths
+}
+
+// scanColumn returns the position of the scanned column referred by expr
+func scanColumn(tableDef *TableDef, expr *Expr) (int32, bool) {
+	for colPos, col := range tableDef.Cols {
+		if isStringType(col.Typ) {
+			if e, ok := expr.Expr.(*plan.Expr_Col); ok && e.Col.RelPos == 0 && e.Col.ColPos == int32(colPos) {
+				return int32(colPos), true
+			}
+		} else if isPartitionColumn(int32(colPos), col.Typ, expr) {
+			return int32(colPos), true
+		}
+	}
+	return 0, false
+}
+
+// scanConstant sets the value of the path to the constant compared with a
+// column of the type, false if the constant can not equal a value of the
+// column
+func scanConstant(typ *plan.Type, expr *Expr, path *AccessPath) bool {
+	if isStringType(typ) {
+		// the equality of the other collations is not the equality of bytes
+		if exprCollation(typ) != types.CollationBin || exprCollation(expr.Typ) != types.CollationBin {
+			return false
+		}
+		if e, ok := expr.Expr.(*plan.Expr_C); ok && !e.C.Isnull {
+			if c, ok := e.C.Value.(*plan.Const_Sval); ok {
+				path.Str = c.Sval
+				return true
+			}
+		}
+		return false
+	}
+	v, ok := partitionConstant(typ, expr)
+	if !ok {
+		return false
+	}
+	if _, ok = columnValue(typ, v); ok {
+		path.Int = v
+	}
+	return ok
+}
+
+// columnValue converts the integer representation of a value to the go type
+// of the column, ok is false if the column can not hold it
+func columnValue(typ *plan.Type, v int64) (any, bool) {
+	switch typ.Id {
+	case plan.Type_INT8:
+		return int8(v), v >= math.MinInt8 && v <= math.MaxInt8
+	case plan.Type_INT16:
+		return int16(v), v >= math.MinInt16 && v <= math.MaxInt16
+	case plan.Type_INT32:
+		return int32(v), v >= math.MinInt32 && v <= math.MaxInt32
+	case plan.Type_INT64:
+		return v, true
+	case plan.Type_UINT8:
+		return uint8(v), v >= 0 && v <= math.MaxUint8
+	case plan.Type_UINT16:
+		return uint16(v), v >= 0 && v <= math.MaxUint16
+	case plan.Type_UINT32:
+		return uint32(v), v >= 0 && v <= math.MaxUint32
+	case plan.Type_UINT64:
+		return uint64(v), v >= 0
+	case plan.Type_DATE:
+		return types.Date(v), v >= math.MinInt32 && v <= math.MaxInt32
+	case plan.Type_DATETIME:
+		return types.Datetime(v), true
+	}
+	return nil, false
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChooseAccessPath(t *testing.T) {
+	choose := func(sql string, paths []engine.PathEstimate) *AccessPath {
+		mock := NewMockOptimizer()
+		mock.ctxt.paths = map[string]map[string][]engine.PathEstimate{
+			"nation": {"n_nationkey": paths},
+		}
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				path, ok := GetAccessPath(node.TableDef)
+				if !ok {
+					return nil
+				}
+				for _, col := range node.TableDef.Cols {
+					if col.Name == path.Column {
+						require.Equal(t, int32(3), path.Value(col.Typ))
+					}
+				}
+				return path
+			}
+		}
+		t.Fatal("table scan not found")
+		return nil
+	}
+
+	sql := "select n_name from nation where n_nationkey = 3"
+	// a table of one block is scanned
+	small := []engine.PathEstimate{
+		{Path: engine.FullScan, Blocks: 1, Rows: 25},
+		{Path: engine.ZonemapScan, Probes: 1, Blocks: 1, Rows: 25},
+		{Path: engine.PrimaryKeyLookup, Probes: 1, Blocks: 1, Rows: 25},
+	}
+	require.Equal(t, engine.FullScan, choose(sql, small).Path)
+
+	// the zonemaps of the sorted blocks leave one block
+	sorted := []engine.PathEstimate{
+		{Path: engine.FullScan, Blocks: 100, Rows: 819200},
+		{Path: engine.ZonemapScan, Probes: 100, Blocks: 1, Rows: 8192},
+		{Path: engine.PrimaryKeyLookup, Probes: 50, Blocks: 1, Rows: 8192},
+	}
+	path := choose(sql, sorted)
+	require.Equal(t, engine.ZonemapScan, path.Path)
+	require.Equal(t, "n_nationkey", path.Column)
+	require.Equal(t, float64(8192+16+100), path.Cost)
+
+	// the zonemaps of the unsorted blocks prune nothing
+	unsorted := []engine.PathEstimate{
+		{Path: engine.FullScan, Blocks: 100, Rows: 819200},
+		{Path: engine.ZonemapScan, Probes: 100, Blocks: 100, Rows: 819200},
+		{Path: engine.PrimaryKeyLookup, Probes: 50, Blocks: 1, Rows: 8192},
+	}
+	require.Equal(t, engine.PrimaryKeyLookup, choose(sql, unsorted).Path)
+	require.Equal(t, engine.PrimaryKeyLookup, choose("select n_name from nation where 3 = n_nationkey and n_name = 'a'", unsorted).Path)
+
+	// no path without an equality with a constant, or the storage gives none
+	require.Nil(t, choose("select n_name from nation where n_nationkey > 3", unsorted))
+	require.Nil(t, choose("select n_name from nation where n_nationkey = n_regionkey", unsorted))
+	require.Nil(t, choose("select n_name from nation where n_nationkey = 3 for update", unsorted))
+	require.Nil(t, choose(sql, nil))
+}
+
+func TestChoosePrefixPath(t *testing.T) {
+	choose := func(sql string) *AccessPath {
+		mock := NewMockOptimizer()
+		mock.ctxt.paths = map[string]map[string][]engine.PathEstimate{
+			"nation": {"n_name": {
+				{Path: engine.FullScan, Blocks: 100, Rows: 819200},
+				{Path: engine.ZonemapScan, Probes: 100, Blocks: 2, Rows: 16384},
+			}},
+		}
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				path, ok := GetAccessPath(node.TableDef)
+				if !ok {
+					return nil
+				}
+				return path
+			}
+		}
+		t.Fatal("table scan not found")
+		return nil
+	}
+
+	path := choose("select n_nationkey from nation where n_name like 'CHI%'")
+	require.NotNil(t, path)
+	require.Equal(t, engine.ZonemapScan, path.Path)
+	require.True(t, path.Prefix)
+	require.Equal(t, "CHI", path.Str)
+	min, max := path.Range(nil)
+	require.Equal(t, []byte("CHI"), min)
+	require.Equal(t, []byte("CHJ"), max)
+
+	// the escaped wildcards are a part of the prefix
+	path = choose("select n_nationkey from nation where n_name like 'A\\\\_B%'")
+	require.NotNil(t, path)
+	require.Equal(t, "A_B", path.Str)
+
+	// the other patterns are not a range of bytes
+	require.Nil(t, choose("select n_nationkey from nation where n_name like '%CHI%'"))
+	require.Nil(t, choose("select n_nationkey from nation where n_name like 'C_I%'"))
+	require.Nil(t, choose("select n_nationkey from nation where n_name like '%'"))
+	require.Nil(t, choose("select n_nationkey from nation where 'CHINA' like n_name"))
+}
+
+func TestChooseRangePath(t *testing.T) {
+	choose := func(sql string) *AccessPath {
+		mock := NewMockOptimizer()
+		mock.ctxt.paths = map[string]map[string][]engine.PathEstimate{
+			"orders": {"o_orderdate": {
+				{Path: engine.FullScan, Blocks: 100, Rows: 819200},
+				{Path: engine.ZonemapScan, Probes: 100, Blocks: 2, Rows: 16384},
+			}},
+		}
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				path, ok := GetAccessPath(node.TableDef)
+				if !ok {
+					return nil
+				}
+				require.Equal(t, "[1995-01-01, 1995-01-31]", path.Interval(node.TableDef))
+				return path
+			}
+		}
+		t.Fatal("table scan not found")
+		return nil
+	}
+
+	// the comparisons of the column in the conjunction are intersected
+	for _, sql := range []string{
+		"select o_orderkey from orders where o_orderdate between '1995-01-01' and '1995-01-31'",
+		"select o_orderkey from orders where o_orderdate >= '1995-01-01' and o_orderdate < '1995-02-01'",
+		"select o_orderkey from orders where '1995-01-31' >= o_orderdate and o_orderdate > '1994-12-31' and o_orderdate > '1994-01-01'",
+	} {
+		path := choose(sql)
+		require.NotNil(t, path, sql)
+		require.Equal(t, engine.ZonemapScan, path.Path)
+		require.True(t, path.Bounded)
+		require.False(t, path.Prefix)
+	}
+
+	// the disjunctions and the comparisons with columns are not a range
+	require.Nil(t, choose("select o_orderkey from orders where o_orderdate < '1995-01-01' or o_orderdate > '1995-02-01'"))
+	require.Nil(t, choose("select o_orderkey from orders where o_orderdate < o_orderdate"))
+
+	mock := NewMockOptimizer()
+	mock.ctxt.paths = map[string]map[string][]engine.PathEstimate{
+		"orders": {"o_orderdate": {
+			{Path: engine.FullScan, Blocks: 100, Rows: 819200},
+			{Path: engine.ZonemapScan, Probes: 100, Blocks: 2, Rows: 16384},
+		}},
+	}
+	logicPlan, err := runOneStmt(mock, t, "select o_orderkey from orders where o_orderdate >= '1995-01-01'")
+	require.NoError(t, err)
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			path, ok := GetAccessPath(node.TableDef)
+			require.True(t, ok)
+			require.Equal(t, "[1995-01-01, +inf)", path.Interval(node.TableDef))
+		}
+	}
+}
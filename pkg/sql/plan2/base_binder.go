@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"go/constant"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
 )
 
 func splitAndBindCondition(astExpr tree.Expr, ctx *BindContext) ([]*plan.Expr, error) {
@@ -68,17 +70,25 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32) (expr *Expr, e
 		expr, err = b.bindRangeCond(exprImpl, depth)
 	case *tree.UnresolvedName:
 		expr, err = b.impl.BindColRef(exprImpl, depth)
+	case *tree.CollateExpr:
+		expr, err = b.bindCollateExpr(exprImpl, depth)
 	case *tree.CastExpr:
-		expr, err = b.impl.BindExpr(exprImpl.Expr, depth, false)
+		var typ *Type
+		typ, err = getTypeFromAst(exprImpl.Type)
 		if err != nil {
 			return
 		}
-		var typ *Type
-		typ, err = getTypeFromAst(exprImpl.Type)
+		arg := exprImpl.Expr
+		if isNumericType(typ) {
+			if arg, err = rewriteNumericArg(arg); err != nil {
+				return
+			}
+		}
+		expr, err = b.impl.BindExpr(arg, depth, false)
 		if err != nil {
 			return
 		}
-		expr, err = appendCastBeforeExpr(expr, typ)
+		expr, err = appendExplicitCastBeforeExpr(expr, typ)
 	case *tree.IsNullExpr:
 		expr, err = b.bindFuncExprImplByAstExpr("isnull", []tree.Expr{exprImpl.Expr}, depth)
 	case *tree.IsNotNullExpr:
@@ -116,7 +126,7 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32) (expr *Expr, e
 	case *tree.MaxValue:
 		err = errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr max'%v' is not support now", exprImpl))
 	case *tree.VarExpr:
-		err = errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr var'%v' is not support now", exprImpl))
+		expr, err = bindVariable(b.builder.compCtx, exprImpl)
 	case *tree.StrVal:
 		err = errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr str'%v' is not support now", exprImpl))
 	case *tree.ExprList:
@@ -147,6 +157,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32) (
 				relPos = binding.tag
 				colPos = binding.colIdByName[col]
 				typ = binding.types[colPos]
+				binding.refCnts[colPos]++
 			} else {
 				return nil, errors.New(errno.AmbiguousColumn, fmt.Sprintf("column reference %q is ambiguous", name))
 			}
@@ -162,6 +173,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32) (
 			if colPos != NotFound {
 				typ = binding.types[colPos]
 				relPos = binding.tag
+				binding.refCnts[colPos]++
 			} else {
 				err = errors.New(errno.InvalidColumnReference, fmt.Sprintf("column %q does not exist", name))
 			}
@@ -174,7 +186,8 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32) (
 		b.boundCols = append(b.boundCols, table+"."+col)
 
 		expr = &plan.Expr{
-			Typ: typ,
+			Typ:     typ,
+			ColName: name,
 		}
 
 		if depth == 0 {
@@ -316,19 +329,21 @@ func (b *baseBinder) bindBinaryExpr(astExpr *tree.BinaryExpr, depth int32) (*Exp
 func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int32) (*Expr, error) {
 	switch astExpr.Op {
 	case tree.EQUAL:
-		return b.bindFuncExprImplByAstExpr("=", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr("=", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.LESS_THAN:
-		return b.bindFuncExprImplByAstExpr("<", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr("<", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.LESS_THAN_EQUAL:
-		return b.bindFuncExprImplByAstExpr("<=", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr("<=", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.GREAT_THAN:
-		return b.bindFuncExprImplByAstExpr(">", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr(">", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.GREAT_THAN_EQUAL:
-		return b.bindFuncExprImplByAstExpr(">=", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr(">=", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.NOT_EQUAL:
-		return b.bindFuncExprImplByAstExpr("<>", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr("<>", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+	case tree.NULL_SAFE_EQUAL:
+		return b.bindFuncExprImplByAstExpr("<=>", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.LIKE:
-		return b.bindFuncExprImplByAstExpr("like", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+		return b.bindCollatedFuncExprImplByAstExpr("like", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
 	case tree.NOT_LIKE:
 		new_expr := tree.NewComparisonExpr(tree.LIKE, astExpr.Left, astExpr.Right)
 		return b.bindFuncExprImplByAstExpr("not", []tree.Expr{new_expr}, depth)
@@ -391,10 +406,20 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 	// rewrite some ast Exprs before binding
 	switch name {
 	case "extract":
-		// ”extract(year from col_name)"  parser return year as UnresolvedName.
-		// we must rewrite it to string。 because binder bind UnresolvedName as column name
-		unit := astArgs[0].(*tree.UnresolvedName).Parts[0]
-		astArgs[0] = tree.NewNumVal(constant.MakeString(unit), unit, false)
+		// "extract(year from col_name)" is rewritten to "year(col_name)", the
+		// parser returns the unit as UnresolvedName
+		var err error
+		if name, astArgs, err = rewriteExtract(astArgs); err != nil {
+			return nil, err
+		}
+	case "trim":
+		// "trim([{both | leading | trailing} [remstr] from] str)" is
+		// rewritten to "trim(remstr, str, mode)", the parser returns the
+		// direction as UnresolvedName
+		var err error
+		if astArgs, err = rewriteTrim(astArgs); err != nil {
+			return nil, err
+		}
 	case "count":
 		// we will rewrite "count(*)" to "starcount(col)"
 		// count(*) : astExprs[0].(type) is *tree.NumVal
@@ -416,19 +441,67 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 			}
 		}
 	}
-	// bind ast function's args
-	args := make([]*Expr, len(astArgs))
-	for idx, arg := range astArgs {
-		expr, err := b.impl.BindExpr(arg, depth, false)
-		if err != nil {
-			return nil, err
-		}
-		args[idx] = expr
+	args, err := b.bindFuncArgs(name, astArgs, depth)
+	if err != nil {
+		return nil, err
 	}
-
 	return b.bindFuncExprImplByPlanExpr(name, args)
 }
 
+// extractFunctions are the functions of the parts of a date by the units of extract
+var extractFunctions = map[string]string{
+	"year":        "year",
+	"month":       "month",
+	"week":        "week",
+	"day":         "day",
+	"hour":        "hour",
+	"minute":      "minute",
+	"second":      "second",
+	"microsecond": "microsecond",
+}
+
+// rewriteExtract returns the function and the args of "extract(unit from expr)"
+func rewriteExtract(astArgs []tree.Expr) (string, []tree.Expr, error) {
+	if len(astArgs) != 2 {
+		return "", nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "extract function need two args")
+	}
+	unitName, ok := astArgs[0].(*tree.UnresolvedName)
+	if !ok {
+		return "", nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "the unit of extract must be a name")
+	}
+	unit := strings.ToLower(unitName.Parts[0])
+	name, ok := extractFunctions[unit]
+	if !ok {
+		return "", nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("extract %s is not supported now", unit))
+	}
+	return name, astArgs[1:], nil
+}
+
+// rewriteTrim returns the args of "trim(remstr, str, mode)" of the args of
+// "trim(str)" or "trim(direction, remstr, str)" made by the parser
+func rewriteTrim(astArgs []tree.Expr) ([]tree.Expr, error) {
+	switch len(astArgs) {
+	case 1:
+		space := tree.NewNumValWithType(constant.MakeString(" "), " ", false, tree.P_char)
+		return []tree.Expr{space, astArgs[0], trimModeExpr(trim.Both)}, nil
+	case 3:
+		direction, ok := astArgs[0].(*tree.UnresolvedName)
+		if !ok {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "the direction of trim must be a name")
+		}
+		mode, ok := trim.Modes[strings.ToLower(direction.Parts[0])]
+		if !ok {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("trim %s is not supported", direction.Parts[0]))
+		}
+		return []tree.Expr{astArgs[1], astArgs[2], trimModeExpr(mode)}, nil
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "trim function need one or three args")
+}
+
+func trimModeExpr(mode int64) tree.Expr {
+	return tree.NewNumValWithType(constant.MakeInt64(mode), strconv.FormatInt(mode, 10), false, tree.P_int64)
+}
+
 func (b *baseBinder) bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 	var err error
 
@@ -440,6 +513,21 @@ func (b *baseBinder) bindFuncExprImplByPlanExpr(name string, args []*Expr) (*pla
 			Id: plan.Type_DATE,
 		})
 	case "interval":
+		// "interval a day" keeps the expression and the unit for date_add and
+		// date_sub to compute the interval of every row
+		if len(args) == 2 {
+			return &Expr{
+				Expr: &plan.Expr_List{
+					List: &plan.ExprList{
+						List: args,
+					},
+				},
+				Typ: &plan.Type{
+					Id:       plan.Type_INTERVAL,
+					Nullable: args[0].Typ.GetNullable(),
+				},
+			}, nil
+		}
 		// rewrite interval function to cast function, and retrun directly
 		return appendCastBeforeExpr(args[0], &plan.Type{
 			Id: plan.Type_INTERVAL,
@@ -460,11 +548,33 @@ func (b *baseBinder) bindFuncExprImplByPlanExpr(name string, args []*Expr) (*pla
 		if err := convertValueIntoBool(name, args, true); err != nil {
 			return nil, err
 		}
-	case "=", "<", "<=", ">", ">=", "<>":
+	case "=", "<", "<=", ">", ">=", "<>", "<=>":
 		// why not append cast function?
 		if err := convertValueIntoBool(name, args, false); err != nil {
 			return nil, err
 		}
+		if err := convertStringIntoTime(args); err != nil {
+			return nil, err
+		}
+		if err := convertIntervalIntoTime(args); err != nil {
+			return nil, err
+		}
+		if err := convertBitIntoUnsigned(name, args); err != nil {
+			return nil, err
+		}
+		if err := convertStringIntoNumber(name, args); err != nil {
+			return nil, err
+		}
+		if err := convertSignedUnsignedIntoDecimal(args); err != nil {
+			return nil, err
+		}
+	case "hour", "minute", "second", "microsecond":
+		// the time parts of a string are of its datetime
+		if len(args) == 1 && (args[0].Typ.Id == plan.Type_VARCHAR || args[0].Typ.Id == plan.Type_CHAR) {
+			if args[0], err = appendCastBeforeExpr(args[0], &plan.Type{Id: plan.Type_DATETIME}); err != nil {
+				return nil, err
+			}
+		}
 	case "date_add", "date_sub":
 		// rewrite date_add/date_sub function
 		// date_add(col_name, "1 day"), will rewrite to date_add(col_name, number, unit)
@@ -480,50 +590,57 @@ func (b *baseBinder) bindFuncExprImplByPlanExpr(name string, args []*Expr) (*pla
 		if len(args) != 2 {
 			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "operator function need two args")
 		}
-		if args[0].Typ.Id == plan.Type_DATE && args[1].Typ.Id == plan.Type_INTERVAL {
-			name = "date_add"
-			args, err = resetDateFunctionArgs(args[0], args[1])
-		}
-		if args[0].Typ.Id == plan.Type_INTERVAL && args[1].Typ.Id == plan.Type_DATE {
-			name = "date_add"
-			args, err = resetDateFunctionArgs(args[1], args[0])
-		}
-		if args[0].Typ.Id == plan.Type_DATETIME && args[1].Typ.Id == plan.Type_INTERVAL {
-			name = "date_add"
-			args, err = resetDateFunctionArgs(args[0], args[1])
-		}
-		if args[0].Typ.Id == plan.Type_INTERVAL && args[1].Typ.Id == plan.Type_DATETIME {
-			name = "date_add"
-			args, err = resetDateFunctionArgs(args[1], args[0])
-		}
-		if args[0].Typ.Id == plan.Type_VARCHAR && args[1].Typ.Id == plan.Type_INTERVAL {
+		if args[1].Typ.Id == plan.Type_INTERVAL && isIntervalOperand(args[0].Typ) {
 			name = "date_add"
 			args, err = resetDateFunctionArgs(args[0], args[1])
-		}
-		if args[0].Typ.Id == plan.Type_INTERVAL && args[1].Typ.Id == plan.Type_VARCHAR {
+		} else if args[0].Typ.Id == plan.Type_INTERVAL && isIntervalOperand(args[1].Typ) {
 			name = "date_add"
 			args, err = resetDateFunctionArgs(args[1], args[0])
 		}
 		if err != nil {
 			return nil, err
 		}
+		if name == "+" {
+			if err = convertBitIntoUnsigned(name, args); err != nil {
+				return nil, err
+			}
+			if err = convertStringIntoNumber(name, args); err != nil {
+				return nil, err
+			}
+		}
 	case "-":
 		// rewrite "date '2001' - interval '1 day'" to date_sub(date '2001', 1, day(unit))
-		if args[0].Typ.Id == plan.Type_DATE && args[1].Typ.Id == plan.Type_INTERVAL {
+		if args[1].Typ.Id == plan.Type_INTERVAL && isIntervalOperand(args[0].Typ) {
 			name = "date_sub"
 			args, err = resetDateFunctionArgs(args[0], args[1])
 		}
-		if args[0].Typ.Id == plan.Type_DATETIME && args[1].Typ.Id == plan.Type_INTERVAL {
-			name = "date_sub"
-			args, err = resetDateFunctionArgs(args[0], args[1])
+		if err != nil {
+			return nil, err
 		}
-		if args[0].Typ.Id == plan.Type_VARCHAR && args[1].Typ.Id == plan.Type_INTERVAL {
-			name = "date_sub"
-			args, err = resetDateFunctionArgs(args[0], args[1])
+		// "dt1 - dt2" is the duration between them
+		if name == "-" {
+			if err = convertTemporalIntoDatetime(args); err != nil {
+				return nil, err
+			}
+			if err = convertBitIntoUnsigned(name, args); err != nil {
+				return nil, err
+			}
+			if err = convertStringIntoNumber(name, args); err != nil {
+				return nil, err
+			}
 		}
-		if err != nil {
+	case "*", "/", "div", "%":
+		if err = convertBitIntoUnsigned(name, args); err != nil {
+			return nil, err
+		}
+		if err = convertStringIntoNumber(name, args); err != nil {
 			return nil, err
 		}
+		if name == "div" || name == "%" {
+			if err = convertConstIntoUnsigned(args); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// get args(exprs) & types
@@ -563,7 +680,8 @@ func (b *baseBinder) bindFuncExprImplByPlanExpr(name string, args []*Expr) (*pla
 			},
 		},
 		Typ: &Type{
-			Id: plan.Type_TypeId(funcDef.ReturnTyp),
+			Id:       plan.Type_TypeId(funcDef.ReturnTyp),
+			Nullable: isNullableResult(name, funcDef, args),
 		},
 	}, nil
 }
@@ -600,7 +718,11 @@ func (b *baseBinder) bindNumVal(astExpr *tree.NumVal) (*Expr, error) {
 			},
 		}, nil
 	case constant.Int:
-		intValue, _ := constant.Int64Val(astExpr.Value)
+		intValue, exact := constant.Int64Val(astExpr.Value)
+		if !exact {
+			// the unsigned integers out of the range of int64
+			return bigIntConst(astExpr.Value)
+		}
 		if astExpr.Negative() {
 			intValue = -intValue
 		}
@@ -664,42 +786,161 @@ func (b *baseBinder) bindNumVal(astExpr *tree.NumVal) (*Expr, error) {
 
 // --- util functions ----
 
+// appendCastBeforeExpr casts expr to toType where the planner coerces it
 func appendCastBeforeExpr(expr *Expr, toType *Type) (*Expr, error) {
+	return appendCastFuncBeforeExpr("cast", expr, toType, true)
+}
+
+// appendExplicitCastBeforeExpr casts expr to toType for a CAST in the query
+func appendExplicitCastBeforeExpr(expr *Expr, toType *Type) (*Expr, error) {
+	return appendCastFuncBeforeExpr("cast", expr, toType, false)
+}
+
+// appendImplicitCastBeforeExpr converts a string to float64 in a numeric
+// context, by the numeric prefix of the string
+func appendImplicitCastBeforeExpr(expr *Expr) (*Expr, error) {
+	return appendCastFuncBeforeExpr("implicit_cast", expr, &plan.Type{
+		Id:   plan.Type_FLOAT64,
+		Size: 8,
+	}, true)
+}
+
+func appendCastFuncBeforeExpr(name string, expr *Expr, toType *Type, implicit bool) (*Expr, error) {
 	argsType := []types.T{
 		types.T(expr.Typ.Id),
 		types.T(toType.Id),
 	}
-	_, funcId, _, err := function.GetFunctionByName("cast", argsType)
+	_, funcId, _, err := function.GetFunctionByName(name, argsType)
 	if err != nil {
 		return nil, err
 	}
 	return &Expr{
 		Expr: &plan.Expr_F{
 			F: &plan.Function{
-				Func: getFunctionObjRef(funcId, "cast"),
+				Func: getFunctionObjRef(funcId, name),
 				Args: []*Expr{expr, {
 					Expr: &plan.Expr_T{
 						T: &plan.TargetType{
-							Typ: toType,
+							Typ:      toType,
+							Implicit: implicit,
 						},
 					},
 				}},
 			},
 		},
-		Typ: toType,
+		Typ: castResultType(expr, toType),
 	}, nil
 }
 
-func resetDateFunctionArgs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error) {
-	strExpr := intervalExpr.Expr.(*plan.Expr_F).F.Args[0].Expr
-	intervalStr := strExpr.(*plan.Expr_C).C.Value.(*plan.Const_Sval).Sval
-	intervalArray := strings.Split(intervalStr, " ")
+// castResultType returns toType, nullable if the casted expr is
+func castResultType(expr *Expr, toType *Type) *Type {
+	if !expr.Typ.GetNullable() || toType.Nullable {
+		return toType
+	}
+	return nullableType(toType)
+}
+
+// nullableType returns a nullable copy of typ
+func nullableType(typ *Type) *Type {
+	ret := *typ
+	ret.Nullable = true
+	return &ret
+}
+
+// isNullableResult returns true if the result of a function can be null: a
+// count never is, the other aggregates are for an empty input, and a scalar
+// function is when any of its arguments is
+func isNullableResult(name string, funcDef function.Function, args []*Expr) bool {
+	if funcDef.IsAggregate() {
+		return name != "count" && name != "starcount"
+	}
+	switch name {
+	case "isnull", "<=>":
+		return false
+	}
+	for _, arg := range args {
+		if arg.Typ.GetNullable() {
+			return true
+		}
+	}
+	return false
+}
 
-	intervalType, err := types.IntervalTypeOf(intervalArray[1])
+// getIntervalValue returns the number and the unit of an interval literal,
+// such as "interval '-1 2' day_hour", whose number is normalized to that of
+// its smallest unit
+func getIntervalValue(intervalExpr *Expr) (int64, types.IntervalType, error) {
+	var intervalStr string
+	if f, ok := intervalExpr.Expr.(*plan.Expr_F); ok {
+		if c, ok := f.F.Args[0].Expr.(*plan.Expr_C); ok {
+			intervalStr = c.C.GetSval()
+		}
+	}
+	if intervalStr == "" {
+		return 0, 0, errors.New(errno.FeatureNotSupported, "the interval must be a constant")
+	}
+	value, unit := "", intervalStr
+	if i := strings.LastIndexByte(intervalStr, ' '); i >= 0 {
+		value, unit = strings.TrimSpace(intervalStr[:i]), intervalStr[i+1:]
+	}
+
+	intervalType, err := types.IntervalTypeOf(unit)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
+	}
+	// the sign of "interval -1 day" is of the whole interval
+	neg := false
+	if strings.HasPrefix(value, "-") {
+		value, neg = value[1:], true
 	}
-	returnNum, returnType, err := types.NormalizeInterval(intervalArray[0], intervalType)
+	num, typ, err := types.NormalizeInterval(value, intervalType)
+	if neg {
+		num = -num
+	}
+	return num, typ, err
+}
+
+// getIntervalArgs returns the number and the unit of an interval for
+// date_add/date_sub. The number of "interval a day" is the expression a of
+// every row cast to int64, its unit can't be a compound one like day_hour,
+// whose number is a string to parse.
+func getIntervalArgs(intervalExpr *Expr) (*Expr, types.IntervalType, error) {
+	list, ok := intervalExpr.Expr.(*plan.Expr_List)
+	if !ok {
+		num, unit, err := getIntervalValue(intervalExpr)
+		if err != nil {
+			return nil, 0, err
+		}
+		return int64Const(num), unit, nil
+	}
+	numExpr, unitStr := list.List.List[0], list.List.List[1].Expr.(*plan.Expr_C).C.Value.(*plan.Const_Sval).Sval
+	unit, err := types.IntervalTypeOf(unitStr)
+	if err != nil {
+		return nil, 0, err
+	}
+	if unit > types.Year {
+		return nil, 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the number of an interval of %s must be a string constant", unit))
+	}
+	if numExpr.Typ.Id != plan.Type_INT64 {
+		if numExpr, err = appendCastBeforeExpr(numExpr, &plan.Type{Id: plan.Type_INT64, Size: 8}); err != nil {
+			return nil, 0, err
+		}
+	}
+	return numExpr, unit, nil
+}
+
+// isIntervalOperand returns true if an interval can be added to or subtracted
+// from a value of typ
+func isIntervalOperand(typ *Type) bool {
+	switch typ.Id {
+	case plan.Type_DATE, plan.Type_DATETIME, plan.Type_VARCHAR, plan.Type_TIME:
+		return true
+	}
+	return false
+}
+
+func resetDateFunctionArgs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error) {
+	numExpr, unit, err := getIntervalArgs(intervalExpr)
 	if err != nil {
 		return nil, err
 	}
@@ -707,7 +948,7 @@ func resetDateFunctionArgs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error)
 	// "date '2020-10-10' - interval 1 Hour"  will return datetime
 	// so we rewrite "date '2020-10-10' - interval 1 Hour"  to  "date_add(datetime, 1, hour)"
 	if dateExpr.Typ.Id == plan.Type_DATE {
-		switch returnType {
+		switch unit {
 		case types.Day, types.Week, types.Month, types.Quarter, types.Year:
 		default:
 			dateExpr, err = appendCastBeforeExpr(dateExpr, &plan.Type{
@@ -721,35 +962,7 @@ func resetDateFunctionArgs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error)
 		}
 	}
 
-	return []*Expr{
-		dateExpr,
-		{
-			Expr: &plan.Expr_C{
-				C: &Const{
-					Value: &plan.Const_Ival{
-						Ival: returnNum,
-					},
-				},
-			},
-			Typ: &plan.Type{
-				Id:   plan.Type_INT64,
-				Size: 8,
-			},
-		},
-		{
-			Expr: &plan.Expr_C{
-				C: &Const{
-					Value: &plan.Const_Ival{
-						Ival: int64(returnType),
-					},
-				},
-			},
-			Typ: &plan.Type{
-				Id:   plan.Type_INT64,
-				Size: 8,
-			},
-		},
-	}, nil
+	return []*Expr{dateExpr, numExpr, int64Const(int64(unit))}, nil
 }
 
 //splitConjunctiveCondition split a expression to a list of AND conditions.
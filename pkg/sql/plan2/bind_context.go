@@ -81,6 +81,60 @@ func (bc *BindContext) mergeContexts(left, right *BindContext) error {
 	return nil
 }
 
+// setNullable makes the columns of the bindings nullable, for the side of an
+// outer join which is padded with nulls
+func (bc *BindContext) setNullable() {
+	for _, binding := range bc.bindings {
+		for i, typ := range binding.types {
+			if !typ.Nullable {
+				binding.types[i] = nullableType(typ)
+			}
+		}
+	}
+}
+
+func (bc *BindContext) addUsingCols(cols []string, typ plan.Node_JoinFlag, left, right *BindContext) ([]*plan.Expr, error) {
+	if typ == plan.Node_OUTER {
+		return nil, errors.New(errno.FeatureNotSupported, "USING and NATURAL are not supported in full outer join")
+	}
+
+	exprs := make([]*plan.Expr, 0, len(cols))
+	visited := make(map[string]any)
+	for _, col := range cols {
+		if _, ok := visited[col]; ok {
+			return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("column %q appears more than once in USING clause", col))
+		}
+		visited[col] = nil
+
+		expr, err := bc.addUsingCol(col, typ, left, right)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+
+	return exprs, nil
+}
+
+// commonCols returns the columns of bc which also exist in right, in the
+// order they appear in the star expansion of bc
+func (bc *BindContext) commonCols(right *BindContext) []string {
+	var cols []string
+	_, names, _ := bc.unfoldStar("")
+	visited := make(map[string]any)
+	for _, col := range names {
+		if _, ok := visited[col]; ok {
+			continue
+		}
+		visited[col] = nil
+		if _, ok := right.bindingByCol[col]; ok {
+			cols = append(cols, col)
+		}
+	}
+
+	return cols
+}
+
 func (bc *BindContext) addUsingCol(col string, typ plan.Node_JoinFlag, left, right *BindContext) (*plan.Expr, error) {
 	leftBinding, ok := left.bindingByCol[col]
 	if !ok {
@@ -114,6 +168,8 @@ func (bc *BindContext) addUsingCol(col string, typ plan.Node_JoinFlag, left, rig
 
 	leftPos := leftBinding.colIdByName[col]
 	rightPos := rightBinding.colIdByName[col]
+	leftBinding.refCnts[leftPos]++
+	rightBinding.refCnts[rightPos]++
 	expr, err := bc.binder.(*TableBinder).bindFuncExprImplByPlanExpr("=", []*plan.Expr{
 		{
 			Typ: leftBinding.types[leftPos],
@@ -154,13 +210,16 @@ func (bc *BindContext) unfoldStar(table string) ([]tree.SelectExpr, []string, er
 			return nil, nil, errors.New(errno.UndefinedTable, fmt.Sprintf("missing FROM-clause entry for table %q", table))
 		}
 
-		exprs := make([]tree.SelectExpr, len(binding.cols))
-		names := make([]string, len(binding.cols))
+		exprs := make([]tree.SelectExpr, 0, len(binding.cols))
+		names := make([]string, 0, len(binding.cols))
 
 		for i, col := range binding.cols {
+			if binding.isHidden(i) {
+				continue
+			}
 			expr, _ := tree.NewUnresolvedName(table, col)
-			exprs[i] = tree.SelectExpr{Expr: expr}
-			names[i] = col
+			exprs = append(exprs, tree.SelectExpr{Expr: expr})
+			names = append(names, col)
 		}
 
 		return exprs, names, nil
@@ -172,8 +231,8 @@ func (bc *BindContext) doUnfoldStar(root *BindingTreeNode, visitedUsingCols map[
 		return
 	}
 	if root.binding != nil {
-		for _, col := range root.binding.cols {
-			if _, ok := visitedUsingCols[col]; !ok {
+		for i, col := range root.binding.cols {
+			if _, ok := visitedUsingCols[col]; !ok && !root.binding.isHidden(i) {
 				expr, _ := tree.NewUnresolvedName(root.binding.table, col)
 				*exprs = append(*exprs, tree.SelectExpr{Expr: expr})
 				*names = append(*names, col)
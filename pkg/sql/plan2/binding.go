@@ -26,6 +26,11 @@ func (b *Binding) FindColumn(col string) int32 {
 	return NotFound
 }
 
+// isHidden returns true if the i-th column is not unfolded by a star
+func (b *Binding) isHidden(i int) bool {
+	return b.hidden && i == len(b.cols)-1
+}
+
 func NewBinding(tag, nodeId int32, table string, cols []string, types []*plan.Type) *Binding {
 	binding := &Binding{
 		tag:     tag,
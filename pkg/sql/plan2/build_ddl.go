@@ -16,7 +16,10 @@ package plan2
 
 import (
 	"fmt"
+	"go/constant"
+	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -40,8 +43,31 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		createTable.Database = string(stmt.Table.SchemaName)
 	}
 
+	// get the default collation of string columns, which is the one of the
+	// database unless the table has its own
+	collation := getDatabaseCollation(ctx, createTable.Database)
+	hasCollate := false
+	for _, option := range stmt.Options {
+		switch opt := option.(type) {
+		case *tree.TableOptionCharset:
+			if !isSupportedCharset(opt.Charset) {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported charset: '%s'", opt.Charset))
+			}
+			// a charset without a collation uses the default one of it
+			if !hasCollate {
+				collation = types.CollationBin
+			}
+		case *tree.TableOptionCollate:
+			c, err := getCollationFromName(opt.Collate)
+			if err != nil {
+				return nil, err
+			}
+			collation, hasCollate = c, true
+		}
+	}
+
 	// set tableDef
-	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef)
+	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef, collation)
 	if err != nil {
 		return nil, err
 	}
@@ -64,11 +90,13 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
-		// todo confirm: option data store like this?
 		case *tree.TableOptionComment:
+			if err := checkTableComment(createTable.TableDef.Name, opt.Comment); err != nil {
+				return nil, err
+			}
 			properties := []*plan.Property{
 				{
-					Key:   "Comment",
+					Key:   CommentPropertyKey,
 					Value: opt.Comment,
 				},
 			}
@@ -79,6 +107,31 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionTTL:
+			property, err := buildTTLProperty(opt, createTable.TableDef)
+			if err != nil {
+				return nil, err
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{property},
+					},
+				},
+			})
+		case *tree.TableOptionChecksum:
+			// CHECKSUM = 1 maintains a checksum of every row
+			if opt.Value != 0 {
+				createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Properties{
+						Properties: &plan.PropertiesDef{
+							Properties: []*plan.Property{{Key: RowChecksumPropertyKey, Value: "1"}},
+						},
+					},
+				})
+			}
+		case *tree.TableOptionCharset, *tree.TableOptionCollate:
+			// already applied to the string columns
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
@@ -95,9 +148,27 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		}
 	}
 
-	// set partition(unsupport now)
+	// set partition
 	if stmt.PartitionOption != nil {
-		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("partition unsupport now; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+		if stmt.Temporary {
+			return nil, errors.New(errno.FeatureNotSupported, "cannot create temporary table with partitions")
+		}
+		partition, err := buildPartitionDef(stmt.PartitionOption, createTable.TableDef)
+		if err != nil {
+			return nil, err
+		}
+		createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: []*plan.Property{
+						{
+							Key:   PartitionPropertyKey,
+							Value: partition.Marshal(),
+						},
+					},
+				},
+			},
+		})
 	}
 
 	return &Plan{
@@ -112,7 +183,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}, nil
 }
 
-func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
+func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef, collation int32) error {
 	var primaryKeys []string
 	for _, item := range defs {
 		switch def := item.(type) {
@@ -121,6 +192,21 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 			if err != nil {
 				return err
 			}
+			if isStringType(colType) {
+				colType.Precision = collation
+			}
+			for _, attr := range def.Attributes {
+				if collateAttr, ok := attr.(*tree.AttributeCollate); ok {
+					c, err := getCollationFromName(collateAttr.Collate)
+					if err != nil {
+						return err
+					}
+					if !isStringType(colType) {
+						return errors.New(errno.DatatypeMismatch, fmt.Sprintf("COLLATE '%s' is not valid for column '%s'", collateAttr.Collate, def.Name.Parts[0]))
+					}
+					colType.Precision = c
+				}
+			}
 			defultValue, err := getDefaultExprFromColumn(def, colType)
 			if err != nil {
 				return err
@@ -131,12 +217,57 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 				Typ:     colType,
 				Default: defultValue,
 			}
+			colType.Nullable = true
 			tableDef.Cols = append(tableDef.Cols, col)
 
 			var pks []string
+			hasDefault := false
 			for _, attr := range def.Attributes {
-				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+				switch attr := attr.(type) {
+				case *tree.AttributePrimaryKey:
 					pks = append(pks, def.Name.Parts[0])
+					colType.Nullable = false
+				case *tree.AttributeNull:
+					if !attr.Is {
+						colType.Nullable = false
+					}
+				case *tree.AttributeAutoIncrement:
+					col.AutoIncr = true
+				case *tree.AttributeComment:
+					if v, ok := attr.CMT.(*tree.NumVal); ok && v.Value.Kind() == constant.String {
+						col.Comment = constant.StringVal(v.Value)
+						if err := checkColumnComment(col.Name, col.Comment); err != nil {
+							return err
+						}
+					}
+				case *tree.AttributeGeneratedAlways:
+					if !attr.Stored {
+						return errors.New(errno.FeatureNotSupported, fmt.Sprintf("virtual generated column '%s' is not supported, use STORED", col.Name))
+					}
+					col.Generated = tree.String(attr.Expr, dialect.MYSQL)
+				case *tree.AttributeDefault:
+					hasDefault = true
+					if isDefaultExpression(attr.Expr) {
+						col.DefaultExpression = tree.String(attr.Expr, dialect.MYSQL)
+					}
+				case *tree.AttributeOnUpdate:
+					col.OnUpdate = tree.String(attr.Expr, dialect.MYSQL)
+				}
+			}
+			if col.Generated != "" && (hasDefault || col.AutoIncr) {
+				return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("generated column '%s' cannot have a default value or be auto_increment", col.Name))
+			}
+			if col.DefaultExpression != "" {
+				if _, err := BuildDefaultExpr(col); err != nil {
+					return err
+				}
+			}
+			if col.OnUpdate != "" {
+				if colType.Id != plan.Type_DATETIME && colType.Id != plan.Type_TIMESTAMP {
+					return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid ON UPDATE clause for '%s' column", col.Name))
+				}
+				if _, err := BuildOnUpdateExpr(col); err != nil {
+					return err
 				}
 			}
 			if len(pks) > 0 {
@@ -200,7 +331,25 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 		}
 	}
 
+	// the expressions of the generated columns refer to the columns defined
+	// anywhere in the table
+	for _, col := range tableDef.Cols {
+		if col.Generated != "" {
+			if _, err := BuildGeneratedExpr(col, tableDef.Cols); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(primaryKeys) > 0 {
+		// the primary key columns are not null
+		for _, name := range primaryKeys {
+			for _, col := range tableDef.Cols {
+				if col.Name == name {
+					col.Typ.Nullable = false
+				}
+			}
+		}
 		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
 			Def: &plan.TableDef_DefType_Pk{
 				Pk: &plan.PrimaryKeyDef{
@@ -238,10 +387,89 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	}, nil
 }
 
+func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	tblName := string(stmt.Table.ObjectName)
+	_, tableDef := ctx.Resolve(dbName, tblName)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%s' does not exist", tblName))
+	}
+	partition, err := GetPartitionDef(tableDef)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := []*plan.Property{
+		{
+			Key:   DatabasePropertyKey,
+			Value: dbName,
+		},
+	}
+	for _, option := range stmt.Options {
+		switch opt := option.(type) {
+		case *tree.AlterOptionDropPartition:
+			if partition == nil {
+				return nil, errors.New(errno.WrongObjectType, "partition management on a not partitioned table is not possible")
+			}
+			names := make([]string, len(opt.Partitions))
+			for i, name := range opt.Partitions {
+				names[i] = string(name)
+			}
+			remain := partition.Drop(names)
+			if len(remain.Names)+len(names) != len(partition.Names) {
+				return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("error in list of partitions to drop: %s", tree.String(&opt.Partitions, dialect.MYSQL)))
+			}
+			if len(remain.Names) == 0 {
+				return nil, errors.New(errno.InvalidTableDefinition, "cannot remove all partitions, use DROP TABLE instead")
+			}
+			properties = append(properties, &plan.Property{
+				Key:   DropPartitionPropertyKey,
+				Value: strings.Join(names, ","),
+			})
+		default:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected options; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+		}
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_ALTER_TABLE,
+				Definition: &plan.DataDefinition_AlterTable{
+					AlterTable: &plan.AlterTable{
+						Table: tblName,
+						TableDef: &TableDef{
+							Name: tblName,
+							Defs: []*plan.TableDef_DefType{
+								{
+									Def: &plan.TableDef_DefType_Properties{
+										Properties: &plan.PropertiesDef{
+											Properties: properties,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
 func buildCreateDatabase(stmt *tree.CreateDatabase, ctx CompilerContext) (*Plan, error) {
+	defaults, err := getDatabaseDefaults(stmt.CreateOptions)
+	if err != nil {
+		return nil, err
+	}
 	createDb := &plan.CreateDatabase{
 		IfNotExists: stmt.IfNotExists,
 		Database:    string(stmt.Name),
+		Charset:     defaults.Charset,
+		Collation:   defaults.Collation,
 	}
 
 	return &Plan{
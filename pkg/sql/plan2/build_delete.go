@@ -38,6 +38,11 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 	if tableDef == nil {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot find delete table")
 	}
+	if partition, err := GetPartitionDef(tableDef); err != nil {
+		return nil, err
+	} else if partition != nil {
+		return nil, errors.New(errno.FeatureNotSupported, "delete from a partitioned table is not supported now")
+	}
 
 	// build the projection of select
 	var projectExprs tree.SelectExprs
@@ -176,7 +176,8 @@ func buildExpr(stmt tree.Expr, ctx CompilerContext, query *Query, node *Node, bi
 	case *tree.MaxValue:
 		return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr max'%v' is not support now", stmt))
 	case *tree.VarExpr:
-		return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr var'%v' is not support now", stmt))
+		resultExpr, err = bindVariable(ctx, astExpr)
+		isAgg = needAgg
 	case *tree.StrVal:
 		return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr str'%v' is not support now", stmt))
 	case *tree.ExprList:
@@ -215,11 +216,17 @@ func buildExpr(stmt tree.Expr, ctx CompilerContext, query *Query, node *Node, bi
 }
 
 func buildCastExpr(astExpr *tree.CastExpr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (expr *Expr, isAgg bool, err error) {
-	expr, isAgg, err = buildExpr(astExpr.Expr, ctx, query, node, binderCtx, needAgg)
+	typ, err := getTypeFromAst(astExpr.Type)
 	if err != nil {
 		return
 	}
-	typ, err := getTypeFromAst(astExpr.Type)
+	arg := astExpr.Expr
+	if isNumericType(typ) {
+		if arg, err = rewriteNumericArg(arg); err != nil {
+			return
+		}
+	}
+	expr, isAgg, err = buildExpr(arg, ctx, query, node, binderCtx, needAgg)
 	if err != nil {
 		return
 	}
@@ -499,7 +506,10 @@ func buildNumVal(val constant.Value) (*Expr, error) {
 			},
 		}, nil
 	case constant.Int:
-		intValue, _ := constant.Int64Val(val)
+		intValue, exact := constant.Int64Val(val)
+		if !exact {
+			return bigIntConst(val)
+		}
 		return &Expr{
 			Expr: &plan.Expr_C{
 				C: &Const{
@@ -378,6 +378,103 @@ func TestExpr_B(t *testing.T) {
 	})
 }
 
+func TestExpr_StringIntoNumber(t *testing.T) {
+	convey.Convey("string operands in numeric contexts succ", t, func() {
+		mock := NewMockOptimizer()
+		input := []string{"select '12abc' + 1 from dual;",
+			"select 2 * '3' from dual;",
+			"select '1' - '2' from dual;",
+			"select '10' > 9 from dual;",
+			"select 9 = '9.0' from dual;"}
+		name := []string{"+", "*", "-", ">", "="}
+		typ := []plan.Type_TypeId{plan.Type_FLOAT64, plan.Type_FLOAT64, plan.Type_FLOAT64, plan.Type_BOOL, plan.Type_BOOL}
+		for i := 0; i < len(input); i++ {
+			pl, err := runOneExprStmt(mock, t, input[i])
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			query, ok := pl.Plan.(*plan.Plan_Query)
+			if !ok {
+				t.Fatalf("%+v", errors.New("return type is not right"))
+			}
+			expr := query.Query.Nodes[1].ProjectList[0]
+			exprF, ok := expr.Expr.(*plan.Expr_F)
+			if !ok {
+				t.Fatalf("%+v", errors.New("the parse expr type is not right"))
+			}
+			convey.So(expr.Typ.Id, convey.ShouldEqual, typ[i])
+			convey.So(exprF.F.Func.ObjName, convey.ShouldEqual, name[i])
+			for _, arg := range exprF.F.Args {
+				// the strings are converted by implicit_cast, the
+				// numbers by cast
+				convey.So(arg.Typ.Id, convey.ShouldEqual, plan.Type_FLOAT64)
+				argF, ok := arg.Expr.(*plan.Expr_F)
+				convey.So(ok, convey.ShouldBeTrue)
+				if argF.F.Args[0].Typ.Id == plan.Type_VARCHAR {
+					convey.So(argF.F.Func.ObjName, convey.ShouldEqual, "implicit_cast")
+				} else {
+					convey.So(argF.F.Func.ObjName, convey.ShouldEqual, "cast")
+				}
+			}
+		}
+
+		// the strings are not converted in the comparisons of strings
+		pl, err := runOneExprStmt(mock, t, "select n_name > '1' from nation;")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expr := pl.GetQuery().Nodes[1].ProjectList[0]
+		for _, arg := range expr.Expr.(*plan.Expr_F).F.Args {
+			convey.So(arg.Typ.Id, convey.ShouldEqual, plan.Type_VARCHAR)
+		}
+	})
+}
+
+func TestExpr_SignedUnsigned(t *testing.T) {
+	convey.Convey("comparisons of signed and unsigned integers succ", t, func() {
+		mock := NewMockOptimizer()
+		input := []string{"select cast(1 as unsigned) < 2 from dual;",
+			"select 2 >= cast(1 as unsigned) from dual;",
+			"select cast(1 as unsigned) = cast(2 as unsigned) from dual;",
+			"select cast(1 as unsigned) = 1.5e0 from dual;"}
+		// bigint unsigned and bigint are compared as decimal128, which
+		// holds both of them
+		typ := []plan.Type_TypeId{plan.Type_DECIMAL128, plan.Type_DECIMAL128, plan.Type_UINT64, plan.Type_FLOAT64}
+		for i := 0; i < len(input); i++ {
+			pl, err := runOneExprStmt(mock, t, input[i])
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			expr := pl.GetQuery().Nodes[1].ProjectList[0]
+			convey.So(expr.Typ.Id, convey.ShouldEqual, plan.Type_BOOL)
+			for _, arg := range expr.Expr.(*plan.Expr_F).F.Args {
+				convey.So(arg.Typ.Id, convey.ShouldEqual, typ[i])
+			}
+		}
+	})
+}
+
+func TestExpr_IntegerDiv(t *testing.T) {
+	convey.Convey("integer div and mod succ", t, func() {
+		mock := NewMockOptimizer()
+		input := []string{"select 7 div 2 from dual;",
+			"select cast(7 as unsigned) div 2 from dual;",
+			"select cast(7 as unsigned) % 2 from dual;",
+			"select 7 % cast(2 as unsigned) from dual;",
+			"select 7.5e0 div 2 from dual;"}
+		// a non-negative constant is unsigned with an unsigned operand
+		typ := []plan.Type_TypeId{plan.Type_INT64, plan.Type_UINT64, plan.Type_UINT64, plan.Type_UINT64, plan.Type_INT64}
+		for i := 0; i < len(input); i++ {
+			pl, err := runOneExprStmt(mock, t, input[i])
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			expr := pl.GetQuery().Nodes[1].ProjectList[0]
+			convey.So(expr.Typ.Id, convey.ShouldEqual, typ[i])
+		}
+	})
+}
+
 func runOneExprStmt(opt Optimizer, t *testing.T, sql string) (*plan.Plan, error) {
 	stmts, err := mysql.Parse(sql)
 	if err != nil {
@@ -15,8 +15,8 @@
 package plan2
 
 import (
-	"go/constant"
-	"strings"
+	"fmt"
+	"math"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -75,7 +75,7 @@ func getFunctionExprByNameAndPlanExprs(name string, distinct bool, exprs []*Expr
 		if err := convertValueIntoBool(name, exprs, true); err != nil {
 			return nil, false, err
 		}
-	case "=", "<", "<=", ">", ">=", "<>":
+	case "=", "<", "<=", ">", ">=", "<>", "<=>":
 		if err := convertValueIntoBool(name, exprs, false); err != nil {
 			return nil, false, err
 		}
@@ -150,9 +150,10 @@ func getFunctionExprByNameAndAstExprs(name string, distinct bool, astExprs []tre
 	// deal with special function [rewrite some ast function expr]
 	switch name {
 	case "extract":
-		// rewrite args[0]
-		kindExpr := astExprs[0].(*tree.UnresolvedName)
-		astExprs[0] = tree.NewNumVal(constant.MakeString(kindExpr.Parts[0]), kindExpr.Parts[0], false)
+		if name, astExprs, err = rewriteExtract(astExprs); err != nil {
+			return
+		}
+		args = make([]*Expr, len(astExprs))
 	case "count":
 		// count(*) : astExprs[0].(type) is *tree.NumVal
 		// count(col_name) : astExprs[0].(type) is *tree.UnresolvedName
@@ -176,10 +177,34 @@ func getFunctionExprByNameAndAstExprs(name string, distinct bool, astExprs []tre
 
 	isAgg = true
 
-	// get args
+	// get args, the binary literals are built at last when it is known
+	// whether they are numbers
 	var expr *Expr
 	var paramIsAgg bool
+	numeric := numericOperators[name]
 	for idx, astExpr := range astExprs {
+		if _, ok := getBinaryLiteral(astExpr); ok {
+			continue
+		}
+		expr, paramIsAgg, err = buildExpr(astExpr, ctx, query, node, binderCtx, needAgg)
+		if err != nil {
+			return
+		}
+		isAgg = isAgg && paramIsAgg
+		args[idx] = expr
+		if comparisonOperators[name] && isNumericType(expr.Typ) {
+			numeric = true
+		}
+	}
+	for idx, astExpr := range astExprs {
+		if args[idx] != nil {
+			continue
+		}
+		if numeric {
+			if astExpr, err = rewriteNumericArg(astExpr); err != nil {
+				return
+			}
+		}
 		expr, paramIsAgg, err = buildExpr(astExpr, ctx, query, node, binderCtx, needAgg)
 		if err != nil {
 			return
@@ -261,15 +286,7 @@ func getFunctionObjRef(funcId int64, name string) *ObjectRef {
 }
 
 func resetIntervalFunctionExprs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error) {
-	strExpr := intervalExpr.Expr.(*plan.Expr_F).F.Args[0].Expr
-	intervalStr := strExpr.(*plan.Expr_C).C.Value.(*plan.Const_Sval).Sval
-	intervalArray := strings.Split(intervalStr, " ")
-
-	intervalType, err := types.IntervalTypeOf(intervalArray[1])
-	if err != nil {
-		return nil, err
-	}
-	returnNum, returnType, err := types.NormalizeInterval(intervalArray[0], intervalType)
+	returnNum, returnType, err := getIntervalValue(intervalExpr)
 	if err != nil {
 		return nil, err
 	}
@@ -360,3 +377,245 @@ func convertValueIntoBool(name string, args []*Expr, isLogic bool) error {
 	}
 	return nil
 }
+
+// convertStringIntoTime casts the string side of a comparison with a time,
+// a date or a datetime to its type, so a column compares with a literal like
+// '2022-01-01'. The cast keeps all the fractional seconds of the string,
+// which would be rounded away by the implicit conversion to a time of
+// precision 0.
+func convertStringIntoTime(args []*Expr) error {
+	if len(args) != 2 {
+		return nil
+	}
+	for i := range args {
+		typ := &plan.Type{Id: args[i].Typ.Id, Size: 8, Precision: 6}
+		switch typ.Id {
+		case plan.Type_DATE:
+			typ.Size, typ.Precision = 4, 0
+		case plan.Type_TIME, plan.Type_DATETIME:
+		default:
+			continue
+		}
+		other := args[1-i]
+		if other.Typ.Id != plan.Type_CHAR && other.Typ.Id != plan.Type_VARCHAR {
+			continue
+		}
+		expr, err := appendCastBeforeExpr(other, typ)
+		if err != nil {
+			return err
+		}
+		args[1-i] = expr
+		return nil
+	}
+	return nil
+}
+
+// convertBitIntoUnsigned casts the bit operands of an arithmetic operator,
+// and the bit side of a comparison with another type, to bigint unsigned, as
+// a bit is the number of its bits in a numeric context. Two bits compare as
+// they are. A non-negative integer constant in arithmetic with a bit is
+// taken as unsigned too, so b + 0 is the unsigned number of b.
+func convertBitIntoUnsigned(name string, args []*Expr) error {
+	isComparison := comparisonOperators[name]
+	converted := false
+	for i := range args {
+		if args[i].Typ.Id != plan.Type_BIT {
+			continue
+		}
+		if isComparison && len(args) == 2 && args[1-i].Typ.Id == plan.Type_BIT {
+			continue
+		}
+		expr, err := appendCastBeforeExpr(args[i], &plan.Type{Id: plan.Type_UINT64, Size: 8})
+		if err != nil {
+			return err
+		}
+		args[i] = expr
+		converted = true
+	}
+	if converted && !isComparison {
+		return convertConstIntoUnsigned(args)
+	}
+	return nil
+}
+
+// convertStringIntoNumber casts the string operands of an arithmetic
+// operator, and the string side of a comparison with a number, to float64
+// as mysql does, so '12' + 1 is 13 and '10' > 9 is true. A string which is
+// not a number is converted by its numeric prefix.
+func convertStringIntoNumber(name string, args []*Expr) error {
+	if len(args) != 2 {
+		return nil
+	}
+	isArith := false
+	switch name {
+	case "+", "-", "*", "/", "div", "%":
+		isArith = true
+	}
+	for i := range args {
+		if !isStringType(args[i].Typ) {
+			continue
+		}
+		other := args[1-i].Typ
+		if !isNumericType(other) && !(isArith && (isStringType(other) || other.Id == plan.Type_ANY)) {
+			continue
+		}
+		expr, err := appendImplicitCastBeforeExpr(args[i])
+		if err != nil {
+			return err
+		}
+		args[i] = expr
+	}
+	return nil
+}
+
+// convertSignedUnsignedIntoDecimal casts the operands of a comparison of a
+// bigint unsigned with a signed integer to decimal128, which holds both of
+// them exactly. The other integers compare as a wider signed integer by the
+// level-up rules of the functions.
+func convertSignedUnsignedIntoDecimal(args []*Expr) error {
+	if len(args) != 2 {
+		return nil
+	}
+	for i := range args {
+		if args[i].Typ.Id != plan.Type_UINT64 || !isSignedIntegerType(args[1-i].Typ) {
+			continue
+		}
+		for j := range args {
+			expr, err := appendCastBeforeExpr(args[j], &plan.Type{Id: plan.Type_DECIMAL128, Size: 16, Width: 38})
+			if err != nil {
+				return err
+			}
+			args[j] = expr
+		}
+		return nil
+	}
+	return nil
+}
+
+// convertConstIntoUnsigned casts a non-negative integer constant operand of
+// DIV or % to bigint unsigned if the other operand is an unsigned integer, so
+// that they are computed on integers instead of float64 which is not exact
+// above 2^53.
+func convertConstIntoUnsigned(args []*Expr) error {
+	if len(args) != 2 {
+		return nil
+	}
+	for i := range args {
+		if !isUnsignedIntegerType(args[1-i].Typ) || args[i].Typ.Id != plan.Type_INT64 {
+			continue
+		}
+		c, ok := args[i].Expr.(*plan.Expr_C)
+		if !ok || c.C.Isnull || c.C.GetIval() < 0 {
+			continue
+		}
+		expr, err := appendCastBeforeExpr(args[i], &plan.Type{Id: plan.Type_UINT64, Size: 8})
+		if err != nil {
+			return err
+		}
+		args[i] = expr
+	}
+	return nil
+}
+
+func isUnsignedIntegerType(typ *plan.Type) bool {
+	switch typ.Id {
+	case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+		return true
+	}
+	return false
+}
+
+func isSignedIntegerType(typ *plan.Type) bool {
+	switch typ.Id {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64:
+		return true
+	}
+	return false
+}
+
+func isTemporalType(typ *plan.Type) bool {
+	switch typ.Id {
+	case plan.Type_DATE, plan.Type_DATETIME, plan.Type_TIMESTAMP:
+		return true
+	}
+	return false
+}
+
+// convertTemporalIntoDatetime casts the operands of a subtraction of
+// dates, datetimes and timestamps to datetime if they are not of the same
+// type, so the difference of any two of them is a duration. A number cannot
+// be subtracted from them, date_sub does it.
+func convertTemporalIntoDatetime(args []*Expr) error {
+	if len(args) != 2 {
+		return nil
+	}
+	if isTemporalType(args[0].Typ) && isNumericType(args[1].Typ) {
+		return errors.New(errno.DatatypeMismatch, fmt.Sprintf("cannot subtract a number from a %s, use date_sub() instead", types.T(args[0].Typ.Id)))
+	}
+	if !isTemporalType(args[0].Typ) || !isTemporalType(args[1].Typ) {
+		return nil
+	}
+	if args[0].Typ.Id == args[1].Typ.Id && args[0].Typ.Id != plan.Type_DATE {
+		return nil
+	}
+	for i := range args {
+		if args[i].Typ.Id == plan.Type_DATETIME {
+			continue
+		}
+		expr, err := appendCastBeforeExpr(args[i], &plan.Type{
+			Id:        plan.Type_DATETIME,
+			Size:      8,
+			Precision: args[i].Typ.Precision,
+		})
+		if err != nil {
+			return err
+		}
+		args[i] = expr
+	}
+	return nil
+}
+
+// convertIntervalIntoTime replaces the interval literal of a comparison with
+// a time by the time of the same duration, such as "dt1 - dt2 > interval 1 hour"
+func convertIntervalIntoTime(args []*Expr) error {
+	if len(args) != 2 {
+		return nil
+	}
+	for i := range args {
+		if args[i].Typ.Id != plan.Type_TIME || args[1-i].Typ.Id != plan.Type_INTERVAL {
+			continue
+		}
+		num, unit, err := getIntervalValue(args[1-i])
+		if err != nil {
+			return err
+		}
+		t, ok := types.Time(0).AddInterval(num, unit)
+		if !ok {
+			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("interval %d %s cannot be compared with a time", num, unit))
+		}
+		expr, err := appendCastBeforeExpr(&Expr{
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Value: &plan.Const_Sval{
+						Sval: t.String2(6),
+					},
+				},
+			},
+			Typ: &plan.Type{
+				Id:    plan.Type_VARCHAR,
+				Size:  4,
+				Width: math.MaxInt32,
+			},
+		}, &plan.Type{
+			Id:        plan.Type_TIME,
+			Size:      8,
+			Precision: 6,
+		})
+		if err != nil {
+			return err
+		}
+		args[1-i] = expr
+		return nil
+	}
+	return nil
+}
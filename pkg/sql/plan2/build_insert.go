@@ -25,6 +25,12 @@ import (
 )
 
 func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
+	if stmt.Ignore {
+		// the rows of INSERT IGNORE are filtered by the frontend
+		if _, ok := stmt.Rows.Select.(*tree.ValuesClause); !ok {
+			return nil, errors.New(errno.FeatureNotSupported, "INSERT IGNORE is only supported with VALUES")
+		}
+	}
 	query, _ := newQueryAndSelectCtx(plan.Query_INSERT)
 
 	// get table
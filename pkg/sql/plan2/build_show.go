@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 const MO_CATALOG_DB_NAME = "mo_catalog"
@@ -98,7 +99,8 @@ func buildShowTables(stmt *tree.ShowTables, ctx CompilerContext) (*Plan, error)
 	}
 
 	ddlType := plan.DataDefinition_SHOW_TABLES
-	sql := fmt.Sprintf("SELECT relname as Tables_in_%s FROM %s.mo_tables WHERE reldatabase = '%s'", dbName, MO_CATALOG_DB_NAME, dbName)
+	// the relations storing temporary tables are not listed
+	sql := fmt.Sprintf("SELECT relname as Tables_in_%s FROM %s.mo_tables WHERE reldatabase = '%s' AND relname NOT LIKE '%s%%'", dbName, MO_CATALOG_DB_NAME, dbName, engine.TemporaryTablePrefix)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSql(ctx, sql, stmt.Where, ddlType)
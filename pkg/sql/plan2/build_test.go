@@ -21,11 +21,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
 )
 
-//only use in developing
+// only use in developing
 func TestSingleSql(t *testing.T) {
 	// sql := `SELECT * FROM (SELECT relname as Tables_in_mo FROM mo_tables WHERE reldatabase = 'mo') a`
 	// sql := "SELECT nation2.* FROM nation2 natural join region"
@@ -377,7 +379,7 @@ func TestSingleSql(t *testing.T) {
 // 	}
 // }
 
-//test single table plan building
+// test single table plan building
 func TestSingleTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
 
@@ -404,8 +406,9 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY >= N_NATIONKEY or (N_NAME like '%ddd' and N_REGIONKEY >0.5)",                                                    //test more expr
 		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY between 2 and 2 OR N_NATIONKEY not between 3 and 10",                                                            //test more expr
 		// "SELECT N_REGIONKEY FROM NATION where N_REGIONKEY is null and N_NAME is not null",
-		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY IN (1, 2)",  //test more expr
-		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY NOT IN (1)", //test more expr
+		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY IN (1, 2)",                                 //test more expr
+		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY NOT IN (1)",                                //test more expr
+		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY <=> 1 or N_NAME <=> null or null <=> null", //test null safe equal
 
 		"SELECT -1",
 		"select date_add('1997-12-31 23:59:59',INTERVAL 100000 SECOND)",
@@ -428,7 +431,175 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
-//test jion table plan building
+func TestExtract(t *testing.T) {
+	mock := NewMockOptimizer()
+	for sql, name := range map[string]string{
+		"select extract(year from l_shipdate) from lineitem":                         "year",
+		"select extract(MONTH from l_shipdate) from lineitem":                        "month",
+		"select extract(day from date '2001-01-01')":                                 "day",
+		"select extract(minute from '1997-12-31 23:59:59')":                          "minute",
+		"select extract(second from '1997-12-31 23:59:59')":                          "second",
+		"select extract(microsecond from cast('1997-12-31 23:59:59.5' as datetime))": "microsecond",
+		"select hour('1997-12-31 23:59:59')":                                         "hour",
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		query := logicPlan.GetQuery()
+		expr := query.Nodes[query.Steps[0]].ProjectList[0]
+		if got := expr.GetF().GetFunc().GetObjName(); got != name {
+			t.Fatalf("function of %v want %v but got %v", sql, name, got)
+		}
+	}
+
+	runTestShouldError(mock, t, []string{
+		"select extract(quarter from l_shipdate) from lineitem",
+		"select extract(year_month from l_shipdate) from lineitem",
+		"select extract(hour from l_shipdate) from lineitem",
+	})
+}
+
+func TestDateInterval(t *testing.T) {
+	mock := NewMockOptimizer()
+	// the number of a constant interval, 0 for that of every row
+	for sql, want := range map[string][2]int64{
+		"select date_sub('2022-01-01 10:00:00', interval '1:30' minute_second)": {90, int64(types.Second)},
+		"select date_add('2022-01-01 10:00:00', INTERVAL '-1 2' DAY_HOUR)":      {-26, int64(types.Hour)},
+		"select l_shipdate - interval '1-2' year_month from lineitem":           {14, int64(types.Month)},
+		"select l_shipdate + interval '1 day' from lineitem":                    {1, int64(types.Day)},
+		"select l_shipdate + interval l_linenumber day from lineitem":           {0, int64(types.Day)},
+		"select date_sub(l_shipdate, interval l_quantity minute) from lineitem": {0, int64(types.Minute)},
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		query := logicPlan.GetQuery()
+		args := query.Nodes[query.Steps[0]].ProjectList[0].GetF().GetArgs()
+		if want[0] != 0 && args[1].GetC().GetIval() != want[0] {
+			t.Fatalf("the interval of %v want %v but got %v", sql, want[0], args[1])
+		}
+		if want[0] == 0 && args[1].GetC() != nil {
+			t.Fatalf("the interval of %v want a column but got %v", sql, args[1])
+		}
+		if args[2].GetC().GetIval() != want[1] {
+			t.Fatalf("the unit of %v want %v but got %v", sql, want[1], args[2])
+		}
+		// a date with a unit shorter than a day is cast to a datetime
+		if want[1] < int64(types.Day) && args[0].Typ.Id == plan.Type_DATE {
+			t.Fatalf("the date of %v want a datetime but got a date", sql)
+		}
+	}
+
+	runTestShouldError(mock, t, []string{
+		"select l_shipdate + interval l_linenumber day_hour from lineitem",
+		"select date_add('2022-01-01', interval '1:30:30' minute_second)",
+	})
+}
+
+func TestTrim(t *testing.T) {
+	mock := NewMockOptimizer()
+	for sql, mode := range map[string]int64{
+		"select trim(N_NAME) from nation":                    trim.Both,
+		"select trim('x' from N_NAME) from nation":           trim.Both,
+		"select trim(LEADING from N_NAME) from nation":       trim.Leading,
+		"select trim(trailing 'ab' from N_NAME) from nation": trim.Trailing,
+		"select trim(both '中' from '中文中')":                   trim.Both,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		query := logicPlan.GetQuery()
+		f := query.Nodes[query.Steps[0]].ProjectList[0].GetF()
+		if got := f.GetFunc().GetObjName(); got != "trim" {
+			t.Fatalf("function of %v want trim but got %v", sql, got)
+		}
+		if got := f.Args[2].GetC().GetIval(); len(f.Args) != 3 || got != mode {
+			t.Fatalf("mode of %v want %v but got %v", sql, mode, got)
+		}
+	}
+}
+
+func TestBinaryLiteral(t *testing.T) {
+	mock := NewMockOptimizer()
+	for sql, typ := range map[string]plan.Type_TypeId{
+		"select 0x41":                                 plan.Type_VARCHAR,
+		"select x'41'":                                plan.Type_VARCHAR,
+		"select 0x010000000000000000":                 plan.Type_VARCHAR,
+		"select b'1000001'":                           plan.Type_VARCHAR,
+		"select 0x41 + 1":                             plan.Type_INT64,
+		"select -b'1000001'":                          plan.Type_INT64,
+		"select (0x41) * 2":                           plan.Type_INT64,
+		"select cast(0x41 as unsigned)":               plan.Type_UINT64,
+		"select cast(0xffffffffffffffff as unsigned)": plan.Type_UINT64,
+		"select cast(0x41 as char)":                   plan.Type_CHAR,
+		"select 0x41 = 'A'":                           plan.Type_BOOL,
+		"select N_NAME = 0x41 from NATION":            plan.Type_BOOL,
+		"select N_NATIONKEY < 0x41 from NATION":       plan.Type_BOOL,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		query := logicPlan.GetQuery()
+		if got := query.Nodes[query.Steps[0]].ProjectList[0].Typ.Id; got != typ {
+			t.Fatalf("type of %v want %v but got %v", sql, typ, got)
+		}
+	}
+
+	// the literal is of the type of the other side of the comparison
+	for sql, typ := range map[string]plan.Type_TypeId{
+		"select N_NAME = 0x41 from NATION":      plan.Type_VARCHAR,
+		"select N_NATIONKEY = 0x41 from NATION": plan.Type_INT64,
+		"select 0x41 = N_NATIONKEY from NATION": plan.Type_INT64,
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		query := logicPlan.GetQuery()
+		for _, arg := range query.Nodes[query.Steps[0]].ProjectList[0].GetF().Args {
+			if c := arg.GetC(); c != nil && arg.Typ.Id != typ {
+				t.Fatalf("type of the literal of %v want %v but got %v", sql, typ, arg.Typ.Id)
+			}
+		}
+	}
+
+	runTestShouldPass(mock, t, []string{
+		"UPDATE NATION SET N_REGIONKEY = 0x10, N_NAME = x'4142'",
+		"UPDATE NATION SET N_REGIONKEY = 2 WHERE N_NATIONKEY > b'11'",
+	}, false, false)
+
+	// more than 64 bits in a numeric context
+	runTestShouldError(mock, t, []string{
+		"select 0x010000000000000000 + 1",
+		"select cast(x'010000000000000000' as signed)",
+		"select N_NATIONKEY from NATION where N_NATIONKEY = 0x010000000000000000",
+		"UPDATE NATION SET N_REGIONKEY = 0x010000000000000000",
+	})
+}
+
+func TestNullSafeEqualJoinCondition(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "SELECT N_NAME FROM NATION join REGION on NATION.N_REGIONKEY <=> REGION.R_REGIONKEY")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType != plan.Node_JOIN {
+			continue
+		}
+		if len(node.OnList) != 1 || node.OnList[0].GetF().Func.ObjName != "<=>" {
+			t.Fatalf("<=> should be kept as the equi-join condition, got %v", node.OnList)
+		}
+		return
+	}
+	t.Fatalf("no join node found")
+}
+
+// test jion table plan building
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
 
@@ -447,6 +618,14 @@ func TestJoinTableSqlBuilder(t *testing.T) {
 		"SELECT * FROM NATION a join REGION b on a.N_REGIONKEY = b.R_REGIONKEY WHERE a.N_REGIONKEY > 0",
 		"SELECT N_NAME, R_REGIONKEY FROM NATION2 join REGION using(R_REGIONKEY)",
 		"select nation.n_name from nation join nation2 on nation.n_name !='a' join region on nation.n_regionkey = region.r_regionkey",
+		"SELECT N_NAME, R_NAME FROM NATION2 left join REGION using(R_REGIONKEY) WHERE R_REGIONKEY > 0",                                             //left join with using
+		"SELECT R_REGIONKEY, NATION2.R_REGIONKEY, REGION.R_REGIONKEY FROM NATION2 join REGION using(R_REGIONKEY)",                                  //qualified reference to merged column
+		"SELECT N_NAME, R_REGIONKEY FROM NATION2 right join REGION using(R_REGIONKEY)",                                                             //right join with using
+		"SELECT N_NAME, R_NAME FROM NATION NATURAL JOIN NATION2 NATURAL JOIN REGION",                                                               //natural join with many common columns
+		"SELECT N_NAME, R_NAME FROM NATION2 NATURAL LEFT JOIN REGION WHERE R_REGIONKEY > 0",                                                        //natural left join
+		"SELECT R_REGIONKEY FROM NATION2 a join REGION b using(R_REGIONKEY) join NATION2 c using(R_REGIONKEY) WHERE a.R_REGIONKEY = c.R_REGIONKEY", //nested using
+		"SELECT N_NAME FROM NATION join REGION on NATION.N_REGIONKEY <=> REGION.R_REGIONKEY",                                                       //null safe equi-join
+		"SELECT N_NAME, R_NAME FROM NATION left join REGION on NATION.N_REGIONKEY <=> REGION.R_REGIONKEY and NATION.N_NAME = REGION.R_NAME",        //null safe left join
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -454,13 +633,15 @@ func TestJoinTableSqlBuilder(t *testing.T) {
 	sqls = []string{
 		"SELECT N_NAME,N_REGIONKEY FROM NATION join REGION on NATION.N_REGIONKEY = REGION.NotExistColumn",                    //column not exist
 		"SELECT N_NAME, R_REGIONKEY FROM NATION join REGION using(R_REGIONKEY)",                                              //column not exist
+		"SELECT N_NAME FROM NATION2 join REGION using(R_REGIONKEY, R_REGIONKEY)",                                             //duplicate using column
+		"SELECT N_NAME FROM NATION2 join REGION on NATION2.R_REGIONKEY = REGION.R_REGIONKEY WHERE R_REGIONKEY > 0",           //ambiguous column without using
 		"SELECT N_NAME,N_REGIONKEY FROM NATION a join REGION b on a.N_REGIONKEY = b.R_REGIONKEY WHERE aaaaa.N_REGIONKEY > 0", //table alias not exist
 		"select *", //No table used
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
-//test derived table plan building
+// test derived table plan building
 func TestDerivedTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -517,6 +698,7 @@ func TestUpdate(t *testing.T) {
 		"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=2",
 		"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=2 WHERE N_NATIONKEY > 10 LIMIT 20",
 		"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=N_REGIONKEY+2 WHERE N_NATIONKEY > 10 LIMIT 20",
+		"UPDATE NATION SET N_REGIONKEY=2 ORDER BY N_NATIONKEY DESC LIMIT 10",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -524,6 +706,7 @@ func TestUpdate(t *testing.T) {
 	sqls = []string{
 		"UPDATE NATION SET N_NAME2 ='U1', N_REGIONKEY=2",    // column not exist
 		"UPDATE NATION2222 SET N_NAME ='U1', N_REGIONKEY=2", // table not exist
+		"UPDATE NATION SET N_NAME ='U1', N_NAME='U2'",       // column set twice
 		// "UPDATE NATION SET N_NAME = 2, N_REGIONKEY=2",       // column type not match
 		// "UPDATE NATION SET N_NAME = 'U1', N_REGIONKEY=2.2",  // column type not match
 	}
@@ -554,6 +737,9 @@ func TestSubQuery(t *testing.T) {
 	sqls := []string{
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION)",                                 // unrelated
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY)", // related
+		"SELECT * FROM NATION where N_REGIONKEY not in (select R_REGIONKEY from REGION) and N_NATIONKEY > 1",             // not in
+		"SELECT * FROM NATION where (N_REGIONKEY, N_NAME) not in (select R_REGIONKEY, R_NAME from REGION)",               // multi-column not in
+		"SELECT * FROM NATION where N_REGIONKEY not in (select R_REGIONKEY from REGION where R_REGIONKEY < N_REGIONKEY)", // related not in
 		//"DELETE FROM NATION WHERE N_NATIONKEY > 10",
 		`select
 		sum(l_extendedprice) / 7.0 as avg_yearly
@@ -583,6 +769,44 @@ func TestSubQuery(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestNotInSubQuery(t *testing.T) {
+	mock := NewMockOptimizer()
+	countAntiJoins := func(sql string) (nullAware int, regular int) {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		nodes := logicPlan.GetQuery().Nodes
+		for _, node := range nodes {
+			if node.NodeType != plan.Node_JOIN || nodes[node.Children[0]].JoinType != plan.Node_ANTI {
+				continue
+			}
+			if len(node.ProjectList) != len(nodes[node.Children[0]].ProjectList) {
+				t.Fatalf("anti join should only output its left side, sql=%v", sql)
+			}
+			if node.NullAware {
+				nullAware++
+			} else {
+				regular++
+			}
+		}
+		return
+	}
+
+	if nullAware, _ := countAntiJoins("SELECT N_NAME FROM NATION where N_REGIONKEY not in (select R_REGIONKEY from REGION) and N_NATIONKEY > 1"); nullAware != 1 {
+		t.Fatalf("not in should be a null-aware anti join")
+	}
+	if nullAware, _ := countAntiJoins("SELECT N_NAME FROM NATION where (N_REGIONKEY, N_NAME) not in (select R_REGIONKEY, R_NAME from REGION)"); nullAware != 1 {
+		t.Fatalf("multi-column not in should be a null-aware anti join")
+	}
+	if nullAware, _ := countAntiJoins("SELECT N_NAME FROM NATION where N_REGIONKEY not in (select R_REGIONKEY from REGION where R_REGIONKEY < N_REGIONKEY)"); nullAware != 0 {
+		t.Fatalf("correlated not in should not be a null-aware anti join")
+	}
+	if nullAware, _ := countAntiJoins("SELECT N_NAME FROM NATION where not exists (select R_REGIONKEY from REGION)"); nullAware != 0 {
+		t.Fatalf("not exists should not be a null-aware anti join")
+	}
+}
+
 func TestTcl(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -720,6 +944,10 @@ func TestResultColumns(t *testing.T) {
 		"show databases":            "Database",
 		"show tables":               "Tables_in_tpch",
 		"show columns from nation":  "Field,Type,Null,Key,Default,Comment",
+		"select * from nation2 join region using(r_regionkey)":        "r_regionkey,n_nationkey,n_name,n_comment,r_name,r_comment",
+		"select * from nation2 natural left join region":              "r_regionkey,n_nationkey,n_name,n_comment,r_name,r_comment",
+		"select * from nation natural join nation2":                   "n_nationkey,n_name,n_comment,n_regionkey,r_regionkey",
+		"select region.*, nation2.* from nation2 natural join region": "r_regionkey,r_name,r_comment,n_nationkey,n_name,r_regionkey,n_comment",
 	}
 	for sql, colsStr := range returnColumnsSql {
 		cols := strings.Split(colsStr, ",")
@@ -23,72 +23,56 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
+// buildUpdate plans the update as a query selecting the key of the rows to
+// update and their new values, with the order and the limit of the update.
+// The rows are updated by deleting them with the key and appending the new
+// values, the output of the query is
+//
+//	key, the value of the first column, ..., the value of the last column
+//
+// The stored generated columns are recomputed from the new values, the
+// columns with ON UPDATE expressions which are not assigned take the values
+// of the expressions.
 func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
-	query, _ := newQueryAndSelectCtx(plan.Query_UPDATE)
-
-	// build select
-	selectStmt := &tree.Select{
-		Select: &tree.SelectClause{
-			Exprs: tree.SelectExprs{
-				tree.SelectExpr{
-					Expr: tree.UnqualifiedStar{},
-				},
-			},
-			From:  &tree.From{Tables: tree.TableExprs{stmt.Table}},
-			Where: stmt.Where,
-		},
-		OrderBy: stmt.OrderBy,
-		Limit:   stmt.Limit,
+	// check database's name and table's name
+	aliasTbl, ok := stmt.Table.(*tree.AliasedTableExpr)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot update multiple tables")
 	}
-	binderCtx := &BinderContext{
-		columnAlias: make(map[string]*Expr),
+	tbl, ok := aliasTbl.Expr.(*tree.TableName)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot update multiple tables")
 	}
-	nodeId, err := buildSelect(selectStmt, ctx, query, binderCtx)
-	if err != nil {
-		return nil, err
+	dbName := string(tbl.SchemaName)
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
 	}
-
-	query.Steps = append(query.Steps, nodeId)
-
-	// get table def
-	objRef, tableDef := getLastTableDef(query)
+	objRef, tableDef := ctx.Resolve(dbName, string(tbl.ObjectName))
 	if tableDef == nil {
 		return nil, errors.New(errno.CaseNotFound, "can not find table in sql")
 	}
-
-	getColumnName := func(name string) *Expr {
-		for idx, col := range tableDef.Cols {
-			if col.Name == name {
-				return &Expr{
-					TableName: tableDef.Name,
-					ColName:   col.Name,
-					Expr: &plan.Expr_Col{
-						Col: &ColRef{
-							RelPos: 0,
-							ColPos: int32(idx),
-						},
-					},
-					Typ: col.Typ,
-				}
-			}
-		}
-		return nil
+	if partition, err := GetPartitionDef(tableDef); err != nil {
+		return nil, err
+	} else if partition != nil {
+		return nil, errors.New(errno.FeatureNotSupported, "update a partitioned table is not supported now")
 	}
 
-	columnLength := len(stmt.Exprs)
-	if columnLength == 0 {
-		return nil, errors.New(errno.CaseNotFound, "no column will be update")
+	// the rows are deleted by the hide key, or by the primary key if the
+	// table has no hide key
+	var useKey *ColDef
+	hideKey := ctx.GetHideKeyDef(objRef.SchemaName, tableDef.Name)
+	if hideKey != nil {
+		useKey = hideKey
+	} else if priKeys := ctx.GetPrimaryKeyDef(objRef.SchemaName, tableDef.Name); len(priKeys) == 1 {
+		useKey = priKeys[0]
+	} else {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot find hide key now")
 	}
 
-	node := &Node{
-		NodeType: plan.Node_UPDATE,
-		ObjRef:   objRef,
-		TableDef: tableDef,
-		Children: []int32{nodeId},
+	if len(stmt.Exprs) == 0 {
+		return nil, errors.New(errno.CaseNotFound, "no column will be update")
 	}
-
-	columns := make([]*Expr, 0, columnLength)
-	values := make([]*Expr, 0, columnLength)
+	assignments := make(map[string]tree.Expr, len(stmt.Exprs))
 	for _, expr := range stmt.Exprs {
 		if len(expr.Names) != 1 {
 			return nil, errors.New(errno.CaseNotFound, "the set list of update must be one")
@@ -96,36 +80,123 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 		if expr.Names[0].NumParts != 1 {
 			return nil, errors.New(errno.CaseNotFound, "the set list of update must be one")
 		}
-
-		column := getColumnName(expr.Names[0].Parts[0])
-		if column == nil {
-			return nil, errors.New(errno.CaseNotFound, fmt.Sprintf("set column name [%v] is not found", expr.Names[0].Parts[0]))
+		name := expr.Names[0].Parts[0]
+		if (hideKey != nil && name == hideKey.Name) || getColDef(tableDef, name) == nil {
+			return nil, errors.New(errno.CaseNotFound, fmt.Sprintf("set column name [%v] is not found", name))
 		}
-
-		value, _, err := buildExpr(expr.Expr, ctx, query, node, binderCtx, false)
-		if err != nil {
-			return nil, err
+		if _, ok := assignments[name]; ok {
+			return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("column '%v' specified twice", name))
+		}
+		if getColDef(tableDef, name).Generated != "" {
+			return nil, errors.New(errno.GeneratedAlways, fmt.Sprintf("the value specified for generated column '%s' in table '%s' is not allowed", name, tableDef.Name))
 		}
+		assignments[name] = expr.Expr
+	}
 
-		// cast value type
-		if column.Typ.Id != value.Typ.Id {
-			tmp, err := appendCastExpr(value, column.Typ)
-			if err != nil {
+	// build the stmt of select and append select node
+	key, _ := tree.NewUnresolvedName(useKey.Name)
+	projectExprs := tree.SelectExprs{tree.SelectExpr{Expr: key}}
+	var cols []*ColDef
+	for _, col := range tableDef.Cols {
+		if hideKey != nil && col.Name == hideKey.Name {
+			continue
+		}
+		e, ok := assignments[col.Name]
+		if !ok {
+			e, _ = tree.NewUnresolvedName(col.Name)
+		} else if isNumericType(col.Typ) {
+			var err error
+			if e, err = rewriteNumericArg(e); err != nil {
 				return nil, err
 			}
-			value = tmp
 		}
+		projectExprs = append(projectExprs, tree.SelectExpr{Expr: e})
+		cols = append(cols, col)
+	}
+	selectStmt := &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: projectExprs,
+			From:  &tree.From{Tables: tree.TableExprs{stmt.Table}},
+			Where: stmt.Where,
+		},
+		OrderBy: stmt.OrderBy,
+		Limit:   stmt.Limit,
+	}
+	query, binderCtx := newQueryAndSelectCtx(plan.Query_UPDATE)
+	nodeId, err := buildSelect(selectStmt, ctx, query, binderCtx)
+	if err != nil {
+		return nil, err
+	}
+	query.Steps = append(query.Steps, nodeId)
+	projectList := query.Nodes[nodeId].ProjectList
+	if len(projectList) != len(projectExprs) {
+		return nil, errors.New(errno.InternalError, "the number of the columns to update is not matched")
+	}
 
-		columns = append(columns, column)
+	// build update node, the values are casted to the types of the columns
+	columns := make([]*Expr, 0, len(cols))
+	values := make([]*Expr, 0, len(cols))
+	for i, col := range cols {
+		columns = append(columns, &Expr{
+			TableName: tableDef.Name,
+			ColName:   col.Name,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+				},
+			},
+			Typ: col.Typ,
+		})
+		value := &Expr{
+			ColName: projectList[i+1].ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(i + 1),
+				},
+			},
+			Typ: projectList[i+1].Typ,
+		}
+		if col.Typ.Id != value.Typ.Id {
+			if value, err = appendCastExpr(value, col.Typ); err != nil {
+				return nil, err
+			}
+		}
 		values = append(values, value)
 	}
-
-	node.UpdateList = &plan.UpdateList{
-		Columns: columns,
-		Values:  values,
+	// the columns not assigned take the values of their ON UPDATE expressions
+	for i, col := range cols {
+		if _, ok := assignments[col.Name]; !ok && col.OnUpdate != "" {
+			if values[i], err = BuildOnUpdateExpr(col); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// the generated columns are computed from the new values of the row
+	for i, col := range cols {
+		if col.Generated != "" {
+			expr, err := BuildGeneratedExpr(col, cols)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = substituteColRefs(expr, values)
+		}
+	}
+	node := &Node{
+		NodeType:     plan.Node_UPDATE,
+		ObjRef:       objRef,
+		TableDef:     tableDef,
+		UseDeleteKey: useKey.Name,
+		Children:     []int32{nodeId},
+		UpdateList: &plan.UpdateList{
+			Columns: columns,
+			Values:  values,
+		},
 	}
 	appendQueryNode(query, node)
 
+	// reset root node
 	preNode := query.Nodes[len(query.Nodes)-1]
 	query.Steps[len(query.Steps)-1] = preNode.NodeId
 
@@ -135,3 +206,12 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 		},
 	}, nil
 }
+
+func getColDef(tableDef *TableDef, name string) *ColDef {
+	for _, col := range tableDef.Cols {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -512,9 +513,24 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 		case defines.MYSQL_TYPE_DATE:
 			return &plan.Type{Id: plan.Type_DATE, Size: 4}, nil
 		case defines.MYSQL_TYPE_DATETIME:
-			return &plan.Type{Id: plan.Type_DATETIME, Size: 8}, nil
+			// the fractional seconds precision of DATETIME(fsp) is kept in
+			// DisplayWith, DATETIME(0) is parsed as the default width
+			fsp := n.InternalType.DisplayWith
+			if fsp < 0 {
+				fsp = 0
+			}
+			if fsp > 6 {
+				return nil, errors.New(errno.InvalidColumnDefinition, "For Datetime(fsp), fsp must in [0, 6]")
+			}
+			return &plan.Type{Id: plan.Type_DATETIME, Size: 8, Precision: fsp}, nil
 		case defines.MYSQL_TYPE_TIMESTAMP:
 			return &plan.Type{Id: plan.Type_TIMESTAMP, Size: 8, Precision: n.InternalType.Precision}, nil
+		case defines.MYSQL_TYPE_TIME:
+			// the fractional seconds precision of TIME(fsp) is kept in DisplayWith
+			if n.InternalType.DisplayWith < 0 || n.InternalType.DisplayWith > 6 {
+				return nil, errors.New(errno.InvalidColumnDefinition, "For Time(fsp), fsp must in [0, 6]")
+			}
+			return &plan.Type{Id: plan.Type_TIME, Size: 8, Precision: n.InternalType.DisplayWith}, nil
 		case defines.MYSQL_TYPE_DECIMAL:
 			if n.InternalType.DisplayWith > 18 {
 				return &plan.Type{Id: plan.Type_DECIMAL128, Size: 16, Width: n.InternalType.DisplayWith, Scale: n.InternalType.Precision}, nil
@@ -522,6 +538,16 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 			return &plan.Type{Id: plan.Type_DECIMAL64, Size: 8, Width: n.InternalType.DisplayWith, Scale: n.InternalType.Precision}, nil
 		case defines.MYSQL_TYPE_BOOL:
 			return &plan.Type{Id: plan.Type_BOOL, Size: 1}, nil
+		case defines.MYSQL_TYPE_BIT:
+			// the width of BIT(n) is kept in DisplayWith, BIT is BIT(1)
+			width := n.InternalType.DisplayWith
+			if width <= 0 {
+				width = 1
+			}
+			if width > types.MaxBitWidth {
+				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("For Bit(n), n must in [1, %d]", types.MaxBitWidth))
+			}
+			return &plan.Type{Id: plan.Type_BIT, Size: 8, Width: width}, nil
 		}
 	}
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport type: '%v'", typ))
@@ -558,6 +584,14 @@ func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type) (*pla
 					IsNull: true,
 				}, nil
 			}
+			// the value is computed from the expression for every inserted row
+			if isDefaultExpression(defaultExpr) {
+				return &plan.DefaultExpr{
+					Exist:  true,
+					Value:  nil,
+					IsNull: true,
+				}, nil
+			}
 
 			value, err := buildConstant(typ, d.Expr)
 			if err != nil {
@@ -621,6 +655,10 @@ func convertToPlanValue(value interface{}) *plan.ConstantValue {
 		return &plan.ConstantValue{
 			ConstantValue: &plan.ConstantValue_TimeStampV{TimeStampV: int64(v)},
 		}
+	case types.Time:
+		return &plan.ConstantValue{
+			ConstantValue: &plan.ConstantValue_Int64V{Int64V: int64(v)},
+		}
 	case types.Decimal64:
 		return &plan.ConstantValue{
 			ConstantValue: &plan.ConstantValue_Decimal64V{Decimal64V: int64(v)},
@@ -679,6 +717,11 @@ func rangeCheck(value interface{}, typ *plan.Type, columnName string, rowNumber
 			}
 		case plan.Type_UINT64:
 			return v, nil
+		case plan.Type_BIT:
+			if types.BitFits(v, typ.Width) {
+				return v, nil
+			}
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
@@ -713,7 +756,7 @@ func rangeCheck(value interface{}, typ *plan.Type, columnName string, rowNumber
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime, types.Timestamp, types.Decimal64, types.Decimal128:
+	case types.Date, types.Datetime, types.Timestamp, types.Time, types.Decimal64, types.Decimal128:
 		return v, nil
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
@@ -722,11 +765,11 @@ func rangeCheck(value interface{}, typ *plan.Type, columnName string, rowNumber
 
 var (
 	// errors may happen while building constant
-	ErrDivByZero        = errors.New(errno.SyntaxErrororAccessRuleViolation, "division by zero")
-	ErrZeroModulus      = errors.New(errno.SyntaxErrororAccessRuleViolation, "zero modulus")
-	errConstantOutRange = errors.New(errno.DataException, "constant value out of range")
-	errBinaryOutRange   = errors.New(errno.DataException, "binary result out of range")
-	errUnaryOutRange    = errors.New(errno.DataException, "unary result out of range")
+	ErrDivByZero        = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "division by zero")
+	ErrZeroModulus      = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "zero modulus")
+	errConstantOutRange = moerr.NewError(moerr.OUT_OF_RANGE, "constant value out of range")
+	errBinaryOutRange   = moerr.NewError(moerr.OUT_OF_RANGE, "binary result out of range")
+	errUnaryOutRange    = moerr.NewError(moerr.OUT_OF_RANGE, "unary result out of range")
 )
 
 func buildConstant(typ *plan.Type, n tree.Expr) (interface{}, error) {
@@ -742,6 +785,12 @@ func buildConstant(typ *plan.Type, n tree.Expr) (interface{}, error) {
 		if e.Op == tree.UNARY_MINUS {
 			switch n := e.Expr.(type) {
 			case *tree.NumVal:
+				if n.IsBinaryLiteral() && (isNumericType(typ) || typ.Id == plan.Type_BIT) {
+					var err error
+					if n, err = numericBinaryLiteral(n); err != nil {
+						return nil, err
+					}
+				}
 				return buildConstantValue(typ, tree.NewNumVal(n.Value, "-"+n.String(), true))
 			}
 
@@ -852,7 +901,7 @@ func buildConstant(typ *plan.Type, n tree.Expr) (interface{}, error) {
 				return int64(floatResult - 0.5), nil
 			}
 			return int64(floatResult), nil
-		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64, plan.Type_BIT:
 			if floatResult < 0 || floatResult+0.5 > math.MaxInt64 {
 				return nil, errBinaryOutRange
 			}
@@ -889,7 +938,7 @@ func buildConstant(typ *plan.Type, n tree.Expr) (interface{}, error) {
 				return int64(floatResult - 0.5), nil
 			}
 			return int64(floatResult), nil
-		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64, plan.Type_BIT:
 			if floatResult < 0 || floatResult+0.5 > math.MaxInt64 {
 				return nil, errBinaryOutRange
 			}
@@ -912,6 +961,12 @@ func buildConstant(typ *plan.Type, n tree.Expr) (interface{}, error) {
 }
 
 func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
+	if num.IsBinaryLiteral() && (isNumericType(typ) || typ.Id == plan.Type_BIT) {
+		var err error
+		if num, err = numericBinaryLiteral(num); err != nil {
+			return nil, err
+		}
+	}
 	val := num.Value
 	str := num.String()
 
@@ -935,10 +990,10 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 				return int64(v), nil
 			}
 		case plan.Type_DECIMAL64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case plan.Type_DECIMAL128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
-		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
+		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64, plan.Type_BIT:
 			v, _ := constant.Uint64Val(val)
 			if num.Negative() {
 				if v != 0 {
@@ -966,6 +1021,12 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case plan.Type_TIME:
+			v, _ := constant.Int64Val(val)
+			if num.Negative() {
+				v = -v
+			}
+			return types.ParseTimeFromInt64(v)
 		}
 	case constant.Float:
 		switch typ.GetId() {
@@ -992,7 +1053,7 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 				}
 			}
 			return v, nil
-		case plan.Type_UINT64, plan.Type_UINT32, plan.Type_UINT16, plan.Type_UINT8:
+		case plan.Type_UINT64, plan.Type_BIT, plan.Type_UINT32, plan.Type_UINT16, plan.Type_UINT8:
 			parts := strings.Split(str, ".")
 			v, err := strconv.ParseUint(parts[0], 10, 64)
 			if err != nil || len(parts) == 1 {
@@ -1019,17 +1080,28 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 			return float64(v), nil
 		case plan.Type_DATETIME:
 			return types.ParseDatetime(str)
+		case plan.Type_TIME:
+			return types.ParseTime(str, typ.Precision)
 		case plan.Type_DECIMAL64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case plan.Type_DECIMAL128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
 		}
 	case constant.String:
 		switch typ.GetId() {
 		case plan.Type_DECIMAL64:
-			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
+			return types.ParseDecimal64(str, typ.Width, typ.Scale)
 		case plan.Type_DECIMAL128:
-			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+			return types.ParseDecimal128(str, typ.Width, typ.Scale)
+		case plan.Type_TIME:
+			return types.ParseTime(constant.StringVal(val), typ.Precision)
+		case plan.Type_BIT:
+			// a string is stored in a BIT column as the bits of its bytes
+			v, ok := types.ParseBitBytes([]byte(constant.StringVal(val)))
+			if !ok {
+				return nil, errConstantOutRange
+			}
+			return v, nil
 		}
 		if !num.Negative() {
 			switch typ.GetId() {
@@ -0,0 +1,211 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// coercibility decides which collation wins when two strings with different
+// collations meet in one comparison, the smaller one wins.
+type coercibility int
+
+const (
+	// an explicit COLLATE clause
+	coercibilityExplicit coercibility = iota
+	// a column or any other expression
+	coercibilityImplicit
+	// a literal or a variable
+	coercibilityCoercible
+)
+
+var coercibilityNames = [...]string{"EXPLICIT", "IMPLICIT", "COERCIBLE"}
+
+func isStringType(typ *plan.Type) bool {
+	return typ.Id == plan.Type_CHAR || typ.Id == plan.Type_VARCHAR
+}
+
+func exprCollation(typ *plan.Type) int32 {
+	if isStringType(typ) {
+		return typ.Precision
+	}
+	return types.CollationBin
+}
+
+func getCollationFromName(name string) (int32, error) {
+	c, ok := types.ParseCollation(name)
+	if !ok {
+		return 0, errors.New(errno.UndefinedObject, fmt.Sprintf("unknown collation: '%s'", name))
+	}
+	return c, nil
+}
+
+// getDatabaseDefaults returns the canonical default charset and collation of
+// the options of CREATE DATABASE, they are empty without the options. Only
+// utf8mb4 and its collations are supported for now.
+func getDatabaseDefaults(opts []tree.CreateOption) (defaults engine.DatabaseDefaults, err error) {
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case *tree.CreateOptionCharset:
+			if !strings.EqualFold(opt.Charset, "utf8mb4") {
+				return defaults, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported charset: '%s', only utf8mb4 is supported", opt.Charset))
+			}
+			defaults.Charset = "utf8mb4"
+		case *tree.CreateOptionCollate:
+			c, err := getCollationFromName(opt.Collate)
+			if err != nil {
+				return defaults, err
+			}
+			if !strings.HasPrefix(strings.ToLower(opt.Collate), "utf8mb4_") {
+				return defaults, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported collation: '%s', only the utf8mb4 collations are supported", opt.Collate))
+			}
+			defaults.Collation = types.CollationName(c)
+		}
+	}
+	if defaults.Charset != "" || defaults.Collation != "" {
+		defaults.Charset = "utf8mb4"
+		if defaults.Collation == "" {
+			defaults.Collation = types.CollationName(types.CollationBin)
+		}
+	}
+	return defaults, nil
+}
+
+// getDatabaseCollation returns the collation of the string columns of the
+// tables created in the database, which is resolved when a table is created
+// so that the tables keep it even if the defaults of the database change.
+func getDatabaseCollation(ctx CompilerContext, dbName string) int32 {
+	name := ctx.DatabaseDefaults(dbName).Collation
+	if name == "" {
+		return types.CollationBin
+	}
+	if c, ok := types.ParseCollation(name); ok {
+		return c
+	}
+	return types.CollationBin
+}
+
+func isSupportedCharset(name string) bool {
+	switch strings.ToLower(name) {
+	case "utf8", "utf8mb4":
+		return true
+	}
+	return false
+}
+
+func getCoercibility(astExpr tree.Expr) coercibility {
+	for {
+		switch e := astExpr.(type) {
+		case *tree.ParenExpr:
+			astExpr = e.Expr
+			continue
+		case *tree.CollateExpr:
+			return coercibilityExplicit
+		case *tree.NumVal, *tree.StrVal, *tree.VarExpr:
+			return coercibilityCoercible
+		}
+		return coercibilityImplicit
+	}
+}
+
+// bindCollateExpr binds 'expr COLLATE name', the expression keeps its value
+// and only its type carries the new collation.
+func (b *baseBinder) bindCollateExpr(astExpr *tree.CollateExpr, depth int32) (*Expr, error) {
+	expr, err := b.impl.BindExpr(astExpr.Expr, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	c, err := getCollationFromName(astExpr.Collate)
+	if err != nil {
+		return nil, err
+	}
+	if !isStringType(expr.Typ) {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("COLLATE '%s' is not valid for type '%s'", astExpr.Collate, expr.Typ.Id))
+	}
+	return &Expr{
+		Expr: expr.Expr,
+		Typ: &plan.Type{
+			Id:        expr.Typ.Id,
+			Nullable:  expr.Typ.Nullable,
+			Width:     expr.Typ.Width,
+			Size:      expr.Typ.Size,
+			Scale:     expr.Typ.Scale,
+			Precision: c,
+		},
+		TableName: expr.TableName,
+		ColName:   expr.ColName,
+	}, nil
+}
+
+// resolveCollation works out the collation used to compare string arguments,
+// following the coercibility rules of mysql.
+func resolveCollation(name string, astArgs []tree.Expr, args []*Expr) (int32, error) {
+	c, level, found := types.CollationBin, coercibilityCoercible, false
+	for i, arg := range args {
+		if !isStringType(arg.Typ) {
+			continue
+		}
+		argC, argLevel := exprCollation(arg.Typ), getCoercibility(astArgs[i])
+		switch {
+		case !found || argLevel < level:
+			c, level, found = argC, argLevel, true
+		case argLevel == level && argC != c:
+			if level == coercibilityCoercible {
+				continue
+			}
+			return 0, errors.New(errno.CollationMismatch, fmt.Sprintf("Illegal mix of collations (%s,%s) and (%s,%s) for operation '%s'",
+				types.CollationName(c), coercibilityNames[level], types.CollationName(argC), coercibilityNames[argLevel], name))
+		}
+	}
+	return c, nil
+}
+
+// bindCollatedFuncExprImplByAstExpr binds a string comparison, picking the
+// overload of the resolved collation when the arguments are strings.
+func (b *baseBinder) bindCollatedFuncExprImplByAstExpr(name string, astArgs []tree.Expr, depth int32) (*Expr, error) {
+	args, err := b.bindFuncArgs(name, astArgs, depth)
+	if err != nil {
+		return nil, err
+	}
+	c, err := resolveCollation(name, astArgs, args)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := b.bindFuncExprImplByPlanExpr(name, args)
+	if err != nil || c == types.CollationBin {
+		return expr, err
+	}
+	f := expr.Expr.(*plan.Expr_F).F
+	for _, arg := range f.Args {
+		if !isStringType(arg.Typ) {
+			return expr, nil
+		}
+	}
+	_, funcId, err := function.GetFunctionByCollation(name, c)
+	if err != nil {
+		return nil, err
+	}
+	f.Func = getFunctionObjRef(funcId, name)
+	return expr, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDatabaseDefaults(t *testing.T) {
+	mock := NewMockOptimizer()
+	for sql, expect := range map[string]engine.DatabaseDefaults{
+		"create database db": {},
+		"create database db default character set utf8mb4":              {Charset: "utf8mb4", Collation: "utf8mb4_bin"},
+		"create database db collate utf8mb4_general_ci":                 {Charset: "utf8mb4", Collation: "utf8mb4_general_ci"},
+		"create database db charset UTF8MB4 collate UTF8MB4_GENERAL_CI": {Charset: "utf8mb4", Collation: "utf8mb4_general_ci"},
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		createDb := logicPlan.GetDdl().GetCreateDatabase()
+		require.Equal(t, expect.Charset, createDb.GetCharset(), sql)
+		require.Equal(t, expect.Collation, createDb.GetCollation(), sql)
+	}
+
+	for _, sql := range []string{
+		"create database db character set latin1",
+		"create database db character set utf8",
+		"create database db collate utf8_general_ci",
+		"create database db collate latin1_swedish_ci",
+	} {
+		_, err := runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestCreateTableInheritsDatabaseCollation(t *testing.T) {
+	mock := NewMockOptimizer()
+	mock.ctxt.defaults = map[string]engine.DatabaseDefaults{
+		"ci": {Charset: "utf8mb4", Collation: "utf8mb4_general_ci"},
+	}
+	collations := func(sql string) []int32 {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		var cs []int32
+		for _, col := range logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols() {
+			cs = append(cs, col.Typ.Precision)
+		}
+		return cs
+	}
+	bin, ci := types.CollationBin, types.CollationGeneralCI
+
+	require.Equal(t, []int32{bin}, collations("create table t (a varchar(10))"))
+	require.Equal(t, []int32{ci, 0}, collations("create table ci.t (a varchar(10), b int)"))
+	// a column or the table overrides the default of the database
+	require.Equal(t, []int32{ci, bin}, collations("create table ci.t (a char(10), b varchar(10) collate utf8mb4_bin)"))
+	require.Equal(t, []int32{bin}, collations("create table ci.t (a varchar(10)) collate utf8mb4_bin"))
+	require.Equal(t, []int32{bin}, collations("create table ci.t (a varchar(10)) charset utf8mb4"))
+	require.Equal(t, []int32{ci}, collations("create table t (a varchar(10)) collate utf8mb4_general_ci charset utf8mb4"))
+}
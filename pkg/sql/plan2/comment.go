@@ -0,0 +1,53 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// CommentPropertyKey is the table property holding the COMMENT of a table,
+// it is persisted in the schema
+const CommentPropertyKey = "comment"
+
+const (
+	// MaxTableCommentLength is the max number of characters of the comment
+	// of a table, as mysql
+	MaxTableCommentLength = 2048
+	// MaxColumnCommentLength is the max number of characters of the comment
+	// of a column, as mysql
+	MaxColumnCommentLength = 1024
+)
+
+// checkTableComment returns an error if the comment of the table is too long
+func checkTableComment(tableName, comment string) error {
+	if utf8.RuneCountInString(comment) > MaxTableCommentLength {
+		return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Comment for table '%s' is too long (max = %d)", tableName, MaxTableCommentLength))
+	}
+	return nil
+}
+
+// checkColumnComment returns an error if the comment of the column is too
+// long
+func checkColumnComment(colName, comment string) error {
+	if utf8.RuneCountInString(comment) > MaxColumnCommentLength {
+		return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Comment for field '%s' is too long (max = %d)", colName, MaxColumnCommentLength))
+	}
+	return nil
+}
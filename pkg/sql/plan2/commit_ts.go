@@ -0,0 +1,73 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// CommitTSPropertyKey is set on the table def of a relation whose rows can
+// be read with the commit ts pseudo column engine.CommitTSColumnName, and
+// which has no column of the name
+const CommitTSPropertyKey = "commit_ts"
+
+// isCommitTSColumn returns true if the column at colPos is the commit ts
+// pseudo column
+func isCommitTSColumn(tableDef *TableDef, colPos int32) bool {
+	return int(colPos) == len(tableDef.Cols)-1 && hasCommitTSColumn(tableDef)
+}
+
+// hasCommitTSColumn returns true if the last column of the table def is the
+// commit ts pseudo column
+func hasCommitTSColumn(tableDef *TableDef) bool {
+	if _, ok := getTableProperty(tableDef, CommitTSPropertyKey); !ok {
+		return false
+	}
+	n := len(tableDef.Cols)
+	return n > 0 && tableDef.Cols[n-1].Name == engine.CommitTSColumnName
+}
+
+// withCommitTSColumn returns the table def of a table scan with the commit
+// ts pseudo column appended if the relation has it. The pseudo column is
+// not unfolded by a star, and it is dropped from the scan if no expression
+// refers to it, so the other queries don't read it.
+func withCommitTSColumn(tableDef *TableDef) *TableDef {
+	if _, ok := getTableProperty(tableDef, CommitTSPropertyKey); !ok || hasCommitTSColumn(tableDef) {
+		return tableDef
+	}
+	def := *tableDef
+	def.Cols = append(make([]*ColDef, 0, len(def.Cols)+1), def.Cols...)
+	def.Cols = append(def.Cols, &ColDef{
+		Name: engine.CommitTSColumnName,
+		Typ: &plan.Type{
+			Id:   plan.Type_UINT64,
+			Size: 8,
+		},
+	})
+	return &def
+}
+
+// pruneCommitTSColumn drops the commit ts pseudo column of the table scan
+// if the binding of the scan has no reference to it
+func pruneCommitTSColumn(node *Node, binding *Binding) {
+	n := len(node.TableDef.Cols)
+	if binding == nil || !hasCommitTSColumn(node.TableDef) || binding.refCnts[n-1] > 0 {
+		return
+	}
+	def := *node.TableDef
+	def.Cols = def.Cols[:n-1]
+	node.TableDef = &def
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitTSColumn(t *testing.T) {
+	mock := NewMockOptimizer()
+	def := *mock.ctxt.tables["nation"]
+	def.Defs = append(def.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: []*plan.Property{{Key: CommitTSPropertyKey, Value: "true"}},
+			},
+		},
+	})
+	mock.ctxt.tables["nation"] = &def
+	mock.ctxt.paths = map[string]map[string][]engine.PathEstimate{
+		"nation": {engine.CommitTSColumnName: {
+			{Path: engine.FullScan, Blocks: 100, Rows: 819200},
+			{Path: engine.ZonemapScan, Probes: 100, Blocks: 2, Rows: 16384},
+		}},
+	}
+	scan := func(sql string) (*Node, int) {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		qry := logicPlan.GetQuery()
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				return node, len(qry.Nodes[qry.Steps[0]].ProjectList)
+			}
+		}
+		t.Fatal("table scan not found")
+		return nil, 0
+	}
+
+	// the pseudo column is not unfolded by a star, and it is not read if no
+	// expression refers to it
+	for _, sql := range []string{
+		"select * from nation",
+		"select nation.* from nation",
+		"select n_name, n_nationkey, n_regionkey, n_comment from nation where n_nationkey > 10",
+	} {
+		node, cols := scan(sql)
+		require.Equal(t, 4, cols, sql)
+		require.Equal(t, 4, len(node.TableDef.Cols), sql)
+	}
+	node, cols := scan("select *, __mo_commit_ts from nation")
+	require.Equal(t, 5, cols)
+	require.Equal(t, engine.CommitTSColumnName, node.TableDef.Cols[4].Name)
+	require.Equal(t, plan.Type_UINT64, node.TableDef.Cols[4].Typ.Id)
+	require.Equal(t, 4, len(mock.ctxt.tables["nation"].Cols))
+
+	// the incremental queries skip the blocks by the range of commit ts
+	for _, sql := range []string{
+		"select n_name from nation where __mo_commit_ts > 100",
+		"select n_name from nation where 101 <= nation.__mo_commit_ts",
+	} {
+		node, _ := scan(sql)
+		path, ok := GetAccessPath(node.TableDef)
+		require.True(t, ok, sql)
+		require.Equal(t, engine.ZonemapScan, path.Path)
+		require.Equal(t, engine.CommitTSColumnName, path.Column)
+		require.Equal(t, "[101, +inf)", path.Interval(node.TableDef))
+	}
+	_, err := runOneStmt(mock, t, "select __mo_commit_ts from region")
+	require.Error(t, err)
+}
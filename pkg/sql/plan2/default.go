@@ -0,0 +1,124 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+)
+
+// DefaultBinder binds the expression of the default value of a column, or
+// the expression assigned to a column on update, the expression refers to
+// no column
+type DefaultBinder struct {
+	baseBinder
+	col *ColDef
+}
+
+func NewDefaultBinder(col *ColDef) *DefaultBinder {
+	b := &DefaultBinder{col: col}
+	b.ctx = NewBindContext(nil, nil)
+	b.impl = b
+	return b
+}
+
+func (b *DefaultBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindExpr(astExpr, depth)
+}
+
+func (b *DefaultBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("default value of column '%s' cannot refer to column '%s'", b.col.Name, tree.String(astExpr, 0)))
+}
+
+func (b *DefaultBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.GroupingError, "aggregate functions not allowed in default value")
+}
+
+func (b *DefaultBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.WindowingError, "window functions not allowed in default value")
+}
+
+func (b *DefaultBinder) BindSubquery(astExpr *tree.Subquery) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnDefinition, "subquery not allowed in default value")
+}
+
+// BuildDefaultExpr binds the expression of the default value of col, the
+// expression is casted to the type of col.
+func BuildDefaultExpr(col *ColDef) (*Expr, error) {
+	return buildColumnExpr(col, col.DefaultExpression)
+}
+
+// BuildOnUpdateExpr binds the expression assigned to col when its row is
+// updated, the expression is casted to the type of col.
+func BuildOnUpdateExpr(col *ColDef) (*Expr, error) {
+	return buildColumnExpr(col, col.OnUpdate)
+}
+
+func buildColumnExpr(col *ColDef, text string) (*Expr, error) {
+	astExpr, err := parseColumnExpr(text)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := NewDefaultBinder(col).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Typ.Id != col.Typ.Id {
+		if expr, err = appendCastBeforeExpr(expr, col.Typ); err != nil {
+			return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", col.Name))
+		}
+	}
+	return expr, nil
+}
+
+// isDefaultExpression returns true if the default value is an expression
+// with function calls, which is evaluated for every inserted row instead of
+// being folded into a constant
+func isDefaultExpression(expr tree.Expr) bool {
+	switch e := expr.(type) {
+	case *tree.FuncExpr:
+		return true
+	case *tree.ParenExpr:
+		return isDefaultExpression(e.Expr)
+	case *tree.UnaryExpr:
+		return isDefaultExpression(e.Expr)
+	case *tree.BinaryExpr:
+		return isDefaultExpression(e.Left) || isDefaultExpression(e.Right)
+	case *tree.CastExpr:
+		return isDefaultExpression(e.Expr)
+	}
+	return false
+}
+
+// IsVolatileExpr returns true if the expression calls a volatile function,
+// whose result is different for every row
+func IsVolatileExpr(expr *Expr) bool {
+	if e, ok := expr.Expr.(*plan.Expr_F); ok {
+		if f, err := function.GetFunctionByID(e.F.Func.GetObj()); err == nil && f.Flag == plan.Function_VOLATILE {
+			return true
+		}
+		for _, arg := range e.F.Args {
+			if IsVolatileExpr(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
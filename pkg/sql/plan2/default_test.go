@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableDefaultExpression(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table t (a int default 1, b datetime default now() on update current_timestamp, c varchar(36) default (uuid()), d int default (1 + 2), e int default (length(uuid())))")
+	require.NoError(t, err)
+	cols := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols()
+	require.Equal(t, "", cols[0].DefaultExpression)
+	require.Equal(t, "now()", cols[1].DefaultExpression)
+	require.Equal(t, "current_timestamp()", cols[1].OnUpdate)
+	require.Equal(t, "(uuid())", cols[2].DefaultExpression)
+	require.Equal(t, "", cols[2].OnUpdate)
+	// the constant expressions are folded
+	require.Equal(t, "", cols[3].DefaultExpression)
+	require.Equal(t, "(length(uuid()))", cols[4].DefaultExpression)
+
+	expr, err := BuildDefaultExpr(cols[1])
+	require.NoError(t, err)
+	require.False(t, IsVolatileExpr(expr))
+	expr, err = BuildDefaultExpr(cols[2])
+	require.NoError(t, err)
+	require.True(t, IsVolatileExpr(expr))
+	expr, err = BuildDefaultExpr(cols[4])
+	require.NoError(t, err)
+	require.True(t, IsVolatileExpr(expr))
+
+	for _, sql := range []string{
+		"create table t (a int, b int default (a + 1))",
+		"create table t (a int, b int default (count(*)))",
+		"create table t (a int, b int default (no_such_function()))",
+		"create table t (a int, b int on update now())",
+		"create table t (a int, b datetime default (1 + 2) as (now()) stored)",
+	} {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestUpdateOnUpdate(t *testing.T) {
+	mock := NewMockOptimizer()
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.objects["events"] = &ObjectRef{SchemaName: "tpch", ObjName: "events"}
+	ctx.tables["events"] = &TableDef{
+		Name: "events",
+		Cols: []*ColDef{
+			{Name: "id", Typ: &plan.Type{Id: plan.Type_INT32}},
+			{Name: "v", Typ: &plan.Type{Id: plan.Type_INT32}},
+			{Name: "modified", Typ: &plan.Type{Id: plan.Type_DATETIME}, OnUpdate: "current_timestamp()"},
+		},
+	}
+
+	logicPlan, err := runOneStmt(mock, t, "update events set v = 1 where id = 1")
+	require.NoError(t, err)
+	nodes := logicPlan.GetQuery().GetNodes()
+	values := nodes[len(nodes)-1].GetUpdateList().GetValues()
+	require.Equal(t, 3, len(values))
+	f := values[2].Expr.(*plan.Expr_F).F
+	require.Equal(t, "current_timestamp", f.Func.ObjName)
+
+	// the assigned value is kept
+	logicPlan, err = runOneStmt(mock, t, "update events set modified = '2022-01-01 00:00:00' where id = 1")
+	require.NoError(t, err)
+	nodes = logicPlan.GetQuery().GetNodes()
+	values = nodes[len(nodes)-1].GetUpdateList().GetValues()
+	_, ok := values[2].Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_Col)
+	require.True(t, ok)
+}
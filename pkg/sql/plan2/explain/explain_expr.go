@@ -15,6 +15,7 @@
 package explain
 
 import (
+	"math"
 	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -145,7 +146,11 @@ func funcExprExplain(funcExpr *plan.Expr_F, Typ *plan.Type, options *ExplainOpti
 		if err != nil {
 			return result, err
 		}
-		result += "CAST(" + describeExpr + " AS " + plan.Type_TypeId_name[int32(Typ.Id)] + ")"
+		if options.Verbose {
+			result += "CAST(" + describeExpr + " AS " + describeType(Typ) + ")"
+		} else {
+			result += "CAST(" + describeExpr + " AS " + plan.Type_TypeId_name[int32(Typ.Id)] + ")"
+		}
 	case function.CASE_WHEN_EXPRESSION:
 		// TODO need rewrite to deal with case is nil
 		result += "CASE"
@@ -225,3 +230,46 @@ func funcExprExplain(funcExpr *plan.Expr_F, Typ *plan.Type, options *ExplainOpti
 	}
 	return result, nil
 }
+
+// describeType returns the name of a type with its width and scale, such as
+// VARCHAR(25) and DECIMAL64(15,2)
+func describeType(typ *plan.Type) string {
+	result := plan.Type_TypeId_name[int32(typ.Id)]
+	switch typ.Id {
+	case plan.Type_DECIMAL64, plan.Type_DECIMAL128:
+		result += "(" + strconv.Itoa(int(typ.Width)) + "," + strconv.Itoa(int(typ.Scale)) + ")"
+	case plan.Type_CHAR, plan.Type_VARCHAR:
+		// the width of a string constant is unbounded
+		if typ.Width > 0 && typ.Width < math.MaxInt32 {
+			result += "(" + strconv.Itoa(int(typ.Width)) + ")"
+		}
+	case plan.Type_DATETIME, plan.Type_TIMESTAMP, plan.Type_TIME:
+		if typ.Precision > 0 {
+			result += "(" + strconv.Itoa(int(typ.Precision)) + ")"
+		}
+	}
+	return result
+}
+
+// describeImplicitCasts appends the casts the planner inserted in expr
+func describeImplicitCasts(casts []string, expr *plan.Expr, options *ExplainOptions) ([]string, error) {
+	funcExpr, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return casts, nil
+	}
+	args := funcExpr.F.Args
+	if len(args) == 2 && args[1].GetT().GetImplicit() {
+		cast, err := describeExpr(expr, options)
+		if err != nil {
+			return nil, err
+		}
+		casts = append(casts, cast)
+	}
+	var err error
+	for _, arg := range args {
+		if casts, err = describeImplicitCasts(casts, arg, options); err != nil {
+			return nil, err
+		}
+	}
+	return casts, nil
+}
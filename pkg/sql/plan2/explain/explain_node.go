@@ -15,11 +15,14 @@
 package explain
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 )
 
 var _ NodeDescribe = &NodeDescribeImpl{}
@@ -224,6 +227,19 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Access Path info
+	if ndesc.Node.NodeType == plan.Node_TABLE_SCAN && ndesc.Node.TableDef != nil {
+		if path, ok := plan2.GetAccessPath(ndesc.Node.TableDef); ok {
+			if path.Prefix {
+				lines = append(lines, fmt.Sprintf("Access Path: %s on %s prefix '%s' (cost=%.2f)", path.Path, path.Column, path.Str, path.Cost))
+			} else if path.Bounded {
+				lines = append(lines, fmt.Sprintf("Access Path: %s on %s range %s (cost=%.2f)", path.Path, path.Column, path.Interval(ndesc.Node.TableDef), path.Cost))
+			} else {
+				lines = append(lines, fmt.Sprintf("Access Path: %s on %s (cost=%.2f)", path.Path, path.Column, path.Cost))
+			}
+		}
+	}
+
 	// Get Filter list info
 	if ndesc.Node.WhereList != nil && len(ndesc.Node.WhereList) != 0 {
 		filterInfo, err := ndesc.GetWhereConditionInfo(options)
@@ -275,6 +291,74 @@ func (ndesc *NodeDescribeImpl) GetProjectListInfo(options *ExplainOptions) (stri
 	return result, nil
 }
 
+// GetOutputSchemaInfo returns a line for each output column of the node: its
+// name, type and nullability, and the table column, the child column
+// (#child.column) or the expression which produces it
+func (ndesc *NodeDescribeImpl) GetOutputSchemaInfo(options *ExplainOptions) ([]string, error) {
+	lines := []string{"Output Schema:"}
+	for i, expr := range ndesc.Node.ProjectList {
+		name := expr.ColName
+		if name == "" {
+			name = "#" + strconv.Itoa(i)
+		}
+		nullability := "NOT NULL"
+		if expr.Typ.GetNullable() {
+			nullability = "NULL"
+		}
+		source, err := ndesc.describeColumnSource(expr, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, "  "+name+": "+describeType(expr.Typ)+" "+nullability+" <- "+source)
+	}
+	return lines, nil
+}
+
+func (ndesc *NodeDescribeImpl) describeColumnSource(expr *plan.Expr, options *ExplainOptions) (string, error) {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return describeExpr(expr, options)
+	}
+	relPos, colPos := col.Col.RelPos, col.Col.ColPos
+	switch ndesc.Node.NodeType {
+	case plan.Node_TABLE_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_VALUE_SCAN:
+		if ndesc.Node.TableDef != nil && int(colPos) < len(ndesc.Node.TableDef.Cols) {
+			return ndesc.Node.TableDef.Name + "." + ndesc.Node.TableDef.Cols[colPos].Name, nil
+		}
+	case plan.Node_AGG:
+		// the group keys and the aggregates are referred by -1 and -2
+		if relPos == -1 && int(colPos) < len(ndesc.Node.GroupBy) {
+			return describeExpr(ndesc.Node.GroupBy[colPos], options)
+		}
+		if relPos == -2 && int(colPos) < len(ndesc.Node.AggList) {
+			return describeExpr(ndesc.Node.AggList[colPos], options)
+		}
+	}
+	return "#" + strconv.Itoa(int(relPos)) + "." + strconv.Itoa(int(colPos)), nil
+}
+
+// GetImplicitCastInfo returns the casts the planner inserted in the
+// expressions of the node, or an empty string if there is none
+func (ndesc *NodeDescribeImpl) GetImplicitCastInfo(options *ExplainOptions) (string, error) {
+	var casts []string
+	var err error
+	exprLists := [][]*plan.Expr{ndesc.Node.ProjectList, ndesc.Node.OnList, ndesc.Node.WhereList, ndesc.Node.GroupBy, ndesc.Node.AggList}
+	for _, orderBy := range ndesc.Node.OrderBy {
+		exprLists = append(exprLists, []*plan.Expr{orderBy.Expr})
+	}
+	for _, exprs := range exprLists {
+		for _, expr := range exprs {
+			if casts, err = describeImplicitCasts(casts, expr, options); err != nil {
+				return "", err
+			}
+		}
+	}
+	if len(casts) == 0 {
+		return "", nil
+	}
+	return "Implicit Casts: " + strings.Join(casts, ", "), nil
+}
+
 func (ndesc *NodeDescribeImpl) GetJoinConditionInfo(options *ExplainOptions) (string, error) {
 	var result string = "Join Cond:"
 	exprs := NewExprListDescribeImpl(ndesc.Node.OnList)
@@ -74,6 +74,22 @@ func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOpti
 					return err
 				}
 				settings.buffer.PushNewLine(projecrtInfo, false, settings.level)
+
+				schemaInfo, err := nodedescImpl.GetOutputSchemaInfo(options)
+				if err != nil {
+					return err
+				}
+				for _, line := range schemaInfo {
+					settings.buffer.PushNewLine(line, false, settings.level)
+				}
+			}
+
+			castInfo, err := nodedescImpl.GetImplicitCastInfo(options)
+			if err != nil {
+				return err
+			}
+			if castInfo != "" {
+				settings.buffer.PushNewLine(castInfo, false, settings.level)
 			}
 
 			if nodedescImpl.Node.NodeType == plan.Node_VALUE_SCAN {
@@ -298,3 +298,118 @@ func runOneStmt(opt plan2.Optimizer, t *testing.T, sql string) error {
 	}
 	return nil
 }
+
+func explainVerbose(t *testing.T, sql string) string {
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ctx := plan2.NewMockOptimizer().CurrentContext()
+	logicPlan, err := plan2.BuildPlan(ctx, stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	buffer := NewExplainDataBuffer()
+	options := NewExplainDefaultOptions()
+	options.Verbose = true
+	if err = NewExplainQueryImpl(logicPlan.GetQuery()).ExplainPlan(buffer, options); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return strings.Join(buffer.Lines, "\n")
+}
+
+// the output schema of every node, with the nullability changed by an outer
+// join and an aggregate, and the casts inserted by the planner
+func TestVerboseOutputSchema(t *testing.T) {
+	cases := []struct {
+		sql    string
+		expect string
+	}{
+		{
+			sql: "SELECT N_NAME, N_REGIONKEY + 1.5 FROM NATION WHERE N_NATIONKEY > '10'",
+			expect: `Project (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+  Output: n_name, (CAST(n_regionkey AS FLOAT64) + 1.5)
+  Output Schema:
+    n_name: VARCHAR(25) NOT NULL <- #0.1
+    #1: FLOAT64 NOT NULL <- (CAST(n_regionkey AS FLOAT64) + 1.5)
+  Implicit Casts: CAST(n_regionkey AS FLOAT64)
+  ->  Project (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+        Output: n_nationkey, n_name, n_regionkey, n_comment
+        Output Schema:
+          n_nationkey: INT32 NOT NULL <- #0.0
+          n_name: VARCHAR(25) NOT NULL <- #0.1
+          n_regionkey: INT32 NOT NULL <- #0.2
+          n_comment: VARCHAR(152) NULL <- #0.3
+        Implicit Casts: CAST(n_nationkey AS FLOAT64), CAST('10' AS FLOAT64)
+        Filter: (CAST(n_nationkey AS FLOAT64) > CAST('10' AS FLOAT64))
+        ->  Table Scan on tpch.nation (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+              Output: n_nationkey, n_name, n_regionkey, n_comment
+              Output Schema:
+                n_nationkey: INT32 NOT NULL <- nation.n_nationkey
+                n_name: VARCHAR(25) NOT NULL <- nation.n_name
+                n_regionkey: INT32 NOT NULL <- nation.n_regionkey
+                n_comment: VARCHAR(152) NULL <- nation.n_comment`,
+		},
+		{
+			sql: "SELECT n.N_NAME, r.R_NAME FROM NATION n LEFT JOIN REGION r ON n.N_REGIONKEY = r.R_REGIONKEY",
+			expect: `Project (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+  Output: n.n_name, r.r_name
+  Output Schema:
+    n.n_name: VARCHAR(25) NOT NULL <- #0.1
+    r.r_name: VARCHAR(25) NULL <- #0.5
+  ->  Join (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+        Output: n_nationkey, n_name, n_regionkey, n_comment, r_regionkey, r_name, r_comment
+        Output Schema:
+          n_nationkey: INT32 NOT NULL <- #0.0
+          n_name: VARCHAR(25) NOT NULL <- #0.1
+          n_regionkey: INT32 NOT NULL <- #0.2
+          n_comment: VARCHAR(152) NULL <- #0.3
+          r_regionkey: INT32 NULL <- #1.0
+          r_name: VARCHAR(25) NULL <- #1.1
+          r_comment: VARCHAR(152) NULL <- #1.2
+        Join Cond: (n.n_regionkey = r.r_regionkey)
+        ->  Table Scan on tpch.nation (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+              Output: n_nationkey, n_name, n_regionkey, n_comment
+              Output Schema:
+                n_nationkey: INT32 NOT NULL <- nation.n_nationkey
+                n_name: VARCHAR(25) NOT NULL <- nation.n_name
+                n_regionkey: INT32 NOT NULL <- nation.n_regionkey
+                n_comment: VARCHAR(152) NULL <- nation.n_comment
+        ->  Table Scan on tpch.region (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+              Output: r_regionkey, r_name, r_comment
+              Output Schema:
+                r_regionkey: INT32 NOT NULL <- region.r_regionkey
+                r_name: VARCHAR(25) NOT NULL <- region.r_name
+                r_comment: VARCHAR(152) NULL <- region.r_comment`,
+		},
+		{
+			sql: "SELECT N_REGIONKEY, count(*), sum(N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY",
+			expect: `Project (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+  Output: n_regionkey, count(*), sum(n_nationkey)
+  Output Schema:
+    n_regionkey: INT32 NOT NULL <- #0.0
+    count(*): INT64 NOT NULL <- #0.1
+    sum(n_nationkey): INT64 NULL <- #0.2
+  ->  Aggregate (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+        Output: n_regionkey, count(*), sum(n_nationkey)
+        Output Schema:
+          n_regionkey: INT32 NOT NULL <- n_regionkey
+          count(*): INT64 NOT NULL <- starcount(n_nationkey)
+          sum(n_nationkey): INT64 NULL <- sum(n_nationkey)
+        Group Key:n_regionkey
+        Aggregate Functions: starcount(n_nationkey), sum(n_nationkey)
+        ->  Table Scan on tpch.nation (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)
+              Output: n_nationkey, n_name, n_regionkey, n_comment
+              Output Schema:
+                n_nationkey: INT32 NOT NULL <- nation.n_nationkey
+                n_name: VARCHAR(25) NOT NULL <- nation.n_name
+                n_regionkey: INT32 NOT NULL <- nation.n_regionkey
+                n_comment: VARCHAR(152) NULL <- nation.n_comment`,
+		},
+	}
+	for _, c := range cases {
+		if got := explainVerbose(t, c.sql); got != c.expect {
+			t.Errorf("explain verbose %q:\n%s\nexpect:\n%s", c.sql, got, c.expect)
+		}
+	}
+}
@@ -29,6 +29,8 @@ type NodeDescribe interface {
 	GetNodeBasicInfo(options *ExplainOptions) (string, error)
 	GetExtraInfo(options *ExplainOptions) ([]string, error)
 	GetProjectListInfo(options *ExplainOptions) (string, error)
+	GetOutputSchemaInfo(options *ExplainOptions) ([]string, error)
+	GetImplicitCastInfo(options *ExplainOptions) (string, error)
 	GetJoinConditionInfo(options *ExplainOptions) (string, error)
 	GetWhereConditionInfo(options *ExplainOptions) (string, error)
 	GetOrderByInfo(options *ExplainOptions) (string, error)
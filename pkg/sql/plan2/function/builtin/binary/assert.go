@@ -0,0 +1,73 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const defaultAssertMessage = "assertion failed"
+
+// Assert fails the statement with the message of the first row whose
+// condition is false. A NULL condition does not fail, the result is the
+// condition, true or NULL.
+func Assert(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	cond, msg := vecs[0], vecs[1]
+	resultType := types.Type{Oid: types.T_bool, Size: 1}
+	if cond.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	cs := cond.Col.([]bool)
+	for i, c := range cs {
+		if !c && !nulls.Contains(cond.Nsp, uint64(i)) {
+			return nil, errors.New(errno.DataException, assertMessage(msg, i))
+		}
+	}
+	if cond.IsScalar() {
+		resultVector := proc.AllocScalarVector(resultType)
+		vector.SetCol(resultVector, []bool{true})
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(len(cs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeBoolSlice(resultVector.Data)[:len(cs)]
+	for i := range rs {
+		rs[i] = true
+	}
+	nulls.Set(resultVector.Nsp, cond.Nsp)
+	vector.SetCol(resultVector, rs)
+	return resultVector, nil
+}
+
+// assertMessage returns the message of row i
+func assertMessage(msg *vector.Vector, i int) string {
+	if msg.IsScalarNull() {
+		return defaultAssertMessage
+	}
+	if msg.IsScalar() {
+		i = 0
+	} else if nulls.Contains(msg.Nsp, uint64(i)) {
+		return defaultAssertMessage
+	}
+	return string(msg.Col.(*types.Bytes).Get(int64(i)))
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_Assert(t *testing.T) {
+	convey.Convey("Test Assert", t, func() {
+		proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(100000), Limit: 100000}))
+		msg := testutil.MakeScalarVarchar("a must be positive", 4)
+
+		// the NULL conditions do not fail
+		cond := testutil.MakeBoolVector([]bool{true, false, true, true})
+		nulls.Add(cond.Nsp, 1)
+		vec, err := Assert([]*vector.Vector{cond, msg}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.Col.([]bool), convey.ShouldResemble, []bool{true, true, true, true})
+		convey.So(nulls.Contains(vec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(nulls.Contains(vec.Nsp, 0), convey.ShouldBeFalse)
+
+		cond = testutil.MakeBoolVector([]bool{true, false, true, true})
+		_, err = Assert([]*vector.Vector{cond, msg}, proc)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "a must be positive")
+
+		// the message of the failed row
+		msgs := testutil.MakeVarcharVector([]string{"row 0", "row 1", "row 2"}, nil)
+		_, err = Assert([]*vector.Vector{testutil.MakeBoolVector([]bool{true, true, false}), msgs}, proc)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "row 2")
+
+		_, err = Assert([]*vector.Vector{testutil.MakeScalarBool(false, 3), testutil.MakeScalarNull(3)}, proc)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, defaultAssertMessage)
+
+		vec, err = Assert([]*vector.Vector{testutil.MakeScalarBool(true, 3), msg}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.IsScalar(), convey.ShouldBeTrue)
+	})
+}
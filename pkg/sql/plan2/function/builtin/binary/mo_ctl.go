@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// MoCtl runs the admin command of mo_ctl(cmd, arg) on the storage by the
+// session and returns its result. The command and its argument must be
+// constants, so the command runs once per statement.
+func MoCtl(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	cmd, arg := vecs[0], vecs[1]
+	if proc.SessionInfo.Ctl == nil {
+		return nil, errors.New(errno.InsufficientPrivilege, "mo_ctl is only allowed to root")
+	}
+	if !cmd.IsScalar() || !arg.IsScalar() {
+		return nil, errors.New(errno.DataException, "the arguments of mo_ctl must be constants")
+	}
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if cmd.IsScalarNull() || arg.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	result, err := proc.SessionInfo.Ctl(string(cmd.Col.(*types.Bytes).Get(0)), string(arg.Col.(*types.Bytes).Get(0)))
+	if err != nil {
+		return nil, err
+	}
+	resultVector := vector.NewConst(resultType)
+	vector.SetCol(resultVector, &types.Bytes{
+		Data:    []byte(result),
+		Offsets: []uint32{0},
+		Lengths: []uint32{uint32(len(result))},
+	})
+	return resultVector, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// maxFsp is the max precision of the fractional seconds
+const maxFsp = 6
+
+// CurrentTimestamp returns the start time of the statement in the local time
+// zone, so the values are the same within a statement. The optional argument
+// is the precision of the fractional seconds.
+func CurrentTimestamp(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	fsp := int64(0)
+	if len(vs) > 0 {
+		if !vs[0].IsScalar() || vs[0].ConstVectorIsNull() {
+			return nil, errors.New(errno.DataException, "the precision of current_timestamp must be a constant")
+		}
+		fsp = vs[0].Col.([]int64)[0]
+		if fsp < 0 || fsp > maxFsp {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("too big precision %d specified for 'current_timestamp', maximum is %d", fsp, maxFsp))
+		}
+	}
+	resultType := types.Type{Oid: types.T_datetime, Size: 8, Precision: int32(fsp)}
+	resultVector := vector.NewConst(resultType)
+	vector.SetCol(resultVector, []types.Datetime{statementTime(proc, fsp)})
+	return resultVector, nil
+}
+
+// statementTime returns the start time of the statement truncated to fsp
+// digits of the fractional seconds
+func statementTime(proc *process.Process, fsp int64) types.Datetime {
+	t := time.Now()
+	if proc.UnixTime != 0 {
+		t = time.Unix(0, proc.UnixTime)
+	}
+	usec := t.Nanosecond() / 1000
+	for i := fsp; i < maxFsp; i++ {
+		usec /= 10
+	}
+	for i := fsp; i < maxFsp; i++ {
+		usec *= 10
+	}
+	return types.FromClock(int32(t.Year()), uint8(t.Month()), uint8(t.Day()), uint8(t.Hour()), uint8(t.Minute()), uint8(t.Second()), uint32(usec))
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_CurrentTimestamp(t *testing.T) {
+	convey.Convey("Test current_timestamp succ", t, func() {
+		start := time.Date(2022, 6, 1, 10, 20, 30, 123456789, time.Local)
+		proc := &process.Process{UnixTime: start.UnixNano()}
+
+		vec, err := CurrentTimestamp(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(vec.Col.([]types.Datetime)[0].String(), convey.ShouldEqual, "2022-06-01 10:20:30")
+
+		// the value is the same within the statement
+		again, err := CurrentTimestamp(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(again.Col, convey.ShouldResemble, vec.Col)
+
+		fsp := vector.NewConst(types.Type{Oid: types.T_int64, Size: 8})
+		vector.SetCol(fsp, []int64{3})
+		vec, err = CurrentTimestamp([]*vector.Vector{fsp}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.Col.([]types.Datetime)[0].String(), convey.ShouldEqual, "2022-06-01 10:20:30.123000")
+	})
+	convey.Convey("Test current_timestamp err", t, func() {
+		fsp := vector.NewConst(types.Type{Oid: types.T_int64, Size: 8})
+		vector.SetCol(fsp, []int64{7})
+		_, err := CurrentTimestamp([]*vector.Vector{fsp}, &process.Process{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -23,95 +23,96 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+// dateStringSize is the length of the longest string of a date or a datetime
+const dateStringSize = 26
+
 func DateAdd(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	firstVector := vectors[0]
-	secondVector := vectors[1]
-	thirdVector := vectors[2]
-	firstValues, secondValues, thirdValues := firstVector.Col.([]types.Date), secondVector.Col.([]int64), thirdVector.Col.([]int64)
-	resultType := types.Type{Oid: types.T_date, Size: 4}
-	resultElementSize := int(resultType.Size)
-	if firstVector.IsScalar() {
-		if firstVector.ConstVectorIsNull() {
-			return proc.AllocScalarNullVector(resultType), nil
-		}
-		resultVector := vector.NewConst(resultType)
-		resultValues := make([]types.Date, 1)
-		vector.SetCol(resultVector, date_add.DateAdd(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	} else {
-		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(firstValues)))
-		if err != nil {
-			return nil, err
-		}
-		resultValues := encoding.DecodeDateSlice(resultVector.Data)
-		resultValues = resultValues[:len(firstValues)]
-		nulls.Set(resultVector.Nsp, firstVector.Nsp)
-		vector.SetCol(resultVector, date_add.DateAdd(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	}
+	return dateInterval(vectors, proc, types.Type{Oid: types.T_date, Size: 4}, date_add.DateAdd)
 }
 
 func DatetimeAdd(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	firstVector := vectors[0]
-	secondVector := vectors[1]
-	thirdVector := vectors[2]
-	firstValues, secondValues, thirdValues := firstVector.Col.([]types.Datetime), secondVector.Col.([]int64), thirdVector.Col.([]int64)
-	resultType := types.Type{Oid: types.T_datetime, Size: 8}
-	resultElementSize := int(resultType.Size)
-	if firstVector.IsScalar() {
-		if firstVector.ConstVectorIsNull() {
-			return proc.AllocScalarNullVector(resultType), nil
-		}
-		resultVector := vector.NewConst(resultType)
-		resultValues := make([]types.Datetime, 1)
-		vector.SetCol(resultVector, date_add.DatetimeAdd(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	} else {
-		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(firstValues)))
-		if err != nil {
-			return nil, err
-		}
-		resultValues := encoding.DecodeDatetimeSlice(resultVector.Data)
-		resultValues = resultValues[:len(firstValues)]
-		nulls.Set(resultVector.Nsp, firstVector.Nsp)
-		vector.SetCol(resultVector, date_add.DatetimeAdd(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	}
+	return dateInterval(vectors, proc, types.Type{Oid: types.T_datetime, Size: 8}, date_add.DatetimeAdd)
 }
 
 func DateStringAdd(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	firstVector := vectors[0]
-	secondVector := vectors[1]
-	thirdVector := vectors[2]
-	firstValues, secondValues, thirdValues := firstVector.Col.(*types.Bytes), secondVector.Col.([]int64), thirdVector.Col.([]int64)
-	resultType := types.Type{Oid: types.T_varchar, Size: 26}
-	resultElementSize := int(resultType.Size)
-	if firstVector.IsScalar() {
-		if firstVector.ConstVectorIsNull() {
-			return proc.AllocScalarNullVector(resultType), nil
-		}
-		resultVector := vector.NewConst(resultType)
-		resultValues := &types.Bytes{
-			Data:    make([]byte, 0),
-			Offsets: make([]uint32, 0),
-			Lengths: make([]uint32, 0),
-		}
-		vector.SetCol(resultVector, date_add.DateStringAdd(firstValues, secondValues, thirdValues, resultVector.Nsp, resultValues))
-		return resultVector, nil
-	} else {
-		// 26 is max string generated by date/datetime
-		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(firstValues.Lengths)))
-		if err != nil {
-			return nil, err
+	return dateStringInterval(vectors, proc, date_add.DateStringAdd)
+}
+
+func TimeAdd(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: vectors[0].Typ.Precision}
+	return dateInterval(vectors, proc, resultType, date_add.TimeAdd)
+}
+
+// dateInterval runs fn, a kernel of date_add or date_sub, on the dates or
+// times of vecs[0] and the intervals of the numbers of vecs[1] and the units
+// of vecs[2]. Each of them can be a constant, and the result is a constant if
+// all of them are.
+func dateInterval[T types.Date | types.Datetime | types.Time](vecs []*vector.Vector, proc *process.Process, resultType types.Type,
+	fn func([]T, []int64, []int64, []bool, *nulls.Nulls, []T) []T) (*vector.Vector, error) {
+	isConst, nsp, ok := intervalArgs(vecs)
+	if !ok {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	xs, ys, zs := vecs[0].Col.([]T), vecs[1].Col.([]int64), vecs[2].Col.([]int64)
+	if isConst[0] && isConst[1] && isConst[2] {
+		vec := proc.AllocScalarVector(resultType)
+		vec.Nsp = nsp
+		vector.SetCol(vec, fn(xs, ys, zs, isConst, nsp, make([]T, 1)))
+		return vec, nil
+	}
+	rows := date_add.Rows([]int{len(xs), len(ys), len(zs)}, isConst)
+	vec, err := proc.AllocVector(resultType, int64(rows)*int64(resultType.Size))
+	if err != nil {
+		return nil, err
+	}
+	vec.Nsp = nsp
+	rs := encoding.DecodeFixedSlice[T](vec.Data, int(resultType.Size))[:rows]
+	vector.SetCol(vec, fn(xs, ys, zs, isConst, nsp, rs))
+	return vec, nil
+}
+
+// dateStringInterval is dateInterval of the strings of dates or datetimes
+func dateStringInterval(vecs []*vector.Vector, proc *process.Process,
+	fn func(*types.Bytes, []int64, []int64, []bool, *nulls.Nulls, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: dateStringSize}
+	isConst, nsp, ok := intervalArgs(vecs)
+	if !ok {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	xs, ys, zs := vecs[0].Col.(*types.Bytes), vecs[1].Col.([]int64), vecs[2].Col.([]int64)
+	if isConst[0] && isConst[1] && isConst[2] {
+		vec := proc.AllocScalarVector(resultType)
+		vec.Nsp = nsp
+		vector.SetCol(vec, fn(xs, ys, zs, isConst, nsp, &types.Bytes{}))
+		return vec, nil
+	}
+	rows := date_add.Rows([]int{len(xs.Lengths), len(ys), len(zs)}, isConst)
+	vec, err := proc.AllocVector(resultType, int64(rows*dateStringSize))
+	if err != nil {
+		return nil, err
+	}
+	vec.Nsp = nsp
+	rs := &types.Bytes{
+		Data:    vec.Data[:0],
+		Offsets: make([]uint32, 0, rows),
+		Lengths: make([]uint32, 0, rows),
+	}
+	vector.SetCol(vec, fn(xs, ys, zs, isConst, nsp, rs))
+	return vec, nil
+}
+
+// intervalArgs returns which of vecs are constants and the nulls of the rows
+// that have a null argument. ok is false if any of vecs is a constant null.
+func intervalArgs(vecs []*vector.Vector) (isConst []bool, nsp *nulls.Nulls, ok bool) {
+	isConst = make([]bool, len(vecs))
+	nsp = new(nulls.Nulls)
+	for i, vec := range vecs {
+		if vec.IsScalarNull() {
+			return nil, nil, false
 		}
-		resultValues := &types.Bytes{
-			Data:    resultVector.Data,
-			Offsets: make([]uint32, 0),
-			Lengths: make([]uint32, 0),
+		if isConst[i] = vec.IsScalar(); !isConst[i] {
+			nulls.Or(nsp, vec.Nsp, nsp)
 		}
-
-		nulls.Set(resultVector.Nsp, firstVector.Nsp)
-		vector.SetCol(resultVector, date_add.DateStringAdd(firstValues, secondValues, thirdValues, resultVector.Nsp, resultValues))
-		return resultVector, nil
 	}
+	return isConst, nsp, true
 }
@@ -15,103 +15,25 @@
 package multi
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/date_sub"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 func DateSub(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	firstVector := vectors[0]
-	secondVector := vectors[1]
-	thirdVector := vectors[2]
-	firstValues, secondValues, thirdValues := firstVector.Col.([]types.Date), secondVector.Col.([]int64), thirdVector.Col.([]int64)
-	resultType := types.Type{Oid: types.T_date, Size: 4}
-	resultElementSize := int(resultType.Size)
-	if firstVector.IsScalar() {
-		if firstVector.ConstVectorIsNull() {
-			return proc.AllocScalarNullVector(resultType), nil
-		}
-		resultVector := vector.NewConst(resultType)
-		resultValues := make([]types.Date, 1)
-		vector.SetCol(resultVector, date_sub.DateSub(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	} else {
-		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(firstValues)))
-		if err != nil {
-			return nil, err
-		}
-		resultValues := encoding.DecodeDateSlice(resultVector.Data)
-		resultValues = resultValues[:len(firstValues)]
-		nulls.Set(resultVector.Nsp, firstVector.Nsp)
-		vector.SetCol(resultVector, date_sub.DateSub(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	}
+	return dateInterval(vectors, proc, types.Type{Oid: types.T_date, Size: 4}, date_sub.DateSub)
 }
 
 func DatetimeSub(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	firstVector := vectors[0]
-	secondVector := vectors[1]
-	thirdVector := vectors[2]
-	firstValues, secondValues, thirdValues := firstVector.Col.([]types.Datetime), secondVector.Col.([]int64), thirdVector.Col.([]int64)
-	resultType := types.Type{Oid: types.T_datetime, Size: 8}
-	resultElementSize := int(resultType.Size)
-	if firstVector.IsScalar() {
-		if firstVector.ConstVectorIsNull() {
-			return proc.AllocScalarNullVector(resultType), nil
-		}
-		resultVector := vector.NewConst(resultType)
-		resultValues := make([]types.Datetime, 1)
-		vector.SetCol(resultVector, date_sub.DatetimeSub(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	} else {
-		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(firstValues)))
-		if err != nil {
-			return nil, err
-		}
-		resultValues := encoding.DecodeDatetimeSlice(resultVector.Data)
-		resultValues = resultValues[:len(firstValues)]
-		nulls.Set(resultVector.Nsp, firstVector.Nsp)
-		vector.SetCol(resultVector, date_sub.DatetimeSub(firstValues, secondValues, thirdValues, resultValues))
-		return resultVector, nil
-	}
+	return dateInterval(vectors, proc, types.Type{Oid: types.T_datetime, Size: 8}, date_sub.DatetimeSub)
 }
 
 func DateStringSub(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	firstVector := vectors[0]
-	secondVector := vectors[1]
-	thirdVector := vectors[2]
-	firstValues, secondValues, thirdValues := firstVector.Col.(*types.Bytes), secondVector.Col.([]int64), thirdVector.Col.([]int64)
-	resultType := types.Type{Oid: types.T_varchar, Size: 26}
-	resultElementSize := int(resultType.Size)
-	if firstVector.IsScalar() {
-		if firstVector.ConstVectorIsNull() {
-			return proc.AllocScalarNullVector(resultType), nil
-		}
-		resultVector := vector.NewConst(resultType)
-		resultValues := &types.Bytes{
-			Data:    make([]byte, 0),
-			Offsets: make([]uint32, 0),
-			Lengths: make([]uint32, 0),
-		}
-		vector.SetCol(resultVector, date_sub.DateStringSub(firstValues, secondValues, thirdValues, resultVector.Nsp, resultValues))
-		return resultVector, nil
-	} else {
-		// 26 is max string generated by date/datetime
-		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(firstValues.Lengths)))
-		if err != nil {
-			return nil, err
-		}
-		resultValues := &types.Bytes{
-			Data:    resultVector.Data,
-			Offsets: make([]uint32, 0),
-			Lengths: make([]uint32, 0),
-		}
+	return dateStringInterval(vectors, proc, date_sub.DateStringSub)
+}
 
-		nulls.Set(resultVector.Nsp, firstVector.Nsp)
-		vector.SetCol(resultVector, date_sub.DateStringSub(firstValues, secondValues, thirdValues, resultVector.Nsp, resultValues))
-		return resultVector, nil
-	}
+func TimeSub(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: vectors[0].Typ.Precision}
+	return dateInterval(vectors, proc, resultType, date_sub.TimeSub)
 }
@@ -19,6 +19,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -225,3 +227,21 @@ func makeDateStringSubVectors(str string, isConst bool, num int64, unit types.In
 	}
 	return vec
 }
+
+func TestDatetimeSubIntervalVector(t *testing.T) {
+	// a constant datetime and the intervals of the rows, the null interval and
+	// the datetime before the year 1 give nulls
+	vecs := makeDatetimeSubVectors("0001-01-02 10:00:00", true, 0, types.Hour)
+	vecs[1] = &vector.Vector{
+		Col:    []int64{-2, 0, 40},
+		Nsp:    &nulls.Nulls{},
+		Typ:    types.Type{Oid: types.T_int64},
+		Length: 3,
+	}
+	nulls.Add(vecs[1].Nsp, 1)
+	vec, err := DatetimeSub(vecs, process.New(mheap.New(&guest.Mmu{Mmu: host.New(100000), Limit: 100000})))
+	require.NoError(t, err)
+	require.False(t, vec.IsScalar())
+	require.Equal(t, "0001-01-02 12:00:00", vec.Col.([]types.Datetime)[0].String())
+	require.Equal(t, []uint64{1, 2}, vec.Nsp.Np.ToArray())
+}
@@ -1,4 +1,4 @@
-// Copyright 2021 - 2022 Matrix Origin
+// Copyright 2022 Matrix Origin
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -15,7 +15,6 @@
 package multi
 
 import (
-	"errors"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -23,53 +22,69 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-const UINT16_MAX = ^uint16(0)
+type padFunc func(*types.Bytes, *types.Bytes, []int64, *types.Bytes, []bool, *nulls.Nulls) *types.Bytes
 
+type padLengthFunc func(*types.Bytes, []int64, *types.Bytes, []bool, *nulls.Nulls) int64
+
+// Lpad is lpad(str, len, padstr), which pads str on the left with padstr to
+// len runes
 func Lpad(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return pad(vecs, proc, lpad.Lpad, lpad.LpadLength)
+}
+
+// pad evaluates lpad or rpad, any of the arguments can be a constant. The
+// result is null if an argument is null or the length is negative.
+func pad(vecs []*vector.Vector, proc *process.Process, fn padFunc, lengthFn padLengthFunc) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
 	if vecs[0].IsScalarNull() || vecs[1].IsScalarNull() || vecs[2].IsScalarNull() {
-		return proc.AllocScalarNullVector(vecs[0].Typ), nil
+		return proc.AllocScalarNullVector(resultType), nil
 	}
-	vs := vecs[0].Col.(*types.Bytes) //Get the first arg
-	if !vecs[1].IsScalar() && vecs[1].Typ.Oid != types.T_int64 {
-		return nil, errors.New("The second argument of the lpad function must be an int64 constant")
+	isConst := []bool{vecs[0].IsScalar(), vecs[1].IsScalar(), vecs[2].IsScalar()}
+	strs := vecs[0].Col.(*types.Bytes)
+	pads, err := lpad.Pads(vecs[2].Col, isConst)
+	if err != nil {
+		return nil, err
 	}
-	if !vecs[2].IsScalar() && vecs[2].Typ.Oid != types.T_varchar {
-		return nil, errors.New("The third argument of the lpad function must be an string constant")
+	nsp := new(nulls.Nulls)
+	sizes, err := lpad.Sizes(vecs[1].Col)
+	if err != nil {
+		return nil, err
 	}
-
-	length := vecs[1].Col.([]int64)
-	padds := vecs[2].Col.(*types.Bytes)
-
-	if vecs[0].IsScalar() {
-		if length[0] < 0 || length[0] > int64(UINT16_MAX) {
-			return proc.AllocScalarNullVector(types.Type{Oid: types.T_varchar, Size: 24}), nil
-		} else {
-			resultVec := proc.AllocScalarVector(types.Type{Oid: types.T_varchar, Size: 24})
-			results := &types.Bytes{
-				Data:    make([]byte, length[0]),
-				Offsets: make([]uint32, 1),
-				Lengths: make([]uint32, 1),
-			}
-			nulls.Set(resultVec.Nsp, vecs[0].Nsp)
-			vector.SetCol(resultVec, lpad.Lpad(results, vs, uint32(length[0]), padds))
-			return resultVec, nil
-		}
+	if sizes == nil {
+		// a non numerical length makes empty strings
+		sizes, isConst[1] = []int64{0}, true
+		nulls.Set(nsp, vecs[0].Nsp)
 	} else {
-		if length[0] < 0 || length[0] > int64(UINT16_MAX) {
-			return proc.AllocScalarNullVector(types.Type{Oid: types.T_varchar, Size: 24}), nil
-		} else {
-			resultVec, err := proc.AllocVector(types.Type{Oid: types.T_varchar, Size: 24}, int64(len(vs.Lengths))*length[0])
-			if err != nil {
-				return nil, err
+		for i, vec := range vecs {
+			if !isConst[i] {
+				nulls.Or(nsp, vec.Nsp, nsp)
 			}
-			results := &types.Bytes{
-				Data:    resultVec.Data,
-				Offsets: make([]uint32, len(vs.Offsets)),
-				Lengths: make([]uint32, len(vs.Lengths)),
-			}
-			nulls.Set(resultVec.Nsp, vecs[0].Nsp)
-			vector.SetCol(resultVec, lpad.Lpad(results, vs, uint32(length[0]), padds))
-			return resultVec, nil
 		}
 	}
+
+	if isConst[0] && isConst[1] && isConst[2] {
+		res := &types.Bytes{
+			Data: make([]byte, 0, lengthFn(strs, sizes, pads, isConst, nsp)),
+		}
+		res = fn(res, strs, sizes, pads, isConst, nsp)
+		if nulls.Any(nsp) {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		vec := proc.AllocScalarVector(resultType)
+		vector.SetCol(vec, res)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(resultType, lengthFn(strs, sizes, pads, isConst, nsp))
+	if err != nil {
+		return nil, err
+	}
+	rows := lpad.Rows(strs, sizes, pads, isConst)
+	res := &types.Bytes{
+		Data:    vec.Data[:0],
+		Offsets: make([]uint32, 0, rows),
+		Lengths: make([]uint32, 0, rows),
+	}
+	vec.Nsp = nsp
+	vector.SetCol(vec, fn(res, strs, sizes, pads, isConst, nsp))
+	return vec, nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
@@ -81,6 +82,77 @@ func TestLpadVarchar(t *testing.T) {
 
 }
 
+func TestPadVectors(t *testing.T) {
+	proc := makeProcess()
+	cases := []struct {
+		name  string
+		vecs  []*vector.Vector
+		lpad  []string
+		rpad  []string
+		nulls []uint64
+	}{
+		{
+			name: "vector length",
+			vecs: []*vector.Vector{
+				testutil.MakeScalarVarchar("中文", 4),
+				testutil.MakeInt64Vector([]int64{1, 3, -1, 5}, nil),
+				testutil.MakeScalarVarchar("é", 4),
+			},
+			lpad:  []string{"中", "é中文", "", "ééé中文"},
+			rpad:  []string{"中", "中文é", "", "中文ééé"},
+			nulls: []uint64{2},
+		},
+		{
+			name: "vector pad",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"12", "ab", "x", "abcdef"}, []uint64{2}),
+				testutil.MakeScalarInt64(5, 4),
+				testutil.MakeVarcharVector([]string{"0", "你好", "y", ""}, nil),
+			},
+			lpad:  []string{"00012", "你好你ab", "", "abcde"},
+			rpad:  []string{"12000", "ab你好你", "", "abcde"},
+			nulls: []uint64{2},
+		},
+		{
+			name: "all vectors",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"naïve", "a", "b"}, nil),
+				testutil.MakeUint64Vector([]uint64{7, 70000, 2}, nil),
+				testutil.MakeVarcharVector([]string{"🙂", "x", "ö"}, []uint64{2}),
+			},
+			lpad:  []string{"🙂🙂naïve", "", ""},
+			rpad:  []string{"naïve🙂🙂", "", ""},
+			nulls: []uint64{1, 2},
+		},
+	}
+	for _, c := range cases {
+		for _, fn := range []struct {
+			f    func([]*vector.Vector, *process.Process) (*vector.Vector, error)
+			want []string
+		}{{Lpad, c.lpad}, {Rpad, c.rpad}} {
+			before := mheap.Size(proc.Mp)
+			vec, err := fn.f(c.vecs, proc)
+			require.NoError(t, err, c.name)
+			res := vec.Col.(*types.Bytes)
+			// the data of the result is allocated by the process
+			require.Equal(t, int64(len(res.Data)), mheap.Size(proc.Mp)-before, c.name)
+			for i, want := range fn.want {
+				require.Equal(t, want, string(res.Get(int64(i))), c.name)
+			}
+			require.Equal(t, c.nulls, vec.Nsp.Np.ToArray(), c.name)
+			process.Put(proc, vec)
+		}
+	}
+
+	vec, err := Lpad([]*vector.Vector{testutil.MakeScalarVarchar("ab", 1), testutil.MakeScalarInt64(5, 1), testutil.MakeScalarVarchar("é", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, "éééab", string(vec.Col.(*types.Bytes).Get(0)))
+	vec, err = Rpad([]*vector.Vector{testutil.MakeScalarVarchar("ab", 1), testutil.MakeScalarInt64(-1, 1), testutil.MakeScalarVarchar("é", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+}
+
 func makeLpadVectors(src string, length int64, pad string) []*vector.Vector {
 	vec := make([]*vector.Vector, 3)
 
@@ -15,38 +15,13 @@
 package multi
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
-	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/rpad"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-func Rpad(origVecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	if origVecs[0].IsScalarNull() || origVecs[1].IsScalarNull() || origVecs[2].IsScalarNull() {
-		return proc.AllocScalarNullVector(origVecs[0].Typ), nil
-	}
-
-	isConst := []bool{origVecs[0].IsScalar(), origVecs[1].IsScalar(), origVecs[2].IsScalar()}
-
-	// gets all args
-	strs, sizes, padstrs := origVecs[0].Col.(*types.Bytes), origVecs[1].Col, origVecs[2].Col
-	oriNsps := []*nulls.Nulls{origVecs[0].Nsp, origVecs[1].Nsp, origVecs[2].Nsp}
-
-	// gets a new vector to store our result
-	resultVec, err := proc.AllocVector(origVecs[0].Typ, 24*int64(len(strs.Lengths)))
-	if err != nil {
-		return nil, err
-	}
-	if origVecs[0].IsScalar() && origVecs[1].IsScalar() && origVecs[2].IsScalar() {
-		resultVec.IsConst = true
-	}
-
-	result, nsp, err := rpad.Rpad(strs, sizes, padstrs, isConst, oriNsps)
-	if err != nil {
-		return nil, err
-	}
-	resultVec.Nsp = nsp
-	vector.SetCol(resultVec, result)
-	return resultVec, nil
+// Rpad is rpad(str, len, padstr), which pads str on the right with padstr to
+// len runes
+func Rpad(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return pad(vecs, proc, rpad.Rpad, rpad.RpadLength)
 }
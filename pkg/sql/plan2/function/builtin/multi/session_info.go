@@ -0,0 +1,61 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Database returns the current database of the session, or NULL if no
+// database is selected
+func Database(_ []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if proc.SessionInfo.Database == "" {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	return newConstString(resultType, proc.SessionInfo.Database), nil
+}
+
+// User returns the name of the authenticated user of the session
+func User(_ []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	return newConstString(resultType, proc.SessionInfo.User), nil
+}
+
+// Version returns the version of the server
+func Version(_ []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	return newConstString(resultType, proc.SessionInfo.Version), nil
+}
+
+// ConnectionID returns the id of the connection of the session
+func ConnectionID(_ []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_uint64, Size: 8}
+	resultVector := vector.NewConst(resultType)
+	vector.SetCol(resultVector, []uint64{proc.SessionInfo.ConnectionID})
+	return resultVector, nil
+}
+
+func newConstString(typ types.Type, s string) *vector.Vector {
+	resultVector := vector.NewConst(typ)
+	vector.SetCol(resultVector, &types.Bytes{
+		Data:    []byte(s),
+		Offsets: []uint32{0},
+		Lengths: []uint32{uint32(len(s))},
+	})
+	return resultVector
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_SessionInfo(t *testing.T) {
+	convey.Convey("Test session info functions succ", t, func() {
+		proc := &process.Process{SessionInfo: process.SessionInfo{
+			User:         "root",
+			Version:      "0.5.0-log",
+			ConnectionID: 42,
+		}}
+		vec, err := Database(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(vec.ConstVectorIsNull(), convey.ShouldBeTrue)
+
+		proc.SessionInfo.Database = "db1"
+		vec, err = Database(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.ConstVectorIsNull(), convey.ShouldBeFalse)
+		convey.So(string(vec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "db1")
+
+		vec, err = User(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(vec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "root")
+
+		vec, err = Version(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(vec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "0.5.0-log")
+
+		vec, err = ConnectionID(nil, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vec.Typ.Oid, convey.ShouldEqual, types.T_uint64)
+		convey.So(vec.Col.([]uint64), convey.ShouldResemble, []uint64{42})
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/substringindex"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// SubstringIndex is substring_index(str, delim, count), which returns the
+// substring of str before count occurrences of delim, counted from the right
+// if count is negative. Any of the arguments can be a constant, the result is
+// null if an argument is null.
+func SubstringIndex(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: vecs[0].Typ.Oid, Size: 24}
+	if vecs[0].IsScalarNull() || vecs[1].IsScalarNull() || vecs[2].IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	isConst := []bool{vecs[0].IsScalar(), vecs[1].IsScalar(), vecs[2].IsScalar()}
+	strs, delims := vecs[0].Col.(*types.Bytes), vecs[1].Col.(*types.Bytes)
+	counts := substringindex.Counts(vecs[2].Col)
+	nsp := new(nulls.Nulls)
+	for i, vec := range vecs {
+		if !isConst[i] {
+			nulls.Or(nsp, vec.Nsp, nsp)
+		}
+	}
+
+	length := substringindex.SubstringIndexLength(strs, delims, counts, isConst, nsp)
+	if isConst[0] && isConst[1] && isConst[2] {
+		res := &types.Bytes{
+			Data: make([]byte, 0, length),
+		}
+		vec := proc.AllocScalarVector(resultType)
+		vector.SetCol(vec, substringindex.SubstringIndex(res, strs, delims, counts, isConst, nsp))
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(resultType, length)
+	if err != nil {
+		return nil, err
+	}
+	rows := substringindex.Rows(strs, delims, counts, isConst)
+	res := &types.Bytes{
+		Data:    vec.Data[:0],
+		Offsets: make([]uint32, 0, rows),
+		Lengths: make([]uint32, 0, rows),
+	}
+	vec.Nsp = nsp
+	vector.SetCol(vec, substringindex.SubstringIndex(res, strs, delims, counts, isConst, nsp))
+	return vec, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstringIndex(t *testing.T) {
+	proc := makeProcess()
+	cases := []struct {
+		name  string
+		vecs  []*vector.Vector
+		want  []string
+		nulls []uint64
+	}{
+		{
+			name: "vector string",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"www.mysql.com", "a.b", "", "x"}, []uint64{3}),
+				testutil.MakeScalarVarchar(".", 4),
+				testutil.MakeScalarInt64(-2, 4),
+			},
+			want:  []string{"mysql.com", "a.b", "", ""},
+			nulls: []uint64{3},
+		},
+		{
+			name: "vector delimiter",
+			vecs: []*vector.Vector{
+				testutil.MakeScalarVarchar("k1=>v1;;k2=>v2", 4),
+				testutil.MakeVarcharVector([]string{"=>", ";;", "", "=>"}, []uint64{3}),
+				testutil.MakeScalarInt64(1, 4),
+			},
+			want:  []string{"k1", "k1=>v1", "", ""},
+			nulls: []uint64{3},
+		},
+		{
+			name: "vector count",
+			vecs: []*vector.Vector{
+				testutil.MakeScalarVarchar("中文、字符、测试", 5),
+				testutil.MakeScalarVarchar("、", 5),
+				testutil.MakeInt64Vector([]int64{1, 2, 3, -1, -5}, []uint64{2}),
+			},
+			want:  []string{"中文", "中文、字符", "", "测试", "中文、字符、测试"},
+			nulls: []uint64{2},
+		},
+		{
+			name: "unsigned count",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"a/b/c", "a/b/c", "a/b/c"}, nil),
+				testutil.MakeScalarVarchar("/", 3),
+				testutil.MakeUint64Vector([]uint64{1, 2, 1 << 63}, nil),
+			},
+			want: []string{"a", "a/b", "a/b/c"},
+		},
+		{
+			name: "all vectors",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"a--b--c", "a.b", "x|y|z"}, []uint64{1}),
+				testutil.MakeVarcharVector([]string{"--", ".", "|"}, nil),
+				testutil.MakeInt64Vector([]int64{-1, 5, 4}, []uint64{2}),
+			},
+			want:  []string{"c", "", ""},
+			nulls: []uint64{1, 2},
+		},
+	}
+	for _, c := range cases {
+		before := mheap.Size(proc.Mp)
+		vec, err := SubstringIndex(c.vecs, proc)
+		require.NoError(t, err, c.name)
+		res := vec.Col.(*types.Bytes)
+		// the data of the result is allocated at once by the process
+		require.Equal(t, int64(len(res.Data)), mheap.Size(proc.Mp)-before, c.name)
+		require.Equal(t, len(res.Data), cap(res.Data), c.name)
+		require.Equal(t, len(c.want), len(res.Lengths), c.name)
+		for i, want := range c.want {
+			require.Equal(t, want, string(res.Get(int64(i))), c.name)
+		}
+		if c.nulls == nil {
+			require.False(t, nulls.Any(vec.Nsp), c.name)
+		} else {
+			require.Equal(t, c.nulls, vec.Nsp.Np.ToArray(), c.name)
+		}
+		process.Put(proc, vec)
+	}
+
+	// constants
+	vec, err := SubstringIndex([]*vector.Vector{testutil.MakeScalarVarchar("www.mysql.com", 1), testutil.MakeScalarVarchar(".", 1), testutil.MakeScalarInt64(2, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, "www.mysql", string(vec.Col.(*types.Bytes).Get(0)))
+	vec, err = SubstringIndex([]*vector.Vector{testutil.MakeScalarVarchar("www.mysql.com", 1), testutil.MakeScalarVarchar("", 1), testutil.MakeScalarInt64(2, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, "", string(vec.Col.(*types.Bytes).Get(0)))
+	for i := 0; i < 3; i++ {
+		vecs := []*vector.Vector{testutil.MakeVarcharVector([]string{"a.b"}, nil), testutil.MakeScalarVarchar(".", 1), testutil.MakeScalarInt64(1, 1)}
+		vecs[i] = testutil.MakeScalarNull(1)
+		vec, err = SubstringIndex(vecs, proc)
+		require.NoError(t, err)
+		require.True(t, vec.IsScalarNull())
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Trim is trim(remstr, str, mode), the "trim([{both | leading | trailing}
+// [remstr] from] str)" of the parser, which removes all the occurrences of
+// remstr from the ends of str told by the constant mode. remstr and str can
+// be constants, the result is null if either of them is null.
+func Trim(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: vecs[1].Typ.Oid, Size: 24}
+	if vecs[0].IsScalarNull() || vecs[1].IsScalarNull() || vecs[2].IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	isConst := []bool{vecs[0].IsScalar(), vecs[1].IsScalar()}
+	remstrs, strs := vecs[0].Col.(*types.Bytes), vecs[1].Col.(*types.Bytes)
+	mode := vecs[2].Col.([]int64)[0]
+	nsp := new(nulls.Nulls)
+	for i, isConst := range isConst {
+		if !isConst {
+			nulls.Or(nsp, vecs[i].Nsp, nsp)
+		}
+	}
+
+	length := trim.TrimLength(remstrs, strs, mode, isConst, nsp)
+	if isConst[0] && isConst[1] {
+		res := &types.Bytes{
+			Data: make([]byte, 0, length),
+		}
+		vec := proc.AllocScalarVector(resultType)
+		vector.SetCol(vec, trim.Trim(res, remstrs, strs, mode, isConst, nsp))
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(resultType, length)
+	if err != nil {
+		return nil, err
+	}
+	rows := trim.Rows(remstrs, strs, isConst)
+	res := &types.Bytes{
+		Data:    vec.Data[:0],
+		Offsets: make([]uint32, 0, rows),
+		Lengths: make([]uint32, 0, rows),
+	}
+	vec.Nsp = nsp
+	vector.SetCol(vec, trim.Trim(res, remstrs, strs, mode, isConst, nsp))
+	return vec, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrim(t *testing.T) {
+	proc := makeProcess()
+	cases := []struct {
+		name  string
+		vecs  []*vector.Vector
+		want  []string
+		nulls []uint64
+	}{
+		{
+			name: "vector string",
+			vecs: []*vector.Vector{
+				testutil.MakeScalarVarchar("中", 4),
+				testutil.MakeVarcharVector([]string{"中中文中", "中", "", "x"}, []uint64{3}),
+				testutil.MakeScalarInt64(trim.Both, 4),
+			},
+			want:  []string{"文", "", "", ""},
+			nulls: []uint64{3},
+		},
+		{
+			name: "vector remstr",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"ab", "ｱｲ", "", "b"}, []uint64{0}),
+				testutil.MakeScalarVarchar("ｱｲｱｲabｳab", 4),
+				testutil.MakeScalarInt64(trim.Leading, 4),
+			},
+			want:  []string{"", "abｳab", "ｱｲｱｲabｳab", "ｱｲｱｲabｳab"},
+			nulls: []uint64{0},
+		},
+		{
+			name: "all vectors",
+			vecs: []*vector.Vector{
+				testutil.MakeVarcharVector([]string{"　", "xy", "z"}, []uint64{2}),
+				testutil.MakeVarcharVector([]string{"　全角　　", "xyxyaxy", "zaz"}, []uint64{1}),
+				testutil.MakeScalarInt64(trim.Trailing, 3),
+			},
+			want:  []string{"　全角", "", ""},
+			nulls: []uint64{1, 2},
+		},
+		{
+			name: "no nulls",
+			vecs: []*vector.Vector{
+				testutil.MakeScalarVarchar(" ", 3),
+				testutil.MakeVarcharVector([]string{"  a  ", "b", "   "}, nil),
+				testutil.MakeScalarInt64(trim.Both, 3),
+			},
+			want: []string{"a", "b", ""},
+		},
+	}
+	for _, c := range cases {
+		before := mheap.Size(proc.Mp)
+		vec, err := Trim(c.vecs, proc)
+		require.NoError(t, err, c.name)
+		res := vec.Col.(*types.Bytes)
+		// the data of the result is allocated at once by the process
+		require.Equal(t, int64(len(res.Data)), mheap.Size(proc.Mp)-before, c.name)
+		require.Equal(t, len(res.Data), cap(res.Data), c.name)
+		require.Equal(t, len(c.want), len(res.Lengths), c.name)
+		for i, want := range c.want {
+			require.Equal(t, want, string(res.Get(int64(i))), c.name)
+		}
+		if c.nulls == nil {
+			require.False(t, nulls.Any(vec.Nsp), c.name)
+		} else {
+			require.Equal(t, c.nulls, vec.Nsp.Np.ToArray(), c.name)
+		}
+		process.Put(proc, vec)
+	}
+
+	// constants
+	vec, err := Trim([]*vector.Vector{testutil.MakeScalarVarchar("你好", 1), testutil.MakeScalarVarchar("你好世界你好", 1), testutil.MakeScalarInt64(trim.Both, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, "世界", string(vec.Col.(*types.Bytes).Get(0)))
+	vec, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("", 1), testutil.MakeScalarVarchar(" a ", 1), testutil.MakeScalarInt64(trim.Both, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, " a ", string(vec.Col.(*types.Bytes).Get(0)))
+	for i := 0; i < 2; i++ {
+		vecs := []*vector.Vector{testutil.MakeScalarVarchar("a", 1), testutil.MakeVarcharVector([]string{"aba"}, nil), testutil.MakeScalarInt64(trim.Both, 1)}
+		vecs[i] = testutil.MakeScalarNull(1)
+		vec, err = Trim(vecs, proc)
+		require.NoError(t, err)
+		require.True(t, vec.IsScalarNull())
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_UUID(t *testing.T) {
+	convey.Convey("Test uuid succ", t, func() {
+		seen := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			vec, err := UUID(nil, nil)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vec.IsScalar(), convey.ShouldBeTrue)
+			id := string(vec.Col.(*types.Bytes).Get(0))
+			convey.So(len(id), convey.ShouldEqual, 36)
+			convey.So(seen[id], convey.ShouldBeFalse)
+			seen[id] = true
+		}
+	})
+}
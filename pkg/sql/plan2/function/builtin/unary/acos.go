@@ -56,7 +56,7 @@ func Acos[T constraints.Integer | constraints.Float](vs []*vector.Vector, proc *
 		results = results[:len(origVecCol)]
 		resultVector.Col = results
 		res := acos.Acos[T](origVecCol, results)
-		nulls.Set(resultVector.Nsp, origVec.Nsp.Or(res.Nsp))
+		nulls.Or(origVec.Nsp, res.Nsp, resultVector.Nsp)
 		vector.SetCol(resultVector, res.Result)
 		return resultVector, nil
 	}
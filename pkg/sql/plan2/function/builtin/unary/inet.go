@@ -0,0 +1,165 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/inet"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"golang.org/x/exp/constraints"
+)
+
+// InetAton returns the number of an IPv4 address, or null if the address is malformed
+func InetAton(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_uint32, Size: 4}
+	resultElementSize := int(resultType.Size)
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.(*types.Bytes)
+		resultNsp := new(nulls.Nulls)
+		resultValues := inet.InetAton(inputValues, make([]uint32, 1), resultNsp)
+		if nulls.Any(resultNsp) {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultVector := vector.NewConst(resultType)
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	} else {
+		inputValues := inputVector.Col.(*types.Bytes)
+		resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*len(inputValues.Lengths)))
+		if err != nil {
+			return nil, err
+		}
+		resultValues := encoding.DecodeUint32Slice(resultVector.Data)
+		resultValues = resultValues[:len(inputValues.Lengths)]
+		nulls.Set(resultVector.Nsp, inputVector.Nsp)
+		resultValues = inet.InetAton(inputValues, resultValues, resultVector.Nsp)
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	}
+}
+
+// InetNtoa returns the IPv4 address of a number, or null if the number is out of the range of uint32
+func InetNtoa[T constraints.Integer](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.([]T)
+		return scalarInet(resultType, proc, func(ns *nulls.Nulls) int {
+			return inet.InetNtoaSize(inputValues, ns)
+		}, func(rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+			return inet.InetNtoa(inputValues, rs, ns)
+		})
+	} else {
+		inputValues := inputVector.Col.([]T)
+		return vectorInet(inputVector, len(inputValues), resultType, proc, func(ns *nulls.Nulls) int {
+			return inet.InetNtoaSize(inputValues, ns)
+		}, func(rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+			return inet.InetNtoa(inputValues, rs, ns)
+		})
+	}
+}
+
+// Inet6Aton returns the 4 or 16 bytes binary of an IPv4 or IPv6 address, or null if the address is malformed
+func Inet6Aton(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.(*types.Bytes)
+		return scalarInet(resultType, proc, func(ns *nulls.Nulls) int {
+			return inet.Inet6AtonSize(inputValues, ns)
+		}, func(rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+			return inet.Inet6Aton(inputValues, rs, ns)
+		})
+	} else {
+		inputValues := inputVector.Col.(*types.Bytes)
+		return vectorInet(inputVector, len(inputValues.Lengths), resultType, proc, func(ns *nulls.Nulls) int {
+			return inet.Inet6AtonSize(inputValues, ns)
+		}, func(rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+			return inet.Inet6Aton(inputValues, rs, ns)
+		})
+	}
+}
+
+// Inet6Ntoa returns the address of a 4 or 16 bytes binary, or null if the binary is of another length
+func Inet6Ntoa(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.(*types.Bytes)
+		return scalarInet(resultType, proc, func(ns *nulls.Nulls) int {
+			return inet.Inet6NtoaSize(inputValues, ns)
+		}, func(rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+			return inet.Inet6Ntoa(inputValues, rs, ns)
+		})
+	} else {
+		inputValues := inputVector.Col.(*types.Bytes)
+		return vectorInet(inputVector, len(inputValues.Lengths), resultType, proc, func(ns *nulls.Nulls) int {
+			return inet.Inet6NtoaSize(inputValues, ns)
+		}, func(rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+			return inet.Inet6Ntoa(inputValues, rs, ns)
+		})
+	}
+}
+
+// scalarInet returns the constant string result of fn, size returns the size of the result
+func scalarInet(resultType types.Type, proc *process.Process, size func(*nulls.Nulls) int, fn func(*types.Bytes, *nulls.Nulls) *types.Bytes) (*vector.Vector, error) {
+	resultNsp := new(nulls.Nulls)
+	resultValues := fn(&types.Bytes{
+		Data:    make([]byte, 0, size(resultNsp)),
+		Offsets: make([]uint32, 1),
+		Lengths: make([]uint32, 1),
+	}, resultNsp)
+	if nulls.Any(resultNsp) {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	resultVector := vector.NewConst(resultType)
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
+
+// vectorInet returns the string results of fn written into the data of a vector, which is
+// allocated of the exact size returned by size. The null rows of the input are skipped by both.
+func vectorInet(inputVector *vector.Vector, length int, resultType types.Type, proc *process.Process, size func(*nulls.Nulls) int, fn func(*types.Bytes, *nulls.Nulls) *types.Bytes) (*vector.Vector, error) {
+	resultNsp := new(nulls.Nulls)
+	nulls.Set(resultNsp, inputVector.Nsp)
+	resultVector, err := proc.AllocVector(resultType, int64(size(resultNsp)))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := fn(&types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, length),
+		Lengths: make([]uint32, length),
+	}, resultNsp)
+	nulls.Set(resultVector.Nsp, resultNsp)
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
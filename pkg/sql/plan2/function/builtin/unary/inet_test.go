@@ -0,0 +1,154 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// stringsOf returns the strings of the result vector, and an empty string for the nulls
+func stringsOf(vec *vector.Vector) []string {
+	col := vec.Col.(*types.Bytes)
+	rs := make([]string, len(col.Lengths))
+	for i := range rs {
+		if !nulls.Contains(vec.Nsp, uint64(i)) {
+			rs[i] = string(col.Get(int64(i)))
+		}
+	}
+	return rs
+}
+
+func TestInetAton(t *testing.T) {
+	convey.Convey("InetAtonCase", t, func() {
+		inVector := testutil.MakeVarcharVector([]string{"10.0.5.9", "", "127.1", "1.2.3.256", "01.02.03.04"}, []uint64{1})
+		wantVector := testutil.MakeUint32Vector([]uint32{167773449, 0, 2130706433, 0, 16909060}, []uint64{1, 3})
+		proc := testutil.NewProc()
+		res, err := InetAton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		compare := testutil.CompareVectors(wantVector, res)
+		convey.So(compare, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("InetAtonCaseScalar", t, func() {
+		inVector := testutil.MakeScalarChar("10.0.5.9", 10)
+		wantVector := testutil.MakeScalarUint32(167773449, 10)
+		proc := testutil.NewProc()
+		res, err := InetAton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		compare := testutil.CompareVectors(wantVector, res)
+		convey.So(compare, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("InetAtonCaseScalarMalformed", t, func() {
+		inVector := testutil.MakeScalarChar("10.0.5.9.1", 10)
+		proc := testutil.NewProc()
+		res, err := InetAton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(res.IsScalarNull(), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("InetAtonCaseScalarNull", t, func() {
+		inVector := testutil.MakeScalarNull(10)
+		proc := testutil.NewProc()
+		res, err := InetAton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(res.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
+
+func TestInetNtoa(t *testing.T) {
+	convey.Convey("InetNtoaCase", t, func() {
+		inVector := testutil.MakeInt64Vector([]int64{167773449, 0, -1, 4294967296, 4294967295}, []uint64{1})
+		proc := testutil.NewProc()
+		res, err := InetNtoa[int64]([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stringsOf(res), convey.ShouldResemble, []string{"10.0.5.9", "", "", "", "255.255.255.255"})
+		convey.So(res.Nsp.Np.ToArray(), convey.ShouldResemble, []uint64{1, 2, 3})
+		convey.So(len(res.Data), convey.ShouldEqual, len("10.0.5.9255.255.255.255"))
+	})
+
+	convey.Convey("InetNtoaCaseScalar", t, func() {
+		inVector := testutil.MakeScalarUint64(2130706433, 10)
+		proc := testutil.NewProc()
+		res, err := InetNtoa[uint64]([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(res.IsScalar(), convey.ShouldBeTrue)
+		convey.So(stringsOf(res), convey.ShouldResemble, []string{"127.0.0.1"})
+	})
+
+	convey.Convey("InetNtoaCaseScalarNull", t, func() {
+		inVector := testutil.MakeScalarNull(10)
+		proc := testutil.NewProc()
+		res, err := InetNtoa[int64]([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(res.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
+
+func TestInet6(t *testing.T) {
+	convey.Convey("Inet6RoundTripCase", t, func() {
+		addrs := []string{"10.0.5.9", "", "FDFE::5A55:CAFF:FEFA:9089", "::ffff:1.2.3.4", "1::2::3", "0:0:0:0:0:0:1.2.3.4"}
+		inVector := testutil.MakeVarcharVector(addrs, []uint64{1})
+		proc := testutil.NewProc()
+		bin, err := Inet6Aton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bin.Nsp.Np.ToArray(), convey.ShouldResemble, []uint64{1, 4})
+		convey.So(stringsOf(bin), convey.ShouldResemble, []string{
+			"\x0a\x00\x05\x09",
+			"",
+			"\xfd\xfe\x00\x00\x00\x00\x00\x00\x5a\x55\xca\xff\xfe\xfa\x90\x89",
+			"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x01\x02\x03\x04",
+			"",
+			"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04",
+		})
+		convey.So(len(bin.Data), convey.ShouldEqual, 4+16+16+16)
+
+		text, err := Inet6Ntoa([]*vector.Vector{bin}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(text.Nsp.Np.ToArray(), convey.ShouldResemble, []uint64{1, 4})
+		convey.So(stringsOf(text), convey.ShouldResemble, []string{"10.0.5.9", "", "fdfe::5a55:caff:fefa:9089", "::ffff:1.2.3.4", "", "::1.2.3.4"})
+	})
+
+	convey.Convey("Inet6NtoaCaseMalformed", t, func() {
+		inVector := testutil.MakeVarcharVector([]string{"abc", "\x7f\x00\x00\x01"}, nil)
+		proc := testutil.NewProc()
+		res, err := Inet6Ntoa([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stringsOf(res), convey.ShouldResemble, []string{"", "127.0.0.1"})
+		convey.So(res.Nsp.Np.ToArray(), convey.ShouldResemble, []uint64{0})
+	})
+
+	convey.Convey("Inet6CaseScalar", t, func() {
+		inVector := testutil.MakeScalarChar("::1", 10)
+		proc := testutil.NewProc()
+		bin, err := Inet6Aton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bin.IsScalar(), convey.ShouldBeTrue)
+		text, err := Inet6Ntoa([]*vector.Vector{bin}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stringsOf(text), convey.ShouldResemble, []string{"::1"})
+
+		inVector = testutil.MakeScalarChar("::1::", 10)
+		bin, err = Inet6Aton([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bin.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Lower(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return convertCase(vectors, proc, bytes.ToLower)
+}
+
+func Upper(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return convertCase(vectors, proc, bytes.ToUpper)
+}
+
+// convertCase converts each string by fn, the length of a string may be
+// changed by the conversion of the unicode characters
+func convertCase(vectors []*vector.Vector, proc *process.Process, fn func([]byte) []byte) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.IsScalar() && inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := inputVector.Col.(*types.Bytes)
+	rs := make([][]byte, len(inputValues.Offsets))
+	size := 0
+	for i := range rs {
+		rs[i] = fn(inputValues.Get(int64(i)))
+		size += len(rs[i])
+	}
+	var resultVector *vector.Vector
+	if inputVector.IsScalar() {
+		resultVector = vector.NewConst(resultType)
+		resultVector.Data = make([]byte, size)
+	} else {
+		var err error
+		if resultVector, err = proc.AllocVector(resultType, int64(size)); err != nil {
+			return nil, err
+		}
+		nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	}
+	resultValues := &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, len(rs)),
+		Lengths: make([]uint32, len(rs)),
+	}
+	for i, r := range rs {
+		resultValues.Offsets[i] = uint32(len(resultValues.Data))
+		resultValues.Lengths[i] = uint32(len(r))
+		resultValues.Data = append(resultValues.Data, r...)
+	}
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLowerUpper(t *testing.T) {
+	proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(100000), Limit: 100000}))
+	input := []*vector.Vector{testutil.MakeVarcharVector([]string{"Ab@C.com", "", "ÄBC", "x"}, []uint64{3})}
+
+	vec, err := Lower(input, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ab@c.com", "", "äbc"}, bytesStrings(vec, 3))
+	require.True(t, nulls.Contains(vec.Nsp, 3))
+
+	vec, err = Upper(input, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"AB@C.COM", "", "ÄBC"}, bytesStrings(vec, 3))
+
+	vec, err = Upper([]*vector.Vector{testutil.MakeScalarVarchar("abc", 2)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, []string{"ABC"}, bytesStrings(vec, 1))
+
+	vec, err = Lower([]*vector.Vector{testutil.MakeScalarNull(2)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.ConstVectorIsNull())
+}
+
+func bytesStrings(vec *vector.Vector, n int) []string {
+	col := vec.Col.(*types.Bytes)
+	rs := make([]string, n)
+	for i := range rs {
+		rs[i] = string(col.Get(int64(i)))
+	}
+	return rs
+}
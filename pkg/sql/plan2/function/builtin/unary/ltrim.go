@@ -31,21 +31,18 @@ func Ltrim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, err
 			return proc.AllocScalarNullVector(resultType), nil
 		}
 		inputValues := inputVector.Col.(*types.Bytes)
-		spaceCount := ltrim.CountSpacesFromLeft(inputValues)
-		totalCount := int32(len(inputValues.Data))
 		resultVector := vector.NewConst(resultType)
 		resultValues := &types.Bytes{
-			Data:    make([]byte, totalCount-spaceCount),
+			Data:    make([]byte, ltrim.LtrimLength(inputValues, inputVector.Nsp)),
 			Offsets: make([]uint32, 1),
 			Lengths: make([]uint32, 1),
 		}
-		vector.SetCol(resultVector, ltrim.LtrimChar(inputValues, resultValues))
+		vector.SetCol(resultVector, ltrim.LtrimChar(inputValues, resultValues, inputVector.Nsp))
 		return resultVector, nil
 	} else {
 		inputValues := inputVector.Col.(*types.Bytes)
-		spaceCount := ltrim.CountSpacesFromLeft(inputValues)
-		totalCount := int32(len(inputValues.Data))
-		resultVector, err := proc.AllocVector(resultType, int64(totalCount-spaceCount))
+		// the data is sized by the ltrim-ed strings of the rows not null
+		resultVector, err := proc.AllocVector(resultType, ltrim.LtrimLength(inputValues, inputVector.Nsp))
 		if err != nil {
 			return nil, err
 		}
@@ -55,7 +52,7 @@ func Ltrim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, err
 			Lengths: make([]uint32, len(inputValues.Lengths)),
 		}
 		nulls.Set(resultVector.Nsp, inputVector.Nsp)
-		vector.SetCol(resultVector, ltrim.LtrimChar(inputValues, resultValues))
+		vector.SetCol(resultVector, ltrim.LtrimChar(inputValues, resultValues, inputVector.Nsp))
 		return resultVector, nil
 	}
 }
@@ -40,26 +40,21 @@ func Rtrim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, err
 		if !ok {
 			return nil, errorParameterIsNotString
 		}
-		// totalCount - spaceCount is the total bytes need for the ltrim-ed string
-		spaceCount := rtrim.CountSpacesFromRight(inputValues)
-		totalCount := int32(len(inputValues.Data))
 		resultVector := vector.NewConst(resultType)
 		resultValues := &types.Bytes{
-			Data:    make([]byte, totalCount-spaceCount),
+			Data:    make([]byte, rtrim.RtrimLength(inputValues, inputVector.Nsp)),
 			Offsets: make([]uint32, 1),
 			Lengths: make([]uint32, 1),
 		}
-		vector.SetCol(resultVector, rtrim.RtrimChar(inputValues, resultValues))
+		vector.SetCol(resultVector, rtrim.RtrimChar(inputValues, resultValues, inputVector.Nsp))
 		return resultVector, nil
 	} else {
 		inputValues, ok := inputVector.Col.(*types.Bytes)
 		if !ok {
 			return nil, errorParameterIsNotString
 		}
-		// totalCount - spaceCount is the total bytes need for the ltrim-ed string
-		spaceCount := rtrim.CountSpacesFromRight(inputValues)
-		totalCount := int32(len(inputValues.Data))
-		resultVector, err := proc.AllocVector(resultType, int64(totalCount-spaceCount))
+		// the data is sized by the rtrim-ed strings of the rows not null
+		resultVector, err := proc.AllocVector(resultType, rtrim.RtrimLength(inputValues, inputVector.Nsp))
 		if err != nil {
 			return nil, err
 		}
@@ -69,7 +64,7 @@ func Rtrim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, err
 			Lengths: make([]uint32, len(inputValues.Lengths)),
 		}
 		nulls.Set(resultVector.Nsp, inputVector.Nsp)
-		vector.SetCol(resultVector, rtrim.RtrimChar(inputValues, resultValues))
+		vector.SetCol(resultVector, rtrim.RtrimChar(inputValues, resultValues, inputVector.Nsp))
 		return resultVector, nil
 	}
 }
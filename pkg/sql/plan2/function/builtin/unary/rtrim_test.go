@@ -1,10 +1,15 @@
 package unary
 
 import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/smartystreets/goconvey/convey"
-	"testing"
 )
 
 func TestRtrim(t *testing.T) {
@@ -269,4 +274,41 @@ func TestRtrim(t *testing.T) {
 		ret := testutil.CompareVectors(wantvec, ovec)
 		convey.So(ret, convey.ShouldBeTrue)
 	})
+
+	convey.Convey("nulls", t, func() {
+		strs := []string{"a  ", " b ", "", "c", "  ", "d e  "}
+		kases := []struct {
+			strs []string
+			nsp  []uint64
+		}{
+			{strs: strs, nsp: []uint64{0, 1}},
+			{strs: strs, nsp: []uint64{2, 3}},
+			{strs: strs, nsp: []uint64{4, 5}},
+			{strs: strs, nsp: []uint64{0, 3, 5}},
+			{strs: []string{" ", "   ", "  "}},
+			{strs: []string{" ", "   ", "  "}, nsp: []uint64{1}},
+		}
+		for _, k := range kases {
+			proc := testutil.NewProc()
+			ivec := testutil.MakeVarcharVector(k.strs, k.nsp)
+			ovec, err := Rtrim([]*vector.Vector{ivec}, proc)
+			convey.So(err, convey.ShouldBeNil)
+			res := ovec.Col.(*types.Bytes)
+			convey.So(len(res.Lengths), convey.ShouldEqual, len(k.strs))
+			convey.So(len(res.Offsets), convey.ShouldEqual, len(k.strs))
+			length := 0
+			for i, s := range k.strs {
+				want := strings.TrimRight(s, " ")
+				if nulls.Contains(ivec.Nsp, uint64(i)) {
+					convey.So(nulls.Contains(ovec.Nsp, uint64(i)), convey.ShouldBeTrue)
+					want = ""
+				}
+				convey.So(string(res.Get(int64(i))), convey.ShouldEqual, want)
+				length += len(want)
+			}
+			// the data is sized by the rtrim-ed strings of the rows not null
+			convey.So(len(res.Data), convey.ShouldEqual, length)
+			convey.So(mheap.Size(proc.Mp), convey.ShouldEqual, int64(length))
+		}
+	})
 }
@@ -0,0 +1,55 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestSecondFunction(t *testing.T) {
+	convey.Convey("DatetimeToSecondCase", t, func() {
+		inVector := testutil.MakeDateTimeVector([]string{"1999-12-31 23:59:59.999999", "", "2000-01-01 00:00:00.000001"}, []uint64{1})
+		wantVector := testutil.MakeUint8Vector([]uint8{59, 0, 0}, []uint64{1})
+		proc := testutil.NewProc()
+		res, err := DatetimeToSecond([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		compare := testutil.CompareVectors(wantVector, res)
+		convey.So(compare, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("DatetimeToSecondCaseScalar", t, func() {
+		inVector := testutil.MakeScalarDateTime("2022-06-30 12:34:56.5", 10)
+		wantVector := testutil.MakeScalarUint8(56, 10)
+		proc := testutil.NewProc()
+		res, err := DatetimeToSecond([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		compare := testutil.CompareVectors(wantVector, res)
+		convey.So(compare, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("DatetimeToSecondCaseScalarNull", t, func() {
+		inVector := testutil.MakeScalarNull(10)
+		wantVector := testutil.MakeScalarNull(10)
+		proc := testutil.NewProc()
+		res, err := DatetimeToSecond([]*vector.Vector{inVector}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		compare := testutil.CompareVectors(wantVector, res)
+		convey.So(compare, convey.ShouldBeTrue)
+	})
+}
@@ -488,6 +488,17 @@ var builtins = map[int][]Function{
 			Fn:          unary.Ltrim,
 		},
 	},
+	LOWER: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Lower,
+		},
+	},
 	MONTH: {
 		{
 			Index:       0,
@@ -613,6 +624,17 @@ var builtins = map[int][]Function{
 			Fn:          unary.Rtrim,
 		},
 	},
+	UPPER: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Upper,
+		},
+	},
 	SIN: {
 		{
 			Index:       0,
@@ -812,6 +834,130 @@ var builtins = map[int][]Function{
 			Fn:          unary.DateStringToYear,
 		},
 	},
+	HOUR: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.DatetimeToHour,
+		},
+	},
+	INET_ATON: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.InetAton,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.InetAton,
+		},
+	},
+	INET_NTOA: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.InetNtoa[int64],
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.InetNtoa[uint64],
+		},
+	},
+	INET6_ATON: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Inet6Aton,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Inet6Aton,
+		},
+	},
+	INET6_NTOA: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Inet6Ntoa,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Inet6Ntoa,
+		},
+	},
+	MINUTE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.DatetimeToMinute,
+		},
+	},
+	SECOND: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.DatetimeToSecond,
+		},
+	},
+	MICROSECOND: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.DatetimeToMicrosecond,
+		},
+	},
 	// binary functions
 	ENDSWITH: {
 		{
@@ -826,157 +972,620 @@ var builtins = map[int][]Function{
 	},
 	FINDINSET: {
 		{
-			Index:       0,
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.FindInSet,
+		},
+	},
+	POW: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_float64, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Power,
+		},
+	},
+	STARTSWITH: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Startswith,
+		},
+	},
+	ASSERT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_bool, types.T_varchar},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Assert,
+		},
+	},
+	MO_CTL: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.MoCtl,
+		},
+	},
+	// variadic functions
+	CEIL: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_uint64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CeilUint64,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_uint64, types.T_int64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CeilUint64,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CeilInt64,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CeilInt64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CeilFloat64,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_float64, types.T_int64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CeilFloat64,
+		},
+	},
+	FLOOR: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_uint64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.FloorUInt64,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_uint64, types.T_int64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.FloorUInt64Int64,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.FloorInt64,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.FloorInt64Int64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.FloorFloat64,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_float64, types.T_int64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.FloorFloat64Int64,
+		},
+	},
+	LPAD: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64, types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64, types.T_float64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_uint64, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_uint64, types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_uint64, types.T_float64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_uint64, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_uint64, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       10,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_float64, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       11,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_float64, types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       12,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_float64, types.T_float64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       13,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_float64, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_float64, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       15,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       16,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_float64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       18,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       19,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       20,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       21,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char, types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       22,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char, types.T_float64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       23,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       24,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       25,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       26,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64, types.T_uint64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       27,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64, types.T_float64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       28,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64, types.T_varchar},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       29,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       30,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_uint64, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       31,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_uint64, types.T_uint64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       32,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_uint64, types.T_float64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       33,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_uint64, types.T_varchar},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Lpad,
+		},
+		{
+			Index:       34,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_varchar, types.T_varchar},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_char, types.T_uint64, types.T_char},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          binary.FindInSet,
+			Fn:          multi.Lpad,
 		},
-	},
-	POW: {
 		{
-			Index:       0,
+			Index:       35,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_float64, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_char, types.T_float64, types.T_int64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          binary.Power,
+			Fn:          multi.Lpad,
 		},
-	},
-	STARTSWITH: {
 		{
-			Index:       0,
+			Index:       36,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_varchar, types.T_varchar},
-			ReturnTyp:   types.T_uint8,
+			Args:        []types.T{types.T_char, types.T_float64, types.T_uint64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          binary.Startswith,
+			Fn:          multi.Lpad,
 		},
-	},
-	// variadic functions
-	CEIL: {
 		{
-			Index:       0,
+			Index:       37,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_uint64},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_char, types.T_float64, types.T_float64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.CeilUint64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       1,
+			Index:       38,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_uint64, types.T_int64},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_char, types.T_float64, types.T_varchar},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.CeilUint64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       2,
+			Index:       39,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_int64},
-			ReturnTyp:   types.T_int64,
+			Args:        []types.T{types.T_char, types.T_float64, types.T_char},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.CeilInt64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       3,
+			Index:       40,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_int64, types.T_int64},
-			ReturnTyp:   types.T_int64,
+			Args:        []types.T{types.T_char, types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.CeilInt64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       4,
+			Index:       41,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_char, types.T_varchar, types.T_uint64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.CeilFloat64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       5,
+			Index:       42,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_float64, types.T_int64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_char, types.T_varchar, types.T_float64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.CeilFloat64,
+			Fn:          multi.Lpad,
 		},
-	},
-	FLOOR: {
 		{
-			Index:       0,
+			Index:       43,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_uint64},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_char, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.FloorUInt64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       1,
+			Index:       44,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_uint64, types.T_int64},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_char, types.T_varchar, types.T_char},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.FloorUInt64Int64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       2,
+			Index:       45,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_int64},
-			ReturnTyp:   types.T_int64,
+			Args:        []types.T{types.T_char, types.T_char, types.T_int64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.FloorInt64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       3,
+			Index:       46,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_int64, types.T_int64},
-			ReturnTyp:   types.T_int64,
+			Args:        []types.T{types.T_char, types.T_char, types.T_uint64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.FloorInt64Int64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       4,
+			Index:       47,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_char, types.T_char, types.T_float64},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.FloorFloat64,
+			Fn:          multi.Lpad,
 		},
 		{
-			Index:       5,
+			Index:       48,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_float64, types.T_int64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_char, types.T_char, types.T_varchar},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          multi.FloorFloat64Int64,
+			Fn:          multi.Lpad,
 		},
-	},
-	LPAD: {
 		{
-			Index:       0,
+			Index:       49,
 			Flag:        plan.Function_STRICT,
 			Layout:      STANDARD_FUNCTION,
-			Args:        []types.T{types.T_varchar, types.T_int64, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_char, types.T_char, types.T_char},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          multi.Lpad,
 		},
@@ -1610,6 +2219,64 @@ var builtins = map[int][]Function{
 			Fn:          multi.Substring,
 		},
 	},
+	SUBSTRING_INDEX: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.SubstringIndex,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.SubstringIndex,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.SubstringIndex,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char, types.T_uint64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.SubstringIndex,
+		},
+	},
+	TRIM: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+	},
 	UTC_TIMESTAMP: {
 		{
 			Index:       0,
@@ -1621,6 +2288,81 @@ var builtins = map[int][]Function{
 			Fn:          multi.UTCTimestamp,
 		},
 	},
+	DATABASE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Database,
+		},
+	},
+	USER: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.User,
+		},
+	},
+	VERSION: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Version,
+		},
+	},
+	CONNECTION_ID: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.ConnectionID,
+		},
+	},
+	CURRENT_TIMESTAMP: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STABLE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CurrentTimestamp,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STABLE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.CurrentTimestamp,
+		},
+	},
+	UUID: {
+		{
+			Index:       0,
+			Flag:        plan.Function_VOLATILE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.UUID,
+		},
+	},
 	EXTRACT: {
 		{
 			Index:       0,
@@ -1672,6 +2414,16 @@ var builtins = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          multi.DateStringAdd,
 		},
+		{
+			Index:       3,
+			Volatile:    true,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_time, types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.TimeAdd,
+		},
 	},
 	DATE_SUB: {
 		{
@@ -1704,6 +2456,16 @@ var builtins = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          multi.DateStringSub,
 		},
+		{
+			Index:       3,
+			Volatile:    true,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_time, types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.TimeSub,
+		},
 	},
 	TAN: {
 		{
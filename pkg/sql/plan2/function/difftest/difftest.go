@@ -0,0 +1,252 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package difftest is a differential test harness of the vectorized
+// functions. A function is run on random vectors, including the scalar, the
+// null and the empty ones, and its output is checked against a reference
+// which evaluates the function row by row.
+package difftest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Seed is the seed of the random cases of go test, so that a failure is
+// reproducible.
+const Seed = 20220801
+
+// Iterations is the number of random inputs a case is run on by go test.
+const Iterations = 500
+
+// Case is a function under test.
+type Case struct {
+	Name string
+	// Args are the types of the arguments.
+	Args []types.Type
+	// Ret is the type of the result.
+	Ret types.Type
+	// Gen returns a random value of the i-th argument which is not null,
+	// it is RandomValue if nil.
+	Gen func(r *rand.Rand, i int) Value
+	// Fn is the vectorized function.
+	Fn func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
+	// Ref evaluates the function on a row, it returns nil for a null.
+	// Fn is expected to fail if Ref fails on a row.
+	Ref func(args []Value) (Value, error)
+}
+
+// Strict returns a reference which is null if an argument is null and
+// which is fn otherwise.
+func Strict(fn func(args []Value) (Value, error)) func(args []Value) (Value, error) {
+	return func(args []Value) (Value, error) {
+		for _, arg := range args {
+			if arg == nil {
+				return nil, nil
+			}
+		}
+		return fn(args)
+	}
+}
+
+// Options of a run.
+type Options struct {
+	Seed       int64
+	Iterations int
+	Gen        GenOptions
+}
+
+// DefaultOptions are the options of go test.
+var DefaultOptions = Options{
+	Seed:       Seed,
+	Iterations: Iterations,
+	Gen:        DefaultGenOptions,
+}
+
+// Run runs c on opts.Iterations random inputs and fails t on the first
+// input on which the output of c.Fn and c.Ref differ.
+func Run(t testing.TB, c Case, opts Options) {
+	t.Helper()
+	r := rand.New(rand.NewSource(opts.Seed))
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	for i := 0; i < opts.Iterations; i++ {
+		vs := randomArgs(r, c, opts.Gen)
+		if err := check(c, vs, proc); err != nil {
+			t.Fatalf("%s, seed %d, iteration %d: %v\ninputs:\n%s", c.Name, opts.Seed, i, err, describe(vs))
+		}
+	}
+}
+
+func randomArgs(r *rand.Rand, c Case, opts GenOptions) []*vector.Vector {
+	n := 1 + r.Intn(opts.MaxRows)
+	if r.Float64() < opts.EmptyRate {
+		n = 0
+	}
+	vs := make([]*vector.Vector, len(c.Args))
+	for i, typ := range c.Args {
+		i, typ := i, typ
+		gen := func() Value {
+			if c.Gen != nil {
+				return c.Gen(r, i)
+			}
+			return RandomValue(r, typ)
+		}
+		if r.Float64() < opts.ScalarRate {
+			vs[i] = MakeScalar(typ, RandomRows(r, 1, opts.NullRate, gen)[0], n)
+		} else {
+			vs[i] = MakeVector(typ, RandomRows(r, n, opts.NullRate, gen))
+		}
+	}
+	return vs
+}
+
+// check runs c on vs and returns how the output differs from the reference.
+func check(c Case, vs []*vector.Vector, proc *process.Process) error {
+	n := vector.Length(vs[0])
+	scalar := true
+	args := make([][]Value, len(vs))
+	for i, v := range vs {
+		args[i] = Rows(v)
+		scalar = scalar && v.IsScalar()
+	}
+	expect := make([]Value, n)
+	var refErr error
+	row := make([]Value, len(vs))
+	for i := range expect {
+		for j := range args {
+			row[j] = args[j][i]
+		}
+		if expect[i], refErr = c.Ref(row); refErr != nil {
+			break
+		}
+	}
+	if scalar && n == 0 && refErr == nil {
+		// a scalar of no rows is still evaluated
+		for j := range args {
+			row[j] = rowValue(vs[j], 0)
+		}
+		_, refErr = c.Ref(row)
+	}
+
+	vec, err := c.Fn(vs, proc)
+	for i, v := range vs {
+		if !reflect.DeepEqual(Rows(v), args[i]) {
+			return fmt.Errorf("argument %d is modified", i)
+		}
+	}
+	if refErr != nil {
+		if err == nil {
+			return fmt.Errorf("expected the error %q", refErr)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unexpected error: %v", err)
+	}
+	defer vector.Clean(vec, proc.Mp)
+
+	if vec.Typ.Oid != c.Ret.Oid {
+		return fmt.Errorf("expected a result of %s, got %s", c.Ret, vec.Typ)
+	}
+	if (vec.Typ.Oid == types.T_decimal64 || vec.Typ.Oid == types.T_decimal128) && vec.Typ.Scale != c.Ret.Scale {
+		return fmt.Errorf("expected a result of scale %d, got %d", c.Ret.Scale, vec.Typ.Scale)
+	}
+	if l := vector.Length(vec); l != n {
+		return fmt.Errorf("expected %d rows, got %d", n, l)
+	}
+	if vec.IsScalar() && !scalar && !vec.IsScalarNull() {
+		return fmt.Errorf("expected a vector, got a scalar")
+	}
+	if !vec.IsScalar() && scalar {
+		return fmt.Errorf("expected a scalar, got a vector")
+	}
+	if err := checkBytes(vec); err != nil {
+		return err
+	}
+	got := Rows(vec)
+	for i := range expect {
+		if !equal(expect[i], got[i]) {
+			return fmt.Errorf("row %d: expected %s, got %s", i, format(expect[i]), format(got[i]))
+		}
+	}
+	return nil
+}
+
+// checkBytes checks the offsets and the lengths of the strings of vec.
+func checkBytes(vec *vector.Vector) error {
+	col, ok := vec.Col.(*types.Bytes)
+	if !ok {
+		return nil
+	}
+	if len(col.Offsets) != len(col.Lengths) {
+		return fmt.Errorf("%d offsets of %d lengths", len(col.Offsets), len(col.Lengths))
+	}
+	for i, o := range col.Offsets {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		if int(o)+int(col.Lengths[i]) > len(col.Data) {
+			return fmt.Errorf("row %d: string [%d, %d) out of %d bytes", i, o, o+col.Lengths[i], len(col.Data))
+		}
+	}
+	return nil
+}
+
+func equal(x, y Value) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func format(v Value) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return fmt.Sprintf("%q", v)
+	}
+	return fmt.Sprintf("%v(%T)", v, v)
+}
+
+func describe(vs []*vector.Vector) string {
+	var buf strings.Builder
+	for i, v := range vs {
+		kind := "vector"
+		if v.IsScalar() {
+			kind = fmt.Sprintf("scalar of %d rows", v.Length)
+		}
+		rows := Rows(v)
+		if v.IsScalar() {
+			rows = []Value{rowValue(v, 0)}
+		}
+		strs := make([]string, len(rows))
+		for j, row := range rows {
+			strs[j] = format(row)
+		}
+		fmt.Fprintf(&buf, "  %d: %s %s [%s]\n", i, v.Typ, kind, strings.Join(strs, ", "))
+	}
+	return buf.String()
+}
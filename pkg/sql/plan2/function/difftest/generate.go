@@ -0,0 +1,307 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package difftest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// GenOptions controls the shape of the generated vectors.
+type GenOptions struct {
+	// MaxRows is the maximum number of rows of a vector.
+	MaxRows int
+	// EmptyRate is the probability of the inputs having no rows.
+	EmptyRate float64
+	// NullRate is the probability of a row being null.
+	NullRate float64
+	// ScalarRate is the probability of an argument being a scalar.
+	ScalarRate float64
+}
+
+var DefaultGenOptions = GenOptions{
+	MaxRows:    24,
+	EmptyRate:  0.05,
+	NullRate:   0.2,
+	ScalarRate: 0.3,
+}
+
+// Value is the value of a row, it is nil for a null, a string for the
+// strings and the element of the column of the vector for the others.
+type Value = interface{}
+
+// RandomValue returns a random value of typ which is not null, the edge
+// values of the type are returned more often than the others.
+func RandomValue(r *rand.Rand, typ types.Type) Value {
+	edge := r.Intn(4) == 0
+	switch typ.Oid {
+	case types.T_bool:
+		return r.Intn(2) == 0
+	case types.T_int8:
+		return int8(randomInt(r, edge, math.MinInt8, math.MaxInt8))
+	case types.T_int16:
+		return int16(randomInt(r, edge, math.MinInt16, math.MaxInt16))
+	case types.T_int32:
+		return int32(randomInt(r, edge, math.MinInt32, math.MaxInt32))
+	case types.T_int64:
+		return randomInt(r, edge, math.MinInt64, math.MaxInt64)
+	case types.T_uint8:
+		return uint8(randomUint(r, edge, math.MaxUint8))
+	case types.T_uint16:
+		return uint16(randomUint(r, edge, math.MaxUint16))
+	case types.T_uint32:
+		return uint32(randomUint(r, edge, math.MaxUint32))
+	case types.T_uint64:
+		return randomUint(r, edge, math.MaxUint64)
+	case types.T_float32:
+		return float32(randomFloat(r, edge))
+	case types.T_float64:
+		return randomFloat(r, edge)
+	case types.T_char, types.T_varchar:
+		return randomString(r, edge)
+	case types.T_date:
+		return randomDate(r, edge)
+	case types.T_datetime:
+		y, m, d, _ := randomDate(r, edge).Calendar(true)
+		return types.FromClock(y, m, d, uint8(r.Intn(24)), uint8(r.Intn(60)), uint8(r.Intn(60)), uint32(r.Intn(1000000)))
+	case types.T_decimal64:
+		return types.Decimal64(randomInt(r, edge, -999999999999999999, 999999999999999999))
+	case types.T_decimal128:
+		return types.InitDecimal128(randomInt(r, edge, math.MinInt64, math.MaxInt64))
+	}
+	panic(fmt.Sprintf("difftest: values of %s are not generated", typ))
+}
+
+func randomInt(r *rand.Rand, edge bool, min, max int64) int64 {
+	if edge {
+		return []int64{min, max, 0, 1, -1, min + 1, max - 1}[r.Intn(7)]
+	}
+	if r.Intn(2) == 0 {
+		// a small value, so that the values repeat
+		return r.Int63n(21) - 10
+	}
+	span := uint64(max) - uint64(min) + 1
+	if span == 0 {
+		// the whole range of int64
+		return int64(r.Uint64())
+	}
+	return min + int64(r.Uint64()%span)
+}
+
+func randomUint(r *rand.Rand, edge bool, max uint64) uint64 {
+	if edge {
+		return []uint64{0, 1, max, max - 1}[r.Intn(4)]
+	}
+	if r.Intn(2) == 0 {
+		return uint64(r.Int63n(11))
+	}
+	if max == math.MaxUint64 {
+		return r.Uint64()
+	}
+	return r.Uint64() % (max + 1)
+}
+
+func randomFloat(r *rand.Rand, edge bool) float64 {
+	if edge {
+		return []float64{0, 1, -1, 0.5, -0.5, 1e-7, 1e9, -1e9}[r.Intn(8)]
+	}
+	if r.Intn(2) == 0 {
+		return float64(r.Int63n(21) - 10)
+	}
+	return r.NormFloat64() * 1e6
+}
+
+const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 "
+
+func randomString(r *rand.Rand, edge bool) string {
+	if edge {
+		return []string{"", " ", "0", "-1", "a"}[r.Intn(5)]
+	}
+	bs := make([]byte, r.Intn(12))
+	for i := range bs {
+		bs[i] = letters[r.Intn(len(letters))]
+	}
+	return string(bs)
+}
+
+func randomDate(r *rand.Rand, edge bool) types.Date {
+	if edge {
+		return []types.Date{
+			types.FromCalendar(1, 1, 1),
+			types.FromCalendar(9999, 12, 31),
+			types.FromCalendar(1970, 1, 1),
+			types.FromCalendar(2000, 2, 29),
+		}[r.Intn(4)]
+	}
+	return types.FromCalendar(1, 1, 1) + types.Date(r.Int63n(int64(types.FromCalendar(9999, 12, 31)-types.FromCalendar(1, 1, 1))))
+}
+
+// RandomRows returns n random values, a row is null with the
+// probability nullRate. gen returns the values which are not null.
+func RandomRows(r *rand.Rand, n int, nullRate float64, gen func() Value) []Value {
+	rows := make([]Value, n)
+	for i := range rows {
+		if r.Float64() >= nullRate {
+			rows[i] = gen()
+		}
+	}
+	return rows
+}
+
+// MakeVector returns a vector of typ holding the rows, the null rows hold
+// the zero value of the type.
+func MakeVector(typ types.Type, rows []Value) *vector.Vector {
+	vec := vector.New(typ)
+	for i, v := range rows {
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	switch typ.Oid {
+	case types.T_bool:
+		setFixed[bool](vec, rows, 1)
+	case types.T_int8:
+		setFixed[int8](vec, rows, 1)
+	case types.T_int16:
+		setFixed[int16](vec, rows, 2)
+	case types.T_int32:
+		setFixed[int32](vec, rows, 4)
+	case types.T_int64:
+		setFixed[int64](vec, rows, 8)
+	case types.T_uint8:
+		setFixed[uint8](vec, rows, 1)
+	case types.T_uint16:
+		setFixed[uint16](vec, rows, 2)
+	case types.T_uint32:
+		setFixed[uint32](vec, rows, 4)
+	case types.T_uint64:
+		setFixed[uint64](vec, rows, 8)
+	case types.T_float32:
+		setFixed[float32](vec, rows, 4)
+	case types.T_float64:
+		setFixed[float64](vec, rows, 8)
+	case types.T_date:
+		setFixed[types.Date](vec, rows, 4)
+	case types.T_datetime:
+		setFixed[types.Datetime](vec, rows, 8)
+	case types.T_decimal64:
+		setFixed[types.Decimal64](vec, rows, 8)
+	case types.T_decimal128:
+		setFixed[types.Decimal128](vec, rows, 16)
+	case types.T_char, types.T_varchar:
+		col := &types.Bytes{
+			Offsets: make([]uint32, len(rows)),
+			Lengths: make([]uint32, len(rows)),
+		}
+		for i, v := range rows {
+			col.Offsets[i] = uint32(len(col.Data))
+			if v != nil {
+				col.Data = append(col.Data, v.(string)...)
+			}
+			col.Lengths[i] = uint32(len(col.Data)) - col.Offsets[i]
+		}
+		vec.Col = col
+		vec.Data = col.Data
+	default:
+		panic(fmt.Sprintf("difftest: vectors of %s are not made", typ))
+	}
+	return vec
+}
+
+func setFixed[T any](vec *vector.Vector, rows []Value, sz int) {
+	vs := make([]T, len(rows))
+	for i, v := range rows {
+		if v != nil {
+			vs[i] = v.(T)
+		}
+	}
+	vec.Col = vs
+	vec.Data = encoding.EncodeFixedSlice(vs, sz)
+}
+
+// MakeScalar returns a scalar vector of typ holding v repeated n times, it
+// is a scalar null if v is nil.
+func MakeScalar(typ types.Type, v Value, n int) *vector.Vector {
+	vec := MakeVector(typ, []Value{v})
+	vec.IsConst = true
+	vec.Length = n
+	return vec
+}
+
+// Rows returns the values of the rows of vec, a scalar is repeated by its
+// length.
+func Rows(vec *vector.Vector) []Value {
+	n := vector.Length(vec)
+	rows := make([]Value, n)
+	for i := range rows {
+		j := i
+		if vec.IsScalar() {
+			j = 0
+		}
+		rows[i] = rowValue(vec, j)
+	}
+	return rows
+}
+
+func rowValue(vec *vector.Vector, i int) Value {
+	if nulls.Contains(vec.Nsp, uint64(i)) {
+		return nil
+	}
+	switch vs := vec.Col.(type) {
+	case []bool:
+		return vs[i]
+	case []int8:
+		return vs[i]
+	case []int16:
+		return vs[i]
+	case []int32:
+		return vs[i]
+	case []int64:
+		return vs[i]
+	case []uint8:
+		return vs[i]
+	case []uint16:
+		return vs[i]
+	case []uint32:
+		return vs[i]
+	case []uint64:
+		return vs[i]
+	case []float32:
+		return vs[i]
+	case []float64:
+		return vs[i]
+	case []types.Date:
+		return vs[i]
+	case []types.Datetime:
+		return vs[i]
+	case []types.Timestamp:
+		return vs[i]
+	case []types.Time:
+		return vs[i]
+	case []types.Decimal64:
+		return vs[i]
+	case []types.Decimal128:
+		return vs[i]
+	case *types.Bytes:
+		return string(vs.Get(int64(i)))
+	}
+	panic(fmt.Sprintf("difftest: values of %T are not read", vec.Col))
+}
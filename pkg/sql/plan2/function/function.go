@@ -114,6 +114,10 @@ type Function struct {
 	// AggregateInfo is related information about aggregate function.
 	AggregateInfo int
 
+	// Collation is the collation of string arguments for overloads which are
+	// never matched by argument types, but picked by GetFunctionByCollation.
+	Collation int32
+
 	// SQLFn returns the sql string of the function. Maybe useful.
 	// TODO(cms): if useless, just remove it.
 	SQLFn func(argNames []string) (string, error)
@@ -144,6 +148,12 @@ func (f Function) IsAggregate() bool {
 	return f.Flag == plan.Function_AGG
 }
 
+// IsVolatile returns true if the function may return different results for
+// the same arguments, or reads the state of the session.
+func (f Function) IsVolatile() bool {
+	return f.Flag == plan.Function_VOLATILE
+}
+
 func (f Function) isFunction() bool {
 	return f.Layout == STANDARD_FUNCTION || f.Layout >= NOPARAMETER_FUNCTION
 }
@@ -265,6 +275,27 @@ func GetFunctionByName(name string, args []types.T) (Function, int64, []types.T,
 	return emptyFunction, -1, nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("unsupported parameter types %v for operator '%s'", args, name))
 }
 
+// GetFunctionByCollation returns the overload of a string operator which
+// compares its arguments under collation c.
+func GetFunctionByCollation(name string, c int32) (Function, int64, error) {
+	fid, err := fromNameToFunctionId(name)
+	if err != nil {
+		return emptyFunction, -1, err
+	}
+	for _, f := range functionRegister[fid] {
+		if f.Collation == c && reflect.ValueOf(f.TypeCheckFn).Pointer() == collationTypeCheckPointer {
+			return f, EncodeOverloadID(fid, f.Index), nil
+		}
+	}
+	return emptyFunction, -1, errors.New(errno.UndefinedFunction, fmt.Sprintf("operator '%s' does not support collation '%s'", name, types.CollationName(c)))
+}
+
+// collationTypeCheck never matches, overloads using it are only reachable
+// by GetFunctionByCollation.
+func collationTypeCheck(_ []types.T, _ []types.T, _ types.T) bool {
+	return false
+}
+
 // strictTypeCheck is a general type check method.
 // it returns true only when each input type meets requirement.
 // Watch that : ScalarNull can match each requirement at this function.
@@ -302,9 +333,10 @@ func up(t1, t2 types.T) int {
 }
 
 var (
-	strictTypeCheckPointer   = reflect.ValueOf(strictTypeCheck).Pointer()
-	caseWhenTypeCheckPointer = reflect.ValueOf(operator.CwTypeCheckFn).Pointer()
-	ifTypeCheckPointer       = reflect.ValueOf(operator.IfTypeCheckFn).Pointer()
+	strictTypeCheckPointer    = reflect.ValueOf(strictTypeCheck).Pointer()
+	caseWhenTypeCheckPointer  = reflect.ValueOf(operator.CwTypeCheckFn).Pointer()
+	ifTypeCheckPointer        = reflect.ValueOf(operator.IfTypeCheckFn).Pointer()
+	collationTypeCheckPointer = reflect.ValueOf(collationTypeCheck).Pointer()
 )
 
 // typeCheckWithLevelUp check if the input parameters meet the function requirements.
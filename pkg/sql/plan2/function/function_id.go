@@ -191,12 +191,39 @@ const (
 	SUBSTRING // SUBSTRING
 	WEEK      //WEEK
 	WEEKDAY
-	YEAR // YEAR
+	YEAR        // YEAR
+	HOUR        // HOUR
+	MINUTE      // MINUTE
+	SECOND      // SECOND
+	MICROSECOND // MICROSECOND
 
 	DATE_ADD              // DATE_ADD
 	DATE_SUB              // DATE_SUB
 	APPROX_COUNT_DISTINCT // APPROX_COUNT_DISTINCT, special aggregate
 
+	DATABASE      // DATABASE
+	USER          // USER
+	VERSION       // VERSION
+	CONNECTION_ID // CONNECTION_ID
+
+	UUID // UUID
+
+	NULL_SAFE_EQUAL // <=>
+
+	ASSERT // ASSERT
+	MO_CTL // MO_CTL
+
+	INET_ATON  // INET_ATON
+	INET_NTOA  // INET_NTOA
+	INET6_ATON // INET6_ATON
+	INET6_NTOA // INET6_NTOA
+
+	SUBSTRING_INDEX // SUBSTRING_INDEX
+
+	IMPLICIT_CAST // cast of the string operands in the numeric contexts
+
+	ISNULL // IS NULL
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -211,11 +238,13 @@ var functionIdRegister = map[string]int32{
 	"<":           LESS_THAN,
 	"<=":          LESS_EQUAL,
 	"<>":          NOT_EQUAL,
+	"<=>":         NULL_SAFE_EQUAL,
 	"!=":          NOT_EQUAL,
 	"not":         NOT,
 	"and":         AND,
 	"or":          OR,
 	"xor":         XOR,
+	"isnull":      ISNULL,
 	"like":        LIKE,
 	"between":     BETWEEN,
 	"in":          IN,
@@ -231,6 +260,9 @@ var functionIdRegister = map[string]int32{
 	"unary_minus": UNARY_MINUS,
 	"case":        CASE,
 	"cast":        CAST,
+	// implicit_cast is added by the binder only, it parses the numeric
+	// prefix of a string
+	"implicit_cast": IMPLICIT_CAST,
 	// aggregate
 	"max":                   MAX,
 	"min":                   MIN,
@@ -241,30 +273,48 @@ var functionIdRegister = map[string]int32{
 	"bit_or":                BIT_OR,
 	"bit_and":               BIT_AND,
 	"bit_xor":               BIT_XOR,
+	"std":                   STDDEV_POP,
+	"stddev":                STDDEV_POP,
 	"stddev_pop":            STDDEV_POP,
+	"var_pop":               VAR_POP,
 	"variance":              VAR_POP,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
+	"assert":     ASSERT,
 	"endswith":   ENDSWITH,
 	"findinset":  FINDINSET,
+	"mo_ctl":     MO_CTL,
 	"power":      POW,
 	"startswith": STARTSWITH,
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// variadic functions
-	"ceil":          CEIL,
-	"ceiling":       CEIL,
-	"concat_ws":     CONCAT_WS,
-	"floor":         FLOOR,
-	"lpad":          LPAD,
-	"pi":            PI,
-	"round":         ROUND,
-	"rpad":          RPAD,
-	"substr":        SUBSTRING,
-	"substring":     SUBSTRING,
-	"utc_timestamp": UTC_TIMESTAMP,
+	"ceil":              CEIL,
+	"ceiling":           CEIL,
+	"concat_ws":         CONCAT_WS,
+	"connection_id":     CONNECTION_ID,
+	"current_timestamp": CURRENT_TIMESTAMP,
+	"current_user":      USER,
+	"database":          DATABASE,
+	"floor":             FLOOR,
+	"localtimestamp":    CURRENT_TIMESTAMP,
+	"lpad":              LPAD,
+	"now":               CURRENT_TIMESTAMP,
+	"pi":                PI,
+	"round":             ROUND,
+	"rpad":              RPAD,
+	"session_user":      USER,
+	"substr":            SUBSTRING,
+	"substring":         SUBSTRING,
+	"substring_index":   SUBSTRING_INDEX,
+	"system_user":       USER,
+	"trim":              TRIM,
+	"user":              USER,
+	"utc_timestamp":     UTC_TIMESTAMP,
+	"uuid":              UUID,
+	"version":           VERSION,
 	// unary functions
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	"abs":         ABS,
@@ -275,20 +325,32 @@ var functionIdRegister = map[string]int32{
 	"dayofyear":   DAYOFYEAR,
 	"exp":         EXP,
 	"empty":       EMPTY,
+	"hour":        HOUR,
+	"inet_aton":   INET_ATON,
+	"inet_ntoa":   INET_NTOA,
+	"inet6_aton":  INET6_ATON,
+	"inet6_ntoa":  INET6_NTOA,
 	"length":      LENGTH,
 	"lengthutf8":  LENGTH_UTF8,
 	"char_length": LENGTH_UTF8,
 	"ln":          LN,
 	"log":         LOG,
+	"lower":       LOWER,
+	"lcase":       LOWER,
 	"ltrim":       LTRIM,
+	"microsecond": MICROSECOND,
+	"minute":      MINUTE,
 	"month":       MONTH,
 	"oct":         OCT,
 	"reverse":     REVERSE,
 	"rtrim":       RTRIM,
+	"second":      SECOND,
 	"sin":         SIN,
 	"sinh":        SINH,
 	"space":       SPACE,
 	"tan":         TAN,
+	"ucase":       UPPER,
+	"upper":       UPPER,
 	"week":        WEEK,
 	"weekday":     WEEKDAY,
 	"year":        YEAR,
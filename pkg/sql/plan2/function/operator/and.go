@@ -17,135 +17,44 @@ package operator
 import (
 	"errors"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 func ColAndCol(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	rvs, ok := rv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the right vec col is not []bool type")
-	}
-	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
-	if err != nil {
-		return nil, err
-	}
-	col := make([]bool, len(lvs))
-	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	for i := 0; i < len(lvs); i++ {
-		col[i] = lvs[i] && rvs[i]
-		ln, rn := nulls.Contains(lv.Nsp, uint64(i)), nulls.Contains(rv.Nsp, uint64(i))
-		if (ln && !rn) || (!ln && rn) {
-			if ln && !rn {
-				if !rvs[i] {
-					vec.Nsp.Np.Remove(uint64(i))
-				}
-			} else {
-				if !lvs[i] {
-					vec.Nsp.Np.Remove(uint64(i))
-				}
-			}
-		}
-	}
-	vector.SetCol(vec, col)
-	return vec, nil
+	return logicColCol(lv, rv, proc, and3)
 }
 
 func ColAndConst(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	rvs, ok := rv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the right vec col is not []bool type")
-	}
-	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
-	if err != nil {
-		return nil, err
-	}
-	rb := rvs[0]
-	col := make([]bool, len(lvs))
-	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	for i := 0; i < len(lvs); i++ {
-		col[i] = lvs[i] && rb
-		if nulls.Contains(lv.Nsp, uint64(i)) && !rb {
-			vec.Nsp.Np.Remove(uint64(i))
-		}
-	}
-	vector.SetCol(vec, col)
-	return vec, nil
+	return logicColScalar(lv, rv, proc, and3)
 }
 
 func ColAndNull(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
-	if err != nil {
-		return nil, err
-	}
-	col := make([]bool, len(lvs))
-	for i := 0; i < len(lvs); i++ {
-		if nulls.Contains(lv.Nsp, uint64(i)) || lvs[i] {
-			nulls.Add(vec.Nsp, uint64(i))
-		}
-	}
-	vector.SetCol(vec, col)
-	return vec, nil
+	return logicColScalar(lv, rv, proc, and3)
 }
 
 func ConstAndCol(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return ColAndConst(rv, lv, proc)
+	return logicColScalar(rv, lv, proc, and3)
 }
 
 func ConstAndConst(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	rvs, ok := rv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the right vec col is not []bool type")
-	}
-	vec := proc.AllocScalarVector(lv.Typ)
-	vector.SetCol(vec, []bool{lvs[0] && rvs[0]})
-	return vec, nil
+	return logicScalarScalar(lv, rv, proc, and3)
 }
 
 func ConstAndNull(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	if lvs[0] {
-		return proc.AllocScalarNullVector(lv.Typ), nil
-	} else {
-		vec := proc.AllocScalarVector(lv.Typ)
-		vector.SetCol(vec, []bool{false})
-		return vec, nil
-	}
+	return logicScalarScalar(lv, rv, proc, and3)
 }
 
 func NullAndCol(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return ColAndNull(rv, lv, proc)
+	return logicColScalar(rv, lv, proc, and3)
 }
 
 func NullAndConst(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return ConstAndNull(rv, lv, proc)
+	return logicScalarScalar(lv, rv, proc, and3)
 }
 
 func NullAndNull(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return proc.AllocScalarNullVector(lv.Typ), nil
+	return logicScalarScalar(lv, rv, proc, and3)
 }
 
 func InitFuncMap() {
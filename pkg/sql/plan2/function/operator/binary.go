@@ -1,13 +1,12 @@
 package operator
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/errno"
-	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 var (
 	// ErrDivByZero is reported on a division by zero.
-	ErrDivByZero = errors.New(errno.SyntaxErrororAccessRuleViolation, "division by zero")
+	ErrDivByZero = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "division by zero")
 	// ErrModByZero is reported when computing the rest of a division by zero.
-	ErrModByZero = errors.New(errno.SyntaxErrororAccessRuleViolation, "zero modulus")
+	ErrModByZero = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "zero modulus")
 )
@@ -167,7 +167,7 @@ func cwGeneral[T CwRet](vs []*vector.Vector, proc *process.Process, t types.Type
 		case whenv.IsScalar() && !thenv.IsScalar():
 			if whencols[0] {
 				copy(rscols, thencols)
-				rs.Nsp.Or(thenv.Nsp)
+				nulls.Set(rs.Nsp, thenv.Nsp)
 				return rs, nil
 			}
 		case !whenv.IsScalar() && thenv.IsScalar():
@@ -320,7 +320,7 @@ func cwString(vs []*vector.Vector, proc *process.Process, typ types.Type) (*vect
 				copy(rscols.Data, thencols.Data)
 				copy(rscols.Offsets, thencols.Offsets)
 				copy(rscols.Lengths, thencols.Lengths)
-				rs.Nsp.Or(thenv.Nsp)
+				nulls.Set(rs.Nsp, thenv.Nsp)
 				return rs, nil
 			}
 		case !whenv.IsScalar() && thenv.IsScalar():
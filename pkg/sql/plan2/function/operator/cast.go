@@ -15,6 +15,12 @@
 package operator
 
 import (
+	goErrors "errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -26,14 +32,29 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// Cast converts the vector to the type of the target vector. The result is a
+// scalar if and only if the source is, and it has the logical length of the
+// source, so that a scalar source repeated n times is cast to a scalar
+// repeated n times, and an empty source to an empty vector.
 func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vec, err := cast(vs, proc)
+	if err != nil {
+		return nil, err
+	}
+	lv := vs[0]
+	vec.IsConst = lv.IsScalar()
+	vec.Length = vector.Length(lv)
+	return vec, nil
+}
+
+func cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lv := vs[0]
 	rv := vs[1]
 	if rv.IsScalarNull() {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "the target type of cast function cannot be null")
 	}
 	if lv.IsScalarNull() {
-		return proc.AllocScalarNullVector(lv.Typ), nil
+		return proc.AllocScalarNullVector(rv.Typ), nil
 	}
 
 	if lv.Typ.Oid == rv.Typ.Oid && isNumeric(lv.Typ.Oid) {
@@ -69,9 +90,73 @@ func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 			return CastSameType2[types.Datetime](lv, rv, proc)
 		case types.T_timestamp:
 			return CastSameType2[types.Timestamp](lv, rv, proc)
+		case types.T_time:
+			return CastSameType2[types.Time](lv, rv, proc)
+		}
+	}
+
+	if lv.Typ.Oid == types.T_bool && rv.Typ.Oid == types.T_bool {
+		return CastSameType2[bool](lv, rv, proc)
+	}
+
+	if isNumeric(lv.Typ.Oid) && rv.Typ.Oid == types.T_bool {
+		switch lv.Typ.Oid {
+		case types.T_int8:
+			return CastNumericAsBool[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastNumericAsBool[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastNumericAsBool[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastNumericAsBool[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastNumericAsBool[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastNumericAsBool[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastNumericAsBool[uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastNumericAsBool[uint64](lv, rv, proc)
+		case types.T_float32:
+			return CastNumericAsBool[float32](lv, rv, proc)
+		case types.T_float64:
+			return CastNumericAsBool[float64](lv, rv, proc)
+		}
+	}
+
+	if lv.Typ.Oid == types.T_bool && isNumeric(rv.Typ.Oid) {
+		switch rv.Typ.Oid {
+		case types.T_int8:
+			return CastBoolAsNumeric[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastBoolAsNumeric[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastBoolAsNumeric[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastBoolAsNumeric[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastBoolAsNumeric[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastBoolAsNumeric[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastBoolAsNumeric[uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastBoolAsNumeric[uint64](lv, rv, proc)
+		case types.T_float32:
+			return CastBoolAsNumeric[float32](lv, rv, proc)
+		case types.T_float64:
+			return CastBoolAsNumeric[float64](lv, rv, proc)
 		}
 	}
 
+	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_bool {
+		return CastStringAsBool(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_bool && isString(rv.Typ.Oid) {
+		return CastBoolAsString(lv, rv, proc)
+	}
+
 	if lv.Typ.Oid != rv.Typ.Oid && isNumeric(lv.Typ.Oid) && isNumeric(rv.Typ.Oid) {
 		switch lv.Typ.Oid {
 		case types.T_int8:
@@ -298,13 +383,13 @@ func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		case types.T_int64:
 			return CastSpecials1Int[int64](lv, rv, proc)
 		case types.T_uint8:
-			return CastSpecials1Int[uint8](lv, rv, proc)
+			return CastSpecials1Uint[uint8](lv, rv, proc)
 		case types.T_uint16:
-			return CastSpecials1Int[uint16](lv, rv, proc)
+			return CastSpecials1Uint[uint16](lv, rv, proc)
 		case types.T_uint32:
-			return CastSpecials1Int[uint32](lv, rv, proc)
+			return CastSpecials1Uint[uint32](lv, rv, proc)
 		case types.T_uint64:
-			return CastSpecials1Int[uint64](lv, rv, proc)
+			return CastSpecials1Uint[uint64](lv, rv, proc)
 		}
 	}
 
@@ -317,6 +402,15 @@ func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		}
 	}
 
+	if isString(lv.Typ.Oid) && isDecimal(rv.Typ.Oid) {
+		switch rv.Typ.Oid {
+		case types.T_decimal64:
+			return CastSpecials1Decimal64(lv, rv, proc)
+		case types.T_decimal128:
+			return CastSpecials1Decimal128(lv, rv, proc)
+		}
+	}
+
 	if isInteger(lv.Typ.Oid) && isString(rv.Typ.Oid) {
 		switch lv.Typ.Oid {
 		case types.T_int8:
@@ -328,13 +422,13 @@ func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		case types.T_int64:
 			return CastSpecials2Int[int64](lv, rv, proc)
 		case types.T_uint8:
-			return CastSpecials2Int[uint8](lv, rv, proc)
+			return CastSpecials2Uint[uint8](lv, rv, proc)
 		case types.T_uint16:
-			return CastSpecials2Int[uint16](lv, rv, proc)
+			return CastSpecials2Uint[uint16](lv, rv, proc)
 		case types.T_uint32:
-			return CastSpecials2Int[uint32](lv, rv, proc)
+			return CastSpecials2Uint[uint32](lv, rv, proc)
 		case types.T_uint64:
-			return CastSpecials2Int[uint64](lv, rv, proc)
+			return CastSpecials2Uint[uint64](lv, rv, proc)
 		}
 	}
 
@@ -377,6 +471,56 @@ func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		}
 	}
 
+	if lv.Typ.Oid == types.T_decimal64 && (isInteger(rv.Typ.Oid) || isFloat(rv.Typ.Oid)) {
+		switch rv.Typ.Oid {
+		case types.T_int8:
+			return CastDecimal64AsInteger[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastDecimal64AsInteger[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastDecimal64AsInteger[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastDecimal64AsInteger[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastDecimal64AsInteger[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastDecimal64AsInteger[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastDecimal64AsInteger[uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastDecimal64AsInteger[uint64](lv, rv, proc)
+		case types.T_float32:
+			return CastDecimal64AsFloat[float32](lv, rv, proc)
+		case types.T_float64:
+			return CastDecimal64AsFloat[float64](lv, rv, proc)
+		}
+	}
+
+	if lv.Typ.Oid == types.T_decimal128 && (isInteger(rv.Typ.Oid) || isFloat(rv.Typ.Oid)) {
+		switch rv.Typ.Oid {
+		case types.T_int8:
+			return CastDecimal128AsInteger[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastDecimal128AsInteger[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastDecimal128AsInteger[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastDecimal128AsInteger[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastDecimal128AsInteger[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastDecimal128AsInteger[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastDecimal128AsInteger[uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastDecimal128AsInteger[uint64](lv, rv, proc)
+		case types.T_float32:
+			return CastDecimal128AsFloat[float32](lv, rv, proc)
+		case types.T_float64:
+			return CastDecimal128AsFloat[float64](lv, rv, proc)
+		}
+	}
+
 	// sametype
 	if lv.Typ.Oid == types.T_decimal64 && rv.Typ.Oid == types.T_decimal64 {
 		return CastDecimal64AsDecimal64(lv, rv, proc)
@@ -405,6 +549,91 @@ func Cast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	if lv.Typ.Oid == types.T_timestamp && rv.Typ.Oid == types.T_datetime {
 		return castTimeStampAsDatetime(lv, rv, proc)
 	}
+
+	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_time {
+		return CastVarcharAsTime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && isString(rv.Typ.Oid) {
+		return CastTimeAsVarchar(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_datetime && rv.Typ.Oid == types.T_time {
+		return CastDatetimeAsTime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && rv.Typ.Oid == types.T_datetime {
+		return CastTimeAsDatetime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_date && rv.Typ.Oid == types.T_datetime {
+		return CastDateAsDatetime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_datetime && rv.Typ.Oid == types.T_timestamp {
+		return CastDatetimeAsTimestamp(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_timestamp && rv.Typ.Oid == types.T_date {
+		return CastTimestampAsDate(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_date && rv.Typ.Oid == types.T_timestamp {
+		return CastDateAsTimestamp(lv, rv, proc)
+	}
+
+	if rv.Typ.Oid == types.T_bit {
+		switch lv.Typ.Oid {
+		case types.T_bit, types.T_uint64:
+			return CastIntegerAsBit[uint64](lv, rv, proc)
+		case types.T_int8:
+			return CastIntegerAsBit[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastIntegerAsBit[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastIntegerAsBit[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastIntegerAsBit[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastIntegerAsBit[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastIntegerAsBit[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastIntegerAsBit[uint32](lv, rv, proc)
+		case types.T_char, types.T_varchar:
+			return CastStringAsBit(lv, rv, proc)
+		}
+	}
+
+	if lv.Typ.Oid == types.T_bit {
+		// the value of a bit is the unsigned integer of its bits
+		switch rv.Typ.Oid {
+		case types.T_int8:
+			return CastLeftToRight[uint64, int8](lv, rv, proc)
+		case types.T_int16:
+			return CastLeftToRight[uint64, int16](lv, rv, proc)
+		case types.T_int32:
+			return CastLeftToRight[uint64, int32](lv, rv, proc)
+		case types.T_int64:
+			return CastLeftToRight[uint64, int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastLeftToRight[uint64, uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastLeftToRight[uint64, uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastLeftToRight[uint64, uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastSameType[uint64](lv, rv, proc)
+		case types.T_float32:
+			return CastLeftToRight[uint64, float32](lv, rv, proc)
+		case types.T_float64:
+			return CastLeftToRight[uint64, float64](lv, rv, proc)
+		case types.T_bool:
+			return CastNumericAsBool[uint64](lv, rv, proc)
+		case types.T_char, types.T_varchar:
+			return CastBitAsString(lv, rv, proc)
+		}
+	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "parameter types of cast function do not match")
 }
 
@@ -443,11 +672,13 @@ func CastSameType[T constraints.Integer | constraints.Float](lv, rv *vector.Vect
 	return vec, nil
 }
 
-//  CastSameType2: Cast handles the same data type and is date series , Contains the following:
+//  CastSameType2: Cast handles the same data type and is date series or bool, Contains the following:
 // date -> date
 // datetime -> datetime
 // timestamp -> timestamp
-func CastSameType2[T types.Date | types.Datetime | types.Timestamp](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+// time -> time
+// bool -> bool
+func CastSameType2[T types.Date | types.Datetime | types.Timestamp | types.Time | bool](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	rtl := rv.Typ.Oid.FixedLength()
 	lvs := lv.Col.([]T)
 
@@ -483,6 +714,8 @@ func CastSameType2[T types.Date | types.Datetime | types.Timestamp](lv, rv *vect
 // uint64 -> (int8/int16/int32/int64/uint8/uint16/uint32/float32/float64)
 // float32 -> (int8/int16/int32/int64/uint8/uint16/uint32/uint64/float64)
 // float64 -> (int8/int16/int32/int64/uint8/uint16/uint32/uint64/float32)
+// a value which does not fit the right type is an out of range error in the
+// strict mode, or clamped to the range of the right type with a warning.
 func CastLeftToRight[T1, T2 constraints.Integer | constraints.Float](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	rtl := rv.Typ.Oid.FixedLength()
 	lvs := lv.Col.([]T1)
@@ -490,7 +723,7 @@ func CastLeftToRight[T1, T2 constraints.Integer | constraints.Float](lv, rv *vec
 	if lv.IsScalar() {
 		vec := proc.AllocScalarVector(rv.Typ)
 		rs := make([]T2, 1)
-		if _, err := typecast.NumericToNumeric(lvs, rs); err != nil {
+		if err := castNumericToNumeric(lv, rv, lvs, rs, proc); err != nil {
 			return nil, err
 		}
 		nulls.Set(vec.Nsp, lv.Nsp)
@@ -504,7 +737,62 @@ func CastLeftToRight[T1, T2 constraints.Integer | constraints.Float](lv, rv *vec
 		return nil, err
 	}
 	rs := encoding.DecodeFixedSlice[T2](vec.Data, rtl)
-	if _, err := typecast.NumericToNumeric(lvs, rs); err != nil {
+	if err := castNumericToNumeric(lv, rv, lvs, rs, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastNumericAsBool: Cast converts the integers and the floats to bool, a
+// number which is not zero is true
+func CastNumericAsBool[T constraints.Integer | constraints.Float](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]T)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]bool, 1)
+		if _, err := typecast.NumericToBool(lvs, rs); err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeFixedSlice[bool](vec.Data, rv.Typ.Oid.FixedLength())
+	if _, err := typecast.NumericToBool(lvs, rs); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastBoolAsNumeric: Cast converts bool to the integers and the floats, true
+// is 1 and false is 0
+func CastBoolAsNumeric[T constraints.Integer | constraints.Float](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]bool)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]T, 1)
+		if _, err := typecast.BoolToNumeric(lvs, rs); err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, rs, err := allocNumericCastResult[T](lv, rv, len(lvs), proc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := typecast.BoolToNumeric(lvs, rs); err != nil {
 		return nil, err
 	}
 	nulls.Set(vec.Nsp, lv.Nsp)
@@ -512,8 +800,45 @@ func CastLeftToRight[T1, T2 constraints.Integer | constraints.Float](lv, rv *vec
 	return vec, nil
 }
 
+// castNumericToNumeric converts the numbers of lv to rs of the type of rv.
+// The warnings of a scalar are raised once per row it stands for, as the
+// ones of a vector of the same rows.
+func castNumericToNumeric[T1, T2 constraints.Integer | constraints.Float](lv, rv *vector.Vector, lvs []T1, rs []T2, proc *process.Process) error {
+	if proc.SessionInfo.StrictMode {
+		_, err := typecast.NumericToNumericOverflow(lvs, rs, rv.Typ.Oid, lv.Nsp)
+		return err
+	}
+	_, clamped := typecast.NumericToNumericSaturate(lvs, rs, lv.Nsp)
+	warnOutOfRange(lv, rv, clamped, func(i int) interface{} { return lvs[i] }, proc)
+	return nil
+}
+
+// castDecimalToInteger checks the rows of lv clamped by a cast to the
+// integers of rv: the first one is an out of range error in the strict mode,
+// else they are warnings.
+func castDecimalToInteger(lv, rv *vector.Vector, clamped []int, value func(int) interface{}, proc *process.Process) error {
+	if len(clamped) > 0 && proc.SessionInfo.StrictMode {
+		return typecast.NumericOutOfRangeError(value(clamped[0]), rv.Typ.Oid)
+	}
+	warnOutOfRange(lv, rv, clamped, value, proc)
+	return nil
+}
+
+// warnOutOfRange adds a warning of each row of lv clamped by a cast to the
+// type of rv, value returns the value of a row.
+func warnOutOfRange(lv, rv *vector.Vector, clamped []int, value func(int) interface{}, proc *process.Process) {
+	for _, i := range clamped {
+		err := typecast.NumericOutOfRangeError(value(i), rv.Typ.Oid)
+		code, _ := moerr.MySQLError(err)
+		proc.AddWarning("Warning", code, err.Error())
+		if n := vector.Length(lv); lv.IsScalar() && n > 1 {
+			proc.AddWarnings(n - 1)
+		}
+	}
+}
+
 //  CastSpecials1Int: Cast converts string to integer,Contains the following:
-// (char / varhcar) -> (int8 / int16 / int32/ int64 / uint8 / uint16 / uint32 / uint64)
+// (char / varhcar) -> (int8 / int16 / int32/ int64)
 func CastSpecials1Int[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	rtl := rv.Typ.Oid.FixedLength()
 	col := lv.Col.(*types.Bytes)
@@ -530,7 +855,40 @@ func CastSpecials1Int[T constraints.Integer](lv, rv *vector.Vector, proc *proces
 		}
 		rs = encoding.DecodeFixedSlice[T](vec.Data, rtl)
 	}
-	if _, err = typecast.BytesToInt(col, rs); err != nil {
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []T, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToInt(xs, rs, nsp)
+		return err
+	}, proc); err != nil {
+		return nil, err
+	}
+
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastSpecials1Uint: Cast converts string to unsigned integer,Contains the following:
+// (char / varhcar) -> (uint8 / uint16 / uint32 / uint64)
+func CastSpecials1Uint[T constraints.Unsigned](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := rv.Typ.Oid.FixedLength()
+	col := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []T
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]T, 1)
+	} else {
+		vec, err = proc.AllocVector(rv.Typ, int64(rtl)*int64(len(col.Offsets)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeFixedSlice[T](vec.Data, rtl)
+	}
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []T, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToUint(xs, rs, nsp)
+		return err
+	}, proc); err != nil {
 		return nil, err
 	}
 
@@ -557,17 +915,144 @@ func CastSpecials1Float[T constraints.Float](lv, rv *vector.Vector, proc *proces
 		}
 		rs = encoding.DecodeFixedSlice[T](vec.Data, rtl)
 	}
-	if _, err = typecast.BytesToFloat(col, rs); err != nil {
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []T, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToFloat(xs, rs, nsp)
+		return err
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+//  CastSpecials1Decimal64: Cast converts string to decimal64,Contains the following:
+// (char / varhcar) -> decimal64
+func CastSpecials1Decimal64(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultTyp := decimalCastType(rv.Typ, 18)
+	col := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []types.Decimal64
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(resultTyp)
+		rs = make([]types.Decimal64, 1)
+	} else {
+		vec, err = proc.AllocVector(resultTyp, int64(resultTyp.Size)*int64(len(col.Offsets)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeDecimal64Slice(vec.Data)
+		rs = rs[:len(col.Offsets)]
+	}
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []types.Decimal64, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToDecimal64(xs, rs, resultTyp.Width, resultTyp.Scale, nsp)
+		return err
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+//  CastSpecials1Decimal128: Cast converts string to decimal128,Contains the following:
+// (char / varhcar) -> decimal128
+func CastSpecials1Decimal128(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultTyp := decimalCastType(rv.Typ, 38)
+	col := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []types.Decimal128
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(resultTyp)
+		rs = make([]types.Decimal128, 1)
+	} else {
+		vec, err = proc.AllocVector(resultTyp, int64(resultTyp.Size)*int64(len(col.Offsets)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeDecimal128Slice(vec.Data)
+		rs = rs[:len(col.Offsets)]
+	}
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []types.Decimal128, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToDecimal128(xs, rs, resultTyp.Width, resultTyp.Scale, nsp)
+		return err
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// decimalCastType returns the decimal type a cast converts to, a cast to a
+// decimal without a width is a cast to the widest one.
+func decimalCastType(typ types.Type, maxWidth int32) types.Type {
+	typ.Size = int32(typ.Oid.TypeLen())
+	if typ.Width == 0 {
+		typ.Width = maxWidth
+	}
+	return typ
+}
+
+// ImplicitCast converts the string operands of the arithmetic operators and
+// of the comparisons with numbers to float64. Unlike Cast, a string which is
+// not a number is converted by its numeric prefix, with a warning, or with an
+// error in the strict mode.
+func ImplicitCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lv, rv := vs[0], vs[1]
+	if lv.IsScalarNull() {
+		return proc.AllocScalarNullVector(rv.Typ), nil
+	}
+	vec, err := castStringAsFloatPrefix(lv, rv, proc)
+	if err != nil {
+		return nil, err
+	}
+	vec.IsConst = lv.IsScalar()
+	vec.Length = vector.Length(lv)
+	return vec, nil
+}
+
+func castStringAsFloatPrefix(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := rv.Typ.Oid.FixedLength()
+	col := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []float64
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]float64, 1)
+	} else {
+		vec, err = proc.AllocVector(rv.Typ, int64(rtl)*int64(len(col.Offsets)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeFixedSlice[float64](vec.Data, rtl)
+	}
+	rs, truncated, err := typecast.BytesToFloatPrefix(col, rs)
+	if err != nil {
 		return nil, err
 	}
+	warnings := 0
+	for _, i := range truncated {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		if proc.SessionInfo.StrictMode {
+			return nil, moerr.NewError(moerr.TRUNCATED_WRONG_VALUE, fmt.Sprintf("Truncated incorrect DOUBLE value: '%s'", col.Get(int64(i))))
+		}
+		warnings++
+	}
+	proc.AddWarnings(warnings)
 	nulls.Set(vec.Nsp, lv.Nsp)
 	vector.SetCol(vec, rs)
 	return vec, nil
 }
 
 //  CastSpecials2Int: Cast converts integer to string,Contains the following:
-// (int8 /int16/int32/int64/uint8/uint16/uint32/uint64) -> (char / varhcar)
-func CastSpecials2Int[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+// (int8 /int16/int32/int64) -> (char / varhcar)
+func CastSpecials2Int[T constraints.Signed](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	var err error
 	lvs := lv.Col.([]T)
 	col := &types.Bytes{
@@ -591,8 +1076,34 @@ func CastSpecials2Int[T constraints.Integer](lv, rv *vector.Vector, proc *proces
 	return vec, nil
 }
 
-//  CastSpecials2Float: Cast converts floating point number to string ,Contains the following:
-// (float32/float64) -> (char / varhcar)
+//  CastSpecials2Uint: Cast converts unsigned integer to string,Contains the following:
+// (uint8/uint16/uint32/uint64) -> (char / varhcar)
+func CastSpecials2Uint[T constraints.Unsigned](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	var err error
+	lvs := lv.Col.([]T)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(lvs)),
+		Offsets: make([]uint32, 0, len(lvs)),
+		Lengths: make([]uint32, 0, len(lvs)),
+	}
+	if col, err = typecast.UintToBytes(lvs, col); err != nil {
+		return nil, err
+	}
+	if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	if lv.IsScalar() {
+		vec.IsConst = true
+	}
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+//  CastSpecials2Float: Cast converts floating point number to string ,Contains the following:
+// (float32/float64) -> (char / varhcar)
 func CastSpecials2Float[T constraints.Float](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	var err error
 	lvs := lv.Col.([]T)
@@ -617,6 +1128,60 @@ func CastSpecials2Float[T constraints.Float](lv, rv *vector.Vector, proc *proces
 	return vec, nil
 }
 
+// CastStringAsBool: Cast converts char/varchar to bool, the strings are
+// "true", "false", "1" and "0" in any case
+func CastStringAsBool(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	col := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []bool
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]bool, 1)
+	} else {
+		vec, err = proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(col.Offsets)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeFixedSlice[bool](vec.Data, rv.Typ.Oid.FixedLength())
+	}
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []bool, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToBool(xs, rs, nsp)
+		return err
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastBoolAsString: Cast converts bool to char/varchar, the strings are
+// "true" and "false"
+func CastBoolAsString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	var err error
+	lvs := lv.Col.([]bool)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(lvs)),
+		Offsets: make([]uint32, 0, len(lvs)),
+		Lengths: make([]uint32, 0, len(lvs)),
+	}
+	if col, err = typecast.BoolToBytes(lvs, col); err != nil {
+		return nil, err
+	}
+	if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	if lv.IsScalar() {
+		vec.IsConst = true
+	}
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
 //
 //  CastSpecials3:  Cast converts string to string ,Contains the following:
 // char -> char
@@ -692,119 +1257,130 @@ func CastSpecialu4[T constraints.Unsigned](lv, rv *vector.Vector, proc *process.
 
 //  CastVarcharAsDate : Cast converts varchar to date type
 func CastVarcharAsDate(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	vs := lv.Col.(*types.Bytes)
+	return castStringAsTemporal(lv, rv, func(xs *types.Bytes, rs []types.Date, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToDate(xs, rs, nsp)
+		return err
+	}, proc)
+}
+
+// CastVarcharAsDatetime : Cast converts varchar to datetime type
+func CastVarcharAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castStringAsTemporal(lv, rv, func(xs *types.Bytes, rs []types.Datetime, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToDatetime(xs, rs, nsp)
+		return err
+	}, proc)
+}
+
+// CastVarcharAsTimestamp : Cast converts varchar to timestamp type
+func CastVarcharAsTimestamp(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castStringAsTemporal(lv, rv, func(xs *types.Bytes, rs []types.Timestamp, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToTimestamp(xs, rs, 6, nsp)
+		return err
+	}, proc)
+}
 
+// castStringAsTemporal converts char/varchar to the date and time types by
+// parse, a string which is not a value of the type is null in the non-strict
+// mode.
+func castStringAsTemporal[T types.Date | types.Datetime | types.Timestamp | types.Time](lv, rv *vector.Vector, parse func(*types.Bytes, []T, *nulls.Nulls) error, proc *process.Process) (*vector.Vector, error) {
+	vs := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []T
 	if lv.IsScalar() {
-		vec := proc.AllocScalarVector(rv.Typ)
-		rs := make([]types.Date, 1)
-		varcharValue := vs.Get(0)
-		data, err2 := types.ParseDate(string(varcharValue))
-		if err2 != nil {
-			return nil, err2
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]T, 1)
+	} else {
+		vec, err = proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength()*len(vs.Lengths)))
+		if err != nil {
+			return nil, err
 		}
-		rs[0] = data
-		nulls.Set(vec.Nsp, lv.Nsp)
-		vector.SetCol(vec, rs)
-		return vec, nil
+		rs = encoding.DecodeFixedSlice[T](vec.Data, rv.Typ.Oid.FixedLength())
+		rs = rs[:len(vs.Lengths)]
 	}
-
-	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength()*len(vs.Lengths)))
-	if err != nil {
+	if err = castStrings(lv, rv, vec, rs, true, parse, proc); err != nil {
 		return nil, err
 	}
-	rs := encoding.DecodeDateSlice(vec.Data)
-	rs = rs[:len(vs.Lengths)]
-	for i := range vs.Lengths {
-		if nulls.Contains(lv.Nsp, uint64(i)) {
-			continue
-		}
-		varcharValue := vs.Get(int64(i))
-		data, err2 := types.ParseDate(string(varcharValue))
-		if err2 != nil {
-			return nil, err2
-		}
-		rs[i] = data
-	}
 	nulls.Set(vec.Nsp, lv.Nsp)
 	vector.SetCol(vec, rs)
 	return vec, nil
 }
 
-// CastVarcharAsDatetime : Cast converts varchar to datetime type
-func CastVarcharAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	vs := lv.Col.(*types.Bytes)
+// maxCastErrorValueLength is the max length of the value of a row quoted by
+// the error of a cast, a longer value is truncated.
+const maxCastErrorValueLength = 64
 
-	if lv.IsScalar() {
-		vec := proc.AllocScalarVector(rv.Typ)
-		rs := make([]types.Datetime, 1)
-		varcharValue := vs.Get(0)
-		data, err2 := types.ParseDatetime(string(varcharValue))
-		if err2 != nil {
-			return nil, err2
+// castStrings parses the not null strings of lv to rs, the rows of vec, by
+// parse, which returns a *typecast.RowError of the first string it can not
+// parse. The error of the string, which names its row, its value and the
+// types of the cast, is returned in the strict mode. Else it is a warning,
+// the row is zero, or null if null is true, and the rows after it are
+// parsed one by one.
+func castStrings[T any](lv, rv, vec *vector.Vector, rs []T, null bool, parse func(*types.Bytes, []T, *nulls.Nulls) error, proc *process.Process) error {
+	col := lv.Col.(*types.Bytes)
+	row := 0
+	err := parse(col, rs, lv.Nsp)
+	for err != nil {
+		rowErr, ok := err.(*typecast.RowError)
+		if !ok {
+			return err
 		}
-		rs[0] = data
-		nulls.Set(vec.Nsp, lv.Nsp)
-		vector.SetCol(vec, rs)
-		return vec, nil
-	}
-
-	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength()*len(vs.Lengths)))
-	if err != nil {
-		return nil, err
-	}
-	rs := encoding.DecodeDatetimeSlice(vec.Data)
-	rs = rs[:len(vs.Lengths)]
-	for i := range vs.Lengths {
-		if nulls.Contains(lv.Nsp, uint64(i)) {
-			continue
+		row += rowErr.Row
+		err = castStringError(rowErr, row, lv.Typ, rv.Typ)
+		if proc.SessionInfo.StrictMode {
+			return err
+		}
+		code, _ := moerr.MySQLError(err)
+		proc.AddWarning("Warning", code, err.Error())
+		if n := vector.Length(lv); lv.IsScalar() && n > 1 {
+			proc.AddWarnings(n - 1)
+		}
+		var zero T
+		rs[row] = zero
+		if null {
+			nulls.Add(vec.Nsp, uint64(row))
 		}
-		varcharValue := vs.Get(int64(i))
-		data, err2 := types.ParseDatetime(string(varcharValue))
-		if err2 != nil {
-			return nil, err2
+
+		err = nil
+		none := &nulls.Nulls{}
+		for row++; row < len(col.Offsets); row++ {
+			if nulls.Contains(lv.Nsp, uint64(row)) {
+				continue
+			}
+			one := &types.Bytes{
+				Data:    col.Data,
+				Offsets: col.Offsets[row : row+1],
+				Lengths: col.Lengths[row : row+1],
+			}
+			if err = parse(one, rs[row:row+1], none); err != nil {
+				break
+			}
 		}
-		rs[i] = data
 	}
-	nulls.Set(vec.Nsp, lv.Nsp)
-	vector.SetCol(vec, rs)
-	return vec, nil
+	return nil
 }
 
-// CastVarcharAsTimestamp : Cast converts varchar to timestamp type
-func CastVarcharAsTimestamp(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	vs := lv.Col.(*types.Bytes)
-
-	if lv.IsScalar() {
-		scalarVector := proc.AllocScalarVector(rv.Typ)
-		rs := make([]types.Timestamp, 1)
-		strBytes := vs.Get(0)
-		data, err := types.ParseTimestamp(string(strBytes), 6)
-		if err != nil {
-			return nil, err
+// castStringError is the error of the cast of the string of a row, which is
+// an out of range error or else an incorrect value error.
+func castStringError(rowErr *typecast.RowError, row int, from, to types.Type) error {
+	value := rowErr.Value
+	if len(value) > maxCastErrorValueLength {
+		n := maxCastErrorValueLength
+		for n > 0 && !utf8.RuneStart(value[n]) {
+			n--
 		}
-		rs[0] = data
-		nulls.Set(scalarVector.Nsp, lv.Nsp)
-		vector.SetCol(scalarVector, rs)
-		return scalarVector, nil
+		value = value[:n] + "..."
 	}
-
-	allocVector, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength()*len(vs.Lengths)))
-	if err != nil {
-		return nil, err
+	reason := rowErr.Err.Error()
+	var numErr *strconv.NumError
+	if goErrors.As(rowErr.Err, &numErr) {
+		reason = numErr.Err.Error()
 	}
-	rs := encoding.DecodeTimestampSlice(allocVector.Data)
-	rs = rs[:len(vs.Lengths)]
-	for i := range vs.Lengths {
-		strBytes := vs.Get(int64(i))
-		data, err := types.ParseTimestamp(string(strBytes), 6)
-		if err != nil {
-			return nil, err
-		}
-		rs[i] = data
+	code := int32(moerr.TRUNCATED_WRONG_VALUE)
+	if moerr.Code(rowErr.Err) == moerr.OUT_OF_RANGE {
+		code = moerr.OUT_OF_RANGE
 	}
-	nulls.Set(allocVector.Nsp, lv.Nsp)
-	vector.SetCol(allocVector, rs)
-	return allocVector, nil
+	return moerr.NewError(code, fmt.Sprintf("cannot cast '%s' from %s to %s at row %d: %s", value, from, to, row+1, reason))
 }
 
 // CastDecimal64AsDecimal128: Cast converts decimal64 to timestamp decimal128
@@ -889,7 +1465,92 @@ func CastDecimal128AsDecimal128(lv, rv *vector.Vector, proc *process.Process) (*
 	return vec, nil
 }
 
-//  castTimeStampAsDatetime : Cast converts timestamp to datetime decimal128
+// CastDecimal64AsFloat: Cast converts decimal64 to float32 / float64 by the
+// scale of the decimal
+func CastDecimal64AsFloat[T constraints.Float](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Decimal64)
+	vec, rs, err := allocNumericCastResult[T](lv, rv, len(lvs), proc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = typecast.Decimal64ToFloat(lvs, lv.Typ.Scale, rs); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDecimal128AsFloat: Cast converts decimal128 to float32 / float64 by the
+// scale of the decimal
+func CastDecimal128AsFloat[T constraints.Float](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Decimal128)
+	vec, rs, err := allocNumericCastResult[T](lv, rv, len(lvs), proc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = typecast.Decimal128ToFloat(lvs, lv.Typ.Scale, rs); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDecimal64AsInteger: Cast converts decimal64 to the integers, rounding
+// half away from zero by the scale of the decimal
+func CastDecimal64AsInteger[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Decimal64)
+	vec, rs, err := allocNumericCastResult[T](lv, rv, len(lvs), proc)
+	if err != nil {
+		return nil, err
+	}
+	_, clamped := typecast.Decimal64ToInteger(lvs, lv.Typ.Scale, rs, lv.Nsp)
+	if err = castDecimalToInteger(lv, rv, clamped, func(i int) interface{} {
+		return types.FormatDecimal64(lvs[i], lv.Typ.Scale)
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDecimal128AsInteger: Cast converts decimal128 to the integers, rounding
+// half away from zero by the scale of the decimal
+func CastDecimal128AsInteger[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Decimal128)
+	vec, rs, err := allocNumericCastResult[T](lv, rv, len(lvs), proc)
+	if err != nil {
+		return nil, err
+	}
+	_, clamped := typecast.Decimal128ToInteger(lvs, lv.Typ.Scale, rs, lv.Nsp)
+	if err = castDecimalToInteger(lv, rv, clamped, func(i int) interface{} {
+		return types.FormatDecimal128(lvs[i], lv.Typ.Scale)
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// allocNumericCastResult returns the result vector of a cast of the n rows of
+// lv to the numbers of rv, and its column
+func allocNumericCastResult[T constraints.Integer | constraints.Float](lv, rv *vector.Vector, n int, proc *process.Process) (*vector.Vector, []T, error) {
+	if lv.IsScalar() {
+		return proc.AllocScalarVector(rv.Typ), make([]T, 1), nil
+	}
+	rtl := rv.Typ.Oid.FixedLength()
+	vec, err := proc.AllocVector(rv.Typ, int64(rtl)*int64(n))
+	if err != nil {
+		return nil, nil, err
+	}
+	return vec, encoding.DecodeFixedSlice[T](vec.Data, rtl)[:n], nil
+}
+
+//  castTimeStampAsDatetime : Cast converts timestamp to datetime type, the
+// datetime is in the time zone of the server
 func castTimeStampAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	rtl := 8
 	lvs := lv.Col.([]types.Timestamp)
@@ -918,6 +1579,304 @@ func castTimeStampAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vec
 	return vec, nil
 }
 
+// CastVarcharAsTime : Cast converts char/varchar to time type, the fractional
+// seconds are rounded to the precision of the result
+func CastVarcharAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castStringAsTemporal(lv, rv, func(xs *types.Bytes, rs []types.Time, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToTime(xs, rs, rv.Typ.Precision, nsp)
+		return err
+	}, proc)
+}
+
+// CastTimeAsVarchar : Cast converts time to char/varchar type, the fractional
+// seconds are printed with the precision of the time
+func CastTimeAsVarchar(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Time)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(lvs)),
+		Offsets: make([]uint32, 0, len(lvs)),
+		Lengths: make([]uint32, 0, len(lvs)),
+	}
+	for i, v := range lvs {
+		s := v.String2(lv.Typ.Precision)
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			s = ""
+		}
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(s)))
+		col.Data = append(col.Data, s...)
+	}
+	if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	if lv.IsScalar() {
+		vec.IsConst = true
+	}
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// CastDatetimeAsTime : Cast converts datetime to time type, which is the time
+// of day part of the datetime
+func CastDatetimeAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Datetime)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := []types.Time{lvs[0].ToTime()}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeTimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i, v := range lvs {
+		rs[i] = v.ToTime()
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDateAsDatetime : Cast converts date to datetime type, the datetime is
+// the start of the day
+func CastDateAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Date)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := []types.Datetime{lvs[0].ToTime()}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeDatetimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i, v := range lvs {
+		rs[i] = v.ToTime()
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDatetimeAsTimestamp : Cast converts datetime to timestamp type, the
+// datetime is in the time zone of the server
+func CastDatetimeAsTimestamp(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Datetime)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]types.Timestamp, 1)
+		if _, err := typecast.DatetimeToTimestamp(lvs, rs); err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeTimestampSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	if _, err := typecast.DatetimeToTimestamp(lvs, rs); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastTimestampAsDate : Cast converts timestamp to date type, the date is the
+// day of the timestamp in the time zone of the server
+func CastTimestampAsDate(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Timestamp)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := []types.Date{lvs[0].ToDate()}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeDateSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i, v := range lvs {
+		rs[i] = v.ToDate()
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDateAsTimestamp : Cast converts date to timestamp type, the timestamp
+// is the start of the day in the time zone of the server
+func CastDateAsTimestamp(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Date)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := []types.Timestamp{lvs[0].ToTimeUTC()}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeTimestampSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i, v := range lvs {
+		rs[i] = v.ToTimeUTC()
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastTimeAsDatetime : Cast converts time to datetime type, the time is taken
+// as the elapsed time since the start of the current day
+func CastTimeAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]types.Time)
+	today := types.Today()
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := []types.Datetime{lvs[0].ToDatetime(today)}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeDatetimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i, v := range lvs {
+		rs[i] = v.ToDatetime(today)
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastIntegerAsBit : Cast converts integers or bits to bit type. A value
+// which does not fit the width of the bit is an out of range error in the
+// strict mode, or clamped to 0 or to all the bits with a warning.
+func CastIntegerAsBit[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]T)
+	var vec *vector.Vector
+	var err error
+	var rs []uint64
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]uint64, 1)
+	} else {
+		vec, err = proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength())*int64(len(lvs)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeFixedSlice[uint64](vec.Data, rv.Typ.Oid.FixedLength())
+		rs = rs[:len(lvs)]
+	}
+	var clamped []int
+	for i, v := range lvs {
+		switch {
+		case v < 0:
+			rs[i] = 0
+		case types.BitFits(uint64(v), rv.Typ.Width):
+			rs[i] = uint64(v)
+			continue
+		default:
+			rs[i] = 1<<uint(rv.Typ.Width) - 1
+		}
+		if !nulls.Contains(lv.Nsp, uint64(i)) {
+			clamped = append(clamped, i)
+		}
+	}
+	if len(clamped) > 0 && proc.SessionInfo.StrictMode {
+		return nil, typecast.NumericOutOfRangeError(lvs[clamped[0]], rv.Typ.Oid)
+	}
+	warnOutOfRange(lv, rv, clamped, func(i int) interface{} { return lvs[i] }, proc)
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastStringAsBit : Cast converts char/varchar to bit type, the bits are the
+// ones of the bytes of the string
+func CastStringAsBit(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vs := lv.Col.(*types.Bytes)
+	var vec *vector.Vector
+	var err error
+	var rs []uint64
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]uint64, 1)
+	} else {
+		vec, err = proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.FixedLength()*len(vs.Lengths)))
+		if err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeFixedSlice[uint64](vec.Data, rv.Typ.Oid.FixedLength())
+		rs = rs[:len(vs.Lengths)]
+	}
+	if err = castStrings(lv, rv, vec, rs, false, func(xs *types.Bytes, rs []uint64, nsp *nulls.Nulls) error {
+		_, err := typecast.BytesToBit(xs, rs, rv.Typ.Width, nsp)
+		return err
+	}, proc); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastBitAsString : Cast converts bit to char/varchar type, the string is the
+// binary string of the (width+7)/8 bytes of the bit
+func CastBitAsString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := lv.Col.([]uint64)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(lvs)),
+		Offsets: make([]uint32, 0, len(lvs)),
+		Lengths: make([]uint32, 0, len(lvs)),
+	}
+	col, err := typecast.BitToBytes(lvs, lv.Typ.Width, col)
+	if err != nil {
+		return nil, err
+	}
+	if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	if lv.IsScalar() {
+		vec.IsConst = true
+	}
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
 //  isInteger return true if the types.T is integer type
 func isInteger(t types.T) bool {
 	if t == types.T_int8 || t == types.T_int16 || t == types.T_int32 || t == types.T_int64 ||
@@ -959,6 +1918,14 @@ func isNumeric(t types.T) bool {
 	return false
 }
 
+//  isDecimal: return true if the types.T is decimal type
+func isDecimal(t types.T) bool {
+	if t == types.T_decimal64 || t == types.T_decimal128 {
+		return true
+	}
+	return false
+}
+
 //  isString: return true if the types.T is string type
 func isString(t types.T) bool {
 	if t == types.T_char || t == types.T_varchar {
@@ -969,7 +1936,7 @@ func isString(t types.T) bool {
 
 //  isDateSeries: return true if the types.T is date related type
 func isDateSeries(t types.T) bool {
-	if t == types.T_date || t == types.T_datetime || t == types.T_timestamp {
+	if t == types.T_date || t == types.T_datetime || t == types.T_timestamp || t == types.T_time {
 		return true
 	}
 	return false
@@ -15,185 +15,26 @@
 package operator
 
 import (
+	"fmt"
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/difftest"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 )
 
-func TestCastSameType(t *testing.T) {
-	makeTempVectors := func(src interface{}, destType types.T, srcIsConst bool) []*vector.Vector {
-		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeVector(src, srcIsConst)
-		vectors[1] = makeTypeVector(destType)
-		return vectors
-	}
-
-	procs := makeProcess()
-	cases := []struct {
-		name       string
-		vecs       []*vector.Vector
-		proc       *process.Process
-		wantValues interface{}
-		wantScalar bool
-	}{
-		{
-			name:       "Test01",
-			vecs:       makeTempVectors(int8(-23), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{-23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test02",
-			vecs:       makeTempVectors(int16(-23), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{-23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test03",
-			vecs:       makeTempVectors(int32(-23), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{-23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors(int64(-23), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{-23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors(uint8(23), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test06",
-			vecs:       makeTempVectors(uint16(23), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test07",
-			vecs:       makeTempVectors(uint32(23), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test08",
-			vecs:       makeTempVectors(uint64(23), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test09",
-			vecs:       makeTempVectors(float32(23.5), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{23.5},
-			wantScalar: true,
-		},
-		{
-			name:       "Test10",
-			vecs:       makeTempVectors(float64(23.5), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{23.5},
-			wantScalar: true,
-		},
-		{
-			name:       "Test11",
-			vecs:       makeTempVectors(int8(-23), types.T_int8, false),
-			proc:       procs,
-			wantValues: []int8{-23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test12",
-			vecs:       makeTempVectors(int16(-23), types.T_int16, false),
-			proc:       procs,
-			wantValues: []int16{-23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test13",
-			vecs:       makeTempVectors(int32(-23), types.T_int32, false),
-			proc:       procs,
-			wantValues: []int32{-23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test14",
-			vecs:       makeTempVectors(int64(-23), types.T_int64, false),
-			proc:       procs,
-			wantValues: []int64{-23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test15",
-			vecs:       makeTempVectors(uint8(23), types.T_uint8, false),
-			proc:       procs,
-			wantValues: []uint8{23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test16",
-			vecs:       makeTempVectors(uint16(23), types.T_uint16, false),
-			proc:       procs,
-			wantValues: []uint16{23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test17",
-			vecs:       makeTempVectors(uint32(23), types.T_uint32, false),
-			proc:       procs,
-			wantValues: []uint32{23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test18",
-			vecs:       makeTempVectors(uint64(23), types.T_uint64, false),
-			proc:       procs,
-			wantValues: []uint64{23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test19",
-			vecs:       makeTempVectors(float32(23.5), types.T_float32, false),
-			proc:       procs,
-			wantValues: []float32{23.5},
-			wantScalar: false,
-		},
-		{
-			name:       "Test20",
-			vecs:       makeTempVectors(float64(23.5), types.T_float64, false),
-			proc:       procs,
-			wantValues: []float64{23.5},
-			wantScalar: false,
-		},
-	}
-
-	for _, c := range cases {
-		t.Run(c.name, func(t *testing.T) {
-			castRes, err := Cast(c.vecs, c.proc)
-			if err != nil {
-				t.Fatal(err)
-			}
-			require.Equal(t, c.wantValues, castRes.Col)
-			require.Equal(t, c.wantScalar, castRes.IsScalar())
-		})
-	}
-}
-
 func TestCastSameType2(t *testing.T) {
 	makeTempVectors := func(src interface{}, destType types.T, srcIsConst bool) []*vector.Vector {
 		vectors := make([]*vector.Vector, 2)
@@ -230,1478 +71,201 @@ func TestCastSameType2(t *testing.T) {
 			vecs:       makeTempVectors(types.Timestamp(66122026122739712), types.T_timestamp, true),
 			proc:       procs,
 			wantValues: []types.Timestamp{66122026122739712},
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors(types.Date(729848), types.T_date, false),
-			proc:       procs,
-			wantValues: []types.Date{729848},
-			wantScalar: false,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors(types.Datetime(66122056321728512), types.T_datetime, false),
-			proc:       procs,
-			wantValues: []types.Datetime{66122056321728512},
-			wantScalar: false,
-		},
-		{
-			name:       "Test06",
-			vecs:       makeTempVectors(types.Timestamp(66122026122739712), types.T_timestamp, false),
-			proc:       procs,
-			wantValues: []types.Timestamp{66122026122739712},
-			wantScalar: false,
-		},
-	}
-	for _, c := range cases {
-		t.Run(c.name, func(t *testing.T) {
-			castRes, err := Cast(c.vecs, c.proc)
-			if err != nil {
-				t.Fatal(err)
-			}
-			require.Equal(t, c.wantValues, castRes.Col)
-			require.Equal(t, c.wantScalar, castRes.IsScalar())
-		})
-	}
-}
-
-func TestCastLeftToRight(t *testing.T) {
-	// int8 -> (int16/int32/int64/uint8/uint16/uint32/uint64/float32/float64)
-	// int16 -> (int8/int32/int64/uint8/uint16/uint32/uint64/float32/float64)
-	// int32 -> (int8/int16/int64/uint8/uint16/uint32/uint64/float32/float64)
-	// int64 -> (int8/int16/int32/uint8/uint16/uint32/uint64/float32/float64)
-	// uint8 -> (int8/int16/int32/int64/uint16/uint32/uint64/float32/float64)
-	// uint16 -> (int8/int16/int32/int64/uint8/uint32/uint64/float32/float64)
-	// uint32 -> (int8/int16/int32/int64/uint8/uint16/uint64/float32/float64)
-	// uint64 -> (int8/int16/int32/int64/uint8/uint16/uint32/float32/float64)
-	// float32 -> (int8/int16/int32/int64/uint8/uint16/uint32/uint64/float64)
-	// float64 -> (int8/int16/int32/int64/uint8/uint16/uint32/uint64/float32)
-
-	makeTempVectors := func(src interface{}, destType types.T, srcIsConst bool) []*vector.Vector {
-		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeVector(src, srcIsConst)
-		vectors[1] = makeTypeVector(destType)
-		return vectors
-	}
-
-	procs := makeProcess()
-	cases := []struct {
-		name       string
-		vecs       []*vector.Vector
-		proc       *process.Process
-		wantValues interface{}
-		wantScalar bool
-	}{
-		{
-			name:       "Test01",
-			vecs:       makeTempVectors(int8(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test02",
-			vecs:       makeTempVectors(int8(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test03",
-			vecs:       makeTempVectors(int8(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors(int8(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors(int8(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test06",
-			vecs:       makeTempVectors(int8(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test07",
-			vecs:       makeTempVectors(int8(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test08",
-			vecs:       makeTempVectors(int8(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test09",
-			vecs:       makeTempVectors(int8(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test10",
-			vecs:       makeTempVectors(int8(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test11",
-			vecs:       makeTempVectors(int16(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test12",
-			vecs:       makeTempVectors(int16(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test13",
-			vecs:       makeTempVectors(int16(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test14",
-			vecs:       makeTempVectors(int16(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test15",
-			vecs:       makeTempVectors(int16(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test16",
-			vecs:       makeTempVectors(int16(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test17",
-			vecs:       makeTempVectors(int16(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test18",
-			vecs:       makeTempVectors(int16(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test19",
-			vecs:       makeTempVectors(int16(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test20",
-			vecs:       makeTempVectors(int16(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test21",
-			vecs:       makeTempVectors(int32(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test22",
-			vecs:       makeTempVectors(int32(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test23",
-			vecs:       makeTempVectors(int32(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test24",
-			vecs:       makeTempVectors(int32(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test25",
-			vecs:       makeTempVectors(int32(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test26",
-			vecs:       makeTempVectors(int32(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test27",
-			vecs:       makeTempVectors(int32(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test28",
-			vecs:       makeTempVectors(int32(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test29",
-			vecs:       makeTempVectors(int32(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test30",
-			vecs:       makeTempVectors(int32(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test31",
-			vecs:       makeTempVectors(int64(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test32",
-			vecs:       makeTempVectors(int64(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test33",
-			vecs:       makeTempVectors(int64(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test34",
-			vecs:       makeTempVectors(int64(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test35",
-			vecs:       makeTempVectors(int64(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test36",
-			vecs:       makeTempVectors(int64(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test37",
-			vecs:       makeTempVectors(int64(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test38",
-			vecs:       makeTempVectors(int64(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test39",
-			vecs:       makeTempVectors(int64(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test40",
-			vecs:       makeTempVectors(int64(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test41",
-			vecs:       makeTempVectors(uint8(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test42",
-			vecs:       makeTempVectors(uint8(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test43",
-			vecs:       makeTempVectors(uint8(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test44",
-			vecs:       makeTempVectors(uint8(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test45",
-			vecs:       makeTempVectors(uint8(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test46",
-			vecs:       makeTempVectors(uint8(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test47",
-			vecs:       makeTempVectors(uint8(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test48",
-			vecs:       makeTempVectors(uint8(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test49",
-			vecs:       makeTempVectors(uint8(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test50",
-			vecs:       makeTempVectors(uint8(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test51",
-			vecs:       makeTempVectors(uint16(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test52",
-			vecs:       makeTempVectors(uint16(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test53",
-			vecs:       makeTempVectors(uint16(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test54",
-			vecs:       makeTempVectors(uint16(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test55",
-			vecs:       makeTempVectors(uint16(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test56",
-			vecs:       makeTempVectors(uint16(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test57",
-			vecs:       makeTempVectors(uint16(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test58",
-			vecs:       makeTempVectors(uint16(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test59",
-			vecs:       makeTempVectors(uint16(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test60",
-			vecs:       makeTempVectors(uint16(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test61",
-			vecs:       makeTempVectors(uint32(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test62",
-			vecs:       makeTempVectors(uint32(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test63",
-			vecs:       makeTempVectors(uint32(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test64",
-			vecs:       makeTempVectors(uint32(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test65",
-			vecs:       makeTempVectors(uint32(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test66",
-			vecs:       makeTempVectors(uint32(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test67",
-			vecs:       makeTempVectors(uint32(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test68",
-			vecs:       makeTempVectors(uint32(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test69",
-			vecs:       makeTempVectors(uint32(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test70",
-			vecs:       makeTempVectors(uint32(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test71",
-			vecs:       makeTempVectors(uint64(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test72",
-			vecs:       makeTempVectors(uint64(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test73",
-			vecs:       makeTempVectors(uint64(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test74",
-			vecs:       makeTempVectors(uint64(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test75",
-			vecs:       makeTempVectors(uint64(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test76",
-			vecs:       makeTempVectors(uint64(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test77",
-			vecs:       makeTempVectors(uint64(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test78",
-			vecs:       makeTempVectors(uint64(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test79",
-			vecs:       makeTempVectors(uint64(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test80",
-			vecs:       makeTempVectors(uint64(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test81",
-			vecs:       makeTempVectors(float32(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test82",
-			vecs:       makeTempVectors(float32(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test83",
-			vecs:       makeTempVectors(float32(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test84",
-			vecs:       makeTempVectors(float32(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test85",
-			vecs:       makeTempVectors(float32(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test86",
-			vecs:       makeTempVectors(float32(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test87",
-			vecs:       makeTempVectors(float32(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test88",
-			vecs:       makeTempVectors(float32(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test89",
-			vecs:       makeTempVectors(float32(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test90",
-			vecs:       makeTempVectors(float32(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test91",
-			vecs:       makeTempVectors(float64(125), types.T_int8, true),
-			proc:       procs,
-			wantValues: []int8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test92",
-			vecs:       makeTempVectors(float64(125), types.T_int16, true),
-			proc:       procs,
-			wantValues: []int16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test93",
-			vecs:       makeTempVectors(float64(125), types.T_int32, true),
-			proc:       procs,
-			wantValues: []int32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test94",
-			vecs:       makeTempVectors(float64(125), types.T_int64, true),
-			proc:       procs,
-			wantValues: []int64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test95",
-			vecs:       makeTempVectors(float64(125), types.T_uint8, true),
-			proc:       procs,
-			wantValues: []uint8{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test96",
-			vecs:       makeTempVectors(float64(125), types.T_uint16, true),
-			proc:       procs,
-			wantValues: []uint16{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test97",
-			vecs:       makeTempVectors(float64(125), types.T_uint32, true),
-			proc:       procs,
-			wantValues: []uint32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test98",
-			vecs:       makeTempVectors(float64(125), types.T_uint64, true),
-			proc:       procs,
-			wantValues: []uint64{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test99",
-			vecs:       makeTempVectors(float64(125), types.T_float32, true),
-			proc:       procs,
-			wantValues: []float32{125},
-			wantScalar: true,
-		},
-		{
-			name:       "Test100",
-			vecs:       makeTempVectors(float64(125), types.T_float64, true),
-			proc:       procs,
-			wantValues: []float64{125},
-			wantScalar: true,
-		},
-	}
-
-	for _, c := range cases {
-		t.Run(c.name, func(t *testing.T) {
-			castRes, err := Cast(c.vecs, c.proc)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			require.Equal(t, c.wantValues, castRes.Col)
-			require.Equal(t, c.wantScalar, castRes.IsScalar())
-		})
-	}
-}
-
-func TestCastSpecials1Int(t *testing.T) {
-	// (char / varhcar) -> (int8 / int16 / int32/ int64 / uint8 / uint16 / uint32 / uint64)
-
-	makeTempVectors := func(src string, srcType types.T, srcIsConst bool, destType types.T) []*vector.Vector {
-		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeStringVector(src, srcType, srcIsConst)
-		vectors[1] = makeTypeVector(destType)
-		return vectors
-	}
-
-	procs := makeProcess()
-	cases := []struct {
-		name       string
-		vecs       []*vector.Vector
-		proc       *process.Process
-		wantValues interface{}
-		wantScalar bool
-	}{
-		{
-			name:       "Test01",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_int8),
-			proc:       procs,
-			wantValues: []int8{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test02",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_int16),
-			proc:       procs,
-			wantValues: []int16{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test03",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_int32),
-			proc:       procs,
-			wantValues: []int32{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_int64),
-			proc:       procs,
-			wantValues: []int64{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_uint8),
-			proc:       procs,
-			wantValues: []uint8{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test06",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_uint16),
-			proc:       procs,
-			wantValues: []uint16{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test07",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_uint32),
-			proc:       procs,
-			wantValues: []uint32{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test08",
-			vecs:       makeTempVectors("15", types.T_varchar, true, types.T_uint64),
-			proc:       procs,
-			wantValues: []uint64{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test09",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_int8),
-			proc:       procs,
-			wantValues: []int8{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test10",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_int16),
-			proc:       procs,
-			wantValues: []int16{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test11",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_int32),
-			proc:       procs,
-			wantValues: []int32{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test12",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_int64),
-			proc:       procs,
-			wantValues: []int64{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test13",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_uint8),
-			proc:       procs,
-			wantValues: []uint8{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test14",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_uint16),
-			proc:       procs,
-			wantValues: []uint16{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test15",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_uint32),
-			proc:       procs,
-			wantValues: []uint32{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test16",
-			vecs:       makeTempVectors("15", types.T_char, true, types.T_uint64),
-			proc:       procs,
-			wantValues: []uint64{15},
-			wantScalar: true,
-		},
-		{
-			name:       "Test17",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_int8),
-			proc:       procs,
-			wantValues: []int8{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test18",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_int16),
-			proc:       procs,
-			wantValues: []int16{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test19",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_int32),
-			proc:       procs,
-			wantValues: []int32{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test20",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_int64),
-			proc:       procs,
-			wantValues: []int64{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test21",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_uint8),
-			proc:       procs,
-			wantValues: []uint8{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test22",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_uint16),
-			proc:       procs,
-			wantValues: []uint16{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test23",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_uint32),
-			proc:       procs,
-			wantValues: []uint32{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test24",
-			vecs:       makeTempVectors("15", types.T_varchar, false, types.T_uint64),
-			proc:       procs,
-			wantValues: []uint64{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test25",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_int8),
-			proc:       procs,
-			wantValues: []int8{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test26",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_int16),
-			proc:       procs,
-			wantValues: []int16{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test27",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_int32),
-			proc:       procs,
-			wantValues: []int32{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test28",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_int64),
-			proc:       procs,
-			wantValues: []int64{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test29",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_uint8),
-			proc:       procs,
-			wantValues: []uint8{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test30",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_uint16),
-			proc:       procs,
-			wantValues: []uint16{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test31",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_uint32),
-			proc:       procs,
-			wantValues: []uint32{15},
-			wantScalar: false,
-		},
-		{
-			name:       "Test32",
-			vecs:       makeTempVectors("15", types.T_char, false, types.T_uint64),
-			proc:       procs,
-			wantValues: []uint64{15},
-			wantScalar: false,
-		},
-	}
-
-	for _, c := range cases {
-		t.Run(c.name, func(t *testing.T) {
-			castRes, err := Cast(c.vecs, c.proc)
-			if err != nil {
-				t.Fatal(err)
-			}
-			require.Equal(t, c.wantValues, castRes.Col)
-			require.Equal(t, c.wantScalar, castRes.IsScalar())
-		})
-	}
-
-}
-
-func TestCastSpecials1Float(t *testing.T) {
-	// (char / varhcar) -> (float32 / float64)
-	makeTempVectors := func(src string, srcType types.T, srcIsConst bool, destType types.T) []*vector.Vector {
-		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeStringVector(src, srcType, srcIsConst)
-		vectors[1] = makeTypeVector(destType)
-		return vectors
-	}
-
-	procs := makeProcess()
-	cases := []struct {
-		name       string
-		vecs       []*vector.Vector
-		proc       *process.Process
-		wantValues interface{}
-		wantScalar bool
-	}{
-		{
-			name:       "Test01",
-			vecs:       makeTempVectors("15.23", types.T_varchar, true, types.T_float32),
-			proc:       procs,
-			wantValues: []float32{15.23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test02",
-			vecs:       makeTempVectors("15.23", types.T_varchar, true, types.T_float64),
-			proc:       procs,
-			wantValues: []float64{15.23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test03",
-			vecs:       makeTempVectors("15.23", types.T_char, true, types.T_float32),
-			proc:       procs,
-			wantValues: []float32{15.23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors("15.23", types.T_char, true, types.T_float64),
-			proc:       procs,
-			wantValues: []float64{15.23},
-			wantScalar: true,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors("15.23", types.T_varchar, false, types.T_float32),
-			proc:       procs,
-			wantValues: []float32{15.23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test06",
-			vecs:       makeTempVectors("15.23", types.T_varchar, false, types.T_float64),
-			proc:       procs,
-			wantValues: []float64{15.23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test07",
-			vecs:       makeTempVectors("15.23", types.T_char, false, types.T_float32),
-			proc:       procs,
-			wantValues: []float32{15.23},
-			wantScalar: false,
-		},
-		{
-			name:       "Test08",
-			vecs:       makeTempVectors("15.23", types.T_char, false, types.T_float64),
-			proc:       procs,
-			wantValues: []float64{15.23},
-			wantScalar: false,
-		},
-	}
-
-	for _, c := range cases {
-		t.Run(c.name, func(t *testing.T) {
-			castRes, err := Cast(c.vecs, c.proc)
-			if err != nil {
-				t.Fatal(err)
-			}
-			require.Equal(t, c.wantValues, castRes.Col)
-			require.Equal(t, c.wantScalar, castRes.IsScalar())
-		})
-	}
-
-}
-
-func TestCastSpecials2Float(t *testing.T) {
-	//(float32/float64) -> (char / varhcar)
-	makeTempVectors := func(src interface{}, destType types.T, srcIsConst bool) []*vector.Vector {
-		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeVector(src, srcIsConst)
-		vectors[1] = makeTypeVector(destType)
-		return vectors
-	}
-
-	procs := makeProcess()
-	cases := []struct {
-		name       string
-		vecs       []*vector.Vector
-		proc       *process.Process
-		wantBytes  []byte
-		wantScalar bool
-	}{
-		{
-			name:       "Test01",
-			vecs:       makeTempVectors(float32(23.65), types.T_char, true),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test02",
-			vecs:       makeTempVectors(float64(23.65), types.T_char, true),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test03",
-			vecs:       makeTempVectors(float32(23.65), types.T_varchar, true),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors(float64(23.65), types.T_varchar, true),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors(float32(23.65), types.T_char, false),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: false,
-		},
-		{
-			name:       "Test06",
-			vecs:       makeTempVectors(float64(23.65), types.T_char, false),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: false,
-		},
-		{
-			name:       "Test07",
-			vecs:       makeTempVectors(float32(23.65), types.T_varchar, false),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: false,
-		},
-		{
-			name:       "Test08",
-			vecs:       makeTempVectors(float64(23.65), types.T_varchar, false),
-			proc:       procs,
-			wantBytes:  []byte("23.65"),
-			wantScalar: false,
-		},
-	}
-
-	for _, c := range cases {
-		t.Run(c.name, func(t *testing.T) {
-			castRes, err := Cast(c.vecs, c.proc)
-			if err != nil {
-				t.Fatal(err)
-			}
-			col := castRes.Col.(*types.Bytes)
-			offset := col.Offsets[0]
-			length := col.Lengths[0]
-			resBytes := col.Data[offset:length]
-			require.Equal(t, c.wantBytes, resBytes)
-			require.Equal(t, c.wantScalar, castRes.IsScalar())
-		})
-	}
-}
-
-func TestCastSpecials3(t *testing.T) {
-	// char -> char
-	// char -> varhcar
-	// varhcar -> char
-	// varhcar -> varhcar
-	makeTempVectors := func(src string, srcType types.T, destType types.T, srcIsConst bool) []*vector.Vector {
-		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeStringVector(src, srcType, srcIsConst)
-		vectors[1] = makeTypeVector(destType)
-		return vectors
-	}
-
-	procs := makeProcess()
-	cases := []struct {
-		name       string
-		vecs       []*vector.Vector
-		proc       *process.Process
-		wantBytes  []byte
-		wantScalar bool
-	}{
-		{
-			name:       "Test01",
-			vecs:       makeTempVectors("abcsedn", types.T_char, types.T_char, true),
-			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test02",
-			vecs:       makeTempVectors("abcsedn", types.T_char, types.T_varchar, true),
-			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test03",
-			vecs:       makeTempVectors("abcsedn", types.T_varchar, types.T_char, true),
-			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test04",
-			vecs:       makeTempVectors("abcsedn", types.T_varchar, types.T_varchar, true),
-			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
-			wantScalar: true,
-		},
-		{
-			name:       "Test05",
-			vecs:       makeTempVectors("abcsedn", types.T_char, types.T_char, false),
-			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
-			wantScalar: false,
+			wantScalar: true,
 		},
 		{
-			name:       "Test06",
-			vecs:       makeTempVectors("abcsedn", types.T_char, types.T_varchar, false),
+			name:       "Test04",
+			vecs:       makeTempVectors(types.Date(729848), types.T_date, false),
 			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
+			wantValues: []types.Date{729848},
 			wantScalar: false,
 		},
 		{
-			name:       "Test07",
-			vecs:       makeTempVectors("abcsedn", types.T_varchar, types.T_char, false),
+			name:       "Test05",
+			vecs:       makeTempVectors(types.Datetime(66122056321728512), types.T_datetime, false),
 			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
+			wantValues: []types.Datetime{66122056321728512},
 			wantScalar: false,
 		},
 		{
-			name:       "Test08",
-			vecs:       makeTempVectors("abcsedn", types.T_varchar, types.T_varchar, false),
+			name:       "Test06",
+			vecs:       makeTempVectors(types.Timestamp(66122026122739712), types.T_timestamp, false),
 			proc:       procs,
-			wantBytes:  []byte("abcsedn"),
+			wantValues: []types.Timestamp{66122026122739712},
 			wantScalar: false,
 		},
 	}
-
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
 			castRes, err := Cast(c.vecs, c.proc)
 			if err != nil {
 				t.Fatal(err)
 			}
-			col := castRes.Col.(*types.Bytes)
-			offset := col.Offsets[0]
-			length := col.Lengths[0]
-			resBytes := col.Data[offset:length]
-			require.Equal(t, c.wantBytes, resBytes)
+			require.Equal(t, c.wantValues, castRes.Col)
 			require.Equal(t, c.wantScalar, castRes.IsScalar())
 		})
 	}
-
 }
 
-func TestCastSpecial4(t *testing.T) {
-	//(int8/int16/int32/int64) to decimal128
-	// (uint8/uint16/uint32/uint64) to decimal128
-
+func TestCastBool(t *testing.T) {
 	makeTempVectors := func(src interface{}, destType types.T, srcIsConst bool) []*vector.Vector {
 		vectors := make([]*vector.Vector, 2)
-		vectors[0] = makeVector(src, srcIsConst)
+		if s, ok := src.(string); ok {
+			vectors[0] = makeStringVector(s, types.T_varchar, srcIsConst)
+		} else {
+			vectors[0] = makeVector(src, srcIsConst)
+		}
 		vectors[1] = makeTypeVector(destType)
 		return vectors
 	}
-	resType := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 0}
-	decimal128 := types.Decimal128{Lo: 123, Hi: 0}
+
 	procs := makeProcess()
 	cases := []struct {
 		name       string
 		vecs       []*vector.Vector
 		proc       *process.Process
 		wantValues interface{}
-		wantType   types.Type
 		wantScalar bool
 	}{
 		{
 			name:       "Test01",
-			vecs:       makeTempVectors(int8(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(true, types.T_bool, true),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []bool{true},
 			wantScalar: true,
 		},
 		{
 			name:       "Test02",
-			vecs:       makeTempVectors(int16(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(false, types.T_bool, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: true,
+			wantValues: []bool{false},
+			wantScalar: false,
 		},
 		{
 			name:       "Test03",
-			vecs:       makeTempVectors(int32(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(int8(0), types.T_bool, true),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []bool{false},
 			wantScalar: true,
 		},
 		{
 			name:       "Test04",
-			vecs:       makeTempVectors(int64(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(int64(-3), types.T_bool, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: true,
+			wantValues: []bool{true},
+			wantScalar: false,
 		},
 		{
 			name:       "Test05",
-			vecs:       makeTempVectors(uint8(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(uint32(7), types.T_bool, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: true,
+			wantValues: []bool{true},
+			wantScalar: false,
 		},
 		{
 			name:       "Test06",
-			vecs:       makeTempVectors(uint16(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(float64(0.5), types.T_bool, true),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []bool{true},
 			wantScalar: true,
 		},
 		{
 			name:       "Test07",
-			vecs:       makeTempVectors(uint32(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(float32(0), types.T_bool, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: true,
+			wantValues: []bool{false},
+			wantScalar: false,
 		},
 		{
 			name:       "Test08",
-			vecs:       makeTempVectors(uint64(123), types.T_decimal128, true),
+			vecs:       makeTempVectors(true, types.T_int8, true),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []int8{1},
 			wantScalar: true,
 		},
 		{
 			name:       "Test09",
-			vecs:       makeTempVectors(int8(123), types.T_decimal128, false),
+			vecs:       makeTempVectors(false, types.T_uint64, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []uint64{0},
 			wantScalar: false,
 		},
 		{
 			name:       "Test10",
-			vecs:       makeTempVectors(int16(123), types.T_decimal128, false),
+			vecs:       makeTempVectors(true, types.T_float32, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []float32{1},
 			wantScalar: false,
 		},
 		{
 			name:       "Test11",
-			vecs:       makeTempVectors(int32(123), types.T_decimal128, false),
+			vecs:       makeTempVectors("true", types.T_bool, true),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: false,
+			wantValues: []bool{true},
+			wantScalar: true,
 		},
 		{
 			name:       "Test12",
-			vecs:       makeTempVectors(int64(123), types.T_decimal128, false),
+			vecs:       makeTempVectors(" FALSE ", types.T_bool, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []bool{false},
 			wantScalar: false,
 		},
 		{
 			name:       "Test13",
-			vecs:       makeTempVectors(uint8(123), types.T_decimal128, false),
+			vecs:       makeTempVectors("1", types.T_bool, false),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			wantValues: []bool{true},
 			wantScalar: false,
 		},
 		{
 			name:       "Test14",
-			vecs:       makeTempVectors(uint16(123), types.T_decimal128, false),
+			vecs:       makeTempVectors("0", types.T_bool, true),
 			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: false,
+			wantValues: []bool{false},
+			wantScalar: true,
 		},
 		{
-			name:       "Test15",
-			vecs:       makeTempVectors(uint32(123), types.T_decimal128, false),
-			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
+			name: "Test15",
+			vecs: makeTempVectors(true, types.T_varchar, false),
+			proc: procs,
+			wantValues: &types.Bytes{
+				Data:    []byte("true"),
+				Offsets: []uint32{0},
+				Lengths: []uint32{4},
+			},
 			wantScalar: false,
 		},
 		{
-			name:       "Test16",
-			vecs:       makeTempVectors(uint64(123), types.T_decimal128, false),
-			proc:       procs,
-			wantValues: []types.Decimal128{decimal128},
-			wantType:   resType,
-			wantScalar: false,
+			name: "Test16",
+			vecs: makeTempVectors(false, types.T_char, true),
+			proc: procs,
+			wantValues: &types.Bytes{
+				Data:    []byte("false"),
+				Offsets: []uint32{0},
+				Lengths: []uint32{5},
+			},
+			wantScalar: true,
 		},
 	}
-
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			decimalres, err := Cast(c.vecs, c.proc)
+			castRes, err := Cast(c.vecs, c.proc)
 			if err != nil {
 				t.Fatal(err)
 			}
-			require.Equal(t, c.wantValues, decimalres.Col)
-			require.Equal(t, c.wantType, decimalres.Typ)
-			require.Equal(t, c.wantScalar, decimalres.IsScalar())
+			require.Equal(t, c.wantValues, castRes.Col)
+			require.Equal(t, c.wantScalar, castRes.IsScalar())
 		})
 	}
 
+	// a scalar null of any type is cast to a scalar null bool
+	for _, typ := range []types.T{types.T_bool, types.T_int32, types.T_float64, types.T_varchar} {
+		castRes, err := Cast([]*vector.Vector{makeScalarNullVector(typ), makeTypeVector(types.T_bool)}, procs)
+		require.NoError(t, err, typ.String())
+		require.True(t, castRes.IsScalarNull(), typ.String())
+		require.Equal(t, types.T_bool, castRes.Typ.Oid, typ.String())
+	}
 }
 
 func TestCastVarcharAsDate(t *testing.T) {
@@ -1985,61 +549,302 @@ func TestCastDecimal128AsDecimal128(t *testing.T) {
 	}
 }
 
-//func TestCastTimeStampAsDatetime(t *testing.T) {
-//	//Cast converts timestamp to datetime
-//	makeTempVectors := func(src types.Timestamp, srcType types.T, srcIsConst bool, destType types.T) []*vector.Vector {
-//		vectors := make([]*vector.Vector, 2)
-//		vectors[0] = &vector.Vector{
-//			Col:     []types.Timestamp{src},
-//			Nsp:     &nulls.Nulls{},
-//			Typ:     types.Type{Oid: srcType},
-//			IsConst: srcIsConst,
-//			Length:  1,
-//		}
-//		vectors[1] = makeTypeVector(destType)
-//		return vectors
-//	}
-//
-//	procs := makeProcess()
-//	cases := []struct {
-//		name       string
-//		vecs       []*vector.Vector
-//		proc       *process.Process
-//		wantBytes  interface{}
-//		wantType   types.T
-//		wantScalar bool
-//	}{
-//		{
-//			name:       "TEST01", //cast(c_timestamp as datetime)  c_timestamp:'1999-04-05 11:01:02'
-//			vecs:       makeTempVectors(66122026122739712, types.T_timestamp, true, types.T_datetime),
-//			proc:       procs,
-//			wantBytes:  []types.Datetime{66122056321728512},
-//			wantType:   types.T_datetime,
-//			wantScalar: true,
-//		},
-//		{
-//			name:       "TEST02", //cast(333.33300 as decimal(20, 5))
-//			vecs:       makeTempVectors(66122026122739712, types.T_timestamp, false, types.T_datetime),
-//			proc:       procs,
-//			wantBytes:  []types.Datetime{66122056321728512},
-//			wantType:   types.T_datetime,
-//			wantScalar: false,
-//		},
-//	}
-//
-//	for _, c := range cases {
-//		t.Run(c.name, func(t *testing.T) {
-//			castRes, err := Cast(c.vecs, c.proc)
-//			if err != nil {
-//				t.Fatal(err)
-//			}
-//			require.Equal(t, c.wantBytes, castRes.Col)
-//			require.Equal(t, c.wantType, castRes.Typ.Oid)
-//			require.Equal(t, c.wantScalar, castRes.IsScalar())
-//		})
-//	}
-//
-//}
+func TestCastTimeStampAsDatetime(t *testing.T) {
+	// the values are parsed in the time zone of the server, which is the
+	// time zone of the casts
+	ts, err := types.ParseTimestamp("1999-04-05 11:01:02", 6)
+	require.NoError(t, err)
+	dt, err := types.ParseDatetime("1999-04-05 11:01:02")
+	require.NoError(t, err)
+	date, err := types.ParseDate("1999-04-05")
+	require.NoError(t, err)
+	startTs, err := types.ParseTimestamp("1999-04-05 00:00:00", 6)
+	require.NoError(t, err)
+
+	procs := makeProcess()
+	cases := []struct {
+		name       string
+		vecs       []*vector.Vector
+		proc       *process.Process
+		wantBytes  interface{}
+		wantType   types.T
+		wantScalar bool
+	}{
+		{
+			name:       "TEST01", //cast(c_timestamp as datetime)  c_timestamp:'1999-04-05 11:01:02'
+			vecs:       []*vector.Vector{makeVector(ts, true), makeTypeVector(types.T_datetime)},
+			proc:       procs,
+			wantBytes:  []types.Datetime{dt},
+			wantType:   types.T_datetime,
+			wantScalar: true,
+		},
+		{
+			name:       "TEST02",
+			vecs:       []*vector.Vector{makeVector(ts, false), makeTypeVector(types.T_datetime)},
+			proc:       procs,
+			wantBytes:  []types.Datetime{dt},
+			wantType:   types.T_datetime,
+			wantScalar: false,
+		},
+		{
+			name:       "TEST03", //cast(c_datetime as timestamp)  c_datetime:'1999-04-05 11:01:02'
+			vecs:       []*vector.Vector{makeVector(dt, true), makeTypeVector(types.T_timestamp)},
+			proc:       procs,
+			wantBytes:  []types.Timestamp{ts},
+			wantType:   types.T_timestamp,
+			wantScalar: true,
+		},
+		{
+			name:       "TEST04",
+			vecs:       []*vector.Vector{makeVector(dt, false), makeTypeVector(types.T_timestamp)},
+			proc:       procs,
+			wantBytes:  []types.Timestamp{ts},
+			wantType:   types.T_timestamp,
+			wantScalar: false,
+		},
+		{
+			name:       "TEST05", //cast(c_timestamp as date)  c_timestamp:'1999-04-05 11:01:02'
+			vecs:       []*vector.Vector{makeVector(ts, true), makeTypeVector(types.T_date)},
+			proc:       procs,
+			wantBytes:  []types.Date{date},
+			wantType:   types.T_date,
+			wantScalar: true,
+		},
+		{
+			name:       "TEST06",
+			vecs:       []*vector.Vector{makeVector(ts, false), makeTypeVector(types.T_date)},
+			proc:       procs,
+			wantBytes:  []types.Date{date},
+			wantType:   types.T_date,
+			wantScalar: false,
+		},
+		{
+			name:       "TEST07", //cast(c_date as timestamp)  c_date:'1999-04-05'
+			vecs:       []*vector.Vector{makeVector(date, true), makeTypeVector(types.T_timestamp)},
+			proc:       procs,
+			wantBytes:  []types.Timestamp{startTs},
+			wantType:   types.T_timestamp,
+			wantScalar: true,
+		},
+		{
+			name:       "TEST08",
+			vecs:       []*vector.Vector{makeVector(date, false), makeTypeVector(types.T_timestamp)},
+			proc:       procs,
+			wantBytes:  []types.Timestamp{startTs},
+			wantType:   types.T_timestamp,
+			wantScalar: false,
+		},
+		{
+			name:       "TEST09", //cast(c_date as datetime)  c_date:'1999-04-05'
+			vecs:       []*vector.Vector{makeVector(date, false), makeTypeVector(types.T_datetime)},
+			proc:       procs,
+			wantBytes:  []types.Datetime{date.ToTime()},
+			wantType:   types.T_datetime,
+			wantScalar: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			castRes, err := Cast(c.vecs, c.proc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			require.Equal(t, c.wantBytes, castRes.Col)
+			require.Equal(t, c.wantType, castRes.Typ.Oid)
+			require.Equal(t, c.wantScalar, castRes.IsScalar())
+		})
+	}
+
+	// the null of a row is kept
+	for _, c := range cases {
+		vec := &vector.Vector{Col: c.vecs[0].Col, Nsp: &nulls.Nulls{}, Typ: c.vecs[0].Typ, Length: 1}
+		nulls.Add(vec.Nsp, 0)
+		castRes, err := Cast([]*vector.Vector{vec, c.vecs[1]}, c.proc)
+		require.NoError(t, err, c.name)
+		require.True(t, nulls.Contains(castRes.Nsp, 0), c.name)
+	}
+}
+
+func TestCastDecimalAsNumeric(t *testing.T) {
+	decimal64 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2}
+	decimal128 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 3}
+	cases := []struct {
+		from types.Type
+		src  string
+		to   types.T
+		want interface{} // the value cast in the non-strict mode
+		ok   bool
+	}{
+		{from: decimal64, src: "123.45", to: types.T_float64, want: 123.45, ok: true},
+		{from: decimal64, src: "-0.01", to: types.T_float32, want: float32(-0.01), ok: true},
+		{from: decimal128, src: "-12345678901234567890.125", to: types.T_float64, want: -12345678901234567890.125, ok: true},
+		{from: decimal128, src: "0.001", to: types.T_float32, want: float32(0.001), ok: true},
+		{from: decimal64, src: "2.50", to: types.T_int64, want: int64(3), ok: true},
+		{from: decimal64, src: "-2.50", to: types.T_int64, want: int64(-3), ok: true},
+		{from: decimal64, src: "2.49", to: types.T_int32, want: int32(2), ok: true},
+		{from: decimal64, src: "-0.49", to: types.T_int8, want: int8(0), ok: true},
+		{from: decimal64, src: "127.49", to: types.T_int8, want: int8(127), ok: true},
+		{from: decimal64, src: "127.50", to: types.T_int8, want: int8(math.MaxInt8)},
+		{from: decimal64, src: "-0.50", to: types.T_uint8, want: uint8(0)},
+		{from: decimal64, src: "-0.49", to: types.T_uint16, want: uint16(0), ok: true},
+		{from: decimal128, src: "9223372036854775807.499", to: types.T_int64, want: int64(math.MaxInt64), ok: true},
+		{from: decimal128, src: "9223372036854775807.500", to: types.T_int64, want: int64(math.MaxInt64)},
+		{from: decimal128, src: "-9223372036854775808.4", to: types.T_int64, want: int64(math.MinInt64), ok: true},
+		{from: decimal128, src: "-9223372036854775808.5", to: types.T_int64, want: int64(math.MinInt64)},
+		{from: decimal128, src: "18446744073709551615", to: types.T_uint64, want: uint64(math.MaxUint64), ok: true},
+		{from: decimal128, src: "1e30", to: types.T_uint64, want: uint64(math.MaxUint64)},
+		{from: decimal128, src: "-1e30", to: types.T_int16, want: int16(math.MinInt16)},
+	}
+
+	for _, c := range cases {
+		for _, strict := range []bool{true, false} {
+			for _, isConst := range []bool{true, false} {
+				name := fmt.Sprintf("cast %s as %s, strict=%v, const=%v", c.src, c.to, strict, isConst)
+				proc := makeProcess()
+				proc.SessionInfo.StrictMode = strict
+				src := &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.from, IsConst: isConst, Length: 1}
+				if c.from.Oid == types.T_decimal64 {
+					d, err := types.ParseDecimal64(c.src, c.from.Width, c.from.Scale)
+					require.NoError(t, err, name)
+					src.Col = []types.Decimal64{d}
+				} else {
+					d, err := types.ParseDecimal128(c.src, c.from.Width, c.from.Scale)
+					require.NoError(t, err, name)
+					src.Col = []types.Decimal128{d}
+				}
+				vec, err := Cast([]*vector.Vector{src, makeTypeVector(c.to)}, proc)
+				if strict && !c.ok {
+					require.Error(t, err, name)
+					require.Equal(t, int32(moerr.OUT_OF_RANGE), moerr.Code(err), name)
+					continue
+				}
+				require.NoError(t, err, name)
+				require.Equal(t, c.to, vec.Typ.Oid, name)
+				require.Equal(t, isConst, vec.IsScalar(), name)
+				col := reflect.ValueOf(vec.Col)
+				require.Equal(t, 1, col.Len(), name)
+				require.Equal(t, c.want, col.Index(0).Interface(), name)
+				if c.ok {
+					require.Equal(t, uint16(0), proc.Warnings(), name)
+				} else {
+					require.Equal(t, uint16(1), proc.Warnings(), name)
+				}
+			}
+		}
+	}
+
+	// the nulls are kept, and a null row is not checked
+	proc := makeProcess()
+	proc.SessionInfo.StrictMode = true
+	src := &vector.Vector{
+		Col: []types.Decimal128{types.InitDecimal128(1500), types.InitDecimal128(-1), types.InitDecimal128(2500)},
+		Nsp: &nulls.Nulls{},
+		Typ: decimal128,
+	}
+	nulls.Add(src.Nsp, 1)
+	vec, err := Cast([]*vector.Vector{src, makeTypeVector(types.T_uint32)}, proc)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	col := vec.Col.([]uint32)
+	require.Equal(t, uint32(2), col[0])
+	require.Equal(t, uint32(3), col[2])
+	_, err = Cast([]*vector.Vector{src, makeTypeVector(types.T_float64)}, proc)
+	require.NoError(t, err)
+
+	// a null scalar is a null scalar of the number
+	null := &vector.Vector{Col: []types.Decimal64{0}, Nsp: &nulls.Nulls{}, Typ: decimal64, IsConst: true, Length: 1}
+	nulls.Add(null.Nsp, 0)
+	vec, err = Cast([]*vector.Vector{null, makeTypeVector(types.T_int64)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+	require.Equal(t, types.T_int64, vec.Typ.Oid)
+}
+
+func TestCastStringAsDecimal(t *testing.T) {
+	decimal64 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 18, Scale: 3}
+	decimal128 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 3}
+	cases := []struct {
+		name string
+		from types.T
+		to   types.Type
+		src  string
+		want string
+		code int32 // the code of the error, 0 if the cast succeeds
+	}{
+		{name: "positive", from: types.T_varchar, to: decimal64, src: "123.456", want: "123.456"},
+		{name: "negative", from: types.T_char, to: decimal64, src: "-123.456", want: "-123.456"},
+		{name: "fewer digits", from: types.T_varchar, to: decimal64, src: " 12.3 ", want: "12.300"},
+		{name: "round up", from: types.T_varchar, to: decimal64, src: "1.23456", want: "1.235"},
+		{name: "round down", from: types.T_varchar, to: decimal64, src: "1.23449", want: "1.234"},
+		{name: "round negative", from: types.T_varchar, to: decimal64, src: "-1.2345", want: "-1.235"},
+		{name: "exponent", from: types.T_varchar, to: decimal64, src: "1.5e2", want: "150.000"},
+		{name: "decimal128", from: types.T_varchar, to: decimal128, src: "-12345678901234567890.1239", want: "-12345678901234567890.124"},
+		{name: "decimal64 out of range", from: types.T_varchar, to: decimal64, src: "1234567890123456", code: moerr.OUT_OF_RANGE},
+		{name: "decimal128 out of range", from: types.T_char, to: decimal128, src: "1e35", code: moerr.OUT_OF_RANGE},
+		{name: "rounded out of range", from: types.T_varchar, to: types.Type{Oid: types.T_decimal64, Size: 8, Width: 5, Scale: 3}, src: "99.9996", code: moerr.OUT_OF_RANGE},
+		{name: "malformed", from: types.T_varchar, to: decimal64, src: "12.3.4", code: -1},
+		{name: "not a number", from: types.T_char, to: decimal128, src: "abc", code: -1},
+		{name: "empty", from: types.T_varchar, to: decimal128, src: "", code: -1},
+	}
+
+	proc := makeProcess()
+	proc.SessionInfo.StrictMode = true
+	for _, c := range cases {
+		for _, isConst := range []bool{true, false} {
+			name := fmt.Sprintf("%s const=%v", c.name, isConst)
+			to := &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.to}
+			vec, err := Cast([]*vector.Vector{makeStringVector(c.src, c.from, isConst), to}, proc)
+			if c.code != 0 {
+				require.Error(t, err, name)
+				if c.code > 0 {
+					require.Equal(t, c.code, moerr.Code(err), name)
+				}
+				continue
+			}
+			require.NoError(t, err, name)
+			require.Equal(t, c.to.Oid, vec.Typ.Oid, name)
+			require.Equal(t, c.to.Scale, vec.Typ.Scale, name)
+			require.Equal(t, isConst, vec.IsScalar(), name)
+			var got []byte
+			switch col := vec.Col.(type) {
+			case []types.Decimal64:
+				require.Len(t, col, 1, name)
+				got = col[0].Decimal64ToString(c.to.Scale)
+			case []types.Decimal128:
+				require.Len(t, col, 1, name)
+				got = col[0].Decimal128ToString(c.to.Scale)
+			}
+			require.Equal(t, c.want, string(got), name)
+		}
+	}
+
+	// the null rows of a vector are skipped
+	src := &vector.Vector{
+		Col: &types.Bytes{
+			Data:    []byte("1.5not a number-2"),
+			Offsets: []uint32{0, 3, 15},
+			Lengths: []uint32{3, 12, 2},
+		},
+		Nsp: &nulls.Nulls{},
+		Typ: types.Type{Oid: types.T_varchar, Size: 24},
+	}
+	nulls.Add(src.Nsp, 1)
+	vec, err := Cast([]*vector.Vector{src, {Nsp: &nulls.Nulls{}, Typ: decimal128}}, proc)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	col := vec.Col.([]types.Decimal128)
+	require.Len(t, col, 3)
+	require.Equal(t, "1.500", string(col[0].Decimal128ToString(3)))
+	require.Equal(t, "-2.000", string(col[2].Decimal128ToString(3)))
+
+	// a null scalar is a null scalar of the decimal
+	null := makeScalarNullVector(types.T_varchar)
+	vec, err = Cast([]*vector.Vector{null, {Nsp: &nulls.Nulls{}, Typ: decimal64}}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+	require.Equal(t, types.T_decimal64, vec.Typ.Oid)
+}
 
 func TestCastNullAsAllType(t *testing.T) {
 	//Cast null as (int8/int16/int32/int64/uint8/uint16/uint32/uint64/float32/float64/date/datetime/timestamp/decimal64/decimal128/char/varchar)
@@ -3267,6 +2072,9 @@ func makeVector(src interface{}, isSrcConst bool) *vector.Vector {
 	case types.Timestamp:
 		typeOid = types.T_timestamp
 		col = []types.Timestamp{val}
+	case bool:
+		typeOid = types.T_bool
+		col = []bool{val}
 	}
 
 	return &vector.Vector{
@@ -3316,3 +2124,619 @@ func makeStringVector(src string, t types.T, isConst bool) *vector.Vector {
 	}
 	return nil
 }
+
+// TestCastLength casts empty vectors, and scalars repeated many times, of
+// every family of the cast
+func TestCastLength(t *testing.T) {
+	decimal64 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2}
+	decimal128 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 2}
+	cases := []struct {
+		from  types.Type
+		to    types.T
+		value string // the value of a string scalar
+	}{
+		{from: types.T_int64.ToType(), to: types.T_int64},
+		{from: types.T_float32.ToType(), to: types.T_float32},
+		{from: types.T_int32.ToType(), to: types.T_int64},
+		{from: types.T_float64.ToType(), to: types.T_int32},
+		{from: types.T_uint8.ToType(), to: types.T_float64},
+		{from: types.T_varchar.ToType(), to: types.T_int64, value: "12"},
+		{from: types.T_char.ToType(), to: types.T_uint16, value: "12"},
+		{from: types.T_varchar.ToType(), to: types.T_float64, value: "1.5"},
+		{from: types.T_int64.ToType(), to: types.T_varchar},
+		{from: types.T_uint32.ToType(), to: types.T_char},
+		{from: types.T_float32.ToType(), to: types.T_varchar},
+		{from: types.T_varchar.ToType(), to: types.T_char, value: "abc"},
+		{from: types.T_int32.ToType(), to: types.T_decimal128},
+		{from: types.T_uint16.ToType(), to: types.T_decimal128},
+		{from: decimal64, to: types.T_decimal64},
+		{from: decimal128, to: types.T_decimal128},
+		{from: decimal64, to: types.T_decimal128},
+		{from: decimal64, to: types.T_float64},
+		{from: decimal128, to: types.T_float32},
+		{from: decimal64, to: types.T_int64},
+		{from: decimal128, to: types.T_uint8},
+		{from: types.T_date.ToType(), to: types.T_date},
+		{from: types.T_datetime.ToType(), to: types.T_datetime},
+		{from: types.T_varchar.ToType(), to: types.T_date, value: "2022-01-02"},
+		{from: types.T_varchar.ToType(), to: types.T_datetime, value: "2022-01-02 03:04:05"},
+		{from: types.T_varchar.ToType(), to: types.T_timestamp, value: "2022-01-02 03:04:05"},
+		{from: types.T_varchar.ToType(), to: types.T_time, value: "03:04:05"},
+		{from: types.T_varchar.ToType(), to: types.T_decimal64, value: "1.5"},
+		{from: types.T_char.ToType(), to: types.T_decimal128, value: "-1.5"},
+		{from: types.T_timestamp.ToType(), to: types.T_datetime},
+		{from: types.T_datetime.ToType(), to: types.T_timestamp},
+		{from: types.T_timestamp.ToType(), to: types.T_date},
+		{from: types.T_date.ToType(), to: types.T_timestamp},
+		{from: types.T_date.ToType(), to: types.T_datetime},
+		{from: types.T_time.ToType(), to: types.T_varchar},
+		{from: types.T_datetime.ToType(), to: types.T_time},
+		{from: types.T_time.ToType(), to: types.T_datetime},
+		{from: types.T_bool.ToType(), to: types.T_bool},
+		{from: types.T_int64.ToType(), to: types.T_bool},
+		{from: types.T_float32.ToType(), to: types.T_bool},
+		{from: types.T_bool.ToType(), to: types.T_uint16},
+		{from: types.T_bool.ToType(), to: types.T_float64},
+		{from: types.T_varchar.ToType(), to: types.T_bool, value: "true"},
+		{from: types.T_bool.ToType(), to: types.T_varchar},
+	}
+
+	proc := makeProcess()
+	for _, c := range cases {
+		name := fmt.Sprintf("%s to %s", c.from, c.to)
+
+		// an empty vector, as the output of a filter which matches nothing
+		vec, err := Cast([]*vector.Vector{vector.New(c.from), makeTypeVector(c.to)}, proc)
+		require.NoError(t, err, name)
+		require.False(t, vec.IsScalar(), name)
+		require.Equal(t, c.to, vec.Typ.Oid, name)
+		require.NoError(t, testutil.CheckVector(vec, 0), name)
+
+		// a scalar repeated 4 times
+		scalar := vector.NewConst(c.from)
+		scalar.Length = 4
+		if c.from.Oid == types.T_char || c.from.Oid == types.T_varchar {
+			scalar.Col = &types.Bytes{
+				Data:    []byte(c.value),
+				Offsets: []uint32{0},
+				Lengths: []uint32{uint32(len(c.value))},
+			}
+		} else {
+			col := reflect.ValueOf(scalar.Col)
+			scalar.Col = reflect.MakeSlice(col.Type(), 1, 1).Interface()
+		}
+		vec, err = Cast([]*vector.Vector{scalar, makeTypeVector(c.to)}, proc)
+		require.NoError(t, err, name)
+		require.True(t, vec.IsScalar(), name)
+		require.Equal(t, c.to, vec.Typ.Oid, name)
+		require.NoError(t, testutil.CheckVector(vec, 4), name)
+
+		// a null scalar repeated 4 times
+		null := proc.AllocScalarNullVector(c.from)
+		null.Length = 4
+		vec, err = Cast([]*vector.Vector{null, makeTypeVector(c.to)}, proc)
+		require.NoError(t, err, name)
+		require.True(t, vec.IsScalarNull(), name)
+		require.Equal(t, c.to, vec.Typ.Oid, name)
+		require.NoError(t, testutil.CheckVector(vec, 4), name)
+	}
+}
+
+func TestCastOutOfRange(t *testing.T) {
+	// want is the value cast in the non-strict mode, clamped if the cast is
+	// out of range
+	cases := []struct {
+		src  interface{}
+		to   types.T
+		want interface{}
+		ok   bool
+	}{
+		{src: int64(127), to: types.T_int8, want: int8(127), ok: true},
+		{src: int64(128), to: types.T_int8, want: int8(math.MaxInt8)},
+		{src: int64(-128), to: types.T_int8, want: int8(-128), ok: true},
+		{src: int64(-129), to: types.T_int8, want: int8(math.MinInt8)},
+		{src: int16(300), to: types.T_int8, want: int8(math.MaxInt8)},
+		{src: uint8(255), to: types.T_int8, want: int8(math.MaxInt8)},
+		{src: uint16(math.MaxUint16), to: types.T_int16, want: int16(math.MaxInt16)},
+		{src: uint32(math.MaxUint32), to: types.T_int32, want: int32(math.MaxInt32)},
+		{src: uint64(math.MaxInt64), to: types.T_int64, want: int64(math.MaxInt64), ok: true},
+		{src: uint64(math.MaxInt64 + 1), to: types.T_int64, want: int64(math.MaxInt64)},
+		{src: uint64(math.MaxUint64), to: types.T_int64, want: int64(math.MaxInt64)},
+		{src: int64(255), to: types.T_uint8, want: uint8(255), ok: true},
+		{src: int64(256), to: types.T_uint8, want: uint8(math.MaxUint8)},
+		{src: int8(-1), to: types.T_uint8, want: uint8(0)},
+		{src: int16(-1), to: types.T_uint16, want: uint16(0)},
+		{src: int32(-1), to: types.T_uint32, want: uint32(0)},
+		{src: int64(-1), to: types.T_uint64, want: uint64(0)},
+		{src: int64(math.MinInt64), to: types.T_uint64, want: uint64(0)},
+		{src: int64(math.MaxInt64), to: types.T_uint64, want: uint64(math.MaxInt64), ok: true},
+		{src: float64(127.9), to: types.T_int8, want: int8(127), ok: true},
+		{src: float64(128), to: types.T_int8, want: int8(math.MaxInt8)},
+		{src: float64(-128.9), to: types.T_int8, want: int8(-128), ok: true},
+		{src: float64(-0.5), to: types.T_uint8, want: uint8(0), ok: true},
+		{src: float32(-1), to: types.T_uint16, want: uint16(0)},
+		{src: float64(9.2e18), to: types.T_int64, want: int64(9.2e18), ok: true},
+		{src: float64(9.3e18), to: types.T_int64, want: int64(math.MaxInt64)},
+		{src: float64(-9.3e18), to: types.T_int64, want: int64(math.MinInt64)},
+		{src: float32(1e19), to: types.T_uint64, want: uint64(float32(1e19)), ok: true},
+		{src: float32(2e19), to: types.T_uint64, want: uint64(math.MaxUint64)},
+		{src: float64(1e300), to: types.T_float32, want: float32(math.MaxFloat32)},
+		{src: float64(-1e300), to: types.T_float32, want: float32(-math.MaxFloat32)},
+		{src: float64(1e30), to: types.T_float32, want: float32(1e30), ok: true},
+		{src: math.NaN(), to: types.T_int32, want: int32(0)},
+	}
+
+	const rows = 3
+	for _, c := range cases {
+		for _, strict := range []bool{true, false} {
+			// a scalar of a row, a scalar standing for rows rows, and a
+			// vector of rows rows and a null
+			for _, branch := range []string{"scalar", "const", "vector"} {
+				name := fmt.Sprintf("cast %v(%T) as %s, strict=%v, %s", c.src, c.src, c.to, strict, branch)
+				proc := makeProcess()
+				proc.SessionInfo.StrictMode = strict
+				src := makeVector(c.src, branch != "vector")
+				n := 1
+				switch branch {
+				case "const":
+					src.Length = rows
+					n = rows
+				case "vector":
+					col := reflect.ValueOf(src.Col)
+					for i := 0; i < rows; i++ {
+						col = reflect.Append(col, col.Index(0))
+					}
+					src.Col = col.Interface()
+					src.Length = rows + 1
+					nulls.Add(src.Nsp, rows)
+					n = rows
+				}
+				vec, err := Cast([]*vector.Vector{src, makeTypeVector(c.to)}, proc)
+				if strict && !c.ok {
+					require.Error(t, err, name)
+					require.Equal(t, int32(moerr.OUT_OF_RANGE), moerr.Code(err), name)
+					require.Contains(t, err.Error(), c.to.String(), name)
+					continue
+				}
+				require.NoError(t, err, name)
+				require.Equal(t, branch != "vector", vec.IsScalar(), name)
+				col := reflect.ValueOf(vec.Col)
+				for i := 0; i < n && i < col.Len(); i++ {
+					require.Equal(t, c.want, col.Index(i).Interface(), name)
+				}
+				if branch == "vector" {
+					require.True(t, nulls.Contains(vec.Nsp, rows), name)
+				}
+				if c.ok {
+					require.Equal(t, uint16(0), proc.Warnings(), name)
+					continue
+				}
+				require.Equal(t, uint16(n), proc.Warnings(), name)
+				ws := proc.WarningMessages()
+				require.NotEmpty(t, ws, name)
+				require.Equal(t, uint16(1264), ws[0].Code, name)
+				require.Contains(t, ws[0].Message, c.to.String(), name)
+			}
+		}
+	}
+
+	// a null row is not checked
+	for _, strict := range []bool{true, false} {
+		proc := makeProcess()
+		proc.SessionInfo.StrictMode = strict
+		src := makeVector(int64(-1), false)
+		nulls.Add(src.Nsp, 0)
+		vec, err := Cast([]*vector.Vector{src, makeTypeVector(types.T_uint64)}, proc)
+		require.NoError(t, err)
+		require.True(t, nulls.Contains(vec.Nsp, 0))
+		require.Equal(t, uint16(0), proc.Warnings())
+	}
+}
+
+func TestCastStringError(t *testing.T) {
+	cases := []struct {
+		to   types.Type
+		good string
+		bad  string
+		code uint16
+		null bool // whether a row which fails the cast is null, else zero
+	}{
+		{to: types.T_int32.ToType(), good: "12", bad: "1x", code: 1292},
+		{to: types.T_uint8.ToType(), good: "12", bad: "256", code: 1264},
+		{to: types.T_float64.ToType(), good: "1.5", bad: "abc", code: 1292},
+		{to: types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2}, good: "1.25", bad: "1.2.3", code: 1292},
+		{to: types.T_date.ToType(), good: "2022-01-02", bad: "2022-13-02", code: 1292, null: true},
+		{to: types.T_datetime.ToType(), good: "2022-01-02 03:04:05", bad: "yesterday", code: 1292, null: true},
+		{to: types.T_timestamp.ToType(), good: "2022-01-02 03:04:05", bad: "2022-01-02 25:00:00", code: 1292, null: true},
+		{to: types.T_time.ToType(), good: "03:04:05", bad: "noon", code: 1292, null: true},
+		{to: types.T_bool.ToType(), good: "1", bad: "yes", code: 1292},
+	}
+
+	const rows = 10
+	for _, c := range cases {
+		goods := make([]string, rows)
+		for i := range goods {
+			goods[i] = c.good
+		}
+		want, err := Cast([]*vector.Vector{testutil.MakeVarcharVector(goods, nil), &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.to}}, makeProcess())
+		require.NoError(t, err)
+		col := reflect.ValueOf(want.Col)
+
+		// the first, a middle and the last row fail the cast
+		for _, bad := range []int{0, rows / 2, rows - 1} {
+			values := append([]string(nil), goods...)
+			values[bad] = c.bad
+			name := fmt.Sprintf("cast %s as %s at row %d", c.bad, c.to, bad+1)
+
+			proc := makeProcess()
+			proc.SessionInfo.StrictMode = true
+			_, err := Cast([]*vector.Vector{testutil.MakeVarcharVector(values, nil), &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.to}}, proc)
+			require.Error(t, err, name)
+			code, _ := moerr.MySQLError(err)
+			require.Equal(t, c.code, code, name)
+			msg := fmt.Sprintf("cannot cast '%s' from VARCHAR to %s at row %d", c.bad, c.to, bad+1)
+			require.Contains(t, err.Error(), msg, name)
+
+			proc = makeProcess()
+			vec, err := Cast([]*vector.Vector{testutil.MakeVarcharVector(values, nil), &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.to}}, proc)
+			require.NoError(t, err, name)
+			require.Equal(t, uint16(1), proc.Warnings(), name)
+			require.Equal(t, c.code, proc.WarningMessages()[0].Code, name)
+			require.Contains(t, proc.WarningMessages()[0].Message, msg, name)
+			require.Equal(t, c.null, nulls.Contains(vec.Nsp, uint64(bad)), name)
+			got := reflect.ValueOf(vec.Col)
+			for i := 0; i < rows; i++ {
+				if i == bad {
+					require.True(t, got.Index(i).IsZero(), name)
+					continue
+				}
+				require.Equal(t, col.Index(i).Interface(), got.Index(i).Interface(), name)
+			}
+		}
+
+		// each failing row is a warning, a null row is not parsed
+		values := append([]string(nil), goods...)
+		values[0], values[rows/2], values[rows-1] = c.bad, c.bad, c.bad
+		values[1] = c.bad
+		proc := makeProcess()
+		vec, err := Cast([]*vector.Vector{testutil.MakeVarcharVector(values, []uint64{1}), &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.to}}, proc)
+		require.NoError(t, err)
+		require.Equal(t, uint16(3), proc.Warnings())
+		for i, row := range []int{1, rows/2 + 1, rows} {
+			require.Contains(t, proc.WarningMessages()[i].Message, fmt.Sprintf("at row %d", row))
+		}
+		require.True(t, nulls.Contains(vec.Nsp, 1))
+
+		// a scalar standing for rows rows is a warning of each row
+		proc = makeProcess()
+		_, err = Cast([]*vector.Vector{testutil.MakeScalarVarchar(c.bad, rows), &vector.Vector{Nsp: &nulls.Nulls{}, Typ: c.to}}, proc)
+		require.NoError(t, err)
+		require.Equal(t, uint16(rows), proc.Warnings())
+	}
+
+	// a long value is truncated in the message
+	long := strings.Repeat("x", 100)
+	proc := makeProcess()
+	proc.SessionInfo.StrictMode = true
+	_, err := Cast([]*vector.Vector{testutil.MakeVarcharVector([]string{long}, nil), makeTypeVector(types.T_int64)}, proc)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "'"+long[:64]+"...'")
+	require.NotContains(t, err.Error(), long)
+}
+
+func TestImplicitCast(t *testing.T) {
+	cases := []struct {
+		value    string
+		want     float64
+		warnings uint16
+	}{
+		{value: "12", want: 12},
+		{value: " -1.5e1 ", want: -15},
+		{value: ".5", want: 0.5},
+		{value: "12abc", want: 12, warnings: 1},
+		{value: "1e", want: 1, warnings: 1},
+		{value: "1.2.3", want: 1.2, warnings: 1},
+		{value: "abc", want: 0, warnings: 1},
+		{value: "", want: 0, warnings: 1},
+	}
+
+	for _, c := range cases {
+		for _, strict := range []bool{false, true} {
+			proc := makeProcess()
+			proc.SessionInfo.StrictMode = strict
+			vec, err := ImplicitCast([]*vector.Vector{makeStringVector(c.value, types.T_varchar, true), makeTypeVector(types.T_float64)}, proc)
+			if strict && c.warnings > 0 {
+				require.Error(t, err, c.value)
+				require.Equal(t, int32(moerr.TRUNCATED_WRONG_VALUE), moerr.Code(err), c.value)
+				continue
+			}
+			require.NoError(t, err, c.value)
+			require.Equal(t, []float64{c.want}, vec.Col, c.value)
+			require.True(t, vec.IsScalar(), c.value)
+			require.Equal(t, c.warnings, proc.Warnings(), c.value)
+		}
+	}
+
+	// the nulls are not truncated
+	proc := makeProcess()
+	proc.SessionInfo.StrictMode = true
+	vec := makeStringVector("", types.T_varchar, false)
+	nulls.Add(vec.Nsp, 0)
+	res, err := ImplicitCast([]*vector.Vector{vec, makeTypeVector(types.T_float64)}, proc)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(res.Nsp, 0))
+}
+
+var numericTypes = []types.T{
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64,
+}
+
+// castCases are the casts checked against a reference by difftest, the
+// reference of a cast converts a value the way Go converts it.
+func castCases() []difftest.Case {
+	var cs []difftest.Case
+	add := func(from, to types.Type, ref func(difftest.Value) (difftest.Value, error), gen func(r *rand.Rand) difftest.Value) {
+		c := difftest.Case{
+			Name: fmt.Sprintf("cast %s as %s", from, to),
+			Args: []types.Type{from},
+			Ret:  to,
+			Fn: func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+				return Cast([]*vector.Vector{vs[0], makeTypeVector(to.Oid)}, proc)
+			},
+			Ref: difftest.Strict(func(args []difftest.Value) (difftest.Value, error) {
+				return ref(args[0])
+			}),
+		}
+		if gen != nil {
+			c.Gen = func(r *rand.Rand, _ int) difftest.Value { return gen(r) }
+		}
+		cs = append(cs, c)
+	}
+	for _, from := range numericTypes {
+		for _, to := range numericTypes {
+			add(from.ToType(), to.ToType(), castNumeric(from, to), nil)
+		}
+	}
+	for _, str := range []types.T{types.T_char, types.T_varchar} {
+		for _, to := range numericTypes {
+			to := to
+			// mostly numbers, the other strings fail the cast
+			gen := func(r *rand.Rand) difftest.Value {
+				if r.Intn(20) == 0 {
+					return difftest.RandomValue(r, str.ToType())
+				}
+				switch v := difftest.RandomValue(r, to.ToType()).(type) {
+				case float32:
+					return strconv.FormatFloat(float64(v), 'g', -1, 32)
+				default:
+					return fmt.Sprint(v)
+				}
+			}
+			add(str.ToType(), to.ToType(), castFromString(to), gen)
+			// the reference fails the strings which are not numbers, as
+			// the cast in the strict mode
+			cs[len(cs)-1].Fn = inStrictMode(cs[len(cs)-1].Fn)
+			add(to.ToType(), str.ToType(), castToString, nil)
+		}
+		for _, to := range []types.T{types.T_char, types.T_varchar} {
+			add(str.ToType(), to.ToType(), func(v difftest.Value) (difftest.Value, error) { return v, nil }, nil)
+		}
+	}
+	for _, from := range []types.T{types.T_int8, types.T_int16, types.T_int32, types.T_int64} {
+		add(from.ToType(), types.Type{Oid: types.T_decimal128}, func(v difftest.Value) (difftest.Value, error) {
+			i, _ := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+			return types.InitDecimal128(i), nil
+		}, nil)
+	}
+	for _, from := range []types.T{types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64} {
+		add(from.ToType(), types.Type{Oid: types.T_decimal128}, func(v difftest.Value) (difftest.Value, error) {
+			u, _ := strconv.ParseUint(fmt.Sprint(v), 10, 64)
+			return types.InitDecimal128UsingUint(u), nil
+		}, nil)
+	}
+	for _, typ := range []types.T{types.T_date, types.T_datetime} {
+		add(typ.ToType(), typ.ToType(), func(v difftest.Value) (difftest.Value, error) { return v, nil }, nil)
+	}
+	add(types.T_date.ToType(), types.T_datetime.ToType(), func(v difftest.Value) (difftest.Value, error) {
+		return v.(types.Date).ToTime(), nil
+	}, nil)
+	return cs
+}
+
+func inStrictMode(fn func([]*vector.Vector, *process.Process) (*vector.Vector, error)) func([]*vector.Vector, *process.Process) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		proc.SessionInfo.StrictMode = true
+		defer func() { proc.SessionInfo.StrictMode = false }()
+		return fn(vs, proc)
+	}
+}
+
+func castNumeric(from, to types.T) func(difftest.Value) (difftest.Value, error) {
+	switch from {
+	case types.T_int8:
+		return castNumericFrom[int8](to)
+	case types.T_int16:
+		return castNumericFrom[int16](to)
+	case types.T_int32:
+		return castNumericFrom[int32](to)
+	case types.T_int64:
+		return castNumericFrom[int64](to)
+	case types.T_uint8:
+		return castNumericFrom[uint8](to)
+	case types.T_uint16:
+		return castNumericFrom[uint16](to)
+	case types.T_uint32:
+		return castNumericFrom[uint32](to)
+	case types.T_uint64:
+		return castNumericFrom[uint64](to)
+	case types.T_float32:
+		return castNumericFrom[float32](to)
+	default:
+		return castNumericFrom[float64](to)
+	}
+}
+
+func castNumericFrom[T constraints.Integer | constraints.Float](to types.T) func(difftest.Value) (difftest.Value, error) {
+	var fn func(T) difftest.Value
+	switch to {
+	case types.T_int8:
+		fn = func(v T) difftest.Value { return int8(v) }
+	case types.T_int16:
+		fn = func(v T) difftest.Value { return int16(v) }
+	case types.T_int32:
+		fn = func(v T) difftest.Value { return int32(v) }
+	case types.T_int64:
+		fn = func(v T) difftest.Value { return int64(v) }
+	case types.T_uint8:
+		fn = func(v T) difftest.Value { return uint8(v) }
+	case types.T_uint16:
+		fn = func(v T) difftest.Value { return uint16(v) }
+	case types.T_uint32:
+		fn = func(v T) difftest.Value { return uint32(v) }
+	case types.T_uint64:
+		fn = func(v T) difftest.Value { return uint64(v) }
+	case types.T_float32:
+		fn = func(v T) difftest.Value { return float32(v) }
+	default:
+		fn = func(v T) difftest.Value { return float64(v) }
+	}
+	// the casts are not strict, a value out of range is clamped
+	return func(v difftest.Value) (difftest.Value, error) {
+		if !numericFits(v, to) {
+			return numericBound(v, to), nil
+		}
+		return fn(v.(T)), nil
+	}
+}
+
+// numericBound returns the bound of to nearest to v, which is out of the
+// range of to, or 0 for a NaN
+func numericBound(v difftest.Value, to types.T) difftest.Value {
+	x, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+	if err != nil {
+		panic(err)
+	}
+	var lo, hi difftest.Value
+	switch to {
+	case types.T_int8:
+		lo, hi = int8(math.MinInt8), int8(math.MaxInt8)
+	case types.T_int16:
+		lo, hi = int16(math.MinInt16), int16(math.MaxInt16)
+	case types.T_int32:
+		lo, hi = int32(math.MinInt32), int32(math.MaxInt32)
+	case types.T_int64:
+		lo, hi = int64(math.MinInt64), int64(math.MaxInt64)
+	case types.T_uint8:
+		lo, hi = uint8(0), uint8(math.MaxUint8)
+	case types.T_uint16:
+		lo, hi = uint16(0), uint16(math.MaxUint16)
+	case types.T_uint32:
+		lo, hi = uint32(0), uint32(math.MaxUint32)
+	case types.T_uint64:
+		lo, hi = uint64(0), uint64(math.MaxUint64)
+	case types.T_float32:
+		lo, hi = float32(-math.MaxFloat32), float32(math.MaxFloat32)
+	default:
+		lo, hi = -math.MaxFloat64, math.MaxFloat64
+	}
+	switch {
+	case math.IsNaN(x):
+		return reflect.Zero(reflect.TypeOf(lo)).Interface()
+	case x < 0:
+		return lo
+	default:
+		return hi
+	}
+}
+
+// numericFits returns true if the number v, with its fractional part
+// truncated, is in the range of to
+func numericFits(v difftest.Value, to types.T) bool {
+	if f, ok := v.(float32); ok {
+		v = float64(f)
+	}
+	if f, ok := v.(float64); ok && (math.IsNaN(f) || math.IsInf(f, 0)) {
+		return to == types.T_float32 || to == types.T_float64
+	}
+	x, _, err := big.ParseFloat(fmt.Sprint(v), 10, 256, big.ToZero)
+	if err != nil {
+		panic(err)
+	}
+	var lo, hi *big.Float
+	switch to {
+	case types.T_float32:
+		lo, hi = big.NewFloat(-math.MaxFloat32), big.NewFloat(math.MaxFloat32)
+	case types.T_float64:
+		return true
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		bits := uint(to.FixedLength() * 8)
+		lo = new(big.Float).SetInt(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1)))
+		hi = new(big.Float).SetInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits-1), big.NewInt(1)))
+	default:
+		bits := uint(to.FixedLength() * 8)
+		lo = new(big.Float)
+		hi = new(big.Float).SetInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1)))
+	}
+	if to != types.T_float32 {
+		i, _ := x.Int(nil)
+		x = new(big.Float).SetInt(i)
+	}
+	return x.Cmp(lo) >= 0 && x.Cmp(hi) <= 0
+}
+
+func castFromString(to types.T) func(difftest.Value) (difftest.Value, error) {
+	bits := to.FixedLength() * 8
+	return func(v difftest.Value) (difftest.Value, error) {
+		s := v.(string)
+		switch to {
+		case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+			i, err := strconv.ParseInt(s, 10, bits)
+			if err != nil {
+				return nil, err
+			}
+			return castNumeric(types.T_int64, to)(i)
+		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+			u, err := strconv.ParseUint(s, 10, bits)
+			if err != nil {
+				return nil, err
+			}
+			return castNumeric(types.T_uint64, to)(u)
+		default:
+			f, err := strconv.ParseFloat(s, bits)
+			if err != nil {
+				return nil, err
+			}
+			return castNumeric(types.T_float64, to)(f)
+		}
+	}
+}
+
+func castToString(v difftest.Value) (difftest.Value, error) {
+	switch v := v.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(v), 'G', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'G', -1, 64), nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+func TestCastDifferential(t *testing.T) {
+	for _, c := range castCases() {
+		difftest.Run(t, c, difftest.DefaultOptions)
+	}
+}
+
+// FuzzCast runs the casts on the inputs of more seeds with
+// go test -fuzz=FuzzCast.
+func FuzzCast(f *testing.F) {
+	f.Add(int64(difftest.Seed))
+	cs := castCases()
+	f.Fuzz(func(t *testing.T, seed int64) {
+		for _, c := range cs {
+			difftest.Run(t, c, difftest.Options{Seed: seed, Iterations: 20, Gen: difftest.DefaultGenOptions})
+		}
+	})
+}
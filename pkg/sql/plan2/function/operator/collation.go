@@ -0,0 +1,92 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// string comparison operators under utf8mb4_general_ci.
+
+func EqGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, func(a, b []byte) bool { return types.CompareGeneralCI(a, b) == 0 })
+}
+
+func NeGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, func(a, b []byte) bool { return types.CompareGeneralCI(a, b) != 0 })
+}
+
+func LtGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, func(a, b []byte) bool { return types.CompareGeneralCI(a, b) < 0 })
+}
+
+func LeGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, func(a, b []byte) bool { return types.CompareGeneralCI(a, b) <= 0 })
+}
+
+func GtGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, func(a, b []byte) bool { return types.CompareGeneralCI(a, b) > 0 })
+}
+
+func GeGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, func(a, b []byte) bool { return types.CompareGeneralCI(a, b) >= 0 })
+}
+
+func LikeGeneralCI(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return generalCI(vectors, proc, like.GeneralCI)
+}
+
+func generalCI(vectors []*vector.Vector, proc *process.Process, fn func([]byte, []byte) bool) (*vector.Vector, error) {
+	lv, rv := vectors[0], vectors[1]
+	boolTyp := types.Type{Oid: types.T_bool, Size: 1}
+	if lv.IsScalarNull() || rv.IsScalarNull() {
+		return proc.AllocScalarNullVector(boolTyp), nil
+	}
+	lvs, rvs := lv.Col.(*types.Bytes), rv.Col.(*types.Bytes)
+	if lv.IsScalar() && rv.IsScalar() {
+		vec := proc.AllocScalarVector(boolTyp)
+		vector.SetCol(vec, []bool{fn(lvs.Get(0), rvs.Get(0))})
+		return vec, nil
+	}
+	n := len(lvs.Lengths)
+	if lv.IsScalar() {
+		n = len(rvs.Lengths)
+	}
+	vec, err := proc.AllocVector(boolTyp, int64(n))
+	if err != nil {
+		return nil, err
+	}
+	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
+	col := make([]bool, n)
+	for i := range col {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		l, r := int64(i), int64(i)
+		if lv.IsScalar() {
+			l = 0
+		}
+		if rv.IsScalar() {
+			r = 0
+		}
+		col[i] = fn(lvs.Get(l), rvs.Get(r))
+	}
+	vector.SetCol(vec, col)
+	return vec, nil
+}
@@ -15,6 +15,7 @@
 package operator
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -24,6 +25,10 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// A division by zero is an error if the sql_mode of the session is strict and
+// has ERROR_FOR_DIVISION_BY_ZERO, else the quotient of the row is NULL with a
+// warning, as MySQL does. DIV and MOD follow the same rule.
+
 func Div[T constraints.Float](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lv, rv := vectors[0], vectors[1]
 	lvs, rvs := lv.Col.([]T), rv.Col.([]T)
@@ -38,66 +43,38 @@ func Div[T constraints.Float](vectors []*vector.Vector, proc *process.Process) (
 		vec := proc.AllocScalarVector(lv.Typ)
 		rs := make([]T, 1)
 		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrDivByZero
-				}
-			}
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, div.NumericDiv[T](lvs, rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
 		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.NumericDivSels[T](lvs, rvs, rs, sels))
 		return vec, nil
 	case lv.IsScalar() && !rv.IsScalar():
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrDivByZero
-				}
-			}
-			vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(rvs)))
-			if err != nil {
-				return nil, err
-			}
-			rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
-			nulls.Set(vec.Nsp, rv.Nsp)
-			vector.SetCol(vec, div.NumericDivScalar[T](lvs[0], rvs, rs))
-			return vec, nil
-		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
-		}
 		vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(rvs)))
 		if err != nil {
 			return nil, err
 		}
 		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
 		nulls.Set(vec.Nsp, rv.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
+			vector.SetCol(vec, div.NumericDivScalar[T](lvs[0], rvs, rs))
+			return vec, nil
+		}
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
+		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.NumericDivScalarSels[T](lvs[0], rvs, rs, sels))
 		return vec, nil
 	case !lv.IsScalar() && rv.IsScalar():
 		if rvs[0] == 0 {
-			return nil, ErrDivByZero
+			return divByScalarZero(proc, lv, lv.Typ, ErrDivByZero)
 		}
 		vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(lvs)))
 		if err != nil {
@@ -114,26 +91,15 @@ func Div[T constraints.Float](vectors []*vector.Vector, proc *process.Process) (
 	}
 	rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
 	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	if !nulls.Any(rv.Nsp) {
-		for _, v := range rvs {
-			if v == 0 {
-				return nil, ErrDivByZero
-			}
-		}
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 		vector.SetCol(vec, div.NumericDiv[T](lvs, rvs, rs))
 		return vec, nil
 	}
-	sels := process.GetSels(proc)
-	defer process.PutSels(sels, proc)
-	for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-		if nulls.Contains(rv.Nsp, i) {
-			continue
-		}
-		if rvs[i] == 0 {
-			return nil, ErrDivByZero
-		}
-		sels = append(sels, int64(i))
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+	if err != nil {
+		return nil, err
 	}
+	defer process.PutSels(sels, proc)
 	vector.SetCol(vec, div.NumericDivSels[T](lvs, rvs, rs, sels))
 	return vec, nil
 }
@@ -142,11 +108,11 @@ func DivDecimal64(vectors []*vector.Vector, proc *process.Process) (*vector.Vect
 	lv, rv := vectors[0], vectors[1]
 	lvs, rvs := lv.Col.([]types.Decimal64), rv.Col.([]types.Decimal64)
 	lvScale, rvScale := lv.Typ.Scale, rv.Typ.Scale
-	resultScale := lv.Typ.Scale
+	resultScale := types.DecimalDivScale(lv.Typ.Scale)
 	resultTyp := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: resultScale}
 
 	if lv.IsScalarNull() || rv.IsScalarNull() {
-		return proc.AllocScalarNullVector(lv.Typ), nil
+		return proc.AllocScalarNullVector(resultTyp), nil
 	}
 
 	switch {
@@ -154,49 +120,20 @@ func DivDecimal64(vectors []*vector.Vector, proc *process.Process) (*vector.Vect
 		vec := proc.AllocScalarVector(resultTyp)
 		rs := make([]types.Decimal128, 1)
 		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if int64(v) == 0 {
-					return nil, ErrDivByZero
-				}
-			}
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, div.Decimal64Div(lvs, rvs, lvScale, rvScale, rs))
 			vec.Typ = resultTyp
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if int64(rvs[i]) == 0 {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
 		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.Decimal64DivSels(lvs, rvs, lvScale, rvScale, rs, sels))
 		vec.Typ = resultTyp
 		return vec, nil
 	case lv.IsScalar() && !rv.IsScalar():
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if int64(v) == 0 {
-					return nil, ErrDivByZero
-				}
-			}
-		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if int64(rvs[i]) == 0 {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
-		}
 		vec, err := proc.AllocVector(resultTyp, int64(resultTyp.Size)*int64(len(rvs)))
 		if err != nil {
 			return nil, err
@@ -204,12 +141,17 @@ func DivDecimal64(vectors []*vector.Vector, proc *process.Process) (*vector.Vect
 		rs := encoding.DecodeDecimal128Slice(vec.Data)
 		rs = rs[:len(rvs)]
 		nulls.Set(vec.Nsp, rv.Nsp)
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
+		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.Decimal64DivScalarSels(lvs[0], rvs, lvScale, rvScale, rs, sels))
 		vec.Typ = resultTyp
 		return vec, nil
 	case !lv.IsScalar() && rv.IsScalar():
-		if int64(rvs[0]) == 0 {
-			return nil, ErrDivByZero
+		if rvs[0] == 0 {
+			return divByScalarZero(proc, lv, resultTyp, ErrDivByZero)
 		}
 		vec, err := proc.AllocVector(resultTyp, int64(resultTyp.Size)*int64(len(lvs)))
 		if err != nil {
@@ -229,27 +171,16 @@ func DivDecimal64(vectors []*vector.Vector, proc *process.Process) (*vector.Vect
 	rs := encoding.DecodeDecimal128Slice(vec.Data)
 	rs = rs[:len(rvs)]
 	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	if !nulls.Any(rv.Nsp) {
-		for _, v := range rvs {
-			if int64(v) == 0 {
-				return nil, ErrDivByZero
-			}
-		}
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 		vector.SetCol(vec, div.Decimal64Div(lvs, rvs, lvScale, rvScale, rs))
 		vec.Typ = resultTyp
 		return vec, nil
 	}
-	sels := process.GetSels(proc)
-	defer process.PutSels(sels, proc)
-	for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-		if nulls.Contains(rv.Nsp, i) {
-			continue
-		}
-		if int64(rvs[i]) == 0 {
-			return nil, ErrDivByZero
-		}
-		sels = append(sels, int64(i))
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+	if err != nil {
+		return nil, err
 	}
+	defer process.PutSels(sels, proc)
 	vector.SetCol(vec, div.Decimal64DivSels(lvs, rvs, lvScale, rvScale, rs, sels))
 	vec.Typ = resultTyp
 	return vec, nil
@@ -259,10 +190,10 @@ func DivDecimal128(vectors []*vector.Vector, proc *process.Process) (*vector.Vec
 	lv, rv := vectors[0], vectors[1]
 	lvs, rvs := lv.Col.([]types.Decimal128), rv.Col.([]types.Decimal128)
 	lvScale, rvScale := lv.Typ.Scale, rv.Typ.Scale
-	resultScale := lv.Typ.Scale
+	resultScale := types.DecimalDivScale(lv.Typ.Scale)
 	resultTyp := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: resultScale}
 	if lv.IsScalarNull() || rv.IsScalarNull() {
-		return proc.AllocScalarNullVector(lv.Typ), nil
+		return proc.AllocScalarNullVector(resultTyp), nil
 	}
 
 	switch {
@@ -270,59 +201,20 @@ func DivDecimal128(vectors []*vector.Vector, proc *process.Process) (*vector.Vec
 		vec := proc.AllocScalarVector(resultTyp)
 		rs := make([]types.Decimal128, 1)
 		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if types.Decimal128IsZero(v) {
-					return nil, ErrDivByZero
-				}
-			}
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, div.Decimal128Div(lvs, rvs, lvScale, rvScale, rs))
 			vec.Typ = resultTyp
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if types.Decimal128IsZero(rvs[i]) {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
 		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.Decimal128DivSels(lvs, rvs, lvScale, rvScale, rs, sels))
 		vec.Typ = resultTyp
 		return vec, nil
 	case lv.IsScalar() && !rv.IsScalar():
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if types.Decimal128IsZero(v) {
-					return nil, ErrDivByZero
-				}
-			}
-			vec, err := proc.AllocVector(lv.Typ, int64(resultTyp.Size)*int64(len(rvs)))
-			if err != nil {
-				return nil, err
-			}
-			rs := encoding.DecodeDecimal128Slice(vec.Data)
-			rs = rs[:len(rvs)]
-			nulls.Set(vec.Nsp, rv.Nsp)
-			vector.SetCol(vec, div.Decimal128DivScalar(lvs[0], rvs, lvScale, rvScale, rs))
-			vec.Typ = resultTyp
-			return vec, nil
-		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if types.Decimal128IsZero(rvs[i]) {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
-		}
 		vec, err := proc.AllocVector(lv.Typ, int64(resultTyp.Size)*int64(len(rvs)))
 		if err != nil {
 			return nil, err
@@ -330,12 +222,22 @@ func DivDecimal128(vectors []*vector.Vector, proc *process.Process) (*vector.Vec
 		rs := encoding.DecodeDecimal128Slice(vec.Data)
 		rs = rs[:len(rvs)]
 		nulls.Set(vec.Nsp, rv.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
+			vector.SetCol(vec, div.Decimal128DivScalar(lvs[0], rvs, lvScale, rvScale, rs))
+			vec.Typ = resultTyp
+			return vec, nil
+		}
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
+		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.Decimal128DivScalarSels(lvs[0], rvs, lvScale, rvScale, rs, sels))
 		vec.Typ = resultTyp
 		return vec, nil
 	case !lv.IsScalar() && rv.IsScalar():
 		if types.Decimal128IsZero(rvs[0]) {
-			return nil, ErrDivByZero
+			return divByScalarZero(proc, lv, resultTyp, ErrDivByZero)
 		}
 		vec, err := proc.AllocVector(lv.Typ, int64(resultTyp.Size)*int64(len(lvs)))
 		if err != nil {
@@ -355,28 +257,76 @@ func DivDecimal128(vectors []*vector.Vector, proc *process.Process) (*vector.Vec
 	rs := encoding.DecodeDecimal128Slice(vec.Data)
 	rs = rs[:len(rvs)]
 	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	if !nulls.Any(rv.Nsp) {
-		for _, v := range rvs {
-			if types.Decimal128IsZero(v) {
-				return nil, ErrDivByZero
-			}
-		}
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 		vector.SetCol(vec, div.Decimal128Div(lvs, rvs, lvScale, rvScale, rs))
 		vec.Typ = resultTyp
 		return vec, nil
 	}
-	sels := process.GetSels(proc)
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+	if err != nil {
+		return nil, err
+	}
 	defer process.PutSels(sels, proc)
-	for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-		if nulls.Contains(rv.Nsp, i) {
+	vector.SetCol(vec, div.Decimal128DivSels(lvs, rvs, lvScale, rvScale, rs, sels))
+	vec.Typ = resultTyp
+	return vec, nil
+}
+
+// hasZero returns true if any of the divisors is zero
+func hasZero[T comparable](rvs []T) bool {
+	var zero T
+	for _, v := range rvs {
+		if v == zero {
+			return true
+		}
+	}
+	return false
+}
+
+// divisorSels returns the rows of the quotient which are not null and whose
+// divisors are not zero, the rows of the zero divisors are added to nsp, the
+// nulls of the quotient. zeroErr is returned for a zero divisor if the
+// division by zero is an error. The sels are given back by process.PutSels.
+func divisorSels[T comparable](proc *process.Process, rvs []T, nsp *nulls.Nulls, zeroErr error) ([]int64, error) {
+	var zero T
+	zeros := 0
+	sels := process.GetSels(proc)
+	for i, v := range rvs {
+		if nulls.Contains(nsp, uint64(i)) {
 			continue
 		}
-		if types.Decimal128IsZero(rvs[i]) {
-			return nil, ErrDivByZero
+		if v == zero {
+			if proc.SessionInfo.ErrorForDivisionByZero {
+				process.PutSels(sels, proc)
+				return nil, zeroErr
+			}
+			nulls.Add(nsp, uint64(i))
+			zeros++
+			continue
 		}
 		sels = append(sels, int64(i))
 	}
-	vector.SetCol(vec, div.Decimal128DivSels(lvs, rvs, lvScale, rvScale, rs, sels))
-	vec.Typ = resultTyp
-	return vec, nil
+	addDivByZeroWarnings(proc, zeros, zeroErr)
+	return sels, nil
+}
+
+// divByScalarZero returns the quotient of the dividends lv by a constant zero,
+// which is NULL.
+func divByScalarZero(proc *process.Process, lv *vector.Vector, typ types.Type, zeroErr error) (*vector.Vector, error) {
+	if proc.SessionInfo.ErrorForDivisionByZero {
+		return nil, zeroErr
+	}
+	addDivByZeroWarnings(proc, vector.Length(lv)-nulls.Length(lv.Nsp), zeroErr)
+	return proc.AllocScalarNullVector(typ), nil
+}
+
+// addDivByZeroWarnings records the warnings of n divisions by zero, only the
+// message of the first is kept.
+func addDivByZeroWarnings(proc *process.Process, n int, zeroErr error) {
+	if n == 0 {
+		return
+	}
+	code, _ := moerr.MySQLError(zeroErr)
+	proc.AddWarning("Warning", code, zeroErr.Error())
+	proc.AddWarnings(n - 1)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/constraints"
@@ -28,16 +29,194 @@ func TestDiv(t *testing.T) {
 	divFloat[float32](t, types.T_float32, 235, 7.5, 31.333334)
 	divFloat[float64](t, types.T_float64, 235, 7.5, 31.333333333333332)
 
+	// the scale of the quotient is the scale of the dividend + 4
 	leftType1 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 5}
 	rightType1 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 5}
-	resType1 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 5}
-	divDecimal64(t, 33333300, leftType1, -123450000, rightType1, types.Decimal128{Lo: -27001, Hi: -1}, resType1)
+	resType1 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 9}
+	divDecimal64(t, 33333300, leftType1, -123450000, rightType1, types.Decimal128{Lo: -270014581, Hi: -1}, resType1)
 
 	leftType2 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 5}
 	rightType2 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 5}
-	resType2 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 5}
+	resType2 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 9}
 	divDecimal128(t, types.Decimal128{Lo: 33333300, Hi: 0}, leftType2, types.Decimal128{Lo: -123450000, Hi: -1}, rightType2,
-		types.Decimal128{Lo: -27001, Hi: -1}, resType2)
+		types.Decimal128{Lo: -270014581, Hi: -1}, resType2)
+
+	// 1.00 / 3 is 0.333333, and 2.00 / 3 is 0.666667 rounded
+	leftType3 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2}
+	rightType3 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 0}
+	resType3 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 6}
+	divDecimal64(t, 100, leftType3, 3, rightType3, types.Decimal128{Lo: 333333, Hi: 0}, resType3)
+	divDecimal64(t, -200, leftType3, 3, rightType3, types.Decimal128{Lo: -666667, Hi: -1}, resType3)
+
+	leftType4 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 2}
+	rightType4 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 1}
+	resType4 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 6}
+	divDecimal128(t, types.Decimal128{Lo: 200, Hi: 0}, leftType4, types.Decimal128{Lo: 30, Hi: 0}, rightType4,
+		types.Decimal128{Lo: 666667, Hi: 0}, resType4)
+}
+
+// A zero divisor makes its row NULL with a warning, unless the sql_mode of the
+// session has ERROR_FOR_DIVISION_BY_ZERO.
+func TestDivByZero(t *testing.T) {
+	d64 := types.Type{Oid: types.T_decimal64, Size: 8, Width: 10}
+	d128 := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20}
+	makeDecimal64 := func(vs []types.Decimal64, nsp ...uint64) *vector.Vector {
+		vec := vector.New(d64)
+		vec.Col = vs
+		nulls.Add(vec.Nsp, nsp...)
+		return vec
+	}
+	makeDecimal128 := func(vs []types.Decimal128, nsp ...uint64) *vector.Vector {
+		vec := vector.New(d128)
+		vec.Col = vs
+		nulls.Add(vec.Nsp, nsp...)
+		return vec
+	}
+	dec128 := func(v int64) types.Decimal128 {
+		return types.Decimal128{Lo: v}
+	}
+	cases := []struct {
+		name     string
+		fn       func([]*vector.Vector, *process.Process) (*vector.Vector, error)
+		vecs     func() []*vector.Vector
+		want     interface{}
+		nsp      []uint64
+		warnings uint16
+	}{
+		{
+			name: "float",
+			fn:   Div[float64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeFloat64Vector([]float64{8, 8, 8, 8, 8}, []uint64{4}),
+					testutil.MakeFloat64Vector([]float64{2, 0, 0, 4, 0}, []uint64{2}),
+				}
+			},
+			want:     []float64{4, 2},
+			nsp:      []uint64{1, 2, 4},
+			warnings: 1,
+		},
+		{
+			name: "float by vector",
+			fn:   Div[float64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeScalarFloat64(8, 4),
+					testutil.MakeFloat64Vector([]float64{2, 0, 0, 4}, []uint64{2}),
+				}
+			},
+			want:     []float64{4, 2},
+			nsp:      []uint64{1, 2},
+			warnings: 1,
+		},
+		{
+			name: "float by zero",
+			fn:   Div[float64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeFloat64Vector([]float64{8, 8, 8}, []uint64{1}),
+					testutil.MakeScalarFloat64(0, 3),
+				}
+			},
+			warnings: 2,
+		},
+		{
+			name: "constant float by zero",
+			fn:   Div[float64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeScalarFloat64(8, 1),
+					testutil.MakeScalarFloat64(0, 1),
+				}
+			},
+			nsp:      []uint64{0},
+			warnings: 1,
+		},
+		{
+			name: "decimal64",
+			fn:   DivDecimal64,
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					makeDecimal64([]types.Decimal64{8, 8, 8, 8}),
+					makeDecimal64([]types.Decimal64{2, 0, 0, 4}, 2),
+				}
+			},
+			want:     []types.Decimal128{dec128(40000), dec128(20000)},
+			nsp:      []uint64{1, 2},
+			warnings: 1,
+		},
+		{
+			name: "decimal64 by vector",
+			fn:   DivDecimal64,
+			vecs: func() []*vector.Vector {
+				vec := makeDecimal64([]types.Decimal64{8})
+				vec.IsConst = true
+				vec.Length = 4
+				return []*vector.Vector{vec, makeDecimal64([]types.Decimal64{0, 2, 0, 4}, 2)}
+			},
+			want:     []types.Decimal128{dec128(40000), dec128(20000)},
+			nsp:      []uint64{0, 2},
+			warnings: 1,
+		},
+		{
+			name: "decimal128",
+			fn:   DivDecimal128,
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					makeDecimal128([]types.Decimal128{dec128(8), dec128(8), dec128(8), dec128(8)}),
+					makeDecimal128([]types.Decimal128{dec128(2), {}, {}, dec128(4)}, 2),
+				}
+			},
+			want:     []types.Decimal128{dec128(40000), dec128(20000)},
+			nsp:      []uint64{1, 2},
+			warnings: 1,
+		},
+		{
+			name: "decimal128 by zero",
+			fn:   DivDecimal128,
+			vecs: func() []*vector.Vector {
+				rv := makeDecimal128([]types.Decimal128{{}})
+				rv.IsConst = true
+				rv.Length = 2
+				return []*vector.Vector{makeDecimal128([]types.Decimal128{dec128(8), dec128(8)}), rv}
+			},
+			warnings: 2,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			proc := makeProcess()
+			vec, err := c.fn(c.vecs(), proc)
+			require.NoError(t, err)
+			require.Equal(t, c.warnings, proc.Warnings())
+			if c.want == nil {
+				require.True(t, vec.IsScalarNull())
+				return
+			}
+			require.Equal(t, c.nsp, vec.Nsp.Np.ToArray())
+			var rows []interface{}
+			switch col := vec.Col.(type) {
+			case []float64:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			case []types.Decimal128:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			}
+			require.ElementsMatch(t, c.want, rows)
+
+			proc = makeProcess()
+			proc.SessionInfo.ErrorForDivisionByZero = true
+			_, err = c.fn(c.vecs(), proc)
+			require.Equal(t, ErrDivByZero, err)
+		})
+	}
 }
 
 // Unit test input of int and float parameters of div operator
@@ -24,11 +24,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-var dataTypeNum = 16
+var dataTypeNum = 17
 
 type OrderedValue interface {
 	int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string |
-		types.Date | types.Datetime | types.Decimal64
+		types.Date | types.Datetime | types.Decimal64 | types.Time
 }
 
 type DataValue interface {
@@ -57,7 +57,7 @@ var EqOpFuncMap = map[int]CompOpFunc{}
 var EqOpFuncVec = []CompOpFunc{
 	equal[int8], equal[int16], equal[int32], equal[int64], equal[uint8], equal[uint16], equal[uint32],
 	equal[uint64], equal[float32], equal[float64], equal[string], equal_B, equal[types.Date],
-	equal[types.Datetime], equal[types.Decimal64], equal_D,
+	equal[types.Datetime], equal[types.Decimal64], equal_D, equal[types.Time],
 }
 
 func InitEqOpFuncMap() {
@@ -333,39 +333,39 @@ var EqFuncMap = map[int]EqFunc{}
 var EqFuncVec = []EqFunc{
 	ColEqCol[int8], ColEqCol[int16], ColEqCol[int32], ColEqCol[int64], ColEqCol[uint8], ColEqCol[uint16],
 	ColEqCol[uint32], ColEqCol[uint64], ColEqCol[float32], ColEqCol[float64], ColEqCol[string], ColEqCol[bool],
-	ColEqCol[types.Date], ColEqCol[types.Datetime], ColEqCol[types.Decimal64], ColEqCol[types.Decimal128],
+	ColEqCol[types.Date], ColEqCol[types.Datetime], ColEqCol[types.Decimal64], ColEqCol[types.Decimal128], ColEqCol[types.Time],
 
 	ColEqConst[int8], ColEqConst[int16], ColEqConst[int32], ColEqConst[int64], ColEqConst[uint8], ColEqConst[uint16],
 	ColEqConst[uint32], ColEqConst[uint64], ColEqConst[float32], ColEqConst[float64], ColEqConst[string], ColEqConst[bool],
-	ColEqConst[types.Date], ColEqConst[types.Datetime], ColEqConst[types.Decimal64], ColEqConst[types.Decimal128],
+	ColEqConst[types.Date], ColEqConst[types.Datetime], ColEqConst[types.Decimal64], ColEqConst[types.Decimal128], ColEqConst[types.Time],
 
 	ColEqNull[int8], ColEqNull[int16], ColEqNull[int32], ColEqNull[int64], ColEqNull[uint8], ColEqNull[uint16],
 	ColEqNull[uint32], ColEqNull[uint64], ColEqNull[float32], ColEqNull[float64], ColEqNull[string], ColEqNull[bool],
-	ColEqNull[types.Date], ColEqNull[types.Datetime], ColEqNull[types.Decimal64], ColEqNull[types.Decimal128],
+	ColEqNull[types.Date], ColEqNull[types.Datetime], ColEqNull[types.Decimal64], ColEqNull[types.Decimal128], ColEqNull[types.Time],
 
 	ConstEqCol[int8], ConstEqCol[int16], ConstEqCol[int32], ConstEqCol[int64], ConstEqCol[uint8], ConstEqCol[uint16],
 	ConstEqCol[uint32], ConstEqCol[uint64], ConstEqCol[float32], ConstEqCol[float64], ConstEqCol[string], ConstEqCol[bool],
-	ConstEqCol[types.Date], ConstEqCol[types.Datetime], ConstEqCol[types.Decimal64], ConstEqCol[types.Decimal128],
+	ConstEqCol[types.Date], ConstEqCol[types.Datetime], ConstEqCol[types.Decimal64], ConstEqCol[types.Decimal128], ConstEqCol[types.Time],
 
 	ConstEqConst[int8], ConstEqConst[int16], ConstEqConst[int32], ConstEqConst[int64], ConstEqConst[uint8], ConstEqConst[uint16],
 	ConstEqConst[uint32], ConstEqConst[uint64], ConstEqConst[float32], ConstEqConst[float64], ConstEqConst[string], ConstEqConst[bool],
-	ConstEqConst[types.Date], ConstEqConst[types.Datetime], ConstEqConst[types.Decimal64], ConstEqConst[types.Decimal128],
+	ConstEqConst[types.Date], ConstEqConst[types.Datetime], ConstEqConst[types.Decimal64], ConstEqConst[types.Decimal128], ConstEqConst[types.Time],
 
 	ConstEqNull[int8], ConstEqNull[int16], ConstEqNull[int32], ConstEqNull[int64], ConstEqNull[uint8], ConstEqNull[uint16],
 	ConstEqNull[uint32], ConstEqNull[uint64], ConstEqNull[float32], ConstEqNull[float64], ConstEqNull[string], ConstEqNull[bool],
-	ConstEqNull[types.Date], ConstEqNull[types.Datetime], ConstEqNull[types.Decimal64], ConstEqNull[types.Decimal128],
+	ConstEqNull[types.Date], ConstEqNull[types.Datetime], ConstEqNull[types.Decimal64], ConstEqNull[types.Decimal128], ConstEqNull[types.Time],
 
 	NullEqCol[int8], NullEqCol[int16], NullEqCol[int32], NullEqCol[int64], NullEqCol[uint8], NullEqCol[uint16],
 	NullEqCol[uint32], NullEqCol[uint64], NullEqCol[float32], NullEqCol[float64], NullEqCol[string], NullEqCol[bool],
-	NullEqCol[types.Date], NullEqCol[types.Datetime], NullEqCol[types.Decimal64], NullEqCol[types.Decimal128],
+	NullEqCol[types.Date], NullEqCol[types.Datetime], NullEqCol[types.Decimal64], NullEqCol[types.Decimal128], NullEqCol[types.Time],
 
 	NullEqConst[int8], NullEqConst[int16], NullEqConst[int32], NullEqConst[int64], NullEqConst[uint8], NullEqConst[uint16],
 	NullEqConst[uint32], NullEqConst[uint64], NullEqConst[float32], NullEqConst[float64], NullEqConst[string], NullEqConst[bool],
-	NullEqConst[types.Date], NullEqConst[types.Datetime], NullEqConst[types.Decimal64], NullEqConst[types.Decimal128],
+	NullEqConst[types.Date], NullEqConst[types.Datetime], NullEqConst[types.Decimal64], NullEqConst[types.Decimal128], NullEqConst[types.Time],
 
 	NullEqNull[int8], NullEqNull[int16], NullEqNull[int32], NullEqNull[int64], NullEqNull[uint8], NullEqNull[uint16],
 	NullEqNull[uint32], NullEqNull[uint64], NullEqNull[float32], NullEqNull[float64], NullEqNull[string], NullEqNull[bool],
-	NullEqNull[types.Date], NullEqNull[types.Datetime], NullEqNull[types.Decimal64], NullEqNull[types.Decimal128],
+	NullEqNull[types.Date], NullEqNull[types.Datetime], NullEqNull[types.Decimal64], NullEqNull[types.Decimal128], NullEqNull[types.Time],
 }
 
 func InitEqFuncMap() {
@@ -412,6 +412,8 @@ func GetDatatypeID[T DataValue]() int {
 		return 14
 	case *types.Decimal128:
 		return 15
+	case *types.Time:
+		return 16
 	default:
 		return -1
 	}
@@ -426,5 +428,9 @@ func EqDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (
 	if err != nil {
 		return nil, errors.New("Equal function:" + err.Error())
 	}
+	// a scalar result has the rows of the operands
+	if vec.IsScalar() {
+		vec.Length = vector.Length(lv)
+	}
 	return vec, nil
 }
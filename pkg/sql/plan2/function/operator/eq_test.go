@@ -1,482 +1,170 @@
 package operator
 
 import (
-	"errors"
-	"log"
+	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/difftest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/smartystreets/goconvey/convey"
 )
 
-var EqintBool = []bool{true, false, false, false, false, true, false, false, false, false, true, false, false, false, false, false}
-var intNullPos = []int{3, 7, 11, 12, 13, 14, 15}
-var intNotNullPos = []int{0, 1, 2, 4, 5, 6, 8, 9, 10}
-
-func GetInt8() []*vector.Vector {
-	v0 := []int8{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []int8{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int8
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int8
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetInt16() []*vector.Vector {
-	v0 := []int16{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []int16{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int16
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int16
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetInt32() []*vector.Vector {
-	v0 := []int32{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []int32{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int32
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int32
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetInt64() []*vector.Vector {
-	v0 := []int64{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []int64{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int64
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_int64
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-var EquintBool = []bool{true, false, false, false, true, false, false, false, false}
-var uintNullPos = []int{2, 5, 6, 7, 8}
-var uintNotNullPos = []int{0, 1, 3, 4}
-
-func GetUint8() []*vector.Vector {
-	v0 := []uint8{0, 0, 0, 1, 1, 1, 0, 0, 0}
-	v1 := []uint8{0, 1, 0, 0, 1, 0, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint8
-	nulls.Add(vec[0].Nsp, 6)
-	nulls.Add(vec[0].Nsp, 7)
-	nulls.Add(vec[0].Nsp, 8)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint8
-	nulls.Add(vec[1].Nsp, 2)
-	nulls.Add(vec[1].Nsp, 5)
-	nulls.Add(vec[1].Nsp, 8)
-	return vec
-}
-
-func GetUint16() []*vector.Vector {
-	v0 := []uint16{0, 0, 0, 1, 1, 1, 0, 0, 0}
-	v1 := []uint16{0, 1, 0, 0, 1, 0, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint16
-	nulls.Add(vec[0].Nsp, 6)
-	nulls.Add(vec[0].Nsp, 7)
-	nulls.Add(vec[0].Nsp, 8)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint16
-	nulls.Add(vec[1].Nsp, 2)
-	nulls.Add(vec[1].Nsp, 5)
-	nulls.Add(vec[1].Nsp, 8)
-	return vec
-}
-
-func GetUint32() []*vector.Vector {
-	v0 := []uint32{0, 0, 0, 1, 1, 1, 0, 0, 0}
-	v1 := []uint32{0, 1, 0, 0, 1, 0, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint32
-	nulls.Add(vec[0].Nsp, 6)
-	nulls.Add(vec[0].Nsp, 7)
-	nulls.Add(vec[0].Nsp, 8)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint32
-	nulls.Add(vec[1].Nsp, 2)
-	nulls.Add(vec[1].Nsp, 5)
-	nulls.Add(vec[1].Nsp, 8)
-	return vec
-}
-
-func GetUint64() []*vector.Vector {
-	v0 := []uint64{0, 0, 0, 1, 1, 1, 0, 0, 0}
-	v1 := []uint64{0, 1, 0, 0, 1, 0, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint64
-	nulls.Add(vec[0].Nsp, 6)
-	nulls.Add(vec[0].Nsp, 7)
-	nulls.Add(vec[0].Nsp, 8)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_uint64
-	nulls.Add(vec[1].Nsp, 2)
-	nulls.Add(vec[1].Nsp, 5)
-	nulls.Add(vec[1].Nsp, 8)
-	return vec
-}
-
-func GetFloat32() []*vector.Vector {
-	v0 := []float32{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []float32{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_float32
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_float32
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetFloat64() []*vector.Vector {
-	v0 := []float64{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []float64{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_float64
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_float64
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetDate() []*vector.Vector {
-	v0 := []types.Date{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []types.Date{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_date
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_date
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetDatetime() []*vector.Vector {
-	v0 := []types.Datetime{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []types.Datetime{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_datetime
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_datetime
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-func GetDecimal64() []*vector.Vector {
-	v0 := []types.Decimal64{-1, -1, -1, -1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}
-	v1 := []types.Decimal64{-1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0, -1, 0, 1, 0}
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: v0,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_decimal64
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: v1,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_decimal64
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-var EqboolBool = []bool{true, false, false, false, true, false, false, false, false}
-
-func GetBool() []*vector.Vector {
-	vec := make([]*vector.Vector, 2)
-	vec[0] = &vector.Vector{
-		Col: boolVec,
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_bool
-	nulls.Add(vec[0].Nsp, 6)
-	nulls.Add(vec[0].Nsp, 7)
-	nulls.Add(vec[0].Nsp, 8)
-
-	vec[1] = &vector.Vector{
-		Col: []bool{true, false, false, true, false, false, true, false, false},
-		Nsp: &nulls.Nulls{},
-	}
-	vec[1].Typ.Oid = types.T_bool
-	nulls.Add(vec[1].Nsp, 2)
-	nulls.Add(vec[1].Nsp, 5)
-	nulls.Add(vec[1].Nsp, 8)
-	return vec
-}
-
-var EqstringBool = []bool{true, false, false, false, false, true, false, false, false, false, true, false, false, false, false, false}
-
-func GetString() []*vector.Vector {
-	vec := make([]*vector.Vector, 2)
-	str1 := []string{"-1", "-1", "-1", "-1", "0", "0", "0", "0", "1", "1", "1", "1", "", "", "", ""}
-	str2 := []string{"-1", "0", "1", "", "-1", "0", "1", "", "-1", "0", "1", "", "-1", "0", "1", ""}
-	offsets1 := []uint32{0, 2, 4, 6, 8, 9, 10, 11, 12, 13, 14, 15, 16, 16, 16, 16}
-	lengths1 := []uint32{2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0}
-	offsets2 := []uint32{0, 2, 3, 4, 4, 6, 7, 8, 8, 10, 11, 12, 13, 14, 15, 16, 16}
-	lengths2 := []uint32{2, 1, 1, 0, 2, 1, 1, 0, 2, 1, 1, 0, 2, 1, 1, 0}
-	var str3, str4 string
-	for i := 0; i < len(str1); i++ {
-		str3 = str3 + str1[i]
-		str4 = str4 + str2[i]
-	}
-	vec[0] = &vector.Vector{
-		Col: &types.Bytes{
-			Data:    []byte(str3),
-			Offsets: offsets1,
-			Lengths: lengths1,
-		},
-		Nsp: &nulls.Nulls{},
-	}
-	vec[0].Typ.Oid = types.T_varchar
-	nulls.Add(vec[0].Nsp, 12)
-	nulls.Add(vec[0].Nsp, 13)
-	nulls.Add(vec[0].Nsp, 14)
-	nulls.Add(vec[0].Nsp, 15)
-
-	vec[1] = &vector.Vector{
-		Col: &types.Bytes{
-			Data:    []byte(str4),
-			Offsets: offsets2,
-			Lengths: lengths2,
-		},
-		Nsp: &nulls.Nulls{},
-	}
-	vec[1].Typ.Oid = types.T_varchar
-	nulls.Add(vec[1].Nsp, 3)
-	nulls.Add(vec[1].Nsp, 7)
-	nulls.Add(vec[1].Nsp, 11)
-	nulls.Add(vec[1].Nsp, 15)
-	return vec
-}
-
-type testFunc = func() []*vector.Vector
-
-var testFuncVec = []testFunc{
-	GetInt8, GetInt16, GetInt32, GetInt64, GetUint8, GetUint16, GetUint32, GetUint64,
-	GetFloat32, GetFloat64, GetDate, GetDatetime, GetDecimal64, GetBool, GetString,
-}
-
-type testEqFunc = func(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error)
-
-var testEqFuncVec = []testEqFunc{
-	EqDataValue[int8], EqDataValue[int16], EqDataValue[int32], EqDataValue[int64],
-	EqDataValue[uint8], EqDataValue[uint16], EqDataValue[uint32], EqDataValue[uint64],
-	EqDataValue[float32], EqDataValue[float64], EqDataValue[types.Date],
-	EqDataValue[types.Datetime], EqDataValue[types.Decimal64], EqDataValue[bool], EqDataValue[string],
-}
-
-var EqretVec = [][]bool{
-	EqintBool, EqintBool, EqintBool, EqintBool, EquintBool, EquintBool, EquintBool, EquintBool,
-	EqintBool, EqintBool, EqintBool, EqintBool, EqintBool, EqboolBool, EqstringBool,
-}
-
-var retNullPosVec = [][]int{
-	intNullPos, intNullPos, intNullPos, intNullPos, uintNullPos, uintNullPos, uintNullPos, uintNullPos,
-	intNullPos, intNullPos, intNullPos, intNullPos, intNullPos, uintNullPos, intNullPos,
-}
-
-var retNotNullPosVec = [][]int{
-	intNotNullPos, intNotNullPos, intNotNullPos, intNotNullPos, uintNotNullPos, uintNotNullPos, uintNotNullPos, uintNotNullPos,
-	intNotNullPos, intNotNullPos, intNotNullPos, intNotNullPos, intNotNullPos, uintNotNullPos, intNotNullPos,
-}
-
-func Test_ColEqCol(t *testing.T) {
-	convey.Convey("Test col eq col operator succ", t, func() {
-		InitFuncMap()
-		proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(1000), Limit: 1000}))
-
-		for i := 0; i < 15; i++ {
-			vec := testFuncVec[i]()
-			ret, err := testEqFuncVec[i](vec, proc)
-			if err != nil {
-				log.Fatal(err)
-			}
-			data, ok := ret.Col.([]bool)
-			if !ok {
-				log.Fatal(errors.New("the return vec type is not right"))
-			}
-			for j := 0; j < len(data); j++ {
-				convey.So(data[j], convey.ShouldEqual, EqretVec[i][j])
-			}
-			for j := 0; j < len(retNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNullPosVec[i][j])), convey.ShouldEqual, true)
-			}
-			for j := 0; j < len(retNotNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNotNullPosVec[i][j])), convey.ShouldEqual, false)
-			}
+type compareFunc = func(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error)
+
+// compareTypes are the types of the operands of the comparisons, the
+// decimals have a scale.
+var compareTypes = []types.Type{
+	types.T_int8.ToType(), types.T_int16.ToType(), types.T_int32.ToType(), types.T_int64.ToType(),
+	types.T_uint8.ToType(), types.T_uint16.ToType(), types.T_uint32.ToType(), types.T_uint64.ToType(),
+	types.T_float32.ToType(), types.T_float64.ToType(), types.T_char.ToType(), types.T_varchar.ToType(),
+	types.T_bool.ToType(), types.T_date.ToType(), types.T_datetime.ToType(),
+	{Oid: types.T_decimal64, Size: 8, Width: 18, Scale: 2},
+	{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 2},
+}
+
+// compareFuncs returns the comparison of each type, fn is instantiated by
+// the type of the values of the type.
+func compareFuncs(fn func(typ types.T) compareFunc) map[types.T]compareFunc {
+	m := make(map[types.T]compareFunc)
+	for _, typ := range compareTypes {
+		m[typ.Oid] = fn(typ.Oid)
+	}
+	return m
+}
+
+// compareCases are the comparisons of op, ok tells if the result is true
+// for a result of compareValues.
+func compareCases(op string, fns map[types.T]compareFunc, ok func(int) bool) []difftest.Case {
+	cs := make([]difftest.Case, 0, len(compareTypes))
+	for _, typ := range compareTypes {
+		typ, fn := typ, fns[typ.Oid]
+		cs = append(cs, difftest.Case{
+			Name: fmt.Sprintf("%s %s %s", typ, op, typ),
+			Args: []types.Type{typ, typ},
+			Ret:  types.T_bool.ToType(),
+			// the values of the two sides are often equal
+			Gen: func(r *rand.Rand, _ int) difftest.Value {
+				if r.Intn(4) == 0 {
+					return difftest.RandomValue(rand.New(rand.NewSource(int64(r.Intn(4)))), typ)
+				}
+				return difftest.RandomValue(r, typ)
+			},
+			Fn: fn,
+			Ref: difftest.Strict(func(args []difftest.Value) (difftest.Value, error) {
+				return ok(compareValues(args[0], args[1])), nil
+			}),
+		})
+	}
+	return cs
+}
+
+func runCompare(t *testing.T, cs []difftest.Case) {
+	InitFuncMap()
+	for _, c := range cs {
+		difftest.Run(t, c, difftest.DefaultOptions)
+	}
+}
+
+// compareValues returns the sign of x - y.
+func compareValues(x, y difftest.Value) int {
+	switch x := x.(type) {
+	case bool:
+		return compareOrdered(boolToInt(x), boolToInt(y.(bool)))
+	case int8:
+		return compareOrdered(x, y.(int8))
+	case int16:
+		return compareOrdered(x, y.(int16))
+	case int32:
+		return compareOrdered(x, y.(int32))
+	case int64:
+		return compareOrdered(x, y.(int64))
+	case uint8:
+		return compareOrdered(x, y.(uint8))
+	case uint16:
+		return compareOrdered(x, y.(uint16))
+	case uint32:
+		return compareOrdered(x, y.(uint32))
+	case uint64:
+		return compareOrdered(x, y.(uint64))
+	case float32:
+		return compareOrdered(x, y.(float32))
+	case float64:
+		return compareOrdered(x, y.(float64))
+	case string:
+		return strings.Compare(x, y.(string))
+	case types.Date:
+		return compareOrdered(x, y.(types.Date))
+	case types.Datetime:
+		return compareOrdered(x, y.(types.Datetime))
+	case types.Decimal64:
+		return compareOrdered(x, y.(types.Decimal64))
+	case types.Decimal128:
+		y := y.(types.Decimal128)
+		if c := compareOrdered(x.Hi, y.Hi); c != 0 {
+			return c
 		}
-	})
+		return compareOrdered(uint64(x.Lo), uint64(y.Lo))
+	}
+	panic(fmt.Sprintf("values of %T are not compared", x))
+}
+
+func compareOrdered[T int | OrderedValue](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var eqFuncs = compareFuncs(func(typ types.T) compareFunc {
+	switch typ {
+	case types.T_int8:
+		return EqDataValue[int8]
+	case types.T_int16:
+		return EqDataValue[int16]
+	case types.T_int32:
+		return EqDataValue[int32]
+	case types.T_int64:
+		return EqDataValue[int64]
+	case types.T_uint8:
+		return EqDataValue[uint8]
+	case types.T_uint16:
+		return EqDataValue[uint16]
+	case types.T_uint32:
+		return EqDataValue[uint32]
+	case types.T_uint64:
+		return EqDataValue[uint64]
+	case types.T_float32:
+		return EqDataValue[float32]
+	case types.T_float64:
+		return EqDataValue[float64]
+	case types.T_char, types.T_varchar:
+		return EqDataValue[string]
+	case types.T_bool:
+		return EqDataValue[bool]
+	case types.T_date:
+		return EqDataValue[types.Date]
+	case types.T_datetime:
+		return EqDataValue[types.Datetime]
+	case types.T_decimal64:
+		return EqDataValue[types.Decimal64]
+	default:
+		return EqDataValue[types.Decimal128]
+	}
+})
+
+func TestEq(t *testing.T) {
+	runCompare(t, compareCases("=", eqFuncs, func(c int) bool { return c == 0 }))
 }
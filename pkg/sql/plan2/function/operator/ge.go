@@ -45,7 +45,7 @@ var GeOpFuncMap = map[int]CompOpFunc{}
 var GeOpFuncVec = []CompOpFunc{
 	gequal[int8], gequal[int16], gequal[int32], gequal[int64], gequal[uint8], gequal[uint16], gequal[uint32],
 	gequal[uint64], gequal[float32], gequal[float64], gequal[string], gequal_B, gequal[types.Date],
-	gequal[types.Datetime], gequal[types.Decimal64], gequal_D,
+	gequal[types.Datetime], gequal[types.Decimal64], gequal_D, gequal[types.Time],
 }
 
 func InitGeOpFuncMap() {
@@ -244,39 +244,39 @@ var GeFuncMap = map[int]GeFunc{}
 var GeFuncVec = []GeFunc{
 	ColGeCol[int8], ColGeCol[int16], ColGeCol[int32], ColGeCol[int64], ColGeCol[uint8], ColGeCol[uint16],
 	ColGeCol[uint32], ColGeCol[uint64], ColGeCol[float32], ColGeCol[float64], ColGeCol[string], ColGeCol[bool],
-	ColGeCol[types.Date], ColGeCol[types.Datetime], ColGeCol[types.Decimal64], ColGeCol[types.Decimal128],
+	ColGeCol[types.Date], ColGeCol[types.Datetime], ColGeCol[types.Decimal64], ColGeCol[types.Decimal128], ColGeCol[types.Time],
 
 	ColGeConst[int8], ColGeConst[int16], ColGeConst[int32], ColGeConst[int64], ColGeConst[uint8], ColGeConst[uint16],
 	ColGeConst[uint32], ColGeConst[uint64], ColGeConst[float32], ColGeConst[float64], ColGeConst[string], ColGeConst[bool],
-	ColGeConst[types.Date], ColGeConst[types.Datetime], ColGeConst[types.Decimal64], ColGeConst[types.Decimal128],
+	ColGeConst[types.Date], ColGeConst[types.Datetime], ColGeConst[types.Decimal64], ColGeConst[types.Decimal128], ColGeConst[types.Time],
 
 	ColGeNull[int8], ColGeNull[int16], ColGeNull[int32], ColGeNull[int64], ColGeNull[uint8], ColGeNull[uint16],
 	ColGeNull[uint32], ColGeNull[uint64], ColGeNull[float32], ColGeNull[float64], ColGeNull[string], ColGeNull[bool],
-	ColGeNull[types.Date], ColGeNull[types.Datetime], ColGeNull[types.Decimal64], ColGeNull[types.Decimal128],
+	ColGeNull[types.Date], ColGeNull[types.Datetime], ColGeNull[types.Decimal64], ColGeNull[types.Decimal128], ColGeNull[types.Time],
 
 	ConstGeCol[int8], ConstGeCol[int16], ConstGeCol[int32], ConstGeCol[int64], ConstGeCol[uint8], ConstGeCol[uint16],
 	ConstGeCol[uint32], ConstGeCol[uint64], ConstGeCol[float32], ConstGeCol[float64], ConstGeCol[string], ConstGeCol[bool],
-	ConstGeCol[types.Date], ConstGeCol[types.Datetime], ConstGeCol[types.Decimal64], ConstGeCol[types.Decimal128],
+	ConstGeCol[types.Date], ConstGeCol[types.Datetime], ConstGeCol[types.Decimal64], ConstGeCol[types.Decimal128], ConstGeCol[types.Time],
 
 	ConstGeConst[int8], ConstGeConst[int16], ConstGeConst[int32], ConstGeConst[int64], ConstGeConst[uint8], ConstGeConst[uint16],
 	ConstGeConst[uint32], ConstGeConst[uint64], ConstGeConst[float32], ConstGeConst[float64], ConstGeConst[string], ConstGeConst[bool],
-	ConstGeConst[types.Date], ConstGeConst[types.Datetime], ConstGeConst[types.Decimal64], ConstGeConst[types.Decimal128],
+	ConstGeConst[types.Date], ConstGeConst[types.Datetime], ConstGeConst[types.Decimal64], ConstGeConst[types.Decimal128], ConstGeConst[types.Time],
 
 	ConstGeNull[int8], ConstGeNull[int16], ConstGeNull[int32], ConstGeNull[int64], ConstGeNull[uint8], ConstGeNull[uint16],
 	ConstGeNull[uint32], ConstGeNull[uint64], ConstGeNull[float32], ConstGeNull[float64], ConstGeNull[string], ConstGeNull[bool],
-	ConstGeNull[types.Date], ConstGeNull[types.Datetime], ConstGeNull[types.Decimal64], ConstGeNull[types.Decimal128],
+	ConstGeNull[types.Date], ConstGeNull[types.Datetime], ConstGeNull[types.Decimal64], ConstGeNull[types.Decimal128], ConstGeNull[types.Time],
 
 	NullGeCol[int8], NullGeCol[int16], NullGeCol[int32], NullGeCol[int64], NullGeCol[uint8], NullGeCol[uint16],
 	NullGeCol[uint32], NullGeCol[uint64], NullGeCol[float32], NullGeCol[float64], NullGeCol[string], NullGeCol[bool],
-	NullGeCol[types.Date], NullGeCol[types.Datetime], NullGeCol[types.Decimal64], NullGeCol[types.Decimal128],
+	NullGeCol[types.Date], NullGeCol[types.Datetime], NullGeCol[types.Decimal64], NullGeCol[types.Decimal128], NullGeCol[types.Time],
 
 	NullGeConst[int8], NullGeConst[int16], NullGeConst[int32], NullGeConst[int64], NullGeConst[uint8], NullGeConst[uint16],
 	NullGeConst[uint32], NullGeConst[uint64], NullGeConst[float32], NullGeConst[float64], NullGeConst[string], NullGeConst[bool],
-	NullGeConst[types.Date], NullGeConst[types.Datetime], NullGeConst[types.Decimal64], NullGeConst[types.Decimal128],
+	NullGeConst[types.Date], NullGeConst[types.Datetime], NullGeConst[types.Decimal64], NullGeConst[types.Decimal128], NullGeConst[types.Time],
 
 	NullGeNull[int8], NullGeNull[int16], NullGeNull[int32], NullGeNull[int64], NullGeNull[uint8], NullGeNull[uint16],
 	NullGeNull[uint32], NullGeNull[uint64], NullGeNull[float32], NullGeNull[float64], NullGeNull[string], NullGeNull[bool],
-	NullGeNull[types.Date], NullGeNull[types.Datetime], NullGeNull[types.Decimal64], NullGeNull[types.Decimal128],
+	NullGeNull[types.Date], NullGeNull[types.Datetime], NullGeNull[types.Decimal64], NullGeNull[types.Decimal128], NullGeNull[types.Time],
 }
 
 func InitGeFuncMap() {
@@ -296,5 +296,8 @@ func GeDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (
 	if err != nil {
 		return nil, errors.New("Ge function: " + err.Error())
 	}
+	if vec.IsScalar() {
+		vec.Length = vector.Length(lv)
+	}
 	return vec, nil
 }
@@ -1,71 +1,48 @@
 package operator
 
 import (
-	"errors"
-	"log"
 	"testing"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
-	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/smartystreets/goconvey/convey"
 )
 
-var GeintBool = []bool{true, false, false, false, true, true, false, false, true, true, true, false, false, false, false, false}
-var GeintNullPos = []int{3, 7, 11, 12, 13, 14, 15}
-var GeintNotNullPos = []int{0, 1, 2, 4, 5, 6, 8, 9, 10}
-
-var GeuintBool = []bool{true, false, false, true, true, false, false, false, false}
-var GeuintNullPos = []int{2, 5, 6, 7, 8}
-var GeuintNotNullPos = []int{0, 1, 3, 4}
-
-var GeboolBool = []bool{true, true, false, false, true, false, false, false, false}
-
-var GestringBool = []bool{true, false, false, false, true, true, false, false, true, true, true, false, false, false, false, false}
-
-type testGeFunc = func(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error)
-
-var testGeFuncVec = []testGeFunc{
-	GeDataValue[int8], GeDataValue[int16], GeDataValue[int32], GeDataValue[int64],
-	GeDataValue[uint8], GeDataValue[uint16], GeDataValue[uint32], GeDataValue[uint64],
-	GeDataValue[float32], GeDataValue[float64], GeDataValue[types.Date],
-	GeDataValue[types.Datetime], GeDataValue[types.Decimal64], GeDataValue[bool], GeDataValue[string],
-}
-
-var GeretVec = [][]bool{
-	GeintBool, GeintBool, GeintBool, GeintBool, GeuintBool, GeuintBool, GeuintBool, GeuintBool,
-	GeintBool, GeintBool, GeintBool, GeintBool, GeintBool, GeboolBool, GestringBool,
-}
-
-func Test_ColGeCol(t *testing.T) {
-	convey.Convey("Test col eq col operator succ", t, func() {
-		InitFuncMap()
-		proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(1000), Limit: 1000}))
-
-		for i := 0; i < 15; i++ {
-			vec := testFuncVec[i]()
-			ret, err := testGeFuncVec[i](vec, proc)
-			if err != nil {
-				log.Fatal(err)
-			}
-			data, ok := ret.Col.([]bool)
-			if !ok {
-				log.Fatal(errors.New("the return vec type is not right"))
-			}
-			for j := 0; j < len(data); j++ {
-				convey.So(data[j], convey.ShouldEqual, GeretVec[i][j])
-			}
-			for j := 0; j < len(retNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNullPosVec[i][j])), convey.ShouldEqual, true)
-			}
-			for j := 0; j < len(retNotNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNotNullPosVec[i][j])), convey.ShouldEqual, false)
-			}
-		}
-
-	})
+var geFuncs = compareFuncs(func(typ types.T) compareFunc {
+	switch typ {
+	case types.T_int8:
+		return GeDataValue[int8]
+	case types.T_int16:
+		return GeDataValue[int16]
+	case types.T_int32:
+		return GeDataValue[int32]
+	case types.T_int64:
+		return GeDataValue[int64]
+	case types.T_uint8:
+		return GeDataValue[uint8]
+	case types.T_uint16:
+		return GeDataValue[uint16]
+	case types.T_uint32:
+		return GeDataValue[uint32]
+	case types.T_uint64:
+		return GeDataValue[uint64]
+	case types.T_float32:
+		return GeDataValue[float32]
+	case types.T_float64:
+		return GeDataValue[float64]
+	case types.T_char, types.T_varchar:
+		return GeDataValue[string]
+	case types.T_bool:
+		return GeDataValue[bool]
+	case types.T_date:
+		return GeDataValue[types.Date]
+	case types.T_datetime:
+		return GeDataValue[types.Datetime]
+	case types.T_decimal64:
+		return GeDataValue[types.Decimal64]
+	default:
+		return GeDataValue[types.Decimal128]
+	}
+})
+
+func TestGe(t *testing.T) {
+	runCompare(t, compareCases(">=", geFuncs, func(c int) bool { return c >= 0 }))
 }
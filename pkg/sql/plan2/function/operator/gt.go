@@ -47,7 +47,7 @@ var GtOpFuncMap = map[int]GtOpFunc{}
 var GtOpFuncVec = []GtOpFunc{
 	great[int8], great[int16], great[int32], great[int64], great[uint8], great[uint16], great[uint32],
 	great[uint64], great[float32], great[float64], great[string], great_B, great[types.Date],
-	great[types.Datetime], great[types.Decimal64], great_D,
+	great[types.Datetime], great[types.Decimal64], great_D, great[types.Time],
 }
 
 func InitGtOpFuncMap() {
@@ -246,39 +246,39 @@ var GtFuncMap = map[int]GtFunc{}
 var GtFuncVec = []GtFunc{
 	ColGtCol[int8], ColGtCol[int16], ColGtCol[int32], ColGtCol[int64], ColGtCol[uint8], ColGtCol[uint16],
 	ColGtCol[uint32], ColGtCol[uint64], ColGtCol[float32], ColGtCol[float64], ColGtCol[string], ColGtCol[bool],
-	ColGtCol[types.Date], ColGtCol[types.Datetime], ColGtCol[types.Decimal64], ColGtCol[types.Decimal128],
+	ColGtCol[types.Date], ColGtCol[types.Datetime], ColGtCol[types.Decimal64], ColGtCol[types.Decimal128], ColGtCol[types.Time],
 
 	ColGtConst[int8], ColGtConst[int16], ColGtConst[int32], ColGtConst[int64], ColGtConst[uint8], ColGtConst[uint16],
 	ColGtConst[uint32], ColGtConst[uint64], ColGtConst[float32], ColGtConst[float64], ColGtConst[string], ColGtConst[bool],
-	ColGtConst[types.Date], ColGtConst[types.Datetime], ColGtConst[types.Decimal64], ColGtConst[types.Decimal128],
+	ColGtConst[types.Date], ColGtConst[types.Datetime], ColGtConst[types.Decimal64], ColGtConst[types.Decimal128], ColGtConst[types.Time],
 
 	ColGtNull[int8], ColGtNull[int16], ColGtNull[int32], ColGtNull[int64], ColGtNull[uint8], ColGtNull[uint16],
 	ColGtNull[uint32], ColGtNull[uint64], ColGtNull[float32], ColGtNull[float64], ColGtNull[string], ColGtNull[bool],
-	ColGtNull[types.Date], ColGtNull[types.Datetime], ColGtNull[types.Decimal64], ColGtNull[types.Decimal128],
+	ColGtNull[types.Date], ColGtNull[types.Datetime], ColGtNull[types.Decimal64], ColGtNull[types.Decimal128], ColGtNull[types.Time],
 
 	ConstGtCol[int8], ConstGtCol[int16], ConstGtCol[int32], ConstGtCol[int64], ConstGtCol[uint8], ConstGtCol[uint16],
 	ConstGtCol[uint32], ConstGtCol[uint64], ConstGtCol[float32], ConstGtCol[float64], ConstGtCol[string], ConstGtCol[bool],
-	ConstGtCol[types.Date], ConstGtCol[types.Datetime], ConstGtCol[types.Decimal64], ConstGtCol[types.Decimal128],
+	ConstGtCol[types.Date], ConstGtCol[types.Datetime], ConstGtCol[types.Decimal64], ConstGtCol[types.Decimal128], ConstGtCol[types.Time],
 
 	ConstGtConst[int8], ConstGtConst[int16], ConstGtConst[int32], ConstGtConst[int64], ConstGtConst[uint8], ConstGtConst[uint16],
 	ConstGtConst[uint32], ConstGtConst[uint64], ConstGtConst[float32], ConstGtConst[float64], ConstGtConst[string], ConstGtConst[bool],
-	ConstGtConst[types.Date], ConstGtConst[types.Datetime], ConstGtConst[types.Decimal64], ConstGtConst[types.Decimal128],
+	ConstGtConst[types.Date], ConstGtConst[types.Datetime], ConstGtConst[types.Decimal64], ConstGtConst[types.Decimal128], ConstGtConst[types.Time],
 
 	ConstGtNull[int8], ConstGtNull[int16], ConstGtNull[int32], ConstGtNull[int64], ConstGtNull[uint8], ConstGtNull[uint16],
 	ConstGtNull[uint32], ConstGtNull[uint64], ConstGtNull[float32], ConstGtNull[float64], ConstGtNull[string], ConstGtNull[bool],
-	ConstGtNull[types.Date], ConstGtNull[types.Datetime], ConstGtNull[types.Decimal64], ConstGtNull[types.Decimal128],
+	ConstGtNull[types.Date], ConstGtNull[types.Datetime], ConstGtNull[types.Decimal64], ConstGtNull[types.Decimal128], ConstGtNull[types.Time],
 
 	NullGtCol[int8], NullGtCol[int16], NullGtCol[int32], NullGtCol[int64], NullGtCol[uint8], NullGtCol[uint16],
 	NullGtCol[uint32], NullGtCol[uint64], NullGtCol[float32], NullGtCol[float64], NullGtCol[string], NullGtCol[bool],
-	NullGtCol[types.Date], NullGtCol[types.Datetime], NullGtCol[types.Decimal64], NullGtCol[types.Decimal128],
+	NullGtCol[types.Date], NullGtCol[types.Datetime], NullGtCol[types.Decimal64], NullGtCol[types.Decimal128], NullGtCol[types.Time],
 
 	NullGtConst[int8], NullGtConst[int16], NullGtConst[int32], NullGtConst[int64], NullGtConst[uint8], NullGtConst[uint16],
 	NullGtConst[uint32], NullGtConst[uint64], NullGtConst[float32], NullGtConst[float64], NullGtConst[string], NullGtConst[bool],
-	NullGtConst[types.Date], NullGtConst[types.Datetime], NullGtConst[types.Decimal64], NullGtConst[types.Decimal128],
+	NullGtConst[types.Date], NullGtConst[types.Datetime], NullGtConst[types.Decimal64], NullGtConst[types.Decimal128], NullGtConst[types.Time],
 
 	NullGtNull[int8], NullGtNull[int16], NullGtNull[int32], NullGtNull[int64], NullGtNull[uint8], NullGtNull[uint16],
 	NullGtNull[uint32], NullGtNull[uint64], NullGtNull[float32], NullGtNull[float64], NullGtNull[string], NullGtNull[bool],
-	NullGtNull[types.Date], NullGtNull[types.Datetime], NullGtNull[types.Decimal64], NullGtNull[types.Decimal128],
+	NullGtNull[types.Date], NullGtNull[types.Datetime], NullGtNull[types.Decimal64], NullGtNull[types.Decimal128], NullGtNull[types.Time],
 }
 
 func InitGtFuncMap() {
@@ -298,5 +298,8 @@ func GtDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (
 	if err != nil {
 		return nil, errors.New("Gt function: " + err.Error())
 	}
+	if vec.IsScalar() {
+		vec.Length = vector.Length(lv)
+	}
 	return vec, nil
 }
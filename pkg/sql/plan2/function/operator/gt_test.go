@@ -1,71 +1,48 @@
 package operator
 
 import (
-	"errors"
-	"log"
 	"testing"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
-	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/smartystreets/goconvey/convey"
 )
 
-var GtintBool = []bool{false, false, false, false, true, false, false, false, true, true, false, false, false, false, false, false}
-var GtintNullPos = []int{3, 7, 11, 12, 13, 14, 15}
-var GtintNotNullPos = []int{0, 1, 2, 4, 5, 6, 8, 9, 10}
-
-var GtuintBool = []bool{false, false, false, true, false, false, false, false, false}
-var GtuintNullPos = []int{2, 5, 6, 7, 8}
-var GtuintNotNullPos = []int{0, 1, 3, 4}
-
-var GtboolBool = []bool{false, true, false, false, false, false, false, false, false}
-
-var GtstringBool = []bool{false, false, false, false, true, false, false, false, true, true, false, false, false, false, false, false}
-
-type testGtFunc = func(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error)
-
-var testGtFuncVec = []testGtFunc{
-	GtDataValue[int8], GtDataValue[int16], GtDataValue[int32], GtDataValue[int64],
-	GtDataValue[uint8], GtDataValue[uint16], GtDataValue[uint32], GtDataValue[uint64],
-	GtDataValue[float32], GtDataValue[float64], GtDataValue[types.Date],
-	GtDataValue[types.Datetime], GtDataValue[types.Decimal64], GtDataValue[bool], GtDataValue[string],
-}
-
-var GtretVec = [][]bool{
-	GtintBool, GtintBool, GtintBool, GtintBool, GtuintBool, GtuintBool, GtuintBool, GtuintBool,
-	GtintBool, GtintBool, GtintBool, GtintBool, GtintBool, GtboolBool, GtstringBool,
-}
-
-func Test_ColGtCol(t *testing.T) {
-	convey.Convey("Test col eq col operator succ", t, func() {
-		InitFuncMap()
-		proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(1000), Limit: 1000}))
-
-		for i := 0; i < 15; i++ {
-			vec := testFuncVec[i]()
-			ret, err := testGtFuncVec[i](vec, proc)
-			if err != nil {
-				log.Fatal(err)
-			}
-			data, ok := ret.Col.([]bool)
-			if !ok {
-				log.Fatal(errors.New("the return vec type is not right"))
-			}
-			for j := 0; j < len(data); j++ {
-				convey.So(data[j], convey.ShouldEqual, GtretVec[i][j])
-			}
-			for j := 0; j < len(retNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNullPosVec[i][j])), convey.ShouldEqual, true)
-			}
-			for j := 0; j < len(retNotNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNotNullPosVec[i][j])), convey.ShouldEqual, false)
-			}
-		}
-
-	})
+var gtFuncs = compareFuncs(func(typ types.T) compareFunc {
+	switch typ {
+	case types.T_int8:
+		return GtDataValue[int8]
+	case types.T_int16:
+		return GtDataValue[int16]
+	case types.T_int32:
+		return GtDataValue[int32]
+	case types.T_int64:
+		return GtDataValue[int64]
+	case types.T_uint8:
+		return GtDataValue[uint8]
+	case types.T_uint16:
+		return GtDataValue[uint16]
+	case types.T_uint32:
+		return GtDataValue[uint32]
+	case types.T_uint64:
+		return GtDataValue[uint64]
+	case types.T_float32:
+		return GtDataValue[float32]
+	case types.T_float64:
+		return GtDataValue[float64]
+	case types.T_char, types.T_varchar:
+		return GtDataValue[string]
+	case types.T_bool:
+		return GtDataValue[bool]
+	case types.T_date:
+		return GtDataValue[types.Date]
+	case types.T_datetime:
+		return GtDataValue[types.Datetime]
+	case types.T_decimal64:
+		return GtDataValue[types.Decimal64]
+	default:
+		return GtDataValue[types.Decimal128]
+	}
+})
+
+func TestGt(t *testing.T) {
+	runCompare(t, compareCases(">", gtFuncs, func(c int) bool { return c > 0 }))
 }
@@ -1,3 +1,17 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 package operator
 
 import (
@@ -10,117 +24,169 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// IntegerDiv is DIV of floats, the quotient is truncated to BIGINT
 func IntegerDiv[T constraints.Float](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lv, rv := vectors[0], vectors[1]
 	lvs, rvs := lv.Col.([]T), rv.Col.([]T)
-	rtl := types.T_int64.FixedLength()
+	resultTyp := types.Type{Oid: types.T_int64, Size: 8}
+	rtl := resultTyp.Oid.FixedLength()
 
 	if lv.IsScalarNull() || rv.IsScalarNull() {
-		return proc.AllocScalarNullVector(types.Type{Oid: types.T_int64, Size: 8}), nil
+		return proc.AllocScalarNullVector(resultTyp), nil
 	}
 
 	switch {
 	case lv.IsScalar() && rv.IsScalar():
-		vec := proc.AllocScalarVector(types.Type{Oid: types.T_int64, Size: 8})
+		vec := proc.AllocScalarVector(resultTyp)
 		rs := make([]int64, 1)
 		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrDivByZero
-				}
-			}
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, div.FloatIntegerDiv(lvs, rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
 		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, div.FloatIntegerDivSels(lvs, rvs, rs, sels))
 		return vec, nil
 	case lv.IsScalar() && !rv.IsScalar():
-		vec, err := proc.AllocVector(types.Type{Oid: types.T_int64, Size: 8}, int64(rtl)*int64(len(rvs)))
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(rvs)))
 		if err != nil {
 			return nil, err
 		}
 		rs := encoding.DecodeInt64Slice(vec.Data)
 		rs = rs[:len(rvs)]
-
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrDivByZero
-				}
-			}
-			nulls.Set(vec.Nsp, rv.Nsp)
+		nulls.Set(vec.Nsp, rv.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, div.FloatIntegerDivScalar(lvs[0], rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrDivByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
 		}
-		vector.SetCol(vec, div.FloatIntegerDivSels(lvs, rvs, rs, sels))
-		nulls.Set(vec.Nsp, lv.Nsp.Or(rv.Nsp))
+		defer process.PutSels(sels, proc)
+		vector.SetCol(vec, div.FloatIntegerDivScalarSels(lvs[0], rvs, rs, sels))
 		return vec, nil
 	case !lv.IsScalar() && rv.IsScalar():
-		vec, err := proc.AllocVector(types.Type{Oid: types.T_int64, Size: 8}, int64(rtl)*int64(len(lvs)))
+		// the divisor is checked once, out of the loop
+		if rvs[0] == 0 {
+			return divByScalarZero(proc, lv, resultTyp, ErrDivByZero)
+		}
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
 		if err != nil {
 			return nil, err
 		}
 		rs := encoding.DecodeInt64Slice(vec.Data)
 		rs = rs[:len(lvs)]
-
-		if rvs[0] == 0 {
-			return nil, ErrDivByZero
-		}
-		vector.SetCol(vec, div.FloatIntegerDivByScalar(rvs[0], lvs, rs))
 		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, div.FloatIntegerDivByScalar(rvs[0], lvs, rs))
 		return vec, nil
 	}
-	vec, err := proc.AllocVector(types.Type{Oid: types.T_int64, Size: 8}, int64(rtl)*int64(len(rvs)))
+	vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
 	if err != nil {
 		return nil, err
 	}
 	rs := encoding.DecodeInt64Slice(vec.Data)
-	rs = rs[:len(rvs)]
-
+	rs = rs[:len(lvs)]
 	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	if !nulls.Any(rv.Nsp) {
-		for _, v := range rvs {
-			if v == 0 {
-				return nil, ErrDivByZero
-			}
-		}
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 		vector.SetCol(vec, div.FloatIntegerDiv(lvs, rvs, rs))
 		return vec, nil
 	}
-	sels := process.GetSels(proc)
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+	if err != nil {
+		return nil, err
+	}
 	defer process.PutSels(sels, proc)
-	for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-		if nulls.Contains(rv.Nsp, i) {
-			continue
+	vector.SetCol(vec, div.FloatIntegerDivSels(lvs, rvs, rs, sels))
+	return vec, nil
+}
+
+// IntegerDivInt is DIV of integers, computed without the float conversion so
+// that the quotient of BIGINT and BIGINT UNSIGNED values is exact. The result
+// is BIGINT for signed operands and BIGINT UNSIGNED for unsigned operands.
+func IntegerDivInt[T constraints.Integer, R int64 | uint64](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lv, rv := vectors[0], vectors[1]
+	lvs, rvs := lv.Col.([]T), rv.Col.([]T)
+	resultTyp := types.Type{Oid: types.T_int64, Size: 8}
+	if _, ok := any(R(0)).(uint64); ok {
+		resultTyp.Oid = types.T_uint64
+	}
+	rtl := resultTyp.Oid.FixedLength()
+
+	if lv.IsScalarNull() || rv.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultTyp), nil
+	}
+
+	switch {
+	case lv.IsScalar() && rv.IsScalar():
+		vec := proc.AllocScalarVector(resultTyp)
+		rs := make([]R, 1)
+		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
+			vector.SetCol(vec, div.IntIntegerDiv(lvs, rvs, rs))
+			return vec, nil
 		}
-		if rvs[i] == 0 {
-			return nil, ErrDivByZero
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
+		}
+		defer process.PutSels(sels, proc)
+		vector.SetCol(vec, div.IntIntegerDivSels(lvs, rvs, rs, sels))
+		return vec, nil
+	case lv.IsScalar() && !rv.IsScalar():
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(rvs)))
+		if err != nil {
+			return nil, err
 		}
-		sels = append(sels, int64(i))
+		rs := encoding.DecodeFixedSlice[R](vec.Data, rtl)
+		rs = rs[:len(rvs)]
+		nulls.Set(vec.Nsp, rv.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
+			vector.SetCol(vec, div.IntIntegerDivScalar(lvs[0], rvs, rs))
+			return vec, nil
+		}
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+		if err != nil {
+			return nil, err
+		}
+		defer process.PutSels(sels, proc)
+		vector.SetCol(vec, div.IntIntegerDivScalarSels(lvs[0], rvs, rs, sels))
+		return vec, nil
+	case !lv.IsScalar() && rv.IsScalar():
+		// the divisor is checked once, out of the loop
+		if rvs[0] == 0 {
+			return divByScalarZero(proc, lv, resultTyp, ErrDivByZero)
+		}
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeFixedSlice[R](vec.Data, rtl)
+		rs = rs[:len(lvs)]
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, div.IntIntegerDivByScalar(rvs[0], lvs, rs))
+		return vec, nil
 	}
-	vector.SetCol(vec, div.FloatIntegerDivSels(lvs, rvs, rs, sels))
+	vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeFixedSlice[R](vec.Data, rtl)
+	rs = rs[:len(lvs)]
+	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
+		vector.SetCol(vec, div.IntIntegerDiv(lvs, rvs, rs))
+		return vec, nil
+	}
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrDivByZero)
+	if err != nil {
+		return nil, err
+	}
+	defer process.PutSels(sels, proc)
+	vector.SetCol(vec, div.IntIntegerDivSels(lvs, rvs, rs, sels))
 	return vec, nil
 }
@@ -18,9 +18,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/constraints"
+	"math"
 	"testing"
 )
 
@@ -100,3 +102,162 @@ func makeIntegerDivVectors[T constraints.Float](left T, leftScalar bool, right T
 	}
 	return vectors
 }
+
+func TestIntegerDivInt(t *testing.T) {
+	cases := []struct {
+		name  string
+		fn    func([]*vector.Vector, *process.Process) (*vector.Vector, error)
+		vecs  []*vector.Vector
+		want  interface{}
+		nulls []uint64
+	}{
+		{
+			name: "uint64 near the max",
+			fn:   IntegerDivInt[uint64, uint64],
+			vecs: []*vector.Vector{testutil.MakeUint64Vector([]uint64{math.MaxUint64, 1<<53 + 1, 10}, nil), testutil.MakeUint64Vector([]uint64{1, 1, 3}, nil)},
+			want: []uint64{math.MaxUint64, 1<<53 + 1, 3},
+		},
+		{
+			name: "uint64 by a scalar",
+			fn:   IntegerDivInt[uint64, uint64],
+			vecs: []*vector.Vector{testutil.MakeUint64Vector([]uint64{math.MaxUint64, math.MaxUint64 - 1}, nil), testutil.MakeScalarUint64(2, 2)},
+			want: []uint64{math.MaxUint64 / 2, math.MaxUint64 / 2},
+		},
+		{
+			name:  "scalar int64 by a vector with nulls",
+			fn:    IntegerDivInt[int64, int64],
+			vecs:  []*vector.Vector{testutil.MakeScalarInt64(math.MaxInt64, 3), testutil.MakeInt64Vector([]int64{2, 0, -1}, []uint64{1})},
+			want:  []int64{math.MaxInt64 / 2, 0, -math.MaxInt64},
+			nulls: []uint64{1},
+		},
+		{
+			name: "int8 does not wrap",
+			fn:   IntegerDivInt[int8, int64],
+			vecs: []*vector.Vector{testutil.MakeInt8Vector([]int8{-128, -7}, nil), testutil.MakeInt8Vector([]int8{-1, 2}, nil)},
+			want: []int64{128, -3},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vec, err := c.fn(c.vecs, makeProcess())
+			require.NoError(t, err)
+			got := vec.Col
+			if len(c.nulls) > 0 {
+				// the values of the null rows are undefined
+				switch col := got.(type) {
+				case []int64:
+					for _, row := range c.nulls {
+						col[row] = 0
+					}
+				}
+			}
+			require.Equal(t, c.want, got)
+			for _, row := range c.nulls {
+				require.True(t, nulls.Contains(vec.Nsp, row))
+			}
+		})
+	}
+
+	vec, err := IntegerDivInt[uint16, uint64]([]*vector.Vector{makeProcess().AllocScalarNullVector(types.T_uint16.ToType()), testutil.MakeUint16Vector([]uint16{1, 0}, nil)}, makeProcess())
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+	require.Equal(t, types.T_uint64, vec.Typ.Oid)
+}
+
+// A zero divisor of DIV makes its row NULL with a warning, unless the sql_mode
+// of the session has ERROR_FOR_DIVISION_BY_ZERO.
+func TestIntegerDivByZero(t *testing.T) {
+	cases := []struct {
+		name     string
+		fn       func([]*vector.Vector, *process.Process) (*vector.Vector, error)
+		vecs     func() []*vector.Vector
+		want     interface{}
+		nsp      []uint64
+		warnings uint16
+	}{
+		{
+			name: "int64",
+			fn:   IntegerDivInt[int64, int64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeInt64Vector([]int64{7, 7, 7, 7, 7}, []uint64{4}),
+					testutil.MakeInt64Vector([]int64{2, 0, 0, -3, 0}, []uint64{2}),
+				}
+			},
+			want:     []int64{3, -2},
+			nsp:      []uint64{1, 2, 4},
+			warnings: 1,
+		},
+		{
+			name: "uint32 by vector",
+			fn:   IntegerDivInt[uint32, uint64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeScalarUint32(7, 3),
+					testutil.MakeUint32Vector([]uint32{0, 2, 0}, nil),
+				}
+			},
+			want:     []uint64{3},
+			nsp:      []uint64{0, 2},
+			warnings: 2,
+		},
+		{
+			name: "int64 by zero",
+			fn:   IntegerDivInt[int64, int64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeInt64Vector([]int64{1, 2}, nil),
+					testutil.MakeScalarInt64(0, 2),
+				}
+			},
+			warnings: 2,
+		},
+		{
+			name: "float64",
+			fn:   IntegerDiv[float64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeFloat64Vector([]float64{7.5, 7.5, 7.5}, nil),
+					testutil.MakeFloat64Vector([]float64{0, 2, 0}, []uint64{2}),
+				}
+			},
+			want:     []int64{3},
+			nsp:      []uint64{0, 2},
+			warnings: 1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			proc := makeProcess()
+			vec, err := c.fn(c.vecs(), proc)
+			require.NoError(t, err)
+			require.Equal(t, c.warnings, proc.Warnings())
+			if c.want == nil {
+				require.True(t, vec.IsScalarNull())
+				return
+			}
+			require.Equal(t, c.nsp, vec.Nsp.Np.ToArray())
+			var rows []interface{}
+			switch col := vec.Col.(type) {
+			case []int64:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			case []uint64:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			}
+			require.ElementsMatch(t, c.want, rows)
+
+			proc = makeProcess()
+			proc.SessionInfo.ErrorForDivisionByZero = true
+			_, err = c.fn(c.vecs(), proc)
+			require.Equal(t, ErrDivByZero, err)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// IsNull implements the IS NULL predicate for an argument of any type, the
+// result has no nulls. IS NOT NULL is bound as its negation.
+func IsNull(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	v := vectors[0]
+	if v.IsScalar() {
+		return nullSafeEqScalar(v, v.IsScalarNull(), proc), nil
+	}
+	return colNullSafeEqNull(v, proc)
+}
@@ -47,7 +47,7 @@ var LeOpFuncMap = map[int]LeOpFunc{}
 var LeOpFuncVec = []LeOpFunc{
 	lequal[int8], lequal[int16], lequal[int32], lequal[int64], lequal[uint8], lequal[uint16], lequal[uint32],
 	lequal[uint64], lequal[float32], lequal[float64], lequal[string], lequal_B, lequal[types.Date],
-	lequal[types.Datetime], lequal[types.Decimal64], lequal_D,
+	lequal[types.Datetime], lequal[types.Decimal64], lequal_D, lequal[types.Time],
 }
 
 func InitLeOpFuncMap() {
@@ -246,39 +246,39 @@ var LeFuncMap = map[int]LeFunc{}
 var LeFuncVec = []LeFunc{
 	ColLeCol[int8], ColLeCol[int16], ColLeCol[int32], ColLeCol[int64], ColLeCol[uint8], ColLeCol[uint16],
 	ColLeCol[uint32], ColLeCol[uint64], ColLeCol[float32], ColLeCol[float64], ColLeCol[string], ColLeCol[bool],
-	ColLeCol[types.Date], ColLeCol[types.Datetime], ColLeCol[types.Decimal64], ColLeCol[types.Decimal128],
+	ColLeCol[types.Date], ColLeCol[types.Datetime], ColLeCol[types.Decimal64], ColLeCol[types.Decimal128], ColLeCol[types.Time],
 
 	ColLeConst[int8], ColLeConst[int16], ColLeConst[int32], ColLeConst[int64], ColLeConst[uint8], ColLeConst[uint16],
 	ColLeConst[uint32], ColLeConst[uint64], ColLeConst[float32], ColLeConst[float64], ColLeConst[string], ColLeConst[bool],
-	ColLeConst[types.Date], ColLeConst[types.Datetime], ColLeConst[types.Decimal64], ColLeConst[types.Decimal128],
+	ColLeConst[types.Date], ColLeConst[types.Datetime], ColLeConst[types.Decimal64], ColLeConst[types.Decimal128], ColLeConst[types.Time],
 
 	ColLeNull[int8], ColLeNull[int16], ColLeNull[int32], ColLeNull[int64], ColLeNull[uint8], ColLeNull[uint16],
 	ColLeNull[uint32], ColLeNull[uint64], ColLeNull[float32], ColLeNull[float64], ColLeNull[string], ColLeNull[bool],
-	ColLeNull[types.Date], ColLeNull[types.Datetime], ColLeNull[types.Decimal64], ColLeNull[types.Decimal128],
+	ColLeNull[types.Date], ColLeNull[types.Datetime], ColLeNull[types.Decimal64], ColLeNull[types.Decimal128], ColLeNull[types.Time],
 
 	ConstLeCol[int8], ConstLeCol[int16], ConstLeCol[int32], ConstLeCol[int64], ConstLeCol[uint8], ConstLeCol[uint16],
 	ConstLeCol[uint32], ConstLeCol[uint64], ConstLeCol[float32], ConstLeCol[float64], ConstLeCol[string], ConstLeCol[bool],
-	ConstLeCol[types.Date], ConstLeCol[types.Datetime], ConstLeCol[types.Decimal64], ConstLeCol[types.Decimal128],
+	ConstLeCol[types.Date], ConstLeCol[types.Datetime], ConstLeCol[types.Decimal64], ConstLeCol[types.Decimal128], ConstLeCol[types.Time],
 
 	ConstLeConst[int8], ConstLeConst[int16], ConstLeConst[int32], ConstLeConst[int64], ConstLeConst[uint8], ConstLeConst[uint16],
 	ConstLeConst[uint32], ConstLeConst[uint64], ConstLeConst[float32], ConstLeConst[float64], ConstLeConst[string], ConstLeConst[bool],
-	ConstLeConst[types.Date], ConstLeConst[types.Datetime], ConstLeConst[types.Decimal64], ConstLeConst[types.Decimal128],
+	ConstLeConst[types.Date], ConstLeConst[types.Datetime], ConstLeConst[types.Decimal64], ConstLeConst[types.Decimal128], ConstLeConst[types.Time],
 
 	ConstLeNull[int8], ConstLeNull[int16], ConstLeNull[int32], ConstLeNull[int64], ConstLeNull[uint8], ConstLeNull[uint16],
 	ConstLeNull[uint32], ConstLeNull[uint64], ConstLeNull[float32], ConstLeNull[float64], ConstLeNull[string], ConstLeNull[bool],
-	ConstLeNull[types.Date], ConstLeNull[types.Datetime], ConstLeNull[types.Decimal64], ConstLeNull[types.Decimal128],
+	ConstLeNull[types.Date], ConstLeNull[types.Datetime], ConstLeNull[types.Decimal64], ConstLeNull[types.Decimal128], ConstLeNull[types.Time],
 
 	NullLeCol[int8], NullLeCol[int16], NullLeCol[int32], NullLeCol[int64], NullLeCol[uint8], NullLeCol[uint16],
 	NullLeCol[uint32], NullLeCol[uint64], NullLeCol[float32], NullLeCol[float64], NullLeCol[string], NullLeCol[bool],
-	NullLeCol[types.Date], NullLeCol[types.Datetime], NullLeCol[types.Decimal64], NullLeCol[types.Decimal128],
+	NullLeCol[types.Date], NullLeCol[types.Datetime], NullLeCol[types.Decimal64], NullLeCol[types.Decimal128], NullLeCol[types.Time],
 
 	NullLeConst[int8], NullLeConst[int16], NullLeConst[int32], NullLeConst[int64], NullLeConst[uint8], NullLeConst[uint16],
 	NullLeConst[uint32], NullLeConst[uint64], NullLeConst[float32], NullLeConst[float64], NullLeConst[string], NullLeConst[bool],
-	NullLeConst[types.Date], NullLeConst[types.Datetime], NullLeConst[types.Decimal64], NullLeConst[types.Decimal128],
+	NullLeConst[types.Date], NullLeConst[types.Datetime], NullLeConst[types.Decimal64], NullLeConst[types.Decimal128], NullLeConst[types.Time],
 
 	NullLeNull[int8], NullLeNull[int16], NullLeNull[int32], NullLeNull[int64], NullLeNull[uint8], NullLeNull[uint16],
 	NullLeNull[uint32], NullLeNull[uint64], NullLeNull[float32], NullLeNull[float64], NullLeNull[string], NullLeNull[bool],
-	NullLeNull[types.Date], NullLeNull[types.Datetime], NullLeNull[types.Decimal64], NullLeNull[types.Decimal128],
+	NullLeNull[types.Date], NullLeNull[types.Datetime], NullLeNull[types.Decimal64], NullLeNull[types.Decimal128], NullLeNull[types.Time],
 }
 
 func InitLeFuncMap() {
@@ -298,5 +298,8 @@ func LeDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (
 	if err != nil {
 		return nil, errors.New("Le function: " + err.Error())
 	}
+	if vec.IsScalar() {
+		vec.Length = vector.Length(lv)
+	}
 	return vec, nil
 }
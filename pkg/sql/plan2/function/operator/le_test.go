@@ -1,71 +1,48 @@
 package operator
 
 import (
-	"errors"
-	"log"
 	"testing"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
-	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/smartystreets/goconvey/convey"
 )
 
-var LeintBool = []bool{true, true, true, false, false, true, true, false, false, false, true, false, false, false, false, false}
-var LeintNullPos = []int{3, 7, 11, 12, 13, 14, 15}
-var LeintNotNullPos = []int{0, 1, 2, 4, 5, 6, 8, 9, 10}
-
-var LeuintBool = []bool{true, true, false, false, true, false, false, false, false}
-var LeuintNullPos = []int{2, 5, 6, 7, 8}
-var LeuintNotNullPos = []int{0, 1, 3, 4}
-
-var LeboolBool = []bool{true, false, false, true, true, false, false, false, false}
-
-var LestringBool = []bool{true, true, true, false, false, true, true, false, false, false, true, false, false, false, false, false}
-
-type testLeFunc = func(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error)
-
-var testLeFuncVec = []testLeFunc{
-	LeDataValue[int8], LeDataValue[int16], LeDataValue[int32], LeDataValue[int64],
-	LeDataValue[uint8], LeDataValue[uint16], LeDataValue[uint32], LeDataValue[uint64],
-	LeDataValue[float32], LeDataValue[float64], LeDataValue[types.Date],
-	LeDataValue[types.Datetime], LeDataValue[types.Decimal64], LeDataValue[bool], LeDataValue[string],
-}
-
-var LeretVec = [][]bool{
-	LeintBool, LeintBool, LeintBool, LeintBool, LeuintBool, LeuintBool, LeuintBool, LeuintBool,
-	LeintBool, LeintBool, LeintBool, LeintBool, LeintBool, LeboolBool, LestringBool,
-}
-
-func Test_ColLeCol(t *testing.T) {
-	convey.Convey("Test col eq col operator succ", t, func() {
-		InitFuncMap()
-		proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(1000), Limit: 1000}))
-
-		for i := 0; i < 15; i++ {
-			vec := testFuncVec[i]()
-			ret, err := testLeFuncVec[i](vec, proc)
-			if err != nil {
-				log.Fatal(err)
-			}
-			data, ok := ret.Col.([]bool)
-			if !ok {
-				log.Fatal(errors.New("the return vec type is not right"))
-			}
-			for j := 0; j < len(data); j++ {
-				convey.So(data[j], convey.ShouldEqual, LeretVec[i][j])
-			}
-			for j := 0; j < len(retNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNullPosVec[i][j])), convey.ShouldEqual, true)
-			}
-			for j := 0; j < len(retNotNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNotNullPosVec[i][j])), convey.ShouldEqual, false)
-			}
-		}
-
-	})
+var leFuncs = compareFuncs(func(typ types.T) compareFunc {
+	switch typ {
+	case types.T_int8:
+		return LeDataValue[int8]
+	case types.T_int16:
+		return LeDataValue[int16]
+	case types.T_int32:
+		return LeDataValue[int32]
+	case types.T_int64:
+		return LeDataValue[int64]
+	case types.T_uint8:
+		return LeDataValue[uint8]
+	case types.T_uint16:
+		return LeDataValue[uint16]
+	case types.T_uint32:
+		return LeDataValue[uint32]
+	case types.T_uint64:
+		return LeDataValue[uint64]
+	case types.T_float32:
+		return LeDataValue[float32]
+	case types.T_float64:
+		return LeDataValue[float64]
+	case types.T_char, types.T_varchar:
+		return LeDataValue[string]
+	case types.T_bool:
+		return LeDataValue[bool]
+	case types.T_date:
+		return LeDataValue[types.Date]
+	case types.T_datetime:
+		return LeDataValue[types.Datetime]
+	case types.T_decimal64:
+		return LeDataValue[types.Decimal64]
+	default:
+		return LeDataValue[types.Decimal128]
+	}
+})
+
+func TestLe(t *testing.T) {
+	runCompare(t, compareCases("<=", leFuncs, func(c int) bool { return c <= 0 }))
 }
@@ -15,7 +15,7 @@
 package operator
 
 import (
-	"errors"
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -24,151 +24,56 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-var (
-	errUnexpected = errors.New("unexpected case for LIKE operator")
-)
-
+// Like is the LIKE operator. A scalar pattern is compiled once for the
+// vector, a pattern vector is compiled row by row. The result is null if
+// either argument is null.
 func Like(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lv, rv := vectors[0], vectors[1]
-	lvs, rvs := lv.Col.(*types.Bytes), rv.Col.(*types.Bytes)
-	rtl := 8
-
+	boolTyp := types.Type{Oid: types.T_bool, Size: 1}
 	if lv.IsScalarNull() || rv.IsScalarNull() {
-		return proc.AllocScalarNullVector(types.Type{Oid: types.T_bool}), nil
+		return proc.AllocScalarNullVector(boolTyp), nil
+	}
+	lvs, rvs := lv.Col.(*types.Bytes), rv.Col.(*types.Bytes)
+	if lv.IsScalar() && rv.IsScalar() {
+		vec := proc.AllocScalarVector(boolTyp)
+		vector.SetCol(vec, []bool{like.Compile(rvs.Get(0)).Match(lvs.Get(0))})
+		return vec, nil
 	}
 
+	n := len(lvs.Lengths)
+	if lv.IsScalar() {
+		n = len(rvs.Lengths)
+	}
+	// the matched rows are collected in the data of the result
+	vec, err := proc.AllocVector(boolTyp, int64(n*8))
+	if err != nil {
+		return nil, err
+	}
+	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
+	var np *roaring.Bitmap
+	if nulls.Any(vec.Nsp) {
+		np = vec.Nsp.Np
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)[:n]
 	switch {
-	case !lv.IsScalar() && rv.IsScalar():
-		vec, err := proc.AllocVector(types.Type{Oid: types.T_bool}, int64(len(lvs.Offsets)*rtl))
-		if err != nil {
-			return nil, err
-		}
-		rs := encoding.DecodeInt64Slice(vec.Data)
-		rs = rs[:len(lvs.Lengths)]
-		if nulls.Any(lv.Nsp) {
-			rs, err = like.BtSliceNullAndConst(lvs, rvs.Get(0), lv.Nsp.Np, rs)
-			if err != nil {
-				return nil, err
-			}
-			vec.Nsp = lv.Nsp
-		} else {
-			rs, err = like.BtSliceAndConst(lvs, rvs.Get(0), rs)
-			if err != nil {
-				return nil, err
-			}
-		}
-		col := make([]bool, len(lvs.Offsets))
-		rsi := 0
-		for i := 0; i < len(col); i++ {
-			if rsi >= len(rs) {
-				break
-			}
-			if int64(i) == rs[rsi] {
-				col[i] = true
-				rsi++
-			} else {
-				col[i] = false
-			}
-		}
-		vector.SetCol(vec, col)
-		return vec, nil
-	case lv.IsScalar() && rv.IsScalar(): // in our design, this case should deal while pruning extends.
-		vec := proc.AllocScalarVector(types.Type{Oid: types.T_bool})
-		rs := make([]int64, 1)
-		rs, err := like.BtConstAndConst(lvs.Get(0), rvs.Get(0), rs)
-		if err != nil {
-			return nil, err
-		}
-		col := make([]bool, 1)
-		if rs == nil {
-			col[0] = false
-		} else {
-			col[0] = rs[0] == int64(0)
-		}
-		vector.SetCol(vec, col)
-		return vec, nil
-	case lv.IsScalar() && !rv.IsScalar():
-		vec, err := proc.AllocVector(types.Type{Oid: types.T_bool}, int64(len(rvs.Offsets)*rtl))
-		if err != nil {
-			return nil, err
-		}
-		rs := encoding.DecodeInt64Slice(vec.Data)
-		rs = rs[:len(rvs.Lengths)]
-		if nulls.Any(rv.Nsp) {
-			rs, err = like.BtConstAndSliceNull(lvs.Get(0), rvs, rv.Nsp.Np, rs)
-			if err != nil {
-				return nil, err
-			}
-			vec.Nsp = rv.Nsp
-		} else {
-			rs, err = like.BtConstAndSlice(lvs.Get(0), rvs, rs)
-			if err != nil {
-				return nil, err
-			}
-		}
-		col := make([]bool, len(rvs.Offsets))
-		rsi := 0
-		for i := 0; i < len(col); i++ {
-			if rsi >= len(rs) {
-				break
-			}
-			if int64(i) == rs[rsi] {
-				col[i] = true
-				rsi++
-			} else {
-				col[i] = false
-			}
-		}
-		vector.SetCol(vec, col)
-		return vec, nil
-	case !lv.IsScalar() && !rv.IsScalar():
-		vec, err := proc.AllocVector(types.Type{Oid: types.T_bool}, int64(len(lvs.Offsets)*rtl))
-		if err != nil {
-			return nil, err
-		}
-		rs := encoding.DecodeInt64Slice(vec.Data)
-		rs = rs[:len(rvs.Lengths)]
-		if nulls.Any(rv.Nsp) && nulls.Any(lv.Nsp) {
-			nsp := lv.Nsp.Or(rv.Nsp)
-			rs, err = like.BtSliceNullAndSliceNull(lvs, rvs, nsp.Np, rs)
-			if err != nil {
-				return nil, err
-			}
-			vec.Nsp = nsp
-		} else if nulls.Any(rv.Nsp) && !nulls.Any(lv.Nsp) {
-			rs, err = like.BtSliceNullAndSliceNull(lvs, rvs, rv.Nsp.Np, rs)
-			if err != nil {
-				return nil, err
-			}
-			vec.Nsp = rv.Nsp
-		} else if !nulls.Any(rv.Nsp) && nulls.Any(lv.Nsp) {
-			rs, err = like.BtSliceNullAndSliceNull(lvs, rvs, lv.Nsp.Np, rs)
-			if err != nil {
-				return nil, err
-			}
-			//vector.SetCol(vec, rs)
-			vec.Nsp = lv.Nsp
-		} else {
-			rs, err = like.BtSliceAndSlice(lvs, rvs, rs)
-			if err != nil {
-				return nil, err
-			}
-		}
-		col := make([]bool, len(lvs.Offsets))
-		rsi := 0
-		for i := 0; i < len(col); i++ {
-			if rsi >= len(rs) {
-				break
-			}
-			if int64(i) == rs[rsi] {
-				col[i] = true
-				rsi++
-			} else {
-				col[i] = false
-			}
-		}
-		vector.SetCol(vec, col)
-		return vec, nil
+	case rv.IsScalar():
+		rs = like.Compile(rvs.Get(0)).MatchSlice(lvs, np, rs)
+	case lv.IsScalar() && np != nil:
+		rs, err = like.BtConstAndSliceNull(lvs.Get(0), rvs, np, rs)
+	case lv.IsScalar():
+		rs, err = like.BtConstAndSlice(lvs.Get(0), rvs, rs)
+	case np != nil:
+		rs, err = like.BtSliceNullAndSliceNull(lvs, rvs, np, rs)
+	default:
+		rs, err = like.BtSliceAndSlice(lvs, rvs, rs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	col := make([]bool, n)
+	for _, i := range rs {
+		col[i] = true
 	}
-	return nil, errUnexpected
+	vector.SetCol(vec, col)
+	return vec, nil
 }
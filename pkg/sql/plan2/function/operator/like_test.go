@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -160,6 +161,77 @@ func TestLpadVarchar2(t *testing.T) {
 	}
 }
 
+// The result of like has the nulls of both arguments, it used to share or
+// mutate the nulls of an argument, which were cleared when the result was put.
+func TestLikeNulls(t *testing.T) {
+	proc := makeProcess()
+	cases := []struct {
+		lnsp, rnsp []uint64
+		want       []uint64
+	}{
+		{lnsp: []uint64{0}, rnsp: []uint64{2}, want: []uint64{0, 2}},
+		{lnsp: []uint64{0}, want: []uint64{0}},
+		{rnsp: []uint64{2}, want: []uint64{2}},
+	}
+	for _, c := range cases {
+		lv := testutil.MakeVarcharVector([]string{"a", "b", "c"}, c.lnsp)
+		rv := testutil.MakeVarcharVector([]string{"a", "%", "c"}, c.rnsp)
+		vec, err := Like([]*vector.Vector{lv, rv}, proc)
+		require.NoError(t, err)
+		require.Equal(t, c.want, vec.Nsp.Np.ToArray())
+		process.Put(proc, vec)
+		require.Equal(t, len(c.lnsp), nulls.Length(lv.Nsp))
+		require.Equal(t, len(c.rnsp), nulls.Length(rv.Nsp))
+	}
+}
+
+func TestLikeVector(t *testing.T) {
+	proc := makeProcess()
+	values := []string{"中文字符", "abc", "naïve", "", "a中c", "ab"}
+	check := func(vec *vector.Vector, want []bool, wantNulls []uint64) {
+		require.Equal(t, want, vec.Col.([]bool))
+		if wantNulls == nil {
+			require.False(t, nulls.Any(vec.Nsp))
+		} else {
+			require.Equal(t, wantNulls, vec.Nsp.Np.ToArray())
+		}
+		process.Put(proc, vec)
+	}
+
+	// scalar patterns
+	for _, c := range []struct {
+		pattern string
+		want    []bool
+	}{
+		{pattern: "中%", want: []bool{true, false, false, false, false, false}},
+		{pattern: "%c", want: []bool{false, true, false, false, true, false}},
+		{pattern: "%ï%", want: []bool{false, false, true, false, false, false}},
+		{pattern: "a_c", want: []bool{false, true, false, false, true, false}},
+		{pattern: "____", want: []bool{true, false, false, false, false, false}},
+		{pattern: "", want: []bool{false, false, false, true, false, false}},
+	} {
+		vec, err := Like([]*vector.Vector{testutil.MakeVarcharVector(values, []uint64{1}), testutil.MakeScalarVarchar(c.pattern, len(values))}, proc)
+		require.NoError(t, err)
+		c.want[1] = false
+		check(vec, c.want, []uint64{1})
+	}
+
+	// a pattern vector
+	patterns := []string{"%字%", "a_c", "naïv_", "%", "abc", "a%"}
+	vec, err := Like([]*vector.Vector{testutil.MakeVarcharVector(values, []uint64{3}), testutil.MakeVarcharVector(patterns, []uint64{4})}, proc)
+	require.NoError(t, err)
+	check(vec, []bool{true, true, true, false, false, true}, []uint64{3, 4})
+
+	vec, err = Like([]*vector.Vector{testutil.MakeScalarVarchar("abc", len(patterns)), testutil.MakeVarcharVector(patterns, nil)}, proc)
+	require.NoError(t, err)
+	check(vec, []bool{false, true, false, true, true, true}, nil)
+
+	// a null pattern
+	vec, err = Like([]*vector.Vector{testutil.MakeVarcharVector(values, nil), testutil.MakeScalarNull(len(values))}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+}
+
 func makeProcess() *process.Process {
 	hm := host.New(1 << 40)
 	gm := guest.New(1<<40, hm)
@@ -0,0 +1,155 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The logical connectives follow the three-valued logic of sql, a null is an
+// unknown truth value: AND is false as soon as one side is false and OR is true
+// as soon as one side is true, whatever the other side is, the other results
+// with a null are null. Each row is computed from the (value, null) pairs of
+// its operands, and the value of a null row is always false in the result, so
+// the rows of an unknown condition never pass a filter.
+
+// logicFn returns the value and the null flag of a connective for one row,
+// from the values l, r and the null flags ln, rn of its operands.
+type logicFn = func(l, ln, r, rn bool) (bool, bool)
+
+func and3(l, ln, r, rn bool) (bool, bool) {
+	if (!l && !ln) || (!r && !rn) {
+		return false, false
+	}
+	if ln || rn {
+		return false, true
+	}
+	return true, false
+}
+
+func or3(l, ln, r, rn bool) (bool, bool) {
+	if (l && !ln) || (r && !rn) {
+		return true, false
+	}
+	if ln || rn {
+		return false, true
+	}
+	return false, false
+}
+
+func xor3(l, ln, r, rn bool) (bool, bool) {
+	if ln || rn {
+		return false, true
+	}
+	return l != r, false
+}
+
+func not3(v, n bool) (bool, bool) {
+	return !v && !n, n
+}
+
+// logicColCol computes the connective of two bool columns
+func logicColCol(lv, rv *vector.Vector, proc *process.Process, fn logicFn) (*vector.Vector, error) {
+	lvs, ok := lv.Col.([]bool)
+	if !ok {
+		return nil, errors.New("the left vec col is not []bool type")
+	}
+	rvs, ok := rv.Col.([]bool)
+	if !ok {
+		return nil, errors.New("the right vec col is not []bool type")
+	}
+	n := len(lvs)
+	vec, err := proc.AllocVector(proc.GetBoolTyp(lv.Typ), int64(n)*1)
+	if err != nil {
+		return nil, err
+	}
+	col := encoding.DecodeBoolSlice(vec.Data)[:n]
+	for i := range col {
+		var isNull bool
+		col[i], isNull = fn(lvs[i], nulls.Contains(lv.Nsp, uint64(i)), rvs[i], nulls.Contains(rv.Nsp, uint64(i)))
+		if isNull {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// logicColScalar computes the connective of a bool column and a scalar, which
+// may be the scalar null. The connectives are commutative, so it also serves
+// for a scalar on the left.
+func logicColScalar(lv, rv *vector.Vector, proc *process.Process, fn logicFn) (*vector.Vector, error) {
+	lvs, ok := lv.Col.([]bool)
+	if !ok {
+		return nil, errors.New("the left vec col is not []bool type")
+	}
+	r, rn, err := scalarBool(rv)
+	if err != nil {
+		return nil, errors.New("the right vec col is not []bool type")
+	}
+	n := len(lvs)
+	vec, err := proc.AllocVector(proc.GetBoolTyp(lv.Typ), int64(n)*1)
+	if err != nil {
+		return nil, err
+	}
+	col := encoding.DecodeBoolSlice(vec.Data)[:n]
+	for i := range col {
+		var isNull bool
+		col[i], isNull = fn(lvs[i], nulls.Contains(lv.Nsp, uint64(i)), r, rn)
+		if isNull {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// logicScalarScalar computes the connective of two scalars, either of which
+// may be the scalar null
+func logicScalarScalar(lv, rv *vector.Vector, proc *process.Process, fn logicFn) (*vector.Vector, error) {
+	l, ln, err := scalarBool(lv)
+	if err != nil {
+		return nil, errors.New("the left vec col is not []bool type")
+	}
+	r, rn, err := scalarBool(rv)
+	if err != nil {
+		return nil, errors.New("the right vec col is not []bool type")
+	}
+	v, isNull := fn(l, ln, r, rn)
+	if isNull {
+		return proc.AllocScalarNullVector(lv.Typ), nil
+	}
+	vec := proc.AllocScalarVector(proc.GetBoolTyp(lv.Typ))
+	vector.SetCol(vec, []bool{v})
+	return vec, nil
+}
+
+// scalarBool returns the value and the null flag of a bool scalar, the scalar
+// null may be of any type
+func scalarBool(v *vector.Vector) (bool, bool, error) {
+	if v.IsScalarNull() {
+		return false, true, nil
+	}
+	vs, ok := v.Col.([]bool)
+	if !ok || len(vs) == 0 {
+		return false, false, errors.New("not a bool scalar")
+	}
+	return vs[0], false, nil
+}
@@ -0,0 +1,322 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// truth is a value of the three-valued logic
+type truth int8
+
+const (
+	tFalse truth = iota
+	tTrue
+	tNull
+)
+
+var truths = []truth{tFalse, tTrue, tNull}
+
+func (t truth) String() string {
+	return [...]string{"false", "true", "null"}[t]
+}
+
+// the truth tables of sql, indexed by the left and the right operands
+var (
+	andTable = [3][3]truth{
+		tFalse: {tFalse, tFalse, tFalse},
+		tTrue:  {tFalse, tTrue, tNull},
+		tNull:  {tFalse, tNull, tNull},
+	}
+	orTable = [3][3]truth{
+		tFalse: {tFalse, tTrue, tNull},
+		tTrue:  {tTrue, tTrue, tTrue},
+		tNull:  {tNull, tTrue, tNull},
+	}
+	xorTable = [3][3]truth{
+		tFalse: {tFalse, tTrue, tNull},
+		tTrue:  {tTrue, tFalse, tNull},
+		tNull:  {tNull, tNull, tNull},
+	}
+	notTable = [3]truth{tFalse: tTrue, tTrue: tFalse, tNull: tNull}
+)
+
+type logicCase struct {
+	name  string
+	fn    func([]*vector.Vector, *process.Process) (*vector.Vector, error)
+	table [3][3]truth
+}
+
+var logicCases = []logicCase{
+	{"and", And, andTable},
+	{"or", Or, orTable},
+	{"xor", Xor, xorTable},
+}
+
+// makeTruthColumn returns a bool column of ts, the value under a null row is
+// garbage, which the connectives must ignore
+func makeTruthColumn(ts []truth, garbage bool) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_bool, Size: 1})
+	vs := make([]bool, len(ts))
+	for i, t := range ts {
+		switch t {
+		case tTrue:
+			vs[i] = true
+		case tNull:
+			vs[i] = garbage
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	vector.SetCol(vec, vs)
+	return vec
+}
+
+func makeTruthScalar(t truth) *vector.Vector {
+	if t == tNull {
+		return makeScalarNullVector(types.T_bool)
+	}
+	return makeVector(t == tTrue, true)
+}
+
+// readTruths returns the n rows of a result, and checks that the value of a
+// null row is false
+func readTruths(t *testing.T, vec *vector.Vector, n int) []truth {
+	ts := make([]truth, n)
+	if vec.IsScalar() {
+		require.Equal(t, types.T_bool, vec.Typ.Oid)
+		r := tNull
+		if !vec.IsScalarNull() {
+			r = tFalse
+			if vec.Col.([]bool)[0] {
+				r = tTrue
+			}
+		}
+		for i := range ts {
+			ts[i] = r
+		}
+		return ts
+	}
+	vs := vec.Col.([]bool)
+	require.Equal(t, n, len(vs))
+	for i, v := range vs {
+		switch {
+		case nulls.Contains(vec.Nsp, uint64(i)):
+			require.False(t, v, "the value of the null row %d", i)
+			ts[i] = tNull
+		case v:
+			ts[i] = tTrue
+		default:
+			ts[i] = tFalse
+		}
+	}
+	return ts
+}
+
+func TestLogicTruthTables(t *testing.T) {
+	InitFuncMap()
+	proc := makeProcess()
+	// every pair of truths, row by row
+	var ls, rs []truth
+	for _, l := range truths {
+		for _, r := range truths {
+			ls, rs = append(ls, l), append(rs, r)
+		}
+	}
+	for _, c := range logicCases {
+		for _, garbage := range []bool{false, true} {
+			lv, rv := makeTruthColumn(ls, garbage), makeTruthColumn(rs, !garbage)
+			ret, err := c.fn([]*vector.Vector{lv, rv}, proc)
+			require.NoError(t, err)
+			for i, r := range readTruths(t, ret, len(ls)) {
+				require.Equal(t, c.table[ls[i]][rs[i]], r, "%s %s %s", ls[i], c.name, rs[i])
+			}
+		}
+		// a column with a scalar on either side
+		for _, s := range truths {
+			for _, garbage := range []bool{false, true} {
+				col := makeTruthColumn(truths, garbage)
+				ret, err := c.fn([]*vector.Vector{col, makeTruthScalar(s)}, proc)
+				require.NoError(t, err)
+				for i, r := range readTruths(t, ret, len(truths)) {
+					require.Equal(t, c.table[truths[i]][s], r, "%s %s scalar %s", truths[i], c.name, s)
+				}
+				ret, err = c.fn([]*vector.Vector{makeTruthScalar(s), col}, proc)
+				require.NoError(t, err)
+				for i, r := range readTruths(t, ret, len(truths)) {
+					require.Equal(t, c.table[s][truths[i]], r, "scalar %s %s %s", s, c.name, truths[i])
+				}
+			}
+		}
+		// two scalars
+		for _, l := range truths {
+			for _, r := range truths {
+				ret, err := c.fn([]*vector.Vector{makeTruthScalar(l), makeTruthScalar(r)}, proc)
+				require.NoError(t, err)
+				require.True(t, ret.IsScalar())
+				require.Equal(t, c.table[l][r], readTruths(t, ret, 1)[0], "scalar %s %s scalar %s", l, c.name, r)
+			}
+		}
+	}
+
+	for _, garbage := range []bool{false, true} {
+		ret, err := Not([]*vector.Vector{makeTruthColumn(truths, garbage)}, proc)
+		require.NoError(t, err)
+		for i, r := range readTruths(t, ret, len(truths)) {
+			require.Equal(t, notTable[truths[i]], r, "not %s", truths[i])
+		}
+	}
+	for _, s := range truths {
+		ret, err := Not([]*vector.Vector{makeTruthScalar(s)}, proc)
+		require.NoError(t, err)
+		require.True(t, ret.IsScalar())
+		require.Equal(t, notTable[s], readTruths(t, ret, 1)[0], "not scalar %s", s)
+	}
+}
+
+// TestLogicRandom compares the connectives with a row at a time evaluation of
+// their truth tables, on random columns and scalars.
+func TestLogicRandom(t *testing.T) {
+	InitFuncMap()
+	proc := makeProcess()
+	rnd := rand.New(rand.NewSource(1017))
+	randomArg := func(n int) ([]truth, *vector.Vector) {
+		ts := make([]truth, n)
+		if rnd.Intn(4) == 0 {
+			s := truths[rnd.Intn(len(truths))]
+			for i := range ts {
+				ts[i] = s
+			}
+			return ts, makeTruthScalar(s)
+		}
+		nullPercent := []int{0, 10, 50, 100}[rnd.Intn(4)]
+		for i := range ts {
+			switch {
+			case rnd.Intn(100) < nullPercent:
+				ts[i] = tNull
+			case rnd.Intn(2) == 0:
+				ts[i] = tTrue
+			}
+		}
+		return ts, makeTruthColumn(ts, rnd.Intn(2) == 0)
+	}
+	for round := 0; round < 500; round++ {
+		n := rnd.Intn(200) + 1
+		ls, lv := randomArg(n)
+		rs, rv := randomArg(n)
+		for _, c := range logicCases {
+			ret, err := c.fn([]*vector.Vector{lv, rv}, proc)
+			require.NoError(t, err)
+			for i, r := range readTruths(t, ret, n) {
+				require.Equal(t, c.table[ls[i]][rs[i]], r, "round %d row %d: %s %s %s", round, i, ls[i], c.name, rs[i])
+			}
+		}
+		ret, err := Not([]*vector.Vector{lv}, proc)
+		require.NoError(t, err)
+		for i, r := range readTruths(t, ret, n) {
+			require.Equal(t, notTable[ls[i]], r, "round %d row %d: not %s", round, i, ls[i])
+		}
+		// the arguments are left untouched
+		if !lv.IsScalar() {
+			require.Equal(t, ls[0] == tNull, nulls.Contains(lv.Nsp, 0))
+		}
+	}
+}
+
+// TestLogicOfComparisons checks that the outputs of the comparison operators,
+// whose values under the null rows are unspecified, compose with the
+// connectives: a > 5 is unknown when a is null.
+func TestLogicOfComparisons(t *testing.T) {
+	InitFuncMap()
+	InitGtOpFuncMap()
+	proc := makeProcess()
+	const rows = 1000
+	rnd := rand.New(rand.NewSource(5))
+	makeColumn := func() ([]int64, []bool, *vector.Vector) {
+		vs, ns := make([]int64, rows), make([]bool, rows)
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		for i := range vs {
+			vs[i] = int64(rnd.Intn(10))
+			if rnd.Intn(3) == 0 {
+				ns[i] = true
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+		vector.SetCol(vec, vs)
+		return vs, ns, vec
+	}
+	compare := func(isNull bool, v bool) truth {
+		switch {
+		case isNull:
+			return tNull
+		case v:
+			return tTrue
+		}
+		return tFalse
+	}
+	as, ans, a := makeColumn()
+	bs, bns, b := makeColumn()
+
+	// NOT (a > 5 OR b IS NULL)
+	gt, err := GtDataValue[int64]([]*vector.Vector{a, makeVector(int64(5), true)}, proc)
+	require.NoError(t, err)
+	isNull, err := IsNull([]*vector.Vector{b}, proc)
+	require.NoError(t, err)
+	or, err := Or([]*vector.Vector{gt, isNull}, proc)
+	require.NoError(t, err)
+	not, err := Not([]*vector.Vector{or}, proc)
+	require.NoError(t, err)
+	for i, r := range readTruths(t, not, rows) {
+		expect := notTable[orTable[compare(ans[i], as[i] > 5)][compare(false, bns[i])]]
+		require.Equal(t, expect, r, "row %d: a %d %v, b null %v", i, as[i], ans[i], bns[i])
+	}
+
+	// a = b AND a < 3
+	eq, err := EqDataValue[int64]([]*vector.Vector{a, b}, proc)
+	require.NoError(t, err)
+	lt, err := LtDataValue[int64]([]*vector.Vector{a, makeVector(int64(3), true)}, proc)
+	require.NoError(t, err)
+	and, err := And([]*vector.Vector{eq, lt}, proc)
+	require.NoError(t, err)
+	for i, r := range readTruths(t, and, rows) {
+		expect := andTable[compare(ans[i] || bns[i], as[i] == bs[i])][compare(ans[i], as[i] < 3)]
+		require.Equal(t, expect, r, "row %d: a %d %v, b %d %v", i, as[i], ans[i], bs[i], bns[i])
+	}
+}
+
+func TestIsNull(t *testing.T) {
+	proc := makeProcess()
+	col := makeTruthColumn([]truth{tTrue, tNull, tFalse, tNull}, true)
+	ret, err := IsNull([]*vector.Vector{col}, proc)
+	require.NoError(t, err)
+	require.False(t, nulls.Any(ret.Nsp))
+	require.Equal(t, []bool{false, true, false, true}, ret.Col.([]bool))
+
+	ret, err = IsNull([]*vector.Vector{makeScalarNullVector(types.T_int64)}, proc)
+	require.NoError(t, err)
+	require.True(t, ret.IsScalar())
+	require.False(t, nulls.Any(ret.Nsp))
+	require.Equal(t, []bool{true}, ret.Col.([]bool))
+
+	ret, err = IsNull([]*vector.Vector{makeStringVector("a", types.T_varchar, true)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, ret.Col.([]bool))
+}
@@ -47,7 +47,7 @@ var LtOpFuncMap = map[int]LtOpFunc{}
 var LtOpFuncVec = []LtOpFunc{
 	less[int8], less[int16], less[int32], less[int64], less[uint8], less[uint16], less[uint32],
 	less[uint64], less[float32], less[float64], less[string], less_B, less[types.Date],
-	less[types.Datetime], less[types.Decimal64], less_D,
+	less[types.Datetime], less[types.Decimal64], less_D, less[types.Time],
 }
 
 func InitLtOpFuncMap() {
@@ -246,39 +246,39 @@ var LtFuncMap = map[int]LtFunc{}
 var LtFuncVec = []LtFunc{
 	ColLtCol[int8], ColLtCol[int16], ColLtCol[int32], ColLtCol[int64], ColLtCol[uint8], ColLtCol[uint16],
 	ColLtCol[uint32], ColLtCol[uint64], ColLtCol[float32], ColLtCol[float64], ColLtCol[string], ColLtCol[bool],
-	ColLtCol[types.Date], ColLtCol[types.Datetime], ColLtCol[types.Decimal64], ColLtCol[types.Decimal128],
+	ColLtCol[types.Date], ColLtCol[types.Datetime], ColLtCol[types.Decimal64], ColLtCol[types.Decimal128], ColLtCol[types.Time],
 
 	ColLtConst[int8], ColLtConst[int16], ColLtConst[int32], ColLtConst[int64], ColLtConst[uint8], ColLtConst[uint16],
 	ColLtConst[uint32], ColLtConst[uint64], ColLtConst[float32], ColLtConst[float64], ColLtConst[string], ColLtConst[bool],
-	ColLtConst[types.Date], ColLtConst[types.Datetime], ColLtConst[types.Decimal64], ColLtConst[types.Decimal128],
+	ColLtConst[types.Date], ColLtConst[types.Datetime], ColLtConst[types.Decimal64], ColLtConst[types.Decimal128], ColLtConst[types.Time],
 
 	ColLtNull[int8], ColLtNull[int16], ColLtNull[int32], ColLtNull[int64], ColLtNull[uint8], ColLtNull[uint16],
 	ColLtNull[uint32], ColLtNull[uint64], ColLtNull[float32], ColLtNull[float64], ColLtNull[string], ColLtNull[bool],
-	ColLtNull[types.Date], ColLtNull[types.Datetime], ColLtNull[types.Decimal64], ColLtNull[types.Decimal128],
+	ColLtNull[types.Date], ColLtNull[types.Datetime], ColLtNull[types.Decimal64], ColLtNull[types.Decimal128], ColLtNull[types.Time],
 
 	ConstLtCol[int8], ConstLtCol[int16], ConstLtCol[int32], ConstLtCol[int64], ConstLtCol[uint8], ConstLtCol[uint16],
 	ConstLtCol[uint32], ConstLtCol[uint64], ConstLtCol[float32], ConstLtCol[float64], ConstLtCol[string], ConstLtCol[bool],
-	ConstLtCol[types.Date], ConstLtCol[types.Datetime], ConstLtCol[types.Decimal64], ConstLtCol[types.Decimal128],
+	ConstLtCol[types.Date], ConstLtCol[types.Datetime], ConstLtCol[types.Decimal64], ConstLtCol[types.Decimal128], ConstLtCol[types.Time],
 
 	ConstLtConst[int8], ConstLtConst[int16], ConstLtConst[int32], ConstLtConst[int64], ConstLtConst[uint8], ConstLtConst[uint16],
 	ConstLtConst[uint32], ConstLtConst[uint64], ConstLtConst[float32], ConstLtConst[float64], ConstLtConst[string], ConstLtConst[bool],
-	ConstLtConst[types.Date], ConstLtConst[types.Datetime], ConstLtConst[types.Decimal64], ConstLtConst[types.Decimal128],
+	ConstLtConst[types.Date], ConstLtConst[types.Datetime], ConstLtConst[types.Decimal64], ConstLtConst[types.Decimal128], ConstLtConst[types.Time],
 
 	ConstLtNull[int8], ConstLtNull[int16], ConstLtNull[int32], ConstLtNull[int64], ConstLtNull[uint8], ConstLtNull[uint16],
 	ConstLtNull[uint32], ConstLtNull[uint64], ConstLtNull[float32], ConstLtNull[float64], ConstLtNull[string], ConstLtNull[bool],
-	ConstLtNull[types.Date], ConstLtNull[types.Datetime], ConstLtNull[types.Decimal64], ConstLtNull[types.Decimal128],
+	ConstLtNull[types.Date], ConstLtNull[types.Datetime], ConstLtNull[types.Decimal64], ConstLtNull[types.Decimal128], ConstLtNull[types.Time],
 
 	NullLtCol[int8], NullLtCol[int16], NullLtCol[int32], NullLtCol[int64], NullLtCol[uint8], NullLtCol[uint16],
 	NullLtCol[uint32], NullLtCol[uint64], NullLtCol[float32], NullLtCol[float64], NullLtCol[string], NullLtCol[bool],
-	NullLtCol[types.Date], NullLtCol[types.Datetime], NullLtCol[types.Decimal64], NullLtCol[types.Decimal128],
+	NullLtCol[types.Date], NullLtCol[types.Datetime], NullLtCol[types.Decimal64], NullLtCol[types.Decimal128], NullLtCol[types.Time],
 
 	NullLtConst[int8], NullLtConst[int16], NullLtConst[int32], NullLtConst[int64], NullLtConst[uint8], NullLtConst[uint16],
 	NullLtConst[uint32], NullLtConst[uint64], NullLtConst[float32], NullLtConst[float64], NullLtConst[string], NullLtConst[bool],
-	NullLtConst[types.Date], NullLtConst[types.Datetime], NullLtConst[types.Decimal64], NullLtConst[types.Decimal128],
+	NullLtConst[types.Date], NullLtConst[types.Datetime], NullLtConst[types.Decimal64], NullLtConst[types.Decimal128], NullLtConst[types.Time],
 
 	NullLtNull[int8], NullLtNull[int16], NullLtNull[int32], NullLtNull[int64], NullLtNull[uint8], NullLtNull[uint16],
 	NullLtNull[uint32], NullLtNull[uint64], NullLtNull[float32], NullLtNull[float64], NullLtNull[string], NullLtNull[bool],
-	NullLtNull[types.Date], NullLtNull[types.Datetime], NullLtNull[types.Decimal64], NullLtNull[types.Decimal128],
+	NullLtNull[types.Date], NullLtNull[types.Datetime], NullLtNull[types.Decimal64], NullLtNull[types.Decimal128], NullLtNull[types.Time],
 }
 
 func InitLtFuncMap() {
@@ -298,5 +298,8 @@ func LtDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (
 	if err != nil {
 		return nil, errors.New("Lt function: " + err.Error())
 	}
+	if vec.IsScalar() {
+		vec.Length = vector.Length(lv)
+	}
 	return vec, nil
 }
@@ -177,3 +177,69 @@ func MinusDecimal128(vectors []*vector.Vector, proc *process.Process) (*vector.V
 		return vec, nil
 	}
 }
+
+// MinusDatetime returns the durations between two datetime or timestamp
+// vectors, they are of TIME with the larger precision of the operands.
+func MinusDatetime[T types.Datetime | types.Timestamp](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lv, rv := vectors[0], vectors[1]
+	lvs, rvs := lv.Col.([]T), rv.Col.([]T)
+	resultTyp := types.Type{Oid: types.T_time, Size: 8, Precision: lv.Typ.Precision}
+	if rv.Typ.Precision > resultTyp.Precision {
+		resultTyp.Precision = rv.Typ.Precision
+	}
+	resultElementSize := int(resultTyp.Size)
+	if lv.IsScalarNull() || rv.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultTyp), nil
+	}
+
+	switch {
+	case lv.IsScalar() && rv.IsScalar():
+		resultVector := proc.AllocScalarVector(resultTyp)
+		resultValues := make([]types.Time, 1)
+		nulls.Or(lv.Nsp, rv.Nsp, resultVector.Nsp)
+		if _, err := sub.Datetime(lvs, rvs, resultVector.Nsp, resultValues); err != nil {
+			return nil, err
+		}
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	case lv.IsScalar() && !rv.IsScalar():
+		resultVector, err := proc.AllocVector(resultTyp, int64(resultElementSize*len(rvs)))
+		if err != nil {
+			return nil, err
+		}
+		resultValues := encoding.DecodeFixedSlice[types.Time](resultVector.Data, resultElementSize)
+		nulls.Or(lv.Nsp, rv.Nsp, resultVector.Nsp)
+		if _, err = sub.DatetimeScalar(lvs[0], rvs, resultVector.Nsp, resultValues); err != nil {
+			vector.Free(resultVector, proc.Mp)
+			return nil, err
+		}
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	case !lv.IsScalar() && rv.IsScalar():
+		resultVector, err := proc.AllocVector(resultTyp, int64(resultElementSize*len(lvs)))
+		if err != nil {
+			return nil, err
+		}
+		resultValues := encoding.DecodeFixedSlice[types.Time](resultVector.Data, resultElementSize)
+		nulls.Or(lv.Nsp, rv.Nsp, resultVector.Nsp)
+		if _, err = sub.DatetimeByScalar(rvs[0], lvs, resultVector.Nsp, resultValues); err != nil {
+			vector.Free(resultVector, proc.Mp)
+			return nil, err
+		}
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	default:
+		resultVector, err := proc.AllocVector(resultTyp, int64(resultElementSize*len(lvs)))
+		if err != nil {
+			return nil, err
+		}
+		resultValues := encoding.DecodeFixedSlice[types.Time](resultVector.Data, resultElementSize)
+		nulls.Or(lv.Nsp, rv.Nsp, resultVector.Nsp)
+		if _, err = sub.Datetime(lvs, rvs, resultVector.Nsp, resultValues); err != nil {
+			vector.Free(resultVector, proc.Mp)
+			return nil, err
+		}
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	}
+}
@@ -240,3 +240,29 @@ func minusDecimal128(t *testing.T, left types.Decimal128, leftType types.Type, r
 		})
 	}
 }
+
+func TestMinusDatetime(t *testing.T) {
+	procs := makeProcess()
+	x, _ := types.ParseDatetime("2022-01-02 10:00:00.5")
+	y, _ := types.ParseDatetime("2022-01-02 08:30:00")
+	z, _ := types.ParseDatetime("2021-01-01 00:00:00")
+
+	lv := &vector.Vector{Col: []types.Datetime{x, y, z}, Nsp: &nulls.Nulls{}, Typ: types.Type{Oid: types.T_datetime, Precision: 1}, Length: 3}
+	rv := &vector.Vector{Col: []types.Datetime{y, x, x}, Nsp: &nulls.Nulls{}, Typ: types.Type{Oid: types.T_datetime}, Length: 3}
+	// the durations of the nulls are not checked
+	nulls.Add(rv.Nsp, 2)
+	res, err := MinusDatetime[types.Datetime]([]*vector.Vector{lv, rv}, procs)
+	require.NoError(t, err)
+	require.Equal(t, types.T_time, res.Typ.Oid)
+	require.Equal(t, int32(1), res.Typ.Precision)
+	rs := res.Col.([]types.Time)
+	require.Equal(t, "01:30:00.500000", rs[0].String2(6))
+	require.Equal(t, "-01:30:00.500000", rs[1].String2(6))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+
+	sv := &vector.Vector{Col: []types.Datetime{z}, Nsp: &nulls.Nulls{}, Typ: types.Type{Oid: types.T_datetime}, IsConst: true, Length: 1}
+	_, err = MinusDatetime[types.Datetime]([]*vector.Vector{sv, rv}, procs)
+	require.Error(t, err)
+	_, err = MinusDatetime[types.Datetime]([]*vector.Vector{rv, sv}, procs)
+	require.Error(t, err)
+}
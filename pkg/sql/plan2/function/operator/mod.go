@@ -23,230 +23,164 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// ModInt is MOD of integers, the rest has the sign of the dividend
 func ModInt[T constraints.Integer](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lv, rv := vectors[0], vectors[1]
 	lvs, rvs := lv.Col.([]T), rv.Col.([]T)
-	rtl := lv.Typ.Oid.FixedLength()
+	resultTyp := lv.Typ
+	rtl := resultTyp.Oid.FixedLength()
 
 	if lv.IsScalarNull() || rv.IsScalarNull() {
-		return proc.AllocScalarNullVector(lv.Typ), nil
+		return proc.AllocScalarNullVector(resultTyp), nil
 	}
 
 	switch {
 	case lv.IsScalar() && rv.IsScalar():
-		vec := proc.AllocScalarVector(lv.Typ)
+		vec := proc.AllocScalarVector(resultTyp)
 		rs := make([]T, 1)
 		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrModByZero
-				}
-			}
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, mod.IntMod(lvs, rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrModByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrModByZero)
+		if err != nil {
+			return nil, err
 		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, mod.IntModSels(lvs, rvs, rs, sels))
 		return vec, nil
 	case lv.IsScalar() && !rv.IsScalar():
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrModByZero
-				}
-			}
-			vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(rvs)))
-			if err != nil {
-				return nil, err
-			}
-			rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
-			nulls.Set(vec.Nsp, rv.Nsp)
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(rvs)))
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
+		rs = rs[:len(rvs)]
+		nulls.Set(vec.Nsp, rv.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, mod.IntModScalar(lvs[0], rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrModByZero
-			}
-			sels = append(sels, int64(i))
-		}
-		vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(rvs)))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrModByZero)
 		if err != nil {
 			return nil, err
 		}
-		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
-		nulls.Set(vec.Nsp, rv.Nsp)
-		vector.SetCol(vec, mod.IntModByScalarSels(lvs[0], rvs, rs, sels))
+		defer process.PutSels(sels, proc)
+		vector.SetCol(vec, mod.IntModScalarSels(lvs[0], rvs, rs, sels))
 		return vec, nil
 	case !lv.IsScalar() && rv.IsScalar():
+		// the divisor is checked once, out of the loop
 		if rvs[0] == 0 {
-			return nil, ErrModByZero
+			return divByScalarZero(proc, lv, resultTyp, ErrModByZero)
 		}
-		vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(lvs)))
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
 		if err != nil {
 			return nil, err
 		}
 		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
+		rs = rs[:len(lvs)]
 		nulls.Set(vec.Nsp, lv.Nsp)
 		vector.SetCol(vec, mod.IntModByScalar(rvs[0], lvs, rs))
 		return vec, nil
 	}
-	vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(lvs)))
+	vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
 	if err != nil {
 		return nil, err
 	}
 	rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
+	rs = rs[:len(lvs)]
 	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	if !nulls.Any(rv.Nsp) {
-		for _, v := range rvs {
-			if v == 0 {
-				return nil, ErrModByZero
-			}
-		}
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 		vector.SetCol(vec, mod.IntMod(lvs, rvs, rs))
 		return vec, nil
 	}
-	sels := process.GetSels(proc)
-	defer process.PutSels(sels, proc)
-	for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-		if nulls.Contains(rv.Nsp, i) {
-			continue
-		}
-		if rvs[i] == 0 {
-			return nil, ErrModByZero
-		}
-		sels = append(sels, int64(i))
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrModByZero)
+	if err != nil {
+		return nil, err
 	}
+	defer process.PutSels(sels, proc)
 	vector.SetCol(vec, mod.IntModSels(lvs, rvs, rs, sels))
 	return vec, nil
 }
 
+// ModFloat is MOD of floats
 func ModFloat[T constraints.Float](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	lv, rv := vectors[0], vectors[1]
 	lvs, rvs := lv.Col.([]T), rv.Col.([]T)
-	rtl := lv.Typ.Oid.FixedLength()
+	resultTyp := lv.Typ
+	rtl := resultTyp.Oid.FixedLength()
 
 	if lv.IsScalarNull() || rv.IsScalarNull() {
-		return proc.AllocScalarNullVector(lv.Typ), nil
+		return proc.AllocScalarNullVector(resultTyp), nil
 	}
 
 	switch {
 	case lv.IsScalar() && rv.IsScalar():
-		vec := proc.AllocScalarVector(lv.Typ)
+		vec := proc.AllocScalarVector(resultTyp)
 		rs := make([]T, 1)
 		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrModByZero
-				}
-			}
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, mod.FloatMod(lvs, rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrModByZero
-			}
-			sels = append(sels, int64(i))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrModByZero)
+		if err != nil {
+			return nil, err
 		}
+		defer process.PutSels(sels, proc)
 		vector.SetCol(vec, mod.FloatModSels(lvs, rvs, rs, sels))
 		return vec, nil
 	case lv.IsScalar() && !rv.IsScalar():
-		if !nulls.Any(rv.Nsp) {
-			for _, v := range rvs {
-				if v == 0 {
-					return nil, ErrModByZero
-				}
-			}
-			vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(rvs)))
-			if err != nil {
-				return nil, err
-			}
-			rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
-			nulls.Set(vec.Nsp, rv.Nsp)
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(rvs)))
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
+		rs = rs[:len(rvs)]
+		nulls.Set(vec.Nsp, rv.Nsp)
+		if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 			vector.SetCol(vec, mod.FloatModScalar(lvs[0], rvs, rs))
 			return vec, nil
 		}
-		sels := process.GetSels(proc)
-		defer process.PutSels(sels, proc)
-		for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-			if nulls.Contains(rv.Nsp, i) {
-				continue
-			}
-			if rvs[i] == 0 {
-				return nil, ErrModByZero
-			}
-			sels = append(sels, int64(i))
-		}
-		vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(rvs)))
+		sels, err := divisorSels(proc, rvs, vec.Nsp, ErrModByZero)
 		if err != nil {
 			return nil, err
 		}
-		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
-		nulls.Set(vec.Nsp, rv.Nsp)
-		vector.SetCol(vec, mod.FloatModByScalarSels(lvs[0], rvs, rs, sels))
+		defer process.PutSels(sels, proc)
+		vector.SetCol(vec, mod.FloatModScalarSels(lvs[0], rvs, rs, sels))
 		return vec, nil
 	case !lv.IsScalar() && rv.IsScalar():
+		// the divisor is checked once, out of the loop
 		if rvs[0] == 0 {
-			return nil, ErrModByZero
+			return divByScalarZero(proc, lv, resultTyp, ErrModByZero)
 		}
-		vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(lvs)))
+		vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
 		if err != nil {
 			return nil, err
 		}
 		rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
+		rs = rs[:len(lvs)]
 		nulls.Set(vec.Nsp, lv.Nsp)
 		vector.SetCol(vec, mod.FloatModByScalar(rvs[0], lvs, rs))
 		return vec, nil
 	}
-	vec, err := proc.AllocVector(lv.Typ, int64(rtl)*int64(len(lvs)))
+	vec, err := proc.AllocVector(resultTyp, int64(rtl)*int64(len(lvs)))
 	if err != nil {
 		return nil, err
 	}
 	rs := encoding.DecodeFixedSlice[T](vec.Data, rtl)
+	rs = rs[:len(lvs)]
 	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	if !nulls.Any(rv.Nsp) {
-		for _, v := range rvs {
-			if v == 0 {
-				return nil, ErrModByZero
-			}
-		}
+	if !nulls.Any(rv.Nsp) && !hasZero(rvs) {
 		vector.SetCol(vec, mod.FloatMod(lvs, rvs, rs))
 		return vec, nil
 	}
-	sels := process.GetSels(proc)
-	defer process.PutSels(sels, proc)
-	for i, j := uint64(0), uint64(len(rvs)); i < j; i++ {
-		if nulls.Contains(rv.Nsp, i) {
-			continue
-		}
-		if rvs[i] == 0 {
-			return nil, ErrModByZero
-		}
-		sels = append(sels, int64(i))
+	sels, err := divisorSels(proc, rvs, vec.Nsp, ErrModByZero)
+	if err != nil {
+		return nil, err
 	}
+	defer process.PutSels(sels, proc)
 	vector.SetCol(vec, mod.FloatModSels(lvs, rvs, rs, sels))
 	return vec, nil
 }
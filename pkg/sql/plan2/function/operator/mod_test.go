@@ -18,9 +18,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/constraints"
+	"math"
 	"testing"
 )
 
@@ -34,9 +36,14 @@ func TestMod(t *testing.T) {
 	modInteger[uint16](t, types.T_uint16, 28, 5, 3)
 	modInteger[uint32](t, types.T_uint32, 28, 5, 3)
 	modInteger[uint64](t, types.T_uint64, 28, 5, 3)
+	modInteger[int8](t, types.T_int8, -28, 5, -3)
+	modInteger[int64](t, types.T_int64, -28, -5, -3)
+	modInteger[int64](t, types.T_int64, math.MinInt64, -1, 0)
+	modInteger[uint64](t, types.T_uint64, math.MaxUint64, math.MaxUint64-1, 1)
 
-	modFloater[float32](t, types.T_float32, 24.45, 12.4, 0)
-	modFloater[float64](t, types.T_float64, 24.45, 12.4, 0)
+	modFloater[float32](t, types.T_float32, 24.5, 10, 4.5)
+	modFloater[float64](t, types.T_float64, 24.5, 10, 4.5)
+	modFloater[float64](t, types.T_float64, -24.5, 10, -4.5)
 }
 
 // Integer unit test entry for mod operator
@@ -162,3 +169,116 @@ func makeModVectors[T constraints.Integer | constraints.Float](left T, leftScala
 	}
 	return vectors
 }
+
+func TestModScalarByNullableVector(t *testing.T) {
+	vec, err := ModInt[int64]([]*vector.Vector{testutil.MakeScalarInt64(-28, 3), testutil.MakeInt64Vector([]int64{5, 0, -3}, []uint64{1})}, makeProcess())
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	rs := vec.Col.([]int64)
+	require.Equal(t, int64(-3), rs[0])
+	require.Equal(t, int64(-1), rs[2])
+}
+
+// A zero divisor of MOD makes its row NULL with a warning, unless the sql_mode
+// of the session has ERROR_FOR_DIVISION_BY_ZERO.
+func TestModByZero(t *testing.T) {
+	cases := []struct {
+		name     string
+		fn       func([]*vector.Vector, *process.Process) (*vector.Vector, error)
+		vecs     func() []*vector.Vector
+		want     interface{}
+		nsp      []uint64
+		warnings uint16
+	}{
+		{
+			name: "int32",
+			fn:   ModInt[int32],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeInt32Vector([]int32{-7, 7, 7, 7}, []uint64{3}),
+					testutil.MakeInt32Vector([]int32{2, 0, 0, 0}, []uint64{2}),
+				}
+			},
+			want:     []int32{-1},
+			nsp:      []uint64{1, 2, 3},
+			warnings: 1,
+		},
+		{
+			name: "scalar uint8 by vector",
+			fn:   ModInt[uint8],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeScalarUint8(7, 3),
+					testutil.MakeUint8Vector([]uint8{0, 4, 0}, nil),
+				}
+			},
+			want:     []uint8{3},
+			nsp:      []uint64{0, 2},
+			warnings: 2,
+		},
+		{
+			name: "float64",
+			fn:   ModFloat[float64],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeFloat64Vector([]float64{7.5, 7.5}, nil),
+					testutil.MakeFloat64Vector([]float64{2, 0}, nil),
+				}
+			},
+			want:     []float64{1.5},
+			nsp:      []uint64{1},
+			warnings: 1,
+		},
+		{
+			name: "float32 by zero",
+			fn:   ModFloat[float32],
+			vecs: func() []*vector.Vector {
+				return []*vector.Vector{
+					testutil.MakeFloat32Vector([]float32{1, 2, 3}, []uint64{0}),
+					testutil.MakeScalarFloat32(0, 3),
+				}
+			},
+			warnings: 2,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			proc := makeProcess()
+			vec, err := c.fn(c.vecs(), proc)
+			require.NoError(t, err)
+			require.Equal(t, c.warnings, proc.Warnings())
+			if c.want == nil {
+				require.True(t, vec.IsScalarNull())
+				return
+			}
+			require.Equal(t, c.nsp, vec.Nsp.Np.ToArray())
+			var rows []interface{}
+			switch col := vec.Col.(type) {
+			case []int32:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			case []uint8:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			case []float64:
+				for i, v := range col {
+					if !nulls.Contains(vec.Nsp, uint64(i)) {
+						rows = append(rows, v)
+					}
+				}
+			}
+			require.ElementsMatch(t, c.want, rows)
+
+			proc = makeProcess()
+			proc.SessionInfo.ErrorForDivisionByZero = true
+			_, err = c.fn(c.vecs(), proc)
+			require.Equal(t, ErrModByZero, err)
+		})
+	}
+}
@@ -46,7 +46,7 @@ var NeOpFuncMap = map[int]NeOpFunc{}
 var NeOpFuncVec = []NeOpFunc{
 	nequal[int8], nequal[int16], nequal[int32], nequal[int64], nequal[uint8], nequal[uint16], nequal[uint32],
 	nequal[uint64], nequal[float32], nequal[float64], nequal[string], nequal_B, nequal[types.Date],
-	nequal[types.Datetime], nequal[types.Decimal64], nequal_D,
+	nequal[types.Datetime], nequal[types.Decimal64], nequal_D, nequal[types.Time],
 }
 
 func InitNeOpFuncMap() {
@@ -234,39 +234,39 @@ var NeFuncMap = map[int]NeFunc{}
 var NeFuncVec = []NeFunc{
 	ColNeCol[int8], ColNeCol[int16], ColNeCol[int32], ColNeCol[int64], ColNeCol[uint8], ColNeCol[uint16],
 	ColNeCol[uint32], ColNeCol[uint64], ColNeCol[float32], ColNeCol[float64], ColNeCol[string], ColNeCol[bool],
-	ColNeCol[types.Date], ColNeCol[types.Datetime], ColNeCol[types.Decimal64], ColNeCol[types.Decimal128],
+	ColNeCol[types.Date], ColNeCol[types.Datetime], ColNeCol[types.Decimal64], ColNeCol[types.Decimal128], ColNeCol[types.Time],
 
 	ColNeConst[int8], ColNeConst[int16], ColNeConst[int32], ColNeConst[int64], ColNeConst[uint8], ColNeConst[uint16],
 	ColNeConst[uint32], ColNeConst[uint64], ColNeConst[float32], ColNeConst[float64], ColNeConst[string], ColNeConst[bool],
-	ColNeConst[types.Date], ColNeConst[types.Datetime], ColNeConst[types.Decimal64], ColNeConst[types.Decimal128],
+	ColNeConst[types.Date], ColNeConst[types.Datetime], ColNeConst[types.Decimal64], ColNeConst[types.Decimal128], ColNeConst[types.Time],
 
 	ColNeNull[int8], ColNeNull[int16], ColNeNull[int32], ColNeNull[int64], ColNeNull[uint8], ColNeNull[uint16],
 	ColNeNull[uint32], ColNeNull[uint64], ColNeNull[float32], ColNeNull[float64], ColNeNull[string], ColNeNull[bool],
-	ColNeNull[types.Date], ColNeNull[types.Datetime], ColNeNull[types.Decimal64], ColNeNull[types.Decimal128],
+	ColNeNull[types.Date], ColNeNull[types.Datetime], ColNeNull[types.Decimal64], ColNeNull[types.Decimal128], ColNeNull[types.Time],
 
 	ConstNeCol[int8], ConstNeCol[int16], ConstNeCol[int32], ConstNeCol[int64], ConstNeCol[uint8], ConstNeCol[uint16],
 	ConstNeCol[uint32], ConstNeCol[uint64], ConstNeCol[float32], ConstNeCol[float64], ConstNeCol[string], ConstNeCol[bool],
-	ConstNeCol[types.Date], ConstNeCol[types.Datetime], ConstNeCol[types.Decimal64], ConstNeCol[types.Decimal128],
+	ConstNeCol[types.Date], ConstNeCol[types.Datetime], ConstNeCol[types.Decimal64], ConstNeCol[types.Decimal128], ConstNeCol[types.Time],
 
 	ConstNeConst[int8], ConstNeConst[int16], ConstNeConst[int32], ConstNeConst[int64], ConstNeConst[uint8], ConstNeConst[uint16],
 	ConstNeConst[uint32], ConstNeConst[uint64], ConstNeConst[float32], ConstNeConst[float64], ConstNeConst[string], ConstNeConst[bool],
-	ConstNeConst[types.Date], ConstNeConst[types.Datetime], ConstNeConst[types.Decimal64], ConstNeConst[types.Decimal128],
+	ConstNeConst[types.Date], ConstNeConst[types.Datetime], ConstNeConst[types.Decimal64], ConstNeConst[types.Decimal128], ConstNeConst[types.Time],
 
 	ConstNeNull[int8], ConstNeNull[int16], ConstNeNull[int32], ConstNeNull[int64], ConstNeNull[uint8], ConstNeNull[uint16],
 	ConstNeNull[uint32], ConstNeNull[uint64], ConstNeNull[float32], ConstNeNull[float64], ConstNeNull[string], ConstNeNull[bool],
-	ConstNeNull[types.Date], ConstNeNull[types.Datetime], ConstNeNull[types.Decimal64], ConstNeNull[types.Decimal128],
+	ConstNeNull[types.Date], ConstNeNull[types.Datetime], ConstNeNull[types.Decimal64], ConstNeNull[types.Decimal128], ConstNeNull[types.Time],
 
 	NullNeCol[int8], NullNeCol[int16], NullNeCol[int32], NullNeCol[int64], NullNeCol[uint8], NullNeCol[uint16],
 	NullNeCol[uint32], NullNeCol[uint64], NullNeCol[float32], NullNeCol[float64], NullNeCol[string], NullNeCol[bool],
-	NullNeCol[types.Date], NullNeCol[types.Datetime], NullNeCol[types.Decimal64], NullNeCol[types.Decimal128],
+	NullNeCol[types.Date], NullNeCol[types.Datetime], NullNeCol[types.Decimal64], NullNeCol[types.Decimal128], NullNeCol[types.Time],
 
 	NullNeConst[int8], NullNeConst[int16], NullNeConst[int32], NullNeConst[int64], NullNeConst[uint8], NullNeConst[uint16],
 	NullNeConst[uint32], NullNeConst[uint64], NullNeConst[float32], NullNeConst[float64], NullNeConst[string], NullNeConst[bool],
-	NullNeConst[types.Date], NullNeConst[types.Datetime], NullNeConst[types.Decimal64], NullNeConst[types.Decimal128],
+	NullNeConst[types.Date], NullNeConst[types.Datetime], NullNeConst[types.Decimal64], NullNeConst[types.Decimal128], NullNeConst[types.Time],
 
 	NullNeNull[int8], NullNeNull[int16], NullNeNull[int32], NullNeNull[int64], NullNeNull[uint8], NullNeNull[uint16],
 	NullNeNull[uint32], NullNeNull[uint64], NullNeNull[float32], NullNeNull[float64], NullNeNull[string], NullNeNull[bool],
-	NullNeNull[types.Date], NullNeNull[types.Datetime], NullNeNull[types.Decimal64], NullNeNull[types.Decimal128],
+	NullNeNull[types.Date], NullNeNull[types.Datetime], NullNeNull[types.Decimal64], NullNeNull[types.Decimal128], NullNeNull[types.Time],
 }
 
 func InitNeFuncMap() {
@@ -286,5 +286,8 @@ func NeDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (
 	if err != nil {
 		return nil, errors.New("Ne function: " + err.Error())
 	}
+	if vec.IsScalar() {
+		vec.Length = vector.Length(lv)
+	}
 	return vec, nil
 }
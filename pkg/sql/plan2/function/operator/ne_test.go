@@ -1,71 +1,48 @@
 package operator
 
 import (
-	"errors"
-	"log"
 	"testing"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
-	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/smartystreets/goconvey/convey"
 )
 
-var NeintBool = []bool{false, true, true, false, true, false, true, false, true, true, false, false, false, false, false, false}
-var NeintNullPos = []int{3, 7, 11, 12, 13, 14, 15}
-var NeintNotNullPos = []int{0, 1, 2, 4, 5, 6, 8, 9, 10}
-
-var NeuintBool = []bool{false, true, false, true, false, false, false, false, false}
-var NeuintNullPos = []int{2, 5, 6, 7, 8}
-var NeuintNotNullPos = []int{0, 1, 3, 4}
-
-var NeboolBool = []bool{false, true, false, true, false, false, false, false, false}
-
-var NestringBool = []bool{false, true, true, false, true, false, true, false, true, true, false, false, false, false, false, false}
-
-type testNeFunc = func(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error)
-
-var testNeFuncVec = []testNeFunc{
-	NeDataValue[int8], NeDataValue[int16], NeDataValue[int32], NeDataValue[int64],
-	NeDataValue[uint8], NeDataValue[uint16], NeDataValue[uint32], NeDataValue[uint64],
-	NeDataValue[float32], NeDataValue[float64], NeDataValue[types.Date],
-	NeDataValue[types.Datetime], NeDataValue[types.Decimal64], NeDataValue[bool], NeDataValue[string],
-}
-
-var NeretVec = [][]bool{
-	NeintBool, NeintBool, NeintBool, NeintBool, NeuintBool, NeuintBool, NeuintBool, NeuintBool,
-	NeintBool, NeintBool, NeintBool, NeintBool, NeintBool, NeboolBool, NestringBool,
-}
-
-func Test_ColNeCol(t *testing.T) {
-	convey.Convey("Test col eq col operator succ", t, func() {
-		InitFuncMap()
-		proc := process.New(mheap.New(&guest.Mmu{Mmu: host.New(1000), Limit: 1000}))
-
-		for i := 0; i < 15; i++ {
-			vec := testFuncVec[i]()
-			ret, err := testNeFuncVec[i](vec, proc)
-			if err != nil {
-				log.Fatal(err)
-			}
-			data, ok := ret.Col.([]bool)
-			if !ok {
-				log.Fatal(errors.New("the return vec type is not right"))
-			}
-			for j := 0; j < len(data); j++ {
-				convey.So(data[j], convey.ShouldEqual, NeretVec[i][j])
-			}
-			for j := 0; j < len(retNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNullPosVec[i][j])), convey.ShouldEqual, true)
-			}
-			for j := 0; j < len(retNotNullPosVec[i]); j++ {
-				convey.So(nulls.Contains(ret.Nsp, uint64(retNotNullPosVec[i][j])), convey.ShouldEqual, false)
-			}
-		}
-
-	})
+var neFuncs = compareFuncs(func(typ types.T) compareFunc {
+	switch typ {
+	case types.T_int8:
+		return NeDataValue[int8]
+	case types.T_int16:
+		return NeDataValue[int16]
+	case types.T_int32:
+		return NeDataValue[int32]
+	case types.T_int64:
+		return NeDataValue[int64]
+	case types.T_uint8:
+		return NeDataValue[uint8]
+	case types.T_uint16:
+		return NeDataValue[uint16]
+	case types.T_uint32:
+		return NeDataValue[uint32]
+	case types.T_uint64:
+		return NeDataValue[uint64]
+	case types.T_float32:
+		return NeDataValue[float32]
+	case types.T_float64:
+		return NeDataValue[float64]
+	case types.T_char, types.T_varchar:
+		return NeDataValue[string]
+	case types.T_bool:
+		return NeDataValue[bool]
+	case types.T_date:
+		return NeDataValue[types.Date]
+	case types.T_datetime:
+		return NeDataValue[types.Datetime]
+	case types.T_decimal64:
+		return NeDataValue[types.Decimal64]
+	default:
+		return NeDataValue[types.Decimal128]
+	}
+})
+
+func TestNe(t *testing.T) {
+	runCompare(t, compareCases("<>", neFuncs, func(c int) bool { return c != 0 }))
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -28,16 +29,13 @@ func NotCol(lv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		return nil, errors.New("the left vec col is not []bool type")
 	}
 	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
+	vec, err := proc.AllocVector(proc.GetBoolTyp(lv.Typ), int64(n)*1)
 	if err != nil {
 		return nil, err
 	}
-	col := make([]bool, len(lvs))
-	for i := 0; i < len(lvs); i++ {
-		col[i] = !lvs[i]
-		if nulls.Contains(lv.Nsp, uint64(i)) {
-			col[i] = false
-		}
+	col := encoding.DecodeBoolSlice(vec.Data)[:n]
+	for i := range col {
+		col[i], _ = not3(lvs[i], nulls.Contains(lv.Nsp, uint64(i)))
 	}
 	nulls.Or(lv.Nsp, nil, vec.Nsp)
 	vector.SetCol(vec, col)
@@ -45,12 +43,12 @@ func NotCol(lv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 }
 
 func NotConst(lv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
+	v, _, err := scalarBool(lv)
+	if err != nil {
 		return nil, errors.New("the left vec col is not []bool type")
 	}
-	vec := proc.AllocScalarVector(lv.Typ)
-	vector.SetCol(vec, []bool{!lvs[0]})
+	vec := proc.AllocScalarVector(proc.GetBoolTyp(lv.Typ))
+	vector.SetCol(vec, []bool{!v})
 	return vec, nil
 }
 
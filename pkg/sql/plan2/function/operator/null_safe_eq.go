@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NullSafeEqDataValue implements the null-safe equal operator <=>. Two nulls are
+// equal, a null is never equal to a non-null value, and the result has no nulls.
+func NullSafeEqDataValue[T DataValue](vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lv := vectors[0]
+	rv := vectors[1]
+	lt, rt := GetTypeID(lv), GetTypeID(rv)
+	switch {
+	case lt == 2 && rt == 2:
+		return nullSafeEqScalar(lv, true, proc), nil
+	case lt == 2 && rt == 1, lt == 1 && rt == 2:
+		return nullSafeEqScalar(lv, false, proc), nil
+	case lt == 2:
+		return colNullSafeEqNull(rv, proc)
+	case rt == 2:
+		return colNullSafeEqNull(lv, proc)
+	}
+	vec, err := EqDataValue[T](vectors, proc)
+	if err != nil {
+		return nil, errors.New("Null safe equal function:" + err.Error())
+	}
+	if lt == 0 && rt == 0 {
+		// rows null on both sides are equal, the rows null on one side only
+		// were already set to false by the equal function
+		both := new(nulls.Nulls)
+		nulls.And(lv.Nsp, rv.Nsp, both)
+		if nulls.Any(both) {
+			col := vec.Col.([]bool)
+			it := both.Np.Iterator()
+			for it.HasNext() {
+				col[it.Next()] = true
+			}
+		}
+	}
+	vec.Nsp = new(nulls.Nulls)
+	return vec, nil
+}
+
+func nullSafeEqScalar(v *vector.Vector, r bool, proc *process.Process) *vector.Vector {
+	vec := proc.AllocScalarVector(proc.GetBoolTyp(v.Typ))
+	vector.SetCol(vec, []bool{r})
+	return vec
+}
+
+// colNullSafeEqNull compares a column with the constant null, the result
+// is true exactly at the null rows of the column
+func colNullSafeEqNull(v *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	n := vector.Length(v)
+	vec, err := proc.AllocVector(proc.GetBoolTyp(v.Typ), int64(n)*1)
+	if err != nil {
+		return nil, err
+	}
+	col := make([]bool, n)
+	if nulls.Any(v.Nsp) {
+		it := v.Nsp.Np.Iterator()
+		for it.HasNext() {
+			col[it.Next()] = true
+		}
+	}
+	vector.SetCol(vec, col)
+	return vec, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+const nullSafeEqRows = 1000
+
+// makeNullSafeEqVector returns a column whose row i holds i%mod and is null if isNull(i)
+func makeNullSafeEqVector(typ types.T, mod int, isNull func(int) bool) *vector.Vector {
+	vec := vector.New(types.Type{Oid: typ, Size: 24})
+	switch typ {
+	case types.T_int64:
+		vs := make([]int64, nullSafeEqRows)
+		for i := range vs {
+			vs[i] = int64(i % mod)
+		}
+		vector.SetCol(vec, vs)
+	case types.T_varchar:
+		vs := &types.Bytes{}
+		for i := 0; i < nullSafeEqRows; i++ {
+			s := strconv.Itoa(i % mod)
+			vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+			vs.Lengths = append(vs.Lengths, uint32(len(s)))
+			vs.Data = append(vs.Data, s...)
+		}
+		vector.SetCol(vec, vs)
+	}
+	for i := 0; i < nullSafeEqRows; i++ {
+		if isNull(i) {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	return vec
+}
+
+func TestNullSafeEqColCol(t *testing.T) {
+	InitFuncMap()
+	proc := makeProcess()
+	densities := []struct {
+		name         string
+		lnull, rnull func(int) bool
+	}{
+		{"no nulls", func(int) bool { return false }, func(int) bool { return false }},
+		{"heavy nulls", func(i int) bool { return i%3 != 0 }, func(i int) bool { return i%2 == 0 }},
+		{"all nulls", func(int) bool { return true }, func(int) bool { return true }},
+	}
+	for _, typ := range []types.T{types.T_int64, types.T_varchar} {
+		for _, d := range densities {
+			lv := makeNullSafeEqVector(typ, 5, d.lnull)
+			rv := makeNullSafeEqVector(typ, 7, d.rnull)
+			var ret *vector.Vector
+			var err error
+			if typ == types.T_int64 {
+				ret, err = NullSafeEqDataValue[int64]([]*vector.Vector{lv, rv}, proc)
+			} else {
+				ret, err = NullSafeEqDataValue[string]([]*vector.Vector{lv, rv}, proc)
+			}
+			require.NoError(t, err)
+			require.False(t, nulls.Any(ret.Nsp), d.name)
+			col := ret.Col.([]bool)
+			require.Equal(t, nullSafeEqRows, len(col))
+			for i, v := range col {
+				var expect bool
+				switch ln, rn := d.lnull(i), d.rnull(i); {
+				case ln && rn:
+					expect = true
+				case ln || rn:
+					expect = false
+				default:
+					expect = i%5 == i%7
+				}
+				require.Equal(t, expect, v, "%s %s row %d", typ, d.name, i)
+			}
+			// the arguments are left untouched
+			require.Equal(t, d.lnull(1), nulls.Contains(lv.Nsp, 1))
+			require.Equal(t, d.rnull(0), nulls.Contains(rv.Nsp, 0))
+		}
+	}
+}
+
+func TestNullSafeEqScalar(t *testing.T) {
+	InitFuncMap()
+	proc := makeProcess()
+	isNull := func(i int) bool { return i%4 == 0 }
+	col := makeNullSafeEqVector(types.T_int64, 5, isNull)
+
+	// col <=> null is true exactly at the null rows
+	ret, err := NullSafeEqDataValue[int64]([]*vector.Vector{col, makeScalarNullVector(types.T_int64)}, proc)
+	require.NoError(t, err)
+	require.False(t, nulls.Any(ret.Nsp))
+	for i, v := range ret.Col.([]bool) {
+		require.Equal(t, isNull(i), v)
+	}
+	ret, err = NullSafeEqDataValue[int64]([]*vector.Vector{makeScalarNullVector(types.T_int64), col}, proc)
+	require.NoError(t, err)
+	require.Equal(t, nullSafeEqRows, len(ret.Col.([]bool)))
+
+	// col <=> 3 is never true at the null rows
+	ret, err = NullSafeEqDataValue[int64]([]*vector.Vector{col, makeVector(int64(3), true)}, proc)
+	require.NoError(t, err)
+	require.False(t, nulls.Any(ret.Nsp))
+	for i, v := range ret.Col.([]bool) {
+		require.Equal(t, !isNull(i) && i%5 == 3, v)
+	}
+	ret, err = NullSafeEqDataValue[int64]([]*vector.Vector{makeVector(int64(3), true), col}, proc)
+	require.NoError(t, err)
+	require.False(t, nulls.Any(ret.Nsp))
+	require.True(t, ret.Col.([]bool)[3])
+	require.False(t, ret.Col.([]bool)[8])
+
+	cases := []struct {
+		lv, rv *vector.Vector
+		expect bool
+	}{
+		{makeScalarNullVector(types.T_int64), makeScalarNullVector(types.T_int64), true},
+		{makeScalarNullVector(types.T_int64), makeVector(int64(1), true), false},
+		{makeVector(int64(1), true), makeScalarNullVector(types.T_int64), false},
+		{makeVector(int64(1), true), makeVector(int64(1), true), true},
+		{makeVector(int64(1), true), makeVector(int64(2), true), false},
+	}
+	for _, c := range cases {
+		ret, err := NullSafeEqDataValue[int64]([]*vector.Vector{c.lv, c.rv}, proc)
+		require.NoError(t, err)
+		require.True(t, ret.IsScalar())
+		require.False(t, nulls.Any(ret.Nsp))
+		require.Equal(t, []bool{c.expect}, ret.Col.([]bool))
+	}
+
+	ret, err = NullSafeEqDataValue[string]([]*vector.Vector{makeStringVector("a", types.T_varchar, true), makeScalarNullVector(types.T_varchar)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, ret.Col.([]bool))
+}
@@ -17,108 +17,44 @@ package operator
 import (
 	"errors"
 
-	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 func ColXorCol(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	rvs, ok := rv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the right vec col is not []bool type")
-	}
-	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
-	if err != nil {
-		return nil, err
-	}
-	col := make([]bool, len(lvs))
-	for i := 0; i < len(lvs); i++ {
-		col[i] = (lvs[i] || rvs[i]) && !(lvs[i] && rvs[i])
-	}
-	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	vector.SetCol(vec, col)
-	return vec, nil
+	return logicColCol(lv, rv, proc, xor3)
 }
 
 func ColXorConst(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	rvs, ok := rv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the right vec col is not []bool type")
-	}
-	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
-	if err != nil {
-		return nil, err
-	}
-	rb := rvs[0]
-	col := make([]bool, len(lvs))
-	for i := 0; i < len(lvs); i++ {
-		col[i] = (lvs[i] || rb) && !(lvs[i] && rb)
-	}
-	nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
-	vector.SetCol(vec, col)
-	return vec, nil
+	return logicColScalar(lv, rv, proc, xor3)
 }
 
 func ColXorNull(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	n := len(lvs)
-	vec, err := proc.AllocVector(lv.Typ, int64(n)*1)
-	if err != nil {
-		return nil, err
-	}
-	col := make([]bool, len(lvs))
-	for i := 0; i < len(lvs); i++ {
-		nulls.Add(vec.Nsp, uint64(i))
-	}
-	vector.SetCol(vec, col)
-	return vec, nil
+	return logicColScalar(lv, rv, proc, xor3)
 }
 
 func ConstXorCol(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return ColXorConst(rv, lv, proc)
+	return logicColScalar(rv, lv, proc, xor3)
 }
 
 func ConstXorConst(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvs, ok := lv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the left vec col is not []bool type")
-	}
-	rvs, ok := rv.Col.([]bool)
-	if !ok {
-		return nil, errors.New("the right vec col is not []bool type")
-	}
-	vec := proc.AllocScalarVector(lv.Typ)
-	vector.SetCol(vec, []bool{(lvs[0] || rvs[0]) && !(lvs[0] && rvs[0])})
-	return vec, nil
+	return logicScalarScalar(lv, rv, proc, xor3)
 }
 
 func ConstXorNull(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return proc.AllocScalarNullVector(lv.Typ), nil
+	return logicScalarScalar(lv, rv, proc, xor3)
 }
 
 func NullXorCol(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return ColXorNull(rv, lv, proc)
+	return logicColScalar(rv, lv, proc, xor3)
 }
 
 func NullXorConst(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return ConstXorNull(rv, lv, proc)
+	return logicScalarScalar(lv, rv, proc, xor3)
 }
 
 func NullXorNull(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	return proc.AllocScalarNullVector(lv.Typ), nil
+	return logicScalarScalar(lv, rv, proc, xor3)
 }
 
 type XorFunc = func(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error)
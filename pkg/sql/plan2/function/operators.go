@@ -244,11 +244,46 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.EqDataValue[bool],
 		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      COMPARISON_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.EqGeneralCI,
+		},
+		{
+			Index:  18,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_time,
+				types.T_time,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.EqDataValue[types.Time],
+		},
+		{
+			Index:  19,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.EqDataValue[uint64],
+		},
 	},
-	GREAT_THAN: {
+
+	NULL_SAFE_EQUAL: {
 		{
 			Index:  0,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_uint8,
@@ -256,11 +291,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[uint8],
+			Fn:          operator.NullSafeEqDataValue[uint8],
 		},
 		{
 			Index:  1,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_uint16,
@@ -268,11 +303,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[uint16],
+			Fn:          operator.NullSafeEqDataValue[uint16],
 		},
 		{
 			Index:  2,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_uint32,
@@ -280,11 +315,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[uint32],
+			Fn:          operator.NullSafeEqDataValue[uint32],
 		},
 		{
 			Index:  3,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_uint64,
@@ -292,11 +327,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[uint64],
+			Fn:          operator.NullSafeEqDataValue[uint64],
 		},
 		{
 			Index:  4,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_int8,
@@ -304,11 +339,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[int8],
+			Fn:          operator.NullSafeEqDataValue[int8],
 		},
 		{
 			Index:  5,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_int16,
@@ -316,11 +351,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[int16],
+			Fn:          operator.NullSafeEqDataValue[int16],
 		},
 		{
 			Index:  6,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_int32,
@@ -328,11 +363,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[int32],
+			Fn:          operator.NullSafeEqDataValue[int32],
 		},
 		{
 			Index:  7,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_int64,
@@ -340,11 +375,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[int64],
+			Fn:          operator.NullSafeEqDataValue[int64],
 		},
 		{
 			Index:  8,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_float32,
@@ -352,11 +387,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[float32],
+			Fn:          operator.NullSafeEqDataValue[float32],
 		},
 		{
 			Index:  9,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_float64,
@@ -364,11 +399,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[float64],
+			Fn:          operator.NullSafeEqDataValue[float64],
 		},
 		{
 			Index:  10,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_decimal64,
@@ -376,11 +411,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[types.Decimal64],
+			Fn:          operator.NullSafeEqDataValue[types.Decimal64],
 		},
 		{
 			Index:  11,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_decimal128,
@@ -388,11 +423,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[types.Decimal128],
+			Fn:          operator.NullSafeEqDataValue[types.Decimal128],
 		},
 		{
 			Index:  12,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_varchar,
@@ -400,11 +435,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[string],
+			Fn:          operator.NullSafeEqDataValue[string],
 		},
 		{
 			Index:  13,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_char,
@@ -412,11 +447,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[string],
+			Fn:          operator.NullSafeEqDataValue[string],
 		},
 		{
 			Index:  14,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_date,
@@ -424,11 +459,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[types.Date],
+			Fn:          operator.NullSafeEqDataValue[types.Date],
 		},
 		{
 			Index:  15,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_datetime,
@@ -436,11 +471,11 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[types.Datetime],
+			Fn:          operator.NullSafeEqDataValue[types.Datetime],
 		},
 		{
 			Index:  16,
-			Flag:   plan.Function_STRICT,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
 			Layout: COMPARISON_OPERATOR,
 			Args: []types.T{
 				types.T_bool,
@@ -448,10 +483,22 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GtDataValue[bool],
+			Fn:          operator.NullSafeEqDataValue[bool],
+		},
+		{
+			Index:  17,
+			Flag:   plan.Function_PRODUCE_NO_NULL,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NullSafeEqDataValue[uint64],
 		},
 	},
-	GREAT_EQUAL: {
+	GREAT_THAN: {
 		{
 			Index:  0,
 			Flag:   plan.Function_STRICT,
@@ -462,7 +509,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[uint8],
+			Fn:          operator.GtDataValue[uint8],
 		},
 		{
 			Index:  1,
@@ -474,7 +521,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[uint16],
+			Fn:          operator.GtDataValue[uint16],
 		},
 		{
 			Index:  2,
@@ -486,7 +533,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[uint32],
+			Fn:          operator.GtDataValue[uint32],
 		},
 		{
 			Index:  3,
@@ -498,7 +545,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[uint64],
+			Fn:          operator.GtDataValue[uint64],
 		},
 		{
 			Index:  4,
@@ -510,7 +557,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[int8],
+			Fn:          operator.GtDataValue[int8],
 		},
 		{
 			Index:  5,
@@ -522,7 +569,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[int16],
+			Fn:          operator.GtDataValue[int16],
 		},
 		{
 			Index:  6,
@@ -534,7 +581,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[int32],
+			Fn:          operator.GtDataValue[int32],
 		},
 		{
 			Index:  7,
@@ -546,7 +593,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[int64],
+			Fn:          operator.GtDataValue[int64],
 		},
 		{
 			Index:  8,
@@ -558,7 +605,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[float32],
+			Fn:          operator.GtDataValue[float32],
 		},
 		{
 			Index:  9,
@@ -570,7 +617,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[float64],
+			Fn:          operator.GtDataValue[float64],
 		},
 		{
 			Index:  10,
@@ -582,7 +629,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[types.Decimal64],
+			Fn:          operator.GtDataValue[types.Decimal64],
 		},
 		{
 			Index:  11,
@@ -594,7 +641,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[types.Decimal128],
+			Fn:          operator.GtDataValue[types.Decimal128],
 		},
 		{
 			Index:  12,
@@ -606,7 +653,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[string],
+			Fn:          operator.GtDataValue[string],
 		},
 		{
 			Index:  13,
@@ -618,7 +665,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[string],
+			Fn:          operator.GtDataValue[string],
 		},
 		{
 			Index:  14,
@@ -630,7 +677,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[types.Date],
+			Fn:          operator.GtDataValue[types.Date],
 		},
 		{
 			Index:  15,
@@ -642,7 +689,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[types.Datetime],
+			Fn:          operator.GtDataValue[types.Datetime],
 		},
 		{
 			Index:  16,
@@ -654,10 +701,44 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.GeDataValue[bool],
+			Fn:          operator.GtDataValue[bool],
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      COMPARISON_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.GtGeneralCI,
+		},
+		{
+			Index:  18,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_time,
+				types.T_time,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.GtDataValue[types.Time],
+		},
+		{
+			Index:  19,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.GtDataValue[uint64],
 		},
 	},
-	LESS_THAN: {
+	GREAT_EQUAL: {
 		{
 			Index:  0,
 			Flag:   plan.Function_STRICT,
@@ -668,7 +749,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[uint8],
+			Fn:          operator.GeDataValue[uint8],
 		},
 		{
 			Index:  1,
@@ -680,7 +761,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[uint16],
+			Fn:          operator.GeDataValue[uint16],
 		},
 		{
 			Index:  2,
@@ -692,7 +773,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[uint32],
+			Fn:          operator.GeDataValue[uint32],
 		},
 		{
 			Index:  3,
@@ -704,7 +785,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[uint64],
+			Fn:          operator.GeDataValue[uint64],
 		},
 		{
 			Index:  4,
@@ -716,7 +797,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[int8],
+			Fn:          operator.GeDataValue[int8],
 		},
 		{
 			Index:  5,
@@ -728,7 +809,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[int16],
+			Fn:          operator.GeDataValue[int16],
 		},
 		{
 			Index:  6,
@@ -740,7 +821,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[int32],
+			Fn:          operator.GeDataValue[int32],
 		},
 		{
 			Index:  7,
@@ -752,7 +833,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[int64],
+			Fn:          operator.GeDataValue[int64],
 		},
 		{
 			Index:  8,
@@ -764,7 +845,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[float32],
+			Fn:          operator.GeDataValue[float32],
 		},
 		{
 			Index:  9,
@@ -776,7 +857,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[float64],
+			Fn:          operator.GeDataValue[float64],
 		},
 		{
 			Index:  10,
@@ -788,7 +869,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[types.Decimal64],
+			Fn:          operator.GeDataValue[types.Decimal64],
 		},
 		{
 			Index:  11,
@@ -800,7 +881,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[types.Decimal128],
+			Fn:          operator.GeDataValue[types.Decimal128],
 		},
 		{
 			Index:  12,
@@ -812,7 +893,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[string],
+			Fn:          operator.GeDataValue[string],
 		},
 		{
 			Index:  13,
@@ -824,7 +905,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[string],
+			Fn:          operator.GeDataValue[string],
 		},
 		{
 			Index:  14,
@@ -836,7 +917,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[types.Date],
+			Fn:          operator.GeDataValue[types.Date],
 		},
 		{
 			Index:  15,
@@ -848,7 +929,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[types.Datetime],
+			Fn:          operator.GeDataValue[types.Datetime],
 		},
 		{
 			Index:  16,
@@ -860,10 +941,44 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LtDataValue[bool],
+			Fn:          operator.GeDataValue[bool],
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      COMPARISON_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.GeGeneralCI,
+		},
+		{
+			Index:  18,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_time,
+				types.T_time,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.GeDataValue[types.Time],
+		},
+		{
+			Index:  19,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.GeDataValue[uint64],
 		},
 	},
-	LESS_EQUAL: {
+	LESS_THAN: {
 		{
 			Index:  0,
 			Flag:   plan.Function_STRICT,
@@ -874,7 +989,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[uint8],
+			Fn:          operator.LtDataValue[uint8],
 		},
 		{
 			Index:  1,
@@ -886,7 +1001,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[uint16],
+			Fn:          operator.LtDataValue[uint16],
 		},
 		{
 			Index:  2,
@@ -898,7 +1013,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[uint32],
+			Fn:          operator.LtDataValue[uint32],
 		},
 		{
 			Index:  3,
@@ -910,7 +1025,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[uint64],
+			Fn:          operator.LtDataValue[uint64],
 		},
 		{
 			Index:  4,
@@ -922,7 +1037,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[int8],
+			Fn:          operator.LtDataValue[int8],
 		},
 		{
 			Index:  5,
@@ -934,7 +1049,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[int16],
+			Fn:          operator.LtDataValue[int16],
 		},
 		{
 			Index:  6,
@@ -946,7 +1061,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[int32],
+			Fn:          operator.LtDataValue[int32],
 		},
 		{
 			Index:  7,
@@ -958,7 +1073,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[int64],
+			Fn:          operator.LtDataValue[int64],
 		},
 		{
 			Index:  8,
@@ -970,7 +1085,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[float32],
+			Fn:          operator.LtDataValue[float32],
 		},
 		{
 			Index:  9,
@@ -982,7 +1097,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[float64],
+			Fn:          operator.LtDataValue[float64],
 		},
 		{
 			Index:  10,
@@ -994,7 +1109,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[types.Decimal64],
+			Fn:          operator.LtDataValue[types.Decimal64],
 		},
 		{
 			Index:  11,
@@ -1006,7 +1121,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[types.Decimal128],
+			Fn:          operator.LtDataValue[types.Decimal128],
 		},
 		{
 			Index:  12,
@@ -1018,7 +1133,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[string],
+			Fn:          operator.LtDataValue[string],
 		},
 		{
 			Index:  13,
@@ -1030,7 +1145,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[string],
+			Fn:          operator.LtDataValue[string],
 		},
 		{
 			Index:  14,
@@ -1042,7 +1157,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[types.Date],
+			Fn:          operator.LtDataValue[types.Date],
 		},
 		{
 			Index:  15,
@@ -1054,7 +1169,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[types.Datetime],
+			Fn:          operator.LtDataValue[types.Datetime],
 		},
 		{
 			Index:  16,
@@ -1066,10 +1181,44 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.LeDataValue[bool],
+			Fn:          operator.LtDataValue[bool],
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      COMPARISON_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.LtGeneralCI,
+		},
+		{
+			Index:  18,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_time,
+				types.T_time,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LtDataValue[types.Time],
+		},
+		{
+			Index:  19,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LtDataValue[uint64],
 		},
 	},
-	NOT_EQUAL: {
+	LESS_EQUAL: {
 		{
 			Index:  0,
 			Flag:   plan.Function_STRICT,
@@ -1080,7 +1229,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[uint8],
+			Fn:          operator.LeDataValue[uint8],
 		},
 		{
 			Index:  1,
@@ -1092,7 +1241,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[uint16],
+			Fn:          operator.LeDataValue[uint16],
 		},
 		{
 			Index:  2,
@@ -1104,7 +1253,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[uint16],
+			Fn:          operator.LeDataValue[uint32],
 		},
 		{
 			Index:  3,
@@ -1116,7 +1265,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[uint64],
+			Fn:          operator.LeDataValue[uint64],
 		},
 		{
 			Index:  4,
@@ -1128,7 +1277,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[int8],
+			Fn:          operator.LeDataValue[int8],
 		},
 		{
 			Index:  5,
@@ -1140,7 +1289,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[int16],
+			Fn:          operator.LeDataValue[int16],
 		},
 		{
 			Index:  6,
@@ -1152,7 +1301,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[int32],
+			Fn:          operator.LeDataValue[int32],
 		},
 		{
 			Index:  7,
@@ -1164,7 +1313,247 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.NeDataValue[int64],
+			Fn:          operator.LeDataValue[int64],
+		},
+		{
+			Index:  8,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_float32,
+				types.T_float32,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[float32],
+		},
+		{
+			Index:  9,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_float64,
+				types.T_float64,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[float64],
+		},
+		{
+			Index:  10,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_decimal64,
+				types.T_decimal64,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[types.Decimal64],
+		},
+		{
+			Index:  11,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_decimal128,
+				types.T_decimal128,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[types.Decimal128],
+		},
+		{
+			Index:  12,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_varchar,
+				types.T_varchar,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[string],
+		},
+		{
+			Index:  13,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_char,
+				types.T_char,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[string],
+		},
+		{
+			Index:  14,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_date,
+				types.T_date,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[types.Date],
+		},
+		{
+			Index:  15,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_datetime,
+				types.T_datetime,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[types.Datetime],
+		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bool,
+				types.T_bool,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[bool],
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      COMPARISON_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.LeGeneralCI,
+		},
+		{
+			Index:  18,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_time,
+				types.T_time,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[types.Time],
+		},
+		{
+			Index:  19,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.LeDataValue[uint64],
+		},
+	},
+	NOT_EQUAL: {
+		{
+			Index:  0,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uint8,
+				types.T_uint8,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[uint8],
+		},
+		{
+			Index:  1,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uint16,
+				types.T_uint16,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[uint16],
+		},
+		{
+			Index:  2,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uint32,
+				types.T_uint32,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[uint16],
+		},
+		{
+			Index:  3,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uint64,
+				types.T_uint64,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[uint64],
+		},
+		{
+			Index:  4,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_int8,
+				types.T_int8,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[int8],
+		},
+		{
+			Index:  5,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_int16,
+				types.T_int16,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[int16],
+		},
+		{
+			Index:  6,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_int32,
+				types.T_int32,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[int32],
+		},
+		{
+			Index:  7,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_int64,
+				types.T_int64,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[int64],
 		},
 		{
 			Index:  8,
@@ -1274,6 +1663,40 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.NeDataValue[bool],
 		},
+		{
+			Index:       17,
+			Flag:        plan.Function_STRICT,
+			Layout:      COMPARISON_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.NeGeneralCI,
+		},
+		{
+			Index:  18,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_time,
+				types.T_time,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[types.Time],
+		},
+		{
+			Index:  19,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_bit,
+				types.T_bit,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.NeDataValue[uint64],
+		},
 	},
 	LIKE: {
 		{
@@ -1297,6 +1720,16 @@ var operators = map[int][]Function{
 				return true
 			},
 		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_LOGICAL_OPERATOR,
+			Args:        nil,
+			ReturnTyp:   types.T_bool,
+			Collation:   types.CollationGeneralCI,
+			TypeCheckFn: collationTypeCheck,
+			Fn:          operator.LikeGeneralCI,
+		},
 	},
 	BETWEEN: {
 		{
@@ -1526,6 +1959,18 @@ var operators = map[int][]Function{
 			Fn:          operator.Not,
 		},
 	},
+	ISNULL: {
+		{
+			Index:     0,
+			Flag:      plan.Function_PRODUCE_NO_NULL,
+			Layout:    IS_NULL_EXPRESSION,
+			ReturnTyp: types.T_bool,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T, _ types.T) (match bool) {
+				return len(inputTypes) == 1
+			},
+			Fn: operator.IsNull,
+		},
+	},
 	// arithmetic operator
 	PLUS: {
 		{
@@ -1764,6 +2209,24 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:       13,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_datetime, types.T_datetime},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.MinusDatetime[types.Datetime],
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_timestamp, types.T_timestamp},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.MinusDatetime[types.Timestamp],
+		},
 	},
 	MULTI: {
 		{
@@ -1932,6 +2395,78 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.IntegerDiv[float64],
 		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_int8, types.T_int8},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[int8, int64],
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_int16, types.T_int16},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[int16, int64],
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_int32, types.T_int32},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[int32, int64],
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_int64, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[int64, int64],
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_uint8, types.T_uint8},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[uint8, uint64],
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_uint16, types.T_uint16},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[uint16, uint64],
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_uint32, types.T_uint32},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[uint32, uint64],
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_STRICT,
+			Layout:      BINARY_ARITHMETIC_OPERATOR,
+			Args:        []types.T{types.T_uint64, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.IntegerDivInt[uint64, uint64],
+		},
 	},
 	MOD: {
 		{
@@ -3091,598 +3626,1374 @@ var operators = map[int][]Function{
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       97,
+			Index:       97,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int64, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       98,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint8, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       99,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint16, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       100,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint32, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       101,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint64, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       102,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_float32, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       103,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_int8},
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       104,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_int8},
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       105,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_int16},
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       106,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_int16},
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       107,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_int32},
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       108,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_int32},
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       109,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       110,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       111,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_uint8},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       112,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_uint8},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       113,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_uint16},
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       114,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_uint16},
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       115,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_uint32},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       116,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_uint32},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       117,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       118,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       119,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_float32},
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       120,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_float32},
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       121,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       122,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_float64},
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       123,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int8, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       124,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int8, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       125,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int16, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       126,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int16, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       127,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int32, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       128,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int32, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       129,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int64, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       130,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int64, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       131,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint8, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       132,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint8, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       133,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint16, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       134,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint16, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       135,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint32, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       136,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint32, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       137,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint64, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       138,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint64, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       139,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_float32, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       140,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_float32, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       141,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_float64, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       142,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_float64, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       143,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       144,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       145,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       146,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       147,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int8, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       148,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int16, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       149,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int32, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       150,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_int64, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       151,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_date},
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       152,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_datetime},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       153,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_timestamp},
+			ReturnTyp:   types.T_timestamp,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       154,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       155,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_decimal64},
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       156,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal128, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       157,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_timestamp, types.T_datetime},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       158,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_interval},
+			ReturnTyp:   types.T_interval,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       159,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint8, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       160,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint16, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       161,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint32, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       162,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uint64, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       163,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       164,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       165,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       166,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       167,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       168,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_datetime, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       169,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_time, types.T_datetime},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       170,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_date, types.T_datetime},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       171,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_decimal64},
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       172,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_char, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       173,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_decimal64},
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       174,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_decimal128},
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       175,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_int8},
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       176,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_int16},
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       177,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_int32},
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       178,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       179,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_uint8},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       180,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_uint16},
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       181,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_uint32},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       182,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_decimal64, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          operator.Cast,
+		},
+		{
+			Index:       183,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int64, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_decimal64, types.T_float32},
+			ReturnTyp:   types.T_float32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       98,
+			Index:       184,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint8, types.T_float64},
+			Args:        []types.T{types.T_decimal64, types.T_float64},
 			ReturnTyp:   types.T_float64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       99,
+			Index:       185,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint16, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_decimal128, types.T_int8},
+			ReturnTyp:   types.T_int8,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       100,
+			Index:       186,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint32, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_decimal128, types.T_int16},
+			ReturnTyp:   types.T_int16,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       101,
+			Index:       187,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint64, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_decimal128, types.T_int32},
+			ReturnTyp:   types.T_int32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       102,
+			Index:       188,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_float32, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_decimal128, types.T_int64},
+			ReturnTyp:   types.T_int64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       103,
+			Index:       189,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_int8},
-			ReturnTyp:   types.T_int8,
+			Args:        []types.T{types.T_decimal128, types.T_uint8},
+			ReturnTyp:   types.T_uint8,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       104,
+			Index:       190,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_int8},
-			ReturnTyp:   types.T_int8,
+			Args:        []types.T{types.T_decimal128, types.T_uint16},
+			ReturnTyp:   types.T_uint16,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       105,
+			Index:       191,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_int16},
-			ReturnTyp:   types.T_int16,
+			Args:        []types.T{types.T_decimal128, types.T_uint32},
+			ReturnTyp:   types.T_uint32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       106,
+			Index:       192,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_int16},
-			ReturnTyp:   types.T_int16,
+			Args:        []types.T{types.T_decimal128, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       107,
+			Index:       193,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_int32},
-			ReturnTyp:   types.T_int32,
+			Args:        []types.T{types.T_decimal128, types.T_float32},
+			ReturnTyp:   types.T_float32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       108,
+			Index:       194,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_int32},
-			ReturnTyp:   types.T_int32,
+			Args:        []types.T{types.T_decimal128, types.T_float64},
+			ReturnTyp:   types.T_float64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       109,
+			Index:       195,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_int64},
-			ReturnTyp:   types.T_int64,
+			Args:        []types.T{types.T_datetime, types.T_timestamp},
+			ReturnTyp:   types.T_timestamp,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       110,
+			Index:       196,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_int64},
-			ReturnTyp:   types.T_int64,
+			Args:        []types.T{types.T_timestamp, types.T_date},
+			ReturnTyp:   types.T_date,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       111,
+			Index:       197,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_uint8},
-			ReturnTyp:   types.T_uint8,
+			Args:        []types.T{types.T_date, types.T_timestamp},
+			ReturnTyp:   types.T_timestamp,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       112,
+			Index:       198,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_uint8},
-			ReturnTyp:   types.T_uint8,
+			Args:        []types.T{types.T_bool, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       113,
+			Index:       199,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_uint16},
-			ReturnTyp:   types.T_uint16,
+			Args:        []types.T{types.T_int8, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       114,
+			Index:       200,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_uint16},
-			ReturnTyp:   types.T_uint16,
+			Args:        []types.T{types.T_int16, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       115,
+			Index:       201,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_uint32},
-			ReturnTyp:   types.T_uint32,
+			Args:        []types.T{types.T_int32, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       116,
+			Index:       202,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_uint32},
-			ReturnTyp:   types.T_uint32,
+			Args:        []types.T{types.T_int64, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       117,
+			Index:       203,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_uint64},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_uint8, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       118,
+			Index:       204,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_uint64},
-			ReturnTyp:   types.T_uint64,
+			Args:        []types.T{types.T_uint16, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       119,
+			Index:       205,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_float32},
-			ReturnTyp:   types.T_float32,
+			Args:        []types.T{types.T_uint32, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       120,
+			Index:       206,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_float32},
-			ReturnTyp:   types.T_float32,
+			Args:        []types.T{types.T_uint64, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       121,
+			Index:       207,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_float32, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       122,
+			Index:       208,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_float64},
-			ReturnTyp:   types.T_float64,
+			Args:        []types.T{types.T_float64, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       123,
+			Index:       209,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int8, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_char, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       124,
+			Index:       210,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int8, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_varchar, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       125,
+			Index:       211,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int16, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_bool, types.T_int8},
+			ReturnTyp:   types.T_int8,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       126,
+			Index:       212,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int16, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_bool, types.T_int16},
+			ReturnTyp:   types.T_int16,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       127,
+			Index:       213,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int32, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_bool, types.T_int32},
+			ReturnTyp:   types.T_int32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       128,
+			Index:       214,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int32, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_bool, types.T_int64},
+			ReturnTyp:   types.T_int64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       129,
+			Index:       215,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int64, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_bool, types.T_uint8},
+			ReturnTyp:   types.T_uint8,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       130,
+			Index:       216,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int64, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_bool, types.T_uint16},
+			ReturnTyp:   types.T_uint16,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       131,
+			Index:       217,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint8, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_bool, types.T_uint32},
+			ReturnTyp:   types.T_uint32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       132,
+			Index:       218,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint8, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_bool, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       133,
+			Index:       219,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint16, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_bool, types.T_float32},
+			ReturnTyp:   types.T_float32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       134,
+			Index:       220,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint16, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_bool, types.T_float64},
+			ReturnTyp:   types.T_float64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       135,
+			Index:       221,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint32, types.T_char},
+			Args:        []types.T{types.T_bool, types.T_char},
 			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       136,
+			Index:       222,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint32, types.T_varchar},
+			Args:        []types.T{types.T_bool, types.T_varchar},
 			ReturnTyp:   types.T_varchar,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       137,
+			Index:       223,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint64, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_int8, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       138,
+			Index:       224,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint64, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_int16, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       139,
+			Index:       225,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_float32, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_int32, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       140,
+			Index:       226,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_float32, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_int64, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       141,
+			Index:       227,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_float64, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_uint8, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       142,
+			Index:       228,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_float64, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_uint16, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       143,
+			Index:       229,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_uint32, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       144,
+			Index:       230,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_uint64, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       145,
+			Index:       231,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_char, types.T_varchar},
-			ReturnTyp:   types.T_varchar,
+			Args:        []types.T{types.T_char, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       146,
+			Index:       232,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_char},
-			ReturnTyp:   types.T_char,
+			Args:        []types.T{types.T_varchar, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       147,
+			Index:       233,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int8, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_bit},
+			ReturnTyp:   types.T_bit,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       148,
+			Index:       234,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int16, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_int8},
+			ReturnTyp:   types.T_int8,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       149,
+			Index:       235,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int32, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_int16},
+			ReturnTyp:   types.T_int16,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       150,
+			Index:       236,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_int64, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_int32},
+			ReturnTyp:   types.T_int32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       151,
+			Index:       237,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_date},
-			ReturnTyp:   types.T_date,
+			Args:        []types.T{types.T_bit, types.T_int64},
+			ReturnTyp:   types.T_int64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       152,
+			Index:       238,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_datetime},
-			ReturnTyp:   types.T_datetime,
+			Args:        []types.T{types.T_bit, types.T_uint8},
+			ReturnTyp:   types.T_uint8,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       153,
+			Index:       239,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_timestamp},
-			ReturnTyp:   types.T_timestamp,
+			Args:        []types.T{types.T_bit, types.T_uint16},
+			ReturnTyp:   types.T_uint16,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       154,
+			Index:       240,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_decimal64, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_uint32},
+			ReturnTyp:   types.T_uint32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       155,
+			Index:       241,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_decimal64, types.T_decimal64},
-			ReturnTyp:   types.T_decimal64,
+			Args:        []types.T{types.T_bit, types.T_uint64},
+			ReturnTyp:   types.T_uint64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       156,
+			Index:       242,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_decimal128, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_float32},
+			ReturnTyp:   types.T_float32,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       157,
+			Index:       243,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_timestamp, types.T_datetime},
-			ReturnTyp:   types.T_datetime,
+			Args:        []types.T{types.T_bit, types.T_float64},
+			ReturnTyp:   types.T_float64,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       158,
+			Index:       244,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_varchar, types.T_interval},
-			ReturnTyp:   types.T_interval,
+			Args:        []types.T{types.T_bit, types.T_bool},
+			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          operator.Cast,
 		},
 		{
-			Index:       159,
+			Index:       245,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint8, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_char},
+			ReturnTyp:   types.T_char,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
 		{
-			Index:       160,
+			Index:       246,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint16, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_bit, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          operator.Cast,
 		},
+	},
+	IMPLICIT_CAST: {
 		{
-			Index:       161,
+			Index:       0,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint32, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_char, types.T_float64},
+			ReturnTyp:   types.T_float64,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.Cast,
+			Fn:          operator.ImplicitCast,
 		},
 		{
-			Index:       162,
+			Index:       1,
 			Flag:        plan.Function_STRICT,
 			Layout:      CAST_EXPRESSION,
-			Args:        []types.T{types.T_uint64, types.T_decimal128},
-			ReturnTyp:   types.T_decimal128,
+			Args:        []types.T{types.T_varchar, types.T_float64},
+			ReturnTyp:   types.T_float64,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          operator.Cast,
+			Fn:          operator.ImplicitCast,
 		},
 	},
 	CASE: {
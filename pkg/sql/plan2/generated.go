@@ -0,0 +1,181 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// nonDeterministicFunctions are the functions whose results are not decided by
+// their arguments, they are not allowed in the generated columns
+var nonDeterministicFunctions = map[string]bool{
+	"connection_id":     true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curdate":           true,
+	"curtime":           true,
+	"database":          true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"now":               true,
+	"rand":              true,
+	"schema":            true,
+	"session_user":      true,
+	"sysdate":           true,
+	"system_user":       true,
+	"unix_timestamp":    true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"version":           true,
+}
+
+// GeneratedBinder binds the expression of a stored generated column, the
+// expression refers to the other columns of the row only
+type GeneratedBinder struct {
+	baseBinder
+	col       *ColDef
+	generated map[string]bool
+}
+
+func NewGeneratedBinder(col *ColDef, cols []*ColDef) *GeneratedBinder {
+	b := &GeneratedBinder{
+		col:       col,
+		generated: make(map[string]bool),
+	}
+	b.ctx = NewBindContext(nil, nil)
+	b.impl = b
+
+	names := make([]string, len(cols))
+	typs := make([]*plan.Type, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+		typs[i] = c.Typ
+		if c.Generated != "" {
+			b.generated[c.Name] = true
+		}
+	}
+	binding := NewBinding(0, 0, "", names, typs)
+	b.ctx.bindings = append(b.ctx.bindings, binding)
+	for _, name := range names {
+		b.ctx.bindingByCol[name] = binding
+	}
+	return b
+}
+
+func (b *GeneratedBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	if f, ok := astExpr.(*tree.FuncExpr); ok {
+		if name, ok := f.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			if nonDeterministicFunctions[strings.ToLower(name.Parts[0])] {
+				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("expression of generated column '%s' contains a disallowed function: %s", b.col.Name, name.Parts[0]))
+			}
+		}
+	}
+	return b.baseBindExpr(astExpr, depth)
+}
+
+func (b *GeneratedBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32) (*plan.Expr, error) {
+	if len(astExpr.Parts[1]) > 0 {
+		return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("generated column '%s' can only refer to the columns of its own table by name", b.col.Name))
+	}
+	if b.generated[astExpr.Parts[0]] {
+		return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("generated column '%s' cannot refer to generated column '%s'", b.col.Name, astExpr.Parts[0]))
+	}
+	return b.baseBindColRef(astExpr, depth)
+}
+
+func (b *GeneratedBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.GroupingError, "aggregate functions not allowed in generated column")
+}
+
+func (b *GeneratedBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.WindowingError, "window functions not allowed in generated column")
+}
+
+func (b *GeneratedBinder) BindSubquery(astExpr *tree.Subquery) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnDefinition, "subquery not allowed in generated column")
+}
+
+// BuildGeneratedExpr binds the expression of the stored generated column col,
+// the column refs of the expression are the positions of the columns in cols.
+// The expression is casted to the type of col.
+func BuildGeneratedExpr(col *ColDef, cols []*ColDef) (*Expr, error) {
+	astExpr, err := parseColumnExpr(col.Generated)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := NewGeneratedBinder(col, cols).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Typ.Id != col.Typ.Id {
+		if expr, err = appendCastBeforeExpr(expr, col.Typ); err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+// parseColumnExpr parses the text of an expression of a column definition
+func parseColumnExpr(text string) (tree.Expr, error) {
+	stmt, err := parsers.ParseOne(dialect.MYSQL, "select "+text)
+	if err != nil {
+		return nil, err
+	}
+	if sel, ok := stmt.(*tree.Select); ok {
+		if clause, ok := sel.Select.(*tree.SelectClause); ok && len(clause.Exprs) == 1 {
+			return clause.Exprs[0].Expr, nil
+		}
+	}
+	return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("invalid expression of column '%s'", text))
+}
+
+// substituteColRefs returns a copy of expr whose column refs are replaced by
+// the copies of the expressions at their positions in exprs
+func substituteColRefs(expr *Expr, exprs []*Expr) *Expr {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return DeepCopyExpr(exprs[e.Col.ColPos])
+	case *plan.Expr_F:
+		args := make([]*Expr, len(e.F.Args))
+		for i, arg := range e.F.Args {
+			args[i] = substituteColRefs(arg, exprs)
+		}
+		return &Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: e.F.Func,
+					Args: args,
+				},
+			},
+		}
+	default:
+		return DeepCopyExpr(expr)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableGenerated(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table t (email varchar(100), email_lc varchar(100) as (lower(email)) stored)")
+	require.NoError(t, err)
+	cols := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols()
+	require.Equal(t, "", cols[0].Generated)
+	require.Equal(t, "lower(email)", cols[1].Generated)
+
+	// the generated column is the primary key
+	_, err = runOneStmt(mock, t, "create table t (a int, b int generated always as (a * 2 + 1) stored primary key)")
+	require.NoError(t, err)
+
+	for _, sql := range []string{
+		"create table t (a int, b int as (a + 1))",
+		"create table t (a int, b int as (a + 1) virtual)",
+		"create table t (a int, b datetime as (now()) stored)",
+		"create table t (a int, b double as (rand()) stored)",
+		"create table t (a int, b int as (a + 1) stored, c int as (b + 1) stored)",
+		"create table t (a int, b int as (c + 1) stored)",
+		"create table t (a int, b int default 1 as (a + 1) stored)",
+		"create table t (a int, b int as (max(a)) stored)",
+	} {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestUpdateGenerated(t *testing.T) {
+	mock := NewMockOptimizer()
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.objects["users"] = &ObjectRef{SchemaName: "tpch", ObjName: "users"}
+	ctx.tables["users"] = &TableDef{
+		Name: "users",
+		Cols: []*ColDef{
+			{Name: "id", Typ: &plan.Type{Id: plan.Type_INT32}},
+			{Name: "email", Typ: &plan.Type{Id: plan.Type_VARCHAR, Width: 100}},
+			{Name: "email_lc", Typ: &plan.Type{Id: plan.Type_VARCHAR, Width: 100}, Generated: "lower(email)"},
+		},
+	}
+
+	logicPlan, err := runOneStmt(mock, t, "update users set email = 'A@B.COM' where id = 1")
+	require.NoError(t, err)
+	nodes := logicPlan.GetQuery().GetNodes()
+	values := nodes[len(nodes)-1].GetUpdateList().GetValues()
+	require.Equal(t, 3, len(values))
+	// email_lc is lower() of the new value of email
+	f := values[2].Expr.(*plan.Expr_F).F
+	require.Equal(t, "lower", f.Func.ObjName)
+	require.Equal(t, values[1], f.Args[0])
+
+	_, err = runOneStmt(mock, t, "update users set email_lc = 'a@b.com' where id = 1")
+	require.Error(t, err)
+}
@@ -42,7 +42,8 @@ func (b *HavingBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*p
 	if !b.insideAgg {
 		if colPos, ok := b.ctx.groupByAst[astStr]; ok {
 			return &plan.Expr{
-				Typ: b.ctx.groups[colPos].Typ,
+				Typ:     b.ctx.groups[colPos].Typ,
+				ColName: astStr,
 				Expr: &plan.Expr_Col{
 					Col: &plan.ColRef{
 						RelPos: b.ctx.groupTag,
@@ -56,7 +57,8 @@ func (b *HavingBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*p
 	if colPos, ok := b.ctx.aggregateByAst[astStr]; ok {
 		if !b.insideAgg {
 			return &plan.Expr{
-				Typ: b.ctx.aggregates[colPos].Typ,
+				Typ:     b.ctx.aggregates[colPos].Typ,
+				ColName: b.ctx.aggregates[colPos].ColName,
 				Expr: &plan.Expr_Col{
 					Col: &plan.ColRef{
 						RelPos: b.ctx.aggregateTag,
@@ -85,6 +87,9 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 		return nil, errors.New(errno.GroupingError, "aggregate function calls cannot be nested")
 	}
 
+	// the name of the aggregate column is the call in the query, before
+	// count(*) is rewritten
+	name := tree.String(astExpr, dialect.MYSQL)
 	b.insideAgg = true
 	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
 	if err != nil {
@@ -98,10 +103,12 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 	colPos := int32(len(b.ctx.aggregates))
 	astStr := tree.String(astExpr, dialect.MYSQL)
 	b.ctx.aggregateByAst[astStr] = colPos
+	expr.ColName = name
 	b.ctx.aggregates = append(b.ctx.aggregates, expr)
 
 	return &plan.Expr{
-		Typ: expr.Typ,
+		Typ:     expr.Typ,
+		ColName: name,
 		Expr: &plan.Expr_Col{
 			Col: &plan.ColRef{
 				RelPos: b.ctx.aggregateTag,
@@ -0,0 +1,161 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"go/constant"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// Like mysql, a hexadecimal or bit-value literal is a binary string, and
+// it is a number in a numeric context: the operands of the arithmetic
+// operators, a comparison with a number, a cast to a number and a value of
+// a numeric column.
+
+// numericOperators are the functions whose binary literal args are numbers
+var numericOperators = map[string]bool{
+	"+":           true,
+	"-":           true,
+	"*":           true,
+	"/":           true,
+	"%":           true,
+	"div":         true,
+	"unary_minus": true,
+	"unary_plus":  true,
+}
+
+// comparisonOperators are the functions whose binary literal args are
+// numbers if another arg is a number
+var comparisonOperators = map[string]bool{
+	"=":   true,
+	"<":   true,
+	"<=":  true,
+	">":   true,
+	">=":  true,
+	"<>":  true,
+	"<=>": true,
+}
+
+func isNumericType(typ *plan.Type) bool {
+	switch typ.Id {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+		plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64,
+		plan.Type_FLOAT32, plan.Type_FLOAT64, plan.Type_DECIMAL64, plan.Type_DECIMAL128:
+		return true
+	}
+	return false
+}
+
+// getBinaryLiteral returns the binary literal of astExpr if it is one
+func getBinaryLiteral(astExpr tree.Expr) (*tree.NumVal, bool) {
+	for {
+		switch e := astExpr.(type) {
+		case *tree.ParenExpr:
+			astExpr = e.Expr
+			continue
+		case *tree.NumVal:
+			return e, e.IsBinaryLiteral()
+		}
+		return nil, false
+	}
+}
+
+// numericBinaryLiteral returns the number of a binary literal, it fails if
+// the literal has more than 64 bits.
+func numericBinaryLiteral(num *tree.NumVal) (*tree.NumVal, error) {
+	v, ok := num.BinaryLiteralUint64()
+	if !ok {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("binary literal %s is out of range of BIGINT UNSIGNED", num.String()))
+	}
+	return tree.NewNumValWithType(constant.MakeUint64(v), strconv.FormatUint(v, 10), false, tree.P_int64), nil
+}
+
+// rewriteNumericArg returns the number of astExpr if it is a binary literal
+func rewriteNumericArg(astExpr tree.Expr) (tree.Expr, error) {
+	if num, ok := getBinaryLiteral(astExpr); ok {
+		return numericBinaryLiteral(num)
+	}
+	return astExpr, nil
+}
+
+// bigIntConst returns the unsigned integer constant out of the range of
+// int64, which is the cast of its string since the constants of the plan are
+// int64.
+func bigIntConst(val constant.Value) (*Expr, error) {
+	if _, ok := constant.Uint64Val(val); !ok {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("integer '%s' is out of range of BIGINT UNSIGNED", val.ExactString()))
+	}
+	return appendCastBeforeExpr(&Expr{
+		Expr: &plan.Expr_C{
+			C: &Const{
+				Isnull: false,
+				Value: &plan.Const_Sval{
+					Sval: val.ExactString(),
+				},
+			},
+		},
+		Typ: &plan.Type{
+			Id:       plan.Type_VARCHAR,
+			Nullable: false,
+			Size:     4,
+			Width:    math.MaxInt32,
+		},
+	}, &plan.Type{
+		Id:   plan.Type_UINT64,
+		Size: 8,
+	})
+}
+
+// bindFuncArgs binds the args of a function, the binary literals are bound at
+// last when it is known whether they are numbers.
+func (b *baseBinder) bindFuncArgs(name string, astArgs []tree.Expr, depth int32) ([]*Expr, error) {
+	args := make([]*Expr, len(astArgs))
+	numeric := numericOperators[name]
+	for idx, arg := range astArgs {
+		if _, ok := getBinaryLiteral(arg); ok {
+			continue
+		}
+		expr, err := b.impl.BindExpr(arg, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		args[idx] = expr
+		if comparisonOperators[name] && (isNumericType(expr.Typ) || expr.Typ.Id == plan.Type_BIT) {
+			numeric = true
+		}
+	}
+	for idx, arg := range astArgs {
+		if args[idx] != nil {
+			continue
+		}
+		var err error
+		if numeric {
+			if arg, err = rewriteNumericArg(arg); err != nil {
+				return nil, err
+			}
+		}
+		if args[idx], err = b.impl.BindExpr(arg, depth, false); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
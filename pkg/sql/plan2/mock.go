@@ -19,11 +19,19 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 type MockCompilerContext struct {
 	objects map[string]*ObjectRef
 	tables  map[string]*TableDef
+	// the NDV of the columns by table and column name
+	ndvs map[string]map[string]float64
+	// the access paths of the equalities and the ranges by table and column
+	// name
+	paths map[string]map[string][]engine.PathEstimate
+	// the defaults of the databases by name
+	defaults map[string]engine.DatabaseDefaults
 }
 
 func (m *MockCompilerContext) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
@@ -209,6 +217,13 @@ func (m *MockCompilerContext) DatabaseExists(name string) bool {
 	return strings.ToLower(name) == "tpch" || strings.ToLower(name) == "mo"
 }
 
+func (m *MockCompilerContext) DatabaseDefaults(name string) engine.DatabaseDefaults {
+	if name == "" {
+		name = m.DefaultDatabase()
+	}
+	return m.defaults[strings.ToLower(name)]
+}
+
 func (m *MockCompilerContext) DefaultDatabase() string {
 	return "tpch"
 }
@@ -219,6 +234,10 @@ func (m *MockCompilerContext) Resolve(dbName string, tableName string) (*ObjectR
 }
 
 func (m *MockCompilerContext) GetPrimaryKeyDef(dbName string, tableName string) []*ColDef {
+	// the first column of a table is its primary key
+	if tableDef, ok := m.tables[strings.ToLower(tableName)]; ok && len(tableDef.Cols) > 0 {
+		return tableDef.Cols[:1]
+	}
 	return nil
 }
 
@@ -241,6 +260,18 @@ func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) *Cost {
 	return c
 }
 
+func (m *MockCompilerContext) ColumnNDV(obj *ObjectRef, colName string) float64 {
+	return m.ndvs[strings.ToLower(obj.ObjName)][colName]
+}
+
+func (m *MockCompilerContext) AccessPaths(obj *ObjectRef, colName string, value any) []engine.PathEstimate {
+	return m.paths[strings.ToLower(obj.ObjName)][colName]
+}
+
+func (m *MockCompilerContext) RangeAccessPaths(obj *ObjectRef, colName string, min, max any) []engine.PathEstimate {
+	return m.paths[strings.ToLower(obj.ObjName)][colName]
+}
+
 type MockOptimizer struct {
 	ctxt MockCompilerContext
 }
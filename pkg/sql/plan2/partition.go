@@ -0,0 +1,592 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+const (
+	// PartitionPropertyKey is the table property holding the range
+	// partition definition of a partitioned table
+	PartitionPropertyKey = "partition"
+	// PartitionScanPropertyKey is set on the table def of a table scan node,
+	// it lists the partitions left after pruning, separated by comma
+	PartitionScanPropertyKey = "partition_scan"
+	// DropPartitionPropertyKey carries the partitions removed by
+	// ALTER TABLE ... DROP PARTITION
+	DropPartitionPropertyKey = "drop_partition"
+	// DatabasePropertyKey carries the database of an ALTER TABLE
+	DatabasePropertyKey = "database"
+
+	partitionTableSeparator = "#P#"
+)
+
+// PartitionDef is a RANGE partition definition on a single column. The
+// data of each partition is stored in its own relation, see
+// PartitionTableName.
+type PartitionDef struct {
+	Column string   `json:"column"`
+	Names  []string `json:"names"`
+	// Bounds[i] is the exclusive upper bound of partition i, the values of
+	// date and datetime columns are stored by their integer representation
+	Bounds []int64 `json:"bounds"`
+	// MaxValue is true if the last partition is VALUES LESS THAN MAXVALUE,
+	// it has no entry in Bounds
+	MaxValue bool `json:"maxvalue"`
+}
+
+// PartitionTableName returns the relation name storing the data of a partition
+func PartitionTableName(table, partition string) string {
+	return table + partitionTableSeparator + partition
+}
+
+// IsPartitionTableName returns true if name is a relation created for a partition
+func IsPartitionTableName(name string) bool {
+	return strings.Contains(name, partitionTableSeparator)
+}
+
+func UnmarshalPartitionDef(data string) (*PartitionDef, error) {
+	def := new(PartitionDef)
+	if err := json.Unmarshal([]byte(data), def); err != nil {
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("invalid partition definition: %v", err))
+	}
+	return def, nil
+}
+
+func (def *PartitionDef) Marshal() string {
+	data, _ := json.Marshal(def)
+	return string(data)
+}
+
+// GetPartitionDef returns the partition definition of the table, or nil if
+// the table is not partitioned
+func GetPartitionDef(tableDef *TableDef) (*PartitionDef, error) {
+	if value, ok := getTableProperty(tableDef, PartitionPropertyKey); ok {
+		return UnmarshalPartitionDef(value)
+	}
+	return nil, nil
+}
+
+// GetPartitionScan returns the partitions a table scan reads after pruning.
+// ok is false if the scanned table is not partitioned
+func GetPartitionScan(tableDef *TableDef) (names []string, ok bool) {
+	value, ok := getTableProperty(tableDef, PartitionScanPropertyKey)
+	if !ok || value == "" {
+		return nil, ok
+	}
+	return strings.Split(value, ","), true
+}
+
+func getTableProperty(tableDef *TableDef, key string) (string, bool) {
+	for _, def := range tableDef.GetDefs() {
+		if properties := def.GetProperties(); properties != nil {
+			for _, property := range properties.GetProperties() {
+				if property.GetKey() == key {
+					return property.GetValue(), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// withTableProperty returns a copy of the table def with the property added,
+// for the table def may be shared by other nodes
+func withTableProperty(tableDef *TableDef, key, value string) *TableDef {
+	def := *tableDef
+	def.Defs = append(make([]*plan.TableDef_DefType, 0, len(def.Defs)+1), def.Defs...)
+	def.Defs = append(def.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: []*plan.Property{{
+					Key:   key,
+					Value: value,
+				}},
+			},
+		},
+	})
+	return &def
+}
+
+func isPartitionColumnType(id plan.Type_TypeId) bool {
+	switch id {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+		plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64,
+		plan.Type_DATE, plan.Type_DATETIME:
+		return true
+	}
+	return false
+}
+
+func buildPartitionDef(opt *tree.PartitionOption, tableDef *TableDef) (*PartitionDef, error) {
+	rangeType, ok := opt.PartBy.PType.(*tree.RangeType)
+	if !ok || opt.SubPartBy != nil {
+		return nil, errors.New(errno.FeatureNotSupported, "only RANGE partitioning without subpartitions is supported")
+	}
+	var column *tree.UnresolvedName
+	if len(rangeType.ColumnList) == 1 {
+		column = rangeType.ColumnList[0]
+	} else if rangeType.ColumnList == nil {
+		column, _ = rangeType.Expr.(*tree.UnresolvedName)
+	}
+	if column == nil || column.NumParts != 1 {
+		return nil, errors.New(errno.FeatureNotSupported, "range partitioning is only supported on a single column")
+	}
+	if len(opt.Partitions) == 0 {
+		return nil, errors.New(errno.InvalidTableDefinition, "for RANGE partitions each partition must be defined")
+	}
+	if opt.PartBy.Num != 0 && int(opt.PartBy.Num) != len(opt.Partitions) {
+		return nil, errors.New(errno.InvalidTableDefinition, "wrong number of partitions defined, mismatch with previous setting")
+	}
+
+	def := &PartitionDef{Column: column.Parts[0]}
+	var colType *plan.Type
+	for _, col := range tableDef.Cols {
+		if strings.EqualFold(col.Name, def.Column) {
+			def.Column = col.Name
+			colType = col.Typ
+			break
+		}
+	}
+	if colType == nil {
+		return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("partition column '%s' does not exist", def.Column))
+	}
+	if !isPartitionColumnType(colType.Id) {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("partition column '%s' of type %s is not supported", def.Column, colType.Id))
+	}
+
+	names := make(map[string]struct{}, len(opt.Partitions))
+	for i, p := range opt.Partitions {
+		name := string(p.Name)
+		if _, ok := names[name]; ok {
+			return nil, errors.New(errno.DuplicateObject, fmt.Sprintf("duplicate partition name %s", name))
+		}
+		names[name] = struct{}{}
+		def.Names = append(def.Names, name)
+
+		values, ok := p.Values.(*tree.ValuesLessThan)
+		if !ok || len(values.ValueList) != 1 {
+			return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("partition %s must be defined by VALUES LESS THAN a single value", name))
+		}
+		if _, ok := values.ValueList[0].(*tree.MaxValue); ok {
+			if i != len(opt.Partitions)-1 {
+				return nil, errors.New(errno.InvalidTableDefinition, "MAXVALUE can only be used in last partition definition")
+			}
+			def.MaxValue = true
+			continue
+		}
+		bound, err := buildPartitionBound(colType, values.ValueList[0])
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && bound <= def.Bounds[i-1] {
+			return nil, errors.New(errno.InvalidTableDefinition, "VALUES LESS THAN value must be strictly increasing for each partition")
+		}
+		def.Bounds = append(def.Bounds, bound)
+	}
+	return def, nil
+}
+
+func buildPartitionBound(typ *plan.Type, expr tree.Expr) (int64, error) {
+	value, err := buildConstant(typ, expr)
+	if err == nil {
+		value, err = rangeCheck(value, typ, "", 0)
+	}
+	if err != nil {
+		return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("invalid partition value %s: %v", tree.String(expr, 0), err))
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v), nil
+		}
+	case types.Date:
+		return int64(v), nil
+	case types.Datetime:
+		return int64(v), nil
+	}
+	return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("invalid partition value %s", tree.String(expr, 0)))
+}
+
+// Locate returns the partition of the value, or -1 if no partition holds it
+func (def *PartitionDef) Locate(v int64) int {
+	i := sort.Search(len(def.Bounds), func(i int) bool { return v < def.Bounds[i] })
+	if i >= len(def.Names) {
+		return -1
+	}
+	return i
+}
+
+// Route computes the partition of each row of vec. Null values go to the
+// first partition.
+func (def *PartitionDef) Route(vec *vector.Vector) ([]int32, error) {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return routeSigned(def, vec.Col.([]int8), vec.Nsp)
+	case types.T_int16:
+		return routeSigned(def, vec.Col.([]int16), vec.Nsp)
+	case types.T_int32:
+		return routeSigned(def, vec.Col.([]int32), vec.Nsp)
+	case types.T_int64:
+		return routeSigned(def, vec.Col.([]int64), vec.Nsp)
+	case types.T_uint8:
+		return routeUnsigned(def, vec.Col.([]uint8), vec.Nsp)
+	case types.T_uint16:
+		return routeUnsigned(def, vec.Col.([]uint16), vec.Nsp)
+	case types.T_uint32:
+		return routeUnsigned(def, vec.Col.([]uint32), vec.Nsp)
+	case types.T_uint64, types.T_bit:
+		return routeUnsigned(def, vec.Col.([]uint64), vec.Nsp)
+	case types.T_date:
+		return routeSigned(def, vec.Col.([]types.Date), vec.Nsp)
+	case types.T_datetime:
+		return routeSigned(def, vec.Col.([]types.Datetime), vec.Nsp)
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("partition column of type %s is not supported", vec.Typ))
+}
+
+func routeSigned[T int8 | int16 | int32 | int64 | types.Date | types.Datetime](def *PartitionDef, vs []T, nsp *nulls.Nulls) ([]int32, error) {
+	parts := make([]int32, len(vs))
+	for i, v := range vs {
+		parts[i] = int32(def.Locate(int64(v)))
+	}
+	return parts, checkRoute(parts, vs, nsp)
+}
+
+func routeUnsigned[T uint8 | uint16 | uint32 | uint64](def *PartitionDef, vs []T, nsp *nulls.Nulls) ([]int32, error) {
+	parts := make([]int32, len(vs))
+	for i, v := range vs {
+		if uint64(v) > math.MaxInt64 {
+			parts[i] = int32(def.Locate(math.MaxInt64))
+		} else {
+			parts[i] = int32(def.Locate(int64(v)))
+		}
+	}
+	return parts, checkRoute(parts, vs, nsp)
+}
+
+func checkRoute[T any](parts []int32, vs []T, nsp *nulls.Nulls) error {
+	hasNull := nulls.Any(nsp)
+	for i, p := range parts {
+		if hasNull && nulls.Contains(nsp, uint64(i)) {
+			parts[i] = 0
+		} else if p < 0 {
+			return errors.New(errno.DataException, fmt.Sprintf("table has no partition for value %v", vs[i]))
+		}
+	}
+	return nil
+}
+
+// SplitBatch splits the rows of bat by the partitions computed by Route.
+// The result has one batch per partition, nil for partitions without rows.
+func (def *PartitionDef) SplitBatch(bat *batch.Batch, parts []int32, m *mheap.Mheap) ([]*batch.Batch, error) {
+	sels := make([][]int64, len(def.Names))
+	for i, p := range parts {
+		sels[p] = append(sels[p], int64(i))
+	}
+	bats := make([]*batch.Batch, len(def.Names))
+	for p := range sels {
+		switch len(sels[p]) {
+		case 0:
+			continue
+		case len(parts):
+			bats[p] = bat
+			return bats, nil
+		}
+		bats[p] = batch.New(true, bat.Attrs)
+		for i, vec := range bat.Vecs {
+			dup, err := vector.Dup(vec, m)
+			if err != nil {
+				return nil, err
+			}
+			vector.Shrink(dup, sels[p])
+			bats[p].Vecs[i] = dup
+		}
+	}
+	return bats, nil
+}
+
+// Drop returns the definition without the named partitions, the rows of a
+// dropped partition fall into the next partition afterwards.
+func (def *PartitionDef) Drop(names []string) *PartitionDef {
+	dropped := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		dropped[name] = struct{}{}
+	}
+	ndef := &PartitionDef{Column: def.Column}
+	for i, name := range def.Names {
+		if _, ok := dropped[name]; ok {
+			continue
+		}
+		ndef.Names = append(ndef.Names, name)
+		if i < len(def.Bounds) {
+			ndef.Bounds = append(ndef.Bounds, def.Bounds[i])
+		} else {
+			ndef.MaxValue = true
+		}
+	}
+	return ndef
+}
+
+// partitionRange is a closed interval of partition column values
+type partitionRange struct {
+	lo, hi int64
+}
+
+// prunePartitions returns the partitions that may hold rows satisfying all
+// the filters. Filters reference the columns of the table by position.
+func (def *PartitionDef) prunePartitions(tableDef *TableDef, filters []*Expr) []string {
+	colPos := int32(-1)
+	for i, col := range tableDef.Cols {
+		if col.Name == def.Column {
+			colPos = int32(i)
+			break
+		}
+	}
+	selected := make([]bool, len(def.Names))
+	for i := range selected {
+		selected[i] = true
+	}
+	if colPos >= 0 {
+		for _, filter := range filters {
+			if keep := def.pruneExpr(colPos, tableDef.Cols[colPos].Typ, filter); keep != nil {
+				for i := range selected {
+					selected[i] = selected[i] && keep[i]
+				}
+			}
+		}
+	}
+	var names []string
+	for i, ok := range selected {
+		if ok {
+			names = append(names, def.Names[i])
+		}
+	}
+	return names
+}
+
+// pruneExpr returns the partitions which may satisfy the expression, or nil
+// if the expression cannot be used for pruning
+func (def *PartitionDef) pruneExpr(colPos int32, colType *plan.Type, expr *Expr) []bool {
+	fn, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := fn.F.Args
+	switch name := fn.F.Func.GetObjName(); name {
+	case "and", "or":
+		left := def.pruneExpr(colPos, colType, args[0])
+		right := def.pruneExpr(colPos, colType, args[1])
+		if name == "and" {
+			if left == nil {
+				return right
+			}
+			if right != nil {
+				for i := range left {
+					left[i] = left[i] && right[i]
+				}
+			}
+			return left
+		}
+		if left == nil || right == nil {
+			return nil
+		}
+		for i := range left {
+			left[i] = left[i] || right[i]
+		}
+		return left
+	case "=", "<", "<=", ">", ">=":
+		if v, ok := partitionConstant(colType, args[1]); ok && isPartitionColumn(colPos, colType, args[0]) {
+			return def.selectRange(name, v)
+		}
+		if v, ok := partitionConstant(colType, args[0]); ok && isPartitionColumn(colPos, colType, args[1]) {
+			return def.selectRange(reverseComparison[name], v)
+		}
+	}
+	return nil
+}
+
+var reverseComparison = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+func (def *PartitionDef) selectRange(op string, v int64) []bool {
+	r := partitionRange{lo: math.MinInt64, hi: math.MaxInt64}
+	selected := make([]bool, len(def.Names))
+	switch op {
+	case "=":
+		r.lo, r.hi = v, v
+	case "<":
+		if v == math.MinInt64 {
+			return selected
+		}
+		r.hi = v - 1
+	case "<=":
+		r.hi = v
+	case ">":
+		if v == math.MaxInt64 {
+			return selected
+		}
+		r.lo = v + 1
+	case ">=":
+		r.lo = v
+	}
+	first := def.Locate(r.lo)
+	if first < 0 {
+		return selected
+	}
+	last := def.Locate(r.hi)
+	if last < 0 {
+		last = len(def.Names) - 1
+	}
+	for i := first; i <= last; i++ {
+		selected[i] = true
+	}
+	return selected
+}
+
+// isPartitionColumn returns true if expr is the partition column, possibly
+// widened by a cast which keeps the order of values
+func isPartitionColumn(colPos int32, colType *plan.Type, expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return e.Col.RelPos == 0 && e.Col.ColPos == colPos
+	case *plan.Expr_F:
+		if e.F.Func.GetObjName() != "cast" || !isPartitionColumn(colPos, colType, e.F.Args[0]) {
+			return false
+		}
+		return isSignedType(colType.Id) && isSignedType(expr.Typ.Id) ||
+			isUnsignedType(colType.Id) && isUnsignedType(expr.Typ.Id) ||
+			isIntegerDecimal(colType, expr)
+	}
+	return false
+}
+
+// isIntegerDecimal returns true if expr is an integer of the type cast to
+// decimal128, like the integers compared with an unsigned column, which
+// keeps the order of values
+func isIntegerDecimal(colType *plan.Type, expr *Expr) bool {
+	return expr.Typ.Id == plan.Type_DECIMAL128 && (isSignedType(colType.Id) || isUnsignedType(colType.Id))
+}
+
+// partitionConstant returns the integer representation of a constant
+// compared with the partition column
+func partitionConstant(colType *plan.Type, expr *Expr) (int64, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		if e.C.Isnull {
+			return 0, false
+		}
+		if c, ok := e.C.Value.(*plan.Const_Ival); ok {
+			return c.Ival, isSignedType(colType.Id) || isUnsignedType(colType.Id)
+		}
+	case *plan.Expr_F:
+		if e.F.Func.GetObjName() != "cast" {
+			return 0, false
+		}
+		c, ok := e.F.Args[0].Expr.(*plan.Expr_C)
+		if !ok || c.C.Isnull {
+			return 0, false
+		}
+		if i, ok := c.C.Value.(*plan.Const_Ival); ok {
+			return i.Ival, isIntegerDecimal(colType, expr)
+		}
+		s, ok := c.C.Value.(*plan.Const_Sval)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case expr.Typ.Id == plan.Type_DATE && colType.Id == plan.Type_DATE:
+			if d, err := types.ParseDate(s.Sval); err == nil {
+				return int64(d), true
+			}
+		case expr.Typ.Id == plan.Type_DATETIME && colType.Id == plan.Type_DATETIME:
+			if d, err := types.ParseDatetime(s.Sval); err == nil {
+				return int64(d), true
+			}
+		}
+	}
+	return 0, false
+}
+
+func isSignedType(id plan.Type_TypeId) bool {
+	return id == plan.Type_INT8 || id == plan.Type_INT16 || id == plan.Type_INT32 || id == plan.Type_INT64
+}
+
+func isUnsignedType(id plan.Type_TypeId) bool {
+	return id == plan.Type_UINT8 || id == plan.Type_UINT16 || id == plan.Type_UINT32 || id == plan.Type_UINT64
+}
+
+// scanFilters returns the filters of each table scan, which are the filters on
+// the scan and the filters directly above it
+func (builder *QueryBuilder) scanFilters() map[int32][]*Expr {
+	filters := make(map[int32][]*Expr)
+	for id, node := range builder.qry.Nodes {
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			filters[int32(id)] = append(filters[int32(id)], node.WhereList...)
+		case plan.Node_PROJECT:
+			if len(node.WhereList) > 0 {
+				child := node.Children[0]
+				if builder.qry.Nodes[child].NodeType == plan.Node_TABLE_SCAN {
+					filters[child] = append(filters[child], node.WhereList...)
+				}
+			}
+		}
+	}
+	return filters
+}
+
+// prunePartitions records the partitions read by each scan of a
+// partitioned table, using the filters of the scan
+func (builder *QueryBuilder) prunePartitions() error {
+	filters := builder.scanFilters()
+	for id, node := range builder.qry.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN {
+			continue
+		}
+		def, err := GetPartitionDef(node.TableDef)
+		if err != nil {
+			return err
+		}
+		if def == nil {
+			continue
+		}
+		names := def.prunePartitions(node.TableDef, filters[int32(id)])
+		node.TableDef = withTableProperty(node.TableDef, PartitionScanPropertyKey, strings.Join(names, ","))
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+// newPartitionMockOptimizer registers the partitioned table pt built from sql
+func newPartitionMockOptimizer(t *testing.T, sql string) *MockOptimizer {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, sql)
+	require.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	mock.ctxt.objects[tableDef.Name] = &ObjectRef{SchemaName: "tpch", ObjName: tableDef.Name}
+	mock.ctxt.tables[tableDef.Name] = tableDef
+	return mock
+}
+
+func getScanPartitions(t *testing.T, mock *MockOptimizer, sql string) []string {
+	logicPlan, err := runOneStmt(mock, t, sql)
+	require.NoError(t, err)
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			names, ok := GetPartitionScan(node.TableDef)
+			require.True(t, ok)
+			return names
+		}
+	}
+	t.Fatalf("no table scan in %s", sql)
+	return nil
+}
+
+func TestCreatePartitionTable(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table pt (a int, b varchar(10)) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than maxvalue)")
+	require.NoError(t, err)
+	def, err := GetPartitionDef(logicPlan.GetDdl().GetCreateTable().GetTableDef())
+	require.NoError(t, err)
+	require.Equal(t, &PartitionDef{Column: "a", Names: []string{"p0", "p1", "p2"}, Bounds: []int64{10, 20}, MaxValue: true}, def)
+
+	logicPlan, err = runOneStmt(mock, t, "create table pt (d date) partition by range columns(d) (partition p0 values less than ('2022-01-01'), partition p1 values less than ('2023-01-01'))")
+	require.NoError(t, err)
+	def, err = GetPartitionDef(logicPlan.GetDdl().GetCreateTable().GetTableDef())
+	require.NoError(t, err)
+	d0, _ := types.ParseDate("2022-01-01")
+	d1, _ := types.ParseDate("2023-01-01")
+	require.Equal(t, []int64{int64(d0), int64(d1)}, def.Bounds)
+	require.False(t, def.MaxValue)
+
+	runTestShouldError(mock, t, []string{
+		"create table pt (a int) partition by range(a) (partition p0 values less than (20), partition p1 values less than (10))",
+		"create table pt (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (10))",
+		"create table pt (a int) partition by range(a) (partition p0 values less than maxvalue, partition p1 values less than (10))",
+		"create table pt (a int) partition by range(a) (partition p0 values less than (10), partition p0 values less than (20))",
+		"create table pt (a int) partition by range(c) (partition p0 values less than (10))",
+		"create table pt (a varchar(10)) partition by range columns(a) (partition p0 values less than ('a'))",
+		"create table pt (a int) partition by hash(a) partitions 2",
+		"create table pt (a int, b int) partition by range columns(a, b) (partition p0 values less than (10, 10))",
+		"create table pt (a tinyint) partition by range(a) (partition p0 values less than (1000))",
+		"create table pt (a int) partition by range(a) partitions 3 (partition p0 values less than (10), partition p1 values less than (20))",
+	})
+}
+
+func TestPartitionPruning(t *testing.T) {
+	mock := newPartitionMockOptimizer(t, "create table pt (a int, b varchar(10)) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than maxvalue)")
+	cases := []struct {
+		sql    string
+		expect []string
+	}{
+		{"select * from pt", []string{"p0", "p1", "p2"}},
+		{"select * from pt where b = 'x'", []string{"p0", "p1", "p2"}},
+		{"select * from pt where a = 10", []string{"p1"}},
+		{"select * from pt where a < 10", []string{"p0"}},
+		{"select * from pt where a <= 10", []string{"p0", "p1"}},
+		{"select * from pt where 20 <= a", []string{"p2"}},
+		{"select * from pt where a > 5 and a < 15", []string{"p0", "p1"}},
+		{"select * from pt where a between 10 and 19", []string{"p1"}},
+		{"select * from pt where a = 1 or a = 30", []string{"p0", "p2"}},
+		{"select * from pt where a = 1 and a = 30", nil},
+		{"select count(*) from pt where a >= 15 and b = 'x' group by b", []string{"p1", "p2"}},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expect, getScanPartitions(t, mock, c.sql), c.sql)
+	}
+
+	mock = newPartitionMockOptimizer(t, "create table pt (d date) partition by range columns(d) (partition p0 values less than ('2022-01-01'), partition p1 values less than ('2023-01-01'))")
+	require.Equal(t, []string{"p1"}, getScanPartitions(t, mock, "select * from pt where d >= cast('2022-03-01' as date)"))
+	require.Equal(t, []string{"p0", "p1"}, getScanPartitions(t, mock, "select * from pt where d < cast('2022-03-01' as date)"))
+}
+
+func TestAlterTableDropPartition(t *testing.T) {
+	mock := newPartitionMockOptimizer(t, "create table pt (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20))")
+	logicPlan, err := runOneStmt(mock, t, "alter table pt drop partition p0")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	require.Equal(t, "pt", alterTable.Table)
+	value, ok := getTableProperty(alterTable.TableDef, DropPartitionPropertyKey)
+	require.True(t, ok)
+	require.Equal(t, "p0", value)
+
+	runTestShouldError(mock, t, []string{
+		"alter table pt drop partition p3",
+		"alter table pt drop partition p0, p1",
+		"alter table nation drop partition p0",
+		"alter table not_exist drop partition p0",
+		"delete from pt where a = 1",
+	})
+}
+
+func TestPartitionRoute(t *testing.T) {
+	def := &PartitionDef{Column: "a", Names: []string{"p0", "p1"}, Bounds: []int64{10, 20}}
+	vec := vector.New(types.Type{Oid: types.T_int32})
+	vector.SetCol(vec, []int32{-5, 9, 10, 19, 30})
+	nulls.Add(vec.Nsp, 4)
+	parts, err := def.Route(vec)
+	require.NoError(t, err)
+	require.Equal(t, []int32{0, 0, 1, 1, 0}, parts)
+
+	vec = vector.New(types.Type{Oid: types.T_int32})
+	vector.SetCol(vec, []int32{20})
+	_, err = def.Route(vec)
+	require.Error(t, err)
+
+	// rows of a dropped partition fall into the next one
+	def = &PartitionDef{Column: "a", Names: []string{"p0", "p1", "p2", "p3"}, Bounds: []int64{10, 20, 30}, MaxValue: true}
+	ndef := def.Drop([]string{"p1", "p3"})
+	require.Equal(t, &PartitionDef{Column: "a", Names: []string{"p0", "p2"}, Bounds: []int64{10, 30}}, ndef)
+	vec = vector.New(types.Type{Oid: types.T_uint64})
+	vector.SetCol(vec, []uint64{15, 1, 25, 1 << 63})
+	parts, err = def.Route(vec)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 0, 2, 3}, parts)
+	_, err = ndef.Route(vec)
+	require.Error(t, err)
+	vector.SetCol(vec, []uint64{15, 1, 25})
+	parts, err = ndef.Route(vec)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 0, 1}, parts)
+}
+
+func TestPartitionSplitBatch(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	def := &PartitionDef{Column: "a", Names: []string{"p0", "p1", "p2"}, Bounds: []int64{10, 20, 30}}
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(bat.Vecs[0], []int64{1, 15, 2, 16}))
+	require.NoError(t, vector.Append(bat.Vecs[1], [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d")}))
+	nulls.Add(bat.Vecs[1].Nsp, 1)
+	nulls.Add(bat.Vecs[1].Nsp, 2)
+
+	parts, err := def.Route(bat.Vecs[0])
+	require.NoError(t, err)
+	bats, err := def.SplitBatch(bat, parts, m)
+	require.NoError(t, err)
+	require.Nil(t, bats[2])
+	require.Equal(t, []int64{1, 2}, bats[0].Vecs[0].Col.([]int64))
+	require.Equal(t, []int64{15, 16}, bats[1].Vecs[0].Col.([]int64))
+	require.Equal(t, "d", string(bats[1].Vecs[1].Col.(*types.Bytes).Get(1)))
+	require.True(t, nulls.Contains(bats[0].Vecs[1].Nsp, 1))
+	require.False(t, nulls.Contains(bats[0].Vecs[1].Nsp, 0))
+	require.True(t, nulls.Contains(bats[1].Vecs[1].Nsp, 0))
+	require.False(t, nulls.Contains(bats[1].Vecs[1].Nsp, 1))
+	// the source batch is left untouched
+	require.Equal(t, []int64{1, 15, 2, 16}, bat.Vecs[0].Col.([]int64))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 1))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 2))
+
+	bats, err = def.SplitBatch(bat, []int32{2, 2, 2, 2}, m)
+	require.NoError(t, err)
+	require.Equal(t, bat, bats[2])
+}
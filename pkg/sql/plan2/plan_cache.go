@@ -0,0 +1,210 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/metric"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// PlanCache keeps the plans of the queries, so that a query executed again is
+// not planned again. A plan is keyed by the digest of the statement and the
+// default database, and is used only if the schema versions of the tables it
+// references are still those read when it was built. The statements reading
+// variables are not cached, for their plans hold the values.
+type PlanCache struct {
+	sync.Mutex
+	capacity int
+	lru      *list.List
+	entries  map[string]*list.Element
+	hits     uint64
+	misses   uint64
+}
+
+type cachedPlan struct {
+	key string
+	// data is the marshaled plan, every execution gets its own plan
+	data   []byte
+	tables []TableVersion
+}
+
+// TableVersion is the schema version of a table referenced by a plan
+type TableVersion struct {
+	Db      string
+	Table   string
+	Version uint64
+}
+
+func NewPlanCache(capacity int) *PlanCache {
+	return &PlanCache{
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// PlanCacheKey returns the key of the plan of stmt, or an empty string if
+// stmt is not a query
+func PlanCacheKey(db string, stmt tree.Statement) string {
+	switch stmt.(type) {
+	case *tree.Select, *tree.ParenSelect, *tree.Insert, *tree.Update, *tree.Delete:
+	default:
+		return ""
+	}
+	digest := sha256.Sum256([]byte(tree.String(stmt, dialect.MYSQL)))
+	return db + "/" + hex.EncodeToString(digest[:])
+}
+
+// Get returns a copy of the plan of the key, the plan is dropped if any table
+// it references has been changed
+func (pc *PlanCache) Get(key string) (*Plan, bool) {
+	pc.Lock()
+	defer pc.Unlock()
+	elem, ok := pc.entries[key]
+	if ok {
+		entry := elem.Value.(*cachedPlan)
+		for _, tbl := range entry.tables {
+			if engine.SchemaVersion(tbl.Db, tbl.Table) != tbl.Version {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			pc.lru.Remove(elem)
+			delete(pc.entries, key)
+		} else {
+			p := new(Plan)
+			if err := p.Unmarshal(entry.data); err == nil {
+				pc.lru.MoveToFront(elem)
+				pc.hits++
+				metric.PlanCacheCounter(true).Inc()
+				return p, true
+			}
+		}
+	}
+	pc.misses++
+	metric.PlanCacheCounter(false).Inc()
+	return nil, false
+}
+
+// Put caches the plan of the key, which references the tables of the versions
+func (pc *PlanCache) Put(key string, p *Plan, tables []TableVersion) {
+	data, err := p.Marshal()
+	if err != nil {
+		return
+	}
+	pc.Lock()
+	defer pc.Unlock()
+	if elem, ok := pc.entries[key]; ok {
+		pc.lru.Remove(elem)
+	}
+	pc.entries[key] = pc.lru.PushFront(&cachedPlan{key: key, data: data, tables: tables})
+	for pc.lru.Len() > pc.capacity {
+		elem := pc.lru.Back()
+		pc.lru.Remove(elem)
+		delete(pc.entries, elem.Value.(*cachedPlan).key)
+	}
+}
+
+// Stats returns the number of the plans found and not found in the cache
+func (pc *PlanCache) Stats() (hits, misses uint64) {
+	pc.Lock()
+	defer pc.Unlock()
+	return pc.hits, pc.misses
+}
+
+// Len returns the number of the cached plans
+func (pc *PlanCache) Len() int {
+	pc.Lock()
+	defer pc.Unlock()
+	return pc.lru.Len()
+}
+
+// BuildPlanCached is BuildPlan which takes the plan of a query from the cache
+// if it is there, or caches the plan built
+func BuildPlanCached(ctx CompilerContext, stmt tree.Statement, cache *PlanCache) (*Plan, error) {
+	if cache == nil {
+		return BuildPlan(ctx, stmt)
+	}
+	key := PlanCacheKey(ctx.DefaultDatabase(), stmt)
+	if key == "" {
+		return BuildPlan(ctx, stmt)
+	}
+	if p, ok := cache.Get(key); ok {
+		return p, nil
+	}
+	cacheCtx := &planCacheContext{CompilerContext: ctx}
+	p, err := BuildPlan(cacheCtx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.Plan.(*plan.Plan_Query); ok && !cacheCtx.uncacheable {
+		cache.Put(key, p, cacheCtx.tables)
+	}
+	return p, nil
+}
+
+// planCacheContext records the schema versions of the tables resolved while a
+// plan is built. The versions are read before the tables are resolved, so a
+// DDL meanwhile is never missed.
+type planCacheContext struct {
+	CompilerContext
+	tables      []TableVersion
+	uncacheable bool
+}
+
+func (ctx *planCacheContext) addTable(dbName, tableName string) {
+	if len(dbName) == 0 {
+		dbName = ctx.DefaultDatabase()
+	}
+	for _, tbl := range ctx.tables {
+		if tbl.Db == dbName && tbl.Table == tableName {
+			return
+		}
+	}
+	ctx.tables = append(ctx.tables, TableVersion{
+		Db:      dbName,
+		Table:   tableName,
+		Version: engine.SchemaVersion(dbName, tableName),
+	})
+}
+
+func (ctx *planCacheContext) Resolve(dbName string, tableName string) (*ObjectRef, *TableDef) {
+	ctx.addTable(dbName, tableName)
+	return ctx.CompilerContext.Resolve(dbName, tableName)
+}
+
+func (ctx *planCacheContext) GetPrimaryKeyDef(dbName string, tableName string) []*ColDef {
+	ctx.addTable(dbName, tableName)
+	return ctx.CompilerContext.GetPrimaryKeyDef(dbName, tableName)
+}
+
+func (ctx *planCacheContext) GetHideKeyDef(dbName string, tableName string) *ColDef {
+	ctx.addTable(dbName, tableName)
+	return ctx.CompilerContext.GetHideKeyDef(dbName, tableName)
+}
+
+func (ctx *planCacheContext) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
+	ctx.uncacheable = true
+	return ctx.CompilerContext.ResolveVariable(varName, isSystemVar, isGlobalVar)
+}
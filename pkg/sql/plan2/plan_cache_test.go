@@ -0,0 +1,106 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+// dbCompilerContext is the mock context of another default database
+type dbCompilerContext struct {
+	*MockCompilerContext
+	db string
+}
+
+func (ctx *dbCompilerContext) DefaultDatabase() string {
+	return ctx.db
+}
+
+func parseOneStmt(t *testing.T, sql string) tree.Statement {
+	stmts, err := mysql.Parse(sql)
+	require.NoError(t, err)
+	return stmts[0]
+}
+
+func TestPlanCache(t *testing.T) {
+	ctx := NewMockCompilerContext()
+	cache := NewPlanCache(2)
+	sql := "select n_name from nation where n_nationkey > 10"
+
+	// the plan is built once, then taken from the cache
+	first, err := BuildPlanCached(ctx, parseOneStmt(t, sql), cache)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		p, err := BuildPlanCached(ctx, parseOneStmt(t, sql), cache)
+		require.NoError(t, err)
+		require.Equal(t, first.String(), p.String())
+		// every execution gets its own plan
+		require.NotSame(t, first, p)
+	}
+	hits, misses := cache.Stats()
+	require.Equal(t, uint64(3), hits)
+	require.Equal(t, uint64(1), misses)
+
+	// a DDL on the table drops the plan
+	engine.BumpSchemaVersion("tpch", "nation")
+	_, err = BuildPlanCached(ctx, parseOneStmt(t, sql), cache)
+	require.NoError(t, err)
+	hits, misses = cache.Stats()
+	require.Equal(t, uint64(3), hits)
+	require.Equal(t, uint64(2), misses)
+
+	// and so does a DDL on its database
+	engine.BumpDatabaseSchemaVersion("tpch")
+	_, err = BuildPlanCached(ctx, parseOneStmt(t, sql), cache)
+	require.NoError(t, err)
+	_, misses = cache.Stats()
+	require.Equal(t, uint64(3), misses)
+
+	// but not a DDL on another table
+	engine.BumpSchemaVersion("tpch", "region")
+	_, err = BuildPlanCached(ctx, parseOneStmt(t, sql), cache)
+	require.NoError(t, err)
+	hits, misses = cache.Stats()
+	require.Equal(t, uint64(4), hits)
+	require.Equal(t, uint64(3), misses)
+
+	// the same statement of another default database is another plan
+	other := &dbCompilerContext{MockCompilerContext: ctx, db: "mo_catalog"}
+	require.NotEqual(t, PlanCacheKey(ctx.DefaultDatabase(), parseOneStmt(t, sql)),
+		PlanCacheKey(other.DefaultDatabase(), parseOneStmt(t, sql)))
+	_, err = BuildPlanCached(other, parseOneStmt(t, sql), cache)
+	require.NoError(t, err)
+	_, misses = cache.Stats()
+	require.Equal(t, uint64(4), misses)
+	require.Equal(t, 2, cache.Len())
+
+	// the least recently used plan is evicted
+	_, err = BuildPlanCached(ctx, parseOneStmt(t, "select r_name from region"), cache)
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.Len())
+	_, err = BuildPlanCached(ctx, parseOneStmt(t, sql), cache)
+	require.NoError(t, err)
+	_, misses = cache.Stats()
+	require.Equal(t, uint64(6), misses)
+
+	// the statements other than the queries are not cached
+	require.Equal(t, "", PlanCacheKey("tpch", parseOneStmt(t, "create table t (a int)")))
+	require.Equal(t, "", PlanCacheKey("tpch", parseOneStmt(t, "show tables")))
+}
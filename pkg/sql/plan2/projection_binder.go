@@ -38,7 +38,8 @@ func (b *ProjectionBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool)
 
 	if colPos, ok := b.ctx.groupByAst[astStr]; ok {
 		return &plan.Expr{
-			Typ: b.ctx.groups[colPos].Typ,
+			Typ:     b.ctx.groups[colPos].Typ,
+			ColName: astStr,
 			Expr: &plan.Expr_Col{
 				Col: &plan.ColRef{
 					RelPos: b.ctx.groupTag,
@@ -50,7 +51,8 @@ func (b *ProjectionBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool)
 
 	if colPos, ok := b.ctx.aggregateByAst[astStr]; ok {
 		return &plan.Expr{
-			Typ: b.ctx.aggregates[colPos].Typ,
+			Typ:     b.ctx.aggregates[colPos].Typ,
+			ColName: b.ctx.aggregates[colPos].ColName,
 			Expr: &plan.Expr_Col{
 				Col: &plan.ColRef{
 					RelPos: b.ctx.aggregateTag,
@@ -67,12 +67,20 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 	returnMap := make(map[int64][2]int32)
 
 	switch node.NodeType {
-	case plan.Node_TABLE_SCAN, plan.Node_MATERIAL_SCAN:
+	case plan.Node_TABLE_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_VALUE_SCAN, plan.Node_FUNCTION_SCAN:
+		if node.TableDef == nil {
+			//do nothing,  optimize can merge valueScan and project
+			break
+		}
 		tag := builder.tagsByNode[nodeId][0]
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			pruneCommitTSColumn(node, ctx.bindingByTag[tag])
+		}
 		node.ProjectList = make([]*Expr, len(node.TableDef.Cols))
 		for idx, col := range node.TableDef.Cols {
 			node.ProjectList[idx] = &Expr{
-				Typ: col.Typ,
+				Typ:     col.Typ,
+				ColName: col.Name,
 				Expr: &plan.Expr_Col{
 					Col: &ColRef{
 						RelPos: 0,
@@ -90,6 +98,9 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 		colIdx := 0
 		// use this colMap to reset OnList
 		thisColMap := make(map[int64][2]int32)
+		// semi and anti join only output the columns of the left side
+		leftJoinType := builder.qry.Nodes[node.Children[0]].JoinType
+		leftOnly := leftJoinType == plan.Node_SEMI || leftJoinType == plan.Node_ANTI
 		for idx, child := range node.Children {
 			colIdx = len(returnMap)
 
@@ -99,16 +110,28 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 			}
 
 			for k, v := range childMap {
-				returnMap[k] = [2]int32{0, int32(colIdx) + v[1]}
-
 				thisColV := v
 				thisColV[0] = int32(idx)
 				thisColMap[k] = thisColV
 			}
+			if idx > 0 && leftOnly {
+				continue
+			}
+			for k, v := range childMap {
+				returnMap[k] = [2]int32{0, int32(colIdx) + v[1]}
+			}
 
+			// an outer join pads the other side with nulls where the
+			// preserved side has no match
+			nullable := len(node.Children) == 2 && builder.qry.Nodes[node.Children[1-idx]].JoinType == plan.Node_OUTER
 			for prjIdx, prj := range builder.qry.Nodes[child].ProjectList {
+				typ := prj.Typ
+				if nullable && !typ.Nullable {
+					typ = nullableType(typ)
+				}
 				node.ProjectList = append(node.ProjectList, &Expr{
-					Typ: prj.Typ,
+					Typ:     typ,
+					ColName: prj.ColName,
 					Expr: &plan.Expr_Col{
 						Col: &ColRef{
 							RelPos: int32(idx),
@@ -143,7 +166,8 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 				return nil, err
 			}
 			node.ProjectList[colIdx] = &Expr{
-				Typ: expr.Typ,
+				Typ:     expr.Typ,
+				ColName: expr.ColName,
 				Expr: &plan.Expr_Col{
 					Col: &ColRef{
 						RelPos: -1,
@@ -162,7 +186,8 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 			}
 
 			node.ProjectList[colIdx] = &Expr{
-				Typ: expr.Typ,
+				Typ:     expr.Typ,
+				ColName: expr.ColName,
 				Expr: &plan.Expr_Col{
 					Col: &ColRef{
 						RelPos: -2,
@@ -190,7 +215,8 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 		for prjIdx, prjExpr := range preNode.ProjectList {
 			// node.ProjectList[prjIdx] = DeepCopyExpr(prjExpr)
 			node.ProjectList[prjIdx] = &Expr{
-				Typ: prjExpr.Typ,
+				Typ:     prjExpr.Typ,
+				ColName: prjExpr.ColName,
 				Expr: &plan.Expr_Col{
 					Col: &plan.ColRef{
 						RelPos: 0,
@@ -219,7 +245,8 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 			node.ProjectList = make([]*Expr, len(preNode.ProjectList))
 			for prjIdx, prjExpr := range preNode.ProjectList {
 				node.ProjectList[prjIdx] = &Expr{
-					Typ: prjExpr.Typ,
+					Typ:     prjExpr.Typ,
+					ColName: prjExpr.ColName,
 					Expr: &plan.Expr_Col{
 						Col: &plan.ColRef{
 							RelPos: 0,
@@ -240,8 +267,6 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 				returnMap[getColMapKey(ctx.projectTag, int32(idx))] = [2]int32{0, int32(idx)}
 			}
 		}
-	case plan.Node_VALUE_SCAN:
-		//do nothing,  optimize can merge valueScan and project
 	default:
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "unsupport node type to rebiuld query")
 	}
@@ -250,14 +275,32 @@ func (builder *QueryBuilder) resetNode(nodeId int32) (map[int64][2]int32, error)
 
 func (builder *QueryBuilder) createQuery() (*Query, error) {
 	for _, rootId := range builder.qry.Steps {
+		builder.chooseBuildSide(rootId)
+		builder.estimateCrossJoins(rootId)
 		_, err := builder.resetNode(rootId)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if err := builder.prunePartitions(); err != nil {
+		return nil, err
+	}
+	builder.chooseAccessPaths()
 	return builder.qry, nil
 }
 
+// lockTableScans marks the table scans under the node to lock the rows they
+// read, for SELECT ... FOR UPDATE
+func (builder *QueryBuilder) lockTableScans(nodeId int32) {
+	node := builder.qry.Nodes[nodeId]
+	if node.NodeType == plan.Node_TABLE_SCAN {
+		node.LockRows = true
+	}
+	for _, child := range node.Children {
+		builder.lockTableScans(child)
+	}
+}
+
 func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, isRoot bool) (int32, error) {
 	// build CTEs
 	err := builder.buildCTE(stmt.With, ctx)
@@ -281,6 +324,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	if err != nil {
 		return 0, err
 	}
+	if stmt.ForUpdate {
+		builder.lockTableScans(nodeId)
+	}
 
 	ctx.binder = NewWhereBinder(builder, ctx)
 
@@ -362,11 +408,30 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			return 0, err
 		}
 
-		nodeId = builder.appendNode(&plan.Node{
-			NodeType:  plan.Node_PROJECT,
-			Children:  []int32{nodeId},
-			WhereList: whereList,
-		}, ctx)
+		var notInList []*Expr
+		filterList := whereList[:0]
+		for _, expr := range whereList {
+			if builder.isNotInSubquery(expr) {
+				notInList = append(notInList, expr)
+			} else {
+				filterList = append(filterList, expr)
+			}
+		}
+
+		if len(filterList) > 0 {
+			nodeId = builder.appendNode(&plan.Node{
+				NodeType:  plan.Node_PROJECT,
+				Children:  []int32{nodeId},
+				WhereList: filterList,
+			}, ctx)
+		}
+
+		for _, expr := range notInList {
+			nodeId, err = builder.buildNotInJoin(nodeId, expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+		}
 	}
 
 	ctx.groupTag = builder.genNewTag()
@@ -684,12 +749,18 @@ func (builder *QueryBuilder) bindTableRef(schema string, table string, compCtx C
 	}
 
 	objRef, tableDef := compCtx.Resolve(schema, table)
+	if tableDef != nil {
+		tableDef = withCommitTSColumn(tableDef)
+	}
 	return objRef, tableDef, false
 }
 
 func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (nodeId int32, err error) {
 	switch tbl := stmt.(type) {
 	case *tree.Select:
+		if values, ok := tbl.Select.(*tree.ValuesClause); ok {
+			return builder.buildValues(values, ctx)
+		}
 		newCtx := NewBindContext(builder, ctx)
 		nodeId, err = builder.buildSelect(tbl, newCtx, false)
 		if len(newCtx.corrCols) > 0 {
@@ -729,6 +800,9 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 	case *tree.JoinTableExpr:
 		return builder.buildJoinTable(tbl, ctx)
 
+	case *tree.TableFunction:
+		return builder.buildTableFunction(tbl, ctx)
+
 	case *tree.ParenTableExpr:
 		return builder.buildTable(tbl.Expr, ctx)
 
@@ -764,7 +838,8 @@ func (builder *QueryBuilder) genNewTag() int32 {
 func (builder *QueryBuilder) addBinding(nodeId int32, alias tree.AliasClause, ctx *BindContext) error {
 	node := builder.qry.Nodes[nodeId]
 
-	if node.NodeType == plan.Node_VALUE_SCAN {
+	// the VALUE_SCAN of a table without FROM has no column
+	if node.NodeType == plan.Node_VALUE_SCAN && node.TableDef == nil {
 		return nil
 	}
 
@@ -772,7 +847,7 @@ func (builder *QueryBuilder) addBinding(nodeId int32, alias tree.AliasClause, ct
 	var types []*plan.Type
 	var binding *Binding
 
-	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_VALUE_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN {
 		if len(alias.Cols) > len(node.TableDef.Cols) {
 			return errors.New(errno.UndefinedColumn, fmt.Sprintf("table %q has %d columns available but %d columns specified", alias.Alias, len(node.TableDef.Cols), len(alias.Cols)))
 		}
@@ -801,6 +876,7 @@ func (builder *QueryBuilder) addBinding(nodeId int32, alias tree.AliasClause, ct
 		}
 
 		binding = NewBinding(builder.tagsByNode[nodeId][0], nodeId, table, cols, types)
+		binding.hidden = node.NodeType == plan.Node_TABLE_SCAN && hasCommitTSColumn(node.TableDef) && len(alias.Cols) < len(cols)
 	} else {
 		// Subquery
 		if len(alias.Cols) > len(node.ProjectList) {
@@ -913,42 +989,34 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		node.OnList = exprs
 
 	case *tree.UsingJoinCond:
-		for _, col := range cond.Cols {
-			expr, err := ctx.addUsingCol(string(col), joinType, leftCtx, rightCtx)
-			if err != nil {
-				return 0, err
-			}
-			node.OnList = append(node.OnList, expr)
+		usingCols := make([]string, len(cond.Cols))
+		for i, col := range cond.Cols {
+			usingCols[i] = string(col)
+		}
+		node.OnList, err = ctx.addUsingCols(usingCols, joinType, leftCtx, rightCtx)
+		if err != nil {
+			return 0, err
 		}
 
 	default:
 		if tbl.JoinType == tree.JOIN_TYPE_NATURAL || tbl.JoinType == tree.JOIN_TYPE_NATURAL_LEFT || tbl.JoinType == tree.JOIN_TYPE_NATURAL_RIGHT {
-			leftCols := make(map[string]any)
-			for _, binding := range leftCtx.bindings {
-				for _, col := range binding.cols {
-					leftCols[col] = nil
-				}
-			}
-
-			var usingCols []string
-			for _, binding := range rightCtx.bindings {
-				for _, col := range binding.cols {
-					if _, ok := leftCols[col]; ok {
-						usingCols = append(usingCols, col)
-					}
-				}
-			}
-
-			for _, col := range usingCols {
-				expr, err := ctx.addUsingCol(col, joinType, leftCtx, rightCtx)
-				if err != nil {
-					return 0, err
-				}
-				node.OnList = append(node.OnList, expr)
+			// without common columns a natural join is a cross join
+			usingCols := leftCtx.commonCols(rightCtx)
+			node.OnList, err = ctx.addUsingCols(usingCols, joinType, leftCtx, rightCtx)
+			if err != nil {
+				return 0, err
 			}
 		}
 	}
 
+	// the columns of the side padded with nulls are nullable above the join
+	if leftJoinType == plan.Node_OUTER {
+		rightCtx.setNullable()
+	}
+	if rightJoinType == plan.Node_OUTER {
+		leftCtx.setNullable()
+	}
+
 	// push down onlist
 	// when optimizer is ok, we can remove these code
 	builder.pushdownOnlist(node)
@@ -956,6 +1024,115 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 	return nodeId, nil
 }
 
+// isNotInSubquery returns true if expr is a NOT IN of an uncorrelated
+// subquery, which is planned as a null-aware anti join
+func (builder *QueryBuilder) isNotInSubquery(expr *Expr) bool {
+	notExpr, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || notExpr.F.Func.ObjName != "not" {
+		return false
+	}
+	inExpr, ok := notExpr.F.Args[0].Expr.(*plan.Expr_F)
+	if !ok || inExpr.F.Func.ObjName != "in" {
+		return false
+	}
+	sub, ok := inExpr.F.Args[1].Expr.(*plan.Expr_Sub)
+	if !ok || builder.isCorrelated(sub.Sub.NodeId) {
+		return false
+	}
+	cols := 1
+	if list, ok := inExpr.F.Args[0].Expr.(*plan.Expr_List); ok {
+		cols = len(list.List.List)
+	}
+	return cols == len(builder.ctxByNode[sub.Sub.NodeId].headings)
+}
+
+// buildNotInJoin builds the NOT IN expr as a null-aware anti join of the
+// node and the subquery, while NOT EXISTS stays a regular anti join
+func (builder *QueryBuilder) buildNotInJoin(nodeId int32, expr *Expr, ctx *BindContext) (int32, error) {
+	inExpr := expr.Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_F)
+	subId := inExpr.F.Args[1].Expr.(*plan.Expr_Sub).Sub.NodeId
+	subCtx := builder.ctxByNode[subId]
+
+	lefts := []*Expr{inExpr.F.Args[0]}
+	if list, ok := inExpr.F.Args[0].Expr.(*plan.Expr_List); ok {
+		lefts = list.List.List
+	}
+	onList := make([]*Expr, len(lefts))
+	for i, left := range lefts {
+		right := &plan.Expr{
+			Typ: subCtx.projects[i].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: subCtx.projectTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		cond, err := ctx.binder.(*WhereBinder).bindFuncExprImplByPlanExpr("=", []*Expr{left, right})
+		if err != nil {
+			return 0, err
+		}
+		onList[i] = cond
+	}
+
+	builder.qry.Nodes[nodeId].JoinType = plan.Node_ANTI
+	builder.qry.Nodes[subId].JoinType = plan.Node_INNER
+	return builder.appendNode(&plan.Node{
+		NodeType:  plan.Node_JOIN,
+		Children:  []int32{nodeId, subId},
+		OnList:    onList,
+		NullAware: true,
+	}, ctx), nil
+}
+
+// isCorrelated returns true if the subquery rooted at nodeId refers to the
+// columns of an outer query
+func (builder *QueryBuilder) isCorrelated(nodeId int32) bool {
+	node := builder.qry.Nodes[nodeId]
+	var exprs []*Expr
+	exprs = append(exprs, node.ProjectList...)
+	exprs = append(exprs, node.OnList...)
+	exprs = append(exprs, node.WhereList...)
+	exprs = append(exprs, node.GroupBy...)
+	exprs = append(exprs, node.AggList...)
+	for _, orderBy := range node.OrderBy {
+		exprs = append(exprs, orderBy.Expr)
+	}
+	for _, expr := range exprs {
+		if builder.hasCorrColRef(expr) {
+			return true
+		}
+	}
+	for _, child := range node.Children {
+		if builder.isCorrelated(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func (builder *QueryBuilder) hasCorrColRef(expr *Expr) bool {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Corr:
+		return true
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			if builder.hasCorrColRef(arg) {
+				return true
+			}
+		}
+	case *plan.Expr_List:
+		for _, item := range ne.List.List {
+			if builder.hasCorrColRef(item) {
+				return true
+			}
+		}
+	case *plan.Expr_Sub:
+		return builder.isCorrelated(ne.Sub.NodeId)
+	}
+	return false
+}
+
 func (builder *QueryBuilder) pushdownOnlist(node *Node) {
 	var toOnList []*Expr
 	var toWhereList []*Expr
@@ -965,7 +1142,7 @@ func (builder *QueryBuilder) pushdownOnlist(node *Node) {
 		isEqual := false
 		switch fun := expr.Expr.(type) {
 		case *plan.Expr_F:
-			isEqual = fun.F.Func.ObjName == "="
+			isEqual = fun.F.Func.ObjName == "=" || fun.F.Func.ObjName == "<=>"
 		}
 		if !isEqual {
 			toWhereList = append(toWhereList, DeepCopyExpr(expr))
@@ -0,0 +1,146 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// defaultSelectivity is the guessed selectivity of a filter which can not be
+// estimated from the statistics
+const defaultSelectivity = 0.1
+
+// chooseBuildSide swaps the children of the inner joins under the node, so
+// the hash table is built on the side with fewer estimated rows. It must be
+// called before the nodes are reset, when the columns are still referred by
+// the binding tags.
+func (builder *QueryBuilder) chooseBuildSide(nodeId int32) {
+	node := builder.qry.Nodes[nodeId]
+	for _, child := range node.Children {
+		builder.chooseBuildSide(child)
+	}
+	if node.NodeType != plan.Node_JOIN {
+		return
+	}
+	left, right := node.Children[0], node.Children[1]
+	if builder.qry.Nodes[left].JoinType != plan.Node_INNER || builder.qry.Nodes[right].JoinType != plan.Node_INNER {
+		return
+	}
+	// the right child is the build side
+	if builder.estimateRows(left) < builder.estimateRows(right) {
+		node.Children[0], node.Children[1] = right, left
+	}
+}
+
+// estimateCrossJoins sets the estimated number of the rows made by the cross
+// joins under the node, the inner joins without an equality to hash on, so
+// the compiler can refuse the ones too large. The rows are the product of
+// the children, as the residual filter of the join is applied after it. It
+// must be called before the nodes are reset, like chooseBuildSide.
+func (builder *QueryBuilder) estimateCrossJoins(nodeId int32) {
+	node := builder.qry.Nodes[nodeId]
+	for _, child := range node.Children {
+		builder.estimateCrossJoins(child)
+	}
+	if node.NodeType != plan.Node_JOIN || len(node.OnList) > 0 {
+		return
+	}
+	left, right := node.Children[0], node.Children[1]
+	if builder.qry.Nodes[left].JoinType != plan.Node_INNER || builder.qry.Nodes[right].JoinType != plan.Node_INNER {
+		return
+	}
+	node.Cost = &plan.Cost{Card: builder.estimateRows(left) * builder.estimateRows(right)}
+}
+
+// estimateRows returns the estimated number of the rows output by the node
+func (builder *QueryBuilder) estimateRows(nodeId int32) float64 {
+	node := builder.qry.Nodes[nodeId]
+	var rows float64
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		rows = builder.compCtx.Cost(node.ObjRef, nil).Card
+	case plan.Node_VALUE_SCAN:
+		rows = 1
+		if node.RowsetData != nil && len(node.RowsetData.Cols) > 0 {
+			rows = float64(node.RowsetData.Cols[0].RowCount)
+		}
+	case plan.Node_FUNCTION_SCAN:
+		rows = estimateTableFunctionRows(node)
+	case plan.Node_JOIN:
+		left := builder.estimateRows(node.Children[0])
+		right := builder.estimateRows(node.Children[1])
+		switch builder.qry.Nodes[node.Children[0]].JoinType {
+		case plan.Node_SEMI, plan.Node_ANTI:
+			rows = left
+		default:
+			rows = left * right
+			for _, expr := range node.OnList {
+				rows *= builder.estimateSelectivity(expr)
+			}
+		}
+	case plan.Node_AGG:
+		rows = builder.estimateRows(node.Children[0])
+		if len(node.GroupBy) == 0 {
+			rows = 1
+		}
+	default:
+		if len(node.Children) > 0 {
+			rows = builder.estimateRows(node.Children[0])
+		}
+	}
+	for _, expr := range node.WhereList {
+		rows *= builder.estimateSelectivity(expr)
+	}
+	return rows
+}
+
+// estimateSelectivity returns the estimated fraction of the rows passing the
+// filter, an equality passes 1/NDV of the rows
+func (builder *QueryBuilder) estimateSelectivity(expr *Expr) float64 {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok {
+		switch f.F.Func.ObjName {
+		case "and":
+			sel := 1.0
+			for _, arg := range f.F.Args {
+				sel *= builder.estimateSelectivity(arg)
+			}
+			return sel
+		case "=":
+			ndv := math.Max(builder.estimateNDV(f.F.Args[0]), builder.estimateNDV(f.F.Args[1]))
+			if ndv >= 1 {
+				return 1 / ndv
+			}
+		}
+	}
+	return defaultSelectivity
+}
+
+// estimateNDV returns the NDV of the table column referred by the expr, 0 if
+// it is unknown
+func (builder *QueryBuilder) estimateNDV(expr *Expr) float64 {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return 0
+	}
+	for nodeId, node := range builder.qry.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN || builder.tagsByNode[nodeId][0] != col.Col.RelPos {
+			continue
+		}
+		return builder.compCtx.ColumnNDV(node.ObjRef, node.TableDef.Cols[col.Col.ColPos].Name)
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinBuildSideByNDV(t *testing.T) {
+	sql := "select n_name, r_name from nation join region on n_regionkey = r_regionkey and n_name = 'a' and r_name = 'b'"
+	buildSide := func(ndvs map[string]map[string]float64) string {
+		mock := NewMockOptimizer()
+		mock.ctxt.ndvs = ndvs
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		qry := logicPlan.GetQuery()
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_JOIN {
+				return qry.Nodes[node.Children[1]].ObjRef.ObjName
+			}
+		}
+		t.Fatal("join not found")
+		return ""
+	}
+
+	// both tables have the same rows, the side with the more selective
+	// filter is the build side
+	require.Equal(t, "nation", buildSide(map[string]map[string]float64{
+		"nation": {"n_name": 1000},
+		"region": {"r_name": 10},
+	}))
+	require.Equal(t, "region", buildSide(map[string]map[string]float64{
+		"nation": {"n_name": 10},
+		"region": {"r_name": 1000},
+	}))
+	// without NDV the filters are guessed to be equally selective
+	require.Equal(t, "region", buildSide(nil))
+}
+
+func TestCrossJoinRows(t *testing.T) {
+	crossJoinCards := func(sql string) []float64 {
+		mock := NewMockOptimizer()
+		mock.ctxt.ndvs = map[string]map[string]float64{"region": {"r_name": 1000}}
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		var cards []float64
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_JOIN && node.Cost != nil {
+				cards = append(cards, node.Cost.Card)
+			}
+		}
+		return cards
+	}
+
+	// every table has 1e6 rows in the mock
+	require.Equal(t, []float64{1e12}, crossJoinCards("select * from nation, region"))
+	// the residual filter is applied after the product
+	require.Equal(t, []float64{1e12}, crossJoinCards("select * from nation join region on n_regionkey < r_regionkey"))
+	// the filters of the sides are applied before it
+	require.Equal(t, []float64{1e9}, crossJoinCards("select * from nation, (select * from region where r_name = 'a') r"))
+	require.Empty(t, crossJoinCards("select * from nation join region on n_regionkey = r_regionkey"))
+}
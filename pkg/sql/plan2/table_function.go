@@ -0,0 +1,200 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// A table function in the FROM clause is a FUNCTION_SCAN node. The ObjRef of
+// the node names the function, the TableDef holds the columns of its result
+// and the TblFuncExprList holds its bound arguments, which are evaluated once
+// when the node is compiled. The rows are produced by the reader of the
+// function in batches.
+
+// GenerateSeriesName is the name of the table function generate_series and
+// of its only column
+const GenerateSeriesName = "generate_series"
+
+// TableFunctionBinder binds an argument of a table function, the argument is
+// a constant expression
+type TableFunctionBinder struct {
+	baseBinder
+}
+
+func NewTableFunctionBinder() *TableFunctionBinder {
+	b := &TableFunctionBinder{}
+	b.impl = b
+	return b
+}
+
+func (b *TableFunctionBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindExpr(astExpr, depth)
+}
+
+func (b *TableFunctionBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnReference, "column name not allowed in table function arguments")
+}
+
+func (b *TableFunctionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.GroupingError, "aggregate functions not allowed in table function arguments")
+}
+
+func (b *TableFunctionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.WindowingError, "window functions not allowed in table function arguments")
+}
+
+func (b *TableFunctionBinder) BindSubquery(astExpr *tree.Subquery) (*plan.Expr, error) {
+	return nil, errors.New(errno.SQLStatementNotYetComplete, "subquery not allowed in table function arguments")
+}
+
+// buildTableFunction appends the FUNCTION_SCAN node of a table function
+func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *BindContext) (int32, error) {
+	name := tbl.Func.Func.FunctionReference.(*tree.UnresolvedName).Parts[0]
+	binder := NewTableFunctionBinder()
+	args := make([]*Expr, len(tbl.Func.Exprs))
+	for i, astExpr := range tbl.Func.Exprs {
+		expr, err := binder.BindExpr(astExpr, 0, true)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = expr
+	}
+
+	var col *ColDef
+	var err error
+	switch name {
+	case GenerateSeriesName:
+		col, args, err = bindGenerateSeries(args)
+	default:
+		err = errors.New(errno.UndefinedFunction, fmt.Sprintf("table function '%s' is not implemented", name))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		ObjRef: &ObjectRef{
+			ObjName: name,
+		},
+		TableDef: &TableDef{
+			Name: name,
+			Cols: []*ColDef{col},
+		},
+		TblFuncExprList: args,
+	}, ctx, builder.genNewTag()), nil
+}
+
+// bindGenerateSeries returns the column and the arguments of
+// generate_series(start, stop[, step]). An integer series has the int64
+// arguments start, stop and the optional step, whose default is 1 or -1 by
+// the order of start and stop. A datetime series has an INTERVAL step, and
+// its arguments are the datetimes start, stop and the number and the unit of
+// the step.
+func bindGenerateSeries(args []*Expr) (*ColDef, []*Expr, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "generate_series needs two or three arguments")
+	}
+	var err error
+	if len(args) == 3 && args[2].Typ.Id == plan.Type_INTERVAL {
+		num, unit, err := getIntervalValue(args[2])
+		if err != nil {
+			return nil, nil, err
+		}
+		typ := &Type{Id: plan.Type_DATETIME, Size: 8}
+		for i := 0; i < 2; i++ {
+			if args[i], err = castGenerateSeriesArg(args[i], typ, isTemporalType(args[i].Typ) || isStringType(args[i].Typ)); err != nil {
+				return nil, nil, err
+			}
+		}
+		args = append(args[:2], int64Const(num), int64Const(int64(unit)))
+		return &ColDef{Name: GenerateSeriesName, Typ: typ}, args, nil
+	}
+
+	typ := &Type{Id: plan.Type_INT64, Size: 8}
+	for i, arg := range args {
+		if isTemporalType(arg.Typ) {
+			return nil, nil, errors.New(errno.DatatypeMismatch, "the step of a datetime generate_series must be an INTERVAL")
+		}
+		isInteger := isSignedIntegerType(arg.Typ) || isUnsignedIntegerType(arg.Typ)
+		if args[i], err = castGenerateSeriesArg(arg, typ, isInteger); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &ColDef{Name: GenerateSeriesName, Typ: typ}, args, nil
+}
+
+func int64Const(v int64) *Expr {
+	return &Expr{
+		Expr: &plan.Expr_C{
+			C: &Const{
+				Value: &plan.Const_Ival{
+					Ival: v,
+				},
+			},
+		},
+		Typ: &plan.Type{
+			Id:   plan.Type_INT64,
+			Size: 8,
+		},
+	}
+}
+
+// castGenerateSeriesArg casts an argument of generate_series to the type of
+// the series, a NULL argument is kept and makes the series empty
+func castGenerateSeriesArg(arg *Expr, typ *Type, ok bool) (*Expr, error) {
+	if arg.Typ.Id == plan.Type_ANY || arg.Typ.Id == typ.Id {
+		return arg, nil
+	}
+	if !ok {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("generate_series does not support the argument type %s", arg.Typ.Id))
+	}
+	return appendCastBeforeExpr(arg, typ)
+}
+
+// estimateTableFunctionRows returns the estimated number of the rows of a
+// FUNCTION_SCAN, the exact count of a series of integer constants
+func estimateTableFunctionRows(node *Node) float64 {
+	rows := 1000.0
+	if node.ObjRef.GetObjName() != GenerateSeriesName || node.TableDef.Cols[0].Typ.Id != plan.Type_INT64 {
+		return rows
+	}
+	var vs [3]int64
+	vs[2] = 1
+	for i, arg := range node.TblFuncExprList {
+		c, ok := arg.Expr.(*plan.Expr_C)
+		if !ok || c.C.Isnull {
+			return rows
+		}
+		vs[i] = c.C.GetIval()
+	}
+	if len(node.TblFuncExprList) == 2 && vs[1] < vs[0] {
+		vs[2] = -1
+	}
+	if vs[2] == 0 {
+		return rows
+	}
+	start, stop, step := float64(vs[0]), float64(vs[1]), float64(vs[2])
+	if (stop-start)/step < 0 {
+		return 1
+	}
+	return (stop-start)/step + 1
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func functionScan(t *testing.T, logicPlan *Plan) *Node {
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_FUNCTION_SCAN {
+			return node
+		}
+	}
+	t.Fatal("no FUNCTION scan")
+	return nil
+}
+
+func TestGenerateSeries(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "select * from generate_series(1, 10, 3)")
+	require.NoError(t, err)
+	require.Equal(t, []string{"generate_series"}, logicPlan.GetQuery().GetHeadings())
+	node := functionScan(t, logicPlan)
+	require.Equal(t, "generate_series", node.ObjRef.ObjName)
+	require.Equal(t, plan.Type_INT64, node.TableDef.Cols[0].Typ.Id)
+	require.Len(t, node.TblFuncExprList, 3)
+	require.Equal(t, float64(4), estimateTableFunctionRows(node))
+
+	// a datetime series has the number and the unit of its INTERVAL step
+	logicPlan, err = runOneStmt(mock, t, "select g.d from generate_series('2022-01-01', '2022-01-31', interval 2 day) g(d)")
+	require.NoError(t, err)
+	require.Equal(t, []string{"d"}, logicPlan.GetQuery().GetHeadings())
+	node = functionScan(t, logicPlan)
+	require.Equal(t, plan.Type_DATETIME, node.TableDef.Cols[0].Typ.Id)
+	require.Len(t, node.TblFuncExprList, 4)
+	require.Equal(t, int64(2), node.TblFuncExprList[2].GetC().GetIval())
+	require.Equal(t, int64(types.Day), node.TblFuncExprList[3].GetC().GetIval())
+
+	// joined with a table
+	_, err = runOneStmt(mock, t, "select n_name from nation join generate_series(0, 4) g on n_nationkey = g.generate_series")
+	require.NoError(t, err)
+
+	for _, sql := range []string{
+		"select * from generate_series(1)",
+		"select * from generate_series(1, 2, 3, 4)",
+		"select * from generate_series(1.5, 3)",
+		"select * from generate_series('a', 'b')",
+		"select * from generate_series(date '2022-01-01', date '2022-01-02')",
+		"select * from generate_series(1, n_nationkey)",
+		"select * from no_such_function(1, 2)",
+	} {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// TTLPropertyKey is the table property holding the TTL of the rows of a
+// table, which is the TTL column and the seconds separated by comma. The rows
+// whose TTL column is older than the TTL are deleted in the background.
+const TTLPropertyKey = "ttl"
+
+// RowChecksumPropertyKey is the table property set by CHECKSUM = 1, the
+// storage maintains a checksum of every row of the table.
+const RowChecksumPropertyKey = "row_checksum"
+
+var ttlUnitSeconds = map[string]int64{
+	"SECOND": 1,
+	"MINUTE": 60,
+	"HOUR":   60 * 60,
+	"DAY":    24 * 60 * 60,
+	"WEEK":   7 * 24 * 60 * 60,
+}
+
+// buildTTLProperty checks the TTL option of the table and returns its property
+func buildTTLProperty(opt *tree.TableOptionTTL, tableDef *TableDef) (*plan.Property, error) {
+	var col *ColDef
+	for _, c := range tableDef.Cols {
+		if strings.EqualFold(c.Name, opt.Column) {
+			col = c
+			break
+		}
+	}
+	if col == nil {
+		return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("TTL column '%s' does not exist", opt.Column))
+	}
+	switch col.Typ.Id {
+	case plan.Type_DATE, plan.Type_DATETIME, plan.Type_TIMESTAMP:
+	default:
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("TTL column '%s' of type %s is not a date, datetime or timestamp", col.Name, col.Typ.Id))
+	}
+	unit, ok := ttlUnitSeconds[strings.ToUpper(opt.Unit)]
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("TTL interval unit '%s' is not supported", opt.Unit))
+	}
+	if opt.Value <= 0 {
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("TTL interval %d must be positive", opt.Value))
+	}
+	return &plan.Property{
+		Key:   TTLPropertyKey,
+		Value: fmt.Sprintf("%s,%d", col.Name, opt.Value*unit),
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableTTL(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table t (a int, TS datetime) ttl(ts, interval 2 day)")
+	require.NoError(t, err)
+	value, ok := getTableProperty(logicPlan.GetDdl().GetCreateTable().GetTableDef(), TTLPropertyKey)
+	require.True(t, ok)
+	require.Equal(t, "ts,172800", value)
+
+	for _, sql := range []string{
+		"create table t (a int, ts datetime) ttl(b, interval 1 day)",
+		"create table t (a int, ts varchar(10)) ttl(ts, interval 1 day)",
+		"create table t (a int, ts date) ttl(ts, interval 1 month)",
+		"create table t (a int, ts timestamp) ttl(ts, interval 0 second)",
+	} {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestCreateTableRowChecksum(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "create table t (a int, b varchar(10)) checksum = 1")
+	require.NoError(t, err)
+	value, ok := getTableProperty(logicPlan.GetDdl().GetCreateTable().GetTableDef(), RowChecksumPropertyKey)
+	require.True(t, ok)
+	require.Equal(t, "1", value)
+
+	logicPlan, err = runOneStmt(mock, t, "create table t (a int) checksum = 0")
+	require.NoError(t, err)
+	_, ok = getTableProperty(logicPlan.GetDdl().GetCreateTable().GetTableDef(), RowChecksumPropertyKey)
+	require.False(t, ok)
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 type TableDef = plan.TableDef
@@ -39,6 +40,8 @@ type CompilerContext interface {
 	DefaultDatabase() string
 	// check if database exist
 	DatabaseExists(name string) bool
+	// get the default charset and collation of the tables created in database
+	DatabaseDefaults(name string) engine.DatabaseDefaults
 	// get table definition by database/schema
 	Resolve(schemaName string, tableName string) (*ObjectRef, *TableDef)
 	// get the value of variable
@@ -49,6 +52,14 @@ type CompilerContext interface {
 	GetHideKeyDef(dbName string, tableName string) *ColDef
 	// get estimated cost by table & expr
 	Cost(obj *ObjectRef, e *Expr) *Cost
+	// get the estimated number of distinct values of a column, 0 if unknown
+	ColumnNDV(obj *ObjectRef, colName string) float64
+	// get the access paths to read the rows whose column equals the value,
+	// with their estimated work
+	AccessPaths(obj *ObjectRef, colName string, value any) []engine.PathEstimate
+	// get the access paths to read the rows whose column is in [min, max],
+	// with their estimated work. A nil bound is unbounded.
+	RangeAccessPaths(obj *ObjectRef, colName string, min, max any) []engine.PathEstimate
 }
 
 type Optimizer interface {
@@ -220,4 +231,7 @@ type Binding struct {
 	types       []*plan.Type
 	refCnts     []uint
 	colIdByName map[string]int32
+	// hidden is set if the last column is the commit ts pseudo column of a
+	// table scan, which is not unfolded by a star
+	hidden bool
 }
@@ -0,0 +1,249 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// A VALUES table constructor is a VALUE_SCAN node whose rowset data holds the
+// literal rows. The type of a column is unified across the rows: a string
+// column if any value is a string, else a float64 column if any value is a
+// float, else an int64 column, and a bool column if all values are bools.
+// The values are converted to the type of their column when the node is
+// built, and the NULLs are recorded in the nulls of the column data.
+
+// ValuesBinder binds a value of a VALUES table constructor, the value is a
+// constant
+type ValuesBinder struct {
+	baseBinder
+}
+
+func NewValuesBinder() *ValuesBinder {
+	b := &ValuesBinder{}
+	b.impl = b
+	return b
+}
+
+func (b *ValuesBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindExpr(astExpr, depth)
+}
+
+func (b *ValuesBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnReference, "column name not allowed in VALUES")
+}
+
+func (b *ValuesBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.GroupingError, "aggregate functions not allowed in VALUES")
+}
+
+func (b *ValuesBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	return nil, errors.New(errno.WindowingError, "window functions not allowed in VALUES")
+}
+
+func (b *ValuesBinder) BindSubquery(astExpr *tree.Subquery) (*plan.Expr, error) {
+	return nil, errors.New(errno.SQLStatementNotYetComplete, "subquery not allowed in VALUES")
+}
+
+// buildValues appends the VALUE_SCAN node of a VALUES table constructor, the
+// columns are named column_0, column_1 ... like mysql
+func (builder *QueryBuilder) buildValues(clause *tree.ValuesClause, ctx *BindContext) (int32, error) {
+	colCount := len(clause.Rows[0])
+	if colCount == 0 {
+		return 0, errors.New(errno.SyntaxErrororAccessRuleViolation, "a row of VALUES must have at least one value")
+	}
+	binder := NewValuesBinder()
+	consts := make([][]*Const, len(clause.Rows))
+	typs := make([]*Type, colCount)
+	for i, row := range clause.Rows {
+		if len(row) != colCount {
+			return 0, errors.New(errno.InvalidColumnReference, fmt.Sprintf("Column count doesn't match value count at row %d", i+1))
+		}
+		consts[i] = make([]*Const, colCount)
+		for j, astExpr := range row {
+			expr, err := binder.BindExpr(astExpr, 0, true)
+			if err != nil {
+				return 0, err
+			}
+			c, ok := foldValuesConstant(expr)
+			if !ok {
+				return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("value of VALUES must be a constant: '%s'", tree.String(astExpr, dialect.MYSQL)))
+			}
+			consts[i][j] = c
+			typs[j] = unifyValuesType(typs[j], expr.Typ)
+		}
+	}
+
+	schema := &TableDef{
+		Cols: make([]*ColDef, colCount),
+	}
+	rowset := &RowsetData{
+		Schema: schema,
+		Cols:   make([]*plan.ColData, colCount),
+	}
+	for j, typ := range typs {
+		if typ.Id == plan.Type_ANY {
+			// a column of NULLs only
+			typ = &Type{Id: plan.Type_INT64, Size: 8}
+		}
+		typ.Nullable = false
+		col := &plan.ColData{
+			RowCount: int32(len(consts)),
+		}
+		for i := range consts {
+			c := consts[i][j]
+			if c.Isnull {
+				typ.Nullable = true
+				if col.Nulls == nil {
+					col.Nulls = make([]bool, len(consts))
+				}
+				col.Nulls[i] = true
+				col.NullCount++
+			}
+			appendValuesConstant(col, typ, c)
+		}
+		schema.Cols[j] = &ColDef{
+			Name: fmt.Sprintf("column_%d", j),
+			Typ:  typ,
+		}
+		rowset.Cols[j] = col
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_VALUE_SCAN,
+		TableDef:   schema,
+		RowsetData: rowset,
+	}, ctx, builder.genNewTag()), nil
+}
+
+// foldValuesConstant returns the constant of a bound value, the sign of a
+// number is folded
+func foldValuesConstant(expr *Expr) (*Const, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		return e.C, true
+	case *plan.Expr_F:
+		if len(e.F.Args) != 1 {
+			return nil, false
+		}
+		c, ok := foldValuesConstant(e.F.Args[0])
+		if !ok {
+			return nil, false
+		}
+		switch e.F.Func.GetObjName() {
+		case "unary_plus":
+			return c, true
+		case "unary_minus":
+			if c.Isnull {
+				return c, true
+			}
+			switch v := c.Value.(type) {
+			case *plan.Const_Ival:
+				return &Const{Value: &plan.Const_Ival{Ival: -v.Ival}}, true
+			case *plan.Const_Dval:
+				return &Const{Value: &plan.Const_Dval{Dval: -v.Dval}}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// unifyValuesType returns the type of a column holding the values of both
+// types, the type of a NULL is ANY
+func unifyValuesType(typ, other *Type) *Type {
+	if typ == nil || typ.Id == plan.Type_ANY {
+		return &Type{
+			Id:        other.Id,
+			Width:     other.Width,
+			Size:      other.Size,
+			Precision: other.Precision,
+		}
+	}
+	if other.Id == plan.Type_ANY || other.Id == typ.Id {
+		return typ
+	}
+	switch {
+	case isStringType(typ):
+		return typ
+	case isStringType(other):
+		return unifyValuesType(nil, other)
+	case typ.Id == plan.Type_FLOAT64 || other.Id == plan.Type_FLOAT64:
+		return &Type{Id: plan.Type_FLOAT64, Size: 8}
+	default:
+		return &Type{Id: plan.Type_INT64, Size: 8}
+	}
+}
+
+// appendValuesConstant appends the constant converted to the type to the
+// column data. The bools are the int32 0 and 1, and the NULLs are the zero
+// values of the type.
+func appendValuesConstant(col *plan.ColData, typ *Type, c *Const) {
+	switch typ.Id {
+	case plan.Type_BOOL:
+		var v int32
+		if b, ok := c.Value.(*plan.Const_Bval); ok && b.Bval {
+			v = 1
+		}
+		col.I32 = append(col.I32, v)
+	case plan.Type_INT64:
+		var v int64
+		switch cv := c.Value.(type) {
+		case *plan.Const_Ival:
+			v = cv.Ival
+		case *plan.Const_Bval:
+			if cv.Bval {
+				v = 1
+			}
+		}
+		col.I64 = append(col.I64, v)
+	case plan.Type_FLOAT64:
+		var v float64
+		switch cv := c.Value.(type) {
+		case *plan.Const_Ival:
+			v = float64(cv.Ival)
+		case *plan.Const_Dval:
+			v = cv.Dval
+		case *plan.Const_Bval:
+			if cv.Bval {
+				v = 1
+			}
+		}
+		col.F64 = append(col.F64, v)
+	default:
+		var v string
+		switch cv := c.Value.(type) {
+		case *plan.Const_Ival:
+			v = strconv.FormatInt(cv.Ival, 10)
+		case *plan.Const_Dval:
+			v = strconv.FormatFloat(cv.Dval, 'g', -1, 64)
+		case *plan.Const_Bval:
+			if cv.Bval {
+				v = "1"
+			} else {
+				v = "0"
+			}
+		case *plan.Const_Sval:
+			v = cv.Sval
+		}
+		col.S = append(col.S, v)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func valuesScan(t *testing.T, logicPlan *Plan) *Node {
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_VALUE_SCAN && node.RowsetData != nil {
+			return node
+		}
+	}
+	t.Fatal("no VALUES scan")
+	return nil
+}
+
+func TestValuesTable(t *testing.T) {
+	mock := NewMockOptimizer()
+	logicPlan, err := runOneStmt(mock, t, "select a, b, c, d from (values (1, 'x', true, null), (2.5, 3, false, null), (-4, null, true, null)) as t(a, b, c, d)")
+	require.NoError(t, err)
+	node := valuesScan(t, logicPlan)
+	cols := node.TableDef.Cols
+	require.Equal(t, plan.Type_FLOAT64, cols[0].Typ.Id)
+	require.Equal(t, plan.Type_VARCHAR, cols[1].Typ.Id)
+	require.Equal(t, plan.Type_BOOL, cols[2].Typ.Id)
+	require.Equal(t, plan.Type_INT64, cols[3].Typ.Id)
+	require.False(t, cols[0].Typ.Nullable)
+	require.True(t, cols[1].Typ.Nullable)
+
+	data := node.RowsetData.Cols
+	require.Equal(t, []float64{1, 2.5, -4}, data[0].F64)
+	require.Equal(t, []string{"x", "3", ""}, data[1].S)
+	require.Equal(t, []bool{false, false, true}, data[1].Nulls)
+	require.Equal(t, int32(1), data[1].NullCount)
+	require.Equal(t, []int32{1, 0, 1}, data[2].I32)
+	require.Equal(t, int32(3), data[3].NullCount)
+
+	// the columns not aliased keep their default names
+	logicPlan, err = runOneStmt(mock, t, "select t.a, column_1 from (values row(1, 2)) t(a)")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "column_1"}, logicPlan.GetQuery().GetHeadings())
+
+	// joined with a table
+	_, err = runOneStmt(mock, t, "select n_name, t.x from nation join (values (1, 'a'), (2, 'b')) as t(k, x) on n_nationkey = t.k")
+	require.NoError(t, err)
+
+	for _, sql := range []string{
+		"select * from (values (1, 2), (3)) as t(a, b)",
+		"select * from (values (1), (2)) as t(a, b)",
+		"select * from (values (n_nationkey)) as t(a)",
+		"select * from (values (abs(1))) as t(a)",
+		"select * from (values (1)) as t(a) join (values (2)) as t(b)",
+	} {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// Like mysql, the value of a user variable is an integer, a float, a
+// decimal, a string or NULL. A variable in a query is bound as the constant
+// of its value when the query is planned, the plan referencing a variable
+// is never cached.
+
+// DecimalVar is the value of a decimal variable, the text of the decimal
+// and the precision and scale of its type.
+type DecimalVar struct {
+	Text  string
+	Width int32
+	Scale int32
+}
+
+func (d DecimalVar) String() string {
+	return d.Text
+}
+
+// Type returns the decimal type of the value.
+func (d DecimalVar) Type() *plan.Type {
+	if d.Width > 18 {
+		return &plan.Type{Id: plan.Type_DECIMAL128, Size: 16, Width: d.Width, Scale: d.Scale}
+	}
+	return &plan.Type{Id: plan.Type_DECIMAL64, Size: 8, Width: d.Width, Scale: d.Scale}
+}
+
+// bindVariable binds the variable as the constant of its current value.
+func bindVariable(ctx CompilerContext, astExpr *tree.VarExpr) (*Expr, error) {
+	val, err := ctx.ResolveVariable(astExpr.Name, astExpr.System, astExpr.Global)
+	if err != nil {
+		return nil, err
+	}
+	return variableConst(astExpr, val)
+}
+
+func variableConst(astExpr *tree.VarExpr, val interface{}) (*Expr, error) {
+	switch v := val.(type) {
+	case nil:
+		return &Expr{
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Isnull: true,
+				},
+			},
+			Typ: &plan.Type{
+				Id:       plan.Type_ANY,
+				Nullable: true,
+			},
+		}, nil
+	case bool:
+		return &Expr{
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Value: &plan.Const_Bval{
+						Bval: v,
+					},
+				},
+			},
+			Typ: &plan.Type{
+				Id:   plan.Type_BOOL,
+				Size: 1,
+			},
+		}, nil
+	case int64:
+		return &Expr{
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Value: &plan.Const_Ival{
+						Ival: v,
+					},
+				},
+			},
+			Typ: &plan.Type{
+				Id:   plan.Type_INT64,
+				Size: 8,
+			},
+		}, nil
+	case uint64:
+		if v <= math.MaxInt64 {
+			return variableConst(astExpr, int64(v))
+		}
+		return appendCastBeforeExpr(stringConst(fmt.Sprintf("%d", v)), &plan.Type{
+			Id:   plan.Type_UINT64,
+			Size: 8,
+		})
+	case float64:
+		return &Expr{
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Value: &plan.Const_Dval{
+						Dval: v,
+					},
+				},
+			},
+			Typ: &plan.Type{
+				Id:   plan.Type_FLOAT64,
+				Size: 8,
+			},
+		}, nil
+	case string:
+		return stringConst(v), nil
+	case DecimalVar:
+		// the decimal constant is kept as its text
+		expr := stringConst(v.Text)
+		expr.Typ = v.Type()
+		return expr, nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the value '%v' of variable '%s' is not supported", val, tree.String(astExpr, dialect.MYSQL)))
+}
+
+func stringConst(s string) *Expr {
+	return &Expr{
+		Expr: &plan.Expr_C{
+			C: &Const{
+				Value: &plan.Const_Sval{
+					Sval: s,
+				},
+			},
+		},
+		Typ: &plan.Type{
+			Id:    plan.Type_VARCHAR,
+			Size:  4,
+			Width: math.MaxInt32,
+		},
+	}
+}
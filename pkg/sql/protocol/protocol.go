@@ -918,63 +918,72 @@ func DecodeBatch(data []byte) (*batch.Batch, []byte, error) {
 	return bat, data, nil
 }
 
-func DecodeBatchWithProcess(data []byte, proc *process.Process) (*batch.Batch, []byte, error) {
-	bat := batch.New(true, []string{})
-	// SelsData
-	n := encoding.DecodeUint32(data[:4])
-	data = data[4:]
-	bat.SelsData = data[:n]
-	data = data[n:]
+// EncodeRemoteBatch encodes a batch shipped by a remote scope, the columns
+// are in the format of batch.Encode, followed by the selection list and the
+// aggregation states of the batch.
+func EncodeRemoteBatch(bat *batch.Batch, buf *bytes.Buffer) error {
+	if err := batch.EncodeTo(buf, bat); err != nil {
+		return err
+	}
 	// Sels
-	sn := encoding.DecodeUint32(data[:4])
-	data = data[4:]
+	sn := len(bat.Sels)
+	buf.Write(encoding.EncodeUint32(uint32(sn)))
 	if sn > 0 {
-		bat.Sels = encoding.DecodeInt64Slice(data[:sn*8])
-		data = data[sn*8:]
+		buf.Write(encoding.EncodeInt64Slice(bat.Sels))
 	}
-	// Attrs
-	if n := encoding.DecodeUint32(data); n > 0 {
-		data = data[4:]
-		if err := encoding.Decode(data[:n], &bat.Attrs); err != nil {
-			return nil, nil, err
-		}
-		data = data[n:]
-	} else {
-		data = data[4:]
+	// As
+	data, err := encoding.Encode(bat.As)
+	if err != nil {
+		return err
 	}
-	// Vecs
-	if n := encoding.DecodeUint32(data); n > 0 {
-		data = data[4:]
-		for i := uint32(0); i < n; i++ {
-			vec, remaining, err := DecodeVector(data)
-			if err != nil {
-				return nil, nil, err
-			}
-			bat.Vecs = append(bat.Vecs, vec)
-			data = remaining
-		}
-		var err error
-		for i, vec := range bat.Vecs {
-			if bat.Vecs[i], err = vector.Dup(vec, proc.Mp); err != nil {
-				return nil, nil, err
-			}
+	buf.Write(encoding.EncodeUint32(uint32(len(data))))
+	buf.Write(data)
+	// Refs
+	rn := len(bat.Refs)
+	buf.Write(encoding.EncodeUint32(uint32(rn)))
+	if rn > 0 {
+		buf.Write(encoding.EncodeUint64Slice(bat.Refs))
+	}
+	// Rs
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Rs))))
+	for _, r := range bat.Rs {
+		if err := EncodeRing(r, buf); err != nil {
+			return err
 		}
-	} else {
-		data = data[4:]
 	}
-	// Zs
-	zn := encoding.DecodeUint32(data[:4])
+	return nil
+}
+
+// DecodeRemoteBatch decodes a batch encoded by EncodeRemoteBatch, the
+// batch is allocated from the memory of the process.
+func DecodeRemoteBatch(data []byte, proc *process.Process) (*batch.Batch, error) {
+	r := bytes.NewReader(data)
+	bat, err := batch.DecodeFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	if bat, err = batch.Clone(bat, proc.Mp); err != nil {
+		return nil, err
+	}
+	data = data[len(data)-r.Len():]
+	// Sels
+	sn := encoding.DecodeUint32(data[:4])
 	data = data[4:]
-	if zn > 0 {
-		bat.Zs = make([]int64, zn)
-		copy(bat.Zs, encoding.DecodeInt64Slice(data[:zn*8]))
-		data = data[zn*8:]
+	if sn > 0 {
+		if bat.SelsData, err = mheap.Alloc(proc.Mp, int64(sn)*8); err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
+		}
+		bat.Sels = encoding.DecodeInt64Slice(bat.SelsData)
+		copy(bat.SelsData, data[:sn*8])
+		data = data[sn*8:]
 	}
 	// As
 	if n := encoding.DecodeUint32(data); n > 0 {
 		data = data[4:]
 		if err := encoding.Decode(data[:n], &bat.As); err != nil {
-			return nil, nil, err
+			batch.Clean(bat, proc.Mp)
+			return nil, err
 		}
 		data = data[n:]
 	} else {
@@ -984,23 +993,23 @@ func DecodeBatchWithProcess(data []byte, proc *process.Process) (*batch.Batch, [
 	rn := encoding.DecodeUint32(data[:4])
 	data = data[4:]
 	if rn > 0 {
-		bat.Refs = encoding.DecodeUint64Slice(data[:rn*8])
+		bat.Refs = make([]uint64, rn)
+		copy(bat.Refs, encoding.DecodeUint64Slice(data[:rn*8]))
 		data = data[rn*8:]
 	}
 	// Rs
-	n = encoding.DecodeUint32(data[:4])
+	n := encoding.DecodeUint32(data[:4])
 	data = data[4:]
-	if n > 0 {
-		for i := uint32(0); i < n; i++ {
-			r, d, err := DecodeRingWithProcess(data, proc)
-			if err != nil {
-				return nil, nil, err
-			}
-			data = d
-			bat.Rs = append(bat.Rs, r)
+	for i := uint32(0); i < n; i++ {
+		r, d, err := DecodeRingWithProcess(data, proc)
+		if err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
 		}
+		data = d
+		bat.Rs = append(bat.Rs, r)
 	}
-	return bat, data, nil
+	return bat, nil
 }
 
 func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
@@ -1432,14 +1441,15 @@ func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
 			buf.Write(encoding.EncodeInt64Slice(v.Ns))
 		}
 		// Vs
-		n = len(v.Vs)
+		n = v.Vs.Count()
 		buf.Write(encoding.EncodeUint32(uint32(n)))
 		if n > 0 {
 			for i := 0; i < n; i++ {
-				m := len(v.Vs[i])
+				val := v.Vs.Get(int64(i))
+				m := len(val)
 				buf.Write(encoding.EncodeUint32(uint32(m)))
 				if m > 0 {
-					buf.Write(v.Vs[i])
+					buf.Write(val)
 				}
 			}
 		}
@@ -1757,17 +1767,25 @@ func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
 			buf.Write(encoding.EncodeInt64Slice(v.Ns))
 		}
 		// Vs
-		n = len(v.Vs)
+		n = v.Vs.Count()
 		buf.Write(encoding.EncodeUint32(uint32(n)))
 		if n > 0 {
 			for i := 0; i < n; i++ {
-				m := len(v.Vs[i])
+				val := v.Vs.Get(int64(i))
+				m := len(val)
 				buf.Write(encoding.EncodeUint32(uint32(m)))
 				if m > 0 {
-					buf.Write(v.Vs[i])
+					buf.Write(val)
 				}
 			}
 		}
+		// Es
+		da := encoding.EncodeBoolSlice(v.Es)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
 		// Typ
 		buf.Write(encoding.EncodeType(v.Typ))
 		return nil
@@ -2580,16 +2598,12 @@ func DecodeRing(data []byte) (ring.Ring, []byte, error) {
 		// Vs
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
-		if n > 0 {
-			r.Vs = make([][]byte, n)
-			for i := uint32(0); i < n; i++ {
-				m := encoding.DecodeUint32(data[:4])
-				data = data[4:]
-				if m > 0 {
-					r.Vs[i] = data[:m]
-					data = data[m:]
-				}
-			}
+		r.Vs.Grows(int(n))
+		for i := uint32(0); i < n; i++ {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			r.Vs.Set(int64(i), data[:m])
+			data = data[m:]
 		}
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
@@ -3001,16 +3015,20 @@ func DecodeRing(data []byte) (ring.Ring, []byte, error) {
 		// Vs
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
+		r.Vs.Grows(int(n))
+		for i := uint32(0); i < n; i++ {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			r.Vs.Set(int64(i), data[:m])
+			data = data[m:]
+		}
+		// Es
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
 		if n > 0 {
-			r.Vs = make([][]byte, n)
-			for i := uint32(0); i < n; i++ {
-				m := encoding.DecodeUint32(data[:4])
-				data = data[4:]
-				if m > 0 {
-					r.Vs[i] = data[:m]
-					data = data[m:]
-				}
-			}
+			r.Es = make([]bool, n)
+			copy(r.Es, encoding.DecodeBoolSlice(data[:n]))
+			data = data[n:]
 		}
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
@@ -3951,21 +3969,12 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 		// Vs
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
-		if n > 0 {
-			r.Vs = make([][]byte, n)
-			for i := uint32(0); i < n; i++ {
-				m := encoding.DecodeUint32(data[:4])
-				data = data[4:]
-				if m > 0 {
-					var err error
-					r.Vs[i], err = mheap.Alloc(proc.Mp, int64(m))
-					if err != nil {
-						return nil, nil, err
-					}
-					copy(r.Vs[i], data[:m])
-					data = data[m:]
-				}
-			}
+		r.Vs.Grows(int(n))
+		for i := uint32(0); i < n; i++ {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			r.Vs.Set(int64(i), data[:m])
+			data = data[m:]
 		}
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
@@ -4439,21 +4448,20 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 		// Vs
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
+		r.Vs.Grows(int(n))
+		for i := uint32(0); i < n; i++ {
+			m := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			r.Vs.Set(int64(i), data[:m])
+			data = data[m:]
+		}
+		// Es
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
 		if n > 0 {
-			r.Vs = make([][]byte, n)
-			for i := uint32(0); i < n; i++ {
-				m := encoding.DecodeUint32(data[:4])
-				data = data[4:]
-				if m > 0 {
-					var err error
-					r.Vs[i], err = mheap.Alloc(proc.Mp, int64(m))
-					if err != nil {
-						return nil, nil, err
-					}
-					copy(r.Vs[i], data[:m])
-					data = data[m:]
-				}
-			}
+			r.Es = make([]bool, n)
+			copy(r.Es, encoding.DecodeBoolSlice(data[:n]))
+			data = data[n:]
 		}
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
@@ -4853,7 +4861,7 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -5007,6 +5015,23 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Time)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeTimeSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
 	case types.T_decimal64:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
@@ -5247,7 +5272,7 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
@@ -5489,6 +5514,34 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
+	case types.T_time:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeTimeSlice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
 	case types.T_decimal64:
 		v := vector.New(typ)
 		v.Or = true
@@ -27,6 +27,7 @@ import (
 
 	"github.com/axiomhq/hyperloglog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
@@ -56,6 +57,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/untransform"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -772,7 +777,7 @@ func TestRing(t *testing.T) {
 		},
 		&max.StrRing{
 			Ns:  []int64{1231245234, 123123123908950, 123},
-			Vs:  [][]byte{[]byte("test1"), []byte("mysql1"), []byte("postgresql1")},
+			Vs:  ring.NewStrArena([]byte("test1"), []byte("mysql1"), []byte("postgresql1")),
 			Typ: types.Type{Oid: types.T(types.T_varchar), Size: 24},
 		},
 		&max.DateRing{
@@ -836,8 +841,9 @@ func TestRing(t *testing.T) {
 			Typ: types.Type{Oid: types.T(types.T_varchar), Size: 24},
 		},
 		&min.StrRing{
+			Es:  []bool{false, false, true},
 			Ns:  []int64{1231245234, 123123123908950, 123},
-			Vs:  [][]byte{[]byte("test1"), []byte("mysql1"), []byte("postgresql1")},
+			Vs:  ring.NewStrArena([]byte("test1"), []byte("mysql1"), []byte("")),
 			Typ: types.Type{Oid: types.T(types.T_varchar), Size: 24},
 		},
 		&min.DateRing{
@@ -1260,9 +1266,10 @@ func TestRing(t *testing.T) {
 				}
 			}
 			// Vs
-			for i, v := range oriRing.Vs {
-				if string(ExpectRing.Vs[i]) != string(v) {
-					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", string(v), string(ExpectRing.Vs[i]))
+			for i := 0; i < oriRing.Vs.Count(); i++ {
+				v := oriRing.Vs.Get(int64(i))
+				if string(ExpectRing.Vs.Get(int64(i))) != string(v) {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", string(v), string(ExpectRing.Vs.Get(int64(i))))
 					return
 				}
 			}
@@ -1528,9 +1535,17 @@ func TestRing(t *testing.T) {
 				}
 			}
 			// Vs
-			for i, v := range oriRing.Vs {
-				if string(ExpectRing.Vs[i]) != string(v) {
-					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", string(v), string(ExpectRing.Vs[i]))
+			for i := 0; i < oriRing.Vs.Count(); i++ {
+				v := oriRing.Vs.Get(int64(i))
+				if string(ExpectRing.Vs.Get(int64(i))) != string(v) {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", string(v), string(ExpectRing.Vs.Get(int64(i))))
+					return
+				}
+			}
+			// Es
+			for i, e := range oriRing.Es {
+				if ExpectRing.Es[i] != e {
+					t.Errorf("Decode ring Es failed. \nExpected/Got:\n%v\n%v", e, ExpectRing.Es[i])
 					return
 				}
 			}
@@ -1762,3 +1777,43 @@ func TestBatch(t *testing.T) {
 		}
 	}
 }
+
+func TestRemoteBatch(t *testing.T) {
+	var buf bytes.Buffer
+
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	bat := batch.New(true, []string{"a", "b", "c"})
+	bat.Vecs[0] = NewFloatVector(1.2)
+	bat.Vecs[1] = vector.NewConst(types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2})
+	bat.Vecs[1].Col = []types.Decimal64{123}
+	bat.Vecs[1].Length = 3
+	bat.Vecs[2] = NewStrVector([]byte("x"))
+	nulls.Add(bat.Vecs[2].Nsp, 1)
+	bat.Sels = []int64{0, 2}
+	bat.Zs = []int64{1, 2, 3}
+	bat.As = []string{"sum"}
+	bat.Refs = []uint64{1}
+	bat.Rs = []ring.Ring{&sum.IntRing{
+		Ns:  []int64{1, 2, 3},
+		Vs:  []int64{4, 5, 6},
+		Typ: types.Type{Oid: types.T_int64, Size: 8},
+	}}
+	require.NoError(t, EncodeRemoteBatch(bat, &buf))
+	result, err := DecodeRemoteBatch(buf.Bytes(), proc)
+	require.NoError(t, err)
+	require.Equal(t, bat.Attrs, result.Attrs)
+	require.Equal(t, bat.Vecs[0].Col, result.Vecs[0].Col)
+	require.Equal(t, bat.Vecs[1].Typ, result.Vecs[1].Typ)
+	require.True(t, result.Vecs[1].IsConst)
+	require.Equal(t, 3, result.Vecs[1].Length)
+	require.Equal(t, bat.Vecs[1].Col, result.Vecs[1].Col)
+	require.Equal(t, []byte("x"), result.Vecs[2].Col.(*types.Bytes).Get(2))
+	require.True(t, nulls.Contains(result.Vecs[2].Nsp, 1))
+	require.Equal(t, bat.Sels, result.Sels)
+	require.Equal(t, bat.Zs, result.Zs)
+	require.Equal(t, bat.As, result.As)
+	require.Equal(t, bat.Refs, result.Refs)
+	require.Equal(t, bat.Rs[0].(*sum.IntRing).Vs, result.Rs[0].(*sum.IntRing).Vs)
+	batch.Clean(result, proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
@@ -15,6 +15,8 @@
 package testutil
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -406,3 +408,56 @@ func CompareVectors(expected *vector.Vector, got *vector.Vector) bool {
 		}
 	}
 }
+
+// CheckVector returns an error if the vector breaks the invariants of a vector
+// of the logical length. The column is of the type of the vector, a scalar
+// holds one value, or none if it is null, and it is repeated as many times as
+// its Length, and the nulls of a vector are within its rows.
+func CheckVector(vec *vector.Vector, length int) error {
+	if vec.Typ.Oid != types.T_any {
+		if want, got := reflect.TypeOf(vector.New(vec.Typ).Col), reflect.TypeOf(vec.Col); want != got {
+			return fmt.Errorf("the column of %s is %v, want %v", vec.Typ, got, want)
+		}
+	}
+	if got := vector.Length(vec); got != length {
+		return fmt.Errorf("the length of the vector is %d, want %d", got, length)
+	}
+	rows := 0
+	if vec.Col != nil {
+		if col, ok := vec.Col.(*types.Bytes); ok {
+			if len(col.Offsets) != len(col.Lengths) {
+				return fmt.Errorf("the vector has %d offsets but %d lengths", len(col.Offsets), len(col.Lengths))
+			}
+			for i := range col.Offsets {
+				if int(col.Offsets[i])+int(col.Lengths[i]) > len(col.Data) {
+					return fmt.Errorf("the value %d is out of the data of the vector", i)
+				}
+			}
+			rows = len(col.Offsets)
+		} else {
+			rows = reflect.ValueOf(vec.Col).Len()
+		}
+	}
+	if vec.IsScalar() {
+		if vec.IsScalarNull() {
+			if rows > 1 {
+				return fmt.Errorf("the null scalar vector has %d values", rows)
+			}
+			return nil
+		}
+		if rows != 1 {
+			return fmt.Errorf("the scalar vector has %d values", rows)
+		}
+		if nulls.Any(vec.Nsp) {
+			return fmt.Errorf("the scalar vector has nulls %s", nulls.String(vec.Nsp))
+		}
+		return nil
+	}
+	if rows != length {
+		return fmt.Errorf("the vector has %d values, want %d", rows, length)
+	}
+	if nulls.Any(vec.Nsp) && int(vec.Nsp.Np.Maximum()) >= length {
+		return fmt.Errorf("the nulls %s of the vector are out of its %d rows", nulls.String(vec.Nsp), length)
+	}
+	return nil
+}
@@ -51,8 +51,16 @@ func TestTableFunction(t *testing.T) {
 				{"ddlt2", "CREATE TABLE `ddlt2` (\n `orderid` VARCHAR(100) DEFAULT NULL,\n `uid` INT(32) DEFAULT NULL,\n `price` FLOAT(32) DEFAULT NULL\n)"},
 			},
 		}},
+		{sql: "create table ddlt5 (a int default 0x10, b bigint unsigned default b'11', c varchar(10) default x'4142', d varchar(10) default 0x00ff, e varchar(10) default 'it''s', f double default 1.5);"},
+		{sql: "show create table ddlt5", res: executeResult{
+			attr: []string{"Table", "Create Table"},
+			data: [][]string{
+				{"ddlt5", "CREATE TABLE `ddlt5` (\n `a` INT(32) DEFAULT 16,\n `b` BIGINT UNSIGNED(64) DEFAULT 3,\n `c` VARCHAR(10) DEFAULT 'AB',\n `d` VARCHAR(10) DEFAULT 0x00ff,\n `e` VARCHAR(10) DEFAULT 'it''s',\n `f` DOUBLE(64) DEFAULT 1.5\n)"},
+			},
+		}},
+		{sql: "create table ddlt6 (a int default 0x010000000000000000);", err: "[42611]Invalid default value for 'a'"},
 		{sql: "drop table ddlt1;"},
-		{sql: "drop table ddlt2, ddlt3;"},
+		{sql: "drop table ddlt2, ddlt3, ddlt5;"},
 		{sql: "drop table ddlt4;", err: "not exist", com: "this error is return by memory-engine, it's diff to real performance"},
 		{sql: "drop table if exists ddlt4;"},
 	}
@@ -18,6 +18,12 @@ package metric
 func registerAllMetrics() {
 	mustRegister(SQLLatencyObserverFactory)
 	mustRegister(StatementCounterFactory)
+	mustRegister(PlanCacheCounterFactory)
+	mustRegister(TaskGaugeFactory)
+	mustRegister(TaeEventCounterFactory)
+	mustRegister(TaeEventRowsCounterFactory)
+	mustRegister(TaeEventBytesCounterFactory)
+	mustRegister(SpillCollector)
 	mustRegister(ProcessCollector)
 	mustRegister(HardwareStatsCollector)
 }
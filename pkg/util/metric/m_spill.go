@@ -0,0 +1,52 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// SpillCollector reports the bytes spilled by the queries by category, they
+// are counted by the spill managers of the processes.
+var SpillCollector = newSpillCollector()
+
+var spillBytesDesc = prom.NewDesc(
+	"sql_spill_bytes_total",
+	"Bytes written to the spill files of the queries by category",
+	[]string{"category"}, nil,
+)
+
+type spillCollector struct {
+	selfAsPromCollector
+}
+
+func newSpillCollector() Collector {
+	c := &spillCollector{}
+	c.init(c)
+	return c
+}
+
+// Describe returns all descriptions of the collector.
+func (c *spillCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- spillBytesDesc
+}
+
+// Collect returns the current state of all metrics of the collector.
+func (c *spillCollector) Collect(ch chan<- prom.Metric) {
+	for _, category := range process.SpillCategories() {
+		ch <- prom.MustNewConstMetric(spillBytesDesc, prom.CounterValue, float64(process.SpilledBytes(category)), category)
+	}
+}
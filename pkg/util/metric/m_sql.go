@@ -38,6 +38,17 @@ var (
 		StatementCounterFactory.WithLabelValues("other", "1"),
 	}
 
+	PlanCacheCounterFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "sql",
+			Name:      "plan_cache_total",
+			Help:      "Counter of the lookups of the cached plans",
+		},
+		[]string{"result"},
+	)
+	planCacheHitCounter  = PlanCacheCounterFactory.WithLabelValues("hit")
+	planCacheMissCounter = PlanCacheCounterFactory.WithLabelValues("miss")
+
 	SQLLatencyObserverFactory = NewRawHistVec(
 		HistogramOpts{
 			Subsystem: "sql",
@@ -90,3 +101,10 @@ func SQLLatencyObserver(t SQLType, isInternal bool) Observer {
 		return sqlLatencyObservers[t]
 	}
 }
+
+func PlanCacheCounter(hit bool) Counter {
+	if hit {
+		return planCacheHitCounter
+	}
+	return planCacheMissCounter
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+var (
+	TaskGaugeFactory = NewGaugeVec(
+		GaugeOpts{
+			Subsystem: "tae",
+			Name:      "task_count",
+			Help:      "Number of the background tasks of the storage by class and state",
+		},
+		[]string{"class", "state"},
+	)
+)
+
+// TaskGauge returns the gauge of the tasks of the class in the state, which
+// is queued or running
+func TaskGauge(class, state string) Gauge {
+	return TaskGaugeFactory.WithLabelValues(class, state)
+}
+
+var (
+	TaeEventCounterFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "tae",
+			Name:      "event_total",
+			Help:      "Number of the compaction and checkpoint events of the storage by type and outcome",
+		},
+		[]string{"type", "outcome"},
+	)
+
+	TaeEventRowsCounterFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "tae",
+			Name:      "event_rows_total",
+			Help:      "Number of the rows processed by the compaction and checkpoint events of the storage by type",
+		},
+		[]string{"type"},
+	)
+
+	TaeEventBytesCounterFactory = NewCounterVec(
+		CounterOpts{
+			Subsystem: "tae",
+			Name:      "event_bytes_total",
+			Help:      "Number of the bytes written by the compaction and checkpoint events of the storage by type",
+		},
+		[]string{"type"},
+	)
+)
+
+// TaeEventCounter returns the counter of the events of the type with the
+// outcome, which is ok or error
+func TaeEventCounter(typ, outcome string) Counter {
+	return TaeEventCounterFactory.WithLabelValues(typ, outcome)
+}
+
+// TaeEventRowsCounter returns the counter of the rows processed by the events
+// of the type
+func TaeEventRowsCounter(typ string) Counter {
+	return TaeEventRowsCounterFactory.WithLabelValues(typ)
+}
+
+// TaeEventBytesCounter returns the counter of the bytes written by the events
+// of the type
+func TaeEventBytesCounter(typ string) Counter {
+	return TaeEventBytesCounterFactory.WithLabelValues(typ)
+}
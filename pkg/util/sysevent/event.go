@@ -0,0 +1,106 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sysevent records the compaction and checkpoint events of the
+// storage. The jobs report an event when they end, the event is counted by
+// the metrics and passed to the sink, which persists it in the system_events
+// table. Reporting is best effort, it never blocks nor fails a job.
+package sysevent
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/util/metric"
+)
+
+// The types of the events
+const (
+	FlushBlock   = "flush_block"
+	CompactBlock = "compact_block"
+	MergeBlocks  = "merge_blocks"
+	Checkpoint   = "checkpoint"
+)
+
+// The outcomes of the events
+const (
+	OutcomeOK    = "ok"
+	OutcomeError = "error"
+)
+
+// MaxErrorLen is the max length of the error text of an event
+const MaxErrorLen = 1024
+
+// Event is a compaction or checkpoint job of the storage
+type Event struct {
+	Type      string
+	TableID   uint64
+	SegmentID uint64
+	BlockID   uint64
+	Start     time.Time
+	End       time.Time
+	// Rows is the number of the rows processed by the job
+	Rows int64
+	// Bytes is the number of the bytes written by the job
+	Bytes   int64
+	Outcome string
+	Error   string
+}
+
+// Sink receives the reported events, Report must not block
+type Sink interface {
+	Report(*Event)
+}
+
+type sinkHolder struct {
+	sink Sink
+}
+
+var sink atomic.Value
+
+func init() {
+	sink.Store(sinkHolder{})
+}
+
+// SetSink sets the sink of the events and returns the previous one, the
+// events are only counted if the sink is nil
+func SetSink(s Sink) Sink {
+	return sink.Swap(sinkHolder{sink: s}).(sinkHolder).sink
+}
+
+// Report ends the event with err, counts it by its type and outcome and passes
+// it to the sink
+func Report(e *Event, err error) {
+	if e.End.IsZero() {
+		e.End = time.Now()
+	}
+	e.Outcome = OutcomeOK
+	if err != nil {
+		e.Outcome = OutcomeError
+		e.Error = err.Error()
+		if len(e.Error) > MaxErrorLen {
+			e.Error = e.Error[:MaxErrorLen]
+		}
+	}
+	metric.TaeEventCounter(e.Type, e.Outcome).Inc()
+	if e.Rows > 0 {
+		metric.TaeEventRowsCounter(e.Type).Add(float64(e.Rows))
+	}
+	if e.Bytes > 0 {
+		metric.TaeEventBytesCounter(e.Type).Add(float64(e.Bytes))
+	}
+	if s := sink.Load().(sinkHolder).sink; s != nil {
+		s.Report(e)
+	}
+}
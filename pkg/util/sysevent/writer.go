@@ -0,0 +1,253 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sysevent
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	EVENT_DB      = "system"
+	EVENT_TABLE   = "system_events"
+	CHAN_CAPACITY = 10000
+
+	SQL_CREATE_DB    = "create database if not exists " + EVENT_DB
+	SQL_CREATE_TABLE = "create table if not exists " + EVENT_DB + "." + EVENT_TABLE + `(
+	event_type varchar(32),
+	table_id bigint unsigned,
+	segment_id bigint unsigned,
+	block_id bigint unsigned,
+	start_time datetime,
+	end_time datetime,
+	rows_processed bigint,
+	bytes_written bigint,
+	outcome varchar(16),
+	error varchar(1024)
+)`
+
+	timeFormat = "2006-01-02 15:04:05.000000"
+)
+
+type writerOpts struct {
+	// if `batchSize` events are buffered, they are flushed
+	batchSize int
+	// the buffered events are flushed anyway after `flushInterval`
+	flushInterval time.Duration
+	// the events which ended `retention` ago are deleted
+	retention time.Duration
+	// the old events are deleted every `retentionInterval`
+	retentionInterval time.Duration
+}
+
+func defaultWriterOpts() writerOpts {
+	return writerOpts{
+		batchSize:         100,
+		flushInterval:     10 * time.Second,
+		retention:         7 * 24 * time.Hour,
+		retentionInterval: time.Hour,
+	}
+}
+
+type writerOpt interface {
+	ApplyTo(*writerOpts)
+}
+
+type WithBatchSize int
+
+func (x WithBatchSize) ApplyTo(o *writerOpts) {
+	o.batchSize = int(x)
+}
+
+type WithFlushInterval time.Duration
+
+func (x WithFlushInterval) ApplyTo(o *writerOpts) {
+	o.flushInterval = time.Duration(x)
+}
+
+type WithRetention time.Duration
+
+func (x WithRetention) ApplyTo(o *writerOpts) {
+	o.retention = time.Duration(x)
+}
+
+type WithRetentionInterval time.Duration
+
+func (x WithRetentionInterval) ApplyTo(o *writerOpts) {
+	o.retentionInterval = time.Duration(x)
+}
+
+// writer is the sink which inserts the events into the system_events table
+// in batches, the events are dropped if the writer falls behind
+type writer struct {
+	exec      ie.InternalExecutor
+	opts      writerOpts
+	isRunning int32
+	eventCh   chan *Event
+	stopCh    chan struct{}
+	stopWg    sync.WaitGroup
+	// dropped is the number of the events dropped for the full channel
+	dropped uint64
+}
+
+func newWriter(factory func() ie.InternalExecutor, opts ...writerOpt) *writer {
+	initOpts := defaultWriterOpts()
+	for _, o := range opts {
+		o.ApplyTo(&initOpts)
+	}
+	exec := factory()
+	exec.ApplySessionOverride(ie.NewOptsBuilder().Database(EVENT_DB).Internal(true).Finish())
+	return &writer{
+		exec:    exec,
+		opts:    initOpts,
+		eventCh: make(chan *Event, CHAN_CAPACITY),
+	}
+}
+
+func (w *writer) Report(e *Event) {
+	select {
+	case w.eventCh <- e:
+	default:
+		atomic.AddUint64(&w.dropped, 1)
+	}
+}
+
+func (w *writer) Start() {
+	if atomic.SwapInt32(&w.isRunning, 1) == 1 {
+		return
+	}
+	w.stopCh = make(chan struct{})
+	w.stopWg.Add(1)
+	go w.loop()
+}
+
+// Stop stops the writer after the buffered events are flushed
+func (w *writer) Stop() {
+	if atomic.SwapInt32(&w.isRunning, 0) == 0 {
+		return
+	}
+	close(w.stopCh)
+	w.stopWg.Wait()
+}
+
+func (w *writer) loop() {
+	defer w.stopWg.Done()
+	flushTicker := time.NewTicker(w.opts.flushInterval)
+	defer flushTicker.Stop()
+	retentionTicker := time.NewTicker(w.opts.retentionInterval)
+	defer retentionTicker.Stop()
+
+	events := make([]*Event, 0, w.opts.batchSize)
+	sqlbuf := new(bytes.Buffer)
+	flush := func() {
+		if len(events) == 0 {
+			return
+		}
+		sql := buildInsertSql(sqlbuf, events)
+		if err := w.exec.Exec(sql, ie.NewOptsBuilder().Finish()); err != nil {
+			logutil.Errorf("[SysEvent] insert error: %v, %d events dropped", err, len(events))
+		}
+		events = events[:0]
+	}
+
+	for {
+		select {
+		case <-w.stopCh:
+			for {
+				select {
+				case e := <-w.eventCh:
+					events = append(events, e)
+				default:
+					flush()
+					return
+				}
+			}
+		case e := <-w.eventCh:
+			events = append(events, e)
+			if len(events) >= w.opts.batchSize {
+				flush()
+			}
+		case <-flushTicker.C:
+			flush()
+		case now := <-retentionTicker.C:
+			sql := buildRetentionSql(now.Add(-w.opts.retention))
+			if err := w.exec.Exec(sql, ie.NewOptsBuilder().Finish()); err != nil {
+				logutil.Errorf("[SysEvent] retention error: %v, sql: %s", err, sql)
+			}
+		}
+	}
+}
+
+func buildInsertSql(buf *bytes.Buffer, events []*Event) string {
+	buf.Reset()
+	buf.WriteString("insert into ")
+	buf.WriteString(EVENT_DB + "." + EVENT_TABLE)
+	buf.WriteString(" values ")
+	for i, e := range events {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "(%s, %d, %d, %d, %s, %s, %d, %d, %s, %s)",
+			quote(e.Type), e.TableID, e.SegmentID, e.BlockID,
+			quote(e.Start.Format(timeFormat)), quote(e.End.Format(timeFormat)),
+			e.Rows, e.Bytes, quote(e.Outcome), quote(e.Error))
+	}
+	return buf.String()
+}
+
+func buildRetentionSql(before time.Time) string {
+	return fmt.Sprintf("delete from %s.%s where end_time < cast(%s as datetime)",
+		EVENT_DB, EVENT_TABLE, quote(before.Format(timeFormat)))
+}
+
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
+func quote(s string) string {
+	return "'" + quoteReplacer.Replace(s) + "'"
+}
+
+var eventWriter *writer
+
+// Init creates the system_events table and starts the writer of the events
+// as the sink. The failures of the writer are logged, they never fail the
+// jobs of the storage.
+func Init(ieFactory func() ie.InternalExecutor, opts ...writerOpt) {
+	w := newWriter(ieFactory, opts...)
+	for _, sql := range []string{SQL_CREATE_DB, SQL_CREATE_TABLE} {
+		if err := w.exec.Exec(sql, ie.NewOptsBuilder().Finish()); err != nil {
+			logutil.Errorf("[SysEvent] init table error: %v, sql: %s", err, sql)
+		}
+	}
+	w.Start()
+	eventWriter = w
+	SetSink(w)
+}
+
+// Stop detaches the writer from the events and stops it
+func Stop() {
+	if eventWriter == nil {
+		return
+	}
+	SetSink(nil)
+	eventWriter.Stop()
+	eventWriter = nil
+}
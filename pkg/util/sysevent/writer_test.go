@@ -0,0 +1,139 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sysevent
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/stretchr/testify/require"
+)
+
+type dummySqlExecutor struct {
+	opts ie.SessionOverrideOptions
+	ch   chan<- string
+}
+
+func (e *dummySqlExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions) {
+	e.opts = opts
+}
+
+func (e *dummySqlExecutor) Exec(sql string, opts ie.SessionOverrideOptions) error {
+	select {
+	case e.ch <- sql:
+	default:
+	}
+	return nil
+}
+
+func newExecutorFactory(sqlch chan string) func() ie.InternalExecutor {
+	return func() ie.InternalExecutor {
+		return &dummySqlExecutor{
+			opts: ie.NewOptsBuilder().Finish(),
+			ch:   sqlch,
+		}
+	}
+}
+
+type collectSink struct {
+	sync.Mutex
+	events []*Event
+}
+
+func (s *collectSink) Report(e *Event) {
+	s.Lock()
+	defer s.Unlock()
+	s.events = append(s.events, e)
+}
+
+func TestReport(t *testing.T) {
+	// no sink, the event is only counted
+	Report(&Event{Type: FlushBlock}, nil)
+
+	sink := new(collectSink)
+	prev := SetSink(sink)
+	defer SetSink(prev)
+
+	start := time.Now()
+	Report(&Event{Type: CompactBlock, TableID: 1, SegmentID: 2, BlockID: 3, Start: start, Rows: 10, Bytes: 100}, nil)
+	Report(&Event{Type: MergeBlocks, Start: start}, errors.New(strings.Repeat("x", MaxErrorLen+1)))
+	require.Equal(t, 2, len(sink.events))
+
+	e := sink.events[0]
+	require.Equal(t, OutcomeOK, e.Outcome)
+	require.Equal(t, "", e.Error)
+	require.False(t, e.End.Before(start))
+	require.Equal(t, uint64(3), e.BlockID)
+
+	e = sink.events[1]
+	require.Equal(t, OutcomeError, e.Outcome)
+	require.Equal(t, MaxErrorLen, len(e.Error))
+}
+
+func TestWriter(t *testing.T) {
+	sqlch := make(chan string, 100)
+	Init(newExecutorFactory(sqlch),
+		WithBatchSize(2),
+		WithFlushInterval(time.Hour),
+		WithRetention(time.Hour),
+		WithRetentionInterval(20*time.Millisecond))
+	defer Stop()
+	require.Equal(t, SQL_CREATE_DB, <-sqlch)
+	require.Equal(t, SQL_CREATE_TABLE, <-sqlch)
+
+	start := time.Date(2022, 7, 1, 10, 0, 0, 123456000, time.UTC)
+	end := start.Add(time.Second)
+	Report(&Event{Type: CompactBlock, TableID: 1, SegmentID: 2, BlockID: 3, Start: start, End: end, Rows: 10, Bytes: 100}, nil)
+	Report(&Event{Type: Checkpoint, TableID: 1, Start: start, End: end}, errors.New(`it's a \ error`))
+
+	var insert, retention string
+	timeout := time.After(5 * time.Second)
+	for insert == "" || retention == "" {
+		select {
+		case sql := <-sqlch:
+			if strings.HasPrefix(sql, "insert") {
+				insert = sql
+			} else if strings.HasPrefix(sql, "delete") {
+				retention = sql
+			}
+		case <-timeout:
+			t.Fatal("timeout")
+		}
+	}
+	require.Equal(t, "insert into system.system_events values "+
+		"('compact_block', 1, 2, 3, '2022-07-01 10:00:00.123456', '2022-07-01 10:00:01.123456', 10, 100, 'ok', ''), "+
+		"('checkpoint', 1, 0, 0, '2022-07-01 10:00:00.123456', '2022-07-01 10:00:01.123456', 0, 0, 'error', 'it''s a \\\\ error')", insert)
+	require.True(t, strings.HasPrefix(retention, "delete from system.system_events where end_time < cast('"))
+}
+
+func TestWriterStop(t *testing.T) {
+	sqlch := make(chan string, 100)
+	w := newWriter(newExecutorFactory(sqlch), WithBatchSize(10), WithFlushInterval(time.Hour))
+	w.Start()
+	w.Report(&Event{Type: FlushBlock})
+	// the buffered events are flushed when the writer stops
+	w.Stop()
+	require.True(t, strings.HasPrefix(<-sqlch, "insert"))
+
+	// the events are dropped when the channel is full
+	for i := 0; i < CHAN_CAPACITY+1; i++ {
+		w.Report(&Event{Type: FlushBlock})
+	}
+	require.Equal(t, uint64(1), w.dropped)
+}
@@ -19,49 +19,123 @@ import (
 )
 
 var (
-	DateAdd       func([]types.Date, []int64, []int64, []types.Date) []types.Date
-	DatetimeAdd   func([]types.Datetime, []int64, []int64, []types.Datetime) []types.Datetime
-	DateStringAdd func(*types.Bytes, []int64, []int64, *nulls.Nulls, *types.Bytes) *types.Bytes
+	// DateAdd adds the intervals of ys units zs to the dates xs. isConst tells
+	// which of xs, ys and zs are constants, whose value is their first one.
+	// The rows in ns are skipped, and the results out of the range of DATE are
+	// set null in ns.
+	DateAdd       func([]types.Date, []int64, []int64, []bool, *nulls.Nulls, []types.Date) []types.Date
+	DatetimeAdd   func([]types.Datetime, []int64, []int64, []bool, *nulls.Nulls, []types.Datetime) []types.Datetime
+	DateStringAdd func(*types.Bytes, []int64, []int64, []bool, *nulls.Nulls, *types.Bytes) *types.Bytes
+	TimeAdd       func([]types.Time, []int64, []int64, []bool, *nulls.Nulls, []types.Time) []types.Time
 )
 
 func init() {
 	DateAdd = dateAdd
 	DatetimeAdd = datetimeAdd
 	DateStringAdd = dateStringAdd
+	TimeAdd = timeAdd
 }
 
-func dateAdd(xs []types.Date, ys []int64, zs []int64, rs []types.Date) []types.Date {
-	for i, d := range xs {
-		rs[i] = d.ToTime().AddInterval(ys[0], types.IntervalType(zs[0])).ToDate()
+func dateAdd(xs []types.Date, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs []types.Date) []types.Date {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		r, ok := xs[At(i, isConst[0])].ToTime().AddInterval(ys[At(i, isConst[1])], types.IntervalType(zs[At(i, isConst[2])]))
+		if !ok {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = r.ToDate()
 	}
 	return rs
 }
 
-func datetimeAdd(xs []types.Datetime, ys []int64, zs []int64, rs []types.Datetime) []types.Datetime {
-	for i, d := range xs {
-		rs[i] = d.AddInterval(ys[0], types.IntervalType(zs[0]))
+func datetimeAdd(xs []types.Datetime, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		r, ok := xs[At(i, isConst[0])].AddInterval(ys[At(i, isConst[1])], types.IntervalType(zs[At(i, isConst[2])]))
+		if !ok {
+			nulls.Add(ns, uint64(i))
+			continue
+		}
+		rs[i] = r
+	}
+	return rs
+}
+
+// dateStringAdd gives a date for a string of a date and a unit not shorter
+// than a day, and a datetime otherwise. The strings that are neither are
+// null.
+func dateStringAdd(xs *types.Bytes, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	for i, n := 0, Rows([]int{len(xs.Lengths), len(ys), len(zs)}, isConst); i < n; i++ {
+		if nulls.Contains(ns, uint64(i)) {
+			rs.AppendOnce([]byte(""))
+			continue
+		}
+		str := string(xs.Get(int64(At(i, isConst[0]))))
+		r, ok := addString(str, ys[At(i, isConst[1])], types.IntervalType(zs[At(i, isConst[2])]))
+		if !ok {
+			nulls.Add(ns, uint64(i))
+		}
+		rs.AppendOnce([]byte(r))
 	}
 	return rs
 }
 
-func dateStringAdd(xs *types.Bytes, ys []int64, zs []int64, ns *nulls.Nulls, rs *types.Bytes) *types.Bytes {
-	for i := range xs.Lengths {
-		str := string(xs.Get(int64(i)))
-		if types.UnitIsDayOrLarger(types.IntervalType(zs[0])) {
-			d, e := types.ParseDate(str)
-			if e == nil {
-				rs.AppendOnce([]byte(d.ToTime().AddInterval(ys[0], types.IntervalType(zs[0])).ToDate().String()))
-				continue
+func addString(str string, num int64, unit types.IntervalType) (string, bool) {
+	if types.UnitIsDayOrLarger(unit) {
+		if d, err := types.ParseDate(str); err == nil {
+			r, ok := d.ToTime().AddInterval(num, unit)
+			if !ok {
+				return "", false
 			}
+			return r.ToDate().String(), true
+		}
+	}
+	d, err := types.ParseDatetime(str)
+	if err != nil {
+		return "", false
+	}
+	r, ok := d.AddInterval(num, unit)
+	if !ok {
+		return "", false
+	}
+	return r.String(), true
+}
+
+// timeAdd sets the rows out of the range of TIME to null
+func timeAdd(xs []types.Time, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs []types.Time) []types.Time {
+	for i := range rs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
 		}
-		d, e := types.ParseDatetime(str)
-		if e != nil {
-			// set null
+		r, ok := xs[At(i, isConst[0])].AddInterval(ys[At(i, isConst[1])], types.IntervalType(zs[At(i, isConst[2])]))
+		if !ok {
 			nulls.Add(ns, uint64(i))
-			rs.AppendOnce([]byte(""))
 			continue
 		}
-		rs.AppendOnce([]byte(d.AddInterval(ys[0], types.IntervalType(zs[0])).String()))
+		rs[i] = r
 	}
 	return rs
 }
+
+// Rows returns the number of the rows of the arguments of the lengths
+func Rows(lengths []int, isConst []bool) int {
+	for i, n := range lengths {
+		if !isConst[i] {
+			return n
+		}
+	}
+	return 1
+}
+
+// At returns the index of the i-th row of an argument
+func At(i int, isConst bool) int {
+	if isConst {
+		return 0
+	}
+	return i
+}
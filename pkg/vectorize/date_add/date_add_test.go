@@ -46,7 +46,7 @@ func TestDateAdd(t *testing.T) {
 	for _, c := range testCases {
 		t.Run(c.name, func(t *testing.T) {
 			got := make([]types.Date, len(c.args1))
-			require.Equal(t, c.want, dateAdd(c.args1, c.args2, c.args3, got))
+			require.Equal(t, c.want, dateAdd(c.args1, c.args2, c.args3, []bool{false, true, true}, new(nulls.Nulls), got))
 		})
 	}
 
@@ -77,7 +77,7 @@ func TestDatetimeAdd(t *testing.T) {
 	for _, c := range testCases {
 		t.Run(c.name, func(t *testing.T) {
 			got := make([]types.Datetime, len(c.args1))
-			require.Equal(t, c.want, datetimeAdd(c.args1, c.args2, c.args3, got))
+			require.Equal(t, c.want, datetimeAdd(c.args1, c.args2, c.args3, []bool{false, true, true}, new(nulls.Nulls), got))
 		})
 	}
 
@@ -137,9 +137,23 @@ func TestDateStringAdd(t *testing.T) {
 				Lengths: make([]uint32, 0),
 			}
 			nu := &nulls.Nulls{}
-			require.Equal(t, c.want, dateStringAdd(c.args1, c.args2, c.args3, nu, got))
+			require.Equal(t, c.want, dateStringAdd(c.args1, c.args2, c.args3, []bool{false, true, true}, nu, got))
 			require.Equal(t, c.contain, nulls.Contains(nu, 0))
 		})
 	}
 
 }
+
+func TestDateAddIntervalVector(t *testing.T) {
+	// a constant date and an interval of every row, the dates after the year
+	// 9999 are null
+	xs := []types.Date{types.FromCalendar(9999, 12, 30)}
+	ys := []int64{1, 2, -1, 1}
+	zs := []int64{int64(types.Day), int64(types.Day), int64(types.Month), int64(types.Year)}
+	require.Equal(t, 4, Rows([]int{len(xs), len(ys), len(zs)}, []bool{true, false, false}))
+	ns := new(nulls.Nulls)
+	got := dateAdd(xs, ys, zs, []bool{true, false, false}, ns, make([]types.Date, len(ys)))
+	require.Equal(t, "9999-12-31", got[0].String())
+	require.Equal(t, "9999-11-30", got[2].String())
+	require.Equal(t, []uint64{1, 3}, ns.Np.ToArray())
+}
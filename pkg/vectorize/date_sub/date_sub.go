@@ -16,52 +16,47 @@ package date_sub
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/date_add"
 )
 
 var (
-	DateSub       func([]types.Date, []int64, []int64, []types.Date) []types.Date
-	DatetimeSub   func([]types.Datetime, []int64, []int64, []types.Datetime) []types.Datetime
-	DateStringSub func(*types.Bytes, []int64, []int64, *nulls.Nulls, *types.Bytes) *types.Bytes
+	// DateSub subtracts the intervals of ys units zs from the dates xs, which
+	// is adding the opposite intervals by date_add.DateAdd, so do the others.
+	DateSub       func([]types.Date, []int64, []int64, []bool, *nulls.Nulls, []types.Date) []types.Date
+	DatetimeSub   func([]types.Datetime, []int64, []int64, []bool, *nulls.Nulls, []types.Datetime) []types.Datetime
+	DateStringSub func(*types.Bytes, []int64, []int64, []bool, *nulls.Nulls, *types.Bytes) *types.Bytes
+	TimeSub       func([]types.Time, []int64, []int64, []bool, *nulls.Nulls, []types.Time) []types.Time
 )
 
 func init() {
 	DateSub = dateSub
 	DatetimeSub = datetimeSub
 	DateStringSub = dateStringSub
+	TimeSub = timeSub
 }
 
-func dateSub(xs []types.Date, ys []int64, zs []int64, rs []types.Date) []types.Date {
-	for i, d := range xs {
-		rs[i] = d.ToTime().AddInterval(-ys[0], types.IntervalType(zs[0])).ToDate()
-	}
-	return rs
+func dateSub(xs []types.Date, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs []types.Date) []types.Date {
+	return date_add.DateAdd(xs, negate(ys), zs, isConst, ns, rs)
 }
 
-func datetimeSub(xs []types.Datetime, ys []int64, zs []int64, rs []types.Datetime) []types.Datetime {
-	for i, d := range xs {
-		rs[i] = d.AddInterval(-ys[0], types.IntervalType(zs[0]))
-	}
-	return rs
+func datetimeSub(xs []types.Datetime, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	return date_add.DatetimeAdd(xs, negate(ys), zs, isConst, ns, rs)
+}
+
+func dateStringSub(xs *types.Bytes, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	return date_add.DateStringAdd(xs, negate(ys), zs, isConst, ns, rs)
+}
+
+func timeSub(xs []types.Time, ys []int64, zs []int64, isConst []bool, ns *nulls.Nulls, rs []types.Time) []types.Time {
+	return date_add.TimeAdd(xs, negate(ys), zs, isConst, ns, rs)
 }
 
-func dateStringSub(xs *types.Bytes, ys []int64, zs []int64, ns *nulls.Nulls, rs *types.Bytes) *types.Bytes {
-	for i := range xs.Lengths {
-		str := string(xs.Get(int64(i)))
-		if types.UnitIsDayOrLarger(types.IntervalType(zs[0])) {
-			d, e := types.ParseDate(str)
-			if e == nil {
-				rs.AppendOnce([]byte(d.ToTime().AddInterval(-ys[0], types.IntervalType(zs[0])).ToDate().String()))
-				continue
-			}
-		}
-		d, e := types.ParseDatetime(str)
-		if e != nil {
-			// set null
-			nulls.Add(ns, uint64(i))
-			rs.AppendOnce([]byte(""))
-			continue
-		}
-		rs.AppendOnce([]byte(d.AddInterval(-ys[0], types.IntervalType(zs[0])).String()))
+// negate returns the opposite numbers of ys, the opposite of math.MinInt64
+// is itself, which is out of the range of any interval anyway
+func negate(ys []int64) []int64 {
+	rs := make([]int64, len(ys))
+	for i, y := range ys {
+		rs[i] = -y
 	}
 	return rs
 }
@@ -15,6 +15,7 @@ package date_sub
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"math"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -46,7 +47,7 @@ func TestDateSub(t *testing.T) {
 	for _, c := range testCases {
 		t.Run(c.name, func(t *testing.T) {
 			got := make([]types.Date, len(c.args1))
-			require.Equal(t, c.want, dateSub(c.args1, c.args2, c.args3, got))
+			require.Equal(t, c.want, dateSub(c.args1, c.args2, c.args3, []bool{false, true, true}, new(nulls.Nulls), got))
 		})
 	}
 
@@ -77,7 +78,7 @@ func TestDatetimeSub(t *testing.T) {
 	for _, c := range testCases {
 		t.Run(c.name, func(t *testing.T) {
 			got := make([]types.Datetime, len(c.args1))
-			require.Equal(t, c.want, datetimeSub(c.args1, c.args2, c.args3, got))
+			require.Equal(t, c.want, datetimeSub(c.args1, c.args2, c.args3, []bool{false, true, true}, new(nulls.Nulls), got))
 		})
 	}
 
@@ -137,9 +138,60 @@ func TestDateStringSub(t *testing.T) {
 				Lengths: make([]uint32, 0),
 			}
 			nu := &nulls.Nulls{}
-			require.Equal(t, c.want, dateStringSub(c.args1, c.args2, c.args3, nu, got))
+			require.Equal(t, c.want, dateStringSub(c.args1, c.args2, c.args3, []bool{false, true, true}, nu, got))
 			require.Equal(t, c.contain, nulls.Contains(nu, 0))
 		})
 	}
 
 }
+
+func TestDatetimeSubCompoundInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		datetime string
+		interval string
+		unit     types.IntervalType
+		want     string
+	}{
+		{"day_hour", "2022-01-01 10:00:00", "1 2", types.Day_Hour, "2021-12-31 08:00:00"},
+		{"minute_second", "2022-01-01 10:00:00", "1:30", types.Minute_Second, "2022-01-01 09:58:30"},
+		{"year_month", "2022-03-31 10:00:00", "1-1", types.Year_Month, "2021-02-28 10:00:00"},
+		{"out of range", "0001-01-01 01:00:00", "0 2", types.Day_Hour, ""},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			d, err := types.ParseDatetime(c.datetime)
+			require.NoError(t, err)
+			num, unit, err := types.NormalizeInterval(c.interval, c.unit)
+			require.NoError(t, err)
+			ns := new(nulls.Nulls)
+			got := datetimeSub([]types.Datetime{d}, []int64{num}, []int64{int64(unit)}, []bool{true, true, true}, ns, make([]types.Datetime, 1))
+			require.Equal(t, c.want == "", nulls.Contains(ns, 0))
+			if c.want != "" {
+				require.Equal(t, c.want, got[0].String())
+			}
+		})
+	}
+}
+
+func TestDatetimeSubIntervalVector(t *testing.T) {
+	// every row has its own interval, a negative one adds to the datetime
+	xs := []types.Datetime{types.FromClock(2022, 1, 1, 10, 0, 0, 0)}
+	ys := []int64{90, -90, 0, math.MinInt64, 1}
+	zs := []int64{int64(types.Second), int64(types.Second), int64(types.Day), int64(types.Second), int64(types.Year)}
+	ns := new(nulls.Nulls)
+	nulls.Add(ns, 2)
+	got := datetimeSub(xs, ys, zs, []bool{true, false, false}, ns, make([]types.Datetime, len(ys)))
+	require.Equal(t, "2022-01-01 09:58:30", got[0].String())
+	require.Equal(t, "2022-01-01 10:01:30", got[1].String())
+	require.Equal(t, "2021-01-01 10:00:00", got[4].String())
+	require.Equal(t, []uint64{2, 3}, ns.Np.ToArray())
+
+	strs := &types.Bytes{Data: []byte("2022-01-012022-01-01 10:00:00"), Offsets: []uint32{0, 10}, Lengths: []uint32{10, 19}}
+	ns = new(nulls.Nulls)
+	res := dateStringSub(strs, []int64{-1, 1}, []int64{int64(types.Day), int64(types.Hour)}, []bool{false, false, false}, ns, &types.Bytes{})
+	require.Equal(t, "2022-01-02", string(res.Get(0)))
+	require.Equal(t, "2022-01-01 09:00:00", string(res.Get(1)))
+	require.Equal(t, 0, nulls.Length(ns))
+}
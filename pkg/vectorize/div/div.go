@@ -107,6 +107,55 @@ var (
 	Float64IntegerDivScalarSels   = FloatIntegerDivScalarSels[float64]
 	Float64IntegerDivByScalar     = FloatIntegerDivByScalar[float64]
 	Float64IntegerDivByScalarSels = FloatIntegerDivByScalarSels[float64]
+
+	Int8IntegerDiv               = IntIntegerDiv[int8, int64]
+	Int8IntegerDivSels           = IntIntegerDivSels[int8, int64]
+	Int8IntegerDivScalar         = IntIntegerDivScalar[int8, int64]
+	Int8IntegerDivScalarSels     = IntIntegerDivScalarSels[int8, int64]
+	Int8IntegerDivByScalar       = IntIntegerDivByScalar[int8, int64]
+	Int8IntegerDivByScalarSels   = IntIntegerDivByScalarSels[int8, int64]
+	Int16IntegerDiv              = IntIntegerDiv[int16, int64]
+	Int16IntegerDivSels          = IntIntegerDivSels[int16, int64]
+	Int16IntegerDivScalar        = IntIntegerDivScalar[int16, int64]
+	Int16IntegerDivScalarSels    = IntIntegerDivScalarSels[int16, int64]
+	Int16IntegerDivByScalar      = IntIntegerDivByScalar[int16, int64]
+	Int16IntegerDivByScalarSels  = IntIntegerDivByScalarSels[int16, int64]
+	Int32IntegerDiv              = IntIntegerDiv[int32, int64]
+	Int32IntegerDivSels          = IntIntegerDivSels[int32, int64]
+	Int32IntegerDivScalar        = IntIntegerDivScalar[int32, int64]
+	Int32IntegerDivScalarSels    = IntIntegerDivScalarSels[int32, int64]
+	Int32IntegerDivByScalar      = IntIntegerDivByScalar[int32, int64]
+	Int32IntegerDivByScalarSels  = IntIntegerDivByScalarSels[int32, int64]
+	Int64IntegerDiv              = IntIntegerDiv[int64, int64]
+	Int64IntegerDivSels          = IntIntegerDivSels[int64, int64]
+	Int64IntegerDivScalar        = IntIntegerDivScalar[int64, int64]
+	Int64IntegerDivScalarSels    = IntIntegerDivScalarSels[int64, int64]
+	Int64IntegerDivByScalar      = IntIntegerDivByScalar[int64, int64]
+	Int64IntegerDivByScalarSels  = IntIntegerDivByScalarSels[int64, int64]
+	Uint8IntegerDiv              = IntIntegerDiv[uint8, uint64]
+	Uint8IntegerDivSels          = IntIntegerDivSels[uint8, uint64]
+	Uint8IntegerDivScalar        = IntIntegerDivScalar[uint8, uint64]
+	Uint8IntegerDivScalarSels    = IntIntegerDivScalarSels[uint8, uint64]
+	Uint8IntegerDivByScalar      = IntIntegerDivByScalar[uint8, uint64]
+	Uint8IntegerDivByScalarSels  = IntIntegerDivByScalarSels[uint8, uint64]
+	Uint16IntegerDiv             = IntIntegerDiv[uint16, uint64]
+	Uint16IntegerDivSels         = IntIntegerDivSels[uint16, uint64]
+	Uint16IntegerDivScalar       = IntIntegerDivScalar[uint16, uint64]
+	Uint16IntegerDivScalarSels   = IntIntegerDivScalarSels[uint16, uint64]
+	Uint16IntegerDivByScalar     = IntIntegerDivByScalar[uint16, uint64]
+	Uint16IntegerDivByScalarSels = IntIntegerDivByScalarSels[uint16, uint64]
+	Uint32IntegerDiv             = IntIntegerDiv[uint32, uint64]
+	Uint32IntegerDivSels         = IntIntegerDivSels[uint32, uint64]
+	Uint32IntegerDivScalar       = IntIntegerDivScalar[uint32, uint64]
+	Uint32IntegerDivScalarSels   = IntIntegerDivScalarSels[uint32, uint64]
+	Uint32IntegerDivByScalar     = IntIntegerDivByScalar[uint32, uint64]
+	Uint32IntegerDivByScalarSels = IntIntegerDivByScalarSels[uint32, uint64]
+	Uint64IntegerDiv             = IntIntegerDiv[uint64, uint64]
+	Uint64IntegerDivSels         = IntIntegerDivSels[uint64, uint64]
+	Uint64IntegerDivScalar       = IntIntegerDivScalar[uint64, uint64]
+	Uint64IntegerDivScalarSels   = IntIntegerDivScalarSels[uint64, uint64]
+	Uint64IntegerDivByScalar     = IntIntegerDivByScalar[uint64, uint64]
+	Uint64IntegerDivByScalarSels = IntIntegerDivByScalarSels[uint64, uint64]
 )
 
 func NumericDiv[T constraints.Integer | constraints.Float](xs, ys, rs []T) []T {
@@ -193,12 +242,58 @@ func FloatIntegerDivByScalarSels[T constraints.Float](x T, ys []T, rs []int64, s
 	return rs
 }
 
+// IntIntegerDiv and the other integer kernels of DIV divide in the result
+// type, which is BIGINT for signed and BIGINT UNSIGNED for unsigned operands,
+// so that the quotient is exact for every value and -128 DIV -1 of a TINYINT
+// does not wrap. The divisors must not be zero.
+func IntIntegerDiv[T constraints.Integer, R int64 | uint64](xs, ys []T, rs []R) []R {
+	for i, x := range xs {
+		rs[i] = R(x) / R(ys[i])
+	}
+	return rs
+}
+
+func IntIntegerDivSels[T constraints.Integer, R int64 | uint64](xs, ys []T, rs []R, sels []int64) []R {
+	for _, sel := range sels {
+		rs[sel] = R(xs[sel]) / R(ys[sel])
+	}
+	return rs
+}
+
+func IntIntegerDivScalar[T constraints.Integer, R int64 | uint64](x T, ys []T, rs []R) []R {
+	for i, y := range ys {
+		rs[i] = R(x) / R(y)
+	}
+	return rs
+}
+
+func IntIntegerDivScalarSels[T constraints.Integer, R int64 | uint64](x T, ys []T, rs []R, sels []int64) []R {
+	for _, sel := range sels {
+		rs[sel] = R(x) / R(ys[sel])
+	}
+	return rs
+}
+
+func IntIntegerDivByScalar[T constraints.Integer, R int64 | uint64](x T, ys []T, rs []R) []R {
+	for i, y := range ys {
+		rs[i] = R(y) / R(x)
+	}
+	return rs
+}
+
+func IntIntegerDivByScalarSels[T constraints.Integer, R int64 | uint64](x T, ys []T, rs []R, sels []int64) []R {
+	for _, sel := range sels {
+		rs[sel] = R(ys[sel]) / R(x)
+	}
+	return rs
+}
+
 func decimal64Div(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
 	// a / b
 	// to divide two decimal value
-	// 1. scale dividend using divisor's scale
+	// 1. scale dividend using divisor's scale and the scale increment of the quotient
 	// 2. perform integer division
-	// division result precision: 38(decimal64) division result scale: dividend's scale
+	// division result precision: 38(decimal128) division result scale: dividend's scale + 4, see types.DecimalDivScale
 	for i, x := range xs {
 		rs[i] = types.Decimal64Decimal64Div(x, ys[i], xsScale, ysScale)
 	}
@@ -235,7 +330,7 @@ func decimal64DivByScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysSca
 
 func decimal64DivByScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
 	for _, sel := range sels {
-		rs[sel] = types.Decimal64Decimal64Div(x, ys[sel], xScale, ysScale)
+		rs[sel] = types.Decimal64Decimal64Div(ys[sel], x, ysScale, xScale)
 	}
 	return rs
 }
@@ -243,9 +338,9 @@ func decimal64DivByScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, y
 func decimal128Div(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
 	// a / b
 	// to divide two decimal value
-	// 1. scale dividend using divisor's scale
+	// 1. scale dividend using divisor's scale and the scale increment of the quotient
 	// 2. perform integer division
-	// division result precision: 38(decimal128) division result scale: dividend's scale
+	// division result precision: 38(decimal128) division result scale: dividend's scale + 4, see types.DecimalDivScale
 	for i, x := range xs {
 		rs[i] = types.Decimal128Decimal128Div(x, ys[i], xsScale, ysScale)
 	}
@@ -282,7 +377,7 @@ func decimal128DivByScalar(x types.Decimal128, ys []types.Decimal128, xScale, ys
 
 func decimal128DivByScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
 	for _, sel := range sels {
-		rs[sel] = types.Decimal128Decimal128Div(x, ys[sel], xScale, ysScale)
+		rs[sel] = types.Decimal128Decimal128Div(ys[sel], x, ysScale, xScale)
 	}
 	return rs
 }
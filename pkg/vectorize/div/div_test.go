@@ -1,8 +1,10 @@
 package div
 
 import (
+	"math"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -768,3 +770,112 @@ func TestFloat64IntegerDivByScalarSels(t *testing.T) {
 	}
 	require.Equal(t, rsCorrect, rs)
 }
+
+func TestInt8IntegerDiv(t *testing.T) {
+	xs := []int8{-128, -7, 7, 127, -128}
+	ys := []int8{-1, 2, -2, 3, 1}
+	rs := make([]int64, len(xs))
+	rs = Int8IntegerDiv(xs, ys, rs)
+	require.Equal(t, []int64{128, -3, -3, 42, -128}, rs)
+}
+
+func TestInt64IntegerDiv(t *testing.T) {
+	xs := []int64{math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, -9007199254740993}
+	ys := []int64{1, 3, 2, 1}
+	rs := make([]int64, len(xs))
+	rs = Int64IntegerDiv(xs, ys, rs)
+	require.Equal(t, []int64{math.MaxInt64, -3074457345618258602, math.MaxInt64 / 2, -9007199254740993}, rs)
+}
+
+func TestUint64IntegerDiv(t *testing.T) {
+	xs := []uint64{math.MaxUint64, math.MaxUint64 - 1, 1<<53 + 1, 10}
+	ys := []uint64{1, 2, 1, math.MaxUint64}
+	rs := make([]uint64, len(xs))
+	rs = Uint64IntegerDiv(xs, ys, rs)
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxUint64 / 2, 1<<53 + 1, 0}, rs)
+}
+
+func TestUint64IntegerDivSels(t *testing.T) {
+	xs := []uint64{math.MaxUint64, math.MaxUint64 - 1, 1<<53 + 1, 10}
+	ys := []uint64{3, 0, 1, 0}
+	selects := []int64{0, 2}
+	rs := make([]uint64, len(xs))
+	rs = Uint64IntegerDivSels(xs, ys, rs, selects)
+	require.Equal(t, []uint64{math.MaxUint64 / 3, 0, 1<<53 + 1, 0}, rs)
+}
+
+func TestUint64IntegerDivScalar(t *testing.T) {
+	x := uint64(math.MaxUint64)
+	ys := []uint64{1, 2, math.MaxUint64, 1 << 63}
+	rs := make([]uint64, len(ys))
+	rs = Uint64IntegerDivScalar(x, ys, rs)
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxUint64 / 2, 1, 1}, rs)
+}
+
+func TestUint64IntegerDivScalarSels(t *testing.T) {
+	x := uint64(math.MaxUint64)
+	ys := []uint64{1, 0, math.MaxUint64, 0}
+	selects := []int64{0, 2}
+	rs := make([]uint64, len(ys))
+	rs = Uint64IntegerDivScalarSels(x, ys, rs, selects)
+	require.Equal(t, []uint64{math.MaxUint64, 0, 1, 0}, rs)
+}
+
+func TestUint64IntegerDivByScalar(t *testing.T) {
+	x := uint64(7)
+	ys := []uint64{math.MaxUint64, math.MaxUint64 - 1, 6, 1<<53 + 1}
+	rs := make([]uint64, len(ys))
+	rs = Uint64IntegerDivByScalar(x, ys, rs)
+	require.Equal(t, []uint64{math.MaxUint64 / 7, (math.MaxUint64 - 1) / 7, 0, (1<<53 + 1) / 7}, rs)
+}
+
+func TestUint64IntegerDivByScalarSels(t *testing.T) {
+	x := uint64(7)
+	ys := []uint64{math.MaxUint64, math.MaxUint64 - 1, 6, 1<<53 + 1}
+	selects := []int64{1, 3}
+	rs := make([]uint64, len(ys))
+	rs = Uint64IntegerDivByScalarSels(x, ys, rs, selects)
+	require.Equal(t, []uint64{0, (math.MaxUint64 - 1) / 7, 0, (1<<53 + 1) / 7}, rs)
+}
+
+// BenchmarkIntegerDiv compares DIV of BIGINTs by the integer kernel with the
+// conversion of the operands to float64 for the float kernel.
+func BenchmarkIntegerDiv(b *testing.B) {
+	const n = 8192
+	xs, ys, rs := make([]int64, n), make([]int64, n), make([]int64, n)
+	for i := range xs {
+		xs[i] = int64(i) * 7919
+		ys[i] = int64(i%97) + 1
+	}
+	b.Run("int64", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Int64IntegerDiv(xs, ys, rs)
+		}
+	})
+	b.Run("float64", func(b *testing.B) {
+		fxs, fys := make([]float64, n), make([]float64, n)
+		for i := 0; i < b.N; i++ {
+			for j := range xs {
+				fxs[j], fys[j] = float64(xs[j]), float64(ys[j])
+			}
+			Float64IntegerDiv(fxs, fys, rs)
+		}
+	})
+}
+
+func TestDecimal64DivByScalarSels(t *testing.T) {
+	// 1.00, 2.00 and 4.00 divided by 3.0
+	x := types.Decimal64(30)
+	ys := []types.Decimal64{100, 200, 400}
+	rs := make([]types.Decimal128, len(ys))
+	rs = Decimal64DivByScalarSels(x, ys, 1, 2, rs, []int64{0, 2})
+	require.Equal(t, []types.Decimal128{types.InitDecimal128(333333), {}, types.InitDecimal128(1333333)}, rs)
+}
+
+func TestDecimal128DivByScalarSels(t *testing.T) {
+	x := types.InitDecimal128(30)
+	ys := []types.Decimal128{types.InitDecimal128(100), types.InitDecimal128(200), types.InitDecimal128(-400)}
+	rs := make([]types.Decimal128, len(ys))
+	rs = Decimal128DivByScalarSels(x, ys, 1, 2, rs, []int64{1, 2})
+	require.Equal(t, []types.Decimal128{{}, types.InitDecimal128(666667), types.InitDecimal128(-1333333)}, rs)
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hour
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func parseDatetime(s string) types.Datetime {
+	dt, _ := types.ParseDatetime(s)
+	return dt
+}
+
+func TestDatetimeToHour(t *testing.T) {
+	type args struct {
+		xs []types.Datetime
+		rs []uint8
+	}
+	tests := []struct {
+		name string
+		args args
+		want []uint8
+	}{
+		{
+			name: "normal datetime test",
+			args: args{
+				xs: []types.Datetime{
+					parseDatetime("1899-12-31 23:59:59.999999"),
+					parseDatetime("1900-01-01 00:00:00"),
+					parseDatetime("2000-02-29 12:34:56.000001"),
+					parseDatetime("2022-06-30 01:02:03.5"),
+				},
+				rs: make([]uint8, 4),
+			},
+			want: []uint8{23, 0, 12, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DatetimeToHour(tt.args.xs, tt.args.rs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DatetimeToHour() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func benchmarkDatetimes() []types.Datetime {
+	xs := make([]types.Datetime, 1000000)
+	start := parseDatetime("1900-01-01 00:00:00")
+	for i := range xs {
+		xs[i] = start + types.Datetime(int64(i)*7919<<20)
+	}
+	return xs
+}
+
+func BenchmarkDatetimeToHour(b *testing.B) {
+	xs := benchmarkDatetimes()
+	rs := make([]uint8, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DatetimeToHour(xs, rs)
+	}
+}
+
+// BenchmarkDatetimeToHourByGoTime is the hour by the go time of the datetime
+func BenchmarkDatetimeToHourByGoTime(b *testing.B) {
+	xs := benchmarkDatetimes()
+	rs := make([]uint8, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			rs[j] = uint8(x.ConvertToGoTime().Hour())
+		}
+	}
+}
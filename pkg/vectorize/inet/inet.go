@@ -0,0 +1,377 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inet
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"golang.org/x/exp/constraints"
+)
+
+// The addresses are converted the way of MySQL. INET_ATON accepts the short
+// forms of IPv4 addresses, a.b is a.0.0.b and a.b.c is a.b.0.c, while
+// INET6_ATON only accepts the full dotted quad, alone or at the end of an
+// IPv6 address. The binary addresses of INET6_ATON and INET6_NTOA are 4 bytes
+// for IPv4 and 16 bytes for IPv6, in network byte order.
+//
+// The nulls ns of a kernel holds the null rows of its input, which are
+// skipped, and the rows of a malformed address are added to it. The string
+// results are written into rs.Data, whose capacity is expected to be the size
+// returned by the Size function of the kernel.
+
+var (
+	InetAton      func(*types.Bytes, []uint32, *nulls.Nulls) []uint32
+	Inet6Aton     func(*types.Bytes, *types.Bytes, *nulls.Nulls) *types.Bytes
+	Inet6AtonSize func(*types.Bytes, *nulls.Nulls) int
+	Inet6Ntoa     func(*types.Bytes, *types.Bytes, *nulls.Nulls) *types.Bytes
+	Inet6NtoaSize func(*types.Bytes, *nulls.Nulls) int
+)
+
+func init() {
+	InetAton = inetAton
+	Inet6Aton = inet6Aton
+	Inet6AtonSize = inet6AtonSize
+	Inet6Ntoa = inet6Ntoa
+	Inet6NtoaSize = inet6NtoaSize
+}
+
+func inetAton(xs *types.Bytes, rs []uint32, ns *nulls.Nulls) []uint32 {
+	for i := range xs.Lengths {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		v, ok := parseIPv4(xs.Get(int64(i)), false)
+		if !ok {
+			nulls.Add(ns, uint64(i))
+		}
+		rs[i] = v
+	}
+	return rs
+}
+
+// InetNtoaSize returns the size of the addresses formatted by InetNtoa
+func InetNtoaSize[T constraints.Integer](xs []T, ns *nulls.Nulls) int {
+	size := 0
+	for i, x := range xs {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		if v, ok := toIPv4[T](x); ok {
+			size += ipv4TextSize(v)
+		}
+	}
+	return size
+}
+
+func InetNtoa[T constraints.Integer](xs []T, rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+	for i, x := range xs {
+		offset := len(rs.Data)
+		if nulls.Contains(ns, uint64(i)) {
+			rs.Offsets[i] = uint32(offset)
+			continue
+		}
+		if v, ok := toIPv4[T](x); ok {
+			rs.Data = appendIPv4(rs.Data, v)
+		} else {
+			nulls.Add(ns, uint64(i))
+		}
+		rs.Offsets[i] = uint32(offset)
+		rs.Lengths[i] = uint32(len(rs.Data) - offset)
+	}
+	return rs
+}
+
+func inet6AtonSize(xs *types.Bytes, ns *nulls.Nulls) int {
+	size := 0
+	for i := range xs.Lengths {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		if _, n, ok := parseIP(xs.Get(int64(i))); ok {
+			size += n
+		}
+	}
+	return size
+}
+
+func inet6Aton(xs *types.Bytes, rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+	for i := range xs.Lengths {
+		offset := len(rs.Data)
+		if nulls.Contains(ns, uint64(i)) {
+			rs.Offsets[i] = uint32(offset)
+			continue
+		}
+		if ip, n, ok := parseIP(xs.Get(int64(i))); ok {
+			rs.Data = append(rs.Data, ip[16-n:]...)
+		} else {
+			nulls.Add(ns, uint64(i))
+		}
+		rs.Offsets[i] = uint32(offset)
+		rs.Lengths[i] = uint32(len(rs.Data) - offset)
+	}
+	return rs
+}
+
+func inet6NtoaSize(xs *types.Bytes, ns *nulls.Nulls) int {
+	var buf [64]byte
+	size := 0
+	for i := range xs.Lengths {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		size += len(formatIP(buf[:0], xs.Get(int64(i))))
+	}
+	return size
+}
+
+func inet6Ntoa(xs *types.Bytes, rs *types.Bytes, ns *nulls.Nulls) *types.Bytes {
+	for i := range xs.Lengths {
+		offset := len(rs.Data)
+		if nulls.Contains(ns, uint64(i)) {
+			rs.Offsets[i] = uint32(offset)
+			continue
+		}
+		x := xs.Get(int64(i))
+		if len(x) == 4 || len(x) == 16 {
+			rs.Data = formatIP(rs.Data, x)
+		} else {
+			nulls.Add(ns, uint64(i))
+		}
+		rs.Offsets[i] = uint32(offset)
+		rs.Lengths[i] = uint32(len(rs.Data) - offset)
+	}
+	return rs
+}
+
+func toIPv4[T constraints.Integer](x T) (uint32, bool) {
+	if x < 0 || uint64(x) > 0xffffffff {
+		return 0, false
+	}
+	return uint32(x), true
+}
+
+// parseIPv4 parses the dotted decimal address s, every part is at most 255
+// and leading zeros are decimal. The short forms are accepted unless strict.
+func parseIPv4(s []byte, strict bool) (uint32, bool) {
+	var parts [4]uint32
+	n := 0
+	for i := 0; ; i++ {
+		if n == 4 {
+			return 0, false
+		}
+		j := i
+		for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			parts[n] = parts[n]*10 + uint32(s[j]-'0')
+			if parts[n] > 255 || strict && j-i == 3 {
+				return 0, false
+			}
+			j++
+		}
+		if j == i {
+			return 0, false
+		}
+		n++
+		if i = j; i == len(s) {
+			break
+		}
+		if s[i] != '.' {
+			return 0, false
+		}
+	}
+	if strict && n != 4 {
+		return 0, false
+	}
+	// the last part is the last byte, the missing ones are zeros before it
+	v := parts[n-1]
+	for k := 0; k < n-1; k++ {
+		v |= parts[k] << (24 - 8*k)
+	}
+	return v, true
+}
+
+// parseIPv6 parses the address s of 8 groups of hex digits, a run of zero
+// groups could be written as :: and the last 2 groups could be an IPv4
+// address.
+func parseIPv6(s []byte) (ip [16]byte, ok bool) {
+	var words [8]uint16
+	n, gap := 0, -1
+	i := 0
+	if len(s) >= 2 && s[0] == ':' && s[1] == ':' {
+		gap, i = 0, 2
+	}
+	for i < len(s) {
+		if n == 8 {
+			return
+		}
+		j := i
+		var w uint32
+		for j < len(s) && j-i <= 4 {
+			d, isHex := fromHex(s[j])
+			if !isHex {
+				break
+			}
+			w = w<<4 | d
+			j++
+		}
+		if j < len(s) && s[j] == '.' {
+			v4, isV4 := parseIPv4(s[i:], true)
+			if !isV4 || n > 6 {
+				return
+			}
+			words[n], words[n+1] = uint16(v4>>16), uint16(v4)
+			n += 2
+			break
+		}
+		if j == i || j-i > 4 {
+			return
+		}
+		words[n] = uint16(w)
+		n++
+		if i = j; i == len(s) {
+			break
+		}
+		if s[i] != ':' {
+			return
+		}
+		if i++; i < len(s) && s[i] == ':' {
+			if gap >= 0 {
+				return
+			}
+			gap = n
+			i++
+		} else if i == len(s) {
+			return
+		}
+	}
+	if gap < 0 && n != 8 || gap >= 0 && n == 8 {
+		return
+	}
+	if gap >= 0 {
+		tail := n - gap
+		copy(words[8-tail:], words[gap:n])
+		for k := gap; k < 8-tail; k++ {
+			words[k] = 0
+		}
+	}
+	for k, w := range words {
+		binary.BigEndian.PutUint16(ip[2*k:], w)
+	}
+	return ip, true
+}
+
+// parseIP parses the IPv4 or IPv6 address s into the last n bytes of ip
+func parseIP(s []byte) (ip [16]byte, n int, ok bool) {
+	if v4, isV4 := parseIPv4(s, true); isV4 {
+		binary.BigEndian.PutUint32(ip[12:], v4)
+		return ip, 4, true
+	}
+	ip, ok = parseIPv6(s)
+	return ip, 16, ok
+}
+
+func fromHex(c byte) (uint32, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return uint32(c - '0'), true
+	case c >= 'a' && c <= 'f':
+		return uint32(c-'a') + 10, true
+	case c >= 'A' && c <= 'F':
+		return uint32(c-'A') + 10, true
+	}
+	return 0, false
+}
+
+func ipv4TextSize(v uint32) int {
+	size := 3
+	for k := 0; k < 4; k++ {
+		switch b := byte(v >> (8 * k)); {
+		case b >= 100:
+			size += 3
+		case b >= 10:
+			size += 2
+		default:
+			size++
+		}
+	}
+	return size
+}
+
+func appendIPv4(dst []byte, v uint32) []byte {
+	for k := 0; k < 4; k++ {
+		if k > 0 {
+			dst = append(dst, '.')
+		}
+		dst = strconv.AppendUint(dst, uint64(byte(v>>(24-8*k))), 10)
+	}
+	return dst
+}
+
+// formatIP appends the text of the 4 or 16 bytes address ip to dst. Like
+// MySQL, the first longest run of more than one zero group is written as ::,
+// and the IPv4-compatible and IPv4-mapped addresses end with the IPv4 address.
+func formatIP(dst []byte, ip []byte) []byte {
+	switch len(ip) {
+	case 4:
+		return appendIPv4(dst, binary.BigEndian.Uint32(ip))
+	case 16:
+	default:
+		return dst
+	}
+	var words [8]uint16
+	for k := range words {
+		words[k] = binary.BigEndian.Uint16(ip[2*k:])
+	}
+	gapPos, gapLen := -1, 0
+	for i := 0; i < 8; {
+		if words[i] != 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < 8 && words[j] == 0 {
+			j++
+		}
+		if j-i > gapLen {
+			gapPos, gapLen = i, j-i
+		}
+		i = j
+	}
+	if gapLen < 2 {
+		gapPos, gapLen = -1, 0
+	}
+	if gapPos == 0 && gapLen == 6 {
+		dst = append(dst, "::"...)
+		return appendIPv4(dst, binary.BigEndian.Uint32(ip[12:]))
+	}
+	if gapPos == 0 && gapLen == 5 && words[5] == 0xffff {
+		dst = append(dst, "::ffff:"...)
+		return appendIPv4(dst, binary.BigEndian.Uint32(ip[12:]))
+	}
+	for i := 0; i < 8; i++ {
+		if i == gapPos {
+			dst = append(dst, "::"...)
+			i += gapLen - 1
+			continue
+		}
+		if i > 0 && i != gapPos+gapLen {
+			dst = append(dst, ':')
+		}
+		dst = strconv.AppendUint(dst, uint64(words[i]), 16)
+	}
+	return dst
+}
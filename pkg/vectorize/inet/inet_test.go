@@ -0,0 +1,165 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(xs []string) *types.Bytes {
+	bs := &types.Bytes{
+		Offsets: make([]uint32, len(xs)),
+		Lengths: make([]uint32, len(xs)),
+	}
+	for i, x := range xs {
+		bs.Offsets[i] = uint32(len(bs.Data))
+		bs.Lengths[i] = uint32(len(x))
+		bs.Data = append(bs.Data, x...)
+	}
+	return bs
+}
+
+func makeResult(n, size int) *types.Bytes {
+	return &types.Bytes{
+		Data:    make([]byte, 0, size),
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func TestInetAton(t *testing.T) {
+	cases := []struct {
+		addr string
+		want uint32
+		null bool
+	}{
+		{addr: "10.0.5.9", want: 167773449},
+		{addr: "255.255.255.255", want: 0xffffffff},
+		{addr: "0.0.0.0", want: 0},
+		{addr: "127.1", want: 0x7f000001},
+		{addr: "127.1.2", want: 0x7f010002},
+		{addr: "7", want: 7},
+		{addr: "010.000.005.009", want: 167773449},
+		{addr: "256.0.0.1", null: true},
+		{addr: "1.2.3.4.5", null: true},
+		{addr: "1..3.4", null: true},
+		{addr: "1.2.3.", null: true},
+		{addr: ".1.2.3", null: true},
+		{addr: "1.2.3.x", null: true},
+		{addr: " 1.2.3.4", null: true},
+		{addr: "", null: true},
+	}
+	xs := make([]string, len(cases))
+	for i, c := range cases {
+		xs[i] = c.addr
+	}
+	ns := new(nulls.Nulls)
+	rs := InetAton(makeBytes(xs), make([]uint32, len(xs)), ns)
+	for i, c := range cases {
+		require.Equal(t, c.null, nulls.Contains(ns, uint64(i)), c.addr)
+		if !c.null {
+			require.Equal(t, c.want, rs[i], c.addr)
+		}
+	}
+}
+
+func TestInetNtoa(t *testing.T) {
+	xs := []int64{167773449, 0, 0xffffffff, -1, 0x100000000, 0x7f000001}
+	// the row 1 is null, and so skipped
+	ns := new(nulls.Nulls)
+	nulls.Add(ns, 1)
+	size := InetNtoaSize(xs, ns)
+	rs := InetNtoa(xs, makeResult(len(xs), size), ns)
+	require.Equal(t, size, len(rs.Data))
+	require.Equal(t, size, cap(rs.Data))
+	want := []string{"10.0.5.9", "", "255.255.255.255", "", "", "127.0.0.1"}
+	for i := range xs {
+		require.Equal(t, i == 1 || i == 3 || i == 4, nulls.Contains(ns, uint64(i)))
+		require.Equal(t, want[i], string(rs.Get(int64(i))))
+	}
+}
+
+func TestInet6(t *testing.T) {
+	cases := []struct {
+		addr string
+		bin  string // hex of the binary address, empty if malformed
+		text string // the address formatted by Inet6Ntoa
+	}{
+		{addr: "10.0.5.9", bin: "0a000509", text: "10.0.5.9"},
+		{addr: "fdfe::5a55:caff:fefa:9089", bin: "fdfe0000000000005a55cafffefa9089", text: "fdfe::5a55:caff:fefa:9089"},
+		{addr: "FDFE:0:0:0:5A55:CAFF:FEFA:9089", bin: "fdfe0000000000005a55cafffefa9089", text: "fdfe::5a55:caff:fefa:9089"},
+		{addr: "::", bin: "00000000000000000000000000000000", text: "::"},
+		{addr: "::1", bin: "00000000000000000000000000000001", text: "::1"},
+		{addr: "1::", bin: "00010000000000000000000000000000", text: "1::"},
+		{addr: "1:0:0:2:0:0:0:3", bin: "00010000000000020000000000000003", text: "1:0:0:2::3"},
+		{addr: "1:0:0:2:3:0:0:4", bin: "00010000000000020003000000000004", text: "1::2:3:0:0:4"},
+		{addr: "1:2:3:4:5:6:0:8", bin: "00010002000300040005000600000008", text: "1:2:3:4:5:6:0:8"},
+		{addr: "::ffff:1.2.3.4", bin: "00000000000000000000ffff01020304", text: "::ffff:1.2.3.4"},
+		{addr: "::1.2.3.4", bin: "00000000000000000000000001020304", text: "::1.2.3.4"},
+		{addr: "1:2:3:4:5:6:1.2.3.4", bin: "00010002000300040005000601020304", text: "1:2:3:4:5:6:102:304"},
+		{addr: "127.1"},
+		{addr: "1.2.3.0004"},
+		{addr: "1.2.3.256"},
+		{addr: ":::"},
+		{addr: "1::2::3"},
+		{addr: "1:2:3:4:5:6:7"},
+		{addr: "1:2:3:4:5:6:7:8:9"},
+		{addr: "1:2:3:4::5:6:7:8"},
+		{addr: "12345::"},
+		{addr: ":1::"},
+		{addr: "1:"},
+		{addr: "1:2:3:4:5:6:7:1.2.3.4"},
+		{addr: "::1.2.3"},
+		{addr: "g::"},
+		{addr: ""},
+	}
+	xs := make([]string, len(cases))
+	for i, c := range cases {
+		xs[i] = c.addr
+	}
+	ns := new(nulls.Nulls)
+	size := Inet6AtonSize(makeBytes(xs), ns)
+	bin := Inet6Aton(makeBytes(xs), makeResult(len(xs), size), ns)
+	require.Equal(t, size, len(bin.Data))
+	require.Equal(t, size, cap(bin.Data))
+	for i, c := range cases {
+		require.Equal(t, c.bin == "", nulls.Contains(ns, uint64(i)), c.addr)
+		require.Equal(t, c.bin, hex.EncodeToString(bin.Get(int64(i))), c.addr)
+	}
+
+	// the binary addresses are formatted back, the malformed ones are
+	// empty and so null
+	ns = new(nulls.Nulls)
+	size = Inet6NtoaSize(bin, ns)
+	text := Inet6Ntoa(bin, makeResult(len(xs), size), ns)
+	require.Equal(t, size, len(text.Data))
+	require.Equal(t, size, cap(text.Data))
+	for i, c := range cases {
+		require.Equal(t, c.bin == "", nulls.Contains(ns, uint64(i)), c.addr)
+		require.Equal(t, c.text, string(text.Get(int64(i))), c.addr)
+	}
+
+	// and the formatted addresses are parsed into the same binary
+	ns = new(nulls.Nulls)
+	again := Inet6Aton(text, makeResult(len(xs), size), ns)
+	for i, c := range cases {
+		require.Equal(t, c.bin, hex.EncodeToString(again.Get(int64(i))), c.text)
+	}
+}
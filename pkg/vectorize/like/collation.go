@@ -0,0 +1,67 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package like
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// GeneralCI reports whether s matches the LIKE pattern p under
+// utf8mb4_general_ci. '%' matches any sequence, '_' matches a single rune and
+// '\' escapes the next rune. Both sides are folded rune by rune while matching.
+func GeneralCI(s, p []byte) bool {
+	var i, j int
+	star, mark := -1, 0
+	for i < len(s) {
+		if j < len(p) {
+			switch c := p[j]; c {
+			case '%':
+				star, mark = j, i
+				j++
+				continue
+			case '_':
+				_, n := utf8.DecodeRune(s[i:])
+				i += n
+				j++
+				continue
+			default:
+				k := j
+				if c == '\\' && k+1 < len(p) {
+					k++
+				}
+				rs, ns := types.FoldGeneralCIRune(s[i:])
+				rp, np := types.FoldGeneralCIRune(p[k:])
+				if rs == rp {
+					i += ns
+					j = k + np
+					continue
+				}
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		// backtrack, let the last '%' swallow one more rune
+		_, n := utf8.DecodeRune(s[mark:])
+		mark += n
+		i, j = mark, star+1
+	}
+	for j < len(p) && p[j] == '%' {
+		j++
+	}
+	return j == len(p)
+}
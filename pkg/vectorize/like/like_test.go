@@ -552,3 +552,28 @@ func Test_sliceNullLikePure(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneralCI(t *testing.T) {
+	kases := []struct {
+		s, p string
+		r    bool
+	}{
+		{"Hello", "hello", true},
+		{"Hello", "HEL%", true},
+		{"Hello", "%LLO", true},
+		{"Hello", "h_l%o", true},
+		{"Hello", "%x%", false},
+		{"ÉMILE", "é%", true},
+		{"abc", "ABC ", false},
+		{"a%c", "A\\%C", true},
+		{"abc", "A\\%C", false},
+		{"aXbXc", "%x%X%", true},
+		{"", "%", true},
+		{"", "_", false},
+	}
+	for _, k := range kases {
+		if got := GeneralCI([]byte(k.s), []byte(k.p)); got != k.r {
+			t.Errorf("GeneralCI(%q, %q) = %v, want %v", k.s, k.p, got, k.r)
+		}
+	}
+}
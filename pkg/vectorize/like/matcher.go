@@ -0,0 +1,171 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package like
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+type tokenKind int8
+
+const (
+	// literal matches its bytes
+	literal tokenKind = iota
+	// anyRune matches a single rune, it is '_'
+	anyRune
+	// anySeq matches any sequence of runes, it is '%'
+	anySeq
+)
+
+type token struct {
+	kind tokenKind
+	lit  []byte
+}
+
+// Matcher is a LIKE pattern compiled once for all the values it is matched
+// with. The simple patterns are matched by the byte comparisons of their
+// kind, the complex ones by the tokens of the pattern.
+type Matcher struct {
+	Pattern
+	tokens []token
+}
+
+// Compile compiles the LIKE pattern expr, it has the escapes of Analyze
+func Compile(expr []byte) *Matcher {
+	m := &Matcher{Pattern: Analyze(expr)}
+	if m.Kind != Complex {
+		return m
+	}
+	var lit []byte
+	flush := func() {
+		if len(lit) > 0 {
+			m.tokens = append(m.tokens, token{kind: literal, lit: lit})
+			lit = nil
+		}
+	}
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; c {
+		case '_':
+			flush()
+			m.tokens = append(m.tokens, token{kind: anyRune})
+			i++
+		case '%':
+			flush()
+			// successive '%'s are one
+			if n := len(m.tokens); n == 0 || m.tokens[n-1].kind != anySeq {
+				m.tokens = append(m.tokens, token{kind: anySeq})
+			}
+			i++
+		default:
+			if c == '\\' {
+				if i++; i == len(expr) {
+					break
+				}
+			}
+			_, n := utf8.DecodeRune(expr[i:])
+			lit = append(lit, expr[i:i+n]...)
+			i += n
+		}
+	}
+	flush()
+	return m
+}
+
+// Match reports whether p matches the pattern
+func (m *Matcher) Match(p []byte) bool {
+	if m.Kind != Complex {
+		return m.Pattern.Match(p)
+	}
+	return m.matchTokens(p)
+}
+
+// MatchSlice returns the rows of s matching the pattern in rs, the rows in
+// nulls are skipped if nulls is not nil
+func (m *Matcher) MatchSlice(s *types.Bytes, nulls *roaring.Bitmap, rs []int64) []int64 {
+	if m.Kind != Complex {
+		return m.Pattern.MatchSlice(s, nulls, rs)
+	}
+	hasNull := nulls != nil && !nulls.IsEmpty()
+	count := 0
+	for i := range s.Offsets {
+		if hasNull && nulls.Contains(uint64(i)) {
+			continue
+		}
+		if m.matchTokens(s.Get(int64(i))) {
+			rs[count] = int64(i)
+			count++
+		}
+	}
+	return rs[:count]
+}
+
+// matchTokens matches p with the tokens. It is greedy and only backtracks to
+// the last '%', which lets it swallow one more rune, the earlier '%'s never
+// need to swallow more since the tokens between them matched at the leftmost.
+func (m *Matcher) matchTokens(p []byte) bool {
+	i, j := 0, 0
+	star, mark := -1, 0
+	for {
+		if j < len(m.tokens) {
+			tok := m.tokens[j]
+			switch tok.kind {
+			case anySeq:
+				if j+1 == len(m.tokens) {
+					// a trailing '%' swallows the rest
+					return true
+				}
+				star, mark = j, i
+				j++
+				continue
+			case anyRune:
+				if i < len(p) {
+					_, n := utf8.DecodeRune(p[i:])
+					i += n
+					j++
+					continue
+				}
+			case literal:
+				if star == j-1 && star >= 0 {
+					// jump to the next occurrence of the literal after the '%'
+					if k := bytes.Index(p[i:], tok.lit); k >= 0 {
+						mark = i + k
+						i = mark + len(tok.lit)
+						j++
+						continue
+					}
+					return false
+				}
+				if bytes.HasPrefix(p[i:], tok.lit) {
+					i += len(tok.lit)
+					j++
+					continue
+				}
+			}
+		} else if i == len(p) {
+			return true
+		}
+		if star < 0 || mark >= len(p) {
+			return false
+		}
+		// backtrack, let the last '%' swallow one more rune
+		_, n := utf8.DecodeRune(p[mark:])
+		mark += n
+		i, j = mark, star+1
+	}
+}
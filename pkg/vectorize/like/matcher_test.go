@@ -0,0 +1,182 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package like
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/stretchr/testify/require"
+)
+
+// likeRegexp is the reference of the matcher, the LIKE pattern translated to
+// a regular expression
+func likeRegexp(expr string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^(?s:")
+	for i := 0; i < len(expr); {
+		r, n := utf8.DecodeRuneInString(expr[i:])
+		switch r {
+		case '_':
+			sb.WriteString(".")
+		case '%':
+			sb.WriteString(".*")
+		case '\\':
+			if i+n < len(expr) {
+				i += n
+				r, n = utf8.DecodeRuneInString(expr[i:])
+				sb.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+		i += n
+	}
+	sb.WriteString(")$")
+	return regexp.MustCompile(sb.String())
+}
+
+var likeCorpus = []string{
+	"", "a", "ab", "abc", "abcabc", "aXbXc", "ba", "cab", "a.c", "a%c", "a_c", `a\c`,
+	"中", "中文", "中文字符", "字符中文", "日本語のテキスト", "é", "café", "cafe", "naïve résumé",
+	"a中c", "中a中", "🙂", "a🙂b", "🙂🙂🙂", "mixed 中 and é and 🙂", "  trailing ", "new\nline",
+}
+
+var likePatterns = []string{
+	"", "%", "%%", "_", "__", "___", "a", "a%", "%a", "%a%", "%b%", "abc", "a_c", "_b%", "%_", "_%",
+	"a%c", "a%b%c", "%a%c%", "%abc%abc", "a%%c", "%c_", "_中_", "中%", "%中", "%中%", "中_", "_文%",
+	"____", "%字%中%", "caf_", "caf%", "%é", "%é%", "_afé", "🙂", "_🙂_", "%🙂%🙂%", "a.c", `a\%c`,
+	`a\_c`, `a\\c`, `a\c`, `\%%`, `%\_`, `a\`, "%e%", "% %", "new_line", "%\n%", "mixed%_é%🙂",
+}
+
+func TestMatcher(t *testing.T) {
+	for _, expr := range likePatterns {
+		m, reg := Compile([]byte(expr)), likeRegexp(expr)
+		for _, s := range likeCorpus {
+			require.Equal(t, reg.MatchString(s), m.Match([]byte(s)), "%q like %q", s, expr)
+		}
+	}
+}
+
+// TestLikeKernels checks the kernels of each pattern on the corpus, with and
+// without nulls, against the reference
+func TestLikeKernels(t *testing.T) {
+	s := makeArgs(likeCorpus)
+	nulls := roaring.New()
+	for i := 0; i < len(likeCorpus); i += 3 {
+		nulls.Add(uint64(i))
+	}
+	for _, expr := range likePatterns {
+		reg := likeRegexp(expr)
+		var want, wantNotNull []int64
+		for i, v := range likeCorpus {
+			if reg.MatchString(v) {
+				want = append(want, int64(i))
+				if !nulls.Contains(uint64(i)) {
+					wantNotNull = append(wantNotNull, int64(i))
+				}
+			}
+		}
+
+		rs, err := BtSliceAndConst(s, []byte(expr), make([]int64, len(likeCorpus)))
+		require.NoError(t, err)
+		require.Equal(t, want, nilIfEmpty(rs), expr)
+
+		rs, err = BtSliceNullAndConst(s, []byte(expr), nulls, make([]int64, len(likeCorpus)))
+		require.NoError(t, err)
+		require.Equal(t, wantNotNull, nilIfEmpty(rs), expr)
+
+		// the same pattern on each row
+		exprs := make([]string, len(likeCorpus))
+		for i := range exprs {
+			exprs[i] = expr
+		}
+		rs, err = BtSliceAndSlice(s, makeArgs(exprs), make([]int64, len(likeCorpus)))
+		require.NoError(t, err)
+		require.Equal(t, want, nilIfEmpty(rs), expr)
+
+		rs, err = BtSliceNullAndSliceNull(s, makeArgs(exprs), nulls, make([]int64, len(likeCorpus)))
+		require.NoError(t, err)
+		require.Equal(t, wantNotNull, nilIfEmpty(rs), expr)
+	}
+
+	// a different pattern on each row
+	for _, v := range likeCorpus {
+		ps := makeArgs(likePatterns)
+		var want []int64
+		for i, expr := range likePatterns {
+			if likeRegexp(expr).MatchString(v) {
+				want = append(want, int64(i))
+			}
+		}
+		rs, err := BtConstAndSlice([]byte(v), ps, make([]int64, len(likePatterns)))
+		require.NoError(t, err)
+		require.Equal(t, want, nilIfEmpty(rs), v)
+	}
+}
+
+func nilIfEmpty(rs []int64) []int64 {
+	if len(rs) == 0 {
+		return nil
+	}
+	return rs
+}
+
+func TestCompile(t *testing.T) {
+	m := Compile([]byte(`a%%_\%b%`))
+	require.Equal(t, Complex, m.Kind)
+	require.Equal(t, []token{
+		{kind: literal, lit: []byte("a")},
+		{kind: anySeq},
+		{kind: anyRune},
+		{kind: literal, lit: []byte("%b")},
+		{kind: anySeq},
+	}, m.tokens)
+	require.Nil(t, Compile([]byte("ab%")).tokens)
+}
+
+func BenchmarkMatcher(b *testing.B) {
+	ss := make([]string, 8192)
+	for i := range ss {
+		ss[i] = fmt.Sprintf("行-%08d-matrixone", i)
+	}
+	s := makeArgs(ss)
+	rs := make([]int64, len(ss))
+	for _, expr := range []string{"行-0000%", "%-matrixone", "%0042%", "_-0000_%", "%00_2%one"} {
+		b.Run(fmt.Sprintf("%s/matcher", expr), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := BtSliceAndConst(s, []byte(expr), rs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("%s/regexp", expr), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				reg := likeRegexp(expr)
+				count := 0
+				for j := range s.Offsets {
+					if reg.Match(s.Get(int64(j))) {
+						rs[count] = int64(j)
+						count++
+					}
+				}
+			}
+		})
+	}
+}
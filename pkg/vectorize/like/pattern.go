@@ -0,0 +1,281 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package like
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// PatternKind is the class of a constant LIKE pattern, which decides the
+// kernel matching the values with it
+type PatternKind int
+
+const (
+	// Complex patterns are matched by the general matcher
+	Complex PatternKind = iota
+	// Exact patterns have no wildcard, the values equal the literal
+	Exact
+	// Prefix patterns are a literal followed by '%'s, the values start
+	// with the literal
+	Prefix
+	// Suffix patterns are '%'s followed by a literal, the values end with
+	// the literal
+	Suffix
+	// Contains patterns are a literal between '%'s, the values contain the
+	// literal
+	Contains
+)
+
+func (k PatternKind) String() string {
+	switch k {
+	case Exact:
+		return "exact"
+	case Prefix:
+		return "prefix"
+	case Suffix:
+		return "suffix"
+	case Contains:
+		return "contains"
+	}
+	return "complex"
+}
+
+// Pattern is a constant LIKE pattern analyzed once for all the values it
+// is matched with
+type Pattern struct {
+	Kind PatternKind
+	// Literal is the unescaped literal of the pattern, nil for the complex
+	// patterns
+	Literal []byte
+}
+
+// Analyze classifies the LIKE pattern expr. '\' escapes the next rune and a
+// trailing '\' is ignored as the general matcher does, the values are
+// compared by bytes so the case and the trailing spaces are significant.
+func Analyze(expr []byte) Pattern {
+	lit := make([]byte, 0, len(expr))
+	leading, trailing := false, false
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; c {
+		case '_':
+			return Pattern{Kind: Complex}
+		case '%':
+			if len(lit) == 0 {
+				leading = true
+			} else {
+				trailing = true
+			}
+			i++
+		default:
+			if trailing {
+				// a literal after the trailing '%'
+				return Pattern{Kind: Complex}
+			}
+			if c == '\\' {
+				if i++; i == len(expr) {
+					break
+				}
+			}
+			_, n := utf8.DecodeRune(expr[i:])
+			lit = append(lit, expr[i:i+n]...)
+			i += n
+		}
+	}
+	switch {
+	case leading && len(lit) == 0:
+		return Pattern{Kind: Contains, Literal: lit}
+	case leading && trailing:
+		return Pattern{Kind: Contains, Literal: lit}
+	case leading:
+		return Pattern{Kind: Suffix, Literal: lit}
+	case trailing:
+		return Pattern{Kind: Prefix, Literal: lit}
+	}
+	return Pattern{Kind: Exact, Literal: lit}
+}
+
+// PrefixRange returns the range of the values starting with prefix, they are
+// in [lo, hi). hi is nil if the range is unbounded.
+func PrefixRange(prefix []byte) (lo, hi []byte) {
+	lo = prefix
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			hi = make([]byte, i+1)
+			copy(hi, prefix[:i+1])
+			hi[i]++
+			break
+		}
+	}
+	return lo, hi
+}
+
+// Match reports whether p matches the LIKE pattern, p must not be complex
+func (pat Pattern) Match(p []byte) bool {
+	switch pat.Kind {
+	case Exact:
+		return bytes.Equal(p, pat.Literal)
+	case Prefix:
+		return bytes.HasPrefix(p, pat.Literal)
+	case Suffix:
+		return bytes.HasSuffix(p, pat.Literal)
+	case Contains:
+		return bytes.Contains(p, pat.Literal)
+	}
+	panic("the general matcher is required by the complex pattern")
+}
+
+// MatchSlice returns the rows of s matching the pattern in rs, the pattern
+// must not be complex. The rows in nulls are skipped if nulls is not nil.
+func (pat Pattern) MatchSlice(s *types.Bytes, nulls *roaring.Bitmap, rs []int64) []int64 {
+	switch pat.Kind {
+	case Exact:
+		rs = exactRows(s, pat.Literal, rs)
+	case Prefix:
+		rs = prefixRows(s, pat.Literal, rs)
+	case Suffix:
+		rs = suffixRows(s, pat.Literal, rs)
+	case Contains:
+		rs = containsRows(s, pat.Literal, rs)
+	default:
+		panic("the general matcher is required by the complex pattern")
+	}
+	if nulls == nil || nulls.IsEmpty() {
+		return rs
+	}
+	count := 0
+	for _, i := range rs {
+		if !nulls.Contains(uint64(i)) {
+			rs[count] = i
+			count++
+		}
+	}
+	return rs[:count]
+}
+
+func exactRows(s *types.Bytes, lit []byte, rs []int64) []int64 {
+	n := uint32(len(lit))
+	count := 0
+	for i := range s.Offsets {
+		if s.Lengths[i] == n && bytes.Equal(s.Get(int64(i)), lit) {
+			rs[count] = int64(i)
+			count++
+		}
+	}
+	return rs[:count]
+}
+
+// prefixRows returns the rows starting with prefix, which are the rows in the
+// byte range of PrefixRange(prefix)
+func prefixRows(s *types.Bytes, prefix []byte, rs []int64) []int64 {
+	count := 0
+	for i := range s.Offsets {
+		if bytes.HasPrefix(s.Get(int64(i)), prefix) {
+			rs[count] = int64(i)
+			count++
+		}
+	}
+	return rs[:count]
+}
+
+func suffixRows(s *types.Bytes, suffix []byte, rs []int64) []int64 {
+	count := 0
+	for i := range s.Offsets {
+		if bytes.HasSuffix(s.Get(int64(i)), suffix) {
+			rs[count] = int64(i)
+			count++
+		}
+	}
+	return rs[:count]
+}
+
+// containsRows returns the rows containing substr. The data of the rows is
+// searched as a whole if the rows are laid out in order, a match is mapped to
+// the row holding it and the search resumes at the next row.
+func containsRows(s *types.Bytes, substr []byte, rs []int64) []int64 {
+	count := 0
+	if len(substr) == 0 {
+		for i := range s.Offsets {
+			rs[count] = int64(i)
+			count++
+		}
+		return rs[:count]
+	}
+	if !inOrder(s) {
+		for i := range s.Offsets {
+			if bytes.Contains(s.Get(int64(i)), substr) {
+				rs[count] = int64(i)
+				count++
+			}
+		}
+		return rs[:count]
+	}
+	n := len(s.Offsets)
+	for i, pos := 0, 0; i < n; {
+		start := int(s.Offsets[i])
+		end := start + int(s.Lengths[i])
+		if pos < start {
+			pos = start
+		}
+		if end-pos < len(substr) {
+			i++
+			continue
+		}
+		k := bytes.Index(s.Data[pos:], substr)
+		if k < 0 {
+			break
+		}
+		k += pos
+		// skip the rows before the match
+		for i < n && int(s.Offsets[i]+s.Lengths[i]) <= k {
+			i++
+		}
+		if i == n {
+			break
+		}
+		start = int(s.Offsets[i])
+		end = start + int(s.Lengths[i])
+		switch {
+		case k < start:
+			// the match begins before the row, in the gap of the rows
+			pos = start
+		case k+len(substr) <= end:
+			rs[count] = int64(i)
+			count++
+			i++
+			pos = end
+		default:
+			// the match crosses the end of the row
+			pos = k + 1
+		}
+	}
+	return rs[:count]
+}
+
+// inOrder reports whether the rows of s are laid out in order without
+// overlapping in the data
+func inOrder(s *types.Bytes) bool {
+	end := uint32(0)
+	for i, off := range s.Offsets {
+		if off < end {
+			return false
+		}
+		end = off + s.Lengths[i]
+	}
+	return int(end) <= len(s.Data)
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package like
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func TestAnalyze(t *testing.T) {
+	kases := []struct {
+		expr string
+		kind PatternKind
+		lit  string
+	}{
+		{"", Exact, ""},
+		{"abc", Exact, "abc"},
+		{"abc ", Exact, "abc "},
+		{"abc%", Prefix, "abc"},
+		{"abc%%", Prefix, "abc"},
+		{"%abc", Suffix, "abc"},
+		{"%abc%", Contains, "abc"},
+		{"%", Contains, ""},
+		{"%%", Contains, ""},
+		{"a\\%", Exact, "a%"},
+		{"a\\_%", Prefix, "a_"},
+		{"%a\\%b%", Contains, "a%b"},
+		{"a\\\\%", Prefix, "a\\"},
+		{"a\\", Exact, "a"},
+		{"a_c", Complex, ""},
+		{"_abc%", Complex, ""},
+		{"a%c", Complex, ""},
+		{"%a%c%", Complex, ""},
+	}
+	for _, k := range kases {
+		pat := Analyze([]byte(k.expr))
+		if pat.Kind != k.kind {
+			t.Errorf("Analyze(%q) = %v, want %v", k.expr, pat.Kind, k.kind)
+			continue
+		}
+		if k.kind != Complex && string(pat.Literal) != k.lit {
+			t.Errorf("Analyze(%q) literal = %q, want %q", k.expr, pat.Literal, k.lit)
+		}
+	}
+}
+
+func TestPrefixRange(t *testing.T) {
+	kases := []struct {
+		prefix string
+		hi     []byte
+	}{
+		{"abc", []byte("abd")},
+		{"ab\xff", []byte("ac")},
+		{"\xff\xff", nil},
+	}
+	for _, k := range kases {
+		lo, hi := PrefixRange([]byte(k.prefix))
+		if string(lo) != k.prefix || !bytes.Equal(hi, k.hi) || (hi == nil) != (k.hi == nil) {
+			t.Errorf("PrefixRange(%q) = [%q, %q), want [%q, %q)", k.prefix, lo, hi, k.prefix, k.hi)
+		}
+	}
+}
+
+func TestMatchSlice(t *testing.T) {
+	s := makeArgs([]string{"abc", "abcd", "ab", "xabc", "ABC", "abc ", "a_c", "a%c", "", "abd"})
+	kases := []struct {
+		expr string
+		want []int64
+	}{
+		{"abc", []int64{0}},
+		{"abc ", []int64{5}},
+		{"abc%", []int64{0, 1, 5}},
+		{"%abc", []int64{0, 3}},
+		{"%abc%", []int64{0, 1, 3, 5}},
+		{"%bc%", []int64{0, 1, 3, 5}},
+		{"%", []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"", []int64{8}},
+		{"a\\_c", []int64{6}},
+		{"a\\%%", []int64{7}},
+		{"%\\%%", []int64{7}},
+	}
+	for _, k := range kases {
+		got, err := sliceLikePure(s, []byte(k.expr), make([]int64, len(s.Offsets)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, k.want) {
+			t.Errorf("sliceLikePure(%q) = %v, want %v", k.expr, got, k.want)
+		}
+		nulls := roaring.BitmapOf(0, 3)
+		got, err = sliceNullLikePure(s, []byte(k.expr), nulls, make([]int64, len(s.Offsets)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := make([]int64, 0, len(k.want))
+		for _, i := range k.want {
+			if !nulls.Contains(uint64(i)) {
+				want = append(want, i)
+			}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("sliceNullLikePure(%q) = %v, want %v", k.expr, got, want)
+		}
+	}
+}
+
+func TestContainsRows(t *testing.T) {
+	// the matches crossing the rows must be ignored
+	s := makeArgs([]string{"xa", "bx", "ab", "a", "b", "cab"})
+	got := containsRows(s, []byte("ab"), make([]int64, len(s.Offsets)))
+	if want := []int64{2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("containsRows() = %v, want %v", got, want)
+	}
+	// rows out of order are searched one by one
+	s = &types.Bytes{
+		Data:    []byte("cabxab"),
+		Offsets: []uint32{4, 0, 3},
+		Lengths: []uint32{2, 3, 1},
+	}
+	got = containsRows(s, []byte("ab"), make([]int64, len(s.Offsets)))
+	if want := []int64{0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("containsRows() = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkLike(b *testing.B) {
+	ss := make([]string, 8192)
+	for i := range ss {
+		ss[i] = fmt.Sprintf("row-%08d-matrixone", i)
+	}
+	s := makeArgs(ss)
+	rs := make([]int64, len(ss))
+	for _, expr := range []string{"row-0000%", "%-matrixone", "%0042%", "row-0000_%"} {
+		b.Run(fmt.Sprintf("%s/%v", expr, Analyze([]byte(expr)).Kind), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := sliceLikePure(s, []byte(expr), rs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lpad
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+)
+
+// Sizes returns the lengths of a pad function as int64s, a float is
+// truncated. It returns nil if the lengths are not numbers.
+func Sizes(sizes interface{}) ([]int64, error) {
+	switch col := sizes.(type) {
+	case []int64:
+		return col, nil
+	case []int32:
+		return typecast.Int32ToInt64(col, make([]int64, len(col)))
+	case []int16:
+		return typecast.Int16ToInt64(col, make([]int64, len(col)))
+	case []int8:
+		return typecast.Int8ToInt64(col, make([]int64, len(col)))
+	case []float64:
+		return typecast.Float64ToInt64(col, make([]int64, len(col)))
+	case []float32:
+		return typecast.Float32ToInt64(col, make([]int64, len(col)))
+	case []uint64:
+		sizes := make([]int64, len(col))
+		for i, v := range col {
+			if v > uint64(MaxLength) {
+				// too large to pad, the result is null
+				v = uint64(MaxLength) + 1
+			}
+			sizes[i] = int64(v)
+		}
+		return sizes, nil
+	case []uint32:
+		return typecast.Uint32ToInt64(col, make([]int64, len(col)))
+	case []uint16:
+		return typecast.Uint16ToInt64(col, make([]int64, len(col)))
+	case []uint8:
+		return typecast.Uint8ToInt64(col, make([]int64, len(col)))
+	}
+	return nil, nil
+}
+
+// Pads returns the pad strings of a pad function, the numbers are converted
+// to strings. It returns a constant empty string for the other types.
+func Pads(pads interface{}, isConst []bool) (*types.Bytes, error) {
+	res := &types.Bytes{}
+	var err error
+	switch col := pads.(type) {
+	case *types.Bytes:
+		res = col
+	case []int64:
+		_, err = typecast.Int64ToBytes(col, res)
+	case []int32:
+		_, err = typecast.Int32ToBytes(col, res)
+	case []int16:
+		_, err = typecast.Int16ToBytes(col, res)
+	case []int8:
+		_, err = typecast.Int8ToBytes(col, res)
+	case []uint64:
+		_, err = typecast.Uint64ToBytes(col, res)
+	case []uint32:
+		_, err = typecast.Uint32ToBytes(col, res)
+	case []uint16:
+		_, err = typecast.Uint16ToBytes(col, res)
+	case []uint8:
+		_, err = typecast.Uint8ToBytes(col, res)
+	case []float32:
+		_, err = typecast.Float32ToBytes(col, res)
+	case []float64:
+		_, err = typecast.Float64ToBytes(col, res)
+	default:
+		res = &types.Bytes{
+			Offsets: make([]uint32, 1),
+			Lengths: make([]uint32, 1),
+		}
+		isConst[2] = true
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -15,121 +15,136 @@
 package lpad
 
 import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
+// MaxLength is the largest length of a padded string, the result is null if
+// the length is negative or larger
+const MaxLength = int64(^uint16(0))
+
 var (
-	Lpad        func(res *types.Bytes, src *types.Bytes, length uint32, pad *types.Bytes) *types.Bytes
-	LpadVarchar func(a *types.Bytes, b []int64, c *types.Bytes) *types.Bytes
+	// Lpad pads the strings of strs on the left with pads to the lengths in
+	// sizes and appends them to res. The lengths count runes, a longer
+	// string is truncated to its length. isConst tells which arguments are
+	// constants, whose value is their first one. The rows in nsp are null,
+	// the rows with an invalid length are added to nsp.
+	Lpad func(res *types.Bytes, strs *types.Bytes, sizes []int64, pads *types.Bytes, isConst []bool, nsp *nulls.Nulls) *types.Bytes
+	// LpadLength returns the number of bytes of the strings made by Lpad, so
+	// the data of the result is allocated before padding
+	LpadLength func(strs *types.Bytes, sizes []int64, pads *types.Bytes, isConst []bool, nsp *nulls.Nulls) int64
 )
 
 func init() {
-	Lpad = lpadPure
-	LpadVarchar = lpadVarcharPure
+	Lpad = lpad
+	LpadLength = lpadLength
 }
 
-func lpadVarcharPure(a *types.Bytes, b []int64, c *types.Bytes) *types.Bytes {
-	var res = &types.Bytes{}
-	//in fact,the length of three slice is the same with each other
-	for i := 0; i < len(a.Lengths); i++ {
-		if a.Lengths[i] > uint32(b[0]) { //length less
-			res.Offsets = append(res.Offsets, uint32(len(res.Data)))
-			res.Data = append(res.Data, a.Data[a.Offsets[i]:a.Offsets[i]+uint32(b[0])]...)
-			res.Lengths = append(res.Lengths, uint32(b[0]))
-		} else {
-			lens := uint32(b[0]) - a.Lengths[i]
-			t1 := lens / c.Lengths[0]
-			t2 := lens % c.Lengths[0]
-			temp := []byte{}
-			for j := 0; j < int(t1); j++ {
-				temp = append(temp, c.Data[c.Offsets[0]:c.Offsets[0]+c.Lengths[0]]...)
+func lpad(res *types.Bytes, strs *types.Bytes, sizes []int64, pads *types.Bytes, isConst []bool, nsp *nulls.Nulls) *types.Bytes {
+	for i, n := 0, Rows(strs, sizes, pads, isConst); i < n; i++ {
+		offset := uint32(len(res.Data))
+		if s, size, pad, ok := Row(strs, sizes, pads, isConst, nsp, i); ok {
+			s, count := Truncate(s, size)
+			if count < size {
+				// an empty pad makes an empty string
+				if len(pad) > 0 {
+					res.Data = AppendPadding(res.Data, pad, size-count)
+					res.Data = append(res.Data, s...)
+				}
+			} else {
+				res.Data = append(res.Data, s...)
 			}
-			temp = append(temp, c.Data[c.Offsets[0]:c.Offsets[0]+t2]...)
-			temp = append(temp, a.Data[a.Offsets[i]:a.Offsets[i]+a.Lengths[i]]...)
-
-			res.Offsets = append(res.Offsets, uint32(len(res.Data)))
-			res.Data = append(res.Data, temp...)
-			res.Lengths = append(res.Lengths, uint32(len(temp)))
+		} else if !nulls.Contains(nsp, uint64(i)) {
+			nulls.Add(nsp, uint64(i))
 		}
+		res.Offsets = append(res.Offsets, offset)
+		res.Lengths = append(res.Lengths, uint32(len(res.Data))-offset)
 	}
 	return res
 }
 
-func lpadPure(res *types.Bytes, src *types.Bytes, length uint32, pad *types.Bytes) *types.Bytes {
-	var retCursor uint32 = 0
-	padLengh := pad.Lengths[0]
-	padOffset := pad.Offsets[0]
-	padBytes := pad.Data[padOffset:padLengh]
-	for idx, offset := range src.Offsets {
-		cursor := offset
-		curLen := src.Lengths[idx]
-		bytes := src.Data[cursor : cursor+curLen]
-
-		if length <= src.Lengths[idx] {
-			slice, size := getSliceFromLeft(bytes, length)
-			for _, b := range slice {
-				res.Data[retCursor] = b
-				retCursor++
-			}
-			if idx != 0 {
-				res.Offsets[idx] = res.Offsets[idx-1] + res.Lengths[idx-1]
-			} else {
-				res.Offsets[idx] = uint32(0)
-			}
-			res.Lengths[idx] = uint32(size)
-		} else {
-			diff := length - curLen
-			if diff <= padLengh {
-				leftpad, size := getSliceFromLeft(padBytes, diff)
-				for _, b := range leftpad {
-					res.Data[retCursor] = b
-					retCursor++
+func lpadLength(strs *types.Bytes, sizes []int64, pads *types.Bytes, isConst []bool, nsp *nulls.Nulls) int64 {
+	var length int64
+	for i, n := 0, Rows(strs, sizes, pads, isConst); i < n; i++ {
+		if s, size, pad, ok := Row(strs, sizes, pads, isConst, nsp, i); ok {
+			s, count := Truncate(s, size)
+			if count < size {
+				if len(pad) > 0 {
+					length += PaddingLength(pad, size-count) + int64(len(s))
 				}
-				for _, b := range bytes {
-					res.Data[retCursor] = b
-					retCursor++
-				}
-				if idx != 0 {
-					res.Offsets[idx] = res.Offsets[idx-1] + res.Lengths[idx-1]
-				} else {
-					res.Offsets[idx] = uint32(0)
-				}
-				res.Lengths[idx] = uint32(size) + curLen
 			} else {
-				frequency := diff / padLengh
-				remainder := diff % padLengh
-
-				for i := uint32(0); i < frequency; i++ {
-					for j := uint32(0); j < padLengh; j++ {
-						res.Data[retCursor] = padBytes[j]
-						retCursor++
-					}
-				}
-				for k := uint32(0); k < remainder; k++ {
-					res.Data[retCursor] = padBytes[k]
-					retCursor++
-				}
-				for _, b := range bytes {
-					res.Data[retCursor] = b
-					retCursor++
-				}
-				if idx != 0 {
-					res.Offsets[idx] = res.Offsets[idx-1] + res.Lengths[idx-1]
-				} else {
-					res.Offsets[idx] = uint32(0)
-				}
-				res.Lengths[idx] = uint32(length)
+				length += int64(len(s))
 			}
 		}
 	}
-	return res
+	return length
 }
 
-//Slice from left to right, starting from 0
-func getSliceFromLeft(bytes []byte, length uint32) ([]byte, uint32) {
-	elemsize := uint32(len(bytes))
-	if length > elemsize {
-		return bytes, elemsize
+// Rows returns the number of the rows of the arguments of a pad function
+func Rows(strs *types.Bytes, sizes []int64, pads *types.Bytes, isConst []bool) int {
+	switch {
+	case !isConst[0]:
+		return len(strs.Lengths)
+	case !isConst[1]:
+		return len(sizes)
+	case !isConst[2]:
+		return len(pads.Lengths)
 	}
-	return bytes[0:length], length
+	return 1
+}
+
+// Row returns the arguments of row i, ok is false if the result of the row
+// is null
+func Row(strs *types.Bytes, sizes []int64, pads *types.Bytes, isConst []bool, nsp *nulls.Nulls, i int) (s []byte, size int64, pad []byte, ok bool) {
+	if nulls.Contains(nsp, uint64(i)) {
+		return nil, 0, nil, false
+	}
+	j := [3]int64{int64(i), int64(i), int64(i)}
+	for k := range j {
+		if isConst[k] {
+			j[k] = 0
+		}
+	}
+	size = sizes[j[1]]
+	if size < 0 || size > MaxLength {
+		return nil, 0, nil, false
+	}
+	return strs.Get(j[0]), size, pads.Get(j[2]), true
+}
+
+// Truncate returns the first size runes of s and their count, which is
+// smaller than size if s is shorter
+func Truncate(s []byte, size int64) ([]byte, int64) {
+	if int64(len(s)) <= size {
+		return s, int64(utf8.RuneCount(s))
+	}
+	var count int64
+	for i := 0; i < len(s); count++ {
+		if count == size {
+			return s[:i], count
+		}
+		_, n := utf8.DecodeRune(s[i:])
+		i += n
+	}
+	return s, count
+}
+
+// AppendPadding appends count runes of the repeated pad to dst, pad must not
+// be empty
+func AppendPadding(dst []byte, pad []byte, count int64) []byte {
+	runes := int64(utf8.RuneCount(pad))
+	for ; count >= runes; count -= runes {
+		dst = append(dst, pad...)
+	}
+	pad, _ = Truncate(pad, count)
+	return append(dst, pad...)
+}
+
+// PaddingLength returns the number of bytes appended by AppendPadding
+func PaddingLength(pad []byte, count int64) int64 {
+	runes := int64(utf8.RuneCount(pad))
+	rest, _ := Truncate(pad, count%runes)
+	return count/runes*int64(len(pad)) + int64(len(rest))
 }
@@ -17,6 +17,7 @@ package lpad
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/require"
 )
@@ -43,9 +44,90 @@ func TestLpadVarchar(t *testing.T) {
 	results := []string{"??so??so??so??so??sohi", "??so??so??so??so??sohi", "??so??so??so?hishjajsa",
 		"??so??so?hish&sa*(#jsa", "??so??so?hish&sa*(#jsa", "??so??so??so?hishjajsa", "??so??so??so??so??sabc"}
 
-	or := LpadVarchar(origins, originsInt64, originsPadd)
+	or := Lpad(&types.Bytes{}, origins, originsInt64, originsPadd, []bool{false, true, true}, new(nulls.Nulls))
 
 	for i := range results {
 		require.Equal(t, []byte(results[i]), or.Data[or.Offsets[i]:or.Offsets[i]+or.Lengths[i]])
 	}
 }
+
+func getBytes(s ...string) *types.Bytes {
+	result := &types.Bytes{}
+	for _, v := range s {
+		result.Offsets = append(result.Offsets, uint32(len(result.Data)))
+		result.Data = append(result.Data, v...)
+		result.Lengths = append(result.Lengths, uint32(len(v)))
+	}
+	return result
+}
+
+func TestLpadMultiByte(t *testing.T) {
+	cases := []struct {
+		strs    *types.Bytes
+		sizes   []int64
+		pads    *types.Bytes
+		isConst []bool
+		nsp     []uint64
+		want    []string
+		nulls   []uint64
+	}{
+		{
+			// multi-byte pads are repeated by runes
+			strs:    getBytes("ab", "中文", "", "abcdef"),
+			sizes:   []int64{5},
+			pads:    getBytes("é"),
+			isConst: []bool{false, true, true},
+			want:    []string{"éééab", "ééé中文", "ééééé", "abcde"},
+		},
+		{
+			strs:    getBytes("ab"),
+			sizes:   []int64{1, 2, 3, 4, 7, 0},
+			pads:    getBytes("你好吗"),
+			isConst: []bool{true, false, true},
+			want:    []string{"a", "ab", "你ab", "你好ab", "你好吗你好ab", ""},
+		},
+		{
+			// strings are truncated by runes
+			strs:    getBytes("日本語のテキスト", "naïve", "🙂🙂🙂"),
+			sizes:   []int64{3, 4, 2},
+			pads:    getBytes("x", "", "🙂"),
+			isConst: []bool{false, false, false},
+			want:    []string{"日本語", "naïv", "🙂🙂"},
+		},
+		{
+			// an empty pad makes an empty string when padding is needed
+			strs:    getBytes("ab", "ab"),
+			sizes:   []int64{3, 2},
+			pads:    getBytes(""),
+			isConst: []bool{false, false, true},
+			want:    []string{"", "ab"},
+		},
+		{
+			// null arguments and invalid lengths make nulls
+			strs:    getBytes("a", "b", "c", "d"),
+			sizes:   []int64{3, -1, MaxLength + 1, 2},
+			pads:    getBytes("ä"),
+			isConst: []bool{false, false, true},
+			nsp:     []uint64{0},
+			want:    []string{"", "", "", "äd"},
+			nulls:   []uint64{0, 1, 2},
+		},
+	}
+	for i, c := range cases {
+		nsp := new(nulls.Nulls)
+		for _, row := range c.nsp {
+			nulls.Add(nsp, row)
+		}
+		length := LpadLength(c.strs, c.sizes, c.pads, c.isConst, nsp)
+		res := Lpad(&types.Bytes{}, c.strs, c.sizes, c.pads, c.isConst, nsp)
+		require.Equal(t, int(length), len(res.Data), "case %d", i)
+		require.Equal(t, len(c.want), len(res.Lengths), "case %d", i)
+		for j, want := range c.want {
+			require.Equal(t, want, string(res.Get(int64(j))), "case %d row %d", i, j)
+		}
+		require.Equal(t, len(c.nulls), nulls.Length(nsp), "case %d", i)
+		for _, row := range c.nulls {
+			require.True(t, nulls.Contains(nsp, row), "case %d row %d", i, row)
+		}
+	}
+}
@@ -15,49 +15,52 @@
 package ltrim
 
 import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
 var (
-	LtrimChar    func(*types.Bytes, *types.Bytes) *types.Bytes
-	LtrimVarChar func(*types.Bytes, *types.Bytes) *types.Bytes
+	// LtrimChar and LtrimVarChar write to rs the strings of xs without their
+	// leading spaces, the data of rs is sized by LtrimLength. The rows in
+	// nsp are null and made empty.
+	LtrimChar    func(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes
+	LtrimVarChar func(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes
+	// LtrimLength returns the number of bytes of the strings made by ltrim,
+	// which skips the rows in nsp
+	LtrimLength func(xs *types.Bytes, nsp *nulls.Nulls) int64
 )
 
 func init() {
 	LtrimChar = ltrim
 	LtrimVarChar = ltrim
+	LtrimLength = ltrimLength
 }
 
-func CountSpacesFromLeft(xs *types.Bytes) int32 {
-	var (
-		spaceCount int32
-	)
-
-	for i, offset := range xs.Offsets {
-		cursor := offset
-		for ; cursor < offset+xs.Lengths[i] && xs.Data[cursor] == ' '; cursor++ {
-			spaceCount++
+func ltrimLength(xs *types.Bytes, nsp *nulls.Nulls) int64 {
+	var length int64
+	for i := range xs.Lengths {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
 		}
+		length += int64(len(bytes.TrimLeft(xs.Get(int64(i)), " ")))
 	}
-
-	return spaceCount
+	return length
 }
 
-func ltrim(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+func ltrim(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
 	var resultCursor uint32
 
-	for i, offset := range xs.Offsets {
-		cursor := offset
-		// ignore the leading spaces
-		for ; cursor < offset+xs.Lengths[i] && xs.Data[cursor] == ' '; cursor++ {
+	for i := range xs.Lengths {
+		rs.Offsets[i] = resultCursor
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.Lengths[i] = 0
 			continue
 		}
-
-		// copy the non-space characters
-		length := xs.Lengths[i] - (cursor - offset)
-		copy(rs.Data[resultCursor:resultCursor+length], xs.Data[cursor:cursor+length])
+		// ignore the leading spaces
+		length := uint32(copy(rs.Data[resultCursor:], bytes.TrimLeft(xs.Get(int64(i)), " ")))
 		rs.Lengths[i] = length
-		rs.Offsets[i] = resultCursor
 		resultCursor += length
 	}
 
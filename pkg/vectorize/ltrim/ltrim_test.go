@@ -15,53 +15,54 @@
 package ltrim
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/require"
 )
 
-func TestCountSpacesFromLeft(t *testing.T) {
-	cases := map[string]int32{
+// getBytes makes the strings of the rows not in nsp, a null row refers to
+// the bytes of no string
+func getBytes(strs []string, nsp []uint64) (*types.Bytes, *nulls.Nulls) {
+	xs, np := &types.Bytes{}, new(nulls.Nulls)
+	nulls.Add(np, nsp...)
+	for i, s := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		if nulls.Contains(np, uint64(i)) {
+			xs.Lengths = append(xs.Lengths, 0)
+			continue
+		}
+		xs.Data = append(xs.Data, s...)
+		xs.Lengths = append(xs.Lengths, uint32(len(s)))
+	}
+	return xs, np
+}
+
+func TestLtrimLength(t *testing.T) {
+	cases := map[string]int64{
 		"":     0,
-		" ":    1,
-		"  ":   2,
-		"   ":  3,
+		" ":    0,
+		"  ":   0,
+		"   ":  0,
+		"a":    1,
 		" a":   1,
-		" a ":  1,
-		" a  ": 1,
+		" a ":  2,
+		" a  ": 3,
 		"  a ": 2,
-		" 你好 ": 1,
-		"　a":   0, // fullwidth space
+		" 你好 ": 7,
+		"　a":   4, // fullwidth space
 	}
 
 	for input, expected := range cases {
-		actual := CountSpacesFromLeft(&types.Bytes{Data: []byte(input), Lengths: []uint32{uint32(len(input))}, Offsets: []uint32{0}})
-		require.Equal(t, expected, actual, input)
-	}
-
-	multiStrings := []string{
-		"",
-		" ",
-		"  ",
-		"   ",
-		" a",
-		" a ",
-		" a  ",
-		"  a ",
-		" 你好 ",
-		"　a", // fullwidth space
+		xs, nsp := getBytes([]string{input}, nil)
+		require.Equal(t, expected, LtrimLength(xs, nsp), input)
 	}
-	multiStringCase := types.Bytes{}
-	var offset uint32 = 0
-	for _, input := range multiStrings {
-		multiStringCase.Data = append(multiStringCase.Data, []byte(input)...)
-		multiStringCase.Lengths = append(multiStringCase.Lengths, uint32(len(input)))
-		multiStringCase.Offsets = append(multiStringCase.Offsets, offset)
 
-		offset += uint32(len(input))
-	}
-	require.Equal(t, int32(12), CountSpacesFromLeft(&multiStringCase))
+	// the null rows are skipped
+	xs, nsp := getBytes([]string{"  a", " bc", "d "}, []uint64{1})
+	require.Equal(t, int64(3), LtrimLength(xs, nsp))
 }
 
 func TestLtrim(t *testing.T) {
@@ -70,6 +71,7 @@ func TestLtrim(t *testing.T) {
 		" ",
 		"  ",
 		"   ",
+		"a",
 		" a",
 		" a ",
 		" a  ",
@@ -77,25 +79,40 @@ func TestLtrim(t *testing.T) {
 		" 你好 ",
 		"　a", // fullwidth space
 	}
-	multiStringCase := types.Bytes{}
-	var offset uint32 = 0
-	for _, input := range multiStrings {
-		multiStringCase.Data = append(multiStringCase.Data, []byte(input)...)
-		multiStringCase.Lengths = append(multiStringCase.Lengths, uint32(len(input)))
-		multiStringCase.Offsets = append(multiStringCase.Offsets, offset)
-
-		offset += uint32(len(input))
+	cases := []struct {
+		name string
+		strs []string
+		nsp  []uint64
+	}{
+		{name: "no nulls", strs: multiStrings},
+		{name: "null head", strs: multiStrings, nsp: []uint64{0, 1, 5}},
+		{name: "null middle", strs: multiStrings, nsp: []uint64{4, 6, 7}},
+		{name: "null tail", strs: multiStrings, nsp: []uint64{8, 9, 10}},
+		{name: "all nulls", strs: []string{" a", " b"}, nsp: []uint64{0, 1}},
+		{name: "all spaces", strs: []string{" ", "   ", "  ", " "}},
+		{name: "all spaces with nulls", strs: []string{" ", "   ", "  ", " "}, nsp: []uint64{0, 3}},
 	}
-
-	spacesCount := CountSpacesFromLeft(&multiStringCase)
-	rs := types.Bytes{
-		Data:    make([]byte, len(multiStringCase.Data)-int(spacesCount)),
-		Lengths: make([]uint32, len(multiStringCase.Lengths)),
-		Offsets: make([]uint32, len(multiStringCase.Offsets)),
+	for _, c := range cases {
+		xs, nsp := getBytes(c.strs, c.nsp)
+		length := LtrimLength(xs, nsp)
+		rs := &types.Bytes{
+			Data:    make([]byte, length),
+			Lengths: make([]uint32, len(xs.Lengths)),
+			Offsets: make([]uint32, len(xs.Offsets)),
+		}
+		ltrim(xs, rs, nsp)
+		require.Equal(t, len(c.strs), len(rs.Lengths), c.name)
+		require.Equal(t, len(c.strs), len(rs.Offsets), c.name)
+		written := 0
+		for i, s := range c.strs {
+			want := strings.TrimLeft(s, " ")
+			if nulls.Contains(nsp, uint64(i)) {
+				want = ""
+			}
+			require.Equal(t, want, string(rs.Get(int64(i))), c.name)
+			require.Equal(t, uint32(written), rs.Offsets[i], c.name)
+			written += len(want)
+		}
+		require.Equal(t, int(length), written, c.name)
 	}
-
-	ltrim(&multiStringCase, &rs)
-	require.Equal(t, 10, len(rs.Lengths))
-	require.Equal(t, 10, len(rs.Offsets))
-	require.Equal(t, int(spacesCount), len(multiStringCase.Data)-len(rs.Data))
 }
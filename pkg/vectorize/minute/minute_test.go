@@ -0,0 +1,61 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func parseDatetime(s string) types.Datetime {
+	dt, _ := types.ParseDatetime(s)
+	return dt
+}
+
+func TestDatetimeToMinute(t *testing.T) {
+	type args struct {
+		xs []types.Datetime
+		rs []uint8
+	}
+	tests := []struct {
+		name string
+		args args
+		want []uint8
+	}{
+		{
+			name: "normal datetime test",
+			args: args{
+				xs: []types.Datetime{
+					parseDatetime("1899-12-31 23:59:59.999999"),
+					parseDatetime("1900-01-01 00:00:00"),
+					parseDatetime("2000-02-29 12:34:56.000001"),
+					parseDatetime("2022-06-30 01:02:03.5"),
+				},
+				rs: make([]uint8, 4),
+			},
+			want: []uint8{59, 0, 34, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DatetimeToMinute(tt.args.xs, tt.args.rs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DatetimeToMinute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntMod(t *testing.T) {
+	xs := []int64{28, -28, 28, -28}
+	ys := []int64{5, 5, -5, -5}
+	rs := make([]int64, len(xs))
+	require.Equal(t, []int64{3, -3, 3, -3}, Int64Mod(xs, ys, rs))
+}
+
+func TestFloatMod(t *testing.T) {
+	xs := []float64{24.5, -24.5, 24.5, 3}
+	ys := []float64{10, 10, -10, 0.5}
+	rs := make([]float64, len(xs))
+	require.Equal(t, []float64{4.5, -4.5, 4.5, 0}, Float64Mod(xs, ys, rs))
+}
+
+func TestFloatModSels(t *testing.T) {
+	xs := []float32{24.5, 7, -24.5}
+	ys := []float32{10, 0, 10}
+	rs := make([]float32, len(xs))
+	require.Equal(t, []float32{4.5, 0, -4.5}, Float32ModSels(xs, ys, rs, []int64{0, 2}))
+	require.Equal(t, []float32{4.5, 7, -4.5}, Float32ModByScalar(10, xs, rs))
+	require.Equal(t, []float32{1, 1, 1}, Float32ModScalar(25, []float32{2, 3, 4}, rs))
+}
@@ -113,3 +113,33 @@ func TestDatetimeToMonth(t *testing.T) {
 		})
 	}
 }
+
+func benchmarkDates() []types.Date {
+	xs := make([]types.Date, 1000000)
+	start := parseDate("1900-01-01")
+	for i := range xs {
+		xs[i] = start + types.Date(i%73000)
+	}
+	return xs
+}
+
+func BenchmarkDateToMonth(b *testing.B) {
+	xs := benchmarkDates()
+	rs := make([]uint8, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DateToMonth(xs, rs)
+	}
+}
+
+// BenchmarkDateToMonthByCalendar is the month by the calendar of the date
+func BenchmarkDateToMonthByCalendar(b *testing.B) {
+	xs := benchmarkDates()
+	rs := make([]uint8, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			_, rs[j], _, _ = x.Calendar(true)
+		}
+	}
+}
@@ -17,6 +17,7 @@ package rpad
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lpad"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -32,6 +33,30 @@ func getBytes(s ...string) *types.Bytes {
 	return result
 }
 
+// rpadOf pads with the arguments of the function, which are converted and
+// whose nulls are collected as the builtin does
+func rpadOf(strs *types.Bytes, sizes, pads interface{}, isConst []bool, nsps []*nulls.Nulls) (*types.Bytes, *nulls.Nulls, error) {
+	isConst = append([]bool{}, isConst...)
+	padstrs, err := lpad.Pads(pads, isConst)
+	if err != nil {
+		return nil, nil, err
+	}
+	lengths, err := lpad.Sizes(sizes)
+	if err != nil {
+		return nil, nil, err
+	}
+	nsp := new(nulls.Nulls)
+	if lengths == nil {
+		lengths, isConst[1] = []int64{0}, true
+		nulls.Set(nsp, nsps[0])
+	} else {
+		for _, n := range nsps {
+			nulls.Or(nsp, n, nsp)
+		}
+	}
+	return Rpad(&types.Bytes{}, strs, lengths, padstrs, isConst, nsp), nsp, nil
+}
+
 func TestRpadInt(t *testing.T) {
 	// test: no nulls and all args are attribute names
 	isConst := []bool{false, false, false}
@@ -46,7 +71,7 @@ func TestRpadInt(t *testing.T) {
 	oriNsps = append(oriNsps, new(nulls.Nulls))
 	expectedNsp := new(nulls.Nulls)
 
-	actualStrs, actualNsp, _ := Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ := rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 
@@ -55,7 +80,7 @@ func TestRpadInt(t *testing.T) {
 	sizes = []int16{3}
 	padstrs = getBytes("111")
 	expectedStrs = getBytes("hel", "hel", "hel", "hel", "hel", "hel", "hel")
-	actualStrs, actualNsp, _ = Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ = rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 
@@ -86,7 +111,7 @@ func TestRpadInt(t *testing.T) {
 		nulls.Add(expectedNsp, uint64(i)) // all strings are NULLs
 
 	}
-	actualStrs, actualNsp, _ = Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ = rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 }
@@ -105,13 +130,13 @@ func TestRpadFloat(t *testing.T) {
 
 	expectedStrs := getBytes("", "", "", "hello你好你", "hello你好你", "hello你好你")
 	expectedNsp := new(nulls.Nulls)
-	actualStrs, actualNsp, _ := Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ := rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 
 	// test float32
 	sizes2 := []float32{0.0, 0.1, -0.1, 8, 8.4, 8.6}
-	actualStrs, actualNsp, _ = Rpad(strs, sizes2, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ = rpadOf(strs, sizes2, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 }
@@ -130,7 +155,7 @@ func TestRpadUint(t *testing.T) {
 	oriNsps = append(oriNsps, new(nulls.Nulls))
 	expectedNsp := new(nulls.Nulls)
 
-	actualStrs, actualNsp, _ := Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ := rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 
@@ -139,7 +164,7 @@ func TestRpadUint(t *testing.T) {
 	sizes = []uint32{3}
 	padstrs = getBytes("111")
 	expectedStrs = getBytes("hel", "hel", "hel", "hel", "hel", "hel", "hel")
-	actualStrs, actualNsp, _ = Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ = rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 
@@ -169,7 +194,7 @@ func TestRpadUint(t *testing.T) {
 		nulls.Add(expectedNsp, uint64(i)) // all strings are NULLs
 
 	}
-	actualStrs, actualNsp, _ = Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ = rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 }
@@ -188,7 +213,7 @@ func TestTypes(t *testing.T) {
 	nulls.Add(expectedNsp, 0)
 	expectedStrs := getBytes("", "")
 
-	actualStrs, actualNsp, _ := Rpad(strs, sizes, padstrs, isConst, oriNsps)
+	actualStrs, actualNsp, _ := rpadOf(strs, sizes, padstrs, isConst, oriNsps)
 	require.Equal(t, expectedStrs, actualStrs)
 	require.Equal(t, expectedNsp, actualNsp)
 
@@ -201,7 +226,21 @@ func TestTypes(t *testing.T) {
 	expectedNsp2 := new(nulls.Nulls)
 	nulls.Add(expectedNsp2, 0)
 	expectedStrs2 := getBytes("", "te", "test101010")
-	actualStrs, actualNsp, _ = Rpad(strs2, sizes2, padstrs2, isConst, oriNsps2)
+	actualStrs, actualNsp, _ = rpadOf(strs2, sizes2, padstrs2, isConst, oriNsps2)
 	require.Equal(t, expectedStrs2, actualStrs)
 	require.Equal(t, expectedNsp2, actualNsp)
 }
+
+func TestRpadMultiByte(t *testing.T) {
+	strs := getBytes("ab", "中文", "naïve", "🙂")
+	pads := getBytes("é", "你好吗", "x", "")
+	nsp := new(nulls.Nulls)
+	isConst := []bool{false, true, false}
+	length := RpadLength(strs, []int64{6}, pads, isConst, nsp)
+	actualStrs := Rpad(&types.Bytes{}, strs, []int64{6}, pads, isConst, nsp)
+	require.Equal(t, getBytes("abéééé", "中文你好吗你", "naïvex", ""), actualStrs)
+	require.Equal(t, int(length), len(actualStrs.Data))
+
+	actualStrs = Rpad(&types.Bytes{}, strs, []int64{1, 1, 3, 1}, pads, []bool{false, false, false}, nsp)
+	require.Equal(t, getBytes("a", "中", "naï", "🙂"), actualStrs)
+}
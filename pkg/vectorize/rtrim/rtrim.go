@@ -15,71 +15,52 @@
 package rtrim
 
 import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
 var (
-	RtrimChar    func(*types.Bytes, *types.Bytes) *types.Bytes
-	RtrimVarChar func(*types.Bytes, *types.Bytes) *types.Bytes
+	// RtrimChar and RtrimVarChar write to rs the strings of xs without their
+	// trailing spaces, the data of rs is sized by RtrimLength. The rows in
+	// nsp are null and made empty.
+	RtrimChar    func(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes
+	RtrimVarChar func(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes
+	// RtrimLength returns the number of bytes of the strings made by rtrim,
+	// which skips the rows in nsp
+	RtrimLength func(xs *types.Bytes, nsp *nulls.Nulls) int64
 )
 
 func init() {
 	RtrimChar = rtrim
 	RtrimVarChar = rtrim
+	RtrimLength = rtrimLength
 }
 
-func CountSpacesFromRight(xs *types.Bytes) int32 {
-	var (
-		spaceCount int32
-	)
-
-	for i, offset := range xs.Offsets {
-		if xs.Lengths[i] == 0 {
+func rtrimLength(xs *types.Bytes, nsp *nulls.Nulls) int64 {
+	var length int64
+	for i := range xs.Lengths {
+		if nulls.Contains(nsp, uint64(i)) {
 			continue
 		}
-
-		cursor := offset + xs.Lengths[i] - 1
-		//cursor >= offset >=0
-		for ; cursor > offset && xs.Data[cursor] == ' '; cursor-- {
-			spaceCount++
-		}
-
-		if cursor == offset && xs.Data[cursor] == ' ' {
-			spaceCount++
-		}
+		length += int64(len(bytes.TrimRight(xs.Get(int64(i)), " ")))
 	}
-
-	return spaceCount
+	return length
 }
 
-func rtrim(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
-	var resultCursor uint32 = 0
+func rtrim(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	var resultCursor uint32
 
-	for i, offset := range xs.Offsets {
-		if xs.Lengths[i] == 0 {
+	for i := range xs.Lengths {
+		rs.Offsets[i] = resultCursor
+		if nulls.Contains(nsp, uint64(i)) {
 			rs.Lengths[i] = 0
-			rs.Offsets[i] = resultCursor
-
-			continue
-		}
-
-		cursor := offset + xs.Lengths[i] - 1
-		//cursor >= offset >=0
-		// ignore the tailing spaces
-		for ; cursor > offset && xs.Data[cursor] == ' '; cursor-- {
 			continue
 		}
-
-		length := uint32(0)
-		//cursor == offset :all spaces
-		if cursor > offset {
-			// copy the non-space characters
-			length = cursor - offset + 1
-			copy(rs.Data[resultCursor:resultCursor+length], xs.Data[offset:offset+length])
-		}
-
+		// ignore the trailing spaces
+		length := uint32(copy(rs.Data[resultCursor:], bytes.TrimRight(xs.Get(int64(i)), " ")))
 		rs.Lengths[i] = length
-		rs.Offsets[i] = resultCursor
 		resultCursor += length
 	}
 
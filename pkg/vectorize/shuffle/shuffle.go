@@ -40,6 +40,7 @@ var (
 	DateShuffle      = fixedLengthShuffle[types.Date]
 	DatetimeShuffle  = fixedLengthShuffle[types.Datetime]
 	TimestampShuffle = fixedLengthShuffle[types.Timestamp]
+	TimeShuffle      = fixedLengthShuffle[types.Time]
 
 	TupleShuffle = tupleShuffle
 
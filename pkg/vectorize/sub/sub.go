@@ -17,6 +17,7 @@ package sub
 import (
 	"math"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"golang.org/x/exp/constraints"
 )
@@ -493,3 +494,53 @@ func decimal128SubByScalarSels(x types.Decimal128, ys []types.Decimal128, xScale
 	}
 	return rs
 }
+
+// Datetime returns the durations xs - ys of datetimes or timestamps, the
+// rows of nsp are skipped. An error is returned if a duration is out of the
+// range of TIME.
+func Datetime[T types.Datetime | types.Timestamp](xs, ys []T, nsp *nulls.Nulls, rs []types.Time) ([]types.Time, error) {
+	var err error
+
+	hasNull := nulls.Any(nsp)
+	for i, x := range xs {
+		if hasNull && nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if rs[i], err = types.DatetimeSub(types.Datetime(x), types.Datetime(ys[i])); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// DatetimeScalar returns the durations x - ys.
+func DatetimeScalar[T types.Datetime | types.Timestamp](x T, ys []T, nsp *nulls.Nulls, rs []types.Time) ([]types.Time, error) {
+	var err error
+
+	hasNull := nulls.Any(nsp)
+	for i, y := range ys {
+		if hasNull && nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if rs[i], err = types.DatetimeSub(types.Datetime(x), types.Datetime(y)); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// DatetimeByScalar returns the durations ys - x.
+func DatetimeByScalar[T types.Datetime | types.Timestamp](x T, ys []T, nsp *nulls.Nulls, rs []types.Time) ([]types.Time, error) {
+	var err error
+
+	hasNull := nulls.Any(nsp)
+	for i, y := range ys {
+		if hasNull && nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if rs[i], err = types.DatetimeSub(types.Datetime(y), types.Datetime(x)); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package substringindex
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	// SubstringIndex appends to res the substrings of strs before count
+	// occurrences of the delimiters, the occurrences are counted from the left
+	// if count is positive, or from the right and the substrings after them
+	// are taken if count is negative. isConst tells which arguments are
+	// constants, whose value is their first one. The rows in nsp are null.
+	SubstringIndex func(res *types.Bytes, strs *types.Bytes, delims *types.Bytes, counts []int64, isConst []bool, nsp *nulls.Nulls) *types.Bytes
+	// SubstringIndexLength returns the number of bytes of the substrings made
+	// by SubstringIndex, so the data of the result is allocated at once
+	SubstringIndexLength func(strs *types.Bytes, delims *types.Bytes, counts []int64, isConst []bool, nsp *nulls.Nulls) int64
+)
+
+func init() {
+	SubstringIndex = substringIndex
+	SubstringIndexLength = substringIndexLength
+}
+
+func substringIndex(res *types.Bytes, strs *types.Bytes, delims *types.Bytes, counts []int64, isConst []bool, nsp *nulls.Nulls) *types.Bytes {
+	for i, n := 0, Rows(strs, delims, counts, isConst); i < n; i++ {
+		offset := uint32(len(res.Data))
+		if s, delim, count, ok := row(strs, delims, counts, isConst, nsp, i); ok {
+			res.Data = append(res.Data, Substring(s, delim, count)...)
+		}
+		res.Offsets = append(res.Offsets, offset)
+		res.Lengths = append(res.Lengths, uint32(len(res.Data))-offset)
+	}
+	return res
+}
+
+func substringIndexLength(strs *types.Bytes, delims *types.Bytes, counts []int64, isConst []bool, nsp *nulls.Nulls) int64 {
+	var length int64
+	for i, n := 0, Rows(strs, delims, counts, isConst); i < n; i++ {
+		if s, delim, count, ok := row(strs, delims, counts, isConst, nsp, i); ok {
+			length += int64(len(Substring(s, delim, count)))
+		}
+	}
+	return length
+}
+
+// Substring returns the substring of s before count occurrences of delim, or
+// after -count occurrences counted from the right if count is negative. The
+// occurrences do not overlap, s is returned if there are fewer of them. An
+// empty delimiter or a zero count makes an empty string.
+func Substring(s []byte, delim []byte, count int64) []byte {
+	if len(delim) == 0 || count == 0 {
+		return s[:0]
+	}
+	if count > 0 {
+		for offset := 0; ; count-- {
+			i := bytes.Index(s[offset:], delim)
+			if i < 0 {
+				return s
+			}
+			if count == 1 {
+				return s[:offset+i]
+			}
+			offset += i + len(delim)
+		}
+	}
+	for end := len(s); ; count++ {
+		i := bytes.LastIndex(s[:end], delim)
+		if i < 0 {
+			return s
+		}
+		if count == -1 {
+			return s[i+len(delim):]
+		}
+		end = i
+	}
+}
+
+// Counts returns the counts of a vector of int64 or uint64, a count larger
+// than the largest int64 takes the whole strings
+func Counts(col interface{}) []int64 {
+	switch vs := col.(type) {
+	case []int64:
+		return vs
+	case []uint64:
+		counts := make([]int64, len(vs))
+		for i, v := range vs {
+			if v > math.MaxInt64 {
+				counts[i] = math.MaxInt64
+			} else {
+				counts[i] = int64(v)
+			}
+		}
+		return counts
+	}
+	return nil
+}
+
+// Rows returns the number of the rows of the arguments
+func Rows(strs *types.Bytes, delims *types.Bytes, counts []int64, isConst []bool) int {
+	switch {
+	case !isConst[0]:
+		return len(strs.Lengths)
+	case !isConst[1]:
+		return len(delims.Lengths)
+	case !isConst[2]:
+		return len(counts)
+	}
+	return 1
+}
+
+func row(strs *types.Bytes, delims *types.Bytes, counts []int64, isConst []bool, nsp *nulls.Nulls, i int) ([]byte, []byte, int64, bool) {
+	if nulls.Contains(nsp, uint64(i)) {
+		return nil, nil, 0, false
+	}
+	j := [3]int64{int64(i), int64(i), int64(i)}
+	for k := range j {
+		if isConst[k] {
+			j[k] = 0
+		}
+	}
+	return strs.Get(j[0]), delims.Get(j[1]), counts[j[2]], true
+}
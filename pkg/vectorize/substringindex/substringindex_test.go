@@ -0,0 +1,145 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package substringindex
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstring(t *testing.T) {
+	cases := []struct {
+		s, delim string
+		count    int64
+		want     string
+	}{
+		{s: "www.mysql.com", delim: ".", count: 2, want: "www.mysql"},
+		{s: "www.mysql.com", delim: ".", count: -2, want: "mysql.com"},
+		{s: "www.mysql.com", delim: ".", count: 1, want: "www"},
+		{s: "www.mysql.com", delim: ".", count: -1, want: "com"},
+		{s: "www.mysql.com", delim: ".", count: 0, want: ""},
+		// counts exceeding the number of occurrences
+		{s: "www.mysql.com", delim: ".", count: 3, want: "www.mysql.com"},
+		{s: "www.mysql.com", delim: ".", count: -3, want: "www.mysql.com"},
+		{s: "www.mysql.com", delim: ".", count: math.MaxInt64, want: "www.mysql.com"},
+		{s: "www.mysql.com", delim: ".", count: math.MinInt64, want: "www.mysql.com"},
+		{s: "www.mysql.com", delim: "/", count: 1, want: "www.mysql.com"},
+		// delimiters longer than one byte
+		{s: "a::b::c::d", delim: "::", count: 2, want: "a::b"},
+		{s: "a::b::c::d", delim: "::", count: -3, want: "b::c::d"},
+		{s: "key=>value=>rest", delim: "=>", count: 1, want: "key"},
+		{s: "中文,字符,测试", delim: "，", count: 1, want: "中文,字符,测试"},
+		{s: "中文、字符、测试", delim: "、", count: 2, want: "中文、字符"},
+		{s: "中文、字符、测试", delim: "、", count: -1, want: "测试"},
+		{s: "GET /index.html HTTP/1.1", delim: " HTTP/", count: 1, want: "GET /index.html"},
+		// the occurrences do not overlap
+		{s: "aaaa", delim: "aa", count: 2, want: "aa"},
+		{s: "aaa", delim: "aa", count: 2, want: "aaa"},
+		{s: "aaa", delim: "aa", count: -1, want: ""},
+		{s: "aaa", delim: "aa", count: -2, want: "aaa"},
+		// delimiters at the ends
+		{s: ".a.", delim: ".", count: 1, want: ""},
+		{s: ".a.", delim: ".", count: -1, want: ""},
+		{s: ".a.", delim: ".", count: 2, want: ".a"},
+		// empty strings
+		{s: "abc", delim: "", count: 1, want: ""},
+		{s: "abc", delim: "", count: -1, want: ""},
+		{s: "", delim: ",", count: 1, want: ""},
+		{s: "abc", delim: "abcd", count: 1, want: "abc"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, string(Substring([]byte(c.s), []byte(c.delim), c.count)), "substring_index(%q, %q, %d)", c.s, c.delim, c.count)
+	}
+}
+
+func getBytes(s ...string) *types.Bytes {
+	result := &types.Bytes{}
+	for _, v := range s {
+		result.Offsets = append(result.Offsets, uint32(len(result.Data)))
+		result.Data = append(result.Data, v...)
+		result.Lengths = append(result.Lengths, uint32(len(v)))
+	}
+	return result
+}
+
+func TestSubstringIndex(t *testing.T) {
+	cases := []struct {
+		strs    *types.Bytes
+		delims  *types.Bytes
+		counts  []int64
+		isConst []bool
+		nsp     []uint64
+		want    []string
+	}{
+		{
+			strs:    getBytes("a.b.c", "x.y", "", "中.文.字"),
+			delims:  getBytes("."),
+			counts:  []int64{-2},
+			isConst: []bool{false, true, true},
+			want:    []string{"b.c", "x.y", "", "文.字"},
+		},
+		{
+			strs:    getBytes("a,b;c"),
+			delims:  getBytes(",", ";", "", "b"),
+			counts:  []int64{1},
+			isConst: []bool{true, false, true},
+			want:    []string{"a", "a,b", "", "a,"},
+		},
+		{
+			strs:    getBytes("a.b.c"),
+			delims:  getBytes("."),
+			counts:  []int64{1, 2, 3, -1, 0},
+			isConst: []bool{true, true, false},
+			nsp:     []uint64{3},
+			want:    []string{"a", "a.b", "a.b.c", "", ""},
+		},
+		{
+			strs:    getBytes("a--b--c", "a.b", "x|y|z"),
+			delims:  getBytes("--", ".", "|"),
+			counts:  []int64{-1, 5, 2},
+			isConst: []bool{false, false, false},
+			nsp:     []uint64{1},
+			want:    []string{"c", "", "x|y"},
+		},
+		{
+			strs:    getBytes("a.b.c"),
+			delims:  getBytes("."),
+			counts:  []int64{2},
+			isConst: []bool{true, true, true},
+			want:    []string{"a.b"},
+		},
+	}
+	for _, c := range cases {
+		nsp := new(nulls.Nulls)
+		nulls.Add(nsp, c.nsp...)
+		length := SubstringIndexLength(c.strs, c.delims, c.counts, c.isConst, nsp)
+		res := SubstringIndex(&types.Bytes{Data: make([]byte, 0, length)}, c.strs, c.delims, c.counts, c.isConst, nsp)
+		require.Equal(t, int(length), len(res.Data))
+		require.Equal(t, int(length), cap(res.Data))
+		require.Equal(t, len(c.want), len(res.Lengths))
+		for i, want := range c.want {
+			require.Equal(t, want, string(res.Get(int64(i))))
+		}
+	}
+}
+
+func TestCounts(t *testing.T) {
+	require.Equal(t, []int64{1, -2}, Counts([]int64{1, -2}))
+	require.Equal(t, []int64{3, math.MaxInt64}, Counts([]uint64{3, math.MaxUint64}))
+}
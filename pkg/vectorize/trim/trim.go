@@ -0,0 +1,117 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// the modes of trim, which tell the ends of a string to remove remstr from
+const (
+	Both int64 = iota
+	Leading
+	Trailing
+)
+
+// Modes are the modes of trim by their keywords
+var Modes = map[string]int64{
+	"both":     Both,
+	"leading":  Leading,
+	"trailing": Trailing,
+}
+
+var (
+	// Trim appends to res the strings of strs with the occurrences of remstrs
+	// removed from the ends told by mode. isConst tells which of remstrs and
+	// strs are constants, whose value is their first one. The rows in nsp are
+	// null.
+	Trim func(res *types.Bytes, remstrs *types.Bytes, strs *types.Bytes, mode int64, isConst []bool, nsp *nulls.Nulls) *types.Bytes
+	// TrimLength returns the number of bytes of the strings made by Trim, so
+	// the data of the result is allocated at once
+	TrimLength func(remstrs *types.Bytes, strs *types.Bytes, mode int64, isConst []bool, nsp *nulls.Nulls) int64
+)
+
+func init() {
+	Trim = trim
+	TrimLength = trimLength
+}
+
+func trim(res *types.Bytes, remstrs *types.Bytes, strs *types.Bytes, mode int64, isConst []bool, nsp *nulls.Nulls) *types.Bytes {
+	for i, n := 0, Rows(remstrs, strs, isConst); i < n; i++ {
+		offset := uint32(len(res.Data))
+		if remstr, s, ok := row(remstrs, strs, isConst, nsp, i); ok {
+			res.Data = append(res.Data, TrimBytes(s, remstr, mode)...)
+		}
+		res.Offsets = append(res.Offsets, offset)
+		res.Lengths = append(res.Lengths, uint32(len(res.Data))-offset)
+	}
+	return res
+}
+
+func trimLength(remstrs *types.Bytes, strs *types.Bytes, mode int64, isConst []bool, nsp *nulls.Nulls) int64 {
+	var length int64
+	for i, n := 0, Rows(remstrs, strs, isConst); i < n; i++ {
+		if remstr, s, ok := row(remstrs, strs, isConst, nsp, i); ok {
+			length += int64(len(TrimBytes(s, remstr, mode)))
+		}
+	}
+	return length
+}
+
+// TrimBytes returns s with all the leading and/or trailing occurrences of
+// remstr removed by mode. An empty remstr removes nothing.
+func TrimBytes(s []byte, remstr []byte, mode int64) []byte {
+	if len(remstr) == 0 {
+		return s
+	}
+	if mode != Trailing {
+		for bytes.HasPrefix(s, remstr) {
+			s = s[len(remstr):]
+		}
+	}
+	if mode != Leading {
+		for bytes.HasSuffix(s, remstr) {
+			s = s[:len(s)-len(remstr)]
+		}
+	}
+	return s
+}
+
+// Rows returns the number of the rows of the arguments
+func Rows(remstrs *types.Bytes, strs *types.Bytes, isConst []bool) int {
+	switch {
+	case !isConst[1]:
+		return len(strs.Lengths)
+	case !isConst[0]:
+		return len(remstrs.Lengths)
+	}
+	return 1
+}
+
+func row(remstrs *types.Bytes, strs *types.Bytes, isConst []bool, nsp *nulls.Nulls, i int) ([]byte, []byte, bool) {
+	if nulls.Contains(nsp, uint64(i)) {
+		return nil, nil, false
+	}
+	j := [2]int64{int64(i), int64(i)}
+	for k := range j {
+		if isConst[k] {
+			j[k] = 0
+		}
+	}
+	return remstrs.Get(j[0]), strs.Get(j[1]), true
+}
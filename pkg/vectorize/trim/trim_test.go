@@ -0,0 +1,119 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrimBytes(t *testing.T) {
+	cases := []struct {
+		s, remstr string
+		mode      int64
+		want      string
+	}{
+		{s: "  bar   ", remstr: " ", mode: Both, want: "bar"},
+		{s: "xxxbarxxx", remstr: "x", mode: Leading, want: "barxxx"},
+		{s: "xxxbarxxx", remstr: "x", mode: Both, want: "bar"},
+		{s: "barxxyz", remstr: "xyz", mode: Trailing, want: "barx"},
+		// repeated occurrences of a remstr longer than one byte
+		{s: "ababcab", remstr: "ab", mode: Both, want: "c"},
+		{s: "ababcab", remstr: "ab", mode: Leading, want: "cab"},
+		{s: "ababcab", remstr: "ab", mode: Trailing, want: "ababc"},
+		{s: "aaa", remstr: "aa", mode: Leading, want: "a"},
+		{s: "aaa", remstr: "aa", mode: Trailing, want: "a"},
+		{s: "abab", remstr: "ab", mode: Both, want: ""},
+		// utf-8 remstr
+		{s: "中中文中", remstr: "中", mode: Both, want: "文"},
+		{s: "　全角　　", remstr: "　", mode: Trailing, want: "　全角"},
+		{s: "ｱｲｳｴｵｱｲ", remstr: "ｱｲ", mode: Leading, want: "ｳｴｵｱｲ"},
+		{s: "你好你好世界你好", remstr: "你好", mode: Both, want: "世界"},
+		// empty strings
+		{s: "  bar  ", remstr: "", mode: Both, want: "  bar  "},
+		{s: "", remstr: "x", mode: Both, want: ""},
+		{s: "x", remstr: "xyz", mode: Both, want: "x"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, string(TrimBytes([]byte(c.s), []byte(c.remstr), c.mode)), "trim(%d %q from %q)", c.mode, c.remstr, c.s)
+	}
+}
+
+func getBytes(s ...string) *types.Bytes {
+	result := &types.Bytes{}
+	for _, v := range s {
+		result.Offsets = append(result.Offsets, uint32(len(result.Data)))
+		result.Data = append(result.Data, v...)
+		result.Lengths = append(result.Lengths, uint32(len(v)))
+	}
+	return result
+}
+
+func TestTrim(t *testing.T) {
+	cases := []struct {
+		remstrs *types.Bytes
+		strs    *types.Bytes
+		mode    int64
+		isConst []bool
+		nsp     []uint64
+		want    []string
+	}{
+		{
+			remstrs: getBytes("、"),
+			strs:    getBytes("、a、", "b、、", "", "、、"),
+			mode:    Both,
+			isConst: []bool{true, false},
+			want:    []string{"a", "b", "", ""},
+		},
+		{
+			remstrs: getBytes("x", "yz", "", "中"),
+			strs:    getBytes("xxabcyzyz"),
+			mode:    Trailing,
+			isConst: []bool{false, true},
+			nsp:     []uint64{3},
+			want:    []string{"xxabcyzyz", "xxabc", "xxabcyzyz", ""},
+		},
+		{
+			remstrs: getBytes("a", "bb", "c"),
+			strs:    getBytes("aab", "bbabb", "ccc"),
+			mode:    Leading,
+			isConst: []bool{false, false},
+			nsp:     []uint64{0},
+			want:    []string{"", "abb", ""},
+		},
+		{
+			remstrs: getBytes("ab"),
+			strs:    getBytes("abcab"),
+			mode:    Both,
+			isConst: []bool{true, true},
+			want:    []string{"c"},
+		},
+	}
+	for _, c := range cases {
+		nsp := new(nulls.Nulls)
+		nulls.Add(nsp, c.nsp...)
+		length := TrimLength(c.remstrs, c.strs, c.mode, c.isConst, nsp)
+		res := Trim(&types.Bytes{Data: make([]byte, 0, length)}, c.remstrs, c.strs, c.mode, c.isConst, nsp)
+		require.Equal(t, int(length), len(res.Data))
+		require.Equal(t, int(length), cap(res.Data))
+		require.Equal(t, len(c.want), len(res.Lengths))
+		for i, want := range c.want {
+			require.Equal(t, want, string(res.Get(int64(i))))
+		}
+	}
+}
@@ -15,9 +15,15 @@
 package typecast
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"unsafe"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"golang.org/x/exp/constraints"
 )
@@ -131,14 +137,14 @@ var (
 	Int32ToBytes   = IntToBytes[int32]
 	BytesToInt64   = BytesToInt[int64]
 	Int64ToBytes   = IntToBytes[int64]
-	BytesToUint8   = BytesToInt[uint8]
-	Uint8ToBytes   = IntToBytes[uint8]
-	BytesToUint16  = BytesToInt[uint16]
-	Uint16ToBytes  = IntToBytes[uint16]
-	BytesToUint32  = BytesToInt[uint32]
-	Uint32ToBytes  = IntToBytes[uint32]
-	BytesToUint64  = BytesToInt[uint64]
-	Uint64ToBytes  = IntToBytes[uint64]
+	BytesToUint8   = BytesToUint[uint8]
+	Uint8ToBytes   = UintToBytes[uint8]
+	BytesToUint16  = BytesToUint[uint16]
+	Uint16ToBytes  = UintToBytes[uint16]
+	BytesToUint32  = BytesToUint[uint32]
+	Uint32ToBytes  = UintToBytes[uint32]
+	BytesToUint64  = BytesToUint[uint64]
+	Uint64ToBytes  = UintToBytes[uint64]
 	BytesToFloat32 = BytesToFloat[float32]
 	Float32ToBytes = FloatToBytes[float32]
 	BytesToFloat64 = BytesToFloat[float64]
@@ -156,6 +162,7 @@ var (
 	Uint64ToDecimal128 = UintToDecimal128[uint64]
 
 	TimestampToDatetime = timestampToDatetime
+	DatetimeToTimestamp = datetimeToTimestamp
 )
 
 func NumericToNumeric[T1, T2 constraints.Integer | constraints.Float](xs []T1, rs []T2) ([]T2, error) {
@@ -165,20 +172,177 @@ func NumericToNumeric[T1, T2 constraints.Integer | constraints.Float](xs []T1, r
 	return rs, nil
 }
 
-func BytesToInt[T constraints.Integer](xs *types.Bytes, rs []T) ([]T, error) {
+// NumericToNumericOverflow converts the numbers like NumericToNumeric, but a
+// number not null in nsp which does not fit T2 is an out of range error
+// naming typ, the type of T2. The fractional part of a float converted to an
+// integer is truncated.
+func NumericToNumericOverflow[T1, T2 constraints.Integer | constraints.Float](xs []T1, rs []T2, typ types.T, nsp *nulls.Nulls) ([]T2, error) {
+	if numericContained[T1, T2]() {
+		return NumericToNumeric(xs, rs)
+	}
+	for i, x := range xs {
+		if !numericFits[T1, T2](x) && !nulls.Contains(nsp, uint64(i)) {
+			return nil, NumericOutOfRangeError(x, typ)
+		}
+		rs[i] = T2(x)
+	}
+	return rs, nil
+}
+
+// NumericToNumericSaturate converts the numbers like NumericToNumeric, but a
+// number not null in nsp which does not fit T2 is clamped to the nearest
+// bound of T2, and a NaN converted to an integer is 0. It returns the rows
+// which are clamped.
+func NumericToNumericSaturate[T1, T2 constraints.Integer | constraints.Float](xs []T1, rs []T2, nsp *nulls.Nulls) ([]T2, []int) {
+	if numericContained[T1, T2]() {
+		rs, _ = NumericToNumeric(xs, rs)
+		return rs, nil
+	}
+	var clamped []int
+	lo, hi := numericBounds[T2]()
+	for i, x := range xs {
+		if numericFits[T1, T2](x) || nulls.Contains(nsp, uint64(i)) {
+			rs[i] = T2(x)
+			continue
+		}
+		switch {
+		case x < 0:
+			rs[i] = lo
+		case x > 0:
+			rs[i] = hi
+		default:
+			rs[i] = 0
+		}
+		clamped = append(clamped, i)
+	}
+	return rs, clamped
+}
+
+// NumericOutOfRangeError is the error of a number x which does not fit typ.
+func NumericOutOfRangeError(x interface{}, typ types.T) error {
+	return moerr.NewError(moerr.OUT_OF_RANGE, fmt.Sprintf("value %v out of range for %s", x, typ))
+}
+
+// numericBounds returns the smallest and the largest numbers of T, the
+// finite ones for the floats.
+func numericBounds[T constraints.Integer | constraints.Float]() (T, T) {
+	if isFloatType[T]() {
+		max := math.MaxFloat64
+		if unsafe.Sizeof(T(0)) == 4 {
+			max = math.MaxFloat32
+		}
+		return T(-max), T(max)
+	}
+	if !isSignedType[T]() {
+		var max T
+		max--
+		return 0, max
+	}
+	// 2^(bits-1)-1, without overflowing T
+	var max T = 1
+	for i := 0; i < int(unsafe.Sizeof(T(0)))*8-2; i++ {
+		max *= 2
+	}
+	max = max - 1 + max
+	return -max - 1, max
+}
+
+// numericContained returns true if every number of T1 fits T2, ignoring the
+// precision lost by the integers converted to floats.
+func numericContained[T1, T2 constraints.Integer | constraints.Float]() bool {
+	size1, size2 := unsafe.Sizeof(T1(0)), unsafe.Sizeof(T2(0))
+	switch {
+	case isFloatType[T2]():
+		return !isFloatType[T1]() || size1 <= size2
+	case isFloatType[T1]():
+		return false
+	case isSignedType[T1]() == isSignedType[T2]():
+		return size1 <= size2
+	default:
+		// an unsigned integer fits a wider signed one
+		return !isSignedType[T1]() && size1 < size2
+	}
+}
+
+// numericFits returns true if x converted to T2 keeps its value, but the
+// fractional part of a float converted to an integer.
+func numericFits[T1, T2 constraints.Integer | constraints.Float](x T1) bool {
+	if !isFloatType[T1]() {
+		if isFloatType[T2]() {
+			return true
+		}
+		y := T2(x)
+		return T1(y) == x && (x < 0) == (y < 0)
+	}
+	f := float64(x)
+	if isFloatType[T2]() {
+		return unsafe.Sizeof(T2(0)) == 8 || math.IsInf(f, 0) || math.IsNaN(f) || math.Abs(f) <= math.MaxFloat32
+	}
+	bits := int(unsafe.Sizeof(T2(0))) * 8
+	lo, hi := 0.0, math.Ldexp(1, bits)
+	if isSignedType[T2]() {
+		lo, hi = -math.Ldexp(1, bits-1), math.Ldexp(1, bits-1)
+	}
+	f = math.Trunc(f)
+	return f >= lo && f < hi
+}
+
+func isFloatType[T constraints.Integer | constraints.Float]() bool {
+	var half T = 1
+	half /= 2
+	return half != 0
+}
+
+func isSignedType[T constraints.Integer | constraints.Float]() bool {
+	var x T
+	x--
+	return x < 0
+}
+
+// RowError is the error of the string of the row Row of a vector which can
+// not be parsed by a cast, the row is counted from 0.
+type RowError struct {
+	Row   int
+	Value string
+	Err   error
+}
+
+func (e *RowError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// BytesToInt parses the strings as integers, skipping the null rows of nsp.
+// The error of the first string which is not an integer is a *RowError.
+func BytesToInt[T constraints.Integer](xs *types.Bytes, rs []T, nsp *nulls.Nulls) ([]T, error) {
 	var bitSize = int(unsafe.Sizeof(T(0))) * 8
 
 	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
 		s := string(xs.Data[o : o+xs.Lengths[i]])
 		val, err := strconv.ParseInt(s, 10, bitSize)
 		if err != nil {
-			return nil, err
+			return nil, parseError(i, s, err)
 		}
 		rs[i] = T(val)
 	}
 	return rs, nil
 }
 
+// parseError is the *RowError of the string s of the row i, the out of range
+// errors of strconv are given their code.
+func parseError(i int, s string, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		err = moerr.Wrap(moerr.OUT_OF_RANGE, err)
+	}
+	return &RowError{Row: i, Value: s, Err: err}
+}
+
 func IntToBytes[T constraints.Integer](xs []T, rs *types.Bytes) (*types.Bytes, error) {
 	oldLen := uint32(0)
 	for _, x := range xs {
@@ -191,20 +355,247 @@ func IntToBytes[T constraints.Integer](xs []T, rs *types.Bytes) (*types.Bytes, e
 	return rs, nil
 }
 
-func BytesToFloat[T constraints.Float](xs *types.Bytes, rs []T) ([]T, error) {
+// BytesToUint parses the strings as unsigned integers, skipping the null rows
+// of nsp. The error of the first string which is not an unsigned integer is a
+// *RowError.
+func BytesToUint[T constraints.Unsigned](xs *types.Bytes, rs []T, nsp *nulls.Nulls) ([]T, error) {
+	var bitSize = int(unsafe.Sizeof(T(0))) * 8
+
+	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		val, err := strconv.ParseUint(s, 10, bitSize)
+		if err != nil {
+			return nil, parseError(i, s, err)
+		}
+		rs[i] = T(val)
+	}
+	return rs, nil
+}
+
+func UintToBytes[T constraints.Unsigned](xs []T, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = strconv.AppendUint(rs.Data, uint64(x), 10)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+// BytesToFloat parses the strings as floats, skipping the null rows of nsp.
+// The error of the first string which is not a float is a *RowError.
+func BytesToFloat[T constraints.Float](xs *types.Bytes, rs []T, nsp *nulls.Nulls) ([]T, error) {
 	var bitSize = int(unsafe.Sizeof(T(0))) * 8
 
 	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
 		s := string(xs.Data[o : o+xs.Lengths[i]])
 		val, err := strconv.ParseFloat(s, bitSize)
 		if err != nil {
-			return nil, err
+			return nil, parseError(i, s, err)
 		}
 		rs[i] = T(val)
 	}
 	return rs, nil
 }
 
+// BytesToDecimal64 parses the strings as decimals of the width and scale,
+// skipping the null rows of nsp. The fractional digits beyond the scale are
+// rounded half away from zero. The error of the first string which is not a
+// decimal of the width is a *RowError.
+func BytesToDecimal64(xs *types.Bytes, rs []types.Decimal64, width, scale int32, nsp *nulls.Nulls) ([]types.Decimal64, error) {
+	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		val, err := types.ParseStringToDecimal64(s, width, scale)
+		if err != nil {
+			return nil, &RowError{Row: i, Value: s, Err: err}
+		}
+		rs[i] = val
+	}
+	return rs, nil
+}
+
+// BytesToDecimal128 parses the strings as decimals of the width and scale,
+// skipping the null rows of nsp. The fractional digits beyond the scale are
+// rounded half away from zero. The error of the first string which is not a
+// decimal of the width is a *RowError.
+func BytesToDecimal128(xs *types.Bytes, rs []types.Decimal128, width, scale int32, nsp *nulls.Nulls) ([]types.Decimal128, error) {
+	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		val, err := types.ParseStringToDecimal128(s, width, scale)
+		if err != nil {
+			return nil, &RowError{Row: i, Value: s, Err: err}
+		}
+		rs[i] = val
+	}
+	return rs, nil
+}
+
+// BytesToDate parses the strings as dates, skipping the null rows of nsp.
+// The error of the first string which is not a date is a *RowError.
+func BytesToDate(xs *types.Bytes, rs []types.Date, nsp *nulls.Nulls) ([]types.Date, error) {
+	return bytesToTemporal(xs, rs, nsp, types.ParseDate)
+}
+
+// BytesToDatetime parses the strings as datetimes, skipping the null rows of
+// nsp. The error of the first string which is not a datetime is a *RowError.
+func BytesToDatetime(xs *types.Bytes, rs []types.Datetime, nsp *nulls.Nulls) ([]types.Datetime, error) {
+	return bytesToTemporal(xs, rs, nsp, types.ParseDatetime)
+}
+
+// BytesToTimestamp parses the strings as timestamps of the precision,
+// skipping the null rows of nsp. The error of the first string which is not
+// a timestamp is a *RowError.
+func BytesToTimestamp(xs *types.Bytes, rs []types.Timestamp, precision int32, nsp *nulls.Nulls) ([]types.Timestamp, error) {
+	return bytesToTemporal(xs, rs, nsp, func(s string) (types.Timestamp, error) {
+		return types.ParseTimestamp(s, precision)
+	})
+}
+
+// BytesToTime parses the strings as times of the precision, skipping the
+// null rows of nsp. The error of the first string which is not a time is a
+// *RowError.
+func BytesToTime(xs *types.Bytes, rs []types.Time, precision int32, nsp *nulls.Nulls) ([]types.Time, error) {
+	return bytesToTemporal(xs, rs, nsp, func(s string) (types.Time, error) {
+		return types.ParseTime(s, precision)
+	})
+}
+
+// BytesToBit converts the strings to the bits of their bytes for a
+// BIT(width), skipping the null rows of nsp. The error of the first string
+// which does not fit the width is a *RowError.
+func BytesToBit(xs *types.Bytes, rs []uint64, width int32, nsp *nulls.Nulls) ([]uint64, error) {
+	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		bs := xs.Data[o : o+xs.Lengths[i]]
+		val, ok := types.ParseBitBytes(bs)
+		if !ok || !types.BitFits(val, width) {
+			return nil, &RowError{Row: i, Value: string(bs), Err: moerr.NewError(moerr.OUT_OF_RANGE, fmt.Sprintf("data too long for BIT(%d)", width))}
+		}
+		rs[i] = val
+	}
+	return rs, nil
+}
+
+// BitToBytes converts the values of a BIT(width) to the binary strings of
+// their (width+7)/8 bytes
+func BitToBytes(xs []uint64, width int32, rs *types.Bytes) (*types.Bytes, error) {
+	for _, x := range xs {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		bs := types.BitBytes(x, width)
+		rs.Lengths = append(rs.Lengths, uint32(len(bs)))
+		rs.Data = append(rs.Data, bs...)
+	}
+	return rs, nil
+}
+
+func bytesToTemporal[T types.Date | types.Datetime | types.Timestamp | types.Time](xs *types.Bytes, rs []T, nsp *nulls.Nulls, parse func(string) (T, error)) ([]T, error) {
+	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		val, err := parse(s)
+		if err != nil {
+			return nil, &RowError{Row: i, Value: s, Err: err}
+		}
+		rs[i] = val
+	}
+	return rs, nil
+}
+
+// BytesToFloatPrefix converts the strings to floats the way mysql converts
+// them in a numeric context: the longest numeric prefix of a string after
+// its leading spaces is converted, and a string without one is 0. It
+// returns the indexes of the strings which are not numbers.
+func BytesToFloatPrefix[T constraints.Float](xs *types.Bytes, rs []T) ([]T, []int, error) {
+	var bitSize = int(unsafe.Sizeof(T(0))) * 8
+	var truncated []int
+
+	for i, o := range xs.Offsets {
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		prefix, ok := numericPrefix(s)
+		if !ok {
+			truncated = append(truncated, i)
+		}
+		if len(prefix) == 0 {
+			rs[i] = 0
+			continue
+		}
+		val, err := strconv.ParseFloat(prefix, bitSize)
+		if err != nil {
+			return nil, nil, parseError(i, s, err)
+		}
+		rs[i] = T(val)
+	}
+	return rs, truncated, nil
+}
+
+// numericPrefix returns the longest prefix of s which is a decimal number,
+// with the leading spaces removed, and whether the rest of s is only spaces.
+func numericPrefix(s string) (string, bool) {
+	start := 0
+	for start < len(s) && isSpace(s[start]) {
+		start++
+	}
+	i := start
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(s) && isDigit(s[i]); i++ {
+		digits++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return "", false
+	}
+	// the exponent is a part of the number only if it has digits
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for i = j; i < len(s) && isDigit(s[i]); i++ {
+			}
+		}
+	}
+	end := i
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+	return s[start:end], i == len(s)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
 func FloatToBytes[T constraints.Float](xs []T, rs *types.Bytes) (*types.Bytes, error) {
 	var bitSize = int(unsafe.Sizeof(T(0))) * 8
 
@@ -219,6 +610,63 @@ func FloatToBytes[T constraints.Float](xs []T, rs *types.Bytes) (*types.Bytes, e
 	return rs, nil
 }
 
+// NumericToBool converts the numbers to bools, a number which is not zero is
+// true.
+func NumericToBool[T constraints.Integer | constraints.Float](xs []T, rs []bool) ([]bool, error) {
+	for i, x := range xs {
+		rs[i] = x != 0
+	}
+	return rs, nil
+}
+
+// BoolToNumeric converts the bools to the numbers 1 and 0.
+func BoolToNumeric[T constraints.Integer | constraints.Float](xs []bool, rs []T) ([]T, error) {
+	for i, x := range xs {
+		if x {
+			rs[i] = 1
+		} else {
+			rs[i] = 0
+		}
+	}
+	return rs, nil
+}
+
+// BytesToBool parses the strings "true", "false", "1" and "0", in any case
+// and with the spaces around them, as bools, skipping the null rows of nsp.
+// The error of the first string which is not a bool is a *RowError.
+func BytesToBool(xs *types.Bytes, rs []bool, nsp *nulls.Nulls) ([]bool, error) {
+	for i, o := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "true", "1":
+			rs[i] = true
+		case "false", "0":
+			rs[i] = false
+		default:
+			return nil, &RowError{Row: i, Value: s, Err: errInvalidBool}
+		}
+	}
+	return rs, nil
+}
+
+var errInvalidBool = errors.New("invalid bool value")
+
+// BoolToBytes converts the bools to the strings "true" and "false".
+func BoolToBytes(xs []bool, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = strconv.AppendBool(rs.Data, x)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
 func decimal64ToDecimal128Pure(xs []types.Decimal64, rs []types.Decimal128) ([]types.Decimal128, error) {
 	for i, x := range xs {
 		rs[i] = types.Decimal64ToDecimal128(x)
@@ -240,6 +688,76 @@ func UintToDecimal128[T constraints.Integer](xs []T, rs []types.Decimal128) ([]t
 	return rs, nil
 }
 
+// Decimal64ToFloat converts the decimals of the scale to the nearest floats.
+func Decimal64ToFloat[T constraints.Float](xs []types.Decimal64, scale int32, rs []T) ([]T, error) {
+	for i, x := range xs {
+		rs[i] = T(types.Decimal64ToFloat64(x, scale))
+	}
+	return rs, nil
+}
+
+// Decimal128ToFloat converts the decimals of the scale to the nearest floats.
+func Decimal128ToFloat[T constraints.Float](xs []types.Decimal128, scale int32, rs []T) ([]T, error) {
+	for i, x := range xs {
+		rs[i] = T(types.Decimal128ToFloat64(x, scale))
+	}
+	return rs, nil
+}
+
+// Decimal64ToInteger rounds the decimals of the scale half away from zero to
+// the integers of T. A decimal not null in nsp which does not fit T is
+// clamped to the nearest bound of T, and the rows clamped are returned.
+func Decimal64ToInteger[T constraints.Integer](xs []types.Decimal64, scale int32, rs []T, nsp *nulls.Nulls) ([]T, []int) {
+	var clamped []int
+	for i, x := range xs {
+		abs, neg := types.Decimal64ToUint64(x, scale)
+		var ok bool
+		if rs[i], ok = roundedInteger[T](abs, neg, true); !ok && !nulls.Contains(nsp, uint64(i)) {
+			clamped = append(clamped, i)
+		}
+	}
+	return rs, clamped
+}
+
+// Decimal128ToInteger is Decimal64ToInteger of the decimal128s.
+func Decimal128ToInteger[T constraints.Integer](xs []types.Decimal128, scale int32, rs []T, nsp *nulls.Nulls) ([]T, []int) {
+	var clamped []int
+	for i, x := range xs {
+		abs, neg, fits := types.Decimal128ToUint64(x, scale)
+		var ok bool
+		if rs[i], ok = roundedInteger[T](abs, neg, fits); !ok && !nulls.Contains(nsp, uint64(i)) {
+			clamped = append(clamped, i)
+		}
+	}
+	return rs, clamped
+}
+
+// roundedInteger returns the integer of T of the absolute value abs and the
+// sign, and false with the nearest bound of T if it does not fit. fits is
+// false if abs is larger than any uint64.
+func roundedInteger[T constraints.Integer](abs uint64, neg, fits bool) (T, bool) {
+	lo, hi := numericBounds[T]()
+	switch {
+	case !fits && neg:
+		return lo, false
+	case !fits:
+		return hi, false
+	case !neg && abs > uint64(hi):
+		return hi, false
+	case !neg || abs == 0:
+		return T(abs), true
+	case !isSignedType[T]() || abs > uint64(hi)+1:
+		return lo, false
+	default:
+		// -abs wraps to the smallest int64 for 2^63
+		return T(-int64(abs)), true
+	}
+}
+
 func timestampToDatetime(xs []types.Timestamp, rs []types.Datetime) ([]types.Datetime, error) {
 	return types.TimestampToDatetime(xs, rs)
 }
+
+func datetimeToTimestamp(xs []types.Datetime, rs []types.Timestamp) ([]types.Timestamp, error) {
+	return types.DatetimeToTimestamp(xs, rs)
+}
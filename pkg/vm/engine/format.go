@@ -16,8 +16,12 @@ package engine
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
@@ -47,10 +51,9 @@ func (node *Attribute) Format(buf *bytes.Buffer) {
 		buf.WriteByte(')')
 	}
 
-	val := makeVal2Str(node.Type, node.Default.Value, node.Default.Exist)
-	if val != "" {
+	if node.Default.Exist {
 		buf.WriteString(" DEFAULT ")
-		buf.WriteString(val)
+		buf.WriteString(makeVal2Str(node.Type, node.Default.Value, node.Default.IsNull))
 	}
 }
 
@@ -91,25 +94,37 @@ func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
 	if isNull {
 		return "NULL"
 	}
-	switch typ.Oid {
-	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
-		res := value.(int64)
-		str := strconv.FormatInt(res, 10)
-		return str
-	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
-		res := value.(uint64)
-		str := strconv.FormatUint(res, 10)
-		return str
-	case types.T_float32, types.T_float64:
-		res := value.(float64)
-		str := strconv.FormatFloat(res, 'f', 10, 64)
-		return str
-	case types.T_char, types.T_varchar:
-		res := value.(string)
-		return res
-	case types.T_date:
-		res := value.(types.Date).String()
-		return res
+	switch v := value.(type) {
+	case string:
+		return quoteDefault([]byte(v))
+	case []byte:
+		return quoteDefault(v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case types.Decimal64:
+		return types.FormatDecimal64(v, typ.Scale)
+	case types.Decimal128:
+		return types.FormatDecimal128(v, typ.Scale)
+	case types.Date, types.Datetime, types.Timestamp:
+		return fmt.Sprintf("'%s'", v)
+	case nil:
+		return "NULL"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// quoteDefault returns the literal of a string default, which is a
+// hexadecimal literal if it is not a printable utf8 string.
+func quoteDefault(v []byte) string {
+	if !utf8.Valid(v) {
+		return "0x" + hex.EncodeToString(v)
+	}
+	for _, r := range string(v) {
+		if !unicode.IsPrint(r) {
+			return "0x" + hex.EncodeToString(v)
+		}
 	}
-	return "NULL"
+	return "'" + strings.NewReplacer("\\", "\\\\", "'", "''").Replace(string(v)) + "'"
 }
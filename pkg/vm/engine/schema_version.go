@@ -0,0 +1,69 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import "sync"
+
+// The schema versions tell the holders of anything derived from the schema of
+// a table, like the cached plans, whether the schema may have changed. The
+// version of a table is changed when a DDL on the table or its database is
+// executed, and again when the txn of a DDL ends, so that a schema read from
+// the snapshot of another txn meanwhile is not taken as the latest one.
+var schemaVersions = struct {
+	sync.RWMutex
+	last      uint64
+	epoch     uint64
+	databases map[string]uint64
+	tables    map[string]uint64
+}{
+	databases: make(map[string]uint64),
+	tables:    make(map[string]uint64),
+}
+
+func schemaVersionKey(db, table string) string {
+	return db + "." + table
+}
+
+// SchemaVersion returns the schema version of the table
+func SchemaVersion(db, table string) uint64 {
+	schemaVersions.RLock()
+	defer schemaVersions.RUnlock()
+	return schemaVersions.epoch + schemaVersions.databases[db] + schemaVersions.tables[schemaVersionKey(db, table)]
+}
+
+// BumpSchemaVersion changes the schema version of the table
+func BumpSchemaVersion(db, table string) {
+	schemaVersions.Lock()
+	defer schemaVersions.Unlock()
+	schemaVersions.last++
+	schemaVersions.tables[schemaVersionKey(db, table)] = schemaVersions.last
+}
+
+// BumpDatabaseSchemaVersion changes the schema versions of all the tables of the database
+func BumpDatabaseSchemaVersion(db string) {
+	schemaVersions.Lock()
+	defer schemaVersions.Unlock()
+	schemaVersions.last++
+	schemaVersions.databases[db] = schemaVersions.last
+}
+
+// BumpSchemaEpoch changes the schema versions of all the tables, it is
+// called when the txn of a DDL ends
+func BumpSchemaEpoch() {
+	schemaVersions.Lock()
+	defer schemaVersions.Unlock()
+	schemaVersions.last++
+	schemaVersions.epoch += schemaVersions.last
+}
@@ -63,6 +63,9 @@ type INode interface {
 	Size() uint64
 	Iteration() uint64
 	IncIteration() uint64
+	// PinCount is the number of times the node has been pinned
+	PinCount() uint64
+	IncPinCount() uint64
 	IsClosed() bool
 	GetState() NodeState
 	Expand(uint64, func() error) error
@@ -81,6 +84,7 @@ type INodeManager interface {
 	TryPin(INode, time.Duration) (INodeHandle, error)
 	Unpin(INode)
 	MakeRoom(uint64) bool
+	Stats() *Stats
 }
 
 type ISizeLimiter interface {
@@ -91,12 +95,33 @@ type ISizeLimiter interface {
 
 type IEvictHandle interface {
 	sync.Locker
+	GetID() common.ID
 	IsClosed() bool
 	Unload()
 	Unloadable() bool
 	Iteration() uint64
 }
 
+// Stats is the statistics of a node manager
+type Stats struct {
+	// Hits is the times a pinned node was loaded already
+	Hits uint64
+	// Misses is the times a pinned node had to be loaded
+	Misses    uint64
+	Evictions uint64
+	HitRatio  float64
+	// TopPinned are the nodes pinned most
+	TopPinned []PinnedNode
+}
+
+type PinnedNode struct {
+	ID string
+	// Pins is the times the node has been pinned and Refs is the pins held
+	// now
+	Pins uint64
+	Refs int64
+}
+
 type NodeState = uint32
 
 const (
@@ -16,6 +16,7 @@ package buffer
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -106,3 +107,102 @@ func TestHandle(t *testing.T) {
 	assert.Equal(t, uint64(0), mgr.Total())
 	t.Log(mgr.String())
 }
+
+func TestLRU2EvictHolder(t *testing.T) {
+	maxsize := uint64(100)
+	holder := NewLRU2EvictHolder()
+	mgr := NewNodeManager(maxsize, holder)
+	baseId := common.ID{}
+	nodes := make([]*testNodeHandle, 3)
+	for i := range nodes {
+		nodes[i] = newTestNodeHandle(mgr, baseId.NextBlock(), 10, t)
+		mgr.RegisterNode(nodes[i])
+	}
+	unpin := func(n *testNodeHandle) {
+		holder.Enqueue(&EvictNode{Handle: n, Iter: n.IncIteration()})
+	}
+	// 0 and 2 are unpinned twice, 0 is the older of them by its second
+	// last unpin
+	unpin(nodes[0])
+	unpin(nodes[2])
+	unpin(nodes[1])
+	unpin(nodes[0])
+	unpin(nodes[2])
+	for _, i := range []int{1, 0, 2} {
+		evicted := holder.Dequeue()
+		assert.Equal(t, nodes[i], evicted.Handle)
+		assert.True(t, evicted.Unloadable(nodes[i]))
+	}
+	assert.Nil(t, holder.Dequeue())
+
+	// the history of an evicted node is kept
+	unpin(nodes[1])
+	unpin(nodes[0])
+	assert.Equal(t, nodes[1], holder.Dequeue().Handle)
+	assert.Equal(t, nodes[0], holder.Dequeue().Handle)
+
+	// the unregistered nodes are forgotten
+	unpin(nodes[2])
+	nodes[2].Close()
+	assert.Nil(t, holder.Dequeue())
+}
+
+// scanFlood pins a node for appends between the pins of scan nodes that
+// are pinned once each and returns the loads of the append node
+func scanFlood(t *testing.T, policy string) (loads int, stats *base.Stats) {
+	evicter, err := NewEvictHolder(policy)
+	assert.Nil(t, err)
+	// room for 10 nodes
+	mgr := NewNodeManager(100, evicter)
+	baseId := common.ID{}
+	newNode := func() *testNodeHandle {
+		n := newTestNodeHandle(mgr, baseId.NextBlock(), 10, t)
+		n.LoadFunc = nil
+		n.UnloadFunc = nil
+		mgr.RegisterNode(n)
+		return n
+	}
+	appendNode := newNode()
+	appendNode.LoadFunc = func() { loads++ }
+
+	// the node is held all the time
+	pinned := newNode()
+	ph := mgr.Pin(pinned)
+	assert.NotNil(t, ph)
+
+	for i := 0; i < 1000; i++ {
+		if i%20 == 0 {
+			h := mgr.Pin(appendNode)
+			assert.NotNil(t, h)
+			h.Close()
+		}
+		h := mgr.Pin(newNode())
+		assert.NotNil(t, h)
+		h.Close()
+		assert.True(t, pinned.IsLoaded())
+	}
+	stats = mgr.Stats()
+	id := appendNode.GetID()
+	assert.Equal(t, id.String(), stats.TopPinned[0].ID)
+	assert.Equal(t, int64(0), stats.TopPinned[0].Refs)
+	ph.Close()
+	return
+}
+
+func TestScanFlood(t *testing.T) {
+	lruLoads, lruStats := scanFlood(t, EvictPolicyLRU)
+	lru2Loads, lru2Stats := scanFlood(t, EvictPolicyLRU2)
+	// the append node stays loaded after its second pin
+	assert.Equal(t, 50, lruLoads)
+	assert.Equal(t, 2, lru2Loads)
+	assert.Less(t, lruStats.HitRatio, lru2Stats.HitRatio)
+	assert.Equal(t, uint64(48), lru2Stats.Hits)
+	assert.Equal(t, uint64(1003), lru2Stats.Misses)
+	assert.Equal(t, uint64(1003-10), lru2Stats.Evictions)
+	// the append node is pinned most
+	assert.Equal(t, uint64(50), lru2Stats.TopPinned[0].Pins)
+	assert.Len(t, lru2Stats.TopPinned, TOP_PINNED_NODES)
+
+	_, err := NewEvictHolder("clock")
+	assert.NotNil(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	sq "github.com/yireyun/go-queue"
 )
 
@@ -31,6 +32,28 @@ type IEvictHolder interface {
 	sync.Locker
 	Enqueue(n *EvictNode)
 	Dequeue() *EvictNode
+	// Remove forgets the node of id, it is called when the node is
+	// unregistered
+	Remove(id common.ID)
+}
+
+const (
+	// EvictPolicyLRU evicts the node unpinned least recently
+	EvictPolicyLRU = "lru"
+	// EvictPolicyLRU2 evicts the node whose second last unpin is the
+	// oldest, see LRU2EvictHolder
+	EvictPolicyLRU2 = "lru2"
+)
+
+// NewEvictHolder returns the evict holder of policy, LRU-2 by default
+func NewEvictHolder(policy string) (IEvictHolder, error) {
+	switch policy {
+	case EvictPolicyLRU:
+		return NewSimpleEvictHolder(), nil
+	case EvictPolicyLRU2, "":
+		return NewLRU2EvictHolder(), nil
+	}
+	return nil, fmt.Errorf("buffer: unknown evict policy %q", policy)
 }
 
 type SimpleEvictHolder struct {
@@ -64,6 +87,10 @@ func (holder *SimpleEvictHolder) Enqueue(node *EvictNode) {
 	holder.Queue.Put(node)
 }
 
+// Remove does nothing, the stale nodes in the queue are skipped by the
+// evicter
+func (holder *SimpleEvictHolder) Remove(common.ID) {}
+
 func (holder *SimpleEvictHolder) Dequeue() *EvictNode {
 	r, ok, _ := holder.Queue.Get()
 	if !ok {
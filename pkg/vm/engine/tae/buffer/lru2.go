@@ -0,0 +1,147 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"container/heap"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+const (
+	// LRU2_HISTORY_CAPACITY is the number of evicted nodes whose unpin
+	// history is kept
+	LRU2_HISTORY_CAPACITY = 100000
+)
+
+type lru2Entry struct {
+	node *EvictNode
+	// the clocks of the last and the second last unpin, prev is 0 if the
+	// node was unpinned only once
+	last, prev uint64
+	// the position in the heap, -1 if the node was dequeued and only its
+	// history is kept
+	index int
+}
+
+type lru2Heap []*lru2Entry
+
+func (h lru2Heap) Len() int { return len(h) }
+
+func (h lru2Heap) Less(i, j int) bool {
+	if h[i].prev != h[j].prev {
+		return h[i].prev < h[j].prev
+	}
+	return h[i].last < h[j].last
+}
+
+func (h lru2Heap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *lru2Heap) Push(x any) {
+	e := x.(*lru2Entry)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *lru2Heap) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	e.index = -1
+	*h = old[:n-1]
+	return e
+}
+
+// LRU2EvictHolder evicts the node whose second last unpin is the oldest,
+// the nodes unpinned only once go first in the order of their unpin. A scan
+// unpins each of its nodes once, so it evicts its own nodes instead of the
+// nodes in repeated use such as the appendable nodes. The history of an
+// evicted node is kept, a node reloaded soon is still in repeated use.
+type LRU2EvictHolder struct {
+	sync.Mutex
+	clock   uint64
+	entries map[common.ID]*lru2Entry
+	heap    lru2Heap
+}
+
+func NewLRU2EvictHolder() IEvictHolder {
+	return &LRU2EvictHolder{
+		entries: make(map[common.ID]*lru2Entry),
+	}
+}
+
+func (holder *LRU2EvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	holder.clock++
+	id := node.Handle.GetID()
+	e, ok := holder.entries[id]
+	if !ok {
+		e = &lru2Entry{index: -1}
+		holder.entries[id] = e
+	}
+	e.node = node
+	e.prev, e.last = e.last, holder.clock
+	if e.index >= 0 {
+		heap.Fix(&holder.heap, e.index)
+		return
+	}
+	heap.Push(&holder.heap, e)
+	holder.trimHistory()
+}
+
+func (holder *LRU2EvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	if len(holder.heap) == 0 {
+		return nil
+	}
+	e := heap.Pop(&holder.heap).(*lru2Entry)
+	node := e.node
+	e.node = nil
+	return node
+}
+
+func (holder *LRU2EvictHolder) Remove(id common.ID) {
+	holder.Lock()
+	defer holder.Unlock()
+	e, ok := holder.entries[id]
+	if !ok {
+		return
+	}
+	if e.index >= 0 {
+		heap.Remove(&holder.heap, e.index)
+	}
+	delete(holder.entries, id)
+}
+
+// trimHistory forgets the history of the evicted nodes if there are too
+// many of them
+func (holder *LRU2EvictHolder) trimHistory() {
+	if len(holder.entries)-len(holder.heap) <= LRU2_HISTORY_CAPACITY {
+		return
+	}
+	for id, e := range holder.entries {
+		if e.index < 0 {
+			delete(holder.entries, id)
+		}
+	}
+}
@@ -56,6 +56,7 @@ type Node struct {
 	state          base.NodeState
 	size           uint64
 	iter           uint64
+	pins           uint64
 	closed         bool
 	impl           base.INode
 	DestroyFunc    func()
@@ -204,3 +205,11 @@ func (n *Node) IncIteration() uint64 {
 func (n *Node) Iteration() uint64 {
 	return atomic.LoadUint64(&n.iter)
 }
+
+func (n *Node) IncPinCount() uint64 {
+	return atomic.AddUint64(&n.pins, uint64(1))
+}
+
+func (n *Node) PinCount() uint64 {
+	return atomic.LoadUint64(&n.pins)
+}
@@ -16,6 +16,7 @@ package buffer
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -25,6 +26,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
+const (
+	// TOP_PINNED_NODES is the number of the nodes pinned most in the stats
+	TOP_PINNED_NODES = 10
+)
+
 type nodeManager struct {
 	sync.RWMutex
 	sizeLimiter
@@ -33,11 +39,12 @@ type nodeManager struct {
 	unregistertimes int64
 	loadtimes       int64
 	evicttimes      int64
+	hittimes        int64
 }
 
 func NewNodeManager(maxsize uint64, evicter IEvictHolder) *nodeManager {
 	if evicter == nil {
-		evicter = NewSimpleEvictHolder()
+		evicter = NewLRU2EvictHolder()
 	}
 	mgr := &nodeManager{
 		sizeLimiter: *newSizeLimiter(maxsize),
@@ -89,6 +96,7 @@ func (mgr *nodeManager) UnregisterNode(node base.INode) {
 	defer mgr.Unlock()
 	atomic.AddInt64(&mgr.unregistertimes, int64(1))
 	delete(mgr.nodes, node.GetID())
+	mgr.evicter.Remove(node.GetID())
 	node.Destroy()
 }
 
@@ -119,6 +127,7 @@ func (mgr *nodeManager) MakeRoom(size uint64) bool {
 			}
 			evicted.Handle.Unload()
 			evicted.Handle.Unlock()
+			atomic.AddInt64(&mgr.evicttimes, int64(1))
 		}
 		ok = mgr.sizeLimiter.ApplyQuota(size)
 	}
@@ -155,6 +164,8 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	if node.IsLoaded() {
 		node.Ref()
 		node.RUnlock()
+		node.IncPinCount()
+		atomic.AddInt64(&mgr.hittimes, int64(1))
 		return node.MakeHandle()
 	}
 	node.RUnlock()
@@ -163,6 +174,8 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	defer node.Unlock()
 	if node.IsLoaded() {
 		node.Ref()
+		node.IncPinCount()
+		atomic.AddInt64(&mgr.hittimes, int64(1))
 		return node.MakeHandle()
 	}
 	ok := mgr.MakeRoom(node.Size())
@@ -172,6 +185,7 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	node.Load()
 	atomic.AddInt64(&mgr.loadtimes, int64(1))
 	node.Ref()
+	node.IncPinCount()
 	return node.MakeHandle()
 }
 
@@ -182,6 +196,32 @@ func (mgr *nodeManager) Unpin(node base.INode) {
 	if node.RefCount() == 0 {
 		toevict := &EvictNode{Handle: node, Iter: node.IncIteration()}
 		mgr.evicter.Enqueue(toevict)
-		atomic.AddInt64(&mgr.evicttimes, int64(1))
 	}
 }
+
+// Stats returns the hits and the evictions of mgr and the nodes pinned most
+func (mgr *nodeManager) Stats() *base.Stats {
+	stats := &base.Stats{
+		Hits:      uint64(atomic.LoadInt64(&mgr.hittimes)),
+		Misses:    uint64(atomic.LoadInt64(&mgr.loadtimes)),
+		Evictions: uint64(atomic.LoadInt64(&mgr.evicttimes)),
+	}
+	if pins := stats.Hits + stats.Misses; pins > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(pins)
+	}
+
+	mgr.RLock()
+	nodes := make([]base.PinnedNode, 0, len(mgr.nodes))
+	for id, node := range mgr.nodes {
+		if pins := node.PinCount(); pins > 0 {
+			nodes = append(nodes, base.PinnedNode{ID: id.String(), Pins: pins, Refs: node.RefCount()})
+		}
+	}
+	mgr.RUnlock()
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Pins > nodes[j].Pins })
+	if len(nodes) > TOP_PINNED_NODES {
+		nodes = nodes[:TOP_PINNED_NODES]
+	}
+	stats.TopPinned = nodes
+	return stats
+}
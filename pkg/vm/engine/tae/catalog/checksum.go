@@ -0,0 +1,38 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+// HasRowChecksum returns true if every row of the table has a checksum. The
+// checksums are computed when the rows are appended, stored in the checksum
+// column like the other columns and recomputed by the compactions.
+func (s *Schema) HasRowChecksum() bool { return s.ChecksumKey != nil }
+
+// IsChecksumCol returns true if the column is the row checksum column
+func (s *Schema) IsChecksumCol(idx int) bool {
+	return s.ChecksumKey != nil && s.ChecksumKey.Idx == idx
+}
+
+// RowChecksumDefs returns the columns covered by the row checksums, which
+// are all the columns but the checksum column and the hidden key
+func (s *Schema) RowChecksumDefs() []*ColDef {
+	defs := make([]*ColDef, 0, len(s.ColDefs))
+	for _, def := range s.ColDefs {
+		if def.IsHidden() || s.IsChecksumCol(def.Idx) {
+			continue
+		}
+		defs = append(defs, def)
+	}
+	return defs
+}
@@ -211,6 +211,14 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 			return
 		}
 		n += sn + 8
+		if sn, err = common.WriteString(cmd.DB.charset, w); err != nil {
+			return
+		}
+		n += sn
+		if sn, err = common.WriteString(cmd.DB.collation, w); err != nil {
+			return
+		}
+		n += sn
 	case CmdCreateTable:
 		if err = binary.Write(w, binary.BigEndian, cmd.Table.db.ID); err != nil {
 			return
@@ -397,6 +405,14 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn + 8
+		if cmd.DB.charset, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if cmd.DB.collation, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
 	case CmdCreateTable:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
@@ -32,6 +32,10 @@ type DBEntry struct {
 	name    string
 	isSys   bool
 
+	// the defaults of the string columns of the tables created in it
+	charset   string
+	collation string
+
 	entries   map[uint64]*common.DLNode
 	nameNodes map[string]*nodeList
 	link      *common.Link
@@ -91,6 +95,23 @@ func NewReplayDBEntry() *DBEntry {
 }
 
 func (e *DBEntry) IsSystemDB() bool { return e.isSys }
+
+// SetDefaults sets the default charset and collation of the tables of the
+// database, it is called by the txn creating the database before it commits
+func (e *DBEntry) SetDefaults(charset, collation string) {
+	e.Lock()
+	defer e.Unlock()
+	e.charset, e.collation = charset, collation
+}
+
+// GetDefaults returns the default charset and collation of the tables of
+// the database, they are empty if the database is created without them
+func (e *DBEntry) GetDefaults() (charset, collation string) {
+	e.RLock()
+	defer e.RUnlock()
+	return e.charset, e.collation
+}
+
 func (e *DBEntry) CoarseTableCnt() int {
 	e.RLock()
 	defer e.RUnlock()
@@ -340,6 +361,18 @@ func (entry *DBEntry) WriteTo(w io.Writer) (n int64, err error) {
 	var sn int
 	sn, err = w.Write([]byte(entry.name))
 	n += int64(sn) + 2
+	if err != nil {
+		return
+	}
+	var sn2 int64
+	if sn2, err = common.WriteString(entry.charset, w); err != nil {
+		return
+	}
+	n += sn2
+	if sn2, err = common.WriteString(entry.collation, w); err != nil {
+		return
+	}
+	n += sn2
 	return
 }
 
@@ -358,6 +391,15 @@ func (entry *DBEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	}
 	n += int64(size)
 	entry.name = string(buf)
+	var sn int64
+	if entry.charset, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	if entry.collation, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
 	return
 }
 
@@ -369,6 +411,8 @@ func (entry *DBEntry) Clone() CheckpointItem {
 	cloned := &DBEntry{
 		BaseEntry: entry.BaseEntry.Clone(),
 		name:      entry.name,
+		charset:   entry.charset,
+		collation: entry.collation,
 	}
 	return cloned
 }
@@ -377,6 +421,8 @@ func (entry *DBEntry) CloneCreate() CheckpointItem {
 	cloned := &DBEntry{
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		name:      entry.name,
+		charset:   entry.charset,
+		collation: entry.collation,
 	}
 	return cloned
 }
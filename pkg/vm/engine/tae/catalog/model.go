@@ -28,12 +28,17 @@ const (
 
 var (
 	HiddenColumnType types.Type
+	// ChecksumColumnType is the type of the row checksums
+	ChecksumColumnType = types.T_uint64.ToType()
 )
 
 const (
 	HiddenColumnName    = "PADDR"
 	HiddenColumnComment = "Physical address"
 
+	ChecksumColumnName    = "__mo_checksum"
+	ChecksumColumnComment = "Row checksum"
+
 	SystemDBID               = uint64(1)
 	SystemDBName             = "mo_catalog"
 	CatalogName              = "taec"
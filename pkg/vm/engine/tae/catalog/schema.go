@@ -67,19 +67,28 @@ type ColDef struct {
 	Idx           int
 	Type          types.Type
 	Hidden        int8
-	NullAbility   int8
+	NullAbility   int8 // 1 if the column is NOT NULL, the attnotnull of mo_columns
 	AutoIncrement int8
 	SortIdx       int8
 	SortKey       int8
+	SortDesc      int8
 	Primary       int8
 	Comment       string
+	Generated     string // the expression of a stored generated column
 	Default       Default
+	// DefaultExpression is the expression of the default value evaluated for
+	// every inserted row
+	DefaultExpression string
+	OnUpdate          string // the expression assigned to the column on update
 }
 
 func (def *ColDef) IsHidden() bool  { return def.Hidden == int8(1) }
 func (def *ColDef) IsPrimary() bool { return def.Primary == int8(1) }
 func (def *ColDef) IsSortKey() bool { return def.SortKey == int8(1) }
 
+// IsSortDesc returns true if the sort key column is sorted in descending order
+func (def *ColDef) IsSortDesc() bool { return def.SortDesc == int8(1) }
+
 type SortKey struct {
 	Defs      []*ColDef
 	search    map[int]int
@@ -114,6 +123,18 @@ func (cpk *SortKey) GetDef(pos int) *ColDef         { return cpk.Defs[pos] }
 func (cpk *SortKey) HasColumn(idx int) (found bool) { _, found = cpk.search[idx]; return }
 func (cpk *SortKey) GetSingleIdx() int              { return cpk.Defs[0].Idx }
 
+// IsDesc returns true if the single sort key is sorted in descending order
+func (cpk *SortKey) IsDesc() bool { return cpk.Defs[0].IsSortDesc() }
+
+// GetDescs returns the direction of every sort key column
+func (cpk *SortKey) GetDescs() []bool {
+	descs := make([]bool, len(cpk.Defs))
+	for i, def := range cpk.Defs {
+		descs[i] = def.IsSortDesc()
+	}
+	return descs
+}
+
 type Schema struct {
 	Name             string
 	ColDefs          []*ColDef
@@ -121,9 +142,17 @@ type Schema struct {
 	BlockMaxRows     uint32
 	SegmentMaxBlocks uint16
 	Comment          string
+	Partition        string
+	// TTLColumn and TTL are the time to live of the rows, see HasTTL
+	TTLColumn string
+	TTL       uint64
+	// RowChecksum maintains a checksum of every row, see ChecksumKey
+	RowChecksum bool
 
 	SortKey   *SortKey
 	HiddenKey *ColDef
+	// ChecksumKey is the column of the row checksums if RowChecksum
+	ChecksumKey *ColDef
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -158,6 +187,19 @@ func (s *Schema) GetSortKeyCnt() int {
 	return s.SortKey.Size()
 }
 
+// GetSortKeyIndexCnt returns the number of the index files of the first
+// sort key column: the zonemap and the bloomfilter of the sort key, and the
+// zonemaps of the columns of a compound sort key
+func (s *Schema) GetSortKeyIndexCnt() int {
+	if s.SortKey == nil {
+		return 0
+	}
+	if s.IsSingleSortKey() {
+		return 2
+	}
+	return 2 + s.SortKey.Size()
+}
+
 func MarshalDefault(w *bytes.Buffer, typ types.Type, data Default) (err error) {
 	if err = binary.Write(w, binary.BigEndian, data.Set); err != nil {
 		return
@@ -226,6 +268,22 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		return
 	}
 	n += sn
+	if s.Partition, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	if s.TTLColumn, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	if err = binary.Read(r, binary.BigEndian, &s.TTL); err != nil {
+		return
+	}
+	n += 8
+	if err = binary.Read(r, binary.BigEndian, &s.RowChecksum); err != nil {
+		return
+	}
+	n += 1
 	colCnt := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
@@ -247,6 +305,18 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if def.Generated, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if def.DefaultExpression, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if def.OnUpdate, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
 		if err = binary.Read(r, binary.BigEndian, &def.NullAbility); err != nil {
 			return
 		}
@@ -271,6 +341,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
+		if err = binary.Read(r, binary.BigEndian, &def.SortDesc); err != nil {
+			return
+		}
+		n += 1
 		def.Default = Default{}
 		if sn, err = UnMarshalDefault(r, def.Type, &def.Default); err != nil {
 			return
@@ -298,6 +372,18 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if _, err = common.WriteString(s.Comment, &w); err != nil {
 		return
 	}
+	if _, err = common.WriteString(s.Partition, &w); err != nil {
+		return
+	}
+	if _, err = common.WriteString(s.TTLColumn, &w); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.TTL); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.RowChecksum); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ColDefs))); err != nil {
 		return
 	}
@@ -311,6 +397,15 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = common.WriteString(def.Comment, &w); err != nil {
 			return
 		}
+		if _, err = common.WriteString(def.Generated, &w); err != nil {
+			return
+		}
+		if _, err = common.WriteString(def.DefaultExpression, &w); err != nil {
+			return
+		}
+		if _, err = common.WriteString(def.OnUpdate, &w); err != nil {
+			return
+		}
 		if err = binary.Write(&w, binary.BigEndian, def.NullAbility); err != nil {
 			return
 		}
@@ -329,6 +424,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, def.SortKey); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, def.SortDesc); err != nil {
+			return
+		}
 		if err = MarshalDefault(&w, def.Type, def.Default); err != nil {
 			return
 		}
@@ -350,6 +448,12 @@ func (s *Schema) AppendColDef(def *ColDef) (err error) {
 }
 
 func (s *Schema) AppendSortKey(name string, typ types.Type, idx int, isPrimary bool) error {
+	return s.AppendSortKeyWithOrder(name, typ, idx, isPrimary, false)
+}
+
+// AppendSortKeyWithOrder appends a sort key column sorted in descending
+// order if desc is true
+func (s *Schema) AppendSortKeyWithOrder(name string, typ types.Type, idx int, isPrimary, desc bool) error {
 	def := &ColDef{
 		Name:    name,
 		Type:    typ,
@@ -359,6 +463,9 @@ func (s *Schema) AppendSortKey(name string, typ types.Type, idx int, isPrimary b
 	if isPrimary {
 		def.Primary = int8(1)
 	}
+	if desc {
+		def.SortDesc = int8(1)
+	}
 	return s.AppendColDef(def)
 }
 
@@ -441,6 +548,16 @@ func (s *Schema) Finalize(rebuild bool) (err error) {
 		return
 	}
 	if !rebuild {
+		if s.RowChecksum {
+			checksumDef := &ColDef{
+				Name:    ChecksumColumnName,
+				Comment: ChecksumColumnComment,
+				Type:    ChecksumColumnType,
+			}
+			if err = s.AppendColDef(checksumDef); err != nil {
+				return
+			}
+		}
 		hiddenDef := &ColDef{
 			Name:    HiddenColumnName,
 			Comment: HiddenColumnComment,
@@ -477,6 +594,9 @@ func (s *Schema) Finalize(rebuild bool) (err error) {
 			}
 			s.HiddenKey = def
 		}
+		if s.RowChecksum && def.Name == ChecksumColumnName {
+			s.ChecksumKey = def
+		}
 	}
 
 	if len(sortIdx) == 1 {
@@ -512,6 +632,23 @@ func (s *Schema) Finalize(rebuild bool) (err error) {
 			}
 		}
 	}
+
+	if s.RowChecksum && s.ChecksumKey == nil {
+		err = fmt.Errorf("%w: row checksum column \"%s\" not found", ErrSchemaValidation, ChecksumColumnName)
+		return
+	}
+
+	if s.HasTTL() {
+		def := s.GetTTLColDef()
+		if def == nil {
+			err = fmt.Errorf("%w: TTL column \"%s\" not found", ErrSchemaValidation, s.TTLColumn)
+			return
+		}
+		if !IsTTLType(def.Type) {
+			err = fmt.Errorf("%w: TTL column \"%s\" of type %s", ErrSchemaValidation, def.Name, def.Type.String())
+			return
+		}
+	}
 	return
 }
 
@@ -339,10 +339,8 @@ func (entry *SegmentEntry) Clone() CheckpointItem {
 func (entry *SegmentEntry) ReplayFile(cache *bytes.Buffer) {
 	colCnt := len(entry.table.GetSchema().ColDefs)
 	indexCnt := make(map[int]int)
-	if entry.table.GetSchema().IsSingleSortKey() {
-		indexCnt[entry.table.GetSchema().GetSingleSortKey().Idx] = 2
-	} else if entry.table.GetSchema().IsCompoundSortKey() {
-		panic("implement me")
+	if entry.table.GetSchema().HasSortKey() {
+		indexCnt[entry.table.GetSchema().SortKey.Defs[0].Idx] = entry.table.GetSchema().GetSortKeyIndexCnt()
 	}
 	if err := entry.GetSegmentData().GetSegmentFile().Replay(colCnt, indexCnt, cache); err != nil {
 		panic(err)
@@ -0,0 +1,104 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+// TableStats are the statistics of a table used by the planner. They are
+// aggregated from the blocks of the table when asked for, and kept until
+// they are older than the staleness bound of the caller.
+type TableStats struct {
+	// Rows is the number of the committed rows of the table
+	Rows uint64
+	// NDV is the estimated number of distinct values of the columns,
+	// indexed by the column idx
+	NDV         []uint64
+	CollectedAt time.Time
+}
+
+// GetStats returns the statistics of the table, which are aggregated again
+// if they were collected more than maxStaleness ago
+func (entry *TableEntry) GetStats(maxStaleness time.Duration) (*TableStats, error) {
+	entry.statsMu.Lock()
+	defer entry.statsMu.Unlock()
+	if entry.stats != nil && time.Since(entry.stats.CollectedAt) <= maxStaleness {
+		return entry.stats, nil
+	}
+	stats, err := entry.collectStats()
+	if err != nil {
+		return nil, err
+	}
+	entry.stats = stats
+	return stats, nil
+}
+
+// collectStats merges the sketches of the flushed blocks. The appendable
+// blocks and the blocks without sketches have no per column NDV, they add
+// the number of the keys in their index, or their rows, to every column.
+func (entry *TableEntry) collectStats() (*TableStats, error) {
+	stats := &TableStats{
+		Rows:        entry.GetRows(),
+		NDV:         make([]uint64, len(entry.GetSchema().ColDefs)),
+		CollectedAt: time.Now(),
+	}
+	sketches := index.NewSketches()
+	rough := uint64(0)
+	segIt := entry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		seg := segIt.Get().GetPayload().(*SegmentEntry)
+		if !seg.IsActive() {
+			continue
+		}
+		blkIt := seg.MakeBlockIt(true)
+		for ; blkIt.Valid(); blkIt.Next() {
+			blk := blkIt.Get().GetPayload().(*BlockEntry)
+			blk.RLock()
+			skip := blk.IsCreatedUncommitted() || blk.IsDroppedCommitted()
+			blk.RUnlock()
+			blkData := blk.GetBlockData()
+			if skip || blkData == nil {
+				continue
+			}
+			if blk.IsAppendable() {
+				rough += uint64(blkData.RoughNDV())
+				continue
+			}
+			blkSketches, err := blkData.GetSketches()
+			if err != nil {
+				return nil, err
+			}
+			if blkSketches == nil {
+				rough += uint64(blkData.Rows(nil, true))
+				continue
+			}
+			if err = sketches.Merge(blkSketches); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := range stats.NDV {
+		ndv, _ := sketches.Estimate(uint16(i))
+		ndv += rough
+		if ndv > stats.Rows {
+			ndv = stats.Rows
+		}
+		stats.NDV[i] = ndv
+	}
+	return stats, nil
+}
@@ -35,6 +35,11 @@ type TableEntry struct {
 	link      *common.Link
 	tableData data.Table
 	rows      uint64
+	// the number of the blocks skipped by the zonemaps of the scans and the
+	// point lookups
+	prunedBlocks uint64
+	statsMu      sync.Mutex
+	stats        *TableStats
 }
 
 func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) *TableEntry {
@@ -131,6 +136,14 @@ func (entry *TableEntry) RemoveRows(delta uint64) uint64 {
 	return atomic.AddUint64(&entry.rows, ^(delta - 1))
 }
 
+func (entry *TableEntry) GetPrunedBlocks() uint64 {
+	return atomic.LoadUint64(&entry.prunedBlocks)
+}
+
+func (entry *TableEntry) AddPrunedBlocks(delta uint64) uint64 {
+	return atomic.AddUint64(&entry.prunedBlocks, delta)
+}
+
 func (entry *TableEntry) GetSegmentByID(id uint64) (seg *SegmentEntry, err error) {
 	entry.RLock()
 	defer entry.RUnlock()
@@ -0,0 +1,66 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// HasTTL returns true if the rows of the table expire. A row expires TTL
+// seconds after the value of its TTL column, the rows whose TTL column is
+// null never expire.
+func (s *Schema) HasTTL() bool { return s.TTL > 0 }
+
+// GetTTLColDef returns the TTL column, or nil if there is no such column
+func (s *Schema) GetTTLColDef() *ColDef {
+	idx := s.GetColIdx(s.TTLColumn)
+	if idx < 0 {
+		return nil
+	}
+	return s.ColDefs[idx]
+}
+
+// IsTTLType returns true if a column of the type can be the TTL column
+func IsTTLType(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_date, types.T_datetime, types.T_timestamp:
+		return true
+	}
+	return false
+}
+
+// TTLCutoff returns the TTL column and the value of the column at which the
+// rows expire at now, the rows whose values are less than it are expired
+func (s *Schema) TTLCutoff(now time.Time) (def *ColDef, cutoff any) {
+	def = s.GetTTLColDef()
+	// the datetimes and dates are of the local time, the timestamps are of UTC
+	t := now.Add(-time.Duration(s.TTL) * time.Second).Local()
+	dt := types.FromClock(int32(t.Year()), uint8(t.Month()), uint8(t.Day()),
+		uint8(t.Hour()), uint8(t.Minute()), uint8(t.Second()), uint32(t.Nanosecond()/1000))
+	switch def.Type.Oid {
+	case types.T_date:
+		// a date is expired if its first moment is
+		cutoff = (dt - 1).ToDate() + 1
+	case types.T_datetime:
+		cutoff = dt
+	case types.T_timestamp:
+		t = t.UTC()
+		cutoff = types.FromClockUTC(int32(t.Year()), uint8(t.Month()), uint8(t.Day()),
+			uint8(t.Hour()), uint8(t.Minute()), uint8(t.Second()), uint32(t.Nanosecond()/1000))
+	}
+	return
+}
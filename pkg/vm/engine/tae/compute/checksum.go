@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	"github.com/cespare/xxhash/v2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/encoding/rowcodec"
+)
+
+// RowChecksums returns the checksums of the rows [start, end) of the
+// columns. The checksum of a row is the xxhash of the canonical encoding of
+// its values, which is built column by column for all the rows.
+func RowChecksums(cols []*gvec.Vector, start, end int) []uint64 {
+	rows := make([][]byte, end-start)
+	for _, col := range cols {
+		for i := range rows {
+			row := uint32(start + i)
+			var v any
+			if !nulls.Contains(col.Nsp, uint64(row)) {
+				v = GetValue(col, row)
+			}
+			rows[i] = rowcodec.EncodeValue(rows[i], col.Typ, v)
+		}
+	}
+	sums := make([]uint64, len(rows))
+	for i, row := range rows {
+		sums[i] = xxhash.Sum64(row)
+	}
+	return sums
+}
+
+// RowChecksumVector returns a vector of the checksums of all the rows of the
+// columns
+func RowChecksumVector(cols []*gvec.Vector) *gvec.Vector {
+	vec := gvec.New(types.T_uint64.ToType())
+	n := 0
+	if len(cols) > 0 {
+		n = gvec.Length(cols[0])
+	}
+	sums := RowChecksums(cols, 0, n)
+	vec.Col = sums
+	vec.Data = encoding.EncodeUint64Slice(sums)
+	return vec
+}
+
+// FirstChecksumMismatch returns the first row whose checksum in sums is not
+// the checksum of the row of the columns, or -1 if all the checksums match.
+// The columns and sums are the rows left by deletes, the row returned is the
+// row before the deletes were applied.
+func FirstChecksumMismatch(cols []*gvec.Vector, sums *gvec.Vector, deletes *roaring.Bitmap) int {
+	stored := sums.Col.([]uint64)
+	for i, sum := range RowChecksums(cols, 0, len(stored)) {
+		if sum == stored[i] {
+			continue
+		}
+		row := uint32(i)
+		if deletes != nil {
+			// skip the deleted rows before it
+			it := deletes.Iterator()
+			for it.HasNext() && it.Next() <= row {
+				row++
+			}
+		}
+		return int(row)
+	}
+	return -1
+}
@@ -23,14 +23,12 @@ import (
 func CompareGeneric(a, b any, t types.Type) int {
 	switch t.Oid {
 	case types.T_bool:
-		if a.(bool) && b.(bool) {
-			return 0
-		} else if !a.(bool) && !b.(bool) {
+		if a.(bool) == b.(bool) {
 			return 0
 		} else if a.(bool) {
 			return 1
 		} else {
-			return 0
+			return -1
 		}
 	case types.T_int8:
 		if a.(int8) > b.(int8) {
@@ -88,7 +86,7 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if a.(uint64) > b.(uint64) {
 			return 1
 		} else if a.(uint64) < b.(uint64) {
@@ -124,6 +122,14 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
+	case types.T_time:
+		if a.(types.Time) > b.(types.Time) {
+			return 1
+		} else if a.(types.Time) < b.(types.Time) {
+			return -1
+		} else {
+			return 0
+		}
 	case types.T_date:
 		if a.(types.Date) > b.(types.Date) {
 			return 1
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
@@ -55,7 +56,7 @@ func AppendValue(vec *gvec.Vector, v any) {
 	case types.T_uint32:
 		vvals := vec.Col.([]uint32)
 		vec.Col = append(vvals, v.(uint32))
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vvals := vec.Col.([]uint64)
 		vec.Col = append(vvals, v.(uint64))
 	case types.T_decimal64:
@@ -76,6 +77,9 @@ func AppendValue(vec *gvec.Vector, v any) {
 	case types.T_timestamp:
 		vvals := vec.Col.([]types.Timestamp)
 		vec.Col = append(vvals, v.(types.Timestamp))
+	case types.T_time:
+		vvals := vec.Col.([]types.Time)
+		vec.Col = append(vvals, v.(types.Time))
 	case types.T_datetime:
 		vvals := vec.Col.([]types.Datetime)
 		vec.Col = append(vvals, v.(types.Datetime))
@@ -122,7 +126,7 @@ func GetValue(col *gvec.Vector, row uint32) any {
 	case types.T_uint32:
 		data := vals.([]uint32)
 		return data[row]
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := vals.([]uint64)
 		return data[row]
 	case types.T_decimal64:
@@ -146,6 +150,9 @@ func GetValue(col *gvec.Vector, row uint32) any {
 	case types.T_timestamp:
 		data := vals.([]types.Timestamp)
 		return data[row]
+	case types.T_time:
+		data := vals.([]types.Time)
+		return data[row]
 	case types.T_char, types.T_varchar, types.T_json:
 		data := vals.(*types.Bytes)
 		s := data.Offsets[row]
@@ -192,7 +199,7 @@ func SetFixSizeTypeValue(col *gvec.Vector, row uint32, val any) error {
 		data := vals.([]uint32)
 		data[row] = val.(uint32)
 		col.Col = data
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := vals.([]uint64)
 		data[row] = val.(uint64)
 		col.Col = data
@@ -224,6 +231,10 @@ func SetFixSizeTypeValue(col *gvec.Vector, row uint32, val any) error {
 		data := vals.([]types.Timestamp)
 		data[row] = val.(types.Timestamp)
 		col.Col = data
+	case types.T_time:
+		data := vals.([]types.Time)
+		data[row] = val.(types.Time)
+		col.Col = data
 	case types.T_char, types.T_varchar, types.T_json:
 		// data := vals.(*types.Bytes)
 		// s := data.Offsets[row]
@@ -270,7 +281,7 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 		data := vals.([]uint32)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := vals.([]uint64)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
@@ -302,6 +313,10 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 		data := vals.([]types.Timestamp)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
+	case types.T_time:
+		data := vals.([]types.Time)
+		data = append(data[:row], data[row+1:]...)
+		col.Col = data
 	case types.T_char, types.T_varchar, types.T_json:
 		// data := vals.(*types.Bytes)
 		// s := data.Offsets[row]
@@ -445,9 +460,9 @@ func ApplyDeleteToVector(vec *gvec.Vector, deletes *roaring.Bitmap) *gvec.Vector
 	deleted := 0
 	switch vec.Typ.Oid {
 	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
-		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit,
 		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64,
-		types.T_date, types.T_datetime, types.T_timestamp:
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_time:
 		vec.Col = InplaceDeleteRows(vec.Col, deletesIterator)
 		deletesIterator = deletes.Iterator()
 		for deletesIterator.HasNext() {
@@ -541,9 +556,9 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 	col := vec.Col
 	switch vec.Typ.Oid {
 	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
-		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit,
 		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64,
-		types.T_date, types.T_datetime, types.T_timestamp:
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_time:
 		for iterator.HasNext() {
 			row := iterator.Next()
 			SetFixSizeTypeValue(vec, row, vals[row])
@@ -747,7 +762,14 @@ func ShuffleByDeletes(origMask *roaring.Bitmap, origVals map[uint32]any, deletes
 	return destMask, destVals, destDelets
 }
 
-func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset uint32, exist bool) {
+// CheckRowExists binary searches v in the sorted column data, the column is
+// sorted in descending order if desc is true.
+func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap, desc bool) (offset uint32, exist bool) {
+	// The rows of a descending column are searched backwards
+	first, step := 0, 1
+	if desc {
+		first, step = gvec.Length(data)-1, -1
+	}
 	switch data.Typ.Oid {
 	case types.T_bool:
 		column := data.Col.([]bool)
@@ -767,16 +789,17 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			ret := compare(column[mid], val)
+			row := first + step*mid
+			ret := compare(column[row], val)
 			if ret == 1 {
 				end = mid - 1
 			} else if ret == -1 {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -789,15 +812,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -810,15 +834,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -831,15 +856,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -852,15 +878,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -873,15 +900,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -894,15 +922,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -915,36 +944,38 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
 		}
 		return
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		column := data.Col.([]uint64)
 		val := v.(uint64)
 		start, end := 0, len(column)-1
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -957,15 +988,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -978,15 +1010,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -999,15 +1032,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -1020,15 +1054,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -1041,15 +1076,38 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
+				end = mid - 1
+			} else if column[row] < val {
+				start = mid + 1
+			} else {
+				if deletes != nil && deletes.Contains(uint32(row)) {
+					return
+				}
+				offset = uint32(row)
+				exist = true
+				return
+			}
+		}
+		return
+	case types.T_time:
+		column := data.Col.([]types.Time)
+		val := v.(types.Time)
+		start, end := 0, len(column)-1
+		var mid int
+		for start <= end {
+			mid = (start + end) / 2
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -1062,15 +1120,16 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			if column[mid] > val {
+			row := first + step*mid
+			if column[row] > val {
 				end = mid - 1
-			} else if column[mid] < val {
+			} else if column[row] < val {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -1083,16 +1142,17 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			ret := types.CompareDecimal128Decimal128Aligned(column[mid], val)
+			row := first + step*mid
+			ret := types.CompareDecimal128Decimal128Aligned(column[row], val)
 			if ret == 1 {
 				end = mid - 1
 			} else if ret == -1 {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -1105,16 +1165,17 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		var mid int
 		for start <= end {
 			mid = (start + end) / 2
-			res := bytes.Compare(column.Get(int64(mid)), val)
+			row := first + step*mid
+			res := bytes.Compare(column.Get(int64(row)), val)
 			if res > 0 {
 				end = mid - 1
 			} else if res < 0 {
 				start = mid + 1
 			} else {
-				if deletes != nil && deletes.Contains(uint32(mid)) {
+				if deletes != nil && deletes.Contains(uint32(row)) {
 					return
 				}
-				offset = uint32(mid)
+				offset = uint32(row)
 				exist = true
 				return
 			}
@@ -1124,3 +1185,11 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 		panic("unsupported type")
 	}
 }
+
+// Uint64Vector returns a uint64 vector of the values
+func Uint64Vector(vs []uint64) *gvec.Vector {
+	vec := gvec.New(types.T_uint64.ToType())
+	vec.Col = vs
+	vec.Data = encoding.EncodeUint64Slice(vs)
+	return vec
+}
@@ -113,22 +113,37 @@ func TestCheckRowExists(t *testing.T) {
 		Width: 32,
 	}
 	vec := MockVec(typ, 100, 0)
-	_, exist := CheckRowExists(vec, int32(55), nil)
+	_, exist := CheckRowExists(vec, int32(55), nil, false)
 	require.True(t, exist)
-	_, exist = CheckRowExists(vec, int32(0), nil)
+	_, exist = CheckRowExists(vec, int32(0), nil, false)
 	require.True(t, exist)
-	_, exist = CheckRowExists(vec, int32(99), nil)
+	_, exist = CheckRowExists(vec, int32(99), nil, false)
 	require.True(t, exist)
 
-	_, exist = CheckRowExists(vec, int32(-1), nil)
+	_, exist = CheckRowExists(vec, int32(-1), nil, false)
 	require.False(t, exist)
-	_, exist = CheckRowExists(vec, int32(100), nil)
+	_, exist = CheckRowExists(vec, int32(100), nil, false)
 	require.False(t, exist)
-	_, exist = CheckRowExists(vec, int32(114514), nil)
+	_, exist = CheckRowExists(vec, int32(114514), nil, false)
 	require.False(t, exist)
 
 	dels := roaring.NewBitmap()
 	dels.Add(uint32(55))
-	_, exist = CheckRowExists(vec, int32(55), dels)
+	_, exist = CheckRowExists(vec, int32(55), dels, false)
+	require.False(t, exist)
+
+	desc := MockVec(typ, 100, 0)
+	col := desc.Col.([]int32)
+	for i, j := 0, len(col)-1; i < j; i, j = i+1, j-1 {
+		col[i], col[j] = col[j], col[i]
+	}
+	for _, v := range []int32{0, 55, 99} {
+		offset, exist := CheckRowExists(desc, v, nil, true)
+		require.True(t, exist)
+		require.Equal(t, v, col[offset])
+	}
+	_, exist = CheckRowExists(desc, int32(100), nil, true)
+	require.False(t, exist)
+	_, exist = CheckRowExists(desc, int32(44), dels, true)
 	require.False(t, exist)
 }
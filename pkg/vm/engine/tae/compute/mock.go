@@ -154,7 +154,7 @@ func MockIVector(t types.Type, rows uint64, unique bool, provider *movec.Vector)
 			}
 		}
 		_ = vec.Append(len(vals), vals)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vec = vector.NewStdVector(t, rows)
 		var vals []uint64
 		if unique {
@@ -225,6 +225,13 @@ func MockIVector(t types.Type, rows uint64, unique bool, provider *movec.Vector)
 			vals = append(vals, types.Timestamp(common.NextGlobalSeqNum()))
 		}
 		_ = vec.Append(len(vals), vals)
+	case types.T_time:
+		vec = vector.NewStdVector(t, rows)
+		vals := make([]types.Time, 0, rows)
+		for i := int32(1); i <= int32(rows); i++ {
+			vals = append(vals, types.Time(common.NextGlobalSeqNum()))
+		}
+		_ = vec.Append(len(vals), vals)
 	case types.T_decimal64:
 		vec = vector.NewStdVector(t, rows)
 		vals := make([]types.Decimal64, 0, rows)
@@ -355,7 +362,7 @@ func MockVec(typ types.Type, rows int, offset int) *movec.Vector {
 			data = append(data, uint32(i+offset))
 		}
 		_ = movec.Append(vec, data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := make([]uint64, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint64(i+offset))
@@ -391,6 +398,12 @@ func MockVec(typ types.Type, rows int, offset int) *movec.Vector {
 			data = append(data, types.Timestamp(i+offset))
 		}
 		_ = movec.Append(vec, data)
+	case types.T_time:
+		data := make([]types.Time, 0)
+		for i := 0; i < rows; i++ {
+			data = append(data, types.Time(i+offset))
+		}
+		_ = movec.Append(vec, data)
 	case types.T_date:
 		data := make([]types.Date, 0)
 		for i := 0; i < rows; i++ {
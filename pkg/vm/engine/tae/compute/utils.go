@@ -46,7 +46,7 @@ func DecodeKey(key []byte, typ types.Type) any {
 		return encoding.DecodeUint16(key)
 	case types.T_uint32:
 		return encoding.DecodeUint32(key)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return encoding.DecodeUint64(key)
 	case types.T_float32:
 		return encoding.DecodeFloat32(key)
@@ -58,6 +58,8 @@ func DecodeKey(key []byte, typ types.Type) any {
 		return encoding.DecodeDatetime(key)
 	case types.T_timestamp:
 		return encoding.DecodeTimestamp(key)
+	case types.T_time:
+		return encoding.DecodeTime(key)
 	case types.T_decimal64:
 		return encoding.DecodeDecimal64(key)
 	case types.T_decimal128:
@@ -87,7 +89,7 @@ func EncodeKey(key any, typ types.Type) []byte {
 		return encoding.EncodeUint16(key.(uint16))
 	case types.T_uint32:
 		return encoding.EncodeUint32(key.(uint32))
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return encoding.EncodeUint64(key.(uint64))
 	case types.T_decimal64:
 		return encoding.EncodeDecimal64(key.(types.Decimal64))
@@ -101,6 +103,8 @@ func EncodeKey(key any, typ types.Type) []byte {
 		return encoding.EncodeDate(key.(types.Date))
 	case types.T_timestamp:
 		return encoding.EncodeTimestamp(key.(types.Timestamp))
+	case types.T_time:
+		return encoding.EncodeTime(key.(types.Time))
 	case types.T_datetime:
 		return encoding.EncodeDatetime(key.(types.Datetime))
 	case types.T_char, types.T_varchar:
@@ -244,7 +248,7 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vs := vec.Col.([]uint64)[offset:]
 		if keyselects == nil {
 			for i, v := range vs {
@@ -340,6 +344,22 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
+	case types.T_time:
+		vs := vec.Col.([]types.Time)[offset:]
+		if keyselects == nil {
+			for i, v := range vs {
+				if err := task(v, uint32(i)); err != nil {
+					return err
+				}
+			}
+		} else {
+			for _, idx := range idxes {
+				v := vs[idx]
+				if err := task(v, idx); err != nil {
+					return err
+				}
+			}
+		}
 	case types.T_date:
 		vs := vec.Col.([]types.Date)[offset:]
 		if keyselects == nil {
@@ -69,6 +69,13 @@ func (v *BaseVector) IsNull(idx int) (bool, error) {
 func MockVector(t types.Type, rows uint64) IVector {
 	var vec IVector
 	switch t.Oid {
+	case types.T_bool:
+		vec = NewStdVector(t, rows)
+		var vals []bool
+		for i := uint64(0); i < rows; i++ {
+			vals = append(vals, i%2 == 0)
+		}
+		vec.Append(len(vals), vals)
 	case types.T_int8:
 		vec = NewStdVector(t, rows)
 		var vals []int8
@@ -118,7 +125,7 @@ func MockVector(t types.Type, rows uint64) IVector {
 			vals = append(vals, uint32(i%5000))
 		}
 		vec.Append(len(vals), vals)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		vec = NewStdVector(t, rows)
 		var vals []uint64
 		for i := uint64(0); i < rows; i++ {
@@ -171,7 +171,7 @@ func (v *StdVector) SetValue(idx int, val any) error {
 		data := encoding.EncodeUint32(val.(uint32))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := encoding.EncodeUint64(val.(uint64))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
@@ -203,6 +203,10 @@ func (v *StdVector) SetValue(idx int, val any) error {
 		data := encoding.EncodeTimestamp(val.(types.Timestamp))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
+	case types.T_time:
+		data := encoding.EncodeTime(val.(types.Time))
+		copy(v.Data[start:start+int(v.Type.Size)], data)
+		return nil
 	default:
 		return ErrVecTypeNotSupport
 	}
@@ -237,7 +241,7 @@ func (v *StdVector) GetValue(idx int) (any, error) {
 		return encoding.DecodeUint16(data), nil
 	case types.T_uint32:
 		return encoding.DecodeUint32(data), nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return encoding.DecodeUint64(data), nil
 	case types.T_float32:
 		return encoding.DecodeFloat32(data), nil
@@ -253,6 +257,8 @@ func (v *StdVector) GetValue(idx int) (any, error) {
 		return encoding.DecodeDatetime(data), nil
 	case types.T_timestamp:
 		return encoding.DecodeTimestamp(data), nil
+	case types.T_time:
+		return encoding.DecodeTime(data), nil
 	default:
 		return nil, ErrVecTypeNotSupport
 	}
@@ -280,8 +286,21 @@ func (v *StdVector) Append(n int, vals any) error {
 }
 
 func (v *StdVector) appendWithOffset(offset, n int, vals any) error {
-	var data []byte
-	switch v.Type.Oid {
+	data, err := fixedSliceBytes(v.Type.Oid, offset, n, vals)
+	if err != nil {
+		return err
+	}
+	if len(v.Data)+len(data) > cap(v.Data) {
+		return ErrVecInvalidOffset
+	}
+	v.Data = append(v.Data, data...)
+	return nil
+}
+
+// fixedSliceBytes returns the bytes of the n values of vals from the offset,
+// the bytes share the memory of vals
+func fixedSliceBytes(oid types.T, offset, n int, vals any) (data []byte, err error) {
+	switch oid {
 	case types.T_bool:
 		data = encoding.EncodeBoolSlice(vals.([]bool)[offset : offset+n])
 	case types.T_int8:
@@ -298,7 +317,7 @@ func (v *StdVector) appendWithOffset(offset, n int, vals any) error {
 		data = encoding.EncodeUint16Slice(vals.([]uint16)[offset : offset+n])
 	case types.T_uint32:
 		data = encoding.EncodeUint32Slice(vals.([]uint32)[offset : offset+n])
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data = encoding.EncodeUint64Slice(vals.([]uint64)[offset : offset+n])
 	case types.T_decimal64:
 		data = encoding.EncodeDecimal64Slice(vals.([]types.Decimal64)[offset : offset+n])
@@ -314,14 +333,45 @@ func (v *StdVector) appendWithOffset(offset, n int, vals any) error {
 		data = encoding.EncodeDatetimeSlice(vals.([]types.Datetime)[offset : offset+n])
 	case types.T_timestamp:
 		data = encoding.EncodeTimestampSlice(vals.([]types.Timestamp)[offset : offset+n])
+	case types.T_time:
+		data = encoding.EncodeTimeSlice(vals.([]types.Time)[offset : offset+n])
 	default:
-		return ErrVecTypeNotSupport
+		err = ErrVecTypeNotSupport
 	}
-	if len(v.Data)+len(data) > cap(v.Data) {
-		return ErrVecInvalidOffset
+	return
+}
+
+// NewStdVectorReference returns a readonly vector of the n values of vec
+// from the offset. The values are not copied, so they must not be modified
+// as long as the returned vector is in use. vec must be of a fixed size type.
+func NewStdVectorReference(t types.Type, vec *gvec.Vector, offset, n int) (*StdVector, error) {
+	if offset < 0 || n <= 0 || offset+n > gvec.Length(vec) {
+		return nil, ErrVecInvalidOffset
 	}
-	v.Data = append(v.Data, data...)
-	return nil
+	data, err := fixedSliceBytes(t.Oid, offset, n, vec.Col)
+	if err != nil {
+		return nil, err
+	}
+	mask := container.ReadonlyMask | (uint64(n) & container.PosMask)
+	vmask := &nulls.Nulls{}
+	if vec.Nsp.Np != nil {
+		for row := offset; row < offset+n; row++ {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				nulls.Add(vmask, uint64(row-offset))
+			}
+		}
+		if nulls.Any(vmask) {
+			mask = mask | container.HasNullMask
+		}
+	}
+	return &StdVector{
+		BaseVector: BaseVector{
+			Type:     t,
+			VMask:    vmask,
+			StatMask: mask,
+		},
+		Data: data[:len(data):len(data)],
+	}, nil
 }
 
 func (v *StdVector) AppendVector(vec *gvec.Vector, offset int) (n int, err error) {
@@ -460,13 +510,11 @@ func (v *StdVector) Window(start, end uint32) IVector {
 		Data: v.Data[startIdx:endIdx],
 	}
 	if mask&container.HasNullMask != 0 {
-		if mask&container.ReadonlyMask == 0 {
-			var np *roaring64.Bitmap
-			if v.VMask != nil {
-				np = common.BM64Window(v.VMask.Np, int(start), int(end))
-			}
-			vec.VMask = &nulls.Nulls{Np: np}
+		var np *roaring64.Bitmap
+		if v.VMask != nil {
+			np = common.BM64Window(v.VMask.Np, int(start), int(end))
 		}
+		vec.VMask = &nulls.Nulls{Np: np}
 	} else {
 		vec.VMask = &nulls.Nulls{}
 	}
@@ -591,7 +639,7 @@ func (v *StdVector) CopyToVector() (*gvec.Vector, error) {
 		copy(col, curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		col := make([]uint64, length)
 		curCol := encoding.DecodeUint64Slice(v.Data)
 		copy(col, curCol[:length])
@@ -639,6 +687,12 @@ func (v *StdVector) CopyToVector() (*gvec.Vector, error) {
 		copy(col, curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
+	case types.T_time:
+		col := make([]types.Time, length)
+		curCol := encoding.DecodeTimeSlice(v.Data)
+		copy(col, curCol[:length])
+		vec.Col = col
+		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
 	default:
 		return nil, ErrVecTypeNotSupport
 	}
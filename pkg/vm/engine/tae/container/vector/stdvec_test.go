@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	v "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -216,3 +217,38 @@ func TestStdVector(t *testing.T) {
 	assert.Equal(t, 10000, tmpv.Length())
 	assert.True(t, tmpv.HasNull())
 }
+
+func TestStdVectorReference(t *testing.T) {
+	typ := types.T_int64.ToType()
+	vvec := v.New(typ)
+	vals := []int64{0, 1, 2, 3, 4, 5}
+	assert.NoError(t, v.Append(vvec, vals))
+	nulls.Add(vvec.Nsp, 1)
+	nulls.Add(vvec.Nsp, 4)
+
+	ref, err := NewStdVectorReference(typ, vvec, 2, 3)
+	assert.NoError(t, err)
+	assert.Nil(t, ref.MNode)
+	assert.Equal(t, 3, ref.Length())
+	assert.True(t, ref.IsReadonly())
+	assert.True(t, ref.HasNull())
+	assert.True(t, nulls.Contains(ref.VMask, 2))
+	assert.False(t, nulls.Contains(ref.VMask, 0))
+	val, err := ref.GetValue(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), val)
+	_, err = ref.AppendVector(vvec, 0)
+	assert.ErrorIs(t, err, ErrVecWriteRo)
+
+	// the values are shared with vvec
+	vals = vvec.Col.([]int64)
+	vals[2] = 20
+	val, _ = ref.GetValue(0)
+	assert.Equal(t, int64(20), val)
+
+	_, err = NewStdVectorReference(typ, vvec, 4, 3)
+	assert.ErrorIs(t, err, ErrVecInvalidOffset)
+	_, err = NewStdVectorReference(types.T_varchar.ToType(), vvec, 0, 1)
+	assert.ErrorIs(t, err, ErrVecTypeNotSupport)
+	assert.NoError(t, ref.Close())
+}
@@ -66,6 +66,9 @@ type BaseVector struct {
 	VMask    *nulls.Nulls
 }
 
+// StdVector stores the values of a fixed size type back to back in Data,
+// which is also the layout of the column in the block files. A bool takes a
+// byte, 0 or 1, like a []bool in memory, so it's not bit-packed.
 type StdVector struct {
 	BaseVector
 	MNode        *common.MemNode
@@ -139,6 +139,8 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 	case types.T_char, types.T_varchar, types.T_json:
 		val := v.Col.(*types.Bytes)
 		return val.Data[val.Offsets[idx] : val.Offsets[idx]+val.Lengths[idx]], nil
+	case types.T_bool:
+		return v.Col.([]bool)[idx], nil
 	case types.T_int8:
 		return v.Col.([]int8)[idx], nil
 	case types.T_int16:
@@ -153,7 +155,7 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return v.Col.([]uint16)[idx], nil
 	case types.T_uint32:
 		return v.Col.([]uint32)[idx], nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return v.Col.([]uint64)[idx], nil
 	case types.T_decimal64:
 		return v.Col.([]types.Decimal64)[idx], nil
@@ -165,6 +167,8 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return v.Col.([]types.Date)[idx], nil
 	case types.T_datetime:
 		return v.Col.([]types.Datetime)[idx], nil
+	case types.T_time:
+		return v.Col.([]types.Time)[idx], nil
 	case types.T_sel:
 		return v.Col.([]int64)[idx], nil
 	case types.T_tuple:
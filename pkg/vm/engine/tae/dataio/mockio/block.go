@@ -38,6 +38,7 @@ type blockFile struct {
 	columns   []*columnBlock
 	deletes   *deletesFile
 	indexMeta *dataFile
+	sketches  *dataFile
 }
 
 func (bf *blockFile) GetDeletesFileStat() common.FileInfo {
@@ -52,6 +53,7 @@ func newBlock(id uint64, seg file.Segment, colCnt int, indexCnt map[int]int) *bl
 	}
 	bf.deletes = newDeletes(bf)
 	bf.indexMeta = newData(nil)
+	bf.sketches = newData(nil)
 	bf.OnZeroCB = bf.close
 	for i := range bf.columns {
 		cnt := 0
@@ -99,6 +101,14 @@ func (bf *blockFile) WriteDeletes(buf []byte) (err error) {
 	return
 }
 
+func (bf *blockFile) AppendDeletes(ts uint64, mask *roaring.Bitmap) (err error) {
+	buf, err := mask.ToBytes()
+	if err != nil {
+		return
+	}
+	return bf.WriteDeletes(buf)
+}
+
 func (bf *blockFile) ReadDeletes(buf []byte) (err error) {
 	_, err = bf.deletes.Read(buf)
 	return
@@ -123,6 +133,21 @@ func (bf *blockFile) LoadIndexMeta() (any, error) {
 	return indices, nil
 }
 
+func (bf *blockFile) WriteSketches(buf []byte) (err error) {
+	_, err = bf.sketches.Write(buf)
+	return
+}
+
+func (bf *blockFile) LoadSketches() ([]byte, error) {
+	size := bf.sketches.Stat().Size()
+	if size == 0 {
+		return nil, nil
+	}
+	buf := make([]byte, size)
+	_, err := bf.sketches.Read(buf)
+	return buf, err
+}
+
 func (bf *blockFile) OpenColumn(colIdx int) (colBlk file.ColumnBlock, err error) {
 	if colIdx >= len(bf.columns) {
 		err = file.ErrInvalidParam
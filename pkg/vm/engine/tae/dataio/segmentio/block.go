@@ -42,6 +42,7 @@ type blockFile struct {
 	columns   []*columnBlock
 	deletes   *deletesFile
 	indexMeta *dataFile
+	sketches  *dataFile
 	destroy   sync.Mutex
 }
 
@@ -60,6 +61,11 @@ func newBlock(id uint64, seg *segmentFile, colCnt int, indexCnt map[int]int) *bl
 	bf.indexMeta.file[0] = bf.seg.GetSegmentFile().NewBlockFile(
 		fmt.Sprintf("%d_%d.idx", colCnt, bf.id))
 	bf.indexMeta.file[0].snode.algo = compress.None
+	bf.sketches = newIndex(&columnBlock{block: bf}).dataFile
+	bf.sketches.file = make([]*DriverFile, 1)
+	bf.sketches.file[0] = bf.seg.GetSegmentFile().NewBlockFile(
+		fmt.Sprintf("%d_%d.sk", colCnt, bf.id))
+	bf.sketches.file[0].snode.algo = compress.None
 	bf.OnZeroCB = bf.close
 	for i := range bf.columns {
 		cnt := 0
@@ -82,6 +88,8 @@ func replayBlock(id uint64, seg *segmentFile, colCnt int, indexCnt map[int]int)
 	bf.deletes.file = make([]*DriverFile, 1)
 	bf.indexMeta = newIndex(&columnBlock{block: bf}).dataFile
 	bf.indexMeta.file = make([]*DriverFile, 1)
+	bf.sketches = newIndex(&columnBlock{block: bf}).dataFile
+	bf.sketches.file = make([]*DriverFile, 1)
 	bf.OnZeroCB = bf.close
 	for i := range bf.columns {
 		cnt := 0
@@ -166,6 +174,26 @@ func (bf *blockFile) LoadIndexMeta() (any, error) {
 	return indices, nil
 }
 
+func (bf *blockFile) WriteSketches(buf []byte) (err error) {
+	_, err = bf.sketches.Write(buf)
+	return
+}
+
+func (bf *blockFile) LoadSketches() ([]byte, error) {
+	if bf.sketches.file[0] == nil {
+		return nil, nil
+	}
+	size := bf.sketches.Stat().Size()
+	if size == 0 {
+		return nil, nil
+	}
+	buf := make([]byte, size)
+	if _, err := bf.sketches.Read(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
 func (bf *blockFile) OpenColumn(colIdx int) (colBlk file.ColumnBlock, err error) {
 	if colIdx >= len(bf.columns) {
 		err = file.ErrInvalidParam
@@ -198,6 +226,10 @@ func (bf *blockFile) Destroy() error {
 		bf.indexMeta.file[0].driver.ReleaseFile(bf.indexMeta.file[0])
 		bf.indexMeta = nil
 	}
+	if bf.sketches.file[0] != nil {
+		bf.sketches.file[0].driver.ReleaseFile(bf.sketches.file[0])
+		bf.sketches = nil
+	}
 	if bf.seg != nil {
 		bf.seg.RemoveBlock(bf.id)
 	}
@@ -386,26 +418,27 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 	return
 }
 
-func (bf *blockFile) LoadDeletes() (mask *roaring.Bitmap, err error) {
-	stats := bf.deletes.Stat()
-	if stats.Size() == 0 {
+func (bf *blockFile) AppendDeletes(ts uint64, mask *roaring.Bitmap) (err error) {
+	data, err := bf.deletes.loadData()
+	if err != nil {
 		return
 	}
-	size := stats.Size()
-	osize := stats.OriginSize()
-	dnode := common.GPool.Alloc(uint64(size))
-	defer common.GPool.Free(dnode)
-	if _, err = bf.deletes.Read(dnode.Buf[:size]); err != nil {
+	if !data.append(ts, mask) {
 		return
 	}
-	node := common.GPool.Alloc(uint64(osize))
-	defer common.GPool.Free(node)
+	buf, err := data.Marshal()
+	if err != nil {
+		return
+	}
+	return bf.WriteDeletes(buf)
+}
 
-	if _, err = compress.Decompress(dnode.Buf[:size], node.Buf[:osize], compress.Lz4); err != nil {
+func (bf *blockFile) LoadDeletes() (mask *roaring.Bitmap, err error) {
+	data, err := bf.deletes.loadData()
+	if err != nil || len(data.segments) == 0 {
 		return
 	}
-	mask = roaring.New()
-	err = mask.UnmarshalBinary(node.Buf[:osize])
+	mask = data.union()
 	return
 }
 
@@ -82,3 +82,125 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockDeletes(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	threshold := DeletesCompactThreshold
+	DeletesCompactThreshold = 3
+	defer func() {
+		DeletesCompactThreshold = threshold
+	}()
+	colCnt := 2
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFactory.Build(dir, id)
+	legacyId, blkId := common.NextGlobalSeqNum(), common.NextGlobalSeqNum()
+
+	// a deletes file of the old format
+	legacy, err := seg.OpenBlock(legacyId, colCnt, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, legacy.WriteTS(common.NextGlobalSeqNum()))
+	legacyMask := roaring.BitmapOf(1, 3, 5)
+	buf, err := legacyMask.ToBytes()
+	assert.Nil(t, err)
+	assert.Nil(t, legacy.WriteDeletes(buf))
+
+	block, err := seg.OpenBlock(blkId, colCnt, nil)
+	assert.Nil(t, err)
+	mask, err := block.LoadDeletes()
+	assert.Nil(t, err)
+	assert.Nil(t, mask)
+
+	// the deletes of the checkpoints are accumulated
+	expected := roaring.New()
+	var minTs, maxTs uint64
+	ckp := func(rows ...uint32) {
+		expected.AddMany(rows)
+		maxTs = common.NextGlobalSeqNum()
+		if minTs == 0 {
+			minTs = maxTs
+		}
+		assert.Nil(t, block.WriteTS(maxTs))
+		assert.Nil(t, block.AppendDeletes(maxTs, expected.Clone()))
+	}
+	ckp(10, 20)
+	ckp(30)
+	ckp()
+	ckp(40, 41, 42)
+	assert.Nil(t, block.Sync())
+
+	data, err := block.(*blockFile).deletes.loadData()
+	assert.Nil(t, err)
+	assert.Equal(t, DeletesVersion1, data.version)
+	assert.Equal(t, 3, len(data.segments))
+	assert.Equal(t, []uint32{30}, data.segments[1].mask.ToArray())
+	assert.Equal(t, minTs, data.minTs)
+	assert.Equal(t, maxTs, data.maxTs)
+
+	replay := func() {
+		seg = SegmentFactory.Build(dir, id)
+		cache := bytes.NewBuffer(make([]byte, 2*1024*1024))
+		assert.Nil(t, seg.Replay(colCnt, nil, cache))
+		block, err = seg.OpenBlock(blkId, colCnt, nil)
+		assert.Nil(t, err)
+	}
+	replay()
+	mask, err = block.LoadDeletes()
+	assert.Nil(t, err)
+	assert.True(t, expected.Equals(mask))
+
+	// the segments are compacted when there are too many
+	ckp(50)
+	assert.Nil(t, block.Sync())
+	replay()
+	data, err = block.(*blockFile).deletes.loadData()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(data.segments))
+	assert.Equal(t, maxTs, data.segments[0].ts)
+	assert.Equal(t, minTs, data.minTs)
+	assert.True(t, expected.Equals(data.segments[0].mask))
+	ckp(60)
+	ckp(70)
+	mask, err = block.LoadDeletes()
+	assert.Nil(t, err)
+	assert.True(t, expected.Equals(mask))
+
+	legacy, err = seg.OpenBlock(legacyId, colCnt, nil)
+	assert.Nil(t, err)
+	mask, err = legacy.LoadDeletes()
+	assert.Nil(t, err)
+	assert.True(t, legacyMask.Equals(mask))
+
+	// the old format is upgraded at the next checkpoint
+	legacyMask.Add(7)
+	ts := common.NextGlobalSeqNum()
+	assert.Nil(t, legacy.WriteTS(ts))
+	assert.Nil(t, legacy.AppendDeletes(ts, legacyMask))
+	data, err = legacy.(*blockFile).deletes.loadData()
+	assert.Nil(t, err)
+	assert.Equal(t, DeletesVersion1, data.version)
+	assert.Equal(t, 2, len(data.segments))
+	assert.True(t, legacyMask.Equals(data.union()))
+}
+
+func TestDeletesData(t *testing.T) {
+	data := &deletesData{}
+	assert.True(t, data.append(1, roaring.BitmapOf(1, 2)))
+	assert.False(t, data.append(2, roaring.BitmapOf(1, 2)))
+	assert.True(t, data.append(3, roaring.BitmapOf(1, 2, 3)))
+	buf, err := data.Marshal()
+	assert.Nil(t, err)
+	other := &deletesData{}
+	assert.Nil(t, other.Unmarshal(buf))
+	assert.Equal(t, uint64(1), other.minTs)
+	assert.Equal(t, uint64(3), other.maxTs)
+	assert.Equal(t, 2, len(other.segments))
+	assert.True(t, roaring.BitmapOf(1, 2, 3).Equals(other.union()))
+
+	// a truncated file
+	err = other.Unmarshal(buf[:len(buf)-1])
+	assert.ErrorIs(t, err, ErrInvalidDeletes)
+	// an unknown version
+	buf[5] = 9
+	err = other.Unmarshal(buf)
+	assert.ErrorIs(t, err, ErrInvalidDeletes)
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+// The deletes file of a block is a header followed by the delete segments.
+// Every checkpoint appends a segment of the rows deleted since the previous
+// one, and the segments are compacted into one when there are too many.
+//
+//	| magic(4) | version(2) | segments(4) | minTs(8) | maxTs(8) | rows(4) |
+//	| ts(8) | size(4) | bitmap(size) | ts(8) | size(4) | bitmap(size) | ...
+//
+// The deletes file of the old format has no header, it is a single bitmap.
+
+const (
+	DeletesMagic    uint32 = 0x4c45444d
+	DeletesVersion1 uint16 = 1
+
+	deletesHeaderSize = 4 + 2 + 4 + 8 + 8 + 4
+)
+
+// DeletesCompactThreshold is the max number of segments in a deletes file
+var DeletesCompactThreshold = 8
+
+var ErrInvalidDeletes = errors.New("tae: invalid deletes file")
+
+type deleteSegment struct {
+	ts   uint64
+	mask *roaring.Bitmap
+}
+
+type deletesData struct {
+	version  uint16
+	minTs    uint64
+	maxTs    uint64
+	segments []deleteSegment
+}
+
+// union returns all the deleted rows
+func (data *deletesData) union() *roaring.Bitmap {
+	mask := roaring.New()
+	for _, seg := range data.segments {
+		mask.Or(seg.mask)
+	}
+	return mask
+}
+
+func (data *deletesData) rows() uint32 {
+	var rows uint64
+	for _, seg := range data.segments {
+		rows += seg.mask.GetCardinality()
+	}
+	return uint32(rows)
+}
+
+// append appends the rows of mask deleted since the last segment, mask is
+// all the rows deleted at ts. It returns false if no row is deleted since
+// the last segment.
+func (data *deletesData) append(ts uint64, mask *roaring.Bitmap) bool {
+	delta := roaring.AndNot(mask, data.union())
+	if delta.IsEmpty() {
+		return false
+	}
+	if len(data.segments) == 0 {
+		data.minTs = ts
+	}
+	data.maxTs = ts
+	data.segments = append(data.segments, deleteSegment{ts: ts, mask: delta})
+	if len(data.segments) > DeletesCompactThreshold {
+		data.compact()
+	}
+	return true
+}
+
+// compact rewrites the segments into a single segment at the max ts
+func (data *deletesData) compact() {
+	data.segments = []deleteSegment{{ts: data.maxTs, mask: data.union()}}
+}
+
+func (data *deletesData) Marshal() (buf []byte, err error) {
+	var w bytes.Buffer
+	header := []any{DeletesMagic, DeletesVersion1, uint32(len(data.segments)), data.minTs, data.maxTs, data.rows()}
+	for _, v := range header {
+		if err = binary.Write(&w, binary.BigEndian, v); err != nil {
+			return
+		}
+	}
+	for _, seg := range data.segments {
+		if err = binary.Write(&w, binary.BigEndian, seg.ts); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint32(seg.mask.GetSerializedSizeInBytes())); err != nil {
+			return
+		}
+		if _, err = seg.mask.WriteTo(&w); err != nil {
+			return
+		}
+	}
+	buf = w.Bytes()
+	return
+}
+
+func (data *deletesData) Unmarshal(buf []byte) (err error) {
+	if len(buf) < deletesHeaderSize || binary.BigEndian.Uint32(buf) != DeletesMagic {
+		// the old format
+		mask := roaring.New()
+		if err = mask.UnmarshalBinary(buf); err != nil {
+			return
+		}
+		data.version = 0
+		data.minTs, data.maxTs = 0, 0
+		data.segments = []deleteSegment{{mask: mask}}
+		return
+	}
+	data.version = binary.BigEndian.Uint16(buf[4:])
+	if data.version != DeletesVersion1 {
+		return fmt.Errorf("%w: unknown version %d", ErrInvalidDeletes, data.version)
+	}
+	cnt := binary.BigEndian.Uint32(buf[6:])
+	data.minTs = binary.BigEndian.Uint64(buf[10:])
+	data.maxTs = binary.BigEndian.Uint64(buf[18:])
+	rows := binary.BigEndian.Uint32(buf[26:])
+	buf = buf[deletesHeaderSize:]
+	data.segments = make([]deleteSegment, cnt)
+	for i := range data.segments {
+		if len(buf) < 12 {
+			return fmt.Errorf("%w: truncated segment %d", ErrInvalidDeletes, i)
+		}
+		seg := &data.segments[i]
+		seg.ts = binary.BigEndian.Uint64(buf)
+		size := binary.BigEndian.Uint32(buf[8:])
+		buf = buf[12:]
+		if uint32(len(buf)) < size {
+			return fmt.Errorf("%w: truncated segment %d", ErrInvalidDeletes, i)
+		}
+		seg.mask = roaring.New()
+		if err = seg.mask.UnmarshalBinary(buf[:size]); err != nil {
+			return
+		}
+		buf = buf[size:]
+	}
+	if data.rows() != rows {
+		return fmt.Errorf("%w: %d rows in segments, expected %d", ErrInvalidDeletes, data.rows(), rows)
+	}
+	return
+}
+
+// readFile reads the uncompressed content of the file
+func readFile(file *DriverFile) (buf []byte, err error) {
+	meta := file.GetInode()
+	buf = make([]byte, meta.GetFileSize())
+	if _, err = file.Read(buf); err != nil {
+		return
+	}
+	if meta.GetAlgo() != compress.Lz4 {
+		return
+	}
+	dbuf := make([]byte, meta.GetOriginSize())
+	return compress.Decompress(buf, dbuf, compress.Lz4)
+}
+
+// loadData returns the content of the last written deletes file
+func (df *deletesFile) loadData() (data *deletesData, err error) {
+	df.mutex.RLock()
+	files := df.file
+	df.mutex.RUnlock()
+	data = &deletesData{version: DeletesVersion1}
+	for i := len(files) - 1; i >= 0; i-- {
+		if files[i] == nil || files[i].GetInode().GetFileSize() == 0 {
+			continue
+		}
+		var buf []byte
+		if buf, err = readFile(files[i]); err != nil {
+			return
+		}
+		err = data.Unmarshal(buf)
+		return
+	}
+	return
+}
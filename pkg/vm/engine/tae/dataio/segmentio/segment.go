@@ -202,6 +202,9 @@ func (sf *segmentFile) Replay(colCnt int, indexCnt map[int]int, cache *bytes.Buf
 			}
 			bf.columns[col].indexes[ts].dataFile.file[0] = file
 			sf.replayInfo(bf.columns[col].indexes[ts].dataFile.stat, file)
+		case "sk":
+			bf.sketches.file[0] = file
+			sf.replayInfo(bf.sketches.stat, file)
 		default:
 			panic(any("No Support"))
 		}
@@ -0,0 +1,332 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	mobat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+const (
+	// BackupVersion is the version of the backup layout written by
+	// ExportTable. ImportTable refuses any other version.
+	BackupVersion uint16 = 1
+
+	BackupManifestName = "manifest.json"
+)
+
+var (
+	ErrBackupVersion = errors.New("tae: incompatible backup version")
+	ErrBackupSchema  = errors.New("tae: incompatible backup schema")
+	ErrBackupCorrupt = errors.New("tae: corrupted backup")
+)
+
+// BackupBlock describes one exported block file
+type BackupBlock struct {
+	SegmentID uint64 `json:"segment_id"`
+	BlockID   uint64 `json:"block_id"`
+	Rows      uint32 `json:"rows"`
+	File      string `json:"file"`
+}
+
+// BackupManifest is the catalog metadata of an exported table
+type BackupManifest struct {
+	Version    uint16        `json:"version"`
+	SnapshotTS uint64        `json:"snapshot_ts"`
+	DBName     string        `json:"db_name"`
+	TableName  string        `json:"table_name"`
+	TableID    uint64        `json:"table_id"`
+	Schema     []byte        `json:"schema"`
+	Blocks     []BackupBlock `json:"blocks"`
+}
+
+// ImportResult maps the ids found in a backup to the ids allocated
+// by the importing instance
+type ImportResult struct {
+	TableName string
+	// SourceTableID is the table id recorded in the manifest
+	SourceTableID uint64
+	TableID       uint64
+	Rows          uint64
+}
+
+func (m *BackupManifest) GetSchema() (schema *catalog.Schema, err error) {
+	schema = catalog.NewEmptySchema("")
+	if _, err = schema.ReadFrom(bytes.NewReader(m.Schema)); err != nil {
+		err = fmt.Errorf("%w: %v", ErrBackupSchema, err)
+	}
+	return
+}
+
+func ReadBackupManifest(dir string) (m *BackupManifest, err error) {
+	buf, err := os.ReadFile(filepath.Join(dir, BackupManifestName))
+	if err != nil {
+		return
+	}
+	m = new(BackupManifest)
+	if err = json.Unmarshal(buf, m); err != nil {
+		err = fmt.Errorf("%w: %v", ErrBackupCorrupt, err)
+		return
+	}
+	if m.Version != BackupVersion {
+		err = fmt.Errorf("%w: expect %d, got %d", ErrBackupVersion, BackupVersion, m.Version)
+	}
+	return
+}
+
+// ExportTable writes a physical backup of the specified table into dir. All
+// appendable blocks are flushed first and the data of every block visible at
+// the snapshot is copied into a block file with deletes and updates applied
+func (db *DB) ExportTable(dbName, tableName, dir string) (manifest *BackupManifest, err error) {
+	if err = db.flushTable(dbName, tableName); err != nil {
+		return
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = txn.Rollback()
+	}()
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		return
+	}
+	meta := rel.GetMeta().(*catalog.TableEntry)
+	schema := meta.GetSchema()
+	manifest = &BackupManifest{
+		Version:    BackupVersion,
+		SnapshotTS: txn.GetStartTS(),
+		DBName:     dbName,
+		TableName:  tableName,
+		TableID:    meta.GetID(),
+	}
+	if manifest.Schema, err = schema.Marshal(); err != nil {
+		return
+	}
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		var block BackupBlock
+		if block, err = exportBlock(blk, schema, dir); err != nil {
+			return
+		}
+		if block.Rows > 0 {
+			manifest.Blocks = append(manifest.Blocks, block)
+		}
+		it.Next()
+	}
+	buf, err := json.Marshal(manifest)
+	if err != nil {
+		return
+	}
+	if err = os.WriteFile(filepath.Join(dir, BackupManifestName), buf, 0644); err != nil {
+		return
+	}
+	logutil.Infof("[Backup] Export %s.%s to %s at %d: %d blocks",
+		dbName, tableName, dir, manifest.SnapshotTS, len(manifest.Blocks))
+	return
+}
+
+// ImportTable registers the table exported into dir into database dbName in
+// a single transaction. The table and block ids are reallocated by this
+// instance and the table id mapping is returned
+func (db *DB) ImportTable(dir, dbName string) (result *ImportResult, err error) {
+	manifest, err := ReadBackupManifest(dir)
+	if err != nil {
+		return
+	}
+	schema, err := manifest.GetSchema()
+	if err != nil {
+		return
+	}
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = txn.Rollback()
+		}
+	}()
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		return
+	}
+	rel, err := database.CreateRelation(schema)
+	if err != nil {
+		return
+	}
+	result = &ImportResult{
+		TableName:     schema.Name,
+		SourceTableID: manifest.TableID,
+		TableID:       rel.ID(),
+	}
+	for _, block := range manifest.Blocks {
+		var bat *mobat.Batch
+		if bat, err = importBlock(filepath.Join(dir, block.File), schema, block.Rows); err != nil {
+			return
+		}
+		if err = rel.Append(bat); err != nil {
+			return
+		}
+		result.Rows += uint64(block.Rows)
+	}
+	if err = txn.Commit(); err != nil {
+		return
+	}
+	logutil.Infof("[Backup] Import %s.%s from %s into %s: table %d",
+		manifest.DBName, manifest.TableName, dir, dbName, result.TableID)
+	return
+}
+
+func (db *DB) flushTable(dbName, tableName string) (err error) {
+	var txn txnif.AsyncTxn
+	if txn, err = db.StartTxn(nil); err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	var metas []*catalog.BlockEntry
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+		if meta.IsAppendable() {
+			metas = append(metas, meta)
+		}
+		it.Next()
+	}
+	if err = txn.Commit(); err != nil {
+		return
+	}
+	for _, meta := range metas {
+		if err = meta.GetBlockData().ForceCompact(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func exportBlock(blk handle.Block, schema *catalog.Schema, dir string) (block BackupBlock, err error) {
+	meta := blk.GetMeta().(*catalog.BlockEntry)
+	block.SegmentID = meta.GetSegment().GetID()
+	block.BlockID = meta.GetID()
+	block.File = fmt.Sprintf("%d-%d.blk", block.SegmentID, block.BlockID)
+	var w bytes.Buffer
+	rows := -1
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() {
+			continue
+		}
+		view, err := blk.GetColumnDataById(def.Idx, nil, nil)
+		if err != nil {
+			return block, err
+		}
+		vec := view.ApplyDeletes()
+		if rows == -1 {
+			rows = movec.Length(vec)
+		} else if rows != movec.Length(vec) {
+			return block, fmt.Errorf("%w: block %d column %s has %d rows, expect %d",
+				ErrBackupCorrupt, block.BlockID, def.Name, movec.Length(vec), rows)
+		}
+		buf, err := vec.Show()
+		if err != nil {
+			return block, err
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint32(len(buf))); err != nil {
+			return block, err
+		}
+		if _, err = w.Write(buf); err != nil {
+			return block, err
+		}
+	}
+	if rows <= 0 {
+		return
+	}
+	block.Rows = uint32(rows)
+	err = os.WriteFile(filepath.Join(dir, block.File), w.Bytes(), 0644)
+	return
+}
+
+func importBlock(name string, schema *catalog.Schema, rows uint32) (bat *mobat.Batch, err error) {
+	buf, err := os.ReadFile(name)
+	if err != nil {
+		return
+	}
+	r := bytes.NewReader(buf)
+	attrs := schema.Attrs()
+	colTypes := schema.Types()
+	bat = mobat.New(true, attrs)
+	for i := range attrs {
+		var size uint32
+		if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+			err = fmt.Errorf("%w: %s: %v", ErrBackupCorrupt, name, err)
+			return
+		}
+		data := make([]byte, size)
+		if _, err = io.ReadFull(r, data); err != nil {
+			err = fmt.Errorf("%w: %s: %v", ErrBackupCorrupt, name, err)
+			return
+		}
+		vec := movec.New(colTypes[i])
+		if err = vec.Read(data); err != nil {
+			err = fmt.Errorf("%w: %s: %v", ErrBackupCorrupt, name, err)
+			return
+		}
+		if vec.Typ.Oid != colTypes[i].Oid {
+			err = fmt.Errorf("%w: column %s type %s, expect %s",
+				ErrBackupSchema, attrs[i], vec.Typ.String(), colTypes[i].String())
+			return
+		}
+		if uint32(movec.Length(vec)) != rows {
+			err = fmt.Errorf("%w: %s: column %s has %d rows, expect %d",
+				ErrBackupCorrupt, name, attrs[i], movec.Length(vec), rows)
+			return
+		}
+		bat.Vecs[i] = vec
+	}
+	if r.Len() != 0 {
+		err = fmt.Errorf("%w: %s: %d trailing bytes", ErrBackupCorrupt, name, r.Len())
+	}
+	return
+}
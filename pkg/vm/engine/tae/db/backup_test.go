@@ -0,0 +1,178 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cespare/xxhash/v2"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// tableChecksum returns an order independent checksum and the row count of
+// all visible rows of the relation
+func tableChecksum(t *testing.T, rel handle.Relation) (sum uint64, rows int) {
+	schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	var w bytes.Buffer
+	forEachBlock(rel, func(blk handle.Block) (err error) {
+		cols := make([]*movec.Vector, 0, len(schema.ColDefs))
+		for _, def := range schema.ColDefs {
+			if def.IsHidden() {
+				continue
+			}
+			view, err := blk.GetColumnDataById(def.Idx, nil, nil)
+			assert.NoError(t, err)
+			cols = append(cols, view.ApplyDeletes())
+		}
+		n := movec.Length(cols[0])
+		for row := 0; row < n; row++ {
+			sum += xxhash.Sum64(model.EncodeTuple(&w, uint32(row), cols...))
+		}
+		rows += n
+		return
+	})
+	return
+}
+
+func TestExportImportTable(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockData(schema, 45)
+	createRelationAndAppend(t, tae, defaultTestDB, schema, bat, true)
+
+	txn, rel := getDefaultRelation(t, tae, schema.Name)
+	for _, row := range []int{2, 17, 44} {
+		filter := handle.NewEQFilter(getSingleSortKeyValue(bat, schema, row))
+		assert.NoError(t, rel.UpdateByFilter(filter, 2, int32(2222)))
+	}
+	for _, row := range []int{5, 6, 30} {
+		filter := handle.NewEQFilter(getSingleSortKeyValue(bat, schema, row))
+		assert.NoError(t, rel.DeleteByFilter(filter))
+	}
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	expectSum, expectRows := tableChecksum(t, rel)
+	assert.Equal(t, 42, expectRows)
+	assert.NoError(t, txn.Commit())
+
+	dir := filepath.Join(tae.Dir, "backup")
+	manifest, err := tae.ExportTable(defaultTestDB, schema.Name, dir)
+	assert.NoError(t, err)
+	assert.Equal(t, BackupVersion, manifest.Version)
+	assert.NotZero(t, manifest.SnapshotTS)
+	total := uint32(0)
+	for _, block := range manifest.Blocks {
+		total += block.Rows
+	}
+	assert.Equal(t, uint32(expectRows), total)
+
+	// Changes committed after the snapshot are not part of the backup
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	filter := handle.NewEQFilter(getSingleSortKeyValue(bat, schema, 0))
+	assert.NoError(t, rel.DeleteByFilter(filter))
+	assert.NoError(t, txn.Commit())
+
+	target, err := Open(filepath.Join(tae.Dir, "target"), nil)
+	assert.NoError(t, err)
+	defer target.Close()
+	createDB(t, target, "db2")
+
+	result, err := target.ImportTable(dir, "db2")
+	assert.NoError(t, err)
+	assert.Equal(t, manifest.TableID, result.SourceTableID)
+	assert.Equal(t, uint64(expectRows), result.Rows)
+
+	txn, rel = getRelation(t, target, "db2", schema.Name)
+	assert.Equal(t, result.TableID, rel.ID())
+	sum, rows := tableChecksum(t, rel)
+	assert.Equal(t, expectRows, rows)
+	assert.Equal(t, expectSum, sum)
+	assert.NoError(t, txn.Commit())
+
+	// Importing the same table twice fails without side effects
+	_, err = target.ImportTable(dir, "db2")
+	assert.Error(t, err)
+	txn, rel = getRelation(t, target, "db2", schema.Name)
+	_, rows = tableChecksum(t, rel)
+	assert.Equal(t, expectRows, rows)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestImportTableIncompatible(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 1)
+	bat := catalog.MockData(schema, 10)
+	createRelationAndAppend(t, tae, defaultTestDB, schema, bat, true)
+
+	dir := filepath.Join(tae.Dir, "backup")
+	manifest, err := tae.ExportTable(defaultTestDB, schema.Name, dir)
+	assert.NoError(t, err)
+	createDB(t, tae, "db2")
+
+	rewrite := func(m *BackupManifest) {
+		buf, err := json.Marshal(m)
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, BackupManifestName), buf, 0644))
+	}
+
+	bad := *manifest
+	bad.Version = BackupVersion + 1
+	rewrite(&bad)
+	_, err = tae.ImportTable(dir, "db2")
+	assert.True(t, errors.Is(err, ErrBackupVersion))
+
+	bad = *manifest
+	bad.Schema = bad.Schema[:len(bad.Schema)/2]
+	rewrite(&bad)
+	_, err = tae.ImportTable(dir, "db2")
+	assert.True(t, errors.Is(err, ErrBackupSchema))
+
+	// A block file written for a different column type
+	other := catalog.MockSchemaAll(4, 1)
+	other.Name = schema.Name
+	other.ColDefs[0].Type, other.ColDefs[1].Type = other.ColDefs[1].Type, other.ColDefs[0].Type
+	bad = *manifest
+	bad.Schema, err = other.Marshal()
+	assert.NoError(t, err)
+	rewrite(&bad)
+	_, err = tae.ImportTable(dir, "db2")
+	assert.True(t, errors.Is(err, ErrBackupSchema))
+
+	txn, err := tae.StartTxn(nil)
+	assert.NoError(t, err)
+	db, err := txn.GetDatabase("db2")
+	assert.NoError(t, err)
+	_, err = db.GetRelationByName(schema.Name)
+	assert.Error(t, err)
+	assert.NoError(t, txn.Commit())
+
+	rewrite(manifest)
+	_, err = tae.ImportTable(dir, "db2")
+	assert.NoError(t, err)
+}
@@ -36,6 +36,7 @@ func newTestEngine(t *testing.T, opts *options.Options) *testEngine {
 	db := initDB(t, opts)
 	return &testEngine{
 		DB: db,
+		t:  t,
 	}
 }
 
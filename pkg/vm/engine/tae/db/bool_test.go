@@ -0,0 +1,120 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// countBools returns the number of the visible rows whose bool column is true
+// and false
+func countBools(t *testing.T, rel handle.Relation, col int) (trues, falses int) {
+	forEachColumnView(rel, col, func(view *model.ColumnView) error {
+		vec := view.ApplyDeletes()
+		for _, v := range vec.Col.([]bool) {
+			if v {
+				trues++
+			} else {
+				falses++
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// 1. Append 4 blocks of a table with a bool column, the compound sort key is
+//    (flag, id)
+// 2. Compact and merge the blocks, the rows are sorted by the key
+// 3. Update the bool of some rows and delete some others
+// 4. Restart, the bools are replayed and the keys are found by GetByFilter
+func TestBoolColumn(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("bools")
+	assert.NoError(t, schema.AppendSortKey("flag", types.T_bool.ToType(), 0, true))
+	assert.NoError(t, schema.AppendSortKey("id", types.T_int32.ToType(), 1, true))
+	assert.NoError(t, schema.AppendCol("v", types.T_bool.ToType()))
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	tae.bindSchema(schema)
+
+	const rows = 40
+	bat := catalog.MockData(schema, rows)
+	flags, ids, vs := make([]bool, rows), make([]int32, rows), make([]bool, rows)
+	keys := make([]any, rows)
+	for i := range ids {
+		flags[i] = i%3 == 0
+		ids[i] = int32(i)
+		vs[i] = i%2 == 0
+	}
+	vector.SetCol(bat.Vecs[0], flags)
+	vector.SetCol(bat.Vecs[1], ids)
+	vector.SetCol(bat.Vecs[2], vs)
+	for i := range keys {
+		keys[i] = model.EncodeTuple(nil, uint32(i), bat.Vecs[0], bat.Vecs[1])
+	}
+	tae.createRelAndAppend(bat, true)
+
+	cmp := func(a, b []any) int {
+		if r := compute.CompareGeneric(a[0], b[0], types.T_bool.ToType()); r != 0 {
+			return r
+		}
+		return compute.CompareGeneric(a[1], b[1], types.T_int32.ToType())
+	}
+	tae.compactBlocks(false)
+	txn, rel := tae.getRelation()
+	checkSortedBlocks(t, rel, []int{0, 1}, cmp, false)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+
+	tae.mergeBlocks(false)
+	txn, rel = tae.getRelation()
+	checkSortedBlocks(t, rel, []int{0, 1}, cmp, true)
+	trues, falses := countBools(t, rel, 2)
+	assert.Equal(t, rows/2, trues)
+	assert.Equal(t, rows/2, falses)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+
+	// set v of the rows 0 and 1 to true, and delete the row 2
+	txn, rel = tae.getRelation()
+	for i, v := range []bool{true, true} {
+		assert.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(keys[i]), 2, v))
+	}
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(keys[2]))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.RangeDelete(id, row, row))
+	assert.NoError(t, txn.Commit())
+
+	check := func() {
+		txn, rel := tae.getRelation()
+		trues, falses := countBools(t, rel, 2)
+		assert.Equal(t, rows/2, trues)
+		assert.Equal(t, rows/2-1, falses)
+		for i, key := range keys {
+			id, row, err := rel.GetByFilter(handle.NewEQFilter(key))
+			if i == 2 {
+				assert.Error(t, err)
+				continue
+			}
+			assert.NoError(t, err)
+			v, err := rel.GetValue(id, row, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, i%2 == 0 || i == 1, v)
+		}
+		assert.NoError(t, txn.Commit())
+	}
+	check()
+	tae.compactBlocks(false)
+	check()
+	tae.restart()
+	check()
+}
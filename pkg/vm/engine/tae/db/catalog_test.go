@@ -240,6 +240,7 @@ func TestLogDatabase(t *testing.T) {
 	txn, _ := tae.StartTxn(nil)
 	db, _ := txn.CreateDatabase("db")
 	meta := db.GetMeta().(*catalog.DBEntry)
+	meta.SetDefaults("utf8mb4", "utf8mb4_general_ci")
 	err := txn.Commit()
 	assert.Nil(t, err)
 	cmd := meta.MakeLogEntry()
@@ -261,6 +262,42 @@ func TestLogDatabase(t *testing.T) {
 	assert.Equal(t, meta.CreateAt, entryCmd.DB.CreateAt)
 	assert.Equal(t, meta.DeleteAt, entryCmd.DB.DeleteAt)
 	assert.Equal(t, meta.GetName(), entryCmd.DB.GetName())
+	charset, collation := entryCmd.DB.GetDefaults()
+	assert.Equal(t, "utf8mb4", charset)
+	assert.Equal(t, "utf8mb4_general_ci", collation)
+}
+
+// The defaults of a database are replayed from the wal and the checkpoint
+func TestReplayDatabaseDefaults(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	db.GetMeta().(*catalog.DBEntry).SetDefaults("utf8mb4", "utf8mb4_general_ci")
+	_, err = txn.CreateDatabase("plain")
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+
+	check := func() {
+		txn, _ := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.NoError(t, err)
+		charset, collation := db.GetMeta().(*catalog.DBEntry).GetDefaults()
+		assert.Equal(t, "utf8mb4", charset)
+		assert.Equal(t, "utf8mb4_general_ci", collation)
+		db, err = txn.GetDatabase("plain")
+		assert.NoError(t, err)
+		charset, collation = db.GetMeta().(*catalog.DBEntry).GetDefaults()
+		assert.Empty(t, charset)
+		assert.Empty(t, collation)
+		assert.NoError(t, txn.Commit())
+	}
+	tae.restart()
+	check()
+	tae.checkpointCatalog()
+	tae.restart()
+	check()
 }
 
 func TestCheckpointCatalog2(t *testing.T) {
@@ -0,0 +1,436 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/txnentries"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+var (
+	ErrSubscriptionInvalidated = errors.New("tae: subscription invalidated")
+	ErrSubscriptionTruncated   = errors.New("tae: changes after the subscription ts were truncated")
+	ErrSubscriptionNoPK        = errors.New("tae: subscription needs a single column primary key")
+)
+
+// Changes are the changes a committed txn made to a subscribed table.
+// A consumer applies the Deletes, then the Updates, then the Inserts.
+type Changes struct {
+	LSN      uint64
+	CommitTS uint64
+	// Inserts are the rows inserted, without the hidden columns
+	Inserts []*gbat.Batch
+	// Updates are the updated columns of the rows
+	Updates []ColumnUpdate
+	// Deletes are the primary keys of the rows deleted
+	Deletes []any
+}
+
+// ColumnUpdate sets the column Attr of the row of primary key Key to Value
+type ColumnUpdate struct {
+	Key   any
+	Attr  string
+	Value any
+}
+
+// CDCManager tracks the change subscriptions of a DB. The log entries a
+// subscription has not consumed yet are not checkpointed, so they are
+// neither truncated from the log nor are the blocks they refer to GCed.
+// A subscription lagging more than maxLag entries behind the log is
+// invalidated and stops holding the log back.
+type CDCManager struct {
+	sync.Mutex
+	db     *DB
+	maxLag uint64
+	nextId uint64
+	subs   map[uint64]*Subscription
+	// held are the checkpointed indexes held back by the subscriptions
+	held []*wal.Index
+}
+
+// Subscription streams the changes committed to a table after fromTS
+type Subscription struct {
+	mgr     *CDCManager
+	id      uint64
+	dbId    uint64
+	tableId uint64
+	schema  *catalog.Schema
+	fromTS  uint64
+	// next is the LSN of the next log entry to consume
+	next uint64
+	err  error
+}
+
+func newCDCManager(db *DB, maxLag uint64) *CDCManager {
+	return &CDCManager{
+		db:     db,
+		maxLag: maxLag,
+		subs:   make(map[uint64]*Subscription),
+	}
+}
+
+// Subscribe subscribes the changes of the table committed after fromTS.
+// ErrSubscriptionTruncated is returned if some of them may have been
+// truncated from the log.
+func (db *DB) Subscribe(dbName, tableName string, fromTS uint64) (sub *Subscription, err error) {
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	meta := rel.GetMeta().(*catalog.TableEntry)
+	if err = txn.Commit(); err != nil {
+		return
+	}
+	schema := meta.GetSchema()
+	if !schema.IsSinglePK() {
+		return nil, ErrSubscriptionNoPK
+	}
+	sub = &Subscription{
+		mgr:     db.CDCMgr,
+		dbId:    meta.GetDB().ID,
+		tableId: meta.ID,
+		schema:  schema,
+		fromTS:  fromTS,
+	}
+	if err = db.CDCMgr.register(sub); err != nil {
+		sub = nil
+	}
+	return
+}
+
+func (mgr *CDCManager) register(sub *Subscription) (err error) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	checkpointed := mgr.db.Wal.GetCheckpointed()
+	if sub.fromTS < mgr.db.TxnMgr.TsAlloc.Get() {
+		var truncatedTS uint64
+		if truncatedTS, err = mgr.truncatedTS(checkpointed); err != nil {
+			return
+		}
+		if sub.fromTS < truncatedTS {
+			return ErrSubscriptionTruncated
+		}
+	}
+	mgr.nextId++
+	sub.id = mgr.nextId
+	sub.next = checkpointed + 1
+	mgr.subs[sub.id] = sub
+	logutil.Infof("[CDC] | Subscribe | Table=%d | FromTS=%d | LSN=%d", sub.tableId, sub.fromTS, sub.next)
+	return
+}
+
+// truncatedTS returns the max commit ts of the log entries up to lsn, the
+// ones which may have been truncated
+func (mgr *CDCManager) truncatedTS(lsn uint64) (uint64, error) {
+	for ; lsn > 0; lsn-- {
+		e, err := mgr.db.Wal.LoadEntry(wal.GroupC, lsn)
+		if err != nil {
+			return 0, ErrSubscriptionTruncated
+		}
+		txnCmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(e.GetPayload()))
+		if err != nil {
+			return 0, err
+		}
+		if ts := commitTSOfCmd(txnCmd); ts != 0 {
+			return ts, nil
+		}
+	}
+	return 0, nil
+}
+
+// checkpoint checkpoints the indexes not needed by the subscriptions and
+// holds back the others
+func (mgr *CDCManager) checkpoint(indexes []*wal.Index) (err error) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.invalidateLaggingLocked()
+	if len(mgr.subs) == 0 && len(mgr.held) == 0 {
+		return mgr.db.checkpointWAL(indexes)
+	}
+	mgr.held = append(mgr.held, indexes...)
+	return mgr.releaseLocked()
+}
+
+func (mgr *CDCManager) releaseLocked() (err error) {
+	low := uint64(0)
+	for _, sub := range mgr.subs {
+		if low == 0 || sub.next < low {
+			low = sub.next
+		}
+	}
+	var released []*wal.Index
+	held := mgr.held[:0]
+	for _, idx := range mgr.held {
+		if low == 0 || idx.LSN < low {
+			released = append(released, idx)
+		} else {
+			held = append(held, idx)
+		}
+	}
+	mgr.held = held
+	if len(released) == 0 {
+		return
+	}
+	return mgr.db.checkpointWAL(released)
+}
+
+func (mgr *CDCManager) invalidateLaggingLocked() {
+	synced := mgr.db.Wal.GetSynced()
+	for id, sub := range mgr.subs {
+		if synced >= sub.next && synced-sub.next >= mgr.maxLag {
+			logutil.Warnf("[CDC] | Invalidate | Table=%d | LSN=%d | Synced=%d", sub.tableId, sub.next, synced)
+			sub.err = ErrSubscriptionInvalidated
+			delete(mgr.subs, id)
+		}
+	}
+}
+
+// Next returns the changes of the log entries synced since the last call,
+// in the commit order. It does not wait for new changes.
+func (sub *Subscription) Next() (changes []*Changes, err error) {
+	sub.mgr.Lock()
+	err, lsn := sub.err, sub.next
+	sub.mgr.Unlock()
+	if err != nil {
+		return
+	}
+	end := sub.mgr.db.Wal.GetSynced()
+	for ; lsn <= end; lsn++ {
+		var c *Changes
+		if c, err = sub.collect(lsn); err != nil {
+			return nil, err
+		}
+		if c != nil {
+			changes = append(changes, c)
+		}
+	}
+
+	sub.mgr.Lock()
+	defer sub.mgr.Unlock()
+	if sub.err != nil {
+		return nil, sub.err
+	}
+	sub.next = lsn
+	err = sub.mgr.releaseLocked()
+	return
+}
+
+// Close ends the subscription and releases the log entries it holds
+func (sub *Subscription) Close() error {
+	sub.mgr.Lock()
+	defer sub.mgr.Unlock()
+	if sub.err != nil {
+		return nil
+	}
+	sub.err = ErrSubscriptionInvalidated
+	delete(sub.mgr.subs, sub.id)
+	return sub.mgr.releaseLocked()
+}
+
+// collect returns the changes of the log entry lsn to the table, or nil
+// if it did not change the table
+func (sub *Subscription) collect(lsn uint64) (changes *Changes, err error) {
+	db := sub.mgr.db
+	e, err := db.Wal.LoadEntry(wal.GroupC, lsn)
+	if err != nil {
+		return
+	}
+	txnCmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(e.GetPayload()))
+	if err != nil {
+		return
+	}
+	cmds := []txnif.TxnCmd{txnCmd}
+	if composed, ok := txnCmd.(*txnbase.ComposedCmd); ok {
+		cmds = composed.Cmds
+	}
+	for _, cmd := range cmds {
+		// The changes of a compaction or a merge are the ones of the
+		// blocks being moved, they were streamed already
+		if typ := cmd.GetType(); typ == txnentries.CmdCompactBlock || typ == txnentries.CmdMergeBlocks {
+			return nil, nil
+		}
+	}
+
+	changes = &Changes{LSN: lsn}
+	for _, cmd := range cmds {
+		switch cmd := cmd.(type) {
+		case *txnimpl.AppendCmd:
+			err = sub.collectInserts(changes, cmd)
+		case *updates.UpdateCmd:
+			switch cmd.GetType() {
+			case txnbase.CmdDelete:
+				err = sub.collectDeletes(changes, cmd)
+			case txnbase.CmdUpdate:
+				err = sub.collectUpdates(changes, cmd)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if changes.CommitTS <= sub.fromTS {
+		return nil, nil
+	}
+	return
+}
+
+func (sub *Subscription) collectInserts(changes *Changes, cmd *txnimpl.AppendCmd) (err error) {
+	var data batch.IBatch
+	var deletes *roaring.Bitmap
+	for _, info := range cmd.Infos {
+		if info.GetDest().TableID != sub.tableId {
+			continue
+		}
+		if data == nil {
+			if data, deletes, err = sub.mgr.db.loadAppendData(cmd); err != nil {
+				return
+			}
+		}
+		start := info.GetSrcOff()
+		bat, err := sub.mgr.db.window(sub.schema, data, deletes, start, start+info.GetSrcLen()-1)
+		if err != nil {
+			return err
+		}
+		changes.Inserts = append(changes.Inserts, bat)
+		changes.CommitTS = cmd.Ts
+	}
+	return
+}
+
+func (sub *Subscription) collectDeletes(changes *Changes, cmd *updates.UpdateCmd) (err error) {
+	node := cmd.GetDeleteNode()
+	id := node.GetID()
+	if id.TableID != sub.tableId {
+		return
+	}
+	changes.CommitTS = node.GetCommitTSLocked()
+	keys, err := sub.getKeys(id, node.GetDeleteMaskLocked(), changes.CommitTS)
+	if err != nil {
+		return
+	}
+	changes.Deletes = append(changes.Deletes, keys...)
+	return
+}
+
+func (sub *Subscription) collectUpdates(changes *Changes, cmd *updates.UpdateCmd) (err error) {
+	node := cmd.GetUpdateNode()
+	id := node.GetID()
+	if id.TableID != sub.tableId {
+		return
+	}
+	changes.CommitTS = node.GetCommitTSLocked()
+	mask, vals := node.GetMask(), node.GetValues()
+	keys, err := sub.getKeys(id, mask, changes.CommitTS)
+	if err != nil {
+		return
+	}
+	attr := sub.schema.ColDefs[id.Idx].Name
+	it := mask.Iterator()
+	for i := 0; it.HasNext(); i++ {
+		row := it.Next()
+		changes.Updates = append(changes.Updates, ColumnUpdate{
+			Key:   keys[i],
+			Attr:  attr,
+			Value: vals[row],
+		})
+	}
+	return
+}
+
+// getKeys returns the primary keys of the rows of the block. The primary
+// key of a row never changes, so the keys are read from the column visible
+// at ts regardless of the rows deleted by the txn committed at ts
+func (sub *Subscription) getKeys(id *common.ID, rows *roaring.Bitmap, ts uint64) (keys []any, err error) {
+	database, err := sub.mgr.db.Catalog.GetDatabaseByID(sub.dbId)
+	if err != nil {
+		return
+	}
+	blk, err := database.GetBlockEntryByID(id)
+	if err != nil {
+		return
+	}
+	snapshot := txnbase.NewTxn(nil, new(txnbase.NoopTxnStore), 0, ts, nil)
+	view, err := blk.GetBlockData().GetColumnDataById(snapshot, sub.schema.GetSingleSortKeyIdx(), nil, nil)
+	if err != nil {
+		return
+	}
+	if view == nil {
+		err = data.ErrNotFound
+		return
+	}
+	length := uint32(movec.Length(view.AppliedVec))
+	it := rows.Iterator()
+	for it.HasNext() {
+		row := it.Next()
+		if row >= length {
+			err = data.ErrNotFound
+			return
+		}
+		keys = append(keys, compute.GetValue(view.AppliedVec, row))
+	}
+	return
+}
+
+// commitTSOfCmd returns the commit ts of the data changes of a txn
+// command, 0 if it has none
+func commitTSOfCmd(txnCmd txnif.TxnCmd) uint64 {
+	switch cmd := txnCmd.(type) {
+	case *txnbase.ComposedCmd:
+		for _, sub := range cmd.Cmds {
+			if ts := commitTSOfCmd(sub); ts != 0 {
+				return ts
+			}
+		}
+	case *txnimpl.AppendCmd:
+		return cmd.Ts
+	case *updates.UpdateCmd:
+		switch cmd.GetType() {
+		case txnbase.CmdAppend:
+			return cmd.GetAppendNode().GetCommitTS()
+		case txnbase.CmdDelete:
+			return cmd.GetDeleteNode().GetCommitTSLocked()
+		case txnbase.CmdUpdate:
+			return cmd.GetUpdateNode().GetCommitTSLocked()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,241 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"sync"
+	"testing"
+
+	mobat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// tableState is a table rebuilt from its changes, the rows by primary key
+type tableState map[any]map[string]any
+
+func (state tableState) apply(t *testing.T, changes []*Changes, lastTS uint64) uint64 {
+	for _, c := range changes {
+		assert.Greater(t, c.CommitTS, lastTS)
+		lastTS = c.CommitTS
+		for _, key := range c.Deletes {
+			_, ok := state[key]
+			assert.Truef(t, ok, "delete missing key %v", key)
+			delete(state, key)
+		}
+		for _, update := range c.Updates {
+			row, ok := state[update.Key]
+			assert.Truef(t, ok, "update missing key %v", update.Key)
+			row[update.Attr] = update.Value
+		}
+		for _, bat := range c.Inserts {
+			state.insert(t, bat)
+		}
+	}
+	return lastTS
+}
+
+func (state tableState) insert(t *testing.T, bat *mobat.Batch) {
+	for i := 0; i < compute.LengthOfBatch(bat); i++ {
+		row := make(map[string]any)
+		for j, attr := range bat.Attrs {
+			row[attr] = compute.GetValue(bat.Vecs[j], uint32(i))
+		}
+		key := row[cdcTestPK]
+		_, ok := state[key]
+		assert.Falsef(t, ok, "insert duplicated key %v", key)
+		state[key] = row
+	}
+}
+
+const cdcTestPK = "mock_3"
+
+// scanTableState reads the table state by a scan
+func scanTableState(t *testing.T, e *DB, schema *catalog.Schema) tableState {
+	txn, rel := getDefaultRelation(t, e, schema.Name)
+	defer func() { assert.NoError(t, txn.Commit()) }()
+	cols := make(map[string][]any)
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() {
+			continue
+		}
+		forEachColumnView(rel, def.Idx, func(view *model.ColumnView) error {
+			view.ApplyDeletes()
+			for i := 0; i < view.Length(); i++ {
+				cols[def.Name] = append(cols[def.Name], compute.GetValue(view.AppliedVec, uint32(i)))
+			}
+			return nil
+		})
+	}
+	state := make(tableState)
+	for i, key := range cols[cdcTestPK] {
+		row := make(map[string]any)
+		for attr, vals := range cols {
+			row[attr] = vals[i]
+		}
+		state[key] = row
+	}
+	return state
+}
+
+func heldCnt(e *DB) int {
+	e.CDCMgr.Lock()
+	defer e.CDCMgr.Unlock()
+	return len(e.CDCMgr.held)
+}
+
+func TestSubscribeChanges(t *testing.T) {
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bats := compute.SplitBatch(catalog.MockData(schema, 200), 20)
+	createRelation(t, tae.DB, defaultTestDB, schema, true)
+
+	sub, err := tae.Subscribe(defaultTestDB, schema.Name, tae.TxnMgr.TsAlloc.Get())
+	assert.NoError(t, err)
+	defer sub.Close()
+	var midSub *Subscription
+	midTS := uint64(0)
+
+	state := make(tableState)
+	lastTS := uint64(0)
+	var wg sync.WaitGroup
+	for i, bat := range bats {
+		wg.Add(1)
+		appendClosure(t, bat, schema.Name, tae.DB, &wg)()
+		if i > 0 {
+			prev := bats[i-1]
+			txn, rel := tae.getRelation()
+			err = rel.DeleteByFilter(handle.NewEQFilter(getSingleSortKeyValue(prev, schema, 0)))
+			assert.NoError(t, err)
+			filter := handle.NewEQFilter(getSingleSortKeyValue(prev, schema, 1))
+			assert.NoError(t, rel.UpdateByFilter(filter, 1, int16(i)))
+			assert.NoError(t, txn.Commit())
+		}
+		if i == 7 {
+			// inserts and deletes in one txn
+			txn, rel := tae.getRelation()
+			data := catalog.MockData(schema, 210)
+			window := compute.SplitBatch(data, 21)[20]
+			assert.NoError(t, rel.Append(window))
+			err = rel.DeleteByFilter(handle.NewEQFilter(getSingleSortKeyValue(window, schema, 9)))
+			assert.NoError(t, err)
+			assert.NoError(t, txn.Commit())
+		}
+		if i == 10 {
+			midTS = tae.TxnMgr.TsAlloc.Get()
+			midSub, err = tae.Subscribe(defaultTestDB, schema.Name, midTS)
+			assert.NoError(t, err)
+			defer midSub.Close()
+
+			// the changes not consumed are not checkpointed
+			tae.compactBlocks(false)
+			assert.NotZero(t, heldCnt(tae.DB))
+			assert.Less(t, tae.Wal.GetCheckpointed(), sub.next)
+		}
+		if i%3 == 0 {
+			changes, err := sub.Next()
+			assert.NoError(t, err)
+			lastTS = state.apply(t, changes, lastTS)
+		}
+	}
+	// Next returns the synced changes only
+	testutils.WaitExpect(1000, func() bool {
+		return tae.Wal.GetSynced() == tae.Wal.GetCurrSeqNum()
+	})
+	changes, err := sub.Next()
+	assert.NoError(t, err)
+	state.apply(t, changes, lastTS)
+	assert.Equal(t, scanTableState(t, tae.DB, schema), state)
+	// midSub still holds the checkpoints back
+	assert.NotZero(t, heldCnt(tae.DB))
+
+	// midSub sees the changes committed after midTS only
+	midChanges, err := midSub.Next()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, midChanges)
+	for _, c := range midChanges {
+		assert.Greater(t, c.CommitTS, midTS)
+	}
+
+	// the log is checkpointed once the subscriptions have consumed it
+	assert.Zero(t, heldCnt(tae.DB))
+	assert.NoError(t, midSub.Close())
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+	testutils.WaitExpect(1000, func() bool {
+		return tae.Wal.GetSynced() == tae.Wal.GetCurrSeqNum()
+	})
+	// the compactions are not streamed
+	changes, err = sub.Next()
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Zero(t, heldCnt(tae.DB))
+
+	// deletes after the checkpoint are streamed from the compacted blocks
+	txn, rel := tae.getRelation()
+	key := getSingleSortKeyValue(bats[len(bats)-1], schema, 5)
+	assert.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(key)))
+	assert.NoError(t, txn.Commit())
+	testutils.WaitExpect(1000, func() bool {
+		return tae.Wal.GetSynced() == tae.Wal.GetCurrSeqNum()
+	})
+	changes, err = sub.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, []any{key}, changes[0].Deletes)
+}
+
+func TestSubscriptionMaxLag(t *testing.T) {
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	opts.CDCCfg = &options.CDCCfg{MaxLag: 5}
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 3)
+	schema.BlockMaxRows = 10
+	tae.bindSchema(schema)
+	createRelation(t, tae.DB, defaultTestDB, schema, true)
+
+	sub, err := tae.Subscribe(defaultTestDB, schema.Name, tae.TxnMgr.TsAlloc.Get())
+	assert.NoError(t, err)
+	var wg sync.WaitGroup
+	for _, bat := range compute.SplitBatch(catalog.MockData(schema, 100), 10) {
+		wg.Add(1)
+		appendClosure(t, bat, schema.Name, tae.DB, &wg)()
+	}
+
+	// the subscription is invalidated by the checkpoints it holds back
+	testutils.WaitExpect(4000, func() bool {
+		return tae.Scheduler.GetPenddingLSNCnt() == 0
+	})
+	assert.Equal(t, uint64(0), tae.Scheduler.GetPenddingLSNCnt())
+	_, err = sub.Next()
+	assert.ErrorIs(t, err, ErrSubscriptionInvalidated)
+
+	schema2 := catalog.MockSchema(2, -1)
+	createRelation(t, tae.DB, defaultTestDB, schema2, false)
+	_, err = tae.Subscribe(defaultTestDB, schema2.Name, 0)
+	assert.ErrorIs(t, err, ErrSubscriptionNoPK)
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRowChecksumSchema(t testing.TB, rowChecksum bool) *catalog.Schema {
+	schema := catalog.NewEmptySchema("checksum")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int64.ToType(), 0))
+	assert.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	assert.NoError(t, schema.AppendCol("s", types.T_varchar.ToType()))
+	schema.RowChecksum = rowChecksum
+	assert.NoError(t, schema.Finalize(false))
+	return schema
+}
+
+// firstBlockChecksumMismatch returns the first row of the block whose
+// checksum does not match, or -1
+func firstBlockChecksumMismatch(t *testing.T, blk handle.Block) int {
+	schema := blk.GetMeta().(*catalog.BlockEntry).GetSchema()
+	defs := schema.RowChecksumDefs()
+	cols := make([]*vector.Vector, len(defs))
+	for i, def := range defs {
+		view, err := blk.GetColumnDataById(def.Idx, nil, nil)
+		assert.NoError(t, err)
+		cols[i] = view.ApplyDeletes()
+	}
+	view, err := blk.GetColumnDataById(schema.ChecksumKey.Idx, nil, nil)
+	assert.NoError(t, err)
+	return compute.FirstChecksumMismatch(cols, view.ApplyDeletes(), view.DeleteMask)
+}
+
+// 1. Append 2 blocks of a table with row checksums, all the checksums match
+// 2. The committed rows are not updated in place, the uncommitted ones are
+// 3. Corrupt row 3 of block 0 by the test hook, the mismatch is found
+// 4. The compaction of block 0 fails, the one of block 1 does not
+// 5. Restart, the schema still has row checksums
+func TestRowChecksum(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := newRowChecksumSchema(t, true)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 10
+	tae.bindSchema(schema)
+	assert.True(t, schema.HasRowChecksum())
+	assert.Equal(t, catalog.ChecksumColumnName, schema.ChecksumKey.Name)
+	assert.Equal(t, 3, len(schema.RowChecksumDefs()))
+
+	bat := catalog.MockData(schema, 21)
+	tae.createRelAndAppend(compute.BatchWindow(bat, 0, 20), true)
+	vIdx := schema.GetColIdx("v")
+
+	txn, rel := tae.getRelation()
+	var blks []handle.Block
+	forEachBlock(rel, func(blk handle.Block) error {
+		assert.Equal(t, -1, firstBlockChecksumMismatch(t, blk))
+		blks = append(blks, blk)
+		return nil
+	})
+	assert.Equal(t, 2, len(blks))
+	id := blks[0].Fingerprint()
+	assert.ErrorIs(t, rel.Update(id, 3, uint16(vIdx), int32(-1)), data.ErrUpdateRowChecksum)
+	assert.ErrorIs(t, rel.Update(id, 3, uint16(schema.ChecksumKey.Idx), uint64(0)), data.ErrUpdateRowChecksum)
+	assert.NoError(t, txn.Rollback())
+
+	// an uncommitted row is appended again with its new checksum
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.Append(compute.BatchWindow(bat, 20, 21)))
+	localID := rel.GetMeta().(*catalog.TableEntry).AsCommonID()
+	localID.SegmentID = txnimpl.LocalSegmentStartID
+	assert.NoError(t, rel.Update(localID, 0, uint16(vIdx), int32(-1)))
+	assert.NoError(t, txn.Rollback())
+
+	txnimpl.UpdateWithoutRowChecksum = true
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.Update(id, 3, uint16(vIdx), int32(-1)))
+	assert.NoError(t, txn.Commit())
+	txnimpl.UpdateWithoutRowChecksum = false
+
+	txn, rel = tae.getRelation()
+	var metas []*catalog.BlockEntry
+	var mismatches []int
+	forEachBlock(rel, func(blk handle.Block) error {
+		metas = append(metas, blk.GetMeta().(*catalog.BlockEntry))
+		mismatches = append(mismatches, firstBlockChecksumMismatch(t, blk))
+		return nil
+	})
+	assert.Equal(t, []int{3, -1}, mismatches)
+	assert.NoError(t, txn.Commit())
+
+	for i, meta := range metas {
+		txn, _ := tae.getRelation()
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		assert.NoError(t, err)
+		err = task.OnExec()
+		if i == 0 {
+			assert.True(t, errors.Is(err, data.ErrRowChecksumMismatch))
+			assert.NoError(t, txn.Rollback())
+			continue
+		}
+		assert.NoError(t, err)
+		assert.NoError(t, txn.Commit())
+	}
+
+	tae.restart()
+	txn, rel = tae.getRelation()
+	replayed := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	assert.True(t, replayed.HasRowChecksum())
+	checkAllColRowsByScan(t, rel, 20, true)
+	mismatches = mismatches[:0]
+	forEachBlock(rel, func(blk handle.Block) error {
+		mismatches = append(mismatches, firstBlockChecksumMismatch(t, blk))
+		return nil
+	})
+	assert.Equal(t, []int{3, -1}, mismatches)
+	assert.NoError(t, txn.Commit())
+}
+
+func BenchmarkAppendRowChecksum(b *testing.B) {
+	for _, checksum := range []bool{false, true} {
+		name := "NoChecksum"
+		if checksum {
+			name = "Checksum"
+		}
+		b.Run(name, func(b *testing.B) {
+			tae := initDB(new(testing.T), nil)
+			defer tae.Close()
+			schema := newRowChecksumSchema(b, checksum)
+			schema.BlockMaxRows = 8192
+			createRelation(new(testing.T), tae, defaultTestDB, schema, true)
+			bat := catalog.MockData(schema, 8192)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				txn, rel := getDefaultRelation(new(testing.T), tae, schema.Name)
+				if err := rel.Append(bat); err != nil {
+					b.Fatal(err)
+				}
+				_ = txn.Rollback()
+			}
+		})
+	}
+}
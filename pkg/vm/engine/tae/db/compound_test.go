@@ -3,6 +3,7 @@ package db
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -147,3 +148,71 @@ func TestCompoundPK1(t *testing.T) {
 
 	t.Log(tae.Catalog.SimplePPString(common.PPL1))
 }
+
+func TestCompoundPKPruneByLeadingKey(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockCompoundSchema(3, 0, 1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockData(schema, 40)
+	c0 := make([]int32, 40)
+	c1 := make([]int32, 40)
+	c2 := make([]int32, 40)
+	for i := range c0 {
+		c0[i] = int32(i / 10)
+		c1[i] = int32(9 - i%10)
+		c2[i] = int32(i)
+	}
+	vector.SetCol(bat.Vecs[0], c0)
+	vector.SetCol(bat.Vecs[1], c1)
+	vector.SetCol(bat.Vecs[2], c2)
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.NoError(t, rel.Append(bat))
+	assert.NoError(t, txn.Commit())
+
+	check := func() {
+		txn, rel := getRelation(t, tae, "db", schema.Name)
+		table := rel.GetMeta().(*catalog.TableEntry)
+
+		// the blocks without the leading key are skipped
+		pruned := table.GetPrunedBlocks()
+		id, row, err := rel.GetByFilter(handle.NewEQFilter(model.EncodeTuple(nil, 35, bat.Vecs[0], bat.Vecs[1])))
+		assert.NoError(t, err)
+		v, err := rel.GetValue(id, row, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(35), v)
+		assert.LessOrEqual(t, table.GetPrunedBlocks()-pruned, uint64(3))
+
+		pruned = table.GetPrunedBlocks()
+		key := model.EncodeTypedVals(nil, []types.Type{bat.Vecs[0].Typ, bat.Vecs[1].Typ}, int32(7), int32(0))
+		_, _, err = rel.GetByFilter(handle.NewEQFilter(key))
+		assert.ErrorIs(t, err, data.ErrNotFound)
+		assert.Equal(t, uint64(4), table.GetPrunedBlocks()-pruned)
+
+		// the block of the leading key is probed
+		pruned = table.GetPrunedBlocks()
+		key = model.EncodeTypedVals(nil, []types.Type{bat.Vecs[0].Typ, bat.Vecs[1].Typ}, int32(2), int32(42))
+		_, _, err = rel.GetByFilter(handle.NewEQFilter(key))
+		assert.ErrorIs(t, err, data.ErrNotFound)
+		assert.Equal(t, uint64(3), table.GetPrunedBlocks()-pruned)
+
+		// every key is found
+		for i := 0; i < 40; i++ {
+			id, row, err := rel.GetByFilter(handle.NewEQFilter(model.EncodeTuple(nil, uint32(i), bat.Vecs[0], bat.Vecs[1])))
+			assert.NoError(t, err)
+			v, err := rel.GetValue(id, row, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, int32(i), v)
+		}
+		assert.NoError(t, txn.Commit())
+	}
+
+	compactBlocks(t, tae, "db", schema, false)
+	check()
+	mergeBlocks(t, tae, "db", schema, false)
+	check()
+}
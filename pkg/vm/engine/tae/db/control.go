@@ -0,0 +1,195 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+var ErrBlockNotFound = errors.New("tae control: block not found")
+
+// compactionUnit is a compaction built as the automatic compactions build it
+type compactionUnit struct {
+	factory  tasks.TxnTaskFactory
+	taskType tasks.TaskType
+	scopes   []common.ID
+}
+
+// CompactTable runs the compactions of a table on demand, the ones of its
+// blocks with changes and then the merges of its segments, or the one of its
+// block of the id if it is not 0. The compactions are the same as the
+// automatic ones and are queued to the scheduler likewise, but they don't
+// wait for the backoffs of the failed ones. A poisoned compaction is skipped
+// until its poison is cleared. It waits for the compactions and returns the
+// number of them run.
+func (db *DB) CompactTable(dbName, tableName string, blockID uint64) (compacted int, err error) {
+	units, err := db.collectCompactions(dbName, tableName, blockID, false)
+	if err != nil {
+		return
+	}
+	if compacted, err = db.runCompactions(units); err != nil || blockID != 0 {
+		return
+	}
+	// the blocks compacted above may make up the segments to merge
+	if units, err = db.collectCompactions(dbName, tableName, 0, true); err != nil {
+		return
+	}
+	merged, err := db.runCompactions(units)
+	compacted += merged
+	logutil.Infof("[Control] Compact %s.%s: %d compactions, err=%v", dbName, tableName, compacted, err)
+	return
+}
+
+// ClearPoisonedTasks forgets the failures of the compactions of a table, or
+// of all the tables if the names are empty, so the poisoned ones are
+// scheduled again. It returns the number of the poisoned compactions cleared.
+func (db *DB) ClearPoisonedTasks(dbName, tableName string) (cleared int, err error) {
+	s, ok := db.Scheduler.(*taskScheduler)
+	if !ok {
+		return
+	}
+	if dbName == "" && tableName == "" {
+		cleared = s.retries.Clear(func(tasks.RetryKey) bool { return true })
+		return
+	}
+	var txn txnif.AsyncTxn
+	if txn, err = db.StartTxn(nil); err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	tableID := rel.ID()
+	if err = txn.Commit(); err != nil {
+		return
+	}
+	cleared = s.retries.Clear(func(key tasks.RetryKey) bool { return key.Scope.TableID == tableID })
+	logutil.Infof("[Control] Clear poisoned compactions of %s.%s: %d", dbName, tableName, cleared)
+	return
+}
+
+// collectCompactions builds the compactions of the blocks of a table which
+// have changes, or of the block of the id, or of the segments to merge
+func (db *DB) collectCompactions(dbName, tableName string, blockID uint64, segments bool) (units []compactionUnit, err error) {
+	var txn txnif.AsyncTxn
+	if txn, err = db.StartTxn(nil); err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	add := func(unit interface {
+		BuildCompactionTaskFactory() (tasks.TxnTaskFactory, tasks.TaskType, []common.ID, error)
+	}) error {
+		factory, taskType, scopes, err := unit.BuildCompactionTaskFactory()
+		if err != nil || factory == nil {
+			return err
+		}
+		units = append(units, compactionUnit{factory: factory, taskType: taskType, scopes: scopes})
+		return nil
+	}
+	found := false
+	if segments {
+		it := rel.MakeSegmentIt()
+		for it.Valid() && err == nil {
+			err = add(it.GetSegment().GetMeta().(*catalog.SegmentEntry).GetSegmentData())
+			it.Next()
+		}
+	} else {
+		it := rel.MakeBlockIt()
+		for it.Valid() && err == nil {
+			meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			it.Next()
+			if blockID != 0 && meta.GetID() != blockID {
+				continue
+			}
+			found = true
+			data := meta.GetBlockData()
+			if blockID == 0 && data.EstimateScore() <= 0 {
+				continue
+			}
+			err = add(data)
+		}
+	}
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	if err = txn.Commit(); err != nil {
+		return
+	}
+	if blockID != 0 && !found {
+		err = fmt.Errorf("%w: %d of %s.%s", ErrBlockNotFound, blockID, dbName, tableName)
+	}
+	return
+}
+
+// runCompactions runs the compactions and waits for them, it returns the
+// number of them run and the first error
+func (db *DB) runCompactions(units []compactionUnit) (compacted int, err error) {
+	s, ok := db.Scheduler.(*taskScheduler)
+	if !ok {
+		return
+	}
+	var scheduled []tasks.Task
+	for _, unit := range units {
+		task := NewScheduledTxnTask(tasks.WaitableCtx, db, unit.taskType, unit.scopes, unit.factory)
+		if key, ok := retryKeyOf(unit.taskType, unit.scopes); ok {
+			if s.retries.Allow(key) == tasks.ErrTaskPoisoned {
+				logutil.Warnf("[Control] Skip the poisoned compaction of %s", common.IDArraryString(unit.scopes))
+				continue
+			}
+			task.SetRetryTracker(s.retries, key)
+		}
+		if err2 := s.Schedule(task); err2 != nil {
+			if err == nil {
+				err = err2
+			}
+			continue
+		}
+		scheduled = append(scheduled, task)
+	}
+	for _, task := range scheduled {
+		if err2 := task.WaitDone(); err2 != nil {
+			if err == nil {
+				err = err2
+			}
+			continue
+		}
+		compacted++
+	}
+	return
+}
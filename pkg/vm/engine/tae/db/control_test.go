@@ -0,0 +1,209 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := tasks.RetryPolicy{
+		MaxFailures:    3,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+	}
+	for failures, backoff := range []time.Duration{10, 10, 20, 40, 50, 50} {
+		assert.Equal(t, backoff*time.Millisecond, policy.Backoff(failures))
+	}
+
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	tracker := tasks.NewRetryTracker(policy)
+	key := tasks.RetryKey{Type: tasks.DataCompactionTask, Scope: common.ID{TableID: 1, SegmentID: 2, BlockID: 3}}
+	other := tasks.RetryKey{Type: tasks.DataCompactionTask, Scope: common.ID{TableID: 2}}
+	assert.NoError(t, tracker.Allow(key))
+	state := tracker.Report(key, errors.New("e1"))
+	assert.Equal(t, 1, state.Failures)
+	assert.False(t, state.Poisoned)
+	assert.ErrorIs(t, tracker.Allow(key), tasks.ErrTaskBackoff)
+	assert.NoError(t, tracker.Allow(other))
+
+	// a success forgets the failures
+	tracker.Report(key, nil)
+	assert.NoError(t, tracker.Allow(key))
+	assert.Equal(t, 0, len(tracker.States()))
+
+	for i := 0; i < 3; i++ {
+		state = tracker.Report(key, errors.New("e2"))
+	}
+	tracker.Report(other, errors.New("e3"))
+	assert.True(t, state.Poisoned)
+	assert.ErrorIs(t, tracker.Allow(key), tasks.ErrTaskPoisoned)
+	states := tracker.States()
+	assert.Equal(t, 2, len(states))
+	assert.Equal(t, key, states[0].Key)
+	assert.Equal(t, "e2", states[0].LastError)
+	assert.Equal(t, 0, tracker.Clear(func(k tasks.RetryKey) bool { return k == other }))
+	assert.Equal(t, 1, tracker.Clear(func(tasks.RetryKey) bool { return true }))
+	assert.NoError(t, tracker.Allow(key))
+}
+
+// 1. A compaction failing by an injected error is poisoned after 3 failures
+// 2. The poisoned compaction does not block the others
+// 3. Clear the poison, the compaction is scheduled and succeeds
+func TestCompactionPoison(t *testing.T) {
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.SchedulerCfg = &options.SchedulerCfg{
+		IOWorkers:                 2,
+		AsyncWorkers:              2,
+		CompactionMaxFailures:     3,
+		CompactionRetryBackoff:    1,
+		CompactionMaxRetryBackoff: 2,
+	}
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	tae.bindSchema(schema)
+	tae.createRelAndAppend(catalog.MockData(schema, 5), true)
+	txn, rel := tae.getRelation()
+	tableID := rel.ID()
+	assert.NoError(t, txn.Commit())
+
+	errInjected := errors.New("injected file error")
+	var failing, runs int32 = 1, 0
+	factory := func(ctx *tasks.Context, txn txnif.AsyncTxn) (tasks.Task, error) {
+		atomic.AddInt32(&runs, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			return nil, errInjected
+		}
+		return tasks.NewFnTask(nil, tasks.MockTask, func() error { return nil }), nil
+	}
+	compact := func(scope common.ID) error {
+		task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, tasks.DataCompactionTask, []common.ID{scope}, factory)
+		if err != nil {
+			return err
+		}
+		return task.WaitDone()
+	}
+	scope := common.ID{TableID: tableID, SegmentID: 1, BlockID: 1}
+
+	assert.ErrorIs(t, compact(scope), errInjected)
+	for i := 0; i < 2; i++ {
+		time.Sleep(5 * time.Millisecond)
+		assert.ErrorIs(t, compact(scope), errInjected)
+	}
+	time.Sleep(5 * time.Millisecond)
+	assert.ErrorIs(t, compact(scope), tasks.ErrTaskPoisoned)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&runs))
+
+	stats := tae.CollectStats()
+	assert.Equal(t, 1, len(stats.RetryStates))
+	assert.True(t, stats.RetryStates[0].Poisoned)
+	assert.Equal(t, 3, stats.RetryStates[0].Failures)
+	assert.Equal(t, errInjected.Error(), stats.RetryStates[0].LastError)
+
+	atomic.StoreInt32(&failing, 0)
+	assert.NoError(t, compact(common.ID{TableID: tableID, SegmentID: 1, BlockID: 2}))
+	assert.ErrorIs(t, compact(scope), tasks.ErrTaskPoisoned)
+
+	cleared, err := tae.ClearPoisonedTasks(defaultTestDB, schema.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cleared)
+	assert.NoError(t, compact(scope))
+	assert.Equal(t, 0, len(tae.CollectStats().RetryStates))
+}
+
+// blockLayout returns the appendable flags and the rows of the blocks of a
+// table, ordered
+func blockLayout(t *testing.T, rel handle.Relation) []string {
+	var layout []string
+	forEachBlock(rel, func(blk handle.Block) error {
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		kind := "N"
+		if meta.IsAppendable() {
+			kind = "A"
+		}
+		layout = append(layout, fmt.Sprintf("%s%d", kind, blk.Rows()))
+		return nil
+	})
+	sort.Strings(layout)
+	return layout
+}
+
+// 1. Compact a table by the compaction tasks and another one by CompactTable
+// 2. The blocks of both are the same, so are their rows
+// 3. Compact a block of the table, or a block not found
+func TestCompactTable(t *testing.T) {
+	tae := newTestEngine(t, config.WithLongScanAndCKPOpts(nil))
+	defer tae.Close()
+	newSchema := func(name string) *catalog.Schema {
+		schema := catalog.NewEmptySchema(name)
+		assert.NoError(t, schema.AppendPKCol("k", types.T_int64.ToType(), 0))
+		assert.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+		assert.NoError(t, schema.Finalize(false))
+		schema.BlockMaxRows = 10
+		schema.SegmentMaxBlocks = 2
+		return schema
+	}
+	auto, manual := newSchema("auto"), newSchema("manual")
+	tae.bindSchema(auto)
+	tae.createRelAndAppend(catalog.MockData(auto, 25), true)
+	tae.compactABlocks(false)
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+
+	tae.bindSchema(manual)
+	tae.createRelAndAppend(catalog.MockData(manual, 25), false)
+	compacted, err := tae.CompactTable(defaultTestDB, manual.Name, 0)
+	assert.NoError(t, err)
+	// 3 blocks and the merge of the segment of the 2 full ones
+	assert.Equal(t, 4, compacted)
+
+	txn, autoRel := getRelation(t, tae.DB, defaultTestDB, auto.Name)
+	_, manualRel := getRelation(t, tae.DB, defaultTestDB, manual.Name)
+	assert.Equal(t, blockLayout(t, autoRel), blockLayout(t, manualRel))
+	checkAllColRowsByScan(t, manualRel, 25, true)
+	var blockID uint64
+	forEachBlock(manualRel, func(blk handle.Block) error {
+		if blk.GetMeta().(*catalog.BlockEntry).IsAppendable() {
+			blockID = blk.Fingerprint().BlockID
+		}
+		return nil
+	})
+	assert.NoError(t, txn.Commit())
+
+	compacted, err = tae.CompactTable(defaultTestDB, manual.Name, blockID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, compacted)
+	_, err = tae.CompactTable(defaultTestDB, manual.Name, blockID+1000)
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+	_, err = tae.CompactTable(defaultTestDB, "missing", 0)
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	"io"
 	"runtime"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
@@ -54,6 +55,8 @@ type DB struct {
 
 	CKPDriver checkpoint.Driver
 
+	CDCMgr *CDCManager
+
 	Scheduler tasks.TaskScheduler
 
 	TimedScanner wb.IHeartbeater
@@ -93,6 +96,15 @@ func (db *DB) RollbackTxn(txn txnif.AsyncTxn) error {
 	return txn.Rollback()
 }
 
+func (db *DB) checkpointWAL(indexes []*wal.Index) (err error) {
+	entry, err := db.Wal.Checkpoint(indexes)
+	if err != nil {
+		return err
+	}
+	db.CKPDriver.EnqueueCheckpointEntry(entry)
+	return
+}
+
 func (db *DB) Replay(dataFactory *tables.DataFactory) {
 	maxTs := db.Catalog.GetCheckpointed().MaxTS
 	replayer := newReplayer(dataFactory, db)
@@ -119,6 +131,14 @@ func (db *DB) CollectStats() *Stats {
 	return stats
 }
 
+// ReportQueryLatency reports the latency of a query, the background tasks
+// are adjusted to it
+func (db *DB) ReportQueryLatency(latency time.Duration) {
+	if s, ok := db.Scheduler.(*taskScheduler); ok {
+		s.ReportQueryLatency(latency)
+	}
+}
+
 func (db *DB) Close() error {
 	if err := db.Closed.Load(); err != nil {
 		panic(err)
@@ -1997,3 +1997,112 @@ func TestDelete2(t *testing.T) {
 	// checkAllColRowsByScan(t, rel, compute.LengthOfBatch(bat)-1, true)
 	// assert.NoError(t, txn.Commit())
 }
+
+// checkGetValues compares GetValues and GetColumnsValues of every block of rel
+// with repeated GetValue calls. The rows in deletes must not be found
+func checkGetValues(t *testing.T, txn txnif.AsyncTxn, rel handle.Relation, cols []uint16, deletes map[uint32]bool) {
+	forEachBlock(rel, func(blk handle.Block) (err error) {
+		dataBlk := blk.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		var rows []uint32
+		for row := blk.Rows() - 1; row >= 0; row-- {
+			if !deletes[uint32(row)] {
+				rows = append(rows, uint32(row), uint32(row/2))
+			}
+		}
+		colVals, err := dataBlk.GetColumnsValues(txn, rows, cols)
+		assert.NoError(t, err)
+		for i, col := range cols {
+			vals, err := dataBlk.GetValues(txn, rows, col)
+			assert.NoError(t, err)
+			for j, row := range rows {
+				v, err := dataBlk.GetValue(txn, row, col)
+				assert.NoError(t, err)
+				assert.Equal(t, v, vals[j])
+				assert.Equal(t, v, colVals[i][j])
+			}
+		}
+		for row := range deletes {
+			_, err = dataBlk.GetValues(txn, []uint32{0, row}, cols[0])
+			assert.ErrorIs(t, err, data.ErrNotFound)
+		}
+		return nil
+	})
+}
+
+func TestGetValues(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockData(schema, 10)
+	createRelationAndAppend(t, tae, defaultTestDB, schema, bat, true)
+	cols := []uint16{0, 2, 3, 12}
+
+	txn, rel := getDefaultRelation(t, tae, schema.Name)
+	for _, row := range []int{2, 5} {
+		filter := handle.NewEQFilter(getSingleSortKeyValue(bat, schema, row))
+		assert.NoError(t, rel.UpdateByFilter(filter, 2, int32(row*1000)))
+	}
+	filter := handle.NewEQFilter(getSingleSortKeyValue(bat, schema, 7))
+	assert.NoError(t, rel.DeleteByFilter(filter))
+	assert.NoError(t, txn.Commit())
+
+	// appendable block with committed updates and deletes
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	checkGetValues(t, txn, rel, cols, map[uint32]bool{7: true})
+	// uncommitted updates of the txn itself are visible
+	filter = handle.NewEQFilter(getSingleSortKeyValue(bat, schema, 3))
+	assert.NoError(t, rel.UpdateByFilter(filter, 12, []byte("updated")))
+	checkGetValues(t, txn, rel, cols, map[uint32]bool{7: true})
+	assert.NoError(t, txn.Commit())
+
+	// non-appendable block, the deleted row is gone after compaction and
+	// the row 9 of bat becomes the row 8 of the block
+	compactBlocks(t, tae, defaultTestDB, schema, false)
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	assert.False(t, getOneBlockMeta(rel).IsAppendable())
+	filter = handle.NewEQFilter(getSingleSortKeyValue(bat, schema, 1))
+	assert.NoError(t, rel.UpdateByFilter(filter, 2, int32(-1)))
+	filter = handle.NewEQFilter(getSingleSortKeyValue(bat, schema, 9))
+	assert.NoError(t, rel.DeleteByFilter(filter))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	checkGetValues(t, txn, rel, cols, map[uint32]bool{8: true})
+	assert.NoError(t, txn.Commit())
+}
+
+func BenchmarkGetValues(b *testing.B) {
+	tae := initDB(new(testing.T), nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 8192
+	bat := catalog.MockData(schema, schema.BlockMaxRows)
+	createRelationAndAppend(new(testing.T), tae, defaultTestDB, schema, bat, true)
+	compactBlocks(new(testing.T), tae, defaultTestDB, schema, false)
+
+	txn, rel := getDefaultRelation(new(testing.T), tae, schema.Name)
+	dataBlk := getOneBlockMeta(rel).GetBlockData()
+	rows := make([]uint32, 256)
+	for i := range rows {
+		rows[i] = uint32(i * 31 % int(schema.BlockMaxRows))
+	}
+	b.Run("GetValue", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, row := range rows {
+				if _, err := dataBlk.GetValue(txn, row, 2); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("GetValues", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := dataBlk.GetValues(txn, rows, 2); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	_ = txn.Commit()
+}
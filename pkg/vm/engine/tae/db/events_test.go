@@ -0,0 +1,154 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/util/sysevent"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventSink struct {
+	sync.Mutex
+	events []*sysevent.Event
+}
+
+func (s *eventSink) Report(e *sysevent.Event) {
+	s.Lock()
+	defer s.Unlock()
+	s.events = append(s.events, e)
+}
+
+// eventsOf returns the events of the type on the table
+func (s *eventSink) eventsOf(typ string, tableID uint64) (events []*sysevent.Event) {
+	s.Lock()
+	defer s.Unlock()
+	for _, e := range s.events {
+		if e.Type == typ && e.TableID == tableID {
+			events = append(events, e)
+		}
+	}
+	return
+}
+
+// 1. Append 25 rows, 3 appendable blocks are flushed and checkpointed
+// 2. Compact the 2 full blocks and merge them
+// 3. All the events are ok with the rows processed and the bytes written
+func TestCompactionEvents(t *testing.T) {
+	sink := new(eventSink)
+	defer sysevent.SetSink(sysevent.SetSink(sink))
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("events")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int64.ToType(), 0))
+	assert.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+
+	bat := catalog.MockData(schema, 25)
+	_, rel := tae.createRelAndAppend(bat, true)
+	tableID := rel.ID()
+
+	checkOK := func(e *sysevent.Event) {
+		assert.Equal(t, tableID, e.TableID)
+		assert.Equal(t, sysevent.OutcomeOK, e.Outcome)
+		assert.Equal(t, "", e.Error)
+		assert.False(t, e.End.Before(e.Start))
+	}
+
+	tae.compactABlocks(false)
+	flushes := sink.eventsOf(sysevent.FlushBlock, tableID)
+	assert.Equal(t, 3, len(flushes))
+	rows := int64(0)
+	for _, e := range flushes {
+		checkOK(e)
+		assert.Less(t, int64(0), e.Bytes)
+		rows += e.Rows
+	}
+	assert.Equal(t, int64(25), rows)
+	testutils.WaitExpect(2000, func() bool {
+		return len(sink.eventsOf(sysevent.Checkpoint, tableID)) >= 3
+	})
+	for _, e := range sink.eventsOf(sysevent.Checkpoint, tableID) {
+		checkOK(e)
+	}
+
+	tae.compactBlocks(false)
+	compactions := sink.eventsOf(sysevent.CompactBlock, tableID)
+	assert.Equal(t, 2, len(compactions))
+	for _, e := range compactions {
+		checkOK(e)
+		assert.Equal(t, int64(10), e.Rows)
+		assert.Less(t, int64(0), e.Bytes)
+	}
+
+	tae.mergeBlocks(false)
+	merges := sink.eventsOf(sysevent.MergeBlocks, tableID)
+	assert.Equal(t, 1, len(merges))
+	checkOK(merges[0])
+	assert.Equal(t, int64(20), merges[0].Rows)
+	assert.Less(t, int64(0), merges[0].Bytes)
+	assert.NotEqual(t, uint64(0), merges[0].SegmentID)
+}
+
+// The compaction of a block with a row checksum mismatch records an error
+func TestCompactionErrorEvent(t *testing.T) {
+	sink := new(eventSink)
+	defer sysevent.SetSink(sysevent.SetSink(sink))
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := newRowChecksumSchema(t, true)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 10
+	tae.bindSchema(schema)
+	tae.createRelAndAppend(catalog.MockData(schema, 10), true)
+
+	txnimpl.UpdateWithoutRowChecksum = true
+	txn, rel := tae.getRelation()
+	tableID := rel.ID()
+	var meta *catalog.BlockEntry
+	forEachBlock(rel, func(blk handle.Block) error {
+		meta = blk.GetMeta().(*catalog.BlockEntry)
+		return nil
+	})
+	assert.NoError(t, rel.Update(meta.AsCommonID(), 3, uint16(schema.GetColIdx("v")), int32(-1)))
+	assert.NoError(t, txn.Commit())
+	txnimpl.UpdateWithoutRowChecksum = false
+
+	txn, _ = tae.getRelation()
+	task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+	assert.NoError(t, err)
+	assert.Error(t, task.OnExec())
+	assert.NoError(t, txn.Rollback())
+
+	events := sink.eventsOf(sysevent.CompactBlock, tableID)
+	assert.Equal(t, 1, len(events))
+	e := events[0]
+	assert.Equal(t, sysevent.OutcomeError, e.Outcome)
+	assert.Contains(t, e.Error, "checksum")
+	assert.Equal(t, meta.ID, e.BlockID)
+	assert.Equal(t, meta.GetSegment().ID, e.SegmentID)
+	assert.Equal(t, int64(0), e.Bytes)
+}
@@ -49,9 +49,15 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	opts = opts.FillDefaults(dirname)
 
-	indexBufMgr := buffer.NewNodeManager(opts.CacheCfg.IndexCapacity, nil)
-	mutBufMgr := buffer.NewNodeManager(opts.CacheCfg.InsertCapacity, nil)
-	txnBufMgr := buffer.NewNodeManager(opts.CacheCfg.TxnCapacity, nil)
+	evicters := make([]buffer.IEvictHolder, 3)
+	for i := range evicters {
+		if evicters[i], err = buffer.NewEvictHolder(opts.CacheCfg.EvictPolicy); err != nil {
+			return nil, err
+		}
+	}
+	indexBufMgr := buffer.NewNodeManager(opts.CacheCfg.IndexCapacity, evicters[0])
+	mutBufMgr := buffer.NewNodeManager(opts.CacheCfg.InsertCapacity, evicters[1])
+	txnBufMgr := buffer.NewNodeManager(opts.CacheCfg.TxnCapacity, evicters[2])
 
 	db = &DB{
 		Dir:         dirname,
@@ -64,7 +70,8 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	}
 
 	db.Wal = wal.NewDriver(dirname, WALDir, nil)
-	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
+	db.CDCMgr = newCDCManager(db, opts.CDCCfg.MaxLag)
+	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg)
 	dataFactory := tables.NewDataFactory(db.FileFactory, mutBufMgr, db.Scheduler, db.Dir)
 	if db.Opts.Catalog, err = catalog.OpenCatalog(dirname, CATALOGDir, nil, db.Scheduler, dataFactory); err != nil {
 		return
@@ -75,6 +82,9 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	txnStoreFactory := txnimpl.TxnStoreFactory(db.Opts.Catalog, db.Wal, txnBufMgr, dataFactory)
 	txnFactory := txnimpl.TxnFactory(db.Opts.Catalog)
 	db.TxnMgr = txnbase.NewTxnManager(txnStoreFactory, txnFactory)
+	db.TxnMgr.Locks.SetWaitPolicy(
+		time.Duration(opts.LockCfg.WaitTimeout)*time.Millisecond,
+		opts.LockCfg.NoWait)
 
 	db.Replay(dataFactory)
 	db.Catalog.ReplayTableRows()
@@ -95,6 +105,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	catalogMonotor := newCatalogStatsMonitor(db, opts.CheckpointCfg.CatalogUnCkpLimit, time.Duration(opts.CheckpointCfg.CatalogCkpInterval))
 	scanner.RegisterOp(calibrationOp)
 	scanner.RegisterOp(catalogMonotor)
+	scanner.RegisterOp(newTTLReaper(db, time.Duration(opts.TTLCfg.ReapInterval)*time.Millisecond))
 	db.TimedScanner = w.NewHeartBeater(time.Duration(opts.CheckpointCfg.ScannerInterval)*time.Millisecond, scanner)
 
 	// Start workers
@@ -126,9 +126,9 @@ func (replayer *Replayer) OnReplayCmd(txncmd txnif.TxnCmd, idxCtx *wal.Index) {
 	}
 }
 
-func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd, observer wal.ReplayObserver) {
-	var data batch.IBatch
-	var deletes *roaring.Bitmap
+// loadAppendData returns the rows of an append command and the rows of
+// them deleted by the same txn
+func (db *DB) loadAppendData(cmd *txnimpl.AppendCmd) (data batch.IBatch, deletes *roaring.Bitmap, err error) {
 	for _, subTxnCmd := range cmd.Cmds {
 		switch subCmd := subTxnCmd.(type) {
 		case *txnbase.BatchCmd:
@@ -138,16 +138,24 @@ func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd, observer wal.ReplayObser
 		case *txnbase.PointerCmd:
 			batEntry, err := db.Wal.LoadEntry(subCmd.Group, subCmd.Lsn)
 			if err != nil {
-				panic(err)
+				return nil, nil, err
 			}
 			r := bytes.NewBuffer(batEntry.GetPayload())
 			txnCmd, _, err := txnbase.BuildCommandFrom(r)
 			if err != nil {
-				panic(err)
+				return nil, nil, err
 			}
 			data = txnCmd.(*txnbase.BatchCmd).Bat
 		}
 	}
+	return
+}
+
+func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd, observer wal.ReplayObserver) {
+	data, deletes, err := db.loadAppendData(cmd)
+	if err != nil {
+		panic(err)
+	}
 
 	for _, info := range cmd.Infos {
 		database, err := db.Catalog.GetDatabaseByID(info.GetDBID())
@@ -203,7 +211,7 @@ func (db *DB) window(schema *catalog.Schema, data batch.IBatch, deletes *roaring
 		if err != nil {
 			return nil, err
 		}
-		deletes := common.BM32Window(deletes, int(start), int(end))
+		deletes := common.BM32Window(deletes, int(start), int(end)+1)
 		srcVec = compute.ApplyDeleteToVector(srcVec, deletes)
 		ret.Vecs = append(ret.Vecs, srcVec)
 		ret.Attrs = append(ret.Attrs, def.Name)
@@ -1334,3 +1334,94 @@ func TestReplay10(t *testing.T) {
 	assert.Equal(t, true, schema1.ColDefs[2].Default.Null)
 
 }
+
+// Test the commit ts of the rows of the appendable blocks checkpointed and
+// replayed, which take the checkpoint ts of their blocks
+func TestReplayCommitTS(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchema(2, 1)
+	schema.BlockMaxRows = 10
+	bat := catalog.MockData(schema, 20)
+
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	db, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	tbl, err := db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, tbl.Append(bat))
+	assert.Nil(t, txn.Commit())
+	appendTs := txn.GetCommitTS()
+
+	txn, err = tae.StartTxn(nil)
+	assert.Nil(t, err)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	tbl, err = db.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	v := compute.GetValue(bat.Vecs[schema.GetSingleSortKeyIdx()], 15)
+	assert.Nil(t, tbl.UpdateByFilter(handle.NewEQFilter(v), 0, int32(-1)))
+	assert.Nil(t, txn.Commit())
+	updateTs := txn.GetCommitTS()
+
+	// before the checkpoint, the rows take the commit ts of their changes
+	txn, err = tae.StartTxn(nil)
+	assert.Nil(t, err)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	tbl, err = db.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	var tss []uint64
+	for it := tbl.MakeBlockIt(); it.Valid(); it.Next() {
+		view, err := it.GetBlock().GetCommitTSColumn()
+		assert.Nil(t, err)
+		tss = append(tss, view.AppliedVec.Col.([]uint64)...)
+	}
+	assert.Equal(t, 20, len(tss))
+	for i, ts := range tss {
+		if i == 15 {
+			assert.Equal(t, updateTs, ts)
+		} else {
+			assert.Equal(t, appendTs, ts)
+		}
+	}
+	for it := tbl.MakeBlockIt(); it.Valid(); it.Next() {
+		it.GetBlock().GetMeta().(*catalog.BlockEntry).GetBlockData().Flush()
+	}
+	assert.Nil(t, tae.Catalog.Checkpoint(txn.GetStartTS()))
+	assert.Nil(t, txn.Commit())
+	testutils.WaitExpect(4000, func() bool {
+		return tae.Wal.GetPenddingCnt() == 0
+	})
+	assert.Nil(t, tae.Close())
+
+	tae2, err := Open(tae.Dir, nil)
+	assert.Nil(t, err)
+	defer tae2.Close()
+	txn, err = tae2.StartTxn(nil)
+	assert.Nil(t, err)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	tbl, err = db.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	// the checkpoint ts of a block is not earlier than its latest change
+	rows := 0
+	for it := tbl.MakeBlockIt(); it.Valid(); it.Next() {
+		blk := it.GetBlock()
+		ckpTs := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetMaxCheckpointTS()
+		if rows == 0 {
+			assert.True(t, ckpTs >= appendTs)
+		} else {
+			assert.True(t, ckpTs >= updateTs)
+		}
+		assert.Equal(t, ckpTs, blk.GetMaxCommitTS())
+		view, err := blk.GetCommitTSColumn()
+		assert.Nil(t, err)
+		for _, ts := range view.AppliedVec.Col.([]uint64) {
+			assert.Equal(t, ckpTs, ts)
+			rows++
+		}
+	}
+	assert.Equal(t, 20, rows)
+	assert.Nil(t, txn.Commit())
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/assert"
+)
+
+// scanColumn reads the column of all the blocks like a scan, with the
+// buffers reused by the reads. Like the pipeline connector, the vectors in
+// the buffers are copied before the next read.
+func scanColumn(t *testing.T, rel handle.Relation, colIdx int, mp *mheap.Mheap) (vecs []*vector.Vector) {
+	var comp, decomp bytes.Buffer
+	forEachBlock(rel, func(blk handle.Block) error {
+		view, err := blk.GetColumnDataById(colIdx, &comp, &decomp)
+		assert.NoError(t, err)
+		vec := view.ApplyDeletes()
+		if vec.Or {
+			vec, err = vector.Dup(vec, mp)
+			assert.NoError(t, err)
+		}
+		vecs = append(vecs, vec)
+		return nil
+	})
+	return
+}
+
+func columnValues(vecs []*vector.Vector) (vals []any) {
+	for _, vec := range vecs {
+		for row := 0; row < vector.Length(vec); row++ {
+			vals = append(vals, compute.GetValue(vec, uint32(row)))
+		}
+	}
+	return
+}
+
+// 1. Append 4 blocks and compact them, the scan borrows the loaded columns
+// 2. Update a row of block 0 and delete a row of block 1, the two blocks are read with the changes applied
+// 3. The borrowed columns of a snapshot are not changed by the merge running at the same time
+func TestScanBorrowedColumns(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	mp := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	bat := catalog.MockData(schema, schema.BlockMaxRows*4)
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(false)
+
+	// int32 and fixed-width
+	colIdx := 2
+	txn, rel := tae.getRelation()
+	vecs := scanColumn(t, rel, colIdx, mp)
+	assert.Equal(t, 4, len(vecs))
+	for _, vec := range vecs {
+		assert.True(t, vector.IsShared(vec))
+	}
+	expected := columnValues(vecs)
+	assert.Equal(t, 40, len(expected))
+	// the reads of another column don't touch the borrowed ones
+	for _, vec := range scanColumn(t, rel, 1, mp) {
+		vector.Clean(vec, mp)
+	}
+	assert.Equal(t, expected, columnValues(vecs))
+	for _, vec := range vecs {
+		vector.Clean(vec, mp)
+	}
+	// a variable-width column is still copied
+	for _, vec := range scanColumn(t, rel, 12, mp) {
+		assert.False(t, vector.IsShared(vec))
+		vector.Clean(vec, mp)
+	}
+	assert.NoError(t, txn.Commit())
+
+	var blks []handle.Block
+	txn, rel = tae.getRelation()
+	forEachBlock(rel, func(blk handle.Block) error {
+		blks = append(blks, blk)
+		return nil
+	})
+	assert.NoError(t, rel.Update(blks[0].Fingerprint(), 1, uint16(colIdx), int32(-1)))
+	assert.NoError(t, rel.RangeDelete(blks[1].Fingerprint(), 0, 0))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = tae.getRelation()
+	vecs = scanColumn(t, rel, colIdx, mp)
+	assert.False(t, vector.IsShared(vecs[0]))
+	assert.Equal(t, int32(-1), compute.GetValue(vecs[0], 1))
+	assert.False(t, vector.IsShared(vecs[1]))
+	assert.Equal(t, 9, vector.Length(vecs[1]))
+	assert.True(t, vector.IsShared(vecs[2]))
+	assert.True(t, vector.IsShared(vecs[3]))
+	expected[1] = int32(-1)
+	expected = append(expected[:10], expected[11:]...)
+	assert.Equal(t, expected, columnValues(vecs))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tae.mergeBlocks(true)
+	}()
+	for i := 0; i < 10; i++ {
+		again := scanColumn(t, rel, colIdx, mp)
+		assert.Equal(t, expected, columnValues(again))
+		for _, vec := range again {
+			vector.Clean(vec, mp)
+		}
+	}
+	wg.Wait()
+	assert.Equal(t, expected, columnValues(vecs))
+	for _, vec := range vecs {
+		vector.Clean(vec, mp)
+	}
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = tae.getRelation()
+	vecs = scanColumn(t, rel, colIdx, mp)
+	assert.Equal(t, expected, columnValues(vecs))
+	for _, vec := range vecs {
+		vector.Clean(vec, mp)
+	}
+	assert.NoError(t, txn.Commit())
+	assert.Equal(t, int64(0), mheap.Size(mp))
+}
+
+func BenchmarkWideScan(b *testing.B) {
+	tae := initDB(new(testing.T), nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 8192
+	schema.SegmentMaxBlocks = 4
+	rows := schema.BlockMaxRows * 8
+	bat := catalog.MockData(schema, rows)
+	// the random values may not be compressible, which the segment files
+	// don't store
+	for colIdx := 0; colIdx < 12; colIdx++ {
+		if colIdx != schema.GetSingleSortKeyIdx() {
+			bat.Vecs[colIdx] = compute.MockVec(schema.ColDefs[colIdx].Type, int(rows), 0)
+		}
+	}
+	createRelationAndAppend(new(testing.T), tae, defaultTestDB, schema, bat, true)
+	compactBlocks(new(testing.T), tae, defaultTestDB, schema, false)
+
+	scan := func(b *testing.B) {
+		b.ReportAllocs()
+		txn, rel := getDefaultRelation(new(testing.T), tae, schema.Name)
+		var comp, decomp bytes.Buffer
+		for i := 0; i < b.N; i++ {
+			forEachBlock(rel, func(blk handle.Block) error {
+				// the fixed-width columns
+				for colIdx := 0; colIdx < 12; colIdx++ {
+					view, err := blk.GetColumnDataById(colIdx, &comp, &decomp)
+					if err != nil {
+						return err
+					}
+					vector.Clean(view.ApplyDeletes(), nil)
+				}
+				return nil
+			})
+		}
+		_ = txn.Commit()
+	}
+	b.Run("Borrowed", scan)
+
+	// a deleted row of each block makes the scan copy the columns
+	txn, rel := getDefaultRelation(new(testing.T), tae, schema.Name)
+	forEachBlock(rel, func(blk handle.Block) error {
+		return rel.RangeDelete(blk.Fingerprint(), 0, 0)
+	})
+	_ = txn.Commit()
+	b.Run("Copied", scan)
+}
@@ -22,7 +22,9 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
@@ -105,34 +107,65 @@ type taskScheduler struct {
 	*tasks.BaseScheduler
 	db        *DB
 	taskTable *taskTable
+	cfg       options.SchedulerCfg
+	// asyncHandler runs the compactions, the checkpoints and the customized
+	// tasks by their priorities
+	asyncHandler *tasks.PriorityHandler
+	ioThrottle   *tasks.IOThrottle
+	throttled    atomic.Value
+	// retries tracks the failures of the compactions, a compaction backing
+	// off or poisoned is not scheduled
+	retries *tasks.RetryTracker
 }
 
-func newTaskScheduler(db *DB, asyncWorkers int, ioWorkers int) *taskScheduler {
+func newTaskScheduler(db *DB, cfg *options.SchedulerCfg) *taskScheduler {
+	asyncWorkers, ioWorkers, sortWorkers := cfg.AsyncWorkers, cfg.IOWorkers, cfg.SortWorkers
 	if asyncWorkers < 0 || asyncWorkers > 100 {
 		panic(fmt.Sprintf("bad param: %d txn workers", asyncWorkers))
 	}
 	if ioWorkers < 0 || ioWorkers > 100 {
 		panic(fmt.Sprintf("bad param: %d io workers", ioWorkers))
 	}
+	if sortWorkers <= 0 || sortWorkers > 100 {
+		panic(fmt.Sprintf("bad param: %d sort workers", sortWorkers))
+	}
+	if cfg.CheckpointWorkers <= 0 || cfg.CheckpointWorkers > 100 {
+		panic(fmt.Sprintf("bad param: %d checkpoint workers", cfg.CheckpointWorkers))
+	}
 	s := &taskScheduler{
 		BaseScheduler: tasks.NewBaseScheduler("taskScheduler"),
 		db:            db,
+		cfg:           *cfg,
+		ioThrottle:    tasks.NewIOThrottle(cfg.CompactionIORate, cfg.CompactionIORate),
+		retries: tasks.NewRetryTracker(tasks.RetryPolicy{
+			MaxFailures:    cfg.CompactionMaxFailures,
+			InitialBackoff: time.Duration(cfg.CompactionRetryBackoff) * time.Millisecond,
+			MaxBackoff:     time.Duration(cfg.CompactionMaxRetryBackoff) * time.Millisecond,
+		}),
 	}
+	s.throttled.Store(false)
+
+	// the compactions take at most asyncWorkers of the workers, the others
+	// are left to the checkpoints which run one at a time per segment
+	s.asyncHandler = tasks.NewPriorityHandler("asyncHandler", asyncWorkers+cfg.CheckpointWorkers)
+	s.asyncHandler.SetQuota(tasks.CompactionClass, asyncWorkers)
+	s.asyncHandler.SerializeScopes(tasks.CheckpointClass)
+	s.asyncHandler.SetObserver(func(stats tasks.ClassStats) {
+		metric.TaskGauge(stats.Class.String(), "queued").Set(float64(stats.Queued))
+		metric.TaskGauge(stats.Class.String(), "running").Set(float64(stats.Running))
+	})
+	s.asyncHandler.Start()
+
 	jobDispatcher := newAsyncJobDispatcher()
-	jobHandler := tasks.NewPoolHandler(asyncWorkers)
-	jobHandler.Start()
-	jobDispatcher.RegisterHandler(tasks.DataCompactionTask, jobHandler)
+	jobDispatcher.RegisterHandler(tasks.DataCompactionTask, s.asyncHandler)
 	// jobDispatcher.RegisterHandler(tasks.GCTask, jobHandler)
 	gcHandler := tasks.NewSingleWorkerHandler("gc")
 	gcHandler.Start()
 	jobDispatcher.RegisterHandler(tasks.GCTask, gcHandler)
 
-	ckpDispatcher := tasks.NewBaseScopedDispatcher(tasks.DefaultScopeSharder)
-	for i := 0; i < 4; i++ {
-		handler := tasks.NewSingleWorkerHandler(fmt.Sprintf("[ckpworker-%d]", i))
-		ckpDispatcher.AddHandle(handler)
-		handler.Start()
-	}
+	priorityDispatcher := tasks.NewBaseDispatcher()
+	priorityDispatcher.RegisterHandler(tasks.CheckpointTask, s.asyncHandler)
+	priorityDispatcher.RegisterHandler(tasks.CustomizedTask, s.asyncHandler)
 
 	ioDispatcher := tasks.NewBaseScopedDispatcher(tasks.DefaultScopeSharder)
 	for i := 0; i < ioWorkers; i++ {
@@ -141,14 +174,58 @@ func newTaskScheduler(db *DB, asyncWorkers int, ioWorkers int) *taskScheduler {
 		handler.Start()
 	}
 
+	// the sort tasks are scheduled by the running compactions, they have
+	// their own workers to not wait for the compactions
+	sortDispatcher := tasks.NewBaseDispatcher()
+	sortHandler := tasks.NewPoolHandler(sortWorkers)
+	sortHandler.Start()
+	sortDispatcher.RegisterHandler(tasks.SortTask, sortHandler)
+
 	s.RegisterDispatcher(tasks.GCTask, jobDispatcher)
 	s.RegisterDispatcher(tasks.DataCompactionTask, jobDispatcher)
 	s.RegisterDispatcher(tasks.IOTask, ioDispatcher)
-	s.RegisterDispatcher(tasks.CheckpointTask, ckpDispatcher)
+	s.RegisterDispatcher(tasks.CheckpointTask, priorityDispatcher)
+	s.RegisterDispatcher(tasks.CustomizedTask, priorityDispatcher)
+	s.RegisterDispatcher(tasks.SortTask, sortDispatcher)
 	s.Start()
 	return s
 }
 
+// IOThrottle returns the throttle of the IO of the compactions
+func (s *taskScheduler) IOThrottle() *tasks.IOThrottle {
+	return s.ioThrottle
+}
+
+// ClassStats returns the stats of the tasks run by their priorities
+func (s *taskScheduler) ClassStats() []tasks.ClassStats {
+	return s.asyncHandler.Stats()
+}
+
+// ReportQueryLatency adjusts the compactions to the latency of the queries.
+// Above the threshold of the config the compactions run one at a time and
+// their IO is throttled, they are restored once the latency is below it.
+func (s *taskScheduler) ReportQueryLatency(latency time.Duration) {
+	if s.cfg.QueryLatencyThreshold <= 0 {
+		return
+	}
+	throttle := latency > time.Duration(s.cfg.QueryLatencyThreshold)*time.Millisecond
+	if s.throttled.Load().(bool) == throttle {
+		return
+	}
+	s.throttled.Store(throttle)
+	if throttle {
+		s.asyncHandler.SetQuota(tasks.CompactionClass, 1)
+		if s.cfg.ThrottledIORate > 0 {
+			s.ioThrottle.SetRate(s.cfg.ThrottledIORate)
+		}
+		logutil.Infof("Compactions throttled by the query latency %s", latency)
+	} else {
+		s.asyncHandler.SetQuota(tasks.CompactionClass, s.cfg.AsyncWorkers)
+		s.ioThrottle.SetRate(s.cfg.CompactionIORate)
+		logutil.Infof("Compactions restored by the query latency %s", latency)
+	}
+}
+
 func (s *taskScheduler) Stop() {
 	s.BaseScheduler.Stop()
 	logutil.Info("TaskScheduler Stopped")
@@ -160,12 +237,42 @@ func (s *taskScheduler) ScheduleTxnTask(ctx *tasks.Context, taskType tasks.TaskT
 	return
 }
 
+// ScheduleMultiScopedTxnTask schedules a txn task on the scopes. A compaction
+// which failed is not scheduled while it backs off or is poisoned, the error
+// is tasks.ErrTaskBackoff or tasks.ErrTaskPoisoned.
 func (s *taskScheduler) ScheduleMultiScopedTxnTask(ctx *tasks.Context, taskType tasks.TaskType, scopes []common.ID, factory tasks.TxnTaskFactory) (task tasks.Task, err error) {
-	task = NewScheduledTxnTask(ctx, s.db, taskType, scopes, factory)
+	txnTask := NewScheduledTxnTask(ctx, s.db, taskType, scopes, factory)
+	if key, ok := retryKeyOf(taskType, scopes); ok {
+		if err = s.retries.Allow(key); err != nil {
+			return
+		}
+		txnTask.SetRetryTracker(s.retries, key)
+	}
+	task = txnTask
 	err = s.Schedule(task)
 	return
 }
 
+// RetryStates returns the states of the compactions which failed
+func (s *taskScheduler) RetryStates() []tasks.RetryState {
+	return s.retries.States()
+}
+
+// retryKeyOf returns the retry key of the compaction of the scopes, the key
+// of the merge of the blocks of a segment has no block id. Only the
+// compactions are retried by the keys.
+func retryKeyOf(taskType tasks.TaskType, scopes []common.ID) (key tasks.RetryKey, ok bool) {
+	if taskType != tasks.DataCompactionTask || len(scopes) == 0 {
+		return
+	}
+	key.Type = taskType
+	key.Scope = scopes[0]
+	if len(scopes) > 1 {
+		key.Scope.BlockID = 0
+	}
+	return key, true
+}
+
 func (s *taskScheduler) ScheduleMultiScopedFn(ctx *tasks.Context, taskType tasks.TaskType, scopes []common.ID, fn tasks.FuncT) (task tasks.Task, err error) {
 	task = tasks.NewMultiScopedFnTask(ctx, taskType, scopes, fn)
 	err = s.Schedule(task)
@@ -173,12 +280,7 @@ func (s *taskScheduler) ScheduleMultiScopedFn(ctx *tasks.Context, taskType tasks
 }
 
 func (s *taskScheduler) Checkpoint(indexes []*wal.Index) (err error) {
-	entry, err := s.db.Wal.Checkpoint(indexes)
-	if err != nil {
-		return err
-	}
-	s.db.CKPDriver.EnqueueCheckpointEntry(entry)
-	return
+	return s.db.CDCMgr.checkpoint(indexes)
 }
 
 func (s *taskScheduler) GetSafeTS() uint64 {
@@ -15,6 +15,8 @@
 package db
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -247,3 +249,205 @@ func TestSchedule1(t *testing.T) {
 	t.Log(db.Opts.Catalog.SimplePPString(common.PPL1))
 	db.Close()
 }
+
+func newClassTask(ctx *tasks.Context, class tasks.TaskClass, scope *common.ID, fn tasks.FuncT) tasks.Task {
+	task := tasks.NewScopedFnTask(ctx, tasks.CustomizedTask, scope, fn)
+	task.SetClass(class)
+	return task
+}
+
+func TestPriorityOrder(t *testing.T) {
+	handler := tasks.NewPriorityHandler("test", 1)
+	handler.Start()
+	defer handler.Close()
+
+	// the only worker is held until all the tasks are queued
+	gate := make(chan struct{})
+	blocker := newClassTask(tasks.WaitableCtx, tasks.CompactionClass, nil, func() error {
+		<-gate
+		return nil
+	})
+	handler.Enqueue(blocker)
+	for handler.Stats()[tasks.CompactionClass].Running == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	// the tasks are not waited for in their order, which is unknown
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) tasks.FuncT {
+		return func() error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			wg.Done()
+			return nil
+		}
+	}
+	pendings := []tasks.Task{
+		newClassTask(nil, tasks.CompactionClass, nil, record("compaction-1")),
+		newClassTask(nil, tasks.CheckpointClass, nil, record("checkpoint-1")),
+		newClassTask(nil, tasks.CompactionClass, nil, record("compaction-2")),
+		newClassTask(nil, tasks.QueryClass, nil, record("query-1")),
+		newClassTask(nil, tasks.CheckpointClass, nil, record("checkpoint-2")),
+	}
+	wg.Add(len(pendings))
+	for _, task := range pendings {
+		handler.Enqueue(task)
+	}
+	stats := handler.Stats()
+	assert.Equal(t, 1, stats[tasks.QueryClass].Queued)
+	assert.Equal(t, 2, stats[tasks.CheckpointClass].Queued)
+	assert.Equal(t, 2, stats[tasks.CompactionClass].Queued)
+	close(gate)
+	assert.Nil(t, blocker.WaitDone())
+	wg.Wait()
+	assert.Equal(t, []string{"query-1", "checkpoint-1", "checkpoint-2", "compaction-1", "compaction-2"}, order)
+	assert.Equal(t, uint64(3), handler.Stats()[tasks.CompactionClass].Done)
+}
+
+func TestPriorityQuota(t *testing.T) {
+	handler := tasks.NewPriorityHandler("test", 4)
+	handler.SetQuota(tasks.CompactionClass, 2)
+	handler.Start()
+	defer handler.Close()
+
+	// the compactions saturated beyond their quota
+	gate := make(chan struct{})
+	var running, maxRunning int32
+	var compactions []tasks.Task
+	for i := 0; i < 6; i++ {
+		task := newClassTask(tasks.WaitableCtx, tasks.CompactionClass, nil, func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			<-gate
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+		handler.Enqueue(task)
+		compactions = append(compactions, task)
+	}
+
+	// the other classes are run by the workers left
+	for i := 0; i < 4; i++ {
+		query := newClassTask(tasks.WaitableCtx, tasks.QueryClass, nil, func() error { return nil })
+		handler.Enqueue(query)
+		assert.Nil(t, query.WaitDone())
+		checkpoint := newClassTask(tasks.WaitableCtx, tasks.CheckpointClass, nil, func() error { return nil })
+		handler.Enqueue(checkpoint)
+		assert.Nil(t, checkpoint.WaitDone())
+	}
+	stats := handler.Stats()[tasks.CompactionClass]
+	assert.Equal(t, 2, stats.Running)
+	assert.Equal(t, 4, stats.Queued)
+	assert.Equal(t, 2, stats.Quota)
+
+	// a raised quota takes the free workers at once
+	handler.SetQuota(tasks.CompactionClass, 3)
+	for handler.Stats()[tasks.CompactionClass].Running < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	close(gate)
+	for _, task := range compactions {
+		assert.Nil(t, task.WaitDone())
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&maxRunning))
+}
+
+func TestPriorityScopes(t *testing.T) {
+	handler := tasks.NewPriorityHandler("test", 4)
+	handler.SerializeScopes(tasks.CheckpointClass)
+	handler.Start()
+	defer handler.Close()
+
+	// the checkpoints of a segment run one at a time in order, the other
+	// segments are not held
+	var mu sync.Mutex
+	var order []int
+	running := make(map[uint64]bool)
+	var pendings []tasks.Task
+	for i := 0; i < 8; i++ {
+		i := i
+		scope := &common.ID{TableID: 1, SegmentID: uint64(i % 2), BlockID: uint64(i)}
+		task := newClassTask(tasks.WaitableCtx, tasks.CheckpointClass, scope, func() error {
+			mu.Lock()
+			if running[scope.SegmentID] {
+				mu.Unlock()
+				return ErrTaskDuplicated
+			}
+			running[scope.SegmentID] = true
+			if scope.SegmentID == 0 {
+				order = append(order, i)
+			}
+			mu.Unlock()
+			time.Sleep(2 * time.Millisecond)
+			mu.Lock()
+			running[scope.SegmentID] = false
+			mu.Unlock()
+			return nil
+		})
+		handler.Enqueue(task)
+		pendings = append(pendings, task)
+	}
+	for _, task := range pendings {
+		assert.Nil(t, task.WaitDone())
+	}
+	assert.Equal(t, []int{0, 2, 4, 6}, order)
+}
+
+func TestIOThrottle(t *testing.T) {
+	throttle := tasks.NewIOThrottle(10000, 0)
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		throttle.Wait(100)
+	}
+	assert.True(t, time.Since(now) >= 40*time.Millisecond)
+	assert.True(t, throttle.Waited() > 0)
+
+	// no limit without a rate
+	throttle.SetRate(0)
+	now = time.Now()
+	throttle.Wait(1 << 30)
+	assert.True(t, time.Since(now) < time.Second)
+	var nilThrottle *tasks.IOThrottle
+	nilThrottle.Wait(1 << 30)
+}
+
+func TestThrottleByQueryLatency(t *testing.T) {
+	opts := new(options.Options)
+	opts.SchedulerCfg = &options.SchedulerCfg{
+		IOWorkers:             2,
+		AsyncWorkers:          4,
+		CompactionIORate:      int64(common.M),
+		QueryLatencyThreshold: 100,
+		ThrottledIORate:       int64(common.K),
+	}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	scheduler := tae.Scheduler.(*taskScheduler)
+	assert.Equal(t, int64(common.M), scheduler.IOThrottle().Rate())
+	assert.Equal(t, 4, scheduler.asyncHandler.Quota(tasks.CompactionClass))
+
+	tae.ReportQueryLatency(50 * time.Millisecond)
+	assert.Equal(t, 4, scheduler.asyncHandler.Quota(tasks.CompactionClass))
+	tae.ReportQueryLatency(time.Second)
+	assert.Equal(t, 1, scheduler.asyncHandler.Quota(tasks.CompactionClass))
+	assert.Equal(t, int64(common.K), scheduler.IOThrottle().Rate())
+	tae.ReportQueryLatency(10 * time.Millisecond)
+	assert.Equal(t, 4, scheduler.asyncHandler.Quota(tasks.CompactionClass))
+	assert.Equal(t, int64(common.M), scheduler.IOThrottle().Rate())
+
+	// the customized tasks are run as the queries
+	task, err := tae.Scheduler.ScheduleFn(tasks.WaitableCtx, tasks.CustomizedTask, func() error { return nil })
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+	assert.Equal(t, tasks.QueryClass, task.Class())
+	stats := tae.CollectStats()
+	assert.Equal(t, tasks.NumTaskClasses, len(stats.TaskStats))
+	assert.Equal(t, uint64(1), stats.TaskStats[tasks.QueryClass].Done)
+}
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// checkSortedBlocks checks the rows of every non-appendable block are
+// sorted by cmp, and those of every segment if merged is true
+func checkSortedBlocks(t *testing.T, rel handle.Relation, cols []int, cmp func(a, b []any) int, merged bool) {
+	segIt := rel.MakeSegmentIt()
+	for segIt.Valid() {
+		var prev []any
+		blkIt := segIt.GetSegment().MakeBlockIt()
+		for blkIt.Valid() {
+			blk := blkIt.GetBlock()
+			if blk.IsAppendableBlock() {
+				blkIt.Next()
+				continue
+			}
+			if !merged {
+				prev = nil
+			}
+			vecs := make([]*vector.Vector, len(cols))
+			for i, col := range cols {
+				view, err := blk.GetColumnDataById(col, nil, nil)
+				assert.NoError(t, err)
+				vecs[i] = view.ApplyDeletes()
+			}
+			for row := 0; row < vector.Length(vecs[0]); row++ {
+				cur := make([]any, len(cols))
+				for i := range vecs {
+					cur[i] = compute.GetValue(vecs[i], uint32(row))
+				}
+				if prev != nil {
+					assert.Less(t, cmp(prev, cur), 0, "%v, %v", prev, cur)
+				}
+				prev = cur
+			}
+			blkIt.Next()
+		}
+		segIt.Next()
+	}
+}
+
+func checkGetByFilter(t *testing.T, e *testEngine, keys []any) {
+	txn, rel := e.getRelation()
+	for _, key := range keys {
+		_, _, err := rel.GetByFilter(handle.NewEQFilter(key))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, txn.Commit())
+}
+
+// 1. Append 4 blocks of a table sorted by a descending pk
+// 2. Compact the blocks, each of them is sorted in descending order
+// 3. Merge the blocks, the merged segment is sorted in descending order
+// 4. Every key is found by GetByFilter after each step and restart
+func TestCompactDescSortKey(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("desc")
+	assert.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	assert.NoError(t, schema.AppendSortKeyWithOrder("k", types.T_int64.ToType(), 0, true, true))
+	assert.NoError(t, schema.Finalize(false))
+	assert.True(t, schema.SortKey.IsDesc())
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	tae.bindSchema(schema)
+	bat := catalog.MockData(schema, 40)
+	keys := make([]any, 40)
+	for i := range keys {
+		keys[i] = compute.GetValue(bat.Vecs[1], uint32(i))
+	}
+	tae.createRelAndAppend(bat, true)
+
+	desc := func(a, b []any) int { return compute.CompareGeneric(b[0], a[0], schema.GetSortKeyType()) }
+	tae.compactBlocks(false)
+	txn, rel := tae.getRelation()
+	forEachBlock(rel, func(blk handle.Block) error {
+		assert.False(t, blk.IsAppendableBlock())
+		return nil
+	})
+	checkSortedBlocks(t, rel, []int{1}, desc, false)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+
+	tae.mergeBlocks(false)
+	txn, rel = tae.getRelation()
+	checkSortedBlocks(t, rel, []int{1}, desc, true)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+
+	tae.restart()
+	txn, rel = tae.getRelation()
+	assert.True(t, rel.GetMeta().(*catalog.TableEntry).GetSchema().SortKey.IsDesc())
+	checkSortedBlocks(t, rel, []int{1}, desc, true)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+}
+
+// The compound sort key (c2 asc, c0 desc) orders the rows of the compacted
+// and merged blocks
+func TestCompactMixedCompoundSortKey(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("mixed")
+	assert.NoError(t, schema.AppendSortKeyWithOrder("c0", types.T_int32.ToType(), 1, true, true))
+	assert.NoError(t, schema.AppendCol("c1", types.T_int32.ToType()))
+	assert.NoError(t, schema.AppendSortKeyWithOrder("c2", types.T_int32.ToType(), 0, true, false))
+	assert.NoError(t, schema.Finalize(false))
+	assert.Equal(t, []bool{false, true}, schema.SortKey.GetDescs())
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockData(schema, 20)
+	c0 := make([]int32, 20)
+	c2 := make([]int32, 20)
+	for i := range c0 {
+		c0[i] = int32(i)
+		c2[i] = int32(i % 3)
+	}
+	vector.SetCol(bat.Vecs[0], c0)
+	vector.SetCol(bat.Vecs[2], c2)
+	keys := make([]any, 20)
+	for i := range keys {
+		keys[i] = model.EncodeTuple(nil, uint32(i), bat.Vecs[2], bat.Vecs[0])
+	}
+	tae.createRelAndAppend(bat, true)
+
+	mixed := func(a, b []any) int {
+		if r := compute.CompareGeneric(a[0], b[0], types.T_int32.ToType()); r != 0 {
+			return r
+		}
+		return compute.CompareGeneric(b[1], a[1], types.T_int32.ToType())
+	}
+	tae.compactBlocks(false)
+	txn, rel := tae.getRelation()
+	checkSortedBlocks(t, rel, []int{2, 0}, mixed, false)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+	tae.mergeBlocks(false)
+	txn, rel = tae.getRelation()
+	checkSortedBlocks(t, rel, []int{2, 0}, mixed, true)
+	assert.NoError(t, txn.Commit())
+	checkGetByFilter(t, tae, keys)
+}
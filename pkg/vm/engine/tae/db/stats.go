@@ -3,7 +3,9 @@ package db
 import (
 	"encoding/json"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
@@ -13,6 +15,11 @@ type Stats struct {
 	CatalogStats *CatalogStats
 	TxnStats     *TxnStats
 	WalStats     *WalStats
+	BufferStats  *BufferStats
+	// TaskStats is the number of the tasks of each class
+	TaskStats []tasks.ClassStats
+	// RetryStates is the compactions which failed, with the poisoned ones
+	RetryStates []tasks.RetryState
 }
 
 func NewStats(db *DB) *Stats {
@@ -25,6 +32,11 @@ func (stats *Stats) Collect() {
 	stats.CatalogStats = CollectCatalogStats(stats.db.Catalog)
 	stats.TxnStats = CollectTxnStats(stats.db.TxnMgr)
 	stats.WalStats = CollectWalStats(stats.db.Wal)
+	stats.BufferStats = CollectBufferStats(stats.db)
+	if s, ok := stats.db.Scheduler.(*taskScheduler); ok {
+		stats.TaskStats = s.ClassStats()
+		stats.RetryStates = s.RetryStates()
+	}
 }
 
 func (stats *Stats) ToString(prefix string) string {
@@ -54,6 +66,12 @@ type WalStats struct {
 	PendingCnt uint64
 }
 
+type BufferStats struct {
+	Index *base.Stats
+	MT    *base.Stats
+	Txn   *base.Stats
+}
+
 func CollectCatalogStats(c *catalog.Catalog) *CatalogStats {
 	return &CatalogStats{
 		MaxDBID: c.CurrDB(),
@@ -78,3 +96,11 @@ func CollectWalStats(w wal.Driver) *WalStats {
 		PendingCnt: w.GetPenddingCnt(),
 	}
 }
+
+func CollectBufferStats(db *DB) *BufferStats {
+	return &BufferStats{
+		Index: db.IndexBufMgr.Stats(),
+		MT:    db.MTBufMgr.Stats(),
+		Txn:   db.TxnBufMgr.Stats(),
+	}
+}
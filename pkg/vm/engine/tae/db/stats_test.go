@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/stretchr/testify/assert"
+)
+
+//  1. Append 90 rows in 9 blocks, column c has 7 distinct values spread over
+//     all the blocks
+//  2. Compact and merge the blocks, the sketches of the new blocks are
+//     merged per table: k has 90 distinct values and c has 7
+//  3. Restart, the sketches are replayed with the blocks
+//  4. Append 5 rows, the appendable block adds its index size to every column
+//  5. The stats are cached until they are stale
+func TestTableStatsNDV(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("stats")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int64.ToType(), 0))
+	assert.NoError(t, schema.AppendCol("c", types.T_int32.ToType()))
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	tae.bindSchema(schema)
+
+	bat := catalog.MockData(schema, 95)
+	cs := make([]int32, 95)
+	for i := range cs {
+		cs[i] = int32(i % 7)
+	}
+	bat.Vecs[1].Col = cs
+	tae.createRelAndAppend(compute.BatchWindow(bat, 0, 90), true)
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+
+	checkNDV := func(rows, k, c uint64) {
+		txn, rel := tae.getRelation()
+		stats, err := rel.GetMeta().(*catalog.TableEntry).GetStats(0)
+		assert.NoError(t, err)
+		assert.Equal(t, rows, stats.Rows)
+		assert.InDelta(t, k, stats.NDV[0], 1)
+		assert.Equal(t, c, stats.NDV[1])
+		assert.NoError(t, txn.Commit())
+	}
+	checkNDV(90, 90, 7)
+
+	tae.restart()
+	checkNDV(90, 90, 7)
+
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(compute.BatchWindow(bat, 90, 95)))
+	assert.NoError(t, txn.Commit())
+	checkNDV(95, 95, 12)
+
+	txn, rel = tae.getRelation()
+	meta := rel.GetMeta().(*catalog.TableEntry)
+	stats, err := meta.GetStats(time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+	cached, err := meta.GetStats(time.Hour)
+	assert.NoError(t, err)
+	assert.Same(t, stats, cached)
+}
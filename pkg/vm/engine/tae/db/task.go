@@ -15,8 +15,12 @@
 package db
 
 import (
+	"errors"
+
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
@@ -25,6 +29,9 @@ type ScheduledTxnTask struct {
 	db      *DB
 	factory tasks.TxnTaskFactory
 	scopes  []common.ID
+	// retries records the result of the task by retryKey if it is set
+	retries  *tasks.RetryTracker
+	retryKey tasks.RetryKey
 }
 
 func NewScheduledTxnTask(ctx *tasks.Context, db *DB, taskType tasks.TaskType, scopes []common.ID, factory tasks.TxnTaskFactory) (task *ScheduledTxnTask) {
@@ -45,7 +52,24 @@ func (task *ScheduledTxnTask) Scope() *common.ID {
 	return &task.scopes[0]
 }
 
+// SetRetryTracker makes the task record its result in the tracker by the key,
+// it must be set before the task is scheduled
+func (task *ScheduledTxnTask) SetRetryTracker(retries *tasks.RetryTracker, key tasks.RetryKey) {
+	task.retries = retries
+	task.retryKey = key
+}
+
 func (task *ScheduledTxnTask) Execute() (err error) {
+	if task.retries != nil {
+		defer func() {
+			if isTransientTaskError(err) {
+				return
+			}
+			if state := task.retries.Report(task.retryKey, err); state.Poisoned {
+				logutil.Errorf("Task %d:%d poisoned after %d failures: %s", task.Type(), task.ID(), state.Failures, state.LastError)
+			}
+		}()
+	}
 	txn, err := task.db.StartTxn(nil)
 	if err != nil {
 		return
@@ -74,3 +98,11 @@ func (task *ScheduledTxnTask) Execute() (err error) {
 	}
 	return
 }
+
+// isTransientTaskError returns true if a task failed for the txns it raced
+// with, the failure is not counted for its retries
+func isTransientTaskError(err error) bool {
+	return errors.Is(err, txnif.TxnWWConflictErr) ||
+		errors.Is(err, txnif.TxnRWConflictErr) ||
+		errors.Is(err, data.ErrStaleRequest)
+}
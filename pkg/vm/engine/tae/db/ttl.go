@@ -0,0 +1,245 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+// TTLStats is the result of a run of the TTL reaper
+type TTLStats struct {
+	// DroppedBlocks are the blocks whose rows are all expired, they are
+	// dropped as a whole
+	DroppedBlocks int
+	DroppedRows   int
+	// DeletedBlocks are the blocks with some expired rows, which are deleted
+	DeletedBlocks int
+	DeletedRows   int
+}
+
+type ttlTable struct {
+	dbName string
+	entry  *catalog.TableEntry
+}
+
+// ReapExpiredRows deletes the rows of the tables with TTL expired at now.
+// Every block is reaped in its own txn, so the writers are not blocked for
+// long, and a block failed, for example by a conflict, is reaped again in
+// the next run.
+func (db *DB) ReapExpiredRows(now time.Time) (stats TTLStats, err error) {
+	var tables []ttlTable
+	processor := new(catalog.LoopProcessor)
+	processor.DatabaseFn = func(entry *catalog.DBEntry) error {
+		if entry.IsSystemDB() || !entry.IsActive() {
+			return catalog.ErrStopCurrRecur
+		}
+		return nil
+	}
+	processor.TableFn = func(entry *catalog.TableEntry) error {
+		if entry.GetSchema().HasTTL() && entry.IsActive() {
+			tables = append(tables, ttlTable{dbName: entry.GetDB().GetName(), entry: entry})
+		}
+		return catalog.ErrStopCurrRecur
+	}
+	if err = db.Catalog.RecurLoop(processor); err != nil {
+		return
+	}
+	for _, table := range tables {
+		if err = db.reapTable(table, now, &stats); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (db *DB) reapTable(table ttlTable, now time.Time, stats *TTLStats) (err error) {
+	schema := table.entry.GetSchema()
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	var blocks []*common.ID
+	database, err := txn.GetDatabase(table.dbName)
+	if err == nil {
+		var rel handle.Relation
+		if rel, err = database.GetRelationByName(schema.Name); err == nil {
+			it := rel.MakeBlockIt()
+			for ; it.Valid(); it.Next() {
+				blocks = append(blocks, it.GetBlock().Fingerprint())
+			}
+		}
+	}
+	if err = txn.Rollback(); err != nil {
+		return
+	}
+	for _, id := range blocks {
+		if err := db.reapBlock(table, id, now, stats); err != nil {
+			logutil.Warnf("[TTL] | %s | %s | %v", schema.Name, id.BlockString(), err)
+		}
+	}
+	return
+}
+
+// reapBlock drops the block if all its rows are expired, or deletes its
+// expired rows. The min and max of the TTL column of the block tell which.
+// Only the non-appendable blocks are dropped, the appendable blocks may
+// still be appended.
+func (db *DB) reapBlock(table ttlTable, id *common.ID, now time.Time, stats *TTLStats) (err error) {
+	schema := table.entry.GetSchema()
+	def, cutoff := schema.TTLCutoff(now)
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = txn.Rollback()
+		}
+	}()
+	database, err := txn.GetDatabase(table.dbName)
+	if err != nil {
+		return
+	}
+	rel, err := database.GetRelationByName(schema.Name)
+	if err != nil {
+		return
+	}
+	seg, err := rel.GetSegment(id.SegmentID)
+	if err != nil {
+		return
+	}
+	blk, err := seg.GetBlock(id.BlockID)
+	if err != nil {
+		return
+	}
+	view, err := blk.GetColumnDataById(def.Idx, nil, nil)
+	if err != nil {
+		return
+	}
+	vec := view.AppliedVec
+	zm := index.NewZoneMap(def.Type)
+	live, valued := 0, 0
+	for row := 0; row < blk.Rows(); row++ {
+		if view.DeleteMask != nil && view.DeleteMask.Contains(uint32(row)) {
+			continue
+		}
+		live++
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			continue
+		}
+		valued++
+		if err = zm.Update(compute.GetValue(vec, uint32(row))); err != nil {
+			return
+		}
+	}
+	if valued == 0 || compute.CompareGeneric(zm.GetMin(), cutoff, def.Type) >= 0 {
+		return
+	}
+
+	if valued == live && !blk.IsAppendableBlock() && compute.CompareGeneric(zm.GetMax(), cutoff, def.Type) < 0 {
+		if err = seg.SoftDeleteBlock(id.BlockID); err != nil {
+			return
+		}
+		committed = true
+		if err = txn.Commit(); err != nil {
+			return
+		}
+		table.entry.RemoveRows(uint64(live))
+		stats.DroppedBlocks++
+		stats.DroppedRows += live
+		return
+	}
+
+	// delete the runs of the expired rows
+	deleted := 0
+	start := -1
+	for row := 0; row <= blk.Rows(); row++ {
+		expired := row < blk.Rows() &&
+			(view.DeleteMask == nil || !view.DeleteMask.Contains(uint32(row))) &&
+			!nulls.Contains(vec.Nsp, uint64(row)) &&
+			compute.CompareGeneric(compute.GetValue(vec, uint32(row)), cutoff, def.Type) < 0
+		if expired {
+			if start < 0 {
+				start = row
+			}
+			deleted++
+			continue
+		}
+		if start >= 0 {
+			if err = blk.RangeDelete(uint32(start), uint32(row-1)); err != nil {
+				return
+			}
+			start = -1
+		}
+	}
+	committed = true
+	if err = txn.Commit(); err != nil {
+		return
+	}
+	stats.DeletedBlocks++
+	stats.DeletedRows += deleted
+	return
+}
+
+// ttlReaper runs the TTL reaper every interval
+type ttlReaper struct {
+	*catalog.LoopProcessor
+	db       *DB
+	interval time.Duration
+	last     time.Time
+}
+
+func newTTLReaper(db *DB, interval time.Duration) *ttlReaper {
+	if interval <= 0 {
+		interval = time.Duration(options.DefaultTTLReapInterval) * time.Millisecond
+	}
+	reaper := &ttlReaper{
+		LoopProcessor: new(catalog.LoopProcessor),
+		db:            db,
+		interval:      interval,
+		last:          time.Now(),
+	}
+	reaper.DatabaseFn = func(*catalog.DBEntry) error { return catalog.ErrStopCurrRecur }
+	return reaper
+}
+
+func (reaper *ttlReaper) PreExecute() error { return nil }
+
+func (reaper *ttlReaper) PostExecute() error {
+	if time.Since(reaper.last) < reaper.interval {
+		return nil
+	}
+	reaper.last = time.Now()
+	stats, err := reaper.db.ReapExpiredRows(reaper.last)
+	if err != nil {
+		logutil.Warnf("[TTL] | reap failed: %v", err)
+		return nil
+	}
+	if stats.DroppedBlocks > 0 || stats.DeletedBlocks > 0 {
+		logutil.Infof("[TTL] | %d blocks dropped, %d rows | %d blocks deleted from, %d rows | %s",
+			stats.DroppedBlocks, stats.DroppedRows, stats.DeletedBlocks, stats.DeletedRows, time.Since(reaper.last))
+	}
+	return nil
+}
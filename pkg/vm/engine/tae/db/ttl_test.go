@@ -0,0 +1,97 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func toDatetime(t time.Time) types.Datetime {
+	t = t.Local()
+	return types.FromClock(int32(t.Year()), uint8(t.Month()), uint8(t.Day()),
+		uint8(t.Hour()), uint8(t.Minute()), uint8(t.Second()), uint32(t.Nanosecond()/1000))
+}
+
+//  1. Append 4 full blocks and 1 appendable block of a table with TTL 1 day:
+//     block 0 and 3 are expired, half of block 1 is expired, block 2 is not,
+//     the appendable block is expired
+//  2. Compact the full blocks and run the reaper, block 0 and 3 are dropped,
+//     the expired rows of block 1 and the appendable block are deleted
+//  3. Run the reaper again, nothing is left to reap
+//  4. Restart, the rows left are the same
+//  5. Nothing expires before the TTL
+func TestReapExpiredRows(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("ttl")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int64.ToType(), 0))
+	assert.NoError(t, schema.AppendCol("ts", types.T_datetime.ToType()))
+	schema.TTLColumn = "ts"
+	schema.TTL = 24 * 60 * 60
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 10
+	tae.bindSchema(schema)
+
+	now := time.Now()
+	expired, fresh := toDatetime(now.Add(-48*time.Hour)), toDatetime(now)
+	bat := catalog.MockData(schema, 45)
+	ts := make([]types.Datetime, 45)
+	for i := range ts {
+		if i < 15 || i >= 30 {
+			ts[i] = expired
+		} else {
+			ts[i] = fresh
+		}
+	}
+	bat.Vecs[1].Col = ts
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(false)
+
+	stats, err := tae.ReapExpiredRows(now)
+	assert.NoError(t, err)
+	assert.Equal(t, TTLStats{DroppedBlocks: 2, DroppedRows: 20, DeletedBlocks: 2, DeletedRows: 10}, stats)
+
+	stats, err = tae.ReapExpiredRows(now)
+	assert.NoError(t, err)
+	assert.Equal(t, TTLStats{}, stats)
+
+	txn, rel := tae.getRelation()
+	checkAllColRowsByScan(t, rel, 15, true)
+	// the deletes are applied to the rows of the table asynchronously
+	meta := rel.GetMeta().(*catalog.TableEntry)
+	testutils.WaitExpect(1000, func() bool { return meta.GetRows() == 15 })
+	assert.Equal(t, uint64(15), meta.GetRows())
+	assert.NoError(t, txn.Commit())
+
+	tae.restart()
+	txn, rel = tae.getRelation()
+	checkAllColRowsByScan(t, rel, 15, true)
+	replayed := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	assert.Equal(t, "ts", replayed.TTLColumn)
+	assert.Equal(t, schema.TTL, replayed.TTL)
+	assert.NoError(t, txn.Commit())
+
+	// nothing expires before the TTL
+	stats, err = tae.ReapExpiredRows(now.Add(-72 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, TTLStats{}, stats)
+}
@@ -747,3 +747,61 @@ func TestTxn9(t *testing.T) {
 	assert.NoError(t, txn.Commit())
 	wg.Wait()
 }
+
+func TestTxnSavepoint(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	bat := catalog.MockData(schema, 20)
+	bats := compute.SplitBatch(bat, 4)
+	createRelationAndAppend(t, tae, defaultTestDB, schema, bats[0], true)
+	pk := func(i, row int) handle.Filter {
+		return *handle.NewEQFilter(compute.GetValue(bats[i].Vecs[2], uint32(row)))
+	}
+
+	txn, rel := getDefaultRelation(t, tae, schema.Name)
+	assert.NoError(t, rel.Append(bats[1]))
+	f := pk(0, 0)
+	assert.NoError(t, rel.DeleteByFilter(&f))
+	f = pk(1, 0)
+	assert.NoError(t, rel.DeleteByFilter(&f))
+	checkAllColRowsByScan(t, rel, 8, true)
+
+	sp := txn.GetStore().Savepoint()
+	assert.NoError(t, rel.Append(bats[2]))
+	f = pk(0, 1)
+	assert.NoError(t, rel.DeleteByFilter(&f))
+	f = pk(1, 1)
+	assert.NoError(t, rel.DeleteByFilter(&f))
+	checkAllColRowsByScan(t, rel, 11, true)
+
+	assert.NoError(t, txn.GetStore().RollbackToSavepoint(sp))
+	checkAllColRowsByScan(t, rel, 8, true)
+	// the restored local rows are in the index again, the rolled back ones
+	// are not
+	assert.Error(t, rel.Append(bats[1]))
+	f = pk(1, 1)
+	_, _, err := rel.GetByFilter(&f)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(bats[2]))
+	assert.NoError(t, txn.Commit())
+
+	// the delete node created after the savepoint is removed
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	checkAllColRowsByScan(t, rel, 13, true)
+	sp = txn.GetStore().Savepoint()
+	f = pk(0, 2)
+	assert.NoError(t, rel.DeleteByFilter(&f))
+	assert.NoError(t, txn.GetStore().RollbackToSavepoint(sp))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	checkAllColRowsByScan(t, rel, 13, true)
+	f = pk(0, 1)
+	_, _, err = rel.GetByFilter(&f)
+	assert.NoError(t, err)
+	f = pk(0, 2)
+	_, _, err = rel.GetByFilter(&f)
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+}
@@ -0,0 +1,95 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/stretchr/testify/assert"
+)
+
+//  1. Append 90 rows of a table without a primary key in 9 blocks, the day
+//     of row i is 2022-01-01 + i, the first 5 days are null
+//  2. Compact the blocks, the zonemaps of the temporal columns prune the
+//     blocks out of a range, the nulls are not in them
+//  3. Merge the blocks and restart, the zonemaps are rebuilt and replayed
+func TestTemporalZoneMap(t *testing.T) {
+	tae := newTestEngine(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("events")
+	assert.NoError(t, schema.AppendCol("d", types.T_date.ToType()))
+	assert.NoError(t, schema.AppendCol("dt", types.T_datetime.ToType()))
+	assert.NoError(t, schema.AppendCol("ts", types.T_timestamp.ToType()))
+	assert.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	tae.bindSchema(schema)
+
+	first, err := types.ParseDate("2022-01-01")
+	assert.NoError(t, err)
+	bat := catalog.MockData(schema, 90)
+	ds := make([]types.Date, 90)
+	dts := make([]types.Datetime, 90)
+	tss := make([]types.Timestamp, 90)
+	for i := range ds {
+		ds[i] = first + types.Date(i)
+		dts[i] = ds[i].ToTime()
+		tss[i] = ds[i].ToTimeUTC()
+	}
+	bat.Vecs[0].Col = ds
+	bat.Vecs[1].Col = dts
+	bat.Vecs[2].Col = tss
+	nulls.Add(bat.Vecs[0].Nsp, 0, 1, 2, 3, 4)
+	nulls.Add(bat.Vecs[1].Nsp, 0, 1, 2, 3, 4)
+	tae.createRelAndAppend(bat, true)
+
+	day := func(i int) types.Date { return first + types.Date(i) }
+	countBlocks := func(colIdx int, min, max any) (cnt int) {
+		txn, rel := tae.getRelation()
+		for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+			meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			if meta.GetBlockData().MayContainRange(colIdx, min, max) {
+				cnt++
+			}
+		}
+		assert.NoError(t, txn.Commit())
+		return
+	}
+	check := func() {
+		// closed, open and out of range intervals
+		assert.Equal(t, 2, countBlocks(0, day(25), day(34)))
+		assert.Equal(t, 2, countBlocks(1, day(25).ToTime(), day(34).ToTime()))
+		assert.Equal(t, 1, countBlocks(0, day(85), nil))
+		assert.Equal(t, 3, countBlocks(1, nil, day(25).ToTime()))
+		assert.Equal(t, 2, countBlocks(2, day(75).ToTimeUTC(), nil))
+		assert.Equal(t, 0, countBlocks(0, day(200), nil))
+		assert.Equal(t, 0, countBlocks(1, nil, day(-1).ToTime()))
+		// the nulls of the first block are not its min
+		assert.Equal(t, 0, countBlocks(0, day(0), day(4)))
+		// the other columns have no zonemap
+		assert.Equal(t, 9, countBlocks(3, int32(100), nil))
+	}
+
+	tae.compactBlocks(false)
+	check()
+	tae.mergeBlocks(false)
+	check()
+	tae.restart()
+	check()
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
@@ -58,6 +59,9 @@ type Block interface {
 	Rows(txn txnif.AsyncTxn, coarse bool) int
 	GetColumnDataByName(txn txnif.AsyncTxn, attr string, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error)
 	GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error)
+	// GetCommitTSColumn returns the view of the commit ts of the latest
+	// change of each row visible to the txn
+	GetCommitTSColumn(txn txnif.AsyncTxn) (*model.ColumnView, error)
 	GetMeta() any
 	GetBufMgr() base.INodeManager
 
@@ -72,12 +76,28 @@ type Block interface {
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector, rowmask *roaring.Bitmap) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (any, error)
+	GetValues(txn txnif.AsyncTxn, rows []uint32, col uint16) ([]any, error)
+	GetColumnsValues(txn txnif.AsyncTxn, rows []uint32, cols []uint16) ([][]any, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
+	// GetSketches returns the NDV sketches of the columns built when the
+	// block was flushed, nil if the block has none
+	GetSketches() (*index.Sketches, error)
+	// MayContainRange returns false if no row of the column in the block
+	// can be in [min, max] by the zonemap of the column, a nil bound is
+	// unbounded. It returns true if the column has no zonemap.
+	MayContainRange(colIdx int, min, max any) bool
+	// RoughNDV returns a rough NDV of an appendable block, which is the
+	// number of the keys in its index
+	RoughNDV() int
 
 	SetMaxCheckpointTS(ts uint64)
 	GetMaxCheckpointTS() uint64
 	GetMaxVisibleTS() uint64
+	// GetMaxCommitTS returns the latest commit ts of the changes of the
+	// block, a bound of the commit ts of its rows. It is UncommitTS if the
+	// block has an update not committed yet.
+	GetMaxCommitTS() uint64
 
 	CheckpointWALClosure(endTs uint64) tasks.FuncT
 	SyncBlockDataClosure(ts uint64, rows uint32) tasks.FuncT
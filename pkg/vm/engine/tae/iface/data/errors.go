@@ -14,7 +14,11 @@
 
 package data
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
 
 var (
 	ErrAppendableSegmentNotFound = errors.New("tae data: no appendable segment")
@@ -22,10 +26,12 @@ var (
 	ErrNotAppendable             = errors.New("tae data: not appendable")
 	ErrUpdateUniqueKey           = errors.New("tae data: update unique key")
 	ErrUpdateHiddenKey           = errors.New("tae data: update hidden key")
+	ErrUpdateRowChecksum         = errors.New("tae data: update in place a table with row checksums")
+	ErrRowChecksumMismatch       = errors.New("tae data: row checksum mismatch")
 	ErrStaleRequest              = errors.New("tae data: stale request")
 
 	ErrPossibleDuplicate = errors.New("tae data: possible duplicate")
-	ErrDuplicate         = errors.New("tae data: duplicate")
+	ErrDuplicate         = moerr.NewError(moerr.DUPLICATE_KEY, "tae data: duplicate")
 	ErrNotFound          = errors.New("tae data: not found")
 	ErrWrongType         = errors.New("tae data: wrong data type")
 )
@@ -36,6 +36,9 @@ type Block interface {
 
 	// OpenDeletesFile() common.IRWFile
 	WriteDeletes(buf []byte) error
+	// AppendDeletes appends the rows deleted since the last checkpoint,
+	// mask is all the rows deleted at ts
+	AppendDeletes(ts uint64, mask *roaring.Bitmap) error
 	ReadDeletes(buf []byte) error
 	GetDeletesFileStat() common.FileInfo
 	LoadDeletes() (*roaring.Bitmap, error)
@@ -45,6 +48,11 @@ type Block interface {
 	LoadIndexMeta() (any, error)
 	WriteIndexMeta(buf []byte) (err error)
 
+	// WriteSketches writes the sketches of the distinct values of the
+	// columns, LoadSketches returns nil if they are not written
+	WriteSketches(buf []byte) error
+	LoadSketches() ([]byte, error)
+
 	OpenColumn(colIdx int) (ColumnBlock, error)
 	// WriteColumn(colIdx int, ts uint64, data []byte, updates []byte) (common.IVFile, error)
 
@@ -59,6 +59,13 @@ type BlockReader interface {
 	GetByFilter(filter *Filter) (uint32, error)
 	GetColumnDataByName(string, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
 	GetColumnDataById(int, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
+	// GetCommitTSColumn returns the view of a uint64 column of the commit ts
+	// of the latest change of each row, which is UncommitTS for the rows
+	// changed by the txn itself
+	GetCommitTSColumn() (*model.ColumnView, error)
+	// GetMaxCommitTS returns a bound of the commit ts of the rows visible to
+	// the txn, which is UncommitTS if the txn may have changed them
+	GetMaxCommitTS() uint64
 	GetMeta() any
 	Fingerprint() *common.ID
 	Rows() int
@@ -42,6 +42,8 @@ type Relation interface {
 
 	RangeDelete(id *common.ID, start, end uint32) error
 	Update(id *common.ID, row uint32, col uint16, v any) error
+	// LockRows locks the rows of a block until the txn is terminated
+	LockRows(id *common.ID, rows []uint32) error
 	GetByFilter(filter *Filter) (id *common.ID, offset uint32, err error)
 	GetValue(id *common.ID, row uint32, col uint16) (any, error)
 	GetValueByFilter(filter *Filter, col int) (any, error)
@@ -50,6 +52,10 @@ type Relation interface {
 
 	BatchDedup(cols ...*vector.Vector) error
 	Append(data *batch.Batch) error
+	// AppendNoCopy is Append without copying the values of the fixed size
+	// columns where it can, they must not be modified until the txn is
+	// terminated
+	AppendNoCopy(data *batch.Batch) error
 
 	GetMeta() any
 	CreateSegment() (Segment, error)
@@ -14,10 +14,14 @@
 
 package txnif
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
 
 var (
 	TxnInternalErr   = errors.New("tae txn: internal error")
 	TxnRWConflictErr = errors.New("tae txn: r-w conflict error")
-	TxnWWConflictErr = errors.New("tae txn: w-w conflict error")
+	TxnWWConflictErr = moerr.NewError(moerr.TXN_WW_CONFLICT, "tae txn: w-w conflict error")
 )
@@ -179,9 +179,11 @@ type TxnStore interface {
 	LogBlockID(dbId, tid, bid uint64)
 
 	Append(dbId, id uint64, data *batch.Batch) error
+	AppendNoCopy(dbId, id uint64, data *batch.Batch) error
 
 	RangeDelete(dbId uint64, id *common.ID, start, end uint32) error
 	Update(dbId uint64, id *common.ID, row uint32, col uint16, v any) error
+	LockRows(id *common.ID, rows []uint32) error
 	GetByFilter(dbId uint64, id uint64, filter *handle.Filter) (*common.ID, uint32, error)
 	GetValue(dbId uint64, id *common.ID, row uint32, col uint16) (any, error)
 
@@ -209,8 +211,15 @@ type TxnStore interface {
 
 	IsReadonly() bool
 	IncreateWriteCnt() int
+
+	Savepoint() Savepoint
+	RollbackToSavepoint(Savepoint) error
 }
 
+// Savepoint is the state of the writes of a txn, the writes made after it
+// are undone by RollbackToSavepoint
+type Savepoint any
+
 type TxnEntryType int16
 
 type TxnEntry interface {
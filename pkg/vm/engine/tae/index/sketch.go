@@ -0,0 +1,184 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"bytes"
+	"sort"
+
+	hll "github.com/axiomhq/hyperloglog"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+)
+
+// Sketches are the HyperLogLog sketches of the distinct values of the
+// columns of a block, indexed by the column idx. They are built when the
+// block is flushed by a compaction or a merge, and merged per table to
+// estimate the NDV of the columns.
+//
+// The zonemaps of the date, datetime and timestamp columns of the block
+// are kept with them, so the blocks can be pruned by a time range on any
+// of these columns, not only on the sort key.
+type Sketches struct {
+	sks map[uint16]*hll.Sketch
+	zms map[uint16]*ZoneMap
+}
+
+func NewSketches() *Sketches {
+	return &Sketches{
+		sks: make(map[uint16]*hll.Sketch),
+		zms: make(map[uint16]*ZoneMap),
+	}
+}
+
+func LoadSketchesFrom(data []byte) (s *Sketches, err error) {
+	s = NewSketches()
+	err = s.Unmarshal(data)
+	return
+}
+
+// Add inserts the values of the column colIdx
+func (s *Sketches) Add(colIdx uint16, vec *vector.Vector) {
+	sk, ok := s.sks[colIdx]
+	if !ok {
+		sk = hll.New()
+		s.sks[colIdx] = sk
+	}
+	approxcd.FillSketch(sk, vec)
+	if isTemporal(vec.Typ.Oid) {
+		zm, ok := s.zms[colIdx]
+		if !ok {
+			zm = NewZoneMap(vec.Typ)
+			s.zms[colIdx] = zm
+		}
+		// the nulls are not in the zonemap, a zonemap of nulls only
+		// contains no range
+		for row := 0; row < vector.Length(vec); row++ {
+			if !nulls.Contains(vec.Nsp, uint64(row)) {
+				_ = zm.Update(compute.GetValue(vec, uint32(row)))
+			}
+		}
+	}
+}
+
+func isTemporal(oid types.T) bool {
+	return oid == types.T_date || oid == types.T_datetime || oid == types.T_timestamp
+}
+
+// Merge merges the sketches of o into s, the zonemaps are not merged
+func (s *Sketches) Merge(o *Sketches) error {
+	for colIdx, osk := range o.sks {
+		sk, ok := s.sks[colIdx]
+		if !ok {
+			s.sks[colIdx] = osk.Clone()
+			continue
+		}
+		if err := sk.Merge(osk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Estimate returns the estimated NDV of the column colIdx, false if the
+// column has no sketch
+func (s *Sketches) Estimate(colIdx uint16) (uint64, bool) {
+	sk, ok := s.sks[colIdx]
+	if !ok {
+		return 0, false
+	}
+	return sk.Estimate(), true
+}
+
+// ZoneMap returns the zonemap of the temporal column colIdx, nil if the
+// column has no zonemap
+func (s *Sketches) ZoneMap(colIdx uint16) *ZoneMap {
+	return s.zms[colIdx]
+}
+
+func (s *Sketches) Marshal() ([]byte, error) {
+	cols := make([]int, 0, len(s.sks))
+	for colIdx := range s.sks {
+		cols = append(cols, int(colIdx))
+	}
+	sort.Ints(cols)
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeUint16(uint16(len(cols))))
+	for _, colIdx := range cols {
+		data, err := s.sks[uint16(colIdx)].MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint16(uint16(colIdx)))
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+	}
+	cols = cols[:0]
+	for colIdx := range s.zms {
+		cols = append(cols, int(colIdx))
+	}
+	sort.Ints(cols)
+	buf.Write(encoding.EncodeUint16(uint16(len(cols))))
+	for _, colIdx := range cols {
+		data, err := s.zms[uint16(colIdx)].Marshal()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint16(uint16(colIdx)))
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Sketches) Unmarshal(buf []byte) error {
+	cnt := encoding.DecodeUint16(buf[:2])
+	buf = buf[2:]
+	for i := uint16(0); i < cnt; i++ {
+		colIdx := encoding.DecodeUint16(buf[:2])
+		buf = buf[2:]
+		size := encoding.DecodeUint32(buf[:4])
+		buf = buf[4:]
+		sk := hll.New()
+		if err := sk.UnmarshalBinary(buf[:size]); err != nil {
+			return err
+		}
+		buf = buf[size:]
+		s.sks[colIdx] = sk
+	}
+	// the sketches written before the zonemaps have none
+	if len(buf) == 0 {
+		return nil
+	}
+	cnt = encoding.DecodeUint16(buf[:2])
+	buf = buf[2:]
+	for i := uint16(0); i < cnt; i++ {
+		colIdx := encoding.DecodeUint16(buf[:2])
+		buf = buf[2:]
+		size := encoding.DecodeUint32(buf[:4])
+		buf = buf[4:]
+		zm, err := LoadZoneMapFrom(buf[:size])
+		if err != nil {
+			return err
+		}
+		buf = buf[size:]
+		s.zms[colIdx] = zm
+	}
+	return nil
+}
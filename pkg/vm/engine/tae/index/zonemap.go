@@ -84,6 +84,22 @@ func (zm *ZoneMap) Contains(key any) (ok bool) {
 	return
 }
 
+// MayContainRange returns false if no value of the zone map can be in
+// [min, max], a nil bound is unbounded
+func (zm *ZoneMap) MayContainRange(min, max any) (ok bool) {
+	if !zm.inited {
+		return
+	}
+	if min != nil && compute.CompareGeneric(min, zm.max, zm.typ) > 0 {
+		return
+	}
+	if max != nil && compute.CompareGeneric(max, zm.min, zm.typ) < 0 {
+		return
+	}
+	ok = true
+	return
+}
+
 func (zm *ZoneMap) ContainsAny(keys *vector.Vector) (visibility *roaring.Bitmap, ok bool) {
 	if !zm.inited {
 		return
@@ -237,7 +253,7 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		if _, err = w.Write(encoding.EncodeUint64(zm.min.(uint64))); err != nil {
 			return
 		}
@@ -291,6 +307,15 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
+	case types.T_time:
+		if _, err = w.Write(encoding.EncodeTime(zm.min.(types.Time))); err != nil {
+			return
+		}
+		if _, err = w.Write(encoding.EncodeTime(zm.max.(types.Time))); err != nil {
+			return
+		}
+		buf = w.Bytes()
+		return
 	case types.T_decimal64:
 		if _, err = w.Write(encoding.EncodeDecimal64(zm.min.(types.Decimal64))); err != nil {
 			return
@@ -381,7 +406,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[4:]
 		zm.max = encoding.DecodeUint32(buf[:4])
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		zm.min = encoding.DecodeUint64(buf[:8])
 		buf = buf[8:]
 		zm.max = encoding.DecodeUint64(buf[:8])
@@ -414,6 +439,12 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		zm.max = encoding.DecodeTimestamp(buf[:8])
 		buf = buf[8:]
 		return nil
+	case types.T_time:
+		zm.min = encoding.DecodeTime(buf[:8])
+		buf = buf[8:]
+		zm.max = encoding.DecodeTime(buf[:8])
+		buf = buf[8:]
+		return nil
 	case types.T_decimal64:
 		zm.min = encoding.DecodeDecimal64(buf[:8])
 		buf = buf[8:]
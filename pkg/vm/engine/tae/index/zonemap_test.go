@@ -120,6 +120,49 @@ func TestZoneMapNumeric(t *testing.T) {
 	require.True(t, yes)
 }
 
+func TestZoneMapRange(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	zm := NewZoneMap(typ)
+	require.False(t, zm.MayContainRange(nil, nil))
+
+	rows := 1000
+	ctx := new(KeysCtx)
+	ctx.Keys = compute.MockVec(typ, rows, 0)
+	ctx.Count = uint32(rows)
+	require.NoError(t, zm.BatchUpdate(ctx))
+
+	require.True(t, zm.MayContainRange(nil, nil))
+	require.True(t, zm.MayContainRange(int32(100), int32(200)))
+	require.True(t, zm.MayContainRange(int32(-5), int32(0)))
+	require.True(t, zm.MayContainRange(int32(999), int32(1500)))
+	require.True(t, zm.MayContainRange(nil, int32(0)))
+	require.True(t, zm.MayContainRange(int32(999), nil))
+	require.False(t, zm.MayContainRange(int32(-10), int32(-1)))
+	require.False(t, zm.MayContainRange(int32(1000), nil))
+	require.False(t, zm.MayContainRange(nil, int32(-1)))
+}
+
+func TestZoneMapBool(t *testing.T) {
+	typ := types.T_bool.ToType()
+	zm := NewZoneMap(typ)
+	require.NoError(t, zm.Update(true))
+	require.True(t, zm.Contains(true))
+	require.False(t, zm.Contains(false))
+	require.False(t, zm.MayContainRange(nil, false))
+
+	require.NoError(t, zm.Update(false))
+	require.Equal(t, false, zm.GetMin())
+	require.Equal(t, true, zm.GetMax())
+	require.True(t, zm.Contains(false))
+
+	buf, err := zm.Marshal()
+	require.NoError(t, err)
+	zm1, err := LoadZoneMapFrom(buf)
+	require.NoError(t, err)
+	require.Equal(t, false, zm1.GetMin())
+	require.Equal(t, true, zm1.GetMax())
+}
+
 func TestZoneMapString(t *testing.T) {
 	typ := types.Type{Oid: types.T_char}
 	zm := NewZoneMap(typ)
@@ -20,7 +20,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 )
 
-func Sort(col *vector.Vector, idx []uint32) {
+// Sort sorts the column in place and stores the original position of every
+// row in idx. A descending sort is the ascending one read backwards.
+func Sort(col *vector.Vector, idx []uint32, desc bool) {
 	data := col.Col.([]bool)
 	n := len(idx)
 	dataWithIdx := make(sortSlice, n)
@@ -31,7 +33,9 @@ func Sort(col *vector.Vector, idx []uint32) {
 
 	sortUnstable(dataWithIdx)
 
-	for i, v := range dataWithIdx {
+	first, step := direction(n, desc)
+	for i := range dataWithIdx {
+		v := dataWithIdx[first+step*i]
 		data[i], idx[i] = v.data, v.idx
 	}
 }
@@ -74,7 +78,11 @@ func shuffleNullableBlock(col *vector.Vector, idx []uint32) {
 	col.Nsp.Np = newNulls
 }
 
-func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32) (ret []*vector.Vector, mapping []uint32) {
+// Merge merges the sorted blocks of col into blocks of toLayout rows, src
+// stores the source block of every merged row and mapping the merged
+// position of every source row. The blocks of a descending merge are read
+// backwards, merged in ascending order and written backwards.
+func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32, desc bool) (ret []*vector.Vector, mapping []uint32) {
 	data := make([][]bool, len(col))
 	ret = make([]*vector.Vector, len(toLayout))
 	mapping = make([]uint32, len(*src))
@@ -91,29 +99,32 @@ func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32) (
 
 	nBlk := len(data)
 	heap := make(heapSlice, nBlk)
-	merged := make([][]bool, len(toLayout))
+	first := make([]int, nBlk)
+	step := 1
 
 	for i := 0; i < nBlk; i++ {
-		heap[i] = heapElem{data: data[i][0], src: uint32(i), next: 1}
-		merged[i] = make([]bool, toLayout[i])
+		first[i], step = direction(int(fromLayout[i]), desc)
+		heap[i] = heapElem{data: data[i][first[i]], src: uint32(i), next: 1}
 	}
 	heapInit(heap)
 
-	k := 0
-	for i := 0; i < len(toLayout); i++ {
-		for j := 0; j < int(toLayout[i]); j++ {
-			top := heapPop(&heap)
-			merged[i][j], (*src)[k] = top.data, top.src
-			mapping[offset[top.src]+top.next-1] = uint32(k)
-			k++
-			if int(top.next) < int(fromLayout[top.src]) {
-				heapPush(&heap, heapElem{data: data[top.src][top.next], src: top.src, next: top.next + 1})
-			}
+	merged := make([]bool, len(*src))
+	last, _ := direction(len(merged), desc)
+	for k := range merged {
+		top := heapPop(&heap)
+		pos := last + step*k
+		merged[pos], (*src)[pos] = top.data, top.src
+		mapping[offset[top.src]+uint32(first[top.src]+step*int(top.next-1))] = uint32(pos)
+		if int(top.next) < int(fromLayout[top.src]) {
+			heapPush(&heap, heapElem{data: data[top.src][first[top.src]+step*int(top.next)], src: top.src, next: top.next + 1})
 		}
 	}
+	start := 0
 	for i := 0; i < len(toLayout); i++ {
+		end := start + int(toLayout[i])
 		ret[i] = vector.New(col[0].Typ)
-		ret[i].Col = merged[i]
+		ret[i].Col = merged[start:end:end]
+		start = end
 	}
 	return
 }
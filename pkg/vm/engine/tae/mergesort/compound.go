@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+)
+
+// A compound sort key is stored encoded in a single column whose byte order
+// knows nothing about the direction of its columns, so the rows are ordered
+// by comparing the key columns one by one instead.
+
+// compareFn compares the row i of block a with the row j of block b
+type compareFn func(a, i, b, j uint32) int
+
+// newCompareFn returns the comparator of the key columns of blocks, the
+// operands of a descending column are swapped once here instead of
+// checking the direction on every comparison.
+func newCompareFn(blocks [][]*vector.Vector, desc []bool) compareFn {
+	cmps := make([]compareFn, len(desc))
+	for k := range cmps {
+		k := k
+		typ := blocks[0][k].Typ
+		cmp := func(a, i, b, j uint32) int {
+			return compute.CompareGeneric(compute.GetValue(blocks[a][k], i), compute.GetValue(blocks[b][k], j), typ)
+		}
+		if desc[k] {
+			cmps[k] = func(a, i, b, j uint32) int { return cmp(b, j, a, i) }
+		} else {
+			cmps[k] = cmp
+		}
+	}
+	return func(a, i, b, j uint32) int {
+		for _, cmp := range cmps {
+			if r := cmp(a, i, b, j); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// SortBlockColumnsByKeys sorts the columns by the key columns keys, every
+// key column is sorted in the direction of desc.
+func SortBlockColumnsByKeys(cols []*vector.Vector, keys []int, desc []bool) error {
+	keyCols := make([]*vector.Vector, len(keys))
+	for i, key := range keys {
+		keyCols[i] = cols[key]
+	}
+	cmp := newCompareFn([][]*vector.Vector{keyCols}, desc)
+	sortedIdx := make([]uint32, vector.Length(cols[keys[0]]))
+	for i := range sortedIdx {
+		sortedIdx[i] = uint32(i)
+	}
+	sort.Slice(sortedIdx, func(i, j int) bool {
+		return cmp(0, sortedIdx[i], 0, sortedIdx[j]) < 0
+	})
+	return ShuffleBlockColumns(cols, sortedIdx, -1)
+}
+
+type keyCursor struct {
+	src  uint32
+	next uint32
+}
+
+type keyHeap struct {
+	cursors []keyCursor
+	cmp     compareFn
+}
+
+func (h *keyHeap) Len() int { return len(h.cursors) }
+func (h *keyHeap) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	return h.cmp(a.src, a.next, b.src, b.next) < 0
+}
+func (h *keyHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+func (h *keyHeap) Push(x any)    { h.cursors = append(h.cursors, x.(keyCursor)) }
+func (h *keyHeap) Pop() any {
+	n := len(h.cursors) - 1
+	x := h.cursors[n]
+	h.cursors = h.cursors[:n]
+	return x
+}
+
+// MergeSortedKeys merges the blocks sorted by SortBlockColumnsByKeys, keys
+// holds the key columns of every block. Like MergeSortedColumn, sortedIdx
+// stores the source block of every merged row and the merged position of
+// every source row is returned.
+func MergeSortedKeys(keys [][]*vector.Vector, desc []bool, sortedIdx []uint32, fromLayout []uint32) (mapping []uint32) {
+	offset := make([]uint32, len(fromLayout))
+	for i := 1; i < len(fromLayout); i++ {
+		offset[i] = offset[i-1] + fromLayout[i-1]
+	}
+	h := &keyHeap{cmp: newCompareFn(keys, desc)}
+	for i := range keys {
+		if fromLayout[i] > 0 {
+			h.cursors = append(h.cursors, keyCursor{src: uint32(i)})
+		}
+	}
+	heap.Init(h)
+	mapping = make([]uint32, len(sortedIdx))
+	for k := range sortedIdx {
+		top := &h.cursors[0]
+		sortedIdx[k] = top.src
+		mapping[offset[top.src]+top.next] = uint32(k)
+		if top.next++; top.next < fromLayout[top.src] {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return
+}
@@ -39,10 +39,24 @@ type heapElem struct {
 
 type heapSlice []heapElem
 
+// Less takes the rows of the same value by the order of their blocks, so
+// the merge is deterministic
 func (x heapSlice) Less(i, j int) bool {
+	if x[i].data == x[j].data {
+		return x[i].src < x[j].src
+	}
 	if x[i].data.Hi == x[j].data.Hi {
 		return x[i].data.Lo < x[j].data.Lo
 	}
 	return x[i].data.Hi < x[j].data.Hi
 }
 func (x heapSlice) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+
+// direction returns the first position and the step to visit n rows in the
+// ascending or descending order
+func direction(n int, desc bool) (first, step int) {
+	if desc {
+		return n - 1, -1
+	}
+	return 0, 1
+}
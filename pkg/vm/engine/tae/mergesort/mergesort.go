@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/times"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/timestamps"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint16s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint32s"
@@ -38,50 +39,60 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/varchar"
 )
 
-func SortBlockColumns(cols []*vector.Vector, pk int) error {
+// SortBlockColumns sorts the columns by the column pk in the ascending or
+// descending order.
+func SortBlockColumns(cols []*vector.Vector, pk int, desc bool) error {
 	sortedIdx := make([]uint32, vector.Length(cols[pk]))
 
 	switch cols[pk].Typ.Oid {
 	case types.T_bool:
-		bools.Sort(cols[pk], sortedIdx)
+		bools.Sort(cols[pk], sortedIdx, desc)
 	case types.T_int8:
-		int8s.Sort(cols[pk], sortedIdx)
+		int8s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_int16:
-		int16s.Sort(cols[pk], sortedIdx)
+		int16s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_int32:
-		int32s.Sort(cols[pk], sortedIdx)
+		int32s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_int64:
-		int64s.Sort(cols[pk], sortedIdx)
+		int64s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_uint8:
-		uint8s.Sort(cols[pk], sortedIdx)
+		uint8s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_uint16:
-		uint16s.Sort(cols[pk], sortedIdx)
+		uint16s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_uint32:
-		uint32s.Sort(cols[pk], sortedIdx)
-	case types.T_uint64:
-		uint64s.Sort(cols[pk], sortedIdx)
+		uint32s.Sort(cols[pk], sortedIdx, desc)
+	case types.T_uint64, types.T_bit:
+		uint64s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_float32:
-		float32s.Sort(cols[pk], sortedIdx)
+		float32s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_float64:
-		float64s.Sort(cols[pk], sortedIdx)
+		float64s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_date:
-		dates.Sort(cols[pk], sortedIdx)
+		dates.Sort(cols[pk], sortedIdx, desc)
 	case types.T_datetime:
-		datetimes.Sort(cols[pk], sortedIdx)
+		datetimes.Sort(cols[pk], sortedIdx, desc)
 	case types.T_decimal64:
-		decimal64s.Sort(cols[pk], sortedIdx)
+		decimal64s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_decimal128:
-		decimal128s.Sort(cols[pk], sortedIdx)
+		decimal128s.Sort(cols[pk], sortedIdx, desc)
 	case types.T_timestamp:
-		timestamps.Sort(cols[pk], sortedIdx)
+		timestamps.Sort(cols[pk], sortedIdx, desc)
+	case types.T_time:
+		times.Sort(cols[pk], sortedIdx, desc)
 	case types.T_char, types.T_json, types.T_varchar:
-		varchar.Sort(cols[pk], sortedIdx)
+		varchar.Sort(cols[pk], sortedIdx, desc)
 	default:
 		panic(fmt.Sprintf("%s not supported", cols[pk].Typ.String()))
 	}
 
+	return ShuffleBlockColumns(cols, sortedIdx, pk)
+}
+
+// ShuffleBlockColumns reorders the rows of all the columns but the column
+// skip by sortedIdx.
+func ShuffleBlockColumns(cols []*vector.Vector, sortedIdx []uint32, skip int) error {
 	for i := 0; i < len(cols); i++ {
-		if i == pk {
+		if i == skip {
 			continue
 		}
 		switch cols[i].Typ.Oid {
@@ -101,7 +112,7 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 			uint16s.Shuffle(cols[i], sortedIdx)
 		case types.T_uint32:
 			uint32s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint64:
+		case types.T_uint64, types.T_bit:
 			uint64s.Shuffle(cols[i], sortedIdx)
 		case types.T_float32:
 			float32s.Shuffle(cols[i], sortedIdx)
@@ -117,6 +128,8 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 			decimal128s.Shuffle(cols[i], sortedIdx)
 		case types.T_timestamp:
 			timestamps.Shuffle(cols[i], sortedIdx)
+		case types.T_time:
+			times.Shuffle(cols[i], sortedIdx)
 		case types.T_char, types.T_json, types.T_varchar:
 			varchar.Shuffle(cols[i], sortedIdx)
 		default:
@@ -127,42 +140,46 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 	return nil
 }
 
-func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout, toLayout []uint32) (ret []*vector.Vector, mapping []uint32) {
+// MergeSortedColumn merges the blocks of column sorted in the ascending or
+// descending order.
+func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout, toLayout []uint32, desc bool) (ret []*vector.Vector, mapping []uint32) {
 	switch column[0].Typ.Oid {
 	case types.T_bool:
-		ret, mapping = bools.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = bools.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_int8:
-		ret, mapping = int8s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = int8s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_int16:
-		ret, mapping = int16s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = int16s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_int32:
-		ret, mapping = int32s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = int32s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_int64:
-		ret, mapping = int64s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = int64s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_uint8:
-		ret, mapping = uint8s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = uint8s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_uint16:
-		ret, mapping = uint16s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = uint16s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_uint32:
-		ret, mapping = uint32s.Merge(column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint64:
-		ret, mapping = uint64s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = uint32s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
+	case types.T_uint64, types.T_bit:
+		ret, mapping = uint64s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_float32:
-		ret, mapping = float32s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = float32s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_float64:
-		ret, mapping = float64s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = float64s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_date:
-		ret, mapping = dates.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = dates.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_datetime:
-		ret, mapping = datetimes.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = datetimes.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_decimal64:
-		ret, mapping = decimal64s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = decimal64s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_decimal128:
-		ret, mapping = decimal128s.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = decimal128s.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_timestamp:
-		ret, mapping = timestamps.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = timestamps.Merge(column, sortedIdx, fromLayout, toLayout, desc)
+	case types.T_time:
+		ret, mapping = times.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	case types.T_char, types.T_json, types.T_varchar:
-		ret, mapping = varchar.Merge(column, sortedIdx, fromLayout, toLayout)
+		ret, mapping = varchar.Merge(column, sortedIdx, fromLayout, toLayout, desc)
 	default:
 		panic(fmt.Sprintf("%s not supported", column[0].Typ.String()))
 	}
@@ -187,7 +204,7 @@ func Reshape(column []*vector.Vector, fromLayout, toLayout []uint32) (ret []*vec
 		ret = uint16s.Reshape(column, fromLayout, toLayout)
 	case types.T_uint32:
 		ret = uint32s.Reshape(column, fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		ret = uint64s.Reshape(column, fromLayout, toLayout)
 	case types.T_float32:
 		ret = float32s.Reshape(column, fromLayout, toLayout)
@@ -203,6 +220,8 @@ func Reshape(column []*vector.Vector, fromLayout, toLayout []uint32) (ret []*vec
 		ret = decimal128s.Reshape(column, fromLayout, toLayout)
 	case types.T_timestamp:
 		ret = timestamps.Reshape(column, fromLayout, toLayout)
+	case types.T_time:
+		ret = times.Reshape(column, fromLayout, toLayout)
 	case types.T_char, types.T_json, types.T_varchar:
 		ret = varchar.Reshape(column, fromLayout, toLayout)
 	}
@@ -227,7 +246,7 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 		ret = uint16s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint32:
 		ret = uint32s.Multiplex(column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		ret = uint64s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_float32:
 		ret = float32s.Multiplex(column, sortedIdx, fromLayout, toLayout)
@@ -243,6 +262,8 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 		ret = decimal128s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_timestamp:
 		ret = timestamps.Multiplex(column, sortedIdx, fromLayout, toLayout)
+	case types.T_time:
+		ret = times.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_char, types.T_json, types.T_varchar:
 		ret = varchar.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	default:
@@ -0,0 +1,545 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+// The sorted blocks are merged by sort tasks of disjoint key ranges. The
+// ranges are split by the values sampled from the blocks, every task merges
+// the rows of its range into their final positions, so the results of the
+// tasks need not be merged again.
+
+var (
+	// RowsPerSortTask is the least rows of a sort task, fewer rows are
+	// merged by the caller
+	RowsPerSortTask = 1 << 16
+	// MaxSortTasks is the most sort tasks of a merge, the tasks run in
+	// parallel are limited by the workers of the scheduler
+	MaxSortTasks = 8
+)
+
+// samplesPerTask is the values sampled from every block for a task
+const samplesPerTask = 16
+
+func sortTasks(rows int) int {
+	n := rows / RowsPerSortTask
+	if n > MaxSortTasks {
+		n = MaxSortTasks
+	}
+	return n
+}
+
+// runTasks runs the functions as sort tasks and waits for all of them
+func runTasks(sched tasks.Scheduler, fns []tasks.FuncT) (err error) {
+	ts := make([]tasks.Task, 0, len(fns))
+	for _, fn := range fns {
+		t := tasks.NewFnTask(tasks.WaitableCtx, tasks.SortTask, fn)
+		if err = sched.Schedule(t); err != nil {
+			break
+		}
+		ts = append(ts, t)
+	}
+	for _, t := range ts {
+		if terr := t.WaitDone(); terr != nil && err == nil {
+			err = terr
+		}
+	}
+	return
+}
+
+type ordered interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// direction returns the first position and the step to visit n rows in the
+// ascending or descending order
+func direction(n int, desc bool) (first, step int) {
+	if desc {
+		return n - 1, -1
+	}
+	return 0, 1
+}
+
+func lessOrdered[T ordered](a, b T) bool { return a < b }
+
+func lessBool(a, b bool) bool { return !a && b }
+
+func lessDecimal128(a, b types.Decimal128) bool {
+	if a.Hi == b.Hi {
+		return a.Lo < b.Lo
+	}
+	return a.Hi < b.Hi
+}
+
+func lessBytes(a, b []byte) bool { return bytes.Compare(a, b) < 0 }
+
+// sortedRuns are the sorted blocks of a column. A block is visited in the
+// ascending order, the rows of a descending block are visited backwards.
+type sortedRuns[T any] struct {
+	data  [][]T
+	first []int
+	step  int
+	less  func(a, b T) bool
+}
+
+func newSortedRuns[T any](data [][]T, desc bool, less func(a, b T) bool) *sortedRuns[T] {
+	runs := &sortedRuns[T]{
+		data:  data,
+		first: make([]int, len(data)),
+		less:  less,
+	}
+	for i := range data {
+		runs.first[i], runs.step = direction(len(data[i]), desc)
+	}
+	return runs
+}
+
+// get returns the t-th smallest row of the block i
+func (runs *sortedRuns[T]) get(i, t int) T {
+	return runs.data[i][runs.first[i]+runs.step*t]
+}
+
+// split returns the first visited row of every block for the ranges of the
+// parts, bounds[parts] is the end of the blocks. The rows of a value are in
+// the same range, the ranges may be empty.
+func (runs *sortedRuns[T]) split(parts int) (bounds [][]int) {
+	var samples []T
+	for i, data := range runs.data {
+		n := len(data)
+		cnt := samplesPerTask * parts
+		if cnt > n {
+			cnt = n
+		}
+		for k := 0; k < cnt; k++ {
+			samples = append(samples, runs.get(i, k*n/cnt))
+		}
+	}
+	sort.Slice(samples, func(i, j int) bool { return runs.less(samples[i], samples[j]) })
+
+	bounds = make([][]int, parts+1)
+	bounds[0] = make([]int, len(runs.data))
+	for j := 1; j < parts; j++ {
+		bounds[j] = make([]int, len(runs.data))
+		v := samples[j*len(samples)/parts]
+		for i, data := range runs.data {
+			bounds[j][i] = sort.Search(len(data), func(t int) bool { return !runs.less(runs.get(i, t), v) })
+		}
+	}
+	bounds[parts] = make([]int, len(runs.data))
+	for i, data := range runs.data {
+		bounds[parts][i] = len(data)
+	}
+	return
+}
+
+type mergeElem[T any] struct {
+	data T
+	src  uint32
+	next int
+}
+
+// mergeHeap takes the rows of the same value by the order of their blocks
+// like the heaps of the typed merges
+type mergeHeap[T any] struct {
+	elems []mergeElem[T]
+	less  func(a, b T) bool
+}
+
+func (h *mergeHeap[T]) lessAt(i, j int) bool {
+	a, b := &h.elems[i], &h.elems[j]
+	if h.less(a.data, b.data) {
+		return true
+	}
+	if h.less(b.data, a.data) {
+		return false
+	}
+	return a.src < b.src
+}
+
+func (h *mergeHeap[T]) init() {
+	n := len(h.elems)
+	for i := n/2 - 1; i >= 0; i-- {
+		h.down(i, n)
+	}
+}
+
+func (h *mergeHeap[T]) push(e mergeElem[T]) {
+	h.elems = append(h.elems, e)
+	h.up(len(h.elems) - 1)
+}
+
+func (h *mergeHeap[T]) pop() mergeElem[T] {
+	n := len(h.elems) - 1
+	h.elems[0], h.elems[n] = h.elems[n], h.elems[0]
+	h.down(0, n)
+	e := h.elems[n]
+	h.elems = h.elems[:n]
+	return e
+}
+
+func (h *mergeHeap[T]) up(j int) {
+	for {
+		i := (j - 1) / 2
+		if i == j || !h.lessAt(j, i) {
+			break
+		}
+		h.elems[i], h.elems[j] = h.elems[j], h.elems[i]
+		j = i
+	}
+}
+
+func (h *mergeHeap[T]) down(i, n int) {
+	for {
+		j1 := 2*i + 1
+		if j1 >= n || j1 < 0 {
+			break
+		}
+		j := j1
+		if j2 := j1 + 1; j2 < n && h.lessAt(j2, j1) {
+			j = j2
+		}
+		if !h.lessAt(j, i) {
+			break
+		}
+		h.elems[i], h.elems[j] = h.elems[j], h.elems[i]
+		i = j
+	}
+}
+
+// mergeRange merges the rows [lo[i], hi[i]) of every block i, the k-th row
+// merged is the base+k smallest row of all the blocks.
+func (runs *sortedRuns[T]) mergeRange(lo, hi []int, base int, merged []T, src, mapping, offset []uint32) {
+	h := &mergeHeap[T]{
+		elems: make([]mergeElem[T], 0, len(runs.data)),
+		less:  runs.less,
+	}
+	for i := range runs.data {
+		if lo[i] < hi[i] {
+			h.elems = append(h.elems, mergeElem[T]{data: runs.get(i, lo[i]), src: uint32(i), next: lo[i] + 1})
+		}
+	}
+	h.init()
+	last, _ := direction(len(merged), runs.step < 0)
+	for k := base; len(h.elems) > 0; k++ {
+		top := h.pop()
+		pos := last + runs.step*k
+		merged[pos], src[pos] = top.data, top.src
+		mapping[offset[top.src]+uint32(runs.first[top.src]+runs.step*(top.next-1))] = uint32(pos)
+		if top.next < hi[top.src] {
+			h.push(mergeElem[T]{data: runs.get(int(top.src), top.next), src: top.src, next: top.next + 1})
+		}
+	}
+}
+
+// parallelMerge merges the sorted blocks into merged by parts sort tasks
+func parallelMerge[T any](sched tasks.Scheduler, data [][]T, src *[]uint32, fromLayout []uint32, desc bool, less func(a, b T) bool, parts int) (merged []T, mapping []uint32, err error) {
+	runs := newSortedRuns(data, desc, less)
+	merged = make([]T, len(*src))
+	mapping = make([]uint32, len(*src))
+	offset := make([]uint32, len(fromLayout))
+	for i := 1; i < len(fromLayout); i++ {
+		offset[i] = offset[i-1] + fromLayout[i-1]
+	}
+	bounds := runs.split(parts)
+	fns := make([]tasks.FuncT, 0, parts)
+	base := 0
+	for j := 0; j < parts; j++ {
+		lo, hi, start := bounds[j], bounds[j+1], base
+		for i := range lo {
+			base += hi[i] - lo[i]
+		}
+		if base == start {
+			continue
+		}
+		fns = append(fns, func() error {
+			runs.mergeRange(lo, hi, start, merged, *src, mapping, offset)
+			return nil
+		})
+	}
+	err = runTasks(sched, fns)
+	return
+}
+
+func fixedColumns[T any](col []*vector.Vector) [][]T {
+	data := make([][]T, len(col))
+	for i, v := range col {
+		data[i] = v.Col.([]T)
+	}
+	return data
+}
+
+func bytesColumns(col []*vector.Vector) [][][]byte {
+	data := make([][][]byte, len(col))
+	for i, v := range col {
+		vs := v.Col.(*types.Bytes)
+		data[i] = make([][]byte, len(vs.Offsets))
+		for j := range data[i] {
+			data[i][j] = vs.Get(int64(j))
+		}
+	}
+	return data
+}
+
+// fixedVectors splits the merged rows into the blocks of toLayout without
+// copying them
+func fixedVectors[T any](typ types.Type, merged []T, toLayout []uint32) []*vector.Vector {
+	ret := make([]*vector.Vector, len(toLayout))
+	start := 0
+	for i := range toLayout {
+		end := start + int(toLayout[i])
+		ret[i] = vector.New(typ)
+		ret[i].Col = merged[start:end:end]
+		start = end
+	}
+	return ret
+}
+
+func packBytes(strs [][]byte) *types.Bytes {
+	var size int
+	for _, str := range strs {
+		size += len(str)
+	}
+	packed := &types.Bytes{
+		Data:    make([]byte, 0, size),
+		Offsets: make([]uint32, len(strs)),
+		Lengths: make([]uint32, len(strs)),
+	}
+	for j, str := range strs {
+		packed.Offsets[j] = uint32(len(packed.Data))
+		packed.Lengths[j] = uint32(len(str))
+		packed.Data = append(packed.Data, str...)
+	}
+	return packed
+}
+
+func bytesVectors(typ types.Type, merged [][]byte, toLayout []uint32) []*vector.Vector {
+	ret := make([]*vector.Vector, len(toLayout))
+	start := 0
+	for i := range toLayout {
+		end := start + int(toLayout[i])
+		ret[i] = vector.New(typ)
+		ret[i].Col = packBytes(merged[start:end])
+		start = end
+	}
+	return ret
+}
+
+func mergeFixed[T any](sched tasks.Scheduler, column []*vector.Vector, sortedIdx *[]uint32, fromLayout, toLayout []uint32, desc bool, less func(a, b T) bool, parts int) (ret []*vector.Vector, mapping []uint32, err error) {
+	merged, mapping, err := parallelMerge(sched, fixedColumns[T](column), sortedIdx, fromLayout, desc, less, parts)
+	if err != nil {
+		return
+	}
+	ret = fixedVectors(column[0].Typ, merged, toLayout)
+	return
+}
+
+// ParallelMergeSortedColumn is MergeSortedColumn run by the sort tasks of
+// sched, the result is the same. The column is merged by the caller if it
+// is too small to split.
+func ParallelMergeSortedColumn(sched tasks.Scheduler, column []*vector.Vector, sortedIdx *[]uint32, fromLayout, toLayout []uint32, desc bool) (ret []*vector.Vector, mapping []uint32, err error) {
+	parts := sortTasks(len(*sortedIdx))
+	if sched == nil || parts < 2 {
+		ret, mapping = MergeSortedColumn(column, sortedIdx, fromLayout, toLayout, desc)
+		return
+	}
+	switch column[0].Typ.Oid {
+	case types.T_bool:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessBool, parts)
+	case types.T_int8:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[int8], parts)
+	case types.T_int16:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[int16], parts)
+	case types.T_int32:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[int32], parts)
+	case types.T_int64:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[int64], parts)
+	case types.T_uint8:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[uint8], parts)
+	case types.T_uint16:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[uint16], parts)
+	case types.T_uint32:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[uint32], parts)
+	case types.T_uint64, types.T_bit:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[uint64], parts)
+	case types.T_float32:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[float32], parts)
+	case types.T_float64:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[float64], parts)
+	case types.T_date:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[types.Date], parts)
+	case types.T_datetime:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[types.Datetime], parts)
+	case types.T_decimal64:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[types.Decimal64], parts)
+	case types.T_decimal128:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessDecimal128, parts)
+	case types.T_timestamp:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[types.Timestamp], parts)
+	case types.T_time:
+		return mergeFixed(sched, column, sortedIdx, fromLayout, toLayout, desc, lessOrdered[types.Time], parts)
+	case types.T_char, types.T_json, types.T_varchar:
+		var merged [][]byte
+		if merged, mapping, err = parallelMerge(sched, bytesColumns(column), sortedIdx, fromLayout, desc, lessBytes, parts); err != nil {
+			return
+		}
+		ret = bytesVectors(column[0].Typ, merged, toLayout)
+		return
+	default:
+		panic(fmt.Sprintf("%s not supported", column[0].Typ.String()))
+	}
+}
+
+// multiplexRange fills the blocks [from, to) of toLayout, cursors are the
+// first rows of the blocks of col taken by them.
+func multiplexRange[T any](data [][]T, nsps []*roaring.Bitmap, src []uint32, toLayout []uint32, from, to, k int, cursors []int, merged [][]T, nulls []*roaring.Bitmap) {
+	for i := from; i < to; i++ {
+		merged[i] = make([]T, toLayout[i])
+		for j := range merged[i] {
+			s := src[k]
+			if nsps[s] != nil && nsps[s].Contains(uint64(cursors[s])) {
+				if nulls[i] == nil {
+					nulls[i] = roaring.New()
+				}
+				nulls[i].AddInt(j)
+			} else {
+				merged[i][j] = data[s][cursors[s]]
+			}
+			cursors[s]++
+			k++
+		}
+	}
+}
+
+// parallelMultiplex shuffles the blocks by parts sort tasks of the ranges of
+// the blocks of toLayout
+func parallelMultiplex[T any](sched tasks.Scheduler, data [][]T, nsps []*roaring.Bitmap, src []uint32, toLayout []uint32, parts int) (merged [][]T, nulls []*roaring.Bitmap, err error) {
+	merged = make([][]T, len(toLayout))
+	nulls = make([]*roaring.Bitmap, len(toLayout))
+	if parts > len(toLayout) {
+		parts = len(toLayout)
+	}
+	fns := make([]tasks.FuncT, 0, parts)
+	cursors := make([]int, len(data))
+	k := 0
+	for j := 0; j < parts; j++ {
+		from, to := j*len(toLayout)/parts, (j+1)*len(toLayout)/parts
+		start, starts := k, append([]int(nil), cursors...)
+		fns = append(fns, func() error {
+			multiplexRange(data, nsps, src, toLayout, from, to, start, starts, merged, nulls)
+			return nil
+		})
+		// the next range starts after the rows of this range
+		for i := from; i < to; i++ {
+			for _, s := range src[k : k+int(toLayout[i])] {
+				cursors[s]++
+			}
+			k += int(toLayout[i])
+		}
+	}
+	err = runTasks(sched, fns)
+	return
+}
+
+func multiplexFixed[T any](sched tasks.Scheduler, column []*vector.Vector, src []uint32, toLayout []uint32, parts int) (ret []*vector.Vector, err error) {
+	nsps := make([]*roaring.Bitmap, len(column))
+	for i, v := range column {
+		nsps[i] = v.Nsp.Np
+	}
+	merged, nulls, err := parallelMultiplex(sched, fixedColumns[T](column), nsps, src, toLayout, parts)
+	if err != nil {
+		return
+	}
+	ret = make([]*vector.Vector, len(toLayout))
+	for i := range ret {
+		ret[i] = vector.New(column[0].Typ)
+		ret[i].Col = merged[i]
+		ret[i].Nsp.Np = nulls[i]
+	}
+	return
+}
+
+// ParallelShuffleColumn is ShuffleColumn run by the sort tasks of sched
+// over the ranges of the blocks of toLayout.
+func ParallelShuffleColumn(sched tasks.Scheduler, column []*vector.Vector, sortedIdx []uint32, fromLayout, toLayout []uint32) (ret []*vector.Vector, err error) {
+	parts := sortTasks(len(sortedIdx))
+	if sched == nil || parts < 2 || len(toLayout) < 2 {
+		ret = ShuffleColumn(column, sortedIdx, fromLayout, toLayout)
+		return
+	}
+	switch column[0].Typ.Oid {
+	case types.T_bool:
+		return multiplexFixed[bool](sched, column, sortedIdx, toLayout, parts)
+	case types.T_int8:
+		return multiplexFixed[int8](sched, column, sortedIdx, toLayout, parts)
+	case types.T_int16:
+		return multiplexFixed[int16](sched, column, sortedIdx, toLayout, parts)
+	case types.T_int32:
+		return multiplexFixed[int32](sched, column, sortedIdx, toLayout, parts)
+	case types.T_int64:
+		return multiplexFixed[int64](sched, column, sortedIdx, toLayout, parts)
+	case types.T_uint8:
+		return multiplexFixed[uint8](sched, column, sortedIdx, toLayout, parts)
+	case types.T_uint16:
+		return multiplexFixed[uint16](sched, column, sortedIdx, toLayout, parts)
+	case types.T_uint32:
+		return multiplexFixed[uint32](sched, column, sortedIdx, toLayout, parts)
+	case types.T_uint64, types.T_bit:
+		return multiplexFixed[uint64](sched, column, sortedIdx, toLayout, parts)
+	case types.T_float32:
+		return multiplexFixed[float32](sched, column, sortedIdx, toLayout, parts)
+	case types.T_float64:
+		return multiplexFixed[float64](sched, column, sortedIdx, toLayout, parts)
+	case types.T_date:
+		return multiplexFixed[types.Date](sched, column, sortedIdx, toLayout, parts)
+	case types.T_datetime:
+		return multiplexFixed[types.Datetime](sched, column, sortedIdx, toLayout, parts)
+	case types.T_decimal64:
+		return multiplexFixed[types.Decimal64](sched, column, sortedIdx, toLayout, parts)
+	case types.T_decimal128:
+		return multiplexFixed[types.Decimal128](sched, column, sortedIdx, toLayout, parts)
+	case types.T_timestamp:
+		return multiplexFixed[types.Timestamp](sched, column, sortedIdx, toLayout, parts)
+	case types.T_time:
+		return multiplexFixed[types.Time](sched, column, sortedIdx, toLayout, parts)
+	case types.T_char, types.T_json, types.T_varchar:
+		nsps := make([]*roaring.Bitmap, len(column))
+		for i, v := range column {
+			nsps[i] = v.Nsp.Np
+		}
+		var merged [][][]byte
+		var nulls []*roaring.Bitmap
+		if merged, nulls, err = parallelMultiplex(sched, bytesColumns(column), nsps, sortedIdx, toLayout, parts); err != nil {
+			return
+		}
+		ret = make([]*vector.Vector, len(toLayout))
+		for i := range ret {
+			ret[i] = vector.New(column[0].Typ)
+			ret[i].Col = packBytes(merged[i])
+			ret[i].Nsp.Np = nulls[i]
+		}
+		return
+	default:
+		panic(fmt.Sprintf("%s not supported", column[0].Typ.String()))
+	}
+}
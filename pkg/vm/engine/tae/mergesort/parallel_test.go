@@ -0,0 +1,227 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	"github.com/stretchr/testify/require"
+)
+
+func newSortScheduler(t testing.TB, workers int) tasks.Scheduler {
+	sched := tasks.NewBaseScheduler("sort")
+	dispatcher := tasks.NewBaseDispatcher()
+	handler := tasks.NewPoolHandler(workers)
+	handler.Start()
+	dispatcher.RegisterHandler(tasks.SortTask, handler)
+	sched.RegisterDispatcher(tasks.SortTask, dispatcher)
+	sched.Start()
+	t.Cleanup(sched.Stop)
+	return sched
+}
+
+// newColumn returns n values of typ, v is a random value of a small range
+// so the blocks share many values
+func newColumn(typ types.Type, n int, nullable bool) *vector.Vector {
+	vec := vector.New(typ)
+	vs := make([]int64, n)
+	for i := range vs {
+		vs[i] = rand.Int63n(200) - 100
+	}
+	switch typ.Oid {
+	case types.T_bool:
+		vec.Col = convert(vs, func(v int64) bool { return v%2 == 0 })
+	case types.T_int8:
+		vec.Col = convert(vs, func(v int64) int8 { return int8(v) })
+	case types.T_int16:
+		vec.Col = convert(vs, func(v int64) int16 { return int16(v) })
+	case types.T_int32:
+		vec.Col = convert(vs, func(v int64) int32 { return int32(v) })
+	case types.T_int64:
+		vec.Col = vs
+	case types.T_uint8:
+		vec.Col = convert(vs, func(v int64) uint8 { return uint8(v + 100) })
+	case types.T_uint16:
+		vec.Col = convert(vs, func(v int64) uint16 { return uint16(v + 100) })
+	case types.T_uint32:
+		vec.Col = convert(vs, func(v int64) uint32 { return uint32(v + 100) })
+	case types.T_uint64:
+		vec.Col = convert(vs, func(v int64) uint64 { return uint64(v + 100) })
+	case types.T_float32:
+		vec.Col = convert(vs, func(v int64) float32 { return float32(v) / 7 })
+	case types.T_float64:
+		vec.Col = convert(vs, func(v int64) float64 { return float64(v) / 7 })
+	case types.T_date:
+		vec.Col = convert(vs, func(v int64) types.Date { return types.Date(v) })
+	case types.T_datetime:
+		vec.Col = convert(vs, func(v int64) types.Datetime { return types.Datetime(v) })
+	case types.T_timestamp:
+		vec.Col = convert(vs, func(v int64) types.Timestamp { return types.Timestamp(v) })
+	case types.T_time:
+		vec.Col = convert(vs, func(v int64) types.Time { return types.Time(v) })
+	case types.T_decimal64:
+		vec.Col = convert(vs, func(v int64) types.Decimal64 { return types.Decimal64(v) })
+	case types.T_decimal128:
+		vec.Col = convert(vs, func(v int64) types.Decimal128 { return types.Decimal128{Lo: v, Hi: v % 3} })
+	case types.T_varchar:
+		vec.Col = packBytes(convert(vs, func(v int64) []byte { return []byte(fmt.Sprintf("s%d", v)) }))
+	}
+	if nullable {
+		vec.Nsp.Np = roaring.New()
+		for i := 0; i < n; i += 5 {
+			vec.Nsp.Np.AddInt(i)
+		}
+	}
+	return vec
+}
+
+func convert[T any](vs []int64, fn func(int64) T) []T {
+	ret := make([]T, len(vs))
+	for i, v := range vs {
+		ret[i] = fn(v)
+	}
+	return ret
+}
+
+// newSortedBlocks returns the sorted blocks of random sizes and the layout
+// of the blocks to merge them into
+func newSortedBlocks(t *testing.T, typ types.Type, desc bool) (blocks []*vector.Vector, fromLayout, toLayout []uint32) {
+	var rows uint32
+	for i := 0; i < 8; i++ {
+		n := 1 + rand.Intn(500)
+		vec := newColumn(typ, n, false)
+		require.NoError(t, SortBlockColumns([]*vector.Vector{vec}, 0, desc))
+		blocks = append(blocks, vec)
+		fromLayout = append(fromLayout, uint32(n))
+		rows += uint32(n)
+	}
+	for rows > 0 {
+		n := uint32(300)
+		if n > rows {
+			n = rows
+		}
+		toLayout = append(toLayout, n)
+		rows -= n
+	}
+	return
+}
+
+func nullRows(vec *vector.Vector) []uint64 {
+	if vec.Nsp.Np == nil || vec.Nsp.Np.IsEmpty() {
+		return nil
+	}
+	return vec.Nsp.Np.ToArray()
+}
+
+var mergeTypes = []types.Type{
+	{Oid: types.T_bool},
+	{Oid: types.T_int8},
+	{Oid: types.T_int16},
+	{Oid: types.T_int32},
+	{Oid: types.T_int64},
+	{Oid: types.T_uint8},
+	{Oid: types.T_uint16},
+	{Oid: types.T_uint32},
+	{Oid: types.T_uint64},
+	{Oid: types.T_float32},
+	{Oid: types.T_float64},
+	{Oid: types.T_date},
+	{Oid: types.T_datetime},
+	{Oid: types.T_timestamp},
+	{Oid: types.T_time},
+	{Oid: types.T_decimal64},
+	{Oid: types.T_decimal128},
+	{Oid: types.T_varchar},
+}
+
+func TestParallelMerge(t *testing.T) {
+	defer func(rows int) { RowsPerSortTask = rows }(RowsPerSortTask)
+	RowsPerSortTask = 64
+	sched := newSortScheduler(t, 4)
+	for _, typ := range mergeTypes {
+		for _, desc := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/desc=%v", typ.String(), desc), func(t *testing.T) {
+				blocks, fromLayout, toLayout := newSortedBlocks(t, typ, desc)
+				var rows uint32
+				for _, n := range fromLayout {
+					rows += n
+				}
+				expectIdx := make([]uint32, rows)
+				expect, expectMapping := MergeSortedColumn(blocks, &expectIdx, fromLayout, toLayout, desc)
+				sortedIdx := make([]uint32, rows)
+				merged, mapping, err := ParallelMergeSortedColumn(sched, blocks, &sortedIdx, fromLayout, toLayout, desc)
+				require.NoError(t, err)
+				require.Equal(t, len(expect), len(merged))
+				for i := range expect {
+					require.Equal(t, expect[i].Col, merged[i].Col)
+				}
+				require.Equal(t, expectIdx, sortedIdx)
+				require.Equal(t, expectMapping, mapping)
+
+				// the other columns are shuffled by the merged sort key
+				cols := make([]*vector.Vector, len(fromLayout))
+				for i, n := range fromLayout {
+					cols[i] = newColumn(typ, int(n), true)
+				}
+				expect = ShuffleColumn(cols, sortedIdx, fromLayout, toLayout)
+				shuffled, err := ParallelShuffleColumn(sched, cols, sortedIdx, fromLayout, toLayout)
+				require.NoError(t, err)
+				require.Equal(t, len(expect), len(shuffled))
+				for i := range expect {
+					require.Equal(t, expect[i].Col, shuffled[i].Col)
+					require.Equal(t, nullRows(expect[i]), nullRows(shuffled[i]))
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkParallelMerge(b *testing.B) {
+	typ := types.Type{Oid: types.T_int64}
+	blocks := make([]*vector.Vector, 8)
+	fromLayout := make([]uint32, len(blocks))
+	var toLayout []uint32
+	for i := range blocks {
+		vs := make([]int64, 1000000)
+		for j := range vs {
+			vs[j] = rand.Int63()
+		}
+		blocks[i] = vector.New(typ)
+		blocks[i].Col = vs
+		if err := SortBlockColumns([]*vector.Vector{blocks[i]}, 0, false); err != nil {
+			b.Fatal(err)
+		}
+		fromLayout[i] = uint32(len(vs))
+		toLayout = append(toLayout, uint32(len(vs)))
+	}
+	sortedIdx := make([]uint32, 8000000)
+	for _, workers := range []int{1, 2, 4} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			sched := newSortScheduler(b, workers)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := ParallelMergeSortedColumn(sched, blocks, &sortedIdx, fromLayout, toLayout, false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
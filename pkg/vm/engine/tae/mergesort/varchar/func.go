@@ -21,7 +21,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 )
 
-func Sort(col *vector.Vector, idx []uint32) {
+// Sort sorts the column in place and stores the original position of every
+// row in idx. A descending sort is the ascending one read backwards.
+func Sort(col *vector.Vector, idx []uint32, desc bool) {
 	data := col.Col.(*types.Bytes)
 	n := len(idx)
 	dataWithIdx := make(sortSlice, n)
@@ -38,7 +40,9 @@ func Sort(col *vector.Vector, idx []uint32) {
 
 	var offset uint32
 
-	for i, v := range dataWithIdx {
+	first, step := direction(n, desc)
+	for i := range dataWithIdx {
+		v := dataWithIdx[first+step*i]
 		copy(newData[offset:], v.data)
 		newOffsets[i] = offset
 		l := uint32(len(v.data))
@@ -110,7 +114,11 @@ func shuffleNullableBlock(col *vector.Vector, idx []uint32) {
 	col.Nsp.Np = newNulls
 }
 
-func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32) (ret []*vector.Vector, mapping []uint32) {
+// Merge merges the sorted blocks of col into blocks of toLayout rows, src
+// stores the source block of every merged row and mapping the merged
+// position of every source row. The blocks of a descending merge are read
+// backwards, merged in ascending order and written backwards.
+func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32, desc bool) (ret []*vector.Vector, mapping []uint32) {
 	data := make([]*types.Bytes, len(col))
 	ret = make([]*vector.Vector, len(toLayout))
 	mapping = make([]uint32, len(*src))
@@ -127,60 +135,48 @@ func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32) (
 
 	from := len(fromLayout)
 	to := len(toLayout)
-	maxTo := uint32(0)
-	for _, i := range toLayout {
-		if i > maxTo {
-			maxTo = i
-		}
-	}
 	heap := make(heapSlice, from)
-	strings := make([][]byte, maxTo)
-	merged := make([]*types.Bytes, to)
+	first := make([]int, from)
+	step := 1
 
 	for i := 0; i < from; i++ {
-		heap[i] = heapElem{data: data[i].Get(0), src: uint32(i), next: 1}
+		first[i], step = direction(int(fromLayout[i]), desc)
+		heap[i] = heapElem{data: data[i].Get(int64(first[i])), src: uint32(i), next: 1}
 	}
 	heapInit(heap)
 
-	k := 0
-	var offset uint32
-	for i := 0; i < to; i++ {
-		offset = 0
-		for j := 0; j < int(toLayout[i]); j++ {
-			top := heapPop(&heap)
-			offset += uint32(len(top.data))
-			strings[j] = top.data
-			(*src)[k] = top.src
-			mapping[colOffset[top.src]+top.next-1] = uint32(k)
-			k++
-			if int(top.next) < int(fromLayout[top.src]) {
-				heapPush(&heap, heapElem{data: data[top.src].Get(int64(top.next)), src: top.src, next: top.next + 1})
-			}
-		}
-
-		newData := make([]byte, offset)
-		newOffsets := make([]uint32, toLayout[i])
-		newLengths := make([]uint32, toLayout[i])
-		offset = 0
-
-		for j := 0; j < int(toLayout[i]); j++ {
-			newOffsets[j] = offset
-			l := uint32(len(strings[j]))
-			newLengths[j] = l
-			copy(newData[offset:], strings[j])
-			offset += l
-		}
-
-		merged[i] = &types.Bytes{
-			Data:    newData,
-			Offsets: newOffsets,
-			Lengths: newLengths,
+	strings := make([][]byte, len(*src))
+	last, _ := direction(len(strings), desc)
+	for k := range strings {
+		top := heapPop(&heap)
+		pos := last + step*k
+		strings[pos], (*src)[pos] = top.data, top.src
+		mapping[colOffset[top.src]+uint32(first[top.src]+step*int(top.next-1))] = uint32(pos)
+		if int(top.next) < int(fromLayout[top.src]) {
+			heapPush(&heap, heapElem{data: data[top.src].Get(int64(first[top.src] + step*int(top.next))), src: top.src, next: top.next + 1})
 		}
 	}
 
+	start := 0
 	for i := 0; i < to; i++ {
+		end := start + int(toLayout[i])
+		var size int
+		for _, str := range strings[start:end] {
+			size += len(str)
+		}
+		merged := &types.Bytes{
+			Data:    make([]byte, 0, size),
+			Offsets: make([]uint32, toLayout[i]),
+			Lengths: make([]uint32, toLayout[i]),
+		}
+		for j, str := range strings[start:end] {
+			merged.Offsets[j] = uint32(len(merged.Data))
+			merged.Lengths[j] = uint32(len(str))
+			merged.Data = append(merged.Data, str...)
+		}
 		ret[i] = vector.New(col[0].Typ)
-		ret[i].Col = merged[i]
+		ret[i].Col = merged
+		start = end
 	}
 	return
 }
@@ -378,8 +374,7 @@ func multiplexNullableBlocks(col []*vector.Vector, src []uint32, fromLayout, toL
 					nextNulls[s] = -1
 				}
 			} else {
-				d, _ := data[s], cursors[s]
-				strings[j] = d.Get(int64(s))
+				strings[j] = data[s].Get(int64(cursors[s]))
 				offset += uint32(len(strings[j]))
 			}
 
@@ -405,6 +400,7 @@ func multiplexNullableBlocks(col []*vector.Vector, src []uint32, fromLayout, toL
 		}
 	}
 
+	ret = make([]*vector.Vector, to)
 	for i := 0; i < to; i++ {
 		ret[i] = vector.New(col[0].Typ)
 		ret[i].Col = merged[i]
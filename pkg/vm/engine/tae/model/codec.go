@@ -7,6 +7,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/encoding/rowcodec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 )
 
@@ -17,7 +18,7 @@ func init() {
 	CompoundKeyType.Width = 100
 }
 
-type CompoundKeyEncoder = func(*bytes.Buffer, ...any) []byte
+type CompoundKeyEncoder = func(*bytes.Buffer, []types.Type, ...any) []byte
 
 // [48 Bit (BlockID) + 48 Bit (SegmentID)]
 func EncodeBlockKeyPrefix(segmentId, blockId uint64) []byte {
@@ -66,55 +67,36 @@ func DecodeHiddenKey(src []byte) (segmentId, blockId uint64, offset uint32) {
 	return
 }
 
-func EncodeTypedVals(w *bytes.Buffer, vals ...any) []byte {
+// EncodeTypedVals encodes the values of a compound key by the canonical row
+// encoding, the value at i is of the type at i. The encoded keys compare like
+// the values column by column.
+func EncodeTypedVals(w *bytes.Buffer, typs []types.Type, vals ...any) []byte {
 	if w == nil {
 		w = new(bytes.Buffer)
 	} else {
 		w.Reset()
 	}
-	for _, val := range vals {
-		switch v := val.(type) {
-		case int8:
-			_, _ = w.Write(encoding.EncodeInt8(v))
-		case int16:
-			_, _ = w.Write(encoding.EncodeInt16(v))
-		case int32:
-			_, _ = w.Write(encoding.EncodeInt32(v))
-		case int64:
-			_, _ = w.Write(encoding.EncodeInt64(v))
-		case uint8:
-			_, _ = w.Write(encoding.EncodeUint8(v))
-		case uint16:
-			_, _ = w.Write(encoding.EncodeUint16(v))
-		case uint32:
-			_, _ = w.Write(encoding.EncodeUint32(v))
-		case uint64:
-			_, _ = w.Write(encoding.EncodeUint64(v))
-		case types.Decimal64:
-			_, _ = w.Write(encoding.EncodeDecimal64(v))
-		case types.Decimal128:
-			_, _ = w.Write(encoding.EncodeDecimal128(v))
-		case float32:
-			_, _ = w.Write(encoding.EncodeFloat32(v))
-		case float64:
-			_, _ = w.Write(encoding.EncodeFloat64(v))
-		case types.Date:
-			_, _ = w.Write(encoding.EncodeDate(v))
-		case types.Datetime:
-			_, _ = w.Write(encoding.EncodeDatetime(v))
-		case []byte:
-			_, _ = w.Write(v)
-		}
-	}
+	// encoded in the space of w if it is large enough
+	key := rowcodec.EncodeRow(w.Bytes(), typs, vals)
+	_, _ = w.Write(key)
 	return w.Bytes()
 }
 
+// DecodeLeadingVal decodes the value of the first column of an encoded
+// compound key, ok is false if the key is corrupted
+func DecodeLeadingVal(key []byte, typ types.Type) (v any, ok bool) {
+	v, _, err := rowcodec.DecodeValue(key, typ)
+	return v, err == nil && v != nil
+}
+
 func EncodeTuple(w *bytes.Buffer, row uint32, cols ...*movec.Vector) []byte {
 	vs := make([]any, len(cols))
+	typs := make([]types.Type, len(cols))
 	for i := range vs {
 		vs[i] = compute.GetValue(cols[i], row)
+		typs[i] = cols[i].Typ
 	}
-	return EncodeTypedVals(w, vs...)
+	return EncodeTypedVals(w, typs, vs...)
 }
 
 // TODO: use buffer pool for cc
@@ -126,12 +108,16 @@ func EncodeCompoundColumn(cols ...*movec.Vector) (cc *movec.Vector) {
 	cc = movec.New(CompoundKeyType)
 	var buf bytes.Buffer
 	vs := make([]any, len(cols))
+	typs := make([]types.Type, len(cols))
+	for i := range cols {
+		typs[i] = cols[i].Typ
+	}
 	for row := 0; row < movec.Length(cols[0]); row++ {
 		buf.Reset()
 		for i := range vs {
 			vs[i] = compute.GetValue(cols[i], uint32(row))
 		}
-		v := EncodeTypedVals(&buf, vs...)
+		v := EncodeTypedVals(&buf, typs, vs...)
 		compute.AppendValue(cc, v)
 	}
 	return cc
@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeCompoundColumn(t *testing.T) {
+	// the rows are in the order of the columns
+	c0 := movec.New(types.T_int32.ToType())
+	c1 := movec.New(types.T_varchar.ToType())
+	for _, row := range []struct {
+		i int32
+		s string
+	}{{-300, "b"}, {-1, ""}, {-1, "a"}, {-1, "a\x00"}, {0, "a"}, {1, "a"}, {256, "a"}} {
+		compute.AppendValue(c0, row.i)
+		compute.AppendValue(c1, []byte(row.s))
+	}
+	cc := EncodeCompoundColumn(c0, c1)
+	assert.Equal(t, CompoundKeyType.Oid, cc.Typ.Oid)
+	for row := 1; row < movec.Length(cc); row++ {
+		prev := compute.GetValue(cc, uint32(row-1)).([]byte)
+		cur := compute.GetValue(cc, uint32(row)).([]byte)
+		assert.Less(t, bytes.Compare(prev, cur), 0)
+		assert.Equal(t, cur, EncodeTuple(nil, uint32(row), c0, c1))
+	}
+
+	// the leading value is decoded for the zonemap of the leading column
+	key := EncodeTuple(nil, 0, c1, c0)
+	v, ok := DecodeLeadingVal(key, c1.Typ)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("b"), v)
+	_, ok = DecodeLeadingVal(key[:2], c1.Typ)
+	assert.False(t, ok)
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
@@ -34,8 +35,13 @@ func (blk *txnBlock) Read(cs []uint64, attrs []string, compressed []*bytes.Buffe
 	var err error
 	bat := batch.New(true, attrs)
 	bat.Vecs = make([]*vector.Vector, len(attrs))
+	schema := blk.handle.GetMeta().(*catalog.BlockEntry).GetSchema()
 	for i, attr := range attrs {
-		view, err = blk.handle.GetColumnDataByName(attr, compressed[i], deCompressed[i])
+		if isCommitTSAttr(schema, attr) {
+			view, err = blk.handle.GetCommitTSColumn()
+		} else {
+			view, err = blk.handle.GetColumnDataByName(attr, compressed[i], deCompressed[i])
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -15,18 +15,25 @@
 package moengine
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 var (
-	_ engine.Database = (*txnDatabase)(nil)
+	_ engine.Database         = (*txnDatabase)(nil)
+	_ engine.DefaultsDatabase = (*txnDatabase)(nil)
 )
 
-func newDatabase(h handle.Database) *txnDatabase {
+func newDatabase(h handle.Database, readRetries int, filterExpired, verifyChecksum bool, statsStaleness time.Duration) *txnDatabase {
 	return &txnDatabase{
-		handle: h,
+		handle:         h,
+		readRetries:    readRetries,
+		filterExpired:  filterExpired,
+		verifyChecksum: verifyChecksum,
+		statsStaleness: statsStaleness,
 	}
 }
 
@@ -39,12 +46,17 @@ func (db *txnDatabase) Relations(_ engine.Snapshot) (names []string) {
 	return
 }
 
+func (db *txnDatabase) Defaults() engine.DatabaseDefaults {
+	charset, collation := db.handle.GetMeta().(*catalog.DBEntry).GetDefaults()
+	return engine.DatabaseDefaults{Charset: charset, Collation: collation}
+}
+
 func (db *txnDatabase) Relation(name string, _ engine.Snapshot) (rel engine.Relation, err error) {
 	h, err := db.handle.GetRelationByName(name)
 	if err != nil {
 		return
 	}
-	rel = newRelation(h)
+	rel = newRelation(h, db.readRetries, db.filterExpired, db.verifyChecksum, db.statsStaleness)
 	return
 }
 
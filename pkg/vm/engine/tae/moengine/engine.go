@@ -16,14 +16,17 @@ package moengine
 
 import (
 	"runtime"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var (
-	_ engine.Engine = (*txnEngine)(nil)
+	_ engine.Engine         = (*txnEngine)(nil)
+	_ engine.DefaultsEngine = (*txnEngine)(nil)
 )
 
 func NewEngine(impl *db.DB) *txnEngine {
@@ -50,6 +53,19 @@ func (e *txnEngine) Create(_ uint64, name string, _ int, ctx engine.Snapshot) (e
 	return
 }
 
+func (e *txnEngine) CreateWithDefaults(_ uint64, name string, _ int, defaults engine.DatabaseDefaults, ctx engine.Snapshot) (err error) {
+	var txn txnif.AsyncTxn
+	if txn, err = e.impl.GetTxnByCtx(ctx); err != nil {
+		panic(err)
+	}
+	h, err := txn.CreateDatabase(name)
+	if err != nil {
+		return
+	}
+	h.GetMeta().(*catalog.DBEntry).SetDefaults(defaults.Charset, defaults.Collation)
+	return
+}
+
 func (e *txnEngine) Databases(ctx engine.Snapshot) (dbs []string) {
 	var err error
 	var txn txnif.AsyncTxn
@@ -68,7 +84,9 @@ func (e *txnEngine) Database(name string, ctx engine.Snapshot) (db engine.Databa
 	if err != nil {
 		return nil, err
 	}
-	db = newDatabase(h)
+	db = newDatabase(h, e.impl.Opts.ReaderCfg.ReadRetries, e.impl.Opts.ReaderCfg.FilterExpired,
+		e.impl.Opts.ReaderCfg.VerifyChecksum,
+		time.Duration(e.impl.Opts.StatsCfg.MaxStaleness)*time.Millisecond)
 	return db, err
 }
 
@@ -79,3 +97,11 @@ func (e *txnEngine) Node(ip string, _ engine.Snapshot) *engine.NodeInfo {
 func (e *txnEngine) StartTxn(info []byte) (txn Txn, err error) {
 	return e.impl.StartTxn(info)
 }
+
+func (e *txnEngine) Compact(dbName, tableName string, blockID uint64) (int, error) {
+	return e.impl.CompactTable(dbName, tableName, blockID)
+}
+
+func (e *txnEngine) ClearPoison(dbName, tableName string) (int, error) {
+	return e.impl.ClearPoisonedTasks(dbName, tableName)
+}
@@ -1,16 +1,27 @@
 package moengine
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"errors"
 	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -169,3 +180,779 @@ func TestTxnRelation_GetHideKey(t *testing.T) {
 	}
 	t.Log(tae.Catalog.SimplePPString(common.PPL1))
 }
+
+// flakyReader fails the reads in fails before the block is read
+type flakyReader struct {
+	ResumableReader
+	calls int
+	fails map[int]bool
+}
+
+func (r *flakyReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
+	r.calls++
+	if r.fails[r.calls] {
+		return nil, errors.New("injected block read failure")
+	}
+	return r.ResumableReader.Read(refCount, attrs)
+}
+
+func TestRetryReader(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Append(catalog.MockData(schema, 55)))
+	assert.Nil(t, txn.Commit())
+
+	e := NewEngine(tae)
+	rtxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", rtxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, rtxn.GetCtx())
+	assert.Nil(t, err)
+	trel := rel.(*txnRelation)
+	pk := schema.ColDefs[2].Name
+
+	scan := func(reader engine.Reader, seen map[int32]int) (err error) {
+		for {
+			bat, err := reader.Read([]uint64{1}, []string{pk})
+			if err != nil || bat == nil {
+				return err
+			}
+			for _, v := range bat.Vecs[0].Col.([]int32) {
+				seen[v]++
+			}
+		}
+	}
+	checkOnce := func(seen map[int32]int, rows int) {
+		assert.Equal(t, rows, len(seen))
+		for _, cnt := range seen {
+			assert.Equal(t, 1, cnt)
+		}
+	}
+
+	// every other reopened reader fails its first read
+	opened := 0
+	reopen := func(shard, num int) func(*ReadPosition) (ResumableReader, error) {
+		return func(pos *ReadPosition) (ResumableReader, error) {
+			reader, err := trel.ResumeReader(shard, num, pos)
+			if err != nil {
+				return nil, err
+			}
+			opened++
+			return &flakyReader{ResumableReader: reader, fails: map[int]bool{1: opened%2 == 1}}, nil
+		}
+	}
+	seen := make(map[int32]int)
+	for i := 0; i < 2; i++ {
+		reader := &flakyReader{
+			ResumableReader: newReader(trel.handle, trel.shardBlocks(i, 2), false, false, false),
+			fails:           map[int]bool{1: true},
+		}
+		assert.Nil(t, scan(newRetryReader(reader, reopen(i, 2), 3), seen))
+	}
+	checkOnce(seen, 55)
+	assert.Equal(t, 4, opened)
+
+	// the retries are exhausted
+	reader := &flakyReader{
+		ResumableReader: newReader(trel.handle, trel.shardBlocks(0, 1), false, false, false),
+		fails:           map[int]bool{2: true},
+	}
+	failed := func(*ReadPosition) (ResumableReader, error) {
+		return nil, errors.New("injected reopen failure")
+	}
+	assert.NotNil(t, scan(newRetryReader(reader, failed, 2), make(map[int32]int)))
+
+	// resume from the middle of a block
+	first := trel.shardBlocks(0, 1)[0].Fingerprint()
+	resumed, err := trel.ResumeReader(0, 1, &ReadPosition{
+		SegmentID: first.SegmentID,
+		BlockID:   first.BlockID,
+		Offset:    3,
+	})
+	assert.Nil(t, err)
+	seen = make(map[int32]int)
+	assert.Nil(t, scan(resumed, seen))
+	checkOnce(seen, 52)
+	_, err = trel.ResumeReader(0, 1, &ReadPosition{SegmentID: first.SegmentID, BlockID: 1 << 40})
+	assert.NotNil(t, err)
+
+	// the resumed scan keeps the snapshot of the txn
+	reader = &flakyReader{
+		ResumableReader: newReader(trel.handle, trel.shardBlocks(0, 1), false, false, false),
+		fails:           map[int]bool{2: true},
+	}
+	retry := newRetryReader(reader, reopen(0, 1), 3)
+	seen = make(map[int32]int)
+	bat, err := retry.Read([]uint64{1}, []string{pk})
+	assert.Nil(t, err)
+	for _, v := range bat.Vecs[0].Col.([]int32) {
+		seen[v]++
+	}
+	txn2, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err = txn2.GetDatabase("db")
+	assert.Nil(t, err)
+	h, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	more := compute.SplitBatch(catalog.MockData(schema, 65), 13)
+	assert.Nil(t, h.Append(more[11]))
+	assert.Nil(t, h.Append(more[12]))
+	assert.Nil(t, txn2.Commit())
+	assert.Nil(t, scan(retry, seen))
+	checkOnce(seen, 55)
+	assert.Nil(t, rtxn.Commit())
+}
+
+func TestFilterExpired(t *testing.T) {
+	opts := new(options.Options)
+	opts.ReaderCfg = &options.ReaderCfg{FilterExpired: true}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	schema := catalog.NewEmptySchema("ttl")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int32.ToType(), 0))
+	assert.NoError(t, schema.AppendCol("ts", types.T_datetime.ToType()))
+	schema.TTLColumn = "ts"
+	schema.TTL = 60 * 60
+	assert.NoError(t, schema.Finalize(false))
+	defs, err := SchemaToDefs(schema)
+	assert.NoError(t, err)
+	assert.Nil(t, dbase.Create(0, schema.Name, defs, txn.GetCtx()))
+	rel, err := dbase.Relation(schema.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	created := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	assert.Equal(t, "ts", created.TTLColumn)
+	assert.Equal(t, uint64(60*60), created.TTL)
+
+	// every third row is expired
+	now := time.Now()
+	expired := now.Add(-2 * time.Hour)
+	bat := catalog.MockData(schema, 25)
+	ts := make([]types.Datetime, 25)
+	for i := range ts {
+		v := now
+		if i%3 == 0 {
+			v = expired
+		}
+		ts[i] = types.FromClock(int32(v.Year()), uint8(v.Month()), uint8(v.Day()),
+			uint8(v.Hour()), uint8(v.Minute()), uint8(v.Second()), 0)
+	}
+	bat.Vecs[1].Col = ts
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	scan := func() (keys []int32) {
+		txn, err := e.StartTxn(nil)
+		assert.Nil(t, err)
+		dbase, err := e.Database("db", txn.GetCtx())
+		assert.Nil(t, err)
+		rel, err := dbase.Relation(schema.Name, txn.GetCtx())
+		assert.Nil(t, err)
+		for _, reader := range rel.NewReader(2, nil, nil, nil) {
+			for {
+				bat, err := reader.Read([]uint64{1, 1}, []string{"k", "ts"})
+				assert.Nil(t, err)
+				if bat == nil {
+					break
+				}
+				assert.Equal(t, len(bat.Zs), vector.Length(bat.Vecs[1]))
+				keys = append(keys, bat.Vecs[0].Col.([]int32)...)
+			}
+		}
+		assert.Nil(t, txn.Commit())
+		return
+	}
+	keys := scan()
+	assert.Equal(t, 16, len(keys))
+	for _, k := range keys {
+		assert.NotEqual(t, int32(0), k%3)
+	}
+
+	// the expired rows are read if they are not filtered
+	tae.Opts.ReaderCfg.FilterExpired = false
+	assert.Equal(t, 25, len(scan()))
+}
+
+func TestSparseFilter(t *testing.T) {
+	for _, retries := range []int{0, 1} {
+		opts := new(options.Options)
+		opts.ReaderCfg = &options.ReaderCfg{ReadRetries: retries}
+		tae := initDB(t, opts)
+		schema := catalog.MockCompoundSchema(3, 0, 1)
+		schema.BlockMaxRows = 10
+		// the leading key of the i-th block is i
+		bat := catalog.MockData(schema, 40)
+		c0 := make([]int32, 40)
+		c1 := make([]int32, 40)
+		for i := range c0 {
+			c0[i] = int32(i / 10)
+			c1[i] = int32(i % 10)
+		}
+		vector.SetCol(bat.Vecs[0], c0)
+		vector.SetCol(bat.Vecs[1], c1)
+		txn, err := tae.StartTxn(nil)
+		assert.Nil(t, err)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		h, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, h.Append(bat))
+		assert.Nil(t, txn.Commit())
+
+		txn, err = tae.StartTxn(nil)
+		assert.Nil(t, err)
+		database, err = txn.GetDatabase("db")
+		assert.Nil(t, err)
+		h, err = database.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		var metas []*catalog.BlockEntry
+		for it := h.MakeBlockIt(); it.Valid(); it.Next() {
+			metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+		}
+		assert.Nil(t, txn.Commit())
+		for _, meta := range metas {
+			txn, err = tae.StartTxn(nil)
+			assert.Nil(t, err)
+			task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+			assert.Nil(t, err)
+			assert.Nil(t, task.OnExec())
+			assert.Nil(t, txn.Commit())
+		}
+
+		e := NewEngine(tae)
+		rtxn, err := e.StartTxn(nil)
+		assert.Nil(t, err)
+		dbase, err := e.Database("db", rtxn.GetCtx())
+		assert.Nil(t, err)
+		rel, err := dbase.Relation(schema.Name, rtxn.GetCtx())
+		assert.Nil(t, err)
+		table := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry)
+		newReader := func() PrunableReader {
+			return rel.NewReader(1, nil, nil, nil)[0].(PrunableReader)
+		}
+		read := func(reader engine.Reader) (keys []int32) {
+			for {
+				bat, err := reader.Read([]uint64{1}, []string{"mock_0"})
+				assert.Nil(t, err)
+				if bat == nil {
+					return
+				}
+				keys = append(keys, bat.Vecs[0].Col.([]int32)...)
+			}
+		}
+
+		// prefix predicates
+		pruned := table.GetPrunedBlocks()
+		reader, err := newReader().NewSparseFilter().Eq("mock_0", int32(2))
+		assert.Nil(t, err)
+		keys := read(reader)
+		assert.Equal(t, 10, len(keys))
+		for _, k := range keys {
+			assert.Equal(t, int32(2), k)
+		}
+		assert.Equal(t, uint64(3), table.GetPrunedBlocks()-pruned)
+
+		pruned = table.GetPrunedBlocks()
+		// the strict bound keeps the block of which it is the max
+		reader, err = newReader().NewSparseFilter().Gt("mock_0", int32(2))
+		assert.Nil(t, err)
+		assert.Equal(t, 20, len(read(reader)))
+		assert.Equal(t, uint64(2), table.GetPrunedBlocks()-pruned)
+
+		// the conjunction skips the blocks skipped by any predicate
+		pruned = table.GetPrunedBlocks()
+		reader, err = newReader().NewSparseFilter().Ge("mock_0", int32(1))
+		assert.Nil(t, err)
+		reader, err = reader.(PrunableReader).NewSparseFilter().Btw("mock_1", int32(3), int32(5))
+		assert.Nil(t, err)
+		reader, err = reader.(PrunableReader).NewSparseFilter().Le("mock_0", int32(2))
+		assert.Nil(t, err)
+		assert.Equal(t, 20, len(read(reader)))
+		assert.Equal(t, uint64(2), table.GetPrunedBlocks()-pruned)
+
+		// non-prefix predicates skip no block holding the matched rows
+		pruned = table.GetPrunedBlocks()
+		reader, err = newReader().NewSparseFilter().Eq("mock_1", int32(3))
+		assert.Nil(t, err)
+		assert.Equal(t, 40, len(read(reader)))
+		reader, err = newReader().NewSparseFilter().Btw("mock_2", int32(100), int32(200))
+		assert.Nil(t, err)
+		assert.Equal(t, 40, len(read(reader)))
+		reader, err = newReader().NewSparseFilter().Ne("mock_0", int32(2))
+		assert.Nil(t, err)
+		assert.Equal(t, 40, len(read(reader)))
+		assert.Equal(t, uint64(0), table.GetPrunedBlocks()-pruned)
+
+		_, err = newReader().NewSparseFilter().Eq("missing", int32(1))
+		assert.NotNil(t, err)
+		assert.Nil(t, rtxn.Commit())
+		tae.Close()
+	}
+}
+
+func TestAccessPaths(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3, 0)
+	schema.BlockMaxRows = 10
+	bat := catalog.MockData(schema, 40)
+	c0 := make([]int32, 40)
+	c1 := make([]int32, 40)
+	for i := range c0 {
+		c0[i] = int32(i)
+		c1[i] = int32(i % 10)
+	}
+	vector.SetCol(bat.Vecs[0], c0)
+	vector.SetCol(bat.Vecs[1], c1)
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	h, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	var metas []*catalog.BlockEntry
+	for it := h.MakeBlockIt(); it.Valid(); it.Next() {
+		metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+	}
+	assert.Nil(t, txn.Commit())
+	for _, meta := range metas {
+		txn, err = tae.StartTxn(nil)
+		assert.Nil(t, err)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+
+	e := NewEngine(tae)
+	rtxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", rtxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, rtxn.GetCtx())
+	assert.Nil(t, err)
+	prel := rel.(engine.PathRelation)
+
+	// the sorted primary key is pruned by the zonemaps, the other column is not
+	costs := make(map[engine.AccessPath]engine.PathEstimate)
+	for _, est := range prel.AccessPaths("mock_0", int32(25)) {
+		costs[est.Path] = est
+	}
+	assert.Equal(t, engine.PathEstimate{Path: engine.FullScan, Blocks: 4, Rows: 40}, costs[engine.FullScan])
+	assert.Equal(t, engine.PathEstimate{Path: engine.ZonemapScan, Probes: 4, Blocks: 1, Rows: 10}, costs[engine.ZonemapScan])
+	assert.Equal(t, engine.PathEstimate{Path: engine.PrimaryKeyLookup, Probes: 2, Blocks: 1, Rows: 10}, costs[engine.PrimaryKeyLookup])
+	costs = make(map[engine.AccessPath]engine.PathEstimate)
+	for _, est := range prel.AccessPaths("mock_1", int32(5)) {
+		costs[est.Path] = est
+	}
+	assert.Equal(t, 2, len(costs))
+	assert.Equal(t, int64(4), costs[engine.ZonemapScan].Blocks)
+	assert.Nil(t, prel.AccessPaths("missing", int32(5)))
+
+	// the ranges are pruned by the zonemaps as the equalities, without lookup
+	rprel := rel.(engine.RangePathRelation)
+	ests := rprel.RangeAccessPaths("mock_0", int32(12), int32(25))
+	assert.Equal(t, []engine.PathEstimate{
+		{Path: engine.FullScan, Blocks: 4, Rows: 40},
+		{Path: engine.ZonemapScan, Probes: 4, Blocks: 2, Rows: 20},
+	}, ests)
+	ests = rprel.RangeAccessPaths("mock_0", int32(25), nil)
+	assert.Equal(t, int64(2), ests[1].Blocks)
+	_, err = rprel.NewRangePathReader(2, engine.PrimaryKeyLookup, "mock_0", int32(25), nil)
+	assert.NotNil(t, err)
+	_, err = prel.NewPathReader(2, engine.PrimaryKeyLookup, "mock_1", int32(5))
+	assert.NotNil(t, err)
+
+	// the rows appended by the txn are read by all paths
+	bat = catalog.MockData(schema, 1)
+	vector.SetCol(bat.Vecs[0], []int32{100})
+	vector.SetCol(bat.Vecs[1], []int32{5})
+	assert.Nil(t, rel.Write(0, bat, nil))
+
+	read := func(path engine.AccessPath, attr string, v int32) (rows, matched int) {
+		rds, err := prel.NewPathReader(2, path, attr, v)
+		assert.Nil(t, err)
+		for _, rd := range rds {
+			for {
+				bat, err := rd.Read([]uint64{1, 1}, []string{"mock_0", "mock_1"})
+				assert.Nil(t, err)
+				if bat == nil {
+					break
+				}
+				vs := bat.Vecs[0].Col.([]int32)
+				if attr == "mock_1" {
+					vs = bat.Vecs[1].Col.([]int32)
+				}
+				for _, x := range vs {
+					rows++
+					if x == v {
+						matched++
+					}
+				}
+			}
+		}
+		return
+	}
+	for _, v := range []int32{0, 25, 39, 100, 1000} {
+		expected := 1
+		if v == 1000 {
+			expected = 0
+		}
+		rows, matched := read(engine.FullScan, "mock_0", v)
+		assert.Equal(t, 41, rows)
+		assert.Equal(t, expected, matched, v)
+		_, matched = read(engine.ZonemapScan, "mock_0", v)
+		assert.Equal(t, expected, matched, v)
+		_, matched = read(engine.PrimaryKeyLookup, "mock_0", v)
+		assert.Equal(t, expected, matched, v)
+	}
+	rows, matched := read(engine.PrimaryKeyLookup, "mock_0", 25)
+	assert.Equal(t, 11, rows)
+	assert.Equal(t, 1, matched)
+	_, matched = read(engine.ZonemapScan, "mock_1", 5)
+	assert.Equal(t, 5, matched)
+	assert.Nil(t, rtxn.Commit())
+}
+
+func TestCommitTS(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3, 0)
+	schema.BlockMaxRows = 10
+	bat := catalog.MockData(schema, 30)
+	c0 := make([]int32, 30)
+	for i := range c0 {
+		c0[i] = int32(i)
+	}
+	vector.SetCol(bat.Vecs[0], c0)
+	bats := compute.SplitBatch(bat, 3)
+
+	// the first two blocks are appended, then the first one is compacted
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Append(bats[0]))
+	assert.Nil(t, h.Append(bats[1]))
+	assert.Nil(t, txn.Commit())
+	appendTs := txn.GetCommitTS()
+
+	txn, err = tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	h, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	meta := h.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry)
+	task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+	assert.Nil(t, err)
+	assert.Nil(t, task.OnExec())
+	assert.Nil(t, txn.Commit())
+	compactTs := txn.GetCommitTS()
+
+	// a row of the second block is updated in place, then the third block
+	// is appended
+	txn, err = tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	h, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	assert.Nil(t, h.UpdateByFilter(handle.NewEQFilter(int32(15)), 1, int32(-1)))
+	assert.Nil(t, txn.Commit())
+	updateTs := txn.GetCommitTS()
+
+	txn, err = tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	h, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Append(bats[2]))
+	assert.Nil(t, txn.Commit())
+	lastTs := txn.GetCommitTS()
+	assert.True(t, appendTs < compactTs && compactTs < updateTs && updateTs < lastTs)
+
+	e := NewEngine(tae)
+	rtxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", rtxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, rtxn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, lastTs, rel.(engine.CommitTSRelation).MaxCommitTS(rtxn.GetCtx()))
+
+	read := func(rds []engine.Reader) map[int32]uint64 {
+		tss := make(map[int32]uint64)
+		for _, rd := range rds {
+			for {
+				bat, err := rd.Read([]uint64{1, 1}, []string{"mock_0", engine.CommitTSColumnName})
+				assert.Nil(t, err)
+				if bat == nil {
+					break
+				}
+				for i, k := range bat.Vecs[0].Col.([]int32) {
+					tss[k] = bat.Vecs[1].Col.([]uint64)[i]
+				}
+			}
+		}
+		return tss
+	}
+	// the rows of a compacted block take the commit ts of the compaction,
+	// and a row updated in place takes the commit ts of the update
+	tss := read(rel.NewReader(2, nil, nil, nil))
+	assert.Equal(t, 30, len(tss))
+	for k, ts := range tss {
+		expected := appendTs
+		switch {
+		case k < 10:
+			expected = compactTs
+		case k == 15:
+			expected = updateTs
+		case k >= 20:
+			expected = lastTs
+		}
+		assert.Equal(t, expected, ts, k)
+	}
+
+	// the incremental ranges skip the blocks not changed since their bound
+	rprel := rel.(engine.RangePathRelation)
+	ests := rprel.RangeAccessPaths(engine.CommitTSColumnName, updateTs+1, nil)
+	assert.Equal(t, engine.PathEstimate{Path: engine.ZonemapScan, Probes: 3, Blocks: 1, Rows: 10}, ests[1])
+	ests = rprel.RangeAccessPaths(engine.CommitTSColumnName, updateTs, nil)
+	assert.Equal(t, int64(2), ests[1].Blocks)
+	ests = rprel.RangeAccessPaths(engine.CommitTSColumnName, lastTs+1, nil)
+	assert.Equal(t, int64(0), ests[1].Blocks)
+	rds, err := rprel.NewRangePathReader(2, engine.ZonemapScan, engine.CommitTSColumnName, updateTs+1, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(read(rds)))
+	rds, err = rprel.NewRangePathReader(2, engine.ZonemapScan, engine.CommitTSColumnName, updateTs, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, len(read(rds)))
+
+	// the rows changed by the txn itself are not committed, so their blocks
+	// are never skipped
+	assert.Nil(t, rel.(*txnRelation).handle.UpdateByFilter(handle.NewEQFilter(int32(5)), 1, int32(-1)))
+	ests = rprel.RangeAccessPaths(engine.CommitTSColumnName, lastTs+1, nil)
+	assert.Equal(t, int64(1), ests[1].Blocks)
+	tss = read(rel.NewReader(1, nil, nil, nil))
+	assert.Equal(t, txnif.UncommitTS, tss[5])
+	assert.Equal(t, compactTs, tss[6])
+	assert.Equal(t, lastTs, rel.(engine.CommitTSRelation).MaxCommitTS(rtxn.GetCtx()))
+	assert.Nil(t, rtxn.Rollback())
+}
+
+func TestCommitTSShadowed(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	// a column of the same name shadows the pseudo column
+	schema := catalog.NewEmptySchema("shadow")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int32.ToType(), 0))
+	assert.NoError(t, schema.AppendCol(engine.CommitTSColumnName, types.T_int32.ToType()))
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	bat := catalog.MockData(schema, 1)
+	vector.SetCol(bat.Vecs[1], []int32{7})
+	assert.Nil(t, h.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	e := NewEngine(tae)
+	rtxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", rtxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, rtxn.GetCtx())
+	assert.Nil(t, err)
+	rbat, err := rel.NewReader(1, nil, nil, nil)[0].Read([]uint64{1}, []string{engine.CommitTSColumnName})
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{7}, rbat.Vecs[0].Col)
+	assert.Nil(t, rtxn.Commit())
+}
+
+func newColumnarSchema(t testing.TB) *catalog.Schema {
+	schema := catalog.NewEmptySchema("columnar")
+	assert.NoError(t, schema.AppendPKCol("k", types.T_int64.ToType(), 0))
+	assert.NoError(t, schema.AppendCol("v", types.T_int32.ToType()))
+	assert.NoError(t, schema.AppendCol("s", types.T_varchar.ToType()))
+	assert.NoError(t, schema.Finalize(false))
+	return schema
+}
+
+func createColumnarRelation(t testing.TB, e TxnEngine, schema *catalog.Schema) {
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	defs, err := SchemaToDefs(schema)
+	assert.NoError(t, err)
+	assert.Nil(t, dbase.Create(0, schema.Name, defs, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+}
+
+func getColumnarRelation(t testing.TB, e TxnEngine, name string) (Txn, engine.Relation) {
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(name, txn.GetCtx())
+	assert.Nil(t, err)
+	return txn, rel
+}
+
+func TestAppendColumnarBatch(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	schema := newColumnarSchema(t)
+	createColumnarRelation(t, e, schema)
+
+	// 2 insert nodes referencing the columns and the copied rest
+	rows := 2*int(txnbase.MaxNodeRows) + 5
+	bat := catalog.MockData(schema, uint32(rows))
+	txn, rel := getColumnarRelation(t, e, schema.Name)
+	for _, mismatch := range []*batch.Batch{
+		{Attrs: bat.Attrs[:2], Vecs: bat.Vecs[:2]},
+		{Attrs: []string{"k", "s", "v"}, Vecs: bat.Vecs},
+		{Attrs: bat.Attrs, Vecs: []*vector.Vector{bat.Vecs[0], bat.Vecs[0], bat.Vecs[2]}},
+		{Attrs: bat.Attrs, Vecs: []*vector.Vector{bat.Vecs[0], compute.MockVec(types.T_int32.ToType(), 10, 0), bat.Vecs[2]}},
+		{Attrs: bat.Attrs, Vecs: []*vector.Vector{bat.Vecs[0], vector.NewConst(types.T_int32.ToType()), bat.Vecs[2]}},
+	} {
+		assert.ErrorIs(t, engine.AppendColumnarBatch(rel, mismatch), ErrBatchMismatch)
+	}
+	nullKeys := compute.MockVec(types.T_int64.ToType(), rows, 0)
+	nulls.Add(nullKeys.Nsp, 3)
+	err := engine.AppendColumnarBatch(rel, &batch.Batch{Attrs: bat.Attrs, Vecs: []*vector.Vector{nullKeys, bat.Vecs[1], bat.Vecs[2]}})
+	assert.ErrorIs(t, err, ErrBatchMismatch)
+
+	// the rows are gone after the rollback
+	assert.Nil(t, engine.AppendColumnarBatch(rel, bat))
+	assert.Equal(t, int64(rows), rel.Rows())
+	assert.Nil(t, txn.Rollback())
+	txn, rel = getColumnarRelation(t, e, schema.Name)
+	assert.Equal(t, int64(0), rel.Rows())
+
+	assert.Nil(t, engine.AppendColumnarBatch(rel, bat))
+	assert.Error(t, engine.AppendColumnarBatch(rel, compute.BatchWindow(bat, 3, 4)))
+	assert.Nil(t, txn.Commit())
+
+	// the committed rows are copied, the batch is owned by the caller again
+	keys := bat.Vecs[0].Col.([]int64)
+	vals := append([]int32(nil), bat.Vecs[1].Col.([]int32)...)
+	bat.Vecs[1].Col.([]int32)[7] = -1
+	txn, rel = getColumnarRelation(t, e, schema.Name)
+	assert.Error(t, engine.AppendColumnarBatch(rel, compute.BatchWindow(bat, 0, 10)))
+	read := make(map[int64]int32)
+	for _, reader := range rel.NewReader(2, nil, nil, nil) {
+		for {
+			bat, err := reader.Read([]uint64{1, 1}, []string{"k", "v"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			for i, k := range bat.Vecs[0].Col.([]int64) {
+				read[k] = bat.Vecs[1].Col.([]int32)[i]
+			}
+		}
+	}
+	assert.Equal(t, rows, len(read))
+	for i, k := range keys {
+		assert.Equal(t, vals[i], read[k])
+	}
+	assert.Nil(t, txn.Commit())
+}
+
+func TestDuplicates(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	schema := newColumnarSchema(t)
+	createColumnarRelation(t, e, schema)
+
+	// 0-9 are committed
+	txn, rel := getColumnarRelation(t, e, schema.Name)
+	assert.Nil(t, rel.Write(0, catalog.MockData(schema, 10), nil))
+	assert.Nil(t, txn.Commit())
+
+	keys := func(ks ...int64) *batch.Batch {
+		vec := vector.New(types.T_int64.ToType())
+		assert.Nil(t, vector.Append(vec, ks))
+		return &batch.Batch{Attrs: []string{"k"}, Vecs: []*vector.Vector{vec}}
+	}
+	txn, rel = getColumnarRelation(t, e, schema.Name)
+	drel := rel.(engine.DedupRelation)
+	// 100 is appended by the txn
+	bat := catalog.MockData(schema, 1)
+	bat.Vecs[0].Col.([]int64)[0] = 100
+	assert.Nil(t, rel.Write(0, bat, nil))
+	dups, err := drel.Duplicates(keys(3, 200, 3, 201, 100, 9, 201, 10))
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, 2, 4, 5, 6}, dups)
+	dups, err = drel.Duplicates(keys(11, 12))
+	assert.Nil(t, err)
+	assert.Empty(t, dups)
+	_, err = drel.Duplicates(&batch.Batch{Attrs: []string{"v"}, Vecs: bat.Vecs[1:2]})
+	assert.ErrorIs(t, err, ErrBatchMismatch)
+	assert.Nil(t, txn.Commit())
+}
+
+func BenchmarkAppendColumnarBatch(b *testing.B) {
+	tae := initDB(new(testing.T), nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	schema := newColumnarSchema(b)
+	createColumnarRelation(b, e, schema)
+	bat := catalog.MockData(schema, 1000000)
+	for name, write := range map[string]func(engine.Relation) error{
+		"Write": func(rel engine.Relation) error {
+			return rel.Write(0, bat, nil)
+		},
+		"Columnar": func(rel engine.Relation) error {
+			return engine.AppendColumnarBatch(rel, bat)
+		},
+	} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				txn, rel := getColumnarRelation(b, e, schema.Name)
+				if err := write(rel); err != nil {
+					b.Fatal(err)
+				}
+				_ = txn.Rollback()
+			}
+		})
+	}
+}
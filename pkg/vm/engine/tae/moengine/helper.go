@@ -15,6 +15,14 @@
 package moengine
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
@@ -25,16 +33,47 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 		commentDef.Comment = schema.Comment
 		defs = append(defs, commentDef)
 	}
+	if schema.Partition != "" {
+		defs = append(defs, &engine.PropertiesDef{
+			Properties: []engine.Property{{
+				Key:   PartitionPropertyKey,
+				Value: schema.Partition,
+			}},
+		})
+	}
+	if schema.HasTTL() {
+		defs = append(defs, &engine.PropertiesDef{
+			Properties: []engine.Property{{
+				Key:   TTLPropertyKey,
+				Value: fmt.Sprintf("%s,%d", schema.TTLColumn, schema.TTL),
+			}},
+		})
+	}
+	if schema.RowChecksum {
+		defs = append(defs, &engine.PropertiesDef{
+			Properties: []engine.Property{{
+				Key:   RowChecksumPropertyKey,
+				Value: "1",
+			}},
+		})
+	}
 	for _, col := range schema.ColDefs {
-		if col.IsHidden() {
+		if col.IsHidden() || schema.IsChecksumCol(col.Idx) {
 			continue
 		}
 		def := &engine.AttributeDef{
 			Attr: engine.Attribute{
-				Name:    col.Name,
-				Type:    col.Type,
-				Primary: col.IsPrimary(),
-				Default: engine.MakeDefaultExpr(col.Default.Set, col.Default.Value, col.Default.Null),
+				Name:          col.Name,
+				Type:          col.Type,
+				Primary:       col.IsPrimary(),
+				Default:       engine.MakeDefaultExpr(col.Default.Set, col.Default.Value, col.Default.Null),
+				NotNull:       col.NullAbility == 1,
+				AutoIncrement: col.AutoIncrement == 1,
+				Comment:       col.Comment,
+				Generated:     col.Generated,
+
+				DefaultExpression: col.DefaultExpression,
+				OnUpdate:          col.OnUpdate,
 			},
 		}
 		defs = append(defs, def)
@@ -53,11 +92,28 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 	schema = catalog.NewEmptySchema(name)
 	pkMap := make(map[string]int)
 	for _, def := range defs {
-		if pkDef, ok := def.(*engine.PrimaryIndexDef); ok {
-			for i, name := range pkDef.Names {
+		switch defVal := def.(type) {
+		case *engine.PrimaryIndexDef:
+			for i, name := range defVal.Names {
 				pkMap[name] = i
 			}
-			break
+		case *engine.CommentDef:
+			schema.Comment = defVal.Comment
+		case *engine.PropertiesDef:
+			for _, property := range defVal.Properties {
+				switch property.Key {
+				case CommentPropertyKey:
+					schema.Comment = property.Value
+				case PartitionPropertyKey:
+					schema.Partition = property.Value
+				case TTLPropertyKey:
+					if schema.TTLColumn, schema.TTL, err = parseTTL(property.Value); err != nil {
+						return
+					}
+				case RowChecksumPropertyKey:
+					schema.RowChecksum = property.Value == "1"
+				}
+			}
 		}
 	}
 	for _, def := range defs {
@@ -84,6 +140,73 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 			}
 		}
 	}
-	schema.Finalize(false)
+	for _, def := range defs {
+		if attrDef, ok := def.(*engine.AttributeDef); ok {
+			col := schema.ColDefs[schema.GetColIdx(attrDef.Attr.Name)]
+			if attrDef.Attr.NotNull {
+				col.NullAbility = 1
+			}
+			if attrDef.Attr.AutoIncrement {
+				col.AutoIncrement = 1
+			}
+			col.Comment = attrDef.Attr.Comment
+			col.Generated = attrDef.Attr.Generated
+			col.DefaultExpression = attrDef.Attr.DefaultExpression
+			col.OnUpdate = attrDef.Attr.OnUpdate
+		}
+	}
+	err = schema.Finalize(false)
 	return
 }
+
+func parseTTL(value string) (column string, ttl uint64, err error) {
+	i := strings.LastIndexByte(value, ',')
+	if i < 0 {
+		return "", 0, fmt.Errorf("invalid TTL '%s'", value)
+	}
+	if ttl, err = strconv.ParseUint(value[i+1:], 10, 64); err != nil {
+		return "", 0, fmt.Errorf("invalid TTL '%s'", value)
+	}
+	return value[:i], ttl, nil
+}
+
+// checkColumnarBatch returns ErrBatchMismatch if bat does not have the
+// columns of the table defined by schema in order, with their types and
+// without nulls in the NOT NULL and the primary key columns
+func checkColumnarBatch(schema *catalog.Schema, bat *batch.Batch) error {
+	if len(bat.Attrs) != len(bat.Vecs) {
+		return fmt.Errorf("%w: %d names of %d columns", ErrBatchMismatch, len(bat.Attrs), len(bat.Vecs))
+	}
+	var defs []*catalog.ColDef
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() || schema.IsChecksumCol(def.Idx) {
+			continue
+		}
+		defs = append(defs, def)
+	}
+	if len(bat.Vecs) != len(defs) {
+		return fmt.Errorf("%w: %d columns, expected %d", ErrBatchMismatch, len(bat.Vecs), len(defs))
+	}
+	rows := 0
+	for i, def := range defs {
+		vec := bat.Vecs[i]
+		switch {
+		case bat.Attrs[i] != def.Name:
+			return fmt.Errorf("%w: column %d is '%s', expected '%s'", ErrBatchMismatch, i, bat.Attrs[i], def.Name)
+		case vec.IsScalar():
+			return fmt.Errorf("%w: column '%s' is a scalar", ErrBatchMismatch, def.Name)
+		case vec.Typ.Oid != def.Type.Oid:
+			return fmt.Errorf("%w: column '%s' is %s, expected %s", ErrBatchMismatch, def.Name, vec.Typ, def.Type)
+		case (def.Type.Oid == types.T_decimal64 || def.Type.Oid == types.T_decimal128) && vec.Typ.Scale != def.Type.Scale:
+			return fmt.Errorf("%w: column '%s' is of scale %d, expected %d", ErrBatchMismatch, def.Name, vec.Typ.Scale, def.Type.Scale)
+		case (def.NullAbility == 1 || def.IsPrimary()) && nulls.Any(vec.Nsp):
+			return fmt.Errorf("%w: column '%s' has nulls", ErrBatchMismatch, def.Name)
+		}
+		if i == 0 {
+			rows = vector.Length(vec)
+		} else if n := vector.Length(vec); n != rows {
+			return fmt.Errorf("%w: column '%s' has %d rows, expected %d", ErrBatchMismatch, def.Name, n, rows)
+		}
+	}
+	return nil
+}
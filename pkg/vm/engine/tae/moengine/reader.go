@@ -16,62 +16,209 @@ package moengine
 
 import (
 	"bytes"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"time"
 )
 
 var (
-	_ engine.Reader = (*txnReader)(nil)
+	_ ResumableReader = (*txnReader)(nil)
+	_ ResumableReader = (*retryReader)(nil)
+	_ PrunableReader  = (*txnReader)(nil)
+	_ PrunableReader  = (*retryReader)(nil)
 )
 
-func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
-	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs)
+// newReader returns the reader of the blocks. If filterExpired, the rows
+// expired by the TTL of the table when the reader is created are not read,
+// even if the TTL reaper has not deleted them yet. If verifyChecksum, the
+// row checksums of a table with them are verified. If lockRows, the rows of
+// a block are locked for update once the block is read.
+func newReader(rel handle.Relation, blocks []handle.Block, filterExpired, verifyChecksum, lockRows bool) *txnReader {
+	schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	attrCnt := len(schema.ColDefs)
 	cds := make([]*bytes.Buffer, attrCnt)
 	dds := make([]*bytes.Buffer, attrCnt)
 	for i := 0; i < attrCnt; i++ {
 		cds[i] = bytes.NewBuffer(make([]byte, 1<<20))
 		dds[i] = bytes.NewBuffer(make([]byte, 1<<20))
 	}
-	return &txnReader{
+	r := &txnReader{
 		compressed:   cds,
 		decompressed: dds,
 		handle:       rel,
-		it:           it,
+		blocks:       blocks,
+		lockRows:     lockRows,
 	}
+	r.verifyChecksum = verifyChecksum && schema.HasRowChecksum()
+	if filterExpired && schema.HasTTL() {
+		r.ttlDef, r.ttlCutoff = schema.TTLCutoff(time.Now())
+	}
+	return r
 }
 
+// Read returns the rows of the next block. The reader stays at the block if
+// the block read fails, so the block is read again by the next call.
 func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
-	r.it.Lock()
-	if !r.it.Valid() {
-		r.it.Unlock()
-		logutil.Infof("reader: %p, read latency: %d ms",
-			r, r.latency)
-		return nil, nil
-	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
-	block := newBlock(h)
-	latency := time.Now()
-	bat, err := block.Read(refCount, attrs, r.compressed, r.decompressed)
-	r.latency += time.Since(latency).Milliseconds()
+	for r.next < len(r.blocks) {
+		h := r.blocks[r.next]
+		latency := time.Now()
+		bat, err := newBlock(h).Read(refCount, attrs, r.compressed, r.decompressed)
+		r.latency += time.Since(latency).Milliseconds()
+		if err != nil {
+			return nil, err
+		}
+		if r.verifyChecksum {
+			if err = r.verifyRowChecksums(h); err != nil {
+				return nil, err
+			}
+		}
+		if r.lockRows {
+			if err = r.lockBlockRows(h); err != nil {
+				return nil, err
+			}
+		}
+		r.next++
+		n := vector.Length(bat.Vecs[0])
+		id := h.Fingerprint()
+		offset := 0
+		if r.pos != nil && r.pos.SegmentID == id.SegmentID && r.pos.BlockID == id.BlockID {
+			// resumed from the block, skip the rows returned before
+			offset = int(r.pos.Offset)
+		}
+		r.pos = &ReadPosition{
+			SegmentID: id.SegmentID,
+			BlockID:   id.BlockID,
+			Offset:    uint32(n),
+		}
+		if offset >= n && offset > 0 {
+			continue
+		}
+		if offset > 0 {
+			for i, vec := range bat.Vecs {
+				bat.Vecs[i] = vector.Window(vec, offset, n, vector.New(vec.Typ))
+			}
+			n -= offset
+		}
+		if r.ttlDef != nil {
+			if n, err = r.filterExpired(h, bat, offset, n); err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				continue
+			}
+		}
+		if n > cap(r.zs) {
+			r.zs = make([]int64, n)
+		}
+		bat.Zs = r.zs[:n]
+		for i := 0; i < n; i++ {
+			bat.Zs[i] = 1
+		}
+		return bat, nil
+	}
+	if r.emptyBatch {
+		r.emptyBatch = false
+		return r.newEmptyBatch(attrs)
+	}
+	logutil.Infof("reader: %p, read latency: %d ms",
+		r, r.latency)
+	return nil, nil
+}
+
+// newEmptyBatch returns a batch of the attrs without rows
+func (r *txnReader) newEmptyBatch(attrs []string) (*batch.Batch, error) {
+	schema := r.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := batch.New(true, attrs)
+	bat.Vecs = make([]*vector.Vector, len(attrs))
+	for i, attr := range attrs {
+		if isCommitTSAttr(schema, attr) {
+			bat.Vecs[i] = vector.New(types.T_uint64.ToType())
+			continue
+		}
+		colIdx := schema.GetColIdx(attr)
+		if colIdx < 0 {
+			return nil, fmt.Errorf("column '%s' is not found", attr)
+		}
+		bat.Vecs[i] = vector.New(schema.ColDefs[colIdx].Type)
+	}
+	return bat, nil
+}
+
+// lockBlockRows locks the rows of the block which are not deleted
+func (r *txnReader) lockBlockRows(h handle.Block) error {
+	view, err := h.GetColumnDataById(0, nil, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	n := vector.Length(bat.Vecs[0])
-	if n > cap(r.zs) {
-		r.zs = make([]int64, n)
+	n := vector.Length(view.AppliedVec)
+	rows := make([]uint32, 0, n)
+	for i := 0; i < n; i++ {
+		if view.DeleteMask == nil || !view.DeleteMask.Contains(uint32(i)) {
+			rows = append(rows, uint32(i))
+		}
+	}
+	return r.handle.LockRows(h.Fingerprint(), rows)
+}
+
+// verifyRowChecksums verifies the checksums of the rows of the block, it
+// fails on the first row whose values do not match its checksum
+func (r *txnReader) verifyRowChecksums(h handle.Block) error {
+	schema := r.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	defs := schema.RowChecksumDefs()
+	cols := make([]*vector.Vector, len(defs))
+	for i, def := range defs {
+		view, err := h.GetColumnDataById(def.Idx, nil, nil)
+		if err != nil {
+			return err
+		}
+		cols[i] = view.ApplyDeletes()
+	}
+	view, err := h.GetColumnDataById(schema.ChecksumKey.Idx, nil, nil)
+	if err != nil {
+		return err
+	}
+	if row := compute.FirstChecksumMismatch(cols, view.ApplyDeletes(), view.DeleteMask); row >= 0 {
+		return fmt.Errorf("%w: block %s row %d", data.ErrRowChecksumMismatch, h.Fingerprint().BlockString(), row)
+	}
+	return nil
+}
+
+// filterExpired removes the expired rows from the n rows of the block read
+// from the offset, and returns the number of the rows left
+func (r *txnReader) filterExpired(h handle.Block, bat *batch.Batch, offset, n int) (int, error) {
+	view, err := h.GetColumnDataById(r.ttlDef.Idx, nil, nil)
+	if err != nil {
+		return 0, err
 	}
-	bat.Zs = r.zs[:n]
+	vec := view.ApplyDeletes()
+	sels := make([]int64, 0, n)
 	for i := 0; i < n; i++ {
-		bat.Zs[i] = 1
+		row := offset + i
+		if nulls.Contains(vec.Nsp, uint64(row)) ||
+			compute.CompareGeneric(compute.GetValue(vec, uint32(row)), r.ttlCutoff, r.ttlDef.Type) >= 0 {
+			sels = append(sels, int64(i))
+		}
 	}
-	return bat, nil
+	if len(sels) < n && len(sels) > 0 {
+		for _, vec := range bat.Vecs {
+			vector.Shrink(vec, sels)
+		}
+	}
+	return len(sels), nil
+}
+
+// Position returns the checkpoint after the last batch
+func (r *txnReader) Position() *ReadPosition {
+	return r.pos
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
@@ -83,5 +230,175 @@ func (r *txnReader) NewSummarizer() engine.Summarizer {
 }
 
 func (r *txnReader) NewSparseFilter() engine.SparseFilter {
+	return &sparseFilter{
+		schema: r.handle.GetMeta().(*catalog.TableEntry).GetSchema(),
+		prune: func(mayContain func(handle.Block) bool) engine.Reader {
+			return r.pruneBlocks(mayContain)
+		},
+	}
+}
+
+// pruneBlocks returns a reader like r, which doesn't read the blocks left
+// that can not contain a row by mayContain. r must not be read after it.
+func (r *txnReader) pruneBlocks(mayContain func(handle.Block) bool) *txnReader {
+	pruned := *r
+	pruned.blocks = make([]handle.Block, r.next, len(r.blocks))
+	copy(pruned.blocks, r.blocks[:r.next])
+	for _, h := range r.blocks[r.next:] {
+		if mayContain(h) {
+			pruned.blocks = append(pruned.blocks, h)
+		}
+	}
+	if skipped := len(r.blocks) - len(pruned.blocks); skipped > 0 {
+		r.handle.GetMeta().(*catalog.TableEntry).AddPrunedBlocks(uint64(skipped))
+		pruned.emptyBatch = len(pruned.blocks) == 0
+	}
+	return &pruned
+}
+
+func blockMayContainRange(h handle.Block, colIdx int, min, max any) bool {
+	if h.IsUncommitted() {
+		return true
+	}
+	meta, ok := h.GetMeta().(*catalog.BlockEntry)
+	if !ok || meta.GetBlockData() == nil {
+		return true
+	}
+	return meta.GetBlockData().MayContainRange(colIdx, min, max)
+}
+
+// isCommitTSAttr returns true if attr is the commit ts pseudo column, which
+// is shadowed by a column of the same name
+func isCommitTSAttr(schema *catalog.Schema, attr string) bool {
+	return attr == engine.CommitTSColumnName && schema.GetColIdx(attr) < 0
+}
+
+// blockMayCommitInRange returns false if no row of the block visible to the
+// txn was changed at or after min by the latest commit ts of the block. The
+// commit ts of the first change of a row is not known, so the upper bound of
+// the range prunes nothing.
+func blockMayCommitInRange(h handle.Block, min any) bool {
+	ts, ok := min.(uint64)
+	if !ok {
+		return true
+	}
+	return h.GetMaxCommitTS() >= ts
+}
+
+func newRetryReader(reader ResumableReader, reopen func(*ReadPosition) (ResumableReader, error), retries int) *retryReader {
+	return &retryReader{
+		reader:  reader,
+		reopen:  reopen,
+		retries: retries,
+	}
+}
+
+// Read retries the failed read with a reader reopened at the last
+// checkpoint, the rows returned before are not returned again.
+func (r *retryReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
+	bat, err := r.reader.Read(refCount, attrs)
+	for i := 0; err != nil && i < r.retries; i++ {
+		logutil.Warnf("reader: %p, read failed: %v, retry %d", r, err, i+1)
+		reader, rerr := r.reopen(r.reader.Position())
+		if rerr != nil {
+			logutil.Warnf("reader: %p, reopen failed: %v", r, rerr)
+			continue
+		}
+		r.reader = reader
+		bat, err = r.reader.Read(refCount, attrs)
+	}
+	return bat, err
+}
+
+func (r *retryReader) Position() *ReadPosition {
+	return r.reader.Position()
+}
+
+func (r *retryReader) NewFilter() engine.Filter {
 	return nil
 }
+
+func (r *retryReader) NewSummarizer() engine.Summarizer {
+	return nil
+}
+
+// NewSparseFilter returns the filter of the blocks of the reader, the reader
+// it returns prunes the blocks of the readers reopened by it too.
+func (r *retryReader) NewSparseFilter() engine.SparseFilter {
+	inner, ok := r.reader.(*txnReader)
+	if !ok {
+		return nil
+	}
+	return &sparseFilter{
+		schema: inner.handle.GetMeta().(*catalog.TableEntry).GetSchema(),
+		prune: func(mayContain func(handle.Block) bool) engine.Reader {
+			reopen := func(pos *ReadPosition) (ResumableReader, error) {
+				reader, err := r.reopen(pos)
+				if err != nil {
+					return nil, err
+				}
+				if inner, ok := reader.(*txnReader); ok {
+					return inner.pruneBlocks(mayContain), nil
+				}
+				return reader, nil
+			}
+			return newRetryReader(inner.pruneBlocks(mayContain), reopen, r.retries)
+		},
+	}
+}
+
+func (f *sparseFilter) Eq(attr string, v any) (engine.Reader, error) {
+	return f.between(attr, v, v)
+}
+
+func (f *sparseFilter) Ne(attr string, _ any) (engine.Reader, error) {
+	return f.between(attr, nil, nil)
+}
+
+func (f *sparseFilter) Lt(attr string, v any) (engine.Reader, error) {
+	return f.between(attr, nil, v)
+}
+
+func (f *sparseFilter) Le(attr string, v any) (engine.Reader, error) {
+	return f.between(attr, nil, v)
+}
+
+func (f *sparseFilter) Gt(attr string, v any) (engine.Reader, error) {
+	return f.between(attr, v, nil)
+}
+
+func (f *sparseFilter) Ge(attr string, v any) (engine.Reader, error) {
+	return f.between(attr, v, nil)
+}
+
+func (f *sparseFilter) Btw(attr string, min, max any) (engine.Reader, error) {
+	return f.between(attr, min, max)
+}
+
+// between prunes the blocks by the closed range, so the strict bounds keep
+// the blocks of which the bound is the min or the max
+func (f *sparseFilter) between(attr string, min, max any) (engine.Reader, error) {
+	mayContain, err := blockRangeFilter(f.schema, attr, min, max)
+	if err != nil {
+		return nil, err
+	}
+	return f.prune(mayContain), nil
+}
+
+// blockRangeFilter returns the function telling if a block may have a row
+// whose attr is in [min, max]. The blocks are probed by the zonemaps of the
+// columns, or by their latest commit ts for the commit ts pseudo column.
+func blockRangeFilter(schema *catalog.Schema, attr string, min, max any) (func(handle.Block) bool, error) {
+	if isCommitTSAttr(schema, attr) {
+		return func(h handle.Block) bool {
+			return blockMayCommitInRange(h, min)
+		}, nil
+	}
+	colIdx := schema.GetColIdx(attr)
+	if colIdx < 0 {
+		return nil, fmt.Errorf("column '%s' is not found", attr)
+	}
+	return func(h handle.Block) bool {
+		return blockMayContainRange(h, colIdx, min, max)
+	}, nil
+}
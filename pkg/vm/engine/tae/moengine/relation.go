@@ -15,26 +15,46 @@
 package moengine
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ engine.Relation          = (*txnRelation)(nil)
+	_ engine.LockingRelation   = (*txnRelation)(nil)
+	_ engine.StatsRelation     = (*txnRelation)(nil)
+	_ engine.PathRelation      = (*txnRelation)(nil)
+	_ engine.RangePathRelation = (*txnRelation)(nil)
+	_ engine.ColumnarRelation  = (*txnRelation)(nil)
+	_ engine.DedupRelation     = (*txnRelation)(nil)
+	_ engine.CommitTSRelation  = (*txnRelation)(nil)
 )
 
 const ADDR = "localhost:20000"
 
-func newRelation(h handle.Relation) *txnRelation {
+func newRelation(h handle.Relation, readRetries int, filterExpired, verifyChecksum bool, statsStaleness time.Duration) *txnRelation {
 	r := &txnRelation{
-		handle: h,
+		handle:         h,
+		readRetries:    readRetries,
+		filterExpired:  filterExpired,
+		verifyChecksum: verifyChecksum,
+		statsStaleness: statsStaleness,
 	}
 	r.nodes = append(r.nodes, engine.Node{
 		Addr: ADDR,
@@ -56,8 +76,18 @@ func (_ *txnRelation) Size(_ string) int64 {
 	return 0
 }
 
-func (_ *txnRelation) CardinalNumber(_ string) int64 {
-	return 0
+func (rel *txnRelation) CardinalNumber(attr string) int64 {
+	meta := rel.handle.GetMeta().(*catalog.TableEntry)
+	colIdx := meta.GetSchema().GetColIdx(attr)
+	if colIdx < 0 {
+		return 0
+	}
+	stats, err := meta.GetStats(rel.statsStaleness)
+	if err != nil {
+		logutil.Warnf("collect stats of %s: %v", meta.GetSchema().Name, err)
+		return 0
+	}
+	return int64(stats.NDV[colIdx])
 }
 
 func (_ *txnRelation) CreateIndex(_ uint64, _ []engine.TableDef) error {
@@ -86,6 +116,17 @@ func (rel *txnRelation) Rows() int64 {
 	return rel.handle.Rows()
 }
 
+// MaxCommitTS returns the latest commit ts of the committed blocks visible
+// to the txn, the blocks appended or updated by the txn itself are skipped
+func (rel *txnRelation) MaxCommitTS(_ engine.Snapshot) (ts uint64) {
+	for it := rel.handle.MakeBlockIt(); it.Valid(); it.Next() {
+		if blkTs := it.GetBlock().GetMaxCommitTS(); blkTs != txnif.UncommitTS && blkTs > ts {
+			ts = blkTs
+		}
+	}
+	return
+}
+
 func (_ *txnRelation) Index() []*engine.IndexTableDef {
 	panic(any("implement me"))
 }
@@ -137,6 +178,107 @@ func (rel *txnRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) err
 	return rel.handle.Append(bat)
 }
 
+// Duplicates finds the rows of bat whose primary keys conflict by
+// BatchDedup. The dedup fails on the first conflict without telling its row,
+// so the ranges of the distinct keys failing it are halved until the
+// conflicting keys are found, d duplicates of n keys take O(d*log(n)) dedups.
+func (rel *txnRelation) Duplicates(bat *batch.Batch) ([]int64, error) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	if !schema.HasPK() {
+		return nil, nil
+	}
+	cols := make([]*vector.Vector, schema.SortKey.Size())
+	typs := make([]types.Type, len(cols))
+	for i := range cols {
+		def := schema.SortKey.GetDef(i)
+		if cols[i] = batch.GetVector(bat, def.Name); cols[i] == nil {
+			return nil, fmt.Errorf("%w: missing primary key column '%s'", ErrBatchMismatch, def.Name)
+		}
+		typs[i] = def.Type
+	}
+
+	// the later rows of a key are duplicates of its first one, the first
+	// rows are deduplicated with the table
+	var dups, firsts []int64
+	keys := make([]*vector.Vector, len(cols))
+	for i := range keys {
+		keys[i] = vector.New(typs[i])
+	}
+	seen := make(map[string]struct{})
+	vals := make([]any, len(cols))
+	var buf bytes.Buffer
+	for row := 0; row < vector.Length(cols[0]); row++ {
+		for i, col := range cols {
+			vals[i] = compute.GetValue(col, uint32(row))
+		}
+		key := string(model.EncodeTypedVals(&buf, typs, vals...))
+		if _, ok := seen[key]; ok {
+			dups = append(dups, int64(row))
+			continue
+		}
+		seen[key] = struct{}{}
+		firsts = append(firsts, int64(row))
+		for i := range keys {
+			compute.AppendValue(keys[i], vals[i])
+		}
+	}
+	conflicts, err := rel.dedupRange(keys, 0, len(firsts))
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range conflicts {
+		dups = append(dups, firsts[i])
+	}
+	sort.Slice(dups, func(i, j int) bool { return dups[i] < dups[j] })
+	return dups, nil
+}
+
+// dedupRange returns the positions in [start, end) of the keys which are in
+// the table
+func (rel *txnRelation) dedupRange(keys []*vector.Vector, start, end int) ([]int64, error) {
+	if start == end {
+		return nil, nil
+	}
+	window := make([]*vector.Vector, len(keys))
+	for i, key := range keys {
+		window[i] = vector.Window(key, start, end, vector.New(key.Typ))
+	}
+	err := rel.handle.BatchDedup(window...)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, data.ErrDuplicate) {
+		return nil, err
+	}
+	if end-start == 1 {
+		return []int64{int64(start)}, nil
+	}
+	mid := (start + end) / 2
+	left, err := rel.dedupRange(keys, start, mid)
+	if err != nil {
+		return nil, err
+	}
+	right, err := rel.dedupRange(keys, mid, end)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+// AppendColumnarBatch appends bat after checking it against the schema, its
+// fixed size columns are referenced by the insert nodes of the txn where they
+// can, so they must not be modified until the txn is terminated
+func (rel *txnRelation) AppendColumnarBatch(bat *batch.Batch) error {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	if err := checkColumnarBatch(schema, bat); err != nil {
+		return err
+	}
+	if len(bat.Vecs) == 0 || vector.Length(bat.Vecs[0]) == 0 {
+		return nil
+	}
+	return rel.handle.AppendNoCopy(bat)
+}
+
 func (rel *txnRelation) Update(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
 	return errors.New("doesn't support now")
 }
@@ -164,11 +306,206 @@ func (rel *txnRelation) Delete(_ uint64, data *vector.Vector, col string, _ engi
 	panic(any("Key not found"))
 }
 
-func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
-	it := rel.handle.MakeBlockIt()
+// NewReader returns num readers, the blocks of the relation are dealt to
+// them in turn. A reader retries its failed block reads if the retries are
+// configured.
+func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) []engine.Reader {
+	return rel.newReaders(num, false)
+}
+
+// NewLockingReader is like NewReader, and the readers lock the rows they
+// return until the txn of the relation is terminated.
+func (rel *txnRelation) NewLockingReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) []engine.Reader {
+	return rel.newReaders(num, true)
+}
+
+func (rel *txnRelation) newReaders(num int, lockRows bool) (rds []engine.Reader) {
 	for i := 0; i < num; i++ {
-		reader := newReader(rel.handle, it)
-		rds = append(rds, reader)
+		reader := newReader(rel.handle, rel.shardBlocks(i, num), rel.filterExpired, rel.verifyChecksum, lockRows)
+		if rel.readRetries <= 0 {
+			rds = append(rds, reader)
+			continue
+		}
+		shard := i
+		reopen := func(pos *ReadPosition) (ResumableReader, error) {
+			return rel.resumeReader(shard, num, pos, lockRows)
+		}
+		rds = append(rds, newRetryReader(reader, reopen, rel.readRetries))
 	}
 	return
 }
+
+// ResumeReader reopens the shard-th of the num readers returned by NewReader
+// and resumes it from the position. The reader reads the snapshot of the
+// txn of the relation, so the resumed scan is consistent with the rows
+// returned before.
+func (rel *txnRelation) ResumeReader(shard, num int, pos *ReadPosition) (ResumableReader, error) {
+	return rel.resumeReader(shard, num, pos, false)
+}
+
+func (rel *txnRelation) resumeReader(shard, num int, pos *ReadPosition, lockRows bool) (ResumableReader, error) {
+	reader := newReader(rel.handle, rel.shardBlocks(shard, num), rel.filterExpired, rel.verifyChecksum, lockRows)
+	if pos == nil {
+		return reader, nil
+	}
+	for i, blk := range reader.blocks {
+		id := blk.Fingerprint()
+		if id.SegmentID == pos.SegmentID && id.BlockID == pos.BlockID {
+			reader.next = i
+			reader.pos = pos
+			return reader, nil
+		}
+	}
+	return nil, fmt.Errorf("block %d-%d of the read position is not found", pos.SegmentID, pos.BlockID)
+}
+
+// shardBlocks returns the blocks of the shard-th of num readers
+func (rel *txnRelation) shardBlocks(shard, num int) (blks []handle.Block) {
+	it := rel.handle.MakeBlockIt()
+	for i := 0; it.Valid(); i++ {
+		if i%num == shard {
+			blks = append(blks, it.GetBlock())
+		}
+		it.Next()
+	}
+	return
+}
+
+// AccessPaths returns the paths reading the rows whose attr equals v. The
+// zonemaps of the blocks are probed to count the blocks read by a zonemap
+// scan, and the primary key lookup is available if attr is the single
+// primary key.
+func (rel *txnRelation) AccessPaths(attr string, v any) []engine.PathEstimate {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	mayContain, err := blockRangeFilter(schema, attr, v, v)
+	if err != nil {
+		return nil
+	}
+	paths := rel.scanPaths(mayContain)
+	if schema.IsSinglePK() && schema.GetSingleSortKey().Name == attr {
+		full := paths[0]
+		// the lookup probes the indexes of half of the blocks on average,
+		// and reads the only block holding the key
+		lookup := engine.PathEstimate{
+			Path:   engine.PrimaryKeyLookup,
+			Probes: (full.Blocks + 1) / 2,
+		}
+		if full.Blocks > 0 {
+			lookup.Blocks = 1
+			lookup.Rows = full.Rows / full.Blocks
+		}
+		paths = append(paths, lookup)
+	}
+	return paths
+}
+
+// RangeAccessPaths returns the paths reading the rows whose attr is in
+// [min, max], which are the full scan and the zonemap scan. The zonemap scan
+// of the commit ts pseudo column skips the blocks not changed since min.
+func (rel *txnRelation) RangeAccessPaths(attr string, min, max any) []engine.PathEstimate {
+	mayContain, err := blockRangeFilter(rel.handle.GetMeta().(*catalog.TableEntry).GetSchema(), attr, min, max)
+	if err != nil {
+		return nil
+	}
+	return rel.scanPaths(mayContain)
+}
+
+// scanPaths returns the estimates of the full scan and the zonemap scan of
+// the rows, the blocks are probed by mayContain to count the blocks read by
+// the zonemap scan.
+func (rel *txnRelation) scanPaths(mayContain func(handle.Block) bool) []engine.PathEstimate {
+	full := engine.PathEstimate{Path: engine.FullScan}
+	zonemap := engine.PathEstimate{Path: engine.ZonemapScan}
+	for it := rel.handle.MakeBlockIt(); it.Valid(); it.Next() {
+		h := it.GetBlock()
+		rows := int64(h.Rows())
+		full.Blocks++
+		full.Rows += rows
+		zonemap.Probes++
+		if mayContain(h) {
+			zonemap.Blocks++
+			zonemap.Rows += rows
+		}
+	}
+	return []engine.PathEstimate{full, zonemap}
+}
+
+// NewPathReader returns num readers of the rows whose attr equals v by the
+// access path, the readers may return the rows not matching.
+func (rel *txnRelation) NewPathReader(num int, path engine.AccessPath, attr string, v any) ([]engine.Reader, error) {
+	switch path {
+	case engine.FullScan:
+		return rel.newReaders(num, false), nil
+	case engine.ZonemapScan:
+		rds := rel.newReaders(num, false)
+		for i, rd := range rds {
+			pruned, err := rd.(PrunableReader).NewSparseFilter().Eq(attr, v)
+			if err != nil {
+				return nil, err
+			}
+			rds[i] = pruned
+		}
+		return rds, nil
+	case engine.PrimaryKeyLookup:
+		return rel.newLookupReaders(num, attr, v)
+	}
+	return nil, fmt.Errorf("access path %s is not supported", path)
+}
+
+// NewRangePathReader returns num readers of the rows whose attr is in
+// [min, max] by the access path, the readers may return the rows not
+// matching.
+func (rel *txnRelation) NewRangePathReader(num int, path engine.AccessPath, attr string, min, max any) ([]engine.Reader, error) {
+	switch path {
+	case engine.FullScan:
+		return rel.newReaders(num, false), nil
+	case engine.ZonemapScan:
+		rds := rel.newReaders(num, false)
+		for i, rd := range rds {
+			pruned, err := rd.(PrunableReader).NewSparseFilter().Btw(attr, min, max)
+			if err != nil {
+				return nil, err
+			}
+			rds[i] = pruned
+		}
+		return rds, nil
+	}
+	return nil, fmt.Errorf("access path %s is not supported by a range", path)
+}
+
+// newLookupReaders returns num readers, the first one reads the block holding
+// the primary key v found by the primary key index, and the blocks appended
+// by the txn which are not indexed yet. The others read nothing.
+func (rel *txnRelation) newLookupReaders(num int, attr string, v any) ([]engine.Reader, error) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	if !schema.IsSinglePK() || schema.GetSingleSortKey().Name != attr {
+		return nil, fmt.Errorf("column '%s' is not the primary key", attr)
+	}
+	id, _, err := rel.handle.GetByFilter(handle.NewEQFilter(v))
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return nil, err
+	}
+	// the key not found is in no block
+	located := err != nil
+	var blocks []handle.Block
+	for it := rel.handle.MakeBlockIt(); it.Valid(); it.Next() {
+		h := it.GetBlock()
+		fp := h.Fingerprint()
+		if h.IsUncommitted() {
+			blocks = append(blocks, h)
+			located = located || fp.SegmentID == id.SegmentID
+		} else if !located && fp.SegmentID == id.SegmentID && fp.BlockID == id.BlockID {
+			blocks = append(blocks, h)
+			located = true
+		}
+	}
+	if !located {
+		blocks = rel.shardBlocks(0, 1)
+	}
+	rds := make([]engine.Reader, num)
+	rds[0] = newReader(rel.handle, blocks, rel.filterExpired, rel.verifyChecksum, false)
+	for i := 1; i < num; i++ {
+		rds[i] = newReader(rel.handle, nil, rel.filterExpired, rel.verifyChecksum, false)
+	}
+	return rds, nil
+}
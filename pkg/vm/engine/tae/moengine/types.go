@@ -16,12 +16,37 @@ package moengine
 
 import (
 	"bytes"
+	"errors"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
+// PartitionPropertyKey is the table property holding the partition
+// definition of a partitioned table, it is persisted in the schema
+const PartitionPropertyKey = "partition"
+
+// TTLPropertyKey is the table property holding the TTL of the rows, which is
+// the TTL column and the seconds separated by comma
+const TTLPropertyKey = "ttl"
+
+// RowChecksumPropertyKey is the table property which is "1" if the rows of
+// the table have checksums
+const RowChecksumPropertyKey = "row_checksum"
+
+// CommentPropertyKey is the table property holding the comment of the
+// table, it is persisted as the comment of the schema
+const CommentPropertyKey = "comment"
+
+// ErrBatchMismatch is returned if a columnar batch is not laid out as its
+// table, see engine.ColumnarRelation
+var ErrBatchMismatch = errors.New("tae: batch does not match the table")
+
 type Txn interface {
 	GetCtx() []byte
 	GetID() uint64
@@ -32,24 +57,51 @@ type Txn interface {
 	GetError() error
 }
 
+// SavepointTxn is a Txn whose writes made after a savepoint can be undone
+// without ending the txn
+type SavepointTxn interface {
+	Txn
+	Savepoint() txnif.Savepoint
+	RollbackToSavepoint(txnif.Savepoint) error
+}
+
 type TxnEngine interface {
 	engine.Engine
 	StartTxn(info []byte) (txn Txn, err error)
 }
 
-var _ TxnEngine = &txnEngine{}
+// AdminEngine is a TxnEngine which runs the admin commands on its storage
+type AdminEngine interface {
+	TxnEngine
+	// Compact runs the compactions of a table, or of its block of the id if
+	// it is not 0, and returns the number of them run
+	Compact(dbName, tableName string, blockID uint64) (int, error)
+	// ClearPoison clears the poisoned compactions of a table, or of all the
+	// tables if the names are empty, and returns the number of them cleared
+	ClearPoison(dbName, tableName string) (int, error)
+}
+
+var _ AdminEngine = &txnEngine{}
 
 type txnEngine struct {
 	impl *db.DB
 }
 
 type txnDatabase struct {
-	handle handle.Database
+	handle         handle.Database
+	readRetries    int
+	filterExpired  bool
+	verifyChecksum bool
+	statsStaleness time.Duration
 }
 
 type txnRelation struct {
-	handle handle.Relation
-	nodes  engine.Nodes
+	handle         handle.Relation
+	nodes          engine.Nodes
+	readRetries    int
+	filterExpired  bool
+	verifyChecksum bool
+	statsStaleness time.Duration
 }
 
 type txnBlock struct {
@@ -57,10 +109,69 @@ type txnBlock struct {
 }
 
 type txnReader struct {
-	handle       handle.Relation
-	it           handle.BlockIt
+	handle handle.Relation
+	// the blocks to read, the reader reads them in order
+	blocks []handle.Block
+	// index of the next block to read
+	next int
+	// checkpoint after the last batch, nil before the first batch
+	pos          *ReadPosition
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
 	zs           []int64
 	latency      int64
+	// the TTL column and its cutoff if the expired rows are filtered
+	ttlDef    *catalog.ColDef
+	ttlCutoff any
+	// lock the rows read for update
+	lockRows bool
+	// verify the row checksums of the blocks read
+	verifyChecksum bool
+	// return an empty batch after the blocks, it is set if the zonemaps
+	// pruned all the blocks, so the aggregates of no rows are computed as
+	// on an empty table
+	emptyBatch bool
+}
+
+// sparseFilter skips the blocks of a reader whose zonemaps tell no row can
+// match the predicate. The reader it returns may still read the rows that
+// don't match, and the predicates on the reader it returns are combined with
+// the ones before. The values of the predicates are of the go type of the
+// column.
+type sparseFilter struct {
+	schema *catalog.Schema
+	// prune returns the reader of the blocks which may contain a row by
+	// mayContain
+	prune func(mayContain func(handle.Block) bool) engine.Reader
+}
+
+// ReadPosition is a checkpoint of a reader. The rows of the blocks before it
+// and the first Offset rows of the block have been returned.
+type ReadPosition struct {
+	SegmentID uint64
+	BlockID   uint64
+	Offset    uint32
+}
+
+// ResumableReader is a reader that reports its checkpoint after each batch,
+// a scan can be resumed from the checkpoint with a new reader
+type ResumableReader interface {
+	engine.Reader
+	// Position returns the checkpoint after the last batch
+	Position() *ReadPosition
+}
+
+// PrunableReader is a reader whose blocks can be pruned by the zonemaps of
+// the columns, the readers returned by its sparse filter are prunable too
+type PrunableReader interface {
+	engine.Reader
+	NewSparseFilter() engine.SparseFilter
+}
+
+// retryReader retries the failed block reads of a read-only scan. The scan
+// is resumed from the last checkpoint with a reopened reader.
+type retryReader struct {
+	reader  ResumableReader
+	reopen  func(pos *ReadPosition) (ResumableReader, error)
+	retries int
 }
@@ -18,6 +18,9 @@ type CacheCfg struct {
 	IndexCapacity  uint64 `toml:"index-cache-size"`
 	InsertCapacity uint64 `toml:"insert-cache-size"`
 	TxnCapacity    uint64 `toml:"txn-cache-size"`
+	// EvictPolicy is the policy to evict the nodes of the caches, lru or
+	// lru2, it is lru2 if empty
+	EvictPolicy string `toml:"evict-policy"`
 }
 
 type StorageCfg struct {
@@ -36,4 +39,65 @@ type CheckpointCfg struct {
 type SchedulerCfg struct {
 	IOWorkers    int `toml:"io-workers"`
 	AsyncWorkers int `toml:"async-workers"`
+	// SortWorkers limits the sort tasks run in parallel by the compactions
+	SortWorkers int `toml:"sort-workers"`
+	// CheckpointWorkers is the number of the workers of the async tasks
+	// which the compactions can not take, they are left to the checkpoints
+	// and the other tasks of higher priority
+	CheckpointWorkers int `toml:"checkpoint-workers"`
+	// CompactionIORate limits the bytes per second read and written by the
+	// compactions, 0 for no limit
+	CompactionIORate int64 `toml:"compaction-io-rate"`
+	// QueryLatencyThreshold is the query latency in millisecond above which
+	// the compactions are throttled, 0 to never throttle them
+	QueryLatencyThreshold int64 `toml:"query-latency-threshold"`
+	// ThrottledIORate is the bytes per second of the throttled compactions,
+	// 0 to keep CompactionIORate
+	ThrottledIORate int64 `toml:"throttled-io-rate"`
+	// CompactionMaxFailures is the number of the failures in a row after
+	// which the compaction of a block or a segment is poisoned, it is not
+	// scheduled again until its poison is cleared
+	CompactionMaxFailures int `toml:"compaction-max-failures"`
+	// CompactionRetryBackoff is the delay in millisecond before a failed
+	// compaction is scheduled again, it doubles with each failure in a row
+	// up to CompactionMaxRetryBackoff
+	CompactionRetryBackoff    int64 `toml:"compaction-retry-backoff"`
+	CompactionMaxRetryBackoff int64 `toml:"compaction-max-retry-backoff"`
+}
+
+type ReaderCfg struct {
+	// ReadRetries is the times to retry a failed block read of a scan
+	ReadRetries int `toml:"read-retries"`
+	// FilterExpired hides the expired rows of the tables with TTL, which
+	// may not have been deleted yet
+	FilterExpired bool `toml:"filter-expired"`
+	// VerifyChecksum verifies the row checksums of the tables with them
+	// when their blocks are read
+	VerifyChecksum bool `toml:"verify-checksum"`
+}
+
+type CDCCfg struct {
+	// MaxLag is the number of log entries a subscription may fall behind
+	// before it is invalidated and stops holding the log back
+	MaxLag uint64 `toml:"max-lag"`
+}
+
+type LockCfg struct {
+	// WaitTimeout is the time in millisecond a txn waits for a row locked
+	// by SELECT ... FOR UPDATE of another txn
+	WaitTimeout int64 `toml:"wait-timeout"`
+	// NoWait fails a conflicting txn at once instead of waiting
+	NoWait bool `toml:"no-wait"`
+}
+
+type TTLCfg struct {
+	// ReapInterval is the interval in millisecond to delete the expired rows
+	// of the tables with TTL
+	ReapInterval int64 `toml:"reap-interval"`
+}
+
+type StatsCfg struct {
+	// MaxStaleness is the age in millisecond after which the statistics of
+	// a table are aggregated again from its blocks
+	MaxStaleness int64 `toml:"max-staleness"`
 }
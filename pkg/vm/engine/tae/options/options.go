@@ -48,6 +48,55 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		o.SchedulerCfg = &SchedulerCfg{
 			IOWorkers:    DefaultIOWorkers,
 			AsyncWorkers: DefaultAsyncWorkers,
+			SortWorkers:  DefaultSortWorkers,
+		}
+	}
+	if o.SchedulerCfg.SortWorkers <= 0 {
+		o.SchedulerCfg.SortWorkers = DefaultSortWorkers
+	}
+	if o.SchedulerCfg.CheckpointWorkers <= 0 {
+		o.SchedulerCfg.CheckpointWorkers = DefaultCheckpointWorkers
+	}
+	if o.SchedulerCfg.CompactionMaxFailures <= 0 {
+		o.SchedulerCfg.CompactionMaxFailures = DefaultCompactionMaxFailures
+	}
+	if o.SchedulerCfg.CompactionRetryBackoff <= 0 {
+		o.SchedulerCfg.CompactionRetryBackoff = DefaultCompactionRetryBackoff
+	}
+	if o.SchedulerCfg.CompactionMaxRetryBackoff < o.SchedulerCfg.CompactionRetryBackoff {
+		o.SchedulerCfg.CompactionMaxRetryBackoff = DefaultCompactionMaxRetryBackoff
+		if o.SchedulerCfg.CompactionMaxRetryBackoff < o.SchedulerCfg.CompactionRetryBackoff {
+			o.SchedulerCfg.CompactionMaxRetryBackoff = o.SchedulerCfg.CompactionRetryBackoff
+		}
+	}
+
+	if o.ReaderCfg == nil {
+		o.ReaderCfg = &ReaderCfg{
+			ReadRetries: DefaultReadRetries,
+		}
+	}
+
+	if o.CDCCfg == nil {
+		o.CDCCfg = &CDCCfg{
+			MaxLag: DefaultCDCMaxLag,
+		}
+	}
+
+	if o.TTLCfg == nil {
+		o.TTLCfg = &TTLCfg{
+			ReapInterval: DefaultTTLReapInterval,
+		}
+	}
+
+	if o.LockCfg == nil {
+		o.LockCfg = &LockCfg{
+			WaitTimeout: DefaultLockWaitTimeout,
+		}
+	}
+
+	if o.StatsCfg == nil {
+		o.StatsCfg = &StatsCfg{
+			MaxStaleness: DefaultStatsMaxStaleness,
 		}
 	}
 
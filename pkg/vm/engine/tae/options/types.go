@@ -35,6 +35,23 @@ const (
 
 	DefaultIOWorkers    = int(8)
 	DefaultAsyncWorkers = int(16)
+	DefaultSortWorkers  = int(4)
+
+	DefaultCheckpointWorkers = int(4)
+
+	DefaultCompactionMaxFailures     = int(10)
+	DefaultCompactionRetryBackoff    = int64(1000)  // millisecond
+	DefaultCompactionMaxRetryBackoff = int64(60000) // millisecond
+
+	DefaultReadRetries = 3
+
+	DefaultCDCMaxLag = uint64(100000)
+
+	DefaultTTLReapInterval = int64(60000) // millisecond
+
+	DefaultLockWaitTimeout = int64(50000) // millisecond
+
+	DefaultStatsMaxStaleness = int64(10000) // millisecond
 )
 
 type Options struct {
@@ -42,5 +59,10 @@ type Options struct {
 	StorageCfg    *StorageCfg    `toml:"storage-cfg"`
 	CheckpointCfg *CheckpointCfg `toml:"checkpoint-cfg"`
 	SchedulerCfg  *SchedulerCfg  `toml:"scheduler-cfg"`
+	ReaderCfg     *ReaderCfg     `toml:"reader-cfg"`
+	CDCCfg        *CDCCfg        `toml:"cdc-cfg"`
+	TTLCfg        *TTLCfg        `toml:"ttl-cfg"`
+	LockCfg       *LockCfg       `toml:"lock-cfg"`
+	StatsCfg      *StatsCfg      `toml:"stats-cfg"`
 	Catalog       *catalog.Catalog
 }
@@ -17,6 +17,8 @@ package tables
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -36,6 +38,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 
 	mobat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -59,13 +62,17 @@ type dataBlock struct {
 	nice      uint32
 	ckpTs     uint64
 	prefix    []byte
+	// the zonemaps of the temporal columns written with the sketches,
+	// loaded on the first pruning
+	colZMOnce sync.Once
+	colZMs    *index.Sketches
 }
 
 func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
 	colCnt := len(meta.GetSchema().ColDefs)
 	indexCnt := make(map[int]int)
 	if meta.GetSchema().HasSortKey() {
-		indexCnt[meta.GetSchema().SortKey.Defs[0].Idx] = 2
+		indexCnt[meta.GetSchema().SortKey.Defs[0].Idx] = meta.GetSchema().GetSortKeyIndexCnt()
 	}
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
@@ -240,6 +247,52 @@ func (blk *dataBlock) GetBlockFile() file.Block {
 	return blk.file
 }
 
+func (blk *dataBlock) GetSketches() (sketches *index.Sketches, err error) {
+	if blk.meta.IsAppendable() {
+		return
+	}
+	data, err := blk.file.LoadSketches()
+	if err != nil || data == nil {
+		return
+	}
+	return index.LoadSketchesFrom(data)
+}
+
+func (blk *dataBlock) MayContainRange(colIdx int, min, max any) bool {
+	if blk.meta.IsAppendable() || blk.index == nil {
+		return true
+	}
+	if sketches := blk.columnZoneMaps(); sketches != nil {
+		if zm := sketches.ZoneMap(uint16(colIdx)); zm != nil && !zm.MayContainRange(min, max) {
+			return false
+		}
+	}
+	return blk.index.MayContainRange(colIdx, min, max)
+}
+
+// columnZoneMaps returns the sketches holding the zonemaps of the temporal
+// columns, nil if they are not written
+func (blk *dataBlock) columnZoneMaps() *index.Sketches {
+	blk.colZMOnce.Do(func() {
+		sketches, err := blk.GetSketches()
+		if err != nil {
+			logutil.Warnf("load the zonemaps of %s: %v", blk.meta.Repr(), err)
+			return
+		}
+		blk.colZMs = sketches
+	})
+	return blk.colZMs
+}
+
+func (blk *dataBlock) RoughNDV() int {
+	if !blk.meta.IsAppendable() || blk.index == nil {
+		return blk.Rows(nil, true)
+	}
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	return blk.index.KeyCount()
+}
+
 func (blk *dataBlock) GetID() *common.ID { return blk.meta.AsCommonID() }
 
 func (blk *dataBlock) RunCalibration() {
@@ -534,10 +587,6 @@ func (blk *dataBlock) GetColumnDataById(
 	}
 
 	view = model.NewColumnView(txn.GetStartTS(), colIdx)
-	if view.RawVec, err = blk.getVectorWithBuffer(colIdx, compressed, decompressed); err != nil {
-		return
-	}
-
 	blk.mvcc.RLock()
 	err = blk.FillColumnUpdates(view)
 	if err == nil {
@@ -547,10 +596,90 @@ func (blk *dataBlock) GetColumnDataById(
 	if err != nil {
 		return
 	}
+
+	// a fixed-width column without updates and deletes is handed over as is
+	if decompressed != nil && view.UpdateMask == nil && view.DeleteMask == nil &&
+		isFixedWidth(blk.meta.GetSchema().ColDefs[colIdx].Type) {
+		view.AppliedVec, err = blk.getBorrowedVector(colIdx)
+		return
+	}
+	if view.RawVec, err = blk.getVectorWithBuffer(colIdx, compressed, decompressed); err != nil {
+		return
+	}
 	err = view.Eval(true)
 	return
 }
 
+// GetCommitTSColumn returns the view of a uint64 column of the commit ts of
+// the latest change of each row visible to the txn, which is the commit ts
+// of the append of the row or of its latest column update. The rows of an
+// appendable block replayed take its checkpoint ts, and the rows of a
+// non-appendable block take the commit ts of its creation if not updated
+// after, so the ts of a row may be later than its actual change but never
+// earlier. The deletes of the view are not applied.
+func (blk *dataBlock) GetCommitTSColumn(txn txnif.AsyncTxn) (view *model.ColumnView, err error) {
+	ts := txn.GetStartTS()
+	view = model.NewColumnView(ts, -1)
+	var tss []uint64
+	if blk.meta.IsAppendable() {
+		blk.mvcc.RLock()
+		maxRow, visible := blk.node.rows, true
+		if ts < blk.GetMaxVisibleTS() {
+			maxRow, visible, err = blk.mvcc.GetMaxVisibleRowLocked(ts)
+		}
+		if !visible {
+			maxRow = 0
+		}
+		tss = make([]uint64, maxRow)
+		if err == nil {
+			err = blk.mvcc.CollectCommitTSLocked(ts, tss)
+		}
+		blk.mvcc.RUnlock()
+	} else {
+		tss = make([]uint64, blk.file.ReadRows())
+		createAt := blk.getCreateAt()
+		for i := range tss {
+			tss[i] = createAt
+		}
+		blk.mvcc.RLock()
+		err = blk.mvcc.CollectCommitTSLocked(ts, tss)
+		blk.mvcc.RUnlock()
+	}
+	if err != nil {
+		return
+	}
+	blk.mvcc.RLock()
+	err = blk.FillColumnDeletes(view)
+	blk.mvcc.RUnlock()
+	if err != nil {
+		return
+	}
+	view.AppliedVec = compute.Uint64Vector(tss)
+	return
+}
+
+// GetMaxCommitTS returns the latest commit ts of the changes of the block,
+// which is not earlier than the commit ts of any row of GetCommitTSColumn.
+// It is UncommitTS if a column has an update not committed yet.
+func (blk *dataBlock) GetMaxCommitTS() uint64 {
+	if blk.mvcc.HasActiveUpdateNode() {
+		return txnif.UncommitTS
+	}
+	ts := blk.mvcc.LoadMaxVisible()
+	if !blk.meta.IsAppendable() {
+		if createAt := blk.getCreateAt(); createAt > ts {
+			ts = createAt
+		}
+	}
+	return ts
+}
+
+func (blk *dataBlock) getCreateAt() uint64 {
+	blk.meta.RLock()
+	defer blk.meta.RUnlock()
+	return blk.meta.CreateAt
+}
+
 func (blk *dataBlock) getVectorCopy(
 	ts uint64,
 	colIdx int,
@@ -732,6 +861,143 @@ func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (v an
 	return
 }
 
+// GetValues returns the values of column col at rows in the order of rows.
+// Compared with calling GetValue for each row, the mvcc lock is acquired
+// once, an appendable block is pinned once and the column of a
+// non-appendable block is read from file at most once
+func (blk *dataBlock) GetValues(txn txnif.AsyncTxn, rows []uint32, col uint16) (vals []any, err error) {
+	colVals, err := blk.GetColumnsValues(txn, rows, []uint16{col})
+	if err != nil {
+		return
+	}
+	vals = colVals[0]
+	return
+}
+
+// GetColumnsValues is the multi-column version of GetValues, vals[i] holds
+// the values of column cols[i]. data.ErrNotFound is returned if any of the
+// rows is deleted or not visible to txn
+func (blk *dataBlock) GetColumnsValues(txn txnif.AsyncTxn, rows []uint32, cols []uint16) (vals [][]any, err error) {
+	ts := txn.GetStartTS()
+	// Visit the rows in ascending order and fill the values in the
+	// caller's order
+	sorted := make([]int, len(rows))
+	for i := range sorted {
+		sorted[i] = i
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return rows[sorted[i]] < rows[sorted[j]]
+	})
+	vals = make([][]any, len(cols))
+	// misses[i] is the positions of the rows without a visible update of cols[i]
+	misses := make([][]int, len(cols))
+	maxRow := uint32(math.MaxUint32)
+
+	blk.mvcc.RLock()
+	if blk.meta.IsAppendable() {
+		if ts >= blk.GetMaxVisibleTS() {
+			maxRow = blk.node.rows
+		} else {
+			var visible bool
+			if maxRow, visible, err = blk.mvcc.GetMaxVisibleRowLocked(ts); err != nil {
+				blk.mvcc.RUnlock()
+				return nil, err
+			}
+			if !visible {
+				maxRow = 0
+			}
+		}
+	}
+	for _, pos := range sorted {
+		var deleted bool
+		if rows[pos] >= maxRow {
+			err = data.ErrNotFound
+		} else if deleted, err = blk.mvcc.IsDeletedLocked(rows[pos], ts, blk.mvcc.RWMutex); err == nil && deleted {
+			err = data.ErrNotFound
+		}
+		if err != nil {
+			blk.mvcc.RUnlock()
+			return nil, err
+		}
+	}
+	for i, col := range cols {
+		vals[i] = make([]any, len(rows))
+		chain := blk.mvcc.GetColumnChain(col)
+		chain.RLock()
+		for _, pos := range sorted {
+			v, verr := chain.GetValueLocked(rows[pos], ts)
+			if verr == txnif.TxnInternalErr {
+				chain.RUnlock()
+				blk.mvcc.RUnlock()
+				return nil, verr
+			}
+			if verr != nil || v == nil {
+				misses[i] = append(misses[i], pos)
+				continue
+			}
+			vals[i][pos] = v
+		}
+		chain.RUnlock()
+	}
+	blk.mvcc.RUnlock()
+
+	if blk.meta.IsAppendable() {
+		err = blk.node.DoWithPin(func() (err error) {
+			for i, col := range cols {
+				if len(misses[i]) == 0 {
+					continue
+				}
+				var ivec vector.IVector
+				if ivec, err = blk.node.GetVectorView(maxRow, int(col)); err != nil {
+					return
+				}
+				for _, pos := range misses[i] {
+					var v any
+					if v, err = ivec.GetValue(int(rows[pos])); err != nil {
+						return
+					}
+					vals[i][pos] = cloneValue(v)
+				}
+			}
+			return
+		})
+		if err != nil {
+			vals = nil
+		}
+		return
+	}
+	for i, col := range cols {
+		if len(misses[i]) == 0 {
+			continue
+		}
+		var wrapper *vector.VectorWrapper
+		if wrapper, err = blk.getVectorWrapper(int(col)); err != nil {
+			return nil, err
+		}
+		for _, pos := range misses[i] {
+			vals[i][pos] = cloneValue(compute.GetValue(&wrapper.Vector, rows[pos]))
+		}
+		common.GPool.Free(wrapper.MNode)
+	}
+	return
+}
+
+func isFixedWidth(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		return false
+	}
+	return true
+}
+
+// cloneValue copies the value referencing the buffer of a column
+func cloneValue(v any) any {
+	if buf, ok := v.([]byte); ok {
+		return append([]byte(nil), buf...)
+	}
+	return v
+}
+
 func (blk *dataBlock) getVectorWithBuffer(
 	colIdx int,
 	compressed, decompressed *bytes.Buffer) (vec *movec.Vector, err error) {
@@ -749,6 +1015,23 @@ func (blk *dataBlock) getVectorWithBuffer(
 	return
 }
 
+// getBorrowedVector returns the column of a non-appendable block without
+// copying it out of the loaded buffer. The buffer is freed when the vector
+// and all its views are cleaned, so it's only used by the scans, which give
+// the buffers and clean the vectors of their batches.
+func (blk *dataBlock) getBorrowedVector(colIdx int) (vec *movec.Vector, err error) {
+	wrapper, err := blk.getVectorWrapper(colIdx)
+	if err != nil {
+		return
+	}
+	vec = &wrapper.Vector
+	node := wrapper.MNode
+	movec.Borrow(vec, func() {
+		common.GPool.Free(node)
+	})
+	return
+}
+
 func (blk *dataBlock) getVectorWrapper(colIdx int) (wrapper *vector.VectorWrapper, err error) {
 	dataFile := blk.colFiles[colIdx]
 
@@ -806,6 +1089,17 @@ func (blk *dataBlock) ablkGetByFilter(ts uint64, filter *handle.Filter) (offset
 }
 
 func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset uint32, err error) {
+	schema := blk.meta.GetSchema()
+	if schema.IsCompoundSortKey() {
+		// The zonemap of the encoded keys hardly skips any block, check the
+		// zonemap of the leading column first
+		def := schema.SortKey.Defs[0]
+		if v, ok := model.DecodeLeadingVal(filter.Val.([]byte), def.Type); ok && !blk.index.MayContainRange(def.Idx, v, v) {
+			blk.meta.GetSegment().GetTable().AddPrunedBlocks(1)
+			err = data.ErrNotFound
+			return
+		}
+	}
 	err = blk.index.Dedup(filter.Val)
 	if err == nil {
 		err = data.ErrNotFound
@@ -815,13 +1109,15 @@ func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset u
 		return
 	}
 	err = nil
-	pkColumn, err := blk.getVectorWrapper(blk.meta.GetSchema().GetSingleSortKeyIdx())
+	var existed bool
+	if schema.IsCompoundSortKey() {
+		offset, existed, err = blk.findCompoundKey(filter.Val)
+	} else {
+		offset, existed, err = blk.findSingleKey(filter.Val)
+	}
 	if err != nil {
 		return
 	}
-	defer common.GPool.Free(pkColumn.MNode)
-	col := &pkColumn.Vector
-	offset, existed := compute.CheckRowExists(col, filter.Val, nil)
 	if !existed {
 		err = data.ErrNotFound
 		return
@@ -839,6 +1135,40 @@ func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset u
 	return
 }
 
+func (blk *dataBlock) findSingleKey(key any) (offset uint32, existed bool, err error) {
+	pkColumn, err := blk.getVectorWrapper(blk.meta.GetSchema().GetSingleSortKeyIdx())
+	if err != nil {
+		return
+	}
+	defer common.GPool.Free(pkColumn.MNode)
+	col := &pkColumn.Vector
+	offset, existed = compute.CheckRowExists(col, key, nil, blk.meta.GetSchema().SortKey.IsDesc())
+	return
+}
+
+// findCompoundKey scans the block for the encoded compound key, which can't
+// be binary searched as the rows are not sorted by the encoded bytes.
+func (blk *dataBlock) findCompoundKey(key any) (offset uint32, existed bool, err error) {
+	schema := blk.meta.GetSchema()
+	cols := make([]*movec.Vector, schema.SortKey.Size())
+	for i := range cols {
+		var wrapper *vector.VectorWrapper
+		if wrapper, err = blk.getVectorWrapper(schema.SortKey.Defs[i].Idx); err != nil {
+			return
+		}
+		defer common.GPool.Free(wrapper.MNode)
+		cols[i] = &wrapper.Vector
+	}
+	var w bytes.Buffer
+	val := key.([]byte)
+	for row := 0; row < movec.Length(cols[0]); row++ {
+		if bytes.Equal(model.EncodeTuple(&w, uint32(row), cols...), val) {
+			return uint32(row), true, nil
+		}
+	}
+	return
+}
+
 func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (offset uint32, err error) {
 	if filter.Op != handle.FilterEq {
 		panic("logic error")
@@ -897,9 +1227,11 @@ func (blk *dataBlock) ABlkApplyDelete(deleted uint64, gen common.RowGen, ts uint
 			var w bytes.Buffer
 			sortKeys := blk.meta.GetSchema().SortKey
 			vals := make([]any, sortKeys.Size())
+			typs := make([]types.Type, sortKeys.Size())
 			vecs := make([]vector.IVector, sortKeys.Size())
 			blk.mvcc.RLock()
 			for i := range vecs {
+				typs[i] = sortKeys.Defs[i].Type
 				vec, err := blk.node.data.GetVectorByAttr(sortKeys.Defs[i].Idx)
 				if err != nil {
 					blk.mvcc.RUnlock()
@@ -916,7 +1248,7 @@ func (blk *dataBlock) ABlkApplyDelete(deleted uint64, gen common.RowGen, ts uint
 				for i := range vals {
 					vals[i], _ = vecs[i].GetValue(int(row))
 				}
-				v := model.EncodeTypedVals(&w, vals...)
+				v := model.EncodeTypedVals(&w, typs, vals...)
 				currRow, err = blk.index.GetActiveRow(v)
 				if err != nil || currRow == row {
 					if err = blk.index.Delete(v, ts); err != nil {
@@ -974,8 +1306,9 @@ func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *movec.Vector, rowmask
 		return err
 	}
 	defer view.Free()
+	desc := blk.meta.GetSchema().SortKey.IsDesc()
 	deduplicate := func(v any, _ uint32) error {
-		if _, existed := compute.CheckRowExists(view.AppliedVec, v, view.DeleteMask); existed {
+		if _, existed := compute.CheckRowExists(view.AppliedVec, v, view.DeleteMask, desc); existed {
 			return data.ErrDuplicate
 		}
 		return nil
@@ -20,8 +20,10 @@ import (
 	"github.com/RoaringBitmap/roaring"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/sysevent"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
@@ -50,6 +52,11 @@ func (blk *dataBlock) ABlkFlushDataClosure(ts uint64, bat batch.IBatch, masks ma
 }
 
 func (blk *dataBlock) CheckpointWAL(endTs uint64) (err error) {
+	if endTs <= blk.GetMaxCheckpointTS() {
+		return
+	}
+	event := jobs.NewBlockEvent(sysevent.Checkpoint, blk.meta.AsCommonID())
+	defer func() { sysevent.Report(event, err) }()
 	if blk.meta.IsAppendable() {
 		return blk.ABlkCheckpointWAL(endTs)
 	}
@@ -196,22 +203,22 @@ func (blk *dataBlock) ABlkFlushData(ts uint64, bat batch.IBatch, masks map[uint1
 		logutil.Infof("FLUSH ABLK | [%s] | CANCELLED | (Stale Request: Already Compacted)", blk.meta.String())
 		return data.ErrStaleRequest
 	}
+	event := jobs.NewBlockEvent(sysevent.FlushBlock, blk.meta.AsCommonID())
+	defer func() { sysevent.Report(event, err) }()
 
-	if err := blk.file.WriteIBatch(bat, ts, masks, vals, nil); err != nil {
+	if err = blk.file.WriteIBatch(bat, ts, masks, vals, nil); err != nil {
 		return err
 	}
 	if deletes != nil {
-		var buf []byte
-		if buf, err = deletes.ToBytes(); err != nil {
-			return
-		}
-		if err = blk.file.WriteDeletes(buf); err != nil {
+		if err = blk.file.AppendDeletes(ts, deletes); err != nil {
 			return
 		}
 	}
 	if err = blk.file.Sync(); err != nil {
 		return
 	}
+	event.Rows = int64(bat.Length())
+	event.Bytes = jobs.BlockDataSize(blk.file, len(blk.meta.GetSchema().ColDefs))
 	blk.node.SetBlockMaxFlushTS(ts)
 	blk.resetNice()
 	logutil.Infof("FLUSH ABLK | [%s] | Done | MaxRow=%d | MaxTs=%d", blk.meta.String(), bat.Length(), ts)
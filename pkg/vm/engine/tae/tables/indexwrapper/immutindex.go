@@ -11,10 +11,16 @@ import (
 type immutableIndex struct {
 	zmReader *ZMReader
 	bfReader *BFReader
+	// the zonemaps of the columns of the sort key, indexed by the column idx.
+	// The zonemap of a compound sort key is of the encoded keys, the columns
+	// of it have their own zonemaps.
+	colZMReaders map[int]*ZMReader
 }
 
 func NewImmutableIndex() *immutableIndex {
-	return new(immutableIndex)
+	return &immutableIndex{
+		colZMReaders: make(map[int]*ZMReader),
+	}
 }
 
 func (index *immutableIndex) IsKeyDeleted(any, uint64) (bool, bool) { panic("not supported") }
@@ -42,11 +48,20 @@ func (index *immutableIndex) Dedup(key any) (err error) {
 	return
 }
 
+func (index *immutableIndex) MayContainRange(colIdx int, min, max any) bool {
+	reader := index.colZMReaders[colIdx]
+	if reader == nil {
+		return true
+	}
+	return reader.MayContainRange(min, max)
+}
+
 func (idx *immutableIndex) String() string {
 	panic("implement me")
 }
 func (index *immutableIndex) GetMaxDeleteTS() uint64                    { panic("not supported") }
 func (index *immutableIndex) HasDeleteFrom(key any, fromTs uint64) bool { panic("not supported") }
+func (index *immutableIndex) KeyCount() int                             { panic("not supported") }
 
 func (index *immutableIndex) BatchDedup(keys *vector.Vector, rowmask *roaring.Bitmap) (keyselects *roaring.Bitmap, err error) {
 	keyselects, exist := index.zmReader.ContainsAny(keys)
@@ -80,7 +95,17 @@ func (index *immutableIndex) Destroy() (err error) {
 		}
 	}
 	if index.bfReader != nil {
-		err = index.bfReader.Destroy()
+		if err = index.bfReader.Destroy(); err != nil {
+			return
+		}
+	}
+	for _, reader := range index.colZMReaders {
+		if reader == index.zmReader {
+			continue
+		}
+		if err = reader.Destroy(); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -93,6 +118,7 @@ func (index *immutableIndex) ReadFrom(blk data.Block) (err error) {
 		return
 	}
 	metas := idxMeta.(*IndicesMeta)
+	compound := entry.GetSchema().IsCompoundSortKey()
 	colFile, err := file.OpenColumn(entry.GetSchema().SortKey.Defs[0].Idx)
 	if err != nil {
 		return
@@ -112,7 +138,13 @@ func (index *immutableIndex) ReadFrom(blk data.Block) (err error) {
 			if _, err = idxFile.Read(buf); err != nil {
 				return err
 			}
-			index.zmReader = NewZMReader(blk.GetBufMgr(), idxFile, id)
+			reader := NewZMReader(blk.GetBufMgr(), idxFile, id)
+			if meta.InternalIdx == 0 {
+				index.zmReader = reader
+			}
+			if meta.InternalIdx != 0 || !compound {
+				index.colZMReaders[int(meta.ColIdx)] = reader
+			}
 		case StaticFilterIndex:
 			size := idxFile.Stat().Size()
 			buf := make([]byte, size)
@@ -55,6 +55,7 @@ func (idx *mutableIndex) IsKeyDeleted(key any, ts uint64) (deleted, existed bool
 }
 
 func (idx *mutableIndex) GetMaxDeleteTS() uint64 { return idx.deletes.GetMaxTS() }
+func (idx *mutableIndex) KeyCount() int          { return idx.art.Size() }
 func (idx *mutableIndex) Delete(key any, ts uint64) (err error) {
 	defer func() {
 		err = TranslateError(err)
@@ -87,7 +88,8 @@ func (idx *mutableIndex) GetActiveRow(key any) (row uint32, err error) {
 func (idx *mutableIndex) String() string {
 	return idx.art.String()
 }
-func (idx *mutableIndex) Dedup(any) error { panic("implement me") }
+func (idx *mutableIndex) Dedup(any) error                    { panic("implement me") }
+func (idx *mutableIndex) MayContainRange(int, any, any) bool { return true }
 func (idx *mutableIndex) BatchDedup(keys *vector.Vector, rowmask *roaring.Bitmap) (keyselects *roaring.Bitmap, err error) {
 	keyselects, exist := idx.zonemap.ContainsAny(keys)
 	// 1. all keys are definitely not existed
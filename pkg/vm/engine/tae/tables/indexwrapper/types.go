@@ -51,6 +51,12 @@ type Index interface {
 	IsKeyDeleted(key any, ts uint64) (deleted, existed bool)
 	HasDeleteFrom(key any, fromTs uint64) bool
 	GetMaxDeleteTS() uint64
+	// KeyCount returns the number of the distinct keys in the index
+	KeyCount() int
+	// MayContainRange returns false if no value of the column can be in
+	// [min, max] by its zonemap, a nil bound is unbounded. It returns true
+	// if the column has no zonemap.
+	MayContainRange(colIdx int, min, max any) bool
 
 	String() string
 
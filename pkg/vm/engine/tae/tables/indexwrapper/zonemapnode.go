@@ -103,6 +103,12 @@ func (reader *ZMReader) Contains(key any) bool {
 	return reader.node.zonemap.Contains(key)
 }
 
+func (reader *ZMReader) MayContainRange(min, max any) bool {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.MayContainRange(min, max)
+}
+
 type ZMWriter struct {
 	cType       CompressType
 	file        common.IRWFile
@@ -15,14 +15,18 @@
 package jobs
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/sysevent"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
@@ -94,29 +98,37 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 			return
 		}
 		vec := view.ApplyDeletes()
+		throttleIO(task.scheduler, vec)
 		preparer.Columns.Vecs = append(preparer.Columns.Vecs, vec)
 		preparer.Columns.Attrs = append(preparer.Columns.Attrs, def.Name)
 	}
+	if schema.HasRowChecksum() {
+		if err = task.recomputeRowChecksums(preparer.Columns, view.DeleteMask); err != nil {
+			return
+		}
+	}
 	// Sort only if sort key is defined
 	if schema.HasSortKey() {
-		var vecs []*vector.Vector
-		var idx int
 		if schema.IsSingleSortKey() {
-			vecs = preparer.Columns.Vecs
-			idx = schema.SortKey.Defs[0].Idx
+			idx := schema.SortKey.Defs[0].Idx
 			preparer.SortKey = preparer.Columns.Vecs[idx]
+			if err = mergesort.SortBlockColumns(preparer.Columns.Vecs, idx, schema.SortKey.IsDesc()); err != nil {
+				return
+			}
 		} else {
+			var vecs []*vector.Vector
 			vecs = append(vecs, preparer.Columns.Vecs...)
 			cols := make([]*vector.Vector, schema.SortKey.Size())
+			keys := make([]int, schema.SortKey.Size())
 			for i := range cols {
-				cols[i] = preparer.Columns.Vecs[schema.SortKey.Defs[i].Idx]
+				keys[i] = schema.SortKey.Defs[i].Idx
+				cols[i] = preparer.Columns.Vecs[keys[i]]
 			}
 			preparer.SortKey = model.EncodeCompoundColumn(cols...)
-			idx = len(vecs)
 			vecs = append(vecs, preparer.SortKey)
-		}
-		if err = mergesort.SortBlockColumns(vecs, idx); err != nil {
-			return
+			if err = mergesort.SortBlockColumnsByKeys(vecs, keys, schema.SortKey.GetDescs()); err != nil {
+				return
+			}
 		}
 	}
 	// Prepare hidden column data
@@ -130,10 +142,29 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 	return
 }
 
+// recomputeRowChecksums verifies the row checksums of the columns and
+// replaces them by the recomputed ones, so that a corrupted row is not
+// compacted silently.
+func (task *compactBlockTask) recomputeRowChecksums(columns *batch.Batch, deletes *roaring.Bitmap) error {
+	schema := task.meta.GetSchema()
+	defs := schema.RowChecksumDefs()
+	cols := make([]*vector.Vector, len(defs))
+	for i, def := range defs {
+		cols[i] = columns.Vecs[def.Idx]
+	}
+	if row := compute.FirstChecksumMismatch(cols, columns.Vecs[schema.ChecksumKey.Idx], deletes); row >= 0 {
+		return fmt.Errorf("%w: block %s row %d", data.ErrRowChecksumMismatch, task.compacted.Fingerprint().BlockString(), row)
+	}
+	columns.Vecs[schema.ChecksumKey.Idx] = compute.RowChecksumVector(cols)
+	return nil
+}
+
 func (task *compactBlockTask) GetNewBlock() handle.Block { return task.created }
 
 func (task *compactBlockTask) Execute() (err error) {
 	now := time.Now()
+	event := NewBlockEvent(sysevent.CompactBlock, task.compacted.Fingerprint())
+	defer func() { sysevent.Report(event, err) }()
 	seg := task.compacted.GetSegment()
 	// Prepare a block placeholder
 	newBlk, err := seg.CreateNonAppendableBlock()
@@ -153,6 +184,7 @@ func (task *compactBlockTask) Execute() (err error) {
 	newBlkData := newMeta.GetBlockData()
 	blockFile := newBlkData.GetBlockFile()
 
+	throttleIO(task.scheduler, preparer.Columns.Vecs...)
 	ioTask := NewFlushBlkTask(tasks.WaitableCtx, blockFile, task.txn.GetStartTS(), newMeta, preparer.Columns, preparer.SortKey)
 	if err = task.scheduler.Schedule(ioTask); err != nil {
 		return
@@ -160,6 +192,8 @@ func (task *compactBlockTask) Execute() (err error) {
 	if err = ioTask.WaitDone(); err != nil {
 		return
 	}
+	event.Rows = int64(compute.LengthOfBatch(preparer.Columns))
+	event.Bytes = BlockDataSize(blockFile, len(preparer.Columns.Vecs))
 
 	if err = newBlkData.ReplayIndex(); err != nil {
 		return err
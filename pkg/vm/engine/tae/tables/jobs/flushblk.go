@@ -54,10 +54,20 @@ func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() (err error) {
 	if task.sortCol != nil {
-		if err = BuildAndFlushIndex(task.file, task.meta, task.sortCol); err != nil {
+		var keys []*vector.Vector
+		if schema := task.meta.GetSchema(); schema.IsCompoundSortKey() {
+			keys = make([]*vector.Vector, schema.SortKey.Size())
+			for i := range keys {
+				keys[i] = task.data.Vecs[schema.SortKey.Defs[i].Idx]
+			}
+		}
+		if err = BuildAndFlushIndex(task.file, task.meta, task.sortCol, keys); err != nil {
 			return
 		}
 	}
+	if err = BuildAndFlushSketches(task.file, task.meta, task.data); err != nil {
+		return
+	}
 	if err = task.file.WriteBatch(task.data, task.ts); err != nil {
 		return
 	}
@@ -15,13 +15,48 @@
 package jobs
 
 import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/util/sysevent"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/indexwrapper"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
-func BuildAndFlushIndex(file file.Block, meta *catalog.BlockEntry, columnData *vector.Vector) (err error) {
+// BuildAndFlushSketches builds the sketches of the distinct values of the
+// visible columns of a block and writes them to its file
+func BuildAndFlushSketches(file file.Block, meta *catalog.BlockEntry, data *batch.Batch) (err error) {
+	schema := meta.GetSchema()
+	sketches := index.NewSketches()
+	for i, attr := range data.Attrs {
+		idx := schema.GetColIdx(attr)
+		if idx < 0 || schema.ColDefs[idx].IsHidden() {
+			continue
+		}
+		sketches.Add(uint16(idx), data.Vecs[i])
+	}
+	return FlushSketches(file, sketches)
+}
+
+// FlushSketches writes the sketches of a block to its file
+func FlushSketches(file file.Block, sketches *index.Sketches) (err error) {
+	buf, err := sketches.Marshal()
+	if err != nil {
+		return
+	}
+	return file.WriteSketches(buf)
+}
+
+// BuildAndFlushIndex writes the zonemap and the bloomfilter of the sort key
+// of a block. The keys are the columns of a compound sort key, whose
+// zonemaps are written after them, nil if the sort key is single.
+func BuildAndFlushIndex(file file.Block, meta *catalog.BlockEntry, columnData *vector.Vector, keys []*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
 	schema := meta.GetSchema()
 	sortCol, err := file.OpenColumn(schema.SortKey.Defs[0].Idx)
@@ -69,6 +104,29 @@ func BuildAndFlushIndex(file file.Block, meta *catalog.BlockEntry, columnData *v
 		return err
 	}
 	metas.AddIndex(*sfMeta)
+
+	for i, key := range keys {
+		keyIdx := sfIdx + 1 + uint16(i)
+		keyWriter := indexwrapper.NewZMWriter()
+		keyFile, err := sortCol.OpenIndexFile(int(keyIdx))
+		if err != nil {
+			return err
+		}
+		err = keyWriter.Init(keyFile, indexwrapper.Plain, uint16(schema.SortKey.Defs[i].Idx), keyIdx)
+		if err != nil {
+			return err
+		}
+		err = keyWriter.AddValues(key)
+		if err != nil {
+			return err
+		}
+		keyMeta, err := keyWriter.Finalize()
+		if err != nil {
+			return err
+		}
+		metas.AddIndex(*keyMeta)
+	}
+
 	metaBuf, err := metas.Marshal()
 	if err != nil {
 		return err
@@ -80,3 +138,45 @@ func BuildAndFlushIndex(file file.Block, meta *catalog.BlockEntry, columnData *v
 	}
 	return nil
 }
+
+// throttleIO waits for the IO throttle of the scheduler before the columns
+// are read or written by a compaction
+func throttleIO(scheduler tasks.TaskScheduler, vecs ...*vector.Vector) {
+	if scheduler == nil {
+		return
+	}
+	size := int64(0)
+	for _, vec := range vecs {
+		if bs, ok := vec.Col.(*types.Bytes); ok {
+			size += int64(len(bs.Data))
+		} else {
+			size += int64(vector.Length(vec)) * int64(vec.Typ.Size)
+		}
+	}
+	scheduler.IOThrottle().Wait(size)
+}
+
+// NewBlockEvent returns the event of a job on a block, which starts now
+func NewBlockEvent(typ string, id *common.ID) *sysevent.Event {
+	return &sysevent.Event{
+		Type:      typ,
+		TableID:   id.TableID,
+		SegmentID: id.SegmentID,
+		BlockID:   id.BlockID,
+		Start:     time.Now(),
+	}
+}
+
+// BlockDataSize returns the size of the data files of the first cols columns
+// of a block
+func BlockDataSize(file file.Block, cols int) (size int64) {
+	for i := 0; i < cols; i++ {
+		col, err := file.OpenColumn(i)
+		if err != nil {
+			continue
+		}
+		size += col.GetDataFileStat().Size()
+		col.Close()
+	}
+	return
+}
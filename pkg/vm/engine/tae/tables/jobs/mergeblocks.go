@@ -20,10 +20,12 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/sysevent"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/txnentries"
@@ -100,12 +102,12 @@ func NewMergeBlocksTask(ctx *tasks.Context, txn txnif.AsyncTxn, mergedBlks []*ca
 
 func (task *mergeBlocksTask) Scopes() []common.ID { return task.scopes }
 
-func (task *mergeBlocksTask) mergeColumn(vecs []*vector.Vector, sortedIdx *[]uint32, isPrimary bool, fromLayout, toLayout []uint32, sort bool) (column []*vector.Vector, mapping []uint32) {
+func (task *mergeBlocksTask) mergeColumn(vecs []*vector.Vector, sortedIdx *[]uint32, isPrimary bool, fromLayout, toLayout []uint32, sort, desc bool) (column []*vector.Vector, mapping []uint32, err error) {
 	if sort {
 		if isPrimary {
-			column, mapping = mergesort.MergeSortedColumn(vecs, sortedIdx, fromLayout, toLayout)
+			column, mapping, err = mergesort.ParallelMergeSortedColumn(task.scheduler, vecs, sortedIdx, fromLayout, toLayout, desc)
 		} else {
-			column = mergesort.ShuffleColumn(vecs, *sortedIdx, fromLayout, toLayout)
+			column, err = mergesort.ParallelShuffleColumn(task.scheduler, vecs, *sortedIdx, fromLayout, toLayout)
 		}
 	} else {
 		column, mapping = task.mergeColumnWithOutSort(vecs, fromLayout, toLayout)
@@ -127,6 +129,10 @@ func (task *mergeBlocksTask) mergeColumnWithOutSort(column []*vector.Vector, fro
 }
 
 func (task *mergeBlocksTask) Execute() (err error) {
+	// The event of a merge is on the segment it merges to
+	event := NewBlockEvent(sysevent.MergeBlocks, task.mergedBlks[0].AsCommonID())
+	event.SegmentID, event.BlockID = 0, 0
+	defer func() { sysevent.Report(event, err) }()
 	segStr := ""
 	for _, seg := range task.mergedSegs {
 		segStr = fmt.Sprintf("%d,", seg.GetID())
@@ -149,6 +155,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			return
 		}
 	}
+	event.SegmentID = task.toSegEntry.GetID()
 
 	schema := task.mergedBlks[0].GetSchema()
 	var view *model.ColumnView
@@ -157,6 +164,8 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	length := 0
 	fromAddr := make([]uint32, 0, len(task.compacted))
 	ids := make([]*common.ID, 0, len(task.compacted))
+	// The key columns of every block if the sort key is compound
+	var keys [][]*vector.Vector
 
 	// 1. Prepare sort key resources
 	// If there's no sort key, use hidden
@@ -167,11 +176,13 @@ func (task *mergeBlocksTask) Execute() (err error) {
 				return
 			}
 			vec = view.ApplyDeletes()
+			throttleIO(task.scheduler, vec)
 		} else if schema.SortKey.Size() == 1 {
 			if view, err = block.GetColumnDataById(schema.SortKey.Defs[0].Idx, nil, nil); err != nil {
 				return
 			}
 			vec = view.ApplyDeletes()
+			throttleIO(task.scheduler, vec)
 		} else {
 			cols := make([]*vector.Vector, schema.SortKey.Size())
 			for idx := range cols {
@@ -179,8 +190,10 @@ func (task *mergeBlocksTask) Execute() (err error) {
 					return
 				}
 				cols[idx] = view.ApplyDeletes()
+				throttleIO(task.scheduler, cols[idx])
 			}
 			vec = model.EncodeCompoundColumn(cols...)
+			keys = append(keys, cols)
 		}
 		vecs = append(vecs, vec)
 		rows[i] = uint32(vector.Length(vec))
@@ -188,6 +201,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		length += vector.Length(vec)
 		ids = append(ids, block.Fingerprint())
 	}
+	event.Rows = int64(length)
 	to := make([]uint32, 0)
 	maxrow := schema.BlockMaxRows
 	totalRows := length
@@ -206,7 +220,29 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	buf := node.Buf[:length]
 	defer common.GPool.Free(node)
 	sortedIdx := *(*[]uint32)(unsafe.Pointer(&buf))
-	vecs, mapping := task.mergeColumn(vecs, &sortedIdx, true, rows, to, schema.HasSortKey())
+	var mapping []uint32
+	// The merged columns of a compound sort key, indexed by the column idx
+	var mergedKeys map[int][]*vector.Vector
+	if schema.IsCompoundSortKey() {
+		mapping = mergesort.MergeSortedKeys(keys, schema.SortKey.GetDescs(), sortedIdx, rows)
+		if vecs, _, err = task.mergeColumn(vecs, &sortedIdx, false, rows, to, true, false); err != nil {
+			return
+		}
+		mergedKeys = make(map[int][]*vector.Vector)
+		for i, def := range schema.SortKey.Defs {
+			cols := make([]*vector.Vector, len(keys))
+			for j := range keys {
+				cols[j] = keys[j][i]
+			}
+			if mergedKeys[def.Idx], _, err = task.mergeColumn(cols, &sortedIdx, false, rows, to, true, false); err != nil {
+				return
+			}
+		}
+	} else {
+		if vecs, mapping, err = task.mergeColumn(vecs, &sortedIdx, true, rows, to, schema.HasSortKey(), schema.HasSortKey() && schema.SortKey.IsDesc()); err != nil {
+			return
+		}
+	}
 	// logutil.Infof("mapping is %v", mapping)
 	// logutil.Infof("sortedIdx is %v", sortedIdx)
 
@@ -215,10 +251,12 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	length = 0
 	var blk handle.Block
 	toAddr := make([]uint32, 0, len(vecs))
+	// The sketches of the distinct values of the created blocks
+	sketches := make([]*index.Sketches, 0, len(vecs))
 	// Prepare new block placeholder
 	// Build and flush block index if sort key is defined
 	// Flush sort key it correlates to only one column
-	for _, vec := range vecs {
+	for pos, vec := range vecs {
 		toAddr = append(toAddr, uint32(length))
 		length += vector.Length(vec)
 		blk, err = toSegEntry.CreateNonAppendableBlock()
@@ -227,16 +265,26 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		task.createdBlks = append(task.createdBlks, blk.GetMeta().(*catalog.BlockEntry))
 		meta := blk.GetMeta().(*catalog.BlockEntry)
+		sketches = append(sketches, index.NewSketches())
+		// The rows are replayed from the column files, they must be set
+		// before any column is flushed
+		if err = meta.GetBlockData().GetBlockFile().WriteRows(uint32(vector.Length(vec))); err != nil {
+			return
+		}
 
 		if !schema.HasSortKey() {
 			continue
 		}
 
 		def := schema.SortKey.Defs[0]
+		if schema.SortKey.Size() == 1 {
+			sketches[len(sketches)-1].Add(uint16(def.Idx), vec)
+		}
 
 		// logutil.Infof("Flushing %s %v", meta.AsCommonID().String(), def)
 		// Flush sort key correlated column
 		if schema.SortKey.Size() == 1 {
+			throttleIO(task.scheduler, vec)
 			closure := meta.GetBlockData().FlushColumnDataClosure(ts, def.Idx, vec, false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, meta.AsCommonID(), closure)
 			if err != nil {
@@ -247,7 +295,13 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			}
 		}
 		// Flush index
-		if err = BuildAndFlushIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
+		var blkKeys []*vector.Vector
+		if mergedKeys != nil {
+			for _, def := range schema.SortKey.Defs {
+				blkKeys = append(blkKeys, mergedKeys[def.Idx][pos])
+			}
+		}
+		if err = BuildAndFlushIndex(meta.GetBlockData().GetBlockFile(), meta, vec, blkKeys); err != nil {
 			return
 		}
 		// Replay index
@@ -280,18 +334,27 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if def.IsHidden() || (schema.IsSingleSortKey() && def.IsSortKey()) {
 			continue
 		}
-		vecs = vecs[:0]
-		for _, block := range task.compacted {
-			if view, err = block.GetColumnDataById(def.Idx, nil, nil); err != nil {
+		// The columns of a compound sort key were merged for their zonemaps
+		merged, ok := mergedKeys[def.Idx]
+		if !ok {
+			vecs = vecs[:0]
+			for _, block := range task.compacted {
+				if view, err = block.GetColumnDataById(def.Idx, nil, nil); err != nil {
+					return
+				}
+				vec := view.ApplyDeletes()
+				throttleIO(task.scheduler, vec)
+				vecs = append(vecs, vec)
+			}
+			if merged, _, err = task.mergeColumn(vecs, &sortedIdx, false, rows, to, schema.HasSortKey(), false); err != nil {
 				return
 			}
-			vec := view.ApplyDeletes()
-			vecs = append(vecs, vec)
 		}
-		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to, schema.HasSortKey())
-		for pos, vec := range vecs {
+		for pos, vec := range merged {
 			blk := task.createdBlks[pos]
+			sketches[pos].Add(uint16(def.Idx), vec)
 			// logutil.Infof("Flushing %s %v", blk.AsCommonID().String(), def)
+			throttleIO(task.scheduler, vec)
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, def.Idx, vec, false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
 			if err != nil {
@@ -303,6 +366,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 	for i, blk := range task.createdBlks {
+		if err = FlushSketches(blk.GetBlockData().GetBlockFile(), sketches[i]); err != nil {
+			return
+		}
 		closure := blk.GetBlockData().SyncBlockDataClosure(ts, rows[i])
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
 		if err != nil {
@@ -311,6 +377,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = flushTask.WaitDone(); err != nil {
 			return
 		}
+		event.Bytes += BlockDataSize(blk.GetBlockData().GetBlockFile(), len(schema.ColDefs))
 	}
 	for _, compacted := range task.compacted {
 		seg := compacted.GetSegment()
@@ -75,6 +75,26 @@ func (n *MVCCHandle) HasActiveAppendNode() bool {
 	return txn != nil
 }
 
+// HasActiveUpdateNode returns true if a column has an update not committed
+// yet
+func (n *MVCCHandle) HasActiveUpdateNode() bool {
+	active := false
+	for _, chain := range n.columns {
+		chain.RLock()
+		chain.LoopChainLocked(func(node *ColumnNode) bool {
+			node.RLock()
+			active = node.txn != nil
+			node.RUnlock()
+			return !active
+		}, false)
+		chain.RUnlock()
+		if active {
+			return true
+		}
+	}
+	return false
+}
+
 func (n *MVCCHandle) IncChangeNodeCnt() {
 	atomic.AddUint32(&n.changes, uint32(1))
 }
@@ -228,6 +248,28 @@ func (n *MVCCHandle) CollectAppendLogIndexesLocked(startTs, endTs uint64) (index
 	return
 }
 
+// CollectCommitTSLocked sets the commit ts of the latest change of each of
+// the first len(tss) rows visible to the txn started at ts in tss, which is
+// the commit ts of its append or of its latest column update. The rows of
+// no append node, like the rows of a compacted block, keep their ts in tss.
+func (n *MVCCHandle) CollectCommitTSLocked(ts uint64, tss []uint64) (err error) {
+	row := 0
+	for _, node := range n.appends {
+		for ; row < int(node.GetMaxRow()) && row < len(tss); row++ {
+			tss[row] = node.GetCommitTS()
+		}
+	}
+	for _, chain := range n.columns {
+		chain.RLock()
+		err = chain.CollectCommitTSLocked(ts, tss)
+		chain.RUnlock()
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
 func (n *MVCCHandle) GetMaxVisibleRowLocked(ts uint64) (row uint32, visible bool, err error) {
 	_, row, visible, err = n.getMaxVisibleRowLocked(ts)
 	return
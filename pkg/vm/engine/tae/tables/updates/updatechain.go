@@ -172,6 +172,12 @@ func (chain *ColumnChain) CollectUpdatesLocked(ts uint64) (*roaring.Bitmap, map[
 	return chain.view.CollectUpdates(ts)
 }
 
+// CollectCommitTSLocked raises the commit ts of the rows of tss to the
+// commit ts of their updates visible to the txn started at ts
+func (chain *ColumnChain) CollectCommitTSLocked(ts uint64, tss []uint64) error {
+	return chain.view.CollectCommitTS(ts, tss)
+}
+
 func (chain *ColumnChain) CollectCommittedInRangeLocked(startTs, endTs uint64) (mask *roaring.Bitmap, vals map[uint32]any, indexes []*wal.Index, err error) {
 	var merged *ColumnNode
 	chain.LoopChainLocked(func(n *ColumnNode) bool {
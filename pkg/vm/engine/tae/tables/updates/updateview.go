@@ -60,70 +60,107 @@ func (view *ColumnView) CollectUpdates(ts uint64) (mask *roaring.Bitmap, vals ma
 }
 
 func (view *ColumnView) GetValue(key uint32, startTs uint64) (v any, err error) {
-	link := view.links[key]
-	if link == nil {
+	node, err := view.visibleNode(key, startTs)
+	if node != nil {
+		node.RLock()
+		v, err = node.GetValueLocked(key)
+		node.RUnlock()
+	}
+	if v == nil && err == nil {
 		err = data.ErrNotFound
+	}
+	return
+}
+
+// GetCommitTS returns the commit ts of the latest update of the key visible
+// to the txn started at startTs, which is UncommitTS if the txn itself
+// updated the key
+func (view *ColumnView) GetCommitTS(key uint32, startTs uint64) (ts uint64, err error) {
+	node, err := view.visibleNode(key, startTs)
+	if node == nil {
+		if err == nil {
+			err = data.ErrNotFound
+		}
 		return
 	}
-	head := link.GetHead()
-	for head != nil {
-		node := head.GetPayload().(*ColumnNode)
-		if node.GetStartTS() < startTs {
-			node.RLock()
-			//        |
-			// start \|/ commit
-			// --+----+----+-------------->
-			//   |_________|  next
-			// --|   NODE  |------->
-			//   +---------+
-			// 1. Read ts is between node start and commit. Go to prev node
-			if node.GetCommitTSLocked() > startTs {
-				node.RUnlock()
-				head = head.GetNext()
-				continue
-			} else {
-				v, err = node.GetValueLocked(key)
-				nTxn := node.txn
-				node.RUnlock()
-				// 2. Node was committed and can be used as the data node
-				if nTxn == nil {
-					break
-				}
-				// 3. Node is committing and wait committed or rollbacked
-				state := nTxn.GetTxnState(true)
-				// logutil.Infof("%d -- wait --> %s: state", startTs, nTxn.Repr(), state)
-				if state == txnif.TxnStateCommitted {
-					// 3.1 If committed. use this node
-					break
-				} else if state == txnif.TxnStateRollbacked {
-					// 3.2 If rollbacked. go to prev node
-					err = nil
-					v = nil
-					head = head.GetNext()
-					continue
-				} else if state == txnif.TxnStateCommitting {
-					logutil.Fatal("txn state error")
-				} else if state == txnif.TxnStateUnknown {
-					err = txnif.TxnInternalErr
-					v = nil
-					break
-				}
-			}
+	node.RLock()
+	ts = node.GetCommitTSLocked()
+	node.RUnlock()
+	return
+}
+
+// CollectCommitTS raises the commit ts of each row of tss updated by the
+// update visible to the txn started at startTs to the commit ts of the
+// update
+func (view *ColumnView) CollectCommitTS(startTs uint64, tss []uint64) (err error) {
+	it := view.mask.Iterator()
+	var ts uint64
+	for it.HasNext() {
+		row := it.Next()
+		if int(row) >= len(tss) {
+			break
+		}
+		ts, err = view.GetCommitTS(row, startTs)
+		if err == txnif.TxnInternalErr {
+			return
+		}
+		if err == nil && ts > tss[row] {
+			tss[row] = ts
 		}
+		err = nil
+	}
+	return
+}
+
+// visibleNode returns the node of the latest update of the key visible to
+// the txn started at startTs, nil if there is none
+func (view *ColumnView) visibleNode(key uint32, startTs uint64) (*ColumnNode, error) {
+	link := view.links[key]
+	if link == nil {
+		return nil, nil
+	}
+	for head := link.GetHead(); head != nil; head = head.GetNext() {
+		node := head.GetPayload().(*ColumnNode)
 		if node.GetStartTS() > startTs {
-			head = head.GetNext()
 			continue
 		}
-
+		// The node of the txn itself
+		if node.GetStartTS() == startTs {
+			return node, nil
+		}
 		node.RLock()
-		v, err = node.GetValueLocked(key)
+		//        |
+		// start \|/ commit
+		// --+----+----+-------------->
+		//   |_________|  next
+		// --|   NODE  |------->
+		//   +---------+
+		// 1. Read ts is between node start and commit. Go to prev node
+		if node.GetCommitTSLocked() > startTs {
+			node.RUnlock()
+			continue
+		}
+		nTxn := node.txn
 		node.RUnlock()
-		break
-	}
-	if v == nil && err == nil {
-		err = data.ErrNotFound
+		// 2. Node was committed and can be used as the data node
+		if nTxn == nil {
+			return node, nil
+		}
+		// 3. Node is committing and wait committed or rollbacked
+		state := nTxn.GetTxnState(true)
+		// logutil.Infof("%d -- wait --> %s: state", startTs, nTxn.Repr(), state)
+		if state == txnif.TxnStateRollbacked {
+			// 3.1 If rollbacked. go to prev node
+			continue
+		} else if state == txnif.TxnStateCommitting {
+			logutil.Fatal("txn state error")
+		} else if state == txnif.TxnStateUnknown {
+			return nil, txnif.TxnInternalErr
+		}
+		// 3.2 If committed. use this node
+		return node, nil
 	}
-	return
+	return nil, nil
 }
 
 func (view *ColumnView) PrepapreInsert(key uint32, ts uint64) (err error) {
@@ -35,6 +35,7 @@ type BaseTask struct {
 	ops.Op
 	id       uint64
 	taskType TaskType
+	class    TaskClass
 	exec     func(Task) error
 }
 
@@ -42,6 +43,7 @@ func NewBaseTask(impl base.IOpInternal, taskType TaskType, ctx *Context) *BaseTa
 	task := &BaseTask{
 		id:       NextTaskId(),
 		taskType: taskType,
+		class:    DefaultTaskClass(taskType),
 	}
 	var doneCB ops.OpDoneCB
 	if ctx != nil {
@@ -68,8 +70,14 @@ func (task *BaseTask) onDone(_ base.IOp) {
 	/* Noop */
 }
 func (task *BaseTask) Type() TaskType      { return task.taskType }
+func (task *BaseTask) Class() TaskClass    { return task.class }
 func (task *BaseTask) Cancel() (err error) { panic("todo") }
 func (task *BaseTask) ID() uint64          { return task.id }
+
+// SetClass overrides the class of the task given by its type, it must be set
+// before the task is scheduled
+func (task *BaseTask) SetClass(class TaskClass) { task.class = class }
+
 func (task *BaseTask) Execute() (err error) {
 	if task.exec != nil {
 		return task.exec(task)
@@ -0,0 +1,301 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// TaskClass is the priority class of a task. The queued tasks of a class
+// are run before those of the classes after it.
+type TaskClass uint8
+
+const (
+	// QueryClass is the class of the tasks a query waits for
+	QueryClass TaskClass = iota
+	// CheckpointClass is the class of the checkpoints, which release the
+	// WAL
+	CheckpointClass
+	// CompactionClass is the class of the compactions and the GC of their
+	// results
+	CompactionClass
+
+	// NumTaskClasses is the number of the task classes
+	NumTaskClasses = int(CompactionClass) + 1
+)
+
+func (c TaskClass) String() string {
+	switch c {
+	case QueryClass:
+		return "query"
+	case CheckpointClass:
+		return "checkpoint"
+	case CompactionClass:
+		return "compaction"
+	}
+	return fmt.Sprintf("unknown class %d", uint8(c))
+}
+
+// DefaultTaskClass returns the class of the tasks of the type. The IO and
+// sort tasks are scheduled by the compactions, so are of their class.
+func DefaultTaskClass(taskType TaskType) TaskClass {
+	switch taskType {
+	case CheckpointTask:
+		return CheckpointClass
+	case DataCompactionTask, GCTask, IOTask, SortTask:
+		return CompactionClass
+	}
+	return QueryClass
+}
+
+// ClassStats is the number of the tasks of a class in a priority handler
+type ClassStats struct {
+	Class   TaskClass
+	Queued  int
+	Running int
+	// Quota is the number of the tasks of the class allowed to run at the
+	// same time, 0 if it is limited by the workers only
+	Quota int
+	Done  uint64
+}
+
+// PriorityHandler runs the tasks of all the classes by a number of workers.
+// A free worker takes the first queued task of the class of the highest
+// priority which has not used up its quota, so the tasks of a class saturated
+// beyond its quota never hold the workers left to the other classes.
+type PriorityHandler struct {
+	name    string
+	workers int
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queues  [NumTaskClasses][]Task
+	running [NumTaskClasses]int
+	quotas  [NumTaskClasses]int
+	done    [NumTaskClasses]uint64
+	// serial is set for the classes whose scoped tasks run one at a time per
+	// scope, in the order they are queued
+	serial   [NumTaskClasses]bool
+	busy     []*common.ID
+	observer func(ClassStats)
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func NewPriorityHandler(name string, workers int) *PriorityHandler {
+	if workers <= 0 {
+		panic(fmt.Sprintf("bad param: %d workers of %s", workers, name))
+	}
+	h := &PriorityHandler{
+		name:    name,
+		workers: workers,
+	}
+	h.cond = sync.NewCond(&h.mu)
+	return h
+}
+
+// SetQuota limits the tasks of the class running at the same time, 0 for no
+// limit other than the workers. It may be changed while the tasks run, the
+// running tasks beyond a lowered quota are not interrupted.
+func (h *PriorityHandler) SetQuota(class TaskClass, quota int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.quotas[class] = quota
+	h.notifyLocked(class)
+	h.cond.Broadcast()
+}
+
+// Quota returns the quota of the class
+func (h *PriorityHandler) Quota(class TaskClass) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.quotas[class]
+}
+
+// SerializeScopes runs the scoped tasks of the class one at a time per scope,
+// in the order they are queued. The tasks of the same scope are those of the
+// same segment as IsSameScope.
+func (h *PriorityHandler) SerializeScopes(class TaskClass) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.serial[class] = true
+}
+
+// SetObserver sets the function called with the stats of a class whenever
+// they change, it must not call the handler
+func (h *PriorityHandler) SetObserver(fn func(ClassStats)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.observer = fn
+}
+
+// Stats returns the stats of all the classes
+func (h *PriorityHandler) Stats() []ClassStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	stats := make([]ClassStats, NumTaskClasses)
+	for i := range stats {
+		stats[i] = h.statsLocked(TaskClass(i))
+	}
+	return stats
+}
+
+func (h *PriorityHandler) statsLocked(class TaskClass) ClassStats {
+	return ClassStats{
+		Class:   class,
+		Queued:  len(h.queues[class]),
+		Running: h.running[class],
+		Quota:   h.quotas[class],
+		Done:    h.done[class],
+	}
+}
+
+func (h *PriorityHandler) notifyLocked(class TaskClass) {
+	if h.observer != nil {
+		h.observer(h.statsLocked(class))
+	}
+}
+
+func (h *PriorityHandler) Start() {
+	for i := 0; i < h.workers; i++ {
+		h.wg.Add(1)
+		go h.work()
+	}
+}
+
+func (h *PriorityHandler) Enqueue(task Task) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		task.SetError(ErrTaskHandleEnqueue)
+		return
+	}
+	class := task.Class()
+	h.queues[class] = append(h.queues[class], task)
+	h.notifyLocked(class)
+	h.mu.Unlock()
+	h.cond.Signal()
+}
+
+func (h *PriorityHandler) Execute(task Task) {
+	task.SetError(task.OnExec())
+}
+
+// Close stops the workers after the queued tasks are run
+func (h *PriorityHandler) Close() error {
+	h.mu.Lock()
+	h.closed = true
+	h.mu.Unlock()
+	h.cond.Broadcast()
+	h.wg.Wait()
+	return nil
+}
+
+func (h *PriorityHandler) work() {
+	defer h.wg.Done()
+	for {
+		h.mu.Lock()
+		task := h.nextLocked()
+		for task == nil {
+			if h.closed && h.emptyLocked() {
+				h.mu.Unlock()
+				return
+			}
+			h.cond.Wait()
+			task = h.nextLocked()
+		}
+		class := task.Class()
+		h.running[class]++
+		scope := h.scopeLocked(task)
+		if scope != nil {
+			h.busy = append(h.busy, scope)
+		}
+		h.notifyLocked(class)
+		h.mu.Unlock()
+
+		// the stats are updated before the task is done, for its waiters
+		err := task.OnExec()
+
+		h.mu.Lock()
+		h.running[class]--
+		h.done[class]++
+		if scope != nil {
+			for i, id := range h.busy {
+				if id == scope {
+					h.busy = append(h.busy[:i], h.busy[i+1:]...)
+					break
+				}
+			}
+		}
+		h.notifyLocked(class)
+		h.mu.Unlock()
+		h.cond.Broadcast()
+		task.SetError(err)
+	}
+}
+
+// nextLocked removes and returns the next task to run, nil if none can run
+func (h *PriorityHandler) nextLocked() Task {
+	for class := range h.queues {
+		if quota := h.quotas[class]; quota > 0 && h.running[class] >= quota {
+			continue
+		}
+		for i, task := range h.queues[class] {
+			if scope := h.scopeLocked(task); scope != nil && h.isBusyLocked(scope) {
+				continue
+			}
+			h.queues[class] = append(h.queues[class][:i], h.queues[class][i+1:]...)
+			h.notifyLocked(TaskClass(class))
+			return task
+		}
+	}
+	return nil
+}
+
+// nilScope is the scope of the scoped tasks without one
+var nilScope = new(common.ID)
+
+// scopeLocked returns the scope serializing the task, nil if the task is
+// not serialized
+func (h *PriorityHandler) scopeLocked(task Task) *common.ID {
+	scoped, ok := task.(ScopedTask)
+	if !ok || !h.serial[task.Class()] {
+		return nil
+	}
+	if scope := scoped.Scope(); scope != nil {
+		return scope
+	}
+	return nilScope
+}
+
+func (h *PriorityHandler) isBusyLocked(scope *common.ID) bool {
+	for _, id := range h.busy {
+		if id == scope || (id != nilScope && scope != nilScope && IsSameScope(id, scope)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *PriorityHandler) emptyLocked() bool {
+	for _, queue := range h.queues {
+		if len(queue) > 0 {
+			return false
+		}
+	}
+	return true
+}
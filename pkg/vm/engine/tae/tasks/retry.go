@@ -0,0 +1,170 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+var (
+	ErrTaskBackoff  = errors.New("tae task: task is backing off after a failure")
+	ErrTaskPoisoned = errors.New("tae task: task is poisoned by repeated failures")
+)
+
+// RetryPolicy is the policy of retrying the failed tasks. A failed task is
+// retried after a backoff which doubles with each failure in a row up to
+// MaxBackoff, it is poisoned after MaxFailures failures in a row and not run
+// again until its poison is cleared.
+type RetryPolicy struct {
+	MaxFailures    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns the delay before the retry of a task failed the times in a
+// row
+func (p RetryPolicy) Backoff(failures int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < failures && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// RetryKey is the key of the tasks scheduled again and again for the same
+// work, the tasks of a key share their failures. The scope of the work on a
+// segment has no block id.
+type RetryKey struct {
+	Type  TaskType
+	Scope common.ID
+}
+
+// RetryState is the state of the retries of a key
+type RetryState struct {
+	Key       RetryKey
+	Failures  int
+	Poisoned  bool
+	NextRetry time.Time
+	LastError string
+}
+
+// RetryTracker tracks the failures of the tasks by their keys. The scheduler
+// asks it whether a task may run before the task is queued, so the tasks
+// backing off or poisoned never take a place in the queue.
+type RetryTracker struct {
+	mu     sync.Mutex
+	policy RetryPolicy
+	states map[RetryKey]*RetryState
+	now    func() time.Time
+}
+
+func NewRetryTracker(policy RetryPolicy) *RetryTracker {
+	return &RetryTracker{
+		policy: policy,
+		states: make(map[RetryKey]*RetryState),
+		now:    time.Now,
+	}
+}
+
+// Allow returns nil if the task of the key may run, ErrTaskBackoff if it is
+// backing off after a failure or ErrTaskPoisoned if it is poisoned
+func (t *RetryTracker) Allow(key RetryKey) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state := t.states[key]
+	if state == nil {
+		return nil
+	}
+	if state.Poisoned {
+		return ErrTaskPoisoned
+	}
+	if t.now().Before(state.NextRetry) {
+		return ErrTaskBackoff
+	}
+	return nil
+}
+
+// Report records the result of a task of the key. A success forgets the
+// failures of the key, a failure backs the key off or poisons it. It returns
+// the state of the key after the result.
+func (t *RetryTracker) Report(key RetryKey, err error) RetryState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err == nil {
+		delete(t.states, key)
+		return RetryState{Key: key}
+	}
+	state := t.states[key]
+	if state == nil {
+		state = &RetryState{Key: key}
+		t.states[key] = state
+	}
+	state.Failures++
+	state.LastError = err.Error()
+	if t.policy.MaxFailures > 0 && state.Failures >= t.policy.MaxFailures {
+		state.Poisoned = true
+	}
+	state.NextRetry = t.now().Add(t.policy.Backoff(state.Failures))
+	return *state
+}
+
+// Clear forgets the failures of the keys matched, it returns the number of
+// the poisoned keys cleared
+func (t *RetryTracker) Clear(match func(RetryKey) bool) (cleared int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, state := range t.states {
+		if !match(key) {
+			continue
+		}
+		if state.Poisoned {
+			cleared++
+		}
+		delete(t.states, key)
+	}
+	return
+}
+
+// States returns the states of the keys which failed, ordered by the keys
+func (t *RetryTracker) States() []RetryState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	states := make([]RetryState, 0, len(t.states))
+	for _, state := range t.states {
+		states = append(states, *state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		a, b := states[i].Key, states[j].Key
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Scope.TableID != b.Scope.TableID {
+			return a.Scope.TableID < b.Scope.TableID
+		}
+		if a.Scope.SegmentID != b.Scope.SegmentID {
+			return a.Scope.SegmentID < b.Scope.SegmentID
+		}
+		return a.Scope.BlockID < b.Scope.BlockID
+	})
+	return states
+}
@@ -43,6 +43,9 @@ type TaskScheduler interface {
 	ScheduleFn(ctx *Context, taskType TaskType, fn func() error) (Task, error)
 	ScheduleScopedFn(ctx *Context, taskType TaskType, scope *common.ID, fn func() error) (Task, error)
 	Checkpoint(indexes []*wal.Index) error
+	// IOThrottle returns the throttle consulted by the compactions between
+	// their block reads and writes
+	IOThrottle() *IOThrottle
 
 	GetCheckpointedLSN() uint64
 	GetPenddingLSNCnt() uint64
@@ -0,0 +1,107 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"sync"
+	"time"
+)
+
+// IOThrottle is a token bucket limiting the bytes read and written by the
+// tasks consulting it. A task takes the tokens of its bytes before each
+// read or write, and waits while the bucket is in debt. A nil throttle or a
+// rate of 0 limits nothing.
+type IOThrottle struct {
+	mu sync.Mutex
+	// rate is the bytes per second refilled
+	rate int64
+	// burst is the max tokens saved while the tasks are idle
+	burst  int64
+	tokens float64
+	last   time.Time
+	waited time.Duration
+}
+
+func NewIOThrottle(rate, burst int64) *IOThrottle {
+	return &IOThrottle{
+		rate:   rate,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// SetRate changes the bytes per second of the throttle, 0 for no limit
+func (t *IOThrottle) SetRate(rate int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refillLocked(time.Now())
+	t.rate = rate
+}
+
+// Rate returns the bytes per second of the throttle
+func (t *IOThrottle) Rate() int64 {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rate
+}
+
+// Waited returns the total time the tasks waited for the throttle
+func (t *IOThrottle) Waited() time.Duration {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.waited
+}
+
+// Wait takes the tokens of n bytes, and waits until the debt of the bucket
+// is repaid if it has not enough
+func (t *IOThrottle) Wait(n int64) {
+	if t == nil || n <= 0 {
+		return
+	}
+	t.mu.Lock()
+	if t.rate <= 0 {
+		t.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	t.refillLocked(now)
+	t.tokens -= float64(n)
+	var wait time.Duration
+	if t.tokens < 0 {
+		wait = time.Duration(-t.tokens / float64(t.rate) * float64(time.Second))
+		t.waited += wait
+	}
+	t.mu.Unlock()
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+func (t *IOThrottle) refillLocked(now time.Time) {
+	if t.rate > 0 {
+		t.tokens += now.Sub(t.last).Seconds() * float64(t.rate)
+		if t.tokens > float64(t.burst) {
+			t.tokens = float64(t.burst)
+		}
+	}
+	t.last = now
+}
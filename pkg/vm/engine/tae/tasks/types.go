@@ -42,6 +42,8 @@ const (
 	CheckpointTask
 	GCTask
 	IOTask
+	// SortTask sorts or merges a part of the rows of a compaction
+	SortTask
 )
 
 func init() {
@@ -58,6 +60,8 @@ type Task interface {
 	base.IOp
 	ID() uint64
 	Type() TaskType
+	// Class returns the priority class of the task
+	Class() TaskClass
 	Cancel() error
 }
 
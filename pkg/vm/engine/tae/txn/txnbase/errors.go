@@ -14,7 +14,11 @@
 
 package txnbase
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
 
 var (
 	ErrTxnAlreadyCommitted = errors.New("tae: txn already committed")
@@ -24,4 +28,9 @@ var (
 	ErrTxnCannotRollback   = errors.New("tae: txn cannot txn rollback")
 
 	ErrDDLDropCreated = errors.New("tae: DDL cannot drop created in a txn")
+
+	ErrLockWaitTimeout  = moerr.NewError(moerr.LOCK_WAIT_TIMEOUT, "tae: lock wait timeout exceeded")
+	ErrLockConflict     = moerr.NewError(moerr.LOCK_NOWAIT, "tae: row is locked by another txn")
+	ErrDeadlock         = moerr.NewError(moerr.DEADLOCK, "tae: deadlock found when trying to get lock")
+	ErrLockedRowChanged = moerr.NewError(moerr.TXN_WW_CONFLICT, "tae: locked row was changed by a committed txn")
 )
@@ -68,6 +68,7 @@ func (rel *TxnRelation) MakeSegmentIt() handle.SegmentIt
 func (rel *TxnRelation) MakeBlockIt() handle.BlockIt                                          { return nil }
 func (rel *TxnRelation) BatchDedup(cols ...*vector.Vector) error                              { return nil }
 func (rel *TxnRelation) Append(data *batch.Batch) error                                       { return nil }
+func (rel *TxnRelation) AppendNoCopy(data *batch.Batch) error                                 { return nil }
 func (rel *TxnRelation) GetMeta() any                                                         { return nil }
 func (rel *TxnRelation) GetSegment(id uint64) (seg handle.Segment, err error)                 { return }
 func (rel *TxnRelation) SoftDeleteSegment(id uint64) (err error)                              { return }
@@ -80,6 +81,7 @@ func (rel *TxnRelation) Update(*common.ID, uint32, uint16, any) (err error)
 func (rel *TxnRelation) DeleteByHiddenKey(any) (err error)                                    { return }
 func (rel *TxnRelation) DeleteByHiddenKeys(*vector.Vector) (err error)                        { return }
 func (rel *TxnRelation) RangeDelete(*common.ID, uint32, uint32) (err error)                   { return }
+func (rel *TxnRelation) LockRows(*common.ID, []uint32) (err error)                            { return }
 func (rel *TxnRelation) GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error) { return }
 func (rel *TxnRelation) GetValueByFilter(filter *handle.Filter, col int) (v any, err error)   { return }
 func (rel *TxnRelation) UpdateByFilter(filter *handle.Filter, col uint16, v any) (err error)  { return }
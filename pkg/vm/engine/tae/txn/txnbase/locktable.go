@@ -0,0 +1,216 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+const DefaultLockWaitTimeout = 50 * time.Second
+
+type blockKey struct {
+	tableID, segmentID, blockID uint64
+}
+
+func newBlockKey(id *common.ID) blockKey {
+	return blockKey{
+		tableID:   id.TableID,
+		segmentID: id.SegmentID,
+		blockID:   id.BlockID,
+	}
+}
+
+// rowLock is an exclusive intention lock on a row. dirty is set once the
+// owner writes the row and committed once the owner committed, so that a
+// waiter can tell whether the row it waited on was changed under its snapshot
+type rowLock struct {
+	owner     uint64
+	dirty     bool
+	committed bool
+}
+
+type lockHolder struct {
+	rows map[blockKey][]uint32
+	done chan struct{}
+}
+
+// LockTable keeps the row locks taken by SELECT ... FOR UPDATE, keyed by
+// block id + row offset, which is what a hidden key encodes. Locks are held
+// until the owner txn is committed or rollbacked. A conflicting txn either
+// fails fast or waits for the owner with a timeout, and a simple wait-for
+// graph rejects the waits that would close a cycle
+type LockTable struct {
+	sync.Mutex
+	blocks  map[blockKey]map[uint32]*rowLock
+	holders map[uint64]*lockHolder
+	waitFor map[uint64]uint64
+	timeout time.Duration
+	noWait  bool
+}
+
+func NewLockTable() *LockTable {
+	return &LockTable{
+		blocks:  make(map[blockKey]map[uint32]*rowLock),
+		holders: make(map[uint64]*lockHolder),
+		waitFor: make(map[uint64]uint64),
+		timeout: DefaultLockWaitTimeout,
+	}
+}
+
+func (t *LockTable) SetWaitPolicy(timeout time.Duration, noWait bool) {
+	t.Lock()
+	defer t.Unlock()
+	if timeout > 0 {
+		t.timeout = timeout
+	}
+	t.noWait = noWait
+}
+
+// LockRows locks the specified rows of a block on behalf of txn
+func (t *LockTable) LockRows(txn uint64, id *common.ID, rows ...uint32) (err error) {
+	key := newBlockKey(id)
+	t.Lock()
+	defer t.Unlock()
+	for _, row := range rows {
+		for {
+			locks := t.blocks[key]
+			if locks == nil {
+				locks = make(map[uint32]*rowLock)
+				t.blocks[key] = locks
+			}
+			lock := locks[row]
+			if lock == nil {
+				locks[row] = &rowLock{owner: txn}
+				holder := t.getOrCreateHolderLocked(txn)
+				holder.rows[key] = append(holder.rows[key], row)
+				break
+			}
+			if lock.owner == txn {
+				break
+			}
+			if err = t.waitLocked(txn, lock); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// WaitUnlocked is called before txn writes the rows [start, end] of a block.
+// It waits until none of the rows is locked by another txn and marks the
+// rows locked by txn itself as dirty
+func (t *LockTable) WaitUnlocked(txn uint64, id *common.ID, start, end uint32) (err error) {
+	key := newBlockKey(id)
+	t.Lock()
+	defer t.Unlock()
+	for {
+		var blocker *rowLock
+		for row, lock := range t.blocks[key] {
+			if row < start || row > end {
+				continue
+			}
+			if lock.owner == txn {
+				lock.dirty = true
+				continue
+			}
+			blocker = lock
+			break
+		}
+		if blocker == nil {
+			return
+		}
+		if err = t.waitLocked(txn, blocker); err != nil {
+			return
+		}
+	}
+}
+
+// Release releases all the locks held by txn and wakes up its waiters
+func (t *LockTable) Release(txn uint64, committed bool) {
+	t.Lock()
+	defer t.Unlock()
+	holder := t.holders[txn]
+	if holder == nil {
+		return
+	}
+	delete(t.holders, txn)
+	for key, rows := range holder.rows {
+		locks := t.blocks[key]
+		for _, row := range rows {
+			locks[row].committed = committed
+			delete(locks, row)
+		}
+		if len(locks) == 0 {
+			delete(t.blocks, key)
+		}
+	}
+	close(holder.done)
+}
+
+// LockedRows returns the count of rows locked by txn
+func (t *LockTable) LockedRows(txn uint64) (cnt int) {
+	t.Lock()
+	defer t.Unlock()
+	if holder := t.holders[txn]; holder != nil {
+		for _, rows := range holder.rows {
+			cnt += len(rows)
+		}
+	}
+	return
+}
+
+func (t *LockTable) getOrCreateHolderLocked(txn uint64) *lockHolder {
+	holder := t.holders[txn]
+	if holder == nil {
+		holder = &lockHolder{
+			rows: make(map[blockKey][]uint32),
+			done: make(chan struct{}),
+		}
+		t.holders[txn] = holder
+	}
+	return holder
+}
+
+// waitLocked waits for the owner of lock to release it. It is called with
+// the table locked and returns with the table locked
+func (t *LockTable) waitLocked(txn uint64, lock *rowLock) (err error) {
+	if t.noWait {
+		return ErrLockConflict
+	}
+	for next, ok := lock.owner, true; ok; next, ok = t.waitFor[next] {
+		if next == txn {
+			return ErrDeadlock
+		}
+	}
+	holder := t.holders[lock.owner]
+	t.waitFor[txn] = lock.owner
+	timer := time.NewTimer(t.timeout)
+	t.Unlock()
+	select {
+	case <-holder.done:
+	case <-timer.C:
+		err = ErrLockWaitTimeout
+	}
+	timer.Stop()
+	t.Lock()
+	delete(t.waitFor, txn)
+	if err == nil && lock.dirty && lock.committed {
+		err = ErrLockedRowChanged
+	}
+	return
+}
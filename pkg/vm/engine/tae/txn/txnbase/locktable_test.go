@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockTable(t *testing.T) {
+	locks := NewLockTable()
+	locks.SetWaitPolicy(time.Second, false)
+	blk1 := &common.ID{TableID: 1, SegmentID: 1, BlockID: 1}
+	blk2 := &common.ID{TableID: 1, SegmentID: 1, BlockID: 2}
+
+	assert.NoError(t, locks.LockRows(1, blk1, 1, 2, 3))
+	assert.NoError(t, locks.LockRows(1, blk1, 2))
+	assert.Equal(t, 3, locks.LockedRows(1))
+	assert.NoError(t, locks.LockRows(2, blk2, 1))
+	assert.NoError(t, locks.WaitUnlocked(2, blk1, 4, 10))
+
+	// txn 2 waits for txn 1, txn 1 waiting for txn 2 is a deadlock
+	done := make(chan error, 1)
+	go func() {
+		done <- locks.LockRows(2, blk1, 3)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, ErrDeadlock, locks.WaitUnlocked(1, blk2, 0, 1))
+
+	// the waiter gets the lock once txn 1 is terminated without changes
+	locks.Release(1, true)
+	assert.NoError(t, <-done)
+	assert.Equal(t, 0, locks.LockedRows(1))
+	assert.Equal(t, 2, locks.LockedRows(2))
+
+	// the waiter fails if the row is changed by the committed owner
+	go func() {
+		done <- locks.WaitUnlocked(3, blk2, 1, 1)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, locks.WaitUnlocked(2, blk2, 0, 5))
+	locks.Release(2, true)
+	assert.Equal(t, ErrLockedRowChanged, <-done)
+
+	// but not if the owner is rollbacked
+	assert.NoError(t, locks.LockRows(4, blk2, 1))
+	go func() {
+		done <- locks.WaitUnlocked(3, blk2, 1, 1)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, locks.WaitUnlocked(4, blk2, 1, 1))
+	locks.Release(4, false)
+	assert.NoError(t, <-done)
+
+	assert.NoError(t, locks.LockRows(5, blk1, 1))
+	locks.SetWaitPolicy(20*time.Millisecond, false)
+	assert.Equal(t, ErrLockWaitTimeout, locks.LockRows(6, blk1, 1))
+	locks.SetWaitPolicy(0, true)
+	assert.Equal(t, ErrLockConflict, locks.WaitUnlocked(6, blk1, 0, 1))
+	locks.Release(5, false)
+	assert.NoError(t, locks.LockRows(6, blk1, 1))
+	locks.Release(6, false)
+	assert.Empty(t, locks.blocks)
+	assert.Empty(t, locks.holders)
+	assert.Empty(t, locks.waitFor)
+}
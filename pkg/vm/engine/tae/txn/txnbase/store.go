@@ -26,16 +26,17 @@ var NoopStoreFactory = func() txnif.TxnStore { return new(NoopTxnStore) }
 
 type NoopTxnStore struct{}
 
-func (store *NoopTxnStore) GetLSN() uint64                                  { return 0 }
-func (store *NoopTxnStore) BindTxn(txn txnif.AsyncTxn)                      {}
-func (store *NoopTxnStore) Close() error                                    { return nil }
-func (store *NoopTxnStore) Append(dbId, id uint64, data *batch.Batch) error { return nil }
-func (store *NoopTxnStore) PrepareRollback() error                          { return nil }
-func (store *NoopTxnStore) PreCommit() error                                { return nil }
-func (store *NoopTxnStore) PrepareCommit() error                            { return nil }
-func (store *NoopTxnStore) ApplyRollback() error                            { return nil }
-func (store *NoopTxnStore) PreApplyCommit() error                           { return nil }
-func (store *NoopTxnStore) ApplyCommit() error                              { return nil }
+func (store *NoopTxnStore) GetLSN() uint64                                        { return 0 }
+func (store *NoopTxnStore) BindTxn(txn txnif.AsyncTxn)                            {}
+func (store *NoopTxnStore) Close() error                                          { return nil }
+func (store *NoopTxnStore) Append(dbId, id uint64, data *batch.Batch) error       { return nil }
+func (store *NoopTxnStore) AppendNoCopy(dbId, id uint64, data *batch.Batch) error { return nil }
+func (store *NoopTxnStore) PrepareRollback() error                                { return nil }
+func (store *NoopTxnStore) PreCommit() error                                      { return nil }
+func (store *NoopTxnStore) PrepareCommit() error                                  { return nil }
+func (store *NoopTxnStore) ApplyRollback() error                                  { return nil }
+func (store *NoopTxnStore) PreApplyCommit() error                                 { return nil }
+func (store *NoopTxnStore) ApplyCommit() error                                    { return nil }
 
 func (store *NoopTxnStore) AddTxnEntry(t txnif.TxnEntryType, entry txnif.TxnEntry) {}
 
@@ -72,6 +73,7 @@ func (store *NoopTxnStore) Update(uint64, *common.ID, uint32, uint16, any) (err
 	return
 }
 func (store *NoopTxnStore) RangeDelete(uint64, *common.ID, uint32, uint32) (err error) { return }
+func (store *NoopTxnStore) LockRows(*common.ID, []uint32) (err error)                  { return }
 func (store *NoopTxnStore) GetByFilter(uint64, uint64, *handle.Filter) (id *common.ID, offset uint32, err error) {
 	return
 }
@@ -87,3 +89,6 @@ func (store *NoopTxnStore) LogTxnEntry(dbId, tableId uint64, entry txnif.TxnEntr
 
 func (store *NoopTxnStore) IsReadonly() bool      { return false }
 func (store *NoopTxnStore) IncreateWriteCnt() int { return 0 }
+
+func (store *NoopTxnStore) Savepoint() txnif.Savepoint                        { return nil }
+func (store *NoopTxnStore) RollbackToSavepoint(_ txnif.Savepoint) (err error) { return }
@@ -115,6 +115,15 @@ func (txn *Txn) GetStore() txnif.TxnStore {
 
 func (txn *Txn) GetLSN() uint64 { return txn.LSN }
 
+// Savepoint returns the state of the writes of the txn
+func (txn *Txn) Savepoint() txnif.Savepoint { return txn.Store.Savepoint() }
+
+// RollbackToSavepoint undoes the writes made after the savepoint, the txn
+// stays active
+func (txn *Txn) RollbackToSavepoint(sp txnif.Savepoint) error {
+	return txn.Store.RollbackToSavepoint(sp)
+}
+
 func (txn *Txn) Rollback() (err error) {
 	if txn.Store.IsReadonly() {
 		txn.Mgr.DeleteTxn(txn.GetID())
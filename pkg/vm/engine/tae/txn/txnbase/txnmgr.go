@@ -39,6 +39,7 @@ type TxnManager struct {
 	TxnFactory       TxnFactory
 	ActiveMask       *roaring64.Bitmap
 	Exception        *atomic.Value
+	Locks            *LockTable
 }
 
 func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnManager {
@@ -53,6 +54,7 @@ func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnM
 		TxnFactory:      txnFactory,
 		ActiveMask:      roaring64.New(),
 		Exception:       new(atomic.Value),
+		Locks:           NewLockTable(),
 	}
 	pqueue := sm.NewSafeQueue(20000, 1000, mgr.onPreparing)
 	cqueue := sm.NewSafeQueue(20000, 1000, mgr.onCommit)
@@ -105,10 +107,11 @@ func (mgr *TxnManager) StartTxn(info []byte) (txn txnif.AsyncTxn, err error) {
 
 func (mgr *TxnManager) DeleteTxn(id uint64) {
 	mgr.Lock()
-	defer mgr.Unlock()
 	txn := mgr.Active[id]
 	delete(mgr.Active, id)
 	mgr.ActiveMask.Remove(txn.GetStartTS())
+	mgr.Unlock()
+	mgr.Locks.Release(id, txn.GetTxnState(false) == txnif.TxnStateCommitted)
 }
 
 func (mgr *TxnManager) GetTxnByCtx(ctx []byte) txnif.AsyncTxn {
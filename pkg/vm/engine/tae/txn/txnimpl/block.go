@@ -198,6 +198,25 @@ func (blk *txnBlock) GetColumnDataByName(attr string, compressed, decompressed *
 	return blk.entry.GetBlockData().GetColumnDataByName(blk.Txn, attr, compressed, decompressed)
 }
 
+func (blk *txnBlock) GetCommitTSColumn() (*model.ColumnView, error) {
+	if blk.isUncommitted {
+		return blk.table.localSegment.GetCommitTSColumn(blk.entry)
+	}
+	return blk.entry.GetBlockData().GetCommitTSColumn(blk.Txn)
+}
+
+func (blk *txnBlock) GetMaxCommitTS() uint64 {
+	if blk.isUncommitted {
+		return txnif.UncommitTS
+	}
+	ts := blk.entry.GetBlockData().GetMaxCommitTS()
+	// the changes committed after the txn started are not visible to it
+	if ts != txnif.UncommitTS && ts > blk.Txn.GetStartTS() {
+		ts = blk.Txn.GetStartTS()
+	}
+	return ts
+}
+
 func (blk *txnBlock) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return blk.Txn.GetStore().LogTxnEntry(blk.getDBID(), blk.entry.GetSegment().GetTable().GetID(), entry, readed)
 }
@@ -273,7 +273,7 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := vals.([]uint64)
 		if dedupCol {
 			set := make(map[uint64]bool)
@@ -406,6 +406,25 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
+	case types.T_time:
+		data := vals.([]types.Time)
+		if dedupCol {
+			set := make(map[types.Time]bool)
+			for _, v := range data[start : start+count] {
+				if _, ok := set[v]; ok {
+					return idata.ErrDuplicate
+				}
+				set[v] = true
+			}
+			break
+		}
+		for _, v := range data[start : start+count] {
+			if _, ok := idx.tree[v]; ok {
+				return idata.ErrDuplicate
+			}
+			idx.tree[v] = row
+			row++
+		}
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		if dedupCol {
@@ -516,7 +535,7 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return idata.ErrDuplicate
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		data := vals.([]uint64)
 		for _, v := range data {
 			if _, ok := idx.tree[v]; ok {
@@ -572,6 +591,13 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return idata.ErrDuplicate
 			}
 		}
+	case types.T_time:
+		data := vals.([]types.Time)
+		for _, v := range data {
+			if _, ok := idx.tree[v]; ok {
+				return idata.ErrDuplicate
+			}
+		}
 	case types.T_char, types.T_varchar, types.T_json:
 		data := vals.(*types.Bytes)
 		// bytes := make([]string, 0, len(data.Lengths))
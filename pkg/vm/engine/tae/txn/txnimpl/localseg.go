@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
@@ -178,6 +179,18 @@ func (seg *localSegment) prepareApplyNode(node InsertNode) (err error) {
 }
 
 func (seg *localSegment) Append(data *batch.Batch) (err error) {
+	return seg.append(data, false)
+}
+
+// AppendNoCopy is Append referencing the values of the fixed size columns
+// of data in the insert nodes instead of copying them where it can. The
+// nodes are copied to the blocks when the txn is committed, so the values
+// must not be modified until the txn is terminated.
+func (seg *localSegment) AppendNoCopy(data *batch.Batch) (err error) {
+	return seg.append(data, true)
+}
+
+func (seg *localSegment) append(data *batch.Batch, noCopy bool) (err error) {
 	if seg.appendable == nil {
 		seg.registerInsertNode()
 	}
@@ -191,7 +204,11 @@ func (seg *localSegment) Append(data *batch.Batch) (err error) {
 		size := compute.EstimateSize(data, offset, toAppend)
 		logutil.Debugf("Offset=%d, ToAppend=%d, EstimateSize=%d", offset, toAppend, size)
 		err = n.Expand(size, func() error {
-			appended, err = n.Append(data, offset)
+			if noCopy {
+				appended, err = n.AppendNoCopy(data, offset)
+			} else {
+				appended, err = n.Append(data, offset)
+			}
 			return err
 		})
 		if err != nil {
@@ -241,13 +258,17 @@ func (seg *localSegment) DeleteSingleIndex(from, to uint32, node InsertNode) (er
 func (seg *localSegment) DeleteCompoundIndex(from, to uint32, node InsertNode) (err error) {
 	var buf bytes.Buffer
 	vs := make([]any, seg.table.schema.GetSortKeyCnt())
+	typs := make([]types.Type, len(vs))
+	for j := range typs {
+		typs[j] = seg.table.schema.SortKey.Defs[j].Type
+	}
 	for i := from; i <= to; i++ {
 		buf.Reset()
 		for j := range vs {
 			v, _ := node.GetValue(seg.table.schema.SortKey.Defs[j].Idx, i)
 			vs[j] = v
 		}
-		key := model.EncodeTypedVals(&buf, vs...)
+		key := model.EncodeTypedVals(&buf, typs, vs...)
 		if err = seg.index.Delete(key); err != nil {
 			break
 		}
@@ -423,6 +444,21 @@ func (seg *localSegment) GetColumnDataById(
 	return
 }
 
+// GetCommitTSColumn returns the view of the commit ts of the rows appended
+// to the block by the txn, which are UncommitTS
+func (seg *localSegment) GetCommitTSColumn(blk *catalog.BlockEntry) (view *model.ColumnView, err error) {
+	if view, err = seg.GetColumnDataById(blk, 0, nil, nil); err != nil {
+		return
+	}
+	tss := make([]uint64, vector.Length(view.AppliedVec))
+	for i := range tss {
+		tss[i] = txnif.UncommitTS
+	}
+	view.ColIdx = -1
+	view.AppliedVec = compute.Uint64Vector(tss)
+	return
+}
+
 func (seg *localSegment) GetBlockRows(blk *catalog.BlockEntry) int {
 	npos := int(blk.ID)
 	n := seg.nodes[npos]
@@ -57,6 +57,8 @@ type InsertNode interface {
 	Append(data *gbat.Batch, offset uint32) (appended uint32, err error)
 	RangeDelete(start, end uint32) error
 	IsRowDeleted(row uint32) bool
+	CloneDeletes() *roaring.Bitmap
+	SetDeletes(*roaring.Bitmap)
 	PrintDeletes() string
 	FillColumnView(*model.ColumnView, *bytes.Buffer, *bytes.Buffer) error
 	Window(start, end uint32) (*gbat.Batch, error)
@@ -372,12 +374,45 @@ func (n *insertNode) PrepareAppend(data *gbat.Batch, offset uint32) uint32 {
 }
 
 func (n *insertNode) Append(data *gbat.Batch, offset uint32) (an uint32, err error) {
+	return n.append(data, offset, false)
+}
+
+// AppendNoCopy is Append referencing the values of the fixed size columns
+// of data instead of copying them. They are only referenced if the node is
+// empty and data fills it up, so the readonly vectors referencing them are
+// never appended.
+func (n *insertNode) AppendNoCopy(data *gbat.Batch, offset uint32) (an uint32, err error) {
+	fills := uint32(gvec.Length(data.Vecs[0]))-offset >= txnbase.MaxNodeRows
+	return n.append(data, offset, n.data == nil && fills)
+}
+
+func (n *insertNode) append(data *gbat.Batch, offset uint32, noCopy bool) (an uint32, err error) {
 	schema := n.table.entry.GetSchema()
+	var referenced map[int]bool
 	if n.data == nil {
+		srcs := make(map[int]*gvec.Vector)
+		if noCopy {
+			referenced = make(map[int]bool)
+			for i, attr := range data.Attrs {
+				srcs[schema.GetColIdx(attr)] = data.Vecs[i]
+			}
+		}
 		vecs := make([]vector.IVector, len(schema.ColDefs))
 		attrIds := make([]int, len(schema.ColDefs))
 		for i, def := range schema.ColDefs {
 			attrIds[i] = def.Idx
+			if src, ok := srcs[def.Idx]; ok && !def.IsHidden() && !schema.IsChecksumCol(def.Idx) {
+				ref, err := vector.NewStdVectorReference(def.Type, src, int(offset), int(txnbase.MaxNodeRows))
+				if err == nil {
+					vecs[i] = ref
+					referenced[def.Idx] = true
+					continue
+				}
+				// the values of the other types are copied
+				if err != vector.ErrVecTypeNotSupport {
+					return an, err
+				}
+			}
 			vecs[i] = vector.NewVector(def.Type, uint64(txnbase.MaxNodeRows))
 		}
 		if n.data, err = batch.NewBatch(attrIds, vecs); err != nil {
@@ -387,8 +422,19 @@ func (n *insertNode) Append(data *gbat.Batch, offset uint32) (an uint32, err err
 
 	var cnt int
 	from := uint32(n.data.Length())
+	if len(referenced) > 0 {
+		cnt = int(txnbase.MaxNodeRows)
+		n.rows = txnbase.MaxNodeRows
+	}
 	for i, attr := range data.Attrs {
 		def := schema.ColDefs[schema.GetColIdx(attr)]
+		if schema.IsChecksumCol(def.Idx) {
+			// computed from the appended rows
+			continue
+		}
+		if referenced[def.Idx] {
+			continue
+		}
 		destVec, err := n.data.GetVectorByAttr(def.Idx)
 		if err != nil {
 			return an, err
@@ -399,10 +445,45 @@ func (n *insertNode) Append(data *gbat.Batch, offset uint32) (an uint32, err err
 		n.rows = uint32(destVec.Length())
 	}
 	an = uint32(cnt)
+	if schema.HasRowChecksum() {
+		if err = n.FillChecksumColumn(data, offset, an); err != nil {
+			return
+		}
+	}
 	err = n.FillHiddenColumn(from, uint32(compute.LengthOfBatch(data))-offset)
 	return
 }
 
+// FillChecksumColumn appends the checksums of the length rows of data from
+// the offset
+func (n *insertNode) FillChecksumColumn(data *gbat.Batch, offset, length uint32) (err error) {
+	if length == 0 {
+		return
+	}
+	schema := n.table.entry.GetSchema()
+	defs := schema.RowChecksumDefs()
+	cols := make([]*gvec.Vector, len(defs))
+	for i, def := range defs {
+		for j, attr := range data.Attrs {
+			if attr == def.Name {
+				cols[i] = data.Vecs[j]
+				break
+			}
+		}
+		if cols[i] == nil {
+			return fmt.Errorf("row checksum: column %s not appended", def.Name)
+		}
+	}
+	sums := gvec.New(catalog.ChecksumColumnType)
+	sums.Col = compute.RowChecksums(cols, int(offset), int(offset+length))
+	vec, err := n.data.GetVectorByAttr(schema.ChecksumKey.Idx)
+	if err != nil {
+		return
+	}
+	_, err = vec.AppendVector(sums, 0)
+	return
+}
+
 func (n *insertNode) FillHiddenColumn(startRow, length uint32) (err error) {
 	col, closer, err := model.PrepareHiddenData(catalog.HiddenColumnType, n.prefix, startRow, length)
 	if err != nil {
@@ -502,6 +583,19 @@ func (n *insertNode) IsRowDeleted(row uint32) bool {
 	return n.deletes.Contains(row)
 }
 
+// CloneDeletes returns a copy of the deleted rows, it is nil if no row is
+// deleted
+func (n *insertNode) CloneDeletes() *roaring.Bitmap {
+	if n.deletes == nil {
+		return nil
+	}
+	return n.deletes.Clone()
+}
+
+func (n *insertNode) SetDeletes(deletes *roaring.Bitmap) {
+	n.deletes = deletes
+}
+
 func (n *insertNode) PrintDeletes() string {
 	if n.deletes == nil {
 		return "NoDeletes"
@@ -525,7 +619,7 @@ func (n *insertNode) Window(start, end uint32) (bat *gbat.Batch, err error) {
 		if err2 != nil {
 			panic(err2)
 		}
-		deletes := common.BM32Window(n.deletes, int(start), int(end))
+		deletes := common.BM32Window(n.deletes, int(start), int(end)+1)
 		srcVec = compute.ApplyDeleteToVector(srcVec, deletes)
 		bat.Vecs = append(bat.Vecs, srcVec)
 		bat.Attrs = append(bat.Attrs, def.Name)
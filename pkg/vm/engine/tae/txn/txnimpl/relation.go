@@ -151,7 +151,14 @@ func (h *txnRelation) Rows() int64 {
 		}
 		panic("logic error")
 	}
-	return int64(h.table.entry.GetRows())
+	rows := int64(h.table.entry.GetRows())
+	// the rows committed after the txn started are not visible to it, a
+	// table without visible blocks is empty to the txn
+	if rows > 0 && !newRelationBlockIt(h).Valid() {
+		rows = 0
+	}
+	// the rows appended by the txn are counted for its own statements
+	return rows + int64(h.table.LiveUncommittedRows())
 }
 func (h *txnRelation) Size(attr string) int64           { return 0 }
 func (h *txnRelation) GetCardinality(attr string) int64 { return 0 }
@@ -164,6 +171,10 @@ func (h *txnRelation) Append(data *batch.Batch) error {
 	return h.Txn.GetStore().Append(h.table.entry.GetDB().ID, h.table.entry.GetID(), data)
 }
 
+func (h *txnRelation) AppendNoCopy(data *batch.Batch) error {
+	return h.Txn.GetStore().AppendNoCopy(h.table.entry.GetDB().ID, h.table.entry.GetID(), data)
+}
+
 func (h *txnRelation) GetSegment(id uint64) (seg handle.Segment, err error) {
 	fp := h.table.entry.AsCommonID()
 	fp.SegmentID = id
@@ -286,6 +297,10 @@ func (h *txnRelation) RangeDelete(id *common.ID, start, end uint32) error {
 	return h.Txn.GetStore().RangeDelete(h.table.entry.GetDB().ID, id, start, end)
 }
 
+func (h *txnRelation) LockRows(id *common.ID, rows []uint32) error {
+	return h.Txn.GetStore().LockRows(id, rows)
+}
+
 func (h *txnRelation) GetValueByHiddenKey(key any, col int) (any, error) {
 	sid, bid, row := model.DecodeHiddenKeyFromValue(key)
 	id := &common.ID{
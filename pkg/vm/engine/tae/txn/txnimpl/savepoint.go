@@ -0,0 +1,222 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+// tableSavepoint is the state of the writes of a table at a savepoint
+type tableSavepoint struct {
+	// rows of the local segment
+	localRows uint32
+	// deleted local rows, one bitmap per insert node
+	localDeletes []*roaring.Bitmap
+	// deleted rows of the committed blocks
+	deletes map[common.ID]*roaring.Bitmap
+	// number of the txn entries
+	entries int
+}
+
+// savepoint is the states of the tables by the table id. A table written
+// after the savepoint has no state and is rolled back to empty.
+type savepoint map[uint64]*tableSavepoint
+
+func (store *txnStore) Savepoint() txnif.Savepoint {
+	sp := make(savepoint)
+	for _, db := range store.dbs {
+		for id, table := range db.tables {
+			sp[id] = table.savepoint()
+		}
+	}
+	return sp
+}
+
+// RollbackToSavepoint undoes the appends and the deletes made after the
+// savepoint. The in-place updates of the rows already updated before the
+// savepoint are not undone.
+func (store *txnStore) RollbackToSavepoint(sp txnif.Savepoint) (err error) {
+	tables := sp.(savepoint)
+	for _, db := range store.dbs {
+		for id, table := range db.tables {
+			tsp := tables[id]
+			if tsp == nil {
+				tsp = &tableSavepoint{}
+			}
+			if err = table.rollbackTo(tsp); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (tbl *txnTable) savepoint() *tableSavepoint {
+	sp := &tableSavepoint{
+		deletes: make(map[common.ID]*roaring.Bitmap, len(tbl.deleteNodes)),
+		entries: len(tbl.txnEntries),
+	}
+	if tbl.localSegment != nil {
+		sp.localRows = tbl.localSegment.Rows()
+		sp.localDeletes = make([]*roaring.Bitmap, len(tbl.localSegment.nodes))
+		for i, node := range tbl.localSegment.nodes {
+			sp.localDeletes[i] = node.CloneDeletes()
+		}
+	}
+	for id, node := range tbl.deleteNodes {
+		mvcc := node.GetChain().(*updates.DeleteChain).GetController()
+		mvcc.RLock()
+		sp.deletes[id] = node.GetRowMaskRefLocked().Clone()
+		mvcc.RUnlock()
+	}
+	return sp
+}
+
+func (tbl *txnTable) rollbackTo(sp *tableSavepoint) (err error) {
+	if tbl.localSegment != nil {
+		if err = tbl.localSegment.rollbackTo(sp.localRows, sp.localDeletes); err != nil {
+			return
+		}
+	}
+	// the nodes created after the savepoint are removed from their chains
+	for _, entry := range tbl.txnEntries[sp.entries:] {
+		if err = entry.PrepareRollback(); err != nil {
+			return
+		}
+		if err = entry.ApplyRollback(); err != nil {
+			return
+		}
+		if node, ok := entry.(txnif.UpdateNode); ok {
+			delete(tbl.updateNodes, *node.GetID())
+		}
+	}
+	tbl.txnEntries = tbl.txnEntries[:sp.entries]
+	for id, node := range tbl.deleteNodes {
+		mask := sp.deletes[id]
+		if mask == nil {
+			delete(tbl.deleteNodes, id)
+			continue
+		}
+		mvcc := node.GetChain().(*updates.DeleteChain).GetController()
+		mvcc.Lock()
+		node.(*updates.DeleteNode).SetDeletes(mask.Clone())
+		mvcc.Unlock()
+	}
+	return
+}
+
+// rollbackTo deletes the rows appended after the savepoint and restores the
+// rows deleted after it. The index is changed along with the rows.
+func (seg *localSegment) rollbackTo(rows uint32, deletes []*roaring.Bitmap) (err error) {
+	for i, node := range seg.nodes {
+		start := uint32(i) * txnbase.MaxNodeRows
+		saved := roaring.New()
+		if i < len(deletes) && deletes[i] != nil {
+			saved = deletes[i]
+		}
+		// rows of the node at the savepoint
+		kept := node.Rows()
+		if rows < start {
+			kept = 0
+		} else if rows-start < kept {
+			kept = rows - start
+		}
+		current := node.CloneDeletes()
+		if current == nil {
+			current = roaring.New()
+		}
+		if kept == node.Rows() && current.Equals(saved) {
+			continue
+		}
+		if seg.table.schema.HasPK() {
+			// the live appended rows leave the index before the restored rows
+			// are added, they may have the same keys
+			appended := roaring.New()
+			appended.AddRange(uint64(kept), uint64(node.Rows()))
+			appended.AndNot(current)
+			if err = seg.updateIndex(node, start, appended, false); err != nil {
+				return
+			}
+			restored := current.Clone()
+			restored.AndNot(saved)
+			restored.RemoveRange(uint64(kept), uint64(node.Rows()))
+			if err = seg.updateIndex(node, start, restored, true); err != nil {
+				return
+			}
+		}
+		saved = saved.Clone()
+		saved.AddRange(uint64(kept), uint64(node.Rows()))
+		node.SetDeletes(saved)
+	}
+	return
+}
+
+// updateIndex inserts or deletes the keys of the rows of the node
+func (seg *localSegment) updateIndex(node InsertNode, start uint32, rows *roaring.Bitmap, insert bool) (err error) {
+	if rows.IsEmpty() {
+		return
+	}
+	h, err := seg.table.store.nodesMgr.TryPin(node, time.Second)
+	if err != nil {
+		return
+	}
+	defer h.Close()
+	schema := seg.table.schema
+	var buf bytes.Buffer
+	typs := make([]types.Type, schema.GetSortKeyCnt())
+	vs := make([]any, len(typs))
+	for j := range typs {
+		typs[j] = schema.SortKey.Defs[j].Type
+	}
+	it := rows.Iterator()
+	for it.HasNext() {
+		row := it.Next()
+		var key any
+		if schema.IsSinglePK() {
+			if key, err = node.GetValue(schema.GetSingleSortKeyIdx(), row); err != nil {
+				return
+			}
+		} else {
+			for j := range vs {
+				if vs[j], err = node.GetValue(schema.SortKey.Defs[j].Idx, row); err != nil {
+					return
+				}
+			}
+			buf.Reset()
+			key = model.EncodeTypedVals(&buf, typs, vs...)
+		}
+		if v, ok := key.([]byte); ok {
+			key = string(v)
+		}
+		if insert {
+			err = seg.index.Insert(key, start+row)
+		} else {
+			err = seg.index.Delete(key)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
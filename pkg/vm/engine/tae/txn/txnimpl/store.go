@@ -121,6 +121,14 @@ func (store *txnStore) BatchDedup(dbId, id uint64, pks ...*vector.Vector) (err e
 }
 
 func (store *txnStore) Append(dbId, id uint64, data *batch.Batch) error {
+	return store.append(dbId, id, data, false)
+}
+
+func (store *txnStore) AppendNoCopy(dbId, id uint64, data *batch.Batch) error {
+	return store.append(dbId, id, data, true)
+}
+
+func (store *txnStore) append(dbId, id uint64, data *batch.Batch, noCopy bool) error {
 	store.IncreateWriteCnt()
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -129,7 +137,7 @@ func (store *txnStore) Append(dbId, id uint64, data *batch.Batch) error {
 	// if db.IsDeleted() {
 	// 	return txnbase.ErrNotFound
 	// }
-	return db.Append(id, data)
+	return db.Append(id, data, noCopy)
 }
 
 func (store *txnStore) RangeDelete(dbId uint64, id *common.ID, start, end uint32) (err error) {
@@ -143,6 +151,31 @@ func (store *txnStore) RangeDelete(dbId uint64, id *common.ID, start, end uint32
 	return db.RangeDelete(id, start, end)
 }
 
+func (store *txnStore) LockRows(id *common.ID, rows []uint32) (err error) {
+	locks := store.getLockTable()
+	if locks == nil {
+		return
+	}
+	return locks.LockRows(store.txn.GetID(), id, rows...)
+}
+
+// waitRowLocks waits until the rows [start, end] of a block are not locked
+// by other txns before the txn writes them
+func (store *txnStore) waitRowLocks(id *common.ID, start, end uint32) (err error) {
+	locks := store.getLockTable()
+	if locks == nil {
+		return
+	}
+	return locks.WaitUnlocked(store.txn.GetID(), id, start, end)
+}
+
+func (store *txnStore) getLockTable() *txnbase.LockTable {
+	if txn, ok := store.txn.(*txnImpl); ok && txn.Mgr != nil {
+		return txn.Mgr.Locks
+	}
+	return nil
+}
+
 func (store *txnStore) GetByFilter(dbId, tid uint64, filter *handle.Filter) (id *common.ID, offset uint32, err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
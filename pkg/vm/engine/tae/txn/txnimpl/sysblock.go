@@ -2,10 +2,12 @@ package txnimpl
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
@@ -219,6 +221,14 @@ func (blk *txnSysBlock) getColumnTableData(colIdx int) (view *model.ColumnView,
 	if err != nil {
 		return
 	}
+	// the operators shrink the data of the fixed size values along with
+	// them, so the data is made of the appended values
+	switch vs := colData.Col.(type) {
+	case []int8:
+		colData.Data = encoding.EncodeInt8Slice(vs)
+	case []int32:
+		colData.Data = encoding.EncodeInt32Slice(vs)
+	}
 	view.AppliedVec = colData
 	return
 }
@@ -300,6 +310,13 @@ func (blk *txnSysBlock) GetColumnDataByName(attr string, compressed, decompresse
 	return blk.GetColumnDataById(colIdx, compressed, decompressed)
 }
 
+func (blk *txnSysBlock) GetCommitTSColumn() (*model.ColumnView, error) {
+	if !blk.isSysTable() {
+		return blk.txnBlock.GetCommitTSColumn()
+	}
+	return nil, fmt.Errorf("the rows of system table %s have no commit ts", blk.table.entry.GetSchema().Name)
+}
+
 func (blk *txnSysBlock) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	if !blk.isSysTable() {
 		return blk.txnBlock.LogTxnEntry(entry, readed)
@@ -332,6 +332,16 @@ func (tbl *txnTable) GetSortColumns(data *batch.Batch) []*vector.Vector {
 // func (tbl *txnTable)
 
 func (tbl *txnTable) Append(data *batch.Batch) (err error) {
+	return tbl.append(data, false)
+}
+
+// AppendNoCopy is Append referencing the values of the fixed size columns
+// of data instead of copying them where it can, see localSegment.AppendNoCopy
+func (tbl *txnTable) AppendNoCopy(data *batch.Batch) (err error) {
+	return tbl.append(data, true)
+}
+
+func (tbl *txnTable) append(data *batch.Batch, noCopy bool) (err error) {
 	if tbl.schema.IsSinglePK() {
 		if err = tbl.DoBatchDedup(data.Vecs[tbl.schema.GetSingleSortKeyIdx()]); err != nil {
 			return
@@ -344,6 +354,9 @@ func (tbl *txnTable) Append(data *batch.Batch) (err error) {
 	if tbl.localSegment == nil {
 		tbl.localSegment = newLocalSegment(tbl)
 	}
+	if noCopy {
+		return tbl.localSegment.AppendNoCopy(data)
+	}
 	return tbl.localSegment.Append(data)
 }
 
@@ -373,6 +386,9 @@ func (tbl *txnTable) RangeDelete(id *common.ID, start, end uint32) (err error) {
 	if isLocalSegment(id) {
 		return tbl.RangeDeleteLocalRows(start, end)
 	}
+	if err = tbl.store.waitRowLocks(id, start, end); err != nil {
+		return
+	}
 	node := tbl.deleteNodes[*id]
 	if node != nil {
 		chain := node.GetChain().(*updates.DeleteChain)
@@ -479,14 +495,32 @@ func (tbl *txnTable) updateWithFineLock(node txnif.UpdateNode, txn txnif.AsyncTx
 	return
 }
 
+// UpdateWithoutRowChecksum is a test hook. The committed rows of a table
+// with row checksums are not updated in place, as their checksums would not
+// be updated, unless it is set to corrupt the rows.
+var UpdateWithoutRowChecksum = false
+
 func (tbl *txnTable) Update(id *common.ID, row uint32, col uint16, v any) (err error) {
-	if tbl.entry.GetSchema().IsPartOfPK(int(col)) {
+	schema := tbl.entry.GetSchema()
+	if schema.IsPartOfPK(int(col)) {
 		err = data.ErrUpdateUniqueKey
 		return
 	}
+	if schema.IsChecksumCol(int(col)) {
+		err = data.ErrUpdateRowChecksum
+		return
+	}
 	if isLocalSegment(id) {
+		// the local rows are updated by appending them again
 		return tbl.UpdateLocalValue(row, col, v)
 	}
+	if schema.HasRowChecksum() && !UpdateWithoutRowChecksum {
+		err = data.ErrUpdateRowChecksum
+		return
+	}
+	if err = tbl.store.waitRowLocks(id, row, row); err != nil {
+		return
+	}
 	uid := *id
 	uid.Idx = col
 	node := tbl.updateNodes[uid]
@@ -537,6 +571,17 @@ func (tbl *txnTable) UncommittedRows() uint32 {
 	return tbl.localSegment.Rows()
 }
 
+// LiveUncommittedRows returns the rows appended by the txn and not deleted
+func (tbl *txnTable) LiveUncommittedRows() (rows uint32) {
+	if tbl.localSegment == nil {
+		return 0
+	}
+	for _, node := range tbl.localSegment.nodes {
+		rows += node.RowsWithoutDeletes()
+	}
+	return
+}
+
 func (tbl *txnTable) PreCommitDedup() (err error) {
 	if tbl.localSegment == nil || !tbl.schema.HasPK() {
 		return
@@ -96,7 +96,7 @@ func (db *txnDB) BatchDedup(id uint64, pks ...*vector.Vector) (err error) {
 	return table.DoBatchDedup(pks...)
 }
 
-func (db *txnDB) Append(id uint64, bat *batch.Batch) error {
+func (db *txnDB) Append(id uint64, bat *batch.Batch, noCopy bool) error {
 	table, err := db.getOrSetTable(id)
 	if err != nil {
 		return err
@@ -104,6 +104,9 @@ func (db *txnDB) Append(id uint64, bat *batch.Batch) error {
 	if table.IsDeleted() {
 		return data.ErrNotFound
 	}
+	if noCopy {
+		return table.AppendNoCopy(bat)
+	}
 	return table.Append(bat)
 }
 
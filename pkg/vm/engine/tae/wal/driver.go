@@ -47,6 +47,10 @@ func (driver *walDriver) GetCheckpointed() uint64 {
 	return driver.impl.GetCheckpointed(GroupC)
 }
 
+func (driver *walDriver) GetSynced() uint64 {
+	return driver.impl.GetSynced(GroupC)
+}
+
 func (driver *walDriver) Replay(handle store.ApplyHandle) (err error) {
 	return driver.impl.Replay(handle)
 }
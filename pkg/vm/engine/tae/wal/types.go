@@ -44,6 +44,7 @@ type LogEntry entry.Entry
 
 type Driver interface {
 	GetCheckpointed() uint64
+	GetSynced() uint64
 	Checkpoint(indexes []*Index) (LogEntry, error)
 	AppendEntry(uint32, LogEntry) (uint64, error)
 	LoadEntry(groupId uint32, lsn uint64) (LogEntry, error)
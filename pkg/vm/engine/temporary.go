@@ -0,0 +1,260 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// A temporary table is stored in an ordinary relation of its database, whose
+// name is prefixed by the session owning it. The session sees the table by
+// its own name through its TemporaryEngine, which shadows a table of the same
+// name, while the other sessions never see it. The relations are dropped when
+// the session ends, and those left by a crash are dropped at startup.
+
+// TemporaryTablePrefix is the prefix of the relations storing temporary tables
+const TemporaryTablePrefix = "#T#"
+
+// temporarySessions generates the ids of the sessions owning temporary tables
+var temporarySessions uint64
+
+// TemporaryTableName returns the relation name storing the temporary table of a session
+func TemporaryTableName(session uint64, table string) string {
+	return fmt.Sprintf("%s%d#%s", TemporaryTablePrefix, session, table)
+}
+
+// IsTemporaryTableName returns true if name is a relation storing a temporary table
+func IsTemporaryTableName(name string) bool {
+	return strings.HasPrefix(name, TemporaryTablePrefix)
+}
+
+// TemporaryDatabase is a database of a TemporaryEngine
+type TemporaryDatabase interface {
+	Database
+	// IsTemporary returns true if the session has the temporary table
+	IsTemporary(string) bool
+	// CreateTemporary creates a temporary table of the session
+	CreateTemporary(uint64, string, []TableDef, Snapshot) error
+}
+
+// TemporaryEngine is the engine seen by a session with temporary tables
+type TemporaryEngine struct {
+	Engine
+
+	session uint64
+	sync.Mutex
+	// tables is the temporary tables of every database
+	tables map[string]map[string]struct{}
+}
+
+func NewTemporaryEngine(e Engine) *TemporaryEngine {
+	return &TemporaryEngine{
+		Engine:  e,
+		session: atomic.AddUint64(&temporarySessions, 1),
+		tables:  make(map[string]map[string]struct{}),
+	}
+}
+
+func (e *TemporaryEngine) Database(name string, snapshot Snapshot) (Database, error) {
+	db, err := e.Engine.Database(name, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &temporaryDatabase{Database: db, engine: e, name: name}, nil
+}
+
+// CreateWithDefaults creates the database by the engine under it
+func (e *TemporaryEngine) CreateWithDefaults(ts uint64, name string, typ int, defaults DatabaseDefaults, snapshot Snapshot) error {
+	de, ok := e.Engine.(DefaultsEngine)
+	if !ok {
+		return fmt.Errorf("the engine does not support the defaults of databases")
+	}
+	return de.CreateWithDefaults(ts, name, typ, defaults, snapshot)
+}
+
+func (e *TemporaryEngine) Delete(ts uint64, name string, snapshot Snapshot) error {
+	if err := e.Engine.Delete(ts, name, snapshot); err != nil {
+		return err
+	}
+	e.Lock()
+	delete(e.tables, name)
+	e.Unlock()
+	return nil
+}
+
+// HasTemporaryTables returns true if the session has any temporary table
+func (e *TemporaryEngine) HasTemporaryTables() bool {
+	e.Lock()
+	defer e.Unlock()
+	return len(e.tables) > 0
+}
+
+// DropTemporaryTables drops all the temporary tables of the session, the
+// tables are forgotten even if some of them fail to be dropped. The tables
+// whose creation was rolled back are skipped.
+func (e *TemporaryEngine) DropTemporaryTables(ts uint64, snapshot Snapshot) error {
+	e.Lock()
+	tables := e.tables
+	e.tables = make(map[string]map[string]struct{})
+	e.Unlock()
+	var firstErr error
+	for dbName, names := range tables {
+		db, err := e.Engine.Database(dbName, snapshot)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for name := range names {
+			relName := TemporaryTableName(e.session, name)
+			if _, err := db.Relation(relName, snapshot); err != nil {
+				continue
+			}
+			if err := db.Delete(ts, relName, snapshot); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (e *TemporaryEngine) isTemporary(db, name string) bool {
+	e.Lock()
+	defer e.Unlock()
+	_, ok := e.tables[db][name]
+	return ok
+}
+
+func (e *TemporaryEngine) temporaryTables(db string) []string {
+	e.Lock()
+	defer e.Unlock()
+	names := make([]string, 0, len(e.tables[db]))
+	for name := range e.tables[db] {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (e *TemporaryEngine) addTemporary(db, name string) {
+	e.Lock()
+	defer e.Unlock()
+	if e.tables[db] == nil {
+		e.tables[db] = make(map[string]struct{})
+	}
+	e.tables[db][name] = struct{}{}
+}
+
+func (e *TemporaryEngine) removeTemporary(db, name string) {
+	e.Lock()
+	defer e.Unlock()
+	if delete(e.tables[db], name); len(e.tables[db]) == 0 {
+		delete(e.tables, db)
+	}
+}
+
+type temporaryDatabase struct {
+	Database
+	engine *TemporaryEngine
+	name   string
+}
+
+var (
+	_ TemporaryDatabase = &temporaryDatabase{}
+	_ DefaultsDatabase  = &temporaryDatabase{}
+	_ DefaultsEngine    = &TemporaryEngine{}
+)
+
+// Defaults returns the defaults of the database under it, if any
+func (db *temporaryDatabase) Defaults() DatabaseDefaults {
+	if ddb, ok := db.Database.(DefaultsDatabase); ok {
+		return ddb.Defaults()
+	}
+	return DatabaseDefaults{}
+}
+
+// Relations returns the tables of the database seen by the session, the
+// temporary tables of the other sessions are hidden
+func (db *temporaryDatabase) Relations(snapshot Snapshot) []string {
+	temps := db.engine.temporaryTables(db.name)
+	names := make([]string, 0, len(temps))
+	shadowed := make(map[string]struct{}, len(temps))
+	for _, name := range temps {
+		names = append(names, name)
+		shadowed[name] = struct{}{}
+	}
+	for _, name := range db.Database.Relations(snapshot) {
+		if _, ok := shadowed[name]; !ok && !IsTemporaryTableName(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (db *temporaryDatabase) Relation(name string, snapshot Snapshot) (Relation, error) {
+	if db.engine.isTemporary(db.name, name) {
+		if rel, err := db.Database.Relation(TemporaryTableName(db.engine.session, name), snapshot); err == nil {
+			return rel, nil
+		}
+	}
+	return db.Database.Relation(name, snapshot)
+}
+
+// Delete drops the temporary table of the name if any, or the table
+func (db *temporaryDatabase) Delete(ts uint64, name string, snapshot Snapshot) error {
+	if db.engine.isTemporary(db.name, name) {
+		db.engine.removeTemporary(db.name, name)
+		return db.Database.Delete(ts, TemporaryTableName(db.engine.session, name), snapshot)
+	}
+	return db.Database.Delete(ts, name, snapshot)
+}
+
+func (db *temporaryDatabase) IsTemporary(name string) bool {
+	return db.engine.isTemporary(db.name, name)
+}
+
+func (db *temporaryDatabase) CreateTemporary(ts uint64, name string, defs []TableDef, snapshot Snapshot) error {
+	if db.engine.isTemporary(db.name, name) {
+		return fmt.Errorf("temporary table '%s' already exists", name)
+	}
+	if err := db.Database.Create(ts, TemporaryTableName(db.engine.session, name), defs, snapshot); err != nil {
+		return err
+	}
+	db.engine.addTemporary(db.name, name)
+	return nil
+}
+
+// DropTemporaryTables drops the relations of all the temporary tables, it is
+// called at startup to drop the tables left by the sessions before a crash.
+func DropTemporaryTables(e Engine, ts uint64, snapshot Snapshot) error {
+	for _, dbName := range e.Databases(snapshot) {
+		db, err := e.Database(dbName, snapshot)
+		if err != nil {
+			return err
+		}
+		for _, name := range db.Relations(snapshot) {
+			if !IsTemporaryTableName(name) {
+				continue
+			}
+			if err := db.Delete(ts, name, snapshot); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -15,6 +15,8 @@
 package engine
 
 import (
+	"fmt"
+
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -34,11 +36,19 @@ type Node struct {
 }
 
 type Attribute struct {
-	Name    string      // name of attribute
-	Alg     compress.T  // compression algorithm
-	Type    types.Type  // type of attribute
-	Default DefaultExpr // default value of this attribute.
-	Primary bool        // if true, it is primary key
+	Name          string      // name of attribute
+	Alg           compress.T  // compression algorithm
+	Type          types.Type  // type of attribute
+	Default       DefaultExpr // default value of this attribute.
+	Primary       bool        // if true, it is primary key
+	NotNull       bool        // if true, the attribute is NOT NULL
+	AutoIncrement bool        // if true, the attribute is AUTO_INCREMENT
+	Comment       string      // comment of the attribute
+	Generated     string      // expression of the stored generated attribute
+	// DefaultExpression is the expression of the default value evaluated for
+	// every inserted row, it overrides Default
+	DefaultExpression string
+	OnUpdate          string // expression assigned to the attribute when its row is updated
 }
 
 type DefaultExpr struct {
@@ -163,6 +173,147 @@ type Relation interface {
 	NewReader(int, extend.Extend, []byte, Snapshot) []Reader
 }
 
+// LockingRelation is a relation whose readers can lock the rows they read,
+// which is required by SELECT ... FOR UPDATE
+type LockingRelation interface {
+	Relation
+	// NewLockingReader is like NewReader, and the readers lock the rows they
+	// return until the txn is committed or rollbacked
+	NewLockingReader(int, extend.Extend, []byte, Snapshot) []Reader
+}
+
+// StatsRelation is a relation which can estimate the number of distinct
+// values of its columns, the planner uses it to estimate the selectivity
+type StatsRelation interface {
+	Relation
+	// CardinalNumber returns the estimated NDV of the column, 0 if unknown
+	CardinalNumber(string) int64
+}
+
+// CommitTSColumnName is the name of the uint64 pseudo column of the commit
+// ts of the latest change of a row, which is the commit ts of its insert or
+// of its latest update in place, whichever is later. An UPDATE statement
+// deletes and inserts the rows, so its commit ts is the ts of the insert.
+// A column of the same name shadows it.
+const CommitTSColumnName = "__mo_commit_ts"
+
+// CommitTSRelation is a relation whose readers can read the pseudo column
+// CommitTSColumnName. The commit ts read may be later than the actual
+// change of a row once the storage has compacted the versions of the rows,
+// but never earlier, so the rows read by an incremental query on the commit
+// ts are a superset of the rows changed after the bound.
+type CommitTSRelation interface {
+	Relation
+	// MaxCommitTS returns the latest commit ts of the changes of the rows
+	// visible to the snapshot, a bound for the next incremental query
+	MaxCommitTS(Snapshot) uint64
+}
+
+// AccessPath is a way to read the rows of a relation whose column equals a
+// value
+type AccessPath int
+
+const (
+	// FullScan reads all the blocks
+	FullScan AccessPath = iota
+	// ZonemapScan reads the blocks whose zonemaps may contain the value
+	ZonemapScan
+	// PrimaryKeyLookup reads the block holding the primary key found by
+	// the primary key index
+	PrimaryKeyLookup
+	// SecondaryIndexLookup reads the blocks of the rows found by a
+	// secondary index on the column
+	SecondaryIndexLookup
+)
+
+func (p AccessPath) String() string {
+	switch p {
+	case FullScan:
+		return "full scan"
+	case ZonemapScan:
+		return "zonemap scan"
+	case PrimaryKeyLookup:
+		return "primary key lookup"
+	case SecondaryIndexLookup:
+		return "secondary index lookup"
+	}
+	return fmt.Sprintf("unknown access path %d", int(p))
+}
+
+// PathEstimate is the estimated work of reading the rows of an equality by
+// an access path
+type PathEstimate struct {
+	Path AccessPath
+	// Probes is the number of the index or zonemap probes
+	Probes int64
+	// Blocks is the number of the blocks read
+	Blocks int64
+	// Rows is the number of the rows read
+	Rows int64
+}
+
+// PathRelation is a relation which can read the rows whose column equals a
+// value by different access paths. The readers of the paths are
+// interchangeable, they read all the rows matching the equality and may read
+// some rows not matching it, which are filtered by the caller.
+type PathRelation interface {
+	Relation
+	// AccessPaths returns the paths available to read the rows whose column
+	// equals the value, the value is of the go type of the column
+	AccessPaths(string, interface{}) []PathEstimate
+	// NewPathReader returns the readers of the rows whose column equals the
+	// value by the access path, the first argument is the number of readers
+	NewPathReader(int, AccessPath, string, interface{}) ([]Reader, error)
+}
+
+// RangePathRelation is a path relation which can also read the rows whose
+// column is in a range of values by the access paths, a nil bound of the
+// range is unbounded
+type RangePathRelation interface {
+	PathRelation
+	// RangeAccessPaths returns the paths available to read the rows whose
+	// column is in [min, max], the bounds are of the go type of the column
+	RangeAccessPaths(string, interface{}, interface{}) []PathEstimate
+	// NewRangePathReader returns the readers of the rows whose column is in
+	// [min, max] by the access path, the first argument is the number of
+	// readers
+	NewRangePathReader(int, AccessPath, string, interface{}, interface{}) ([]Reader, error)
+}
+
+// ColumnarRelation is a relation which can append a batch of columns laid out
+// as the table without converting its values one by one
+type ColumnarRelation interface {
+	Relation
+	// AppendColumnarBatch appends the rows of the batch in the txn of the
+	// relation as Write does. The batch has the columns of the table in the
+	// order of their definitions, named and typed as them. The values of the
+	// fixed size columns may be referenced by the txn instead of copied, so
+	// the caller owns them again only after the txn is committed or
+	// rollbacked and must not modify them before.
+	AppendColumnarBatch(*batch.Batch) error
+}
+
+// AppendColumnarBatch appends the batch to the relation by ColumnarRelation
+func AppendColumnarBatch(rel Relation, bat *batch.Batch) error {
+	crel, ok := rel.(ColumnarRelation)
+	if !ok {
+		return fmt.Errorf("relation %s does not support columnar batches", rel.ID(nil))
+	}
+	return crel.AppendColumnarBatch(bat)
+}
+
+// DedupRelation is a relation which can report the rows of a batch whose
+// primary keys conflict, INSERT IGNORE skips them instead of failing
+type DedupRelation interface {
+	Relation
+	// Duplicates returns the rows of the batch, in ascending order, whose
+	// primary keys are already in the relation as seen by its txn, or
+	// appear in an earlier row of the batch. The batch has the columns of
+	// the primary key, named as them. It returns nothing if the relation
+	// has no primary key.
+	Duplicates(*batch.Batch) ([]int64, error)
+}
+
 type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
@@ -213,6 +364,29 @@ type Engine interface {
 	Node(string, Snapshot) *NodeInfo
 }
 
+// DatabaseDefaults are the default charset and collation of the string
+// columns of the tables created in a database, an empty one is the default
+// of the server
+type DatabaseDefaults struct {
+	Charset   string
+	Collation string
+}
+
+// DefaultsDatabase is a database which stores the defaults of its tables
+type DefaultsDatabase interface {
+	Database
+	// Defaults returns the defaults the database is created with
+	Defaults() DatabaseDefaults
+}
+
+// DefaultsEngine is an engine which can create a database with the defaults
+// of its tables
+type DefaultsEngine interface {
+	Engine
+	// CreateWithDefaults is like Create, and the database stores the defaults
+	CreateWithDefaults(uint64, string, int, DatabaseDefaults, Snapshot) error
+}
+
 // MakeDefaultExpr returns a new DefaultExpr
 func MakeDefaultExpr(exist bool, value interface{}, isNull bool) DefaultExpr {
 	return DefaultExpr{
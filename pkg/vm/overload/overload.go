@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/complement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/exchange"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
@@ -37,8 +38,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrictprojection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/setop"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/streamgroup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/update"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -56,16 +61,23 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Product:    product.String,
 	Restrict:   restrict.String,
 	Dispatch:   dispatch.String,
+	Exchange:   exchange.String,
 	Connector:  connector.String,
 	Projection: projection.String,
 	Complement: complement.String,
 
+	RestrictProjection: restrictprojection.String,
+
 	MergeTop:    mergetop.String,
 	MergeLimit:  mergelimit.String,
 	MergeOrder:  mergeorder.String,
 	MergeGroup:  mergegroup.String,
 	MergeOffset: mergeoffset.String,
+	StreamGroup: streamgroup.String,
 	Deletion:    deletion.String,
+	Update:      update.String,
+
+	SetOp: setop.String,
 }
 
 var prepareFunc = [...]func(*process.Process, interface{}) error{
@@ -82,17 +94,24 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	Product:    product.Prepare,
 	Restrict:   restrict.Prepare,
 	Dispatch:   dispatch.Prepare,
+	Exchange:   exchange.Prepare,
 	Connector:  connector.Prepare,
 	Projection: projection.Prepare,
 	Complement: complement.Prepare,
 
+	RestrictProjection: restrictprojection.Prepare,
+
 	MergeTop:    mergetop.Prepare,
 	MergeLimit:  mergelimit.Prepare,
 	MergeOrder:  mergeorder.Prepare,
 	MergeGroup:  mergegroup.Prepare,
 	MergeOffset: mergeoffset.Prepare,
+	StreamGroup: streamgroup.Prepare,
 
 	Deletion: deletion.Prepare,
+	Update:   update.Prepare,
+
+	SetOp: setop.Prepare,
 }
 
 var execFunc = [...]func(*process.Process, interface{}) (bool, error){
@@ -109,15 +128,22 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	Product:    product.Call,
 	Restrict:   restrict.Call,
 	Dispatch:   dispatch.Call,
+	Exchange:   exchange.Call,
 	Connector:  connector.Call,
 	Projection: projection.Call,
 	Complement: complement.Call,
 
+	RestrictProjection: restrictprojection.Call,
+
 	MergeTop:    mergetop.Call,
 	MergeLimit:  mergelimit.Call,
 	MergeOrder:  mergeorder.Call,
 	MergeGroup:  mergegroup.Call,
 	MergeOffset: mergeoffset.Call,
+	StreamGroup: streamgroup.Call,
 
 	Deletion: deletion.Call,
+	Update:   update.Call,
+
+	SetOp: setop.Call,
 }
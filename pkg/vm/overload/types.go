@@ -28,15 +28,22 @@ const (
 	Product
 	Restrict
 	Dispatch
+	Exchange
 	Connector
 	Projection
 	Complement
 
+	RestrictProjection
+
 	MergeTop
 	MergeLimit
 	MergeOrder
 	MergeGroup
 	MergeOffset
+	StreamGroup
 
 	Deletion
+	Update
+
+	SetOp
 )
@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/exchange"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
@@ -66,6 +67,9 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 	}
 	refCnts := make([]uint64, len(p.attrs))
 	for {
+		if err = proc.Interrupted(); err != nil {
+			return false, err
+		}
 		// read data from storage engine
 		if bat, err = r.Read(refCnts, p.attrs); err != nil {
 			return false, err
@@ -126,6 +130,9 @@ func (p *Pipeline) MergeRun(proc *process.Process) (bool, error) {
 		return false, err
 	}
 	for {
+		if err = proc.Interrupted(); err != nil {
+			return false, err
+		}
 		proc.Reg.InputBatch = nil
 		if end, err = overload.Run(p.instructions, proc); err != nil || end {
 			return end, err
@@ -155,5 +162,15 @@ func cleanup(p *Pipeline, proc *process.Process) {
 			}
 			break
 		}
+		if in.Op == overload.Exchange {
+			arg := p.instructions[i].Arg.(*exchange.Argument)
+			for _, reg := range arg.Regs {
+				select {
+				case <-reg.Ctx.Done():
+				case reg.Ch <- nil:
+				}
+			}
+			break
+		}
 	}
 }
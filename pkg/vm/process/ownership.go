@@ -0,0 +1,120 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// The ownership contract of the vectors passed between the operators:
+//
+//  - The Ref of a vector of a batch is the number of its consumers which have
+//    not consumed it yet, the compiler counts one for every expression or
+//    operator reading the column, and batch.Reduce takes one when a consumer
+//    is done.
+//  - A vector whose Ref is 0 is an intermediate result, it's owned by the
+//    operator it's passed to, which gives it back by Put once done.
+//  - An operator writes its result into an argument only if it's the last
+//    consumer of the argument, and no other vector links or views its data.
+//  - A vector shared by the evaluations of all the batches, like a constant
+//    of the plan, is passed pinned, it's never owned by an operator.
+//
+// When PoisonReleased is set, Put poisons the vectors instead of reusing them,
+// so that a vector used after being given back, or given back twice, is
+// caught, and so is a vector given back with the Nulls of another vector.
+
+// PinnedRef is the reference count of a pinned vector.
+const PinnedRef = math.MaxUint64 >> 1
+
+// poisonByte fills the data of the vectors given back in the poisoning mode.
+const poisonByte = 0xdb
+
+var (
+	// PoisonReleased, if true, the vectors given back by Put are poisoned
+	// and never reused. It's set by the debug builds and by the tests.
+	PoisonReleased = debugBuild
+
+	// released records the vectors poisoned, they are never reused so that
+	// the records are never stale.
+	released sync.Map
+
+	// nspOwners records the vector allocated with every Nulls in the
+	// poisoning mode, a vector put with the Nulls of another is caught.
+	nspOwners sync.Map
+)
+
+// Owned reports whether vec is an intermediate result owned by the operator
+// it's passed to, the operator must give it back by Put.
+func Owned(vec *vector.Vector) bool {
+	return vec.Ref == 0 && vec.Link == 0
+}
+
+// Reusable reports whether the operator vec is passed to is its last consumer,
+// and can write its result into vec in place.
+func Reusable(vec *vector.Vector) bool {
+	return vec.Ref <= 1 && vec.Link == 0 && !vector.IsShared(vec)
+}
+
+// Pin returns a view of vec which is never owned by an operator, vec is shared
+// by the evaluations of many batches.
+func Pin(vec *vector.Vector) *vector.Vector {
+	v := *vec
+	v.Ref = PinnedRef
+	return &v
+}
+
+// own records vec as the owner of its Nulls in the poisoning mode.
+func own(vec *vector.Vector) {
+	if PoisonReleased {
+		nspOwners.Store(vec.Nsp, vec)
+	}
+}
+
+// poison fills the data of vec given back by Put, and panics if vec is still
+// referenced or has been given back before.
+func poison(proc *Process, vec *vector.Vector) {
+	if !Owned(vec) {
+		panic(fmt.Sprintf("vector %p is put with ref %v and link %v", vec, vec.Ref, vec.Link))
+	}
+	if _, ok := released.LoadOrStore(vec, struct{}{}); ok {
+		panic(fmt.Sprintf("vector %p is put twice", vec))
+	}
+	if owner, ok := nspOwners.Load(vec.Nsp); ok && owner.(*vector.Vector) != vec {
+		panic(fmt.Sprintf("vector %p is put with the nulls of vector %p", vec, owner))
+	}
+	if vector.IsShared(vec) {
+		vector.Clean(vec, proc.Mp)
+	} else {
+		for i := range vec.Data {
+			vec.Data[i] = poisonByte
+		}
+		if !vec.Or && vec.Data != nil {
+			mheap.Free(proc.Mp, vec.Data)
+		}
+	}
+	vec.Col = nil
+}
+
+// Poisoned reports whether vec has been given back by Put in the poisoning
+// mode.
+func Poisoned(vec *vector.Vector) bool {
+	_, ok := released.Load(vec)
+	return ok
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnership(t *testing.T) {
+	proc := newTestProcess()
+	vec, err := Get(proc, 64, selsType)
+	require.NoError(t, err)
+	require.True(t, Owned(vec))
+	require.True(t, Reusable(vec))
+
+	// a column read by two consumers
+	vec.Ref = 2
+	require.False(t, Owned(vec))
+	require.False(t, Reusable(vec))
+	vec.Ref = 1
+	require.True(t, Reusable(vec))
+	vec.Link = 1
+	require.False(t, Reusable(vec))
+	vec.Ref, vec.Link = 0, 0
+
+	pinned := Pin(vec)
+	require.False(t, Owned(pinned))
+	require.False(t, Reusable(pinned))
+	require.Equal(t, uint64(0), vec.Ref)
+
+	vector.SetCol(vec, encoding.DecodeInt64Slice(vec.Data))
+	view := vector.NewView(vec, 0, 4)
+	require.False(t, Reusable(vec))
+	vector.Clean(view, proc.Mp)
+	Put(proc, vec)
+}
+
+func TestPoisonReleased(t *testing.T) {
+	defer func(poison bool) { PoisonReleased = poison }(PoisonReleased)
+	PoisonReleased = true
+
+	proc := newTestProcess()
+	vec, err := Get(proc, 64, selsType)
+	require.NoError(t, err)
+	Put(proc, vec)
+	require.True(t, Poisoned(vec))
+	require.Nil(t, vec.Col)
+	for _, b := range vec.Data {
+		require.Equal(t, byte(poisonByte), b)
+	}
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	// the vector is never reused
+	rvec, err := Get(proc, 64, selsType)
+	require.NoError(t, err)
+	require.NotSame(t, vec, rvec)
+
+	require.Panics(t, func() { Put(proc, vec) })
+	rvec.Ref = 1
+	require.Panics(t, func() { Put(proc, rvec) })
+}
+
+func TestPoisonSharedNulls(t *testing.T) {
+	defer func(poison bool) { PoisonReleased = poison }(PoisonReleased)
+	PoisonReleased = true
+
+	proc := newTestProcess()
+	arg, err := proc.AllocVector(selsType, 64)
+	require.NoError(t, err)
+	nulls.Add(arg.Nsp, 1)
+	rs, err := Get(proc, 64, selsType)
+	require.NoError(t, err)
+	rs.Nsp = arg.Nsp
+	require.Panics(t, func() { Put(proc, rs) })
+
+	// a result with a copy of the nulls is put
+	rs, err = Get(proc, 64, selsType)
+	require.NoError(t, err)
+	nulls.Set(rs.Nsp, arg.Nsp)
+	Put(proc, rs)
+	Put(proc, arg)
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"math/bits"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+const (
+	// minClassShift is the shift of the smallest size class, 64 bytes.
+	minClassShift = 6
+	// maxClassShift is the shift of the largest size class, 64KB.
+	maxClassShift = 16
+	// MaxPooledSize is the largest buffer served by the freelist of a process.
+	MaxPooledSize = 1 << maxClassShift
+)
+
+// vectorPool is the freelist of small buffers of a process. It keeps one
+// sync.Pool per power-of-two size class, and each sync.Pool is sharded per P,
+// so the scopes sharing a process don't contend on a lock.
+//
+// The buffers in the freelist are not counted by the memory limit of the
+// process, they are counted again when they are handed out.
+type vectorPool struct {
+	classes [maxClassShift - minClassShift + 1]sync.Pool
+}
+
+func newVectorPool() *vectorPool {
+	return &vectorPool{}
+}
+
+// sizeClass returns the size class of a buffer of size bytes.
+func sizeClass(size int64) int {
+	if size <= 1<<minClassShift {
+		return 0
+	}
+	return bits.Len64(uint64(size-1)) - minClassShift
+}
+
+// classOf returns the size class of a buffer whose capacity is exactly the
+// size of the class, ok is false for the other buffers.
+func classOf(capacity int) (int, bool) {
+	if capacity < 1<<minClassShift || capacity > MaxPooledSize || capacity&(capacity-1) != 0 {
+		return 0, false
+	}
+	return bits.TrailingZeros(uint(capacity)) - minClassShift, true
+}
+
+func (p *vectorPool) get(proc *Process, size int64, typ types.Type) (*vector.Vector, error) {
+	class := sizeClass(size)
+	if v := p.classes[class].Get(); v != nil {
+		vec := v.(*vector.Vector)
+		if err := proc.Mp.Gm.Alloc(int64(cap(vec.Data))); err != nil {
+			p.classes[class].Put(vec)
+			return nil, err
+		}
+		vec.Ref = 0
+		vec.Or = false
+		vec.Typ = typ
+		vec.Nsp = &nulls.Nulls{}
+		vec.Data = vec.Data[:size]
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(typ, 1<<(class+minClassShift))
+	if err != nil {
+		return nil, err
+	}
+	vec.Data = vec.Data[:size]
+	return vec, nil
+}
+
+// put returns vec to the freelist if its buffer has the size of a class.
+// Only the part of the buffer in use is zeroed, not the whole class.
+func (p *vectorPool) put(proc *Process, vec *vector.Vector) bool {
+	if vec.Or {
+		return false
+	}
+	class, ok := classOf(cap(vec.Data))
+	if !ok {
+		return false
+	}
+	data := vec.Data
+	for i := range data {
+		data[i] = 0
+	}
+	mheap.Free(proc.Mp, data)
+	vec.Col = nil
+	// the bitmap may be shared with a vector still in use, it's dropped
+	// rather than cleared
+	vec.Nsp = &nulls.Nulls{}
+	p.classes[class].Put(vec)
+	return true
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/eq"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	scopes        = 8    // scopes sharing a process in the benchmark
+	BenchmarkRows = 8192 // rows of a batch in the benchmark
+)
+
+var selsType = types.Type{Oid: types.T_sel, Size: 8}
+
+func newTestProcess() *Process {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return New(mheap.New(gm))
+}
+
+func TestSizeClass(t *testing.T) {
+	require.Equal(t, 0, sizeClass(0))
+	require.Equal(t, 0, sizeClass(64))
+	require.Equal(t, 1, sizeClass(65))
+	require.Equal(t, 10, sizeClass(MaxPooledSize))
+	for _, n := range []int{64, 128, MaxPooledSize} {
+		class, ok := classOf(n)
+		require.True(t, ok)
+		require.Equal(t, sizeClass(int64(n)), class)
+	}
+	for _, n := range []int{0, 32, 100, 2 * MaxPooledSize} {
+		_, ok := classOf(n)
+		require.False(t, ok)
+	}
+}
+
+func TestGetPut(t *testing.T) {
+	proc := newTestProcess()
+
+	vec, err := Get(proc, 100, selsType)
+	require.NoError(t, err)
+	require.Equal(t, 100, len(vec.Data))
+	require.Equal(t, 128, cap(vec.Data))
+	require.Equal(t, int64(128), mheap.Size(proc.Mp))
+	for i := range vec.Data {
+		vec.Data[i] = 0xff
+	}
+	Put(proc, vec)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	require.Equal(t, 0, len(proc.Reg.Vecs))
+
+	// the buffers given back are zeroed
+	vec, err = Get(proc, 128, selsType)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 128), vec.Data)
+	require.Equal(t, int64(128), mheap.Size(proc.Mp))
+	Put(proc, vec)
+
+	// large buffers still go through the registers
+	vec, err = Get(proc, MaxPooledSize+1, selsType)
+	require.NoError(t, err)
+	Put(proc, vec)
+	require.Equal(t, 1, len(proc.Reg.Vecs))
+}
+
+// A vector put with the nulls of a vector still in use, like the result of an
+// operator aliasing the nulls of its argument, must not clear the nulls of the
+// argument, and the vector reused has empty nulls of its own.
+func TestPutSharedNulls(t *testing.T) {
+	for _, pooled := range []bool{true, false} {
+		proc := newTestProcess()
+		if !pooled {
+			proc.pool = nil
+		}
+		arg, err := Get(proc, 64, selsType)
+		require.NoError(t, err)
+		nulls.Add(arg.Nsp, 1, 3)
+		rs, err := Get(proc, 64, selsType)
+		require.NoError(t, err)
+		rs.Nsp = arg.Nsp
+		Put(proc, rs)
+		require.Equal(t, 2, nulls.Length(arg.Nsp))
+
+		vec, err := Get(proc, 64, selsType)
+		require.NoError(t, err)
+		require.Same(t, rs, vec)
+		require.NotSame(t, arg.Nsp, vec.Nsp)
+		require.False(t, nulls.Any(vec.Nsp))
+		nulls.Add(vec.Nsp, 0)
+		require.False(t, nulls.Contains(arg.Nsp, 0))
+		Put(proc, vec)
+		Put(proc, arg)
+	}
+}
+
+func TestGetLimit(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(100, hm)
+	proc := New(mheap.New(gm))
+	_, err := Get(proc, 100, selsType)
+	require.Error(t, err)
+}
+
+func TestConcurrentGetPut(t *testing.T) {
+	proc := newTestProcess()
+	var wg sync.WaitGroup
+	for i := 0; i < scopes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			filter(proc, 100, 1000)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func BenchmarkGetPut(b *testing.B) {
+	proc := newTestProcess()
+	b.ReportAllocs()
+	b.ResetTimer()
+	var wg sync.WaitGroup
+	for i := 0; i < scopes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			filter(proc, b.N/scopes+1, BenchmarkRows)
+		}()
+	}
+	wg.Wait()
+}
+
+// filter runs n comparisons of two columns of rows rows with a selection
+// buffer from proc each time, like the filters of a scope do.
+func filter(proc *Process, n, rows int) {
+	xs, ys := make([]int64, rows), make([]int64, rows)
+	for i := range xs {
+		xs[i] = int64(i % 3)
+		ys[i] = int64(i % 5)
+	}
+	for i := 0; i < n; i++ {
+		vec, err := Get(proc, int64(rows)*8, selsType)
+		if err != nil {
+			panic(err)
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = eq.Int64Eq(xs, ys, rs[:rows])
+		vec.Data = vec.Data[:len(rs)*8]
+		Put(proc, vec)
+	}
+}
@@ -16,30 +16,50 @@ package process
 
 import (
 	"context"
+	"math"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+var (
+	// ErrQueryTimeout is returned when the statement runs longer than its max_execution_time.
+	ErrQueryTimeout = errors.New(errno.OperatorIntervention, "query execution was interrupted, maximum statement execution time exceeded")
+	// ErrQueryInterrupted is returned when the statement is canceled.
+	ErrQueryInterrupted = errors.New(errno.OperatorIntervention, "query execution was interrupted")
+)
+
 // New creates a new Process.
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
-	return &Process{
-		Mp: m,
+	proc := &Process{
+		Mp:       m,
+		pool:     newVectorPool(),
+		warnings: new(warningList),
+		progress: NewProgress(),
 	}
+	proc.spill = newSpillManager(&proc.Lim)
+	return proc
 }
 
 // NewFromProc create a new Process based on another process.
 func NewFromProc(m *mheap.Mheap, p *Process, regNumber int) *Process {
-	proc := &Process{Mp: m}
+	proc := &Process{Mp: m, pool: newVectorPool()}
 	ctx, cancel := context.WithCancel(context.Background())
 	proc.Id = p.Id
 	proc.Lim = p.Lim
 	proc.UnixTime = p.UnixTime
 	proc.Snapshot = p.Snapshot
+	proc.SessionInfo = p.SessionInfo
+	proc.Ctx = p.Ctx
+	proc.warnings = p.warnings
+	proc.spill = p.Spill()
+	proc.progress = p.Progress()
 	// reg and cancel
 	proc.Cancel = cancel
 	proc.Reg.MergeReceivers = make([]*WaitRegister, regNumber)
@@ -52,6 +72,129 @@ func NewFromProc(m *mheap.Mheap, p *Process, regNumber int) *Process {
 	return proc
 }
 
+// NewWorker returns a process for a goroutine computing a part of a batch of
+// proc. It has registers and a freelist of its own, and shares the memory, the
+// session and the warnings with proc. The workers compute serially.
+func (proc *Process) NewWorker() *Process {
+	w := &Process{
+		Id:          proc.Id,
+		Lim:         proc.Lim,
+		Mp:          proc.Mp,
+		UnixTime:    proc.UnixTime,
+		Snapshot:    proc.Snapshot,
+		Ctx:         proc.Ctx,
+		SessionInfo: proc.SessionInfo,
+		pool:        newVectorPool(),
+		warnings:    proc.warnings,
+		spill:       proc.Spill(),
+		progress:    proc.Progress(),
+	}
+	w.Lim.Parallelism = 0
+	return w
+}
+
+// Interrupted returns an error if the statement of the process has been
+// interrupted, either because its deadline is exceeded or because it is
+// canceled.
+func (proc *Process) Interrupted() error {
+	if proc.Ctx == nil {
+		return nil
+	}
+	select {
+	case <-proc.Ctx.Done():
+		if proc.Ctx.Err() == context.DeadlineExceeded {
+			return ErrQueryTimeout
+		}
+		return ErrQueryInterrupted
+	default:
+		return nil
+	}
+}
+
+// ShareWarnings makes the process record its warnings with the ones of p.
+func (proc *Process) ShareWarnings(p *Process) {
+	proc.warnings = p.warnings
+}
+
+// ShareSpill makes the process create its spill files with the ones of p.
+func (proc *Process) ShareSpill(p *Process) {
+	proc.spill = p.Spill()
+}
+
+// ShareProgress makes the process count its work in the progress of p.
+func (proc *Process) ShareProgress(p *Process) {
+	proc.progress = p.Progress()
+}
+
+// Progress returns the progress of the statement run by the process.
+func (proc *Process) Progress() *Progress {
+	if proc.progress == nil {
+		proc.progress = NewProgress()
+	}
+	return proc.progress
+}
+
+// ResetProgress starts the progress of the next statement run by the
+// process, the progress of the previous one is left as it is.
+func (proc *Process) ResetProgress() {
+	proc.progress = NewProgress()
+}
+
+// Spill returns the manager of the spill files of the query.
+func (proc *Process) Spill() *SpillManager {
+	if proc.spill == nil {
+		proc.spill = newSpillManager(&proc.Lim)
+	}
+	return proc.spill
+}
+
+// AddWarnings records n warnings raised by the statement, without their
+// messages.
+func (proc *Process) AddWarnings(n int) {
+	proc.warnings.Lock()
+	defer proc.warnings.Unlock()
+	proc.warnings.n += uint32(n)
+}
+
+// AddWarning records a warning raised by the statement with its message,
+// which is listed by SHOW WARNINGS.
+func (proc *Process) AddWarning(level string, code uint16, message string) {
+	proc.warnings.Lock()
+	defer proc.warnings.Unlock()
+	proc.warnings.n++
+	if len(proc.warnings.msgs) < MaxWarningMessages {
+		proc.warnings.msgs = append(proc.warnings.msgs, Warning{Level: level, Code: code, Message: message})
+	}
+}
+
+// Warnings returns the number of the warnings raised by the statement, which
+// is sent to the client in the OK or the EOF packet.
+func (proc *Process) Warnings() uint16 {
+	proc.warnings.Lock()
+	defer proc.warnings.Unlock()
+	if proc.warnings.n > math.MaxUint16 {
+		return math.MaxUint16
+	}
+	return uint16(proc.warnings.n)
+}
+
+// WarningMessages returns the messages of the warnings raised by the
+// statement, in the order they are raised.
+func (proc *Process) WarningMessages() []Warning {
+	proc.warnings.Lock()
+	defer proc.warnings.Unlock()
+	return append([]Warning(nil), proc.warnings.msgs...)
+}
+
+// ResetWarnings clears the warnings before the process runs the next
+// statement.
+func (proc *Process) ResetWarnings() {
+	proc.warnings.Lock()
+	defer proc.warnings.Unlock()
+	proc.warnings.n = 0
+	proc.warnings.msgs = nil
+}
+
 func GetSels(proc *Process) []int64 {
 	if len(proc.Reg.Ss) == 0 {
 		return make([]int64, 0, 16)
@@ -70,6 +213,8 @@ func (proc *Process) GetBoolTyp(typ types.Type) (typ2 types.Type) {
 	return typ
 }
 
+// AllocVector returns a vector with a buffer of size bytes and a new empty
+// Nulls, which is shared with no other vector.
 func (proc *Process) AllocVector(typ types.Type, size int64) (*vector.Vector, error) {
 	data, err := mheap.Alloc(proc.Mp, size)
 	if err != nil {
@@ -77,42 +222,60 @@ func (proc *Process) AllocVector(typ types.Type, size int64) (*vector.Vector, er
 	}
 	vec := vector.New(typ)
 	vec.Data = data
+	own(vec)
 	return vec, nil
 }
 
 func (proc *Process) AllocScalarVector(typ types.Type) *vector.Vector {
-	return vector.NewConst(typ)
+	vec := vector.NewConst(typ)
+	own(vec)
+	return vec
 }
 
 func (proc *Process) AllocScalarNullVector(typ types.Type) *vector.Vector {
 	vec := vector.NewConst(typ)
 	nulls.Add(vec.Nsp, 0)
+	own(vec)
 	return vec
 }
 
+// Get returns a vector with a buffer of size bytes. Small buffers come from the
+// freelist of the process, the others are reused from the registers. The Nulls
+// of a reused vector is a new one, its old bitmap may still be read by another
+// vector.
 func Get(proc *Process, size int64, typ types.Type) (*vector.Vector, error) {
+	if proc.pool != nil && size <= MaxPooledSize {
+		return proc.pool.get(proc, size, typ)
+	}
 	for i, vec := range proc.Reg.Vecs {
 		if int64(cap(vec.Data)) >= size {
 			vec.Ref = 0
 			vec.Or = false
 			vec.Typ = typ
-			nulls.Reset(vec.Nsp)
+			vec.Nsp = &nulls.Nulls{}
 			vec.Data = vec.Data[:size]
 			proc.Reg.Vecs[i] = proc.Reg.Vecs[len(proc.Reg.Vecs)-1]
 			proc.Reg.Vecs = proc.Reg.Vecs[:len(proc.Reg.Vecs)-1]
 			return vec, nil
 		}
 	}
-	data, err := mheap.Alloc(proc.Mp, size)
-	if err != nil {
-		return nil, err
-	}
-	vec := vector.New(typ)
-	vec.Data = data
-	return vec, nil
+	return proc.AllocVector(typ, size)
 }
 
+// Put gives back a vector got from Get, vec must be owned by the caller.
 func Put(proc *Process, vec *vector.Vector) {
+	if PoisonReleased {
+		poison(proc, vec)
+		return
+	}
+	// the data of a view or a vector having views can't be reused
+	if vector.IsShared(vec) {
+		vector.Clean(vec, proc.Mp)
+		return
+	}
+	if proc.pool != nil && proc.pool.put(proc, vec) {
+		return
+	}
 	proc.Reg.Vecs = append(proc.Reg.Vecs, vec)
 }
 
@@ -0,0 +1,120 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Progress counts the work done by a statement, the counters are updated by
+// the operators of all the processes of the statement and read by the
+// other sessions while the statement runs.
+type Progress struct {
+	sync.Mutex
+	scans []*ScanProgress
+}
+
+// ScanProgress counts the rows and the blocks read by the scopes scanning a
+// relation, they are updated once per batch read.
+type ScanProgress struct {
+	// Relation, the name of the relation scanned
+	Relation string
+	// EstimatedRows, the rows of the relation when the scan is compiled
+	EstimatedRows int64
+
+	rows   int64
+	blocks int64
+}
+
+// NewProgress returns the progress of a statement that has done nothing.
+func NewProgress() *Progress {
+	return &Progress{}
+}
+
+// AddScan registers a scan of the relation, whose rows are estimated to
+// estimatedRows, and returns its counters.
+func (p *Progress) AddScan(relation string, estimatedRows int64) *ScanProgress {
+	p.Lock()
+	defer p.Unlock()
+	sp := &ScanProgress{Relation: relation, EstimatedRows: estimatedRows}
+	p.scans = append(p.scans, sp)
+	return sp
+}
+
+// Scans returns the scans of the statement, in the order they are compiled.
+func (p *Progress) Scans() []*ScanProgress {
+	p.Lock()
+	defer p.Unlock()
+	return append([]*ScanProgress(nil), p.scans...)
+}
+
+// RowsExamined returns the rows read by all the scans of the statement.
+func (p *Progress) RowsExamined() int64 {
+	var rows int64
+
+	for _, sp := range p.Scans() {
+		rows += sp.Rows()
+	}
+	return rows
+}
+
+// Percent returns the percentage of the estimated rows of the scans read so
+// far, it is false if the statement scans nothing.
+func (p *Progress) Percent() (float64, bool) {
+	var rows, estimated int64
+
+	for _, sp := range p.Scans() {
+		rows += sp.Rows()
+		estimated += sp.EstimatedRows
+	}
+	if estimated <= 0 {
+		return 0, false
+	}
+	return percent(rows, estimated), true
+}
+
+// Add counts a block of n rows read by the scan.
+func (sp *ScanProgress) Add(n int64) {
+	atomic.AddInt64(&sp.rows, n)
+	atomic.AddInt64(&sp.blocks, 1)
+}
+
+// Rows returns the rows read by the scan.
+func (sp *ScanProgress) Rows() int64 {
+	return atomic.LoadInt64(&sp.rows)
+}
+
+// Blocks returns the blocks read by the scan.
+func (sp *ScanProgress) Blocks() int64 {
+	return atomic.LoadInt64(&sp.blocks)
+}
+
+// Percent returns the percentage of the estimated rows read by the scan, the
+// rows appended after the scan is compiled are not estimated, so it is
+// capped to 100.
+func (sp *ScanProgress) Percent() float64 {
+	if sp.EstimatedRows <= 0 {
+		return 100
+	}
+	return percent(sp.Rows(), sp.EstimatedRows)
+}
+
+func percent(rows, estimated int64) float64 {
+	if rows >= estimated {
+		return 100
+	}
+	return float64(rows) * 100 / float64(estimated)
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	proc := newTestProcess()
+	p := proc.Progress()
+	_, ok := p.Percent()
+	require.False(t, ok)
+
+	// the processes of the statement count in the same progress
+	child := NewFromProc(proc.Mp, proc, 0)
+	require.Same(t, p, child.Progress())
+	t1 := child.Progress().AddScan("t1", 1000)
+	t2 := p.AddScan("t2", 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			t1.Add(50)
+		}()
+	}
+	wg.Wait()
+	t2.Add(10)
+	require.Equal(t, int64(500), t1.Rows())
+	require.Equal(t, int64(10), t1.Blocks())
+	require.Equal(t, 50.0, t1.Percent())
+	require.Equal(t, 100.0, t2.Percent())
+	require.Equal(t, int64(510), p.RowsExamined())
+	pct, ok := p.Percent()
+	require.True(t, ok)
+	require.Equal(t, 51.0, pct)
+	t1.Add(600)
+	require.Equal(t, 100.0, t1.Percent())
+
+	// the next statement starts from scratch, the previous one is kept
+	proc.ResetProgress()
+	require.NotSame(t, p, proc.Progress())
+	require.Empty(t, proc.Progress().Scans())
+	require.Len(t, p.Scans(), 2)
+}
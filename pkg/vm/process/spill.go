@@ -0,0 +1,270 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The categories of the spill files, by the operators spilling.
+const (
+	SpillSetOp = "setop"
+	SpillSort  = "sort"
+	SpillJoin  = "join"
+)
+
+var (
+	// SpillRoot is the directory of the spill files, each query spilling
+	// has a directory of its own in it.
+	SpillRoot = filepath.Join(os.TempDir(), "matrixone-spill")
+
+	// ErrSpillLimitExceeded is returned when the spill files of a query
+	// take more than the Limitation.SpillSize of its process.
+	ErrSpillLimitExceeded = errors.New(errno.InsufficientResources, "the spill files exceed the disk limit of the query")
+)
+
+var (
+	// spillDirs are the directories of the queries running, the others in
+	// SpillRoot are orphans
+	spillDirs   = make(map[string]struct{})
+	spillDirsMu sync.Mutex
+	spillSeq    uint64
+
+	// spilledBytes are the bytes spilled by category, for the metrics
+	spilledBytes sync.Map
+)
+
+// SpillManager owns the spill files of a query, it is shared by all the
+// processes of the query. The files are created in a directory of the query,
+// which is removed with them by Clean when the query ends.
+type SpillManager struct {
+	sync.Mutex
+	lim   *Limitation
+	dir   string
+	size  int64
+	files map[*SpillFile]struct{}
+}
+
+// SpillFile is a temporary file of a query, the bytes written are charged
+// to the spill limit of the query until the file is removed.
+type SpillFile struct {
+	f        *os.File
+	m        *SpillManager
+	category string
+	size     int64
+	closed   bool
+}
+
+func newSpillManager(lim *Limitation) *SpillManager {
+	return &SpillManager{lim: lim}
+}
+
+// CreateTempFile returns a new spill file of the category, the directory of
+// the query is created by the first one.
+func (m *SpillManager) CreateTempFile(category string) (*SpillFile, error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.dir == "" {
+		name := fmt.Sprintf("%d-%d", os.Getpid(), atomic.AddUint64(&spillSeq, 1))
+		dir := filepath.Join(SpillRoot, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		spillDirsMu.Lock()
+		spillDirs[name] = struct{}{}
+		spillDirsMu.Unlock()
+		m.dir = dir
+		m.files = make(map[*SpillFile]struct{})
+	}
+	f, err := os.CreateTemp(m.dir, category)
+	if err != nil {
+		return nil, err
+	}
+	sf := &SpillFile{f: f, m: m, category: category}
+	m.files[sf] = struct{}{}
+	return sf, nil
+}
+
+// Dir returns the directory of the query, it is empty if nothing has been
+// spilled.
+func (m *SpillManager) Dir() string {
+	m.Lock()
+	defer m.Unlock()
+	return m.dir
+}
+
+// Size returns the bytes of the spill files of the query.
+func (m *SpillManager) Size() int64 {
+	m.Lock()
+	defer m.Unlock()
+	return m.size
+}
+
+// Clean removes all the spill files and the directory of the query. It is
+// called when the query ends, whether it succeeds, fails or is canceled.
+func (m *SpillManager) Clean() error {
+	m.Lock()
+	defer m.Unlock()
+	if m.dir == "" {
+		return nil
+	}
+	for f := range m.files {
+		if !f.closed {
+			f.closed = true
+			_ = f.f.Close()
+		}
+	}
+	err := os.RemoveAll(m.dir)
+	spillDirsMu.Lock()
+	delete(spillDirs, filepath.Base(m.dir))
+	spillDirsMu.Unlock()
+	m.dir = ""
+	m.files = nil
+	m.size = 0
+	return err
+}
+
+// charge adds n bytes to the spill files of the query, it fails if they
+// exceed the limit.
+func (m *SpillManager) charge(category string, n int64) error {
+	m.Lock()
+	defer m.Unlock()
+	if m.lim != nil && m.lim.SpillSize > 0 && m.size+n > m.lim.SpillSize {
+		return ErrSpillLimitExceeded
+	}
+	m.size += n
+	addSpilledBytes(category, n)
+	return nil
+}
+
+func (f *SpillFile) Write(p []byte) (int, error) {
+	if err := f.m.charge(f.category, int64(len(p))); err != nil {
+		return 0, err
+	}
+	n, err := f.f.Write(p)
+	f.size += int64(n)
+	if n < len(p) {
+		f.m.Lock()
+		f.m.size -= int64(len(p) - n)
+		f.m.Unlock()
+	}
+	return n, err
+}
+
+func (f *SpillFile) Read(p []byte) (int, error) {
+	return f.f.Read(p)
+}
+
+func (f *SpillFile) Seek(offset int64, whence int) (int64, error) {
+	return f.f.Seek(offset, whence)
+}
+
+// Name returns the path of the file.
+func (f *SpillFile) Name() string {
+	return f.f.Name()
+}
+
+// Size returns the bytes written to the file.
+func (f *SpillFile) Size() int64 {
+	return f.size
+}
+
+// Close closes the file, which is kept until it is removed or the query
+// ends.
+func (f *SpillFile) Close() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	return f.f.Close()
+}
+
+// Remove closes and removes the file, its bytes are no longer charged to
+// the query.
+func (f *SpillFile) Remove() error {
+	if err := f.Close(); err != nil {
+		return err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	if _, ok := f.m.files[f]; !ok {
+		return nil
+	}
+	delete(f.m.files, f)
+	f.m.size -= f.size
+	return os.Remove(f.f.Name())
+}
+
+// SweepSpillDirs removes the directories in SpillRoot which belong to no
+// running query, they are left by the queries of a crashed server. It is
+// called when the server starts.
+func SweepSpillDirs() error {
+	entries, err := os.ReadDir(SpillRoot)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	spillDirsMu.Lock()
+	defer spillDirsMu.Unlock()
+	for _, e := range entries {
+		if _, ok := spillDirs[e.Name()]; ok {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(SpillRoot, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addSpilledBytes(category string, n int64) {
+	v, ok := spilledBytes.Load(category)
+	if !ok {
+		v, _ = spilledBytes.LoadOrStore(category, new(int64))
+	}
+	atomic.AddInt64(v.(*int64), n)
+}
+
+// SpilledBytes returns the bytes spilled by the category since the server
+// started.
+func SpilledBytes(category string) int64 {
+	if v, ok := spilledBytes.Load(category); ok {
+		return atomic.LoadInt64(v.(*int64))
+	}
+	return 0
+}
+
+// SpillCategories returns the categories having spilled.
+func SpillCategories() []string {
+	var cs []string
+	spilledBytes.Range(func(k, _ any) bool {
+		cs = append(cs, k.(string))
+		return true
+	})
+	sort.Strings(cs)
+	return cs
+}
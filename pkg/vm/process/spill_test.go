@@ -0,0 +1,118 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setSpillRoot(t *testing.T) {
+	root := SpillRoot
+	SpillRoot = t.TempDir()
+	t.Cleanup(func() { SpillRoot = root })
+}
+
+func TestSpillManager(t *testing.T) {
+	setSpillRoot(t)
+	proc := newTestProcess()
+	proc.Lim.SpillSize = 100
+	m := proc.Spill()
+	require.Equal(t, "", m.Dir())
+	// nothing is created before the first file
+	require.NoError(t, m.Clean())
+
+	spilled := SpilledBytes(SpillSort)
+	f, err := m.CreateTempFile(SpillSort)
+	require.NoError(t, err)
+	dir := m.Dir()
+	require.Equal(t, SpillRoot, filepath.Dir(dir))
+	require.Equal(t, dir, filepath.Dir(f.Name()))
+	_, err = f.Write(make([]byte, 60))
+	require.NoError(t, err)
+	require.Equal(t, int64(60), m.Size())
+	require.Equal(t, spilled+60, SpilledBytes(SpillSort))
+	require.Contains(t, SpillCategories(), SpillSort)
+
+	// the processes of the query share the budget
+	child := NewFromProc(proc.Mp, proc, 0)
+	require.Same(t, m, child.Spill())
+	g, err := child.Spill().CreateTempFile(SpillJoin)
+	require.NoError(t, err)
+	_, err = g.Write(make([]byte, 50))
+	require.ErrorIs(t, err, ErrSpillLimitExceeded)
+	require.Equal(t, int64(60), m.Size())
+
+	// a removed file gives back its bytes
+	require.NoError(t, f.Remove())
+	_, err = os.Stat(f.Name())
+	require.True(t, os.IsNotExist(err))
+	require.Equal(t, int64(0), m.Size())
+	_, err = g.Write([]byte("spilled rows"))
+	require.NoError(t, err)
+	_, err = g.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	data, err := io.ReadAll(g)
+	require.NoError(t, err)
+	require.Equal(t, "spilled rows", string(data))
+	require.NoError(t, g.Close())
+	require.NoError(t, g.Close())
+	_, err = os.Stat(g.Name())
+	require.NoError(t, err)
+
+	// the directory is removed with the files left when the query ends
+	_, err = m.CreateTempFile(SpillSort)
+	require.NoError(t, err)
+	require.NoError(t, m.Clean())
+	require.Equal(t, "", m.Dir())
+	require.Equal(t, int64(0), m.Size())
+	_, err = os.Stat(dir)
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, g.Remove())
+}
+
+func TestSweepSpillDirs(t *testing.T) {
+	setSpillRoot(t)
+	require.NoError(t, SweepSpillDirs())
+
+	// a query abandoned by a crash, its files are never cleaned
+	abandoned := newTestProcess().Spill()
+	f, err := abandoned.CreateTempFile(SpillSetOp)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("left behind"))
+	require.NoError(t, err)
+	spillDirsMu.Lock()
+	spillDirs = make(map[string]struct{})
+	spillDirsMu.Unlock()
+
+	// and the query running
+	running := newTestProcess().Spill()
+	_, err = running.CreateTempFile(SpillSort)
+	require.NoError(t, err)
+
+	require.NoError(t, SweepSpillDirs())
+	_, err = os.Stat(abandoned.Dir())
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(running.Dir())
+	require.NoError(t, err)
+	require.NoError(t, running.Clean())
+	entries, err := os.ReadDir(SpillRoot)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
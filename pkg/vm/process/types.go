@@ -16,12 +16,31 @@ package process
 
 import (
 	"context"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+// MaxWarningMessages is the max number of the warning messages kept for a
+// statement, the warnings beyond it are only counted.
+const MaxWarningMessages = 1024
+
+// Warning is a warning raised by a statement, as listed by SHOW WARNINGS.
+type Warning struct {
+	// Level, Note, Warning or Error
+	Level   string
+	Code    uint16
+	Message string
+}
+
+type warningList struct {
+	sync.Mutex
+	n    uint32
+	msgs []Warning
+}
+
 // WaitRegister channel
 type WaitRegister struct {
 	Ctx context.Context
@@ -53,6 +72,34 @@ type Limitation struct {
 	BatchSize int64
 	// PartitionRows, max rows for partition.
 	PartitionRows int64
+	// SpillSize, max bytes of the spill files, 0 for no limit.
+	SpillSize int64
+	// Parallelism, the number of the goroutines an operator may use to
+	// compute a batch, 0 or 1 for the serial computation. The budget of a
+	// query is divided among the scopes running in parallel.
+	Parallelism int
+}
+
+// SessionInfo is the state of the session running the query, it is
+// used by functions like database() and connection_id().
+type SessionInfo struct {
+	User         string
+	Database     string
+	Version      string
+	ConnectionID uint64
+	// StrictMode, the sql_mode of the session has STRICT_TRANS_TABLES or
+	// STRICT_ALL_TABLES, the truncation of a value is an error but not a
+	// warning.
+	StrictMode bool
+	// ErrorForDivisionByZero, the sql_mode of the session is strict and has
+	// ERROR_FOR_DIVISION_BY_ZERO, a division by zero is an error but not NULL.
+	ErrorForDivisionByZero bool
+	// MaxCrossJoinRows, a cross join estimated to make more rows is an error,
+	// there is no limit if it is 0.
+	MaxCrossJoinRows int64
+	// Ctl runs an admin command of mo_ctl on the storage and returns its
+	// result, it is nil if the session may not run the admin commands.
+	Ctl func(cmd, arg string) (string, error)
 }
 
 // Process contains context used in query execution
@@ -73,4 +120,23 @@ type Process struct {
 
 	// snapshot is transaction context
 	Cancel context.CancelFunc
+
+	// Ctx, the context of the statement, it carries the deadline of the
+	// statement and is checked by the pipelines and the long running operators.
+	Ctx context.Context
+
+	SessionInfo SessionInfo
+
+	// pool, the freelist of small buffers used by Get and Put.
+	pool *vectorPool
+
+	// warnings, the warnings raised by the statement, they are shared by
+	// the processes created from the same process.
+	warnings *warningList
+
+	// spill, the spill files of the query, it is shared like warnings.
+	spill *SpillManager
+
+	// progress, the progress of the statement, it is shared like warnings.
+	progress *Progress
 }